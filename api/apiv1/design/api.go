@@ -139,16 +139,93 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("get-storage-usage", func() {
+		g.Description("Returns the amount of Etcd storage used by the Control Plane, grouped by key prefix.")
+		g.Meta("openapi:summary", "Get storage usage")
+		g.Payload(func() {
+			g.Attribute("depth", g.Int, func() {
+				g.Description("The number of key levels to group by. Defaults to 1.")
+				g.Minimum(1)
+				g.Maximum(4)
+				g.Example(2)
+			})
+		})
+		g.Result(StorageUsage)
+		g.Error("cluster_not_initialized")
+
+		g.HTTP(func() {
+			g.GET("/v1/cluster/storage-usage")
+
+			g.Param("depth")
+
+			g.Meta("openapi:tag:Cluster")
+		})
+	})
+
+	g.Method("get-etcd-cluster", func() {
+		g.Description("Returns the members of the Control Plane's Etcd cluster with their role, database size, and raft progress, along with the cluster's quorum and failure tolerance.")
+		g.Meta("openapi:summary", "Get Etcd cluster")
+		g.Result(EtcdCluster)
+		g.Error("cluster_not_initialized")
+
+		g.HTTP(func() {
+			g.GET("/v1/cluster/etcd")
+
+			g.Meta("openapi:tag:Cluster")
+		})
+	})
+
+	g.Method("defragment-etcd", func() {
+		g.Description("Defragments the Etcd database on the given hosts to reclaim the space that Etcd can reuse after compaction. Hosts are defragmented one at a time, and the leader is defragmented last. Each member stops serving requests while it's defragmented.")
+		g.Meta("openapi:summary", "Defragment Etcd")
+		g.Payload(DefragmentEtcdRequest)
+		g.Result(DefragmentEtcdResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+
+		g.HTTP(func() {
+			g.POST("/v1/cluster/etcd/defragment")
+
+			g.Meta("openapi:tag:Cluster")
+		})
+	})
+
 	g.Method("list-hosts", func() {
 		g.Description("Lists all hosts within the cluster.")
 		g.Meta("openapi:summary", "List hosts")
+		g.Payload(func() {
+			g.Attribute("limit", g.Int, func() {
+				g.Description("Maximum number of hosts to return.")
+				g.Minimum(1)
+				g.Example(100)
+			})
+			g.Attribute("cursor", Identifier, func() {
+				g.Description("Returns hosts after this cursor. Use the next_cursor value from a previous response.")
+				g.Example("host-1")
+			})
+			g.Attribute("state", g.ArrayOf(g.String, func() {
+				g.Enum("healthy", "unreachable", "degraded", "unknown")
+			}), func() {
+				g.Description("Only return hosts in one of these states.")
+				g.Example([]string{"unreachable", "degraded"})
+			})
+			g.Attribute("postgres_version", g.String, func() {
+				g.Description("Only return hosts that support this Postgres version. Partial versions match by prefix, so '17' matches '17.6'.")
+				g.Example("17")
+			})
+		})
 		g.Result(ListHostsResponse, func() {
 			g.Example(ListHostsResponseExample)
 		})
 		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
 
 		g.HTTP(func() {
 			g.GET("/v1/hosts")
+			g.Param("limit")
+			g.Param("cursor")
+			g.Param("state")
+			g.Param("postgres_version")
 
 			g.Meta("openapi:tag:Host")
 		})
@@ -206,6 +283,31 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("change-host-etcd-mode", func() {
+		g.Description("Changes whether a host runs an Etcd server or connects to the other hosts as an Etcd client. The host restarts to apply the change. The response includes warnings when the cluster will be unable to tolerate the failure of an Etcd server after the change.")
+		g.Meta("openapi:summary", "Change host Etcd mode")
+		g.Payload(func() {
+			g.Attribute("host_id", Identifier, func() {
+				g.Description("ID of the host to change.")
+				g.Example("host-1")
+			})
+			g.Attribute("request", ChangeHostEtcdModeRequest)
+
+			g.Required("host_id", "request")
+		})
+		g.Result(ChangeHostEtcdModeResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.POST("/v1/hosts/{host_id}/etcd-mode")
+			g.Body("request")
+
+			g.Meta("openapi:tag:Host")
+		})
+	})
+
 	g.Method("list-databases", func() {
 		g.Description("Lists all databases in the cluster.")
 		g.Meta("openapi:summary", "List databases")
@@ -214,13 +316,63 @@ var _ = g.Service("control-plane", func() {
 				g.Description("Optional fields to include in each database response. Supported values: available_upgrades.")
 				g.Example([]string{"available_upgrades"})
 			})
+			g.Attribute("limit", g.Int, func() {
+				g.Description("Maximum number of databases to return.")
+				g.Minimum(1)
+				g.Example(100)
+			})
+			g.Attribute("cursor", Identifier, func() {
+				g.Description("Returns databases after this cursor. Use the next_cursor value from a previous response.")
+				g.Example("inventory")
+			})
+			g.Attribute("tenant_id", Identifier, func() {
+				g.Description("Only return databases that belong to this tenant.")
+				g.Example("8210ec10-2dca-406c-ac4a-0661d2189954")
+			})
+			g.Attribute("state", g.ArrayOf(g.String, func() {
+				g.Enum(
+					"creating",
+					"modifying",
+					"available",
+					"deleting",
+					"degraded",
+					"failed",
+					"restoring",
+					"unknown",
+				)
+			}), func() {
+				g.Description("Only return databases in one of these states.")
+				g.Example([]string{"available", "degraded"})
+			})
+			g.Attribute("host_id", Identifier, func() {
+				g.Description("Only return databases with an instance on this host.")
+				g.Example("host-1")
+			})
+			g.Attribute("postgres_version", g.String, func() {
+				g.Description("Only return databases that use this Postgres version. Partial versions match by prefix, so '17' matches '17.6'.")
+				g.Example("17")
+			})
+			g.Attribute("view", g.String, func() {
+				g.Enum("full", "summary")
+				g.Default("full")
+				g.Description("The summary view omits instances and service instances from each database.")
+				g.Example("summary")
+			})
 		})
 		g.Result(ListDatabasesResponse)
 		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
 
 		g.HTTP(func() {
 			g.GET("/v1/databases")
 			g.Param("include")
+			g.Param("limit")
+			g.Param("cursor")
+			g.Param("tenant_id")
+			g.Param("state")
+			g.Param("host_id")
+			g.Param("postgres_version")
+			g.Param("view")
 
 			g.Meta("openapi:tag:Database")
 		})
@@ -310,7 +462,7 @@ var _ = g.Service("control-plane", func() {
 	})
 
 	g.Method("apply-upgrade", func() {
-		g.Description("Applies a minor-version upgrade to a database. The target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered.")
+		g.Description("Applies an upgrade to a database. In the default 'minor' mode, the target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered. In the 'pg_upgrade' mode, each node is upgraded to a new Postgres major version in place: every node's primary is first checked with pg_upgrade --check, then each node is stopped, its primary is upgraded with pg_upgrade --link, and its replicas are rebuilt from the upgraded primary. Each node is unavailable while it's being upgraded.")
 		g.Meta("openapi:summary", "Apply database upgrade")
 		g.Payload(func() {
 			g.Attribute("database_id", Identifier, func() {
@@ -336,6 +488,58 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("upgrade-database-major", func() {
+		g.Description("Upgrades a database to a new Postgres major version by replacing each of its nodes, one at a time, with a new node running the target version. Each replacement node is populated from the node it replaces, services are switched over to the replacement, and then the original node is removed. If adding a replacement node or switching services over fails, that node's changes are rolled back and the task fails.")
+		g.Meta("openapi:summary", "Upgrade database major version")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database to upgrade.")
+				g.Example("my-app")
+			})
+			g.Attribute("request", UpgradeDatabaseMajorRequest)
+
+			g.Required("database_id", "request")
+		})
+		g.Result(UpgradeDatabaseMajorResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("database_not_modifiable")
+		g.Error("invalid_input")
+		g.Error("not_found")
+		g.Error("operation_already_in_progress")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/upgrade-major")
+			g.Body("request")
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
+	g.Method("cutover-database-import", func() {
+		g.Description("Cuts over a database that was created with an import source. The task waits for the import's replication lag to reach zero, copies the source's sequence values, and then stops replication from the source. Writes to the source should be stopped before the cutover.")
+		g.Meta("openapi:summary", "Cut over database import")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database to cut over.")
+				g.Example("my-app")
+			})
+
+			g.Required("database_id")
+		})
+		g.Result(CutoverDatabaseImportResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("database_not_modifiable")
+		g.Error("invalid_input")
+		g.Error("not_found")
+		g.Error("operation_already_in_progress")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/import/cutover")
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
 	g.Method("delete-database", func() {
 		g.Description("Deletes a database from the cluster.")
 		g.Meta("openapi:summary", "Delete database")
@@ -445,6 +649,33 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("rolling-restart-database", func() {
+		g.Description("Restarts a database's instances one at a time without taking any of its nodes offline. On each node, replicas are restarted first, and each replica must resume streaming before the next instance is restarted. If a node's primary needs a restart, it's switched over to the most up-to-date replica, restarted, and optionally switched back. Each restart and switchover is tracked by its own task, whose parent is the rolling restart task.")
+		g.Meta("openapi:summary", "Rolling restart database")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database to restart.")
+				g.Example("my-app")
+			})
+			g.Attribute("request", RollingRestartDatabaseRequest)
+
+			g.Required("database_id")
+		})
+		g.Result(RollingRestartDatabaseResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("database_not_modifiable")
+		g.Error("invalid_input")
+		g.Error("not_found")
+		g.Error("operation_already_in_progress")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/rolling-restart")
+			g.Body("request")
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
 	g.Method("failover-database-node", func() {
 		g.Description("Performs a failover for a node to a replica candidate.")
 		g.Meta("openapi:summary", "Failover database node")
@@ -463,6 +694,75 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("get-node-query-stats", func() {
+		g.Description("Returns the most recently collected pg_stat_statements statistics for each instance in a node. Query stats collection must be enabled in the database spec.")
+		g.Meta("openapi:summary", "Get node query stats")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database.")
+				g.Example("my-app")
+			})
+			g.Attribute("node_name", g.String, func() {
+				g.Description("Name of the node.")
+				g.Pattern(nodeNamePattern)
+				g.Example("n1")
+			})
+			g.Attribute("sort_by", g.String, func() {
+				g.Description("The statistic to sort the statements by, in descending order.")
+				g.Enum("total_time", "calls", "rows")
+				g.Default("total_time")
+				g.Example("calls")
+			})
+			g.Attribute("limit", g.Int, func() {
+				g.Description("Maximum number of statements to return for each instance.")
+				g.Minimum(1)
+				g.Example(10)
+			})
+
+			g.Required("database_id", "node_name")
+		})
+		g.Result(GetNodeQueryStatsResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.GET("/v1/databases/{database_id}/nodes/{node_name}/query-stats")
+			g.Param("sort_by")
+			g.Param("limit")
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
+	g.Method("reset-node-query-stats", func() {
+		g.Description("Discards the pg_stat_statements statistics on each instance in a node, as well as the statistics that have been collected from them.")
+		g.Meta("openapi:summary", "Reset node query stats")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database.")
+				g.Example("my-app")
+			})
+			g.Attribute("node_name", g.String, func() {
+				g.Description("Name of the node.")
+				g.Pattern(nodeNamePattern)
+				g.Example("n1")
+			})
+
+			g.Required("database_id", "node_name")
+		})
+		g.Result(ResetNodeQueryStatsResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/nodes/{node_name}/query-stats/reset")
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
 	g.Method("list-database-tasks", func() {
 		g.Description("Lists all tasks for a database.")
 		g.Meta("openapi:summary", "List database tasks")
@@ -720,6 +1020,155 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("stream-events", func() {
+		g.Description("Streams database, instance, and task change events as server-sent events. The first event is always a heartbeat, and only changes that happen after the heartbeat are sent.")
+		g.Meta("openapi:summary", "Stream events")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("Only stream events for this database.")
+				g.Example("my-app")
+			})
+			g.Attribute("host_id", Identifier, func() {
+				g.Description("Only stream events for databases, instances, and tasks on this host.")
+				g.Example("host-1")
+			})
+			g.Attribute("kind", g.ArrayOf(g.String, func() {
+				g.Enum("database", "instance", "task", "task_log")
+			}), func() {
+				g.Description("Only stream events of these kinds. All kinds are streamed by default.")
+				g.Example([]string{"database", "task"})
+			})
+		})
+		g.StreamingResult(Event)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+
+		g.HTTP(func() {
+			g.GET("/v1/events")
+			g.Param("database_id")
+			g.Param("host_id")
+			g.Param("kind")
+			g.ServerSentEvents(func() {
+				g.SSEEventID("id")
+				g.SSEEventType("kind")
+			})
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
+	g.Method("create-webhook", func() {
+		g.Description("Registers a webhook endpoint that receives lifecycle events, such as task failures and database state changes. Each payload is signed with the webhook's secret.")
+		g.Meta("openapi:summary", "Create webhook")
+		g.Payload(func() {
+			g.Attribute("request", CreateWebhookRequest)
+
+			g.Required("request")
+		})
+		g.Result(Webhook)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+
+		g.HTTP(func() {
+			g.POST("/v1/webhooks")
+			g.Body("request")
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
+	g.Method("list-webhooks", func() {
+		g.Description("Lists the webhooks in the cluster. Secrets are not returned.")
+		g.Meta("openapi:summary", "List webhooks")
+		g.Result(ListWebhooksResponse)
+		g.Error("cluster_not_initialized")
+
+		g.HTTP(func() {
+			g.GET("/v1/webhooks")
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
+	g.Method("get-webhook", func() {
+		g.Description("Returns information about a particular webhook. The secret is not returned.")
+		g.Meta("openapi:summary", "Get webhook")
+		g.Payload(func() {
+			g.Attribute("webhook_id", g.String, func() {
+				g.Format(g.FormatUUID)
+				g.Description("ID of the webhook to get.")
+				g.Example("0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1")
+			})
+
+			g.Required("webhook_id")
+		})
+		g.Result(Webhook)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.GET("/v1/webhooks/{webhook_id}")
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
+	g.Method("delete-webhook", func() {
+		g.Description("Deletes a webhook and its delivery history. Pending deliveries are discarded.")
+		g.Meta("openapi:summary", "Delete webhook")
+		g.Payload(func() {
+			g.Attribute("webhook_id", g.String, func() {
+				g.Format(g.FormatUUID)
+				g.Description("ID of the webhook to delete.")
+				g.Example("0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1")
+			})
+
+			g.Required("webhook_id")
+		})
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.DELETE("/v1/webhooks/{webhook_id}")
+			g.Response(g.StatusNoContent)
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
+	g.Method("list-webhook-deliveries", func() {
+		g.Description("Lists a webhook's most recent deliveries, newest first. Delivery history is kept for seven days.")
+		g.Meta("openapi:summary", "List webhook deliveries")
+		g.Payload(func() {
+			g.Attribute("webhook_id", g.String, func() {
+				g.Format(g.FormatUUID)
+				g.Description("ID of the webhook.")
+				g.Example("0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1")
+			})
+			g.Attribute("limit", g.Int, func() {
+				g.Description("Maximum number of deliveries to return.")
+				g.Minimum(1)
+				g.Default(50)
+				g.Example(20)
+			})
+
+			g.Required("webhook_id")
+		})
+		g.Result(ListWebhookDeliveriesResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.GET("/v1/webhooks/{webhook_id}/deliveries")
+			g.Param("limit")
+
+			g.Meta("openapi:tag:System")
+		})
+	})
+
 	g.Method("restore-database", func() {
 		g.Description("Perform an in-place restore of one or more nodes using the given restore configuration.")
 		g.Meta("openapi:summary", "Restore database")
@@ -754,6 +1203,34 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("clone-database", func() {
+		g.Description("Creates a new database from a node of an existing database. The clone is restored from the source node's backup repository, either from its latest backup and archived WAL or from a fresh backup taken from one of its replicas. The clone is a single-node database, and it never replicates with the source: Spock subscriptions are dropped during the restore.")
+		g.Meta("openapi:summary", "Clone database")
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database to clone.")
+				g.Example("storefront")
+			})
+			g.Attribute("request", CloneDatabaseRequest)
+
+			g.Required("database_id", "request")
+		})
+		g.Result(CloneDatabaseResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("database_already_exists", APIError)
+		g.Error("invalid_input")
+		g.Error("not_found")
+		g.Error("operation_already_in_progress")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/clone")
+			g.Body("request")
+			g.Response("database_already_exists", http.StatusConflict)
+
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
 	g.Method("get-version", func() {
 		g.Description("Returns version information for this Control Plane server.")
 		g.Meta("openapi:summary", "Get version")
@@ -825,6 +1302,145 @@ var _ = g.Service("control-plane", func() {
 			g.Meta("openapi:tag:Database")
 		})
 	})
+	g.Method("get-instance-sessions", func() {
+		g.Description("Returns the client sessions on a specific instance within a database, including the locks that each session holds or is waiting for and the chains of sessions that are blocking each other.")
+		g.Meta("openapi:summary", "Get instance sessions")
+
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("The ID of the database that owns the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d")
+			})
+			g.Attribute("instance_id", g.String, func() {
+				g.Description("The ID of the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi")
+				g.MinLength(1)
+				g.MaxLength(63)
+			})
+
+			g.Required("database_id", "instance_id")
+		})
+
+		g.Result(GetInstanceSessionsResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.GET("/v1/databases/{database_id}/instances/{instance_id}/sessions")
+			g.Param("database_id")
+			g.Param("instance_id")
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
+	g.Method("cancel-instance-session", func() {
+		g.Description("Cancels the current query of a client session on a specific instance. The cancellation is performed and recorded by a task.")
+		g.Meta("openapi:summary", "Cancel instance session")
+
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("The ID of the database that owns the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d")
+			})
+			g.Attribute("instance_id", g.String, func() {
+				g.Description("The ID of the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi")
+				g.MinLength(1)
+				g.MaxLength(63)
+			})
+			g.Attribute("pid", g.Int, func() {
+				g.Description("The process ID of the session.")
+				g.Minimum(1)
+				g.Example(4182)
+			})
+
+			g.Required("database_id", "instance_id", "pid")
+		})
+
+		g.Result(SignalInstanceSessionResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/cancel")
+			g.Param("database_id")
+			g.Param("instance_id")
+			g.Param("pid")
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
+	g.Method("terminate-instance-session", func() {
+		g.Description("Terminates a client session on a specific instance. The termination is performed and recorded by a task.")
+		g.Meta("openapi:summary", "Terminate instance session")
+
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("The ID of the database that owns the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d")
+			})
+			g.Attribute("instance_id", g.String, func() {
+				g.Description("The ID of the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi")
+				g.MinLength(1)
+				g.MaxLength(63)
+			})
+			g.Attribute("pid", g.Int, func() {
+				g.Description("The process ID of the session.")
+				g.Minimum(1)
+				g.Example(4182)
+			})
+
+			g.Required("database_id", "instance_id", "pid")
+		})
+
+		g.Result(SignalInstanceSessionResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/terminate")
+			g.Param("database_id")
+			g.Param("instance_id")
+			g.Param("pid")
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
+	g.Method("get-instance-postgresql-conf", func() {
+		g.Description("Returns the effective postgresql.conf for a specific instance within a database, including the source of each setting and whether it's waiting for a restart.")
+		g.Meta("openapi:summary", "Get instance postgresql.conf")
+
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("The ID of the database that owns the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d")
+			})
+			g.Attribute("instance_id", g.String, func() {
+				g.Description("The ID of the instance.")
+				g.Example("68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi")
+				g.MinLength(1)
+				g.MaxLength(63)
+			})
+
+			g.Required("database_id", "instance_id")
+		})
+
+		g.Result(GetInstancePostgreSQLConfResponse)
+		g.Error("cluster_not_initialized")
+		g.Error("invalid_input")
+		g.Error("not_found")
+
+		g.HTTP(func() {
+			g.GET("/v1/databases/{database_id}/instances/{instance_id}/postgresql-conf")
+			g.Param("database_id")
+			g.Param("instance_id")
+			g.Meta("openapi:tag:Database")
+		})
+	})
 	g.Method("stop-instance", func() {
 		g.Description("Stops a specific instance within a database. Supports immediate stops.")
 		g.Meta("openapi:summary", "Stops a database instance")
@@ -977,6 +1593,41 @@ var _ = g.Service("control-plane", func() {
 		})
 	})
 
+	g.Method("resume-database-task", func() {
+		g.Description("Resumes a paused task for a database.")
+		g.Meta("openapi:summary", "Resume a database task")
+
+		g.Payload(func() {
+			g.Attribute("database_id", Identifier, func() {
+				g.Description("ID of the database that owns the task.")
+				g.Example("abc123")
+			})
+			g.Attribute("task_id", Identifier, func() {
+				g.Description("ID of the task to resume.")
+				g.Example("def456")
+			})
+
+			g.Required("database_id", "task_id")
+		})
+
+		g.Result(Task, func() {
+			g.Description("Returns the resumed task.")
+		})
+
+		g.Error("cluster_not_initialized")
+		g.Error("not_found", func() {
+			g.Description("The specified database or task could not be found.")
+		})
+		g.Error("invalid_input", func() {
+			g.Description("The task is not running.")
+		})
+
+		g.HTTP(func() {
+			g.POST("/v1/databases/{database_id}/tasks/{task_id}/resume")
+			g.Meta("openapi:tag:Database")
+		})
+	})
+
 	// Serves the OpenAPI spec as a static file
 	g.Files("/v1/openapi.json", "./gen/http/openapi3.json", func() {
 		g.Meta("openapi:generate", "false")
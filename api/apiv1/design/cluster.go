@@ -6,8 +6,8 @@ import (
 
 var ClusterStatus = g.Type("ClusterStatus", func() {
 	g.Attribute("state", g.String, func() {
-		g.Description("The current state of the cluster.")
-		g.Enum("available", "error")
+		g.Description("The current state of the cluster. The state is starting when the host that served the request hasn't finished starting up, such as while it waits for the other hosts to be upgraded.")
+		g.Enum("available", "error", "starting")
 		g.Meta("struct:tag:json", "state")
 	})
 
@@ -29,10 +29,300 @@ var Cluster = g.Type("Cluster", func() {
 		g.Example(HostsArrayExample)
 		g.Meta("struct:tag:json", "hosts")
 	})
+	g.Attribute("upgrade", ClusterUpgradeStatus, func() {
+		g.Description("The progress of the current Control Plane upgrade, if any.")
+		g.Meta("struct:tag:json", "upgrade,omitempty")
+	})
 
 	g.Required("id", "status", "hosts")
 })
 
+var ClusterUpgradeHost = g.Type("ClusterUpgradeHost", func() {
+	g.Attribute("host_id", Identifier, func() {
+		g.Description("The ID of the host.")
+		g.Example("host-1")
+		g.Meta("struct:tag:json", "host_id")
+	})
+	g.Attribute("version", g.String, func() {
+		g.Description("The Control Plane version that's running on the host.")
+		g.Example("v0.6.0")
+		g.Meta("struct:tag:json", "version,omitempty")
+	})
+	g.Attribute("revision", g.String, func() {
+		g.Description("The newest data migration that the host's version supports.")
+		g.Example("add_task_scope")
+		g.Meta("struct:tag:json", "revision,omitempty")
+	})
+	g.Attribute("state_version", g.String, func() {
+		g.Description("The newest resource state version that the host's version supports.")
+		g.Example("1.0.0")
+		g.Meta("struct:tag:json", "state_version,omitempty")
+	})
+	g.Attribute("compatible", g.Boolean, func() {
+		g.Description("True if the host's version can read the current data.")
+		g.Example(true)
+		g.Meta("struct:tag:json", "compatible")
+	})
+	g.Attribute("upgraded", g.Boolean, func() {
+		g.Description("True if the host's version supports every change that's supported by the newest version in the cluster.")
+		g.Example(false)
+		g.Meta("struct:tag:json", "upgraded")
+	})
+
+	g.Required("host_id", "compatible", "upgraded")
+})
+
+var ClusterUpgradeStatus = g.Type("ClusterUpgradeStatus", func() {
+	g.Attribute("state", g.String, func() {
+		g.Description("Whether every host has been upgraded and every pending change has been applied.")
+		g.Enum("complete", "in_progress")
+		g.Example("in_progress")
+		g.Meta("struct:tag:json", "state")
+	})
+	g.Attribute("revision", g.String, func() {
+		g.Description("The most recent data migration that's been applied.")
+		g.Example("add_task_scope")
+		g.Meta("struct:tag:json", "revision,omitempty")
+	})
+	g.Attribute("state_version", g.String, func() {
+		g.Description("The newest resource state version in the data.")
+		g.Example("1.0.0")
+		g.Meta("struct:tag:json", "state_version,omitempty")
+	})
+	g.Attribute("pending_migrations", g.ArrayOf(g.String), func() {
+		g.Description("Data migrations that are supported by the newest version in the cluster but haven't been applied. Upgraded hosts apply them once every host supports them.")
+		g.Example([]string{"add_task_scope"})
+		g.Meta("struct:tag:json", "pending_migrations")
+	})
+	g.Attribute("hosts", g.ArrayOf(ClusterUpgradeHost), func() {
+		g.Description("The upgrade status of each host.")
+		g.Meta("struct:tag:json", "hosts")
+	})
+	g.Attribute("incompatibilities", g.ArrayOf(g.String), func() {
+		g.Description("Hosts that can't read the current data or that are holding back the upgrade.")
+		g.Example([]string{"host 'host-2' doesn't support pending migration 'add_task_scope'"})
+		g.Meta("struct:tag:json", "incompatibilities")
+	})
+
+	g.Required("state", "pending_migrations", "hosts", "incompatibilities")
+})
+
+var StoragePrefixUsage = g.Type("StoragePrefixUsage", func() {
+	g.Attribute("prefix", g.String, func() {
+		g.Description("The key prefix.")
+		g.Example("/control-plane/workflows/history_events/")
+		g.Meta("struct:tag:json", "prefix")
+	})
+	g.Attribute("keys", g.Int64, func() {
+		g.Description("The number of keys under this prefix.")
+		g.Example(5230)
+		g.Meta("struct:tag:json", "keys")
+	})
+	g.Attribute("bytes", g.Int64, func() {
+		g.Description("The total size of the keys and values under this prefix.")
+		g.Example(48234112)
+		g.Meta("struct:tag:json", "bytes")
+	})
+
+	g.Required("prefix", "keys", "bytes")
+})
+
+var StorageUsage = g.Type("StorageUsage", func() {
+	g.Attribute("db_size_bytes", g.Int64, func() {
+		g.Description("The size of the Etcd database on disk, including space that Etcd can reuse.")
+		g.Example(73400320)
+		g.Meta("struct:tag:json", "db_size_bytes")
+	})
+	g.Attribute("db_size_in_use_bytes", g.Int64, func() {
+		g.Description("The portion of the Etcd database that's in use.")
+		g.Example(61865984)
+		g.Meta("struct:tag:json", "db_size_in_use_bytes")
+	})
+	g.Attribute("keys", g.Int64, func() {
+		g.Description("The total number of Control Plane keys.")
+		g.Example(8120)
+		g.Meta("struct:tag:json", "keys")
+	})
+	g.Attribute("bytes", g.Int64, func() {
+		g.Description("The total size of the Control Plane's keys and values.")
+		g.Example(52428800)
+		g.Meta("struct:tag:json", "bytes")
+	})
+	g.Attribute("prefixes", g.ArrayOf(StoragePrefixUsage), func() {
+		g.Description("Usage for each key prefix, largest first.")
+		g.Meta("struct:tag:json", "prefixes")
+	})
+
+	g.Required("db_size_bytes", "db_size_in_use_bytes", "keys", "bytes", "prefixes")
+})
+
+var EtcdMember = g.Type("EtcdMember", func() {
+	g.Attribute("id", g.String, func() {
+		g.Description("The hexadecimal Etcd member ID.")
+		g.Example("8e9e05c52164694d")
+		g.Meta("struct:tag:json", "id")
+	})
+	g.Attribute("host_id", Identifier, func() {
+		g.Description("The ID of the host that runs this member.")
+		g.Example("host-1")
+		g.Meta("struct:tag:json", "host_id")
+	})
+	g.Attribute("role", g.String, func() {
+		g.Description("Voters count toward quorum. Learners receive updates, but don't vote until they're promoted.")
+		g.Enum("voter", "learner")
+		g.Example("voter")
+		g.Meta("struct:tag:json", "role")
+	})
+	g.Attribute("is_leader", g.Boolean, func() {
+		g.Description("True if this member is the raft leader.")
+		g.Example(true)
+		g.Meta("struct:tag:json", "is_leader")
+	})
+	g.Attribute("reachable", g.Boolean, func() {
+		g.Description("True if this member responded to a status request.")
+		g.Example(true)
+		g.Meta("struct:tag:json", "reachable")
+	})
+	g.Attribute("peer_urls", g.ArrayOf(g.String), func() {
+		g.Description("The member's peer URLs.")
+		g.Example([]string{"https://10.1.0.1:2380"})
+		g.Meta("struct:tag:json", "peer_urls")
+	})
+	g.Attribute("client_urls", g.ArrayOf(g.String), func() {
+		g.Description("The member's client URLs.")
+		g.Example([]string{"https://10.1.0.1:2379"})
+		g.Meta("struct:tag:json", "client_urls")
+	})
+	g.Attribute("version", g.String, func() {
+		g.Description("The member's Etcd version.")
+		g.Example("3.6.5")
+		g.Meta("struct:tag:json", "version,omitempty")
+	})
+	g.Attribute("db_size_bytes", g.Int64, func() {
+		g.Description("The size of the member's database on disk, including space that Etcd can reuse.")
+		g.Example(73400320)
+		g.Meta("struct:tag:json", "db_size_bytes,omitempty")
+	})
+	g.Attribute("db_size_in_use_bytes", g.Int64, func() {
+		g.Description("The portion of the member's database that's in use.")
+		g.Example(61865984)
+		g.Meta("struct:tag:json", "db_size_in_use_bytes,omitempty")
+	})
+	g.Attribute("raft_term", g.UInt64, func() {
+		g.Description("The member's current raft term.")
+		g.Example(4)
+		g.Meta("struct:tag:json", "raft_term,omitempty")
+	})
+	g.Attribute("raft_index", g.UInt64, func() {
+		g.Description("The member's committed raft index.")
+		g.Example(184523)
+		g.Meta("struct:tag:json", "raft_index,omitempty")
+	})
+	g.Attribute("raft_applied_index", g.UInt64, func() {
+		g.Description("The member's applied raft index.")
+		g.Example(184520)
+		g.Meta("struct:tag:json", "raft_applied_index,omitempty")
+	})
+	g.Attribute("raft_index_lag", g.UInt64, func() {
+		g.Description("The number of raft entries that this member has yet to apply relative to the leader.")
+		g.Example(3)
+		g.Meta("struct:tag:json", "raft_index_lag")
+	})
+	g.Attribute("errors", g.ArrayOf(g.String), func() {
+		g.Description("Errors reported by or for this member.")
+		g.Example([]string{"context deadline exceeded"})
+		g.Meta("struct:tag:json", "errors,omitempty")
+	})
+
+	g.Required("id", "host_id", "role", "is_leader", "reachable", "peer_urls", "client_urls", "raft_index_lag")
+})
+
+var EtcdCluster = g.Type("EtcdCluster", func() {
+	g.Attribute("members", g.ArrayOf(EtcdMember), func() {
+		g.Description("The members of the Etcd cluster, sorted by host ID.")
+		g.Meta("struct:tag:json", "members")
+	})
+	g.Attribute("leader", g.String, func() {
+		g.Description("The host ID of the raft leader. Empty if the cluster has no leader.")
+		g.Example("host-1")
+		g.Meta("struct:tag:json", "leader,omitempty")
+	})
+	g.Attribute("voters", g.Int, func() {
+		g.Description("The number of voting members.")
+		g.Example(3)
+		g.Meta("struct:tag:json", "voters")
+	})
+	g.Attribute("learners", g.Int, func() {
+		g.Description("The number of learners.")
+		g.Example(0)
+		g.Meta("struct:tag:json", "learners")
+	})
+	g.Attribute("quorum", g.Int, func() {
+		g.Description("The number of voters that must be available for the cluster to accept writes.")
+		g.Example(2)
+		g.Meta("struct:tag:json", "quorum")
+	})
+	g.Attribute("failure_tolerance", g.Int, func() {
+		g.Description("The number of voters that can fail before the cluster loses quorum.")
+		g.Example(1)
+		g.Meta("struct:tag:json", "failure_tolerance")
+	})
+	g.Attribute("alarms", g.ArrayOf(g.String), func() {
+		g.Description("Active Etcd alarms, such as NOSPACE.")
+		g.Example([]string{"NOSPACE on member 8e9e05c52164694d"})
+		g.Meta("struct:tag:json", "alarms,omitempty")
+	})
+	g.Attribute("warnings", g.ArrayOf(g.String), func() {
+		g.Description("Problems with the cluster's quorum or members.")
+		g.Example([]string{"the cluster has an even number of voters, which tolerates no more failures than 3 voters"})
+		g.Meta("struct:tag:json", "warnings,omitempty")
+	})
+
+	g.Required("members", "voters", "learners", "quorum", "failure_tolerance")
+})
+
+var DefragmentEtcdRequest = g.Type("DefragmentEtcdRequest", func() {
+	g.Attribute("host_ids", g.ArrayOf(Identifier), func() {
+		g.Description("The hosts to defragment. Defaults to every Etcd server in the cluster.")
+		g.Example([]string{"host-1", "host-2"})
+		g.Meta("struct:tag:json", "host_ids,omitempty")
+	})
+})
+
+var EtcdDefragmentResult = g.Type("EtcdDefragmentResult", func() {
+	g.Attribute("host_id", Identifier, func() {
+		g.Description("The ID of the host that was defragmented.")
+		g.Example("host-1")
+		g.Meta("struct:tag:json", "host_id")
+	})
+	g.Attribute("db_size_before_bytes", g.Int64, func() {
+		g.Description("The size of the member's database on disk before defragmentation.")
+		g.Example(73400320)
+		g.Meta("struct:tag:json", "db_size_before_bytes")
+	})
+	g.Attribute("db_size_after_bytes", g.Int64, func() {
+		g.Description("The size of the member's database on disk after defragmentation.")
+		g.Example(61865984)
+		g.Meta("struct:tag:json", "db_size_after_bytes,omitempty")
+	})
+	g.Attribute("error", g.String, func() {
+		g.Description("The reason that the member couldn't be defragmented.")
+		g.Example("member is unreachable")
+		g.Meta("struct:tag:json", "error,omitempty")
+	})
+
+	g.Required("host_id", "db_size_before_bytes")
+})
+
+var DefragmentEtcdResponse = g.Type("DefragmentEtcdResponse", func() {
+	g.Attribute("results", g.ArrayOf(EtcdDefragmentResult), func() {
+		g.Description("The result for each host, in the order that they were defragmented.")
+		g.Meta("struct:tag:json", "results")
+	})
+
+	g.Required("results")
+})
+
 var InitClusterRequest = g.Type("InitClusterRequest", func() {
 	g.Description("Request to initialize a cluster")
 	g.Attribute("cluster_id", Identifier, func() {
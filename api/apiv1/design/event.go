@@ -0,0 +1,60 @@
+package design
+
+import (
+	g "goa.design/goa/v3/dsl"
+)
+
+var Event = g.Type("Event", func() {
+	g.Description("A change notification from the event stream.")
+	g.Attribute("id", g.String, func() {
+		g.Description("The etcd revision of the change that produced this event. Events from the same change share an ID.")
+		g.Example("1042")
+		g.Meta("struct:tag:json", "id")
+	})
+	g.Attribute("kind", g.String, func() {
+		g.Enum("heartbeat", "database", "instance", "task", "task_log")
+		g.Description("The kind of event. Determines which of the database, instance, task, or task_log_entry fields is set. Heartbeat events are sent when the stream opens and periodically afterward.")
+		g.Example("database")
+		g.Meta("struct:tag:json", "kind")
+	})
+	g.Attribute("timestamp", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("The time that the server observed the change.")
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "timestamp")
+	})
+	g.Attribute("database_id", Identifier, func() {
+		g.Description("The database that this event relates to, if any.")
+		g.Example("inventory")
+		g.Meta("struct:tag:json", "database_id,omitempty")
+	})
+	g.Attribute("host_id", Identifier, func() {
+		g.Description("The host that this event relates to, if known.")
+		g.Example("host-1")
+		g.Meta("struct:tag:json", "host_id,omitempty")
+	})
+	g.Attribute("task_id", g.String, func() {
+		g.Format(g.FormatUUID)
+		g.Description("The task that this event relates to. Only set for task and task_log events.")
+		g.Example("3c875a27-f6a6-4c1c-ba5f-6972fb1fc348")
+		g.Meta("struct:tag:json", "task_id,omitempty")
+	})
+	g.Attribute("database", DatabaseSummary, func() {
+		g.Description("The database after a state transition. Only set for database events. Instances are omitted.")
+		g.Meta("struct:tag:json", "database,omitempty")
+	})
+	g.Attribute("instance", Instance, func() {
+		g.Description("The instance after a state or status change. Only set for instance events.")
+		g.Meta("struct:tag:json", "instance,omitempty")
+	})
+	g.Attribute("task", Task, func() {
+		g.Description("The task after a status change. Only set for task events.")
+		g.Meta("struct:tag:json", "task,omitempty")
+	})
+	g.Attribute("task_log_entry", TaskLogEntry, func() {
+		g.Description("A new task log entry. Only set for task_log events.")
+		g.Meta("struct:tag:json", "task_log_entry,omitempty")
+	})
+
+	g.Required("id", "kind", "timestamp")
+})
@@ -10,6 +10,11 @@ var ListHostsResponse = g.Type("ListHostsResponse", func() {
 		g.Description("List of hosts in the cluster")
 		g.Meta("struct:tag:json", "hosts")
 	})
+	g.Attribute("next_cursor", g.String, func() {
+		g.Description("Cursor for the next page of results. Only set when there are more results.")
+		g.Example("host-3")
+		g.Meta("struct:tag:json", "next_cursor,omitempty")
+	})
 	g.Required("hosts")
 })
 
@@ -51,6 +56,10 @@ var HostStatus = g.Type("HostStatus", func() {
 		g.Description("The status of each component of the host.")
 		g.Meta("struct:tag:json", "components")
 	})
+	g.Attribute("version", VersionInfo, func() {
+		g.Description("The Control Plane version that's running on the host.")
+		g.Meta("struct:tag:json", "version,omitempty")
+	})
 
 	g.Required("state", "updated_at", "components")
 })
@@ -315,3 +324,28 @@ var RemoveHostResponse = g.Type("RemoveHostResponse", func() {
 
 	g.Required("task", "update_database_tasks")
 })
+
+var ChangeHostEtcdModeRequest = g.Type("ChangeHostEtcdModeRequest", func() {
+	g.Attribute("etcd_mode", g.String, func() {
+		g.Description("The new Etcd mode for the host.")
+		g.Enum("server", "client")
+		g.Example("server")
+		g.Meta("struct:tag:json", "etcd_mode")
+	})
+
+	g.Required("etcd_mode")
+})
+
+var ChangeHostEtcdModeResponse = g.Type("ChangeHostEtcdModeResponse", func() {
+	g.Attribute("task", Task, func() {
+		g.Description("The task that tracks the change.")
+		g.Meta("struct:tag:json", "task")
+	})
+	g.Attribute("warnings", g.ArrayOf(g.String), func() {
+		g.Description("Warnings about the Etcd cluster's failure tolerance after the change.")
+		g.Example([]string{"the cluster has 2 voter(s) and can't tolerate the failure of any of them. Run Etcd on at least 3 hosts to tolerate a failure"})
+		g.Meta("struct:tag:json", "warnings,omitempty")
+	})
+
+	g.Required("task")
+})
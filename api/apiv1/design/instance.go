@@ -31,6 +31,304 @@ var StartInstanceResponse = g.Type("StartInstanceResponse", func() {
 	g.Required("task")
 })
 
+var PostgreSQLSetting = g.Type("PostgreSQLSetting", func() {
+	g.Description("A setting from an instance's effective postgresql.conf.")
+	g.Attribute("name", g.String, func() {
+		g.Description("The name of the setting.")
+		g.Example("shared_buffers")
+		g.Meta("struct:tag:json", "name")
+	})
+	g.Attribute("value", g.String, func() {
+		g.Description("The value of the setting.")
+		g.Example("262144")
+		g.Meta("struct:tag:json", "value")
+	})
+	g.Attribute("source", g.String, func() {
+		g.Description("Where the value comes from. Tunable settings are derived from the instance's memory, CPUs, and cluster size.")
+		g.Enum("default", "tunable", "spec", "node")
+		g.Example("tunable")
+		g.Meta("struct:tag:json", "source")
+	})
+	g.Attribute("pending_restart", g.Boolean, func() {
+		g.Description("True if the instance must be restarted for this setting to take effect.")
+		g.Meta("struct:tag:json", "pending_restart,omitempty")
+	})
+
+	g.Required("name", "value", "source")
+})
+
+var GetInstancePostgreSQLConfResponse = g.Type("GetInstancePostgreSQLConfResponse", func() {
+	g.Description("Response containing an instance's effective postgresql.conf.")
+	g.Attribute("settings", g.ArrayOf(PostgreSQLSetting), func() {
+		g.Description("The instance's effective settings, sorted by name.")
+		g.Meta("struct:tag:json", "settings")
+	})
+
+	g.Required("settings")
+})
+
+var PostgreSQLConfChange = g.Type("PostgreSQLConfChange", func() {
+	g.Description("A change to an instance's effective postgresql.conf.")
+	g.Attribute("instance_id", g.String, func() {
+		g.Description("The ID of the instance whose setting will change.")
+		g.Example("storefront-n1-689qacsi")
+		g.Meta("struct:tag:json", "instance_id")
+	})
+	g.Attribute("name", g.String, func() {
+		g.Description("The name of the setting.")
+		g.Example("shared_buffers")
+		g.Meta("struct:tag:json", "name")
+	})
+	g.Attribute("old_value", g.String, func() {
+		g.Description("The current value of the setting, if it's currently set.")
+		g.Example("131072")
+		g.Meta("struct:tag:json", "old_value,omitempty")
+	})
+	g.Attribute("new_value", g.String, func() {
+		g.Description("The new value of the setting, if it will still be set.")
+		g.Example("262144")
+		g.Meta("struct:tag:json", "new_value,omitempty")
+	})
+	g.Attribute("requires_restart", g.Boolean, func() {
+		g.Description("True if the instance must be restarted for this change to take effect.")
+		g.Meta("struct:tag:json", "requires_restart")
+	})
+
+	g.Required("instance_id", "name", "requires_restart")
+})
+
+var SessionLock = g.Type("SessionLock", func() {
+	g.Description("A lock that a session holds or is waiting for.")
+	g.Attribute("lock_type", g.String, func() {
+		g.Description("The type of the locked object.")
+		g.Example("relation")
+		g.Meta("struct:tag:json", "lock_type")
+	})
+	g.Attribute("relation", g.String, func() {
+		g.Description("The name of the locked relation. Only set for relations in the instance's database.")
+		g.Example("public.orders")
+		g.Meta("struct:tag:json", "relation,omitempty")
+	})
+	g.Attribute("mode", g.String, func() {
+		g.Description("The lock mode.")
+		g.Example("RowExclusiveLock")
+		g.Meta("struct:tag:json", "mode")
+	})
+	g.Attribute("granted", g.Boolean, func() {
+		g.Description("True if the lock is held, false if the session is waiting for it.")
+		g.Meta("struct:tag:json", "granted")
+	})
+
+	g.Required("lock_type", "mode", "granted")
+})
+
+var InstanceSession = g.Type("InstanceSession", func() {
+	g.Description("A client session on an instance, from pg_stat_activity.")
+	g.Attribute("pid", g.Int, func() {
+		g.Description("The process ID of the session.")
+		g.Example(4182)
+		g.Meta("struct:tag:json", "pid")
+	})
+	g.Attribute("username", g.String, func() {
+		g.Description("The user that the session is logged in as.")
+		g.Example("app")
+		g.Meta("struct:tag:json", "username,omitempty")
+	})
+	g.Attribute("database_name", g.String, func() {
+		g.Description("The database that the session is connected to.")
+		g.Example("storefront")
+		g.Meta("struct:tag:json", "database_name,omitempty")
+	})
+	g.Attribute("application_name", g.String, func() {
+		g.Description("The application name that the client provided.")
+		g.Example("psql")
+		g.Meta("struct:tag:json", "application_name")
+	})
+	g.Attribute("client_addr", g.String, func() {
+		g.Description("The address of the client.")
+		g.Example("10.24.34.8")
+		g.Meta("struct:tag:json", "client_addr,omitempty")
+	})
+	g.Attribute("state", g.String, func() {
+		g.Description("The state of the session, such as active or idle in transaction.")
+		g.Example("active")
+		g.Meta("struct:tag:json", "state,omitempty")
+	})
+	g.Attribute("wait_event_type", g.String, func() {
+		g.Description("The type of event that the session is waiting for.")
+		g.Example("Lock")
+		g.Meta("struct:tag:json", "wait_event_type,omitempty")
+	})
+	g.Attribute("wait_event", g.String, func() {
+		g.Description("The event that the session is waiting for.")
+		g.Example("transactionid")
+		g.Meta("struct:tag:json", "wait_event,omitempty")
+	})
+	g.Attribute("backend_start", g.String, func() {
+		g.Description("The time that the session connected.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "backend_start,omitempty")
+	})
+	g.Attribute("xact_start", g.String, func() {
+		g.Description("The time that the session's current transaction started.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "xact_start,omitempty")
+	})
+	g.Attribute("query_start", g.String, func() {
+		g.Description("The time that the session's current or most recent query started.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "query_start,omitempty")
+	})
+	g.Attribute("state_change", g.String, func() {
+		g.Description("The time that the session's state last changed.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "state_change,omitempty")
+	})
+	g.Attribute("query", g.String, func() {
+		g.Description("The session's current or most recent query, truncated to 1024 characters.")
+		g.Example("UPDATE orders SET status = 'shipped' WHERE id = 42")
+		g.Meta("struct:tag:json", "query")
+	})
+	g.Attribute("blocked_by", g.ArrayOf(g.Int), func() {
+		g.Description("The process IDs of the sessions that are blocking this session.")
+		g.Example([]int{4177})
+		g.Meta("struct:tag:json", "blocked_by,omitempty")
+	})
+	g.Attribute("locks", g.ArrayOf(SessionLock), func() {
+		g.Description("The locks that this session holds or is waiting for.")
+		g.Meta("struct:tag:json", "locks,omitempty")
+	})
+
+	g.Required("pid", "application_name", "query")
+})
+
+var GetInstanceSessionsResponse = g.Type("GetInstanceSessionsResponse", func() {
+	g.Description("Response containing the client sessions on an instance.")
+	g.Attribute("sessions", g.ArrayOf(InstanceSession), func() {
+		g.Description("The client sessions, sorted by process ID.")
+		g.Meta("struct:tag:json", "sessions")
+	})
+	g.Attribute("blocking_chains", g.ArrayOf(g.ArrayOf(g.Int)), func() {
+		g.Description("Each chain of blocked sessions, as process IDs ordered from the session at the root of the chain to a blocked session that isn't blocking any others.")
+		g.Example([][]int{{4177, 4182, 4190}})
+		g.Meta("struct:tag:json", "blocking_chains")
+	})
+
+	g.Required("sessions", "blocking_chains")
+})
+
+var SignalInstanceSessionResponse = g.Type("SignalInstanceSessionResponse", func() {
+	g.Description("Response containing the task that cancels or terminates a session")
+	g.Attribute("task", Task, func() {
+		g.Description("Task representing the operation. The session's details are recorded in the task's log.")
+		g.Meta("struct:tag:json", "task")
+	})
+	g.Required("task")
+})
+
+var QueryStat = g.Type("QueryStat", func() {
+	g.Description("Statistics for a single statement from pg_stat_statements.")
+	g.Attribute("query_id", g.Int64, func() {
+		g.Description("The statement's query ID.")
+		g.Example(-3749826364427519473)
+		g.Meta("struct:tag:json", "query_id")
+	})
+	g.Attribute("query", g.String, func() {
+		g.Description("The normalized text of the statement, truncated to 1024 characters.")
+		g.Example("SELECT * FROM orders WHERE customer_id = $1")
+		g.Meta("struct:tag:json", "query")
+	})
+	g.Attribute("calls", g.Int64, func() {
+		g.Description("The number of times the statement has been executed.")
+		g.Example(1250)
+		g.Meta("struct:tag:json", "calls")
+	})
+	g.Attribute("total_time_ms", g.Float64, func() {
+		g.Description("The total time spent executing the statement, in milliseconds.")
+		g.Example(5310.25)
+		g.Meta("struct:tag:json", "total_time_ms")
+	})
+	g.Attribute("mean_time_ms", g.Float64, func() {
+		g.Description("The mean time spent executing the statement, in milliseconds.")
+		g.Example(4.25)
+		g.Meta("struct:tag:json", "mean_time_ms")
+	})
+	g.Attribute("rows", g.Int64, func() {
+		g.Description("The total number of rows retrieved or affected by the statement.")
+		g.Example(1250)
+		g.Meta("struct:tag:json", "rows")
+	})
+	g.Attribute("window_calls", g.Int64, func() {
+		g.Description("The number of calls since the start of the window. Absent if the statement was not collected at the start of the window.")
+		g.Example(310)
+		g.Meta("struct:tag:json", "window_calls,omitempty")
+	})
+	g.Attribute("window_total_time_ms", g.Float64, func() {
+		g.Description("The execution time since the start of the window, in milliseconds. Absent if the statement was not collected at the start of the window.")
+		g.Example(1290.5)
+		g.Meta("struct:tag:json", "window_total_time_ms,omitempty")
+	})
+	g.Attribute("window_rows", g.Int64, func() {
+		g.Description("The number of rows since the start of the window. Absent if the statement was not collected at the start of the window.")
+		g.Example(310)
+		g.Meta("struct:tag:json", "window_rows,omitempty")
+	})
+
+	g.Required("query_id", "query", "calls", "total_time_ms", "mean_time_ms", "rows")
+})
+
+var InstanceQueryStats = g.Type("InstanceQueryStats", func() {
+	g.Description("The most recently collected query statistics for an instance.")
+	g.Attribute("instance_id", g.String, func() {
+		g.Description("The ID of the instance.")
+		g.Example("storefront-n1-689qacsi")
+		g.Meta("struct:tag:json", "instance_id")
+	})
+	g.Attribute("collected_at", g.String, func() {
+		g.Description("The time that the statistics were collected.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "collected_at,omitempty")
+	})
+	g.Attribute("window_start", g.String, func() {
+		g.Description("The time of the oldest retained collection, which is the start of the window for the window_* fields.")
+		g.Format(g.FormatDateTime)
+		g.Example("2025-06-18T15:52:05Z")
+		g.Meta("struct:tag:json", "window_start,omitempty")
+	})
+	g.Attribute("queries", g.ArrayOf(QueryStat), func() {
+		g.Description("The collected statements.")
+		g.Meta("struct:tag:json", "queries")
+	})
+
+	g.Required("instance_id", "queries")
+})
+
+var GetNodeQueryStatsResponse = g.Type("GetNodeQueryStatsResponse", func() {
+	g.Description("Response containing the query statistics for each instance in a node.")
+	g.Attribute("instances", g.ArrayOf(InstanceQueryStats), func() {
+		g.Description("The query statistics for each instance, sorted by instance ID.")
+		g.Meta("struct:tag:json", "instances")
+	})
+
+	g.Required("instances")
+})
+
+var ResetNodeQueryStatsResponse = g.Type("ResetNodeQueryStatsResponse", func() {
+	g.Description("Response from resetting a node's query statistics.")
+	g.Attribute("instance_ids", g.ArrayOf(g.String), func() {
+		g.Description("The IDs of the instances whose statistics were reset.")
+		g.Example([]string{"storefront-n1-689qacsi"})
+		g.Meta("struct:tag:json", "instance_ids")
+	})
+
+	g.Required("instance_ids")
+})
+
 var InstanceConnectionInfo = g.Type("InstanceConnectionInfo", func() {
 	g.Description("Connection information for a pgEdge instance.")
 	g.Attribute("addresses", g.ArrayOf(g.String), func() {
@@ -126,6 +424,69 @@ var InstanceSpockStatus = g.Type("InstanceSpockStatus", func() {
 	})
 })
 
+var InstanceResourceUsage = g.Type("InstanceResourceUsage", func() {
+	g.Description("Resource usage information for a pgEdge instance.")
+	g.Attribute("data_directory_bytes", g.Int64, func() {
+		g.Description("The total size of the instance's data directory in bytes.")
+		g.Example(2147483648)
+		g.Meta("struct:tag:json", "data_directory_bytes,omitempty")
+	})
+	g.Attribute("wal_directory_bytes", g.Int64, func() {
+		g.Description("The total size of the instance's WAL directory in bytes.")
+		g.Example(201326592)
+		g.Meta("struct:tag:json", "wal_directory_bytes,omitempty")
+	})
+	g.Attribute("volume_free_bytes", g.Int64, func() {
+		g.Description("The free space on the volume that contains the data directory in bytes.")
+		g.Example(53687091200)
+		g.Meta("struct:tag:json", "volume_free_bytes,omitempty")
+	})
+	g.Attribute("volume_total_bytes", g.Int64, func() {
+		g.Description("The total size of the volume that contains the data directory in bytes.")
+		g.Example(107374182400)
+		g.Meta("struct:tag:json", "volume_total_bytes,omitempty")
+	})
+	g.Attribute("database_size_bytes", g.Int64, func() {
+		g.Description("The size of the database in bytes, as reported by pg_database_size.")
+		g.Example(1073741824)
+		g.Meta("struct:tag:json", "database_size_bytes,omitempty")
+	})
+	g.Attribute("connections", g.Int, func() {
+		g.Description("The number of client connections to the instance.")
+		g.Example(12)
+		g.Meta("struct:tag:json", "connections,omitempty")
+	})
+	g.Attribute("max_connections", g.Int, func() {
+		g.Description("The instance's max_connections setting.")
+		g.Example(100)
+		g.Meta("struct:tag:json", "max_connections,omitempty")
+	})
+	g.Attribute("cpu_percent", g.Float64, func() {
+		g.Description("The CPU usage of the instance's container or unit as a percentage of a single CPU.")
+		g.Example(35.2)
+		g.Meta("struct:tag:json", "cpu_percent,omitempty")
+	})
+	g.Attribute("memory_bytes", g.Int64, func() {
+		g.Description("The memory usage of the instance's container or unit in bytes.")
+		g.Example(402653184)
+		g.Meta("struct:tag:json", "memory_bytes,omitempty")
+	})
+	g.Attribute("memory_limit_bytes", g.Int64, func() {
+		g.Description("The memory limit of the instance's container or unit in bytes.")
+		g.Example(2147483648)
+		g.Meta("struct:tag:json", "memory_limit_bytes,omitempty")
+	})
+	g.Attribute("disk_full", g.Boolean, func() {
+		g.Description("True if the volume usage exceeds the configured disk-full threshold.")
+		g.Meta("struct:tag:json", "disk_full,omitempty")
+	})
+	g.Attribute("collected_at", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("The time that the resource usage was collected.")
+		g.Meta("struct:tag:json", "collected_at,omitempty")
+	})
+})
+
 var Instance = g.Type("Instance", func() {
 	g.Description("An instance of pgEdge Postgres running on a host.")
 	g.Attribute("id", g.String, func() {
@@ -184,6 +545,10 @@ var Instance = g.Type("Instance", func() {
 		g.Description("Spock status information for the instance.")
 		g.Meta("struct:tag:json", "spock,omitempty")
 	})
+	g.Attribute("resources", InstanceResourceUsage, func() {
+		g.Description("Resource usage information for the instance.")
+		g.Meta("struct:tag:json", "resources,omitempty")
+	})
 	g.Attribute("error", g.String, func() {
 		g.Description("An error message if the instance is in an error state.")
 		g.Example("failed to get patroni status: connection refused")
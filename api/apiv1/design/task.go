@@ -71,6 +71,12 @@ var Task = g.Type("Task", func() {
 		g.Example("pending")
 		g.Meta("struct:tag:json", "status")
 	})
+	g.Attribute("status_reason", g.String, func() {
+		g.Enum("queued")
+		g.Description("Additional detail about the task's status. A pending task is 'queued' while it waits for other tasks to complete.")
+		g.Example("queued")
+		g.Meta("struct:tag:json", "status_reason,omitempty")
+	})
 	g.Attribute("error", g.String, func() {
 		g.Description("The error message if the task failed.")
 		g.Example("failed to connect to database")
@@ -0,0 +1,173 @@
+package design
+
+import (
+	g "goa.design/goa/v3/dsl"
+)
+
+var WebhookEventType = g.Type("WebhookEventType", g.String, func() {
+	g.Description("A type of event that can be delivered to a webhook.")
+	g.Enum(
+		"task_completed",
+		"task_failed",
+		"database_state_changed",
+		"instance_role_changed",
+		"host_unreachable",
+	)
+	g.Example("task_failed")
+})
+
+var CreateWebhookRequest = g.Type("CreateWebhookRequest", func() {
+	g.Attribute("url", g.String, func() {
+		g.Format(g.FormatURI)
+		g.Description("The URL to send events to. Must use the http or https scheme.")
+		g.Example("https://alerts.example.com/hooks/control-plane")
+		g.Meta("struct:tag:json", "url")
+	})
+	g.Attribute("description", g.String, func() {
+		g.Description("An optional description of this webhook.")
+		g.MaxLength(256)
+		g.Example("Alerting for failed backups")
+		g.Meta("struct:tag:json", "description,omitempty")
+	})
+	g.Attribute("secret", g.String, func() {
+		g.Description("The secret used to sign each payload. A random secret is generated if this is omitted. It's only returned in the create-webhook response.")
+		g.MinLength(16)
+		g.MaxLength(256)
+		g.Example("3f1b6c2e9a8d4f0b7e5c1a2d3b4c5d6e")
+		g.Meta("struct:tag:json", "secret,omitempty")
+	})
+	g.Attribute("event_types", g.ArrayOf(WebhookEventType), func() {
+		g.Description("Only deliver events of these types. All event types are delivered by default.")
+		g.Example([]string{"task_failed", "database_state_changed"})
+		g.Meta("struct:tag:json", "event_types,omitempty")
+	})
+	g.Attribute("database_ids", g.ArrayOf(Identifier), func() {
+		g.Description("Only deliver events for these databases. Events that aren't specific to a database, such as host_unreachable, are not delivered when this is set.")
+		g.Example([]string{"inventory"})
+		g.Meta("struct:tag:json", "database_ids,omitempty")
+	})
+
+	g.Required("url")
+})
+
+var Webhook = g.Type("Webhook", func() {
+	g.Description("A webhook endpoint that receives lifecycle events.")
+	g.Attribute("id", g.String, func() {
+		g.Format(g.FormatUUID)
+		g.Description("Unique identifier for this webhook.")
+		g.Example("0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1")
+		g.Meta("struct:tag:json", "id")
+	})
+	g.Attribute("url", g.String, func() {
+		g.Description("The URL that events are sent to.")
+		g.Example("https://alerts.example.com/hooks/control-plane")
+		g.Meta("struct:tag:json", "url")
+	})
+	g.Attribute("description", g.String, func() {
+		g.Description("The description of this webhook.")
+		g.Example("Alerting for failed backups")
+		g.Meta("struct:tag:json", "description,omitempty")
+	})
+	g.Attribute("secret", g.String, func() {
+		g.Description("The secret used to sign each payload. Only set in the create-webhook response.")
+		g.Example("3f1b6c2e9a8d4f0b7e5c1a2d3b4c5d6e")
+		g.Meta("struct:tag:json", "secret,omitempty")
+	})
+	g.Attribute("event_types", g.ArrayOf(WebhookEventType), func() {
+		g.Description("The event types that are delivered to this webhook. All event types are delivered when this is empty.")
+		g.Example([]string{"task_failed", "database_state_changed"})
+		g.Meta("struct:tag:json", "event_types,omitempty")
+	})
+	g.Attribute("database_ids", g.ArrayOf(Identifier), func() {
+		g.Description("The databases whose events are delivered to this webhook. Events for all databases are delivered when this is empty.")
+		g.Example([]string{"inventory"})
+		g.Meta("struct:tag:json", "database_ids,omitempty")
+	})
+	g.Attribute("created_at", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("The time that this webhook was created.")
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "created_at")
+	})
+
+	g.Required("id", "url", "created_at")
+})
+
+var ListWebhooksResponse = g.Type("ListWebhooksResponse", func() {
+	g.Attribute("webhooks", g.ArrayOf(Webhook), func() {
+		g.Description("The webhooks in the cluster, oldest first.")
+		g.Meta("struct:tag:json", "webhooks")
+	})
+
+	g.Required("webhooks")
+})
+
+var WebhookDelivery = g.Type("WebhookDelivery", func() {
+	g.Description("A single event sent to a webhook.")
+	g.Attribute("delivery_id", g.String, func() {
+		g.Format(g.FormatUUID)
+		g.Description("Unique identifier for this delivery. Sent in the X-Control-Plane-Delivery header and the payload's delivery_id field.")
+		g.Example("01980356-1e1e-7bc5-8f6a-7e6d1f0d2c3b")
+		g.Meta("struct:tag:json", "delivery_id")
+	})
+	g.Attribute("webhook_id", g.String, func() {
+		g.Format(g.FormatUUID)
+		g.Description("The webhook that this delivery was sent to.")
+		g.Example("0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1")
+		g.Meta("struct:tag:json", "webhook_id")
+	})
+	g.Attribute("event_type", WebhookEventType, func() {
+		g.Description("The type of event that was delivered.")
+		g.Meta("struct:tag:json", "event_type")
+	})
+	g.Attribute("status", g.String, func() {
+		g.Enum("pending", "succeeded", "failed")
+		g.Description("The status of this delivery. Pending deliveries are waiting for their first attempt or for a retry. Failed deliveries have used all of their attempts.")
+		g.Example("succeeded")
+		g.Meta("struct:tag:json", "status")
+	})
+	g.Attribute("attempts", g.Int, func() {
+		g.Description("The number of attempts made so far.")
+		g.Example(1)
+		g.Meta("struct:tag:json", "attempts")
+	})
+	g.Attribute("last_status_code", g.Int, func() {
+		g.Description("The HTTP status code from the most recent attempt. Omitted if no response was received.")
+		g.Example(200)
+		g.Meta("struct:tag:json", "last_status_code,omitempty")
+	})
+	g.Attribute("last_error", g.String, func() {
+		g.Description("The error from the most recent attempt, if it failed.")
+		g.Example("unexpected response status 503")
+		g.Meta("struct:tag:json", "last_error,omitempty")
+	})
+	g.Attribute("next_attempt_at", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("When the next attempt will be made. Only set for pending deliveries.")
+		g.Example("2025-06-18T16:52:15Z")
+		g.Meta("struct:tag:json", "next_attempt_at,omitempty")
+	})
+	g.Attribute("created_at", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("The time that the event occurred.")
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "created_at")
+	})
+	g.Attribute("updated_at", g.String, func() {
+		g.Format(g.FormatDateTime)
+		g.Description("The time of the most recent attempt.")
+		g.Example("2025-06-18T16:52:05Z")
+		g.Meta("struct:tag:json", "updated_at")
+	})
+
+	g.Required("delivery_id", "webhook_id", "event_type", "status", "attempts", "created_at", "updated_at")
+})
+
+var ListWebhookDeliveriesResponse = g.Type("ListWebhookDeliveriesResponse", func() {
+	g.Attribute("deliveries", g.ArrayOf(WebhookDelivery), func() {
+		g.Description("The webhook's most recent deliveries, newest first.")
+		g.Meta("struct:tag:json", "deliveries")
+	})
+
+	g.Required("deliveries")
+})
@@ -15,71 +15,113 @@ import (
 
 // Client is the "control-plane" service client.
 type Client struct {
-	InitClusterEndpoint            goa.Endpoint
-	JoinClusterEndpoint            goa.Endpoint
-	GetJoinTokenEndpoint           goa.Endpoint
-	GetJoinOptionsEndpoint         goa.Endpoint
-	GetClusterEndpoint             goa.Endpoint
-	ListHostsEndpoint              goa.Endpoint
-	GetHostEndpoint                goa.Endpoint
-	RemoveHostEndpoint             goa.Endpoint
-	ListDatabasesEndpoint          goa.Endpoint
-	CreateDatabaseEndpoint         goa.Endpoint
-	GetDatabaseEndpoint            goa.Endpoint
-	UpdateDatabaseEndpoint         goa.Endpoint
-	ApplyUpgradeEndpoint           goa.Endpoint
-	DeleteDatabaseEndpoint         goa.Endpoint
-	BackupDatabaseNodeEndpoint     goa.Endpoint
-	SwitchoverDatabaseNodeEndpoint goa.Endpoint
-	FailoverDatabaseNodeEndpoint   goa.Endpoint
-	ListDatabaseTasksEndpoint      goa.Endpoint
-	GetDatabaseTaskEndpoint        goa.Endpoint
-	GetDatabaseTaskLogEndpoint     goa.Endpoint
-	ListHostTasksEndpoint          goa.Endpoint
-	GetHostTaskEndpoint            goa.Endpoint
-	GetHostTaskLogEndpoint         goa.Endpoint
-	ListTasksEndpoint              goa.Endpoint
-	RestoreDatabaseEndpoint        goa.Endpoint
-	GetVersionEndpoint             goa.Endpoint
-	RestartInstanceEndpoint        goa.Endpoint
-	StopInstanceEndpoint           goa.Endpoint
-	StartInstanceEndpoint          goa.Endpoint
-	CancelDatabaseTaskEndpoint     goa.Endpoint
+	InitClusterEndpoint               goa.Endpoint
+	JoinClusterEndpoint               goa.Endpoint
+	GetJoinTokenEndpoint              goa.Endpoint
+	GetJoinOptionsEndpoint            goa.Endpoint
+	GetClusterEndpoint                goa.Endpoint
+	GetStorageUsageEndpoint           goa.Endpoint
+	GetEtcdClusterEndpoint            goa.Endpoint
+	DefragmentEtcdEndpoint            goa.Endpoint
+	ListHostsEndpoint                 goa.Endpoint
+	GetHostEndpoint                   goa.Endpoint
+	RemoveHostEndpoint                goa.Endpoint
+	ChangeHostEtcdModeEndpoint        goa.Endpoint
+	ListDatabasesEndpoint             goa.Endpoint
+	CreateDatabaseEndpoint            goa.Endpoint
+	GetDatabaseEndpoint               goa.Endpoint
+	UpdateDatabaseEndpoint            goa.Endpoint
+	ApplyUpgradeEndpoint              goa.Endpoint
+	UpgradeDatabaseMajorEndpoint      goa.Endpoint
+	CutoverDatabaseImportEndpoint     goa.Endpoint
+	DeleteDatabaseEndpoint            goa.Endpoint
+	BackupDatabaseNodeEndpoint        goa.Endpoint
+	SwitchoverDatabaseNodeEndpoint    goa.Endpoint
+	RollingRestartDatabaseEndpoint    goa.Endpoint
+	FailoverDatabaseNodeEndpoint      goa.Endpoint
+	GetNodeQueryStatsEndpoint         goa.Endpoint
+	ResetNodeQueryStatsEndpoint       goa.Endpoint
+	ListDatabaseTasksEndpoint         goa.Endpoint
+	GetDatabaseTaskEndpoint           goa.Endpoint
+	GetDatabaseTaskLogEndpoint        goa.Endpoint
+	ListHostTasksEndpoint             goa.Endpoint
+	GetHostTaskEndpoint               goa.Endpoint
+	GetHostTaskLogEndpoint            goa.Endpoint
+	ListTasksEndpoint                 goa.Endpoint
+	StreamEventsEndpoint              goa.Endpoint
+	CreateWebhookEndpoint             goa.Endpoint
+	ListWebhooksEndpoint              goa.Endpoint
+	GetWebhookEndpoint                goa.Endpoint
+	DeleteWebhookEndpoint             goa.Endpoint
+	ListWebhookDeliveriesEndpoint     goa.Endpoint
+	RestoreDatabaseEndpoint           goa.Endpoint
+	CloneDatabaseEndpoint             goa.Endpoint
+	GetVersionEndpoint                goa.Endpoint
+	RestartInstanceEndpoint           goa.Endpoint
+	GetInstanceSessionsEndpoint       goa.Endpoint
+	CancelInstanceSessionEndpoint     goa.Endpoint
+	TerminateInstanceSessionEndpoint  goa.Endpoint
+	GetInstancePostgresqlConfEndpoint goa.Endpoint
+	StopInstanceEndpoint              goa.Endpoint
+	StartInstanceEndpoint             goa.Endpoint
+	CancelDatabaseTaskEndpoint        goa.Endpoint
+	ResumeDatabaseTaskEndpoint        goa.Endpoint
 }
 
 // NewClient initializes a "control-plane" service client given the endpoints.
-func NewClient(initCluster, joinCluster, getJoinToken, getJoinOptions, getCluster, listHosts, getHost, removeHost, listDatabases, createDatabase, getDatabase, updateDatabase, applyUpgrade, deleteDatabase, backupDatabaseNode, switchoverDatabaseNode, failoverDatabaseNode, listDatabaseTasks, getDatabaseTask, getDatabaseTaskLog, listHostTasks, getHostTask, getHostTaskLog, listTasks, restoreDatabase, getVersion, restartInstance, stopInstance, startInstance, cancelDatabaseTask goa.Endpoint) *Client {
+func NewClient(initCluster, joinCluster, getJoinToken, getJoinOptions, getCluster, getStorageUsage, getEtcdCluster, defragmentEtcd, listHosts, getHost, removeHost, changeHostEtcdMode, listDatabases, createDatabase, getDatabase, updateDatabase, applyUpgrade, upgradeDatabaseMajor, cutoverDatabaseImport, deleteDatabase, backupDatabaseNode, switchoverDatabaseNode, rollingRestartDatabase, failoverDatabaseNode, getNodeQueryStats, resetNodeQueryStats, listDatabaseTasks, getDatabaseTask, getDatabaseTaskLog, listHostTasks, getHostTask, getHostTaskLog, listTasks, streamEvents, createWebhook, listWebhooks, getWebhook, deleteWebhook, listWebhookDeliveries, restoreDatabase, cloneDatabase, getVersion, restartInstance, getInstanceSessions, cancelInstanceSession, terminateInstanceSession, getInstancePostgresqlConf, stopInstance, startInstance, cancelDatabaseTask, resumeDatabaseTask goa.Endpoint) *Client {
 	return &Client{
-		InitClusterEndpoint:            initCluster,
-		JoinClusterEndpoint:            joinCluster,
-		GetJoinTokenEndpoint:           getJoinToken,
-		GetJoinOptionsEndpoint:         getJoinOptions,
-		GetClusterEndpoint:             getCluster,
-		ListHostsEndpoint:              listHosts,
-		GetHostEndpoint:                getHost,
-		RemoveHostEndpoint:             removeHost,
-		ListDatabasesEndpoint:          listDatabases,
-		CreateDatabaseEndpoint:         createDatabase,
-		GetDatabaseEndpoint:            getDatabase,
-		UpdateDatabaseEndpoint:         updateDatabase,
-		ApplyUpgradeEndpoint:           applyUpgrade,
-		DeleteDatabaseEndpoint:         deleteDatabase,
-		BackupDatabaseNodeEndpoint:     backupDatabaseNode,
-		SwitchoverDatabaseNodeEndpoint: switchoverDatabaseNode,
-		FailoverDatabaseNodeEndpoint:   failoverDatabaseNode,
-		ListDatabaseTasksEndpoint:      listDatabaseTasks,
-		GetDatabaseTaskEndpoint:        getDatabaseTask,
-		GetDatabaseTaskLogEndpoint:     getDatabaseTaskLog,
-		ListHostTasksEndpoint:          listHostTasks,
-		GetHostTaskEndpoint:            getHostTask,
-		GetHostTaskLogEndpoint:         getHostTaskLog,
-		ListTasksEndpoint:              listTasks,
-		RestoreDatabaseEndpoint:        restoreDatabase,
-		GetVersionEndpoint:             getVersion,
-		RestartInstanceEndpoint:        restartInstance,
-		StopInstanceEndpoint:           stopInstance,
-		StartInstanceEndpoint:          startInstance,
-		CancelDatabaseTaskEndpoint:     cancelDatabaseTask,
+		InitClusterEndpoint:               initCluster,
+		JoinClusterEndpoint:               joinCluster,
+		GetJoinTokenEndpoint:              getJoinToken,
+		GetJoinOptionsEndpoint:            getJoinOptions,
+		GetClusterEndpoint:                getCluster,
+		GetStorageUsageEndpoint:           getStorageUsage,
+		GetEtcdClusterEndpoint:            getEtcdCluster,
+		DefragmentEtcdEndpoint:            defragmentEtcd,
+		ListHostsEndpoint:                 listHosts,
+		GetHostEndpoint:                   getHost,
+		RemoveHostEndpoint:                removeHost,
+		ChangeHostEtcdModeEndpoint:        changeHostEtcdMode,
+		ListDatabasesEndpoint:             listDatabases,
+		CreateDatabaseEndpoint:            createDatabase,
+		GetDatabaseEndpoint:               getDatabase,
+		UpdateDatabaseEndpoint:            updateDatabase,
+		ApplyUpgradeEndpoint:              applyUpgrade,
+		UpgradeDatabaseMajorEndpoint:      upgradeDatabaseMajor,
+		CutoverDatabaseImportEndpoint:     cutoverDatabaseImport,
+		DeleteDatabaseEndpoint:            deleteDatabase,
+		BackupDatabaseNodeEndpoint:        backupDatabaseNode,
+		SwitchoverDatabaseNodeEndpoint:    switchoverDatabaseNode,
+		RollingRestartDatabaseEndpoint:    rollingRestartDatabase,
+		FailoverDatabaseNodeEndpoint:      failoverDatabaseNode,
+		GetNodeQueryStatsEndpoint:         getNodeQueryStats,
+		ResetNodeQueryStatsEndpoint:       resetNodeQueryStats,
+		ListDatabaseTasksEndpoint:         listDatabaseTasks,
+		GetDatabaseTaskEndpoint:           getDatabaseTask,
+		GetDatabaseTaskLogEndpoint:        getDatabaseTaskLog,
+		ListHostTasksEndpoint:             listHostTasks,
+		GetHostTaskEndpoint:               getHostTask,
+		GetHostTaskLogEndpoint:            getHostTaskLog,
+		ListTasksEndpoint:                 listTasks,
+		StreamEventsEndpoint:              streamEvents,
+		CreateWebhookEndpoint:             createWebhook,
+		ListWebhooksEndpoint:              listWebhooks,
+		GetWebhookEndpoint:                getWebhook,
+		DeleteWebhookEndpoint:             deleteWebhook,
+		ListWebhookDeliveriesEndpoint:     listWebhookDeliveries,
+		RestoreDatabaseEndpoint:           restoreDatabase,
+		CloneDatabaseEndpoint:             cloneDatabase,
+		GetVersionEndpoint:                getVersion,
+		RestartInstanceEndpoint:           restartInstance,
+		GetInstanceSessionsEndpoint:       getInstanceSessions,
+		CancelInstanceSessionEndpoint:     cancelInstanceSession,
+		TerminateInstanceSessionEndpoint:  terminateInstanceSession,
+		GetInstancePostgresqlConfEndpoint: getInstancePostgresqlConf,
+		StopInstanceEndpoint:              stopInstance,
+		StartInstanceEndpoint:             startInstance,
+		CancelDatabaseTaskEndpoint:        cancelDatabaseTask,
+		ResumeDatabaseTaskEndpoint:        resumeDatabaseTask,
 	}
 }
 
@@ -156,14 +198,61 @@ func (c *Client) GetCluster(ctx context.Context) (res *Cluster, err error) {
 	return ires.(*Cluster), nil
 }
 
+// GetStorageUsage calls the "get-storage-usage" endpoint of the
+// "control-plane" service.
+// GetStorageUsage may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetStorageUsage(ctx context.Context, p *GetStorageUsagePayload) (res *StorageUsage, err error) {
+	var ires any
+	ires, err = c.GetStorageUsageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StorageUsage), nil
+}
+
+// GetEtcdCluster calls the "get-etcd-cluster" endpoint of the "control-plane"
+// service.
+// GetEtcdCluster may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetEtcdCluster(ctx context.Context) (res *EtcdCluster, err error) {
+	var ires any
+	ires, err = c.GetEtcdClusterEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*EtcdCluster), nil
+}
+
+// DefragmentEtcd calls the "defragment-etcd" endpoint of the "control-plane"
+// service.
+// DefragmentEtcd may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) DefragmentEtcd(ctx context.Context, p *DefragmentEtcdRequest) (res *DefragmentEtcdResponse, err error) {
+	var ires any
+	ires, err = c.DefragmentEtcdEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DefragmentEtcdResponse), nil
+}
+
 // ListHosts calls the "list-hosts" endpoint of the "control-plane" service.
 // ListHosts may return the following errors:
 //   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
 //   - "server_error" (type *goa.ServiceError)
 //   - error: internal error
-func (c *Client) ListHosts(ctx context.Context) (res *ListHostsResponse, err error) {
+func (c *Client) ListHosts(ctx context.Context, p *ListHostsPayload) (res *ListHostsResponse, err error) {
 	var ires any
-	ires, err = c.ListHostsEndpoint(ctx, nil)
+	ires, err = c.ListHostsEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
@@ -202,10 +291,28 @@ func (c *Client) RemoveHost(ctx context.Context, p *RemoveHostPayload) (res *Rem
 	return ires.(*RemoveHostResponse), nil
 }
 
+// ChangeHostEtcdMode calls the "change-host-etcd-mode" endpoint of the
+// "control-plane" service.
+// ChangeHostEtcdMode may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ChangeHostEtcdMode(ctx context.Context, p *ChangeHostEtcdModePayload) (res *ChangeHostEtcdModeResponse, err error) {
+	var ires any
+	ires, err = c.ChangeHostEtcdModeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ChangeHostEtcdModeResponse), nil
+}
+
 // ListDatabases calls the "list-databases" endpoint of the "control-plane"
 // service.
 // ListDatabases may return the following errors:
 //   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
 //   - "server_error" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) ListDatabases(ctx context.Context, p *ListDatabasesPayload) (res *ListDatabasesResponse, err error) {
@@ -289,6 +396,44 @@ func (c *Client) ApplyUpgrade(ctx context.Context, p *ApplyUpgradePayload) (res
 	return ires.(*ApplyUpgradeResponse), nil
 }
 
+// UpgradeDatabaseMajor calls the "upgrade-database-major" endpoint of the
+// "control-plane" service.
+// UpgradeDatabaseMajor may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "database_not_modifiable" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "operation_already_in_progress" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) UpgradeDatabaseMajor(ctx context.Context, p *UpgradeDatabaseMajorPayload) (res *UpgradeDatabaseMajorResponse, err error) {
+	var ires any
+	ires, err = c.UpgradeDatabaseMajorEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UpgradeDatabaseMajorResponse), nil
+}
+
+// CutoverDatabaseImport calls the "cutover-database-import" endpoint of the
+// "control-plane" service.
+// CutoverDatabaseImport may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "database_not_modifiable" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "operation_already_in_progress" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CutoverDatabaseImport(ctx context.Context, p *CutoverDatabaseImportPayload) (res *CutoverDatabaseImportResponse, err error) {
+	var ires any
+	ires, err = c.CutoverDatabaseImportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CutoverDatabaseImportResponse), nil
+}
+
 // DeleteDatabase calls the "delete-database" endpoint of the "control-plane"
 // service.
 // DeleteDatabase may return the following errors:
@@ -346,6 +491,25 @@ func (c *Client) SwitchoverDatabaseNode(ctx context.Context, p *SwitchoverDataba
 	return ires.(*SwitchoverDatabaseNodeResponse), nil
 }
 
+// RollingRestartDatabase calls the "rolling-restart-database" endpoint of the
+// "control-plane" service.
+// RollingRestartDatabase may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "database_not_modifiable" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "operation_already_in_progress" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) RollingRestartDatabase(ctx context.Context, p *RollingRestartDatabasePayload) (res *RollingRestartDatabaseResponse, err error) {
+	var ires any
+	ires, err = c.RollingRestartDatabaseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RollingRestartDatabaseResponse), nil
+}
+
 // FailoverDatabaseNode calls the "failover-database-node" endpoint of the
 // "control-plane" service.
 // FailoverDatabaseNode may return the following errors:
@@ -365,6 +529,40 @@ func (c *Client) FailoverDatabaseNode(ctx context.Context, p *FailoverDatabaseNo
 	return ires.(*FailoverDatabaseNodeResponse), nil
 }
 
+// GetNodeQueryStats calls the "get-node-query-stats" endpoint of the
+// "control-plane" service.
+// GetNodeQueryStats may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetNodeQueryStats(ctx context.Context, p *GetNodeQueryStatsPayload) (res *GetNodeQueryStatsResponse, err error) {
+	var ires any
+	ires, err = c.GetNodeQueryStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetNodeQueryStatsResponse), nil
+}
+
+// ResetNodeQueryStats calls the "reset-node-query-stats" endpoint of the
+// "control-plane" service.
+// ResetNodeQueryStats may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ResetNodeQueryStats(ctx context.Context, p *ResetNodeQueryStatsPayload) (res *ResetNodeQueryStatsResponse, err error) {
+	var ires any
+	ires, err = c.ResetNodeQueryStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResetNodeQueryStatsResponse), nil
+}
+
 // ListDatabaseTasks calls the "list-database-tasks" endpoint of the
 // "control-plane" service.
 // ListDatabaseTasks may return the following errors:
@@ -482,6 +680,99 @@ func (c *Client) ListTasks(ctx context.Context, p *ListTasksPayload) (res *ListT
 	return ires.(*ListTasksResponse), nil
 }
 
+// StreamEvents calls the "stream-events" endpoint of the "control-plane"
+// service.
+// StreamEvents may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) StreamEvents(ctx context.Context, p *StreamEventsPayload) (res StreamEventsClientStream, err error) {
+	var ires any
+	ires, err = c.StreamEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamEventsClientStream), nil
+}
+
+// CreateWebhook calls the "create-webhook" endpoint of the "control-plane"
+// service.
+// CreateWebhook may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *CreateWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// ListWebhooks calls the "list-webhooks" endpoint of the "control-plane"
+// service.
+// ListWebhooks may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context) (res *ListWebhooksResponse, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*ListWebhooksResponse), nil
+}
+
+// GetWebhook calls the "get-webhook" endpoint of the "control-plane" service.
+// GetWebhook may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetWebhook(ctx context.Context, p *GetWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.GetWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// DeleteWebhook calls the "delete-webhook" endpoint of the "control-plane"
+// service.
+// DeleteWebhook may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "list-webhook-deliveries" endpoint of the
+// "control-plane" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res *ListWebhookDeliveriesResponse, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListWebhookDeliveriesResponse), nil
+}
+
 // RestoreDatabase calls the "restore-database" endpoint of the "control-plane"
 // service.
 // RestoreDatabase may return the following errors:
@@ -501,6 +792,25 @@ func (c *Client) RestoreDatabase(ctx context.Context, p *RestoreDatabasePayload)
 	return ires.(*RestoreDatabaseResponse), nil
 }
 
+// CloneDatabase calls the "clone-database" endpoint of the "control-plane"
+// service.
+// CloneDatabase may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "database_already_exists" (type *APIError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "operation_already_in_progress" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CloneDatabase(ctx context.Context, p *CloneDatabasePayload) (res *CloneDatabaseResponse, err error) {
+	var ires any
+	ires, err = c.CloneDatabaseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CloneDatabaseResponse), nil
+}
+
 // GetVersion calls the "get-version" endpoint of the "control-plane" service.
 // GetVersion may return the following errors:
 //   - "server_error" (type *goa.ServiceError)
@@ -532,6 +842,74 @@ func (c *Client) RestartInstance(ctx context.Context, p *RestartInstancePayload)
 	return ires.(*RestartInstanceResponse), nil
 }
 
+// GetInstanceSessions calls the "get-instance-sessions" endpoint of the
+// "control-plane" service.
+// GetInstanceSessions may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetInstanceSessions(ctx context.Context, p *GetInstanceSessionsPayload) (res *GetInstanceSessionsResponse, err error) {
+	var ires any
+	ires, err = c.GetInstanceSessionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetInstanceSessionsResponse), nil
+}
+
+// CancelInstanceSession calls the "cancel-instance-session" endpoint of the
+// "control-plane" service.
+// CancelInstanceSession may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CancelInstanceSession(ctx context.Context, p *CancelInstanceSessionPayload) (res *SignalInstanceSessionResponse, err error) {
+	var ires any
+	ires, err = c.CancelInstanceSessionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SignalInstanceSessionResponse), nil
+}
+
+// TerminateInstanceSession calls the "terminate-instance-session" endpoint of
+// the "control-plane" service.
+// TerminateInstanceSession may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) TerminateInstanceSession(ctx context.Context, p *TerminateInstanceSessionPayload) (res *SignalInstanceSessionResponse, err error) {
+	var ires any
+	ires, err = c.TerminateInstanceSessionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SignalInstanceSessionResponse), nil
+}
+
+// GetInstancePostgresqlConf calls the "get-instance-postgresql-conf" endpoint
+// of the "control-plane" service.
+// GetInstancePostgresqlConf may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "invalid_input" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetInstancePostgresqlConf(ctx context.Context, p *GetInstancePostgresqlConfPayload) (res *GetInstancePostgreSQLConfResponse, err error) {
+	var ires any
+	ires, err = c.GetInstancePostgresqlConfEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetInstancePostgreSQLConfResponse), nil
+}
+
 // StopInstance calls the "stop-instance" endpoint of the "control-plane"
 // service.
 // StopInstance may return the following errors:
@@ -584,3 +962,20 @@ func (c *Client) CancelDatabaseTask(ctx context.Context, p *CancelDatabaseTaskPa
 	}
 	return ires.(*Task), nil
 }
+
+// ResumeDatabaseTask calls the "resume-database-task" endpoint of the
+// "control-plane" service.
+// ResumeDatabaseTask may return the following errors:
+//   - "cluster_not_initialized" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError): The specified database or task could not be found.
+//   - "invalid_input" (type *goa.ServiceError): The task is not running.
+//   - "server_error" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ResumeDatabaseTask(ctx context.Context, p *ResumeDatabaseTaskPayload) (res *Task, err error) {
+	var ires any
+	ires, err = c.ResumeDatabaseTaskEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Task), nil
+}
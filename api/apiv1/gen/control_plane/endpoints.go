@@ -15,71 +15,122 @@ import (
 
 // Endpoints wraps the "control-plane" service endpoints.
 type Endpoints struct {
-	InitCluster            goa.Endpoint
-	JoinCluster            goa.Endpoint
-	GetJoinToken           goa.Endpoint
-	GetJoinOptions         goa.Endpoint
-	GetCluster             goa.Endpoint
-	ListHosts              goa.Endpoint
-	GetHost                goa.Endpoint
-	RemoveHost             goa.Endpoint
-	ListDatabases          goa.Endpoint
-	CreateDatabase         goa.Endpoint
-	GetDatabase            goa.Endpoint
-	UpdateDatabase         goa.Endpoint
-	ApplyUpgrade           goa.Endpoint
-	DeleteDatabase         goa.Endpoint
-	BackupDatabaseNode     goa.Endpoint
-	SwitchoverDatabaseNode goa.Endpoint
-	FailoverDatabaseNode   goa.Endpoint
-	ListDatabaseTasks      goa.Endpoint
-	GetDatabaseTask        goa.Endpoint
-	GetDatabaseTaskLog     goa.Endpoint
-	ListHostTasks          goa.Endpoint
-	GetHostTask            goa.Endpoint
-	GetHostTaskLog         goa.Endpoint
-	ListTasks              goa.Endpoint
-	RestoreDatabase        goa.Endpoint
-	GetVersion             goa.Endpoint
-	RestartInstance        goa.Endpoint
-	StopInstance           goa.Endpoint
-	StartInstance          goa.Endpoint
-	CancelDatabaseTask     goa.Endpoint
+	InitCluster               goa.Endpoint
+	JoinCluster               goa.Endpoint
+	GetJoinToken              goa.Endpoint
+	GetJoinOptions            goa.Endpoint
+	GetCluster                goa.Endpoint
+	GetStorageUsage           goa.Endpoint
+	GetEtcdCluster            goa.Endpoint
+	DefragmentEtcd            goa.Endpoint
+	ListHosts                 goa.Endpoint
+	GetHost                   goa.Endpoint
+	RemoveHost                goa.Endpoint
+	ChangeHostEtcdMode        goa.Endpoint
+	ListDatabases             goa.Endpoint
+	CreateDatabase            goa.Endpoint
+	GetDatabase               goa.Endpoint
+	UpdateDatabase            goa.Endpoint
+	ApplyUpgrade              goa.Endpoint
+	UpgradeDatabaseMajor      goa.Endpoint
+	CutoverDatabaseImport     goa.Endpoint
+	DeleteDatabase            goa.Endpoint
+	BackupDatabaseNode        goa.Endpoint
+	SwitchoverDatabaseNode    goa.Endpoint
+	RollingRestartDatabase    goa.Endpoint
+	FailoverDatabaseNode      goa.Endpoint
+	GetNodeQueryStats         goa.Endpoint
+	ResetNodeQueryStats       goa.Endpoint
+	ListDatabaseTasks         goa.Endpoint
+	GetDatabaseTask           goa.Endpoint
+	GetDatabaseTaskLog        goa.Endpoint
+	ListHostTasks             goa.Endpoint
+	GetHostTask               goa.Endpoint
+	GetHostTaskLog            goa.Endpoint
+	ListTasks                 goa.Endpoint
+	StreamEvents              goa.Endpoint
+	CreateWebhook             goa.Endpoint
+	ListWebhooks              goa.Endpoint
+	GetWebhook                goa.Endpoint
+	DeleteWebhook             goa.Endpoint
+	ListWebhookDeliveries     goa.Endpoint
+	RestoreDatabase           goa.Endpoint
+	CloneDatabase             goa.Endpoint
+	GetVersion                goa.Endpoint
+	RestartInstance           goa.Endpoint
+	GetInstanceSessions       goa.Endpoint
+	CancelInstanceSession     goa.Endpoint
+	TerminateInstanceSession  goa.Endpoint
+	GetInstancePostgresqlConf goa.Endpoint
+	StopInstance              goa.Endpoint
+	StartInstance             goa.Endpoint
+	CancelDatabaseTask        goa.Endpoint
+	ResumeDatabaseTask        goa.Endpoint
+}
+
+// StreamEventsEndpointInput holds both the payload and the server stream of
+// the "stream-events" method.
+type StreamEventsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamEventsPayload
+	// Stream is the server stream used by the "stream-events" method to send data.
+	Stream StreamEventsServerStream
 }
 
 // NewEndpoints wraps the methods of the "control-plane" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		InitCluster:            NewInitClusterEndpoint(s),
-		JoinCluster:            NewJoinClusterEndpoint(s),
-		GetJoinToken:           NewGetJoinTokenEndpoint(s),
-		GetJoinOptions:         NewGetJoinOptionsEndpoint(s),
-		GetCluster:             NewGetClusterEndpoint(s),
-		ListHosts:              NewListHostsEndpoint(s),
-		GetHost:                NewGetHostEndpoint(s),
-		RemoveHost:             NewRemoveHostEndpoint(s),
-		ListDatabases:          NewListDatabasesEndpoint(s),
-		CreateDatabase:         NewCreateDatabaseEndpoint(s),
-		GetDatabase:            NewGetDatabaseEndpoint(s),
-		UpdateDatabase:         NewUpdateDatabaseEndpoint(s),
-		ApplyUpgrade:           NewApplyUpgradeEndpoint(s),
-		DeleteDatabase:         NewDeleteDatabaseEndpoint(s),
-		BackupDatabaseNode:     NewBackupDatabaseNodeEndpoint(s),
-		SwitchoverDatabaseNode: NewSwitchoverDatabaseNodeEndpoint(s),
-		FailoverDatabaseNode:   NewFailoverDatabaseNodeEndpoint(s),
-		ListDatabaseTasks:      NewListDatabaseTasksEndpoint(s),
-		GetDatabaseTask:        NewGetDatabaseTaskEndpoint(s),
-		GetDatabaseTaskLog:     NewGetDatabaseTaskLogEndpoint(s),
-		ListHostTasks:          NewListHostTasksEndpoint(s),
-		GetHostTask:            NewGetHostTaskEndpoint(s),
-		GetHostTaskLog:         NewGetHostTaskLogEndpoint(s),
-		ListTasks:              NewListTasksEndpoint(s),
-		RestoreDatabase:        NewRestoreDatabaseEndpoint(s),
-		GetVersion:             NewGetVersionEndpoint(s),
-		RestartInstance:        NewRestartInstanceEndpoint(s),
-		StopInstance:           NewStopInstanceEndpoint(s),
-		StartInstance:          NewStartInstanceEndpoint(s),
-		CancelDatabaseTask:     NewCancelDatabaseTaskEndpoint(s),
+		InitCluster:               NewInitClusterEndpoint(s),
+		JoinCluster:               NewJoinClusterEndpoint(s),
+		GetJoinToken:              NewGetJoinTokenEndpoint(s),
+		GetJoinOptions:            NewGetJoinOptionsEndpoint(s),
+		GetCluster:                NewGetClusterEndpoint(s),
+		GetStorageUsage:           NewGetStorageUsageEndpoint(s),
+		GetEtcdCluster:            NewGetEtcdClusterEndpoint(s),
+		DefragmentEtcd:            NewDefragmentEtcdEndpoint(s),
+		ListHosts:                 NewListHostsEndpoint(s),
+		GetHost:                   NewGetHostEndpoint(s),
+		RemoveHost:                NewRemoveHostEndpoint(s),
+		ChangeHostEtcdMode:        NewChangeHostEtcdModeEndpoint(s),
+		ListDatabases:             NewListDatabasesEndpoint(s),
+		CreateDatabase:            NewCreateDatabaseEndpoint(s),
+		GetDatabase:               NewGetDatabaseEndpoint(s),
+		UpdateDatabase:            NewUpdateDatabaseEndpoint(s),
+		ApplyUpgrade:              NewApplyUpgradeEndpoint(s),
+		UpgradeDatabaseMajor:      NewUpgradeDatabaseMajorEndpoint(s),
+		CutoverDatabaseImport:     NewCutoverDatabaseImportEndpoint(s),
+		DeleteDatabase:            NewDeleteDatabaseEndpoint(s),
+		BackupDatabaseNode:        NewBackupDatabaseNodeEndpoint(s),
+		SwitchoverDatabaseNode:    NewSwitchoverDatabaseNodeEndpoint(s),
+		RollingRestartDatabase:    NewRollingRestartDatabaseEndpoint(s),
+		FailoverDatabaseNode:      NewFailoverDatabaseNodeEndpoint(s),
+		GetNodeQueryStats:         NewGetNodeQueryStatsEndpoint(s),
+		ResetNodeQueryStats:       NewResetNodeQueryStatsEndpoint(s),
+		ListDatabaseTasks:         NewListDatabaseTasksEndpoint(s),
+		GetDatabaseTask:           NewGetDatabaseTaskEndpoint(s),
+		GetDatabaseTaskLog:        NewGetDatabaseTaskLogEndpoint(s),
+		ListHostTasks:             NewListHostTasksEndpoint(s),
+		GetHostTask:               NewGetHostTaskEndpoint(s),
+		GetHostTaskLog:            NewGetHostTaskLogEndpoint(s),
+		ListTasks:                 NewListTasksEndpoint(s),
+		StreamEvents:              NewStreamEventsEndpoint(s),
+		CreateWebhook:             NewCreateWebhookEndpoint(s),
+		ListWebhooks:              NewListWebhooksEndpoint(s),
+		GetWebhook:                NewGetWebhookEndpoint(s),
+		DeleteWebhook:             NewDeleteWebhookEndpoint(s),
+		ListWebhookDeliveries:     NewListWebhookDeliveriesEndpoint(s),
+		RestoreDatabase:           NewRestoreDatabaseEndpoint(s),
+		CloneDatabase:             NewCloneDatabaseEndpoint(s),
+		GetVersion:                NewGetVersionEndpoint(s),
+		RestartInstance:           NewRestartInstanceEndpoint(s),
+		GetInstanceSessions:       NewGetInstanceSessionsEndpoint(s),
+		CancelInstanceSession:     NewCancelInstanceSessionEndpoint(s),
+		TerminateInstanceSession:  NewTerminateInstanceSessionEndpoint(s),
+		GetInstancePostgresqlConf: NewGetInstancePostgresqlConfEndpoint(s),
+		StopInstance:              NewStopInstanceEndpoint(s),
+		StartInstance:             NewStartInstanceEndpoint(s),
+		CancelDatabaseTask:        NewCancelDatabaseTaskEndpoint(s),
+		ResumeDatabaseTask:        NewResumeDatabaseTaskEndpoint(s),
 	}
 }
 
@@ -91,18 +142,27 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetJoinToken = m(e.GetJoinToken)
 	e.GetJoinOptions = m(e.GetJoinOptions)
 	e.GetCluster = m(e.GetCluster)
+	e.GetStorageUsage = m(e.GetStorageUsage)
+	e.GetEtcdCluster = m(e.GetEtcdCluster)
+	e.DefragmentEtcd = m(e.DefragmentEtcd)
 	e.ListHosts = m(e.ListHosts)
 	e.GetHost = m(e.GetHost)
 	e.RemoveHost = m(e.RemoveHost)
+	e.ChangeHostEtcdMode = m(e.ChangeHostEtcdMode)
 	e.ListDatabases = m(e.ListDatabases)
 	e.CreateDatabase = m(e.CreateDatabase)
 	e.GetDatabase = m(e.GetDatabase)
 	e.UpdateDatabase = m(e.UpdateDatabase)
 	e.ApplyUpgrade = m(e.ApplyUpgrade)
+	e.UpgradeDatabaseMajor = m(e.UpgradeDatabaseMajor)
+	e.CutoverDatabaseImport = m(e.CutoverDatabaseImport)
 	e.DeleteDatabase = m(e.DeleteDatabase)
 	e.BackupDatabaseNode = m(e.BackupDatabaseNode)
 	e.SwitchoverDatabaseNode = m(e.SwitchoverDatabaseNode)
+	e.RollingRestartDatabase = m(e.RollingRestartDatabase)
 	e.FailoverDatabaseNode = m(e.FailoverDatabaseNode)
+	e.GetNodeQueryStats = m(e.GetNodeQueryStats)
+	e.ResetNodeQueryStats = m(e.ResetNodeQueryStats)
 	e.ListDatabaseTasks = m(e.ListDatabaseTasks)
 	e.GetDatabaseTask = m(e.GetDatabaseTask)
 	e.GetDatabaseTaskLog = m(e.GetDatabaseTaskLog)
@@ -110,12 +170,24 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetHostTask = m(e.GetHostTask)
 	e.GetHostTaskLog = m(e.GetHostTaskLog)
 	e.ListTasks = m(e.ListTasks)
+	e.StreamEvents = m(e.StreamEvents)
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.GetWebhook = m(e.GetWebhook)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
 	e.RestoreDatabase = m(e.RestoreDatabase)
+	e.CloneDatabase = m(e.CloneDatabase)
 	e.GetVersion = m(e.GetVersion)
 	e.RestartInstance = m(e.RestartInstance)
+	e.GetInstanceSessions = m(e.GetInstanceSessions)
+	e.CancelInstanceSession = m(e.CancelInstanceSession)
+	e.TerminateInstanceSession = m(e.TerminateInstanceSession)
+	e.GetInstancePostgresqlConf = m(e.GetInstancePostgresqlConf)
 	e.StopInstance = m(e.StopInstance)
 	e.StartInstance = m(e.StartInstance)
 	e.CancelDatabaseTask = m(e.CancelDatabaseTask)
+	e.ResumeDatabaseTask = m(e.ResumeDatabaseTask)
 }
 
 // NewInitClusterEndpoint returns an endpoint function that calls the method
@@ -161,11 +233,38 @@ func NewGetClusterEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetStorageUsageEndpoint returns an endpoint function that calls the
+// method "get-storage-usage" of service "control-plane".
+func NewGetStorageUsageEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetStorageUsagePayload)
+		return s.GetStorageUsage(ctx, p)
+	}
+}
+
+// NewGetEtcdClusterEndpoint returns an endpoint function that calls the method
+// "get-etcd-cluster" of service "control-plane".
+func NewGetEtcdClusterEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.GetEtcdCluster(ctx)
+	}
+}
+
+// NewDefragmentEtcdEndpoint returns an endpoint function that calls the method
+// "defragment-etcd" of service "control-plane".
+func NewDefragmentEtcdEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DefragmentEtcdRequest)
+		return s.DefragmentEtcd(ctx, p)
+	}
+}
+
 // NewListHostsEndpoint returns an endpoint function that calls the method
 // "list-hosts" of service "control-plane".
 func NewListHostsEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return s.ListHosts(ctx)
+		p := req.(*ListHostsPayload)
+		return s.ListHosts(ctx, p)
 	}
 }
 
@@ -187,6 +286,15 @@ func NewRemoveHostEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewChangeHostEtcdModeEndpoint returns an endpoint function that calls the
+// method "change-host-etcd-mode" of service "control-plane".
+func NewChangeHostEtcdModeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ChangeHostEtcdModePayload)
+		return s.ChangeHostEtcdMode(ctx, p)
+	}
+}
+
 // NewListDatabasesEndpoint returns an endpoint function that calls the method
 // "list-databases" of service "control-plane".
 func NewListDatabasesEndpoint(s Service) goa.Endpoint {
@@ -232,6 +340,24 @@ func NewApplyUpgradeEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewUpgradeDatabaseMajorEndpoint returns an endpoint function that calls the
+// method "upgrade-database-major" of service "control-plane".
+func NewUpgradeDatabaseMajorEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpgradeDatabaseMajorPayload)
+		return s.UpgradeDatabaseMajor(ctx, p)
+	}
+}
+
+// NewCutoverDatabaseImportEndpoint returns an endpoint function that calls the
+// method "cutover-database-import" of service "control-plane".
+func NewCutoverDatabaseImportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CutoverDatabaseImportPayload)
+		return s.CutoverDatabaseImport(ctx, p)
+	}
+}
+
 // NewDeleteDatabaseEndpoint returns an endpoint function that calls the method
 // "delete-database" of service "control-plane".
 func NewDeleteDatabaseEndpoint(s Service) goa.Endpoint {
@@ -259,6 +385,15 @@ func NewSwitchoverDatabaseNodeEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewRollingRestartDatabaseEndpoint returns an endpoint function that calls
+// the method "rolling-restart-database" of service "control-plane".
+func NewRollingRestartDatabaseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RollingRestartDatabasePayload)
+		return s.RollingRestartDatabase(ctx, p)
+	}
+}
+
 // NewFailoverDatabaseNodeEndpoint returns an endpoint function that calls the
 // method "failover-database-node" of service "control-plane".
 func NewFailoverDatabaseNodeEndpoint(s Service) goa.Endpoint {
@@ -268,6 +403,24 @@ func NewFailoverDatabaseNodeEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetNodeQueryStatsEndpoint returns an endpoint function that calls the
+// method "get-node-query-stats" of service "control-plane".
+func NewGetNodeQueryStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetNodeQueryStatsPayload)
+		return s.GetNodeQueryStats(ctx, p)
+	}
+}
+
+// NewResetNodeQueryStatsEndpoint returns an endpoint function that calls the
+// method "reset-node-query-stats" of service "control-plane".
+func NewResetNodeQueryStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ResetNodeQueryStatsPayload)
+		return s.ResetNodeQueryStats(ctx, p)
+	}
+}
+
 // NewListDatabaseTasksEndpoint returns an endpoint function that calls the
 // method "list-database-tasks" of service "control-plane".
 func NewListDatabaseTasksEndpoint(s Service) goa.Endpoint {
@@ -331,6 +484,59 @@ func NewListTasksEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewStreamEventsEndpoint returns an endpoint function that calls the method
+// "stream-events" of service "control-plane".
+func NewStreamEventsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamEventsEndpointInput)
+		return nil, s.StreamEvents(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "create-webhook" of service "control-plane".
+func NewCreateWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWebhookPayload)
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "list-webhooks" of service "control-plane".
+func NewListWebhooksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListWebhooks(ctx)
+	}
+}
+
+// NewGetWebhookEndpoint returns an endpoint function that calls the method
+// "get-webhook" of service "control-plane".
+func NewGetWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetWebhookPayload)
+		return s.GetWebhook(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "delete-webhook" of service "control-plane".
+func NewDeleteWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "list-webhook-deliveries" of service "control-plane".
+func NewListWebhookDeliveriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		return s.ListWebhookDeliveries(ctx, p)
+	}
+}
+
 // NewRestoreDatabaseEndpoint returns an endpoint function that calls the
 // method "restore-database" of service "control-plane".
 func NewRestoreDatabaseEndpoint(s Service) goa.Endpoint {
@@ -340,6 +546,15 @@ func NewRestoreDatabaseEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewCloneDatabaseEndpoint returns an endpoint function that calls the method
+// "clone-database" of service "control-plane".
+func NewCloneDatabaseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CloneDatabasePayload)
+		return s.CloneDatabase(ctx, p)
+	}
+}
+
 // NewGetVersionEndpoint returns an endpoint function that calls the method
 // "get-version" of service "control-plane".
 func NewGetVersionEndpoint(s Service) goa.Endpoint {
@@ -357,6 +572,42 @@ func NewRestartInstanceEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetInstanceSessionsEndpoint returns an endpoint function that calls the
+// method "get-instance-sessions" of service "control-plane".
+func NewGetInstanceSessionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetInstanceSessionsPayload)
+		return s.GetInstanceSessions(ctx, p)
+	}
+}
+
+// NewCancelInstanceSessionEndpoint returns an endpoint function that calls the
+// method "cancel-instance-session" of service "control-plane".
+func NewCancelInstanceSessionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelInstanceSessionPayload)
+		return s.CancelInstanceSession(ctx, p)
+	}
+}
+
+// NewTerminateInstanceSessionEndpoint returns an endpoint function that calls
+// the method "terminate-instance-session" of service "control-plane".
+func NewTerminateInstanceSessionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TerminateInstanceSessionPayload)
+		return s.TerminateInstanceSession(ctx, p)
+	}
+}
+
+// NewGetInstancePostgresqlConfEndpoint returns an endpoint function that calls
+// the method "get-instance-postgresql-conf" of service "control-plane".
+func NewGetInstancePostgresqlConfEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetInstancePostgresqlConfPayload)
+		return s.GetInstancePostgresqlConf(ctx, p)
+	}
+}
+
 // NewStopInstanceEndpoint returns an endpoint function that calls the method
 // "stop-instance" of service "control-plane".
 func NewStopInstanceEndpoint(s Service) goa.Endpoint {
@@ -383,3 +634,12 @@ func NewCancelDatabaseTaskEndpoint(s Service) goa.Endpoint {
 		return s.CancelDatabaseTask(ctx, p)
 	}
 }
+
+// NewResumeDatabaseTaskEndpoint returns an endpoint function that calls the
+// method "resume-database-task" of service "control-plane".
+func NewResumeDatabaseTaskEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ResumeDatabaseTaskPayload)
+		return s.ResumeDatabaseTask(ctx, p)
+	}
+}
@@ -25,12 +25,29 @@ type Service interface {
 	GetJoinOptions(context.Context, *ClusterJoinRequest) (res *ClusterJoinOptions, err error)
 	// Returns information about the cluster.
 	GetCluster(context.Context) (res *Cluster, err error)
+	// Returns the amount of Etcd storage used by the Control Plane, grouped by key
+	// prefix.
+	GetStorageUsage(context.Context, *GetStorageUsagePayload) (res *StorageUsage, err error)
+	// Returns the members of the Control Plane's Etcd cluster with their role,
+	// database size, and raft progress, along with the cluster's quorum and
+	// failure tolerance.
+	GetEtcdCluster(context.Context) (res *EtcdCluster, err error)
+	// Defragments the Etcd database on the given hosts to reclaim the space that
+	// Etcd can reuse after compaction. Hosts are defragmented one at a time, and
+	// the leader is defragmented last. Each member stops serving requests while
+	// it's defragmented.
+	DefragmentEtcd(context.Context, *DefragmentEtcdRequest) (res *DefragmentEtcdResponse, err error)
 	// Lists all hosts within the cluster.
-	ListHosts(context.Context) (res *ListHostsResponse, err error)
+	ListHosts(context.Context, *ListHostsPayload) (res *ListHostsResponse, err error)
 	// Returns information about a particular host in the cluster.
 	GetHost(context.Context, *GetHostPayload) (res *Host, err error)
 	// Removes a host from the cluster.
 	RemoveHost(context.Context, *RemoveHostPayload) (res *RemoveHostResponse, err error)
+	// Changes whether a host runs an Etcd server or connects to the other hosts as
+	// an Etcd client. The host restarts to apply the change. The response includes
+	// warnings when the cluster will be unable to tolerate the failure of an Etcd
+	// server after the change.
+	ChangeHostEtcdMode(context.Context, *ChangeHostEtcdModePayload) (res *ChangeHostEtcdModeResponse, err error)
 	// Lists all databases in the cluster.
 	ListDatabases(context.Context, *ListDatabasesPayload) (res *ListDatabasesResponse, err error)
 	// Creates a new database in the cluster.
@@ -39,19 +56,50 @@ type Service interface {
 	GetDatabase(context.Context, *GetDatabasePayload) (res *Database, err error)
 	// Updates a database with the given specification.
 	UpdateDatabase(context.Context, *UpdateDatabasePayload) (res *UpdateDatabaseResponse, err error)
-	// Applies a minor-version upgrade to a database. The target image must be a
-	// stable manifest entry in the same Postgres major / Spock major bucket as the
-	// current version and strictly newer. Container pull and restart happen
-	// asynchronously; this endpoint returns once redeployment is triggered.
+	// Applies an upgrade to a database. In the default 'minor' mode, the target
+	// image must be a stable manifest entry in the same Postgres major / Spock
+	// major bucket as the current version and strictly newer. Container pull and
+	// restart happen asynchronously; this endpoint returns once redeployment is
+	// triggered. In the 'pg_upgrade' mode, each node is upgraded to a new Postgres
+	// major version in place: every node's primary is first checked with
+	// pg_upgrade --check, then each node is stopped, its primary is upgraded with
+	// pg_upgrade --link, and its replicas are rebuilt from the upgraded primary.
+	// Each node is unavailable while it's being upgraded.
 	ApplyUpgrade(context.Context, *ApplyUpgradePayload) (res *ApplyUpgradeResponse, err error)
+	// Upgrades a database to a new Postgres major version by replacing each of its
+	// nodes, one at a time, with a new node running the target version. Each
+	// replacement node is populated from the node it replaces, services are
+	// switched over to the replacement, and then the original node is removed. If
+	// adding a replacement node or switching services over fails, that node's
+	// changes are rolled back and the task fails.
+	UpgradeDatabaseMajor(context.Context, *UpgradeDatabaseMajorPayload) (res *UpgradeDatabaseMajorResponse, err error)
+	// Cuts over a database that was created with an import source. The task waits
+	// for the import's replication lag to reach zero, copies the source's sequence
+	// values, and then stops replication from the source. Writes to the source
+	// should be stopped before the cutover.
+	CutoverDatabaseImport(context.Context, *CutoverDatabaseImportPayload) (res *CutoverDatabaseImportResponse, err error)
 	// Deletes a database from the cluster.
 	DeleteDatabase(context.Context, *DeleteDatabasePayload) (res *DeleteDatabaseResponse, err error)
 	// Initiates a backup for a database node.
 	BackupDatabaseNode(context.Context, *BackupDatabaseNodePayload) (res *BackupDatabaseNodeResponse, err error)
 	// Performs a planned switchover for a node's primary to a replica candidate.
 	SwitchoverDatabaseNode(context.Context, *SwitchoverDatabaseNodePayload) (res *SwitchoverDatabaseNodeResponse, err error)
+	// Restarts a database's instances one at a time without taking any of its
+	// nodes offline. On each node, replicas are restarted first, and each replica
+	// must resume streaming before the next instance is restarted. If a node's
+	// primary needs a restart, it's switched over to the most up-to-date replica,
+	// restarted, and optionally switched back. Each restart and switchover is
+	// tracked by its own task, whose parent is the rolling restart task.
+	RollingRestartDatabase(context.Context, *RollingRestartDatabasePayload) (res *RollingRestartDatabaseResponse, err error)
 	// Performs a failover for a node to a replica candidate.
 	FailoverDatabaseNode(context.Context, *FailoverDatabaseNodeRequest) (res *FailoverDatabaseNodeResponse, err error)
+	// Returns the most recently collected pg_stat_statements statistics for each
+	// instance in a node. Query stats collection must be enabled in the database
+	// spec.
+	GetNodeQueryStats(context.Context, *GetNodeQueryStatsPayload) (res *GetNodeQueryStatsResponse, err error)
+	// Discards the pg_stat_statements statistics on each instance in a node, as
+	// well as the statistics that have been collected from them.
+	ResetNodeQueryStats(context.Context, *ResetNodeQueryStatsPayload) (res *ResetNodeQueryStatsResponse, err error)
 	// Lists all tasks for a database.
 	ListDatabaseTasks(context.Context, *ListDatabaseTasksPayload) (res *ListDatabaseTasksResponse, err error)
 	// Returns information about a particular task.
@@ -66,20 +114,59 @@ type Service interface {
 	GetHostTaskLog(context.Context, *GetHostTaskLogPayload) (res *TaskLog, err error)
 	// Lists tasks across all scopes with optional filtering by scope and entity ID.
 	ListTasks(context.Context, *ListTasksPayload) (res *ListTasksResponse, err error)
+	// Streams database, instance, and task change events as server-sent events.
+	// The first event is always a heartbeat, and only changes that happen after
+	// the heartbeat are sent.
+	StreamEvents(context.Context, *StreamEventsPayload, StreamEventsServerStream) (err error)
+	// Registers a webhook endpoint that receives lifecycle events, such as task
+	// failures and database state changes. Each payload is signed with the
+	// webhook's secret.
+	CreateWebhook(context.Context, *CreateWebhookPayload) (res *Webhook, err error)
+	// Lists the webhooks in the cluster. Secrets are not returned.
+	ListWebhooks(context.Context) (res *ListWebhooksResponse, err error)
+	// Returns information about a particular webhook. The secret is not returned.
+	GetWebhook(context.Context, *GetWebhookPayload) (res *Webhook, err error)
+	// Deletes a webhook and its delivery history. Pending deliveries are discarded.
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// Lists a webhook's most recent deliveries, newest first. Delivery history is
+	// kept for seven days.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res *ListWebhookDeliveriesResponse, err error)
 	// Perform an in-place restore of one or more nodes using the given restore
 	// configuration.
 	RestoreDatabase(context.Context, *RestoreDatabasePayload) (res *RestoreDatabaseResponse, err error)
+	// Creates a new database from a node of an existing database. The clone is
+	// restored from the source node's backup repository, either from its latest
+	// backup and archived WAL or from a fresh backup taken from one of its
+	// replicas. The clone is a single-node database, and it never replicates with
+	// the source: Spock subscriptions are dropped during the restore.
+	CloneDatabase(context.Context, *CloneDatabasePayload) (res *CloneDatabaseResponse, err error)
 	// Returns version information for this Control Plane server.
 	GetVersion(context.Context) (res *VersionInfo, err error)
 	// Restarts a specific instance within a database. Supports immediate or
 	// scheduled restarts.
 	RestartInstance(context.Context, *RestartInstancePayload) (res *RestartInstanceResponse, err error)
+	// Returns the client sessions on a specific instance within a database,
+	// including the locks that each session holds or is waiting for and the chains
+	// of sessions that are blocking each other.
+	GetInstanceSessions(context.Context, *GetInstanceSessionsPayload) (res *GetInstanceSessionsResponse, err error)
+	// Cancels the current query of a client session on a specific instance. The
+	// cancellation is performed and recorded by a task.
+	CancelInstanceSession(context.Context, *CancelInstanceSessionPayload) (res *SignalInstanceSessionResponse, err error)
+	// Terminates a client session on a specific instance. The termination is
+	// performed and recorded by a task.
+	TerminateInstanceSession(context.Context, *TerminateInstanceSessionPayload) (res *SignalInstanceSessionResponse, err error)
+	// Returns the effective postgresql.conf for a specific instance within a
+	// database, including the source of each setting and whether it's waiting for
+	// a restart.
+	GetInstancePostgresqlConf(context.Context, *GetInstancePostgresqlConfPayload) (res *GetInstancePostgreSQLConfResponse, err error)
 	// Stops a specific instance within a database. Supports immediate stops.
 	StopInstance(context.Context, *StopInstancePayload) (res *StopInstanceResponse, err error)
 	// Starts a specific instance within a database. Supports immediate starts
 	StartInstance(context.Context, *StartInstancePayload) (res *StartInstanceResponse, err error)
 	// Cancels a running or pending task for a database.
 	CancelDatabaseTask(context.Context, *CancelDatabaseTaskPayload) (res *Task, err error)
+	// Resumes a paused task for a database.
+	ResumeDatabaseTask(context.Context, *ResumeDatabaseTaskPayload) (res *Task, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -96,7 +183,25 @@ const ServiceName = "control-plane"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [30]string{"init-cluster", "join-cluster", "get-join-token", "get-join-options", "get-cluster", "list-hosts", "get-host", "remove-host", "list-databases", "create-database", "get-database", "update-database", "apply-upgrade", "delete-database", "backup-database-node", "switchover-database-node", "failover-database-node", "list-database-tasks", "get-database-task", "get-database-task-log", "list-host-tasks", "get-host-task", "get-host-task-log", "list-tasks", "restore-database", "get-version", "restart-instance", "stop-instance", "start-instance", "cancel-database-task"}
+var MethodNames = [51]string{"init-cluster", "join-cluster", "get-join-token", "get-join-options", "get-cluster", "get-storage-usage", "get-etcd-cluster", "defragment-etcd", "list-hosts", "get-host", "remove-host", "change-host-etcd-mode", "list-databases", "create-database", "get-database", "update-database", "apply-upgrade", "upgrade-database-major", "cutover-database-import", "delete-database", "backup-database-node", "switchover-database-node", "rolling-restart-database", "failover-database-node", "get-node-query-stats", "reset-node-query-stats", "list-database-tasks", "get-database-task", "get-database-task-log", "list-host-tasks", "get-host-task", "get-host-task-log", "list-tasks", "stream-events", "create-webhook", "list-webhooks", "get-webhook", "delete-webhook", "list-webhook-deliveries", "restore-database", "clone-database", "get-version", "restart-instance", "get-instance-sessions", "cancel-instance-session", "terminate-instance-session", "get-instance-postgresql-conf", "stop-instance", "start-instance", "cancel-database-task", "resume-database-task"}
+
+// StreamEventsServerStream allows streaming instances of *Event to the client.
+type StreamEventsServerStream interface {
+	// Send streams instances of "Event".
+	Send(*Event) error
+	// SendWithContext streams instances of "Event" with context.
+	SendWithContext(context.Context, *Event) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamEventsClientStream allows streaming instances of *Event to the client.
+type StreamEventsClientStream interface {
+	// Recv reads instances of "Event" from the stream.
+	Recv() (*Event, error)
+	// RecvWithContext reads instances of "Event" from the stream with context.
+	RecvWithContext(context.Context) (*Event, error)
+}
 
 // A Control Plane API error.
 type APIError struct {
@@ -115,10 +220,17 @@ type ApplyUpgradePayload struct {
 }
 
 type ApplyUpgradeRequest struct {
+	// The kind of upgrade to apply. 'minor' redeploys the database with a newer
+	// image in the same major version. 'pg_upgrade' upgrades each node to a new
+	// Postgres major version in place with pg_upgrade --link.
+	Mode string `json:"mode,omitempty"`
 	// Full container image reference of the upgrade target. Must match the image
 	// field of a stable manifest entry in the same Postgres major / Spock major
-	// bucket as the current version and be strictly newer.
-	Image string `json:"image"`
+	// bucket as the current version and be strictly newer. Required for the
+	// 'minor' mode.
+	Image *string `json:"image,omitempty"`
+	// The target Postgres version. Required for the 'pg_upgrade' mode.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
 }
 
 // ApplyUpgradeResponse is the result type of the control-plane service
@@ -248,6 +360,96 @@ type CancelDatabaseTaskPayload struct {
 	TaskID Identifier
 }
 
+// CancelInstanceSessionPayload is the payload type of the control-plane
+// service cancel-instance-session method.
+type CancelInstanceSessionPayload struct {
+	// The ID of the database that owns the instance.
+	DatabaseID Identifier
+	// The ID of the instance.
+	InstanceID string
+	// The process ID of the session.
+	Pid int
+}
+
+// ChangeHostEtcdModePayload is the payload type of the control-plane service
+// change-host-etcd-mode method.
+type ChangeHostEtcdModePayload struct {
+	// ID of the host to change.
+	HostID  Identifier
+	Request *ChangeHostEtcdModeRequest
+}
+
+type ChangeHostEtcdModeRequest struct {
+	// The new Etcd mode for the host.
+	EtcdMode string `json:"etcd_mode"`
+}
+
+// ChangeHostEtcdModeResponse is the result type of the control-plane service
+// change-host-etcd-mode method.
+type ChangeHostEtcdModeResponse struct {
+	// The task that tracks the change.
+	Task *Task `json:"task"`
+	// Warnings about the Etcd cluster's failure tolerance after the change.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// CloneDatabasePayload is the payload type of the control-plane service
+// clone-database method.
+type CloneDatabasePayload struct {
+	// ID of the database to clone.
+	DatabaseID Identifier
+	Request    *CloneDatabaseRequest
+}
+
+type CloneDatabaseRequest struct {
+	// ID of the new database.
+	ID Identifier `json:"id"`
+	// Unique identifier for the new database's owner. The clone always has the
+	// same owner as the source database, so this must match the source database's
+	// tenant ID when it's given. It's required for requests that are made by a
+	// tenant.
+	TenantID *Identifier `json:"tenant_id,omitempty"`
+	// The node of the source database to clone. Defaults to the source database's
+	// first node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// How the clone is populated. The 'backup' method restores the source node's
+	// latest backup and replays its archived WAL. The 'base_backup' method first
+	// takes a fresh full backup from one of the source node's replicas, or from
+	// its primary if it has no replicas, and then restores that backup. Both
+	// methods require a backup repository on the source node.
+	Method string `json:"method,omitempty"`
+	// Recover the clone to this point in time rather than to the end of the
+	// archived WAL. Only valid with the 'backup' method.
+	TargetTime *string `json:"target_time,omitempty"`
+	// The IDs of the hosts that should run the clone. Defaults to the source
+	// node's hosts.
+	HostIds []Identifier `json:"host_ids,omitempty"`
+	// A new name for the cloned Postgres database. Defaults to the source
+	// database's name.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// Renames database users in the clone. Each key is the name of a user in the
+	// source database's spec, and each value is the user's new name. Roles are
+	// renamed before they're updated from the spec, so renamed users keep the
+	// objects and privileges they had in the source.
+	RenameUsers map[string]string `json:"rename_users,omitempty"`
+	// SQL statements that run once in the cloned database, within a transaction,
+	// after it's restored and before the clone becomes available. This can be used
+	// to remove or mask sensitive data.
+	ScrubScript SQLScript `json:"scrub_script,omitempty"`
+}
+
+// CloneDatabaseResponse is the result type of the control-plane service
+// clone-database method.
+type CloneDatabaseResponse struct {
+	// The task that will create the clone.
+	Task *Task `json:"task"`
+	// The source database task that will take a fresh backup for the clone. Only
+	// set for the 'base_backup' method.
+	BackupTask *Task `json:"backup_task,omitempty"`
+	// The database being created.
+	Database *Database `json:"database"`
+}
+
 // Cluster is the result type of the control-plane service get-cluster method.
 type Cluster struct {
 	// Unique identifier for the cluster.
@@ -256,6 +458,8 @@ type Cluster struct {
 	Status *ClusterStatus `json:"status"`
 	// All of the hosts in the cluster.
 	Hosts []*Host `json:"hosts"`
+	// The progress of the current Control Plane upgrade, if any.
+	Upgrade *ClusterUpgradeStatus `json:"upgrade,omitempty"`
 }
 
 type ClusterCredentials struct {
@@ -307,8 +511,44 @@ type ClusterJoinToken struct {
 }
 
 type ClusterStatus struct {
-	// The current state of the cluster.
+	// The current state of the cluster. The state is starting when the host that
+	// served the request hasn't finished starting up, such as while it waits for
+	// the other hosts to be upgraded.
+	State string `json:"state"`
+}
+
+type ClusterUpgradeHost struct {
+	// The ID of the host.
+	HostID Identifier `json:"host_id"`
+	// The Control Plane version that's running on the host.
+	Version *string `json:"version,omitempty"`
+	// The newest data migration that the host's version supports.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version that the host's version supports.
+	StateVersion *string `json:"state_version,omitempty"`
+	// True if the host's version can read the current data.
+	Compatible bool `json:"compatible"`
+	// True if the host's version supports every change that's supported by the
+	// newest version in the cluster.
+	Upgraded bool `json:"upgraded"`
+}
+
+type ClusterUpgradeStatus struct {
+	// Whether every host has been upgraded and every pending change has been
+	// applied.
 	State string `json:"state"`
+	// The most recent data migration that's been applied.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version in the data.
+	StateVersion *string `json:"state_version,omitempty"`
+	// Data migrations that are supported by the newest version in the cluster but
+	// haven't been applied. Upgraded hosts apply them once every host supports
+	// them.
+	PendingMigrations []string `json:"pending_migrations"`
+	// The upgrade status of each host.
+	Hosts []*ClusterUpgradeHost `json:"hosts"`
+	// Hosts that can't read the current data or that are holding back the upgrade.
+	Incompatibilities []string `json:"incompatibilities"`
 }
 
 type ComponentStatus struct {
@@ -329,6 +569,9 @@ type CreateDatabaseRequest struct {
 	TenantID *Identifier `json:"tenant_id,omitempty"`
 	// The specification for the database.
 	Spec *DatabaseSpec `json:"spec"`
+	// An external Postgres database to import into the new database. The database
+	// must have exactly one node during the import.
+	Source *ImportSourceSpec `json:"source,omitempty"`
 }
 
 // CreateDatabaseResponse is the result type of the control-plane service
@@ -340,6 +583,43 @@ type CreateDatabaseResponse struct {
 	Database *Database `json:"database"`
 }
 
+// CreateWebhookPayload is the payload type of the control-plane service
+// create-webhook method.
+type CreateWebhookPayload struct {
+	Request *CreateWebhookRequest
+}
+
+type CreateWebhookRequest struct {
+	// The URL to send events to. Must use the http or https scheme.
+	URL string `json:"url"`
+	// An optional description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. A random secret is generated if this
+	// is omitted. It's only returned in the create-webhook response.
+	Secret *string `json:"secret,omitempty"`
+	// Only deliver events of these types. All event types are delivered by default.
+	EventTypes []WebhookEventType `json:"event_types,omitempty"`
+	// Only deliver events for these databases. Events that aren't specific to a
+	// database, such as host_unreachable, are not delivered when this is set.
+	DatabaseIds []Identifier `json:"database_ids,omitempty"`
+}
+
+// CutoverDatabaseImportPayload is the payload type of the control-plane
+// service cutover-database-import method.
+type CutoverDatabaseImportPayload struct {
+	// ID of the database to cut over.
+	DatabaseID Identifier
+}
+
+// CutoverDatabaseImportResponse is the result type of the control-plane
+// service cutover-database-import method.
+type CutoverDatabaseImportResponse struct {
+	// The task that will cut over the import.
+	Task *Task `json:"task"`
+	// The database being cut over.
+	Database *Database `json:"database"`
+}
+
 // Database is the result type of the control-plane service get-database method.
 type Database struct {
 	// Unique identifier for the database.
@@ -352,6 +632,10 @@ type Database struct {
 	UpdatedAt string `json:"updated_at"`
 	// Current state of the database.
 	State string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
 	// All of the instances in the database.
 	Instances []*Instance `json:"instances,omitempty"`
 	// Service instances running alongside this database.
@@ -361,6 +645,9 @@ type Database struct {
 	// Newer stable image versions available in the same Postgres major / Spock
 	// major bucket. Present only when ?include=available_upgrades is set.
 	AvailableUpgrades []*AvailableUpgrade `json:"available_upgrades,omitempty"`
+	// The result of the most recent drift check. Absent if the database has not
+	// been checked.
+	Drift *DatabaseDrift `json:"drift,omitempty"`
 }
 
 // Controls how the service connects to the database. When omitted, all nodes
@@ -376,6 +663,19 @@ type DatabaseConnection struct {
 	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
 }
 
+// The result of a drift check, which compares the database's resources against
+// its spec.
+type DatabaseDrift struct {
+	// The time that the database was checked.
+	CheckedAt string `json:"checked_at"`
+	// The resources that no longer match the database's spec.
+	Resources []*DriftedResource `json:"resources"`
+	// The error that prevented the check from completing, if any.
+	Error *string `json:"error,omitempty"`
+	// The ID of the update task that was started to remediate the drift, if any.
+	RemediationTaskID *string `json:"remediation_task_id,omitempty"`
+}
+
 type DatabaseNodeSpec struct {
 	// The name of the database node.
 	Name string `json:"name"`
@@ -428,6 +728,9 @@ type DatabaseNodeSpec struct {
 	// The name of the source node to use for sync. This is typically the node
 	// (like 'n1') from which the data will be copied to initialize this new node.
 	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettings `json:"patroni,omitempty"`
 }
 
 type DatabaseScripts struct {
@@ -499,6 +802,24 @@ type DatabaseSpec struct {
 	// creation process. Once a database has been successfully created, changes to
 	// these scripts will have no effect.
 	Scripts *DatabaseScripts `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettings `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettings `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
 }
 
 type DatabaseSummary struct {
@@ -512,6 +833,10 @@ type DatabaseSummary struct {
 	UpdatedAt string `json:"updated_at"`
 	// Current state of the database.
 	State string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
 	// All of the instances in the database.
 	Instances []*Instance `json:"instances,omitempty"`
 	// Newer stable image versions available in the same Postgres major / Spock
@@ -534,6 +859,20 @@ type DatabaseUserSpec struct {
 	Roles []string `json:"roles,omitempty"`
 }
 
+// DefragmentEtcdRequest is the payload type of the control-plane service
+// defragment-etcd method.
+type DefragmentEtcdRequest struct {
+	// The hosts to defragment. Defaults to every Etcd server in the cluster.
+	HostIds []Identifier `json:"host_ids,omitempty"`
+}
+
+// DefragmentEtcdResponse is the result type of the control-plane service
+// defragment-etcd method.
+type DefragmentEtcdResponse struct {
+	// The result for each host, in the order that they were defragmented.
+	Results []*EtcdDefragmentResult `json:"results"`
+}
+
 // DeleteDatabasePayload is the payload type of the control-plane service
 // delete-database method.
 type DeleteDatabasePayload struct {
@@ -550,6 +889,47 @@ type DeleteDatabaseResponse struct {
 	Task *Task `json:"task"`
 }
 
+// DeleteWebhookPayload is the payload type of the control-plane service
+// delete-webhook method.
+type DeleteWebhookPayload struct {
+	// ID of the webhook to delete.
+	WebhookID string
+}
+
+// A resource that no longer matches the database's spec.
+type DriftedResource struct {
+	// The type and ID of the resource.
+	ResourceID string `json:"resource_id"`
+	// The change that an update would make to the resource.
+	Type string `json:"type"`
+	// Why the resource would be changed.
+	Reason *string `json:"reason,omitempty"`
+	// The JSON Patch operations that would bring the resource in line with the
+	// spec. Only present for updates.
+	Diff []map[string]any `json:"diff,omitempty"`
+}
+
+// EtcdCluster is the result type of the control-plane service get-etcd-cluster
+// method.
+type EtcdCluster struct {
+	// The members of the Etcd cluster, sorted by host ID.
+	Members []*EtcdMember `json:"members"`
+	// The host ID of the raft leader. Empty if the cluster has no leader.
+	Leader *string `json:"leader,omitempty"`
+	// The number of voting members.
+	Voters int `json:"voters"`
+	// The number of learners.
+	Learners int `json:"learners"`
+	// The number of voters that must be available for the cluster to accept writes.
+	Quorum int `json:"quorum"`
+	// The number of voters that can fail before the cluster loses quorum.
+	FailureTolerance int `json:"failure_tolerance"`
+	// Active Etcd alarms, such as NOSPACE.
+	Alarms []string `json:"alarms,omitempty"`
+	// Problems with the cluster's quorum or members.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 type EtcdClusterMember struct {
 	// The name of the Etcd cluster member.
 	Name string `json:"name"`
@@ -559,6 +939,81 @@ type EtcdClusterMember struct {
 	ClientUrls []string `json:"client_urls"`
 }
 
+type EtcdDefragmentResult struct {
+	// The ID of the host that was defragmented.
+	HostID Identifier `json:"host_id"`
+	// The size of the member's database on disk before defragmentation.
+	DbSizeBeforeBytes int64 `json:"db_size_before_bytes"`
+	// The size of the member's database on disk after defragmentation.
+	DbSizeAfterBytes *int64 `json:"db_size_after_bytes,omitempty"`
+	// The reason that the member couldn't be defragmented.
+	Error *string `json:"error,omitempty"`
+}
+
+type EtcdMember struct {
+	// The hexadecimal Etcd member ID.
+	ID string `json:"id"`
+	// The ID of the host that runs this member.
+	HostID Identifier `json:"host_id"`
+	// Voters count toward quorum. Learners receive updates, but don't vote until
+	// they're promoted.
+	Role string `json:"role"`
+	// True if this member is the raft leader.
+	IsLeader bool `json:"is_leader"`
+	// True if this member responded to a status request.
+	Reachable bool `json:"reachable"`
+	// The member's peer URLs.
+	PeerUrls []string `json:"peer_urls"`
+	// The member's client URLs.
+	ClientUrls []string `json:"client_urls"`
+	// The member's Etcd version.
+	Version *string `json:"version,omitempty"`
+	// The size of the member's database on disk, including space that Etcd can
+	// reuse.
+	DbSizeBytes *int64 `json:"db_size_bytes,omitempty"`
+	// The portion of the member's database that's in use.
+	DbSizeInUseBytes *int64 `json:"db_size_in_use_bytes,omitempty"`
+	// The member's current raft term.
+	RaftTerm *uint64 `json:"raft_term,omitempty"`
+	// The member's committed raft index.
+	RaftIndex *uint64 `json:"raft_index,omitempty"`
+	// The member's applied raft index.
+	RaftAppliedIndex *uint64 `json:"raft_applied_index,omitempty"`
+	// The number of raft entries that this member has yet to apply relative to the
+	// leader.
+	RaftIndexLag uint64 `json:"raft_index_lag"`
+	// Errors reported by or for this member.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Event is the result type of the control-plane service stream-events method.
+type Event struct {
+	// The etcd revision of the change that produced this event. Events from the
+	// same change share an ID.
+	ID string `json:"id"`
+	// The kind of event. Determines which of the database, instance, task, or
+	// task_log_entry fields is set. Heartbeat events are sent when the stream
+	// opens and periodically afterward.
+	Kind string `json:"kind"`
+	// The time that the server observed the change.
+	Timestamp string `json:"timestamp"`
+	// The database that this event relates to, if any.
+	DatabaseID *Identifier `json:"database_id,omitempty"`
+	// The host that this event relates to, if known.
+	HostID *Identifier `json:"host_id,omitempty"`
+	// The task that this event relates to. Only set for task and task_log events.
+	TaskID *string `json:"task_id,omitempty"`
+	// The database after a state transition. Only set for database events.
+	// Instances are omitted.
+	Database *DatabaseSummary `json:"database,omitempty"`
+	// The instance after a state or status change. Only set for instance events.
+	Instance *Instance `json:"instance,omitempty"`
+	// The task after a status change. Only set for task events.
+	Task *Task `json:"task,omitempty"`
+	// A new task log entry. Only set for task_log events.
+	TaskLogEntry *TaskLogEntry `json:"task_log_entry,omitempty"`
+}
+
 // Describes an additional Docker network to attach the container to.
 type ExtraNetworkSpec struct {
 	// The name or ID of the network to connect to.
@@ -660,6 +1115,75 @@ type GetHostTaskPayload struct {
 	TaskID string
 }
 
+// GetInstancePostgreSQLConfResponse is the result type of the control-plane
+// service get-instance-postgresql-conf method.
+type GetInstancePostgreSQLConfResponse struct {
+	// The instance's effective settings, sorted by name.
+	Settings []*PostgreSQLSetting `json:"settings"`
+}
+
+// GetInstancePostgresqlConfPayload is the payload type of the control-plane
+// service get-instance-postgresql-conf method.
+type GetInstancePostgresqlConfPayload struct {
+	// The ID of the database that owns the instance.
+	DatabaseID Identifier
+	// The ID of the instance.
+	InstanceID string
+}
+
+// GetInstanceSessionsPayload is the payload type of the control-plane service
+// get-instance-sessions method.
+type GetInstanceSessionsPayload struct {
+	// The ID of the database that owns the instance.
+	DatabaseID Identifier
+	// The ID of the instance.
+	InstanceID string
+}
+
+// GetInstanceSessionsResponse is the result type of the control-plane service
+// get-instance-sessions method.
+type GetInstanceSessionsResponse struct {
+	// The client sessions, sorted by process ID.
+	Sessions []*InstanceSession `json:"sessions"`
+	// Each chain of blocked sessions, as process IDs ordered from the session at
+	// the root of the chain to a blocked session that isn't blocking any others.
+	BlockingChains [][]int `json:"blocking_chains"`
+}
+
+// GetNodeQueryStatsPayload is the payload type of the control-plane service
+// get-node-query-stats method.
+type GetNodeQueryStatsPayload struct {
+	// ID of the database.
+	DatabaseID Identifier
+	// Name of the node.
+	NodeName string
+	// The statistic to sort the statements by, in descending order.
+	SortBy string
+	// Maximum number of statements to return for each instance.
+	Limit *int
+}
+
+// GetNodeQueryStatsResponse is the result type of the control-plane service
+// get-node-query-stats method.
+type GetNodeQueryStatsResponse struct {
+	// The query statistics for each instance, sorted by instance ID.
+	Instances []*InstanceQueryStats `json:"instances"`
+}
+
+// GetStorageUsagePayload is the payload type of the control-plane service
+// get-storage-usage method.
+type GetStorageUsagePayload struct {
+	// The number of key levels to group by. Defaults to 1.
+	Depth *int
+}
+
+// GetWebhookPayload is the payload type of the control-plane service
+// get-webhook method.
+type GetWebhookPayload struct {
+	// ID of the webhook to get.
+	WebhookID string
+}
+
 // Health check result for a service instance.
 type HealthCheckResult struct {
 	// The health status.
@@ -713,6 +1237,8 @@ type HostStatus struct {
 	UpdatedAt string `json:"updated_at"`
 	// The status of each component of the host.
 	Components map[string]*ComponentStatus `json:"components"`
+	// The Control Plane version that's running on the host.
+	Version *VersionInfo `json:"version,omitempty"`
 }
 
 // A user-specified identifier. Must be 1-36 characters, contain only
@@ -720,6 +1246,17 @@ type HostStatus struct {
 // not contain consecutive hyphens.
 type Identifier string
 
+// An external Postgres database that's imported without downtime. The source's
+// roles and schema are copied into the new database, and then its data is
+// streamed with native logical replication until the import is cut over. The
+// source must have wal_level set to logical, and the user in the connection
+// string must be a superuser.
+type ImportSourceSpec struct {
+	// The connection string for the source database. It must be reachable from the
+	// Control Plane hosts and from the database's instances.
+	Dsn string `json:"dsn"`
+}
+
 // InitClusterRequest is the payload type of the control-plane service
 // init-cluster method.
 type InitClusterRequest struct {
@@ -748,6 +1285,8 @@ type Instance struct {
 	Postgres *InstancePostgresStatus `json:"postgres,omitempty"`
 	// Spock status information for the instance.
 	Spock *InstanceSpockStatus `json:"spock,omitempty"`
+	// Resource usage information for the instance.
+	Resources *InstanceResourceUsage `json:"resources,omitempty"`
 	// An error message if the instance is in an error state.
 	Error *string `json:"error,omitempty"`
 }
@@ -772,6 +1311,82 @@ type InstancePostgresStatus struct {
 	PatroniPaused *bool `json:"patroni_paused,omitempty"`
 }
 
+// The most recently collected query statistics for an instance.
+type InstanceQueryStats struct {
+	// The ID of the instance.
+	InstanceID string `json:"instance_id"`
+	// The time that the statistics were collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+	// The time of the oldest retained collection, which is the start of the window
+	// for the window_* fields.
+	WindowStart *string `json:"window_start,omitempty"`
+	// The collected statements.
+	Queries []*QueryStat `json:"queries"`
+}
+
+// Resource usage information for a pgEdge instance.
+type InstanceResourceUsage struct {
+	// The total size of the instance's data directory in bytes.
+	DataDirectoryBytes *int64 `json:"data_directory_bytes,omitempty"`
+	// The total size of the instance's WAL directory in bytes.
+	WalDirectoryBytes *int64 `json:"wal_directory_bytes,omitempty"`
+	// The free space on the volume that contains the data directory in bytes.
+	VolumeFreeBytes *int64 `json:"volume_free_bytes,omitempty"`
+	// The total size of the volume that contains the data directory in bytes.
+	VolumeTotalBytes *int64 `json:"volume_total_bytes,omitempty"`
+	// The size of the database in bytes, as reported by pg_database_size.
+	DatabaseSizeBytes *int64 `json:"database_size_bytes,omitempty"`
+	// The number of client connections to the instance.
+	Connections *int `json:"connections,omitempty"`
+	// The instance's max_connections setting.
+	MaxConnections *int `json:"max_connections,omitempty"`
+	// The CPU usage of the instance's container or unit as a percentage of a
+	// single CPU.
+	CPUPercent *float64 `json:"cpu_percent,omitempty"`
+	// The memory usage of the instance's container or unit in bytes.
+	MemoryBytes *int64 `json:"memory_bytes,omitempty"`
+	// The memory limit of the instance's container or unit in bytes.
+	MemoryLimitBytes *int64 `json:"memory_limit_bytes,omitempty"`
+	// True if the volume usage exceeds the configured disk-full threshold.
+	DiskFull *bool `json:"disk_full,omitempty"`
+	// The time that the resource usage was collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+}
+
+// A client session on an instance, from pg_stat_activity.
+type InstanceSession struct {
+	// The process ID of the session.
+	Pid int `json:"pid"`
+	// The user that the session is logged in as.
+	Username *string `json:"username,omitempty"`
+	// The database that the session is connected to.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// The application name that the client provided.
+	ApplicationName string `json:"application_name"`
+	// The address of the client.
+	ClientAddr *string `json:"client_addr,omitempty"`
+	// The state of the session, such as active or idle in transaction.
+	State *string `json:"state,omitempty"`
+	// The type of event that the session is waiting for.
+	WaitEventType *string `json:"wait_event_type,omitempty"`
+	// The event that the session is waiting for.
+	WaitEvent *string `json:"wait_event,omitempty"`
+	// The time that the session connected.
+	BackendStart *string `json:"backend_start,omitempty"`
+	// The time that the session's current transaction started.
+	XactStart *string `json:"xact_start,omitempty"`
+	// The time that the session's current or most recent query started.
+	QueryStart *string `json:"query_start,omitempty"`
+	// The time that the session's state last changed.
+	StateChange *string `json:"state_change,omitempty"`
+	// The session's current or most recent query, truncated to 1024 characters.
+	Query string `json:"query"`
+	// The process IDs of the sessions that are blocking this session.
+	BlockedBy []int `json:"blocked_by,omitempty"`
+	// The locks that this session holds or is waiting for.
+	Locks []*SessionLock `json:"locks,omitempty"`
+}
+
 // Spock status information for a pgEdge instance.
 type InstanceSpockStatus struct {
 	// The current spock.readonly setting.
@@ -818,6 +1433,22 @@ type ListDatabasesPayload struct {
 	// Optional fields to include in each database response. Supported values:
 	// available_upgrades.
 	Include []string
+	// Maximum number of databases to return.
+	Limit *int
+	// Returns databases after this cursor. Use the next_cursor value from a
+	// previous response.
+	Cursor *Identifier
+	// Only return databases that belong to this tenant.
+	TenantID *Identifier
+	// Only return databases in one of these states.
+	State []string
+	// Only return databases with an instance on this host.
+	HostID *Identifier
+	// Only return databases that use this Postgres version. Partial versions match
+	// by prefix, so '17' matches '17.6'.
+	PostgresVersion *string
+	// The summary view omits instances and service instances from each database.
+	View string
 }
 
 // ListDatabasesResponse is the result type of the control-plane service
@@ -825,6 +1456,8 @@ type ListDatabasesPayload struct {
 type ListDatabasesResponse struct {
 	// The databases managed by this cluster.
 	Databases []*DatabaseSummary `json:"databases"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // ListHostTasksPayload is the payload type of the control-plane service
@@ -847,11 +1480,28 @@ type ListHostTasksResponse struct {
 	Tasks []*Task `json:"tasks"`
 }
 
+// ListHostsPayload is the payload type of the control-plane service list-hosts
+// method.
+type ListHostsPayload struct {
+	// Maximum number of hosts to return.
+	Limit *int
+	// Returns hosts after this cursor. Use the next_cursor value from a previous
+	// response.
+	Cursor *Identifier
+	// Only return hosts in one of these states.
+	State []string
+	// Only return hosts that support this Postgres version. Partial versions match
+	// by prefix, so '17' matches '17.6'.
+	PostgresVersion *string
+}
+
 // ListHostsResponse is the result type of the control-plane service list-hosts
 // method.
 type ListHostsResponse struct {
 	// List of hosts in the cluster
 	Hosts []*Host `json:"hosts"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // ListTasksPayload is the payload type of the control-plane service list-tasks
@@ -876,12 +1526,91 @@ type ListTasksResponse struct {
 	Tasks []*Task `json:"tasks"`
 }
 
+// ListWebhookDeliveriesPayload is the payload type of the control-plane
+// service list-webhook-deliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// ID of the webhook.
+	WebhookID string
+	// Maximum number of deliveries to return.
+	Limit int
+}
+
+// ListWebhookDeliveriesResponse is the result type of the control-plane
+// service list-webhook-deliveries method.
+type ListWebhookDeliveriesResponse struct {
+	// The webhook's most recent deliveries, newest first.
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+// ListWebhooksResponse is the result type of the control-plane service
+// list-webhooks method.
+type ListWebhooksResponse struct {
+	// The webhooks in the cluster, oldest first.
+	Webhooks []*Webhook `json:"webhooks"`
+}
+
+// A daily window, in UTC, during which disruptive operations are allowed to
+// run. A window whose end is before its start spans midnight.
+type MaintenanceWindow struct {
+	// The time of day, in UTC, when the window opens.
+	Start string `json:"start"`
+	// The time of day, in UTC, when the window closes.
+	End string `json:"end"`
+}
+
+type MajorUpgradeNodeSpec struct {
+	// The name of the node to replace.
+	Name string `json:"name"`
+	// The IDs of the hosts for the replacement node.
+	HostIds []Identifier `json:"host_ids"`
+}
+
 // Options specific to the selected orchestrator.
 type OrchestratorOpts struct {
 	// Swarm-specific configuration.
 	Swarm *SwarmOpts `json:"swarm,omitempty"`
 }
 
+// Settings that control how Patroni detects failures and chooses a new
+// primary. Unset fields use control-plane's defaults.
+type PatroniSettings struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdog `json:"watchdog,omitempty"`
+}
+
+type PatroniWatchdog struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
 type PgEdgeVersion struct {
 	// The Postgres major and minor version.
 	PostgresVersion string `json:"postgres_version"`
@@ -899,6 +1628,69 @@ type PortMapping struct {
 	HostPort *int `json:"host_port,omitempty"`
 }
 
+// A change to an instance's effective postgresql.conf.
+type PostgreSQLConfChange struct {
+	// The ID of the instance whose setting will change.
+	InstanceID string `json:"instance_id"`
+	// The name of the setting.
+	Name string `json:"name"`
+	// The current value of the setting, if it's currently set.
+	OldValue *string `json:"old_value,omitempty"`
+	// The new value of the setting, if it will still be set.
+	NewValue *string `json:"new_value,omitempty"`
+	// True if the instance must be restarted for this change to take effect.
+	RequiresRestart bool `json:"requires_restart"`
+}
+
+// A setting from an instance's effective postgresql.conf.
+type PostgreSQLSetting struct {
+	// The name of the setting.
+	Name string `json:"name"`
+	// The value of the setting.
+	Value string `json:"value"`
+	// Where the value comes from. Tunable settings are derived from the instance's
+	// memory, CPUs, and cluster size.
+	Source string `json:"source"`
+	// True if the instance must be restarted for this setting to take effect.
+	PendingRestart *bool `json:"pending_restart,omitempty"`
+}
+
+// Statistics for a single statement from pg_stat_statements.
+type QueryStat struct {
+	// The statement's query ID.
+	QueryID int64 `json:"query_id"`
+	// The normalized text of the statement, truncated to 1024 characters.
+	Query string `json:"query"`
+	// The number of times the statement has been executed.
+	Calls int64 `json:"calls"`
+	// The total time spent executing the statement, in milliseconds.
+	TotalTimeMs float64 `json:"total_time_ms"`
+	// The mean time spent executing the statement, in milliseconds.
+	MeanTimeMs float64 `json:"mean_time_ms"`
+	// The total number of rows retrieved or affected by the statement.
+	Rows int64 `json:"rows"`
+	// The number of calls since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowCalls *int64 `json:"window_calls,omitempty"`
+	// The execution time since the start of the window, in milliseconds. Absent if
+	// the statement was not collected at the start of the window.
+	WindowTotalTimeMs *float64 `json:"window_total_time_ms,omitempty"`
+	// The number of rows since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowRows *int64 `json:"window_rows,omitempty"`
+}
+
+// Settings that control the collection of query statistics from
+// pg_stat_statements.
+type QueryStatsSettings struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
 // RemoveHostPayload is the payload type of the control-plane service
 // remove-host method.
 type RemoveHostPayload struct {
@@ -918,6 +1710,22 @@ type RemoveHostResponse struct {
 	UpdateDatabaseTasks []*Task `json:"update_database_tasks"`
 }
 
+// ResetNodeQueryStatsPayload is the payload type of the control-plane service
+// reset-node-query-stats method.
+type ResetNodeQueryStatsPayload struct {
+	// ID of the database.
+	DatabaseID Identifier
+	// Name of the node.
+	NodeName string
+}
+
+// ResetNodeQueryStatsResponse is the result type of the control-plane service
+// reset-node-query-stats method.
+type ResetNodeQueryStatsResponse struct {
+	// The IDs of the instances whose statistics were reset.
+	InstanceIds []string `json:"instance_ids"`
+}
+
 // RestartInstancePayload is the payload type of the control-plane service
 // restart-instance method.
 type RestartInstancePayload struct {
@@ -1021,6 +1829,44 @@ type RestoreRepositorySpec struct {
 	CustomOptions map[string]string `json:"custom_options,omitempty"`
 }
 
+// ResumeDatabaseTaskPayload is the payload type of the control-plane service
+// resume-database-task method.
+type ResumeDatabaseTaskPayload struct {
+	// ID of the database that owns the task.
+	DatabaseID Identifier
+	// ID of the task to resume.
+	TaskID Identifier
+}
+
+// RollingRestartDatabasePayload is the payload type of the control-plane
+// service rolling-restart-database method.
+type RollingRestartDatabasePayload struct {
+	// ID of the database to restart.
+	DatabaseID Identifier
+	Request    *RollingRestartDatabaseRequest
+}
+
+type RollingRestartDatabaseRequest struct {
+	// Restart every instance. By default, only instances with settings that are
+	// waiting for a restart are restarted.
+	AllInstances bool `json:"all_instances,omitempty"`
+	// The number of nodes to restart at the same time. Instances within a node are
+	// always restarted one at a time.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Switch each node back to its original primary after the original primary is
+	// restarted.
+	SwitchBack bool `json:"switch_back,omitempty"`
+	// When set, each restart and switchover waits until the window is open.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+// RollingRestartDatabaseResponse is the result type of the control-plane
+// service rolling-restart-database method.
+type RollingRestartDatabaseResponse struct {
+	// The task that will perform the rolling restart.
+	Task *Task `json:"task"`
+}
+
 // Each element of this array is an individual SQL statement.
 type SQLScript []string
 
@@ -1097,6 +1943,27 @@ type ServiceSpec struct {
 	ConnectAs string `json:"connect_as"`
 }
 
+// A lock that a session holds or is waiting for.
+type SessionLock struct {
+	// The type of the locked object.
+	LockType string `json:"lock_type"`
+	// The name of the locked relation. Only set for relations in the instance's
+	// database.
+	Relation *string `json:"relation,omitempty"`
+	// The lock mode.
+	Mode string `json:"mode"`
+	// True if the lock is held, false if the session is waiting for it.
+	Granted bool `json:"granted"`
+}
+
+// SignalInstanceSessionResponse is the result type of the control-plane
+// service cancel-instance-session method.
+type SignalInstanceSessionResponse struct {
+	// Task representing the operation. The session's details are recorded in the
+	// task's log.
+	Task *Task `json:"task"`
+}
+
 // StartInstancePayload is the payload type of the control-plane service
 // start-instance method.
 type StartInstancePayload struct {
@@ -1131,6 +1998,41 @@ type StopInstanceResponse struct {
 	Task *Task `json:"task"`
 }
 
+type StoragePrefixUsage struct {
+	// The key prefix.
+	Prefix string `json:"prefix"`
+	// The number of keys under this prefix.
+	Keys int64 `json:"keys"`
+	// The total size of the keys and values under this prefix.
+	Bytes int64 `json:"bytes"`
+}
+
+// StorageUsage is the result type of the control-plane service
+// get-storage-usage method.
+type StorageUsage struct {
+	// The size of the Etcd database on disk, including space that Etcd can reuse.
+	DbSizeBytes int64 `json:"db_size_bytes"`
+	// The portion of the Etcd database that's in use.
+	DbSizeInUseBytes int64 `json:"db_size_in_use_bytes"`
+	// The total number of Control Plane keys.
+	Keys int64 `json:"keys"`
+	// The total size of the Control Plane's keys and values.
+	Bytes int64 `json:"bytes"`
+	// Usage for each key prefix, largest first.
+	Prefixes []*StoragePrefixUsage `json:"prefixes"`
+}
+
+// StreamEventsPayload is the payload type of the control-plane service
+// stream-events method.
+type StreamEventsPayload struct {
+	// Only stream events for this database.
+	DatabaseID *Identifier
+	// Only stream events for databases, instances, and tasks on this host.
+	HostID *Identifier
+	// Only stream events of these kinds. All kinds are streamed by default.
+	Kind []string
+}
+
 // Docker Swarm-specific options.
 type SwarmOpts struct {
 	// A list of extra volumes to mount. Each entry defines a host and container
@@ -1197,6 +2099,9 @@ type Task struct {
 	Type string `json:"type"`
 	// The status of the task.
 	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -1229,6 +2134,17 @@ type TaskLogEntry struct {
 	Fields map[string]any `json:"fields,omitempty"`
 }
 
+// TerminateInstanceSessionPayload is the payload type of the control-plane
+// service terminate-instance-session method.
+type TerminateInstanceSessionPayload struct {
+	// The ID of the database that owns the instance.
+	DatabaseID Identifier
+	// The ID of the instance.
+	InstanceID string
+	// The process ID of the session.
+	Pid int
+}
+
 // UpdateDatabasePayload is the payload type of the control-plane service
 // update-database method.
 type UpdateDatabasePayload struct {
@@ -1256,6 +2172,38 @@ type UpdateDatabaseResponse struct {
 	Task *Task `json:"task"`
 	// The database being updated.
 	Database *Database `json:"database"`
+	// The postgresql.conf settings that will change on existing instances, such as
+	// tunable settings that are affected by memory or CPU changes.
+	PostgresqlConfChanges []*PostgreSQLConfChange `json:"postgresql_conf_changes,omitempty"`
+}
+
+// UpgradeDatabaseMajorPayload is the payload type of the control-plane service
+// upgrade-database-major method.
+type UpgradeDatabaseMajorPayload struct {
+	// ID of the database to upgrade.
+	DatabaseID Identifier
+	Request    *UpgradeDatabaseMajorRequest
+}
+
+type UpgradeDatabaseMajorRequest struct {
+	// The Postgres version to upgrade to. Nodes that already run this major
+	// version are skipped, and no node may run a newer major version.
+	PostgresVersion string `json:"postgres_version"`
+	// Places the replacements for these nodes on different hosts. Nodes that are
+	// not listed are replaced on their current hosts.
+	Nodes []*MajorUpgradeNodeSpec `json:"nodes,omitempty"`
+	// Pause the upgrade after each node is replaced. Paused upgrades are continued
+	// with the resume-database-task endpoint.
+	PauseBetweenNodes *bool `json:"pause_between_nodes,omitempty"`
+}
+
+// UpgradeDatabaseMajorResponse is the result type of the control-plane service
+// upgrade-database-major method.
+type UpgradeDatabaseMajorResponse struct {
+	// The task tracking the upgrade operation.
+	Task *Task `json:"task"`
+	// The database being upgraded.
+	Database *Database `json:"database"`
 }
 
 // VersionInfo is the result type of the control-plane service get-version
@@ -1271,6 +2219,58 @@ type VersionInfo struct {
 	Arch string `json:"arch"`
 }
 
+// Webhook is the result type of the control-plane service create-webhook
+// method.
+type Webhook struct {
+	// Unique identifier for this webhook.
+	ID string `json:"id"`
+	// The URL that events are sent to.
+	URL string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []WebhookEventType `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []Identifier `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt string `json:"created_at"`
+}
+
+// A single event sent to a webhook.
+type WebhookDelivery struct {
+	// Unique identifier for this delivery. Sent in the X-Control-Plane-Delivery
+	// header and the payload's delivery_id field.
+	DeliveryID string `json:"delivery_id"`
+	// The webhook that this delivery was sent to.
+	WebhookID string `json:"webhook_id"`
+	// The type of event that was delivered.
+	EventType WebhookEventType `json:"event_type"`
+	// The status of this delivery. Pending deliveries are waiting for their first
+	// attempt or for a retry. Failed deliveries have used all of their attempts.
+	Status string `json:"status"`
+	// The number of attempts made so far.
+	Attempts int `json:"attempts"`
+	// The HTTP status code from the most recent attempt. Omitted if no response
+	// was received.
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+	// The error from the most recent attempt, if it failed.
+	LastError *string `json:"last_error,omitempty"`
+	// When the next attempt will be made. Only set for pending deliveries.
+	NextAttemptAt *string `json:"next_attempt_at,omitempty"`
+	// The time that the event occurred.
+	CreatedAt string `json:"created_at"`
+	// The time of the most recent attempt.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// A type of event that can be delivered to a webhook.
+type WebhookEventType string
+
 // Error returns an error description.
 func (e *APIError) Error() string {
 	return "A Control Plane API error."
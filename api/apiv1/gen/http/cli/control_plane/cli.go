@@ -23,7 +23,7 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"control-plane (init-cluster|join-cluster|get-join-token|get-join-options|get-cluster|list-hosts|get-host|remove-host|list-databases|create-database|get-database|update-database|apply-upgrade|delete-database|backup-database-node|switchover-database-node|failover-database-node|list-database-tasks|get-database-task|get-database-task-log|list-host-tasks|get-host-task|get-host-task-log|list-tasks|restore-database|get-version|restart-instance|stop-instance|start-instance|cancel-database-task)",
+		"control-plane (init-cluster|join-cluster|get-join-token|get-join-options|get-cluster|get-storage-usage|get-etcd-cluster|defragment-etcd|list-hosts|get-host|remove-host|change-host-etcd-mode|list-databases|create-database|get-database|update-database|apply-upgrade|upgrade-database-major|cutover-database-import|delete-database|backup-database-node|switchover-database-node|rolling-restart-database|failover-database-node|get-node-query-stats|reset-node-query-stats|list-database-tasks|get-database-task|get-database-task-log|list-host-tasks|get-host-task|get-host-task-log|list-tasks|stream-events|create-webhook|list-webhooks|get-webhook|delete-webhook|list-webhook-deliveries|restore-database|clone-database|get-version|restart-instance|get-instance-sessions|cancel-instance-session|terminate-instance-session|get-instance-postgresql-conf|stop-instance|start-instance|cancel-database-task|resume-database-task)",
 	}
 }
 
@@ -58,7 +58,19 @@ func ParseEndpoint(
 
 		controlPlaneGetClusterFlags = flag.NewFlagSet("get-cluster", flag.ExitOnError)
 
-		controlPlaneListHostsFlags = flag.NewFlagSet("list-hosts", flag.ExitOnError)
+		controlPlaneGetStorageUsageFlags     = flag.NewFlagSet("get-storage-usage", flag.ExitOnError)
+		controlPlaneGetStorageUsageDepthFlag = controlPlaneGetStorageUsageFlags.String("depth", "", "")
+
+		controlPlaneGetEtcdClusterFlags = flag.NewFlagSet("get-etcd-cluster", flag.ExitOnError)
+
+		controlPlaneDefragmentEtcdFlags    = flag.NewFlagSet("defragment-etcd", flag.ExitOnError)
+		controlPlaneDefragmentEtcdBodyFlag = controlPlaneDefragmentEtcdFlags.String("body", "REQUIRED", "")
+
+		controlPlaneListHostsFlags               = flag.NewFlagSet("list-hosts", flag.ExitOnError)
+		controlPlaneListHostsLimitFlag           = controlPlaneListHostsFlags.String("limit", "", "")
+		controlPlaneListHostsCursorFlag          = controlPlaneListHostsFlags.String("cursor", "", "")
+		controlPlaneListHostsStateFlag           = controlPlaneListHostsFlags.String("state", "", "")
+		controlPlaneListHostsPostgresVersionFlag = controlPlaneListHostsFlags.String("postgres-version", "", "")
 
 		controlPlaneGetHostFlags      = flag.NewFlagSet("get-host", flag.ExitOnError)
 		controlPlaneGetHostHostIDFlag = controlPlaneGetHostFlags.String("host-id", "REQUIRED", "ID of the host to get.")
@@ -67,8 +79,19 @@ func ParseEndpoint(
 		controlPlaneRemoveHostHostIDFlag = controlPlaneRemoveHostFlags.String("host-id", "REQUIRED", "ID of the host to remove.")
 		controlPlaneRemoveHostForceFlag  = controlPlaneRemoveHostFlags.String("force", "", "")
 
-		controlPlaneListDatabasesFlags       = flag.NewFlagSet("list-databases", flag.ExitOnError)
-		controlPlaneListDatabasesIncludeFlag = controlPlaneListDatabasesFlags.String("include", "", "")
+		controlPlaneChangeHostEtcdModeFlags      = flag.NewFlagSet("change-host-etcd-mode", flag.ExitOnError)
+		controlPlaneChangeHostEtcdModeBodyFlag   = controlPlaneChangeHostEtcdModeFlags.String("body", "REQUIRED", "")
+		controlPlaneChangeHostEtcdModeHostIDFlag = controlPlaneChangeHostEtcdModeFlags.String("host-id", "REQUIRED", "ID of the host to change.")
+
+		controlPlaneListDatabasesFlags               = flag.NewFlagSet("list-databases", flag.ExitOnError)
+		controlPlaneListDatabasesIncludeFlag         = controlPlaneListDatabasesFlags.String("include", "", "")
+		controlPlaneListDatabasesLimitFlag           = controlPlaneListDatabasesFlags.String("limit", "", "")
+		controlPlaneListDatabasesCursorFlag          = controlPlaneListDatabasesFlags.String("cursor", "", "")
+		controlPlaneListDatabasesTenantIDFlag        = controlPlaneListDatabasesFlags.String("tenant-id", "", "")
+		controlPlaneListDatabasesStateFlag           = controlPlaneListDatabasesFlags.String("state", "", "")
+		controlPlaneListDatabasesHostIDFlag          = controlPlaneListDatabasesFlags.String("host-id", "", "")
+		controlPlaneListDatabasesPostgresVersionFlag = controlPlaneListDatabasesFlags.String("postgres-version", "", "")
+		controlPlaneListDatabasesViewFlag            = controlPlaneListDatabasesFlags.String("view", "full", "")
 
 		controlPlaneCreateDatabaseFlags    = flag.NewFlagSet("create-database", flag.ExitOnError)
 		controlPlaneCreateDatabaseBodyFlag = controlPlaneCreateDatabaseFlags.String("body", "REQUIRED", "")
@@ -87,6 +110,13 @@ func ParseEndpoint(
 		controlPlaneApplyUpgradeBodyFlag       = controlPlaneApplyUpgradeFlags.String("body", "REQUIRED", "")
 		controlPlaneApplyUpgradeDatabaseIDFlag = controlPlaneApplyUpgradeFlags.String("database-id", "REQUIRED", "ID of the database to upgrade.")
 
+		controlPlaneUpgradeDatabaseMajorFlags          = flag.NewFlagSet("upgrade-database-major", flag.ExitOnError)
+		controlPlaneUpgradeDatabaseMajorBodyFlag       = controlPlaneUpgradeDatabaseMajorFlags.String("body", "REQUIRED", "")
+		controlPlaneUpgradeDatabaseMajorDatabaseIDFlag = controlPlaneUpgradeDatabaseMajorFlags.String("database-id", "REQUIRED", "ID of the database to upgrade.")
+
+		controlPlaneCutoverDatabaseImportFlags          = flag.NewFlagSet("cutover-database-import", flag.ExitOnError)
+		controlPlaneCutoverDatabaseImportDatabaseIDFlag = controlPlaneCutoverDatabaseImportFlags.String("database-id", "REQUIRED", "ID of the database to cut over.")
+
 		controlPlaneDeleteDatabaseFlags          = flag.NewFlagSet("delete-database", flag.ExitOnError)
 		controlPlaneDeleteDatabaseDatabaseIDFlag = controlPlaneDeleteDatabaseFlags.String("database-id", "REQUIRED", "ID of the database to delete.")
 		controlPlaneDeleteDatabaseForceFlag      = controlPlaneDeleteDatabaseFlags.String("force", "", "")
@@ -102,11 +132,25 @@ func ParseEndpoint(
 		controlPlaneSwitchoverDatabaseNodeDatabaseIDFlag = controlPlaneSwitchoverDatabaseNodeFlags.String("database-id", "REQUIRED", "ID of the database to operate on.")
 		controlPlaneSwitchoverDatabaseNodeNodeNameFlag   = controlPlaneSwitchoverDatabaseNodeFlags.String("node-name", "REQUIRED", "Name of the node to operate on.")
 
+		controlPlaneRollingRestartDatabaseFlags          = flag.NewFlagSet("rolling-restart-database", flag.ExitOnError)
+		controlPlaneRollingRestartDatabaseBodyFlag       = controlPlaneRollingRestartDatabaseFlags.String("body", "REQUIRED", "")
+		controlPlaneRollingRestartDatabaseDatabaseIDFlag = controlPlaneRollingRestartDatabaseFlags.String("database-id", "REQUIRED", "ID of the database to restart.")
+
 		controlPlaneFailoverDatabaseNodeFlags          = flag.NewFlagSet("failover-database-node", flag.ExitOnError)
 		controlPlaneFailoverDatabaseNodeBodyFlag       = controlPlaneFailoverDatabaseNodeFlags.String("body", "REQUIRED", "")
 		controlPlaneFailoverDatabaseNodeDatabaseIDFlag = controlPlaneFailoverDatabaseNodeFlags.String("database-id", "REQUIRED", "ID of the database to perform the failover for.")
 		controlPlaneFailoverDatabaseNodeNodeNameFlag   = controlPlaneFailoverDatabaseNodeFlags.String("node-name", "REQUIRED", "Name of the node to initiate the failover from.")
 
+		controlPlaneGetNodeQueryStatsFlags          = flag.NewFlagSet("get-node-query-stats", flag.ExitOnError)
+		controlPlaneGetNodeQueryStatsDatabaseIDFlag = controlPlaneGetNodeQueryStatsFlags.String("database-id", "REQUIRED", "ID of the database.")
+		controlPlaneGetNodeQueryStatsNodeNameFlag   = controlPlaneGetNodeQueryStatsFlags.String("node-name", "REQUIRED", "Name of the node.")
+		controlPlaneGetNodeQueryStatsSortByFlag     = controlPlaneGetNodeQueryStatsFlags.String("sort-by", "total_time", "")
+		controlPlaneGetNodeQueryStatsLimitFlag      = controlPlaneGetNodeQueryStatsFlags.String("limit", "", "")
+
+		controlPlaneResetNodeQueryStatsFlags          = flag.NewFlagSet("reset-node-query-stats", flag.ExitOnError)
+		controlPlaneResetNodeQueryStatsDatabaseIDFlag = controlPlaneResetNodeQueryStatsFlags.String("database-id", "REQUIRED", "ID of the database.")
+		controlPlaneResetNodeQueryStatsNodeNameFlag   = controlPlaneResetNodeQueryStatsFlags.String("node-name", "REQUIRED", "Name of the node.")
+
 		controlPlaneListDatabaseTasksFlags           = flag.NewFlagSet("list-database-tasks", flag.ExitOnError)
 		controlPlaneListDatabaseTasksDatabaseIDFlag  = controlPlaneListDatabaseTasksFlags.String("database-id", "REQUIRED", "ID of the database to list tasks for.")
 		controlPlaneListDatabaseTasksAfterTaskIDFlag = controlPlaneListDatabaseTasksFlags.String("after-task-id", "", "")
@@ -146,11 +190,35 @@ func ParseEndpoint(
 		controlPlaneListTasksLimitFlag       = controlPlaneListTasksFlags.String("limit", "", "")
 		controlPlaneListTasksSortOrderFlag   = controlPlaneListTasksFlags.String("sort-order", "", "")
 
+		controlPlaneStreamEventsFlags          = flag.NewFlagSet("stream-events", flag.ExitOnError)
+		controlPlaneStreamEventsDatabaseIDFlag = controlPlaneStreamEventsFlags.String("database-id", "", "")
+		controlPlaneStreamEventsHostIDFlag     = controlPlaneStreamEventsFlags.String("host-id", "", "")
+		controlPlaneStreamEventsKindFlag       = controlPlaneStreamEventsFlags.String("kind", "", "")
+
+		controlPlaneCreateWebhookFlags    = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		controlPlaneCreateWebhookBodyFlag = controlPlaneCreateWebhookFlags.String("body", "REQUIRED", "")
+
+		controlPlaneListWebhooksFlags = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+
+		controlPlaneGetWebhookFlags         = flag.NewFlagSet("get-webhook", flag.ExitOnError)
+		controlPlaneGetWebhookWebhookIDFlag = controlPlaneGetWebhookFlags.String("webhook-id", "REQUIRED", "ID of the webhook to get.")
+
+		controlPlaneDeleteWebhookFlags         = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		controlPlaneDeleteWebhookWebhookIDFlag = controlPlaneDeleteWebhookFlags.String("webhook-id", "REQUIRED", "ID of the webhook to delete.")
+
+		controlPlaneListWebhookDeliveriesFlags         = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		controlPlaneListWebhookDeliveriesWebhookIDFlag = controlPlaneListWebhookDeliveriesFlags.String("webhook-id", "REQUIRED", "ID of the webhook.")
+		controlPlaneListWebhookDeliveriesLimitFlag     = controlPlaneListWebhookDeliveriesFlags.String("limit", "50", "")
+
 		controlPlaneRestoreDatabaseFlags          = flag.NewFlagSet("restore-database", flag.ExitOnError)
 		controlPlaneRestoreDatabaseBodyFlag       = controlPlaneRestoreDatabaseFlags.String("body", "REQUIRED", "")
 		controlPlaneRestoreDatabaseDatabaseIDFlag = controlPlaneRestoreDatabaseFlags.String("database-id", "REQUIRED", "ID of the database to restore.")
 		controlPlaneRestoreDatabaseForceFlag      = controlPlaneRestoreDatabaseFlags.String("force", "", "")
 
+		controlPlaneCloneDatabaseFlags          = flag.NewFlagSet("clone-database", flag.ExitOnError)
+		controlPlaneCloneDatabaseBodyFlag       = controlPlaneCloneDatabaseFlags.String("body", "REQUIRED", "")
+		controlPlaneCloneDatabaseDatabaseIDFlag = controlPlaneCloneDatabaseFlags.String("database-id", "REQUIRED", "ID of the database to clone.")
+
 		controlPlaneGetVersionFlags = flag.NewFlagSet("get-version", flag.ExitOnError)
 
 		controlPlaneRestartInstanceFlags          = flag.NewFlagSet("restart-instance", flag.ExitOnError)
@@ -158,6 +226,24 @@ func ParseEndpoint(
 		controlPlaneRestartInstanceDatabaseIDFlag = controlPlaneRestartInstanceFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
 		controlPlaneRestartInstanceInstanceIDFlag = controlPlaneRestartInstanceFlags.String("instance-id", "REQUIRED", "The ID of the instance to restart.")
 
+		controlPlaneGetInstanceSessionsFlags          = flag.NewFlagSet("get-instance-sessions", flag.ExitOnError)
+		controlPlaneGetInstanceSessionsDatabaseIDFlag = controlPlaneGetInstanceSessionsFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
+		controlPlaneGetInstanceSessionsInstanceIDFlag = controlPlaneGetInstanceSessionsFlags.String("instance-id", "REQUIRED", "The ID of the instance.")
+
+		controlPlaneCancelInstanceSessionFlags          = flag.NewFlagSet("cancel-instance-session", flag.ExitOnError)
+		controlPlaneCancelInstanceSessionDatabaseIDFlag = controlPlaneCancelInstanceSessionFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
+		controlPlaneCancelInstanceSessionInstanceIDFlag = controlPlaneCancelInstanceSessionFlags.String("instance-id", "REQUIRED", "The ID of the instance.")
+		controlPlaneCancelInstanceSessionPidFlag        = controlPlaneCancelInstanceSessionFlags.String("pid", "REQUIRED", "The process ID of the session.")
+
+		controlPlaneTerminateInstanceSessionFlags          = flag.NewFlagSet("terminate-instance-session", flag.ExitOnError)
+		controlPlaneTerminateInstanceSessionDatabaseIDFlag = controlPlaneTerminateInstanceSessionFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
+		controlPlaneTerminateInstanceSessionInstanceIDFlag = controlPlaneTerminateInstanceSessionFlags.String("instance-id", "REQUIRED", "The ID of the instance.")
+		controlPlaneTerminateInstanceSessionPidFlag        = controlPlaneTerminateInstanceSessionFlags.String("pid", "REQUIRED", "The process ID of the session.")
+
+		controlPlaneGetInstancePostgresqlConfFlags          = flag.NewFlagSet("get-instance-postgresql-conf", flag.ExitOnError)
+		controlPlaneGetInstancePostgresqlConfDatabaseIDFlag = controlPlaneGetInstancePostgresqlConfFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
+		controlPlaneGetInstancePostgresqlConfInstanceIDFlag = controlPlaneGetInstancePostgresqlConfFlags.String("instance-id", "REQUIRED", "The ID of the instance.")
+
 		controlPlaneStopInstanceFlags          = flag.NewFlagSet("stop-instance", flag.ExitOnError)
 		controlPlaneStopInstanceDatabaseIDFlag = controlPlaneStopInstanceFlags.String("database-id", "REQUIRED", "The ID of the database that owns the instance.")
 		controlPlaneStopInstanceInstanceIDFlag = controlPlaneStopInstanceFlags.String("instance-id", "REQUIRED", "The ID of the instance to stop.")
@@ -171,6 +257,10 @@ func ParseEndpoint(
 		controlPlaneCancelDatabaseTaskFlags          = flag.NewFlagSet("cancel-database-task", flag.ExitOnError)
 		controlPlaneCancelDatabaseTaskDatabaseIDFlag = controlPlaneCancelDatabaseTaskFlags.String("database-id", "REQUIRED", "ID of the database that owns the task.")
 		controlPlaneCancelDatabaseTaskTaskIDFlag     = controlPlaneCancelDatabaseTaskFlags.String("task-id", "REQUIRED", "ID of the task to cancel.")
+
+		controlPlaneResumeDatabaseTaskFlags          = flag.NewFlagSet("resume-database-task", flag.ExitOnError)
+		controlPlaneResumeDatabaseTaskDatabaseIDFlag = controlPlaneResumeDatabaseTaskFlags.String("database-id", "REQUIRED", "ID of the database that owns the task.")
+		controlPlaneResumeDatabaseTaskTaskIDFlag     = controlPlaneResumeDatabaseTaskFlags.String("task-id", "REQUIRED", "ID of the task to resume.")
 	)
 	controlPlaneFlags.Usage = controlPlaneUsage
 	controlPlaneInitClusterFlags.Usage = controlPlaneInitClusterUsage
@@ -178,18 +268,27 @@ func ParseEndpoint(
 	controlPlaneGetJoinTokenFlags.Usage = controlPlaneGetJoinTokenUsage
 	controlPlaneGetJoinOptionsFlags.Usage = controlPlaneGetJoinOptionsUsage
 	controlPlaneGetClusterFlags.Usage = controlPlaneGetClusterUsage
+	controlPlaneGetStorageUsageFlags.Usage = controlPlaneGetStorageUsageUsage
+	controlPlaneGetEtcdClusterFlags.Usage = controlPlaneGetEtcdClusterUsage
+	controlPlaneDefragmentEtcdFlags.Usage = controlPlaneDefragmentEtcdUsage
 	controlPlaneListHostsFlags.Usage = controlPlaneListHostsUsage
 	controlPlaneGetHostFlags.Usage = controlPlaneGetHostUsage
 	controlPlaneRemoveHostFlags.Usage = controlPlaneRemoveHostUsage
+	controlPlaneChangeHostEtcdModeFlags.Usage = controlPlaneChangeHostEtcdModeUsage
 	controlPlaneListDatabasesFlags.Usage = controlPlaneListDatabasesUsage
 	controlPlaneCreateDatabaseFlags.Usage = controlPlaneCreateDatabaseUsage
 	controlPlaneGetDatabaseFlags.Usage = controlPlaneGetDatabaseUsage
 	controlPlaneUpdateDatabaseFlags.Usage = controlPlaneUpdateDatabaseUsage
 	controlPlaneApplyUpgradeFlags.Usage = controlPlaneApplyUpgradeUsage
+	controlPlaneUpgradeDatabaseMajorFlags.Usage = controlPlaneUpgradeDatabaseMajorUsage
+	controlPlaneCutoverDatabaseImportFlags.Usage = controlPlaneCutoverDatabaseImportUsage
 	controlPlaneDeleteDatabaseFlags.Usage = controlPlaneDeleteDatabaseUsage
 	controlPlaneBackupDatabaseNodeFlags.Usage = controlPlaneBackupDatabaseNodeUsage
 	controlPlaneSwitchoverDatabaseNodeFlags.Usage = controlPlaneSwitchoverDatabaseNodeUsage
+	controlPlaneRollingRestartDatabaseFlags.Usage = controlPlaneRollingRestartDatabaseUsage
 	controlPlaneFailoverDatabaseNodeFlags.Usage = controlPlaneFailoverDatabaseNodeUsage
+	controlPlaneGetNodeQueryStatsFlags.Usage = controlPlaneGetNodeQueryStatsUsage
+	controlPlaneResetNodeQueryStatsFlags.Usage = controlPlaneResetNodeQueryStatsUsage
 	controlPlaneListDatabaseTasksFlags.Usage = controlPlaneListDatabaseTasksUsage
 	controlPlaneGetDatabaseTaskFlags.Usage = controlPlaneGetDatabaseTaskUsage
 	controlPlaneGetDatabaseTaskLogFlags.Usage = controlPlaneGetDatabaseTaskLogUsage
@@ -197,12 +296,24 @@ func ParseEndpoint(
 	controlPlaneGetHostTaskFlags.Usage = controlPlaneGetHostTaskUsage
 	controlPlaneGetHostTaskLogFlags.Usage = controlPlaneGetHostTaskLogUsage
 	controlPlaneListTasksFlags.Usage = controlPlaneListTasksUsage
+	controlPlaneStreamEventsFlags.Usage = controlPlaneStreamEventsUsage
+	controlPlaneCreateWebhookFlags.Usage = controlPlaneCreateWebhookUsage
+	controlPlaneListWebhooksFlags.Usage = controlPlaneListWebhooksUsage
+	controlPlaneGetWebhookFlags.Usage = controlPlaneGetWebhookUsage
+	controlPlaneDeleteWebhookFlags.Usage = controlPlaneDeleteWebhookUsage
+	controlPlaneListWebhookDeliveriesFlags.Usage = controlPlaneListWebhookDeliveriesUsage
 	controlPlaneRestoreDatabaseFlags.Usage = controlPlaneRestoreDatabaseUsage
+	controlPlaneCloneDatabaseFlags.Usage = controlPlaneCloneDatabaseUsage
 	controlPlaneGetVersionFlags.Usage = controlPlaneGetVersionUsage
 	controlPlaneRestartInstanceFlags.Usage = controlPlaneRestartInstanceUsage
+	controlPlaneGetInstanceSessionsFlags.Usage = controlPlaneGetInstanceSessionsUsage
+	controlPlaneCancelInstanceSessionFlags.Usage = controlPlaneCancelInstanceSessionUsage
+	controlPlaneTerminateInstanceSessionFlags.Usage = controlPlaneTerminateInstanceSessionUsage
+	controlPlaneGetInstancePostgresqlConfFlags.Usage = controlPlaneGetInstancePostgresqlConfUsage
 	controlPlaneStopInstanceFlags.Usage = controlPlaneStopInstanceUsage
 	controlPlaneStartInstanceFlags.Usage = controlPlaneStartInstanceUsage
 	controlPlaneCancelDatabaseTaskFlags.Usage = controlPlaneCancelDatabaseTaskUsage
+	controlPlaneResumeDatabaseTaskFlags.Usage = controlPlaneResumeDatabaseTaskUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -253,6 +364,15 @@ func ParseEndpoint(
 			case "get-cluster":
 				epf = controlPlaneGetClusterFlags
 
+			case "get-storage-usage":
+				epf = controlPlaneGetStorageUsageFlags
+
+			case "get-etcd-cluster":
+				epf = controlPlaneGetEtcdClusterFlags
+
+			case "defragment-etcd":
+				epf = controlPlaneDefragmentEtcdFlags
+
 			case "list-hosts":
 				epf = controlPlaneListHostsFlags
 
@@ -262,6 +382,9 @@ func ParseEndpoint(
 			case "remove-host":
 				epf = controlPlaneRemoveHostFlags
 
+			case "change-host-etcd-mode":
+				epf = controlPlaneChangeHostEtcdModeFlags
+
 			case "list-databases":
 				epf = controlPlaneListDatabasesFlags
 
@@ -277,6 +400,12 @@ func ParseEndpoint(
 			case "apply-upgrade":
 				epf = controlPlaneApplyUpgradeFlags
 
+			case "upgrade-database-major":
+				epf = controlPlaneUpgradeDatabaseMajorFlags
+
+			case "cutover-database-import":
+				epf = controlPlaneCutoverDatabaseImportFlags
+
 			case "delete-database":
 				epf = controlPlaneDeleteDatabaseFlags
 
@@ -286,9 +415,18 @@ func ParseEndpoint(
 			case "switchover-database-node":
 				epf = controlPlaneSwitchoverDatabaseNodeFlags
 
+			case "rolling-restart-database":
+				epf = controlPlaneRollingRestartDatabaseFlags
+
 			case "failover-database-node":
 				epf = controlPlaneFailoverDatabaseNodeFlags
 
+			case "get-node-query-stats":
+				epf = controlPlaneGetNodeQueryStatsFlags
+
+			case "reset-node-query-stats":
+				epf = controlPlaneResetNodeQueryStatsFlags
+
 			case "list-database-tasks":
 				epf = controlPlaneListDatabaseTasksFlags
 
@@ -310,15 +448,48 @@ func ParseEndpoint(
 			case "list-tasks":
 				epf = controlPlaneListTasksFlags
 
+			case "stream-events":
+				epf = controlPlaneStreamEventsFlags
+
+			case "create-webhook":
+				epf = controlPlaneCreateWebhookFlags
+
+			case "list-webhooks":
+				epf = controlPlaneListWebhooksFlags
+
+			case "get-webhook":
+				epf = controlPlaneGetWebhookFlags
+
+			case "delete-webhook":
+				epf = controlPlaneDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = controlPlaneListWebhookDeliveriesFlags
+
 			case "restore-database":
 				epf = controlPlaneRestoreDatabaseFlags
 
+			case "clone-database":
+				epf = controlPlaneCloneDatabaseFlags
+
 			case "get-version":
 				epf = controlPlaneGetVersionFlags
 
 			case "restart-instance":
 				epf = controlPlaneRestartInstanceFlags
 
+			case "get-instance-sessions":
+				epf = controlPlaneGetInstanceSessionsFlags
+
+			case "cancel-instance-session":
+				epf = controlPlaneCancelInstanceSessionFlags
+
+			case "terminate-instance-session":
+				epf = controlPlaneTerminateInstanceSessionFlags
+
+			case "get-instance-postgresql-conf":
+				epf = controlPlaneGetInstancePostgresqlConfFlags
+
 			case "stop-instance":
 				epf = controlPlaneStopInstanceFlags
 
@@ -328,6 +499,9 @@ func ParseEndpoint(
 			case "cancel-database-task":
 				epf = controlPlaneCancelDatabaseTaskFlags
 
+			case "resume-database-task":
+				epf = controlPlaneResumeDatabaseTaskFlags
+
 			}
 
 		}
@@ -366,17 +540,29 @@ func ParseEndpoint(
 				data, err = controlplanec.BuildGetJoinOptionsPayload(*controlPlaneGetJoinOptionsBodyFlag)
 			case "get-cluster":
 				endpoint = c.GetCluster()
+			case "get-storage-usage":
+				endpoint = c.GetStorageUsage()
+				data, err = controlplanec.BuildGetStorageUsagePayload(*controlPlaneGetStorageUsageDepthFlag)
+			case "get-etcd-cluster":
+				endpoint = c.GetEtcdCluster()
+			case "defragment-etcd":
+				endpoint = c.DefragmentEtcd()
+				data, err = controlplanec.BuildDefragmentEtcdPayload(*controlPlaneDefragmentEtcdBodyFlag)
 			case "list-hosts":
 				endpoint = c.ListHosts()
+				data, err = controlplanec.BuildListHostsPayload(*controlPlaneListHostsLimitFlag, *controlPlaneListHostsCursorFlag, *controlPlaneListHostsStateFlag, *controlPlaneListHostsPostgresVersionFlag)
 			case "get-host":
 				endpoint = c.GetHost()
 				data, err = controlplanec.BuildGetHostPayload(*controlPlaneGetHostHostIDFlag)
 			case "remove-host":
 				endpoint = c.RemoveHost()
 				data, err = controlplanec.BuildRemoveHostPayload(*controlPlaneRemoveHostHostIDFlag, *controlPlaneRemoveHostForceFlag)
+			case "change-host-etcd-mode":
+				endpoint = c.ChangeHostEtcdMode()
+				data, err = controlplanec.BuildChangeHostEtcdModePayload(*controlPlaneChangeHostEtcdModeBodyFlag, *controlPlaneChangeHostEtcdModeHostIDFlag)
 			case "list-databases":
 				endpoint = c.ListDatabases()
-				data, err = controlplanec.BuildListDatabasesPayload(*controlPlaneListDatabasesIncludeFlag)
+				data, err = controlplanec.BuildListDatabasesPayload(*controlPlaneListDatabasesIncludeFlag, *controlPlaneListDatabasesLimitFlag, *controlPlaneListDatabasesCursorFlag, *controlPlaneListDatabasesTenantIDFlag, *controlPlaneListDatabasesStateFlag, *controlPlaneListDatabasesHostIDFlag, *controlPlaneListDatabasesPostgresVersionFlag, *controlPlaneListDatabasesViewFlag)
 			case "create-database":
 				endpoint = c.CreateDatabase()
 				data, err = controlplanec.BuildCreateDatabasePayload(*controlPlaneCreateDatabaseBodyFlag)
@@ -389,6 +575,12 @@ func ParseEndpoint(
 			case "apply-upgrade":
 				endpoint = c.ApplyUpgrade()
 				data, err = controlplanec.BuildApplyUpgradePayload(*controlPlaneApplyUpgradeBodyFlag, *controlPlaneApplyUpgradeDatabaseIDFlag)
+			case "upgrade-database-major":
+				endpoint = c.UpgradeDatabaseMajor()
+				data, err = controlplanec.BuildUpgradeDatabaseMajorPayload(*controlPlaneUpgradeDatabaseMajorBodyFlag, *controlPlaneUpgradeDatabaseMajorDatabaseIDFlag)
+			case "cutover-database-import":
+				endpoint = c.CutoverDatabaseImport()
+				data, err = controlplanec.BuildCutoverDatabaseImportPayload(*controlPlaneCutoverDatabaseImportDatabaseIDFlag)
 			case "delete-database":
 				endpoint = c.DeleteDatabase()
 				data, err = controlplanec.BuildDeleteDatabasePayload(*controlPlaneDeleteDatabaseDatabaseIDFlag, *controlPlaneDeleteDatabaseForceFlag)
@@ -398,9 +590,18 @@ func ParseEndpoint(
 			case "switchover-database-node":
 				endpoint = c.SwitchoverDatabaseNode()
 				data, err = controlplanec.BuildSwitchoverDatabaseNodePayload(*controlPlaneSwitchoverDatabaseNodeBodyFlag, *controlPlaneSwitchoverDatabaseNodeDatabaseIDFlag, *controlPlaneSwitchoverDatabaseNodeNodeNameFlag)
+			case "rolling-restart-database":
+				endpoint = c.RollingRestartDatabase()
+				data, err = controlplanec.BuildRollingRestartDatabasePayload(*controlPlaneRollingRestartDatabaseBodyFlag, *controlPlaneRollingRestartDatabaseDatabaseIDFlag)
 			case "failover-database-node":
 				endpoint = c.FailoverDatabaseNode()
 				data, err = controlplanec.BuildFailoverDatabaseNodePayload(*controlPlaneFailoverDatabaseNodeBodyFlag, *controlPlaneFailoverDatabaseNodeDatabaseIDFlag, *controlPlaneFailoverDatabaseNodeNodeNameFlag)
+			case "get-node-query-stats":
+				endpoint = c.GetNodeQueryStats()
+				data, err = controlplanec.BuildGetNodeQueryStatsPayload(*controlPlaneGetNodeQueryStatsDatabaseIDFlag, *controlPlaneGetNodeQueryStatsNodeNameFlag, *controlPlaneGetNodeQueryStatsSortByFlag, *controlPlaneGetNodeQueryStatsLimitFlag)
+			case "reset-node-query-stats":
+				endpoint = c.ResetNodeQueryStats()
+				data, err = controlplanec.BuildResetNodeQueryStatsPayload(*controlPlaneResetNodeQueryStatsDatabaseIDFlag, *controlPlaneResetNodeQueryStatsNodeNameFlag)
 			case "list-database-tasks":
 				endpoint = c.ListDatabaseTasks()
 				data, err = controlplanec.BuildListDatabaseTasksPayload(*controlPlaneListDatabaseTasksDatabaseIDFlag, *controlPlaneListDatabaseTasksAfterTaskIDFlag, *controlPlaneListDatabaseTasksLimitFlag, *controlPlaneListDatabaseTasksSortOrderFlag)
@@ -422,14 +623,46 @@ func ParseEndpoint(
 			case "list-tasks":
 				endpoint = c.ListTasks()
 				data, err = controlplanec.BuildListTasksPayload(*controlPlaneListTasksScopeFlag, *controlPlaneListTasksEntityIDFlag, *controlPlaneListTasksAfterTaskIDFlag, *controlPlaneListTasksLimitFlag, *controlPlaneListTasksSortOrderFlag)
+			case "stream-events":
+				endpoint = c.StreamEvents()
+				data, err = controlplanec.BuildStreamEventsPayload(*controlPlaneStreamEventsDatabaseIDFlag, *controlPlaneStreamEventsHostIDFlag, *controlPlaneStreamEventsKindFlag)
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = controlplanec.BuildCreateWebhookPayload(*controlPlaneCreateWebhookBodyFlag)
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+			case "get-webhook":
+				endpoint = c.GetWebhook()
+				data, err = controlplanec.BuildGetWebhookPayload(*controlPlaneGetWebhookWebhookIDFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = controlplanec.BuildDeleteWebhookPayload(*controlPlaneDeleteWebhookWebhookIDFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = controlplanec.BuildListWebhookDeliveriesPayload(*controlPlaneListWebhookDeliveriesWebhookIDFlag, *controlPlaneListWebhookDeliveriesLimitFlag)
 			case "restore-database":
 				endpoint = c.RestoreDatabase()
 				data, err = controlplanec.BuildRestoreDatabasePayload(*controlPlaneRestoreDatabaseBodyFlag, *controlPlaneRestoreDatabaseDatabaseIDFlag, *controlPlaneRestoreDatabaseForceFlag)
+			case "clone-database":
+				endpoint = c.CloneDatabase()
+				data, err = controlplanec.BuildCloneDatabasePayload(*controlPlaneCloneDatabaseBodyFlag, *controlPlaneCloneDatabaseDatabaseIDFlag)
 			case "get-version":
 				endpoint = c.GetVersion()
 			case "restart-instance":
 				endpoint = c.RestartInstance()
 				data, err = controlplanec.BuildRestartInstancePayload(*controlPlaneRestartInstanceBodyFlag, *controlPlaneRestartInstanceDatabaseIDFlag, *controlPlaneRestartInstanceInstanceIDFlag)
+			case "get-instance-sessions":
+				endpoint = c.GetInstanceSessions()
+				data, err = controlplanec.BuildGetInstanceSessionsPayload(*controlPlaneGetInstanceSessionsDatabaseIDFlag, *controlPlaneGetInstanceSessionsInstanceIDFlag)
+			case "cancel-instance-session":
+				endpoint = c.CancelInstanceSession()
+				data, err = controlplanec.BuildCancelInstanceSessionPayload(*controlPlaneCancelInstanceSessionDatabaseIDFlag, *controlPlaneCancelInstanceSessionInstanceIDFlag, *controlPlaneCancelInstanceSessionPidFlag)
+			case "terminate-instance-session":
+				endpoint = c.TerminateInstanceSession()
+				data, err = controlplanec.BuildTerminateInstanceSessionPayload(*controlPlaneTerminateInstanceSessionDatabaseIDFlag, *controlPlaneTerminateInstanceSessionInstanceIDFlag, *controlPlaneTerminateInstanceSessionPidFlag)
+			case "get-instance-postgresql-conf":
+				endpoint = c.GetInstancePostgresqlConf()
+				data, err = controlplanec.BuildGetInstancePostgresqlConfPayload(*controlPlaneGetInstancePostgresqlConfDatabaseIDFlag, *controlPlaneGetInstancePostgresqlConfInstanceIDFlag)
 			case "stop-instance":
 				endpoint = c.StopInstance()
 				data, err = controlplanec.BuildStopInstancePayload(*controlPlaneStopInstanceDatabaseIDFlag, *controlPlaneStopInstanceInstanceIDFlag, *controlPlaneStopInstanceForceFlag)
@@ -439,6 +672,9 @@ func ParseEndpoint(
 			case "cancel-database-task":
 				endpoint = c.CancelDatabaseTask()
 				data, err = controlplanec.BuildCancelDatabaseTaskPayload(*controlPlaneCancelDatabaseTaskDatabaseIDFlag, *controlPlaneCancelDatabaseTaskTaskIDFlag)
+			case "resume-database-task":
+				endpoint = c.ResumeDatabaseTask()
+				data, err = controlplanec.BuildResumeDatabaseTaskPayload(*controlPlaneResumeDatabaseTaskDatabaseIDFlag, *controlPlaneResumeDatabaseTaskTaskIDFlag)
 			}
 		}
 	}
@@ -460,18 +696,27 @@ func controlPlaneUsage() {
 	fmt.Fprintln(os.Stderr, `    get-join-token: Gets the join token for this cluster.`)
 	fmt.Fprintln(os.Stderr, `    get-join-options: Internal endpoint for other cluster members seeking to join this cluster.`)
 	fmt.Fprintln(os.Stderr, `    get-cluster: Returns information about the cluster.`)
+	fmt.Fprintln(os.Stderr, `    get-storage-usage: Returns the amount of Etcd storage used by the Control Plane, grouped by key prefix.`)
+	fmt.Fprintln(os.Stderr, `    get-etcd-cluster: Returns the members of the Control Plane's Etcd cluster with their role, database size, and raft progress, along with the cluster's quorum and failure tolerance.`)
+	fmt.Fprintln(os.Stderr, `    defragment-etcd: Defragments the Etcd database on the given hosts to reclaim the space that Etcd can reuse after compaction. Hosts are defragmented one at a time, and the leader is defragmented last. Each member stops serving requests while it's defragmented.`)
 	fmt.Fprintln(os.Stderr, `    list-hosts: Lists all hosts within the cluster.`)
 	fmt.Fprintln(os.Stderr, `    get-host: Returns information about a particular host in the cluster.`)
 	fmt.Fprintln(os.Stderr, `    remove-host: Removes a host from the cluster.`)
+	fmt.Fprintln(os.Stderr, `    change-host-etcd-mode: Changes whether a host runs an Etcd server or connects to the other hosts as an Etcd client. The host restarts to apply the change. The response includes warnings when the cluster will be unable to tolerate the failure of an Etcd server after the change.`)
 	fmt.Fprintln(os.Stderr, `    list-databases: Lists all databases in the cluster.`)
 	fmt.Fprintln(os.Stderr, `    create-database: Creates a new database in the cluster.`)
 	fmt.Fprintln(os.Stderr, `    get-database: Returns information about a particular database in the cluster.`)
 	fmt.Fprintln(os.Stderr, `    update-database: Updates a database with the given specification.`)
-	fmt.Fprintln(os.Stderr, `    apply-upgrade: Applies a minor-version upgrade to a database. The target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered.`)
+	fmt.Fprintln(os.Stderr, `    apply-upgrade: Applies an upgrade to a database. In the default 'minor' mode, the target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered. In the 'pg_upgrade' mode, each node is upgraded to a new Postgres major version in place: every node's primary is first checked with pg_upgrade --check, then each node is stopped, its primary is upgraded with pg_upgrade --link, and its replicas are rebuilt from the upgraded primary. Each node is unavailable while it's being upgraded.`)
+	fmt.Fprintln(os.Stderr, `    upgrade-database-major: Upgrades a database to a new Postgres major version by replacing each of its nodes, one at a time, with a new node running the target version. Each replacement node is populated from the node it replaces, services are switched over to the replacement, and then the original node is removed. If adding a replacement node or switching services over fails, that node's changes are rolled back and the task fails.`)
+	fmt.Fprintln(os.Stderr, `    cutover-database-import: Cuts over a database that was created with an import source. The task waits for the import's replication lag to reach zero, copies the source's sequence values, and then stops replication from the source. Writes to the source should be stopped before the cutover.`)
 	fmt.Fprintln(os.Stderr, `    delete-database: Deletes a database from the cluster.`)
 	fmt.Fprintln(os.Stderr, `    backup-database-node: Initiates a backup for a database node.`)
 	fmt.Fprintln(os.Stderr, `    switchover-database-node: Performs a planned switchover for a node's primary to a replica candidate.`)
+	fmt.Fprintln(os.Stderr, `    rolling-restart-database: Restarts a database's instances one at a time without taking any of its nodes offline. On each node, replicas are restarted first, and each replica must resume streaming before the next instance is restarted. If a node's primary needs a restart, it's switched over to the most up-to-date replica, restarted, and optionally switched back. Each restart and switchover is tracked by its own task, whose parent is the rolling restart task.`)
 	fmt.Fprintln(os.Stderr, `    failover-database-node: Performs a failover for a node to a replica candidate.`)
+	fmt.Fprintln(os.Stderr, `    get-node-query-stats: Returns the most recently collected pg_stat_statements statistics for each instance in a node. Query stats collection must be enabled in the database spec.`)
+	fmt.Fprintln(os.Stderr, `    reset-node-query-stats: Discards the pg_stat_statements statistics on each instance in a node, as well as the statistics that have been collected from them.`)
 	fmt.Fprintln(os.Stderr, `    list-database-tasks: Lists all tasks for a database.`)
 	fmt.Fprintln(os.Stderr, `    get-database-task: Returns information about a particular task.`)
 	fmt.Fprintln(os.Stderr, `    get-database-task-log: Returns the log of a particular task for a database.`)
@@ -479,12 +724,24 @@ func controlPlaneUsage() {
 	fmt.Fprintln(os.Stderr, `    get-host-task: Returns information about a particular task for a host.`)
 	fmt.Fprintln(os.Stderr, `    get-host-task-log: Returns the log of a particular task for a host.`)
 	fmt.Fprintln(os.Stderr, `    list-tasks: Lists tasks across all scopes with optional filtering by scope and entity ID.`)
+	fmt.Fprintln(os.Stderr, `    stream-events: Streams database, instance, and task change events as server-sent events. The first event is always a heartbeat, and only changes that happen after the heartbeat are sent.`)
+	fmt.Fprintln(os.Stderr, `    create-webhook: Registers a webhook endpoint that receives lifecycle events, such as task failures and database state changes. Each payload is signed with the webhook's secret.`)
+	fmt.Fprintln(os.Stderr, `    list-webhooks: Lists the webhooks in the cluster. Secrets are not returned.`)
+	fmt.Fprintln(os.Stderr, `    get-webhook: Returns information about a particular webhook. The secret is not returned.`)
+	fmt.Fprintln(os.Stderr, `    delete-webhook: Deletes a webhook and its delivery history. Pending deliveries are discarded.`)
+	fmt.Fprintln(os.Stderr, `    list-webhook-deliveries: Lists a webhook's most recent deliveries, newest first. Delivery history is kept for seven days.`)
 	fmt.Fprintln(os.Stderr, `    restore-database: Perform an in-place restore of one or more nodes using the given restore configuration.`)
+	fmt.Fprintln(os.Stderr, `    clone-database: Creates a new database from a node of an existing database. The clone is restored from the source node's backup repository, either from its latest backup and archived WAL or from a fresh backup taken from one of its replicas. The clone is a single-node database, and it never replicates with the source: Spock subscriptions are dropped during the restore.`)
 	fmt.Fprintln(os.Stderr, `    get-version: Returns version information for this Control Plane server.`)
 	fmt.Fprintln(os.Stderr, `    restart-instance: Restarts a specific instance within a database. Supports immediate or scheduled restarts.`)
+	fmt.Fprintln(os.Stderr, `    get-instance-sessions: Returns the client sessions on a specific instance within a database, including the locks that each session holds or is waiting for and the chains of sessions that are blocking each other.`)
+	fmt.Fprintln(os.Stderr, `    cancel-instance-session: Cancels the current query of a client session on a specific instance. The cancellation is performed and recorded by a task.`)
+	fmt.Fprintln(os.Stderr, `    terminate-instance-session: Terminates a client session on a specific instance. The termination is performed and recorded by a task.`)
+	fmt.Fprintln(os.Stderr, `    get-instance-postgresql-conf: Returns the effective postgresql.conf for a specific instance within a database, including the source of each setting and whether it's waiting for a restart.`)
 	fmt.Fprintln(os.Stderr, `    stop-instance: Stops a specific instance within a database. Supports immediate stops.`)
 	fmt.Fprintln(os.Stderr, `    start-instance: Starts a specific instance within a database. Supports immediate starts`)
 	fmt.Fprintln(os.Stderr, `    cancel-database-task: Cancels a running or pending task for a database.`)
+	fmt.Fprintln(os.Stderr, `    resume-database-task: Resumes a paused task for a database.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s control-plane COMMAND --help\n", os.Args[0])
@@ -575,9 +832,65 @@ func controlPlaneGetClusterUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-cluster")
 }
 
+func controlPlaneGetStorageUsageUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-storage-usage", os.Args[0])
+	fmt.Fprint(os.Stderr, " -depth INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the amount of Etcd storage used by the Control Plane, grouped by key prefix.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -depth INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-storage-usage --depth 2")
+}
+
+func controlPlaneGetEtcdClusterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-etcd-cluster", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the members of the Control Plane's Etcd cluster with their role, database size, and raft progress, along with the cluster's quorum and failure tolerance.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-etcd-cluster")
+}
+
+func controlPlaneDefragmentEtcdUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane defragment-etcd", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Defragments the Etcd database on the given hosts to reclaim the space that Etcd can reuse after compaction. Hosts are defragmented one at a time, and the leader is defragmented last. Each member stops serving requests while it's defragmented.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane defragment-etcd --body '{\n      \"host_ids\": [\n         \"host-1\",\n         \"host-2\"\n      ]\n   }'")
+}
+
 func controlPlaneListHostsUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane list-hosts", os.Args[0])
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -state JSON")
+	fmt.Fprint(os.Stderr, " -postgres-version STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
@@ -585,10 +898,14 @@ func controlPlaneListHostsUsage() {
 	fmt.Fprintln(os.Stderr, `Lists all hosts within the cluster.`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -state JSON: `)
+	fmt.Fprintln(os.Stderr, `    -postgres-version STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-hosts")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-hosts --limit 100 --cursor \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --state '[\n      \"unreachable\",\n      \"degraded\"\n   ]' --postgres-version \"17\"")
 }
 
 func controlPlaneGetHostUsage() {
@@ -629,10 +946,37 @@ func controlPlaneRemoveHostUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane remove-host --host-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --force true")
 }
 
+func controlPlaneChangeHostEtcdModeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane change-host-etcd-mode", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -host-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes whether a host runs an Etcd server or connects to the other hosts as an Etcd client. The host restarts to apply the change. The response includes warnings when the cluster will be unable to tolerate the failure of an Etcd server after the change.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -host-id STRING: ID of the host to change.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane change-host-etcd-mode --body '{\n      \"etcd_mode\": \"server\"\n   }' --host-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
+
 func controlPlaneListDatabasesUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane list-databases", os.Args[0])
 	fmt.Fprint(os.Stderr, " -include JSON")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -tenant-id STRING")
+	fmt.Fprint(os.Stderr, " -state JSON")
+	fmt.Fprint(os.Stderr, " -host-id STRING")
+	fmt.Fprint(os.Stderr, " -postgres-version STRING")
+	fmt.Fprint(os.Stderr, " -view STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
@@ -641,10 +985,17 @@ func controlPlaneListDatabasesUsage() {
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -include JSON: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tenant-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -state JSON: `)
+	fmt.Fprintln(os.Stderr, `    -host-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -postgres-version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -view STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-databases --include '[\n      \"available_upgrades\"\n   ]'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-databases --include '[\n      \"available_upgrades\"\n   ]' --limit 100 --cursor \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --tenant-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --state '[\n      \"available\",\n      \"degraded\"\n   ]' --host-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --postgres-version \"17\" --view \"summary\"")
 }
 
 func controlPlaneCreateDatabaseUsage() {
@@ -706,7 +1057,7 @@ func controlPlaneUpdateDatabaseUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane update-database --body '{\n      \"spec\": {\n         \"database_name\": \"storefront\",\n         \"database_users\": [\n            {\n               \"attributes\": [\n                  \"LOGIN\",\n                  \"SUPERUSER\"\n               ],\n               \"db_owner\": true,\n               \"username\": \"admin\"\n            }\n         ],\n         \"nodes\": [\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-us-east-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"us-east-1\"\n               ],\n               \"name\": \"n1\"\n            },\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-ap-south-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"ap-south-1\"\n               ],\n               \"name\": \"n2\"\n            },\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-eu-central-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"eu-central-1\"\n               ],\n               \"name\": \"n3\",\n               \"restore_config\": {\n                  \"repository\": {\n                     \"s3_bucket\": \"storefront-db-backups-us-east-1\",\n                     \"type\": \"s3\"\n                  },\n                  \"source_database_id\": \"storefront\",\n                  \"source_database_name\": \"storefront\",\n                  \"source_node_name\": \"n1\"\n               }\n            }\n         ],\n         \"port\": 5432\n      }\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --force-update true --remove-host '[\n      \"In doloremque.\",\n      \"Officia rerum eum nemo autem iste illo.\",\n      \"Non libero quibusdam et sapiente.\"\n   ]'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane update-database --body '{\n      \"spec\": {\n         \"database_name\": \"storefront\",\n         \"database_users\": [\n            {\n               \"attributes\": [\n                  \"LOGIN\",\n                  \"SUPERUSER\"\n               ],\n               \"db_owner\": true,\n               \"username\": \"admin\"\n            }\n         ],\n         \"nodes\": [\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-us-east-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"us-east-1\"\n               ],\n               \"name\": \"n1\"\n            },\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-ap-south-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"ap-south-1\"\n               ],\n               \"name\": \"n2\"\n            },\n            {\n               \"backup_config\": {\n                  \"repositories\": [\n                     {\n                        \"s3_bucket\": \"storefront-db-backups-eu-central-1\",\n                        \"type\": \"s3\"\n                     }\n                  ]\n               },\n               \"host_ids\": [\n                  \"eu-central-1\"\n               ],\n               \"name\": \"n3\",\n               \"restore_config\": {\n                  \"repository\": {\n                     \"s3_bucket\": \"storefront-db-backups-us-east-1\",\n                     \"type\": \"s3\"\n                  },\n                  \"source_database_id\": \"storefront\",\n                  \"source_database_name\": \"storefront\",\n                  \"source_node_name\": \"n1\"\n               }\n            }\n         ],\n         \"port\": 5432\n      }\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --force-update true --remove-host '[\n      \"Mollitia esse eveniet.\",\n      \"Ut rerum.\"\n   ]'")
 }
 
 func controlPlaneApplyUpgradeUsage() {
@@ -718,7 +1069,7 @@ func controlPlaneApplyUpgradeUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Applies a minor-version upgrade to a database. The target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered.`)
+	fmt.Fprintln(os.Stderr, `Applies an upgrade to a database. In the default 'minor' mode, the target image must be a stable manifest entry in the same Postgres major / Spock major bucket as the current version and strictly newer. Container pull and restart happen asynchronously; this endpoint returns once redeployment is triggered. In the 'pg_upgrade' mode, each node is upgraded to a new Postgres major version in place: every node's primary is first checked with pg_upgrade --check, then each node is stopped, its primary is upgraded with pg_upgrade --link, and its replicas are rebuilt from the upgraded primary. Each node is unavailable while it's being upgraded.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
@@ -726,7 +1077,45 @@ func controlPlaneApplyUpgradeUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane apply-upgrade --body '{\n      \"image\": \"ghcr.io/pgedge/pgedge-postgres:17.10-spock5.0.8-standard-1\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane apply-upgrade --body '{\n      \"image\": \"ghcr.io/pgedge/pgedge-postgres:17.10-spock5.0.8-standard-1\",\n      \"mode\": \"minor\",\n      \"postgres_version\": \"18.1\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
+
+func controlPlaneUpgradeDatabaseMajorUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane upgrade-database-major", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upgrades a database to a new Postgres major version by replacing each of its nodes, one at a time, with a new node running the target version. Each replacement node is populated from the node it replaces, services are switched over to the replacement, and then the original node is removed. If adding a replacement node or switching services over fails, that node's changes are rolled back and the task fails.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database to upgrade.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane upgrade-database-major --body '{\n      \"nodes\": [\n         {\n            \"host_ids\": [\n               \"host-4\"\n            ],\n            \"name\": \"n1\"\n         },\n         {\n            \"host_ids\": [\n               \"host-4\"\n            ],\n            \"name\": \"n1\"\n         }\n      ],\n      \"pause_between_nodes\": true,\n      \"postgres_version\": \"18.1\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
+
+func controlPlaneCutoverDatabaseImportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane cutover-database-import", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Cuts over a database that was created with an import source. The task waits for the import's replication lag to reach zero, copies the source's sequence values, and then stops replication from the source. Writes to the source should be stopped before the cutover.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database to cut over.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane cutover-database-import --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
 }
 
 func controlPlaneDeleteDatabaseUsage() {
@@ -795,6 +1184,26 @@ func controlPlaneSwitchoverDatabaseNodeUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane switchover-database-node --body '{\n      \"candidate_instance_id\": \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\",\n      \"scheduled_at\": \"2025-09-20T22:00:00+05:30\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --node-name \"n1\"")
 }
 
+func controlPlaneRollingRestartDatabaseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane rolling-restart-database", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Restarts a database's instances one at a time without taking any of its nodes offline. On each node, replicas are restarted first, and each replica must resume streaming before the next instance is restarted. If a node's primary needs a restart, it's switched over to the most up-to-date replica, restarted, and optionally switched back. Each restart and switchover is tracked by its own task, whose parent is the rolling restart task.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database to restart.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane rolling-restart-database --body '{\n      \"all_instances\": false,\n      \"concurrency\": 1,\n      \"maintenance_window\": {\n         \"end\": \"04:00\",\n         \"start\": \"02:00\"\n      },\n      \"switch_back\": true\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
+
 func controlPlaneFailoverDatabaseNodeUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane failover-database-node", os.Args[0])
@@ -814,7 +1223,51 @@ func controlPlaneFailoverDatabaseNodeUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane failover-database-node --body '{\n      \"candidate_instance_id\": \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\",\n      \"skip_validation\": false\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --node-name \"n1\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane failover-database-node --body '{\n      \"candidate_instance_id\": \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\",\n      \"skip_validation\": true\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --node-name \"n1\"")
+}
+
+func controlPlaneGetNodeQueryStatsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-node-query-stats", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -node-name STRING")
+	fmt.Fprint(os.Stderr, " -sort-by STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the most recently collected pg_stat_statements statistics for each instance in a node. Query stats collection must be enabled in the database spec.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database.`)
+	fmt.Fprintln(os.Stderr, `    -node-name STRING: Name of the node.`)
+	fmt.Fprintln(os.Stderr, `    -sort-by STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-node-query-stats --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --node-name \"n1\" --sort-by \"calls\" --limit 10")
+}
+
+func controlPlaneResetNodeQueryStatsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane reset-node-query-stats", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -node-name STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Discards the pg_stat_statements statistics on each instance in a node, as well as the statistics that have been collected from them.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database.`)
+	fmt.Fprintln(os.Stderr, `    -node-name STRING: Name of the node.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane reset-node-query-stats --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --node-name \"n1\"")
 }
 
 func controlPlaneListDatabaseTasksUsage() {
@@ -979,6 +1432,118 @@ func controlPlaneListTasksUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-tasks --scope \"database\" --entity-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --after-task-id \"3c875a27-f6a6-4c1c-ba5f-6972fb1fc348\" --limit 100 --sort-order \"ascend\"")
 }
 
+func controlPlaneStreamEventsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane stream-events", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -host-id STRING")
+	fmt.Fprint(os.Stderr, " -kind JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Streams database, instance, and task change events as server-sent events. The first event is always a heartbeat, and only changes that happen after the heartbeat are sent.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -host-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -kind JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane stream-events --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --host-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --kind '[\n      \"database\",\n      \"task\"\n   ]'")
+}
+
+func controlPlaneCreateWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane create-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Registers a webhook endpoint that receives lifecycle events, such as task failures and database state changes. Each payload is signed with the webhook's secret.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane create-webhook --body '{\n      \"database_ids\": [\n         \"inventory\"\n      ],\n      \"description\": \"Alerting for failed backups\",\n      \"event_types\": [\n         \"task_failed\",\n         \"database_state_changed\"\n      ],\n      \"secret\": \"3f1b6c2e9a8d4f0b7e5c1a2d3b4c5d6e\",\n      \"url\": \"https://alerts.example.com/hooks/control-plane\"\n   }'")
+}
+
+func controlPlaneListWebhooksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane list-webhooks", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the webhooks in the cluster. Secrets are not returned.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-webhooks")
+}
+
+func controlPlaneGetWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns information about a particular webhook. The secret is not returned.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: ID of the webhook to get.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-webhook --webhook-id \"0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1\"")
+}
+
+func controlPlaneDeleteWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane delete-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes a webhook and its delivery history. Pending deliveries are discarded.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: ID of the webhook to delete.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane delete-webhook --webhook-id \"0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1\"")
+}
+
+func controlPlaneListWebhookDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane list-webhook-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists a webhook's most recent deliveries, newest first. Delivery history is kept for seven days.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: ID of the webhook.`)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane list-webhook-deliveries --webhook-id \"0f8a4e36-6d5c-4a49-8e4a-7d0b62b2f4a1\" --limit 20")
+}
+
 func controlPlaneRestoreDatabaseUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane restore-database", os.Args[0])
@@ -1001,6 +1566,26 @@ func controlPlaneRestoreDatabaseUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane restore-database --body '{\n      \"restore_config\": {\n         \"repository\": {\n            \"base_path\": \"/backups\",\n            \"type\": \"posix\"\n         },\n         \"source_database_id\": \"storefront\",\n         \"source_database_name\": \"storefront\",\n         \"source_node_name\": \"n1\"\n      },\n      \"target_nodes\": [\n         \"n1\"\n      ]\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --force true")
 }
 
+func controlPlaneCloneDatabaseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane clone-database", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates a new database from a node of an existing database. The clone is restored from the source node's backup repository, either from its latest backup and archived WAL or from a fresh backup taken from one of its replicas. The clone is a single-node database, and it never replicates with the source: Spock subscriptions are dropped during the restore.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database to clone.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane clone-database --body '{\n      \"database_name\": \"storefront_staging\",\n      \"id\": \"storefront-staging\",\n      \"rename_users\": {\n         \"app\": \"app_staging\"\n      },\n      \"scrub_script\": [\n         \"UPDATE customers SET email = \\'customer-\\' || id || \\'@example.com\\'\"\n      ],\n      \"source_node\": \"n1\",\n      \"target_time\": \"2025-06-18T16:00:00Z\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
+
 func controlPlaneGetVersionUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-version", os.Args[0])
@@ -1039,6 +1624,90 @@ func controlPlaneRestartInstanceUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane restart-instance --body '{\n      \"scheduled_at\": \"2025-06-18T16:52:05Z\"\n   }' --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --instance-id \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\"")
 }
 
+func controlPlaneGetInstanceSessionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-instance-sessions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -instance-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the client sessions on a specific instance within a database, including the locks that each session holds or is waiting for and the chains of sessions that are blocking each other.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: The ID of the database that owns the instance.`)
+	fmt.Fprintln(os.Stderr, `    -instance-id STRING: The ID of the instance.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-instance-sessions --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --instance-id \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\"")
+}
+
+func controlPlaneCancelInstanceSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane cancel-instance-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -instance-id STRING")
+	fmt.Fprint(os.Stderr, " -pid INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Cancels the current query of a client session on a specific instance. The cancellation is performed and recorded by a task.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: The ID of the database that owns the instance.`)
+	fmt.Fprintln(os.Stderr, `    -instance-id STRING: The ID of the instance.`)
+	fmt.Fprintln(os.Stderr, `    -pid INT: The process ID of the session.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane cancel-instance-session --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --instance-id \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\" --pid 4182")
+}
+
+func controlPlaneTerminateInstanceSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane terminate-instance-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -instance-id STRING")
+	fmt.Fprint(os.Stderr, " -pid INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Terminates a client session on a specific instance. The termination is performed and recorded by a task.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: The ID of the database that owns the instance.`)
+	fmt.Fprintln(os.Stderr, `    -instance-id STRING: The ID of the instance.`)
+	fmt.Fprintln(os.Stderr, `    -pid INT: The process ID of the session.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane terminate-instance-session --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --instance-id \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\" --pid 4182")
+}
+
+func controlPlaneGetInstancePostgresqlConfUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane get-instance-postgresql-conf", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -instance-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the effective postgresql.conf for a specific instance within a database, including the source of each setting and whether it's waiting for a restart.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: The ID of the database that owns the instance.`)
+	fmt.Fprintln(os.Stderr, `    -instance-id STRING: The ID of the instance.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane get-instance-postgresql-conf --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --instance-id \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\"")
+}
+
 func controlPlaneStopInstanceUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] control-plane stop-instance", os.Args[0])
@@ -1102,3 +1771,23 @@ func controlPlaneCancelDatabaseTaskUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane cancel-database-task --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --task-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
 }
+
+func controlPlaneResumeDatabaseTaskUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] control-plane resume-database-task", os.Args[0])
+	fmt.Fprint(os.Stderr, " -database-id STRING")
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Resumes a paused task for a database.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -database-id STRING: ID of the database that owns the task.`)
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: ID of the task to resume.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "control-plane resume-database-task --database-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\" --task-id \"76f9b8c0-4958-11f0-a489-3bb29577c696\"")
+}
@@ -126,6 +126,131 @@ func BuildGetJoinOptionsPayload(controlPlaneGetJoinOptionsBody string) (*control
 	return v, nil
 }
 
+// BuildGetStorageUsagePayload builds the payload for the control-plane
+// get-storage-usage endpoint from CLI flags.
+func BuildGetStorageUsagePayload(controlPlaneGetStorageUsageDepth string) (*controlplane.GetStorageUsagePayload, error) {
+	var err error
+	var depth *int
+	{
+		if controlPlaneGetStorageUsageDepth != "" {
+			var v int64
+			v, err = strconv.ParseInt(controlPlaneGetStorageUsageDepth, 10, strconv.IntSize)
+			val := int(v)
+			depth = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for depth, must be INT")
+			}
+			if *depth < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("depth", *depth, 1, true))
+			}
+			if *depth > 4 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("depth", *depth, 4, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &controlplane.GetStorageUsagePayload{}
+	v.Depth = depth
+
+	return v, nil
+}
+
+// BuildDefragmentEtcdPayload builds the payload for the control-plane
+// defragment-etcd endpoint from CLI flags.
+func BuildDefragmentEtcdPayload(controlPlaneDefragmentEtcdBody string) (*controlplane.DefragmentEtcdRequest, error) {
+	var err error
+	var body DefragmentEtcdRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneDefragmentEtcdBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"host_ids\": [\n         \"host-1\",\n         \"host-2\"\n      ]\n   }'")
+		}
+	}
+	v := &controlplane.DefragmentEtcdRequest{}
+	if body.HostIds != nil {
+		v.HostIds = make([]controlplane.Identifier, len(body.HostIds))
+		for i, val := range body.HostIds {
+			v.HostIds[i] = controlplane.Identifier(val)
+		}
+	}
+
+	return v, nil
+}
+
+// BuildListHostsPayload builds the payload for the control-plane list-hosts
+// endpoint from CLI flags.
+func BuildListHostsPayload(controlPlaneListHostsLimit string, controlPlaneListHostsCursor string, controlPlaneListHostsState string, controlPlaneListHostsPostgresVersion string) (*controlplane.ListHostsPayload, error) {
+	var err error
+	var limit *int
+	{
+		if controlPlaneListHostsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(controlPlaneListHostsLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if controlPlaneListHostsCursor != "" {
+			cursor = &controlPlaneListHostsCursor
+			if utf8.RuneCountInString(*cursor) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 1, true))
+			}
+			if utf8.RuneCountInString(*cursor) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var state []string
+	{
+		if controlPlaneListHostsState != "" {
+			err = json.Unmarshal([]byte(controlPlaneListHostsState), &state)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for state, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"unreachable\",\n      \"degraded\"\n   ]'")
+			}
+			for _, e := range state {
+				if !(e == "healthy" || e == "unreachable" || e == "degraded" || e == "unknown") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("state[*]", e, []any{"healthy", "unreachable", "degraded", "unknown"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var postgresVersion *string
+	{
+		if controlPlaneListHostsPostgresVersion != "" {
+			postgresVersion = &controlPlaneListHostsPostgresVersion
+		}
+	}
+	v := &controlplane.ListHostsPayload{}
+	v.Limit = limit
+	if cursor != nil {
+		tmpcursor := controlplane.Identifier(*cursor)
+		v.Cursor = &tmpcursor
+	}
+	v.State = state
+	v.PostgresVersion = postgresVersion
+
+	return v, nil
+}
+
 // BuildGetHostPayload builds the payload for the control-plane get-host
 // endpoint from CLI flags.
 func BuildGetHostPayload(controlPlaneGetHostHostID string) (*controlplane.GetHostPayload, error) {
@@ -182,9 +307,50 @@ func BuildRemoveHostPayload(controlPlaneRemoveHostHostID string, controlPlaneRem
 	return v, nil
 }
 
+// BuildChangeHostEtcdModePayload builds the payload for the control-plane
+// change-host-etcd-mode endpoint from CLI flags.
+func BuildChangeHostEtcdModePayload(controlPlaneChangeHostEtcdModeBody string, controlPlaneChangeHostEtcdModeHostID string) (*controlplane.ChangeHostEtcdModePayload, error) {
+	var err error
+	var body ChangeHostEtcdModeRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneChangeHostEtcdModeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"etcd_mode\": \"server\"\n   }'")
+		}
+		if !(body.EtcdMode == "server" || body.EtcdMode == "client") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.etcd_mode", body.EtcdMode, []any{"server", "client"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var hostID string
+	{
+		hostID = controlPlaneChangeHostEtcdModeHostID
+		if utf8.RuneCountInString(hostID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		}
+		if utf8.RuneCountInString(hostID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.ChangeHostEtcdModeRequest{
+		EtcdMode: body.EtcdMode,
+	}
+	res := &controlplane.ChangeHostEtcdModePayload{
+		Request: v,
+	}
+	res.HostID = controlplane.Identifier(hostID)
+
+	return res, nil
+}
+
 // BuildListDatabasesPayload builds the payload for the control-plane
 // list-databases endpoint from CLI flags.
-func BuildListDatabasesPayload(controlPlaneListDatabasesInclude string) (*controlplane.ListDatabasesPayload, error) {
+func BuildListDatabasesPayload(controlPlaneListDatabasesInclude string, controlPlaneListDatabasesLimit string, controlPlaneListDatabasesCursor string, controlPlaneListDatabasesTenantID string, controlPlaneListDatabasesState string, controlPlaneListDatabasesHostID string, controlPlaneListDatabasesPostgresVersion string, controlPlaneListDatabasesView string) (*controlplane.ListDatabasesPayload, error) {
 	var err error
 	var include []string
 	{
@@ -195,8 +361,122 @@ func BuildListDatabasesPayload(controlPlaneListDatabasesInclude string) (*contro
 			}
 		}
 	}
+	var limit *int
+	{
+		if controlPlaneListDatabasesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(controlPlaneListDatabasesLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if controlPlaneListDatabasesCursor != "" {
+			cursor = &controlPlaneListDatabasesCursor
+			if utf8.RuneCountInString(*cursor) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 1, true))
+			}
+			if utf8.RuneCountInString(*cursor) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tenantID *string
+	{
+		if controlPlaneListDatabasesTenantID != "" {
+			tenantID = &controlPlaneListDatabasesTenantID
+			if utf8.RuneCountInString(*tenantID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("tenant_id", *tenantID, utf8.RuneCountInString(*tenantID), 1, true))
+			}
+			if utf8.RuneCountInString(*tenantID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("tenant_id", *tenantID, utf8.RuneCountInString(*tenantID), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var state []string
+	{
+		if controlPlaneListDatabasesState != "" {
+			err = json.Unmarshal([]byte(controlPlaneListDatabasesState), &state)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for state, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"available\",\n      \"degraded\"\n   ]'")
+			}
+			for _, e := range state {
+				if !(e == "creating" || e == "modifying" || e == "available" || e == "deleting" || e == "degraded" || e == "failed" || e == "restoring" || e == "unknown") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("state[*]", e, []any{"creating", "modifying", "available", "deleting", "degraded", "failed", "restoring", "unknown"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var hostID *string
+	{
+		if controlPlaneListDatabasesHostID != "" {
+			hostID = &controlPlaneListDatabasesHostID
+			if utf8.RuneCountInString(*hostID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 1, true))
+			}
+			if utf8.RuneCountInString(*hostID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var postgresVersion *string
+	{
+		if controlPlaneListDatabasesPostgresVersion != "" {
+			postgresVersion = &controlPlaneListDatabasesPostgresVersion
+		}
+	}
+	var view string
+	{
+		if controlPlaneListDatabasesView != "" {
+			view = controlPlaneListDatabasesView
+			if !(view == "full" || view == "summary") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("view", view, []any{"full", "summary"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := &controlplane.ListDatabasesPayload{}
 	v.Include = include
+	v.Limit = limit
+	if cursor != nil {
+		tmpcursor := controlplane.Identifier(*cursor)
+		v.Cursor = &tmpcursor
+	}
+	if tenantID != nil {
+		tmptenantID := controlplane.Identifier(*tenantID)
+		v.TenantID = &tmptenantID
+	}
+	v.State = state
+	if hostID != nil {
+		tmphostID := controlplane.Identifier(*hostID)
+		v.HostID = &tmphostID
+	}
+	v.PostgresVersion = postgresVersion
+	v.View = view
 
 	return v, nil
 }
@@ -239,6 +519,11 @@ func BuildCreateDatabasePayload(controlPlaneCreateDatabaseBody string) (*control
 				err = goa.MergeErrors(err, err2)
 			}
 		}
+		if body.Source != nil {
+			if err2 := ValidateImportSourceSpecRequestBody(body.Source); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -255,6 +540,9 @@ func BuildCreateDatabasePayload(controlPlaneCreateDatabaseBody string) (*control
 	if body.Spec != nil {
 		v.Spec = marshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(body.Spec)
 	}
+	if body.Source != nil {
+		v.Source = marshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec(body.Source)
+	}
 
 	return v, nil
 }
@@ -351,7 +639,7 @@ func BuildUpdateDatabasePayload(controlPlaneUpdateDatabaseBody string, controlPl
 		if controlPlaneUpdateDatabaseRemoveHost != "" {
 			err = json.Unmarshal([]byte(controlPlaneUpdateDatabaseRemoveHost), &removeHost)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for removeHost, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"In doloremque.\",\n      \"Officia rerum eum nemo autem iste illo.\",\n      \"Non libero quibusdam et sapiente.\"\n   ]'")
+				return nil, fmt.Errorf("invalid JSON for removeHost, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"Mollitia esse eveniet.\",\n      \"Ut rerum.\"\n   ]'")
 			}
 		}
 	}
@@ -381,10 +669,58 @@ func BuildApplyUpgradePayload(controlPlaneApplyUpgradeBody string, controlPlaneA
 	{
 		err = json.Unmarshal([]byte(controlPlaneApplyUpgradeBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"image\": \"ghcr.io/pgedge/pgedge-postgres:17.10-spock5.0.8-standard-1\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"image\": \"ghcr.io/pgedge/pgedge-postgres:17.10-spock5.0.8-standard-1\",\n      \"mode\": \"minor\",\n      \"postgres_version\": \"18.1\"\n   }'")
+		}
+	}
+	var databaseID string
+	{
+		databaseID = controlPlaneApplyUpgradeDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
 		}
-		if utf8.RuneCountInString(body.Image) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.image", body.Image, utf8.RuneCountInString(body.Image), 1, true))
+	}
+	v := &controlplane.ApplyUpgradeRequest{
+		Mode:            body.Mode,
+		Image:           body.Image,
+		PostgresVersion: body.PostgresVersion,
+	}
+	{
+		var zero string
+		if v.Mode == zero {
+			v.Mode = "minor"
+		}
+	}
+	res := &controlplane.ApplyUpgradePayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res, nil
+}
+
+// BuildUpgradeDatabaseMajorPayload builds the payload for the control-plane
+// upgrade-database-major endpoint from CLI flags.
+func BuildUpgradeDatabaseMajorPayload(controlPlaneUpgradeDatabaseMajorBody string, controlPlaneUpgradeDatabaseMajorDatabaseID string) (*controlplane.UpgradeDatabaseMajorPayload, error) {
+	var err error
+	var body UpgradeDatabaseMajorRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneUpgradeDatabaseMajorBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"nodes\": [\n         {\n            \"host_ids\": [\n               \"host-4\"\n            ],\n            \"name\": \"n1\"\n         },\n         {\n            \"host_ids\": [\n               \"host-4\"\n            ],\n            \"name\": \"n1\"\n         }\n      ],\n      \"pause_between_nodes\": true,\n      \"postgres_version\": \"18.1\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.postgres_version", body.PostgresVersion, "^\\d{2}\\.\\d{1,2}$"))
+		for _, e := range body.Nodes {
+			if e != nil {
+				if err2 := ValidateMajorUpgradeNodeSpecRequestBodyRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
 		}
 		if err != nil {
 			return nil, err
@@ -392,7 +728,7 @@ func BuildApplyUpgradePayload(controlPlaneApplyUpgradeBody string, controlPlaneA
 	}
 	var databaseID string
 	{
-		databaseID = controlPlaneApplyUpgradeDatabaseID
+		databaseID = controlPlaneUpgradeDatabaseMajorDatabaseID
 		if utf8.RuneCountInString(databaseID) < 1 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
 		}
@@ -403,10 +739,21 @@ func BuildApplyUpgradePayload(controlPlaneApplyUpgradeBody string, controlPlaneA
 			return nil, err
 		}
 	}
-	v := &controlplane.ApplyUpgradeRequest{
-		Image: body.Image,
+	v := &controlplane.UpgradeDatabaseMajorRequest{
+		PostgresVersion:   body.PostgresVersion,
+		PauseBetweenNodes: body.PauseBetweenNodes,
 	}
-	res := &controlplane.ApplyUpgradePayload{
+	if body.Nodes != nil {
+		v.Nodes = make([]*controlplane.MajorUpgradeNodeSpec, len(body.Nodes))
+		for i, val := range body.Nodes {
+			if val == nil {
+				v.Nodes[i] = nil
+				continue
+			}
+			v.Nodes[i] = marshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec(val)
+		}
+	}
+	res := &controlplane.UpgradeDatabaseMajorPayload{
 		Request: v,
 	}
 	res.DatabaseID = controlplane.Identifier(databaseID)
@@ -414,6 +761,29 @@ func BuildApplyUpgradePayload(controlPlaneApplyUpgradeBody string, controlPlaneA
 	return res, nil
 }
 
+// BuildCutoverDatabaseImportPayload builds the payload for the control-plane
+// cutover-database-import endpoint from CLI flags.
+func BuildCutoverDatabaseImportPayload(controlPlaneCutoverDatabaseImportDatabaseID string) (*controlplane.CutoverDatabaseImportPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneCutoverDatabaseImportDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.CutoverDatabaseImportPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+
+	return v, nil
+}
+
 // BuildDeleteDatabasePayload builds the payload for the control-plane
 // delete-database endpoint from CLI flags.
 func BuildDeleteDatabasePayload(controlPlaneDeleteDatabaseDatabaseID string, controlPlaneDeleteDatabaseForce string) (*controlplane.DeleteDatabasePayload, error) {
@@ -581,6 +951,64 @@ func BuildSwitchoverDatabaseNodePayload(controlPlaneSwitchoverDatabaseNodeBody s
 	return v, nil
 }
 
+// BuildRollingRestartDatabasePayload builds the payload for the control-plane
+// rolling-restart-database endpoint from CLI flags.
+func BuildRollingRestartDatabasePayload(controlPlaneRollingRestartDatabaseBody string, controlPlaneRollingRestartDatabaseDatabaseID string) (*controlplane.RollingRestartDatabasePayload, error) {
+	var err error
+	var body RollingRestartDatabaseRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneRollingRestartDatabaseBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"all_instances\": false,\n      \"concurrency\": 1,\n      \"maintenance_window\": {\n         \"end\": \"04:00\",\n         \"start\": \"02:00\"\n      },\n      \"switch_back\": true\n   }'")
+		}
+	}
+	var databaseID string
+	{
+		databaseID = controlPlaneRollingRestartDatabaseDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.RollingRestartDatabaseRequest{
+		AllInstances: body.AllInstances,
+		Concurrency:  body.Concurrency,
+		SwitchBack:   body.SwitchBack,
+	}
+	{
+		var zero bool
+		if v.AllInstances == zero {
+			v.AllInstances = false
+		}
+	}
+	{
+		var zero int
+		if v.Concurrency == zero {
+			v.Concurrency = 1
+		}
+	}
+	{
+		var zero bool
+		if v.SwitchBack == zero {
+			v.SwitchBack = false
+		}
+	}
+	if body.MaintenanceWindow != nil {
+		v.MaintenanceWindow = marshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow(body.MaintenanceWindow)
+	}
+	res := &controlplane.RollingRestartDatabasePayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res, nil
+}
+
 // BuildFailoverDatabaseNodePayload builds the payload for the control-plane
 // failover-database-node endpoint from CLI flags.
 func BuildFailoverDatabaseNodePayload(controlPlaneFailoverDatabaseNodeBody string, controlPlaneFailoverDatabaseNodeDatabaseID string, controlPlaneFailoverDatabaseNodeNodeName string) (*controlplane.FailoverDatabaseNodeRequest, error) {
@@ -589,7 +1017,7 @@ func BuildFailoverDatabaseNodePayload(controlPlaneFailoverDatabaseNodeBody strin
 	{
 		err = json.Unmarshal([]byte(controlPlaneFailoverDatabaseNodeBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"candidate_instance_id\": \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\",\n      \"skip_validation\": false\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"candidate_instance_id\": \"68f50878-44d2-4524-a823-e31bd478706d-n1-689qacsi\",\n      \"skip_validation\": true\n   }'")
 		}
 	}
 	var databaseID string
@@ -629,7 +1057,103 @@ func BuildFailoverDatabaseNodePayload(controlPlaneFailoverDatabaseNodeBody strin
 	return v, nil
 }
 
-// BuildListDatabaseTasksPayload builds the payload for the control-plane
+// BuildGetNodeQueryStatsPayload builds the payload for the control-plane
+// get-node-query-stats endpoint from CLI flags.
+func BuildGetNodeQueryStatsPayload(controlPlaneGetNodeQueryStatsDatabaseID string, controlPlaneGetNodeQueryStatsNodeName string, controlPlaneGetNodeQueryStatsSortBy string, controlPlaneGetNodeQueryStatsLimit string) (*controlplane.GetNodeQueryStatsPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneGetNodeQueryStatsDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var nodeName string
+	{
+		nodeName = controlPlaneGetNodeQueryStatsNodeName
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sortBy string
+	{
+		if controlPlaneGetNodeQueryStatsSortBy != "" {
+			sortBy = controlPlaneGetNodeQueryStatsSortBy
+			if !(sortBy == "total_time" || sortBy == "calls" || sortBy == "rows") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_by", sortBy, []any{"total_time", "calls", "rows"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit *int
+	{
+		if controlPlaneGetNodeQueryStatsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(controlPlaneGetNodeQueryStatsLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &controlplane.GetNodeQueryStatsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.NodeName = nodeName
+	v.SortBy = sortBy
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildResetNodeQueryStatsPayload builds the payload for the control-plane
+// reset-node-query-stats endpoint from CLI flags.
+func BuildResetNodeQueryStatsPayload(controlPlaneResetNodeQueryStatsDatabaseID string, controlPlaneResetNodeQueryStatsNodeName string) (*controlplane.ResetNodeQueryStatsPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneResetNodeQueryStatsDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var nodeName string
+	{
+		nodeName = controlPlaneResetNodeQueryStatsNodeName
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.ResetNodeQueryStatsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.NodeName = nodeName
+
+	return v, nil
+}
+
+// BuildListDatabaseTasksPayload builds the payload for the control-plane
 // list-database-tasks endpoint from CLI flags.
 func BuildListDatabaseTasksPayload(controlPlaneListDatabaseTasksDatabaseID string, controlPlaneListDatabaseTasksAfterTaskID string, controlPlaneListDatabaseTasksLimit string, controlPlaneListDatabaseTasksSortOrder string) (*controlplane.ListDatabaseTasksPayload, error) {
 	var err error
@@ -1003,6 +1527,210 @@ func BuildListTasksPayload(controlPlaneListTasksScope string, controlPlaneListTa
 	return v, nil
 }
 
+// BuildStreamEventsPayload builds the payload for the control-plane
+// stream-events endpoint from CLI flags.
+func BuildStreamEventsPayload(controlPlaneStreamEventsDatabaseID string, controlPlaneStreamEventsHostID string, controlPlaneStreamEventsKind string) (*controlplane.StreamEventsPayload, error) {
+	var err error
+	var databaseID *string
+	{
+		if controlPlaneStreamEventsDatabaseID != "" {
+			databaseID = &controlPlaneStreamEventsDatabaseID
+			if utf8.RuneCountInString(*databaseID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", *databaseID, utf8.RuneCountInString(*databaseID), 1, true))
+			}
+			if utf8.RuneCountInString(*databaseID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", *databaseID, utf8.RuneCountInString(*databaseID), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var hostID *string
+	{
+		if controlPlaneStreamEventsHostID != "" {
+			hostID = &controlPlaneStreamEventsHostID
+			if utf8.RuneCountInString(*hostID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 1, true))
+			}
+			if utf8.RuneCountInString(*hostID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 36, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var kind []string
+	{
+		if controlPlaneStreamEventsKind != "" {
+			err = json.Unmarshal([]byte(controlPlaneStreamEventsKind), &kind)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for kind, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"database\",\n      \"task\"\n   ]'")
+			}
+			for _, e := range kind {
+				if !(e == "database" || e == "instance" || e == "task" || e == "task_log") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind[*]", e, []any{"database", "instance", "task", "task_log"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &controlplane.StreamEventsPayload{}
+	if databaseID != nil {
+		tmpdatabaseID := controlplane.Identifier(*databaseID)
+		v.DatabaseID = &tmpdatabaseID
+	}
+	if hostID != nil {
+		tmphostID := controlplane.Identifier(*hostID)
+		v.HostID = &tmphostID
+	}
+	v.Kind = kind
+
+	return v, nil
+}
+
+// BuildCreateWebhookPayload builds the payload for the control-plane
+// create-webhook endpoint from CLI flags.
+func BuildCreateWebhookPayload(controlPlaneCreateWebhookBody string) (*controlplane.CreateWebhookPayload, error) {
+	var err error
+	var body CreateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneCreateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"database_ids\": [\n         \"inventory\"\n      ],\n      \"description\": \"Alerting for failed backups\",\n      \"event_types\": [\n         \"task_failed\",\n         \"database_state_changed\"\n      ],\n      \"secret\": \"3f1b6c2e9a8d4f0b7e5c1a2d3b4c5d6e\",\n      \"url\": \"https://alerts.example.com/hooks/control-plane\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 256 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 256, false))
+			}
+		}
+		if body.Secret != nil {
+			if utf8.RuneCountInString(*body.Secret) < 16 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.secret", *body.Secret, utf8.RuneCountInString(*body.Secret), 16, true))
+			}
+		}
+		if body.Secret != nil {
+			if utf8.RuneCountInString(*body.Secret) > 256 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.secret", *body.Secret, utf8.RuneCountInString(*body.Secret), 256, false))
+			}
+		}
+		for _, e := range body.EventTypes {
+			if !(e == "task_completed" || e == "task_failed" || e == "database_state_changed" || e == "instance_role_changed" || e == "host_unreachable") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"task_completed", "task_failed", "database_state_changed", "instance_role_changed", "host_unreachable"}))
+			}
+		}
+		for _, e := range body.DatabaseIds {
+			if utf8.RuneCountInString(e) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+			}
+			if utf8.RuneCountInString(e) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.CreateWebhookRequest{
+		URL:         body.URL,
+		Description: body.Description,
+		Secret:      body.Secret,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]controlplane.WebhookEventType, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = controlplane.WebhookEventType(val)
+		}
+	}
+	if body.DatabaseIds != nil {
+		v.DatabaseIds = make([]controlplane.Identifier, len(body.DatabaseIds))
+		for i, val := range body.DatabaseIds {
+			v.DatabaseIds[i] = controlplane.Identifier(val)
+		}
+	}
+	res := &controlplane.CreateWebhookPayload{
+		Request: v,
+	}
+
+	return res, nil
+}
+
+// BuildGetWebhookPayload builds the payload for the control-plane get-webhook
+// endpoint from CLI flags.
+func BuildGetWebhookPayload(controlPlaneGetWebhookWebhookID string) (*controlplane.GetWebhookPayload, error) {
+	var err error
+	var webhookID string
+	{
+		webhookID = controlPlaneGetWebhookWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.GetWebhookPayload{}
+	v.WebhookID = webhookID
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the control-plane
+// delete-webhook endpoint from CLI flags.
+func BuildDeleteWebhookPayload(controlPlaneDeleteWebhookWebhookID string) (*controlplane.DeleteWebhookPayload, error) {
+	var err error
+	var webhookID string
+	{
+		webhookID = controlPlaneDeleteWebhookWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.DeleteWebhookPayload{}
+	v.WebhookID = webhookID
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the control-plane
+// list-webhook-deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(controlPlaneListWebhookDeliveriesWebhookID string, controlPlaneListWebhookDeliveriesLimit string) (*controlplane.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var webhookID string
+	{
+		webhookID = controlPlaneListWebhookDeliveriesWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if controlPlaneListWebhookDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(controlPlaneListWebhookDeliveriesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &controlplane.ListWebhookDeliveriesPayload{}
+	v.WebhookID = webhookID
+	v.Limit = limit
+
+	return v, nil
+}
+
 // BuildRestoreDatabasePayload builds the payload for the control-plane
 // restore-database endpoint from CLI flags.
 func BuildRestoreDatabasePayload(controlPlaneRestoreDatabaseBody string, controlPlaneRestoreDatabaseDatabaseID string, controlPlaneRestoreDatabaseForce string) (*controlplane.RestoreDatabasePayload, error) {
@@ -1069,6 +1797,138 @@ func BuildRestoreDatabasePayload(controlPlaneRestoreDatabaseBody string, control
 	return res, nil
 }
 
+// BuildCloneDatabasePayload builds the payload for the control-plane
+// clone-database endpoint from CLI flags.
+func BuildCloneDatabasePayload(controlPlaneCloneDatabaseBody string, controlPlaneCloneDatabaseDatabaseID string) (*controlplane.CloneDatabasePayload, error) {
+	var err error
+	var body CloneDatabaseRequestBody
+	{
+		err = json.Unmarshal([]byte(controlPlaneCloneDatabaseBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"database_name\": \"storefront_staging\",\n      \"id\": \"storefront-staging\",\n      \"rename_users\": {\n         \"app\": \"app_staging\"\n      },\n      \"scrub_script\": [\n         \"UPDATE customers SET email = \\'customer-\\' || id || \\'@example.com\\'\"\n      ],\n      \"source_node\": \"n1\",\n      \"target_time\": \"2025-06-18T16:00:00Z\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.ID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.id", body.ID, utf8.RuneCountInString(body.ID), 1, true))
+		}
+		if utf8.RuneCountInString(body.ID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.id", body.ID, utf8.RuneCountInString(body.ID), 36, false))
+		}
+		if body.TenantID != nil {
+			if utf8.RuneCountInString(*body.TenantID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tenant_id", *body.TenantID, utf8.RuneCountInString(*body.TenantID), 1, true))
+			}
+		}
+		if body.TenantID != nil {
+			if utf8.RuneCountInString(*body.TenantID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tenant_id", *body.TenantID, utf8.RuneCountInString(*body.TenantID), 36, false))
+			}
+		}
+		if body.SourceNode != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.source_node", *body.SourceNode, "n[0-9]+"))
+		}
+		if !(body.Method == "backup" || body.Method == "base_backup") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.method", body.Method, []any{"backup", "base_backup"}))
+		}
+		if body.TargetTime != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.target_time", *body.TargetTime, goa.FormatDateTime))
+		}
+		if len(body.HostIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids", body.HostIds, len(body.HostIds), 1, true))
+		}
+		for _, e := range body.HostIds {
+			if utf8.RuneCountInString(e) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+			}
+			if utf8.RuneCountInString(e) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+			}
+		}
+		if body.DatabaseName != nil {
+			if utf8.RuneCountInString(*body.DatabaseName) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_name", *body.DatabaseName, utf8.RuneCountInString(*body.DatabaseName), 1, true))
+			}
+		}
+		if body.DatabaseName != nil {
+			if utf8.RuneCountInString(*body.DatabaseName) > 31 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_name", *body.DatabaseName, utf8.RuneCountInString(*body.DatabaseName), 31, false))
+			}
+		}
+		if len(body.ScrubScript) < 0 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script", body.ScrubScript, len(body.ScrubScript), 0, true))
+		}
+		if len(body.ScrubScript) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script", body.ScrubScript, len(body.ScrubScript), 256, false))
+		}
+		for _, e := range body.ScrubScript {
+			if utf8.RuneCountInString(e) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script[*]", e, utf8.RuneCountInString(e), 1, true))
+			}
+			if utf8.RuneCountInString(e) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script[*]", e, utf8.RuneCountInString(e), 1024, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var databaseID string
+	{
+		databaseID = controlPlaneCloneDatabaseDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.CloneDatabaseRequest{
+		ID:           controlplane.Identifier(body.ID),
+		SourceNode:   body.SourceNode,
+		Method:       body.Method,
+		TargetTime:   body.TargetTime,
+		DatabaseName: body.DatabaseName,
+	}
+	if body.TenantID != nil {
+		tenantID := controlplane.Identifier(*body.TenantID)
+		v.TenantID = &tenantID
+	}
+	{
+		var zero string
+		if v.Method == zero {
+			v.Method = "backup"
+		}
+	}
+	if body.HostIds != nil {
+		v.HostIds = make([]controlplane.Identifier, len(body.HostIds))
+		for i, val := range body.HostIds {
+			v.HostIds[i] = controlplane.Identifier(val)
+		}
+	}
+	if body.RenameUsers != nil {
+		v.RenameUsers = make(map[string]string, len(body.RenameUsers))
+		for key, val := range body.RenameUsers {
+			tk := key
+			tv := val
+			v.RenameUsers[tk] = tv
+		}
+	}
+	if body.ScrubScript != nil {
+		v.ScrubScript = make([]string, len(body.ScrubScript))
+		for i, val := range body.ScrubScript {
+			v.ScrubScript[i] = val
+		}
+	}
+	res := &controlplane.CloneDatabasePayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res, nil
+}
+
 // BuildRestartInstancePayload builds the payload for the control-plane
 // restart-instance endpoint from CLI flags.
 func BuildRestartInstancePayload(controlPlaneRestartInstanceBody string, controlPlaneRestartInstanceDatabaseID string, controlPlaneRestartInstanceInstanceID string) (*controlplane.RestartInstancePayload, error) {
@@ -1118,6 +1978,186 @@ func BuildRestartInstancePayload(controlPlaneRestartInstanceBody string, control
 	return v, nil
 }
 
+// BuildGetInstanceSessionsPayload builds the payload for the control-plane
+// get-instance-sessions endpoint from CLI flags.
+func BuildGetInstanceSessionsPayload(controlPlaneGetInstanceSessionsDatabaseID string, controlPlaneGetInstanceSessionsInstanceID string) (*controlplane.GetInstanceSessionsPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneGetInstanceSessionsDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var instanceID string
+	{
+		instanceID = controlPlaneGetInstanceSessionsInstanceID
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.GetInstanceSessionsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+
+	return v, nil
+}
+
+// BuildCancelInstanceSessionPayload builds the payload for the control-plane
+// cancel-instance-session endpoint from CLI flags.
+func BuildCancelInstanceSessionPayload(controlPlaneCancelInstanceSessionDatabaseID string, controlPlaneCancelInstanceSessionInstanceID string, controlPlaneCancelInstanceSessionPid string) (*controlplane.CancelInstanceSessionPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneCancelInstanceSessionDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var instanceID string
+	{
+		instanceID = controlPlaneCancelInstanceSessionInstanceID
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pid int
+	{
+		var v int64
+		v, err = strconv.ParseInt(controlPlaneCancelInstanceSessionPid, 10, strconv.IntSize)
+		pid = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for pid, must be INT")
+		}
+		if pid < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("pid", pid, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.CancelInstanceSessionPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+	v.Pid = pid
+
+	return v, nil
+}
+
+// BuildTerminateInstanceSessionPayload builds the payload for the
+// control-plane terminate-instance-session endpoint from CLI flags.
+func BuildTerminateInstanceSessionPayload(controlPlaneTerminateInstanceSessionDatabaseID string, controlPlaneTerminateInstanceSessionInstanceID string, controlPlaneTerminateInstanceSessionPid string) (*controlplane.TerminateInstanceSessionPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneTerminateInstanceSessionDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var instanceID string
+	{
+		instanceID = controlPlaneTerminateInstanceSessionInstanceID
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pid int
+	{
+		var v int64
+		v, err = strconv.ParseInt(controlPlaneTerminateInstanceSessionPid, 10, strconv.IntSize)
+		pid = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for pid, must be INT")
+		}
+		if pid < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("pid", pid, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.TerminateInstanceSessionPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+	v.Pid = pid
+
+	return v, nil
+}
+
+// BuildGetInstancePostgresqlConfPayload builds the payload for the
+// control-plane get-instance-postgresql-conf endpoint from CLI flags.
+func BuildGetInstancePostgresqlConfPayload(controlPlaneGetInstancePostgresqlConfDatabaseID string, controlPlaneGetInstancePostgresqlConfInstanceID string) (*controlplane.GetInstancePostgresqlConfPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneGetInstancePostgresqlConfDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var instanceID string
+	{
+		instanceID = controlPlaneGetInstancePostgresqlConfInstanceID
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.GetInstancePostgresqlConfPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+
+	return v, nil
+}
+
 // BuildStopInstancePayload builds the payload for the control-plane
 // stop-instance endpoint from CLI flags.
 func BuildStopInstancePayload(controlPlaneStopInstanceDatabaseID string, controlPlaneStopInstanceInstanceID string, controlPlaneStopInstanceForce string) (*controlplane.StopInstancePayload, error) {
@@ -1248,3 +2288,40 @@ func BuildCancelDatabaseTaskPayload(controlPlaneCancelDatabaseTaskDatabaseID str
 
 	return v, nil
 }
+
+// BuildResumeDatabaseTaskPayload builds the payload for the control-plane
+// resume-database-task endpoint from CLI flags.
+func BuildResumeDatabaseTaskPayload(controlPlaneResumeDatabaseTaskDatabaseID string, controlPlaneResumeDatabaseTaskTaskID string) (*controlplane.ResumeDatabaseTaskPayload, error) {
+	var err error
+	var databaseID string
+	{
+		databaseID = controlPlaneResumeDatabaseTaskDatabaseID
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var taskID string
+	{
+		taskID = controlPlaneResumeDatabaseTaskTaskID
+		if utf8.RuneCountInString(taskID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("task_id", taskID, utf8.RuneCountInString(taskID), 1, true))
+		}
+		if utf8.RuneCountInString(taskID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("task_id", taskID, utf8.RuneCountInString(taskID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &controlplane.ResumeDatabaseTaskPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.TaskID = controlplane.Identifier(taskID)
+
+	return v, nil
+}
@@ -9,7 +9,9 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -37,6 +39,18 @@ type Client struct {
 	// endpoint.
 	GetClusterDoer goahttp.Doer
 
+	// GetStorageUsage Doer is the HTTP client used to make requests to the
+	// get-storage-usage endpoint.
+	GetStorageUsageDoer goahttp.Doer
+
+	// GetEtcdCluster Doer is the HTTP client used to make requests to the
+	// get-etcd-cluster endpoint.
+	GetEtcdClusterDoer goahttp.Doer
+
+	// DefragmentEtcd Doer is the HTTP client used to make requests to the
+	// defragment-etcd endpoint.
+	DefragmentEtcdDoer goahttp.Doer
+
 	// ListHosts Doer is the HTTP client used to make requests to the list-hosts
 	// endpoint.
 	ListHostsDoer goahttp.Doer
@@ -49,6 +63,10 @@ type Client struct {
 	// endpoint.
 	RemoveHostDoer goahttp.Doer
 
+	// ChangeHostEtcdMode Doer is the HTTP client used to make requests to the
+	// change-host-etcd-mode endpoint.
+	ChangeHostEtcdModeDoer goahttp.Doer
+
 	// ListDatabases Doer is the HTTP client used to make requests to the
 	// list-databases endpoint.
 	ListDatabasesDoer goahttp.Doer
@@ -69,6 +87,14 @@ type Client struct {
 	// apply-upgrade endpoint.
 	ApplyUpgradeDoer goahttp.Doer
 
+	// UpgradeDatabaseMajor Doer is the HTTP client used to make requests to the
+	// upgrade-database-major endpoint.
+	UpgradeDatabaseMajorDoer goahttp.Doer
+
+	// CutoverDatabaseImport Doer is the HTTP client used to make requests to the
+	// cutover-database-import endpoint.
+	CutoverDatabaseImportDoer goahttp.Doer
+
 	// DeleteDatabase Doer is the HTTP client used to make requests to the
 	// delete-database endpoint.
 	DeleteDatabaseDoer goahttp.Doer
@@ -81,10 +107,22 @@ type Client struct {
 	// switchover-database-node endpoint.
 	SwitchoverDatabaseNodeDoer goahttp.Doer
 
+	// RollingRestartDatabase Doer is the HTTP client used to make requests to the
+	// rolling-restart-database endpoint.
+	RollingRestartDatabaseDoer goahttp.Doer
+
 	// FailoverDatabaseNode Doer is the HTTP client used to make requests to the
 	// failover-database-node endpoint.
 	FailoverDatabaseNodeDoer goahttp.Doer
 
+	// GetNodeQueryStats Doer is the HTTP client used to make requests to the
+	// get-node-query-stats endpoint.
+	GetNodeQueryStatsDoer goahttp.Doer
+
+	// ResetNodeQueryStats Doer is the HTTP client used to make requests to the
+	// reset-node-query-stats endpoint.
+	ResetNodeQueryStatsDoer goahttp.Doer
+
 	// ListDatabaseTasks Doer is the HTTP client used to make requests to the
 	// list-database-tasks endpoint.
 	ListDatabaseTasksDoer goahttp.Doer
@@ -113,10 +151,38 @@ type Client struct {
 	// endpoint.
 	ListTasksDoer goahttp.Doer
 
+	// StreamEvents Doer is the HTTP client used to make requests to the
+	// stream-events endpoint.
+	StreamEventsDoer goahttp.Doer
+
+	// CreateWebhook Doer is the HTTP client used to make requests to the
+	// create-webhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// ListWebhooks Doer is the HTTP client used to make requests to the
+	// list-webhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// GetWebhook Doer is the HTTP client used to make requests to the get-webhook
+	// endpoint.
+	GetWebhookDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the
+	// delete-webhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// list-webhook-deliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
 	// RestoreDatabase Doer is the HTTP client used to make requests to the
 	// restore-database endpoint.
 	RestoreDatabaseDoer goahttp.Doer
 
+	// CloneDatabase Doer is the HTTP client used to make requests to the
+	// clone-database endpoint.
+	CloneDatabaseDoer goahttp.Doer
+
 	// GetVersion Doer is the HTTP client used to make requests to the get-version
 	// endpoint.
 	GetVersionDoer goahttp.Doer
@@ -125,6 +191,22 @@ type Client struct {
 	// restart-instance endpoint.
 	RestartInstanceDoer goahttp.Doer
 
+	// GetInstanceSessions Doer is the HTTP client used to make requests to the
+	// get-instance-sessions endpoint.
+	GetInstanceSessionsDoer goahttp.Doer
+
+	// CancelInstanceSession Doer is the HTTP client used to make requests to the
+	// cancel-instance-session endpoint.
+	CancelInstanceSessionDoer goahttp.Doer
+
+	// TerminateInstanceSession Doer is the HTTP client used to make requests to
+	// the terminate-instance-session endpoint.
+	TerminateInstanceSessionDoer goahttp.Doer
+
+	// GetInstancePostgresqlConf Doer is the HTTP client used to make requests to
+	// the get-instance-postgresql-conf endpoint.
+	GetInstancePostgresqlConfDoer goahttp.Doer
+
 	// StopInstance Doer is the HTTP client used to make requests to the
 	// stop-instance endpoint.
 	StopInstanceDoer goahttp.Doer
@@ -137,6 +219,10 @@ type Client struct {
 	// cancel-database-task endpoint.
 	CancelDatabaseTaskDoer goahttp.Doer
 
+	// ResumeDatabaseTask Doer is the HTTP client used to make requests to the
+	// resume-database-task endpoint.
+	ResumeDatabaseTaskDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -158,41 +244,62 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		InitClusterDoer:            doer,
-		JoinClusterDoer:            doer,
-		GetJoinTokenDoer:           doer,
-		GetJoinOptionsDoer:         doer,
-		GetClusterDoer:             doer,
-		ListHostsDoer:              doer,
-		GetHostDoer:                doer,
-		RemoveHostDoer:             doer,
-		ListDatabasesDoer:          doer,
-		CreateDatabaseDoer:         doer,
-		GetDatabaseDoer:            doer,
-		UpdateDatabaseDoer:         doer,
-		ApplyUpgradeDoer:           doer,
-		DeleteDatabaseDoer:         doer,
-		BackupDatabaseNodeDoer:     doer,
-		SwitchoverDatabaseNodeDoer: doer,
-		FailoverDatabaseNodeDoer:   doer,
-		ListDatabaseTasksDoer:      doer,
-		GetDatabaseTaskDoer:        doer,
-		GetDatabaseTaskLogDoer:     doer,
-		ListHostTasksDoer:          doer,
-		GetHostTaskDoer:            doer,
-		GetHostTaskLogDoer:         doer,
-		ListTasksDoer:              doer,
-		RestoreDatabaseDoer:        doer,
-		GetVersionDoer:             doer,
-		RestartInstanceDoer:        doer,
-		StopInstanceDoer:           doer,
-		StartInstanceDoer:          doer,
-		CancelDatabaseTaskDoer:     doer,
-		RestoreResponseBody:        restoreBody,
-		scheme:                     scheme,
-		host:                       host,
-		decoder:                    dec,
-		encoder:                    enc,
+		InitClusterDoer:               doer,
+		JoinClusterDoer:               doer,
+		GetJoinTokenDoer:              doer,
+		GetJoinOptionsDoer:            doer,
+		GetClusterDoer:                doer,
+		GetStorageUsageDoer:           doer,
+		GetEtcdClusterDoer:            doer,
+		DefragmentEtcdDoer:            doer,
+		ListHostsDoer:                 doer,
+		GetHostDoer:                   doer,
+		RemoveHostDoer:                doer,
+		ChangeHostEtcdModeDoer:        doer,
+		ListDatabasesDoer:             doer,
+		CreateDatabaseDoer:            doer,
+		GetDatabaseDoer:               doer,
+		UpdateDatabaseDoer:            doer,
+		ApplyUpgradeDoer:              doer,
+		UpgradeDatabaseMajorDoer:      doer,
+		CutoverDatabaseImportDoer:     doer,
+		DeleteDatabaseDoer:            doer,
+		BackupDatabaseNodeDoer:        doer,
+		SwitchoverDatabaseNodeDoer:    doer,
+		RollingRestartDatabaseDoer:    doer,
+		FailoverDatabaseNodeDoer:      doer,
+		GetNodeQueryStatsDoer:         doer,
+		ResetNodeQueryStatsDoer:       doer,
+		ListDatabaseTasksDoer:         doer,
+		GetDatabaseTaskDoer:           doer,
+		GetDatabaseTaskLogDoer:        doer,
+		ListHostTasksDoer:             doer,
+		GetHostTaskDoer:               doer,
+		GetHostTaskLogDoer:            doer,
+		ListTasksDoer:                 doer,
+		StreamEventsDoer:              doer,
+		CreateWebhookDoer:             doer,
+		ListWebhooksDoer:              doer,
+		GetWebhookDoer:                doer,
+		DeleteWebhookDoer:             doer,
+		ListWebhookDeliveriesDoer:     doer,
+		RestoreDatabaseDoer:           doer,
+		CloneDatabaseDoer:             doer,
+		GetVersionDoer:                doer,
+		RestartInstanceDoer:           doer,
+		GetInstanceSessionsDoer:       doer,
+		CancelInstanceSessionDoer:     doer,
+		TerminateInstanceSessionDoer:  doer,
+		GetInstancePostgresqlConfDoer: doer,
+		StopInstanceDoer:              doer,
+		StartInstanceDoer:             doer,
+		CancelDatabaseTaskDoer:        doer,
+		ResumeDatabaseTaskDoer:        doer,
+		RestoreResponseBody:           restoreBody,
+		scheme:                        scheme,
+		host:                          host,
+		decoder:                       dec,
+		encoder:                       enc,
 	}
 }
 
@@ -306,10 +413,78 @@ func (c *Client) GetCluster() goa.Endpoint {
 	}
 }
 
+// GetStorageUsage returns an endpoint that makes HTTP requests to the
+// control-plane service get-storage-usage server.
+func (c *Client) GetStorageUsage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetStorageUsageRequest(c.encoder)
+		decodeResponse = DecodeGetStorageUsageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetStorageUsageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetStorageUsageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-storage-usage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetEtcdCluster returns an endpoint that makes HTTP requests to the
+// control-plane service get-etcd-cluster server.
+func (c *Client) GetEtcdCluster() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetEtcdClusterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetEtcdClusterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetEtcdClusterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-etcd-cluster", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DefragmentEtcd returns an endpoint that makes HTTP requests to the
+// control-plane service defragment-etcd server.
+func (c *Client) DefragmentEtcd() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDefragmentEtcdRequest(c.encoder)
+		decodeResponse = DecodeDefragmentEtcdResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDefragmentEtcdRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DefragmentEtcdDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "defragment-etcd", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ListHosts returns an endpoint that makes HTTP requests to the control-plane
 // service list-hosts server.
 func (c *Client) ListHosts() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeListHostsRequest(c.encoder)
 		decodeResponse = DecodeListHostsResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -317,6 +492,10 @@ func (c *Client) ListHosts() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ListHostsDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("control-plane", "list-hosts", err)
@@ -368,6 +547,30 @@ func (c *Client) RemoveHost() goa.Endpoint {
 	}
 }
 
+// ChangeHostEtcdMode returns an endpoint that makes HTTP requests to the
+// control-plane service change-host-etcd-mode server.
+func (c *Client) ChangeHostEtcdMode() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeChangeHostEtcdModeRequest(c.encoder)
+		decodeResponse = DecodeChangeHostEtcdModeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildChangeHostEtcdModeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ChangeHostEtcdModeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "change-host-etcd-mode", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ListDatabases returns an endpoint that makes HTTP requests to the
 // control-plane service list-databases server.
 func (c *Client) ListDatabases() goa.Endpoint {
@@ -488,6 +691,49 @@ func (c *Client) ApplyUpgrade() goa.Endpoint {
 	}
 }
 
+// UpgradeDatabaseMajor returns an endpoint that makes HTTP requests to the
+// control-plane service upgrade-database-major server.
+func (c *Client) UpgradeDatabaseMajor() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpgradeDatabaseMajorRequest(c.encoder)
+		decodeResponse = DecodeUpgradeDatabaseMajorResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpgradeDatabaseMajorRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpgradeDatabaseMajorDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "upgrade-database-major", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CutoverDatabaseImport returns an endpoint that makes HTTP requests to the
+// control-plane service cutover-database-import server.
+func (c *Client) CutoverDatabaseImport() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCutoverDatabaseImportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCutoverDatabaseImportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CutoverDatabaseImportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "cutover-database-import", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DeleteDatabase returns an endpoint that makes HTTP requests to the
 // control-plane service delete-database server.
 func (c *Client) DeleteDatabase() goa.Endpoint {
@@ -560,6 +806,30 @@ func (c *Client) SwitchoverDatabaseNode() goa.Endpoint {
 	}
 }
 
+// RollingRestartDatabase returns an endpoint that makes HTTP requests to the
+// control-plane service rolling-restart-database server.
+func (c *Client) RollingRestartDatabase() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRollingRestartDatabaseRequest(c.encoder)
+		decodeResponse = DecodeRollingRestartDatabaseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRollingRestartDatabaseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RollingRestartDatabaseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "rolling-restart-database", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // FailoverDatabaseNode returns an endpoint that makes HTTP requests to the
 // control-plane service failover-database-node server.
 func (c *Client) FailoverDatabaseNode() goa.Endpoint {
@@ -584,6 +854,49 @@ func (c *Client) FailoverDatabaseNode() goa.Endpoint {
 	}
 }
 
+// GetNodeQueryStats returns an endpoint that makes HTTP requests to the
+// control-plane service get-node-query-stats server.
+func (c *Client) GetNodeQueryStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetNodeQueryStatsRequest(c.encoder)
+		decodeResponse = DecodeGetNodeQueryStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetNodeQueryStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetNodeQueryStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-node-query-stats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ResetNodeQueryStats returns an endpoint that makes HTTP requests to the
+// control-plane service reset-node-query-stats server.
+func (c *Client) ResetNodeQueryStats() goa.Endpoint {
+	var (
+		decodeResponse = DecodeResetNodeQueryStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResetNodeQueryStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResetNodeQueryStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "reset-node-query-stats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ListDatabaseTasks returns an endpoint that makes HTTP requests to the
 // control-plane service list-database-tasks server.
 func (c *Client) ListDatabaseTasks() goa.Endpoint {
@@ -742,6 +1055,147 @@ func (c *Client) ListTasks() goa.Endpoint {
 	}
 }
 
+// StreamEvents returns an endpoint that makes HTTP requests to the
+// control-plane service stream-events server.
+func (c *Client) StreamEvents() goa.Endpoint {
+	var (
+		encodeRequest = EncodeStreamEventsRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStreamEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.StreamEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "stream-events", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewStreamEventsStream(resp, c.decoder), nil
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the
+// control-plane service create-webhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "create-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the
+// control-plane service list-webhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "list-webhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWebhook returns an endpoint that makes HTTP requests to the control-plane
+// service get-webhook server.
+func (c *Client) GetWebhook() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the
+// control-plane service delete-webhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "delete-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// control-plane service list-webhook-deliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "list-webhook-deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // RestoreDatabase returns an endpoint that makes HTTP requests to the
 // control-plane service restore-database server.
 func (c *Client) RestoreDatabase() goa.Endpoint {
@@ -766,6 +1220,30 @@ func (c *Client) RestoreDatabase() goa.Endpoint {
 	}
 }
 
+// CloneDatabase returns an endpoint that makes HTTP requests to the
+// control-plane service clone-database server.
+func (c *Client) CloneDatabase() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCloneDatabaseRequest(c.encoder)
+		decodeResponse = DecodeCloneDatabaseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCloneDatabaseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CloneDatabaseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "clone-database", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetVersion returns an endpoint that makes HTTP requests to the control-plane
 // service get-version server.
 func (c *Client) GetVersion() goa.Endpoint {
@@ -809,6 +1287,82 @@ func (c *Client) RestartInstance() goa.Endpoint {
 	}
 }
 
+// GetInstanceSessions returns an endpoint that makes HTTP requests to the
+// control-plane service get-instance-sessions server.
+func (c *Client) GetInstanceSessions() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetInstanceSessionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetInstanceSessionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetInstanceSessionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-instance-sessions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelInstanceSession returns an endpoint that makes HTTP requests to the
+// control-plane service cancel-instance-session server.
+func (c *Client) CancelInstanceSession() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCancelInstanceSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelInstanceSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelInstanceSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "cancel-instance-session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// TerminateInstanceSession returns an endpoint that makes HTTP requests to the
+// control-plane service terminate-instance-session server.
+func (c *Client) TerminateInstanceSession() goa.Endpoint {
+	var (
+		decodeResponse = DecodeTerminateInstanceSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTerminateInstanceSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TerminateInstanceSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "terminate-instance-session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetInstancePostgresqlConf returns an endpoint that makes HTTP requests to
+// the control-plane service get-instance-postgresql-conf server.
+func (c *Client) GetInstancePostgresqlConf() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetInstancePostgresqlConfResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetInstancePostgresqlConfRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetInstancePostgresqlConfDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "get-instance-postgresql-conf", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // StopInstance returns an endpoint that makes HTTP requests to the
 // control-plane service stop-instance server.
 func (c *Client) StopInstance() goa.Endpoint {
@@ -875,3 +1429,22 @@ func (c *Client) CancelDatabaseTask() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ResumeDatabaseTask returns an endpoint that makes HTTP requests to the
+// control-plane service resume-database-task server.
+func (c *Client) ResumeDatabaseTask() goa.Endpoint {
+	var (
+		decodeResponse = DecodeResumeDatabaseTaskResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResumeDatabaseTaskRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResumeDatabaseTaskDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("control-plane", "resume-database-task", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -565,6 +565,315 @@ func DecodeGetClusterResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
+// BuildGetStorageUsageRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "get-storage-usage" endpoint
+func (c *Client) BuildGetStorageUsageRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetStorageUsageControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-storage-usage", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetStorageUsageRequest returns an encoder for requests sent to the
+// control-plane get-storage-usage server.
+func EncodeGetStorageUsageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.GetStorageUsagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "get-storage-usage", "*controlplane.GetStorageUsagePayload", v)
+		}
+		values := req.URL.Query()
+		if p.Depth != nil {
+			values.Add("depth", fmt.Sprintf("%v", *p.Depth))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetStorageUsageResponse returns a decoder for responses returned by
+// the control-plane get-storage-usage endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetStorageUsageResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetStorageUsageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetStorageUsageResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-storage-usage", err)
+			}
+			err = ValidateGetStorageUsageResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-storage-usage", err)
+			}
+			res := NewGetStorageUsageStorageUsageOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetStorageUsageClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-storage-usage", err)
+			}
+			err = ValidateGetStorageUsageClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-storage-usage", err)
+			}
+			return nil, NewGetStorageUsageClusterNotInitialized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetStorageUsageServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-storage-usage", err)
+			}
+			err = ValidateGetStorageUsageServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-storage-usage", err)
+			}
+			return nil, NewGetStorageUsageServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-storage-usage", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetEtcdClusterRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "get-etcd-cluster" endpoint
+func (c *Client) BuildGetEtcdClusterRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetEtcdClusterControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-etcd-cluster", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetEtcdClusterResponse returns a decoder for responses returned by the
+// control-plane get-etcd-cluster endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetEtcdClusterResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetEtcdClusterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetEtcdClusterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-etcd-cluster", err)
+			}
+			err = ValidateGetEtcdClusterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-etcd-cluster", err)
+			}
+			res := NewGetEtcdClusterEtcdClusterOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetEtcdClusterClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-etcd-cluster", err)
+			}
+			err = ValidateGetEtcdClusterClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-etcd-cluster", err)
+			}
+			return nil, NewGetEtcdClusterClusterNotInitialized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetEtcdClusterServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-etcd-cluster", err)
+			}
+			err = ValidateGetEtcdClusterServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-etcd-cluster", err)
+			}
+			return nil, NewGetEtcdClusterServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-etcd-cluster", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDefragmentEtcdRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "defragment-etcd" endpoint
+func (c *Client) BuildDefragmentEtcdRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DefragmentEtcdControlPlanePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "defragment-etcd", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDefragmentEtcdRequest returns an encoder for requests sent to the
+// control-plane defragment-etcd server.
+func EncodeDefragmentEtcdRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.DefragmentEtcdRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "defragment-etcd", "*controlplane.DefragmentEtcdRequest", v)
+		}
+		body := NewDefragmentEtcdRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "defragment-etcd", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDefragmentEtcdResponse returns a decoder for responses returned by the
+// control-plane defragment-etcd endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDefragmentEtcdResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDefragmentEtcdResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DefragmentEtcdResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "defragment-etcd", err)
+			}
+			err = ValidateDefragmentEtcdResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "defragment-etcd", err)
+			}
+			res := NewDefragmentEtcdResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body DefragmentEtcdClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "defragment-etcd", err)
+			}
+			err = ValidateDefragmentEtcdClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "defragment-etcd", err)
+			}
+			return nil, NewDefragmentEtcdClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body DefragmentEtcdInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "defragment-etcd", err)
+			}
+			err = ValidateDefragmentEtcdInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "defragment-etcd", err)
+			}
+			return nil, NewDefragmentEtcdInvalidInput(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DefragmentEtcdServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "defragment-etcd", err)
+			}
+			err = ValidateDefragmentEtcdServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "defragment-etcd", err)
+			}
+			return nil, NewDefragmentEtcdServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "defragment-etcd", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildListHostsRequest instantiates a HTTP request object with method and
 // path set to call the "control-plane" service "list-hosts" endpoint
 func (c *Client) BuildListHostsRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -580,11 +889,38 @@ func (c *Client) BuildListHostsRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
+// EncodeListHostsRequest returns an encoder for requests sent to the
+// control-plane list-hosts server.
+func EncodeListHostsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.ListHostsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "list-hosts", "*controlplane.ListHostsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", string(*p.Cursor))
+		}
+		for _, value := range p.State {
+			values.Add("state", value)
+		}
+		if p.PostgresVersion != nil {
+			values.Add("postgres_version", *p.PostgresVersion)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeListHostsResponse returns a decoder for responses returned by the
 // control-plane list-hosts endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeListHostsResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
 func DecodeListHostsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -631,6 +967,20 @@ func DecodeListHostsResponse(decoder func(*http.Response) goahttp.Decoder, resto
 				return nil, goahttp.ErrValidationError("control-plane", "list-hosts", err)
 			}
 			return nil, NewListHostsClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListHostsInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-hosts", err)
+			}
+			err = ValidateListHostsInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-hosts", err)
+			}
+			return nil, NewListHostsInvalidInput(&body)
 		case http.StatusInternalServerError:
 			var (
 				body ListHostsServerErrorResponseBody
@@ -921,20 +1271,164 @@ func DecodeRemoveHostResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
-// BuildListDatabasesRequest instantiates a HTTP request object with method and
-// path set to call the "control-plane" service "list-databases" endpoint
-func (c *Client) BuildListDatabasesRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDatabasesControlPlanePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "list-databases", u.String(), err)
-	}
-	if ctx != nil {
-		req = req.WithContext(ctx)
-	}
-
-	return req, nil
-}
+// BuildChangeHostEtcdModeRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "change-host-etcd-mode" endpoint
+func (c *Client) BuildChangeHostEtcdModeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		hostID string
+	)
+	{
+		p, ok := v.(*controlplane.ChangeHostEtcdModePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "change-host-etcd-mode", "*controlplane.ChangeHostEtcdModePayload", v)
+		}
+		hostID = string(p.HostID)
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ChangeHostEtcdModeControlPlanePath(hostID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "change-host-etcd-mode", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeChangeHostEtcdModeRequest returns an encoder for requests sent to the
+// control-plane change-host-etcd-mode server.
+func EncodeChangeHostEtcdModeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.ChangeHostEtcdModePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "change-host-etcd-mode", "*controlplane.ChangeHostEtcdModePayload", v)
+		}
+		body := NewChangeHostEtcdModeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "change-host-etcd-mode", err)
+		}
+		return nil
+	}
+}
+
+// DecodeChangeHostEtcdModeResponse returns a decoder for responses returned by
+// the control-plane change-host-etcd-mode endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeChangeHostEtcdModeResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeChangeHostEtcdModeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ChangeHostEtcdModeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "change-host-etcd-mode", err)
+			}
+			err = ValidateChangeHostEtcdModeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "change-host-etcd-mode", err)
+			}
+			res := NewChangeHostEtcdModeResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ChangeHostEtcdModeClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "change-host-etcd-mode", err)
+			}
+			err = ValidateChangeHostEtcdModeClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "change-host-etcd-mode", err)
+			}
+			return nil, NewChangeHostEtcdModeClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ChangeHostEtcdModeInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "change-host-etcd-mode", err)
+			}
+			err = ValidateChangeHostEtcdModeInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "change-host-etcd-mode", err)
+			}
+			return nil, NewChangeHostEtcdModeInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body ChangeHostEtcdModeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "change-host-etcd-mode", err)
+			}
+			err = ValidateChangeHostEtcdModeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "change-host-etcd-mode", err)
+			}
+			return nil, NewChangeHostEtcdModeNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ChangeHostEtcdModeServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "change-host-etcd-mode", err)
+			}
+			err = ValidateChangeHostEtcdModeServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "change-host-etcd-mode", err)
+			}
+			return nil, NewChangeHostEtcdModeServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "change-host-etcd-mode", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDatabasesRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "list-databases" endpoint
+func (c *Client) BuildListDatabasesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDatabasesControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-databases", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
 
 // EncodeListDatabasesRequest returns an encoder for requests sent to the
 // control-plane list-databases server.
@@ -948,6 +1442,25 @@ func EncodeListDatabasesRequest(encoder func(*http.Request) goahttp.Encoder) fun
 		for _, value := range p.Include {
 			values.Add("include", value)
 		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", string(*p.Cursor))
+		}
+		if p.TenantID != nil {
+			values.Add("tenant_id", string(*p.TenantID))
+		}
+		for _, value := range p.State {
+			values.Add("state", value)
+		}
+		if p.HostID != nil {
+			values.Add("host_id", string(*p.HostID))
+		}
+		if p.PostgresVersion != nil {
+			values.Add("postgres_version", *p.PostgresVersion)
+		}
+		values.Add("view", p.View)
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -958,6 +1471,7 @@ func EncodeListDatabasesRequest(encoder func(*http.Request) goahttp.Encoder) fun
 // response body should be restored after having been read.
 // DecodeListDatabasesResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
 func DecodeListDatabasesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -1004,6 +1518,20 @@ func DecodeListDatabasesResponse(decoder func(*http.Response) goahttp.Decoder, r
 				return nil, goahttp.ErrValidationError("control-plane", "list-databases", err)
 			}
 			return nil, NewListDatabasesClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListDatabasesInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-databases", err)
+			}
+			err = ValidateListDatabasesInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-databases", err)
+			}
+			return nil, NewListDatabasesInvalidInput(&body)
 		case http.StatusInternalServerError:
 			var (
 				body ListDatabasesServerErrorResponseBody
@@ -1690,23 +2218,24 @@ func DecodeApplyUpgradeResponse(decoder func(*http.Response) goahttp.Decoder, re
 	}
 }
 
-// BuildDeleteDatabaseRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "delete-database" endpoint
-func (c *Client) BuildDeleteDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildUpgradeDatabaseMajorRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "upgrade-database-major" endpoint
+func (c *Client) BuildUpgradeDatabaseMajorRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
 	)
 	{
-		p, ok := v.(*controlplane.DeleteDatabasePayload)
+		p, ok := v.(*controlplane.UpgradeDatabaseMajorPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "delete-database", "*controlplane.DeleteDatabasePayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "upgrade-database-major", "*controlplane.UpgradeDatabaseMajorPayload", v)
 		}
 		databaseID = string(p.DatabaseID)
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteDatabaseControlPlanePath(databaseID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpgradeDatabaseMajorControlPlanePath(databaseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "delete-database", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "upgrade-database-major", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1715,25 +2244,26 @@ func (c *Client) BuildDeleteDatabaseRequest(ctx context.Context, v any) (*http.R
 	return req, nil
 }
 
-// EncodeDeleteDatabaseRequest returns an encoder for requests sent to the
-// control-plane delete-database server.
-func EncodeDeleteDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUpgradeDatabaseMajorRequest returns an encoder for requests sent to
+// the control-plane upgrade-database-major server.
+func EncodeUpgradeDatabaseMajorRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.DeleteDatabasePayload)
+		p, ok := v.(*controlplane.UpgradeDatabaseMajorPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "delete-database", "*controlplane.DeleteDatabasePayload", v)
+			return goahttp.ErrInvalidType("control-plane", "upgrade-database-major", "*controlplane.UpgradeDatabaseMajorPayload", v)
+		}
+		body := NewUpgradeDatabaseMajorRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "upgrade-database-major", err)
 		}
-		values := req.URL.Query()
-		values.Add("force", fmt.Sprintf("%v", p.Force))
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeDeleteDatabaseResponse returns a decoder for responses returned by the
-// control-plane delete-database endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeDeleteDatabaseResponse may return the following errors:
+// DecodeUpgradeDatabaseMajorResponse returns a decoder for responses returned
+// by the control-plane upgrade-database-major endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpgradeDatabaseMajorResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
 //   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
@@ -1741,7 +2271,7 @@ func EncodeDeleteDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) fu
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeDeleteDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUpgradeDatabaseMajorResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1758,137 +2288,135 @@ func DecodeDeleteDatabaseResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body DeleteDatabaseResponseBody
+				body UpgradeDatabaseMajorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 			}
-			err = ValidateDeleteDatabaseResponseBody(&body)
+			err = ValidateUpgradeDatabaseMajorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 			}
-			res := NewDeleteDatabaseResponseOK(&body)
+			res := NewUpgradeDatabaseMajorResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			en := resp.Header.Get("goa-error")
 			switch en {
 			case "cluster_not_initialized":
 				var (
-					body DeleteDatabaseClusterNotInitializedResponseBody
+					body UpgradeDatabaseMajorClusterNotInitializedResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 				}
-				err = ValidateDeleteDatabaseClusterNotInitializedResponseBody(&body)
+				err = ValidateUpgradeDatabaseMajorClusterNotInitializedResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 				}
-				return nil, NewDeleteDatabaseClusterNotInitialized(&body)
+				return nil, NewUpgradeDatabaseMajorClusterNotInitialized(&body)
 			case "database_not_modifiable":
 				var (
-					body DeleteDatabaseDatabaseNotModifiableResponseBody
+					body UpgradeDatabaseMajorDatabaseNotModifiableResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 				}
-				err = ValidateDeleteDatabaseDatabaseNotModifiableResponseBody(&body)
+				err = ValidateUpgradeDatabaseMajorDatabaseNotModifiableResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 				}
-				return nil, NewDeleteDatabaseDatabaseNotModifiable(&body)
+				return nil, NewUpgradeDatabaseMajorDatabaseNotModifiable(&body)
 			case "operation_already_in_progress":
 				var (
-					body DeleteDatabaseOperationAlreadyInProgressResponseBody
+					body UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 				}
-				err = ValidateDeleteDatabaseOperationAlreadyInProgressResponseBody(&body)
+				err = ValidateUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+					return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 				}
-				return nil, NewDeleteDatabaseOperationAlreadyInProgress(&body)
+				return nil, NewUpgradeDatabaseMajorOperationAlreadyInProgress(&body)
 			default:
 				body, _ := io.ReadAll(resp.Body)
-				return nil, goahttp.ErrInvalidResponse("control-plane", "delete-database", resp.StatusCode, string(body))
+				return nil, goahttp.ErrInvalidResponse("control-plane", "upgrade-database-major", resp.StatusCode, string(body))
 			}
 		case http.StatusBadRequest:
 			var (
-				body DeleteDatabaseInvalidInputResponseBody
+				body UpgradeDatabaseMajorInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 			}
-			err = ValidateDeleteDatabaseInvalidInputResponseBody(&body)
+			err = ValidateUpgradeDatabaseMajorInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 			}
-			return nil, NewDeleteDatabaseInvalidInput(&body)
+			return nil, NewUpgradeDatabaseMajorInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteDatabaseNotFoundResponseBody
+				body UpgradeDatabaseMajorNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 			}
-			err = ValidateDeleteDatabaseNotFoundResponseBody(&body)
+			err = ValidateUpgradeDatabaseMajorNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 			}
-			return nil, NewDeleteDatabaseNotFound(&body)
+			return nil, NewUpgradeDatabaseMajorNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteDatabaseServerErrorResponseBody
+				body UpgradeDatabaseMajorServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "upgrade-database-major", err)
 			}
-			err = ValidateDeleteDatabaseServerErrorResponseBody(&body)
+			err = ValidateUpgradeDatabaseMajorServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "upgrade-database-major", err)
 			}
-			return nil, NewDeleteDatabaseServerError(&body)
+			return nil, NewUpgradeDatabaseMajorServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "delete-database", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "upgrade-database-major", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildBackupDatabaseNodeRequest instantiates a HTTP request object with
+// BuildCutoverDatabaseImportRequest instantiates a HTTP request object with
 // method and path set to call the "control-plane" service
-// "backup-database-node" endpoint
-func (c *Client) BuildBackupDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
+// "cutover-database-import" endpoint
+func (c *Client) BuildCutoverDatabaseImportRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
-		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.BackupDatabaseNodePayload)
+		p, ok := v.(*controlplane.CutoverDatabaseImportPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "backup-database-node", "*controlplane.BackupDatabaseNodePayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "cutover-database-import", "*controlplane.CutoverDatabaseImportPayload", v)
 		}
 		databaseID = string(p.DatabaseID)
-		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BackupDatabaseNodeControlPlanePath(databaseID, nodeName)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CutoverDatabaseImportControlPlanePath(databaseID)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "backup-database-node", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "cutover-database-import", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1897,29 +2425,10 @@ func (c *Client) BuildBackupDatabaseNodeRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeBackupDatabaseNodeRequest returns an encoder for requests sent to the
-// control-plane backup-database-node server.
-func EncodeBackupDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.BackupDatabaseNodePayload)
-		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "backup-database-node", "*controlplane.BackupDatabaseNodePayload", v)
-		}
-		values := req.URL.Query()
-		values.Add("force", fmt.Sprintf("%v", p.Force))
-		req.URL.RawQuery = values.Encode()
-		body := NewBackupDatabaseNodeRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("control-plane", "backup-database-node", err)
-		}
-		return nil
-	}
-}
-
-// DecodeBackupDatabaseNodeResponse returns a decoder for responses returned by
-// the control-plane backup-database-node endpoint. restoreBody controls
+// DecodeCutoverDatabaseImportResponse returns a decoder for responses returned
+// by the control-plane cutover-database-import endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeBackupDatabaseNodeResponse may return the following errors:
+// DecodeCutoverDatabaseImportResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
 //   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
@@ -1927,7 +2436,7 @@ func EncodeBackupDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeBackupDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCutoverDatabaseImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1944,137 +2453,134 @@ func DecodeBackupDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decod
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body BackupDatabaseNodeResponseBody
+				body CutoverDatabaseImportResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 			}
-			err = ValidateBackupDatabaseNodeResponseBody(&body)
+			err = ValidateCutoverDatabaseImportResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 			}
-			res := NewBackupDatabaseNodeResponseOK(&body)
+			res := NewCutoverDatabaseImportResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			en := resp.Header.Get("goa-error")
 			switch en {
 			case "cluster_not_initialized":
 				var (
-					body BackupDatabaseNodeClusterNotInitializedResponseBody
+					body CutoverDatabaseImportClusterNotInitializedResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 				}
-				err = ValidateBackupDatabaseNodeClusterNotInitializedResponseBody(&body)
+				err = ValidateCutoverDatabaseImportClusterNotInitializedResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 				}
-				return nil, NewBackupDatabaseNodeClusterNotInitialized(&body)
+				return nil, NewCutoverDatabaseImportClusterNotInitialized(&body)
 			case "database_not_modifiable":
 				var (
-					body BackupDatabaseNodeDatabaseNotModifiableResponseBody
+					body CutoverDatabaseImportDatabaseNotModifiableResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 				}
-				err = ValidateBackupDatabaseNodeDatabaseNotModifiableResponseBody(&body)
+				err = ValidateCutoverDatabaseImportDatabaseNotModifiableResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 				}
-				return nil, NewBackupDatabaseNodeDatabaseNotModifiable(&body)
+				return nil, NewCutoverDatabaseImportDatabaseNotModifiable(&body)
 			case "operation_already_in_progress":
 				var (
-					body BackupDatabaseNodeOperationAlreadyInProgressResponseBody
+					body CutoverDatabaseImportOperationAlreadyInProgressResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 				}
-				err = ValidateBackupDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
+				err = ValidateCutoverDatabaseImportOperationAlreadyInProgressResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 				}
-				return nil, NewBackupDatabaseNodeOperationAlreadyInProgress(&body)
+				return nil, NewCutoverDatabaseImportOperationAlreadyInProgress(&body)
 			default:
 				body, _ := io.ReadAll(resp.Body)
-				return nil, goahttp.ErrInvalidResponse("control-plane", "backup-database-node", resp.StatusCode, string(body))
+				return nil, goahttp.ErrInvalidResponse("control-plane", "cutover-database-import", resp.StatusCode, string(body))
 			}
 		case http.StatusBadRequest:
 			var (
-				body BackupDatabaseNodeInvalidInputResponseBody
+				body CutoverDatabaseImportInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 			}
-			err = ValidateBackupDatabaseNodeInvalidInputResponseBody(&body)
+			err = ValidateCutoverDatabaseImportInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 			}
-			return nil, NewBackupDatabaseNodeInvalidInput(&body)
+			return nil, NewCutoverDatabaseImportInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body BackupDatabaseNodeNotFoundResponseBody
+				body CutoverDatabaseImportNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 			}
-			err = ValidateBackupDatabaseNodeNotFoundResponseBody(&body)
+			err = ValidateCutoverDatabaseImportNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 			}
-			return nil, NewBackupDatabaseNodeNotFound(&body)
+			return nil, NewCutoverDatabaseImportNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body BackupDatabaseNodeServerErrorResponseBody
+				body CutoverDatabaseImportServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cutover-database-import", err)
 			}
-			err = ValidateBackupDatabaseNodeServerErrorResponseBody(&body)
+			err = ValidateCutoverDatabaseImportServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cutover-database-import", err)
 			}
-			return nil, NewBackupDatabaseNodeServerError(&body)
+			return nil, NewCutoverDatabaseImportServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "backup-database-node", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "cutover-database-import", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildSwitchoverDatabaseNodeRequest instantiates a HTTP request object with
-// method and path set to call the "control-plane" service
-// "switchover-database-node" endpoint
-func (c *Client) BuildSwitchoverDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildDeleteDatabaseRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "delete-database" endpoint
+func (c *Client) BuildDeleteDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
-		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.SwitchoverDatabaseNodePayload)
+		p, ok := v.(*controlplane.DeleteDatabasePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "switchover-database-node", "*controlplane.SwitchoverDatabaseNodePayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "delete-database", "*controlplane.DeleteDatabasePayload", v)
 		}
 		databaseID = string(p.DatabaseID)
-		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SwitchoverDatabaseNodeControlPlanePath(databaseID, nodeName)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteDatabaseControlPlanePath(databaseID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "switchover-database-node", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "delete-database", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2083,26 +2589,25 @@ func (c *Client) BuildSwitchoverDatabaseNodeRequest(ctx context.Context, v any)
 	return req, nil
 }
 
-// EncodeSwitchoverDatabaseNodeRequest returns an encoder for requests sent to
-// the control-plane switchover-database-node server.
-func EncodeSwitchoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeDeleteDatabaseRequest returns an encoder for requests sent to the
+// control-plane delete-database server.
+func EncodeDeleteDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.SwitchoverDatabaseNodePayload)
+		p, ok := v.(*controlplane.DeleteDatabasePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "switchover-database-node", "*controlplane.SwitchoverDatabaseNodePayload", v)
-		}
-		body := NewSwitchoverDatabaseNodeRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("control-plane", "switchover-database-node", err)
+			return goahttp.ErrInvalidType("control-plane", "delete-database", "*controlplane.DeleteDatabasePayload", v)
 		}
+		values := req.URL.Query()
+		values.Add("force", fmt.Sprintf("%v", p.Force))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeSwitchoverDatabaseNodeResponse returns a decoder for responses
-// returned by the control-plane switchover-database-node endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeSwitchoverDatabaseNodeResponse may return the following errors:
+// DecodeDeleteDatabaseResponse returns a decoder for responses returned by the
+// control-plane delete-database endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteDatabaseResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
 //   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
@@ -2110,7 +2615,7 @@ func EncodeSwitchoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Enc
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeSwitchoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeDeleteDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2127,137 +2632,137 @@ func DecodeSwitchoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.D
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body SwitchoverDatabaseNodeResponseBody
+				body DeleteDatabaseResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 			}
-			err = ValidateSwitchoverDatabaseNodeResponseBody(&body)
+			err = ValidateDeleteDatabaseResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 			}
-			res := NewSwitchoverDatabaseNodeResponseOK(&body)
+			res := NewDeleteDatabaseResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			en := resp.Header.Get("goa-error")
 			switch en {
 			case "cluster_not_initialized":
 				var (
-					body SwitchoverDatabaseNodeClusterNotInitializedResponseBody
+					body DeleteDatabaseClusterNotInitializedResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 				}
-				err = ValidateSwitchoverDatabaseNodeClusterNotInitializedResponseBody(&body)
+				err = ValidateDeleteDatabaseClusterNotInitializedResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 				}
-				return nil, NewSwitchoverDatabaseNodeClusterNotInitialized(&body)
+				return nil, NewDeleteDatabaseClusterNotInitialized(&body)
 			case "database_not_modifiable":
 				var (
-					body SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody
+					body DeleteDatabaseDatabaseNotModifiableResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 				}
-				err = ValidateSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(&body)
+				err = ValidateDeleteDatabaseDatabaseNotModifiableResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 				}
-				return nil, NewSwitchoverDatabaseNodeDatabaseNotModifiable(&body)
+				return nil, NewDeleteDatabaseDatabaseNotModifiable(&body)
 			case "operation_already_in_progress":
 				var (
-					body SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody
+					body DeleteDatabaseOperationAlreadyInProgressResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 				}
-				err = ValidateSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
+				err = ValidateDeleteDatabaseOperationAlreadyInProgressResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 				}
-				return nil, NewSwitchoverDatabaseNodeOperationAlreadyInProgress(&body)
+				return nil, NewDeleteDatabaseOperationAlreadyInProgress(&body)
 			default:
 				body, _ := io.ReadAll(resp.Body)
-				return nil, goahttp.ErrInvalidResponse("control-plane", "switchover-database-node", resp.StatusCode, string(body))
+				return nil, goahttp.ErrInvalidResponse("control-plane", "delete-database", resp.StatusCode, string(body))
 			}
 		case http.StatusBadRequest:
 			var (
-				body SwitchoverDatabaseNodeInvalidInputResponseBody
+				body DeleteDatabaseInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 			}
-			err = ValidateSwitchoverDatabaseNodeInvalidInputResponseBody(&body)
+			err = ValidateDeleteDatabaseInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 			}
-			return nil, NewSwitchoverDatabaseNodeInvalidInput(&body)
+			return nil, NewDeleteDatabaseInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body SwitchoverDatabaseNodeNotFoundResponseBody
+				body DeleteDatabaseNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 			}
-			err = ValidateSwitchoverDatabaseNodeNotFoundResponseBody(&body)
+			err = ValidateDeleteDatabaseNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 			}
-			return nil, NewSwitchoverDatabaseNodeNotFound(&body)
+			return nil, NewDeleteDatabaseNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body SwitchoverDatabaseNodeServerErrorResponseBody
+				body DeleteDatabaseServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-database", err)
 			}
-			err = ValidateSwitchoverDatabaseNodeServerErrorResponseBody(&body)
+			err = ValidateDeleteDatabaseServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "delete-database", err)
 			}
-			return nil, NewSwitchoverDatabaseNodeServerError(&body)
+			return nil, NewDeleteDatabaseServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "switchover-database-node", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "delete-database", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildFailoverDatabaseNodeRequest instantiates a HTTP request object with
+// BuildBackupDatabaseNodeRequest instantiates a HTTP request object with
 // method and path set to call the "control-plane" service
-// "failover-database-node" endpoint
-func (c *Client) BuildFailoverDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
+// "backup-database-node" endpoint
+func (c *Client) BuildBackupDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
 		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.FailoverDatabaseNodeRequest)
+		p, ok := v.(*controlplane.BackupDatabaseNodePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "failover-database-node", "*controlplane.FailoverDatabaseNodeRequest", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "backup-database-node", "*controlplane.BackupDatabaseNodePayload", v)
 		}
 		databaseID = string(p.DatabaseID)
 		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FailoverDatabaseNodeControlPlanePath(databaseID, nodeName)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BackupDatabaseNodeControlPlanePath(databaseID, nodeName)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "failover-database-node", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "backup-database-node", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2266,26 +2771,29 @@ func (c *Client) BuildFailoverDatabaseNodeRequest(ctx context.Context, v any) (*
 	return req, nil
 }
 
-// EncodeFailoverDatabaseNodeRequest returns an encoder for requests sent to
-// the control-plane failover-database-node server.
-func EncodeFailoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeBackupDatabaseNodeRequest returns an encoder for requests sent to the
+// control-plane backup-database-node server.
+func EncodeBackupDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.FailoverDatabaseNodeRequest)
+		p, ok := v.(*controlplane.BackupDatabaseNodePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "failover-database-node", "*controlplane.FailoverDatabaseNodeRequest", v)
+			return goahttp.ErrInvalidType("control-plane", "backup-database-node", "*controlplane.BackupDatabaseNodePayload", v)
 		}
-		body := NewFailoverDatabaseNodeRequestBody(p)
+		values := req.URL.Query()
+		values.Add("force", fmt.Sprintf("%v", p.Force))
+		req.URL.RawQuery = values.Encode()
+		body := NewBackupDatabaseNodeRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("control-plane", "failover-database-node", err)
+			return goahttp.ErrEncodingError("control-plane", "backup-database-node", err)
 		}
 		return nil
 	}
 }
 
-// DecodeFailoverDatabaseNodeResponse returns a decoder for responses returned
-// by the control-plane failover-database-node endpoint. restoreBody controls
+// DecodeBackupDatabaseNodeResponse returns a decoder for responses returned by
+// the control-plane backup-database-node endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeFailoverDatabaseNodeResponse may return the following errors:
+// DecodeBackupDatabaseNodeResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
 //   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
@@ -2293,7 +2801,7 @@ func EncodeFailoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encod
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeFailoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeBackupDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2310,135 +2818,137 @@ func DecodeFailoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.Dec
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body FailoverDatabaseNodeResponseBody
+				body BackupDatabaseNodeResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 			}
-			err = ValidateFailoverDatabaseNodeResponseBody(&body)
+			err = ValidateBackupDatabaseNodeResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 			}
-			res := NewFailoverDatabaseNodeResponseOK(&body)
+			res := NewBackupDatabaseNodeResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			en := resp.Header.Get("goa-error")
 			switch en {
 			case "cluster_not_initialized":
 				var (
-					body FailoverDatabaseNodeClusterNotInitializedResponseBody
+					body BackupDatabaseNodeClusterNotInitializedResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 				}
-				err = ValidateFailoverDatabaseNodeClusterNotInitializedResponseBody(&body)
+				err = ValidateBackupDatabaseNodeClusterNotInitializedResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 				}
-				return nil, NewFailoverDatabaseNodeClusterNotInitialized(&body)
+				return nil, NewBackupDatabaseNodeClusterNotInitialized(&body)
 			case "database_not_modifiable":
 				var (
-					body FailoverDatabaseNodeDatabaseNotModifiableResponseBody
+					body BackupDatabaseNodeDatabaseNotModifiableResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 				}
-				err = ValidateFailoverDatabaseNodeDatabaseNotModifiableResponseBody(&body)
+				err = ValidateBackupDatabaseNodeDatabaseNotModifiableResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 				}
-				return nil, NewFailoverDatabaseNodeDatabaseNotModifiable(&body)
+				return nil, NewBackupDatabaseNodeDatabaseNotModifiable(&body)
 			case "operation_already_in_progress":
 				var (
-					body FailoverDatabaseNodeOperationAlreadyInProgressResponseBody
+					body BackupDatabaseNodeOperationAlreadyInProgressResponseBody
 					err  error
 				)
 				err = decoder(resp).Decode(&body)
 				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 				}
-				err = ValidateFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
+				err = ValidateBackupDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
 				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+					return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 				}
-				return nil, NewFailoverDatabaseNodeOperationAlreadyInProgress(&body)
+				return nil, NewBackupDatabaseNodeOperationAlreadyInProgress(&body)
 			default:
 				body, _ := io.ReadAll(resp.Body)
-				return nil, goahttp.ErrInvalidResponse("control-plane", "failover-database-node", resp.StatusCode, string(body))
+				return nil, goahttp.ErrInvalidResponse("control-plane", "backup-database-node", resp.StatusCode, string(body))
 			}
 		case http.StatusBadRequest:
 			var (
-				body FailoverDatabaseNodeInvalidInputResponseBody
+				body BackupDatabaseNodeInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 			}
-			err = ValidateFailoverDatabaseNodeInvalidInputResponseBody(&body)
+			err = ValidateBackupDatabaseNodeInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 			}
-			return nil, NewFailoverDatabaseNodeInvalidInput(&body)
+			return nil, NewBackupDatabaseNodeInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body FailoverDatabaseNodeNotFoundResponseBody
+				body BackupDatabaseNodeNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 			}
-			err = ValidateFailoverDatabaseNodeNotFoundResponseBody(&body)
+			err = ValidateBackupDatabaseNodeNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 			}
-			return nil, NewFailoverDatabaseNodeNotFound(&body)
+			return nil, NewBackupDatabaseNodeNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body FailoverDatabaseNodeServerErrorResponseBody
+				body BackupDatabaseNodeServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "backup-database-node", err)
 			}
-			err = ValidateFailoverDatabaseNodeServerErrorResponseBody(&body)
+			err = ValidateBackupDatabaseNodeServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				return nil, goahttp.ErrValidationError("control-plane", "backup-database-node", err)
 			}
-			return nil, NewFailoverDatabaseNodeServerError(&body)
+			return nil, NewBackupDatabaseNodeServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "failover-database-node", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "backup-database-node", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListDatabaseTasksRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "list-database-tasks"
-// endpoint
-func (c *Client) BuildListDatabaseTasksRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildSwitchoverDatabaseNodeRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "switchover-database-node" endpoint
+func (c *Client) BuildSwitchoverDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
+		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.ListDatabaseTasksPayload)
+		p, ok := v.(*controlplane.SwitchoverDatabaseNodePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "list-database-tasks", "*controlplane.ListDatabaseTasksPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "switchover-database-node", "*controlplane.SwitchoverDatabaseNodePayload", v)
 		}
 		databaseID = string(p.DatabaseID)
+		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDatabaseTasksControlPlanePath(databaseID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SwitchoverDatabaseNodeControlPlanePath(databaseID, nodeName)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "list-database-tasks", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "switchover-database-node", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2447,39 +2957,34 @@ func (c *Client) BuildListDatabaseTasksRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeListDatabaseTasksRequest returns an encoder for requests sent to the
-// control-plane list-database-tasks server.
-func EncodeListDatabaseTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeSwitchoverDatabaseNodeRequest returns an encoder for requests sent to
+// the control-plane switchover-database-node server.
+func EncodeSwitchoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.ListDatabaseTasksPayload)
+		p, ok := v.(*controlplane.SwitchoverDatabaseNodePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "list-database-tasks", "*controlplane.ListDatabaseTasksPayload", v)
-		}
-		values := req.URL.Query()
-		if p.AfterTaskID != nil {
-			values.Add("after_task_id", *p.AfterTaskID)
-		}
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+			return goahttp.ErrInvalidType("control-plane", "switchover-database-node", "*controlplane.SwitchoverDatabaseNodePayload", v)
 		}
-		if p.SortOrder != nil {
-			values.Add("sort_order", *p.SortOrder)
+		body := NewSwitchoverDatabaseNodeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "switchover-database-node", err)
 		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListDatabaseTasksResponse returns a decoder for responses returned by
-// the control-plane list-database-tasks endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeListDatabaseTasksResponse may return the following errors:
+// DecodeSwitchoverDatabaseNodeResponse returns a decoder for responses
+// returned by the control-plane switchover-database-node endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeSwitchoverDatabaseNodeResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
+//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeListDatabaseTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeSwitchoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2496,101 +3001,135 @@ func DecodeListDatabaseTasksResponse(decoder func(*http.Response) goahttp.Decode
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListDatabaseTasksResponseBody
+				body SwitchoverDatabaseNodeResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
 			}
-			err = ValidateListDatabaseTasksResponseBody(&body)
+			err = ValidateSwitchoverDatabaseNodeResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
 			}
-			res := NewListDatabaseTasksResponseOK(&body)
+			res := NewSwitchoverDatabaseNodeResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
-			var (
-				body ListDatabaseTasksClusterNotInitializedResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
-			}
-			err = ValidateListDatabaseTasksClusterNotInitializedResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "cluster_not_initialized":
+				var (
+					body SwitchoverDatabaseNodeClusterNotInitializedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				}
+				err = ValidateSwitchoverDatabaseNodeClusterNotInitializedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				}
+				return nil, NewSwitchoverDatabaseNodeClusterNotInitialized(&body)
+			case "database_not_modifiable":
+				var (
+					body SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				}
+				err = ValidateSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				}
+				return nil, NewSwitchoverDatabaseNodeDatabaseNotModifiable(&body)
+			case "operation_already_in_progress":
+				var (
+					body SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
+				}
+				err = ValidateSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
+				}
+				return nil, NewSwitchoverDatabaseNodeOperationAlreadyInProgress(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("control-plane", "switchover-database-node", resp.StatusCode, string(body))
 			}
-			return nil, NewListDatabaseTasksClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body ListDatabaseTasksInvalidInputResponseBody
+				body SwitchoverDatabaseNodeInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
 			}
-			err = ValidateListDatabaseTasksInvalidInputResponseBody(&body)
+			err = ValidateSwitchoverDatabaseNodeInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
 			}
-			return nil, NewListDatabaseTasksInvalidInput(&body)
+			return nil, NewSwitchoverDatabaseNodeInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body ListDatabaseTasksNotFoundResponseBody
+				body SwitchoverDatabaseNodeNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
 			}
-			err = ValidateListDatabaseTasksNotFoundResponseBody(&body)
+			err = ValidateSwitchoverDatabaseNodeNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
 			}
-			return nil, NewListDatabaseTasksNotFound(&body)
+			return nil, NewSwitchoverDatabaseNodeNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ListDatabaseTasksServerErrorResponseBody
+				body SwitchoverDatabaseNodeServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "switchover-database-node", err)
 			}
-			err = ValidateListDatabaseTasksServerErrorResponseBody(&body)
+			err = ValidateSwitchoverDatabaseNodeServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "switchover-database-node", err)
 			}
-			return nil, NewListDatabaseTasksServerError(&body)
+			return nil, NewSwitchoverDatabaseNodeServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "list-database-tasks", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "switchover-database-node", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetDatabaseTaskRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "get-database-task" endpoint
-func (c *Client) BuildGetDatabaseTaskRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildRollingRestartDatabaseRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "rolling-restart-database" endpoint
+func (c *Client) BuildRollingRestartDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
-		taskID     string
 	)
 	{
-		p, ok := v.(*controlplane.GetDatabaseTaskPayload)
+		p, ok := v.(*controlplane.RollingRestartDatabasePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "get-database-task", "*controlplane.GetDatabaseTaskPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "rolling-restart-database", "*controlplane.RollingRestartDatabasePayload", v)
 		}
 		databaseID = string(p.DatabaseID)
-		taskID = p.TaskID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDatabaseTaskControlPlanePath(databaseID, taskID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RollingRestartDatabaseControlPlanePath(databaseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "get-database-task", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "rolling-restart-database", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2599,16 +3138,34 @@ func (c *Client) BuildGetDatabaseTaskRequest(ctx context.Context, v any) (*http.
 	return req, nil
 }
 
-// DecodeGetDatabaseTaskResponse returns a decoder for responses returned by
-// the control-plane get-database-task endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeGetDatabaseTaskResponse may return the following errors:
+// EncodeRollingRestartDatabaseRequest returns an encoder for requests sent to
+// the control-plane rolling-restart-database server.
+func EncodeRollingRestartDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.RollingRestartDatabasePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "rolling-restart-database", "*controlplane.RollingRestartDatabasePayload", v)
+		}
+		body := NewRollingRestartDatabaseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "rolling-restart-database", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRollingRestartDatabaseResponse returns a decoder for responses
+// returned by the control-plane rolling-restart-database endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeRollingRestartDatabaseResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
+//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetDatabaseTaskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRollingRestartDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2625,102 +3182,137 @@ func DecodeGetDatabaseTaskResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetDatabaseTaskResponseBody
+				body RollingRestartDatabaseResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
 			}
-			err = ValidateGetDatabaseTaskResponseBody(&body)
+			err = ValidateRollingRestartDatabaseResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
 			}
-			res := NewGetDatabaseTaskTaskOK(&body)
+			res := NewRollingRestartDatabaseResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
-			var (
-				body GetDatabaseTaskClusterNotInitializedResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
-			}
-			err = ValidateGetDatabaseTaskClusterNotInitializedResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "cluster_not_initialized":
+				var (
+					body RollingRestartDatabaseClusterNotInitializedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
+				}
+				err = ValidateRollingRestartDatabaseClusterNotInitializedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
+				}
+				return nil, NewRollingRestartDatabaseClusterNotInitialized(&body)
+			case "database_not_modifiable":
+				var (
+					body RollingRestartDatabaseDatabaseNotModifiableResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
+				}
+				err = ValidateRollingRestartDatabaseDatabaseNotModifiableResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
+				}
+				return nil, NewRollingRestartDatabaseDatabaseNotModifiable(&body)
+			case "operation_already_in_progress":
+				var (
+					body RollingRestartDatabaseOperationAlreadyInProgressResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
+				}
+				err = ValidateRollingRestartDatabaseOperationAlreadyInProgressResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
+				}
+				return nil, NewRollingRestartDatabaseOperationAlreadyInProgress(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("control-plane", "rolling-restart-database", resp.StatusCode, string(body))
 			}
-			return nil, NewGetDatabaseTaskClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body GetDatabaseTaskInvalidInputResponseBody
+				body RollingRestartDatabaseInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
 			}
-			err = ValidateGetDatabaseTaskInvalidInputResponseBody(&body)
+			err = ValidateRollingRestartDatabaseInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
 			}
-			return nil, NewGetDatabaseTaskInvalidInput(&body)
+			return nil, NewRollingRestartDatabaseInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body GetDatabaseTaskNotFoundResponseBody
+				body RollingRestartDatabaseNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
 			}
-			err = ValidateGetDatabaseTaskNotFoundResponseBody(&body)
+			err = ValidateRollingRestartDatabaseNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
 			}
-			return nil, NewGetDatabaseTaskNotFound(&body)
+			return nil, NewRollingRestartDatabaseNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetDatabaseTaskServerErrorResponseBody
+				body RollingRestartDatabaseServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "rolling-restart-database", err)
 			}
-			err = ValidateGetDatabaseTaskServerErrorResponseBody(&body)
+			err = ValidateRollingRestartDatabaseServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "rolling-restart-database", err)
 			}
-			return nil, NewGetDatabaseTaskServerError(&body)
+			return nil, NewRollingRestartDatabaseServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "get-database-task", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "rolling-restart-database", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetDatabaseTaskLogRequest instantiates a HTTP request object with
+// BuildFailoverDatabaseNodeRequest instantiates a HTTP request object with
 // method and path set to call the "control-plane" service
-// "get-database-task-log" endpoint
-func (c *Client) BuildGetDatabaseTaskLogRequest(ctx context.Context, v any) (*http.Request, error) {
+// "failover-database-node" endpoint
+func (c *Client) BuildFailoverDatabaseNodeRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
-		taskID     string
+		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.GetDatabaseTaskLogPayload)
+		p, ok := v.(*controlplane.FailoverDatabaseNodeRequest)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "get-database-task-log", "*controlplane.GetDatabaseTaskLogPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "failover-database-node", "*controlplane.FailoverDatabaseNodeRequest", v)
 		}
 		databaseID = string(p.DatabaseID)
-		taskID = p.TaskID
+		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDatabaseTaskLogControlPlanePath(databaseID, taskID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FailoverDatabaseNodeControlPlanePath(databaseID, nodeName)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "get-database-task-log", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "failover-database-node", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2729,36 +3321,34 @@ func (c *Client) BuildGetDatabaseTaskLogRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeGetDatabaseTaskLogRequest returns an encoder for requests sent to the
-// control-plane get-database-task-log server.
-func EncodeGetDatabaseTaskLogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeFailoverDatabaseNodeRequest returns an encoder for requests sent to
+// the control-plane failover-database-node server.
+func EncodeFailoverDatabaseNodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.GetDatabaseTaskLogPayload)
+		p, ok := v.(*controlplane.FailoverDatabaseNodeRequest)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "get-database-task-log", "*controlplane.GetDatabaseTaskLogPayload", v)
-		}
-		values := req.URL.Query()
-		if p.AfterEntryID != nil {
-			values.Add("after_entry_id", *p.AfterEntryID)
+			return goahttp.ErrInvalidType("control-plane", "failover-database-node", "*controlplane.FailoverDatabaseNodeRequest", v)
 		}
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		body := NewFailoverDatabaseNodeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "failover-database-node", err)
 		}
-		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeGetDatabaseTaskLogResponse returns a decoder for responses returned by
-// the control-plane get-database-task-log endpoint. restoreBody controls
+// DecodeFailoverDatabaseNodeResponse returns a decoder for responses returned
+// by the control-plane failover-database-node endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetDatabaseTaskLogResponse may return the following errors:
+// DecodeFailoverDatabaseNodeResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
+//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetDatabaseTaskLogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeFailoverDatabaseNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2775,99 +3365,137 @@ func DecodeGetDatabaseTaskLogResponse(decoder func(*http.Response) goahttp.Decod
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetDatabaseTaskLogResponseBody
+				body FailoverDatabaseNodeResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
 			}
-			err = ValidateGetDatabaseTaskLogResponseBody(&body)
+			err = ValidateFailoverDatabaseNodeResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
 			}
-			res := NewGetDatabaseTaskLogTaskLogOK(&body)
+			res := NewFailoverDatabaseNodeResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
-			var (
-				body GetDatabaseTaskLogClusterNotInitializedResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
-			}
-			err = ValidateGetDatabaseTaskLogClusterNotInitializedResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "cluster_not_initialized":
+				var (
+					body FailoverDatabaseNodeClusterNotInitializedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				}
+				err = ValidateFailoverDatabaseNodeClusterNotInitializedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				}
+				return nil, NewFailoverDatabaseNodeClusterNotInitialized(&body)
+			case "database_not_modifiable":
+				var (
+					body FailoverDatabaseNodeDatabaseNotModifiableResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				}
+				err = ValidateFailoverDatabaseNodeDatabaseNotModifiableResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				}
+				return nil, NewFailoverDatabaseNodeDatabaseNotModifiable(&body)
+			case "operation_already_in_progress":
+				var (
+					body FailoverDatabaseNodeOperationAlreadyInProgressResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
+				}
+				err = ValidateFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
+				}
+				return nil, NewFailoverDatabaseNodeOperationAlreadyInProgress(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("control-plane", "failover-database-node", resp.StatusCode, string(body))
 			}
-			return nil, NewGetDatabaseTaskLogClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body GetDatabaseTaskLogInvalidInputResponseBody
+				body FailoverDatabaseNodeInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
 			}
-			err = ValidateGetDatabaseTaskLogInvalidInputResponseBody(&body)
+			err = ValidateFailoverDatabaseNodeInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
 			}
-			return nil, NewGetDatabaseTaskLogInvalidInput(&body)
+			return nil, NewFailoverDatabaseNodeInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body GetDatabaseTaskLogNotFoundResponseBody
+				body FailoverDatabaseNodeNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
 			}
-			err = ValidateGetDatabaseTaskLogNotFoundResponseBody(&body)
+			err = ValidateFailoverDatabaseNodeNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
 			}
-			return nil, NewGetDatabaseTaskLogNotFound(&body)
+			return nil, NewFailoverDatabaseNodeNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetDatabaseTaskLogServerErrorResponseBody
+				body FailoverDatabaseNodeServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "failover-database-node", err)
 			}
-			err = ValidateGetDatabaseTaskLogServerErrorResponseBody(&body)
+			err = ValidateFailoverDatabaseNodeServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "failover-database-node", err)
 			}
-			return nil, NewGetDatabaseTaskLogServerError(&body)
+			return nil, NewFailoverDatabaseNodeServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "get-database-task-log", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "failover-database-node", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListHostTasksRequest instantiates a HTTP request object with method and
-// path set to call the "control-plane" service "list-host-tasks" endpoint
-func (c *Client) BuildListHostTasksRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetNodeQueryStatsRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "get-node-query-stats"
+// endpoint
+func (c *Client) BuildGetNodeQueryStatsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		hostID string
+		databaseID string
+		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.ListHostTasksPayload)
+		p, ok := v.(*controlplane.GetNodeQueryStatsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "list-host-tasks", "*controlplane.ListHostTasksPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "get-node-query-stats", "*controlplane.GetNodeQueryStatsPayload", v)
 		}
-		hostID = string(p.HostID)
+		databaseID = string(p.DatabaseID)
+		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListHostTasksControlPlanePath(hostID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetNodeQueryStatsControlPlanePath(databaseID, nodeName)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "list-host-tasks", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-node-query-stats", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2876,39 +3504,34 @@ func (c *Client) BuildListHostTasksRequest(ctx context.Context, v any) (*http.Re
 	return req, nil
 }
 
-// EncodeListHostTasksRequest returns an encoder for requests sent to the
-// control-plane list-host-tasks server.
-func EncodeListHostTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetNodeQueryStatsRequest returns an encoder for requests sent to the
+// control-plane get-node-query-stats server.
+func EncodeGetNodeQueryStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.ListHostTasksPayload)
+		p, ok := v.(*controlplane.GetNodeQueryStatsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "list-host-tasks", "*controlplane.ListHostTasksPayload", v)
+			return goahttp.ErrInvalidType("control-plane", "get-node-query-stats", "*controlplane.GetNodeQueryStatsPayload", v)
 		}
 		values := req.URL.Query()
-		if p.AfterTaskID != nil {
-			values.Add("after_task_id", *p.AfterTaskID)
-		}
+		values.Add("sort_by", p.SortBy)
 		if p.Limit != nil {
 			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
 		}
-		if p.SortOrder != nil {
-			values.Add("sort_order", *p.SortOrder)
-		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeListHostTasksResponse returns a decoder for responses returned by the
-// control-plane list-host-tasks endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeListHostTasksResponse may return the following errors:
+// DecodeGetNodeQueryStatsResponse returns a decoder for responses returned by
+// the control-plane get-node-query-stats endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetNodeQueryStatsResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeListHostTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetNodeQueryStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2925,101 +3548,102 @@ func DecodeListHostTasksResponse(decoder func(*http.Response) goahttp.Decoder, r
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListHostTasksResponseBody
+				body GetNodeQueryStatsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-node-query-stats", err)
 			}
-			err = ValidateListHostTasksResponseBody(&body)
+			err = ValidateGetNodeQueryStatsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-node-query-stats", err)
 			}
-			res := NewListHostTasksResponseOK(&body)
+			res := NewGetNodeQueryStatsResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			var (
-				body ListHostTasksClusterNotInitializedResponseBody
+				body GetNodeQueryStatsClusterNotInitializedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-node-query-stats", err)
 			}
-			err = ValidateListHostTasksClusterNotInitializedResponseBody(&body)
+			err = ValidateGetNodeQueryStatsClusterNotInitializedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-node-query-stats", err)
 			}
-			return nil, NewListHostTasksClusterNotInitialized(&body)
+			return nil, NewGetNodeQueryStatsClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body ListHostTasksInvalidInputResponseBody
+				body GetNodeQueryStatsInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-node-query-stats", err)
 			}
-			err = ValidateListHostTasksInvalidInputResponseBody(&body)
+			err = ValidateGetNodeQueryStatsInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-node-query-stats", err)
 			}
-			return nil, NewListHostTasksInvalidInput(&body)
+			return nil, NewGetNodeQueryStatsInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body ListHostTasksNotFoundResponseBody
+				body GetNodeQueryStatsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-node-query-stats", err)
 			}
-			err = ValidateListHostTasksNotFoundResponseBody(&body)
+			err = ValidateGetNodeQueryStatsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-node-query-stats", err)
 			}
-			return nil, NewListHostTasksNotFound(&body)
+			return nil, NewGetNodeQueryStatsNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ListHostTasksServerErrorResponseBody
+				body GetNodeQueryStatsServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-node-query-stats", err)
 			}
-			err = ValidateListHostTasksServerErrorResponseBody(&body)
+			err = ValidateGetNodeQueryStatsServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-node-query-stats", err)
 			}
-			return nil, NewListHostTasksServerError(&body)
+			return nil, NewGetNodeQueryStatsServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "list-host-tasks", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-node-query-stats", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetHostTaskRequest instantiates a HTTP request object with method and
-// path set to call the "control-plane" service "get-host-task" endpoint
-func (c *Client) BuildGetHostTaskRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildResetNodeQueryStatsRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "reset-node-query-stats" endpoint
+func (c *Client) BuildResetNodeQueryStatsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		hostID string
-		taskID string
+		databaseID string
+		nodeName   string
 	)
 	{
-		p, ok := v.(*controlplane.GetHostTaskPayload)
+		p, ok := v.(*controlplane.ResetNodeQueryStatsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "get-host-task", "*controlplane.GetHostTaskPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "reset-node-query-stats", "*controlplane.ResetNodeQueryStatsPayload", v)
 		}
-		hostID = string(p.HostID)
-		taskID = p.TaskID
+		databaseID = string(p.DatabaseID)
+		nodeName = p.NodeName
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHostTaskControlPlanePath(hostID, taskID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResetNodeQueryStatsControlPlanePath(databaseID, nodeName)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "get-host-task", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "reset-node-query-stats", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3028,16 +3652,16 @@ func (c *Client) BuildGetHostTaskRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// DecodeGetHostTaskResponse returns a decoder for responses returned by the
-// control-plane get-host-task endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetHostTaskResponse may return the following errors:
+// DecodeResetNodeQueryStatsResponse returns a decoder for responses returned
+// by the control-plane reset-node-query-stats endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeResetNodeQueryStatsResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetHostTaskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeResetNodeQueryStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3054,101 +3678,100 @@ func DecodeGetHostTaskResponse(decoder func(*http.Response) goahttp.Decoder, res
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetHostTaskResponseBody
+				body ResetNodeQueryStatsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "reset-node-query-stats", err)
 			}
-			err = ValidateGetHostTaskResponseBody(&body)
+			err = ValidateResetNodeQueryStatsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "reset-node-query-stats", err)
 			}
-			res := NewGetHostTaskTaskOK(&body)
+			res := NewResetNodeQueryStatsResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			var (
-				body GetHostTaskClusterNotInitializedResponseBody
+				body ResetNodeQueryStatsClusterNotInitializedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "reset-node-query-stats", err)
 			}
-			err = ValidateGetHostTaskClusterNotInitializedResponseBody(&body)
+			err = ValidateResetNodeQueryStatsClusterNotInitializedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "reset-node-query-stats", err)
 			}
-			return nil, NewGetHostTaskClusterNotInitialized(&body)
+			return nil, NewResetNodeQueryStatsClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body GetHostTaskInvalidInputResponseBody
+				body ResetNodeQueryStatsInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "reset-node-query-stats", err)
 			}
-			err = ValidateGetHostTaskInvalidInputResponseBody(&body)
+			err = ValidateResetNodeQueryStatsInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "reset-node-query-stats", err)
 			}
-			return nil, NewGetHostTaskInvalidInput(&body)
+			return nil, NewResetNodeQueryStatsInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body GetHostTaskNotFoundResponseBody
+				body ResetNodeQueryStatsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "reset-node-query-stats", err)
 			}
-			err = ValidateGetHostTaskNotFoundResponseBody(&body)
+			err = ValidateResetNodeQueryStatsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "reset-node-query-stats", err)
 			}
-			return nil, NewGetHostTaskNotFound(&body)
+			return nil, NewResetNodeQueryStatsNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetHostTaskServerErrorResponseBody
+				body ResetNodeQueryStatsServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "reset-node-query-stats", err)
 			}
-			err = ValidateGetHostTaskServerErrorResponseBody(&body)
+			err = ValidateResetNodeQueryStatsServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+				return nil, goahttp.ErrValidationError("control-plane", "reset-node-query-stats", err)
 			}
-			return nil, NewGetHostTaskServerError(&body)
+			return nil, NewResetNodeQueryStatsServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "get-host-task", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "reset-node-query-stats", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetHostTaskLogRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "get-host-task-log" endpoint
-func (c *Client) BuildGetHostTaskLogRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildListDatabaseTasksRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "list-database-tasks"
+// endpoint
+func (c *Client) BuildListDatabaseTasksRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		hostID string
-		taskID string
+		databaseID string
 	)
 	{
-		p, ok := v.(*controlplane.GetHostTaskLogPayload)
+		p, ok := v.(*controlplane.ListDatabaseTasksPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "get-host-task-log", "*controlplane.GetHostTaskLogPayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "list-database-tasks", "*controlplane.ListDatabaseTasksPayload", v)
 		}
-		hostID = string(p.HostID)
-		taskID = p.TaskID
+		databaseID = string(p.DatabaseID)
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHostTaskLogControlPlanePath(hostID, taskID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDatabaseTasksControlPlanePath(databaseID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "get-host-task-log", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-database-tasks", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3157,36 +3780,39 @@ func (c *Client) BuildGetHostTaskLogRequest(ctx context.Context, v any) (*http.R
 	return req, nil
 }
 
-// EncodeGetHostTaskLogRequest returns an encoder for requests sent to the
-// control-plane get-host-task-log server.
-func EncodeGetHostTaskLogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListDatabaseTasksRequest returns an encoder for requests sent to the
+// control-plane list-database-tasks server.
+func EncodeListDatabaseTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.GetHostTaskLogPayload)
+		p, ok := v.(*controlplane.ListDatabaseTasksPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "get-host-task-log", "*controlplane.GetHostTaskLogPayload", v)
+			return goahttp.ErrInvalidType("control-plane", "list-database-tasks", "*controlplane.ListDatabaseTasksPayload", v)
 		}
 		values := req.URL.Query()
-		if p.AfterEntryID != nil {
-			values.Add("after_entry_id", *p.AfterEntryID)
+		if p.AfterTaskID != nil {
+			values.Add("after_task_id", *p.AfterTaskID)
 		}
 		if p.Limit != nil {
 			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
 		}
+		if p.SortOrder != nil {
+			values.Add("sort_order", *p.SortOrder)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeGetHostTaskLogResponse returns a decoder for responses returned by the
-// control-plane get-host-task-log endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetHostTaskLogResponse may return the following errors:
+// DecodeListDatabaseTasksResponse returns a decoder for responses returned by
+// the control-plane list-database-tasks endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListDatabaseTasksResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetHostTaskLogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListDatabaseTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3203,89 +3829,2234 @@ func DecodeGetHostTaskLogResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetHostTaskLogResponseBody
+				body ListDatabaseTasksResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
 			}
-			err = ValidateGetHostTaskLogResponseBody(&body)
+			err = ValidateListDatabaseTasksResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
 			}
-			res := NewGetHostTaskLogTaskLogOK(&body)
+			res := NewListDatabaseTasksResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			var (
-				body GetHostTaskLogClusterNotInitializedResponseBody
+				body ListDatabaseTasksClusterNotInitializedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
 			}
-			err = ValidateGetHostTaskLogClusterNotInitializedResponseBody(&body)
+			err = ValidateListDatabaseTasksClusterNotInitializedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
 			}
-			return nil, NewGetHostTaskLogClusterNotInitialized(&body)
+			return nil, NewListDatabaseTasksClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body GetHostTaskLogInvalidInputResponseBody
+				body ListDatabaseTasksInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
 			}
-			err = ValidateGetHostTaskLogInvalidInputResponseBody(&body)
+			err = ValidateListDatabaseTasksInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
 			}
-			return nil, NewGetHostTaskLogInvalidInput(&body)
+			return nil, NewListDatabaseTasksInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body GetHostTaskLogNotFoundResponseBody
+				body ListDatabaseTasksNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
 			}
-			err = ValidateGetHostTaskLogNotFoundResponseBody(&body)
+			err = ValidateListDatabaseTasksNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
 			}
-			return nil, NewGetHostTaskLogNotFound(&body)
+			return nil, NewListDatabaseTasksNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetHostTaskLogServerErrorResponseBody
+				body ListDatabaseTasksServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "list-database-tasks", err)
 			}
-			err = ValidateGetHostTaskLogServerErrorResponseBody(&body)
+			err = ValidateListDatabaseTasksServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+				return nil, goahttp.ErrValidationError("control-plane", "list-database-tasks", err)
+			}
+			return nil, NewListDatabaseTasksServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "list-database-tasks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetDatabaseTaskRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "get-database-task" endpoint
+func (c *Client) BuildGetDatabaseTaskRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		taskID     string
+	)
+	{
+		p, ok := v.(*controlplane.GetDatabaseTaskPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-database-task", "*controlplane.GetDatabaseTaskPayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDatabaseTaskControlPlanePath(databaseID, taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-database-task", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetDatabaseTaskResponse returns a decoder for responses returned by
+// the control-plane get-database-task endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetDatabaseTaskResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetDatabaseTaskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetDatabaseTaskResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+			}
+			err = ValidateGetDatabaseTaskResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			}
+			res := NewGetDatabaseTaskTaskOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetDatabaseTaskClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+			}
+			err = ValidateGetDatabaseTaskClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			}
+			return nil, NewGetDatabaseTaskClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetDatabaseTaskInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+			}
+			err = ValidateGetDatabaseTaskInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			}
+			return nil, NewGetDatabaseTaskInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetDatabaseTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+			}
+			err = ValidateGetDatabaseTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			}
+			return nil, NewGetDatabaseTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetDatabaseTaskServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task", err)
+			}
+			err = ValidateGetDatabaseTaskServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task", err)
+			}
+			return nil, NewGetDatabaseTaskServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-database-task", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetDatabaseTaskLogRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "get-database-task-log" endpoint
+func (c *Client) BuildGetDatabaseTaskLogRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		taskID     string
+	)
+	{
+		p, ok := v.(*controlplane.GetDatabaseTaskLogPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-database-task-log", "*controlplane.GetDatabaseTaskLogPayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDatabaseTaskLogControlPlanePath(databaseID, taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-database-task-log", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetDatabaseTaskLogRequest returns an encoder for requests sent to the
+// control-plane get-database-task-log server.
+func EncodeGetDatabaseTaskLogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.GetDatabaseTaskLogPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "get-database-task-log", "*controlplane.GetDatabaseTaskLogPayload", v)
+		}
+		values := req.URL.Query()
+		if p.AfterEntryID != nil {
+			values.Add("after_entry_id", *p.AfterEntryID)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetDatabaseTaskLogResponse returns a decoder for responses returned by
+// the control-plane get-database-task-log endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetDatabaseTaskLogResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetDatabaseTaskLogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetDatabaseTaskLogResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+			}
+			err = ValidateGetDatabaseTaskLogResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			}
+			res := NewGetDatabaseTaskLogTaskLogOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetDatabaseTaskLogClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+			}
+			err = ValidateGetDatabaseTaskLogClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			}
+			return nil, NewGetDatabaseTaskLogClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetDatabaseTaskLogInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+			}
+			err = ValidateGetDatabaseTaskLogInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			}
+			return nil, NewGetDatabaseTaskLogInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetDatabaseTaskLogNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+			}
+			err = ValidateGetDatabaseTaskLogNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			}
+			return nil, NewGetDatabaseTaskLogNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetDatabaseTaskLogServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-database-task-log", err)
+			}
+			err = ValidateGetDatabaseTaskLogServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-database-task-log", err)
+			}
+			return nil, NewGetDatabaseTaskLogServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-database-task-log", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListHostTasksRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "list-host-tasks" endpoint
+func (c *Client) BuildListHostTasksRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		hostID string
+	)
+	{
+		p, ok := v.(*controlplane.ListHostTasksPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "list-host-tasks", "*controlplane.ListHostTasksPayload", v)
+		}
+		hostID = string(p.HostID)
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListHostTasksControlPlanePath(hostID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-host-tasks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListHostTasksRequest returns an encoder for requests sent to the
+// control-plane list-host-tasks server.
+func EncodeListHostTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.ListHostTasksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "list-host-tasks", "*controlplane.ListHostTasksPayload", v)
+		}
+		values := req.URL.Query()
+		if p.AfterTaskID != nil {
+			values.Add("after_task_id", *p.AfterTaskID)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.SortOrder != nil {
+			values.Add("sort_order", *p.SortOrder)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListHostTasksResponse returns a decoder for responses returned by the
+// control-plane list-host-tasks endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListHostTasksResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListHostTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListHostTasksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+			}
+			err = ValidateListHostTasksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+			}
+			res := NewListHostTasksResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ListHostTasksClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+			}
+			err = ValidateListHostTasksClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+			}
+			return nil, NewListHostTasksClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListHostTasksInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+			}
+			err = ValidateListHostTasksInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+			}
+			return nil, NewListHostTasksInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body ListHostTasksNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+			}
+			err = ValidateListHostTasksNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+			}
+			return nil, NewListHostTasksNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListHostTasksServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-host-tasks", err)
+			}
+			err = ValidateListHostTasksServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-host-tasks", err)
+			}
+			return nil, NewListHostTasksServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "list-host-tasks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetHostTaskRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "get-host-task" endpoint
+func (c *Client) BuildGetHostTaskRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		hostID string
+		taskID string
+	)
+	{
+		p, ok := v.(*controlplane.GetHostTaskPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-host-task", "*controlplane.GetHostTaskPayload", v)
+		}
+		hostID = string(p.HostID)
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHostTaskControlPlanePath(hostID, taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-host-task", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetHostTaskResponse returns a decoder for responses returned by the
+// control-plane get-host-task endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetHostTaskResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetHostTaskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetHostTaskResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+			}
+			err = ValidateGetHostTaskResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+			}
+			res := NewGetHostTaskTaskOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetHostTaskClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+			}
+			err = ValidateGetHostTaskClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+			}
+			return nil, NewGetHostTaskClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetHostTaskInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+			}
+			err = ValidateGetHostTaskInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+			}
+			return nil, NewGetHostTaskInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetHostTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+			}
+			err = ValidateGetHostTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+			}
+			return nil, NewGetHostTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetHostTaskServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task", err)
+			}
+			err = ValidateGetHostTaskServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task", err)
+			}
+			return nil, NewGetHostTaskServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-host-task", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetHostTaskLogRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "get-host-task-log" endpoint
+func (c *Client) BuildGetHostTaskLogRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		hostID string
+		taskID string
+	)
+	{
+		p, ok := v.(*controlplane.GetHostTaskLogPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-host-task-log", "*controlplane.GetHostTaskLogPayload", v)
+		}
+		hostID = string(p.HostID)
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHostTaskLogControlPlanePath(hostID, taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-host-task-log", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetHostTaskLogRequest returns an encoder for requests sent to the
+// control-plane get-host-task-log server.
+func EncodeGetHostTaskLogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.GetHostTaskLogPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "get-host-task-log", "*controlplane.GetHostTaskLogPayload", v)
+		}
+		values := req.URL.Query()
+		if p.AfterEntryID != nil {
+			values.Add("after_entry_id", *p.AfterEntryID)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetHostTaskLogResponse returns a decoder for responses returned by the
+// control-plane get-host-task-log endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetHostTaskLogResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetHostTaskLogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetHostTaskLogResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+			}
+			err = ValidateGetHostTaskLogResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+			}
+			res := NewGetHostTaskLogTaskLogOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetHostTaskLogClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+			}
+			err = ValidateGetHostTaskLogClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+			}
+			return nil, NewGetHostTaskLogClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetHostTaskLogInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+			}
+			err = ValidateGetHostTaskLogInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+			}
+			return nil, NewGetHostTaskLogInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetHostTaskLogNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+			}
+			err = ValidateGetHostTaskLogNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+			}
+			return nil, NewGetHostTaskLogNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetHostTaskLogServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-host-task-log", err)
+			}
+			err = ValidateGetHostTaskLogServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-host-task-log", err)
+			}
+			return nil, NewGetHostTaskLogServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-host-task-log", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListTasksRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "list-tasks" endpoint
+func (c *Client) BuildListTasksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTasksControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-tasks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTasksRequest returns an encoder for requests sent to the
+// control-plane list-tasks server.
+func EncodeListTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.ListTasksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "list-tasks", "*controlplane.ListTasksPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Scope != nil {
+			values.Add("scope", *p.Scope)
+		}
+		if p.EntityID != nil {
+			values.Add("entity_id", string(*p.EntityID))
+		}
+		if p.AfterTaskID != nil {
+			values.Add("after_task_id", *p.AfterTaskID)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.SortOrder != nil {
+			values.Add("sort_order", *p.SortOrder)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListTasksResponse returns a decoder for responses returned by the
+// control-plane list-tasks endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListTasksResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListTasksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+			}
+			err = ValidateListTasksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+			}
+			res := NewListTasksResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ListTasksClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+			}
+			err = ValidateListTasksClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+			}
+			return nil, NewListTasksClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListTasksInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+			}
+			err = ValidateListTasksInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+			}
+			return nil, NewListTasksInvalidInput(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListTasksServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+			}
+			err = ValidateListTasksServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+			}
+			return nil, NewListTasksServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "list-tasks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStreamEventsRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "stream-events" endpoint
+func (c *Client) BuildStreamEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StreamEventsControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "stream-events", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStreamEventsRequest returns an encoder for requests sent to the
+// control-plane stream-events server.
+func EncodeStreamEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.StreamEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "stream-events", "*controlplane.StreamEventsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.DatabaseID != nil {
+			values.Add("database_id", string(*p.DatabaseID))
+		}
+		if p.HostID != nil {
+			values.Add("host_id", string(*p.HostID))
+		}
+		for _, value := range p.Kind {
+			values.Add("kind", value)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeStreamEventsResponse returns a decoder for responses returned by the
+// control-plane stream-events endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeStreamEventsResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeStreamEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StreamEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "stream-events", err)
+			}
+			err = ValidateStreamEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "stream-events", err)
+			}
+			res := NewStreamEventsEventOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body StreamEventsClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "stream-events", err)
+			}
+			err = ValidateStreamEventsClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "stream-events", err)
+			}
+			return nil, NewStreamEventsClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body StreamEventsInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "stream-events", err)
+			}
+			err = ValidateStreamEventsInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "stream-events", err)
+			}
+			return nil, NewStreamEventsInvalidInput(&body)
+		case http.StatusInternalServerError:
+			var (
+				body StreamEventsServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "stream-events", err)
+			}
+			err = ValidateStreamEventsServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "stream-events", err)
+			}
+			return nil, NewStreamEventsServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "stream-events", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "create-webhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookControlPlanePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "create-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the
+// control-plane create-webhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.CreateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "create-webhook", "*controlplane.CreateWebhookPayload", v)
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "create-webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// control-plane create-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "create-webhook", err)
+			}
+			res := NewCreateWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CreateWebhookClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateWebhookInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookInvalidInput(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateWebhookServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "create-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "list-webhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-webhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// control-plane list-webhooks endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhooks", err)
+			}
+			res := NewListWebhooksResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ListWebhooksClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhooks", err)
+			}
+			return nil, NewListWebhooksClusterNotInitialized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhooksServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhooks", err)
+			}
+			return nil, NewListWebhooksServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "list-webhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "get-webhook" endpoint
+func (c *Client) BuildGetWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID string
+	)
+	{
+		p, ok := v.(*controlplane.GetWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-webhook", "*controlplane.GetWebhookPayload", v)
+		}
+		webhookID = p.WebhookID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookControlPlanePath(webhookID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetWebhookResponse returns a decoder for responses returned by the
+// control-plane get-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetWebhookResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-webhook", err)
+			}
+			err = ValidateGetWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-webhook", err)
+			}
+			res := NewGetWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body GetWebhookClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-webhook", err)
+			}
+			err = ValidateGetWebhookClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-webhook", err)
+			}
+			return nil, NewGetWebhookClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetWebhookInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-webhook", err)
+			}
+			err = ValidateGetWebhookInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-webhook", err)
+			}
+			return nil, NewGetWebhookInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-webhook", err)
+			}
+			err = ValidateGetWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-webhook", err)
+			}
+			return nil, NewGetWebhookNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetWebhookServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-webhook", err)
+			}
+			err = ValidateGetWebhookServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-webhook", err)
+			}
+			return nil, NewGetWebhookServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "delete-webhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID string
+	)
+	{
+		p, ok := v.(*controlplane.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "delete-webhook", "*controlplane.DeleteWebhookPayload", v)
+		}
+		webhookID = p.WebhookID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookControlPlanePath(webhookID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "delete-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// control-plane delete-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusConflict:
+			var (
+				body DeleteWebhookClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body DeleteWebhookInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteWebhookServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "delete-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "list-webhook-deliveries" endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID string
+	)
+	{
+		p, ok := v.(*controlplane.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "list-webhook-deliveries", "*controlplane.ListWebhookDeliveriesPayload", v)
+		}
+		webhookID = p.WebhookID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesControlPlanePath(webhookID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "list-webhook-deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the control-plane list-webhook-deliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "list-webhook-deliveries", "*controlplane.ListWebhookDeliveriesPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the control-plane list-webhook-deliveries endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhook-deliveries", err)
+			}
+			res := NewListWebhookDeliveriesResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ListWebhookDeliveriesClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListWebhookDeliveriesInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body ListWebhookDeliveriesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhookDeliveriesServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "list-webhook-deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestoreDatabaseRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "restore-database" endpoint
+func (c *Client) BuildRestoreDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+	)
+	{
+		p, ok := v.(*controlplane.RestoreDatabasePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "restore-database", "*controlplane.RestoreDatabasePayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreDatabaseControlPlanePath(databaseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "restore-database", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreDatabaseRequest returns an encoder for requests sent to the
+// control-plane restore-database server.
+func EncodeRestoreDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.RestoreDatabasePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "restore-database", "*controlplane.RestoreDatabasePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("force", fmt.Sprintf("%v", p.Force))
+		req.URL.RawQuery = values.Encode()
+		body := NewRestoreDatabaseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "restore-database", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreDatabaseResponse returns a decoder for responses returned by
+// the control-plane restore-database endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeRestoreDatabaseResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
+//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRestoreDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreDatabaseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+			}
+			err = ValidateRestoreDatabaseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+			}
+			res := NewRestoreDatabaseResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "cluster_not_initialized":
+				var (
+					body RestoreDatabaseClusterNotInitializedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				}
+				err = ValidateRestoreDatabaseClusterNotInitializedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				}
+				return nil, NewRestoreDatabaseClusterNotInitialized(&body)
+			case "database_not_modifiable":
+				var (
+					body RestoreDatabaseDatabaseNotModifiableResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				}
+				err = ValidateRestoreDatabaseDatabaseNotModifiableResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				}
+				return nil, NewRestoreDatabaseDatabaseNotModifiable(&body)
+			case "operation_already_in_progress":
+				var (
+					body RestoreDatabaseOperationAlreadyInProgressResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				}
+				err = ValidateRestoreDatabaseOperationAlreadyInProgressResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				}
+				return nil, NewRestoreDatabaseOperationAlreadyInProgress(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("control-plane", "restore-database", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body RestoreDatabaseInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+			}
+			err = ValidateRestoreDatabaseInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+			}
+			return nil, NewRestoreDatabaseInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreDatabaseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+			}
+			err = ValidateRestoreDatabaseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+			}
+			return nil, NewRestoreDatabaseNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RestoreDatabaseServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+			}
+			err = ValidateRestoreDatabaseServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+			}
+			return nil, NewRestoreDatabaseServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "restore-database", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCloneDatabaseRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "clone-database" endpoint
+func (c *Client) BuildCloneDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+	)
+	{
+		p, ok := v.(*controlplane.CloneDatabasePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "clone-database", "*controlplane.CloneDatabasePayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CloneDatabaseControlPlanePath(databaseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "clone-database", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCloneDatabaseRequest returns an encoder for requests sent to the
+// control-plane clone-database server.
+func EncodeCloneDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.CloneDatabasePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "clone-database", "*controlplane.CloneDatabasePayload", v)
+		}
+		body := NewCloneDatabaseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "clone-database", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCloneDatabaseResponse returns a decoder for responses returned by the
+// control-plane clone-database endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCloneDatabaseResponse may return the following errors:
+//   - "database_already_exists" (type *controlplane.APIError): http.StatusConflict
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCloneDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CloneDatabaseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+			}
+			err = ValidateCloneDatabaseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+			}
+			res := NewCloneDatabaseResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "database_already_exists":
+				var (
+					body CloneDatabaseDatabaseAlreadyExistsResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+				}
+				err = ValidateCloneDatabaseDatabaseAlreadyExistsResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+				}
+				return nil, NewCloneDatabaseDatabaseAlreadyExists(&body)
+			case "cluster_not_initialized":
+				var (
+					body CloneDatabaseClusterNotInitializedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+				}
+				err = ValidateCloneDatabaseClusterNotInitializedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+				}
+				return nil, NewCloneDatabaseClusterNotInitialized(&body)
+			case "operation_already_in_progress":
+				var (
+					body CloneDatabaseOperationAlreadyInProgressResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+				}
+				err = ValidateCloneDatabaseOperationAlreadyInProgressResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+				}
+				return nil, NewCloneDatabaseOperationAlreadyInProgress(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("control-plane", "clone-database", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body CloneDatabaseInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+			}
+			err = ValidateCloneDatabaseInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+			}
+			return nil, NewCloneDatabaseInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body CloneDatabaseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+			}
+			err = ValidateCloneDatabaseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+			}
+			return nil, NewCloneDatabaseNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CloneDatabaseServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "clone-database", err)
+			}
+			err = ValidateCloneDatabaseServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "clone-database", err)
+			}
+			return nil, NewCloneDatabaseServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "clone-database", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetVersionRequest instantiates a HTTP request object with method and
+// path set to call the "control-plane" service "get-version" endpoint
+func (c *Client) BuildGetVersionRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetVersionControlPlanePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-version", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetVersionResponse returns a decoder for responses returned by the
+// control-plane get-version endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetVersionResponse may return the following errors:
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetVersionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetVersionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-version", err)
+			}
+			err = ValidateGetVersionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-version", err)
+			}
+			res := NewGetVersionVersionInfoOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body GetVersionServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-version", err)
+			}
+			err = ValidateGetVersionServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-version", err)
+			}
+			return nil, NewGetVersionServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-version", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestartInstanceRequest instantiates a HTTP request object with method
+// and path set to call the "control-plane" service "restart-instance" endpoint
+func (c *Client) BuildRestartInstanceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		instanceID string
+	)
+	{
+		p, ok := v.(*controlplane.RestartInstancePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "restart-instance", "*controlplane.RestartInstancePayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		instanceID = p.InstanceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestartInstanceControlPlanePath(databaseID, instanceID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "restart-instance", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestartInstanceRequest returns an encoder for requests sent to the
+// control-plane restart-instance server.
+func EncodeRestartInstanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*controlplane.RestartInstancePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("control-plane", "restart-instance", "*controlplane.RestartInstancePayload", v)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("control-plane", "restart-instance", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRestartInstanceResponse returns a decoder for responses returned by
+// the control-plane restart-instance endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeRestartInstanceResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRestartInstanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestartInstanceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+			}
+			err = ValidateRestartInstanceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+			}
+			res := NewRestartInstanceResponseOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body RestartInstanceClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+			}
+			err = ValidateRestartInstanceClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+			}
+			return nil, NewRestartInstanceClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body RestartInstanceInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+			}
+			err = ValidateRestartInstanceInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+			}
+			return nil, NewRestartInstanceInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body RestartInstanceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+			}
+			err = ValidateRestartInstanceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+			}
+			return nil, NewRestartInstanceNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RestartInstanceServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+			}
+			err = ValidateRestartInstanceServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
 			}
-			return nil, NewGetHostTaskLogServerError(&body)
+			return nil, NewRestartInstanceServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "get-host-task-log", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "restart-instance", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListTasksRequest instantiates a HTTP request object with method and
-// path set to call the "control-plane" service "list-tasks" endpoint
-func (c *Client) BuildListTasksRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTasksControlPlanePath()}
+// BuildGetInstanceSessionsRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "get-instance-sessions" endpoint
+func (c *Client) BuildGetInstanceSessionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		instanceID string
+	)
+	{
+		p, ok := v.(*controlplane.GetInstanceSessionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "get-instance-sessions", "*controlplane.GetInstanceSessionsPayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		instanceID = p.InstanceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetInstanceSessionsControlPlanePath(databaseID, instanceID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "list-tasks", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-instance-sessions", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3294,44 +6065,16 @@ func (c *Client) BuildListTasksRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
-// EncodeListTasksRequest returns an encoder for requests sent to the
-// control-plane list-tasks server.
-func EncodeListTasksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.ListTasksPayload)
-		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "list-tasks", "*controlplane.ListTasksPayload", v)
-		}
-		values := req.URL.Query()
-		if p.Scope != nil {
-			values.Add("scope", *p.Scope)
-		}
-		if p.EntityID != nil {
-			values.Add("entity_id", string(*p.EntityID))
-		}
-		if p.AfterTaskID != nil {
-			values.Add("after_task_id", *p.AfterTaskID)
-		}
-		if p.Limit != nil {
-			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
-		}
-		if p.SortOrder != nil {
-			values.Add("sort_order", *p.SortOrder)
-		}
-		req.URL.RawQuery = values.Encode()
-		return nil
-	}
-}
-
-// DecodeListTasksResponse returns a decoder for responses returned by the
-// control-plane list-tasks endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeListTasksResponse may return the following errors:
+// DecodeGetInstanceSessionsResponse returns a decoder for responses returned
+// by the control-plane get-instance-sessions endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetInstanceSessionsResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeListTasksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetInstanceSessionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3348,85 +6091,104 @@ func DecodeListTasksResponse(decoder func(*http.Response) goahttp.Decoder, resto
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ListTasksResponseBody
+				body GetInstanceSessionsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-sessions", err)
 			}
-			err = ValidateListTasksResponseBody(&body)
+			err = ValidateGetInstanceSessionsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-sessions", err)
 			}
-			res := NewListTasksResponseOK(&body)
+			res := NewGetInstanceSessionsResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			var (
-				body ListTasksClusterNotInitializedResponseBody
+				body GetInstanceSessionsClusterNotInitializedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-sessions", err)
 			}
-			err = ValidateListTasksClusterNotInitializedResponseBody(&body)
+			err = ValidateGetInstanceSessionsClusterNotInitializedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-sessions", err)
 			}
-			return nil, NewListTasksClusterNotInitialized(&body)
+			return nil, NewGetInstanceSessionsClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body ListTasksInvalidInputResponseBody
+				body GetInstanceSessionsInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-sessions", err)
 			}
-			err = ValidateListTasksInvalidInputResponseBody(&body)
+			err = ValidateGetInstanceSessionsInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-sessions", err)
 			}
-			return nil, NewListTasksInvalidInput(&body)
+			return nil, NewGetInstanceSessionsInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body GetInstanceSessionsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-sessions", err)
+			}
+			err = ValidateGetInstanceSessionsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-sessions", err)
+			}
+			return nil, NewGetInstanceSessionsNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ListTasksServerErrorResponseBody
+				body GetInstanceSessionsServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-sessions", err)
 			}
-			err = ValidateListTasksServerErrorResponseBody(&body)
+			err = ValidateGetInstanceSessionsServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "list-tasks", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-sessions", err)
 			}
-			return nil, NewListTasksServerError(&body)
+			return nil, NewGetInstanceSessionsServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "list-tasks", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-instance-sessions", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildRestoreDatabaseRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "restore-database" endpoint
-func (c *Client) BuildRestoreDatabaseRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildCancelInstanceSessionRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "cancel-instance-session" endpoint
+func (c *Client) BuildCancelInstanceSessionRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
+		instanceID string
+		pid        int
 	)
 	{
-		p, ok := v.(*controlplane.RestoreDatabasePayload)
+		p, ok := v.(*controlplane.CancelInstanceSessionPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "restore-database", "*controlplane.RestoreDatabasePayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "cancel-instance-session", "*controlplane.CancelInstanceSessionPayload", v)
 		}
 		databaseID = string(p.DatabaseID)
+		instanceID = p.InstanceID
+		pid = p.Pid
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreDatabaseControlPlanePath(databaseID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelInstanceSessionControlPlanePath(databaseID, instanceID, pid)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "restore-database", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "cancel-instance-session", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3435,37 +6197,16 @@ func (c *Client) BuildRestoreDatabaseRequest(ctx context.Context, v any) (*http.
 	return req, nil
 }
 
-// EncodeRestoreDatabaseRequest returns an encoder for requests sent to the
-// control-plane restore-database server.
-func EncodeRestoreDatabaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.RestoreDatabasePayload)
-		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "restore-database", "*controlplane.RestoreDatabasePayload", v)
-		}
-		values := req.URL.Query()
-		values.Add("force", fmt.Sprintf("%v", p.Force))
-		req.URL.RawQuery = values.Encode()
-		body := NewRestoreDatabaseRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("control-plane", "restore-database", err)
-		}
-		return nil
-	}
-}
-
-// DecodeRestoreDatabaseResponse returns a decoder for responses returned by
-// the control-plane restore-database endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeRestoreDatabaseResponse may return the following errors:
+// DecodeCancelInstanceSessionResponse returns a decoder for responses returned
+// by the control-plane cancel-instance-session endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeCancelInstanceSessionResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
-//   - "database_not_modifiable" (type *controlplane.APIError): http.StatusConflict
-//   - "operation_already_in_progress" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeRestoreDatabaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCancelInstanceSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3482,124 +6223,104 @@ func DecodeRestoreDatabaseResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body RestoreDatabaseResponseBody
+				body CancelInstanceSessionResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cancel-instance-session", err)
 			}
-			err = ValidateRestoreDatabaseResponseBody(&body)
+			err = ValidateCancelInstanceSessionResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cancel-instance-session", err)
 			}
-			res := NewRestoreDatabaseResponseOK(&body)
+			res := NewCancelInstanceSessionSignalInstanceSessionResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
-			en := resp.Header.Get("goa-error")
-			switch en {
-			case "cluster_not_initialized":
-				var (
-					body RestoreDatabaseClusterNotInitializedResponseBody
-					err  error
-				)
-				err = decoder(resp).Decode(&body)
-				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
-				}
-				err = ValidateRestoreDatabaseClusterNotInitializedResponseBody(&body)
-				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
-				}
-				return nil, NewRestoreDatabaseClusterNotInitialized(&body)
-			case "database_not_modifiable":
-				var (
-					body RestoreDatabaseDatabaseNotModifiableResponseBody
-					err  error
-				)
-				err = decoder(resp).Decode(&body)
-				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
-				}
-				err = ValidateRestoreDatabaseDatabaseNotModifiableResponseBody(&body)
-				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
-				}
-				return nil, NewRestoreDatabaseDatabaseNotModifiable(&body)
-			case "operation_already_in_progress":
-				var (
-					body RestoreDatabaseOperationAlreadyInProgressResponseBody
-					err  error
-				)
-				err = decoder(resp).Decode(&body)
-				if err != nil {
-					return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
-				}
-				err = ValidateRestoreDatabaseOperationAlreadyInProgressResponseBody(&body)
-				if err != nil {
-					return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
-				}
-				return nil, NewRestoreDatabaseOperationAlreadyInProgress(&body)
-			default:
-				body, _ := io.ReadAll(resp.Body)
-				return nil, goahttp.ErrInvalidResponse("control-plane", "restore-database", resp.StatusCode, string(body))
+			var (
+				body CancelInstanceSessionClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "cancel-instance-session", err)
 			}
+			err = ValidateCancelInstanceSessionClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "cancel-instance-session", err)
+			}
+			return nil, NewCancelInstanceSessionClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body RestoreDatabaseInvalidInputResponseBody
+				body CancelInstanceSessionInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cancel-instance-session", err)
 			}
-			err = ValidateRestoreDatabaseInvalidInputResponseBody(&body)
+			err = ValidateCancelInstanceSessionInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cancel-instance-session", err)
 			}
-			return nil, NewRestoreDatabaseInvalidInput(&body)
+			return nil, NewCancelInstanceSessionInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body RestoreDatabaseNotFoundResponseBody
+				body CancelInstanceSessionNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cancel-instance-session", err)
 			}
-			err = ValidateRestoreDatabaseNotFoundResponseBody(&body)
+			err = ValidateCancelInstanceSessionNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cancel-instance-session", err)
 			}
-			return nil, NewRestoreDatabaseNotFound(&body)
+			return nil, NewCancelInstanceSessionNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body RestoreDatabaseServerErrorResponseBody
+				body CancelInstanceSessionServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "cancel-instance-session", err)
 			}
-			err = ValidateRestoreDatabaseServerErrorResponseBody(&body)
+			err = ValidateCancelInstanceSessionServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restore-database", err)
+				return nil, goahttp.ErrValidationError("control-plane", "cancel-instance-session", err)
 			}
-			return nil, NewRestoreDatabaseServerError(&body)
+			return nil, NewCancelInstanceSessionServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "restore-database", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "cancel-instance-session", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetVersionRequest instantiates a HTTP request object with method and
-// path set to call the "control-plane" service "get-version" endpoint
-func (c *Client) BuildGetVersionRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetVersionControlPlanePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildTerminateInstanceSessionRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "terminate-instance-session" endpoint
+func (c *Client) BuildTerminateInstanceSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		instanceID string
+		pid        int
+	)
+	{
+		p, ok := v.(*controlplane.TerminateInstanceSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "terminate-instance-session", "*controlplane.TerminateInstanceSessionPayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		instanceID = p.InstanceID
+		pid = p.Pid
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TerminateInstanceSessionControlPlanePath(databaseID, instanceID, pid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "get-version", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "terminate-instance-session", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3608,13 +6329,17 @@ func (c *Client) BuildGetVersionRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// DecodeGetVersionResponse returns a decoder for responses returned by the
-// control-plane get-version endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetVersionResponse may return the following errors:
+// DecodeTerminateInstanceSessionResponse returns a decoder for responses
+// returned by the control-plane terminate-instance-session endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeTerminateInstanceSessionResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetVersionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeTerminateInstanceSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3631,59 +6356,102 @@ func DecodeGetVersionResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetVersionResponseBody
+				body TerminateInstanceSessionResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-version", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "terminate-instance-session", err)
 			}
-			err = ValidateGetVersionResponseBody(&body)
+			err = ValidateTerminateInstanceSessionResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-version", err)
+				return nil, goahttp.ErrValidationError("control-plane", "terminate-instance-session", err)
 			}
-			res := NewGetVersionVersionInfoOK(&body)
+			res := NewTerminateInstanceSessionSignalInstanceSessionResponseOK(&body)
 			return res, nil
+		case http.StatusConflict:
+			var (
+				body TerminateInstanceSessionClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "terminate-instance-session", err)
+			}
+			err = ValidateTerminateInstanceSessionClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "terminate-instance-session", err)
+			}
+			return nil, NewTerminateInstanceSessionClusterNotInitialized(&body)
+		case http.StatusBadRequest:
+			var (
+				body TerminateInstanceSessionInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "terminate-instance-session", err)
+			}
+			err = ValidateTerminateInstanceSessionInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "terminate-instance-session", err)
+			}
+			return nil, NewTerminateInstanceSessionInvalidInput(&body)
+		case http.StatusNotFound:
+			var (
+				body TerminateInstanceSessionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "terminate-instance-session", err)
+			}
+			err = ValidateTerminateInstanceSessionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "terminate-instance-session", err)
+			}
+			return nil, NewTerminateInstanceSessionNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetVersionServerErrorResponseBody
+				body TerminateInstanceSessionServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "get-version", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "terminate-instance-session", err)
 			}
-			err = ValidateGetVersionServerErrorResponseBody(&body)
+			err = ValidateTerminateInstanceSessionServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "get-version", err)
+				return nil, goahttp.ErrValidationError("control-plane", "terminate-instance-session", err)
 			}
-			return nil, NewGetVersionServerError(&body)
+			return nil, NewTerminateInstanceSessionServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "get-version", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "terminate-instance-session", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildRestartInstanceRequest instantiates a HTTP request object with method
-// and path set to call the "control-plane" service "restart-instance" endpoint
-func (c *Client) BuildRestartInstanceRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetInstancePostgresqlConfRequest instantiates a HTTP request object
+// with method and path set to call the "control-plane" service
+// "get-instance-postgresql-conf" endpoint
+func (c *Client) BuildGetInstancePostgresqlConfRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		databaseID string
 		instanceID string
 	)
 	{
-		p, ok := v.(*controlplane.RestartInstancePayload)
+		p, ok := v.(*controlplane.GetInstancePostgresqlConfPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("control-plane", "restart-instance", "*controlplane.RestartInstancePayload", v)
+			return nil, goahttp.ErrInvalidType("control-plane", "get-instance-postgresql-conf", "*controlplane.GetInstancePostgresqlConfPayload", v)
 		}
 		databaseID = string(p.DatabaseID)
 		instanceID = p.InstanceID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestartInstanceControlPlanePath(databaseID, instanceID)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetInstancePostgresqlConfControlPlanePath(databaseID, instanceID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("control-plane", "restart-instance", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("control-plane", "get-instance-postgresql-conf", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -3692,32 +6460,17 @@ func (c *Client) BuildRestartInstanceRequest(ctx context.Context, v any) (*http.
 	return req, nil
 }
 
-// EncodeRestartInstanceRequest returns an encoder for requests sent to the
-// control-plane restart-instance server.
-func EncodeRestartInstanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*controlplane.RestartInstancePayload)
-		if !ok {
-			return goahttp.ErrInvalidType("control-plane", "restart-instance", "*controlplane.RestartInstancePayload", v)
-		}
-		body := p
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("control-plane", "restart-instance", err)
-		}
-		return nil
-	}
-}
-
-// DecodeRestartInstanceResponse returns a decoder for responses returned by
-// the control-plane restart-instance endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeRestartInstanceResponse may return the following errors:
+// DecodeGetInstancePostgresqlConfResponse returns a decoder for responses
+// returned by the control-plane get-instance-postgresql-conf endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetInstancePostgresqlConfResponse may return the following errors:
 //   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
 //   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
 //   - "not_found" (type *controlplane.APIError): http.StatusNotFound
 //   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeRestartInstanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetInstancePostgresqlConfResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -3734,78 +6487,78 @@ func DecodeRestartInstanceResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body RestartInstanceResponseBody
+				body GetInstancePostgresqlConfResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			err = ValidateRestartInstanceResponseBody(&body)
+			err = ValidateGetInstancePostgresqlConfResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			res := NewRestartInstanceResponseOK(&body)
+			res := NewGetInstancePostgresqlConfGetInstancePostgreSQLConfResponseOK(&body)
 			return res, nil
 		case http.StatusConflict:
 			var (
-				body RestartInstanceClusterNotInitializedResponseBody
+				body GetInstancePostgresqlConfClusterNotInitializedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			err = ValidateRestartInstanceClusterNotInitializedResponseBody(&body)
+			err = ValidateGetInstancePostgresqlConfClusterNotInitializedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			return nil, NewRestartInstanceClusterNotInitialized(&body)
+			return nil, NewGetInstancePostgresqlConfClusterNotInitialized(&body)
 		case http.StatusBadRequest:
 			var (
-				body RestartInstanceInvalidInputResponseBody
+				body GetInstancePostgresqlConfInvalidInputResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			err = ValidateRestartInstanceInvalidInputResponseBody(&body)
+			err = ValidateGetInstancePostgresqlConfInvalidInputResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			return nil, NewRestartInstanceInvalidInput(&body)
+			return nil, NewGetInstancePostgresqlConfInvalidInput(&body)
 		case http.StatusNotFound:
 			var (
-				body RestartInstanceNotFoundResponseBody
+				body GetInstancePostgresqlConfNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			err = ValidateRestartInstanceNotFoundResponseBody(&body)
+			err = ValidateGetInstancePostgresqlConfNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			return nil, NewRestartInstanceNotFound(&body)
+			return nil, NewGetInstancePostgresqlConfNotFound(&body)
 		case http.StatusInternalServerError:
 			var (
-				body RestartInstanceServerErrorResponseBody
+				body GetInstancePostgresqlConfServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrDecodingError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			err = ValidateRestartInstanceServerErrorResponseBody(&body)
+			err = ValidateGetInstancePostgresqlConfServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("control-plane", "restart-instance", err)
+				return nil, goahttp.ErrValidationError("control-plane", "get-instance-postgresql-conf", err)
 			}
-			return nil, NewRestartInstanceServerError(&body)
+			return nil, NewGetInstancePostgresqlConfServerError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("control-plane", "restart-instance", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("control-plane", "get-instance-postgresql-conf", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -4213,6 +6966,136 @@ func DecodeCancelDatabaseTaskResponse(decoder func(*http.Response) goahttp.Decod
 	}
 }
 
+// BuildResumeDatabaseTaskRequest instantiates a HTTP request object with
+// method and path set to call the "control-plane" service
+// "resume-database-task" endpoint
+func (c *Client) BuildResumeDatabaseTaskRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		databaseID string
+		taskID     string
+	)
+	{
+		p, ok := v.(*controlplane.ResumeDatabaseTaskPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("control-plane", "resume-database-task", "*controlplane.ResumeDatabaseTaskPayload", v)
+		}
+		databaseID = string(p.DatabaseID)
+		taskID = string(p.TaskID)
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResumeDatabaseTaskControlPlanePath(databaseID, taskID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("control-plane", "resume-database-task", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeResumeDatabaseTaskResponse returns a decoder for responses returned by
+// the control-plane resume-database-task endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeResumeDatabaseTaskResponse may return the following errors:
+//   - "cluster_not_initialized" (type *controlplane.APIError): http.StatusConflict
+//   - "not_found" (type *controlplane.APIError): http.StatusNotFound
+//   - "invalid_input" (type *controlplane.APIError): http.StatusBadRequest
+//   - "server_error" (type *controlplane.APIError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeResumeDatabaseTaskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ResumeDatabaseTaskResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "resume-database-task", err)
+			}
+			err = ValidateResumeDatabaseTaskResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "resume-database-task", err)
+			}
+			res := NewResumeDatabaseTaskTaskOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ResumeDatabaseTaskClusterNotInitializedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "resume-database-task", err)
+			}
+			err = ValidateResumeDatabaseTaskClusterNotInitializedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "resume-database-task", err)
+			}
+			return nil, NewResumeDatabaseTaskClusterNotInitialized(&body)
+		case http.StatusNotFound:
+			var (
+				body ResumeDatabaseTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "resume-database-task", err)
+			}
+			err = ValidateResumeDatabaseTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "resume-database-task", err)
+			}
+			return nil, NewResumeDatabaseTaskNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ResumeDatabaseTaskInvalidInputResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "resume-database-task", err)
+			}
+			err = ValidateResumeDatabaseTaskInvalidInputResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "resume-database-task", err)
+			}
+			return nil, NewResumeDatabaseTaskInvalidInput(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ResumeDatabaseTaskServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("control-plane", "resume-database-task", err)
+			}
+			err = ValidateResumeDatabaseTaskServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("control-plane", "resume-database-task", err)
+			}
+			return nil, NewResumeDatabaseTaskServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("control-plane", "resume-database-task", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalEtcdClusterMemberResponseBodyToControlplaneEtcdClusterMember builds
 // a value of type *controlplane.EtcdClusterMember from a value of type
 // *EtcdClusterMemberResponseBody.
@@ -4331,6 +7214,9 @@ func unmarshalHostStatusResponseBodyToControlplaneHostStatus(v *HostStatusRespon
 		}
 		res.Components[tk] = unmarshalComponentStatusResponseBodyToControlplaneComponentStatus(val)
 	}
+	if v.Version != nil {
+		res.Version = unmarshalVersionInfoResponseBodyToControlplaneVersionInfo(v.Version)
+	}
 
 	return res
 }
@@ -4355,6 +7241,22 @@ func unmarshalComponentStatusResponseBodyToControlplaneComponentStatus(v *Compon
 	return res
 }
 
+// unmarshalVersionInfoResponseBodyToControlplaneVersionInfo builds a value of
+// type *controlplane.VersionInfo from a value of type *VersionInfoResponseBody.
+func unmarshalVersionInfoResponseBodyToControlplaneVersionInfo(v *VersionInfoResponseBody) *controlplane.VersionInfo {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.VersionInfo{
+		Version:      *v.Version,
+		Revision:     *v.Revision,
+		RevisionTime: *v.RevisionTime,
+		Arch:         *v.Arch,
+	}
+
+	return res
+}
+
 // unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion builds a value
 // of type *controlplane.PgEdgeVersion from a value of type
 // *PgEdgeVersionResponseBody.
@@ -4370,23 +7272,134 @@ func unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion(v *PgEdgeVers
 	return res
 }
 
+// unmarshalClusterUpgradeStatusResponseBodyToControlplaneClusterUpgradeStatus
+// builds a value of type *controlplane.ClusterUpgradeStatus from a value of
+// type *ClusterUpgradeStatusResponseBody.
+func unmarshalClusterUpgradeStatusResponseBodyToControlplaneClusterUpgradeStatus(v *ClusterUpgradeStatusResponseBody) *controlplane.ClusterUpgradeStatus {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.ClusterUpgradeStatus{
+		State:        *v.State,
+		Revision:     v.Revision,
+		StateVersion: v.StateVersion,
+	}
+	res.PendingMigrations = make([]string, len(v.PendingMigrations))
+	for i, val := range v.PendingMigrations {
+		res.PendingMigrations[i] = val
+	}
+	res.Hosts = make([]*controlplane.ClusterUpgradeHost, len(v.Hosts))
+	for i, val := range v.Hosts {
+		if val == nil {
+			res.Hosts[i] = nil
+			continue
+		}
+		res.Hosts[i] = unmarshalClusterUpgradeHostResponseBodyToControlplaneClusterUpgradeHost(val)
+	}
+	res.Incompatibilities = make([]string, len(v.Incompatibilities))
+	for i, val := range v.Incompatibilities {
+		res.Incompatibilities[i] = val
+	}
+
+	return res
+}
+
+// unmarshalClusterUpgradeHostResponseBodyToControlplaneClusterUpgradeHost
+// builds a value of type *controlplane.ClusterUpgradeHost from a value of type
+// *ClusterUpgradeHostResponseBody.
+func unmarshalClusterUpgradeHostResponseBodyToControlplaneClusterUpgradeHost(v *ClusterUpgradeHostResponseBody) *controlplane.ClusterUpgradeHost {
+	res := &controlplane.ClusterUpgradeHost{
+		HostID:       controlplane.Identifier(*v.HostID),
+		Version:      v.Version,
+		Revision:     v.Revision,
+		StateVersion: v.StateVersion,
+		Compatible:   *v.Compatible,
+		Upgraded:     *v.Upgraded,
+	}
+
+	return res
+}
+
+// unmarshalStoragePrefixUsageResponseBodyToControlplaneStoragePrefixUsage
+// builds a value of type *controlplane.StoragePrefixUsage from a value of type
+// *StoragePrefixUsageResponseBody.
+func unmarshalStoragePrefixUsageResponseBodyToControlplaneStoragePrefixUsage(v *StoragePrefixUsageResponseBody) *controlplane.StoragePrefixUsage {
+	res := &controlplane.StoragePrefixUsage{
+		Prefix: *v.Prefix,
+		Keys:   *v.Keys,
+		Bytes:  *v.Bytes,
+	}
+
+	return res
+}
+
+// unmarshalEtcdMemberResponseBodyToControlplaneEtcdMember builds a value of
+// type *controlplane.EtcdMember from a value of type *EtcdMemberResponseBody.
+func unmarshalEtcdMemberResponseBodyToControlplaneEtcdMember(v *EtcdMemberResponseBody) *controlplane.EtcdMember {
+	res := &controlplane.EtcdMember{
+		ID:               *v.ID,
+		HostID:           controlplane.Identifier(*v.HostID),
+		Role:             *v.Role,
+		IsLeader:         *v.IsLeader,
+		Reachable:        *v.Reachable,
+		Version:          v.Version,
+		DbSizeBytes:      v.DbSizeBytes,
+		DbSizeInUseBytes: v.DbSizeInUseBytes,
+		RaftTerm:         v.RaftTerm,
+		RaftIndex:        v.RaftIndex,
+		RaftAppliedIndex: v.RaftAppliedIndex,
+		RaftIndexLag:     *v.RaftIndexLag,
+	}
+	res.PeerUrls = make([]string, len(v.PeerUrls))
+	for i, val := range v.PeerUrls {
+		res.PeerUrls[i] = val
+	}
+	res.ClientUrls = make([]string, len(v.ClientUrls))
+	for i, val := range v.ClientUrls {
+		res.ClientUrls[i] = val
+	}
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalEtcdDefragmentResultResponseBodyToControlplaneEtcdDefragmentResult
+// builds a value of type *controlplane.EtcdDefragmentResult from a value of
+// type *EtcdDefragmentResultResponseBody.
+func unmarshalEtcdDefragmentResultResponseBodyToControlplaneEtcdDefragmentResult(v *EtcdDefragmentResultResponseBody) *controlplane.EtcdDefragmentResult {
+	res := &controlplane.EtcdDefragmentResult{
+		HostID:            controlplane.Identifier(*v.HostID),
+		DbSizeBeforeBytes: *v.DbSizeBeforeBytes,
+		DbSizeAfterBytes:  v.DbSizeAfterBytes,
+		Error:             v.Error,
+	}
+
+	return res
+}
+
 // unmarshalTaskResponseBodyToControlplaneTask builds a value of type
 // *controlplane.Task from a value of type *TaskResponseBody.
 func unmarshalTaskResponseBodyToControlplaneTask(v *TaskResponseBody) *controlplane.Task {
 	res := &controlplane.Task{
-		ParentID:    v.ParentID,
-		Scope:       *v.Scope,
-		EntityID:    *v.EntityID,
-		DatabaseID:  v.DatabaseID,
-		NodeName:    v.NodeName,
-		InstanceID:  v.InstanceID,
-		HostID:      v.HostID,
-		TaskID:      *v.TaskID,
-		CreatedAt:   *v.CreatedAt,
-		CompletedAt: v.CompletedAt,
-		Type:        *v.Type,
-		Status:      *v.Status,
-		Error:       v.Error,
+		ParentID:     v.ParentID,
+		Scope:        *v.Scope,
+		EntityID:     *v.EntityID,
+		DatabaseID:   v.DatabaseID,
+		NodeName:     v.NodeName,
+		InstanceID:   v.InstanceID,
+		HostID:       v.HostID,
+		TaskID:       *v.TaskID,
+		CreatedAt:    *v.CreatedAt,
+		CompletedAt:  v.CompletedAt,
+		Type:         *v.Type,
+		Status:       *v.Status,
+		StatusReason: v.StatusReason,
+		Error:        v.Error,
 	}
 
 	return res
@@ -4406,6 +7419,12 @@ func unmarshalDatabaseSummaryResponseBodyToControlplaneDatabaseSummary(v *Databa
 		tenantID := controlplane.Identifier(*v.TenantID)
 		res.TenantID = &tenantID
 	}
+	if v.DegradedReasons != nil {
+		res.DegradedReasons = make([]string, len(v.DegradedReasons))
+		for i, val := range v.DegradedReasons {
+			res.DegradedReasons[i] = val
+		}
+	}
 	if v.Instances != nil {
 		res.Instances = make([]*controlplane.Instance, len(v.Instances))
 		for i, val := range v.Instances {
@@ -4455,6 +7474,9 @@ func unmarshalInstanceResponseBodyToControlplaneInstance(v *InstanceResponseBody
 	if v.Spock != nil {
 		res.Spock = unmarshalInstanceSpockStatusResponseBodyToControlplaneInstanceSpockStatus(v.Spock)
 	}
+	if v.Resources != nil {
+		res.Resources = unmarshalInstanceResourceUsageResponseBodyToControlplaneInstanceResourceUsage(v.Resources)
+	}
 
 	return res
 }
@@ -4538,6 +7560,31 @@ func unmarshalInstanceSubscriptionResponseBodyToControlplaneInstanceSubscription
 	return res
 }
 
+// unmarshalInstanceResourceUsageResponseBodyToControlplaneInstanceResourceUsage
+// builds a value of type *controlplane.InstanceResourceUsage from a value of
+// type *InstanceResourceUsageResponseBody.
+func unmarshalInstanceResourceUsageResponseBodyToControlplaneInstanceResourceUsage(v *InstanceResourceUsageResponseBody) *controlplane.InstanceResourceUsage {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.InstanceResourceUsage{
+		DataDirectoryBytes: v.DataDirectoryBytes,
+		WalDirectoryBytes:  v.WalDirectoryBytes,
+		VolumeFreeBytes:    v.VolumeFreeBytes,
+		VolumeTotalBytes:   v.VolumeTotalBytes,
+		DatabaseSizeBytes:  v.DatabaseSizeBytes,
+		Connections:        v.Connections,
+		MaxConnections:     v.MaxConnections,
+		CPUPercent:         v.CPUPercent,
+		MemoryBytes:        v.MemoryBytes,
+		MemoryLimitBytes:   v.MemoryLimitBytes,
+		DiskFull:           v.DiskFull,
+		CollectedAt:        v.CollectedAt,
+	}
+
+	return res
+}
+
 // unmarshalAvailableUpgradeResponseBodyToControlplaneAvailableUpgrade builds a
 // value of type *controlplane.AvailableUpgrade from a value of type
 // *AvailableUpgradeResponseBody.
@@ -4566,6 +7613,8 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecRequestBody(v *controlplane.Da
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	if v.Nodes != nil {
 		res.Nodes = make([]*DatabaseNodeSpecRequestBody, len(v.Nodes))
@@ -4631,6 +7680,12 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecRequestBody(v *controlplane.Da
 	if v.Scripts != nil {
 		res.Scripts = marshalControlplaneDatabaseScriptsToDatabaseScriptsRequestBody(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsRequestBody(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBody(v.QueryStats)
+	}
 
 	return res
 }
@@ -4685,6 +7740,9 @@ func marshalControlplaneDatabaseNodeSpecToDatabaseNodeSpecRequestBody(v *control
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = marshalControlplaneOrchestratorOptsToOrchestratorOptsRequestBody(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsRequestBody(v.Patroni)
+	}
 
 	return res
 }
@@ -4939,6 +7997,44 @@ func marshalControlplaneExtraNetworkSpecToExtraNetworkSpecRequestBody(v *control
 	return res
 }
 
+// marshalControlplanePatroniSettingsToPatroniSettingsRequestBody builds a
+// value of type *PatroniSettingsRequestBody from a value of type
+// *controlplane.PatroniSettings.
+func marshalControlplanePatroniSettingsToPatroniSettingsRequestBody(v *controlplane.PatroniSettings) *PatroniSettingsRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniSettingsRequestBody{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBody(v.Watchdog)
+	}
+
+	return res
+}
+
+// marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBody builds a
+// value of type *PatroniWatchdogRequestBody from a value of type
+// *controlplane.PatroniWatchdog.
+func marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBody(v *controlplane.PatroniWatchdog) *PatroniWatchdogRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniWatchdogRequestBody{
+		Mode:         v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // marshalControlplaneDatabaseUserSpecToDatabaseUserSpecRequestBody builds a
 // value of type *DatabaseUserSpecRequestBody from a value of type
 // *controlplane.DatabaseUserSpec.
@@ -5052,6 +8148,35 @@ func marshalControlplaneDatabaseScriptsToDatabaseScriptsRequestBody(v *controlpl
 	return res
 }
 
+// marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBody builds
+// a value of type *QueryStatsSettingsRequestBody from a value of type
+// *controlplane.QueryStatsSettings.
+func marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBody(v *controlplane.QueryStatsSettings) *QueryStatsSettingsRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &QueryStatsSettingsRequestBody{
+		Enabled: v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// marshalControlplaneImportSourceSpecToImportSourceSpecRequestBody builds a
+// value of type *ImportSourceSpecRequestBody from a value of type
+// *controlplane.ImportSourceSpec.
+func marshalControlplaneImportSourceSpecToImportSourceSpecRequestBody(v *controlplane.ImportSourceSpec) *ImportSourceSpecRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ImportSourceSpecRequestBody{
+		Dsn: v.Dsn,
+	}
+
+	return res
+}
+
 // marshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec builds a value of
 // type *controlplane.DatabaseSpec from a value of type
 // *DatabaseSpecRequestBody.
@@ -5064,6 +8189,8 @@ func marshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(v *DatabaseSpecReq
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	if v.Nodes != nil {
 		res.Nodes = make([]*controlplane.DatabaseNodeSpec, len(v.Nodes))
@@ -5129,6 +8256,12 @@ func marshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(v *DatabaseSpecReq
 	if v.Scripts != nil {
 		res.Scripts = marshalDatabaseScriptsRequestBodyToControlplaneDatabaseScripts(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = marshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings(v.QueryStats)
+	}
 
 	return res
 }
@@ -5183,6 +8316,9 @@ func marshalDatabaseNodeSpecRequestBodyToControlplaneDatabaseNodeSpec(v *Databas
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = marshalOrchestratorOptsRequestBodyToControlplaneOrchestratorOpts(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
 
 	return res
 }
@@ -5437,6 +8573,44 @@ func marshalExtraNetworkSpecRequestBodyToControlplaneExtraNetworkSpec(v *ExtraNe
 	return res
 }
 
+// marshalPatroniSettingsRequestBodyToControlplanePatroniSettings builds a
+// value of type *controlplane.PatroniSettings from a value of type
+// *PatroniSettingsRequestBody.
+func marshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v *PatroniSettingsRequestBody) *controlplane.PatroniSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniSettings{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = marshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog(v.Watchdog)
+	}
+
+	return res
+}
+
+// marshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog builds a
+// value of type *controlplane.PatroniWatchdog from a value of type
+// *PatroniWatchdogRequestBody.
+func marshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog(v *PatroniWatchdogRequestBody) *controlplane.PatroniWatchdog {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniWatchdog{
+		Mode:         v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // marshalDatabaseUserSpecRequestBodyToControlplaneDatabaseUserSpec builds a
 // value of type *controlplane.DatabaseUserSpec from a value of type
 // *DatabaseUserSpecRequestBody.
@@ -5550,6 +8724,35 @@ func marshalDatabaseScriptsRequestBodyToControlplaneDatabaseScripts(v *DatabaseS
 	return res
 }
 
+// marshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings builds
+// a value of type *controlplane.QueryStatsSettings from a value of type
+// *QueryStatsSettingsRequestBody.
+func marshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings(v *QueryStatsSettingsRequestBody) *controlplane.QueryStatsSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.QueryStatsSettings{
+		Enabled: v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// marshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec builds a
+// value of type *controlplane.ImportSourceSpec from a value of type
+// *ImportSourceSpecRequestBody.
+func marshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec(v *ImportSourceSpecRequestBody) *controlplane.ImportSourceSpec {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.ImportSourceSpec{
+		Dsn: v.Dsn,
+	}
+
+	return res
+}
+
 // unmarshalDatabaseResponseBodyToControlplaneDatabase builds a value of type
 // *controlplane.Database from a value of type *DatabaseResponseBody.
 func unmarshalDatabaseResponseBodyToControlplaneDatabase(v *DatabaseResponseBody) *controlplane.Database {
@@ -5563,6 +8766,12 @@ func unmarshalDatabaseResponseBodyToControlplaneDatabase(v *DatabaseResponseBody
 		tenantID := controlplane.Identifier(*v.TenantID)
 		res.TenantID = &tenantID
 	}
+	if v.DegradedReasons != nil {
+		res.DegradedReasons = make([]string, len(v.DegradedReasons))
+		for i, val := range v.DegradedReasons {
+			res.DegradedReasons[i] = val
+		}
+	}
 	if v.Instances != nil {
 		res.Instances = make([]*controlplane.Instance, len(v.Instances))
 		for i, val := range v.Instances {
@@ -5596,6 +8805,9 @@ func unmarshalDatabaseResponseBodyToControlplaneDatabase(v *DatabaseResponseBody
 			res.AvailableUpgrades[i] = unmarshalAvailableUpgradeResponseBodyToControlplaneAvailableUpgrade(val)
 		}
 	}
+	if v.Drift != nil {
+		res.Drift = unmarshalDatabaseDriftResponseBodyToControlplaneDatabaseDrift(v.Drift)
+	}
 
 	return res
 }
@@ -5706,6 +8918,8 @@ func unmarshalDatabaseSpecResponseBodyToControlplaneDatabaseSpec(v *DatabaseSpec
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	res.Nodes = make([]*controlplane.DatabaseNodeSpec, len(v.Nodes))
 	for i, val := range v.Nodes {
@@ -5767,6 +8981,12 @@ func unmarshalDatabaseSpecResponseBodyToControlplaneDatabaseSpec(v *DatabaseSpec
 	if v.Scripts != nil {
 		res.Scripts = unmarshalDatabaseScriptsResponseBodyToControlplaneDatabaseScripts(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsResponseBodyToControlplanePatroniSettings(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = unmarshalQueryStatsSettingsResponseBodyToControlplaneQueryStatsSettings(v.QueryStats)
+	}
 
 	return res
 }
@@ -5817,6 +9037,9 @@ func unmarshalDatabaseNodeSpecResponseBodyToControlplaneDatabaseNodeSpec(v *Data
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = unmarshalOrchestratorOptsResponseBodyToControlplaneOrchestratorOpts(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsResponseBodyToControlplanePatroniSettings(v.Patroni)
+	}
 
 	return res
 }
@@ -6065,6 +9288,44 @@ func unmarshalExtraNetworkSpecResponseBodyToControlplaneExtraNetworkSpec(v *Extr
 	return res
 }
 
+// unmarshalPatroniSettingsResponseBodyToControlplanePatroniSettings builds a
+// value of type *controlplane.PatroniSettings from a value of type
+// *PatroniSettingsResponseBody.
+func unmarshalPatroniSettingsResponseBodyToControlplanePatroniSettings(v *PatroniSettingsResponseBody) *controlplane.PatroniSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniSettings{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = unmarshalPatroniWatchdogResponseBodyToControlplanePatroniWatchdog(v.Watchdog)
+	}
+
+	return res
+}
+
+// unmarshalPatroniWatchdogResponseBodyToControlplanePatroniWatchdog builds a
+// value of type *controlplane.PatroniWatchdog from a value of type
+// *PatroniWatchdogResponseBody.
+func unmarshalPatroniWatchdogResponseBodyToControlplanePatroniWatchdog(v *PatroniWatchdogResponseBody) *controlplane.PatroniWatchdog {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniWatchdog{
+		Mode:         *v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // unmarshalDatabaseUserSpecResponseBodyToControlplaneDatabaseUserSpec builds a
 // value of type *controlplane.DatabaseUserSpec from a value of type
 // *DatabaseUserSpecResponseBody.
@@ -6174,6 +9435,69 @@ func unmarshalDatabaseScriptsResponseBodyToControlplaneDatabaseScripts(v *Databa
 	return res
 }
 
+// unmarshalQueryStatsSettingsResponseBodyToControlplaneQueryStatsSettings
+// builds a value of type *controlplane.QueryStatsSettings from a value of type
+// *QueryStatsSettingsResponseBody.
+func unmarshalQueryStatsSettingsResponseBodyToControlplaneQueryStatsSettings(v *QueryStatsSettingsResponseBody) *controlplane.QueryStatsSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.QueryStatsSettings{
+		Enabled: *v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// unmarshalDatabaseDriftResponseBodyToControlplaneDatabaseDrift builds a value
+// of type *controlplane.DatabaseDrift from a value of type
+// *DatabaseDriftResponseBody.
+func unmarshalDatabaseDriftResponseBodyToControlplaneDatabaseDrift(v *DatabaseDriftResponseBody) *controlplane.DatabaseDrift {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.DatabaseDrift{
+		CheckedAt:         *v.CheckedAt,
+		Error:             v.Error,
+		RemediationTaskID: v.RemediationTaskID,
+	}
+	res.Resources = make([]*controlplane.DriftedResource, len(v.Resources))
+	for i, val := range v.Resources {
+		if val == nil {
+			res.Resources[i] = nil
+			continue
+		}
+		res.Resources[i] = unmarshalDriftedResourceResponseBodyToControlplaneDriftedResource(val)
+	}
+
+	return res
+}
+
+// unmarshalDriftedResourceResponseBodyToControlplaneDriftedResource builds a
+// value of type *controlplane.DriftedResource from a value of type
+// *DriftedResourceResponseBody.
+func unmarshalDriftedResourceResponseBodyToControlplaneDriftedResource(v *DriftedResourceResponseBody) *controlplane.DriftedResource {
+	res := &controlplane.DriftedResource{
+		ResourceID: *v.ResourceID,
+		Type:       *v.Type,
+		Reason:     v.Reason,
+	}
+	if v.Diff != nil {
+		res.Diff = make([]map[string]any, len(v.Diff))
+		for i, val := range v.Diff {
+			res.Diff[i] = make(map[string]any, len(val))
+			for key, val := range val {
+				tk := key
+				tv := val
+				res.Diff[i][tk] = tv
+			}
+		}
+	}
+
+	return res
+}
+
 // marshalControlplaneDatabaseSpecToDatabaseSpecRequestBodyRequestBody builds a
 // value of type *DatabaseSpecRequestBodyRequestBody from a value of type
 // *controlplane.DatabaseSpec.
@@ -6186,6 +9510,8 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecRequestBodyRequestBody(v *cont
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	if v.Nodes != nil {
 		res.Nodes = make([]*DatabaseNodeSpecRequestBodyRequestBody, len(v.Nodes))
@@ -6251,6 +9577,12 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecRequestBodyRequestBody(v *cont
 	if v.Scripts != nil {
 		res.Scripts = marshalControlplaneDatabaseScriptsToDatabaseScriptsRequestBodyRequestBody(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsRequestBodyRequestBody(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBodyRequestBody(v.QueryStats)
+	}
 
 	return res
 }
@@ -6305,6 +9637,9 @@ func marshalControlplaneDatabaseNodeSpecToDatabaseNodeSpecRequestBodyRequestBody
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = marshalControlplaneOrchestratorOptsToOrchestratorOptsRequestBodyRequestBody(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsRequestBodyRequestBody(v.Patroni)
+	}
 
 	return res
 }
@@ -6560,6 +9895,44 @@ func marshalControlplaneExtraNetworkSpecToExtraNetworkSpecRequestBodyRequestBody
 	return res
 }
 
+// marshalControlplanePatroniSettingsToPatroniSettingsRequestBodyRequestBody
+// builds a value of type *PatroniSettingsRequestBodyRequestBody from a value
+// of type *controlplane.PatroniSettings.
+func marshalControlplanePatroniSettingsToPatroniSettingsRequestBodyRequestBody(v *controlplane.PatroniSettings) *PatroniSettingsRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniSettingsRequestBodyRequestBody{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBodyRequestBody(v.Watchdog)
+	}
+
+	return res
+}
+
+// marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBodyRequestBody
+// builds a value of type *PatroniWatchdogRequestBodyRequestBody from a value
+// of type *controlplane.PatroniWatchdog.
+func marshalControlplanePatroniWatchdogToPatroniWatchdogRequestBodyRequestBody(v *controlplane.PatroniWatchdog) *PatroniWatchdogRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniWatchdogRequestBodyRequestBody{
+		Mode:         v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // marshalControlplaneDatabaseUserSpecToDatabaseUserSpecRequestBodyRequestBody
 // builds a value of type *DatabaseUserSpecRequestBodyRequestBody from a value
 // of type *controlplane.DatabaseUserSpec.
@@ -6674,6 +10047,21 @@ func marshalControlplaneDatabaseScriptsToDatabaseScriptsRequestBodyRequestBody(v
 	return res
 }
 
+// marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBodyRequestBody
+// builds a value of type *QueryStatsSettingsRequestBodyRequestBody from a
+// value of type *controlplane.QueryStatsSettings.
+func marshalControlplaneQueryStatsSettingsToQueryStatsSettingsRequestBodyRequestBody(v *controlplane.QueryStatsSettings) *QueryStatsSettingsRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &QueryStatsSettingsRequestBodyRequestBody{
+		Enabled: v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
 // marshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec builds a
 // value of type *controlplane.DatabaseSpec from a value of type
 // *DatabaseSpecRequestBodyRequestBody.
@@ -6686,6 +10074,8 @@ func marshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec(v *Data
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	if v.Nodes != nil {
 		res.Nodes = make([]*controlplane.DatabaseNodeSpec, len(v.Nodes))
@@ -6751,6 +10141,12 @@ func marshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec(v *Data
 	if v.Scripts != nil {
 		res.Scripts = marshalDatabaseScriptsRequestBodyRequestBodyToControlplaneDatabaseScripts(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = marshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings(v.QueryStats)
+	}
 
 	return res
 }
@@ -6805,6 +10201,9 @@ func marshalDatabaseNodeSpecRequestBodyRequestBodyToControlplaneDatabaseNodeSpec
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = marshalOrchestratorOptsRequestBodyRequestBodyToControlplaneOrchestratorOpts(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
 
 	return res
 }
@@ -7060,6 +10459,44 @@ func marshalExtraNetworkSpecRequestBodyRequestBodyToControlplaneExtraNetworkSpec
 	return res
 }
 
+// marshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings
+// builds a value of type *controlplane.PatroniSettings from a value of type
+// *PatroniSettingsRequestBodyRequestBody.
+func marshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v *PatroniSettingsRequestBodyRequestBody) *controlplane.PatroniSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniSettings{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = marshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog(v.Watchdog)
+	}
+
+	return res
+}
+
+// marshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog
+// builds a value of type *controlplane.PatroniWatchdog from a value of type
+// *PatroniWatchdogRequestBodyRequestBody.
+func marshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog(v *PatroniWatchdogRequestBodyRequestBody) *controlplane.PatroniWatchdog {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniWatchdog{
+		Mode:         v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // marshalDatabaseUserSpecRequestBodyRequestBodyToControlplaneDatabaseUserSpec
 // builds a value of type *controlplane.DatabaseUserSpec from a value of type
 // *DatabaseUserSpecRequestBodyRequestBody.
@@ -7174,6 +10611,152 @@ func marshalDatabaseScriptsRequestBodyRequestBodyToControlplaneDatabaseScripts(v
 	return res
 }
 
+// marshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings
+// builds a value of type *controlplane.QueryStatsSettings from a value of type
+// *QueryStatsSettingsRequestBodyRequestBody.
+func marshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings(v *QueryStatsSettingsRequestBodyRequestBody) *controlplane.QueryStatsSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.QueryStatsSettings{
+		Enabled: v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// unmarshalPostgreSQLConfChangeResponseBodyToControlplanePostgreSQLConfChange
+// builds a value of type *controlplane.PostgreSQLConfChange from a value of
+// type *PostgreSQLConfChangeResponseBody.
+func unmarshalPostgreSQLConfChangeResponseBodyToControlplanePostgreSQLConfChange(v *PostgreSQLConfChangeResponseBody) *controlplane.PostgreSQLConfChange {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PostgreSQLConfChange{
+		InstanceID:      *v.InstanceID,
+		Name:            *v.Name,
+		OldValue:        v.OldValue,
+		NewValue:        v.NewValue,
+		RequiresRestart: *v.RequiresRestart,
+	}
+
+	return res
+}
+
+// marshalControlplaneMajorUpgradeNodeSpecToMajorUpgradeNodeSpecRequestBodyRequestBody
+// builds a value of type *MajorUpgradeNodeSpecRequestBodyRequestBody from a
+// value of type *controlplane.MajorUpgradeNodeSpec.
+func marshalControlplaneMajorUpgradeNodeSpecToMajorUpgradeNodeSpecRequestBodyRequestBody(v *controlplane.MajorUpgradeNodeSpec) *MajorUpgradeNodeSpecRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &MajorUpgradeNodeSpecRequestBodyRequestBody{
+		Name: v.Name,
+	}
+	if v.HostIds != nil {
+		res.HostIds = make([]string, len(v.HostIds))
+		for i, val := range v.HostIds {
+			res.HostIds[i] = string(val)
+		}
+	} else {
+		res.HostIds = []string{}
+	}
+
+	return res
+}
+
+// marshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec
+// builds a value of type *controlplane.MajorUpgradeNodeSpec from a value of
+// type *MajorUpgradeNodeSpecRequestBodyRequestBody.
+func marshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec(v *MajorUpgradeNodeSpecRequestBodyRequestBody) *controlplane.MajorUpgradeNodeSpec {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.MajorUpgradeNodeSpec{
+		Name: v.Name,
+	}
+	if v.HostIds != nil {
+		res.HostIds = make([]controlplane.Identifier, len(v.HostIds))
+		for i, val := range v.HostIds {
+			res.HostIds[i] = controlplane.Identifier(val)
+		}
+	} else {
+		res.HostIds = []controlplane.Identifier{}
+	}
+
+	return res
+}
+
+// marshalControlplaneMaintenanceWindowToMaintenanceWindowRequestBodyRequestBody
+// builds a value of type *MaintenanceWindowRequestBodyRequestBody from a value
+// of type *controlplane.MaintenanceWindow.
+func marshalControlplaneMaintenanceWindowToMaintenanceWindowRequestBodyRequestBody(v *controlplane.MaintenanceWindow) *MaintenanceWindowRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &MaintenanceWindowRequestBodyRequestBody{
+		Start: v.Start,
+		End:   v.End,
+	}
+
+	return res
+}
+
+// marshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow
+// builds a value of type *controlplane.MaintenanceWindow from a value of type
+// *MaintenanceWindowRequestBodyRequestBody.
+func marshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow(v *MaintenanceWindowRequestBodyRequestBody) *controlplane.MaintenanceWindow {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.MaintenanceWindow{
+		Start: v.Start,
+		End:   v.End,
+	}
+
+	return res
+}
+
+// unmarshalInstanceQueryStatsResponseBodyToControlplaneInstanceQueryStats
+// builds a value of type *controlplane.InstanceQueryStats from a value of type
+// *InstanceQueryStatsResponseBody.
+func unmarshalInstanceQueryStatsResponseBodyToControlplaneInstanceQueryStats(v *InstanceQueryStatsResponseBody) *controlplane.InstanceQueryStats {
+	res := &controlplane.InstanceQueryStats{
+		InstanceID:  *v.InstanceID,
+		CollectedAt: v.CollectedAt,
+		WindowStart: v.WindowStart,
+	}
+	res.Queries = make([]*controlplane.QueryStat, len(v.Queries))
+	for i, val := range v.Queries {
+		if val == nil {
+			res.Queries[i] = nil
+			continue
+		}
+		res.Queries[i] = unmarshalQueryStatResponseBodyToControlplaneQueryStat(val)
+	}
+
+	return res
+}
+
+// unmarshalQueryStatResponseBodyToControlplaneQueryStat builds a value of type
+// *controlplane.QueryStat from a value of type *QueryStatResponseBody.
+func unmarshalQueryStatResponseBodyToControlplaneQueryStat(v *QueryStatResponseBody) *controlplane.QueryStat {
+	res := &controlplane.QueryStat{
+		QueryID:           *v.QueryID,
+		Query:             *v.Query,
+		Calls:             *v.Calls,
+		TotalTimeMs:       *v.TotalTimeMs,
+		MeanTimeMs:        *v.MeanTimeMs,
+		Rows:              *v.Rows,
+		WindowCalls:       v.WindowCalls,
+		WindowTotalTimeMs: v.WindowTotalTimeMs,
+		WindowRows:        v.WindowRows,
+	}
+
+	return res
+}
+
 // unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry builds a value
 // of type *controlplane.TaskLogEntry from a value of type
 // *TaskLogEntryResponseBody.
@@ -7193,3 +10776,118 @@ func unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(v *TaskLogEntry
 
 	return res
 }
+
+// unmarshalWebhookResponseBodyToControlplaneWebhook builds a value of type
+// *controlplane.Webhook from a value of type *WebhookResponseBody.
+func unmarshalWebhookResponseBodyToControlplaneWebhook(v *WebhookResponseBody) *controlplane.Webhook {
+	res := &controlplane.Webhook{
+		ID:          *v.ID,
+		URL:         *v.URL,
+		Description: v.Description,
+		Secret:      v.Secret,
+		CreatedAt:   *v.CreatedAt,
+	}
+	if v.EventTypes != nil {
+		res.EventTypes = make([]controlplane.WebhookEventType, len(v.EventTypes))
+		for i, val := range v.EventTypes {
+			res.EventTypes[i] = controlplane.WebhookEventType(val)
+		}
+	}
+	if v.DatabaseIds != nil {
+		res.DatabaseIds = make([]controlplane.Identifier, len(v.DatabaseIds))
+		for i, val := range v.DatabaseIds {
+			res.DatabaseIds[i] = controlplane.Identifier(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseBodyToControlplaneWebhookDelivery builds a
+// value of type *controlplane.WebhookDelivery from a value of type
+// *WebhookDeliveryResponseBody.
+func unmarshalWebhookDeliveryResponseBodyToControlplaneWebhookDelivery(v *WebhookDeliveryResponseBody) *controlplane.WebhookDelivery {
+	res := &controlplane.WebhookDelivery{
+		DeliveryID:     *v.DeliveryID,
+		WebhookID:      *v.WebhookID,
+		EventType:      controlplane.WebhookEventType(*v.EventType),
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		LastStatusCode: v.LastStatusCode,
+		LastError:      v.LastError,
+		NextAttemptAt:  v.NextAttemptAt,
+		CreatedAt:      *v.CreatedAt,
+		UpdatedAt:      *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalInstanceSessionResponseBodyToControlplaneInstanceSession builds a
+// value of type *controlplane.InstanceSession from a value of type
+// *InstanceSessionResponseBody.
+func unmarshalInstanceSessionResponseBodyToControlplaneInstanceSession(v *InstanceSessionResponseBody) *controlplane.InstanceSession {
+	res := &controlplane.InstanceSession{
+		Pid:             *v.Pid,
+		Username:        v.Username,
+		DatabaseName:    v.DatabaseName,
+		ApplicationName: *v.ApplicationName,
+		ClientAddr:      v.ClientAddr,
+		State:           v.State,
+		WaitEventType:   v.WaitEventType,
+		WaitEvent:       v.WaitEvent,
+		BackendStart:    v.BackendStart,
+		XactStart:       v.XactStart,
+		QueryStart:      v.QueryStart,
+		StateChange:     v.StateChange,
+		Query:           *v.Query,
+	}
+	if v.BlockedBy != nil {
+		res.BlockedBy = make([]int, len(v.BlockedBy))
+		for i, val := range v.BlockedBy {
+			res.BlockedBy[i] = val
+		}
+	}
+	if v.Locks != nil {
+		res.Locks = make([]*controlplane.SessionLock, len(v.Locks))
+		for i, val := range v.Locks {
+			if val == nil {
+				res.Locks[i] = nil
+				continue
+			}
+			res.Locks[i] = unmarshalSessionLockResponseBodyToControlplaneSessionLock(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalSessionLockResponseBodyToControlplaneSessionLock builds a value of
+// type *controlplane.SessionLock from a value of type *SessionLockResponseBody.
+func unmarshalSessionLockResponseBodyToControlplaneSessionLock(v *SessionLockResponseBody) *controlplane.SessionLock {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.SessionLock{
+		LockType: *v.LockType,
+		Relation: v.Relation,
+		Mode:     *v.Mode,
+		Granted:  *v.Granted,
+	}
+
+	return res
+}
+
+// unmarshalPostgreSQLSettingResponseBodyToControlplanePostgreSQLSetting builds
+// a value of type *controlplane.PostgreSQLSetting from a value of type
+// *PostgreSQLSettingResponseBody.
+func unmarshalPostgreSQLSettingResponseBodyToControlplanePostgreSQLSetting(v *PostgreSQLSettingResponseBody) *controlplane.PostgreSQLSetting {
+	res := &controlplane.PostgreSQLSetting{
+		Name:           *v.Name,
+		Value:          *v.Value,
+		Source:         *v.Source,
+		PendingRestart: v.PendingRestart,
+	}
+
+	return res
+}
@@ -36,6 +36,20 @@ type GetJoinOptionsRequestBody struct {
 	EmbeddedEtcdEnabled bool `json:"embedded_etcd_enabled"`
 }
 
+// DefragmentEtcdRequestBody is the type of the "control-plane" service
+// "defragment-etcd" endpoint HTTP request body.
+type DefragmentEtcdRequestBody struct {
+	// The hosts to defragment. Defaults to every Etcd server in the cluster.
+	HostIds []string `json:"host_ids,omitempty"`
+}
+
+// ChangeHostEtcdModeRequestBody is the type of the "control-plane" service
+// "change-host-etcd-mode" endpoint HTTP request body.
+type ChangeHostEtcdModeRequestBody struct {
+	// The new Etcd mode for the host.
+	EtcdMode string `json:"etcd_mode"`
+}
+
 // CreateDatabaseRequestBody is the type of the "control-plane" service
 // "create-database" endpoint HTTP request body.
 type CreateDatabaseRequestBody struct {
@@ -45,6 +59,9 @@ type CreateDatabaseRequestBody struct {
 	TenantID *string `json:"tenant_id,omitempty"`
 	// The specification for the database.
 	Spec *DatabaseSpecRequestBody `json:"spec"`
+	// An external Postgres database to import into the new database. The database
+	// must have exactly one node during the import.
+	Source *ImportSourceSpecRequestBody `json:"source,omitempty"`
 }
 
 // UpdateDatabaseRequestBody is the type of the "control-plane" service
@@ -59,10 +76,31 @@ type UpdateDatabaseRequestBody struct {
 // ApplyUpgradeRequestBody is the type of the "control-plane" service
 // "apply-upgrade" endpoint HTTP request body.
 type ApplyUpgradeRequestBody struct {
+	// The kind of upgrade to apply. 'minor' redeploys the database with a newer
+	// image in the same major version. 'pg_upgrade' upgrades each node to a new
+	// Postgres major version in place with pg_upgrade --link.
+	Mode string `json:"mode,omitempty"`
 	// Full container image reference of the upgrade target. Must match the image
 	// field of a stable manifest entry in the same Postgres major / Spock major
-	// bucket as the current version and be strictly newer.
-	Image string `json:"image"`
+	// bucket as the current version and be strictly newer. Required for the
+	// 'minor' mode.
+	Image *string `json:"image,omitempty"`
+	// The target Postgres version. Required for the 'pg_upgrade' mode.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+}
+
+// UpgradeDatabaseMajorRequestBody is the type of the "control-plane" service
+// "upgrade-database-major" endpoint HTTP request body.
+type UpgradeDatabaseMajorRequestBody struct {
+	// The Postgres version to upgrade to. Nodes that already run this major
+	// version are skipped, and no node may run a newer major version.
+	PostgresVersion string `json:"postgres_version"`
+	// Places the replacements for these nodes on different hosts. Nodes that are
+	// not listed are replaced on their current hosts.
+	Nodes []*MajorUpgradeNodeSpecRequestBodyRequestBody `json:"nodes,omitempty"`
+	// Pause the upgrade after each node is replaced. Paused upgrades are continued
+	// with the resume-database-task endpoint.
+	PauseBetweenNodes *bool `json:"pause_between_nodes,omitempty"`
 }
 
 // BackupDatabaseNodeRequestBody is the type of the "control-plane" service
@@ -86,6 +124,22 @@ type SwitchoverDatabaseNodeRequestBody struct {
 	ScheduledAt *string `form:"scheduled_at,omitempty" json:"scheduled_at,omitempty" xml:"scheduled_at,omitempty"`
 }
 
+// RollingRestartDatabaseRequestBody is the type of the "control-plane" service
+// "rolling-restart-database" endpoint HTTP request body.
+type RollingRestartDatabaseRequestBody struct {
+	// Restart every instance. By default, only instances with settings that are
+	// waiting for a restart are restarted.
+	AllInstances bool `json:"all_instances,omitempty"`
+	// The number of nodes to restart at the same time. Instances within a node are
+	// always restarted one at a time.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Switch each node back to its original primary after the original primary is
+	// restarted.
+	SwitchBack bool `json:"switch_back,omitempty"`
+	// When set, each restart and switchover waits until the window is open.
+	MaintenanceWindow *MaintenanceWindowRequestBodyRequestBody `json:"maintenance_window,omitempty"`
+}
+
 // FailoverDatabaseNodeRequestBody is the type of the "control-plane" service
 // "failover-database-node" endpoint HTTP request body.
 type FailoverDatabaseNodeRequestBody struct {
@@ -97,6 +151,23 @@ type FailoverDatabaseNodeRequestBody struct {
 	SkipValidation bool `json:"skip_validation,omitempty"`
 }
 
+// CreateWebhookRequestBody is the type of the "control-plane" service
+// "create-webhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The URL to send events to. Must use the http or https scheme.
+	URL string `json:"url"`
+	// An optional description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. A random secret is generated if this
+	// is omitted. It's only returned in the create-webhook response.
+	Secret *string `json:"secret,omitempty"`
+	// Only deliver events of these types. All event types are delivered by default.
+	EventTypes []string `json:"event_types,omitempty"`
+	// Only deliver events for these databases. Events that aren't specific to a
+	// database, such as host_unreachable, are not delivered when this is set.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+}
+
 // RestoreDatabaseRequestBody is the type of the "control-plane" service
 // "restore-database" endpoint HTTP request body.
 type RestoreDatabaseRequestBody struct {
@@ -106,6 +177,45 @@ type RestoreDatabaseRequestBody struct {
 	TargetNodes []string `json:"target_nodes,omitempty"`
 }
 
+// CloneDatabaseRequestBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP request body.
+type CloneDatabaseRequestBody struct {
+	// ID of the new database.
+	ID string `json:"id"`
+	// Unique identifier for the new database's owner. The clone always has the
+	// same owner as the source database, so this must match the source database's
+	// tenant ID when it's given. It's required for requests that are made by a
+	// tenant.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The node of the source database to clone. Defaults to the source database's
+	// first node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// How the clone is populated. The 'backup' method restores the source node's
+	// latest backup and replays its archived WAL. The 'base_backup' method first
+	// takes a fresh full backup from one of the source node's replicas, or from
+	// its primary if it has no replicas, and then restores that backup. Both
+	// methods require a backup repository on the source node.
+	Method string `json:"method,omitempty"`
+	// Recover the clone to this point in time rather than to the end of the
+	// archived WAL. Only valid with the 'backup' method.
+	TargetTime *string `json:"target_time,omitempty"`
+	// The IDs of the hosts that should run the clone. Defaults to the source
+	// node's hosts.
+	HostIds []string `json:"host_ids,omitempty"`
+	// A new name for the cloned Postgres database. Defaults to the source
+	// database's name.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// Renames database users in the clone. Each key is the name of a user in the
+	// source database's spec, and each value is the user's new name. Roles are
+	// renamed before they're updated from the spec, so renamed users keep the
+	// objects and privileges they had in the source.
+	RenameUsers map[string]string `json:"rename_users,omitempty"`
+	// SQL statements that run once in the cloned database, within a transaction,
+	// after it's restored and before the clone becomes available. This can be used
+	// to remove or mask sensitive data.
+	ScrubScript []string `json:"scrub_script,omitempty"`
+}
+
 // InitClusterResponseBody is the type of the "control-plane" service
 // "init-cluster" endpoint HTTP response body.
 type InitClusterResponseBody struct {
@@ -142,6 +252,51 @@ type GetClusterResponseBody struct {
 	Status *ClusterStatusResponseBody `json:"status"`
 	// All of the hosts in the cluster.
 	Hosts []*HostResponseBody `json:"hosts"`
+	// The progress of the current Control Plane upgrade, if any.
+	Upgrade *ClusterUpgradeStatusResponseBody `json:"upgrade,omitempty"`
+}
+
+// GetStorageUsageResponseBody is the type of the "control-plane" service
+// "get-storage-usage" endpoint HTTP response body.
+type GetStorageUsageResponseBody struct {
+	// The size of the Etcd database on disk, including space that Etcd can reuse.
+	DbSizeBytes *int64 `json:"db_size_bytes"`
+	// The portion of the Etcd database that's in use.
+	DbSizeInUseBytes *int64 `json:"db_size_in_use_bytes"`
+	// The total number of Control Plane keys.
+	Keys *int64 `json:"keys"`
+	// The total size of the Control Plane's keys and values.
+	Bytes *int64 `json:"bytes"`
+	// Usage for each key prefix, largest first.
+	Prefixes []*StoragePrefixUsageResponseBody `json:"prefixes"`
+}
+
+// GetEtcdClusterResponseBody is the type of the "control-plane" service
+// "get-etcd-cluster" endpoint HTTP response body.
+type GetEtcdClusterResponseBody struct {
+	// The members of the Etcd cluster, sorted by host ID.
+	Members []*EtcdMemberResponseBody `json:"members"`
+	// The host ID of the raft leader. Empty if the cluster has no leader.
+	Leader *string `json:"leader,omitempty"`
+	// The number of voting members.
+	Voters *int `json:"voters"`
+	// The number of learners.
+	Learners *int `json:"learners"`
+	// The number of voters that must be available for the cluster to accept writes.
+	Quorum *int `json:"quorum"`
+	// The number of voters that can fail before the cluster loses quorum.
+	FailureTolerance *int `json:"failure_tolerance"`
+	// Active Etcd alarms, such as NOSPACE.
+	Alarms []string `json:"alarms,omitempty"`
+	// Problems with the cluster's quorum or members.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DefragmentEtcdResponseBody is the type of the "control-plane" service
+// "defragment-etcd" endpoint HTTP response body.
+type DefragmentEtcdResponseBody struct {
+	// The result for each host, in the order that they were defragmented.
+	Results []*EtcdDefragmentResultResponseBody `json:"results"`
 }
 
 // ListHostsResponseBody is the type of the "control-plane" service
@@ -149,6 +304,8 @@ type GetClusterResponseBody struct {
 type ListHostsResponseBody struct {
 	// List of hosts in the cluster
 	Hosts []*HostResponseBody `json:"hosts"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // GetHostResponseBody is the type of the "control-plane" service "get-host"
@@ -189,11 +346,22 @@ type RemoveHostResponseBody struct {
 	UpdateDatabaseTasks []*TaskResponseBody `json:"update_database_tasks"`
 }
 
+// ChangeHostEtcdModeResponseBody is the type of the "control-plane" service
+// "change-host-etcd-mode" endpoint HTTP response body.
+type ChangeHostEtcdModeResponseBody struct {
+	// The task that tracks the change.
+	Task *TaskResponseBody `json:"task"`
+	// Warnings about the Etcd cluster's failure tolerance after the change.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 // ListDatabasesResponseBody is the type of the "control-plane" service
 // "list-databases" endpoint HTTP response body.
 type ListDatabasesResponseBody struct {
 	// The databases managed by this cluster.
 	Databases []*DatabaseSummaryResponseBody `json:"databases"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // CreateDatabaseResponseBody is the type of the "control-plane" service
@@ -218,6 +386,10 @@ type GetDatabaseResponseBody struct {
 	UpdatedAt *string `json:"updated_at"`
 	// Current state of the database.
 	State *string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
 	// All of the instances in the database.
 	Instances []*InstanceResponseBody `json:"instances,omitempty"`
 	// Service instances running alongside this database.
@@ -227,6 +399,9 @@ type GetDatabaseResponseBody struct {
 	// Newer stable image versions available in the same Postgres major / Spock
 	// major bucket. Present only when ?include=available_upgrades is set.
 	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+	// The result of the most recent drift check. Absent if the database has not
+	// been checked.
+	Drift *DatabaseDriftResponseBody `json:"drift,omitempty"`
 }
 
 // UpdateDatabaseResponseBody is the type of the "control-plane" service
@@ -236,6 +411,9 @@ type UpdateDatabaseResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 	// The database being updated.
 	Database *DatabaseResponseBody `json:"database"`
+	// The postgresql.conf settings that will change on existing instances, such as
+	// tunable settings that are affected by memory or CPU changes.
+	PostgresqlConfChanges []*PostgreSQLConfChangeResponseBody `json:"postgresql_conf_changes,omitempty"`
 }
 
 // ApplyUpgradeResponseBody is the type of the "control-plane" service
@@ -247,6 +425,24 @@ type ApplyUpgradeResponseBody struct {
 	Database *DatabaseResponseBody `json:"database"`
 }
 
+// UpgradeDatabaseMajorResponseBody is the type of the "control-plane" service
+// "upgrade-database-major" endpoint HTTP response body.
+type UpgradeDatabaseMajorResponseBody struct {
+	// The task tracking the upgrade operation.
+	Task *TaskResponseBody `json:"task"`
+	// The database being upgraded.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
+// CutoverDatabaseImportResponseBody is the type of the "control-plane" service
+// "cutover-database-import" endpoint HTTP response body.
+type CutoverDatabaseImportResponseBody struct {
+	// The task that will cut over the import.
+	Task *TaskResponseBody `json:"task"`
+	// The database being cut over.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
 // DeleteDatabaseResponseBody is the type of the "control-plane" service
 // "delete-database" endpoint HTTP response body.
 type DeleteDatabaseResponseBody struct {
@@ -268,6 +464,13 @@ type SwitchoverDatabaseNodeResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// RollingRestartDatabaseResponseBody is the type of the "control-plane"
+// service "rolling-restart-database" endpoint HTTP response body.
+type RollingRestartDatabaseResponseBody struct {
+	// The task that will perform the rolling restart.
+	Task *TaskResponseBody `json:"task"`
+}
+
 // FailoverDatabaseNodeResponseBody is the type of the "control-plane" service
 // "failover-database-node" endpoint HTTP response body.
 type FailoverDatabaseNodeResponseBody struct {
@@ -275,6 +478,20 @@ type FailoverDatabaseNodeResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// GetNodeQueryStatsResponseBody is the type of the "control-plane" service
+// "get-node-query-stats" endpoint HTTP response body.
+type GetNodeQueryStatsResponseBody struct {
+	// The query statistics for each instance, sorted by instance ID.
+	Instances []*InstanceQueryStatsResponseBody `json:"instances"`
+}
+
+// ResetNodeQueryStatsResponseBody is the type of the "control-plane" service
+// "reset-node-query-stats" endpoint HTTP response body.
+type ResetNodeQueryStatsResponseBody struct {
+	// The IDs of the instances whose statistics were reset.
+	InstanceIds []string `json:"instance_ids"`
+}
+
 // ListDatabaseTasksResponseBody is the type of the "control-plane" service
 // "list-database-tasks" endpoint HTTP response body.
 type ListDatabaseTasksResponseBody struct {
@@ -309,6 +526,9 @@ type GetDatabaseTaskResponseBody struct {
 	Type *string `json:"type"`
 	// The status of the task.
 	Status *string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -366,6 +586,9 @@ type GetHostTaskResponseBody struct {
 	Type *string `json:"type"`
 	// The status of the task.
 	Status *string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -396,6 +619,93 @@ type ListTasksResponseBody struct {
 	Tasks []*TaskResponseBody `json:"tasks"`
 }
 
+// StreamEventsResponseBody is the type of the "control-plane" service
+// "stream-events" endpoint HTTP response body.
+type StreamEventsResponseBody struct {
+	// The etcd revision of the change that produced this event. Events from the
+	// same change share an ID.
+	ID *string `json:"id"`
+	// The kind of event. Determines which of the database, instance, task, or
+	// task_log_entry fields is set. Heartbeat events are sent when the stream
+	// opens and periodically afterward.
+	Kind *string `json:"kind"`
+	// The time that the server observed the change.
+	Timestamp *string `json:"timestamp"`
+	// The database that this event relates to, if any.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The host that this event relates to, if known.
+	HostID *string `json:"host_id,omitempty"`
+	// The task that this event relates to. Only set for task and task_log events.
+	TaskID *string `json:"task_id,omitempty"`
+	// The database after a state transition. Only set for database events.
+	// Instances are omitted.
+	Database *DatabaseSummaryResponseBody `json:"database,omitempty"`
+	// The instance after a state or status change. Only set for instance events.
+	Instance *InstanceResponseBody `json:"instance,omitempty"`
+	// The task after a status change. Only set for task events.
+	Task *TaskResponseBody `json:"task,omitempty"`
+	// A new task log entry. Only set for task_log events.
+	TaskLogEntry *TaskLogEntryResponseBody `json:"task_log_entry,omitempty"`
+}
+
+// CreateWebhookResponseBody is the type of the "control-plane" service
+// "create-webhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID *string `json:"id"`
+	// The URL that events are sent to.
+	URL *string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt *string `json:"created_at"`
+}
+
+// ListWebhooksResponseBody is the type of the "control-plane" service
+// "list-webhooks" endpoint HTTP response body.
+type ListWebhooksResponseBody struct {
+	// The webhooks in the cluster, oldest first.
+	Webhooks []*WebhookResponseBody `json:"webhooks"`
+}
+
+// GetWebhookResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body.
+type GetWebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID *string `json:"id"`
+	// The URL that events are sent to.
+	URL *string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt *string `json:"created_at"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "control-plane" service
+// "list-webhook-deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody struct {
+	// The webhook's most recent deliveries, newest first.
+	Deliveries []*WebhookDeliveryResponseBody `json:"deliveries"`
+}
+
 // RestoreDatabaseResponseBody is the type of the "control-plane" service
 // "restore-database" endpoint HTTP response body.
 type RestoreDatabaseResponseBody struct {
@@ -407,6 +717,18 @@ type RestoreDatabaseResponseBody struct {
 	Database *DatabaseResponseBody `json:"database"`
 }
 
+// CloneDatabaseResponseBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP response body.
+type CloneDatabaseResponseBody struct {
+	// The task that will create the clone.
+	Task *TaskResponseBody `json:"task"`
+	// The source database task that will take a fresh backup for the clone. Only
+	// set for the 'base_backup' method.
+	BackupTask *TaskResponseBody `json:"backup_task,omitempty"`
+	// The database being created.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
 // GetVersionResponseBody is the type of the "control-plane" service
 // "get-version" endpoint HTTP response body.
 type GetVersionResponseBody struct {
@@ -427,6 +749,39 @@ type RestartInstanceResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// GetInstanceSessionsResponseBody is the type of the "control-plane" service
+// "get-instance-sessions" endpoint HTTP response body.
+type GetInstanceSessionsResponseBody struct {
+	// The client sessions, sorted by process ID.
+	Sessions []*InstanceSessionResponseBody `json:"sessions"`
+	// Each chain of blocked sessions, as process IDs ordered from the session at
+	// the root of the chain to a blocked session that isn't blocking any others.
+	BlockingChains [][]int `json:"blocking_chains"`
+}
+
+// CancelInstanceSessionResponseBody is the type of the "control-plane" service
+// "cancel-instance-session" endpoint HTTP response body.
+type CancelInstanceSessionResponseBody struct {
+	// Task representing the operation. The session's details are recorded in the
+	// task's log.
+	Task *TaskResponseBody `json:"task"`
+}
+
+// TerminateInstanceSessionResponseBody is the type of the "control-plane"
+// service "terminate-instance-session" endpoint HTTP response body.
+type TerminateInstanceSessionResponseBody struct {
+	// Task representing the operation. The session's details are recorded in the
+	// task's log.
+	Task *TaskResponseBody `json:"task"`
+}
+
+// GetInstancePostgresqlConfResponseBody is the type of the "control-plane"
+// service "get-instance-postgresql-conf" endpoint HTTP response body.
+type GetInstancePostgresqlConfResponseBody struct {
+	// The instance's effective settings, sorted by name.
+	Settings []*PostgreSQLSettingResponseBody `json:"settings"`
+}
+
 // StopInstanceResponseBody is the type of the "control-plane" service
 // "stop-instance" endpoint HTTP response body.
 type StopInstanceResponseBody struct {
@@ -468,6 +823,43 @@ type CancelDatabaseTaskResponseBody struct {
 	Type *string `json:"type"`
 	// The status of the task.
 	Status *string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
+	// The error message if the task failed.
+	Error *string `json:"error,omitempty"`
+}
+
+// ResumeDatabaseTaskResponseBody is the type of the "control-plane" service
+// "resume-database-task" endpoint HTTP response body.
+type ResumeDatabaseTaskResponseBody struct {
+	// The parent task ID of the task.
+	ParentID *string `json:"parent_id,omitempty"`
+	// The scope of the task (database or host).
+	Scope *string `json:"scope"`
+	// The entity ID (database_id or host_id) that this task belongs to.
+	EntityID *string `json:"entity_id"`
+	// The database ID of the task.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The name of the node that the task is operating on.
+	NodeName *string `json:"node_name,omitempty"`
+	// The ID of the instance that the task is operating on.
+	InstanceID *string `json:"instance_id,omitempty"`
+	// The ID of the host that the task is running on.
+	HostID *string `json:"host_id,omitempty"`
+	// The unique ID of the task.
+	TaskID *string `json:"task_id"`
+	// The time when the task was created.
+	CreatedAt *string `json:"created_at"`
+	// The time when the task was completed.
+	CompletedAt *string `json:"completed_at,omitempty"`
+	// The type of the task.
+	Type *string `json:"type"`
+	// The status of the task.
+	Status *string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -621,6 +1013,76 @@ type GetClusterServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// GetStorageUsageClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-storage-usage" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type GetStorageUsageClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetStorageUsageServerErrorResponseBody is the type of the "control-plane"
+// service "get-storage-usage" endpoint HTTP response body for the
+// "server_error" error.
+type GetStorageUsageServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetEtcdClusterClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-etcd-cluster" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type GetEtcdClusterClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetEtcdClusterServerErrorResponseBody is the type of the "control-plane"
+// service "get-etcd-cluster" endpoint HTTP response body for the
+// "server_error" error.
+type GetEtcdClusterServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DefragmentEtcdClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "defragment-etcd" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type DefragmentEtcdClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DefragmentEtcdInvalidInputResponseBody is the type of the "control-plane"
+// service "defragment-etcd" endpoint HTTP response body for the
+// "invalid_input" error.
+type DefragmentEtcdInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DefragmentEtcdServerErrorResponseBody is the type of the "control-plane"
+// service "defragment-etcd" endpoint HTTP response body for the "server_error"
+// error.
+type DefragmentEtcdServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // ListHostsClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-hosts" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
@@ -631,6 +1093,15 @@ type ListHostsClusterNotInitializedResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// ListHostsInvalidInputResponseBody is the type of the "control-plane" service
+// "list-hosts" endpoint HTTP response body for the "invalid_input" error.
+type ListHostsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // ListHostsServerErrorResponseBody is the type of the "control-plane" service
 // "list-hosts" endpoint HTTP response body for the "server_error" error.
 type ListHostsServerErrorResponseBody struct {
@@ -715,6 +1186,46 @@ type RemoveHostServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// ChangeHostEtcdModeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "change-host-etcd-mode" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ChangeHostEtcdModeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ChangeHostEtcdModeInvalidInputResponseBody is the type of the
+// "control-plane" service "change-host-etcd-mode" endpoint HTTP response body
+// for the "invalid_input" error.
+type ChangeHostEtcdModeInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ChangeHostEtcdModeNotFoundResponseBody is the type of the "control-plane"
+// service "change-host-etcd-mode" endpoint HTTP response body for the
+// "not_found" error.
+type ChangeHostEtcdModeNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ChangeHostEtcdModeServerErrorResponseBody is the type of the "control-plane"
+// service "change-host-etcd-mode" endpoint HTTP response body for the
+// "server_error" error.
+type ChangeHostEtcdModeServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // ListDatabasesClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-databases" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
@@ -725,6 +1236,16 @@ type ListDatabasesClusterNotInitializedResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// ListDatabasesInvalidInputResponseBody is the type of the "control-plane"
+// service "list-databases" endpoint HTTP response body for the "invalid_input"
+// error.
+type ListDatabasesInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // ListDatabasesServerErrorResponseBody is the type of the "control-plane"
 // service "list-databases" endpoint HTTP response body for the "server_error"
 // error.
@@ -943,140 +1464,260 @@ type ApplyUpgradeServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type DeleteDatabaseClusterNotInitializedResponseBody struct {
+// UpgradeDatabaseMajorClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type UpgradeDatabaseMajorClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "database_not_modifiable" error.
-type DeleteDatabaseDatabaseNotModifiableResponseBody struct {
+// UpgradeDatabaseMajorDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "database_not_modifiable" error.
+type UpgradeDatabaseMajorDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "operation_already_in_progress" error.
-type DeleteDatabaseOperationAlreadyInProgressResponseBody struct {
+// UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "upgrade-database-major" endpoint HTTP response
+// body for the "operation_already_in_progress" error.
+type UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseInvalidInputResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the
-// "invalid_input" error.
-type DeleteDatabaseInvalidInputResponseBody struct {
+// UpgradeDatabaseMajorInvalidInputResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "invalid_input" error.
+type UpgradeDatabaseMajorInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseNotFoundResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the "not_found"
-// error.
-type DeleteDatabaseNotFoundResponseBody struct {
+// UpgradeDatabaseMajorNotFoundResponseBody is the type of the "control-plane"
+// service "upgrade-database-major" endpoint HTTP response body for the
+// "not_found" error.
+type UpgradeDatabaseMajorNotFoundResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// DeleteDatabaseServerErrorResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the "server_error"
-// error.
-type DeleteDatabaseServerErrorResponseBody struct {
+// UpgradeDatabaseMajorServerErrorResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "server_error" error.
+type UpgradeDatabaseMajorServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "cluster_not_initialized" error.
-type BackupDatabaseNodeClusterNotInitializedResponseBody struct {
+// CutoverDatabaseImportClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type CutoverDatabaseImportClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "database_not_modifiable" error.
-type BackupDatabaseNodeDatabaseNotModifiableResponseBody struct {
+// CutoverDatabaseImportDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type CutoverDatabaseImportDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "operation_already_in_progress" error.
-type BackupDatabaseNodeOperationAlreadyInProgressResponseBody struct {
+// CutoverDatabaseImportOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "operation_already_in_progress" error.
+type CutoverDatabaseImportOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeInvalidInputResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "invalid_input" error.
-type BackupDatabaseNodeInvalidInputResponseBody struct {
+// CutoverDatabaseImportInvalidInputResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "invalid_input" error.
+type CutoverDatabaseImportInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeNotFoundResponseBody is the type of the "control-plane"
-// service "backup-database-node" endpoint HTTP response body for the
+// CutoverDatabaseImportNotFoundResponseBody is the type of the "control-plane"
+// service "cutover-database-import" endpoint HTTP response body for the
 // "not_found" error.
-type BackupDatabaseNodeNotFoundResponseBody struct {
+type CutoverDatabaseImportNotFoundResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// BackupDatabaseNodeServerErrorResponseBody is the type of the "control-plane"
-// service "backup-database-node" endpoint HTTP response body for the
-// "server_error" error.
-type BackupDatabaseNodeServerErrorResponseBody struct {
+// CutoverDatabaseImportServerErrorResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "server_error" error.
+type CutoverDatabaseImportServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// SwitchoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "switchover-database-node" endpoint HTTP response
-// body for the "cluster_not_initialized" error.
-type SwitchoverDatabaseNodeClusterNotInitializedResponseBody struct {
+// DeleteDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type DeleteDatabaseClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "switchover-database-node" endpoint HTTP response
-// body for the "database_not_modifiable" error.
-type SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody struct {
+// DeleteDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "database_not_modifiable" error.
+type DeleteDatabaseDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DeleteDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "operation_already_in_progress" error.
+type DeleteDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DeleteDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the
+// "invalid_input" error.
+type DeleteDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DeleteDatabaseNotFoundResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the "not_found"
+// error.
+type DeleteDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DeleteDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the "server_error"
+// error.
+type DeleteDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type BackupDatabaseNodeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "database_not_modifiable" error.
+type BackupDatabaseNodeDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "operation_already_in_progress" error.
+type BackupDatabaseNodeOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeInvalidInputResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "invalid_input" error.
+type BackupDatabaseNodeInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeNotFoundResponseBody is the type of the "control-plane"
+// service "backup-database-node" endpoint HTTP response body for the
+// "not_found" error.
+type BackupDatabaseNodeNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// BackupDatabaseNodeServerErrorResponseBody is the type of the "control-plane"
+// service "backup-database-node" endpoint HTTP response body for the
+// "server_error" error.
+type BackupDatabaseNodeServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// SwitchoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "switchover-database-node" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type SwitchoverDatabaseNodeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "switchover-database-node" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
@@ -1123,6 +1764,66 @@ type SwitchoverDatabaseNodeServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// RollingRestartDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type RollingRestartDatabaseClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// RollingRestartDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type RollingRestartDatabaseDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// RollingRestartDatabaseOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "rolling-restart-database" endpoint HTTP
+// response body for the "operation_already_in_progress" error.
+type RollingRestartDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// RollingRestartDatabaseInvalidInputResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "invalid_input" error.
+type RollingRestartDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// RollingRestartDatabaseNotFoundResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "not_found" error.
+type RollingRestartDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// RollingRestartDatabaseServerErrorResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "server_error" error.
+type RollingRestartDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // FailoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "failover-database-node" endpoint HTTP response body
 // for the "cluster_not_initialized" error.
@@ -1183,6 +1884,86 @@ type FailoverDatabaseNodeServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
+// GetNodeQueryStatsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-node-query-stats" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type GetNodeQueryStatsClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetNodeQueryStatsInvalidInputResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "invalid_input" error.
+type GetNodeQueryStatsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetNodeQueryStatsNotFoundResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "not_found" error.
+type GetNodeQueryStatsNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// GetNodeQueryStatsServerErrorResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "server_error" error.
+type GetNodeQueryStatsServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ResetNodeQueryStatsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ResetNodeQueryStatsClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ResetNodeQueryStatsInvalidInputResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "invalid_input" error.
+type ResetNodeQueryStatsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ResetNodeQueryStatsNotFoundResponseBody is the type of the "control-plane"
+// service "reset-node-query-stats" endpoint HTTP response body for the
+// "not_found" error.
+type ResetNodeQueryStatsNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// ResetNodeQueryStatsServerErrorResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "server_error" error.
+type ResetNodeQueryStatsServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
 // ListDatabaseTasksClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-database-tasks" endpoint HTTP response body
 // for the "cluster_not_initialized" error.
@@ -1449,1275 +2230,1064 @@ type ListTasksServerErrorResponseBody struct {
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type RestoreDatabaseClusterNotInitializedResponseBody struct {
+// StreamEventsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "stream-events" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StreamEventsClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "database_not_modifiable" error.
-type RestoreDatabaseDatabaseNotModifiableResponseBody struct {
+// StreamEventsInvalidInputResponseBody is the type of the "control-plane"
+// service "stream-events" endpoint HTTP response body for the "invalid_input"
+// error.
+type StreamEventsInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "operation_already_in_progress" error.
-type RestoreDatabaseOperationAlreadyInProgressResponseBody struct {
+// StreamEventsServerErrorResponseBody is the type of the "control-plane"
+// service "stream-events" endpoint HTTP response body for the "server_error"
+// error.
+type StreamEventsServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseInvalidInputResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the
-// "invalid_input" error.
-type RestoreDatabaseInvalidInputResponseBody struct {
+// CreateWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "create-webhook" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type CreateWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseNotFoundResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the "not_found"
+// CreateWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "create-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type RestoreDatabaseNotFoundResponseBody struct {
+type CreateWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestoreDatabaseServerErrorResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the
-// "server_error" error.
-type RestoreDatabaseServerErrorResponseBody struct {
+// CreateWebhookServerErrorResponseBody is the type of the "control-plane"
+// service "create-webhook" endpoint HTTP response body for the "server_error"
+// error.
+type CreateWebhookServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// GetVersionServerErrorResponseBody is the type of the "control-plane" service
-// "get-version" endpoint HTTP response body for the "server_error" error.
-type GetVersionServerErrorResponseBody struct {
+// ListWebhooksClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "list-webhooks" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type ListWebhooksClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestartInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "restart-instance" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type RestartInstanceClusterNotInitializedResponseBody struct {
+// ListWebhooksServerErrorResponseBody is the type of the "control-plane"
+// service "list-webhooks" endpoint HTTP response body for the "server_error"
+// error.
+type ListWebhooksServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestartInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the
-// "invalid_input" error.
-type RestartInstanceInvalidInputResponseBody struct {
+// GetWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-webhook" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type GetWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestartInstanceNotFoundResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the "not_found"
+// GetWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "get-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type RestartInstanceNotFoundResponseBody struct {
+type GetWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// RestartInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the
-// "server_error" error.
-type RestartInstanceServerErrorResponseBody struct {
+// GetWebhookNotFoundResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body for the "not_found" error.
+type GetWebhookNotFoundResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StopInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "stop-instance" endpoint HTTP response body for the
+// GetWebhookServerErrorResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body for the "server_error" error.
+type GetWebhookServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
+
+// DeleteWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "delete-webhook" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
-type StopInstanceClusterNotInitializedResponseBody struct {
+type DeleteWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StopInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "stop-instance" endpoint HTTP response body for the "invalid_input"
+// DeleteWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "delete-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type StopInstanceInvalidInputResponseBody struct {
+type DeleteWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StopInstanceNotFoundResponseBody is the type of the "control-plane" service
-// "stop-instance" endpoint HTTP response body for the "not_found" error.
-type StopInstanceNotFoundResponseBody struct {
+// DeleteWebhookNotFoundResponseBody is the type of the "control-plane" service
+// "delete-webhook" endpoint HTTP response body for the "not_found" error.
+type DeleteWebhookNotFoundResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StopInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "stop-instance" endpoint HTTP response body for the "server_error"
+// DeleteWebhookServerErrorResponseBody is the type of the "control-plane"
+// service "delete-webhook" endpoint HTTP response body for the "server_error"
 // error.
-type StopInstanceServerErrorResponseBody struct {
+type DeleteWebhookServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StartInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "start-instance" endpoint HTTP response body for the
-// "cluster_not_initialized" error.
-type StartInstanceClusterNotInitializedResponseBody struct {
+// ListWebhookDeliveriesClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type ListWebhookDeliveriesClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StartInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "start-instance" endpoint HTTP response body for the "invalid_input"
-// error.
-type StartInstanceInvalidInputResponseBody struct {
+// ListWebhookDeliveriesInvalidInputResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "invalid_input" error.
+type ListWebhookDeliveriesInvalidInputResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StartInstanceNotFoundResponseBody is the type of the "control-plane" service
-// "start-instance" endpoint HTTP response body for the "not_found" error.
-type StartInstanceNotFoundResponseBody struct {
+// ListWebhookDeliveriesNotFoundResponseBody is the type of the "control-plane"
+// service "list-webhook-deliveries" endpoint HTTP response body for the
+// "not_found" error.
+type ListWebhookDeliveriesNotFoundResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// StartInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "start-instance" endpoint HTTP response body for the "server_error"
-// error.
-type StartInstanceServerErrorResponseBody struct {
+// ListWebhookDeliveriesServerErrorResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "server_error" error.
+type ListWebhookDeliveriesServerErrorResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// CancelDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
-// service "cancel-database-task" endpoint HTTP response body for the
-// "not_found" error.
-type CancelDatabaseTaskNotFoundResponseBody struct {
+// RestoreDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type RestoreDatabaseClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// CancelDatabaseTaskInvalidInputResponseBody is the type of the
-// "control-plane" service "cancel-database-task" endpoint HTTP response body
-// for the "invalid_input" error.
-type CancelDatabaseTaskInvalidInputResponseBody struct {
+// RestoreDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "database_not_modifiable" error.
+type RestoreDatabaseDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// CancelDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
-// service "cancel-database-task" endpoint HTTP response body for the
-// "server_error" error.
-type CancelDatabaseTaskServerErrorResponseBody struct {
+// RestoreDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "operation_already_in_progress" error.
+type RestoreDatabaseOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name *string `json:"name"`
 	// The error message.
 	Message *string `json:"message"`
 }
 
-// EtcdClusterMemberResponseBody is used to define fields on response body
-// types.
-type EtcdClusterMemberResponseBody struct {
-	// The name of the Etcd cluster member.
+// RestoreDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the
+// "invalid_input" error.
+type RestoreDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
 	Name *string `json:"name"`
-	// The Etcd peer endpoint for this cluster member.
-	PeerUrls []string `json:"peer_urls"`
-	// The Etcd client endpoint for this cluster member.
-	ClientUrls []string `json:"client_urls"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ClusterCredentialsResponseBody is used to define fields on response body
-// types.
-type ClusterCredentialsResponseBody struct {
-	// The Etcd username for the new host.
-	Username *string `json:"username"`
-	// The Etcd password for the new host.
-	Password *string `json:"password"`
-	// The base64-encoded CA certificate for the cluster.
-	CaCert *string `json:"ca_cert"`
-	// The base64-encoded etcd client certificate for the new cluster member.
-	ClientCert *string `json:"client_cert"`
-	// The base64-encoded etcd client key for the new cluster member.
-	ClientKey *string `json:"client_key"`
-	// The base64-encoded etcd server certificate for the new cluster member.
-	ServerCert *string `json:"server_cert"`
-	// The base64-encoded etcd server key for the new cluster member.
-	ServerKey *string `json:"server_key"`
+// RestoreDatabaseNotFoundResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the "not_found"
+// error.
+type RestoreDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ClusterStatusResponseBody is used to define fields on response body types.
-type ClusterStatusResponseBody struct {
-	// The current state of the cluster.
-	State *string `json:"state"`
+// RestoreDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the
+// "server_error" error.
+type RestoreDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// HostResponseBody is used to define fields on response body types.
-type HostResponseBody struct {
-	// Unique identifier for the host.
-	ID *string `json:"id"`
-	// The orchestrator used by this host.
-	Orchestrator *string `json:"orchestrator"`
-	// The data directory for the host.
-	DataDir *string `json:"data_dir"`
-	// The cohort that this host belongs to.
-	Cohort *HostCohortResponseBody `json:"cohort,omitempty"`
-	// The addresses that this host advertises to other hosts.
-	PeerAddresses []string `json:"peer_addresses"`
-	// The addresses that this host advertises to client applications.
-	ClientAddresses []string `json:"client_addresses"`
-	// The number of CPUs on this host.
-	Cpus *int `json:"cpus,omitempty"`
-	// The amount of memory available on this host.
-	Memory *string `json:"memory,omitempty"`
-	// Current status of the host.
-	Status *HostStatusResponseBody `json:"status"`
-	// The default PgEdge version for this host.
-	DefaultPgedgeVersion *PgEdgeVersionResponseBody `json:"default_pgedge_version,omitempty"`
-	// The PgEdge versions supported by this host.
-	SupportedPgedgeVersions []*PgEdgeVersionResponseBody `json:"supported_pgedge_versions,omitempty"`
-	// The etcd mode for this host.
-	EtcdMode *string `json:"etcd_mode,omitempty"`
+// CloneDatabaseDatabaseAlreadyExistsResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "database_already_exists" error.
+type CloneDatabaseDatabaseAlreadyExistsResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// HostCohortResponseBody is used to define fields on response body types.
-type HostCohortResponseBody struct {
-	// The type of cohort that the host belongs to.
-	Type *string `json:"type"`
-	// The member ID of the host within the cohort.
-	MemberID *string `json:"member_id"`
-	// Indicates if the host is a control node in the cohort.
-	ControlAvailable *bool `json:"control_available"`
+// CloneDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type CloneDatabaseClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// HostStatusResponseBody is used to define fields on response body types.
-type HostStatusResponseBody struct {
-	State *string `json:"state"`
-	// The last time the host status was updated.
-	UpdatedAt *string `json:"updated_at"`
-	// The status of each component of the host.
-	Components map[string]*ComponentStatusResponseBody `json:"components"`
+// CloneDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "operation_already_in_progress" error.
+type CloneDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ComponentStatusResponseBody is used to define fields on response body types.
-type ComponentStatusResponseBody struct {
-	// Indicates if the component is healthy.
-	Healthy *bool `json:"healthy"`
-	// Error message from any errors that occurred during the health check.
-	Error *string `json:"error,omitempty"`
-	// Additional details about the component.
-	Details map[string]any `json:"details,omitempty"`
+// CloneDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "clone-database" endpoint HTTP response body for the "invalid_input"
+// error.
+type CloneDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// PgEdgeVersionResponseBody is used to define fields on response body types.
-type PgEdgeVersionResponseBody struct {
-	// The Postgres major and minor version.
-	PostgresVersion *string `json:"postgres_version"`
-	// The Spock major version.
-	SpockVersion *string `json:"spock_version"`
+// CloneDatabaseNotFoundResponseBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP response body for the "not_found" error.
+type CloneDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// TaskResponseBody is used to define fields on response body types.
-type TaskResponseBody struct {
-	// The parent task ID of the task.
-	ParentID *string `json:"parent_id,omitempty"`
-	// The scope of the task (database or host).
-	Scope *string `json:"scope"`
-	// The entity ID (database_id or host_id) that this task belongs to.
-	EntityID *string `json:"entity_id"`
-	// The database ID of the task.
-	DatabaseID *string `json:"database_id,omitempty"`
-	// The name of the node that the task is operating on.
-	NodeName *string `json:"node_name,omitempty"`
-	// The ID of the instance that the task is operating on.
-	InstanceID *string `json:"instance_id,omitempty"`
-	// The ID of the host that the task is running on.
-	HostID *string `json:"host_id,omitempty"`
-	// The unique ID of the task.
-	TaskID *string `json:"task_id"`
-	// The time when the task was created.
-	CreatedAt *string `json:"created_at"`
-	// The time when the task was completed.
-	CompletedAt *string `json:"completed_at,omitempty"`
-	// The type of the task.
-	Type *string `json:"type"`
-	// The status of the task.
-	Status *string `json:"status"`
-	// The error message if the task failed.
-	Error *string `json:"error,omitempty"`
+// CloneDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "clone-database" endpoint HTTP response body for the "server_error"
+// error.
+type CloneDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseSummaryResponseBody is used to define fields on response body types.
-type DatabaseSummaryResponseBody struct {
-	// Unique identifier for the database.
-	ID *string `json:"id"`
-	// Unique identifier for the database's owner.
-	TenantID *string `json:"tenant_id,omitempty"`
-	// The time that the database was created.
-	CreatedAt *string `json:"created_at"`
-	// The time that the database was last updated.
-	UpdatedAt *string `json:"updated_at"`
-	// Current state of the database.
-	State *string `json:"state"`
-	// All of the instances in the database.
-	Instances []*InstanceResponseBody `json:"instances,omitempty"`
-	// Newer stable image versions available in the same Postgres major / Spock
-	// major bucket. Present only when ?include=available_upgrades is set.
-	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
-}
+// GetVersionServerErrorResponseBody is the type of the "control-plane" service
+// "get-version" endpoint HTTP response body for the "server_error" error.
+type GetVersionServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
+}
 
-// InstanceResponseBody is used to define fields on response body types.
-type InstanceResponseBody struct {
-	// Unique identifier for the instance.
-	ID *string `json:"id"`
-	// The ID of the host this instance is running on.
-	HostID *string `json:"host_id"`
-	// The Spock node name for this instance.
-	NodeName *string `json:"node_name"`
-	// The time that the instance was created.
-	CreatedAt *string `json:"created_at"`
-	// The time that the instance was last modified.
-	UpdatedAt *string `json:"updated_at"`
-	// The time that the instance status information was last updated.
-	StatusUpdatedAt *string `json:"status_updated_at,omitempty"`
-	State           *string `json:"state"`
-	// Connection information for the instance.
-	ConnectionInfo *InstanceConnectionInfoResponseBody `json:"connection_info,omitempty"`
-	// Postgres status information for the instance.
-	Postgres *InstancePostgresStatusResponseBody `json:"postgres,omitempty"`
-	// Spock status information for the instance.
-	Spock *InstanceSpockStatusResponseBody `json:"spock,omitempty"`
-	// An error message if the instance is in an error state.
-	Error *string `json:"error,omitempty"`
+// RestartInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "restart-instance" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type RestartInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// InstanceConnectionInfoResponseBody is used to define fields on response body
-// types.
-type InstanceConnectionInfoResponseBody struct {
-	// The addresses of the host that's running this instance.
-	Addresses []string `json:"addresses,omitempty"`
-	// The host port that Postgres is listening on for this instance.
-	Port *int `json:"port,omitempty"`
+// RestartInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the
+// "invalid_input" error.
+type RestartInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// InstancePostgresStatusResponseBody is used to define fields on response body
-// types.
-type InstancePostgresStatusResponseBody struct {
-	// The version of Postgres for this instance.
-	Version      *string `json:"version,omitempty"`
-	PatroniState *string `json:"patroni_state,omitempty"`
-	Role         *string `json:"role,omitempty"`
-	// True if this instance has a pending restart from a configuration change.
-	PendingRestart *bool `json:"pending_restart,omitempty"`
-	// True if Patroni is paused for this instance.
-	PatroniPaused *bool `json:"patroni_paused,omitempty"`
+// RestartInstanceNotFoundResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the "not_found"
+// error.
+type RestartInstanceNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// InstanceSpockStatusResponseBody is used to define fields on response body
-// types.
-type InstanceSpockStatusResponseBody struct {
-	// The current spock.readonly setting.
-	ReadOnly *string `json:"read_only,omitempty"`
-	// The version of Spock for this instance.
-	Version *string `json:"version,omitempty"`
-	// Status information for this instance's Spock subscriptions.
-	Subscriptions []*InstanceSubscriptionResponseBody `json:"subscriptions,omitempty"`
+// RestartInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the
+// "server_error" error.
+type RestartInstanceServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// InstanceSubscriptionResponseBody is used to define fields on response body
-// types.
-type InstanceSubscriptionResponseBody struct {
-	// The Spock node name of the provider for this subscription.
-	ProviderNode *string `json:"provider_node"`
-	// The name of the subscription.
+// GetInstanceSessionsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type GetInstanceSessionsClusterNotInitializedResponseBody struct {
+	// The name of the error.
 	Name *string `json:"name"`
-	// The current status of the subscription.
-	Status *string `json:"status"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// AvailableUpgradeResponseBody is used to define fields on response body types.
-type AvailableUpgradeResponseBody struct {
-	// Postgres version of the upgrade candidate.
-	PostgresVersion *string `json:"postgres_version"`
-	// Spock major version of the upgrade candidate.
-	SpockVersion *string `json:"spock_version"`
-	// Full container image reference for the upgrade candidate.
-	Image *string `json:"image"`
+// GetInstanceSessionsInvalidInputResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "invalid_input" error.
+type GetInstanceSessionsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseSpecRequestBody is used to define fields on request body types.
-type DatabaseSpecRequestBody struct {
-	// The name of the Postgres database.
-	DatabaseName string `json:"database_name"`
-	// The Postgres version in 'major.minor' format.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The major version of the Spock extension.
-	SpockVersion *string `json:"spock_version,omitempty"`
-	// The port used by the Postgres database. If the port is 0, each instance will
-	// be assigned a random port. If the port is unspecified, the database will not
-	// be exposed on any port, dependent on orchestrator support for that feature.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this database. If the port is 0, each instance
-	// will be assigned a random port. NOTE: This field is not currently supported
-	// for Docker Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database and to use for tuning
-	// Postgres. Defaults to the number of available CPUs on the host. Can include
-	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
-	// depends on the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database and
-	// to use for tuning Postgres. Defaults to the total available memory on the
-	// host. Whether this limit is enforced depends on the orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecRequestBody `json:"nodes"`
-	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecRequestBody `json:"database_users,omitempty"`
-	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecRequestBody `json:"services,omitempty"`
-	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
-	// Additional postgresql.conf settings. Will be merged with the settings
-	// provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries, one rule per array element. Inserted between
-	// control-plane's system-user rules and its catch-all, so they cannot affect
-	// control-plane-internal connectivity (Patroni, replication, health checks).
-	// Node-level pg_hba_conf entries are prepended to these.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries, one mapping per array element. Purely
-	// additive; control-plane writes no pg_ident entries of its own. The primary
-	// use case is cert auth with map= translating certificate CNs to PostgreSQL
-	// usernames.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// User-defined SQL scripts that run at different points during the database
-	// creation process. Once a database has been successfully created, changes to
-	// these scripts will have no effect.
-	Scripts *DatabaseScriptsRequestBody `json:"scripts,omitempty"`
+// GetInstanceSessionsNotFoundResponseBody is the type of the "control-plane"
+// service "get-instance-sessions" endpoint HTTP response body for the
+// "not_found" error.
+type GetInstanceSessionsNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseNodeSpecRequestBody is used to define fields on request body types.
-type DatabaseNodeSpecRequestBody struct {
-	// The name of the database node.
-	Name string `json:"name"`
-	// The IDs of the hosts that should run this node. When multiple hosts are
-	// specified, one host will chosen as a primary, and the others will be read
-	// replicas.
-	HostIds []string `json:"host_ids"`
-	// The Postgres version for this node in 'major.minor' format. Overrides the
-	// Postgres version set in the DatabaseSpec.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The port used by the Postgres database for this node. Overrides the Postgres
-	// port set in the DatabaseSpec.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this node. Overrides the Patroni port set in
-	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
-	// Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database on this node and to use for
-	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
-	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
-	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
-	// than are available on the host. Whether this limit is enforced depends on
-	// the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database on
-	// this node and to use for tuning Postgres. Defaults to the total available
-	// memory on the host. Whether this limit is enforced depends on the
-	// orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// Additional postgresql.conf settings for this particular node. Will be merged
-	// with the settings provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries for this particular node, one rule per array
-	// element. Prepended to the database-level pg_hba_conf entries, so node
-	// entries take first-match priority. Entries are inserted between
-	// control-plane's system-user rules and its catch-all, and cannot affect
-	// control-plane-internal connectivity.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries for this particular node, one mapping per
-	// array element. Prepended to the database-level pg_ident_conf entries.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// The backup configuration for this node. Overrides the backup configuration
-	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this node. Overrides the restore configuration
-	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// The name of the source node to use for sync. This is typically the node
-	// (like 'n1') from which the data will be copied to initialize this new node.
-	SourceNode *string `json:"source_node,omitempty"`
+// GetInstanceSessionsServerErrorResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "server_error" error.
+type GetInstanceSessionsServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupConfigSpecRequestBody is used to define fields on request body types.
-type BackupConfigSpecRequestBody struct {
-	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecRequestBody `json:"repositories"`
-	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecRequestBody `json:"schedules,omitempty"`
+// CancelInstanceSessionClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type CancelInstanceSessionClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupRepositorySpecRequestBody is used to define fields on request body
-// types.
-type BackupRepositorySpecRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain. This field will
-	// be excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile. This field will be
-	// excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// The Azure storage account access key to use for this repository. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The count of full backups to retain or the time to retain full backups.
-	RetentionFull *int `json:"retention_full,omitempty"`
-	// The type of measure used for retention_full.
-	RetentionFullType *string `json:"retention_full_type,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// CancelInstanceSessionInvalidInputResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "invalid_input" error.
+type CancelInstanceSessionInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupScheduleSpecRequestBody is used to define fields on request body types.
-type BackupScheduleSpecRequestBody struct {
-	// The unique identifier for this backup schedule.
-	ID string `json:"id"`
-	// The type of backup to take on this schedule.
-	Type string `json:"type"`
-	// The cron expression for this schedule.
-	CronExpression string `json:"cron_expression"`
+// CancelInstanceSessionNotFoundResponseBody is the type of the "control-plane"
+// service "cancel-instance-session" endpoint HTTP response body for the
+// "not_found" error.
+type CancelInstanceSessionNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// RestoreConfigSpecRequestBody is used to define fields on request body types.
-type RestoreConfigSpecRequestBody struct {
-	// The ID of the database to restore this database from.
-	SourceDatabaseID string `json:"source_database_id"`
-	// The name of the node to restore this database from.
-	SourceNodeName string `json:"source_node_name"`
-	// The name of the database in this repository. The database will be renamed to
-	// the database_name in the DatabaseSpec after it's restored.
-	SourceDatabaseName string `json:"source_database_name"`
-	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecRequestBody `json:"repository"`
-	// Additional options to use when restoring this database. If omitted, the
-	// database will be restored to the latest point in the given repository.
-	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+// CancelInstanceSessionServerErrorResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "server_error" error.
+type CancelInstanceSessionServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// RestoreRepositorySpecRequestBody is used to define fields on request body
-// types.
-type RestoreRepositorySpecRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// An optional Azure storage account access key to use for this repository. If
-	// not provided, pgbackrest will use the VM's managed identity.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// TerminateInstanceSessionClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type TerminateInstanceSessionClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// OrchestratorOptsRequestBody is used to define fields on request body types.
-type OrchestratorOptsRequestBody struct {
-	// Swarm-specific configuration.
-	Swarm *SwarmOptsRequestBody `json:"swarm,omitempty"`
+// TerminateInstanceSessionInvalidInputResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "invalid_input" error.
+type TerminateInstanceSessionInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// SwarmOptsRequestBody is used to define fields on request body types.
-type SwarmOptsRequestBody struct {
-	// A list of extra volumes to mount. Each entry defines a host and container
-	// path.
-	ExtraVolumes []*ExtraVolumesSpecRequestBody `json:"extra_volumes,omitempty"`
-	// A list of additional Docker Swarm networks to attach containers in this
-	// database to.
-	ExtraNetworks []*ExtraNetworkSpecRequestBody `json:"extra_networks,omitempty"`
-	// Arbitrary labels to apply to the Docker Swarm service
-	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
-	// User-specified container image override. Bypasses manifest version
-	// constraints entirely — the CP will deploy this image without validating it
-	// against the version manifest. The CP verifies the image exists in its
-	// registry before accepting the spec. Clearing this field causes the CP to
-	// fall back to the manifest-resolved image on the next reconcile.
-	Image *string `json:"image,omitempty"`
+// TerminateInstanceSessionNotFoundResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "not_found" error.
+type TerminateInstanceSessionNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ExtraVolumesSpecRequestBody is used to define fields on request body types.
-type ExtraVolumesSpecRequestBody struct {
-	// The host path for the volume.
-	HostPath string `json:"host_path"`
-	// The path inside the container where the volume will be mounted.
-	DestinationPath string `json:"destination_path"`
+// TerminateInstanceSessionServerErrorResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "server_error" error.
+type TerminateInstanceSessionServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ExtraNetworkSpecRequestBody is used to define fields on request body types.
-type ExtraNetworkSpecRequestBody struct {
-	// The name or ID of the network to connect to.
-	ID string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// GetInstancePostgresqlConfClusterNotInitializedResponseBody is the type of
+// the "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "cluster_not_initialized" error.
+type GetInstancePostgresqlConfClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseUserSpecRequestBody is used to define fields on request body types.
-type DatabaseUserSpecRequestBody struct {
-	// The username for this database user.
-	Username string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+// GetInstancePostgresqlConfInvalidInputResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "invalid_input" error.
+type GetInstancePostgresqlConfInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ServiceSpecRequestBody is used to define fields on request body types.
-type ServiceSpecRequestBody struct {
-	// The unique identifier for this service.
-	ServiceID string `json:"service_id"`
-	// The type of service to run.
-	ServiceType string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
-	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionRequestBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs string `json:"connect_as"`
+// GetInstancePostgresqlConfNotFoundResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "not_found" error.
+type GetInstancePostgresqlConfNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseConnectionRequestBody is used to define fields on request body types.
-type DatabaseConnectionRequestBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+// GetInstancePostgresqlConfServerErrorResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "server_error" error.
+type GetInstancePostgresqlConfServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseScriptsRequestBody is used to define fields on request body types.
-type DatabaseScriptsRequestBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
-}
-
-// DatabaseResponseBody is used to define fields on response body types.
-type DatabaseResponseBody struct {
-	// Unique identifier for the database.
-	ID *string `json:"id"`
-	// Unique identifier for the database's owner.
-	TenantID *string `json:"tenant_id,omitempty"`
-	// The time that the database was created.
-	CreatedAt *string `json:"created_at"`
-	// The time that the database was last updated.
-	UpdatedAt *string `json:"updated_at"`
-	// Current state of the database.
-	State *string `json:"state"`
-	// All of the instances in the database.
-	Instances []*InstanceResponseBody `json:"instances,omitempty"`
-	// Service instances running alongside this database.
-	ServiceInstances []*ServiceInstanceResponseBody `json:"service_instances,omitempty"`
-	// The user-provided specification for the database.
-	Spec *DatabaseSpecResponseBody `json:"spec,omitempty"`
-	// Newer stable image versions available in the same Postgres major / Spock
-	// major bucket. Present only when ?include=available_upgrades is set.
-	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+// StopInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "stop-instance" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StopInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ServiceInstanceResponseBody is used to define fields on response body types.
-type ServiceInstanceResponseBody struct {
-	// Unique identifier for the service instance.
-	ServiceInstanceID *string `json:"service_instance_id"`
-	// The service ID from the DatabaseSpec.
-	ServiceID *string `json:"service_id"`
-	// The ID of the database this service belongs to.
-	DatabaseID *string `json:"database_id"`
-	// The ID of the host this service instance is running on.
-	HostID *string `json:"host_id"`
-	// Current state of the service instance.
-	State *string `json:"state"`
-	// Runtime status information for the service instance.
-	Status *ServiceInstanceStatusResponseBody `json:"status,omitempty"`
-	// The time that the service instance was created.
-	CreatedAt *string `json:"created_at"`
-	// The time that the service instance was last updated.
-	UpdatedAt *string `json:"updated_at"`
-	// An error message if the service instance is in an error state.
-	Error *string `json:"error,omitempty"`
+// StopInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "stop-instance" endpoint HTTP response body for the "invalid_input"
+// error.
+type StopInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ServiceInstanceStatusResponseBody is used to define fields on response body
-// types.
-type ServiceInstanceStatusResponseBody struct {
-	// The Docker container ID.
-	ContainerID *string `json:"container_id,omitempty"`
-	// The container image version currently running.
-	ImageVersion *string `json:"image_version,omitempty"`
-	// The addresses of the host that's running this service instance.
-	Addresses []string `json:"addresses,omitempty"`
-	// Port mappings for this service instance.
-	Ports []*PortMappingResponseBody `json:"ports,omitempty"`
-	// Most recent health check result.
-	HealthCheck *HealthCheckResultResponseBody `json:"health_check,omitempty"`
-	// The time of the last health check attempt.
-	LastHealthAt *string `json:"last_health_at,omitempty"`
-	// Whether the service is ready to accept requests.
-	ServiceReady *bool `json:"service_ready,omitempty"`
+// StopInstanceNotFoundResponseBody is the type of the "control-plane" service
+// "stop-instance" endpoint HTTP response body for the "not_found" error.
+type StopInstanceNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// PortMappingResponseBody is used to define fields on response body types.
-type PortMappingResponseBody struct {
-	// The name of the port (e.g., 'http', 'web-client').
+// StopInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "stop-instance" endpoint HTTP response body for the "server_error"
+// error.
+type StopInstanceServerErrorResponseBody struct {
+	// The name of the error.
 	Name *string `json:"name"`
-	// The port number inside the container.
-	ContainerPort *int `json:"container_port,omitempty"`
-	// The port number on the host (if port-forwarded).
-	HostPort *int `json:"host_port,omitempty"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// HealthCheckResultResponseBody is used to define fields on response body
-// types.
-type HealthCheckResultResponseBody struct {
-	// The health status.
-	Status *string `json:"status"`
-	// Optional message about the health status.
-	Message *string `json:"message,omitempty"`
-	// The time this health check was performed.
-	CheckedAt *string `json:"checked_at"`
+// StartInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "start-instance" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StartInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseSpecResponseBody is used to define fields on response body types.
-type DatabaseSpecResponseBody struct {
-	// The name of the Postgres database.
-	DatabaseName *string `json:"database_name"`
-	// The Postgres version in 'major.minor' format.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The major version of the Spock extension.
-	SpockVersion *string `json:"spock_version,omitempty"`
-	// The port used by the Postgres database. If the port is 0, each instance will
-	// be assigned a random port. If the port is unspecified, the database will not
-	// be exposed on any port, dependent on orchestrator support for that feature.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this database. If the port is 0, each instance
-	// will be assigned a random port. NOTE: This field is not currently supported
-	// for Docker Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database and to use for tuning
-	// Postgres. Defaults to the number of available CPUs on the host. Can include
-	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
-	// depends on the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database and
-	// to use for tuning Postgres. Defaults to the total available memory on the
-	// host. Whether this limit is enforced depends on the orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecResponseBody `json:"nodes"`
-	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecResponseBody `json:"database_users,omitempty"`
-	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecResponseBody `json:"services,omitempty"`
-	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
-	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
-	// Additional postgresql.conf settings. Will be merged with the settings
-	// provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries, one rule per array element. Inserted between
-	// control-plane's system-user rules and its catch-all, so they cannot affect
-	// control-plane-internal connectivity (Patroni, replication, health checks).
-	// Node-level pg_hba_conf entries are prepended to these.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries, one mapping per array element. Purely
-	// additive; control-plane writes no pg_ident entries of its own. The primary
-	// use case is cert auth with map= translating certificate CNs to PostgreSQL
-	// usernames.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// User-defined SQL scripts that run at different points during the database
-	// creation process. Once a database has been successfully created, changes to
-	// these scripts will have no effect.
-	Scripts *DatabaseScriptsResponseBody `json:"scripts,omitempty"`
+// StartInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "start-instance" endpoint HTTP response body for the "invalid_input"
+// error.
+type StartInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// DatabaseNodeSpecResponseBody is used to define fields on response body types.
-type DatabaseNodeSpecResponseBody struct {
-	// The name of the database node.
+// StartInstanceNotFoundResponseBody is the type of the "control-plane" service
+// "start-instance" endpoint HTTP response body for the "not_found" error.
+type StartInstanceNotFoundResponseBody struct {
+	// The name of the error.
 	Name *string `json:"name"`
-	// The IDs of the hosts that should run this node. When multiple hosts are
-	// specified, one host will chosen as a primary, and the others will be read
-	// replicas.
-	HostIds []string `json:"host_ids"`
-	// The Postgres version for this node in 'major.minor' format. Overrides the
-	// Postgres version set in the DatabaseSpec.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The port used by the Postgres database for this node. Overrides the Postgres
-	// port set in the DatabaseSpec.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this node. Overrides the Patroni port set in
-	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
-	// Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database on this node and to use for
-	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
-	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
-	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
-	// than are available on the host. Whether this limit is enforced depends on
-	// the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database on
-	// this node and to use for tuning Postgres. Defaults to the total available
-	// memory on the host. Whether this limit is enforced depends on the
-	// orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// Additional postgresql.conf settings for this particular node. Will be merged
-	// with the settings provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries for this particular node, one rule per array
-	// element. Prepended to the database-level pg_hba_conf entries, so node
-	// entries take first-match priority. Entries are inserted between
-	// control-plane's system-user rules and its catch-all, and cannot affect
-	// control-plane-internal connectivity.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries for this particular node, one mapping per
-	// array element. Prepended to the database-level pg_ident_conf entries.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// The backup configuration for this node. Overrides the backup configuration
-	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
-	// The restore configuration for this node. Overrides the restore configuration
-	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// The name of the source node to use for sync. This is typically the node
-	// (like 'n1') from which the data will be copied to initialize this new node.
-	SourceNode *string `json:"source_node,omitempty"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupConfigSpecResponseBody is used to define fields on response body types.
-type BackupConfigSpecResponseBody struct {
-	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecResponseBody `json:"repositories"`
-	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecResponseBody `json:"schedules,omitempty"`
+// StartInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "start-instance" endpoint HTTP response body for the "server_error"
+// error.
+type StartInstanceServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupRepositorySpecResponseBody is used to define fields on response body
-// types.
-type BackupRepositorySpecResponseBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain. This field will
-	// be excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile. This field will be
-	// excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// The Azure storage account access key to use for this repository. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The count of full backups to retain or the time to retain full backups.
-	RetentionFull *int `json:"retention_full,omitempty"`
-	// The type of measure used for retention_full.
-	RetentionFullType *string `json:"retention_full_type,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// CancelDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
+// service "cancel-database-task" endpoint HTTP response body for the
+// "not_found" error.
+type CancelDatabaseTaskNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// BackupScheduleSpecResponseBody is used to define fields on response body
-// types.
-type BackupScheduleSpecResponseBody struct {
-	// The unique identifier for this backup schedule.
-	ID *string `json:"id"`
-	// The type of backup to take on this schedule.
-	Type *string `json:"type"`
-	// The cron expression for this schedule.
-	CronExpression *string `json:"cron_expression"`
+// CancelDatabaseTaskInvalidInputResponseBody is the type of the
+// "control-plane" service "cancel-database-task" endpoint HTTP response body
+// for the "invalid_input" error.
+type CancelDatabaseTaskInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// RestoreConfigSpecResponseBody is used to define fields on response body
-// types.
-type RestoreConfigSpecResponseBody struct {
-	// The ID of the database to restore this database from.
-	SourceDatabaseID *string `json:"source_database_id"`
-	// The name of the node to restore this database from.
-	SourceNodeName *string `json:"source_node_name"`
-	// The name of the database in this repository. The database will be renamed to
-	// the database_name in the DatabaseSpec after it's restored.
-	SourceDatabaseName *string `json:"source_database_name"`
-	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecResponseBody `json:"repository"`
-	// Additional options to use when restoring this database. If omitted, the
-	// database will be restored to the latest point in the given repository.
-	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+// CancelDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
+// service "cancel-database-task" endpoint HTTP response body for the
+// "server_error" error.
+type CancelDatabaseTaskServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// RestoreRepositorySpecResponseBody is used to define fields on response body
-// types.
-type RestoreRepositorySpecResponseBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// An optional Azure storage account access key to use for this repository. If
-	// not provided, pgbackrest will use the VM's managed identity.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// ResumeDatabaseTaskClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "resume-database-task" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ResumeDatabaseTaskClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// OrchestratorOptsResponseBody is used to define fields on response body types.
-type OrchestratorOptsResponseBody struct {
-	// Swarm-specific configuration.
-	Swarm *SwarmOptsResponseBody `json:"swarm,omitempty"`
+// ResumeDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
+// service "resume-database-task" endpoint HTTP response body for the
+// "not_found" error.
+type ResumeDatabaseTaskNotFoundResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// SwarmOptsResponseBody is used to define fields on response body types.
-type SwarmOptsResponseBody struct {
-	// A list of extra volumes to mount. Each entry defines a host and container
-	// path.
-	ExtraVolumes []*ExtraVolumesSpecResponseBody `json:"extra_volumes,omitempty"`
-	// A list of additional Docker Swarm networks to attach containers in this
-	// database to.
-	ExtraNetworks []*ExtraNetworkSpecResponseBody `json:"extra_networks,omitempty"`
-	// Arbitrary labels to apply to the Docker Swarm service
-	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
-	// User-specified container image override. Bypasses manifest version
-	// constraints entirely — the CP will deploy this image without validating it
-	// against the version manifest. The CP verifies the image exists in its
-	// registry before accepting the spec. Clearing this field causes the CP to
-	// fall back to the manifest-resolved image on the next reconcile.
-	Image *string `json:"image,omitempty"`
+// ResumeDatabaseTaskInvalidInputResponseBody is the type of the
+// "control-plane" service "resume-database-task" endpoint HTTP response body
+// for the "invalid_input" error.
+type ResumeDatabaseTaskInvalidInputResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ExtraVolumesSpecResponseBody is used to define fields on response body types.
-type ExtraVolumesSpecResponseBody struct {
-	// The host path for the volume.
-	HostPath *string `json:"host_path"`
-	// The path inside the container where the volume will be mounted.
-	DestinationPath *string `json:"destination_path"`
+// ResumeDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
+// service "resume-database-task" endpoint HTTP response body for the
+// "server_error" error.
+type ResumeDatabaseTaskServerErrorResponseBody struct {
+	// The name of the error.
+	Name *string `json:"name"`
+	// The error message.
+	Message *string `json:"message"`
 }
 
-// ExtraNetworkSpecResponseBody is used to define fields on response body types.
-type ExtraNetworkSpecResponseBody struct {
-	// The name or ID of the network to connect to.
-	ID *string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// EtcdClusterMemberResponseBody is used to define fields on response body
+// types.
+type EtcdClusterMemberResponseBody struct {
+	// The name of the Etcd cluster member.
+	Name *string `json:"name"`
+	// The Etcd peer endpoint for this cluster member.
+	PeerUrls []string `json:"peer_urls"`
+	// The Etcd client endpoint for this cluster member.
+	ClientUrls []string `json:"client_urls"`
 }
 
-// DatabaseUserSpecResponseBody is used to define fields on response body types.
-type DatabaseUserSpecResponseBody struct {
-	// The username for this database user.
+// ClusterCredentialsResponseBody is used to define fields on response body
+// types.
+type ClusterCredentialsResponseBody struct {
+	// The Etcd username for the new host.
 	Username *string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+	// The Etcd password for the new host.
+	Password *string `json:"password"`
+	// The base64-encoded CA certificate for the cluster.
+	CaCert *string `json:"ca_cert"`
+	// The base64-encoded etcd client certificate for the new cluster member.
+	ClientCert *string `json:"client_cert"`
+	// The base64-encoded etcd client key for the new cluster member.
+	ClientKey *string `json:"client_key"`
+	// The base64-encoded etcd server certificate for the new cluster member.
+	ServerCert *string `json:"server_cert"`
+	// The base64-encoded etcd server key for the new cluster member.
+	ServerKey *string `json:"server_key"`
 }
 
-// ServiceSpecResponseBody is used to define fields on response body types.
-type ServiceSpecResponseBody struct {
-	// The unique identifier for this service.
-	ServiceID *string `json:"service_id"`
-	// The type of service to run.
-	ServiceType *string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version *string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
+// ClusterStatusResponseBody is used to define fields on response body types.
+type ClusterStatusResponseBody struct {
+	// The current state of the cluster. The state is starting when the host that
+	// served the request hasn't finished starting up, such as while it waits for
+	// the other hosts to be upgraded.
+	State *string `json:"state"`
+}
+
+// HostResponseBody is used to define fields on response body types.
+type HostResponseBody struct {
+	// Unique identifier for the host.
+	ID *string `json:"id"`
+	// The orchestrator used by this host.
+	Orchestrator *string `json:"orchestrator"`
+	// The data directory for the host.
+	DataDir *string `json:"data_dir"`
+	// The cohort that this host belongs to.
+	Cohort *HostCohortResponseBody `json:"cohort,omitempty"`
+	// The addresses that this host advertises to other hosts.
+	PeerAddresses []string `json:"peer_addresses"`
+	// The addresses that this host advertises to client applications.
+	ClientAddresses []string `json:"client_addresses"`
+	// The number of CPUs on this host.
+	Cpus *int `json:"cpus,omitempty"`
+	// The amount of memory available on this host.
 	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionResponseBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs *string `json:"connect_as"`
+	// Current status of the host.
+	Status *HostStatusResponseBody `json:"status"`
+	// The default PgEdge version for this host.
+	DefaultPgedgeVersion *PgEdgeVersionResponseBody `json:"default_pgedge_version,omitempty"`
+	// The PgEdge versions supported by this host.
+	SupportedPgedgeVersions []*PgEdgeVersionResponseBody `json:"supported_pgedge_versions,omitempty"`
+	// The etcd mode for this host.
+	EtcdMode *string `json:"etcd_mode,omitempty"`
 }
 
-// DatabaseConnectionResponseBody is used to define fields on response body
+// HostCohortResponseBody is used to define fields on response body types.
+type HostCohortResponseBody struct {
+	// The type of cohort that the host belongs to.
+	Type *string `json:"type"`
+	// The member ID of the host within the cohort.
+	MemberID *string `json:"member_id"`
+	// Indicates if the host is a control node in the cohort.
+	ControlAvailable *bool `json:"control_available"`
+}
+
+// HostStatusResponseBody is used to define fields on response body types.
+type HostStatusResponseBody struct {
+	State *string `json:"state"`
+	// The last time the host status was updated.
+	UpdatedAt *string `json:"updated_at"`
+	// The status of each component of the host.
+	Components map[string]*ComponentStatusResponseBody `json:"components"`
+	// The Control Plane version that's running on the host.
+	Version *VersionInfoResponseBody `json:"version,omitempty"`
+}
+
+// ComponentStatusResponseBody is used to define fields on response body types.
+type ComponentStatusResponseBody struct {
+	// Indicates if the component is healthy.
+	Healthy *bool `json:"healthy"`
+	// Error message from any errors that occurred during the health check.
+	Error *string `json:"error,omitempty"`
+	// Additional details about the component.
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// VersionInfoResponseBody is used to define fields on response body types.
+type VersionInfoResponseBody struct {
+	// The version of the API server.
+	Version *string `json:"version"`
+	// The VCS revision of the API server.
+	Revision *string `json:"revision"`
+	// The timestamp associated with the revision.
+	RevisionTime *string `json:"revision_time"`
+	// The CPU architecture of the API server.
+	Arch *string `json:"arch"`
+}
+
+// PgEdgeVersionResponseBody is used to define fields on response body types.
+type PgEdgeVersionResponseBody struct {
+	// The Postgres major and minor version.
+	PostgresVersion *string `json:"postgres_version"`
+	// The Spock major version.
+	SpockVersion *string `json:"spock_version"`
+}
+
+// ClusterUpgradeStatusResponseBody is used to define fields on response body
 // types.
-type DatabaseConnectionResponseBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+type ClusterUpgradeStatusResponseBody struct {
+	// Whether every host has been upgraded and every pending change has been
+	// applied.
+	State *string `json:"state"`
+	// The most recent data migration that's been applied.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version in the data.
+	StateVersion *string `json:"state_version,omitempty"`
+	// Data migrations that are supported by the newest version in the cluster but
+	// haven't been applied. Upgraded hosts apply them once every host supports
+	// them.
+	PendingMigrations []string `json:"pending_migrations"`
+	// The upgrade status of each host.
+	Hosts []*ClusterUpgradeHostResponseBody `json:"hosts"`
+	// Hosts that can't read the current data or that are holding back the upgrade.
+	Incompatibilities []string `json:"incompatibilities"`
+}
+
+// ClusterUpgradeHostResponseBody is used to define fields on response body
+// types.
+type ClusterUpgradeHostResponseBody struct {
+	// The ID of the host.
+	HostID *string `json:"host_id"`
+	// The Control Plane version that's running on the host.
+	Version *string `json:"version,omitempty"`
+	// The newest data migration that the host's version supports.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version that the host's version supports.
+	StateVersion *string `json:"state_version,omitempty"`
+	// True if the host's version can read the current data.
+	Compatible *bool `json:"compatible"`
+	// True if the host's version supports every change that's supported by the
+	// newest version in the cluster.
+	Upgraded *bool `json:"upgraded"`
+}
+
+// StoragePrefixUsageResponseBody is used to define fields on response body
+// types.
+type StoragePrefixUsageResponseBody struct {
+	// The key prefix.
+	Prefix *string `json:"prefix"`
+	// The number of keys under this prefix.
+	Keys *int64 `json:"keys"`
+	// The total size of the keys and values under this prefix.
+	Bytes *int64 `json:"bytes"`
+}
+
+// EtcdMemberResponseBody is used to define fields on response body types.
+type EtcdMemberResponseBody struct {
+	// The hexadecimal Etcd member ID.
+	ID *string `json:"id"`
+	// The ID of the host that runs this member.
+	HostID *string `json:"host_id"`
+	// Voters count toward quorum. Learners receive updates, but don't vote until
+	// they're promoted.
+	Role *string `json:"role"`
+	// True if this member is the raft leader.
+	IsLeader *bool `json:"is_leader"`
+	// True if this member responded to a status request.
+	Reachable *bool `json:"reachable"`
+	// The member's peer URLs.
+	PeerUrls []string `json:"peer_urls"`
+	// The member's client URLs.
+	ClientUrls []string `json:"client_urls"`
+	// The member's Etcd version.
+	Version *string `json:"version,omitempty"`
+	// The size of the member's database on disk, including space that Etcd can
+	// reuse.
+	DbSizeBytes *int64 `json:"db_size_bytes,omitempty"`
+	// The portion of the member's database that's in use.
+	DbSizeInUseBytes *int64 `json:"db_size_in_use_bytes,omitempty"`
+	// The member's current raft term.
+	RaftTerm *uint64 `json:"raft_term,omitempty"`
+	// The member's committed raft index.
+	RaftIndex *uint64 `json:"raft_index,omitempty"`
+	// The member's applied raft index.
+	RaftAppliedIndex *uint64 `json:"raft_applied_index,omitempty"`
+	// The number of raft entries that this member has yet to apply relative to the
+	// leader.
+	RaftIndexLag *uint64 `json:"raft_index_lag"`
+	// Errors reported by or for this member.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// EtcdDefragmentResultResponseBody is used to define fields on response body
+// types.
+type EtcdDefragmentResultResponseBody struct {
+	// The ID of the host that was defragmented.
+	HostID *string `json:"host_id"`
+	// The size of the member's database on disk before defragmentation.
+	DbSizeBeforeBytes *int64 `json:"db_size_before_bytes"`
+	// The size of the member's database on disk after defragmentation.
+	DbSizeAfterBytes *int64 `json:"db_size_after_bytes,omitempty"`
+	// The reason that the member couldn't be defragmented.
+	Error *string `json:"error,omitempty"`
 }
 
-// DatabaseScriptsResponseBody is used to define fields on response body types.
-type DatabaseScriptsResponseBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+// TaskResponseBody is used to define fields on response body types.
+type TaskResponseBody struct {
+	// The parent task ID of the task.
+	ParentID *string `json:"parent_id,omitempty"`
+	// The scope of the task (database or host).
+	Scope *string `json:"scope"`
+	// The entity ID (database_id or host_id) that this task belongs to.
+	EntityID *string `json:"entity_id"`
+	// The database ID of the task.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The name of the node that the task is operating on.
+	NodeName *string `json:"node_name,omitempty"`
+	// The ID of the instance that the task is operating on.
+	InstanceID *string `json:"instance_id,omitempty"`
+	// The ID of the host that the task is running on.
+	HostID *string `json:"host_id,omitempty"`
+	// The unique ID of the task.
+	TaskID *string `json:"task_id"`
+	// The time when the task was created.
+	CreatedAt *string `json:"created_at"`
+	// The time when the task was completed.
+	CompletedAt *string `json:"completed_at,omitempty"`
+	// The type of the task.
+	Type *string `json:"type"`
+	// The status of the task.
+	Status *string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
+	// The error message if the task failed.
+	Error *string `json:"error,omitempty"`
 }
 
-// DatabaseSpecRequestBodyRequestBody is used to define fields on request body
+// DatabaseSummaryResponseBody is used to define fields on response body types.
+type DatabaseSummaryResponseBody struct {
+	// Unique identifier for the database.
+	ID *string `json:"id"`
+	// Unique identifier for the database's owner.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The time that the database was created.
+	CreatedAt *string `json:"created_at"`
+	// The time that the database was last updated.
+	UpdatedAt *string `json:"updated_at"`
+	// Current state of the database.
+	State *string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
+	// All of the instances in the database.
+	Instances []*InstanceResponseBody `json:"instances,omitempty"`
+	// Newer stable image versions available in the same Postgres major / Spock
+	// major bucket. Present only when ?include=available_upgrades is set.
+	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+}
+
+// InstanceResponseBody is used to define fields on response body types.
+type InstanceResponseBody struct {
+	// Unique identifier for the instance.
+	ID *string `json:"id"`
+	// The ID of the host this instance is running on.
+	HostID *string `json:"host_id"`
+	// The Spock node name for this instance.
+	NodeName *string `json:"node_name"`
+	// The time that the instance was created.
+	CreatedAt *string `json:"created_at"`
+	// The time that the instance was last modified.
+	UpdatedAt *string `json:"updated_at"`
+	// The time that the instance status information was last updated.
+	StatusUpdatedAt *string `json:"status_updated_at,omitempty"`
+	State           *string `json:"state"`
+	// Connection information for the instance.
+	ConnectionInfo *InstanceConnectionInfoResponseBody `json:"connection_info,omitempty"`
+	// Postgres status information for the instance.
+	Postgres *InstancePostgresStatusResponseBody `json:"postgres,omitempty"`
+	// Spock status information for the instance.
+	Spock *InstanceSpockStatusResponseBody `json:"spock,omitempty"`
+	// Resource usage information for the instance.
+	Resources *InstanceResourceUsageResponseBody `json:"resources,omitempty"`
+	// An error message if the instance is in an error state.
+	Error *string `json:"error,omitempty"`
+}
+
+// InstanceConnectionInfoResponseBody is used to define fields on response body
 // types.
-type DatabaseSpecRequestBodyRequestBody struct {
+type InstanceConnectionInfoResponseBody struct {
+	// The addresses of the host that's running this instance.
+	Addresses []string `json:"addresses,omitempty"`
+	// The host port that Postgres is listening on for this instance.
+	Port *int `json:"port,omitempty"`
+}
+
+// InstancePostgresStatusResponseBody is used to define fields on response body
+// types.
+type InstancePostgresStatusResponseBody struct {
+	// The version of Postgres for this instance.
+	Version      *string `json:"version,omitempty"`
+	PatroniState *string `json:"patroni_state,omitempty"`
+	Role         *string `json:"role,omitempty"`
+	// True if this instance has a pending restart from a configuration change.
+	PendingRestart *bool `json:"pending_restart,omitempty"`
+	// True if Patroni is paused for this instance.
+	PatroniPaused *bool `json:"patroni_paused,omitempty"`
+}
+
+// InstanceSpockStatusResponseBody is used to define fields on response body
+// types.
+type InstanceSpockStatusResponseBody struct {
+	// The current spock.readonly setting.
+	ReadOnly *string `json:"read_only,omitempty"`
+	// The version of Spock for this instance.
+	Version *string `json:"version,omitempty"`
+	// Status information for this instance's Spock subscriptions.
+	Subscriptions []*InstanceSubscriptionResponseBody `json:"subscriptions,omitempty"`
+}
+
+// InstanceSubscriptionResponseBody is used to define fields on response body
+// types.
+type InstanceSubscriptionResponseBody struct {
+	// The Spock node name of the provider for this subscription.
+	ProviderNode *string `json:"provider_node"`
+	// The name of the subscription.
+	Name *string `json:"name"`
+	// The current status of the subscription.
+	Status *string `json:"status"`
+}
+
+// InstanceResourceUsageResponseBody is used to define fields on response body
+// types.
+type InstanceResourceUsageResponseBody struct {
+	// The total size of the instance's data directory in bytes.
+	DataDirectoryBytes *int64 `json:"data_directory_bytes,omitempty"`
+	// The total size of the instance's WAL directory in bytes.
+	WalDirectoryBytes *int64 `json:"wal_directory_bytes,omitempty"`
+	// The free space on the volume that contains the data directory in bytes.
+	VolumeFreeBytes *int64 `json:"volume_free_bytes,omitempty"`
+	// The total size of the volume that contains the data directory in bytes.
+	VolumeTotalBytes *int64 `json:"volume_total_bytes,omitempty"`
+	// The size of the database in bytes, as reported by pg_database_size.
+	DatabaseSizeBytes *int64 `json:"database_size_bytes,omitempty"`
+	// The number of client connections to the instance.
+	Connections *int `json:"connections,omitempty"`
+	// The instance's max_connections setting.
+	MaxConnections *int `json:"max_connections,omitempty"`
+	// The CPU usage of the instance's container or unit as a percentage of a
+	// single CPU.
+	CPUPercent *float64 `json:"cpu_percent,omitempty"`
+	// The memory usage of the instance's container or unit in bytes.
+	MemoryBytes *int64 `json:"memory_bytes,omitempty"`
+	// The memory limit of the instance's container or unit in bytes.
+	MemoryLimitBytes *int64 `json:"memory_limit_bytes,omitempty"`
+	// True if the volume usage exceeds the configured disk-full threshold.
+	DiskFull *bool `json:"disk_full,omitempty"`
+	// The time that the resource usage was collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+}
+
+// AvailableUpgradeResponseBody is used to define fields on response body types.
+type AvailableUpgradeResponseBody struct {
+	// Postgres version of the upgrade candidate.
+	PostgresVersion *string `json:"postgres_version"`
+	// Spock major version of the upgrade candidate.
+	SpockVersion *string `json:"spock_version"`
+	// Full container image reference for the upgrade candidate.
+	Image *string `json:"image"`
+}
+
+// DatabaseSpecRequestBody is used to define fields on request body types.
+type DatabaseSpecRequestBody struct {
 	// The name of the Postgres database.
 	DatabaseName string `json:"database_name"`
 	// The Postgres version in 'major.minor' format.
@@ -2742,15 +3312,15 @@ type DatabaseSpecRequestBodyRequestBody struct {
 	// host. Whether this limit is enforced depends on the orchestrator.
 	Memory *string `json:"memory,omitempty"`
 	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecRequestBodyRequestBody `json:"nodes"`
+	Nodes []*DatabaseNodeSpecRequestBody `json:"nodes"`
 	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecRequestBodyRequestBody `json:"database_users,omitempty"`
+	DatabaseUsers []*DatabaseUserSpecRequestBody `json:"database_users,omitempty"`
 	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecRequestBodyRequestBody `json:"services,omitempty"`
+	Services []*ServiceSpecRequestBody `json:"services,omitempty"`
 	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
 	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
 	// Additional postgresql.conf settings. Will be merged with the settings
 	// provided by control-plane.
 	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
@@ -2765,16 +3335,33 @@ type DatabaseSpecRequestBodyRequestBody struct {
 	// usernames.
 	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
 	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
 	// User-defined SQL scripts that run at different points during the database
 	// creation process. Once a database has been successfully created, changes to
 	// these scripts will have no effect.
-	Scripts *DatabaseScriptsRequestBodyRequestBody `json:"scripts,omitempty"`
+	Scripts *DatabaseScriptsRequestBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsRequestBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsRequestBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
 }
 
-// DatabaseNodeSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseNodeSpecRequestBodyRequestBody struct {
+// DatabaseNodeSpecRequestBody is used to define fields on request body types.
+type DatabaseNodeSpecRequestBody struct {
 	// The name of the database node.
 	Name string `json:"name"`
 	// The IDs of the hosts that should run this node. When multiple hosts are
@@ -2817,29 +3404,31 @@ type DatabaseNodeSpecRequestBodyRequestBody struct {
 	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
 	// The backup configuration for this node. Overrides the backup configuration
 	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
 	// The restore configuration for this node. Overrides the restore configuration
 	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
 	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
 	// The name of the source node to use for sync. This is typically the node
 	// (like 'n1') from which the data will be copied to initialize this new node.
 	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsRequestBody `json:"patroni,omitempty"`
 }
 
-// BackupConfigSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type BackupConfigSpecRequestBodyRequestBody struct {
+// BackupConfigSpecRequestBody is used to define fields on request body types.
+type BackupConfigSpecRequestBody struct {
 	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecRequestBodyRequestBody `json:"repositories"`
+	Repositories []*BackupRepositorySpecRequestBody `json:"repositories"`
 	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecRequestBodyRequestBody `json:"schedules,omitempty"`
+	Schedules []*BackupScheduleSpecRequestBody `json:"schedules,omitempty"`
 }
 
-// BackupRepositorySpecRequestBodyRequestBody is used to define fields on
-// request body types.
-type BackupRepositorySpecRequestBodyRequestBody struct {
+// BackupRepositorySpecRequestBody is used to define fields on request body
+// types.
+type BackupRepositorySpecRequestBody struct {
 	// The unique identifier of this repository.
 	ID *string `json:"id,omitempty"`
 	// The type of this repository.
@@ -2893,9 +3482,8 @@ type BackupRepositorySpecRequestBodyRequestBody struct {
 	CustomOptions map[string]string `json:"custom_options,omitempty"`
 }
 
-// BackupScheduleSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type BackupScheduleSpecRequestBodyRequestBody struct {
+// BackupScheduleSpecRequestBody is used to define fields on request body types.
+type BackupScheduleSpecRequestBody struct {
 	// The unique identifier for this backup schedule.
 	ID string `json:"id"`
 	// The type of backup to take on this schedule.
@@ -2904,9 +3492,8 @@ type BackupScheduleSpecRequestBodyRequestBody struct {
 	CronExpression string `json:"cron_expression"`
 }
 
-// RestoreConfigSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type RestoreConfigSpecRequestBodyRequestBody struct {
+// RestoreConfigSpecRequestBody is used to define fields on request body types.
+type RestoreConfigSpecRequestBody struct {
 	// The ID of the database to restore this database from.
 	SourceDatabaseID string `json:"source_database_id"`
 	// The name of the node to restore this database from.
@@ -2915,15 +3502,15 @@ type RestoreConfigSpecRequestBodyRequestBody struct {
 	// the database_name in the DatabaseSpec after it's restored.
 	SourceDatabaseName string `json:"source_database_name"`
 	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecRequestBodyRequestBody `json:"repository"`
+	Repository *RestoreRepositorySpecRequestBody `json:"repository"`
 	// Additional options to use when restoring this database. If omitted, the
 	// database will be restored to the latest point in the given repository.
 	RestoreOptions map[string]string `json:"restore_options,omitempty"`
 }
 
-// RestoreRepositorySpecRequestBodyRequestBody is used to define fields on
-// request body types.
-type RestoreRepositorySpecRequestBodyRequestBody struct {
+// RestoreRepositorySpecRequestBody is used to define fields on request body
+// types.
+type RestoreRepositorySpecRequestBody struct {
 	// The unique identifier of this repository.
 	ID *string `json:"id,omitempty"`
 	// The type of this repository.
@@ -2966,22 +3553,20 @@ type RestoreRepositorySpecRequestBodyRequestBody struct {
 	CustomOptions map[string]string `json:"custom_options,omitempty"`
 }
 
-// OrchestratorOptsRequestBodyRequestBody is used to define fields on request
-// body types.
-type OrchestratorOptsRequestBodyRequestBody struct {
+// OrchestratorOptsRequestBody is used to define fields on request body types.
+type OrchestratorOptsRequestBody struct {
 	// Swarm-specific configuration.
-	Swarm *SwarmOptsRequestBodyRequestBody `json:"swarm,omitempty"`
+	Swarm *SwarmOptsRequestBody `json:"swarm,omitempty"`
 }
 
-// SwarmOptsRequestBodyRequestBody is used to define fields on request body
-// types.
-type SwarmOptsRequestBodyRequestBody struct {
+// SwarmOptsRequestBody is used to define fields on request body types.
+type SwarmOptsRequestBody struct {
 	// A list of extra volumes to mount. Each entry defines a host and container
 	// path.
-	ExtraVolumes []*ExtraVolumesSpecRequestBodyRequestBody `json:"extra_volumes,omitempty"`
+	ExtraVolumes []*ExtraVolumesSpecRequestBody `json:"extra_volumes,omitempty"`
 	// A list of additional Docker Swarm networks to attach containers in this
 	// database to.
-	ExtraNetworks []*ExtraNetworkSpecRequestBodyRequestBody `json:"extra_networks,omitempty"`
+	ExtraNetworks []*ExtraNetworkSpecRequestBody `json:"extra_networks,omitempty"`
 	// Arbitrary labels to apply to the Docker Swarm service
 	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
 	// User-specified container image override. Bypasses manifest version
@@ -2992,282 +3577,2804 @@ type SwarmOptsRequestBodyRequestBody struct {
 	Image *string `json:"image,omitempty"`
 }
 
-// ExtraVolumesSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type ExtraVolumesSpecRequestBodyRequestBody struct {
+// ExtraVolumesSpecRequestBody is used to define fields on request body types.
+type ExtraVolumesSpecRequestBody struct {
 	// The host path for the volume.
 	HostPath string `json:"host_path"`
 	// The path inside the container where the volume will be mounted.
 	DestinationPath string `json:"destination_path"`
 }
 
-// ExtraNetworkSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type ExtraNetworkSpecRequestBodyRequestBody struct {
-	// The name or ID of the network to connect to.
-	ID string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// ExtraNetworkSpecRequestBody is used to define fields on request body types.
+type ExtraNetworkSpecRequestBody struct {
+	// The name or ID of the network to connect to.
+	ID string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsRequestBody is used to define fields on request body types.
+type PatroniSettingsRequestBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogRequestBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogRequestBody is used to define fields on request body types.
+type PatroniWatchdogRequestBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecRequestBody is used to define fields on request body types.
+type DatabaseUserSpecRequestBody struct {
+	// The username for this database user.
+	Username string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecRequestBody is used to define fields on request body types.
+type ServiceSpecRequestBody struct {
+	// The unique identifier for this service.
+	ServiceID string `json:"service_id"`
+	// The type of service to run.
+	ServiceType string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionRequestBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs string `json:"connect_as"`
+}
+
+// DatabaseConnectionRequestBody is used to define fields on request body types.
+type DatabaseConnectionRequestBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsRequestBody is used to define fields on request body types.
+type DatabaseScriptsRequestBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsRequestBody is used to define fields on request body types.
+type QueryStatsSettingsRequestBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// ImportSourceSpecRequestBody is used to define fields on request body types.
+type ImportSourceSpecRequestBody struct {
+	// The connection string for the source database. It must be reachable from the
+	// Control Plane hosts and from the database's instances.
+	Dsn string `json:"dsn"`
+}
+
+// DatabaseResponseBody is used to define fields on response body types.
+type DatabaseResponseBody struct {
+	// Unique identifier for the database.
+	ID *string `json:"id"`
+	// Unique identifier for the database's owner.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The time that the database was created.
+	CreatedAt *string `json:"created_at"`
+	// The time that the database was last updated.
+	UpdatedAt *string `json:"updated_at"`
+	// Current state of the database.
+	State *string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
+	// All of the instances in the database.
+	Instances []*InstanceResponseBody `json:"instances,omitempty"`
+	// Service instances running alongside this database.
+	ServiceInstances []*ServiceInstanceResponseBody `json:"service_instances,omitempty"`
+	// The user-provided specification for the database.
+	Spec *DatabaseSpecResponseBody `json:"spec,omitempty"`
+	// Newer stable image versions available in the same Postgres major / Spock
+	// major bucket. Present only when ?include=available_upgrades is set.
+	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+	// The result of the most recent drift check. Absent if the database has not
+	// been checked.
+	Drift *DatabaseDriftResponseBody `json:"drift,omitempty"`
+}
+
+// ServiceInstanceResponseBody is used to define fields on response body types.
+type ServiceInstanceResponseBody struct {
+	// Unique identifier for the service instance.
+	ServiceInstanceID *string `json:"service_instance_id"`
+	// The service ID from the DatabaseSpec.
+	ServiceID *string `json:"service_id"`
+	// The ID of the database this service belongs to.
+	DatabaseID *string `json:"database_id"`
+	// The ID of the host this service instance is running on.
+	HostID *string `json:"host_id"`
+	// Current state of the service instance.
+	State *string `json:"state"`
+	// Runtime status information for the service instance.
+	Status *ServiceInstanceStatusResponseBody `json:"status,omitempty"`
+	// The time that the service instance was created.
+	CreatedAt *string `json:"created_at"`
+	// The time that the service instance was last updated.
+	UpdatedAt *string `json:"updated_at"`
+	// An error message if the service instance is in an error state.
+	Error *string `json:"error,omitempty"`
+}
+
+// ServiceInstanceStatusResponseBody is used to define fields on response body
+// types.
+type ServiceInstanceStatusResponseBody struct {
+	// The Docker container ID.
+	ContainerID *string `json:"container_id,omitempty"`
+	// The container image version currently running.
+	ImageVersion *string `json:"image_version,omitempty"`
+	// The addresses of the host that's running this service instance.
+	Addresses []string `json:"addresses,omitempty"`
+	// Port mappings for this service instance.
+	Ports []*PortMappingResponseBody `json:"ports,omitempty"`
+	// Most recent health check result.
+	HealthCheck *HealthCheckResultResponseBody `json:"health_check,omitempty"`
+	// The time of the last health check attempt.
+	LastHealthAt *string `json:"last_health_at,omitempty"`
+	// Whether the service is ready to accept requests.
+	ServiceReady *bool `json:"service_ready,omitempty"`
+}
+
+// PortMappingResponseBody is used to define fields on response body types.
+type PortMappingResponseBody struct {
+	// The name of the port (e.g., 'http', 'web-client').
+	Name *string `json:"name"`
+	// The port number inside the container.
+	ContainerPort *int `json:"container_port,omitempty"`
+	// The port number on the host (if port-forwarded).
+	HostPort *int `json:"host_port,omitempty"`
+}
+
+// HealthCheckResultResponseBody is used to define fields on response body
+// types.
+type HealthCheckResultResponseBody struct {
+	// The health status.
+	Status *string `json:"status"`
+	// Optional message about the health status.
+	Message *string `json:"message,omitempty"`
+	// The time this health check was performed.
+	CheckedAt *string `json:"checked_at"`
+}
+
+// DatabaseSpecResponseBody is used to define fields on response body types.
+type DatabaseSpecResponseBody struct {
+	// The name of the Postgres database.
+	DatabaseName *string `json:"database_name"`
+	// The Postgres version in 'major.minor' format.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The major version of the Spock extension.
+	SpockVersion *string `json:"spock_version,omitempty"`
+	// The port used by the Postgres database. If the port is 0, each instance will
+	// be assigned a random port. If the port is unspecified, the database will not
+	// be exposed on any port, dependent on orchestrator support for that feature.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this database. If the port is 0, each instance
+	// will be assigned a random port. NOTE: This field is not currently supported
+	// for Docker Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database and to use for tuning
+	// Postgres. Defaults to the number of available CPUs on the host. Can include
+	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
+	// depends on the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database and
+	// to use for tuning Postgres. Defaults to the total available memory on the
+	// host. Whether this limit is enforced depends on the orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// The Spock nodes for this database.
+	Nodes []*DatabaseNodeSpecResponseBody `json:"nodes"`
+	// The users to create for this database.
+	DatabaseUsers []*DatabaseUserSpecResponseBody `json:"database_users,omitempty"`
+	// Service instances to run alongside the database (e.g., MCP servers).
+	Services []*ServiceSpecResponseBody `json:"services,omitempty"`
+	// The backup configuration for this database.
+	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
+	// The restore configuration for this database.
+	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
+	// Additional postgresql.conf settings. Will be merged with the settings
+	// provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries, one rule per array element. Inserted between
+	// control-plane's system-user rules and its catch-all, so they cannot affect
+	// control-plane-internal connectivity (Patroni, replication, health checks).
+	// Node-level pg_hba_conf entries are prepended to these.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries, one mapping per array element. Purely
+	// additive; control-plane writes no pg_ident entries of its own. The primary
+	// use case is cert auth with map= translating certificate CNs to PostgreSQL
+	// usernames.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// User-defined SQL scripts that run at different points during the database
+	// creation process. Once a database has been successfully created, changes to
+	// these scripts will have no effect.
+	Scripts *DatabaseScriptsResponseBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsResponseBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsResponseBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
+}
+
+// DatabaseNodeSpecResponseBody is used to define fields on response body types.
+type DatabaseNodeSpecResponseBody struct {
+	// The name of the database node.
+	Name *string `json:"name"`
+	// The IDs of the hosts that should run this node. When multiple hosts are
+	// specified, one host will chosen as a primary, and the others will be read
+	// replicas.
+	HostIds []string `json:"host_ids"`
+	// The Postgres version for this node in 'major.minor' format. Overrides the
+	// Postgres version set in the DatabaseSpec.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The port used by the Postgres database for this node. Overrides the Postgres
+	// port set in the DatabaseSpec.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this node. Overrides the Patroni port set in
+	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
+	// Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database on this node and to use for
+	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
+	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
+	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
+	// than are available on the host. Whether this limit is enforced depends on
+	// the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database on
+	// this node and to use for tuning Postgres. Defaults to the total available
+	// memory on the host. Whether this limit is enforced depends on the
+	// orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// Additional postgresql.conf settings for this particular node. Will be merged
+	// with the settings provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries for this particular node, one rule per array
+	// element. Prepended to the database-level pg_hba_conf entries, so node
+	// entries take first-match priority. Entries are inserted between
+	// control-plane's system-user rules and its catch-all, and cannot affect
+	// control-plane-internal connectivity.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries for this particular node, one mapping per
+	// array element. Prepended to the database-level pg_ident_conf entries.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// The backup configuration for this node. Overrides the backup configuration
+	// set in the DatabaseSpec.
+	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
+	// The restore configuration for this node. Overrides the restore configuration
+	// set in the DatabaseSpec.
+	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// The name of the source node to use for sync. This is typically the node
+	// (like 'n1') from which the data will be copied to initialize this new node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsResponseBody `json:"patroni,omitempty"`
+}
+
+// BackupConfigSpecResponseBody is used to define fields on response body types.
+type BackupConfigSpecResponseBody struct {
+	// The repositories for this backup configuration.
+	Repositories []*BackupRepositorySpecResponseBody `json:"repositories"`
+	// The schedules for this backup configuration.
+	Schedules []*BackupScheduleSpecResponseBody `json:"schedules,omitempty"`
+}
+
+// BackupRepositorySpecResponseBody is used to define fields on response body
+// types.
+type BackupRepositorySpecResponseBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain. This field will
+	// be excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile. This field will be
+	// excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// The Azure storage account access key to use for this repository. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The count of full backups to retain or the time to retain full backups.
+	RetentionFull *int `json:"retention_full,omitempty"`
+	// The type of measure used for retention_full.
+	RetentionFullType *string `json:"retention_full_type,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// BackupScheduleSpecResponseBody is used to define fields on response body
+// types.
+type BackupScheduleSpecResponseBody struct {
+	// The unique identifier for this backup schedule.
+	ID *string `json:"id"`
+	// The type of backup to take on this schedule.
+	Type *string `json:"type"`
+	// The cron expression for this schedule.
+	CronExpression *string `json:"cron_expression"`
+}
+
+// RestoreConfigSpecResponseBody is used to define fields on response body
+// types.
+type RestoreConfigSpecResponseBody struct {
+	// The ID of the database to restore this database from.
+	SourceDatabaseID *string `json:"source_database_id"`
+	// The name of the node to restore this database from.
+	SourceNodeName *string `json:"source_node_name"`
+	// The name of the database in this repository. The database will be renamed to
+	// the database_name in the DatabaseSpec after it's restored.
+	SourceDatabaseName *string `json:"source_database_name"`
+	// The repository to restore this database from.
+	Repository *RestoreRepositorySpecResponseBody `json:"repository"`
+	// Additional options to use when restoring this database. If omitted, the
+	// database will be restored to the latest point in the given repository.
+	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+}
+
+// RestoreRepositorySpecResponseBody is used to define fields on response body
+// types.
+type RestoreRepositorySpecResponseBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// An optional Azure storage account access key to use for this repository. If
+	// not provided, pgbackrest will use the VM's managed identity.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// OrchestratorOptsResponseBody is used to define fields on response body types.
+type OrchestratorOptsResponseBody struct {
+	// Swarm-specific configuration.
+	Swarm *SwarmOptsResponseBody `json:"swarm,omitempty"`
+}
+
+// SwarmOptsResponseBody is used to define fields on response body types.
+type SwarmOptsResponseBody struct {
+	// A list of extra volumes to mount. Each entry defines a host and container
+	// path.
+	ExtraVolumes []*ExtraVolumesSpecResponseBody `json:"extra_volumes,omitempty"`
+	// A list of additional Docker Swarm networks to attach containers in this
+	// database to.
+	ExtraNetworks []*ExtraNetworkSpecResponseBody `json:"extra_networks,omitempty"`
+	// Arbitrary labels to apply to the Docker Swarm service
+	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
+	// User-specified container image override. Bypasses manifest version
+	// constraints entirely — the CP will deploy this image without validating it
+	// against the version manifest. The CP verifies the image exists in its
+	// registry before accepting the spec. Clearing this field causes the CP to
+	// fall back to the manifest-resolved image on the next reconcile.
+	Image *string `json:"image,omitempty"`
+}
+
+// ExtraVolumesSpecResponseBody is used to define fields on response body types.
+type ExtraVolumesSpecResponseBody struct {
+	// The host path for the volume.
+	HostPath *string `json:"host_path"`
+	// The path inside the container where the volume will be mounted.
+	DestinationPath *string `json:"destination_path"`
+}
+
+// ExtraNetworkSpecResponseBody is used to define fields on response body types.
+type ExtraNetworkSpecResponseBody struct {
+	// The name or ID of the network to connect to.
+	ID *string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsResponseBody is used to define fields on response body types.
+type PatroniSettingsResponseBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogResponseBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogResponseBody is used to define fields on response body types.
+type PatroniWatchdogResponseBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode *string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecResponseBody is used to define fields on response body types.
+type DatabaseUserSpecResponseBody struct {
+	// The username for this database user.
+	Username *string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecResponseBody is used to define fields on response body types.
+type ServiceSpecResponseBody struct {
+	// The unique identifier for this service.
+	ServiceID *string `json:"service_id"`
+	// The type of service to run.
+	ServiceType *string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version *string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionResponseBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs *string `json:"connect_as"`
+}
+
+// DatabaseConnectionResponseBody is used to define fields on response body
+// types.
+type DatabaseConnectionResponseBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsResponseBody is used to define fields on response body types.
+type DatabaseScriptsResponseBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsResponseBody is used to define fields on response body
+// types.
+type QueryStatsSettingsResponseBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled *bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// DatabaseDriftResponseBody is used to define fields on response body types.
+type DatabaseDriftResponseBody struct {
+	// The time that the database was checked.
+	CheckedAt *string `json:"checked_at"`
+	// The resources that no longer match the database's spec.
+	Resources []*DriftedResourceResponseBody `json:"resources"`
+	// The error that prevented the check from completing, if any.
+	Error *string `json:"error,omitempty"`
+	// The ID of the update task that was started to remediate the drift, if any.
+	RemediationTaskID *string `json:"remediation_task_id,omitempty"`
+}
+
+// DriftedResourceResponseBody is used to define fields on response body types.
+type DriftedResourceResponseBody struct {
+	// The type and ID of the resource.
+	ResourceID *string `json:"resource_id"`
+	// The change that an update would make to the resource.
+	Type *string `json:"type"`
+	// Why the resource would be changed.
+	Reason *string `json:"reason,omitempty"`
+	// The JSON Patch operations that would bring the resource in line with the
+	// spec. Only present for updates.
+	Diff []map[string]any `json:"diff,omitempty"`
+}
+
+// DatabaseSpecRequestBodyRequestBody is used to define fields on request body
+// types.
+type DatabaseSpecRequestBodyRequestBody struct {
+	// The name of the Postgres database.
+	DatabaseName string `json:"database_name"`
+	// The Postgres version in 'major.minor' format.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The major version of the Spock extension.
+	SpockVersion *string `json:"spock_version,omitempty"`
+	// The port used by the Postgres database. If the port is 0, each instance will
+	// be assigned a random port. If the port is unspecified, the database will not
+	// be exposed on any port, dependent on orchestrator support for that feature.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this database. If the port is 0, each instance
+	// will be assigned a random port. NOTE: This field is not currently supported
+	// for Docker Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database and to use for tuning
+	// Postgres. Defaults to the number of available CPUs on the host. Can include
+	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
+	// depends on the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database and
+	// to use for tuning Postgres. Defaults to the total available memory on the
+	// host. Whether this limit is enforced depends on the orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// The Spock nodes for this database.
+	Nodes []*DatabaseNodeSpecRequestBodyRequestBody `json:"nodes"`
+	// The users to create for this database.
+	DatabaseUsers []*DatabaseUserSpecRequestBodyRequestBody `json:"database_users,omitempty"`
+	// Service instances to run alongside the database (e.g., MCP servers).
+	Services []*ServiceSpecRequestBodyRequestBody `json:"services,omitempty"`
+	// The backup configuration for this database.
+	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this database.
+	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	// Additional postgresql.conf settings. Will be merged with the settings
+	// provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries, one rule per array element. Inserted between
+	// control-plane's system-user rules and its catch-all, so they cannot affect
+	// control-plane-internal connectivity (Patroni, replication, health checks).
+	// Node-level pg_hba_conf entries are prepended to these.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries, one mapping per array element. Purely
+	// additive; control-plane writes no pg_ident entries of its own. The primary
+	// use case is cert auth with map= translating certificate CNs to PostgreSQL
+	// usernames.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// User-defined SQL scripts that run at different points during the database
+	// creation process. Once a database has been successfully created, changes to
+	// these scripts will have no effect.
+	Scripts *DatabaseScriptsRequestBodyRequestBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsRequestBodyRequestBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsRequestBodyRequestBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
+}
+
+// DatabaseNodeSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseNodeSpecRequestBodyRequestBody struct {
+	// The name of the database node.
+	Name string `json:"name"`
+	// The IDs of the hosts that should run this node. When multiple hosts are
+	// specified, one host will chosen as a primary, and the others will be read
+	// replicas.
+	HostIds []string `json:"host_ids"`
+	// The Postgres version for this node in 'major.minor' format. Overrides the
+	// Postgres version set in the DatabaseSpec.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The port used by the Postgres database for this node. Overrides the Postgres
+	// port set in the DatabaseSpec.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this node. Overrides the Patroni port set in
+	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
+	// Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database on this node and to use for
+	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
+	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
+	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
+	// than are available on the host. Whether this limit is enforced depends on
+	// the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database on
+	// this node and to use for tuning Postgres. Defaults to the total available
+	// memory on the host. Whether this limit is enforced depends on the
+	// orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// Additional postgresql.conf settings for this particular node. Will be merged
+	// with the settings provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries for this particular node, one rule per array
+	// element. Prepended to the database-level pg_hba_conf entries, so node
+	// entries take first-match priority. Entries are inserted between
+	// control-plane's system-user rules and its catch-all, and cannot affect
+	// control-plane-internal connectivity.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries for this particular node, one mapping per
+	// array element. Prepended to the database-level pg_ident_conf entries.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// The backup configuration for this node. Overrides the backup configuration
+	// set in the DatabaseSpec.
+	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this node. Overrides the restore configuration
+	// set in the DatabaseSpec.
+	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// The name of the source node to use for sync. This is typically the node
+	// (like 'n1') from which the data will be copied to initialize this new node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsRequestBodyRequestBody `json:"patroni,omitempty"`
+}
+
+// BackupConfigSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type BackupConfigSpecRequestBodyRequestBody struct {
+	// The repositories for this backup configuration.
+	Repositories []*BackupRepositorySpecRequestBodyRequestBody `json:"repositories"`
+	// The schedules for this backup configuration.
+	Schedules []*BackupScheduleSpecRequestBodyRequestBody `json:"schedules,omitempty"`
+}
+
+// BackupRepositorySpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type BackupRepositorySpecRequestBodyRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain. This field will
+	// be excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile. This field will be
+	// excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// The Azure storage account access key to use for this repository. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The count of full backups to retain or the time to retain full backups.
+	RetentionFull *int `json:"retention_full,omitempty"`
+	// The type of measure used for retention_full.
+	RetentionFullType *string `json:"retention_full_type,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// BackupScheduleSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type BackupScheduleSpecRequestBodyRequestBody struct {
+	// The unique identifier for this backup schedule.
+	ID string `json:"id"`
+	// The type of backup to take on this schedule.
+	Type string `json:"type"`
+	// The cron expression for this schedule.
+	CronExpression string `json:"cron_expression"`
+}
+
+// RestoreConfigSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type RestoreConfigSpecRequestBodyRequestBody struct {
+	// The ID of the database to restore this database from.
+	SourceDatabaseID string `json:"source_database_id"`
+	// The name of the node to restore this database from.
+	SourceNodeName string `json:"source_node_name"`
+	// The name of the database in this repository. The database will be renamed to
+	// the database_name in the DatabaseSpec after it's restored.
+	SourceDatabaseName string `json:"source_database_name"`
+	// The repository to restore this database from.
+	Repository *RestoreRepositorySpecRequestBodyRequestBody `json:"repository"`
+	// Additional options to use when restoring this database. If omitted, the
+	// database will be restored to the latest point in the given repository.
+	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+}
+
+// RestoreRepositorySpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type RestoreRepositorySpecRequestBodyRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// An optional Azure storage account access key to use for this repository. If
+	// not provided, pgbackrest will use the VM's managed identity.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// OrchestratorOptsRequestBodyRequestBody is used to define fields on request
+// body types.
+type OrchestratorOptsRequestBodyRequestBody struct {
+	// Swarm-specific configuration.
+	Swarm *SwarmOptsRequestBodyRequestBody `json:"swarm,omitempty"`
+}
+
+// SwarmOptsRequestBodyRequestBody is used to define fields on request body
+// types.
+type SwarmOptsRequestBodyRequestBody struct {
+	// A list of extra volumes to mount. Each entry defines a host and container
+	// path.
+	ExtraVolumes []*ExtraVolumesSpecRequestBodyRequestBody `json:"extra_volumes,omitempty"`
+	// A list of additional Docker Swarm networks to attach containers in this
+	// database to.
+	ExtraNetworks []*ExtraNetworkSpecRequestBodyRequestBody `json:"extra_networks,omitempty"`
+	// Arbitrary labels to apply to the Docker Swarm service
+	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
+	// User-specified container image override. Bypasses manifest version
+	// constraints entirely — the CP will deploy this image without validating it
+	// against the version manifest. The CP verifies the image exists in its
+	// registry before accepting the spec. Clearing this field causes the CP to
+	// fall back to the manifest-resolved image on the next reconcile.
+	Image *string `json:"image,omitempty"`
+}
+
+// ExtraVolumesSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type ExtraVolumesSpecRequestBodyRequestBody struct {
+	// The host path for the volume.
+	HostPath string `json:"host_path"`
+	// The path inside the container where the volume will be mounted.
+	DestinationPath string `json:"destination_path"`
+}
+
+// ExtraNetworkSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type ExtraNetworkSpecRequestBodyRequestBody struct {
+	// The name or ID of the network to connect to.
+	ID string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsRequestBodyRequestBody is used to define fields on request
+// body types.
+type PatroniSettingsRequestBodyRequestBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogRequestBodyRequestBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogRequestBodyRequestBody is used to define fields on request
+// body types.
+type PatroniWatchdogRequestBodyRequestBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseUserSpecRequestBodyRequestBody struct {
+	// The username for this database user.
+	Username string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecRequestBodyRequestBody is used to define fields on request body
+// types.
+type ServiceSpecRequestBodyRequestBody struct {
+	// The unique identifier for this service.
+	ServiceID string `json:"service_id"`
+	// The type of service to run.
+	ServiceType string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionRequestBodyRequestBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs string `json:"connect_as"`
+}
+
+// DatabaseConnectionRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseConnectionRequestBodyRequestBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseScriptsRequestBodyRequestBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsRequestBodyRequestBody is used to define fields on request
+// body types.
+type QueryStatsSettingsRequestBodyRequestBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// PostgreSQLConfChangeResponseBody is used to define fields on response body
+// types.
+type PostgreSQLConfChangeResponseBody struct {
+	// The ID of the instance whose setting will change.
+	InstanceID *string `json:"instance_id"`
+	// The name of the setting.
+	Name *string `json:"name"`
+	// The current value of the setting, if it's currently set.
+	OldValue *string `json:"old_value,omitempty"`
+	// The new value of the setting, if it will still be set.
+	NewValue *string `json:"new_value,omitempty"`
+	// True if the instance must be restarted for this change to take effect.
+	RequiresRestart *bool `json:"requires_restart"`
+}
+
+// MajorUpgradeNodeSpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type MajorUpgradeNodeSpecRequestBodyRequestBody struct {
+	// The name of the node to replace.
+	Name string `json:"name"`
+	// The IDs of the hosts for the replacement node.
+	HostIds []string `json:"host_ids"`
+}
+
+// MaintenanceWindowRequestBodyRequestBody is used to define fields on request
+// body types.
+type MaintenanceWindowRequestBodyRequestBody struct {
+	// The time of day, in UTC, when the window opens.
+	Start string `json:"start"`
+	// The time of day, in UTC, when the window closes.
+	End string `json:"end"`
+}
+
+// InstanceQueryStatsResponseBody is used to define fields on response body
+// types.
+type InstanceQueryStatsResponseBody struct {
+	// The ID of the instance.
+	InstanceID *string `json:"instance_id"`
+	// The time that the statistics were collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+	// The time of the oldest retained collection, which is the start of the window
+	// for the window_* fields.
+	WindowStart *string `json:"window_start,omitempty"`
+	// The collected statements.
+	Queries []*QueryStatResponseBody `json:"queries"`
+}
+
+// QueryStatResponseBody is used to define fields on response body types.
+type QueryStatResponseBody struct {
+	// The statement's query ID.
+	QueryID *int64 `json:"query_id"`
+	// The normalized text of the statement, truncated to 1024 characters.
+	Query *string `json:"query"`
+	// The number of times the statement has been executed.
+	Calls *int64 `json:"calls"`
+	// The total time spent executing the statement, in milliseconds.
+	TotalTimeMs *float64 `json:"total_time_ms"`
+	// The mean time spent executing the statement, in milliseconds.
+	MeanTimeMs *float64 `json:"mean_time_ms"`
+	// The total number of rows retrieved or affected by the statement.
+	Rows *int64 `json:"rows"`
+	// The number of calls since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowCalls *int64 `json:"window_calls,omitempty"`
+	// The execution time since the start of the window, in milliseconds. Absent if
+	// the statement was not collected at the start of the window.
+	WindowTotalTimeMs *float64 `json:"window_total_time_ms,omitempty"`
+	// The number of rows since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowRows *int64 `json:"window_rows,omitempty"`
+}
+
+// TaskLogEntryResponseBody is used to define fields on response body types.
+type TaskLogEntryResponseBody struct {
+	// The timestamp of the log entry.
+	Timestamp *string `json:"timestamp"`
+	// The log message.
+	Message *string `json:"message"`
+	// Additional fields for the log entry.
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// WebhookResponseBody is used to define fields on response body types.
+type WebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID *string `json:"id"`
+	// The URL that events are sent to.
+	URL *string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt *string `json:"created_at"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Unique identifier for this delivery. Sent in the X-Control-Plane-Delivery
+	// header and the payload's delivery_id field.
+	DeliveryID *string `json:"delivery_id"`
+	// The webhook that this delivery was sent to.
+	WebhookID *string `json:"webhook_id"`
+	// The type of event that was delivered.
+	EventType *string `json:"event_type"`
+	// The status of this delivery. Pending deliveries are waiting for their first
+	// attempt or for a retry. Failed deliveries have used all of their attempts.
+	Status *string `json:"status"`
+	// The number of attempts made so far.
+	Attempts *int `json:"attempts"`
+	// The HTTP status code from the most recent attempt. Omitted if no response
+	// was received.
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+	// The error from the most recent attempt, if it failed.
+	LastError *string `json:"last_error,omitempty"`
+	// When the next attempt will be made. Only set for pending deliveries.
+	NextAttemptAt *string `json:"next_attempt_at,omitempty"`
+	// The time that the event occurred.
+	CreatedAt *string `json:"created_at"`
+	// The time of the most recent attempt.
+	UpdatedAt *string `json:"updated_at"`
+}
+
+// InstanceSessionResponseBody is used to define fields on response body types.
+type InstanceSessionResponseBody struct {
+	// The process ID of the session.
+	Pid *int `json:"pid"`
+	// The user that the session is logged in as.
+	Username *string `json:"username,omitempty"`
+	// The database that the session is connected to.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// The application name that the client provided.
+	ApplicationName *string `json:"application_name"`
+	// The address of the client.
+	ClientAddr *string `json:"client_addr,omitempty"`
+	// The state of the session, such as active or idle in transaction.
+	State *string `json:"state,omitempty"`
+	// The type of event that the session is waiting for.
+	WaitEventType *string `json:"wait_event_type,omitempty"`
+	// The event that the session is waiting for.
+	WaitEvent *string `json:"wait_event,omitempty"`
+	// The time that the session connected.
+	BackendStart *string `json:"backend_start,omitempty"`
+	// The time that the session's current transaction started.
+	XactStart *string `json:"xact_start,omitempty"`
+	// The time that the session's current or most recent query started.
+	QueryStart *string `json:"query_start,omitempty"`
+	// The time that the session's state last changed.
+	StateChange *string `json:"state_change,omitempty"`
+	// The session's current or most recent query, truncated to 1024 characters.
+	Query *string `json:"query"`
+	// The process IDs of the sessions that are blocking this session.
+	BlockedBy []int `json:"blocked_by,omitempty"`
+	// The locks that this session holds or is waiting for.
+	Locks []*SessionLockResponseBody `json:"locks,omitempty"`
+}
+
+// SessionLockResponseBody is used to define fields on response body types.
+type SessionLockResponseBody struct {
+	// The type of the locked object.
+	LockType *string `json:"lock_type"`
+	// The name of the locked relation. Only set for relations in the instance's
+	// database.
+	Relation *string `json:"relation,omitempty"`
+	// The lock mode.
+	Mode *string `json:"mode"`
+	// True if the lock is held, false if the session is waiting for it.
+	Granted *bool `json:"granted"`
+}
+
+// PostgreSQLSettingResponseBody is used to define fields on response body
+// types.
+type PostgreSQLSettingResponseBody struct {
+	// The name of the setting.
+	Name *string `json:"name"`
+	// The value of the setting.
+	Value *string `json:"value"`
+	// Where the value comes from. Tunable settings are derived from the instance's
+	// memory, CPUs, and cluster size.
+	Source *string `json:"source"`
+	// True if the instance must be restarted for this setting to take effect.
+	PendingRestart *bool `json:"pending_restart,omitempty"`
+}
+
+// NewJoinClusterRequestBody builds the HTTP request body from the payload of
+// the "join-cluster" endpoint of the "control-plane" service.
+func NewJoinClusterRequestBody(p *controlplane.ClusterJoinToken) *JoinClusterRequestBody {
+	body := &JoinClusterRequestBody{
+		Token: p.Token,
+	}
+	if p.ServerUrls != nil {
+		body.ServerUrls = make([]string, len(p.ServerUrls))
+		for i, val := range p.ServerUrls {
+			body.ServerUrls[i] = val
+		}
+	} else {
+		body.ServerUrls = []string{}
+	}
+	return body
+}
+
+// NewGetJoinOptionsRequestBody builds the HTTP request body from the payload
+// of the "get-join-options" endpoint of the "control-plane" service.
+func NewGetJoinOptionsRequestBody(p *controlplane.ClusterJoinRequest) *GetJoinOptionsRequestBody {
+	body := &GetJoinOptionsRequestBody{
+		Token:               p.Token,
+		HostID:              string(p.HostID),
+		EmbeddedEtcdEnabled: p.EmbeddedEtcdEnabled,
+	}
+	if p.Addresses != nil {
+		body.Addresses = make([]string, len(p.Addresses))
+		for i, val := range p.Addresses {
+			body.Addresses[i] = val
+		}
+	} else {
+		body.Addresses = []string{}
+	}
+	return body
+}
+
+// NewDefragmentEtcdRequestBody builds the HTTP request body from the payload
+// of the "defragment-etcd" endpoint of the "control-plane" service.
+func NewDefragmentEtcdRequestBody(p *controlplane.DefragmentEtcdRequest) *DefragmentEtcdRequestBody {
+	body := &DefragmentEtcdRequestBody{}
+	if p.HostIds != nil {
+		body.HostIds = make([]string, len(p.HostIds))
+		for i, val := range p.HostIds {
+			body.HostIds[i] = string(val)
+		}
+	}
+	return body
+}
+
+// NewChangeHostEtcdModeRequestBody builds the HTTP request body from the
+// payload of the "change-host-etcd-mode" endpoint of the "control-plane"
+// service.
+func NewChangeHostEtcdModeRequestBody(p *controlplane.ChangeHostEtcdModePayload) *ChangeHostEtcdModeRequestBody {
+	body := &ChangeHostEtcdModeRequestBody{
+		EtcdMode: p.Request.EtcdMode,
+	}
+	return body
+}
+
+// NewCreateDatabaseRequestBody builds the HTTP request body from the payload
+// of the "create-database" endpoint of the "control-plane" service.
+func NewCreateDatabaseRequestBody(p *controlplane.CreateDatabaseRequest) *CreateDatabaseRequestBody {
+	body := &CreateDatabaseRequestBody{}
+	if p.ID != nil {
+		id := string(*p.ID)
+		body.ID = &id
+	}
+	if p.TenantID != nil {
+		tenantID := string(*p.TenantID)
+		body.TenantID = &tenantID
+	}
+	if p.Spec != nil {
+		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecRequestBody(p.Spec)
+	}
+	if p.Source != nil {
+		body.Source = marshalControlplaneImportSourceSpecToImportSourceSpecRequestBody(p.Source)
+	}
+	return body
+}
+
+// NewUpdateDatabaseRequestBody builds the HTTP request body from the payload
+// of the "update-database" endpoint of the "control-plane" service.
+func NewUpdateDatabaseRequestBody(p *controlplane.UpdateDatabasePayload) *UpdateDatabaseRequestBody {
+	body := &UpdateDatabaseRequestBody{}
+	if p.Request.TenantID != nil {
+		tenantID := string(*p.Request.TenantID)
+		body.TenantID = &tenantID
+	}
+	if p.Request.Spec != nil {
+		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecRequestBodyRequestBody(p.Request.Spec)
+	}
+	return body
+}
+
+// NewApplyUpgradeRequestBody builds the HTTP request body from the payload of
+// the "apply-upgrade" endpoint of the "control-plane" service.
+func NewApplyUpgradeRequestBody(p *controlplane.ApplyUpgradePayload) *ApplyUpgradeRequestBody {
+	body := &ApplyUpgradeRequestBody{
+		Mode:            p.Request.Mode,
+		Image:           p.Request.Image,
+		PostgresVersion: p.Request.PostgresVersion,
+	}
+	{
+		var zero string
+		if body.Mode == zero {
+			body.Mode = "minor"
+		}
+	}
+	return body
+}
+
+// NewUpgradeDatabaseMajorRequestBody builds the HTTP request body from the
+// payload of the "upgrade-database-major" endpoint of the "control-plane"
+// service.
+func NewUpgradeDatabaseMajorRequestBody(p *controlplane.UpgradeDatabaseMajorPayload) *UpgradeDatabaseMajorRequestBody {
+	body := &UpgradeDatabaseMajorRequestBody{
+		PostgresVersion:   p.Request.PostgresVersion,
+		PauseBetweenNodes: p.Request.PauseBetweenNodes,
+	}
+	if p.Request.Nodes != nil {
+		body.Nodes = make([]*MajorUpgradeNodeSpecRequestBodyRequestBody, len(p.Request.Nodes))
+		for i, val := range p.Request.Nodes {
+			if val == nil {
+				body.Nodes[i] = nil
+				continue
+			}
+			body.Nodes[i] = marshalControlplaneMajorUpgradeNodeSpecToMajorUpgradeNodeSpecRequestBodyRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewBackupDatabaseNodeRequestBody builds the HTTP request body from the
+// payload of the "backup-database-node" endpoint of the "control-plane"
+// service.
+func NewBackupDatabaseNodeRequestBody(p *controlplane.BackupDatabaseNodePayload) *BackupDatabaseNodeRequestBody {
+	body := &BackupDatabaseNodeRequestBody{
+		Type: p.Options.Type,
+	}
+	if p.Options.Annotations != nil {
+		body.Annotations = make(map[string]string, len(p.Options.Annotations))
+		for key, val := range p.Options.Annotations {
+			tk := key
+			tv := val
+			body.Annotations[tk] = tv
+		}
+	}
+	if p.Options.BackupOptions != nil {
+		body.BackupOptions = make(map[string]string, len(p.Options.BackupOptions))
+		for key, val := range p.Options.BackupOptions {
+			tk := key
+			tv := val
+			body.BackupOptions[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewSwitchoverDatabaseNodeRequestBody builds the HTTP request body from the
+// payload of the "switchover-database-node" endpoint of the "control-plane"
+// service.
+func NewSwitchoverDatabaseNodeRequestBody(p *controlplane.SwitchoverDatabaseNodePayload) *SwitchoverDatabaseNodeRequestBody {
+	body := &SwitchoverDatabaseNodeRequestBody{
+		CandidateInstanceID: p.CandidateInstanceID,
+		ScheduledAt:         p.ScheduledAt,
+	}
+	return body
+}
+
+// NewRollingRestartDatabaseRequestBody builds the HTTP request body from the
+// payload of the "rolling-restart-database" endpoint of the "control-plane"
+// service.
+func NewRollingRestartDatabaseRequestBody(p *controlplane.RollingRestartDatabasePayload) *RollingRestartDatabaseRequestBody {
+	body := &RollingRestartDatabaseRequestBody{
+		AllInstances: p.Request.AllInstances,
+		Concurrency:  p.Request.Concurrency,
+		SwitchBack:   p.Request.SwitchBack,
+	}
+	{
+		var zero bool
+		if body.AllInstances == zero {
+			body.AllInstances = false
+		}
+	}
+	{
+		var zero int
+		if body.Concurrency == zero {
+			body.Concurrency = 1
+		}
+	}
+	{
+		var zero bool
+		if body.SwitchBack == zero {
+			body.SwitchBack = false
+		}
+	}
+	if p.Request.MaintenanceWindow != nil {
+		body.MaintenanceWindow = marshalControlplaneMaintenanceWindowToMaintenanceWindowRequestBodyRequestBody(p.Request.MaintenanceWindow)
+	}
+	return body
+}
+
+// NewFailoverDatabaseNodeRequestBody builds the HTTP request body from the
+// payload of the "failover-database-node" endpoint of the "control-plane"
+// service.
+func NewFailoverDatabaseNodeRequestBody(p *controlplane.FailoverDatabaseNodeRequest) *FailoverDatabaseNodeRequestBody {
+	body := &FailoverDatabaseNodeRequestBody{
+		CandidateInstanceID: p.CandidateInstanceID,
+		SkipValidation:      p.SkipValidation,
+	}
+	{
+		var zero bool
+		if body.SkipValidation == zero {
+			body.SkipValidation = false
+		}
+	}
+	return body
+}
+
+// NewCreateWebhookRequestBody builds the HTTP request body from the payload of
+// the "create-webhook" endpoint of the "control-plane" service.
+func NewCreateWebhookRequestBody(p *controlplane.CreateWebhookPayload) *CreateWebhookRequestBody {
+	body := &CreateWebhookRequestBody{
+		URL:         p.Request.URL,
+		Description: p.Request.Description,
+		Secret:      p.Request.Secret,
+	}
+	if p.Request.EventTypes != nil {
+		body.EventTypes = make([]string, len(p.Request.EventTypes))
+		for i, val := range p.Request.EventTypes {
+			body.EventTypes[i] = string(val)
+		}
+	}
+	if p.Request.DatabaseIds != nil {
+		body.DatabaseIds = make([]string, len(p.Request.DatabaseIds))
+		for i, val := range p.Request.DatabaseIds {
+			body.DatabaseIds[i] = string(val)
+		}
+	}
+	return body
+}
+
+// NewRestoreDatabaseRequestBody builds the HTTP request body from the payload
+// of the "restore-database" endpoint of the "control-plane" service.
+func NewRestoreDatabaseRequestBody(p *controlplane.RestoreDatabasePayload) *RestoreDatabaseRequestBody {
+	body := &RestoreDatabaseRequestBody{}
+	if p.Request.RestoreConfig != nil {
+		body.RestoreConfig = marshalControlplaneRestoreConfigSpecToRestoreConfigSpecRequestBodyRequestBody(p.Request.RestoreConfig)
+	}
+	if p.Request.TargetNodes != nil {
+		body.TargetNodes = make([]string, len(p.Request.TargetNodes))
+		for i, val := range p.Request.TargetNodes {
+			body.TargetNodes[i] = val
+		}
+	}
+	return body
+}
+
+// NewCloneDatabaseRequestBody builds the HTTP request body from the payload of
+// the "clone-database" endpoint of the "control-plane" service.
+func NewCloneDatabaseRequestBody(p *controlplane.CloneDatabasePayload) *CloneDatabaseRequestBody {
+	body := &CloneDatabaseRequestBody{
+		ID:           string(p.Request.ID),
+		SourceNode:   p.Request.SourceNode,
+		Method:       p.Request.Method,
+		TargetTime:   p.Request.TargetTime,
+		DatabaseName: p.Request.DatabaseName,
+	}
+	if p.Request.TenantID != nil {
+		tenantID := string(*p.Request.TenantID)
+		body.TenantID = &tenantID
+	}
+	{
+		var zero string
+		if body.Method == zero {
+			body.Method = "backup"
+		}
+	}
+	if p.Request.HostIds != nil {
+		body.HostIds = make([]string, len(p.Request.HostIds))
+		for i, val := range p.Request.HostIds {
+			body.HostIds[i] = string(val)
+		}
+	}
+	if p.Request.RenameUsers != nil {
+		body.RenameUsers = make(map[string]string, len(p.Request.RenameUsers))
+		for key, val := range p.Request.RenameUsers {
+			tk := key
+			tv := val
+			body.RenameUsers[tk] = tv
+		}
+	}
+	if p.Request.ScrubScript != nil {
+		body.ScrubScript = make([]string, len(p.Request.ScrubScript))
+		for i, val := range p.Request.ScrubScript {
+			body.ScrubScript[i] = val
+		}
+	}
+	return body
+}
+
+// NewInitClusterClusterJoinTokenOK builds a "control-plane" service
+// "init-cluster" endpoint result from a HTTP "OK" response.
+func NewInitClusterClusterJoinTokenOK(body *InitClusterResponseBody) *controlplane.ClusterJoinToken {
+	v := &controlplane.ClusterJoinToken{
+		Token: *body.Token,
+	}
+	v.ServerUrls = make([]string, len(body.ServerUrls))
+	for i, val := range body.ServerUrls {
+		v.ServerUrls[i] = val
+	}
+
+	return v
+}
+
+// NewInitClusterClusterAlreadyInitialized builds a control-plane service
+// init-cluster endpoint cluster_already_initialized error.
+func NewInitClusterClusterAlreadyInitialized(body *InitClusterClusterAlreadyInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInitClusterOperationNotSupported builds a control-plane service
+// init-cluster endpoint operation_not_supported error.
+func NewInitClusterOperationNotSupported(body *InitClusterOperationNotSupportedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInitClusterServerError builds a control-plane service init-cluster
+// endpoint server_error error.
+func NewInitClusterServerError(body *InitClusterServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewJoinClusterClusterAlreadyInitialized builds a control-plane service
+// join-cluster endpoint cluster_already_initialized error.
+func NewJoinClusterClusterAlreadyInitialized(body *JoinClusterClusterAlreadyInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewJoinClusterInvalidJoinToken builds a control-plane service join-cluster
+// endpoint invalid_join_token error.
+func NewJoinClusterInvalidJoinToken(body *JoinClusterInvalidJoinTokenResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewJoinClusterInvalidInput builds a control-plane service join-cluster
+// endpoint invalid_input error.
+func NewJoinClusterInvalidInput(body *JoinClusterInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewJoinClusterServerError builds a control-plane service join-cluster
+// endpoint server_error error.
+func NewJoinClusterServerError(body *JoinClusterServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinTokenClusterJoinTokenOK builds a "control-plane" service
+// "get-join-token" endpoint result from a HTTP "OK" response.
+func NewGetJoinTokenClusterJoinTokenOK(body *GetJoinTokenResponseBody) *controlplane.ClusterJoinToken {
+	v := &controlplane.ClusterJoinToken{
+		Token: *body.Token,
+	}
+	v.ServerUrls = make([]string, len(body.ServerUrls))
+	for i, val := range body.ServerUrls {
+		v.ServerUrls[i] = val
+	}
+
+	return v
+}
+
+// NewGetJoinTokenClusterNotInitialized builds a control-plane service
+// get-join-token endpoint cluster_not_initialized error.
+func NewGetJoinTokenClusterNotInitialized(body *GetJoinTokenClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinTokenServerError builds a control-plane service get-join-token
+// endpoint server_error error.
+func NewGetJoinTokenServerError(body *GetJoinTokenServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinOptionsClusterJoinOptionsOK builds a "control-plane" service
+// "get-join-options" endpoint result from a HTTP "OK" response.
+func NewGetJoinOptionsClusterJoinOptionsOK(body *GetJoinOptionsResponseBody) *controlplane.ClusterJoinOptions {
+	v := &controlplane.ClusterJoinOptions{}
+	v.Leader = unmarshalEtcdClusterMemberResponseBodyToControlplaneEtcdClusterMember(body.Leader)
+	v.Credentials = unmarshalClusterCredentialsResponseBodyToControlplaneClusterCredentials(body.Credentials)
+
+	return v
+}
+
+// NewGetJoinOptionsClusterNotInitialized builds a control-plane service
+// get-join-options endpoint cluster_not_initialized error.
+func NewGetJoinOptionsClusterNotInitialized(body *GetJoinOptionsClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinOptionsInvalidJoinToken builds a control-plane service
+// get-join-options endpoint invalid_join_token error.
+func NewGetJoinOptionsInvalidJoinToken(body *GetJoinOptionsInvalidJoinTokenResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinOptionsInvalidInput builds a control-plane service
+// get-join-options endpoint invalid_input error.
+func NewGetJoinOptionsInvalidInput(body *GetJoinOptionsInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetJoinOptionsServerError builds a control-plane service get-join-options
+// endpoint server_error error.
+func NewGetJoinOptionsServerError(body *GetJoinOptionsServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetClusterClusterOK builds a "control-plane" service "get-cluster"
+// endpoint result from a HTTP "OK" response.
+func NewGetClusterClusterOK(body *GetClusterResponseBody) *controlplane.Cluster {
+	v := &controlplane.Cluster{
+		ID: controlplane.Identifier(*body.ID),
+	}
+	v.Status = unmarshalClusterStatusResponseBodyToControlplaneClusterStatus(body.Status)
+	v.Hosts = make([]*controlplane.Host, len(body.Hosts))
+	for i, val := range body.Hosts {
+		if val == nil {
+			v.Hosts[i] = nil
+			continue
+		}
+		v.Hosts[i] = unmarshalHostResponseBodyToControlplaneHost(val)
+	}
+	if body.Upgrade != nil {
+		v.Upgrade = unmarshalClusterUpgradeStatusResponseBodyToControlplaneClusterUpgradeStatus(body.Upgrade)
+	}
+
+	return v
+}
+
+// NewGetClusterClusterNotInitialized builds a control-plane service
+// get-cluster endpoint cluster_not_initialized error.
+func NewGetClusterClusterNotInitialized(body *GetClusterClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetClusterServerError builds a control-plane service get-cluster endpoint
+// server_error error.
+func NewGetClusterServerError(body *GetClusterServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetStorageUsageStorageUsageOK builds a "control-plane" service
+// "get-storage-usage" endpoint result from a HTTP "OK" response.
+func NewGetStorageUsageStorageUsageOK(body *GetStorageUsageResponseBody) *controlplane.StorageUsage {
+	v := &controlplane.StorageUsage{
+		DbSizeBytes:      *body.DbSizeBytes,
+		DbSizeInUseBytes: *body.DbSizeInUseBytes,
+		Keys:             *body.Keys,
+		Bytes:            *body.Bytes,
+	}
+	v.Prefixes = make([]*controlplane.StoragePrefixUsage, len(body.Prefixes))
+	for i, val := range body.Prefixes {
+		if val == nil {
+			v.Prefixes[i] = nil
+			continue
+		}
+		v.Prefixes[i] = unmarshalStoragePrefixUsageResponseBodyToControlplaneStoragePrefixUsage(val)
+	}
+
+	return v
+}
+
+// NewGetStorageUsageClusterNotInitialized builds a control-plane service
+// get-storage-usage endpoint cluster_not_initialized error.
+func NewGetStorageUsageClusterNotInitialized(body *GetStorageUsageClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetStorageUsageServerError builds a control-plane service
+// get-storage-usage endpoint server_error error.
+func NewGetStorageUsageServerError(body *GetStorageUsageServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetEtcdClusterEtcdClusterOK builds a "control-plane" service
+// "get-etcd-cluster" endpoint result from a HTTP "OK" response.
+func NewGetEtcdClusterEtcdClusterOK(body *GetEtcdClusterResponseBody) *controlplane.EtcdCluster {
+	v := &controlplane.EtcdCluster{
+		Leader:           body.Leader,
+		Voters:           *body.Voters,
+		Learners:         *body.Learners,
+		Quorum:           *body.Quorum,
+		FailureTolerance: *body.FailureTolerance,
+	}
+	v.Members = make([]*controlplane.EtcdMember, len(body.Members))
+	for i, val := range body.Members {
+		if val == nil {
+			v.Members[i] = nil
+			continue
+		}
+		v.Members[i] = unmarshalEtcdMemberResponseBodyToControlplaneEtcdMember(val)
+	}
+	if body.Alarms != nil {
+		v.Alarms = make([]string, len(body.Alarms))
+		for i, val := range body.Alarms {
+			v.Alarms[i] = val
+		}
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetEtcdClusterClusterNotInitialized builds a control-plane service
+// get-etcd-cluster endpoint cluster_not_initialized error.
+func NewGetEtcdClusterClusterNotInitialized(body *GetEtcdClusterClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetEtcdClusterServerError builds a control-plane service get-etcd-cluster
+// endpoint server_error error.
+func NewGetEtcdClusterServerError(body *GetEtcdClusterServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDefragmentEtcdResponseOK builds a "control-plane" service
+// "defragment-etcd" endpoint result from a HTTP "OK" response.
+func NewDefragmentEtcdResponseOK(body *DefragmentEtcdResponseBody) *controlplane.DefragmentEtcdResponse {
+	v := &controlplane.DefragmentEtcdResponse{}
+	v.Results = make([]*controlplane.EtcdDefragmentResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalEtcdDefragmentResultResponseBodyToControlplaneEtcdDefragmentResult(val)
+	}
+
+	return v
+}
+
+// NewDefragmentEtcdClusterNotInitialized builds a control-plane service
+// defragment-etcd endpoint cluster_not_initialized error.
+func NewDefragmentEtcdClusterNotInitialized(body *DefragmentEtcdClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDefragmentEtcdInvalidInput builds a control-plane service defragment-etcd
+// endpoint invalid_input error.
+func NewDefragmentEtcdInvalidInput(body *DefragmentEtcdInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDefragmentEtcdServerError builds a control-plane service defragment-etcd
+// endpoint server_error error.
+func NewDefragmentEtcdServerError(body *DefragmentEtcdServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListHostsResponseOK builds a "control-plane" service "list-hosts"
+// endpoint result from a HTTP "OK" response.
+func NewListHostsResponseOK(body *ListHostsResponseBody) *controlplane.ListHostsResponse {
+	v := &controlplane.ListHostsResponse{
+		NextCursor: body.NextCursor,
+	}
+	v.Hosts = make([]*controlplane.Host, len(body.Hosts))
+	for i, val := range body.Hosts {
+		if val == nil {
+			v.Hosts[i] = nil
+			continue
+		}
+		v.Hosts[i] = unmarshalHostResponseBodyToControlplaneHost(val)
+	}
+
+	return v
+}
+
+// NewListHostsClusterNotInitialized builds a control-plane service list-hosts
+// endpoint cluster_not_initialized error.
+func NewListHostsClusterNotInitialized(body *ListHostsClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListHostsInvalidInput builds a control-plane service list-hosts endpoint
+// invalid_input error.
+func NewListHostsInvalidInput(body *ListHostsInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListHostsServerError builds a control-plane service list-hosts endpoint
+// server_error error.
+func NewListHostsServerError(body *ListHostsServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetHostHostOK builds a "control-plane" service "get-host" endpoint result
+// from a HTTP "OK" response.
+func NewGetHostHostOK(body *GetHostResponseBody) *controlplane.Host {
+	v := &controlplane.Host{
+		ID:           controlplane.Identifier(*body.ID),
+		Orchestrator: *body.Orchestrator,
+		DataDir:      *body.DataDir,
+		Cpus:         body.Cpus,
+		Memory:       body.Memory,
+		EtcdMode:     body.EtcdMode,
+	}
+	if body.Cohort != nil {
+		v.Cohort = unmarshalHostCohortResponseBodyToControlplaneHostCohort(body.Cohort)
+	}
+	v.PeerAddresses = make([]string, len(body.PeerAddresses))
+	for i, val := range body.PeerAddresses {
+		v.PeerAddresses[i] = val
+	}
+	v.ClientAddresses = make([]string, len(body.ClientAddresses))
+	for i, val := range body.ClientAddresses {
+		v.ClientAddresses[i] = val
+	}
+	v.Status = unmarshalHostStatusResponseBodyToControlplaneHostStatus(body.Status)
+	if body.DefaultPgedgeVersion != nil {
+		v.DefaultPgedgeVersion = unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion(body.DefaultPgedgeVersion)
+	}
+	if body.SupportedPgedgeVersions != nil {
+		v.SupportedPgedgeVersions = make([]*controlplane.PgEdgeVersion, len(body.SupportedPgedgeVersions))
+		for i, val := range body.SupportedPgedgeVersions {
+			if val == nil {
+				v.SupportedPgedgeVersions[i] = nil
+				continue
+			}
+			v.SupportedPgedgeVersions[i] = unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetHostClusterNotInitialized builds a control-plane service get-host
+// endpoint cluster_not_initialized error.
+func NewGetHostClusterNotInitialized(body *GetHostClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetHostInvalidInput builds a control-plane service get-host endpoint
+// invalid_input error.
+func NewGetHostInvalidInput(body *GetHostInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetHostNotFound builds a control-plane service get-host endpoint
+// not_found error.
+func NewGetHostNotFound(body *GetHostNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetHostServerError builds a control-plane service get-host endpoint
+// server_error error.
+func NewGetHostServerError(body *GetHostServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveHostResponseOK builds a "control-plane" service "remove-host"
+// endpoint result from a HTTP "OK" response.
+func NewRemoveHostResponseOK(body *RemoveHostResponseBody) *controlplane.RemoveHostResponse {
+	v := &controlplane.RemoveHostResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.UpdateDatabaseTasks = make([]*controlplane.Task, len(body.UpdateDatabaseTasks))
+	for i, val := range body.UpdateDatabaseTasks {
+		if val == nil {
+			v.UpdateDatabaseTasks[i] = nil
+			continue
+		}
+		v.UpdateDatabaseTasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+	}
+
+	return v
+}
+
+// NewRemoveHostClusterNotInitialized builds a control-plane service
+// remove-host endpoint cluster_not_initialized error.
+func NewRemoveHostClusterNotInitialized(body *RemoveHostClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveHostInvalidInput builds a control-plane service remove-host
+// endpoint invalid_input error.
+func NewRemoveHostInvalidInput(body *RemoveHostInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveHostNotFound builds a control-plane service remove-host endpoint
+// not_found error.
+func NewRemoveHostNotFound(body *RemoveHostNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveHostServerError builds a control-plane service remove-host endpoint
+// server_error error.
+func NewRemoveHostServerError(body *RemoveHostServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeHostEtcdModeResponseOK builds a "control-plane" service
+// "change-host-etcd-mode" endpoint result from a HTTP "OK" response.
+func NewChangeHostEtcdModeResponseOK(body *ChangeHostEtcdModeResponseBody) *controlplane.ChangeHostEtcdModeResponse {
+	v := &controlplane.ChangeHostEtcdModeResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewChangeHostEtcdModeClusterNotInitialized builds a control-plane service
+// change-host-etcd-mode endpoint cluster_not_initialized error.
+func NewChangeHostEtcdModeClusterNotInitialized(body *ChangeHostEtcdModeClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeHostEtcdModeInvalidInput builds a control-plane service
+// change-host-etcd-mode endpoint invalid_input error.
+func NewChangeHostEtcdModeInvalidInput(body *ChangeHostEtcdModeInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeHostEtcdModeNotFound builds a control-plane service
+// change-host-etcd-mode endpoint not_found error.
+func NewChangeHostEtcdModeNotFound(body *ChangeHostEtcdModeNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewChangeHostEtcdModeServerError builds a control-plane service
+// change-host-etcd-mode endpoint server_error error.
+func NewChangeHostEtcdModeServerError(body *ChangeHostEtcdModeServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDatabasesResponseOK builds a "control-plane" service "list-databases"
+// endpoint result from a HTTP "OK" response.
+func NewListDatabasesResponseOK(body *ListDatabasesResponseBody) *controlplane.ListDatabasesResponse {
+	v := &controlplane.ListDatabasesResponse{
+		NextCursor: body.NextCursor,
+	}
+	v.Databases = make([]*controlplane.DatabaseSummary, len(body.Databases))
+	for i, val := range body.Databases {
+		if val == nil {
+			v.Databases[i] = nil
+			continue
+		}
+		v.Databases[i] = unmarshalDatabaseSummaryResponseBodyToControlplaneDatabaseSummary(val)
+	}
+
+	return v
+}
+
+// NewListDatabasesClusterNotInitialized builds a control-plane service
+// list-databases endpoint cluster_not_initialized error.
+func NewListDatabasesClusterNotInitialized(body *ListDatabasesClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDatabasesInvalidInput builds a control-plane service list-databases
+// endpoint invalid_input error.
+func NewListDatabasesInvalidInput(body *ListDatabasesInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDatabasesServerError builds a control-plane service list-databases
+// endpoint server_error error.
+func NewListDatabasesServerError(body *ListDatabasesServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateDatabaseResponseOK builds a "control-plane" service
+// "create-database" endpoint result from a HTTP "OK" response.
+func NewCreateDatabaseResponseOK(body *CreateDatabaseResponseBody) *controlplane.CreateDatabaseResponse {
+	v := &controlplane.CreateDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+
+	return v
+}
+
+// NewCreateDatabaseDatabaseAlreadyExists builds a control-plane service
+// create-database endpoint database_already_exists error.
+func NewCreateDatabaseDatabaseAlreadyExists(body *CreateDatabaseDatabaseAlreadyExistsResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateDatabaseClusterNotInitialized builds a control-plane service
+// create-database endpoint cluster_not_initialized error.
+func NewCreateDatabaseClusterNotInitialized(body *CreateDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateDatabaseOperationAlreadyInProgress builds a control-plane service
+// create-database endpoint operation_already_in_progress error.
+func NewCreateDatabaseOperationAlreadyInProgress(body *CreateDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateDatabaseInvalidInput builds a control-plane service create-database
+// endpoint invalid_input error.
+func NewCreateDatabaseInvalidInput(body *CreateDatabaseInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateDatabaseServerError builds a control-plane service create-database
+// endpoint server_error error.
+func NewCreateDatabaseServerError(body *CreateDatabaseServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDatabaseDatabaseOK builds a "control-plane" service "get-database"
+// endpoint result from a HTTP "OK" response.
+func NewGetDatabaseDatabaseOK(body *GetDatabaseResponseBody) *controlplane.Database {
+	v := &controlplane.Database{
+		ID:        controlplane.Identifier(*body.ID),
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		State:     *body.State,
+	}
+	if body.TenantID != nil {
+		tenantID := controlplane.Identifier(*body.TenantID)
+		v.TenantID = &tenantID
+	}
+	if body.DegradedReasons != nil {
+		v.DegradedReasons = make([]string, len(body.DegradedReasons))
+		for i, val := range body.DegradedReasons {
+			v.DegradedReasons[i] = val
+		}
+	}
+	if body.Instances != nil {
+		v.Instances = make([]*controlplane.Instance, len(body.Instances))
+		for i, val := range body.Instances {
+			if val == nil {
+				v.Instances[i] = nil
+				continue
+			}
+			v.Instances[i] = unmarshalInstanceResponseBodyToControlplaneInstance(val)
+		}
+	}
+	if body.ServiceInstances != nil {
+		v.ServiceInstances = make([]*controlplane.ServiceInstance, len(body.ServiceInstances))
+		for i, val := range body.ServiceInstances {
+			if val == nil {
+				v.ServiceInstances[i] = nil
+				continue
+			}
+			v.ServiceInstances[i] = unmarshalServiceInstanceResponseBodyToControlplaneServiceInstance(val)
+		}
+	}
+	if body.Spec != nil {
+		v.Spec = unmarshalDatabaseSpecResponseBodyToControlplaneDatabaseSpec(body.Spec)
+	}
+	if body.AvailableUpgrades != nil {
+		v.AvailableUpgrades = make([]*controlplane.AvailableUpgrade, len(body.AvailableUpgrades))
+		for i, val := range body.AvailableUpgrades {
+			if val == nil {
+				v.AvailableUpgrades[i] = nil
+				continue
+			}
+			v.AvailableUpgrades[i] = unmarshalAvailableUpgradeResponseBodyToControlplaneAvailableUpgrade(val)
+		}
+	}
+	if body.Drift != nil {
+		v.Drift = unmarshalDatabaseDriftResponseBodyToControlplaneDatabaseDrift(body.Drift)
+	}
+
+	return v
+}
+
+// NewGetDatabaseClusterNotInitialized builds a control-plane service
+// get-database endpoint cluster_not_initialized error.
+func NewGetDatabaseClusterNotInitialized(body *GetDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDatabaseInvalidInput builds a control-plane service get-database
+// endpoint invalid_input error.
+func NewGetDatabaseInvalidInput(body *GetDatabaseInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDatabaseNotFound builds a control-plane service get-database endpoint
+// not_found error.
+func NewGetDatabaseNotFound(body *GetDatabaseNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDatabaseServerError builds a control-plane service get-database
+// endpoint server_error error.
+func NewGetDatabaseServerError(body *GetDatabaseServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateDatabaseResponseOK builds a "control-plane" service
+// "update-database" endpoint result from a HTTP "OK" response.
+func NewUpdateDatabaseResponseOK(body *UpdateDatabaseResponseBody) *controlplane.UpdateDatabaseResponse {
+	v := &controlplane.UpdateDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+	if body.PostgresqlConfChanges != nil {
+		v.PostgresqlConfChanges = make([]*controlplane.PostgreSQLConfChange, len(body.PostgresqlConfChanges))
+		for i, val := range body.PostgresqlConfChanges {
+			if val == nil {
+				v.PostgresqlConfChanges[i] = nil
+				continue
+			}
+			v.PostgresqlConfChanges[i] = unmarshalPostgreSQLConfChangeResponseBodyToControlplanePostgreSQLConfChange(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateDatabaseClusterNotInitialized builds a control-plane service
+// update-database endpoint cluster_not_initialized error.
+func NewUpdateDatabaseClusterNotInitialized(body *UpdateDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateDatabaseDatabaseNotModifiable builds a control-plane service
+// update-database endpoint database_not_modifiable error.
+func NewUpdateDatabaseDatabaseNotModifiable(body *UpdateDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
 }
 
-// DatabaseUserSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseUserSpecRequestBodyRequestBody struct {
-	// The username for this database user.
-	Username string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+// NewUpdateDatabaseOperationAlreadyInProgress builds a control-plane service
+// update-database endpoint operation_already_in_progress error.
+func NewUpdateDatabaseOperationAlreadyInProgress(body *UpdateDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
 }
 
-// ServiceSpecRequestBodyRequestBody is used to define fields on request body
-// types.
-type ServiceSpecRequestBodyRequestBody struct {
-	// The unique identifier for this service.
-	ServiceID string `json:"service_id"`
-	// The type of service to run.
-	ServiceType string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
-	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionRequestBodyRequestBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs string `json:"connect_as"`
+// NewUpdateDatabaseInvalidInput builds a control-plane service update-database
+// endpoint invalid_input error.
+func NewUpdateDatabaseInvalidInput(body *UpdateDatabaseInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
 }
 
-// DatabaseConnectionRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseConnectionRequestBodyRequestBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+// NewUpdateDatabaseNotFound builds a control-plane service update-database
+// endpoint not_found error.
+func NewUpdateDatabaseNotFound(body *UpdateDatabaseNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
 }
 
-// DatabaseScriptsRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseScriptsRequestBodyRequestBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+// NewUpdateDatabaseServerError builds a control-plane service update-database
+// endpoint server_error error.
+func NewUpdateDatabaseServerError(body *UpdateDatabaseServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
 }
 
-// TaskLogEntryResponseBody is used to define fields on response body types.
-type TaskLogEntryResponseBody struct {
-	// The timestamp of the log entry.
-	Timestamp *string `json:"timestamp"`
-	// The log message.
-	Message *string `json:"message"`
-	// Additional fields for the log entry.
-	Fields map[string]any `json:"fields,omitempty"`
+// NewApplyUpgradeResponseOK builds a "control-plane" service "apply-upgrade"
+// endpoint result from a HTTP "OK" response.
+func NewApplyUpgradeResponseOK(body *ApplyUpgradeResponseBody) *controlplane.ApplyUpgradeResponse {
+	v := &controlplane.ApplyUpgradeResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+
+	return v
 }
 
-// NewJoinClusterRequestBody builds the HTTP request body from the payload of
-// the "join-cluster" endpoint of the "control-plane" service.
-func NewJoinClusterRequestBody(p *controlplane.ClusterJoinToken) *JoinClusterRequestBody {
-	body := &JoinClusterRequestBody{
-		Token: p.Token,
+// NewApplyUpgradeClusterNotInitialized builds a control-plane service
+// apply-upgrade endpoint cluster_not_initialized error.
+func NewApplyUpgradeClusterNotInitialized(body *ApplyUpgradeClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if p.ServerUrls != nil {
-		body.ServerUrls = make([]string, len(p.ServerUrls))
-		for i, val := range p.ServerUrls {
-			body.ServerUrls[i] = val
-		}
-	} else {
-		body.ServerUrls = []string{}
+
+	return v
+}
+
+// NewApplyUpgradeDatabaseNotModifiable builds a control-plane service
+// apply-upgrade endpoint database_not_modifiable error.
+func NewApplyUpgradeDatabaseNotModifiable(body *ApplyUpgradeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewGetJoinOptionsRequestBody builds the HTTP request body from the payload
-// of the "get-join-options" endpoint of the "control-plane" service.
-func NewGetJoinOptionsRequestBody(p *controlplane.ClusterJoinRequest) *GetJoinOptionsRequestBody {
-	body := &GetJoinOptionsRequestBody{
-		Token:               p.Token,
-		HostID:              string(p.HostID),
-		EmbeddedEtcdEnabled: p.EmbeddedEtcdEnabled,
+// NewApplyUpgradeOperationAlreadyInProgress builds a control-plane service
+// apply-upgrade endpoint operation_already_in_progress error.
+func NewApplyUpgradeOperationAlreadyInProgress(body *ApplyUpgradeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if p.Addresses != nil {
-		body.Addresses = make([]string, len(p.Addresses))
-		for i, val := range p.Addresses {
-			body.Addresses[i] = val
-		}
-	} else {
-		body.Addresses = []string{}
+
+	return v
+}
+
+// NewApplyUpgradeInvalidInput builds a control-plane service apply-upgrade
+// endpoint invalid_input error.
+func NewApplyUpgradeInvalidInput(body *ApplyUpgradeInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewCreateDatabaseRequestBody builds the HTTP request body from the payload
-// of the "create-database" endpoint of the "control-plane" service.
-func NewCreateDatabaseRequestBody(p *controlplane.CreateDatabaseRequest) *CreateDatabaseRequestBody {
-	body := &CreateDatabaseRequestBody{}
-	if p.ID != nil {
-		id := string(*p.ID)
-		body.ID = &id
+// NewApplyUpgradeNotFound builds a control-plane service apply-upgrade
+// endpoint not_found error.
+func NewApplyUpgradeNotFound(body *ApplyUpgradeNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if p.TenantID != nil {
-		tenantID := string(*p.TenantID)
-		body.TenantID = &tenantID
+
+	return v
+}
+
+// NewApplyUpgradeServerError builds a control-plane service apply-upgrade
+// endpoint server_error error.
+func NewApplyUpgradeServerError(body *ApplyUpgradeServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if p.Spec != nil {
-		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecRequestBody(p.Spec)
+
+	return v
+}
+
+// NewUpgradeDatabaseMajorResponseOK builds a "control-plane" service
+// "upgrade-database-major" endpoint result from a HTTP "OK" response.
+func NewUpgradeDatabaseMajorResponseOK(body *UpgradeDatabaseMajorResponseBody) *controlplane.UpgradeDatabaseMajorResponse {
+	v := &controlplane.UpgradeDatabaseMajorResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+
+	return v
+}
+
+// NewUpgradeDatabaseMajorClusterNotInitialized builds a control-plane service
+// upgrade-database-major endpoint cluster_not_initialized error.
+func NewUpgradeDatabaseMajorClusterNotInitialized(body *UpgradeDatabaseMajorClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewUpdateDatabaseRequestBody builds the HTTP request body from the payload
-// of the "update-database" endpoint of the "control-plane" service.
-func NewUpdateDatabaseRequestBody(p *controlplane.UpdateDatabasePayload) *UpdateDatabaseRequestBody {
-	body := &UpdateDatabaseRequestBody{}
-	if p.Request.TenantID != nil {
-		tenantID := string(*p.Request.TenantID)
-		body.TenantID = &tenantID
-	}
-	if p.Request.Spec != nil {
-		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecRequestBodyRequestBody(p.Request.Spec)
+// NewUpgradeDatabaseMajorDatabaseNotModifiable builds a control-plane service
+// upgrade-database-major endpoint database_not_modifiable error.
+func NewUpgradeDatabaseMajorDatabaseNotModifiable(body *UpgradeDatabaseMajorDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewApplyUpgradeRequestBody builds the HTTP request body from the payload of
-// the "apply-upgrade" endpoint of the "control-plane" service.
-func NewApplyUpgradeRequestBody(p *controlplane.ApplyUpgradePayload) *ApplyUpgradeRequestBody {
-	body := &ApplyUpgradeRequestBody{
-		Image: p.Request.Image,
+// NewUpgradeDatabaseMajorOperationAlreadyInProgress builds a control-plane
+// service upgrade-database-major endpoint operation_already_in_progress error.
+func NewUpgradeDatabaseMajorOperationAlreadyInProgress(body *UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewBackupDatabaseNodeRequestBody builds the HTTP request body from the
-// payload of the "backup-database-node" endpoint of the "control-plane"
-// service.
-func NewBackupDatabaseNodeRequestBody(p *controlplane.BackupDatabaseNodePayload) *BackupDatabaseNodeRequestBody {
-	body := &BackupDatabaseNodeRequestBody{
-		Type: p.Options.Type,
-	}
-	if p.Options.Annotations != nil {
-		body.Annotations = make(map[string]string, len(p.Options.Annotations))
-		for key, val := range p.Options.Annotations {
-			tk := key
-			tv := val
-			body.Annotations[tk] = tv
-		}
-	}
-	if p.Options.BackupOptions != nil {
-		body.BackupOptions = make(map[string]string, len(p.Options.BackupOptions))
-		for key, val := range p.Options.BackupOptions {
-			tk := key
-			tv := val
-			body.BackupOptions[tk] = tv
-		}
+// NewUpgradeDatabaseMajorInvalidInput builds a control-plane service
+// upgrade-database-major endpoint invalid_input error.
+func NewUpgradeDatabaseMajorInvalidInput(body *UpgradeDatabaseMajorInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewSwitchoverDatabaseNodeRequestBody builds the HTTP request body from the
-// payload of the "switchover-database-node" endpoint of the "control-plane"
-// service.
-func NewSwitchoverDatabaseNodeRequestBody(p *controlplane.SwitchoverDatabaseNodePayload) *SwitchoverDatabaseNodeRequestBody {
-	body := &SwitchoverDatabaseNodeRequestBody{
-		CandidateInstanceID: p.CandidateInstanceID,
-		ScheduledAt:         p.ScheduledAt,
+// NewUpgradeDatabaseMajorNotFound builds a control-plane service
+// upgrade-database-major endpoint not_found error.
+func NewUpgradeDatabaseMajorNotFound(body *UpgradeDatabaseMajorNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewFailoverDatabaseNodeRequestBody builds the HTTP request body from the
-// payload of the "failover-database-node" endpoint of the "control-plane"
-// service.
-func NewFailoverDatabaseNodeRequestBody(p *controlplane.FailoverDatabaseNodeRequest) *FailoverDatabaseNodeRequestBody {
-	body := &FailoverDatabaseNodeRequestBody{
-		CandidateInstanceID: p.CandidateInstanceID,
-		SkipValidation:      p.SkipValidation,
-	}
-	{
-		var zero bool
-		if body.SkipValidation == zero {
-			body.SkipValidation = false
-		}
+// NewUpgradeDatabaseMajorServerError builds a control-plane service
+// upgrade-database-major endpoint server_error error.
+func NewUpgradeDatabaseMajorServerError(body *UpgradeDatabaseMajorServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewRestoreDatabaseRequestBody builds the HTTP request body from the payload
-// of the "restore-database" endpoint of the "control-plane" service.
-func NewRestoreDatabaseRequestBody(p *controlplane.RestoreDatabasePayload) *RestoreDatabaseRequestBody {
-	body := &RestoreDatabaseRequestBody{}
-	if p.Request.RestoreConfig != nil {
-		body.RestoreConfig = marshalControlplaneRestoreConfigSpecToRestoreConfigSpecRequestBodyRequestBody(p.Request.RestoreConfig)
+// NewCutoverDatabaseImportResponseOK builds a "control-plane" service
+// "cutover-database-import" endpoint result from a HTTP "OK" response.
+func NewCutoverDatabaseImportResponseOK(body *CutoverDatabaseImportResponseBody) *controlplane.CutoverDatabaseImportResponse {
+	v := &controlplane.CutoverDatabaseImportResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+
+	return v
+}
+
+// NewCutoverDatabaseImportClusterNotInitialized builds a control-plane service
+// cutover-database-import endpoint cluster_not_initialized error.
+func NewCutoverDatabaseImportClusterNotInitialized(body *CutoverDatabaseImportClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if p.Request.TargetNodes != nil {
-		body.TargetNodes = make([]string, len(p.Request.TargetNodes))
-		for i, val := range p.Request.TargetNodes {
-			body.TargetNodes[i] = val
-		}
+
+	return v
+}
+
+// NewCutoverDatabaseImportDatabaseNotModifiable builds a control-plane service
+// cutover-database-import endpoint database_not_modifiable error.
+func NewCutoverDatabaseImportDatabaseNotModifiable(body *CutoverDatabaseImportDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	return body
+
+	return v
 }
 
-// NewInitClusterClusterJoinTokenOK builds a "control-plane" service
-// "init-cluster" endpoint result from a HTTP "OK" response.
-func NewInitClusterClusterJoinTokenOK(body *InitClusterResponseBody) *controlplane.ClusterJoinToken {
-	v := &controlplane.ClusterJoinToken{
-		Token: *body.Token,
+// NewCutoverDatabaseImportOperationAlreadyInProgress builds a control-plane
+// service cutover-database-import endpoint operation_already_in_progress error.
+func NewCutoverDatabaseImportOperationAlreadyInProgress(body *CutoverDatabaseImportOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	v.ServerUrls = make([]string, len(body.ServerUrls))
-	for i, val := range body.ServerUrls {
-		v.ServerUrls[i] = val
+
+	return v
+}
+
+// NewCutoverDatabaseImportInvalidInput builds a control-plane service
+// cutover-database-import endpoint invalid_input error.
+func NewCutoverDatabaseImportInvalidInput(body *CutoverDatabaseImportInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewInitClusterClusterAlreadyInitialized builds a control-plane service
-// init-cluster endpoint cluster_already_initialized error.
-func NewInitClusterClusterAlreadyInitialized(body *InitClusterClusterAlreadyInitializedResponseBody) *controlplane.APIError {
+// NewCutoverDatabaseImportNotFound builds a control-plane service
+// cutover-database-import endpoint not_found error.
+func NewCutoverDatabaseImportNotFound(body *CutoverDatabaseImportNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3276,9 +6383,9 @@ func NewInitClusterClusterAlreadyInitialized(body *InitClusterClusterAlreadyInit
 	return v
 }
 
-// NewInitClusterOperationNotSupported builds a control-plane service
-// init-cluster endpoint operation_not_supported error.
-func NewInitClusterOperationNotSupported(body *InitClusterOperationNotSupportedResponseBody) *controlplane.APIError {
+// NewCutoverDatabaseImportServerError builds a control-plane service
+// cutover-database-import endpoint server_error error.
+func NewCutoverDatabaseImportServerError(body *CutoverDatabaseImportServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3287,9 +6394,18 @@ func NewInitClusterOperationNotSupported(body *InitClusterOperationNotSupportedR
 	return v
 }
 
-// NewInitClusterServerError builds a control-plane service init-cluster
-// endpoint server_error error.
-func NewInitClusterServerError(body *InitClusterServerErrorResponseBody) *controlplane.APIError {
+// NewDeleteDatabaseResponseOK builds a "control-plane" service
+// "delete-database" endpoint result from a HTTP "OK" response.
+func NewDeleteDatabaseResponseOK(body *DeleteDatabaseResponseBody) *controlplane.DeleteDatabaseResponse {
+	v := &controlplane.DeleteDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewDeleteDatabaseClusterNotInitialized builds a control-plane service
+// delete-database endpoint cluster_not_initialized error.
+func NewDeleteDatabaseClusterNotInitialized(body *DeleteDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3298,9 +6414,9 @@ func NewInitClusterServerError(body *InitClusterServerErrorResponseBody) *contro
 	return v
 }
 
-// NewJoinClusterClusterAlreadyInitialized builds a control-plane service
-// join-cluster endpoint cluster_already_initialized error.
-func NewJoinClusterClusterAlreadyInitialized(body *JoinClusterClusterAlreadyInitializedResponseBody) *controlplane.APIError {
+// NewDeleteDatabaseDatabaseNotModifiable builds a control-plane service
+// delete-database endpoint database_not_modifiable error.
+func NewDeleteDatabaseDatabaseNotModifiable(body *DeleteDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3309,9 +6425,9 @@ func NewJoinClusterClusterAlreadyInitialized(body *JoinClusterClusterAlreadyInit
 	return v
 }
 
-// NewJoinClusterInvalidJoinToken builds a control-plane service join-cluster
-// endpoint invalid_join_token error.
-func NewJoinClusterInvalidJoinToken(body *JoinClusterInvalidJoinTokenResponseBody) *controlplane.APIError {
+// NewDeleteDatabaseOperationAlreadyInProgress builds a control-plane service
+// delete-database endpoint operation_already_in_progress error.
+func NewDeleteDatabaseOperationAlreadyInProgress(body *DeleteDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3320,9 +6436,9 @@ func NewJoinClusterInvalidJoinToken(body *JoinClusterInvalidJoinTokenResponseBod
 	return v
 }
 
-// NewJoinClusterInvalidInput builds a control-plane service join-cluster
+// NewDeleteDatabaseInvalidInput builds a control-plane service delete-database
 // endpoint invalid_input error.
-func NewJoinClusterInvalidInput(body *JoinClusterInvalidInputResponseBody) *controlplane.APIError {
+func NewDeleteDatabaseInvalidInput(body *DeleteDatabaseInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3331,9 +6447,20 @@ func NewJoinClusterInvalidInput(body *JoinClusterInvalidInputResponseBody) *cont
 	return v
 }
 
-// NewJoinClusterServerError builds a control-plane service join-cluster
+// NewDeleteDatabaseNotFound builds a control-plane service delete-database
+// endpoint not_found error.
+func NewDeleteDatabaseNotFound(body *DeleteDatabaseNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteDatabaseServerError builds a control-plane service delete-database
 // endpoint server_error error.
-func NewJoinClusterServerError(body *JoinClusterServerErrorResponseBody) *controlplane.APIError {
+func NewDeleteDatabaseServerError(body *DeleteDatabaseServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3342,23 +6469,40 @@ func NewJoinClusterServerError(body *JoinClusterServerErrorResponseBody) *contro
 	return v
 }
 
-// NewGetJoinTokenClusterJoinTokenOK builds a "control-plane" service
-// "get-join-token" endpoint result from a HTTP "OK" response.
-func NewGetJoinTokenClusterJoinTokenOK(body *GetJoinTokenResponseBody) *controlplane.ClusterJoinToken {
-	v := &controlplane.ClusterJoinToken{
-		Token: *body.Token,
+// NewBackupDatabaseNodeResponseOK builds a "control-plane" service
+// "backup-database-node" endpoint result from a HTTP "OK" response.
+func NewBackupDatabaseNodeResponseOK(body *BackupDatabaseNodeResponseBody) *controlplane.BackupDatabaseNodeResponse {
+	v := &controlplane.BackupDatabaseNodeResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewBackupDatabaseNodeClusterNotInitialized builds a control-plane service
+// backup-database-node endpoint cluster_not_initialized error.
+func NewBackupDatabaseNodeClusterNotInitialized(body *BackupDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	v.ServerUrls = make([]string, len(body.ServerUrls))
-	for i, val := range body.ServerUrls {
-		v.ServerUrls[i] = val
+
+	return v
+}
+
+// NewBackupDatabaseNodeDatabaseNotModifiable builds a control-plane service
+// backup-database-node endpoint database_not_modifiable error.
+func NewBackupDatabaseNodeDatabaseNotModifiable(body *BackupDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetJoinTokenClusterNotInitialized builds a control-plane service
-// get-join-token endpoint cluster_not_initialized error.
-func NewGetJoinTokenClusterNotInitialized(body *GetJoinTokenClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewBackupDatabaseNodeOperationAlreadyInProgress builds a control-plane
+// service backup-database-node endpoint operation_already_in_progress error.
+func NewBackupDatabaseNodeOperationAlreadyInProgress(body *BackupDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3367,9 +6511,9 @@ func NewGetJoinTokenClusterNotInitialized(body *GetJoinTokenClusterNotInitialize
 	return v
 }
 
-// NewGetJoinTokenServerError builds a control-plane service get-join-token
-// endpoint server_error error.
-func NewGetJoinTokenServerError(body *GetJoinTokenServerErrorResponseBody) *controlplane.APIError {
+// NewBackupDatabaseNodeInvalidInput builds a control-plane service
+// backup-database-node endpoint invalid_input error.
+func NewBackupDatabaseNodeInvalidInput(body *BackupDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3378,19 +6522,20 @@ func NewGetJoinTokenServerError(body *GetJoinTokenServerErrorResponseBody) *cont
 	return v
 }
 
-// NewGetJoinOptionsClusterJoinOptionsOK builds a "control-plane" service
-// "get-join-options" endpoint result from a HTTP "OK" response.
-func NewGetJoinOptionsClusterJoinOptionsOK(body *GetJoinOptionsResponseBody) *controlplane.ClusterJoinOptions {
-	v := &controlplane.ClusterJoinOptions{}
-	v.Leader = unmarshalEtcdClusterMemberResponseBodyToControlplaneEtcdClusterMember(body.Leader)
-	v.Credentials = unmarshalClusterCredentialsResponseBodyToControlplaneClusterCredentials(body.Credentials)
+// NewBackupDatabaseNodeNotFound builds a control-plane service
+// backup-database-node endpoint not_found error.
+func NewBackupDatabaseNodeNotFound(body *BackupDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
 
 	return v
 }
 
-// NewGetJoinOptionsClusterNotInitialized builds a control-plane service
-// get-join-options endpoint cluster_not_initialized error.
-func NewGetJoinOptionsClusterNotInitialized(body *GetJoinOptionsClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewBackupDatabaseNodeServerError builds a control-plane service
+// backup-database-node endpoint server_error error.
+func NewBackupDatabaseNodeServerError(body *BackupDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3399,9 +6544,18 @@ func NewGetJoinOptionsClusterNotInitialized(body *GetJoinOptionsClusterNotInitia
 	return v
 }
 
-// NewGetJoinOptionsInvalidJoinToken builds a control-plane service
-// get-join-options endpoint invalid_join_token error.
-func NewGetJoinOptionsInvalidJoinToken(body *GetJoinOptionsInvalidJoinTokenResponseBody) *controlplane.APIError {
+// NewSwitchoverDatabaseNodeResponseOK builds a "control-plane" service
+// "switchover-database-node" endpoint result from a HTTP "OK" response.
+func NewSwitchoverDatabaseNodeResponseOK(body *SwitchoverDatabaseNodeResponseBody) *controlplane.SwitchoverDatabaseNodeResponse {
+	v := &controlplane.SwitchoverDatabaseNodeResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewSwitchoverDatabaseNodeClusterNotInitialized builds a control-plane
+// service switchover-database-node endpoint cluster_not_initialized error.
+func NewSwitchoverDatabaseNodeClusterNotInitialized(body *SwitchoverDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3410,9 +6564,9 @@ func NewGetJoinOptionsInvalidJoinToken(body *GetJoinOptionsInvalidJoinTokenRespo
 	return v
 }
 
-// NewGetJoinOptionsInvalidInput builds a control-plane service
-// get-join-options endpoint invalid_input error.
-func NewGetJoinOptionsInvalidInput(body *GetJoinOptionsInvalidInputResponseBody) *controlplane.APIError {
+// NewSwitchoverDatabaseNodeDatabaseNotModifiable builds a control-plane
+// service switchover-database-node endpoint database_not_modifiable error.
+func NewSwitchoverDatabaseNodeDatabaseNotModifiable(body *SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3421,9 +6575,10 @@ func NewGetJoinOptionsInvalidInput(body *GetJoinOptionsInvalidInputResponseBody)
 	return v
 }
 
-// NewGetJoinOptionsServerError builds a control-plane service get-join-options
-// endpoint server_error error.
-func NewGetJoinOptionsServerError(body *GetJoinOptionsServerErrorResponseBody) *controlplane.APIError {
+// NewSwitchoverDatabaseNodeOperationAlreadyInProgress builds a control-plane
+// service switchover-database-node endpoint operation_already_in_progress
+// error.
+func NewSwitchoverDatabaseNodeOperationAlreadyInProgress(body *SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3432,28 +6587,20 @@ func NewGetJoinOptionsServerError(body *GetJoinOptionsServerErrorResponseBody) *
 	return v
 }
 
-// NewGetClusterClusterOK builds a "control-plane" service "get-cluster"
-// endpoint result from a HTTP "OK" response.
-func NewGetClusterClusterOK(body *GetClusterResponseBody) *controlplane.Cluster {
-	v := &controlplane.Cluster{
-		ID: controlplane.Identifier(*body.ID),
-	}
-	v.Status = unmarshalClusterStatusResponseBodyToControlplaneClusterStatus(body.Status)
-	v.Hosts = make([]*controlplane.Host, len(body.Hosts))
-	for i, val := range body.Hosts {
-		if val == nil {
-			v.Hosts[i] = nil
-			continue
-		}
-		v.Hosts[i] = unmarshalHostResponseBodyToControlplaneHost(val)
+// NewSwitchoverDatabaseNodeInvalidInput builds a control-plane service
+// switchover-database-node endpoint invalid_input error.
+func NewSwitchoverDatabaseNodeInvalidInput(body *SwitchoverDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetClusterClusterNotInitialized builds a control-plane service
-// get-cluster endpoint cluster_not_initialized error.
-func NewGetClusterClusterNotInitialized(body *GetClusterClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewSwitchoverDatabaseNodeNotFound builds a control-plane service
+// switchover-database-node endpoint not_found error.
+func NewSwitchoverDatabaseNodeNotFound(body *SwitchoverDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3462,9 +6609,9 @@ func NewGetClusterClusterNotInitialized(body *GetClusterClusterNotInitializedRes
 	return v
 }
 
-// NewGetClusterServerError builds a control-plane service get-cluster endpoint
-// server_error error.
-func NewGetClusterServerError(body *GetClusterServerErrorResponseBody) *controlplane.APIError {
+// NewSwitchoverDatabaseNodeServerError builds a control-plane service
+// switchover-database-node endpoint server_error error.
+func NewSwitchoverDatabaseNodeServerError(body *SwitchoverDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3473,25 +6620,18 @@ func NewGetClusterServerError(body *GetClusterServerErrorResponseBody) *controlp
 	return v
 }
 
-// NewListHostsResponseOK builds a "control-plane" service "list-hosts"
-// endpoint result from a HTTP "OK" response.
-func NewListHostsResponseOK(body *ListHostsResponseBody) *controlplane.ListHostsResponse {
-	v := &controlplane.ListHostsResponse{}
-	v.Hosts = make([]*controlplane.Host, len(body.Hosts))
-	for i, val := range body.Hosts {
-		if val == nil {
-			v.Hosts[i] = nil
-			continue
-		}
-		v.Hosts[i] = unmarshalHostResponseBodyToControlplaneHost(val)
-	}
+// NewRollingRestartDatabaseResponseOK builds a "control-plane" service
+// "rolling-restart-database" endpoint result from a HTTP "OK" response.
+func NewRollingRestartDatabaseResponseOK(body *RollingRestartDatabaseResponseBody) *controlplane.RollingRestartDatabaseResponse {
+	v := &controlplane.RollingRestartDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
 
 	return v
 }
 
-// NewListHostsClusterNotInitialized builds a control-plane service list-hosts
-// endpoint cluster_not_initialized error.
-func NewListHostsClusterNotInitialized(body *ListHostsClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewRollingRestartDatabaseClusterNotInitialized builds a control-plane
+// service rolling-restart-database endpoint cluster_not_initialized error.
+func NewRollingRestartDatabaseClusterNotInitialized(body *RollingRestartDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3500,9 +6640,9 @@ func NewListHostsClusterNotInitialized(body *ListHostsClusterNotInitializedRespo
 	return v
 }
 
-// NewListHostsServerError builds a control-plane service list-hosts endpoint
-// server_error error.
-func NewListHostsServerError(body *ListHostsServerErrorResponseBody) *controlplane.APIError {
+// NewRollingRestartDatabaseDatabaseNotModifiable builds a control-plane
+// service rolling-restart-database endpoint database_not_modifiable error.
+func NewRollingRestartDatabaseDatabaseNotModifiable(body *RollingRestartDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3511,49 +6651,21 @@ func NewListHostsServerError(body *ListHostsServerErrorResponseBody) *controlpla
 	return v
 }
 
-// NewGetHostHostOK builds a "control-plane" service "get-host" endpoint result
-// from a HTTP "OK" response.
-func NewGetHostHostOK(body *GetHostResponseBody) *controlplane.Host {
-	v := &controlplane.Host{
-		ID:           controlplane.Identifier(*body.ID),
-		Orchestrator: *body.Orchestrator,
-		DataDir:      *body.DataDir,
-		Cpus:         body.Cpus,
-		Memory:       body.Memory,
-		EtcdMode:     body.EtcdMode,
-	}
-	if body.Cohort != nil {
-		v.Cohort = unmarshalHostCohortResponseBodyToControlplaneHostCohort(body.Cohort)
-	}
-	v.PeerAddresses = make([]string, len(body.PeerAddresses))
-	for i, val := range body.PeerAddresses {
-		v.PeerAddresses[i] = val
-	}
-	v.ClientAddresses = make([]string, len(body.ClientAddresses))
-	for i, val := range body.ClientAddresses {
-		v.ClientAddresses[i] = val
-	}
-	v.Status = unmarshalHostStatusResponseBodyToControlplaneHostStatus(body.Status)
-	if body.DefaultPgedgeVersion != nil {
-		v.DefaultPgedgeVersion = unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion(body.DefaultPgedgeVersion)
-	}
-	if body.SupportedPgedgeVersions != nil {
-		v.SupportedPgedgeVersions = make([]*controlplane.PgEdgeVersion, len(body.SupportedPgedgeVersions))
-		for i, val := range body.SupportedPgedgeVersions {
-			if val == nil {
-				v.SupportedPgedgeVersions[i] = nil
-				continue
-			}
-			v.SupportedPgedgeVersions[i] = unmarshalPgEdgeVersionResponseBodyToControlplanePgEdgeVersion(val)
-		}
+// NewRollingRestartDatabaseOperationAlreadyInProgress builds a control-plane
+// service rolling-restart-database endpoint operation_already_in_progress
+// error.
+func NewRollingRestartDatabaseOperationAlreadyInProgress(body *RollingRestartDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetHostClusterNotInitialized builds a control-plane service get-host
-// endpoint cluster_not_initialized error.
-func NewGetHostClusterNotInitialized(body *GetHostClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewRollingRestartDatabaseInvalidInput builds a control-plane service
+// rolling-restart-database endpoint invalid_input error.
+func NewRollingRestartDatabaseInvalidInput(body *RollingRestartDatabaseInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3562,9 +6674,9 @@ func NewGetHostClusterNotInitialized(body *GetHostClusterNotInitializedResponseB
 	return v
 }
 
-// NewGetHostInvalidInput builds a control-plane service get-host endpoint
-// invalid_input error.
-func NewGetHostInvalidInput(body *GetHostInvalidInputResponseBody) *controlplane.APIError {
+// NewRollingRestartDatabaseNotFound builds a control-plane service
+// rolling-restart-database endpoint not_found error.
+func NewRollingRestartDatabaseNotFound(body *RollingRestartDatabaseNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3573,9 +6685,9 @@ func NewGetHostInvalidInput(body *GetHostInvalidInputResponseBody) *controlplane
 	return v
 }
 
-// NewGetHostNotFound builds a control-plane service get-host endpoint
-// not_found error.
-func NewGetHostNotFound(body *GetHostNotFoundResponseBody) *controlplane.APIError {
+// NewRollingRestartDatabaseServerError builds a control-plane service
+// rolling-restart-database endpoint server_error error.
+func NewRollingRestartDatabaseServerError(body *RollingRestartDatabaseServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3584,9 +6696,18 @@ func NewGetHostNotFound(body *GetHostNotFoundResponseBody) *controlplane.APIErro
 	return v
 }
 
-// NewGetHostServerError builds a control-plane service get-host endpoint
-// server_error error.
-func NewGetHostServerError(body *GetHostServerErrorResponseBody) *controlplane.APIError {
+// NewFailoverDatabaseNodeResponseOK builds a "control-plane" service
+// "failover-database-node" endpoint result from a HTTP "OK" response.
+func NewFailoverDatabaseNodeResponseOK(body *FailoverDatabaseNodeResponseBody) *controlplane.FailoverDatabaseNodeResponse {
+	v := &controlplane.FailoverDatabaseNodeResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewFailoverDatabaseNodeClusterNotInitialized builds a control-plane service
+// failover-database-node endpoint cluster_not_initialized error.
+func NewFailoverDatabaseNodeClusterNotInitialized(body *FailoverDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3595,26 +6716,20 @@ func NewGetHostServerError(body *GetHostServerErrorResponseBody) *controlplane.A
 	return v
 }
 
-// NewRemoveHostResponseOK builds a "control-plane" service "remove-host"
-// endpoint result from a HTTP "OK" response.
-func NewRemoveHostResponseOK(body *RemoveHostResponseBody) *controlplane.RemoveHostResponse {
-	v := &controlplane.RemoveHostResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
-	v.UpdateDatabaseTasks = make([]*controlplane.Task, len(body.UpdateDatabaseTasks))
-	for i, val := range body.UpdateDatabaseTasks {
-		if val == nil {
-			v.UpdateDatabaseTasks[i] = nil
-			continue
-		}
-		v.UpdateDatabaseTasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+// NewFailoverDatabaseNodeDatabaseNotModifiable builds a control-plane service
+// failover-database-node endpoint database_not_modifiable error.
+func NewFailoverDatabaseNodeDatabaseNotModifiable(body *FailoverDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewRemoveHostClusterNotInitialized builds a control-plane service
-// remove-host endpoint cluster_not_initialized error.
-func NewRemoveHostClusterNotInitialized(body *RemoveHostClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewFailoverDatabaseNodeOperationAlreadyInProgress builds a control-plane
+// service failover-database-node endpoint operation_already_in_progress error.
+func NewFailoverDatabaseNodeOperationAlreadyInProgress(body *FailoverDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3623,9 +6738,9 @@ func NewRemoveHostClusterNotInitialized(body *RemoveHostClusterNotInitializedRes
 	return v
 }
 
-// NewRemoveHostInvalidInput builds a control-plane service remove-host
-// endpoint invalid_input error.
-func NewRemoveHostInvalidInput(body *RemoveHostInvalidInputResponseBody) *controlplane.APIError {
+// NewFailoverDatabaseNodeInvalidInput builds a control-plane service
+// failover-database-node endpoint invalid_input error.
+func NewFailoverDatabaseNodeInvalidInput(body *FailoverDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3634,9 +6749,9 @@ func NewRemoveHostInvalidInput(body *RemoveHostInvalidInputResponseBody) *contro
 	return v
 }
 
-// NewRemoveHostNotFound builds a control-plane service remove-host endpoint
-// not_found error.
-func NewRemoveHostNotFound(body *RemoveHostNotFoundResponseBody) *controlplane.APIError {
+// NewFailoverDatabaseNodeNotFound builds a control-plane service
+// failover-database-node endpoint not_found error.
+func NewFailoverDatabaseNodeNotFound(body *FailoverDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3645,9 +6760,9 @@ func NewRemoveHostNotFound(body *RemoveHostNotFoundResponseBody) *controlplane.A
 	return v
 }
 
-// NewRemoveHostServerError builds a control-plane service remove-host endpoint
-// server_error error.
-func NewRemoveHostServerError(body *RemoveHostServerErrorResponseBody) *controlplane.APIError {
+// NewFailoverDatabaseNodeServerError builds a control-plane service
+// failover-database-node endpoint server_error error.
+func NewFailoverDatabaseNodeServerError(body *FailoverDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3656,25 +6771,25 @@ func NewRemoveHostServerError(body *RemoveHostServerErrorResponseBody) *controlp
 	return v
 }
 
-// NewListDatabasesResponseOK builds a "control-plane" service "list-databases"
-// endpoint result from a HTTP "OK" response.
-func NewListDatabasesResponseOK(body *ListDatabasesResponseBody) *controlplane.ListDatabasesResponse {
-	v := &controlplane.ListDatabasesResponse{}
-	v.Databases = make([]*controlplane.DatabaseSummary, len(body.Databases))
-	for i, val := range body.Databases {
+// NewGetNodeQueryStatsResponseOK builds a "control-plane" service
+// "get-node-query-stats" endpoint result from a HTTP "OK" response.
+func NewGetNodeQueryStatsResponseOK(body *GetNodeQueryStatsResponseBody) *controlplane.GetNodeQueryStatsResponse {
+	v := &controlplane.GetNodeQueryStatsResponse{}
+	v.Instances = make([]*controlplane.InstanceQueryStats, len(body.Instances))
+	for i, val := range body.Instances {
 		if val == nil {
-			v.Databases[i] = nil
+			v.Instances[i] = nil
 			continue
 		}
-		v.Databases[i] = unmarshalDatabaseSummaryResponseBodyToControlplaneDatabaseSummary(val)
+		v.Instances[i] = unmarshalInstanceQueryStatsResponseBodyToControlplaneInstanceQueryStats(val)
 	}
 
 	return v
 }
 
-// NewListDatabasesClusterNotInitialized builds a control-plane service
-// list-databases endpoint cluster_not_initialized error.
-func NewListDatabasesClusterNotInitialized(body *ListDatabasesClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetNodeQueryStatsClusterNotInitialized builds a control-plane service
+// get-node-query-stats endpoint cluster_not_initialized error.
+func NewGetNodeQueryStatsClusterNotInitialized(body *GetNodeQueryStatsClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3683,9 +6798,9 @@ func NewListDatabasesClusterNotInitialized(body *ListDatabasesClusterNotInitiali
 	return v
 }
 
-// NewListDatabasesServerError builds a control-plane service list-databases
-// endpoint server_error error.
-func NewListDatabasesServerError(body *ListDatabasesServerErrorResponseBody) *controlplane.APIError {
+// NewGetNodeQueryStatsInvalidInput builds a control-plane service
+// get-node-query-stats endpoint invalid_input error.
+func NewGetNodeQueryStatsInvalidInput(body *GetNodeQueryStatsInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3694,19 +6809,20 @@ func NewListDatabasesServerError(body *ListDatabasesServerErrorResponseBody) *co
 	return v
 }
 
-// NewCreateDatabaseResponseOK builds a "control-plane" service
-// "create-database" endpoint result from a HTTP "OK" response.
-func NewCreateDatabaseResponseOK(body *CreateDatabaseResponseBody) *controlplane.CreateDatabaseResponse {
-	v := &controlplane.CreateDatabaseResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
-	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+// NewGetNodeQueryStatsNotFound builds a control-plane service
+// get-node-query-stats endpoint not_found error.
+func NewGetNodeQueryStatsNotFound(body *GetNodeQueryStatsNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
 
 	return v
 }
 
-// NewCreateDatabaseDatabaseAlreadyExists builds a control-plane service
-// create-database endpoint database_already_exists error.
-func NewCreateDatabaseDatabaseAlreadyExists(body *CreateDatabaseDatabaseAlreadyExistsResponseBody) *controlplane.APIError {
+// NewGetNodeQueryStatsServerError builds a control-plane service
+// get-node-query-stats endpoint server_error error.
+func NewGetNodeQueryStatsServerError(body *GetNodeQueryStatsServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3715,20 +6831,21 @@ func NewCreateDatabaseDatabaseAlreadyExists(body *CreateDatabaseDatabaseAlreadyE
 	return v
 }
 
-// NewCreateDatabaseClusterNotInitialized builds a control-plane service
-// create-database endpoint cluster_not_initialized error.
-func NewCreateDatabaseClusterNotInitialized(body *CreateDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewResetNodeQueryStatsResponseOK builds a "control-plane" service
+// "reset-node-query-stats" endpoint result from a HTTP "OK" response.
+func NewResetNodeQueryStatsResponseOK(body *ResetNodeQueryStatsResponseBody) *controlplane.ResetNodeQueryStatsResponse {
+	v := &controlplane.ResetNodeQueryStatsResponse{}
+	v.InstanceIds = make([]string, len(body.InstanceIds))
+	for i, val := range body.InstanceIds {
+		v.InstanceIds[i] = val
 	}
 
 	return v
 }
 
-// NewCreateDatabaseOperationAlreadyInProgress builds a control-plane service
-// create-database endpoint operation_already_in_progress error.
-func NewCreateDatabaseOperationAlreadyInProgress(body *CreateDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewResetNodeQueryStatsClusterNotInitialized builds a control-plane service
+// reset-node-query-stats endpoint cluster_not_initialized error.
+func NewResetNodeQueryStatsClusterNotInitialized(body *ResetNodeQueryStatsClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3737,9 +6854,9 @@ func NewCreateDatabaseOperationAlreadyInProgress(body *CreateDatabaseOperationAl
 	return v
 }
 
-// NewCreateDatabaseInvalidInput builds a control-plane service create-database
-// endpoint invalid_input error.
-func NewCreateDatabaseInvalidInput(body *CreateDatabaseInvalidInputResponseBody) *controlplane.APIError {
+// NewResetNodeQueryStatsInvalidInput builds a control-plane service
+// reset-node-query-stats endpoint invalid_input error.
+func NewResetNodeQueryStatsInvalidInput(body *ResetNodeQueryStatsInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3748,9 +6865,9 @@ func NewCreateDatabaseInvalidInput(body *CreateDatabaseInvalidInputResponseBody)
 	return v
 }
 
-// NewCreateDatabaseServerError builds a control-plane service create-database
-// endpoint server_error error.
-func NewCreateDatabaseServerError(body *CreateDatabaseServerErrorResponseBody) *controlplane.APIError {
+// NewResetNodeQueryStatsNotFound builds a control-plane service
+// reset-node-query-stats endpoint not_found error.
+func NewResetNodeQueryStatsNotFound(body *ResetNodeQueryStatsNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3759,59 +6876,36 @@ func NewCreateDatabaseServerError(body *CreateDatabaseServerErrorResponseBody) *
 	return v
 }
 
-// NewGetDatabaseDatabaseOK builds a "control-plane" service "get-database"
-// endpoint result from a HTTP "OK" response.
-func NewGetDatabaseDatabaseOK(body *GetDatabaseResponseBody) *controlplane.Database {
-	v := &controlplane.Database{
-		ID:        controlplane.Identifier(*body.ID),
-		CreatedAt: *body.CreatedAt,
-		UpdatedAt: *body.UpdatedAt,
-		State:     *body.State,
-	}
-	if body.TenantID != nil {
-		tenantID := controlplane.Identifier(*body.TenantID)
-		v.TenantID = &tenantID
-	}
-	if body.Instances != nil {
-		v.Instances = make([]*controlplane.Instance, len(body.Instances))
-		for i, val := range body.Instances {
-			if val == nil {
-				v.Instances[i] = nil
-				continue
-			}
-			v.Instances[i] = unmarshalInstanceResponseBodyToControlplaneInstance(val)
-		}
-	}
-	if body.ServiceInstances != nil {
-		v.ServiceInstances = make([]*controlplane.ServiceInstance, len(body.ServiceInstances))
-		for i, val := range body.ServiceInstances {
-			if val == nil {
-				v.ServiceInstances[i] = nil
-				continue
-			}
-			v.ServiceInstances[i] = unmarshalServiceInstanceResponseBodyToControlplaneServiceInstance(val)
-		}
-	}
-	if body.Spec != nil {
-		v.Spec = unmarshalDatabaseSpecResponseBodyToControlplaneDatabaseSpec(body.Spec)
+// NewResetNodeQueryStatsServerError builds a control-plane service
+// reset-node-query-stats endpoint server_error error.
+func NewResetNodeQueryStatsServerError(body *ResetNodeQueryStatsServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	if body.AvailableUpgrades != nil {
-		v.AvailableUpgrades = make([]*controlplane.AvailableUpgrade, len(body.AvailableUpgrades))
-		for i, val := range body.AvailableUpgrades {
-			if val == nil {
-				v.AvailableUpgrades[i] = nil
-				continue
-			}
-			v.AvailableUpgrades[i] = unmarshalAvailableUpgradeResponseBodyToControlplaneAvailableUpgrade(val)
+
+	return v
+}
+
+// NewListDatabaseTasksResponseOK builds a "control-plane" service
+// "list-database-tasks" endpoint result from a HTTP "OK" response.
+func NewListDatabaseTasksResponseOK(body *ListDatabaseTasksResponseBody) *controlplane.ListDatabaseTasksResponse {
+	v := &controlplane.ListDatabaseTasksResponse{}
+	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
+	for i, val := range body.Tasks {
+		if val == nil {
+			v.Tasks[i] = nil
+			continue
 		}
+		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
 	}
 
 	return v
 }
 
-// NewGetDatabaseClusterNotInitialized builds a control-plane service
-// get-database endpoint cluster_not_initialized error.
-func NewGetDatabaseClusterNotInitialized(body *GetDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewListDatabaseTasksClusterNotInitialized builds a control-plane service
+// list-database-tasks endpoint cluster_not_initialized error.
+func NewListDatabaseTasksClusterNotInitialized(body *ListDatabaseTasksClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3820,9 +6914,9 @@ func NewGetDatabaseClusterNotInitialized(body *GetDatabaseClusterNotInitializedR
 	return v
 }
 
-// NewGetDatabaseInvalidInput builds a control-plane service get-database
-// endpoint invalid_input error.
-func NewGetDatabaseInvalidInput(body *GetDatabaseInvalidInputResponseBody) *controlplane.APIError {
+// NewListDatabaseTasksInvalidInput builds a control-plane service
+// list-database-tasks endpoint invalid_input error.
+func NewListDatabaseTasksInvalidInput(body *ListDatabaseTasksInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3831,9 +6925,9 @@ func NewGetDatabaseInvalidInput(body *GetDatabaseInvalidInputResponseBody) *cont
 	return v
 }
 
-// NewGetDatabaseNotFound builds a control-plane service get-database endpoint
-// not_found error.
-func NewGetDatabaseNotFound(body *GetDatabaseNotFoundResponseBody) *controlplane.APIError {
+// NewListDatabaseTasksNotFound builds a control-plane service
+// list-database-tasks endpoint not_found error.
+func NewListDatabaseTasksNotFound(body *ListDatabaseTasksNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3842,9 +6936,9 @@ func NewGetDatabaseNotFound(body *GetDatabaseNotFoundResponseBody) *controlplane
 	return v
 }
 
-// NewGetDatabaseServerError builds a control-plane service get-database
-// endpoint server_error error.
-func NewGetDatabaseServerError(body *GetDatabaseServerErrorResponseBody) *controlplane.APIError {
+// NewListDatabaseTasksServerError builds a control-plane service
+// list-database-tasks endpoint server_error error.
+func NewListDatabaseTasksServerError(body *ListDatabaseTasksServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3853,19 +6947,32 @@ func NewGetDatabaseServerError(body *GetDatabaseServerErrorResponseBody) *contro
 	return v
 }
 
-// NewUpdateDatabaseResponseOK builds a "control-plane" service
-// "update-database" endpoint result from a HTTP "OK" response.
-func NewUpdateDatabaseResponseOK(body *UpdateDatabaseResponseBody) *controlplane.UpdateDatabaseResponse {
-	v := &controlplane.UpdateDatabaseResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
-	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+// NewGetDatabaseTaskTaskOK builds a "control-plane" service
+// "get-database-task" endpoint result from a HTTP "OK" response.
+func NewGetDatabaseTaskTaskOK(body *GetDatabaseTaskResponseBody) *controlplane.Task {
+	v := &controlplane.Task{
+		ParentID:     body.ParentID,
+		Scope:        *body.Scope,
+		EntityID:     *body.EntityID,
+		DatabaseID:   body.DatabaseID,
+		NodeName:     body.NodeName,
+		InstanceID:   body.InstanceID,
+		HostID:       body.HostID,
+		TaskID:       *body.TaskID,
+		CreatedAt:    *body.CreatedAt,
+		CompletedAt:  body.CompletedAt,
+		Type:         *body.Type,
+		Status:       *body.Status,
+		StatusReason: body.StatusReason,
+		Error:        body.Error,
+	}
 
 	return v
 }
 
-// NewUpdateDatabaseClusterNotInitialized builds a control-plane service
-// update-database endpoint cluster_not_initialized error.
-func NewUpdateDatabaseClusterNotInitialized(body *UpdateDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskClusterNotInitialized builds a control-plane service
+// get-database-task endpoint cluster_not_initialized error.
+func NewGetDatabaseTaskClusterNotInitialized(body *GetDatabaseTaskClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3874,9 +6981,9 @@ func NewUpdateDatabaseClusterNotInitialized(body *UpdateDatabaseClusterNotInitia
 	return v
 }
 
-// NewUpdateDatabaseDatabaseNotModifiable builds a control-plane service
-// update-database endpoint database_not_modifiable error.
-func NewUpdateDatabaseDatabaseNotModifiable(body *UpdateDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskInvalidInput builds a control-plane service
+// get-database-task endpoint invalid_input error.
+func NewGetDatabaseTaskInvalidInput(body *GetDatabaseTaskInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3885,9 +6992,9 @@ func NewUpdateDatabaseDatabaseNotModifiable(body *UpdateDatabaseDatabaseNotModif
 	return v
 }
 
-// NewUpdateDatabaseOperationAlreadyInProgress builds a control-plane service
-// update-database endpoint operation_already_in_progress error.
-func NewUpdateDatabaseOperationAlreadyInProgress(body *UpdateDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskNotFound builds a control-plane service get-database-task
+// endpoint not_found error.
+func NewGetDatabaseTaskNotFound(body *GetDatabaseTaskNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3896,9 +7003,9 @@ func NewUpdateDatabaseOperationAlreadyInProgress(body *UpdateDatabaseOperationAl
 	return v
 }
 
-// NewUpdateDatabaseInvalidInput builds a control-plane service update-database
-// endpoint invalid_input error.
-func NewUpdateDatabaseInvalidInput(body *UpdateDatabaseInvalidInputResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskServerError builds a control-plane service
+// get-database-task endpoint server_error error.
+func NewGetDatabaseTaskServerError(body *GetDatabaseTaskServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3907,20 +7014,32 @@ func NewUpdateDatabaseInvalidInput(body *UpdateDatabaseInvalidInputResponseBody)
 	return v
 }
 
-// NewUpdateDatabaseNotFound builds a control-plane service update-database
-// endpoint not_found error.
-func NewUpdateDatabaseNotFound(body *UpdateDatabaseNotFoundResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewGetDatabaseTaskLogTaskLogOK builds a "control-plane" service
+// "get-database-task-log" endpoint result from a HTTP "OK" response.
+func NewGetDatabaseTaskLogTaskLogOK(body *GetDatabaseTaskLogResponseBody) *controlplane.TaskLog {
+	v := &controlplane.TaskLog{
+		Scope:       *body.Scope,
+		EntityID:    *body.EntityID,
+		DatabaseID:  body.DatabaseID,
+		TaskID:      *body.TaskID,
+		TaskStatus:  *body.TaskStatus,
+		LastEntryID: body.LastEntryID,
+	}
+	v.Entries = make([]*controlplane.TaskLogEntry, len(body.Entries))
+	for i, val := range body.Entries {
+		if val == nil {
+			v.Entries[i] = nil
+			continue
+		}
+		v.Entries[i] = unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(val)
 	}
 
 	return v
 }
 
-// NewUpdateDatabaseServerError builds a control-plane service update-database
-// endpoint server_error error.
-func NewUpdateDatabaseServerError(body *UpdateDatabaseServerErrorResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskLogClusterNotInitialized builds a control-plane service
+// get-database-task-log endpoint cluster_not_initialized error.
+func NewGetDatabaseTaskLogClusterNotInitialized(body *GetDatabaseTaskLogClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3929,19 +7048,20 @@ func NewUpdateDatabaseServerError(body *UpdateDatabaseServerErrorResponseBody) *
 	return v
 }
 
-// NewApplyUpgradeResponseOK builds a "control-plane" service "apply-upgrade"
-// endpoint result from a HTTP "OK" response.
-func NewApplyUpgradeResponseOK(body *ApplyUpgradeResponseBody) *controlplane.ApplyUpgradeResponse {
-	v := &controlplane.ApplyUpgradeResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
-	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
+// NewGetDatabaseTaskLogInvalidInput builds a control-plane service
+// get-database-task-log endpoint invalid_input error.
+func NewGetDatabaseTaskLogInvalidInput(body *GetDatabaseTaskLogInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
 
 	return v
 }
 
-// NewApplyUpgradeClusterNotInitialized builds a control-plane service
-// apply-upgrade endpoint cluster_not_initialized error.
-func NewApplyUpgradeClusterNotInitialized(body *ApplyUpgradeClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskLogNotFound builds a control-plane service
+// get-database-task-log endpoint not_found error.
+func NewGetDatabaseTaskLogNotFound(body *GetDatabaseTaskLogNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3950,9 +7070,9 @@ func NewApplyUpgradeClusterNotInitialized(body *ApplyUpgradeClusterNotInitialize
 	return v
 }
 
-// NewApplyUpgradeDatabaseNotModifiable builds a control-plane service
-// apply-upgrade endpoint database_not_modifiable error.
-func NewApplyUpgradeDatabaseNotModifiable(body *ApplyUpgradeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewGetDatabaseTaskLogServerError builds a control-plane service
+// get-database-task-log endpoint server_error error.
+func NewGetDatabaseTaskLogServerError(body *GetDatabaseTaskLogServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3961,9 +7081,25 @@ func NewApplyUpgradeDatabaseNotModifiable(body *ApplyUpgradeDatabaseNotModifiabl
 	return v
 }
 
-// NewApplyUpgradeOperationAlreadyInProgress builds a control-plane service
-// apply-upgrade endpoint operation_already_in_progress error.
-func NewApplyUpgradeOperationAlreadyInProgress(body *ApplyUpgradeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewListHostTasksResponseOK builds a "control-plane" service
+// "list-host-tasks" endpoint result from a HTTP "OK" response.
+func NewListHostTasksResponseOK(body *ListHostTasksResponseBody) *controlplane.ListHostTasksResponse {
+	v := &controlplane.ListHostTasksResponse{}
+	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
+	for i, val := range body.Tasks {
+		if val == nil {
+			v.Tasks[i] = nil
+			continue
+		}
+		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+	}
+
+	return v
+}
+
+// NewListHostTasksClusterNotInitialized builds a control-plane service
+// list-host-tasks endpoint cluster_not_initialized error.
+func NewListHostTasksClusterNotInitialized(body *ListHostTasksClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3972,9 +7108,9 @@ func NewApplyUpgradeOperationAlreadyInProgress(body *ApplyUpgradeOperationAlread
 	return v
 }
 
-// NewApplyUpgradeInvalidInput builds a control-plane service apply-upgrade
+// NewListHostTasksInvalidInput builds a control-plane service list-host-tasks
 // endpoint invalid_input error.
-func NewApplyUpgradeInvalidInput(body *ApplyUpgradeInvalidInputResponseBody) *controlplane.APIError {
+func NewListHostTasksInvalidInput(body *ListHostTasksInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3983,9 +7119,9 @@ func NewApplyUpgradeInvalidInput(body *ApplyUpgradeInvalidInputResponseBody) *co
 	return v
 }
 
-// NewApplyUpgradeNotFound builds a control-plane service apply-upgrade
+// NewListHostTasksNotFound builds a control-plane service list-host-tasks
 // endpoint not_found error.
-func NewApplyUpgradeNotFound(body *ApplyUpgradeNotFoundResponseBody) *controlplane.APIError {
+func NewListHostTasksNotFound(body *ListHostTasksNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -3994,9 +7130,9 @@ func NewApplyUpgradeNotFound(body *ApplyUpgradeNotFoundResponseBody) *controlpla
 	return v
 }
 
-// NewApplyUpgradeServerError builds a control-plane service apply-upgrade
+// NewListHostTasksServerError builds a control-plane service list-host-tasks
 // endpoint server_error error.
-func NewApplyUpgradeServerError(body *ApplyUpgradeServerErrorResponseBody) *controlplane.APIError {
+func NewListHostTasksServerError(body *ListHostTasksServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4005,18 +7141,32 @@ func NewApplyUpgradeServerError(body *ApplyUpgradeServerErrorResponseBody) *cont
 	return v
 }
 
-// NewDeleteDatabaseResponseOK builds a "control-plane" service
-// "delete-database" endpoint result from a HTTP "OK" response.
-func NewDeleteDatabaseResponseOK(body *DeleteDatabaseResponseBody) *controlplane.DeleteDatabaseResponse {
-	v := &controlplane.DeleteDatabaseResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+// NewGetHostTaskTaskOK builds a "control-plane" service "get-host-task"
+// endpoint result from a HTTP "OK" response.
+func NewGetHostTaskTaskOK(body *GetHostTaskResponseBody) *controlplane.Task {
+	v := &controlplane.Task{
+		ParentID:     body.ParentID,
+		Scope:        *body.Scope,
+		EntityID:     *body.EntityID,
+		DatabaseID:   body.DatabaseID,
+		NodeName:     body.NodeName,
+		InstanceID:   body.InstanceID,
+		HostID:       body.HostID,
+		TaskID:       *body.TaskID,
+		CreatedAt:    *body.CreatedAt,
+		CompletedAt:  body.CompletedAt,
+		Type:         *body.Type,
+		Status:       *body.Status,
+		StatusReason: body.StatusReason,
+		Error:        body.Error,
+	}
 
 	return v
 }
 
-// NewDeleteDatabaseClusterNotInitialized builds a control-plane service
-// delete-database endpoint cluster_not_initialized error.
-func NewDeleteDatabaseClusterNotInitialized(body *DeleteDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetHostTaskClusterNotInitialized builds a control-plane service
+// get-host-task endpoint cluster_not_initialized error.
+func NewGetHostTaskClusterNotInitialized(body *GetHostTaskClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4025,9 +7175,9 @@ func NewDeleteDatabaseClusterNotInitialized(body *DeleteDatabaseClusterNotInitia
 	return v
 }
 
-// NewDeleteDatabaseDatabaseNotModifiable builds a control-plane service
-// delete-database endpoint database_not_modifiable error.
-func NewDeleteDatabaseDatabaseNotModifiable(body *DeleteDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewGetHostTaskInvalidInput builds a control-plane service get-host-task
+// endpoint invalid_input error.
+func NewGetHostTaskInvalidInput(body *GetHostTaskInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4036,9 +7186,9 @@ func NewDeleteDatabaseDatabaseNotModifiable(body *DeleteDatabaseDatabaseNotModif
 	return v
 }
 
-// NewDeleteDatabaseOperationAlreadyInProgress builds a control-plane service
-// delete-database endpoint operation_already_in_progress error.
-func NewDeleteDatabaseOperationAlreadyInProgress(body *DeleteDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewGetHostTaskNotFound builds a control-plane service get-host-task endpoint
+// not_found error.
+func NewGetHostTaskNotFound(body *GetHostTaskNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4047,9 +7197,9 @@ func NewDeleteDatabaseOperationAlreadyInProgress(body *DeleteDatabaseOperationAl
 	return v
 }
 
-// NewDeleteDatabaseInvalidInput builds a control-plane service delete-database
-// endpoint invalid_input error.
-func NewDeleteDatabaseInvalidInput(body *DeleteDatabaseInvalidInputResponseBody) *controlplane.APIError {
+// NewGetHostTaskServerError builds a control-plane service get-host-task
+// endpoint server_error error.
+func NewGetHostTaskServerError(body *GetHostTaskServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4058,20 +7208,32 @@ func NewDeleteDatabaseInvalidInput(body *DeleteDatabaseInvalidInputResponseBody)
 	return v
 }
 
-// NewDeleteDatabaseNotFound builds a control-plane service delete-database
-// endpoint not_found error.
-func NewDeleteDatabaseNotFound(body *DeleteDatabaseNotFoundResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewGetHostTaskLogTaskLogOK builds a "control-plane" service
+// "get-host-task-log" endpoint result from a HTTP "OK" response.
+func NewGetHostTaskLogTaskLogOK(body *GetHostTaskLogResponseBody) *controlplane.TaskLog {
+	v := &controlplane.TaskLog{
+		Scope:       *body.Scope,
+		EntityID:    *body.EntityID,
+		DatabaseID:  body.DatabaseID,
+		TaskID:      *body.TaskID,
+		TaskStatus:  *body.TaskStatus,
+		LastEntryID: body.LastEntryID,
+	}
+	v.Entries = make([]*controlplane.TaskLogEntry, len(body.Entries))
+	for i, val := range body.Entries {
+		if val == nil {
+			v.Entries[i] = nil
+			continue
+		}
+		v.Entries[i] = unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(val)
 	}
 
 	return v
 }
 
-// NewDeleteDatabaseServerError builds a control-plane service delete-database
-// endpoint server_error error.
-func NewDeleteDatabaseServerError(body *DeleteDatabaseServerErrorResponseBody) *controlplane.APIError {
+// NewGetHostTaskLogClusterNotInitialized builds a control-plane service
+// get-host-task-log endpoint cluster_not_initialized error.
+func NewGetHostTaskLogClusterNotInitialized(body *GetHostTaskLogClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4080,18 +7242,20 @@ func NewDeleteDatabaseServerError(body *DeleteDatabaseServerErrorResponseBody) *
 	return v
 }
 
-// NewBackupDatabaseNodeResponseOK builds a "control-plane" service
-// "backup-database-node" endpoint result from a HTTP "OK" response.
-func NewBackupDatabaseNodeResponseOK(body *BackupDatabaseNodeResponseBody) *controlplane.BackupDatabaseNodeResponse {
-	v := &controlplane.BackupDatabaseNodeResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+// NewGetHostTaskLogInvalidInput builds a control-plane service
+// get-host-task-log endpoint invalid_input error.
+func NewGetHostTaskLogInvalidInput(body *GetHostTaskLogInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
 
 	return v
 }
 
-// NewBackupDatabaseNodeClusterNotInitialized builds a control-plane service
-// backup-database-node endpoint cluster_not_initialized error.
-func NewBackupDatabaseNodeClusterNotInitialized(body *BackupDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetHostTaskLogNotFound builds a control-plane service get-host-task-log
+// endpoint not_found error.
+func NewGetHostTaskLogNotFound(body *GetHostTaskLogNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4099,10 +7263,10 @@ func NewBackupDatabaseNodeClusterNotInitialized(body *BackupDatabaseNodeClusterN
 
 	return v
 }
-
-// NewBackupDatabaseNodeDatabaseNotModifiable builds a control-plane service
-// backup-database-node endpoint database_not_modifiable error.
-func NewBackupDatabaseNodeDatabaseNotModifiable(body *BackupDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+
+// NewGetHostTaskLogServerError builds a control-plane service
+// get-host-task-log endpoint server_error error.
+func NewGetHostTaskLogServerError(body *GetHostTaskLogServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4111,20 +7275,25 @@ func NewBackupDatabaseNodeDatabaseNotModifiable(body *BackupDatabaseNodeDatabase
 	return v
 }
 
-// NewBackupDatabaseNodeOperationAlreadyInProgress builds a control-plane
-// service backup-database-node endpoint operation_already_in_progress error.
-func NewBackupDatabaseNodeOperationAlreadyInProgress(body *BackupDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewListTasksResponseOK builds a "control-plane" service "list-tasks"
+// endpoint result from a HTTP "OK" response.
+func NewListTasksResponseOK(body *ListTasksResponseBody) *controlplane.ListTasksResponse {
+	v := &controlplane.ListTasksResponse{}
+	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
+	for i, val := range body.Tasks {
+		if val == nil {
+			v.Tasks[i] = nil
+			continue
+		}
+		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
 	}
 
 	return v
 }
 
-// NewBackupDatabaseNodeInvalidInput builds a control-plane service
-// backup-database-node endpoint invalid_input error.
-func NewBackupDatabaseNodeInvalidInput(body *BackupDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
+// NewListTasksClusterNotInitialized builds a control-plane service list-tasks
+// endpoint cluster_not_initialized error.
+func NewListTasksClusterNotInitialized(body *ListTasksClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4133,9 +7302,9 @@ func NewBackupDatabaseNodeInvalidInput(body *BackupDatabaseNodeInvalidInputRespo
 	return v
 }
 
-// NewBackupDatabaseNodeNotFound builds a control-plane service
-// backup-database-node endpoint not_found error.
-func NewBackupDatabaseNodeNotFound(body *BackupDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
+// NewListTasksInvalidInput builds a control-plane service list-tasks endpoint
+// invalid_input error.
+func NewListTasksInvalidInput(body *ListTasksInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4144,9 +7313,9 @@ func NewBackupDatabaseNodeNotFound(body *BackupDatabaseNodeNotFoundResponseBody)
 	return v
 }
 
-// NewBackupDatabaseNodeServerError builds a control-plane service
-// backup-database-node endpoint server_error error.
-func NewBackupDatabaseNodeServerError(body *BackupDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
+// NewListTasksServerError builds a control-plane service list-tasks endpoint
+// server_error error.
+func NewListTasksServerError(body *ListTasksServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4155,18 +7324,42 @@ func NewBackupDatabaseNodeServerError(body *BackupDatabaseNodeServerErrorRespons
 	return v
 }
 
-// NewSwitchoverDatabaseNodeResponseOK builds a "control-plane" service
-// "switchover-database-node" endpoint result from a HTTP "OK" response.
-func NewSwitchoverDatabaseNodeResponseOK(body *SwitchoverDatabaseNodeResponseBody) *controlplane.SwitchoverDatabaseNodeResponse {
-	v := &controlplane.SwitchoverDatabaseNodeResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+// NewStreamEventsEventOK builds a "control-plane" service "stream-events"
+// endpoint result from a HTTP "OK" response.
+func NewStreamEventsEventOK(body *StreamEventsResponseBody) *controlplane.Event {
+	v := &controlplane.Event{
+		ID:        *body.ID,
+		Kind:      *body.Kind,
+		Timestamp: *body.Timestamp,
+		TaskID:    body.TaskID,
+	}
+	if body.DatabaseID != nil {
+		databaseID := controlplane.Identifier(*body.DatabaseID)
+		v.DatabaseID = &databaseID
+	}
+	if body.HostID != nil {
+		hostID := controlplane.Identifier(*body.HostID)
+		v.HostID = &hostID
+	}
+	if body.Database != nil {
+		v.Database = unmarshalDatabaseSummaryResponseBodyToControlplaneDatabaseSummary(body.Database)
+	}
+	if body.Instance != nil {
+		v.Instance = unmarshalInstanceResponseBodyToControlplaneInstance(body.Instance)
+	}
+	if body.Task != nil {
+		v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	}
+	if body.TaskLogEntry != nil {
+		v.TaskLogEntry = unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(body.TaskLogEntry)
+	}
 
 	return v
 }
 
-// NewSwitchoverDatabaseNodeClusterNotInitialized builds a control-plane
-// service switchover-database-node endpoint cluster_not_initialized error.
-func NewSwitchoverDatabaseNodeClusterNotInitialized(body *SwitchoverDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewStreamEventsClusterNotInitialized builds a control-plane service
+// stream-events endpoint cluster_not_initialized error.
+func NewStreamEventsClusterNotInitialized(body *StreamEventsClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4175,9 +7368,9 @@ func NewSwitchoverDatabaseNodeClusterNotInitialized(body *SwitchoverDatabaseNode
 	return v
 }
 
-// NewSwitchoverDatabaseNodeDatabaseNotModifiable builds a control-plane
-// service switchover-database-node endpoint database_not_modifiable error.
-func NewSwitchoverDatabaseNodeDatabaseNotModifiable(body *SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewStreamEventsInvalidInput builds a control-plane service stream-events
+// endpoint invalid_input error.
+func NewStreamEventsInvalidInput(body *StreamEventsInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4186,10 +7379,9 @@ func NewSwitchoverDatabaseNodeDatabaseNotModifiable(body *SwitchoverDatabaseNode
 	return v
 }
 
-// NewSwitchoverDatabaseNodeOperationAlreadyInProgress builds a control-plane
-// service switchover-database-node endpoint operation_already_in_progress
-// error.
-func NewSwitchoverDatabaseNodeOperationAlreadyInProgress(body *SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewStreamEventsServerError builds a control-plane service stream-events
+// endpoint server_error error.
+func NewStreamEventsServerError(body *StreamEventsServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4198,9 +7390,35 @@ func NewSwitchoverDatabaseNodeOperationAlreadyInProgress(body *SwitchoverDatabas
 	return v
 }
 
-// NewSwitchoverDatabaseNodeInvalidInput builds a control-plane service
-// switchover-database-node endpoint invalid_input error.
-func NewSwitchoverDatabaseNodeInvalidInput(body *SwitchoverDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
+// NewCreateWebhookWebhookOK builds a "control-plane" service "create-webhook"
+// endpoint result from a HTTP "OK" response.
+func NewCreateWebhookWebhookOK(body *CreateWebhookResponseBody) *controlplane.Webhook {
+	v := &controlplane.Webhook{
+		ID:          *body.ID,
+		URL:         *body.URL,
+		Description: body.Description,
+		Secret:      body.Secret,
+		CreatedAt:   *body.CreatedAt,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]controlplane.WebhookEventType, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = controlplane.WebhookEventType(val)
+		}
+	}
+	if body.DatabaseIds != nil {
+		v.DatabaseIds = make([]controlplane.Identifier, len(body.DatabaseIds))
+		for i, val := range body.DatabaseIds {
+			v.DatabaseIds[i] = controlplane.Identifier(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateWebhookClusterNotInitialized builds a control-plane service
+// create-webhook endpoint cluster_not_initialized error.
+func NewCreateWebhookClusterNotInitialized(body *CreateWebhookClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4209,9 +7427,9 @@ func NewSwitchoverDatabaseNodeInvalidInput(body *SwitchoverDatabaseNodeInvalidIn
 	return v
 }
 
-// NewSwitchoverDatabaseNodeNotFound builds a control-plane service
-// switchover-database-node endpoint not_found error.
-func NewSwitchoverDatabaseNodeNotFound(body *SwitchoverDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
+// NewCreateWebhookInvalidInput builds a control-plane service create-webhook
+// endpoint invalid_input error.
+func NewCreateWebhookInvalidInput(body *CreateWebhookInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4220,9 +7438,9 @@ func NewSwitchoverDatabaseNodeNotFound(body *SwitchoverDatabaseNodeNotFoundRespo
 	return v
 }
 
-// NewSwitchoverDatabaseNodeServerError builds a control-plane service
-// switchover-database-node endpoint server_error error.
-func NewSwitchoverDatabaseNodeServerError(body *SwitchoverDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
+// NewCreateWebhookServerError builds a control-plane service create-webhook
+// endpoint server_error error.
+func NewCreateWebhookServerError(body *CreateWebhookServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4231,18 +7449,25 @@ func NewSwitchoverDatabaseNodeServerError(body *SwitchoverDatabaseNodeServerErro
 	return v
 }
 
-// NewFailoverDatabaseNodeResponseOK builds a "control-plane" service
-// "failover-database-node" endpoint result from a HTTP "OK" response.
-func NewFailoverDatabaseNodeResponseOK(body *FailoverDatabaseNodeResponseBody) *controlplane.FailoverDatabaseNodeResponse {
-	v := &controlplane.FailoverDatabaseNodeResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+// NewListWebhooksResponseOK builds a "control-plane" service "list-webhooks"
+// endpoint result from a HTTP "OK" response.
+func NewListWebhooksResponseOK(body *ListWebhooksResponseBody) *controlplane.ListWebhooksResponse {
+	v := &controlplane.ListWebhooksResponse{}
+	v.Webhooks = make([]*controlplane.Webhook, len(body.Webhooks))
+	for i, val := range body.Webhooks {
+		if val == nil {
+			v.Webhooks[i] = nil
+			continue
+		}
+		v.Webhooks[i] = unmarshalWebhookResponseBodyToControlplaneWebhook(val)
+	}
 
 	return v
 }
 
-// NewFailoverDatabaseNodeClusterNotInitialized builds a control-plane service
-// failover-database-node endpoint cluster_not_initialized error.
-func NewFailoverDatabaseNodeClusterNotInitialized(body *FailoverDatabaseNodeClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewListWebhooksClusterNotInitialized builds a control-plane service
+// list-webhooks endpoint cluster_not_initialized error.
+func NewListWebhooksClusterNotInitialized(body *ListWebhooksClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4251,9 +7476,9 @@ func NewFailoverDatabaseNodeClusterNotInitialized(body *FailoverDatabaseNodeClus
 	return v
 }
 
-// NewFailoverDatabaseNodeDatabaseNotModifiable builds a control-plane service
-// failover-database-node endpoint database_not_modifiable error.
-func NewFailoverDatabaseNodeDatabaseNotModifiable(body *FailoverDatabaseNodeDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewListWebhooksServerError builds a control-plane service list-webhooks
+// endpoint server_error error.
+func NewListWebhooksServerError(body *ListWebhooksServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4262,20 +7487,35 @@ func NewFailoverDatabaseNodeDatabaseNotModifiable(body *FailoverDatabaseNodeData
 	return v
 }
 
-// NewFailoverDatabaseNodeOperationAlreadyInProgress builds a control-plane
-// service failover-database-node endpoint operation_already_in_progress error.
-func NewFailoverDatabaseNodeOperationAlreadyInProgress(body *FailoverDatabaseNodeOperationAlreadyInProgressResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewGetWebhookWebhookOK builds a "control-plane" service "get-webhook"
+// endpoint result from a HTTP "OK" response.
+func NewGetWebhookWebhookOK(body *GetWebhookResponseBody) *controlplane.Webhook {
+	v := &controlplane.Webhook{
+		ID:          *body.ID,
+		URL:         *body.URL,
+		Description: body.Description,
+		Secret:      body.Secret,
+		CreatedAt:   *body.CreatedAt,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]controlplane.WebhookEventType, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = controlplane.WebhookEventType(val)
+		}
+	}
+	if body.DatabaseIds != nil {
+		v.DatabaseIds = make([]controlplane.Identifier, len(body.DatabaseIds))
+		for i, val := range body.DatabaseIds {
+			v.DatabaseIds[i] = controlplane.Identifier(val)
+		}
 	}
 
 	return v
 }
 
-// NewFailoverDatabaseNodeInvalidInput builds a control-plane service
-// failover-database-node endpoint invalid_input error.
-func NewFailoverDatabaseNodeInvalidInput(body *FailoverDatabaseNodeInvalidInputResponseBody) *controlplane.APIError {
+// NewGetWebhookClusterNotInitialized builds a control-plane service
+// get-webhook endpoint cluster_not_initialized error.
+func NewGetWebhookClusterNotInitialized(body *GetWebhookClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4284,9 +7524,9 @@ func NewFailoverDatabaseNodeInvalidInput(body *FailoverDatabaseNodeInvalidInputR
 	return v
 }
 
-// NewFailoverDatabaseNodeNotFound builds a control-plane service
-// failover-database-node endpoint not_found error.
-func NewFailoverDatabaseNodeNotFound(body *FailoverDatabaseNodeNotFoundResponseBody) *controlplane.APIError {
+// NewGetWebhookInvalidInput builds a control-plane service get-webhook
+// endpoint invalid_input error.
+func NewGetWebhookInvalidInput(body *GetWebhookInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4295,9 +7535,9 @@ func NewFailoverDatabaseNodeNotFound(body *FailoverDatabaseNodeNotFoundResponseB
 	return v
 }
 
-// NewFailoverDatabaseNodeServerError builds a control-plane service
-// failover-database-node endpoint server_error error.
-func NewFailoverDatabaseNodeServerError(body *FailoverDatabaseNodeServerErrorResponseBody) *controlplane.APIError {
+// NewGetWebhookNotFound builds a control-plane service get-webhook endpoint
+// not_found error.
+func NewGetWebhookNotFound(body *GetWebhookNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4306,25 +7546,20 @@ func NewFailoverDatabaseNodeServerError(body *FailoverDatabaseNodeServerErrorRes
 	return v
 }
 
-// NewListDatabaseTasksResponseOK builds a "control-plane" service
-// "list-database-tasks" endpoint result from a HTTP "OK" response.
-func NewListDatabaseTasksResponseOK(body *ListDatabaseTasksResponseBody) *controlplane.ListDatabaseTasksResponse {
-	v := &controlplane.ListDatabaseTasksResponse{}
-	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
-	for i, val := range body.Tasks {
-		if val == nil {
-			v.Tasks[i] = nil
-			continue
-		}
-		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+// NewGetWebhookServerError builds a control-plane service get-webhook endpoint
+// server_error error.
+func NewGetWebhookServerError(body *GetWebhookServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewListDatabaseTasksClusterNotInitialized builds a control-plane service
-// list-database-tasks endpoint cluster_not_initialized error.
-func NewListDatabaseTasksClusterNotInitialized(body *ListDatabaseTasksClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewDeleteWebhookClusterNotInitialized builds a control-plane service
+// delete-webhook endpoint cluster_not_initialized error.
+func NewDeleteWebhookClusterNotInitialized(body *DeleteWebhookClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4333,9 +7568,9 @@ func NewListDatabaseTasksClusterNotInitialized(body *ListDatabaseTasksClusterNot
 	return v
 }
 
-// NewListDatabaseTasksInvalidInput builds a control-plane service
-// list-database-tasks endpoint invalid_input error.
-func NewListDatabaseTasksInvalidInput(body *ListDatabaseTasksInvalidInputResponseBody) *controlplane.APIError {
+// NewDeleteWebhookInvalidInput builds a control-plane service delete-webhook
+// endpoint invalid_input error.
+func NewDeleteWebhookInvalidInput(body *DeleteWebhookInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4344,9 +7579,9 @@ func NewListDatabaseTasksInvalidInput(body *ListDatabaseTasksInvalidInputRespons
 	return v
 }
 
-// NewListDatabaseTasksNotFound builds a control-plane service
-// list-database-tasks endpoint not_found error.
-func NewListDatabaseTasksNotFound(body *ListDatabaseTasksNotFoundResponseBody) *controlplane.APIError {
+// NewDeleteWebhookNotFound builds a control-plane service delete-webhook
+// endpoint not_found error.
+func NewDeleteWebhookNotFound(body *DeleteWebhookNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4355,9 +7590,9 @@ func NewListDatabaseTasksNotFound(body *ListDatabaseTasksNotFoundResponseBody) *
 	return v
 }
 
-// NewListDatabaseTasksServerError builds a control-plane service
-// list-database-tasks endpoint server_error error.
-func NewListDatabaseTasksServerError(body *ListDatabaseTasksServerErrorResponseBody) *controlplane.APIError {
+// NewDeleteWebhookServerError builds a control-plane service delete-webhook
+// endpoint server_error error.
+func NewDeleteWebhookServerError(body *DeleteWebhookServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4366,31 +7601,25 @@ func NewListDatabaseTasksServerError(body *ListDatabaseTasksServerErrorResponseB
 	return v
 }
 
-// NewGetDatabaseTaskTaskOK builds a "control-plane" service
-// "get-database-task" endpoint result from a HTTP "OK" response.
-func NewGetDatabaseTaskTaskOK(body *GetDatabaseTaskResponseBody) *controlplane.Task {
-	v := &controlplane.Task{
-		ParentID:    body.ParentID,
-		Scope:       *body.Scope,
-		EntityID:    *body.EntityID,
-		DatabaseID:  body.DatabaseID,
-		NodeName:    body.NodeName,
-		InstanceID:  body.InstanceID,
-		HostID:      body.HostID,
-		TaskID:      *body.TaskID,
-		CreatedAt:   *body.CreatedAt,
-		CompletedAt: body.CompletedAt,
-		Type:        *body.Type,
-		Status:      *body.Status,
-		Error:       body.Error,
+// NewListWebhookDeliveriesResponseOK builds a "control-plane" service
+// "list-webhook-deliveries" endpoint result from a HTTP "OK" response.
+func NewListWebhookDeliveriesResponseOK(body *ListWebhookDeliveriesResponseBody) *controlplane.ListWebhookDeliveriesResponse {
+	v := &controlplane.ListWebhookDeliveriesResponse{}
+	v.Deliveries = make([]*controlplane.WebhookDelivery, len(body.Deliveries))
+	for i, val := range body.Deliveries {
+		if val == nil {
+			v.Deliveries[i] = nil
+			continue
+		}
+		v.Deliveries[i] = unmarshalWebhookDeliveryResponseBodyToControlplaneWebhookDelivery(val)
 	}
 
 	return v
 }
 
-// NewGetDatabaseTaskClusterNotInitialized builds a control-plane service
-// get-database-task endpoint cluster_not_initialized error.
-func NewGetDatabaseTaskClusterNotInitialized(body *GetDatabaseTaskClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewListWebhookDeliveriesClusterNotInitialized builds a control-plane service
+// list-webhook-deliveries endpoint cluster_not_initialized error.
+func NewListWebhookDeliveriesClusterNotInitialized(body *ListWebhookDeliveriesClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4399,9 +7628,9 @@ func NewGetDatabaseTaskClusterNotInitialized(body *GetDatabaseTaskClusterNotInit
 	return v
 }
 
-// NewGetDatabaseTaskInvalidInput builds a control-plane service
-// get-database-task endpoint invalid_input error.
-func NewGetDatabaseTaskInvalidInput(body *GetDatabaseTaskInvalidInputResponseBody) *controlplane.APIError {
+// NewListWebhookDeliveriesInvalidInput builds a control-plane service
+// list-webhook-deliveries endpoint invalid_input error.
+func NewListWebhookDeliveriesInvalidInput(body *ListWebhookDeliveriesInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4410,9 +7639,9 @@ func NewGetDatabaseTaskInvalidInput(body *GetDatabaseTaskInvalidInputResponseBod
 	return v
 }
 
-// NewGetDatabaseTaskNotFound builds a control-plane service get-database-task
-// endpoint not_found error.
-func NewGetDatabaseTaskNotFound(body *GetDatabaseTaskNotFoundResponseBody) *controlplane.APIError {
+// NewListWebhookDeliveriesNotFound builds a control-plane service
+// list-webhook-deliveries endpoint not_found error.
+func NewListWebhookDeliveriesNotFound(body *ListWebhookDeliveriesNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4421,9 +7650,9 @@ func NewGetDatabaseTaskNotFound(body *GetDatabaseTaskNotFoundResponseBody) *cont
 	return v
 }
 
-// NewGetDatabaseTaskServerError builds a control-plane service
-// get-database-task endpoint server_error error.
-func NewGetDatabaseTaskServerError(body *GetDatabaseTaskServerErrorResponseBody) *controlplane.APIError {
+// NewListWebhookDeliveriesServerError builds a control-plane service
+// list-webhook-deliveries endpoint server_error error.
+func NewListWebhookDeliveriesServerError(body *ListWebhookDeliveriesServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4432,32 +7661,27 @@ func NewGetDatabaseTaskServerError(body *GetDatabaseTaskServerErrorResponseBody)
 	return v
 }
 
-// NewGetDatabaseTaskLogTaskLogOK builds a "control-plane" service
-// "get-database-task-log" endpoint result from a HTTP "OK" response.
-func NewGetDatabaseTaskLogTaskLogOK(body *GetDatabaseTaskLogResponseBody) *controlplane.TaskLog {
-	v := &controlplane.TaskLog{
-		Scope:       *body.Scope,
-		EntityID:    *body.EntityID,
-		DatabaseID:  body.DatabaseID,
-		TaskID:      *body.TaskID,
-		TaskStatus:  *body.TaskStatus,
-		LastEntryID: body.LastEntryID,
-	}
-	v.Entries = make([]*controlplane.TaskLogEntry, len(body.Entries))
-	for i, val := range body.Entries {
+// NewRestoreDatabaseResponseOK builds a "control-plane" service
+// "restore-database" endpoint result from a HTTP "OK" response.
+func NewRestoreDatabaseResponseOK(body *RestoreDatabaseResponseBody) *controlplane.RestoreDatabaseResponse {
+	v := &controlplane.RestoreDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	v.NodeTasks = make([]*controlplane.Task, len(body.NodeTasks))
+	for i, val := range body.NodeTasks {
 		if val == nil {
-			v.Entries[i] = nil
+			v.NodeTasks[i] = nil
 			continue
 		}
-		v.Entries[i] = unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(val)
+		v.NodeTasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
 	}
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
 
 	return v
 }
 
-// NewGetDatabaseTaskLogClusterNotInitialized builds a control-plane service
-// get-database-task-log endpoint cluster_not_initialized error.
-func NewGetDatabaseTaskLogClusterNotInitialized(body *GetDatabaseTaskLogClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewRestoreDatabaseClusterNotInitialized builds a control-plane service
+// restore-database endpoint cluster_not_initialized error.
+func NewRestoreDatabaseClusterNotInitialized(body *RestoreDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4466,9 +7690,9 @@ func NewGetDatabaseTaskLogClusterNotInitialized(body *GetDatabaseTaskLogClusterN
 	return v
 }
 
-// NewGetDatabaseTaskLogInvalidInput builds a control-plane service
-// get-database-task-log endpoint invalid_input error.
-func NewGetDatabaseTaskLogInvalidInput(body *GetDatabaseTaskLogInvalidInputResponseBody) *controlplane.APIError {
+// NewRestoreDatabaseDatabaseNotModifiable builds a control-plane service
+// restore-database endpoint database_not_modifiable error.
+func NewRestoreDatabaseDatabaseNotModifiable(body *RestoreDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4477,9 +7701,9 @@ func NewGetDatabaseTaskLogInvalidInput(body *GetDatabaseTaskLogInvalidInputRespo
 	return v
 }
 
-// NewGetDatabaseTaskLogNotFound builds a control-plane service
-// get-database-task-log endpoint not_found error.
-func NewGetDatabaseTaskLogNotFound(body *GetDatabaseTaskLogNotFoundResponseBody) *controlplane.APIError {
+// NewRestoreDatabaseOperationAlreadyInProgress builds a control-plane service
+// restore-database endpoint operation_already_in_progress error.
+func NewRestoreDatabaseOperationAlreadyInProgress(body *RestoreDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4488,9 +7712,9 @@ func NewGetDatabaseTaskLogNotFound(body *GetDatabaseTaskLogNotFoundResponseBody)
 	return v
 }
 
-// NewGetDatabaseTaskLogServerError builds a control-plane service
-// get-database-task-log endpoint server_error error.
-func NewGetDatabaseTaskLogServerError(body *GetDatabaseTaskLogServerErrorResponseBody) *controlplane.APIError {
+// NewRestoreDatabaseInvalidInput builds a control-plane service
+// restore-database endpoint invalid_input error.
+func NewRestoreDatabaseInvalidInput(body *RestoreDatabaseInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4499,36 +7723,44 @@ func NewGetDatabaseTaskLogServerError(body *GetDatabaseTaskLogServerErrorRespons
 	return v
 }
 
-// NewListHostTasksResponseOK builds a "control-plane" service
-// "list-host-tasks" endpoint result from a HTTP "OK" response.
-func NewListHostTasksResponseOK(body *ListHostTasksResponseBody) *controlplane.ListHostTasksResponse {
-	v := &controlplane.ListHostTasksResponse{}
-	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
-	for i, val := range body.Tasks {
-		if val == nil {
-			v.Tasks[i] = nil
-			continue
-		}
-		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+// NewRestoreDatabaseNotFound builds a control-plane service restore-database
+// endpoint not_found error.
+func NewRestoreDatabaseNotFound(body *RestoreDatabaseNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreDatabaseServerError builds a control-plane service
+// restore-database endpoint server_error error.
+func NewRestoreDatabaseServerError(body *RestoreDatabaseServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewListHostTasksClusterNotInitialized builds a control-plane service
-// list-host-tasks endpoint cluster_not_initialized error.
-func NewListHostTasksClusterNotInitialized(body *ListHostTasksClusterNotInitializedResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewCloneDatabaseResponseOK builds a "control-plane" service "clone-database"
+// endpoint result from a HTTP "OK" response.
+func NewCloneDatabaseResponseOK(body *CloneDatabaseResponseBody) *controlplane.CloneDatabaseResponse {
+	v := &controlplane.CloneDatabaseResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+	if body.BackupTask != nil {
+		v.BackupTask = unmarshalTaskResponseBodyToControlplaneTask(body.BackupTask)
 	}
+	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
 
 	return v
 }
 
-// NewListHostTasksInvalidInput builds a control-plane service list-host-tasks
-// endpoint invalid_input error.
-func NewListHostTasksInvalidInput(body *ListHostTasksInvalidInputResponseBody) *controlplane.APIError {
+// NewCloneDatabaseDatabaseAlreadyExists builds a control-plane service
+// clone-database endpoint database_already_exists error.
+func NewCloneDatabaseDatabaseAlreadyExists(body *CloneDatabaseDatabaseAlreadyExistsResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4537,9 +7769,9 @@ func NewListHostTasksInvalidInput(body *ListHostTasksInvalidInputResponseBody) *
 	return v
 }
 
-// NewListHostTasksNotFound builds a control-plane service list-host-tasks
-// endpoint not_found error.
-func NewListHostTasksNotFound(body *ListHostTasksNotFoundResponseBody) *controlplane.APIError {
+// NewCloneDatabaseClusterNotInitialized builds a control-plane service
+// clone-database endpoint cluster_not_initialized error.
+func NewCloneDatabaseClusterNotInitialized(body *CloneDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4548,9 +7780,9 @@ func NewListHostTasksNotFound(body *ListHostTasksNotFoundResponseBody) *controlp
 	return v
 }
 
-// NewListHostTasksServerError builds a control-plane service list-host-tasks
-// endpoint server_error error.
-func NewListHostTasksServerError(body *ListHostTasksServerErrorResponseBody) *controlplane.APIError {
+// NewCloneDatabaseOperationAlreadyInProgress builds a control-plane service
+// clone-database endpoint operation_already_in_progress error.
+func NewCloneDatabaseOperationAlreadyInProgress(body *CloneDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4559,31 +7791,20 @@ func NewListHostTasksServerError(body *ListHostTasksServerErrorResponseBody) *co
 	return v
 }
 
-// NewGetHostTaskTaskOK builds a "control-plane" service "get-host-task"
-// endpoint result from a HTTP "OK" response.
-func NewGetHostTaskTaskOK(body *GetHostTaskResponseBody) *controlplane.Task {
-	v := &controlplane.Task{
-		ParentID:    body.ParentID,
-		Scope:       *body.Scope,
-		EntityID:    *body.EntityID,
-		DatabaseID:  body.DatabaseID,
-		NodeName:    body.NodeName,
-		InstanceID:  body.InstanceID,
-		HostID:      body.HostID,
-		TaskID:      *body.TaskID,
-		CreatedAt:   *body.CreatedAt,
-		CompletedAt: body.CompletedAt,
-		Type:        *body.Type,
-		Status:      *body.Status,
-		Error:       body.Error,
+// NewCloneDatabaseInvalidInput builds a control-plane service clone-database
+// endpoint invalid_input error.
+func NewCloneDatabaseInvalidInput(body *CloneDatabaseInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetHostTaskClusterNotInitialized builds a control-plane service
-// get-host-task endpoint cluster_not_initialized error.
-func NewGetHostTaskClusterNotInitialized(body *GetHostTaskClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewCloneDatabaseNotFound builds a control-plane service clone-database
+// endpoint not_found error.
+func NewCloneDatabaseNotFound(body *CloneDatabaseNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4592,9 +7813,9 @@ func NewGetHostTaskClusterNotInitialized(body *GetHostTaskClusterNotInitializedR
 	return v
 }
 
-// NewGetHostTaskInvalidInput builds a control-plane service get-host-task
-// endpoint invalid_input error.
-func NewGetHostTaskInvalidInput(body *GetHostTaskInvalidInputResponseBody) *controlplane.APIError {
+// NewCloneDatabaseServerError builds a control-plane service clone-database
+// endpoint server_error error.
+func NewCloneDatabaseServerError(body *CloneDatabaseServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4603,20 +7824,22 @@ func NewGetHostTaskInvalidInput(body *GetHostTaskInvalidInputResponseBody) *cont
 	return v
 }
 
-// NewGetHostTaskNotFound builds a control-plane service get-host-task endpoint
-// not_found error.
-func NewGetHostTaskNotFound(body *GetHostTaskNotFoundResponseBody) *controlplane.APIError {
-	v := &controlplane.APIError{
-		Name:    *body.Name,
-		Message: *body.Message,
+// NewGetVersionVersionInfoOK builds a "control-plane" service "get-version"
+// endpoint result from a HTTP "OK" response.
+func NewGetVersionVersionInfoOK(body *GetVersionResponseBody) *controlplane.VersionInfo {
+	v := &controlplane.VersionInfo{
+		Version:      *body.Version,
+		Revision:     *body.Revision,
+		RevisionTime: *body.RevisionTime,
+		Arch:         *body.Arch,
 	}
 
 	return v
 }
 
-// NewGetHostTaskServerError builds a control-plane service get-host-task
-// endpoint server_error error.
-func NewGetHostTaskServerError(body *GetHostTaskServerErrorResponseBody) *controlplane.APIError {
+// NewGetVersionServerError builds a control-plane service get-version endpoint
+// server_error error.
+func NewGetVersionServerError(body *GetVersionServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4625,32 +7848,18 @@ func NewGetHostTaskServerError(body *GetHostTaskServerErrorResponseBody) *contro
 	return v
 }
 
-// NewGetHostTaskLogTaskLogOK builds a "control-plane" service
-// "get-host-task-log" endpoint result from a HTTP "OK" response.
-func NewGetHostTaskLogTaskLogOK(body *GetHostTaskLogResponseBody) *controlplane.TaskLog {
-	v := &controlplane.TaskLog{
-		Scope:       *body.Scope,
-		EntityID:    *body.EntityID,
-		DatabaseID:  body.DatabaseID,
-		TaskID:      *body.TaskID,
-		TaskStatus:  *body.TaskStatus,
-		LastEntryID: body.LastEntryID,
-	}
-	v.Entries = make([]*controlplane.TaskLogEntry, len(body.Entries))
-	for i, val := range body.Entries {
-		if val == nil {
-			v.Entries[i] = nil
-			continue
-		}
-		v.Entries[i] = unmarshalTaskLogEntryResponseBodyToControlplaneTaskLogEntry(val)
-	}
+// NewRestartInstanceResponseOK builds a "control-plane" service
+// "restart-instance" endpoint result from a HTTP "OK" response.
+func NewRestartInstanceResponseOK(body *RestartInstanceResponseBody) *controlplane.RestartInstanceResponse {
+	v := &controlplane.RestartInstanceResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
 
 	return v
 }
 
-// NewGetHostTaskLogClusterNotInitialized builds a control-plane service
-// get-host-task-log endpoint cluster_not_initialized error.
-func NewGetHostTaskLogClusterNotInitialized(body *GetHostTaskLogClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewRestartInstanceClusterNotInitialized builds a control-plane service
+// restart-instance endpoint cluster_not_initialized error.
+func NewRestartInstanceClusterNotInitialized(body *RestartInstanceClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4659,9 +7868,9 @@ func NewGetHostTaskLogClusterNotInitialized(body *GetHostTaskLogClusterNotInitia
 	return v
 }
 
-// NewGetHostTaskLogInvalidInput builds a control-plane service
-// get-host-task-log endpoint invalid_input error.
-func NewGetHostTaskLogInvalidInput(body *GetHostTaskLogInvalidInputResponseBody) *controlplane.APIError {
+// NewRestartInstanceInvalidInput builds a control-plane service
+// restart-instance endpoint invalid_input error.
+func NewRestartInstanceInvalidInput(body *RestartInstanceInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4670,9 +7879,9 @@ func NewGetHostTaskLogInvalidInput(body *GetHostTaskLogInvalidInputResponseBody)
 	return v
 }
 
-// NewGetHostTaskLogNotFound builds a control-plane service get-host-task-log
+// NewRestartInstanceNotFound builds a control-plane service restart-instance
 // endpoint not_found error.
-func NewGetHostTaskLogNotFound(body *GetHostTaskLogNotFoundResponseBody) *controlplane.APIError {
+func NewRestartInstanceNotFound(body *RestartInstanceNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4681,9 +7890,9 @@ func NewGetHostTaskLogNotFound(body *GetHostTaskLogNotFoundResponseBody) *contro
 	return v
 }
 
-// NewGetHostTaskLogServerError builds a control-plane service
-// get-host-task-log endpoint server_error error.
-func NewGetHostTaskLogServerError(body *GetHostTaskLogServerErrorResponseBody) *controlplane.APIError {
+// NewRestartInstanceServerError builds a control-plane service
+// restart-instance endpoint server_error error.
+func NewRestartInstanceServerError(body *RestartInstanceServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4692,25 +7901,32 @@ func NewGetHostTaskLogServerError(body *GetHostTaskLogServerErrorResponseBody) *
 	return v
 }
 
-// NewListTasksResponseOK builds a "control-plane" service "list-tasks"
-// endpoint result from a HTTP "OK" response.
-func NewListTasksResponseOK(body *ListTasksResponseBody) *controlplane.ListTasksResponse {
-	v := &controlplane.ListTasksResponse{}
-	v.Tasks = make([]*controlplane.Task, len(body.Tasks))
-	for i, val := range body.Tasks {
+// NewGetInstanceSessionsResponseOK builds a "control-plane" service
+// "get-instance-sessions" endpoint result from a HTTP "OK" response.
+func NewGetInstanceSessionsResponseOK(body *GetInstanceSessionsResponseBody) *controlplane.GetInstanceSessionsResponse {
+	v := &controlplane.GetInstanceSessionsResponse{}
+	v.Sessions = make([]*controlplane.InstanceSession, len(body.Sessions))
+	for i, val := range body.Sessions {
 		if val == nil {
-			v.Tasks[i] = nil
+			v.Sessions[i] = nil
 			continue
 		}
-		v.Tasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+		v.Sessions[i] = unmarshalInstanceSessionResponseBodyToControlplaneInstanceSession(val)
+	}
+	v.BlockingChains = make([][]int, len(body.BlockingChains))
+	for i, val := range body.BlockingChains {
+		v.BlockingChains[i] = make([]int, len(val))
+		for j, val := range val {
+			v.BlockingChains[i][j] = val
+		}
 	}
 
 	return v
 }
 
-// NewListTasksClusterNotInitialized builds a control-plane service list-tasks
-// endpoint cluster_not_initialized error.
-func NewListTasksClusterNotInitialized(body *ListTasksClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetInstanceSessionsClusterNotInitialized builds a control-plane service
+// get-instance-sessions endpoint cluster_not_initialized error.
+func NewGetInstanceSessionsClusterNotInitialized(body *GetInstanceSessionsClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4719,9 +7935,9 @@ func NewListTasksClusterNotInitialized(body *ListTasksClusterNotInitializedRespo
 	return v
 }
 
-// NewListTasksInvalidInput builds a control-plane service list-tasks endpoint
-// invalid_input error.
-func NewListTasksInvalidInput(body *ListTasksInvalidInputResponseBody) *controlplane.APIError {
+// NewGetInstanceSessionsInvalidInput builds a control-plane service
+// get-instance-sessions endpoint invalid_input error.
+func NewGetInstanceSessionsInvalidInput(body *GetInstanceSessionsInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4730,9 +7946,9 @@ func NewListTasksInvalidInput(body *ListTasksInvalidInputResponseBody) *controlp
 	return v
 }
 
-// NewListTasksServerError builds a control-plane service list-tasks endpoint
-// server_error error.
-func NewListTasksServerError(body *ListTasksServerErrorResponseBody) *controlplane.APIError {
+// NewGetInstanceSessionsNotFound builds a control-plane service
+// get-instance-sessions endpoint not_found error.
+func NewGetInstanceSessionsNotFound(body *GetInstanceSessionsNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4741,27 +7957,30 @@ func NewListTasksServerError(body *ListTasksServerErrorResponseBody) *controlpla
 	return v
 }
 
-// NewRestoreDatabaseResponseOK builds a "control-plane" service
-// "restore-database" endpoint result from a HTTP "OK" response.
-func NewRestoreDatabaseResponseOK(body *RestoreDatabaseResponseBody) *controlplane.RestoreDatabaseResponse {
-	v := &controlplane.RestoreDatabaseResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
-	v.NodeTasks = make([]*controlplane.Task, len(body.NodeTasks))
-	for i, val := range body.NodeTasks {
-		if val == nil {
-			v.NodeTasks[i] = nil
-			continue
-		}
-		v.NodeTasks[i] = unmarshalTaskResponseBodyToControlplaneTask(val)
+// NewGetInstanceSessionsServerError builds a control-plane service
+// get-instance-sessions endpoint server_error error.
+func NewGetInstanceSessionsServerError(body *GetInstanceSessionsServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
-	v.Database = unmarshalDatabaseResponseBodyToControlplaneDatabase(body.Database)
 
 	return v
 }
 
-// NewRestoreDatabaseClusterNotInitialized builds a control-plane service
-// restore-database endpoint cluster_not_initialized error.
-func NewRestoreDatabaseClusterNotInitialized(body *RestoreDatabaseClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewCancelInstanceSessionSignalInstanceSessionResponseOK builds a
+// "control-plane" service "cancel-instance-session" endpoint result from a
+// HTTP "OK" response.
+func NewCancelInstanceSessionSignalInstanceSessionResponseOK(body *CancelInstanceSessionResponseBody) *controlplane.SignalInstanceSessionResponse {
+	v := &controlplane.SignalInstanceSessionResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewCancelInstanceSessionClusterNotInitialized builds a control-plane service
+// cancel-instance-session endpoint cluster_not_initialized error.
+func NewCancelInstanceSessionClusterNotInitialized(body *CancelInstanceSessionClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4770,9 +7989,9 @@ func NewRestoreDatabaseClusterNotInitialized(body *RestoreDatabaseClusterNotInit
 	return v
 }
 
-// NewRestoreDatabaseDatabaseNotModifiable builds a control-plane service
-// restore-database endpoint database_not_modifiable error.
-func NewRestoreDatabaseDatabaseNotModifiable(body *RestoreDatabaseDatabaseNotModifiableResponseBody) *controlplane.APIError {
+// NewCancelInstanceSessionInvalidInput builds a control-plane service
+// cancel-instance-session endpoint invalid_input error.
+func NewCancelInstanceSessionInvalidInput(body *CancelInstanceSessionInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4781,9 +8000,9 @@ func NewRestoreDatabaseDatabaseNotModifiable(body *RestoreDatabaseDatabaseNotMod
 	return v
 }
 
-// NewRestoreDatabaseOperationAlreadyInProgress builds a control-plane service
-// restore-database endpoint operation_already_in_progress error.
-func NewRestoreDatabaseOperationAlreadyInProgress(body *RestoreDatabaseOperationAlreadyInProgressResponseBody) *controlplane.APIError {
+// NewCancelInstanceSessionNotFound builds a control-plane service
+// cancel-instance-session endpoint not_found error.
+func NewCancelInstanceSessionNotFound(body *CancelInstanceSessionNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4792,9 +8011,9 @@ func NewRestoreDatabaseOperationAlreadyInProgress(body *RestoreDatabaseOperation
 	return v
 }
 
-// NewRestoreDatabaseInvalidInput builds a control-plane service
-// restore-database endpoint invalid_input error.
-func NewRestoreDatabaseInvalidInput(body *RestoreDatabaseInvalidInputResponseBody) *controlplane.APIError {
+// NewCancelInstanceSessionServerError builds a control-plane service
+// cancel-instance-session endpoint server_error error.
+func NewCancelInstanceSessionServerError(body *CancelInstanceSessionServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4803,9 +8022,19 @@ func NewRestoreDatabaseInvalidInput(body *RestoreDatabaseInvalidInputResponseBod
 	return v
 }
 
-// NewRestoreDatabaseNotFound builds a control-plane service restore-database
-// endpoint not_found error.
-func NewRestoreDatabaseNotFound(body *RestoreDatabaseNotFoundResponseBody) *controlplane.APIError {
+// NewTerminateInstanceSessionSignalInstanceSessionResponseOK builds a
+// "control-plane" service "terminate-instance-session" endpoint result from a
+// HTTP "OK" response.
+func NewTerminateInstanceSessionSignalInstanceSessionResponseOK(body *TerminateInstanceSessionResponseBody) *controlplane.SignalInstanceSessionResponse {
+	v := &controlplane.SignalInstanceSessionResponse{}
+	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+
+	return v
+}
+
+// NewTerminateInstanceSessionClusterNotInitialized builds a control-plane
+// service terminate-instance-session endpoint cluster_not_initialized error.
+func NewTerminateInstanceSessionClusterNotInitialized(body *TerminateInstanceSessionClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4814,9 +8043,9 @@ func NewRestoreDatabaseNotFound(body *RestoreDatabaseNotFoundResponseBody) *cont
 	return v
 }
 
-// NewRestoreDatabaseServerError builds a control-plane service
-// restore-database endpoint server_error error.
-func NewRestoreDatabaseServerError(body *RestoreDatabaseServerErrorResponseBody) *controlplane.APIError {
+// NewTerminateInstanceSessionInvalidInput builds a control-plane service
+// terminate-instance-session endpoint invalid_input error.
+func NewTerminateInstanceSessionInvalidInput(body *TerminateInstanceSessionInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4825,22 +8054,20 @@ func NewRestoreDatabaseServerError(body *RestoreDatabaseServerErrorResponseBody)
 	return v
 }
 
-// NewGetVersionVersionInfoOK builds a "control-plane" service "get-version"
-// endpoint result from a HTTP "OK" response.
-func NewGetVersionVersionInfoOK(body *GetVersionResponseBody) *controlplane.VersionInfo {
-	v := &controlplane.VersionInfo{
-		Version:      *body.Version,
-		Revision:     *body.Revision,
-		RevisionTime: *body.RevisionTime,
-		Arch:         *body.Arch,
+// NewTerminateInstanceSessionNotFound builds a control-plane service
+// terminate-instance-session endpoint not_found error.
+func NewTerminateInstanceSessionNotFound(body *TerminateInstanceSessionNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetVersionServerError builds a control-plane service get-version endpoint
-// server_error error.
-func NewGetVersionServerError(body *GetVersionServerErrorResponseBody) *controlplane.APIError {
+// NewTerminateInstanceSessionServerError builds a control-plane service
+// terminate-instance-session endpoint server_error error.
+func NewTerminateInstanceSessionServerError(body *TerminateInstanceSessionServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4849,18 +8076,26 @@ func NewGetVersionServerError(body *GetVersionServerErrorResponseBody) *controlp
 	return v
 }
 
-// NewRestartInstanceResponseOK builds a "control-plane" service
-// "restart-instance" endpoint result from a HTTP "OK" response.
-func NewRestartInstanceResponseOK(body *RestartInstanceResponseBody) *controlplane.RestartInstanceResponse {
-	v := &controlplane.RestartInstanceResponse{}
-	v.Task = unmarshalTaskResponseBodyToControlplaneTask(body.Task)
+// NewGetInstancePostgresqlConfGetInstancePostgreSQLConfResponseOK builds a
+// "control-plane" service "get-instance-postgresql-conf" endpoint result from
+// a HTTP "OK" response.
+func NewGetInstancePostgresqlConfGetInstancePostgreSQLConfResponseOK(body *GetInstancePostgresqlConfResponseBody) *controlplane.GetInstancePostgreSQLConfResponse {
+	v := &controlplane.GetInstancePostgreSQLConfResponse{}
+	v.Settings = make([]*controlplane.PostgreSQLSetting, len(body.Settings))
+	for i, val := range body.Settings {
+		if val == nil {
+			v.Settings[i] = nil
+			continue
+		}
+		v.Settings[i] = unmarshalPostgreSQLSettingResponseBodyToControlplanePostgreSQLSetting(val)
+	}
 
 	return v
 }
 
-// NewRestartInstanceClusterNotInitialized builds a control-plane service
-// restart-instance endpoint cluster_not_initialized error.
-func NewRestartInstanceClusterNotInitialized(body *RestartInstanceClusterNotInitializedResponseBody) *controlplane.APIError {
+// NewGetInstancePostgresqlConfClusterNotInitialized builds a control-plane
+// service get-instance-postgresql-conf endpoint cluster_not_initialized error.
+func NewGetInstancePostgresqlConfClusterNotInitialized(body *GetInstancePostgresqlConfClusterNotInitializedResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4869,9 +8104,9 @@ func NewRestartInstanceClusterNotInitialized(body *RestartInstanceClusterNotInit
 	return v
 }
 
-// NewRestartInstanceInvalidInput builds a control-plane service
-// restart-instance endpoint invalid_input error.
-func NewRestartInstanceInvalidInput(body *RestartInstanceInvalidInputResponseBody) *controlplane.APIError {
+// NewGetInstancePostgresqlConfInvalidInput builds a control-plane service
+// get-instance-postgresql-conf endpoint invalid_input error.
+func NewGetInstancePostgresqlConfInvalidInput(body *GetInstancePostgresqlConfInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4880,9 +8115,9 @@ func NewRestartInstanceInvalidInput(body *RestartInstanceInvalidInputResponseBod
 	return v
 }
 
-// NewRestartInstanceNotFound builds a control-plane service restart-instance
-// endpoint not_found error.
-func NewRestartInstanceNotFound(body *RestartInstanceNotFoundResponseBody) *controlplane.APIError {
+// NewGetInstancePostgresqlConfNotFound builds a control-plane service
+// get-instance-postgresql-conf endpoint not_found error.
+func NewGetInstancePostgresqlConfNotFound(body *GetInstancePostgresqlConfNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -4891,9 +8126,9 @@ func NewRestartInstanceNotFound(body *RestartInstanceNotFoundResponseBody) *cont
 	return v
 }
 
-// NewRestartInstanceServerError builds a control-plane service
-// restart-instance endpoint server_error error.
-func NewRestartInstanceServerError(body *RestartInstanceServerErrorResponseBody) *controlplane.APIError {
+// NewGetInstancePostgresqlConfServerError builds a control-plane service
+// get-instance-postgresql-conf endpoint server_error error.
+func NewGetInstancePostgresqlConfServerError(body *GetInstancePostgresqlConfServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -5012,27 +8247,95 @@ func NewStartInstanceServerError(body *StartInstanceServerErrorResponseBody) *co
 // "cancel-database-task" endpoint result from a HTTP "OK" response.
 func NewCancelDatabaseTaskTaskOK(body *CancelDatabaseTaskResponseBody) *controlplane.Task {
 	v := &controlplane.Task{
-		ParentID:    body.ParentID,
-		Scope:       *body.Scope,
-		EntityID:    *body.EntityID,
-		DatabaseID:  body.DatabaseID,
-		NodeName:    body.NodeName,
-		InstanceID:  body.InstanceID,
-		HostID:      body.HostID,
-		TaskID:      *body.TaskID,
-		CreatedAt:   *body.CreatedAt,
-		CompletedAt: body.CompletedAt,
-		Type:        *body.Type,
-		Status:      *body.Status,
-		Error:       body.Error,
+		ParentID:     body.ParentID,
+		Scope:        *body.Scope,
+		EntityID:     *body.EntityID,
+		DatabaseID:   body.DatabaseID,
+		NodeName:     body.NodeName,
+		InstanceID:   body.InstanceID,
+		HostID:       body.HostID,
+		TaskID:       *body.TaskID,
+		CreatedAt:    *body.CreatedAt,
+		CompletedAt:  body.CompletedAt,
+		Type:         *body.Type,
+		Status:       *body.Status,
+		StatusReason: body.StatusReason,
+		Error:        body.Error,
+	}
+
+	return v
+}
+
+// NewCancelDatabaseTaskNotFound builds a control-plane service
+// cancel-database-task endpoint not_found error.
+func NewCancelDatabaseTaskNotFound(body *CancelDatabaseTaskNotFoundResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelDatabaseTaskInvalidInput builds a control-plane service
+// cancel-database-task endpoint invalid_input error.
+func NewCancelDatabaseTaskInvalidInput(body *CancelDatabaseTaskInvalidInputResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelDatabaseTaskServerError builds a control-plane service
+// cancel-database-task endpoint server_error error.
+func NewCancelDatabaseTaskServerError(body *CancelDatabaseTaskServerErrorResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResumeDatabaseTaskTaskOK builds a "control-plane" service
+// "resume-database-task" endpoint result from a HTTP "OK" response.
+func NewResumeDatabaseTaskTaskOK(body *ResumeDatabaseTaskResponseBody) *controlplane.Task {
+	v := &controlplane.Task{
+		ParentID:     body.ParentID,
+		Scope:        *body.Scope,
+		EntityID:     *body.EntityID,
+		DatabaseID:   body.DatabaseID,
+		NodeName:     body.NodeName,
+		InstanceID:   body.InstanceID,
+		HostID:       body.HostID,
+		TaskID:       *body.TaskID,
+		CreatedAt:    *body.CreatedAt,
+		CompletedAt:  body.CompletedAt,
+		Type:         *body.Type,
+		Status:       *body.Status,
+		StatusReason: body.StatusReason,
+		Error:        body.Error,
+	}
+
+	return v
+}
+
+// NewResumeDatabaseTaskClusterNotInitialized builds a control-plane service
+// resume-database-task endpoint cluster_not_initialized error.
+func NewResumeDatabaseTaskClusterNotInitialized(body *ResumeDatabaseTaskClusterNotInitializedResponseBody) *controlplane.APIError {
+	v := &controlplane.APIError{
+		Name:    *body.Name,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewCancelDatabaseTaskNotFound builds a control-plane service
-// cancel-database-task endpoint not_found error.
-func NewCancelDatabaseTaskNotFound(body *CancelDatabaseTaskNotFoundResponseBody) *controlplane.APIError {
+// NewResumeDatabaseTaskNotFound builds a control-plane service
+// resume-database-task endpoint not_found error.
+func NewResumeDatabaseTaskNotFound(body *ResumeDatabaseTaskNotFoundResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -5041,9 +8344,9 @@ func NewCancelDatabaseTaskNotFound(body *CancelDatabaseTaskNotFoundResponseBody)
 	return v
 }
 
-// NewCancelDatabaseTaskInvalidInput builds a control-plane service
-// cancel-database-task endpoint invalid_input error.
-func NewCancelDatabaseTaskInvalidInput(body *CancelDatabaseTaskInvalidInputResponseBody) *controlplane.APIError {
+// NewResumeDatabaseTaskInvalidInput builds a control-plane service
+// resume-database-task endpoint invalid_input error.
+func NewResumeDatabaseTaskInvalidInput(body *ResumeDatabaseTaskInvalidInputResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -5052,9 +8355,9 @@ func NewCancelDatabaseTaskInvalidInput(body *CancelDatabaseTaskInvalidInputRespo
 	return v
 }
 
-// NewCancelDatabaseTaskServerError builds a control-plane service
-// cancel-database-task endpoint server_error error.
-func NewCancelDatabaseTaskServerError(body *CancelDatabaseTaskServerErrorResponseBody) *controlplane.APIError {
+// NewResumeDatabaseTaskServerError builds a control-plane service
+// resume-database-task endpoint server_error error.
+func NewResumeDatabaseTaskServerError(body *ResumeDatabaseTaskServerErrorResponseBody) *controlplane.APIError {
 	v := &controlplane.APIError{
 		Name:    *body.Name,
 		Message: *body.Message,
@@ -5087,6 +8390,24 @@ func ValidateGetClusterResponseBody(body *GetClusterResponseBody) (err error) {
 	return
 }
 
+// ValidateGetStorageUsageResponseBody runs a no-op validation on
+// Get-Storage-UsageResponseBody
+func ValidateGetStorageUsageResponseBody(body *GetStorageUsageResponseBody) (err error) {
+	return
+}
+
+// ValidateGetEtcdClusterResponseBody runs a no-op validation on
+// Get-Etcd-ClusterResponseBody
+func ValidateGetEtcdClusterResponseBody(body *GetEtcdClusterResponseBody) (err error) {
+	return
+}
+
+// ValidateDefragmentEtcdResponseBody runs a no-op validation on
+// Defragment-EtcdResponseBody
+func ValidateDefragmentEtcdResponseBody(body *DefragmentEtcdResponseBody) (err error) {
+	return
+}
+
 // ValidateListHostsResponseBody runs a no-op validation on
 // List-HostsResponseBody
 func ValidateListHostsResponseBody(body *ListHostsResponseBody) (err error) {
@@ -5104,6 +8425,12 @@ func ValidateRemoveHostResponseBody(body *RemoveHostResponseBody) (err error) {
 	return
 }
 
+// ValidateChangeHostEtcdModeResponseBody runs a no-op validation on
+// Change-Host-Etcd-ModeResponseBody
+func ValidateChangeHostEtcdModeResponseBody(body *ChangeHostEtcdModeResponseBody) (err error) {
+	return
+}
+
 // ValidateListDatabasesResponseBody runs a no-op validation on
 // List-DatabasesResponseBody
 func ValidateListDatabasesResponseBody(body *ListDatabasesResponseBody) (err error) {
@@ -5134,6 +8461,18 @@ func ValidateApplyUpgradeResponseBody(body *ApplyUpgradeResponseBody) (err error
 	return
 }
 
+// ValidateUpgradeDatabaseMajorResponseBody runs a no-op validation on
+// Upgrade-Database-MajorResponseBody
+func ValidateUpgradeDatabaseMajorResponseBody(body *UpgradeDatabaseMajorResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportResponseBody runs a no-op validation on
+// Cutover-Database-ImportResponseBody
+func ValidateCutoverDatabaseImportResponseBody(body *CutoverDatabaseImportResponseBody) (err error) {
+	return
+}
+
 // ValidateDeleteDatabaseResponseBody runs a no-op validation on
 // Delete-DatabaseResponseBody
 func ValidateDeleteDatabaseResponseBody(body *DeleteDatabaseResponseBody) (err error) {
@@ -5152,12 +8491,30 @@ func ValidateSwitchoverDatabaseNodeResponseBody(body *SwitchoverDatabaseNodeResp
 	return
 }
 
+// ValidateRollingRestartDatabaseResponseBody runs a no-op validation on
+// Rolling-Restart-DatabaseResponseBody
+func ValidateRollingRestartDatabaseResponseBody(body *RollingRestartDatabaseResponseBody) (err error) {
+	return
+}
+
 // ValidateFailoverDatabaseNodeResponseBody runs a no-op validation on
 // Failover-Database-NodeResponseBody
 func ValidateFailoverDatabaseNodeResponseBody(body *FailoverDatabaseNodeResponseBody) (err error) {
 	return
 }
 
+// ValidateGetNodeQueryStatsResponseBody runs a no-op validation on
+// Get-Node-Query-StatsResponseBody
+func ValidateGetNodeQueryStatsResponseBody(body *GetNodeQueryStatsResponseBody) (err error) {
+	return
+}
+
+// ValidateResetNodeQueryStatsResponseBody runs a no-op validation on
+// Reset-Node-Query-StatsResponseBody
+func ValidateResetNodeQueryStatsResponseBody(body *ResetNodeQueryStatsResponseBody) (err error) {
+	return
+}
+
 // ValidateListDatabaseTasksResponseBody runs a no-op validation on
 // List-Database-TasksResponseBody
 func ValidateListDatabaseTasksResponseBody(body *ListDatabaseTasksResponseBody) (err error) {
@@ -5200,12 +8557,48 @@ func ValidateListTasksResponseBody(body *ListTasksResponseBody) (err error) {
 	return
 }
 
+// ValidateStreamEventsResponseBody runs a no-op validation on
+// Stream-EventsResponseBody
+func ValidateStreamEventsResponseBody(body *StreamEventsResponseBody) (err error) {
+	return
+}
+
+// ValidateCreateWebhookResponseBody runs a no-op validation on
+// Create-WebhookResponseBody
+func ValidateCreateWebhookResponseBody(body *CreateWebhookResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhooksResponseBody runs a no-op validation on
+// List-WebhooksResponseBody
+func ValidateListWebhooksResponseBody(body *ListWebhooksResponseBody) (err error) {
+	return
+}
+
+// ValidateGetWebhookResponseBody runs a no-op validation on
+// Get-WebhookResponseBody
+func ValidateGetWebhookResponseBody(body *GetWebhookResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhookDeliveriesResponseBody runs a no-op validation on
+// List-Webhook-DeliveriesResponseBody
+func ValidateListWebhookDeliveriesResponseBody(body *ListWebhookDeliveriesResponseBody) (err error) {
+	return
+}
+
 // ValidateRestoreDatabaseResponseBody runs a no-op validation on
 // Restore-DatabaseResponseBody
 func ValidateRestoreDatabaseResponseBody(body *RestoreDatabaseResponseBody) (err error) {
 	return
 }
 
+// ValidateCloneDatabaseResponseBody runs a no-op validation on
+// Clone-DatabaseResponseBody
+func ValidateCloneDatabaseResponseBody(body *CloneDatabaseResponseBody) (err error) {
+	return
+}
+
 // ValidateGetVersionResponseBody runs a no-op validation on
 // Get-VersionResponseBody
 func ValidateGetVersionResponseBody(body *GetVersionResponseBody) (err error) {
@@ -5218,6 +8611,30 @@ func ValidateRestartInstanceResponseBody(body *RestartInstanceResponseBody) (err
 	return
 }
 
+// ValidateGetInstanceSessionsResponseBody runs a no-op validation on
+// Get-Instance-SessionsResponseBody
+func ValidateGetInstanceSessionsResponseBody(body *GetInstanceSessionsResponseBody) (err error) {
+	return
+}
+
+// ValidateCancelInstanceSessionResponseBody runs a no-op validation on
+// Cancel-Instance-SessionResponseBody
+func ValidateCancelInstanceSessionResponseBody(body *CancelInstanceSessionResponseBody) (err error) {
+	return
+}
+
+// ValidateTerminateInstanceSessionResponseBody runs a no-op validation on
+// Terminate-Instance-SessionResponseBody
+func ValidateTerminateInstanceSessionResponseBody(body *TerminateInstanceSessionResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstancePostgresqlConfResponseBody runs a no-op validation on
+// Get-Instance-Postgresql-ConfResponseBody
+func ValidateGetInstancePostgresqlConfResponseBody(body *GetInstancePostgresqlConfResponseBody) (err error) {
+	return
+}
+
 // ValidateStopInstanceResponseBody runs a no-op validation on
 // Stop-InstanceResponseBody
 func ValidateStopInstanceResponseBody(body *StopInstanceResponseBody) (err error) {
@@ -5236,6 +8653,12 @@ func ValidateCancelDatabaseTaskResponseBody(body *CancelDatabaseTaskResponseBody
 	return
 }
 
+// ValidateResumeDatabaseTaskResponseBody runs a no-op validation on
+// Resume-Database-TaskResponseBody
+func ValidateResumeDatabaseTaskResponseBody(body *ResumeDatabaseTaskResponseBody) (err error) {
+	return
+}
+
 // ValidateInitClusterClusterAlreadyInitializedResponseBody runs a no-op
 // validation on init-cluster_cluster_already_initialized_response_body
 func ValidateInitClusterClusterAlreadyInitializedResponseBody(body *InitClusterClusterAlreadyInitializedResponseBody) (err error) {
@@ -5326,12 +8749,60 @@ func ValidateGetClusterServerErrorResponseBody(body *GetClusterServerErrorRespon
 	return
 }
 
+// ValidateGetStorageUsageClusterNotInitializedResponseBody runs a no-op
+// validation on get-storage-usage_cluster_not_initialized_response_body
+func ValidateGetStorageUsageClusterNotInitializedResponseBody(body *GetStorageUsageClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetStorageUsageServerErrorResponseBody runs a no-op validation on
+// get-storage-usage_server_error_response_body
+func ValidateGetStorageUsageServerErrorResponseBody(body *GetStorageUsageServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateGetEtcdClusterClusterNotInitializedResponseBody runs a no-op
+// validation on get-etcd-cluster_cluster_not_initialized_response_body
+func ValidateGetEtcdClusterClusterNotInitializedResponseBody(body *GetEtcdClusterClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetEtcdClusterServerErrorResponseBody runs a no-op validation on
+// get-etcd-cluster_server_error_response_body
+func ValidateGetEtcdClusterServerErrorResponseBody(body *GetEtcdClusterServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateDefragmentEtcdClusterNotInitializedResponseBody runs a no-op
+// validation on defragment-etcd_cluster_not_initialized_response_body
+func ValidateDefragmentEtcdClusterNotInitializedResponseBody(body *DefragmentEtcdClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateDefragmentEtcdInvalidInputResponseBody runs a no-op validation on
+// defragment-etcd_invalid_input_response_body
+func ValidateDefragmentEtcdInvalidInputResponseBody(body *DefragmentEtcdInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateDefragmentEtcdServerErrorResponseBody runs a no-op validation on
+// defragment-etcd_server_error_response_body
+func ValidateDefragmentEtcdServerErrorResponseBody(body *DefragmentEtcdServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateListHostsClusterNotInitializedResponseBody runs a no-op validation
 // on list-hosts_cluster_not_initialized_response_body
 func ValidateListHostsClusterNotInitializedResponseBody(body *ListHostsClusterNotInitializedResponseBody) (err error) {
 	return
 }
 
+// ValidateListHostsInvalidInputResponseBody runs a no-op validation on
+// list-hosts_invalid_input_response_body
+func ValidateListHostsInvalidInputResponseBody(body *ListHostsInvalidInputResponseBody) (err error) {
+	return
+}
+
 // ValidateListHostsServerErrorResponseBody runs a no-op validation on
 // list-hosts_server_error_response_body
 func ValidateListHostsServerErrorResponseBody(body *ListHostsServerErrorResponseBody) (err error) {
@@ -5386,12 +8857,42 @@ func ValidateRemoveHostServerErrorResponseBody(body *RemoveHostServerErrorRespon
 	return
 }
 
+// ValidateChangeHostEtcdModeClusterNotInitializedResponseBody runs a no-op
+// validation on change-host-etcd-mode_cluster_not_initialized_response_body
+func ValidateChangeHostEtcdModeClusterNotInitializedResponseBody(body *ChangeHostEtcdModeClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateChangeHostEtcdModeInvalidInputResponseBody runs a no-op validation
+// on change-host-etcd-mode_invalid_input_response_body
+func ValidateChangeHostEtcdModeInvalidInputResponseBody(body *ChangeHostEtcdModeInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateChangeHostEtcdModeNotFoundResponseBody runs a no-op validation on
+// change-host-etcd-mode_not_found_response_body
+func ValidateChangeHostEtcdModeNotFoundResponseBody(body *ChangeHostEtcdModeNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateChangeHostEtcdModeServerErrorResponseBody runs a no-op validation on
+// change-host-etcd-mode_server_error_response_body
+func ValidateChangeHostEtcdModeServerErrorResponseBody(body *ChangeHostEtcdModeServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateListDatabasesClusterNotInitializedResponseBody runs a no-op
 // validation on list-databases_cluster_not_initialized_response_body
 func ValidateListDatabasesClusterNotInitializedResponseBody(body *ListDatabasesClusterNotInitializedResponseBody) (err error) {
 	return
 }
 
+// ValidateListDatabasesInvalidInputResponseBody runs a no-op validation on
+// list-databases_invalid_input_response_body
+func ValidateListDatabasesInvalidInputResponseBody(body *ListDatabasesInvalidInputResponseBody) (err error) {
+	return
+}
+
 // ValidateListDatabasesServerErrorResponseBody runs a no-op validation on
 // list-databases_server_error_response_body
 func ValidateListDatabasesServerErrorResponseBody(body *ListDatabasesServerErrorResponseBody) (err error) {
@@ -5524,6 +9025,80 @@ func ValidateApplyUpgradeServerErrorResponseBody(body *ApplyUpgradeServerErrorRe
 	return
 }
 
+// ValidateUpgradeDatabaseMajorClusterNotInitializedResponseBody runs a no-op
+// validation on upgrade-database-major_cluster_not_initialized_response_body
+func ValidateUpgradeDatabaseMajorClusterNotInitializedResponseBody(body *UpgradeDatabaseMajorClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateUpgradeDatabaseMajorDatabaseNotModifiableResponseBody runs a no-op
+// validation on upgrade-database-major_database_not_modifiable_response_body
+func ValidateUpgradeDatabaseMajorDatabaseNotModifiableResponseBody(body *UpgradeDatabaseMajorDatabaseNotModifiableResponseBody) (err error) {
+	return
+}
+
+// ValidateUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody runs a
+// no-op validation on
+// upgrade-database-major_operation_already_in_progress_response_body
+func ValidateUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody(body *UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody) (err error) {
+	return
+}
+
+// ValidateUpgradeDatabaseMajorInvalidInputResponseBody runs a no-op validation
+// on upgrade-database-major_invalid_input_response_body
+func ValidateUpgradeDatabaseMajorInvalidInputResponseBody(body *UpgradeDatabaseMajorInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateUpgradeDatabaseMajorNotFoundResponseBody runs a no-op validation on
+// upgrade-database-major_not_found_response_body
+func ValidateUpgradeDatabaseMajorNotFoundResponseBody(body *UpgradeDatabaseMajorNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateUpgradeDatabaseMajorServerErrorResponseBody runs a no-op validation
+// on upgrade-database-major_server_error_response_body
+func ValidateUpgradeDatabaseMajorServerErrorResponseBody(body *UpgradeDatabaseMajorServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportClusterNotInitializedResponseBody runs a no-op
+// validation on cutover-database-import_cluster_not_initialized_response_body
+func ValidateCutoverDatabaseImportClusterNotInitializedResponseBody(body *CutoverDatabaseImportClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportDatabaseNotModifiableResponseBody runs a no-op
+// validation on cutover-database-import_database_not_modifiable_response_body
+func ValidateCutoverDatabaseImportDatabaseNotModifiableResponseBody(body *CutoverDatabaseImportDatabaseNotModifiableResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportOperationAlreadyInProgressResponseBody runs a
+// no-op validation on
+// cutover-database-import_operation_already_in_progress_response_body
+func ValidateCutoverDatabaseImportOperationAlreadyInProgressResponseBody(body *CutoverDatabaseImportOperationAlreadyInProgressResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportInvalidInputResponseBody runs a no-op
+// validation on cutover-database-import_invalid_input_response_body
+func ValidateCutoverDatabaseImportInvalidInputResponseBody(body *CutoverDatabaseImportInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportNotFoundResponseBody runs a no-op validation on
+// cutover-database-import_not_found_response_body
+func ValidateCutoverDatabaseImportNotFoundResponseBody(body *CutoverDatabaseImportNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateCutoverDatabaseImportServerErrorResponseBody runs a no-op validation
+// on cutover-database-import_server_error_response_body
+func ValidateCutoverDatabaseImportServerErrorResponseBody(body *CutoverDatabaseImportServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateDeleteDatabaseClusterNotInitializedResponseBody runs a no-op
 // validation on delete-database_cluster_not_initialized_response_body
 func ValidateDeleteDatabaseClusterNotInitializedResponseBody(body *DeleteDatabaseClusterNotInitializedResponseBody) (err error) {
@@ -5634,6 +9209,43 @@ func ValidateSwitchoverDatabaseNodeServerErrorResponseBody(body *SwitchoverDatab
 	return
 }
 
+// ValidateRollingRestartDatabaseClusterNotInitializedResponseBody runs a no-op
+// validation on rolling-restart-database_cluster_not_initialized_response_body
+func ValidateRollingRestartDatabaseClusterNotInitializedResponseBody(body *RollingRestartDatabaseClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateRollingRestartDatabaseDatabaseNotModifiableResponseBody runs a no-op
+// validation on rolling-restart-database_database_not_modifiable_response_body
+func ValidateRollingRestartDatabaseDatabaseNotModifiableResponseBody(body *RollingRestartDatabaseDatabaseNotModifiableResponseBody) (err error) {
+	return
+}
+
+// ValidateRollingRestartDatabaseOperationAlreadyInProgressResponseBody runs a
+// no-op validation on
+// rolling-restart-database_operation_already_in_progress_response_body
+func ValidateRollingRestartDatabaseOperationAlreadyInProgressResponseBody(body *RollingRestartDatabaseOperationAlreadyInProgressResponseBody) (err error) {
+	return
+}
+
+// ValidateRollingRestartDatabaseInvalidInputResponseBody runs a no-op
+// validation on rolling-restart-database_invalid_input_response_body
+func ValidateRollingRestartDatabaseInvalidInputResponseBody(body *RollingRestartDatabaseInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateRollingRestartDatabaseNotFoundResponseBody runs a no-op validation
+// on rolling-restart-database_not_found_response_body
+func ValidateRollingRestartDatabaseNotFoundResponseBody(body *RollingRestartDatabaseNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateRollingRestartDatabaseServerErrorResponseBody runs a no-op
+// validation on rolling-restart-database_server_error_response_body
+func ValidateRollingRestartDatabaseServerErrorResponseBody(body *RollingRestartDatabaseServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateFailoverDatabaseNodeClusterNotInitializedResponseBody runs a no-op
 // validation on failover-database-node_cluster_not_initialized_response_body
 func ValidateFailoverDatabaseNodeClusterNotInitializedResponseBody(body *FailoverDatabaseNodeClusterNotInitializedResponseBody) (err error) {
@@ -5671,6 +9283,54 @@ func ValidateFailoverDatabaseNodeServerErrorResponseBody(body *FailoverDatabaseN
 	return
 }
 
+// ValidateGetNodeQueryStatsClusterNotInitializedResponseBody runs a no-op
+// validation on get-node-query-stats_cluster_not_initialized_response_body
+func ValidateGetNodeQueryStatsClusterNotInitializedResponseBody(body *GetNodeQueryStatsClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetNodeQueryStatsInvalidInputResponseBody runs a no-op validation on
+// get-node-query-stats_invalid_input_response_body
+func ValidateGetNodeQueryStatsInvalidInputResponseBody(body *GetNodeQueryStatsInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateGetNodeQueryStatsNotFoundResponseBody runs a no-op validation on
+// get-node-query-stats_not_found_response_body
+func ValidateGetNodeQueryStatsNotFoundResponseBody(body *GetNodeQueryStatsNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateGetNodeQueryStatsServerErrorResponseBody runs a no-op validation on
+// get-node-query-stats_server_error_response_body
+func ValidateGetNodeQueryStatsServerErrorResponseBody(body *GetNodeQueryStatsServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateResetNodeQueryStatsClusterNotInitializedResponseBody runs a no-op
+// validation on reset-node-query-stats_cluster_not_initialized_response_body
+func ValidateResetNodeQueryStatsClusterNotInitializedResponseBody(body *ResetNodeQueryStatsClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateResetNodeQueryStatsInvalidInputResponseBody runs a no-op validation
+// on reset-node-query-stats_invalid_input_response_body
+func ValidateResetNodeQueryStatsInvalidInputResponseBody(body *ResetNodeQueryStatsInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateResetNodeQueryStatsNotFoundResponseBody runs a no-op validation on
+// reset-node-query-stats_not_found_response_body
+func ValidateResetNodeQueryStatsNotFoundResponseBody(body *ResetNodeQueryStatsNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateResetNodeQueryStatsServerErrorResponseBody runs a no-op validation
+// on reset-node-query-stats_server_error_response_body
+func ValidateResetNodeQueryStatsServerErrorResponseBody(body *ResetNodeQueryStatsServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateListDatabaseTasksClusterNotInitializedResponseBody runs a no-op
 // validation on list-database-tasks_cluster_not_initialized_response_body
 func ValidateListDatabaseTasksClusterNotInitializedResponseBody(body *ListDatabaseTasksClusterNotInitializedResponseBody) (err error) {
@@ -5815,21 +9475,141 @@ func ValidateGetHostTaskLogServerErrorResponseBody(body *GetHostTaskLogServerErr
 	return
 }
 
-// ValidateListTasksClusterNotInitializedResponseBody runs a no-op validation
-// on list-tasks_cluster_not_initialized_response_body
-func ValidateListTasksClusterNotInitializedResponseBody(body *ListTasksClusterNotInitializedResponseBody) (err error) {
+// ValidateListTasksClusterNotInitializedResponseBody runs a no-op validation
+// on list-tasks_cluster_not_initialized_response_body
+func ValidateListTasksClusterNotInitializedResponseBody(body *ListTasksClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateListTasksInvalidInputResponseBody runs a no-op validation on
+// list-tasks_invalid_input_response_body
+func ValidateListTasksInvalidInputResponseBody(body *ListTasksInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateListTasksServerErrorResponseBody runs a no-op validation on
+// list-tasks_server_error_response_body
+func ValidateListTasksServerErrorResponseBody(body *ListTasksServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateStreamEventsClusterNotInitializedResponseBody runs a no-op
+// validation on stream-events_cluster_not_initialized_response_body
+func ValidateStreamEventsClusterNotInitializedResponseBody(body *StreamEventsClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateStreamEventsInvalidInputResponseBody runs a no-op validation on
+// stream-events_invalid_input_response_body
+func ValidateStreamEventsInvalidInputResponseBody(body *StreamEventsInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateStreamEventsServerErrorResponseBody runs a no-op validation on
+// stream-events_server_error_response_body
+func ValidateStreamEventsServerErrorResponseBody(body *StreamEventsServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateCreateWebhookClusterNotInitializedResponseBody runs a no-op
+// validation on create-webhook_cluster_not_initialized_response_body
+func ValidateCreateWebhookClusterNotInitializedResponseBody(body *CreateWebhookClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateCreateWebhookInvalidInputResponseBody runs a no-op validation on
+// create-webhook_invalid_input_response_body
+func ValidateCreateWebhookInvalidInputResponseBody(body *CreateWebhookInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateCreateWebhookServerErrorResponseBody runs a no-op validation on
+// create-webhook_server_error_response_body
+func ValidateCreateWebhookServerErrorResponseBody(body *CreateWebhookServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhooksClusterNotInitializedResponseBody runs a no-op
+// validation on list-webhooks_cluster_not_initialized_response_body
+func ValidateListWebhooksClusterNotInitializedResponseBody(body *ListWebhooksClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhooksServerErrorResponseBody runs a no-op validation on
+// list-webhooks_server_error_response_body
+func ValidateListWebhooksServerErrorResponseBody(body *ListWebhooksServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateGetWebhookClusterNotInitializedResponseBody runs a no-op validation
+// on get-webhook_cluster_not_initialized_response_body
+func ValidateGetWebhookClusterNotInitializedResponseBody(body *GetWebhookClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetWebhookInvalidInputResponseBody runs a no-op validation on
+// get-webhook_invalid_input_response_body
+func ValidateGetWebhookInvalidInputResponseBody(body *GetWebhookInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateGetWebhookNotFoundResponseBody runs a no-op validation on
+// get-webhook_not_found_response_body
+func ValidateGetWebhookNotFoundResponseBody(body *GetWebhookNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateGetWebhookServerErrorResponseBody runs a no-op validation on
+// get-webhook_server_error_response_body
+func ValidateGetWebhookServerErrorResponseBody(body *GetWebhookServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateDeleteWebhookClusterNotInitializedResponseBody runs a no-op
+// validation on delete-webhook_cluster_not_initialized_response_body
+func ValidateDeleteWebhookClusterNotInitializedResponseBody(body *DeleteWebhookClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateDeleteWebhookInvalidInputResponseBody runs a no-op validation on
+// delete-webhook_invalid_input_response_body
+func ValidateDeleteWebhookInvalidInputResponseBody(body *DeleteWebhookInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateDeleteWebhookNotFoundResponseBody runs a no-op validation on
+// delete-webhook_not_found_response_body
+func ValidateDeleteWebhookNotFoundResponseBody(body *DeleteWebhookNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateDeleteWebhookServerErrorResponseBody runs a no-op validation on
+// delete-webhook_server_error_response_body
+func ValidateDeleteWebhookServerErrorResponseBody(body *DeleteWebhookServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhookDeliveriesClusterNotInitializedResponseBody runs a no-op
+// validation on list-webhook-deliveries_cluster_not_initialized_response_body
+func ValidateListWebhookDeliveriesClusterNotInitializedResponseBody(body *ListWebhookDeliveriesClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateListWebhookDeliveriesInvalidInputResponseBody runs a no-op
+// validation on list-webhook-deliveries_invalid_input_response_body
+func ValidateListWebhookDeliveriesInvalidInputResponseBody(body *ListWebhookDeliveriesInvalidInputResponseBody) (err error) {
 	return
 }
 
-// ValidateListTasksInvalidInputResponseBody runs a no-op validation on
-// list-tasks_invalid_input_response_body
-func ValidateListTasksInvalidInputResponseBody(body *ListTasksInvalidInputResponseBody) (err error) {
+// ValidateListWebhookDeliveriesNotFoundResponseBody runs a no-op validation on
+// list-webhook-deliveries_not_found_response_body
+func ValidateListWebhookDeliveriesNotFoundResponseBody(body *ListWebhookDeliveriesNotFoundResponseBody) (err error) {
 	return
 }
 
-// ValidateListTasksServerErrorResponseBody runs a no-op validation on
-// list-tasks_server_error_response_body
-func ValidateListTasksServerErrorResponseBody(body *ListTasksServerErrorResponseBody) (err error) {
+// ValidateListWebhookDeliveriesServerErrorResponseBody runs a no-op validation
+// on list-webhook-deliveries_server_error_response_body
+func ValidateListWebhookDeliveriesServerErrorResponseBody(body *ListWebhookDeliveriesServerErrorResponseBody) (err error) {
 	return
 }
 
@@ -5869,6 +9649,42 @@ func ValidateRestoreDatabaseServerErrorResponseBody(body *RestoreDatabaseServerE
 	return
 }
 
+// ValidateCloneDatabaseDatabaseAlreadyExistsResponseBody runs a no-op
+// validation on clone-database_database_already_exists_response_body
+func ValidateCloneDatabaseDatabaseAlreadyExistsResponseBody(body *CloneDatabaseDatabaseAlreadyExistsResponseBody) (err error) {
+	return
+}
+
+// ValidateCloneDatabaseClusterNotInitializedResponseBody runs a no-op
+// validation on clone-database_cluster_not_initialized_response_body
+func ValidateCloneDatabaseClusterNotInitializedResponseBody(body *CloneDatabaseClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateCloneDatabaseOperationAlreadyInProgressResponseBody runs a no-op
+// validation on clone-database_operation_already_in_progress_response_body
+func ValidateCloneDatabaseOperationAlreadyInProgressResponseBody(body *CloneDatabaseOperationAlreadyInProgressResponseBody) (err error) {
+	return
+}
+
+// ValidateCloneDatabaseInvalidInputResponseBody runs a no-op validation on
+// clone-database_invalid_input_response_body
+func ValidateCloneDatabaseInvalidInputResponseBody(body *CloneDatabaseInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateCloneDatabaseNotFoundResponseBody runs a no-op validation on
+// clone-database_not_found_response_body
+func ValidateCloneDatabaseNotFoundResponseBody(body *CloneDatabaseNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateCloneDatabaseServerErrorResponseBody runs a no-op validation on
+// clone-database_server_error_response_body
+func ValidateCloneDatabaseServerErrorResponseBody(body *CloneDatabaseServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateGetVersionServerErrorResponseBody runs a no-op validation on
 // get-version_server_error_response_body
 func ValidateGetVersionServerErrorResponseBody(body *GetVersionServerErrorResponseBody) (err error) {
@@ -5899,6 +9715,104 @@ func ValidateRestartInstanceServerErrorResponseBody(body *RestartInstanceServerE
 	return
 }
 
+// ValidateGetInstanceSessionsClusterNotInitializedResponseBody runs a no-op
+// validation on get-instance-sessions_cluster_not_initialized_response_body
+func ValidateGetInstanceSessionsClusterNotInitializedResponseBody(body *GetInstanceSessionsClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstanceSessionsInvalidInputResponseBody runs a no-op validation
+// on get-instance-sessions_invalid_input_response_body
+func ValidateGetInstanceSessionsInvalidInputResponseBody(body *GetInstanceSessionsInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstanceSessionsNotFoundResponseBody runs a no-op validation on
+// get-instance-sessions_not_found_response_body
+func ValidateGetInstanceSessionsNotFoundResponseBody(body *GetInstanceSessionsNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstanceSessionsServerErrorResponseBody runs a no-op validation
+// on get-instance-sessions_server_error_response_body
+func ValidateGetInstanceSessionsServerErrorResponseBody(body *GetInstanceSessionsServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateCancelInstanceSessionClusterNotInitializedResponseBody runs a no-op
+// validation on cancel-instance-session_cluster_not_initialized_response_body
+func ValidateCancelInstanceSessionClusterNotInitializedResponseBody(body *CancelInstanceSessionClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateCancelInstanceSessionInvalidInputResponseBody runs a no-op
+// validation on cancel-instance-session_invalid_input_response_body
+func ValidateCancelInstanceSessionInvalidInputResponseBody(body *CancelInstanceSessionInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateCancelInstanceSessionNotFoundResponseBody runs a no-op validation on
+// cancel-instance-session_not_found_response_body
+func ValidateCancelInstanceSessionNotFoundResponseBody(body *CancelInstanceSessionNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateCancelInstanceSessionServerErrorResponseBody runs a no-op validation
+// on cancel-instance-session_server_error_response_body
+func ValidateCancelInstanceSessionServerErrorResponseBody(body *CancelInstanceSessionServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateTerminateInstanceSessionClusterNotInitializedResponseBody runs a
+// no-op validation on
+// terminate-instance-session_cluster_not_initialized_response_body
+func ValidateTerminateInstanceSessionClusterNotInitializedResponseBody(body *TerminateInstanceSessionClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateTerminateInstanceSessionInvalidInputResponseBody runs a no-op
+// validation on terminate-instance-session_invalid_input_response_body
+func ValidateTerminateInstanceSessionInvalidInputResponseBody(body *TerminateInstanceSessionInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateTerminateInstanceSessionNotFoundResponseBody runs a no-op validation
+// on terminate-instance-session_not_found_response_body
+func ValidateTerminateInstanceSessionNotFoundResponseBody(body *TerminateInstanceSessionNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateTerminateInstanceSessionServerErrorResponseBody runs a no-op
+// validation on terminate-instance-session_server_error_response_body
+func ValidateTerminateInstanceSessionServerErrorResponseBody(body *TerminateInstanceSessionServerErrorResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstancePostgresqlConfClusterNotInitializedResponseBody runs a
+// no-op validation on
+// get-instance-postgresql-conf_cluster_not_initialized_response_body
+func ValidateGetInstancePostgresqlConfClusterNotInitializedResponseBody(body *GetInstancePostgresqlConfClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstancePostgresqlConfInvalidInputResponseBody runs a no-op
+// validation on get-instance-postgresql-conf_invalid_input_response_body
+func ValidateGetInstancePostgresqlConfInvalidInputResponseBody(body *GetInstancePostgresqlConfInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstancePostgresqlConfNotFoundResponseBody runs a no-op
+// validation on get-instance-postgresql-conf_not_found_response_body
+func ValidateGetInstancePostgresqlConfNotFoundResponseBody(body *GetInstancePostgresqlConfNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateGetInstancePostgresqlConfServerErrorResponseBody runs a no-op
+// validation on get-instance-postgresql-conf_server_error_response_body
+func ValidateGetInstancePostgresqlConfServerErrorResponseBody(body *GetInstancePostgresqlConfServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateStopInstanceClusterNotInitializedResponseBody runs a no-op
 // validation on stop-instance_cluster_not_initialized_response_body
 func ValidateStopInstanceClusterNotInitializedResponseBody(body *StopInstanceClusterNotInitializedResponseBody) (err error) {
@@ -5965,6 +9879,30 @@ func ValidateCancelDatabaseTaskServerErrorResponseBody(body *CancelDatabaseTaskS
 	return
 }
 
+// ValidateResumeDatabaseTaskClusterNotInitializedResponseBody runs a no-op
+// validation on resume-database-task_cluster_not_initialized_response_body
+func ValidateResumeDatabaseTaskClusterNotInitializedResponseBody(body *ResumeDatabaseTaskClusterNotInitializedResponseBody) (err error) {
+	return
+}
+
+// ValidateResumeDatabaseTaskNotFoundResponseBody runs a no-op validation on
+// resume-database-task_not_found_response_body
+func ValidateResumeDatabaseTaskNotFoundResponseBody(body *ResumeDatabaseTaskNotFoundResponseBody) (err error) {
+	return
+}
+
+// ValidateResumeDatabaseTaskInvalidInputResponseBody runs a no-op validation
+// on resume-database-task_invalid_input_response_body
+func ValidateResumeDatabaseTaskInvalidInputResponseBody(body *ResumeDatabaseTaskInvalidInputResponseBody) (err error) {
+	return
+}
+
+// ValidateResumeDatabaseTaskServerErrorResponseBody runs a no-op validation on
+// resume-database-task_server_error_response_body
+func ValidateResumeDatabaseTaskServerErrorResponseBody(body *ResumeDatabaseTaskServerErrorResponseBody) (err error) {
+	return
+}
+
 // ValidateEtcdClusterMemberResponseBody runs a no-op validation on
 // EtcdClusterMemberResponseBody
 func ValidateEtcdClusterMemberResponseBody(body *EtcdClusterMemberResponseBody) (err error) {
@@ -6006,12 +9944,48 @@ func ValidateComponentStatusResponseBody(body *ComponentStatusResponseBody) (err
 	return
 }
 
+// ValidateVersionInfoResponseBody runs a no-op validation on
+// VersionInfoResponseBody
+func ValidateVersionInfoResponseBody(body *VersionInfoResponseBody) (err error) {
+	return
+}
+
 // ValidatePgEdgeVersionResponseBody runs a no-op validation on
 // PgEdgeVersionResponseBody
 func ValidatePgEdgeVersionResponseBody(body *PgEdgeVersionResponseBody) (err error) {
 	return
 }
 
+// ValidateClusterUpgradeStatusResponseBody runs a no-op validation on
+// ClusterUpgradeStatusResponseBody
+func ValidateClusterUpgradeStatusResponseBody(body *ClusterUpgradeStatusResponseBody) (err error) {
+	return
+}
+
+// ValidateClusterUpgradeHostResponseBody runs a no-op validation on
+// ClusterUpgradeHostResponseBody
+func ValidateClusterUpgradeHostResponseBody(body *ClusterUpgradeHostResponseBody) (err error) {
+	return
+}
+
+// ValidateStoragePrefixUsageResponseBody runs a no-op validation on
+// StoragePrefixUsageResponseBody
+func ValidateStoragePrefixUsageResponseBody(body *StoragePrefixUsageResponseBody) (err error) {
+	return
+}
+
+// ValidateEtcdMemberResponseBody runs a no-op validation on
+// EtcdMemberResponseBody
+func ValidateEtcdMemberResponseBody(body *EtcdMemberResponseBody) (err error) {
+	return
+}
+
+// ValidateEtcdDefragmentResultResponseBody runs a no-op validation on
+// EtcdDefragmentResultResponseBody
+func ValidateEtcdDefragmentResultResponseBody(body *EtcdDefragmentResultResponseBody) (err error) {
+	return
+}
+
 // ValidateTaskResponseBody runs a no-op validation on TaskResponseBody
 func ValidateTaskResponseBody(body *TaskResponseBody) (err error) {
 	return
@@ -6040,6 +10014,12 @@ func ValidateInstanceSubscriptionResponseBody(body *InstanceSubscriptionResponse
 	return
 }
 
+// ValidateInstanceResourceUsageResponseBody runs a no-op validation on
+// InstanceResourceUsageResponseBody
+func ValidateInstanceResourceUsageResponseBody(body *InstanceResourceUsageResponseBody) (err error) {
+	return
+}
+
 // ValidateAvailableUpgradeResponseBody runs a no-op validation on
 // AvailableUpgradeResponseBody
 func ValidateAvailableUpgradeResponseBody(body *AvailableUpgradeResponseBody) (err error) {
@@ -6145,6 +10125,26 @@ func ValidateDatabaseSpecRequestBody(body *DatabaseSpecRequestBody) (err error)
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.QueryStats != nil {
+		if err2 := ValidateQueryStatsSettingsRequestBody(body.QueryStats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.DriftPolicy != nil {
+		if !(*body.DriftPolicy == "report" || *body.DriftPolicy == "remediate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.drift_policy", *body.DriftPolicy, []any{"report", "remediate"}))
+		}
+	}
+	if body.PatroniDcs != nil {
+		if !(*body.PatroniDcs == "control_plane" || *body.PatroniDcs == "external") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.patroni_dcs", *body.PatroniDcs, []any{"control_plane", "external"}))
+		}
+	}
 	return
 }
 
@@ -6212,6 +10212,11 @@ func ValidateDatabaseNodeSpecRequestBody(body *DatabaseNodeSpecRequestBody) (err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -6610,6 +10615,51 @@ func ValidateExtraNetworkSpecRequestBody(body *ExtraNetworkSpecRequestBody) (err
 	return
 }
 
+// ValidatePatroniSettingsRequestBody runs the validations defined on
+// PatroniSettingsRequestBody
+func ValidatePatroniSettingsRequestBody(body *PatroniSettingsRequestBody) (err error) {
+	if body.MaximumLagOnFailover != nil {
+		if *body.MaximumLagOnFailover < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maximum_lag_on_failover", *body.MaximumLagOnFailover, 0, true))
+		}
+	}
+	if body.LoopWait != nil {
+		if *body.LoopWait < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loop_wait", *body.LoopWait, 1, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL < 20 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 20, true))
+		}
+	}
+	if body.RetryTimeout != nil {
+		if *body.RetryTimeout < 3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.retry_timeout", *body.RetryTimeout, 3, true))
+		}
+	}
+	if body.Watchdog != nil {
+		if err2 := ValidatePatroniWatchdogRequestBody(body.Watchdog); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatroniWatchdogRequestBody runs the validations defined on
+// PatroniWatchdogRequestBody
+func ValidatePatroniWatchdogRequestBody(body *PatroniWatchdogRequestBody) (err error) {
+	if !(body.Mode == "off" || body.Mode == "automatic" || body.Mode == "required") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", body.Mode, []any{"off", "automatic", "required"}))
+	}
+	if body.SafetyMargin != nil {
+		if *body.SafetyMargin < -1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.safety_margin", *body.SafetyMargin, -1, true))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseUserSpecRequestBody runs the validations defined on
 // DatabaseUserSpecRequestBody
 func ValidateDatabaseUserSpecRequestBody(body *DatabaseUserSpecRequestBody) (err error) {
@@ -6733,6 +10783,31 @@ func ValidateDatabaseScriptsRequestBody(body *DatabaseScriptsRequestBody) (err e
 	return
 }
 
+// ValidateQueryStatsSettingsRequestBody runs the validations defined on
+// QueryStatsSettingsRequestBody
+func ValidateQueryStatsSettingsRequestBody(body *QueryStatsSettingsRequestBody) (err error) {
+	if body.TopN != nil {
+		if *body.TopN < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 1, true))
+		}
+	}
+	if body.TopN != nil {
+		if *body.TopN > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateImportSourceSpecRequestBody runs the validations defined on
+// ImportSourceSpecRequestBody
+func ValidateImportSourceSpecRequestBody(body *ImportSourceSpecRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Dsn) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.dsn", body.Dsn, utf8.RuneCountInString(body.Dsn), 1, true))
+	}
+	return
+}
+
 // ValidateDatabaseResponseBody runs a no-op validation on DatabaseResponseBody
 func ValidateDatabaseResponseBody(body *DatabaseResponseBody) (err error) {
 	return
@@ -6828,6 +10903,18 @@ func ValidateExtraNetworkSpecResponseBody(body *ExtraNetworkSpecResponseBody) (e
 	return
 }
 
+// ValidatePatroniSettingsResponseBody runs a no-op validation on
+// PatroniSettingsResponseBody
+func ValidatePatroniSettingsResponseBody(body *PatroniSettingsResponseBody) (err error) {
+	return
+}
+
+// ValidatePatroniWatchdogResponseBody runs a no-op validation on
+// PatroniWatchdogResponseBody
+func ValidatePatroniWatchdogResponseBody(body *PatroniWatchdogResponseBody) (err error) {
+	return
+}
+
 // ValidateDatabaseUserSpecResponseBody runs a no-op validation on
 // DatabaseUserSpecResponseBody
 func ValidateDatabaseUserSpecResponseBody(body *DatabaseUserSpecResponseBody) (err error) {
@@ -6852,6 +10939,24 @@ func ValidateDatabaseScriptsResponseBody(body *DatabaseScriptsResponseBody) (err
 	return
 }
 
+// ValidateQueryStatsSettingsResponseBody runs a no-op validation on
+// QueryStatsSettingsResponseBody
+func ValidateQueryStatsSettingsResponseBody(body *QueryStatsSettingsResponseBody) (err error) {
+	return
+}
+
+// ValidateDatabaseDriftResponseBody runs a no-op validation on
+// DatabaseDriftResponseBody
+func ValidateDatabaseDriftResponseBody(body *DatabaseDriftResponseBody) (err error) {
+	return
+}
+
+// ValidateDriftedResourceResponseBody runs a no-op validation on
+// DriftedResourceResponseBody
+func ValidateDriftedResourceResponseBody(body *DriftedResourceResponseBody) (err error) {
+	return
+}
+
 // ValidateDatabaseSpecRequestBodyRequestBody runs the validations defined on
 // DatabaseSpecRequestBodyRequestBody
 func ValidateDatabaseSpecRequestBodyRequestBody(body *DatabaseSpecRequestBodyRequestBody) (err error) {
@@ -6951,6 +11056,26 @@ func ValidateDatabaseSpecRequestBodyRequestBody(body *DatabaseSpecRequestBodyReq
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBodyRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.QueryStats != nil {
+		if err2 := ValidateQueryStatsSettingsRequestBodyRequestBody(body.QueryStats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.DriftPolicy != nil {
+		if !(*body.DriftPolicy == "report" || *body.DriftPolicy == "remediate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.drift_policy", *body.DriftPolicy, []any{"report", "remediate"}))
+		}
+	}
+	if body.PatroniDcs != nil {
+		if !(*body.PatroniDcs == "control_plane" || *body.PatroniDcs == "external") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.patroni_dcs", *body.PatroniDcs, []any{"control_plane", "external"}))
+		}
+	}
 	return
 }
 
@@ -7018,6 +11143,11 @@ func ValidateDatabaseNodeSpecRequestBodyRequestBody(body *DatabaseNodeSpecReques
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBodyRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -7416,6 +11546,51 @@ func ValidateExtraNetworkSpecRequestBodyRequestBody(body *ExtraNetworkSpecReques
 	return
 }
 
+// ValidatePatroniSettingsRequestBodyRequestBody runs the validations defined
+// on PatroniSettingsRequestBodyRequestBody
+func ValidatePatroniSettingsRequestBodyRequestBody(body *PatroniSettingsRequestBodyRequestBody) (err error) {
+	if body.MaximumLagOnFailover != nil {
+		if *body.MaximumLagOnFailover < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maximum_lag_on_failover", *body.MaximumLagOnFailover, 0, true))
+		}
+	}
+	if body.LoopWait != nil {
+		if *body.LoopWait < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loop_wait", *body.LoopWait, 1, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL < 20 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 20, true))
+		}
+	}
+	if body.RetryTimeout != nil {
+		if *body.RetryTimeout < 3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.retry_timeout", *body.RetryTimeout, 3, true))
+		}
+	}
+	if body.Watchdog != nil {
+		if err2 := ValidatePatroniWatchdogRequestBodyRequestBody(body.Watchdog); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatroniWatchdogRequestBodyRequestBody runs the validations defined
+// on PatroniWatchdogRequestBodyRequestBody
+func ValidatePatroniWatchdogRequestBodyRequestBody(body *PatroniWatchdogRequestBodyRequestBody) (err error) {
+	if !(body.Mode == "off" || body.Mode == "automatic" || body.Mode == "required") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", body.Mode, []any{"off", "automatic", "required"}))
+	}
+	if body.SafetyMargin != nil {
+		if *body.SafetyMargin < -1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.safety_margin", *body.SafetyMargin, -1, true))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseUserSpecRequestBodyRequestBody runs the validations defined
 // on DatabaseUserSpecRequestBodyRequestBody
 func ValidateDatabaseUserSpecRequestBodyRequestBody(body *DatabaseUserSpecRequestBodyRequestBody) (err error) {
@@ -7539,8 +11714,100 @@ func ValidateDatabaseScriptsRequestBodyRequestBody(body *DatabaseScriptsRequestB
 	return
 }
 
+// ValidateQueryStatsSettingsRequestBodyRequestBody runs the validations
+// defined on QueryStatsSettingsRequestBodyRequestBody
+func ValidateQueryStatsSettingsRequestBodyRequestBody(body *QueryStatsSettingsRequestBodyRequestBody) (err error) {
+	if body.TopN != nil {
+		if *body.TopN < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 1, true))
+		}
+	}
+	if body.TopN != nil {
+		if *body.TopN > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 100, false))
+		}
+	}
+	return
+}
+
+// ValidatePostgreSQLConfChangeResponseBody runs a no-op validation on
+// PostgreSQLConfChangeResponseBody
+func ValidatePostgreSQLConfChangeResponseBody(body *PostgreSQLConfChangeResponseBody) (err error) {
+	return
+}
+
+// ValidateMajorUpgradeNodeSpecRequestBodyRequestBody runs the validations
+// defined on MajorUpgradeNodeSpecRequestBodyRequestBody
+func ValidateMajorUpgradeNodeSpecRequestBodyRequestBody(body *MajorUpgradeNodeSpecRequestBodyRequestBody) (err error) {
+	if body.HostIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("host_ids", "body"))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "n[0-9]+"))
+	if len(body.HostIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids", body.HostIds, len(body.HostIds), 1, true))
+	}
+	for _, e := range body.HostIds {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+		}
+	}
+	return
+}
+
+// ValidateMaintenanceWindowRequestBodyRequestBody runs the validations defined
+// on MaintenanceWindowRequestBodyRequestBody
+func ValidateMaintenanceWindowRequestBodyRequestBody(body *MaintenanceWindowRequestBodyRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.start", body.Start, "^([01][0-9]|2[0-3]):[0-5][0-9]$"))
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.end", body.End, "^([01][0-9]|2[0-3]):[0-5][0-9]$"))
+	return
+}
+
+// ValidateInstanceQueryStatsResponseBody runs a no-op validation on
+// InstanceQueryStatsResponseBody
+func ValidateInstanceQueryStatsResponseBody(body *InstanceQueryStatsResponseBody) (err error) {
+	return
+}
+
+// ValidateQueryStatResponseBody runs a no-op validation on
+// QueryStatResponseBody
+func ValidateQueryStatResponseBody(body *QueryStatResponseBody) (err error) {
+	return
+}
+
 // ValidateTaskLogEntryResponseBody runs a no-op validation on
 // TaskLogEntryResponseBody
 func ValidateTaskLogEntryResponseBody(body *TaskLogEntryResponseBody) (err error) {
 	return
 }
+
+// ValidateWebhookResponseBody runs a no-op validation on WebhookResponseBody
+func ValidateWebhookResponseBody(body *WebhookResponseBody) (err error) {
+	return
+}
+
+// ValidateWebhookDeliveryResponseBody runs a no-op validation on
+// WebhookDeliveryResponseBody
+func ValidateWebhookDeliveryResponseBody(body *WebhookDeliveryResponseBody) (err error) {
+	return
+}
+
+// ValidateInstanceSessionResponseBody runs a no-op validation on
+// InstanceSessionResponseBody
+func ValidateInstanceSessionResponseBody(body *InstanceSessionResponseBody) (err error) {
+	return
+}
+
+// ValidateSessionLockResponseBody runs a no-op validation on
+// SessionLockResponseBody
+func ValidateSessionLockResponseBody(body *SessionLockResponseBody) (err error) {
+	return
+}
+
+// ValidatePostgreSQLSettingResponseBody runs a no-op validation on
+// PostgreSQLSettingResponseBody
+func ValidatePostgreSQLSettingResponseBody(body *PostgreSQLSettingResponseBody) (err error) {
+	return
+}
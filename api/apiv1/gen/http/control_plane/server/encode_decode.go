@@ -441,6 +441,248 @@ func EncodeGetClusterError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
+// EncodeGetStorageUsageResponse returns an encoder for responses returned by
+// the control-plane get-storage-usage endpoint.
+func EncodeGetStorageUsageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.StorageUsage)
+		enc := encoder(ctx, w)
+		body := NewGetStorageUsageResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetStorageUsageRequest returns a decoder for requests sent to the
+// control-plane get-storage-usage endpoint.
+func DecodeGetStorageUsageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetStorageUsagePayload, error) {
+	return func(r *http.Request) (*controlplane.GetStorageUsagePayload, error) {
+		var (
+			depth *int
+			err   error
+		)
+		{
+			depthRaw := r.URL.Query().Get("depth")
+			if depthRaw != "" {
+				v, err2 := strconv.ParseInt(depthRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("depth", depthRaw, "integer"))
+				}
+				pv := int(v)
+				depth = &pv
+			}
+		}
+		if depth != nil {
+			if *depth < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("depth", *depth, 1, true))
+			}
+		}
+		if depth != nil {
+			if *depth > 4 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("depth", *depth, 4, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetStorageUsagePayload(depth)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetStorageUsageError returns an encoder for errors returned by the
+// get-storage-usage control-plane endpoint.
+func EncodeGetStorageUsageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetStorageUsageClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetStorageUsageServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetEtcdClusterResponse returns an encoder for responses returned by
+// the control-plane get-etcd-cluster endpoint.
+func EncodeGetEtcdClusterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.EtcdCluster)
+		enc := encoder(ctx, w)
+		body := NewGetEtcdClusterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeGetEtcdClusterError returns an encoder for errors returned by the
+// get-etcd-cluster control-plane endpoint.
+func EncodeGetEtcdClusterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetEtcdClusterClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetEtcdClusterServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDefragmentEtcdResponse returns an encoder for responses returned by
+// the control-plane defragment-etcd endpoint.
+func EncodeDefragmentEtcdResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.DefragmentEtcdResponse)
+		enc := encoder(ctx, w)
+		body := NewDefragmentEtcdResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDefragmentEtcdRequest returns a decoder for requests sent to the
+// control-plane defragment-etcd endpoint.
+func DecodeDefragmentEtcdRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.DefragmentEtcdRequest, error) {
+	return func(r *http.Request) (*controlplane.DefragmentEtcdRequest, error) {
+		var (
+			body DefragmentEtcdRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateDefragmentEtcdRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDefragmentEtcdRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeDefragmentEtcdError returns an encoder for errors returned by the
+// defragment-etcd control-plane endpoint.
+func EncodeDefragmentEtcdError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDefragmentEtcdClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDefragmentEtcdInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDefragmentEtcdServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeListHostsResponse returns an encoder for responses returned by the
 // control-plane list-hosts endpoint.
 func EncodeListHostsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -453,6 +695,67 @@ func EncodeListHostsResponse(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// DecodeListHostsRequest returns a decoder for requests sent to the
+// control-plane list-hosts endpoint.
+func DecodeListHostsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListHostsPayload, error) {
+	return func(r *http.Request) (*controlplane.ListHostsPayload, error) {
+		var (
+			limit           *int
+			cursor          *string
+			state           []string
+			postgresVersion *string
+			err             error
+		)
+		qp := r.URL.Query()
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		if limit != nil {
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		if cursor != nil {
+			if utf8.RuneCountInString(*cursor) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 1, true))
+			}
+		}
+		if cursor != nil {
+			if utf8.RuneCountInString(*cursor) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 36, false))
+			}
+		}
+		state = qp["state"]
+		for _, e := range state {
+			if !(e == "healthy" || e == "unreachable" || e == "degraded" || e == "unknown") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state[*]", e, []any{"healthy", "unreachable", "degraded", "unknown"}))
+			}
+		}
+		postgresVersionRaw := qp.Get("postgres_version")
+		if postgresVersionRaw != "" {
+			postgresVersion = &postgresVersionRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListHostsPayload(limit, cursor, state, postgresVersion)
+
+		return payload, nil
+	}
+}
+
 // EncodeListHostsError returns an encoder for errors returned by the
 // list-hosts control-plane endpoint.
 func EncodeListHostsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
@@ -476,6 +779,19 @@ func EncodeListHostsError(encoder func(context.Context, http.ResponseWriter) goa
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListHostsInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "server_error":
 			var res *controlplane.APIError
 			errors.As(v, &res)
@@ -718,27 +1034,241 @@ func EncodeRemoveHostError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
-// EncodeListDatabasesResponse returns an encoder for responses returned by the
-// control-plane list-databases endpoint.
-func EncodeListDatabasesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeChangeHostEtcdModeResponse returns an encoder for responses returned
+// by the control-plane change-host-etcd-mode endpoint.
+func EncodeChangeHostEtcdModeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.ListDatabasesResponse)
+		res, _ := v.(*controlplane.ChangeHostEtcdModeResponse)
 		enc := encoder(ctx, w)
-		body := NewListDatabasesResponseBody(res)
+		body := NewChangeHostEtcdModeResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListDatabasesRequest returns a decoder for requests sent to the
-// control-plane list-databases endpoint.
-func DecodeListDatabasesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListDatabasesPayload, error) {
-	return func(r *http.Request) (*controlplane.ListDatabasesPayload, error) {
+// DecodeChangeHostEtcdModeRequest returns a decoder for requests sent to the
+// control-plane change-host-etcd-mode endpoint.
+func DecodeChangeHostEtcdModeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ChangeHostEtcdModePayload, error) {
+	return func(r *http.Request) (*controlplane.ChangeHostEtcdModePayload, error) {
 		var (
-			include []string
+			body ChangeHostEtcdModeRequestBody
+			err  error
 		)
-		include = r.URL.Query()["include"]
-		payload := NewListDatabasesPayload(include)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateChangeHostEtcdModeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			hostID string
+
+			params = mux.Vars(r)
+		)
+		hostID = params["host_id"]
+		if utf8.RuneCountInString(hostID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		}
+		if utf8.RuneCountInString(hostID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewChangeHostEtcdModePayload(&body, hostID)
+
+		return payload, nil
+	}
+}
+
+// EncodeChangeHostEtcdModeError returns an encoder for errors returned by the
+// change-host-etcd-mode control-plane endpoint.
+func EncodeChangeHostEtcdModeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeHostEtcdModeClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeHostEtcdModeInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeHostEtcdModeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewChangeHostEtcdModeServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListDatabasesResponse returns an encoder for responses returned by the
+// control-plane list-databases endpoint.
+func EncodeListDatabasesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListDatabasesResponse)
+		enc := encoder(ctx, w)
+		body := NewListDatabasesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListDatabasesRequest returns a decoder for requests sent to the
+// control-plane list-databases endpoint.
+func DecodeListDatabasesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListDatabasesPayload, error) {
+	return func(r *http.Request) (*controlplane.ListDatabasesPayload, error) {
+		var (
+			include         []string
+			limit           *int
+			cursor          *string
+			tenantID        *string
+			state           []string
+			hostID          *string
+			postgresVersion *string
+			view            string
+			err             error
+		)
+		qp := r.URL.Query()
+		include = qp["include"]
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		if limit != nil {
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		if cursor != nil {
+			if utf8.RuneCountInString(*cursor) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 1, true))
+			}
+		}
+		if cursor != nil {
+			if utf8.RuneCountInString(*cursor) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("cursor", *cursor, utf8.RuneCountInString(*cursor), 36, false))
+			}
+		}
+		tenantIDRaw := qp.Get("tenant_id")
+		if tenantIDRaw != "" {
+			tenantID = &tenantIDRaw
+		}
+		if tenantID != nil {
+			if utf8.RuneCountInString(*tenantID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("tenant_id", *tenantID, utf8.RuneCountInString(*tenantID), 1, true))
+			}
+		}
+		if tenantID != nil {
+			if utf8.RuneCountInString(*tenantID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("tenant_id", *tenantID, utf8.RuneCountInString(*tenantID), 36, false))
+			}
+		}
+		state = qp["state"]
+		for _, e := range state {
+			if !(e == "creating" || e == "modifying" || e == "available" || e == "deleting" || e == "degraded" || e == "failed" || e == "restoring" || e == "unknown") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state[*]", e, []any{"creating", "modifying", "available", "deleting", "degraded", "failed", "restoring", "unknown"}))
+			}
+		}
+		hostIDRaw := qp.Get("host_id")
+		if hostIDRaw != "" {
+			hostID = &hostIDRaw
+		}
+		if hostID != nil {
+			if utf8.RuneCountInString(*hostID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 1, true))
+			}
+		}
+		if hostID != nil {
+			if utf8.RuneCountInString(*hostID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 36, false))
+			}
+		}
+		postgresVersionRaw := qp.Get("postgres_version")
+		if postgresVersionRaw != "" {
+			postgresVersion = &postgresVersionRaw
+		}
+		viewRaw := qp.Get("view")
+		if viewRaw != "" {
+			view = viewRaw
+		} else {
+			view = "full"
+		}
+		if !(view == "full" || view == "summary") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("view", view, []any{"full", "summary"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListDatabasesPayload(include, limit, cursor, tenantID, state, hostID, postgresVersion, view)
 
 		return payload, nil
 	}
@@ -767,6 +1297,19 @@ func EncodeListDatabasesError(encoder func(context.Context, http.ResponseWriter)
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDatabasesInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "server_error":
 			var res *controlplane.APIError
 			errors.As(v, &res)
@@ -1332,26 +1875,44 @@ func EncodeApplyUpgradeError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeDeleteDatabaseResponse returns an encoder for responses returned by
-// the control-plane delete-database endpoint.
-func EncodeDeleteDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpgradeDatabaseMajorResponse returns an encoder for responses returned
+// by the control-plane upgrade-database-major endpoint.
+func EncodeUpgradeDatabaseMajorResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.DeleteDatabaseResponse)
+		res, _ := v.(*controlplane.UpgradeDatabaseMajorResponse)
 		enc := encoder(ctx, w)
-		body := NewDeleteDatabaseResponseBody(res)
+		body := NewUpgradeDatabaseMajorResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteDatabaseRequest returns a decoder for requests sent to the
-// control-plane delete-database endpoint.
-func DecodeDeleteDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.DeleteDatabasePayload, error) {
-	return func(r *http.Request) (*controlplane.DeleteDatabasePayload, error) {
+// DecodeUpgradeDatabaseMajorRequest returns a decoder for requests sent to the
+// control-plane upgrade-database-major endpoint.
+func DecodeUpgradeDatabaseMajorRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.UpgradeDatabaseMajorPayload, error) {
+	return func(r *http.Request) (*controlplane.UpgradeDatabaseMajorPayload, error) {
+		var (
+			body UpgradeDatabaseMajorRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpgradeDatabaseMajorRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			databaseID string
-			force      bool
-			err        error
 
 			params = mux.Vars(r)
 		)
@@ -1362,28 +1923,18 @@ func DecodeDeleteDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		{
-			forceRaw := r.URL.Query().Get("force")
-			if forceRaw != "" {
-				v, err2 := strconv.ParseBool(forceRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
-				}
-				force = v
-			}
-		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteDatabasePayload(databaseID, force)
+		payload := NewUpgradeDatabaseMajorPayload(&body, databaseID)
 
 		return payload, nil
 	}
 }
 
-// EncodeDeleteDatabaseError returns an encoder for errors returned by the
-// delete-database control-plane endpoint.
-func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpgradeDatabaseMajorError returns an encoder for errors returned by
+// the upgrade-database-major control-plane endpoint.
+func EncodeUpgradeDatabaseMajorError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1399,7 +1950,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseClusterNotInitializedResponseBody(res)
+				body = NewUpgradeDatabaseMajorClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1412,7 +1963,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseDatabaseNotModifiableResponseBody(res)
+				body = NewUpgradeDatabaseMajorDatabaseNotModifiableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1425,7 +1976,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseOperationAlreadyInProgressResponseBody(res)
+				body = NewUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1438,7 +1989,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseInvalidInputResponseBody(res)
+				body = NewUpgradeDatabaseMajorInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1451,7 +2002,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseNotFoundResponseBody(res)
+				body = NewUpgradeDatabaseMajorNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1464,7 +2015,7 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteDatabaseServerErrorResponseBody(res)
+				body = NewUpgradeDatabaseMajorServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1475,46 +2026,25 @@ func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeBackupDatabaseNodeResponse returns an encoder for responses returned
-// by the control-plane backup-database-node endpoint.
-func EncodeBackupDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCutoverDatabaseImportResponse returns an encoder for responses
+// returned by the control-plane cutover-database-import endpoint.
+func EncodeCutoverDatabaseImportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.BackupDatabaseNodeResponse)
+		res, _ := v.(*controlplane.CutoverDatabaseImportResponse)
 		enc := encoder(ctx, w)
-		body := NewBackupDatabaseNodeResponseBody(res)
+		body := NewCutoverDatabaseImportResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeBackupDatabaseNodeRequest returns a decoder for requests sent to the
-// control-plane backup-database-node endpoint.
-func DecodeBackupDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.BackupDatabaseNodePayload, error) {
-	return func(r *http.Request) (*controlplane.BackupDatabaseNodePayload, error) {
-		var (
-			body BackupDatabaseNodeRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateBackupDatabaseNodeRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeCutoverDatabaseImportRequest returns a decoder for requests sent to
+// the control-plane cutover-database-import endpoint.
+func DecodeCutoverDatabaseImportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.CutoverDatabaseImportPayload, error) {
+	return func(r *http.Request) (*controlplane.CutoverDatabaseImportPayload, error) {
 		var (
 			databaseID string
-			nodeName   string
-			force      bool
+			err        error
 
 			params = mux.Vars(r)
 		)
@@ -1525,30 +2055,18 @@ func DecodeBackupDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Reque
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		nodeName = params["node_name"]
-		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
-		{
-			forceRaw := r.URL.Query().Get("force")
-			if forceRaw != "" {
-				v, err2 := strconv.ParseBool(forceRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
-				}
-				force = v
-			}
-		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewBackupDatabaseNodePayload(&body, databaseID, nodeName, force)
+		payload := NewCutoverDatabaseImportPayload(databaseID)
 
 		return payload, nil
 	}
 }
 
-// EncodeBackupDatabaseNodeError returns an encoder for errors returned by the
-// backup-database-node control-plane endpoint.
-func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCutoverDatabaseImportError returns an encoder for errors returned by
+// the cutover-database-import control-plane endpoint.
+func EncodeCutoverDatabaseImportError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1564,7 +2082,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeClusterNotInitializedResponseBody(res)
+				body = NewCutoverDatabaseImportClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1577,7 +2095,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeDatabaseNotModifiableResponseBody(res)
+				body = NewCutoverDatabaseImportDatabaseNotModifiableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1590,7 +2108,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody(res)
+				body = NewCutoverDatabaseImportOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1603,7 +2121,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeInvalidInputResponseBody(res)
+				body = NewCutoverDatabaseImportInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1616,7 +2134,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeNotFoundResponseBody(res)
+				body = NewCutoverDatabaseImportNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1629,7 +2147,7 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewBackupDatabaseNodeServerErrorResponseBody(res)
+				body = NewCutoverDatabaseImportServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1640,45 +2158,26 @@ func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
-// EncodeSwitchoverDatabaseNodeResponse returns an encoder for responses
-// returned by the control-plane switchover-database-node endpoint.
-func EncodeSwitchoverDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.SwitchoverDatabaseNodeResponse)
+// EncodeDeleteDatabaseResponse returns an encoder for responses returned by
+// the control-plane delete-database endpoint.
+func EncodeDeleteDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.DeleteDatabaseResponse)
 		enc := encoder(ctx, w)
-		body := NewSwitchoverDatabaseNodeResponseBody(res)
+		body := NewDeleteDatabaseResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeSwitchoverDatabaseNodeRequest returns a decoder for requests sent to
-// the control-plane switchover-database-node endpoint.
-func DecodeSwitchoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.SwitchoverDatabaseNodePayload, error) {
-	return func(r *http.Request) (*controlplane.SwitchoverDatabaseNodePayload, error) {
-		var (
-			body SwitchoverDatabaseNodeRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateSwitchoverDatabaseNodeRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeDeleteDatabaseRequest returns a decoder for requests sent to the
+// control-plane delete-database endpoint.
+func DecodeDeleteDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.DeleteDatabasePayload, error) {
+	return func(r *http.Request) (*controlplane.DeleteDatabasePayload, error) {
 		var (
 			databaseID string
-			nodeName   string
+			force      bool
+			err        error
 
 			params = mux.Vars(r)
 		)
@@ -1689,20 +2188,28 @@ func DecodeSwitchoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.R
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		nodeName = params["node_name"]
-		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
+		{
+			forceRaw := r.URL.Query().Get("force")
+			if forceRaw != "" {
+				v, err2 := strconv.ParseBool(forceRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
+				}
+				force = v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewSwitchoverDatabaseNodePayload(&body, databaseID, nodeName)
+		payload := NewDeleteDatabasePayload(databaseID, force)
 
 		return payload, nil
 	}
 }
 
-// EncodeSwitchoverDatabaseNodeError returns an encoder for errors returned by
-// the switchover-database-node control-plane endpoint.
-func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteDatabaseError returns an encoder for errors returned by the
+// delete-database control-plane endpoint.
+func EncodeDeleteDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1718,7 +2225,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody(res)
+				body = NewDeleteDatabaseClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1731,7 +2238,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(res)
+				body = NewDeleteDatabaseDatabaseNotModifiableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1744,7 +2251,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(res)
+				body = NewDeleteDatabaseOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1757,7 +2264,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeInvalidInputResponseBody(res)
+				body = NewDeleteDatabaseInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1770,7 +2277,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeNotFoundResponseBody(res)
+				body = NewDeleteDatabaseNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1783,7 +2290,7 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSwitchoverDatabaseNodeServerErrorResponseBody(res)
+				body = NewDeleteDatabaseServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1794,24 +2301,24 @@ func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.Respon
 	}
 }
 
-// EncodeFailoverDatabaseNodeResponse returns an encoder for responses returned
-// by the control-plane failover-database-node endpoint.
-func EncodeFailoverDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeBackupDatabaseNodeResponse returns an encoder for responses returned
+// by the control-plane backup-database-node endpoint.
+func EncodeBackupDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.FailoverDatabaseNodeResponse)
+		res, _ := v.(*controlplane.BackupDatabaseNodeResponse)
 		enc := encoder(ctx, w)
-		body := NewFailoverDatabaseNodeResponseBody(res)
+		body := NewBackupDatabaseNodeResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeFailoverDatabaseNodeRequest returns a decoder for requests sent to the
-// control-plane failover-database-node endpoint.
-func DecodeFailoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.FailoverDatabaseNodeRequest, error) {
-	return func(r *http.Request) (*controlplane.FailoverDatabaseNodeRequest, error) {
+// DecodeBackupDatabaseNodeRequest returns a decoder for requests sent to the
+// control-plane backup-database-node endpoint.
+func DecodeBackupDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.BackupDatabaseNodePayload, error) {
+	return func(r *http.Request) (*controlplane.BackupDatabaseNodePayload, error) {
 		var (
-			body FailoverDatabaseNodeRequestBody
+			body BackupDatabaseNodeRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -1825,10 +2332,15 @@ func DecodeFailoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Req
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
+		err = ValidateBackupDatabaseNodeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
 
 		var (
 			databaseID string
 			nodeName   string
+			force      bool
 
 			params = mux.Vars(r)
 		)
@@ -1841,18 +2353,28 @@ func DecodeFailoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Req
 		}
 		nodeName = params["node_name"]
 		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
+		{
+			forceRaw := r.URL.Query().Get("force")
+			if forceRaw != "" {
+				v, err2 := strconv.ParseBool(forceRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
+				}
+				force = v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewFailoverDatabaseNodeRequest(&body, databaseID, nodeName)
+		payload := NewBackupDatabaseNodePayload(&body, databaseID, nodeName, force)
 
 		return payload, nil
 	}
 }
 
-// EncodeFailoverDatabaseNodeError returns an encoder for errors returned by
-// the failover-database-node control-plane endpoint.
-func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeBackupDatabaseNodeError returns an encoder for errors returned by the
+// backup-database-node control-plane endpoint.
+func EncodeBackupDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1868,7 +2390,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeClusterNotInitializedResponseBody(res)
+				body = NewBackupDatabaseNodeClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1881,7 +2403,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody(res)
+				body = NewBackupDatabaseNodeDatabaseNotModifiableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1894,7 +2416,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(res)
+				body = NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -1907,7 +2429,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeInvalidInputResponseBody(res)
+				body = NewBackupDatabaseNodeInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1920,7 +2442,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeNotFoundResponseBody(res)
+				body = NewBackupDatabaseNodeNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1933,7 +2455,7 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewFailoverDatabaseNodeServerErrorResponseBody(res)
+				body = NewBackupDatabaseNodeServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1944,28 +2466,45 @@ func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.Response
 	}
 }
 
-// EncodeListDatabaseTasksResponse returns an encoder for responses returned by
-// the control-plane list-database-tasks endpoint.
-func EncodeListDatabaseTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeSwitchoverDatabaseNodeResponse returns an encoder for responses
+// returned by the control-plane switchover-database-node endpoint.
+func EncodeSwitchoverDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.ListDatabaseTasksResponse)
+		res, _ := v.(*controlplane.SwitchoverDatabaseNodeResponse)
 		enc := encoder(ctx, w)
-		body := NewListDatabaseTasksResponseBody(res)
+		body := NewSwitchoverDatabaseNodeResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListDatabaseTasksRequest returns a decoder for requests sent to the
-// control-plane list-database-tasks endpoint.
-func DecodeListDatabaseTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListDatabaseTasksPayload, error) {
-	return func(r *http.Request) (*controlplane.ListDatabaseTasksPayload, error) {
+// DecodeSwitchoverDatabaseNodeRequest returns a decoder for requests sent to
+// the control-plane switchover-database-node endpoint.
+func DecodeSwitchoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.SwitchoverDatabaseNodePayload, error) {
+	return func(r *http.Request) (*controlplane.SwitchoverDatabaseNodePayload, error) {
 		var (
-			databaseID  string
-			afterTaskID *string
-			limit       *int
-			sortOrder   *string
-			err         error
+			body SwitchoverDatabaseNodeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSwitchoverDatabaseNodeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			databaseID string
+			nodeName   string
 
 			params = mux.Vars(r)
 		)
@@ -1976,46 +2515,20 @@ func DecodeListDatabaseTasksRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		qp := r.URL.Query()
-		afterTaskIDRaw := qp.Get("after_task_id")
-		if afterTaskIDRaw != "" {
-			afterTaskID = &afterTaskIDRaw
-		}
-		if afterTaskID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
-		}
-		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
-			}
-		}
-		sortOrderRaw := qp.Get("sort_order")
-		if sortOrderRaw != "" {
-			sortOrder = &sortOrderRaw
-		}
-		if sortOrder != nil {
-			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
-			}
-		}
+		nodeName = params["node_name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListDatabaseTasksPayload(databaseID, afterTaskID, limit, sortOrder)
+		payload := NewSwitchoverDatabaseNodePayload(&body, databaseID, nodeName)
 
 		return payload, nil
 	}
 }
 
-// EncodeListDatabaseTasksError returns an encoder for errors returned by the
-// list-database-tasks control-plane endpoint.
-func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeSwitchoverDatabaseNodeError returns an encoder for errors returned by
+// the switchover-database-node control-plane endpoint.
+func EncodeSwitchoverDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2031,7 +2544,33 @@ func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListDatabaseTasksClusterNotInitializedResponseBody(res)
+				body = NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "database_not_modifiable":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "operation_already_in_progress":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2044,7 +2583,7 @@ func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListDatabaseTasksInvalidInputResponseBody(res)
+				body = NewSwitchoverDatabaseNodeInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2057,7 +2596,7 @@ func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListDatabaseTasksNotFoundResponseBody(res)
+				body = NewSwitchoverDatabaseNodeNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2070,7 +2609,7 @@ func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWri
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListDatabaseTasksServerErrorResponseBody(res)
+				body = NewSwitchoverDatabaseNodeServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2081,27 +2620,46 @@ func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeGetDatabaseTaskResponse returns an encoder for responses returned by
-// the control-plane get-database-task endpoint.
-func EncodeGetDatabaseTaskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRollingRestartDatabaseResponse returns an encoder for responses
+// returned by the control-plane rolling-restart-database endpoint.
+func EncodeRollingRestartDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.Task)
+		res, _ := v.(*controlplane.RollingRestartDatabaseResponse)
 		enc := encoder(ctx, w)
-		body := NewGetDatabaseTaskResponseBody(res)
+		body := NewRollingRestartDatabaseResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetDatabaseTaskRequest returns a decoder for requests sent to the
-// control-plane get-database-task endpoint.
-func DecodeGetDatabaseTaskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetDatabaseTaskPayload, error) {
-	return func(r *http.Request) (*controlplane.GetDatabaseTaskPayload, error) {
+// DecodeRollingRestartDatabaseRequest returns a decoder for requests sent to
+// the control-plane rolling-restart-database endpoint.
+func DecodeRollingRestartDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.RollingRestartDatabasePayload, error) {
+	return func(r *http.Request) (*controlplane.RollingRestartDatabasePayload, error) {
 		var (
-			databaseID string
-			taskID     string
-			err        error
-
+			body RollingRestartDatabaseRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			} else {
+				var gerr *goa.ServiceError
+				if errors.As(err, &gerr) {
+					return nil, gerr
+				}
+				return nil, goa.DecodePayloadError(err.Error())
+			}
+		}
+		err = ValidateRollingRestartDatabaseRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			databaseID string
+
 			params = mux.Vars(r)
 		)
 		databaseID = params["database_id"]
@@ -2111,20 +2669,18 @@ func DecodeGetDatabaseTaskRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		taskID = params["task_id"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetDatabaseTaskPayload(databaseID, taskID)
+		payload := NewRollingRestartDatabasePayload(&body, databaseID)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetDatabaseTaskError returns an encoder for errors returned by the
-// get-database-task control-plane endpoint.
-func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRollingRestartDatabaseError returns an encoder for errors returned by
+// the rolling-restart-database control-plane endpoint.
+func EncodeRollingRestartDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2140,7 +2696,33 @@ func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskClusterNotInitializedResponseBody(res)
+				body = NewRollingRestartDatabaseClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "database_not_modifiable":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRollingRestartDatabaseDatabaseNotModifiableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "operation_already_in_progress":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRollingRestartDatabaseOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2153,7 +2735,7 @@ func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskInvalidInputResponseBody(res)
+				body = NewRollingRestartDatabaseInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2166,7 +2748,7 @@ func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskNotFoundResponseBody(res)
+				body = NewRollingRestartDatabaseNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2179,7 +2761,7 @@ func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskServerErrorResponseBody(res)
+				body = NewRollingRestartDatabaseServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2190,28 +2772,41 @@ func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWrite
 	}
 }
 
-// EncodeGetDatabaseTaskLogResponse returns an encoder for responses returned
-// by the control-plane get-database-task-log endpoint.
-func EncodeGetDatabaseTaskLogResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeFailoverDatabaseNodeResponse returns an encoder for responses returned
+// by the control-plane failover-database-node endpoint.
+func EncodeFailoverDatabaseNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.TaskLog)
+		res, _ := v.(*controlplane.FailoverDatabaseNodeResponse)
 		enc := encoder(ctx, w)
-		body := NewGetDatabaseTaskLogResponseBody(res)
+		body := NewFailoverDatabaseNodeResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetDatabaseTaskLogRequest returns a decoder for requests sent to the
-// control-plane get-database-task-log endpoint.
-func DecodeGetDatabaseTaskLogRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetDatabaseTaskLogPayload, error) {
-	return func(r *http.Request) (*controlplane.GetDatabaseTaskLogPayload, error) {
+// DecodeFailoverDatabaseNodeRequest returns a decoder for requests sent to the
+// control-plane failover-database-node endpoint.
+func DecodeFailoverDatabaseNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.FailoverDatabaseNodeRequest, error) {
+	return func(r *http.Request) (*controlplane.FailoverDatabaseNodeRequest, error) {
 		var (
-			databaseID   string
-			taskID       string
-			afterEntryID *string
-			limit        *int
-			err          error
+			body FailoverDatabaseNodeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			databaseID string
+			nodeName   string
 
 			params = mux.Vars(r)
 		)
@@ -2222,39 +2817,20 @@ func DecodeGetDatabaseTaskLogRequest(mux goahttp.Muxer, decoder func(*http.Reque
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		taskID = params["task_id"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
-		qp := r.URL.Query()
-		afterEntryIDRaw := qp.Get("after_entry_id")
-		if afterEntryIDRaw != "" {
-			afterEntryID = &afterEntryIDRaw
-		}
-		if afterEntryID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("after_entry_id", *afterEntryID, goa.FormatUUID))
-		}
-		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
-			}
-		}
+		nodeName = params["node_name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetDatabaseTaskLogPayload(databaseID, taskID, afterEntryID, limit)
+		payload := NewFailoverDatabaseNodeRequest(&body, databaseID, nodeName)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetDatabaseTaskLogError returns an encoder for errors returned by the
-// get-database-task-log control-plane endpoint.
-func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeFailoverDatabaseNodeError returns an encoder for errors returned by
+// the failover-database-node control-plane endpoint.
+func EncodeFailoverDatabaseNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2270,7 +2846,33 @@ func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskLogClusterNotInitializedResponseBody(res)
+				body = NewFailoverDatabaseNodeClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "database_not_modifiable":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "operation_already_in_progress":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2283,7 +2885,7 @@ func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskLogInvalidInputResponseBody(res)
+				body = NewFailoverDatabaseNodeInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2296,7 +2898,7 @@ func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskLogNotFoundResponseBody(res)
+				body = NewFailoverDatabaseNodeNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2309,7 +2911,7 @@ func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetDatabaseTaskLogServerErrorResponseBody(res)
+				body = NewFailoverDatabaseNodeServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2320,45 +2922,49 @@ func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
-// EncodeListHostTasksResponse returns an encoder for responses returned by the
-// control-plane list-host-tasks endpoint.
-func EncodeListHostTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetNodeQueryStatsResponse returns an encoder for responses returned by
+// the control-plane get-node-query-stats endpoint.
+func EncodeGetNodeQueryStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.ListHostTasksResponse)
+		res, _ := v.(*controlplane.GetNodeQueryStatsResponse)
 		enc := encoder(ctx, w)
-		body := NewListHostTasksResponseBody(res)
+		body := NewGetNodeQueryStatsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListHostTasksRequest returns a decoder for requests sent to the
-// control-plane list-host-tasks endpoint.
-func DecodeListHostTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListHostTasksPayload, error) {
-	return func(r *http.Request) (*controlplane.ListHostTasksPayload, error) {
+// DecodeGetNodeQueryStatsRequest returns a decoder for requests sent to the
+// control-plane get-node-query-stats endpoint.
+func DecodeGetNodeQueryStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetNodeQueryStatsPayload, error) {
+	return func(r *http.Request) (*controlplane.GetNodeQueryStatsPayload, error) {
 		var (
-			hostID      string
-			afterTaskID *string
-			limit       *int
-			sortOrder   *string
-			err         error
+			databaseID string
+			nodeName   string
+			sortBy     string
+			limit      *int
+			err        error
 
 			params = mux.Vars(r)
 		)
-		hostID = params["host_id"]
-		if utf8.RuneCountInString(hostID) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
 		}
-		if utf8.RuneCountInString(hostID) > 36 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
+		nodeName = params["node_name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
 		qp := r.URL.Query()
-		afterTaskIDRaw := qp.Get("after_task_id")
-		if afterTaskIDRaw != "" {
-			afterTaskID = &afterTaskIDRaw
+		sortByRaw := qp.Get("sort_by")
+		if sortByRaw != "" {
+			sortBy = sortByRaw
+		} else {
+			sortBy = "total_time"
 		}
-		if afterTaskID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
+		if !(sortBy == "total_time" || sortBy == "calls" || sortBy == "rows") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_by", sortBy, []any{"total_time", "calls", "rows"}))
 		}
 		{
 			limitRaw := qp.Get("limit")
@@ -2371,27 +2977,23 @@ func DecodeListHostTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 				limit = &pv
 			}
 		}
-		sortOrderRaw := qp.Get("sort_order")
-		if sortOrderRaw != "" {
-			sortOrder = &sortOrderRaw
-		}
-		if sortOrder != nil {
-			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
+		if limit != nil {
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
 			}
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListHostTasksPayload(hostID, afterTaskID, limit, sortOrder)
+		payload := NewGetNodeQueryStatsPayload(databaseID, nodeName, sortBy, limit)
 
 		return payload, nil
 	}
 }
 
-// EncodeListHostTasksError returns an encoder for errors returned by the
-// list-host-tasks control-plane endpoint.
-func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetNodeQueryStatsError returns an encoder for errors returned by the
+// get-node-query-stats control-plane endpoint.
+func EncodeGetNodeQueryStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2407,7 +3009,7 @@ func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListHostTasksClusterNotInitializedResponseBody(res)
+				body = NewGetNodeQueryStatsClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2420,7 +3022,7 @@ func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListHostTasksInvalidInputResponseBody(res)
+				body = NewGetNodeQueryStatsInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2433,7 +3035,7 @@ func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListHostTasksNotFoundResponseBody(res)
+				body = NewGetNodeQueryStatsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2446,7 +3048,7 @@ func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListHostTasksServerErrorResponseBody(res)
+				body = NewGetNodeQueryStatsServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2457,50 +3059,2020 @@ func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeGetHostTaskResponse returns an encoder for responses returned by the
-// control-plane get-host-task endpoint.
-func EncodeGetHostTaskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeResetNodeQueryStatsResponse returns an encoder for responses returned
+// by the control-plane reset-node-query-stats endpoint.
+func EncodeResetNodeQueryStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.Task)
+		res, _ := v.(*controlplane.ResetNodeQueryStatsResponse)
 		enc := encoder(ctx, w)
-		body := NewGetHostTaskResponseBody(res)
+		body := NewResetNodeQueryStatsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetHostTaskRequest returns a decoder for requests sent to the
-// control-plane get-host-task endpoint.
-func DecodeGetHostTaskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetHostTaskPayload, error) {
-	return func(r *http.Request) (*controlplane.GetHostTaskPayload, error) {
+// DecodeResetNodeQueryStatsRequest returns a decoder for requests sent to the
+// control-plane reset-node-query-stats endpoint.
+func DecodeResetNodeQueryStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ResetNodeQueryStatsPayload, error) {
+	return func(r *http.Request) (*controlplane.ResetNodeQueryStatsPayload, error) {
 		var (
-			hostID string
-			taskID string
-			err    error
+			databaseID string
+			nodeName   string
+			err        error
 
 			params = mux.Vars(r)
 		)
-		hostID = params["host_id"]
-		if utf8.RuneCountInString(hostID) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
 		}
-		if utf8.RuneCountInString(hostID) > 36 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		taskID = params["task_id"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
+		nodeName = params["node_name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("node_name", nodeName, "n[0-9]+"))
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetHostTaskPayload(hostID, taskID)
+		payload := NewResetNodeQueryStatsPayload(databaseID, nodeName)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetHostTaskError returns an encoder for errors returned by the
-// get-host-task control-plane endpoint.
-func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeResetNodeQueryStatsError returns an encoder for errors returned by the
+// reset-node-query-stats control-plane endpoint.
+func EncodeResetNodeQueryStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResetNodeQueryStatsClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResetNodeQueryStatsInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResetNodeQueryStatsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResetNodeQueryStatsServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListDatabaseTasksResponse returns an encoder for responses returned by
+// the control-plane list-database-tasks endpoint.
+func EncodeListDatabaseTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListDatabaseTasksResponse)
+		enc := encoder(ctx, w)
+		body := NewListDatabaseTasksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListDatabaseTasksRequest returns a decoder for requests sent to the
+// control-plane list-database-tasks endpoint.
+func DecodeListDatabaseTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListDatabaseTasksPayload, error) {
+	return func(r *http.Request) (*controlplane.ListDatabaseTasksPayload, error) {
+		var (
+			databaseID  string
+			afterTaskID *string
+			limit       *int
+			sortOrder   *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		qp := r.URL.Query()
+		afterTaskIDRaw := qp.Get("after_task_id")
+		if afterTaskIDRaw != "" {
+			afterTaskID = &afterTaskIDRaw
+		}
+		if afterTaskID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		sortOrderRaw := qp.Get("sort_order")
+		if sortOrderRaw != "" {
+			sortOrder = &sortOrderRaw
+		}
+		if sortOrder != nil {
+			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListDatabaseTasksPayload(databaseID, afterTaskID, limit, sortOrder)
+
+		return payload, nil
+	}
+}
+
+// EncodeListDatabaseTasksError returns an encoder for errors returned by the
+// list-database-tasks control-plane endpoint.
+func EncodeListDatabaseTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDatabaseTasksClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDatabaseTasksInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDatabaseTasksNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDatabaseTasksServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetDatabaseTaskResponse returns an encoder for responses returned by
+// the control-plane get-database-task endpoint.
+func EncodeGetDatabaseTaskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Task)
+		enc := encoder(ctx, w)
+		body := NewGetDatabaseTaskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetDatabaseTaskRequest returns a decoder for requests sent to the
+// control-plane get-database-task endpoint.
+func DecodeGetDatabaseTaskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetDatabaseTaskPayload, error) {
+	return func(r *http.Request) (*controlplane.GetDatabaseTaskPayload, error) {
+		var (
+			databaseID string
+			taskID     string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		taskID = params["task_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetDatabaseTaskPayload(databaseID, taskID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetDatabaseTaskError returns an encoder for errors returned by the
+// get-database-task control-plane endpoint.
+func EncodeGetDatabaseTaskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetDatabaseTaskLogResponse returns an encoder for responses returned
+// by the control-plane get-database-task-log endpoint.
+func EncodeGetDatabaseTaskLogResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.TaskLog)
+		enc := encoder(ctx, w)
+		body := NewGetDatabaseTaskLogResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetDatabaseTaskLogRequest returns a decoder for requests sent to the
+// control-plane get-database-task-log endpoint.
+func DecodeGetDatabaseTaskLogRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetDatabaseTaskLogPayload, error) {
+	return func(r *http.Request) (*controlplane.GetDatabaseTaskLogPayload, error) {
+		var (
+			databaseID   string
+			taskID       string
+			afterEntryID *string
+			limit        *int
+			err          error
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		taskID = params["task_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
+		qp := r.URL.Query()
+		afterEntryIDRaw := qp.Get("after_entry_id")
+		if afterEntryIDRaw != "" {
+			afterEntryID = &afterEntryIDRaw
+		}
+		if afterEntryID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("after_entry_id", *afterEntryID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetDatabaseTaskLogPayload(databaseID, taskID, afterEntryID, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetDatabaseTaskLogError returns an encoder for errors returned by the
+// get-database-task-log control-plane endpoint.
+func EncodeGetDatabaseTaskLogError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskLogClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskLogInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskLogNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetDatabaseTaskLogServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListHostTasksResponse returns an encoder for responses returned by the
+// control-plane list-host-tasks endpoint.
+func EncodeListHostTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListHostTasksResponse)
+		enc := encoder(ctx, w)
+		body := NewListHostTasksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListHostTasksRequest returns a decoder for requests sent to the
+// control-plane list-host-tasks endpoint.
+func DecodeListHostTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListHostTasksPayload, error) {
+	return func(r *http.Request) (*controlplane.ListHostTasksPayload, error) {
+		var (
+			hostID      string
+			afterTaskID *string
+			limit       *int
+			sortOrder   *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		hostID = params["host_id"]
+		if utf8.RuneCountInString(hostID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		}
+		if utf8.RuneCountInString(hostID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		}
+		qp := r.URL.Query()
+		afterTaskIDRaw := qp.Get("after_task_id")
+		if afterTaskIDRaw != "" {
+			afterTaskID = &afterTaskIDRaw
+		}
+		if afterTaskID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		sortOrderRaw := qp.Get("sort_order")
+		if sortOrderRaw != "" {
+			sortOrder = &sortOrderRaw
+		}
+		if sortOrder != nil {
+			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListHostTasksPayload(hostID, afterTaskID, limit, sortOrder)
+
+		return payload, nil
+	}
+}
+
+// EncodeListHostTasksError returns an encoder for errors returned by the
+// list-host-tasks control-plane endpoint.
+func EncodeListHostTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListHostTasksClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListHostTasksInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListHostTasksNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListHostTasksServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetHostTaskResponse returns an encoder for responses returned by the
+// control-plane get-host-task endpoint.
+func EncodeGetHostTaskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Task)
+		enc := encoder(ctx, w)
+		body := NewGetHostTaskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetHostTaskRequest returns a decoder for requests sent to the
+// control-plane get-host-task endpoint.
+func DecodeGetHostTaskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetHostTaskPayload, error) {
+	return func(r *http.Request) (*controlplane.GetHostTaskPayload, error) {
+		var (
+			hostID string
+			taskID string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		hostID = params["host_id"]
+		if utf8.RuneCountInString(hostID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		}
+		if utf8.RuneCountInString(hostID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		}
+		taskID = params["task_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetHostTaskPayload(hostID, taskID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetHostTaskError returns an encoder for errors returned by the
+// get-host-task control-plane endpoint.
+func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetHostTaskLogResponse returns an encoder for responses returned by
+// the control-plane get-host-task-log endpoint.
+func EncodeGetHostTaskLogResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.TaskLog)
+		enc := encoder(ctx, w)
+		body := NewGetHostTaskLogResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetHostTaskLogRequest returns a decoder for requests sent to the
+// control-plane get-host-task-log endpoint.
+func DecodeGetHostTaskLogRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetHostTaskLogPayload, error) {
+	return func(r *http.Request) (*controlplane.GetHostTaskLogPayload, error) {
+		var (
+			hostID       string
+			taskID       string
+			afterEntryID *string
+			limit        *int
+			err          error
+
+			params = mux.Vars(r)
+		)
+		hostID = params["host_id"]
+		if utf8.RuneCountInString(hostID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
+		}
+		if utf8.RuneCountInString(hostID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		}
+		taskID = params["task_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
+		qp := r.URL.Query()
+		afterEntryIDRaw := qp.Get("after_entry_id")
+		if afterEntryIDRaw != "" {
+			afterEntryID = &afterEntryIDRaw
+		}
+		if afterEntryID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("after_entry_id", *afterEntryID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetHostTaskLogPayload(hostID, taskID, afterEntryID, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetHostTaskLogError returns an encoder for errors returned by the
+// get-host-task-log control-plane endpoint.
+func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskLogClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskLogInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskLogNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetHostTaskLogServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListTasksResponse returns an encoder for responses returned by the
+// control-plane list-tasks endpoint.
+func EncodeListTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListTasksResponse)
+		enc := encoder(ctx, w)
+		body := NewListTasksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListTasksRequest returns a decoder for requests sent to the
+// control-plane list-tasks endpoint.
+func DecodeListTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListTasksPayload, error) {
+	return func(r *http.Request) (*controlplane.ListTasksPayload, error) {
+		var (
+			scope       *string
+			entityID    *string
+			afterTaskID *string
+			limit       *int
+			sortOrder   *string
+			err         error
+		)
+		qp := r.URL.Query()
+		scopeRaw := qp.Get("scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		if scope != nil {
+			if !(*scope == "database" || *scope == "host") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("scope", *scope, []any{"database", "host"}))
+			}
+		}
+		entityIDRaw := qp.Get("entity_id")
+		if entityIDRaw != "" {
+			entityID = &entityIDRaw
+		}
+		if entityID != nil {
+			if utf8.RuneCountInString(*entityID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("entity_id", *entityID, utf8.RuneCountInString(*entityID), 1, true))
+			}
+		}
+		if entityID != nil {
+			if utf8.RuneCountInString(*entityID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("entity_id", *entityID, utf8.RuneCountInString(*entityID), 36, false))
+			}
+		}
+		afterTaskIDRaw := qp.Get("after_task_id")
+		if afterTaskIDRaw != "" {
+			afterTaskID = &afterTaskIDRaw
+		}
+		if afterTaskID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		sortOrderRaw := qp.Get("sort_order")
+		if sortOrderRaw != "" {
+			sortOrder = &sortOrderRaw
+		}
+		if sortOrder != nil {
+			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListTasksPayload(scope, entityID, afterTaskID, limit, sortOrder)
+
+		return payload, nil
+	}
+}
+
+// EncodeListTasksError returns an encoder for errors returned by the
+// list-tasks control-plane endpoint.
+func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListTasksClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListTasksInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListTasksServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeStreamEventsResponse returns an encoder for responses returned by the
+// control-plane stream-events endpoint.
+func EncodeStreamEventsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Event)
+		enc := encoder(ctx, w)
+		body := NewStreamEventsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeStreamEventsRequest returns a decoder for requests sent to the
+// control-plane stream-events endpoint.
+func DecodeStreamEventsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.StreamEventsPayload, error) {
+	return func(r *http.Request) (*controlplane.StreamEventsPayload, error) {
+		var (
+			databaseID *string
+			hostID     *string
+			kind       []string
+			err        error
+		)
+		qp := r.URL.Query()
+		databaseIDRaw := qp.Get("database_id")
+		if databaseIDRaw != "" {
+			databaseID = &databaseIDRaw
+		}
+		if databaseID != nil {
+			if utf8.RuneCountInString(*databaseID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", *databaseID, utf8.RuneCountInString(*databaseID), 1, true))
+			}
+		}
+		if databaseID != nil {
+			if utf8.RuneCountInString(*databaseID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", *databaseID, utf8.RuneCountInString(*databaseID), 36, false))
+			}
+		}
+		hostIDRaw := qp.Get("host_id")
+		if hostIDRaw != "" {
+			hostID = &hostIDRaw
+		}
+		if hostID != nil {
+			if utf8.RuneCountInString(*hostID) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 1, true))
+			}
+		}
+		if hostID != nil {
+			if utf8.RuneCountInString(*hostID) > 36 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", *hostID, utf8.RuneCountInString(*hostID), 36, false))
+			}
+		}
+		kind = qp["kind"]
+		for _, e := range kind {
+			if !(e == "database" || e == "instance" || e == "task" || e == "task_log") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind[*]", e, []any{"database", "instance", "task", "task_log"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewStreamEventsPayload(databaseID, hostID, kind)
+
+		return payload, nil
+	}
+}
+
+// EncodeStreamEventsError returns an encoder for errors returned by the
+// stream-events control-plane endpoint.
+func EncodeStreamEventsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStreamEventsClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStreamEventsInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStreamEventsServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateWebhookResponse returns an encoder for responses returned by the
+// control-plane create-webhook endpoint.
+func EncodeCreateWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Webhook)
+		enc := encoder(ctx, w)
+		body := NewCreateWebhookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateWebhookRequest returns a decoder for requests sent to the
+// control-plane create-webhook endpoint.
+func DecodeCreateWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.CreateWebhookPayload, error) {
+	return func(r *http.Request) (*controlplane.CreateWebhookPayload, error) {
+		var (
+			body CreateWebhookRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateWebhookRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateWebhookPayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateWebhookError returns an encoder for errors returned by the
+// create-webhook control-plane endpoint.
+func EncodeCreateWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhooksResponse returns an encoder for responses returned by the
+// control-plane list-webhooks endpoint.
+func EncodeListWebhooksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListWebhooksResponse)
+		enc := encoder(ctx, w)
+		body := NewListWebhooksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListWebhooksError returns an encoder for errors returned by the
+// list-webhooks control-plane endpoint.
+func EncodeListWebhooksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetWebhookResponse returns an encoder for responses returned by the
+// control-plane get-webhook endpoint.
+func EncodeGetWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Webhook)
+		enc := encoder(ctx, w)
+		body := NewGetWebhookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWebhookRequest returns a decoder for requests sent to the
+// control-plane get-webhook endpoint.
+func DecodeGetWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetWebhookPayload, error) {
+	return func(r *http.Request) (*controlplane.GetWebhookPayload, error) {
+		var (
+			webhookID string
+			err       error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWebhookPayload(webhookID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWebhookError returns an encoder for errors returned by the
+// get-webhook control-plane endpoint.
+func EncodeGetWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteWebhookResponse returns an encoder for responses returned by the
+// control-plane delete-webhook endpoint.
+func EncodeDeleteWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookRequest returns a decoder for requests sent to the
+// control-plane delete-webhook endpoint.
+func DecodeDeleteWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.DeleteWebhookPayload, error) {
+	return func(r *http.Request) (*controlplane.DeleteWebhookPayload, error) {
+		var (
+			webhookID string
+			err       error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteWebhookPayload(webhookID)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteWebhookError returns an encoder for errors returned by the
+// delete-webhook control-plane endpoint.
+func EncodeDeleteWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhookDeliveriesResponse returns an encoder for responses
+// returned by the control-plane list-webhook-deliveries endpoint.
+func EncodeListWebhookDeliveriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.ListWebhookDeliveriesResponse)
+		enc := encoder(ctx, w)
+		body := NewListWebhookDeliveriesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhookDeliveriesRequest returns a decoder for requests sent to
+// the control-plane list-webhook-deliveries endpoint.
+func DecodeListWebhookDeliveriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListWebhookDeliveriesPayload, error) {
+	return func(r *http.Request) (*controlplane.ListWebhookDeliveriesPayload, error) {
+		var (
+			webhookID string
+			limit     int
+			err       error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("webhook_id", webhookID, goa.FormatUUID))
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListWebhookDeliveriesPayload(webhookID, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeListWebhookDeliveriesError returns an encoder for errors returned by
+// the list-webhook-deliveries control-plane endpoint.
+func EncodeListWebhookDeliveriesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRestoreDatabaseResponse returns an encoder for responses returned by
+// the control-plane restore-database endpoint.
+func EncodeRestoreDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.RestoreDatabaseResponse)
+		enc := encoder(ctx, w)
+		body := NewRestoreDatabaseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRestoreDatabaseRequest returns a decoder for requests sent to the
+// control-plane restore-database endpoint.
+func DecodeRestoreDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.RestoreDatabasePayload, error) {
+	return func(r *http.Request) (*controlplane.RestoreDatabasePayload, error) {
+		var (
+			body RestoreDatabaseRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRestoreDatabaseRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			databaseID string
+			force      bool
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		{
+			forceRaw := r.URL.Query().Get("force")
+			if forceRaw != "" {
+				v, err2 := strconv.ParseBool(forceRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
+				}
+				force = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRestoreDatabasePayload(&body, databaseID, force)
+
+		return payload, nil
+	}
+}
+
+// EncodeRestoreDatabaseError returns an encoder for errors returned by the
+// restore-database control-plane endpoint.
+func EncodeRestoreDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "database_not_modifiable":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseDatabaseNotModifiableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "operation_already_in_progress":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseOperationAlreadyInProgressResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreDatabaseServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCloneDatabaseResponse returns an encoder for responses returned by the
+// control-plane clone-database endpoint.
+func EncodeCloneDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.CloneDatabaseResponse)
+		enc := encoder(ctx, w)
+		body := NewCloneDatabaseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCloneDatabaseRequest returns a decoder for requests sent to the
+// control-plane clone-database endpoint.
+func DecodeCloneDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.CloneDatabasePayload, error) {
+	return func(r *http.Request) (*controlplane.CloneDatabasePayload, error) {
+		var (
+			body CloneDatabaseRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCloneDatabaseRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			databaseID string
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCloneDatabasePayload(&body, databaseID)
+
+		return payload, nil
+	}
+}
+
+// EncodeCloneDatabaseError returns an encoder for errors returned by the
+// clone-database control-plane endpoint.
+func EncodeCloneDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "database_already_exists":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseDatabaseAlreadyExistsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "operation_already_in_progress":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseOperationAlreadyInProgressResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneDatabaseServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetVersionResponse returns an encoder for responses returned by the
+// control-plane get-version endpoint.
+func EncodeGetVersionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.VersionInfo)
+		enc := encoder(ctx, w)
+		body := NewGetVersionResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeGetVersionError returns an encoder for errors returned by the
+// get-version control-plane endpoint.
+func EncodeGetVersionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetVersionServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRestartInstanceResponse returns an encoder for responses returned by
+// the control-plane restart-instance endpoint.
+func EncodeRestartInstanceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.RestartInstanceResponse)
+		enc := encoder(ctx, w)
+		body := NewRestartInstanceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRestartInstanceRequest returns a decoder for requests sent to the
+// control-plane restart-instance endpoint.
+func DecodeRestartInstanceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.RestartInstancePayload, error) {
+	return func(r *http.Request) (*controlplane.RestartInstancePayload, error) {
+		var (
+			body struct {
+				// The time at which the restart is scheduled.
+				ScheduledAt *string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		if body.ScheduledAt != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.scheduled_at", *body.ScheduledAt, goa.FormatDateTime))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			databaseID string
+			instanceID string
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		instanceID = params["instance_id"]
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRestartInstancePayload(body, databaseID, instanceID)
+
+		return payload, nil
+	}
+}
+
+// EncodeRestartInstanceError returns an encoder for errors returned by the
+// restart-instance control-plane endpoint.
+func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2516,7 +5088,7 @@ func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskClusterNotInitializedResponseBody(res)
+				body = NewRestartInstanceClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2529,7 +5101,7 @@ func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskInvalidInputResponseBody(res)
+				body = NewRestartInstanceInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2542,7 +5114,7 @@ func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskNotFoundResponseBody(res)
+				body = NewRestartInstanceNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2555,7 +5127,7 @@ func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskServerErrorResponseBody(res)
+				body = NewRestartInstanceServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2566,71 +5138,55 @@ func EncodeGetHostTaskError(encoder func(context.Context, http.ResponseWriter) g
 	}
 }
 
-// EncodeGetHostTaskLogResponse returns an encoder for responses returned by
-// the control-plane get-host-task-log endpoint.
-func EncodeGetHostTaskLogResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetInstanceSessionsResponse returns an encoder for responses returned
+// by the control-plane get-instance-sessions endpoint.
+func EncodeGetInstanceSessionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.TaskLog)
+		res, _ := v.(*controlplane.GetInstanceSessionsResponse)
 		enc := encoder(ctx, w)
-		body := NewGetHostTaskLogResponseBody(res)
+		body := NewGetInstanceSessionsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetHostTaskLogRequest returns a decoder for requests sent to the
-// control-plane get-host-task-log endpoint.
-func DecodeGetHostTaskLogRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetHostTaskLogPayload, error) {
-	return func(r *http.Request) (*controlplane.GetHostTaskLogPayload, error) {
+// DecodeGetInstanceSessionsRequest returns a decoder for requests sent to the
+// control-plane get-instance-sessions endpoint.
+func DecodeGetInstanceSessionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetInstanceSessionsPayload, error) {
+	return func(r *http.Request) (*controlplane.GetInstanceSessionsPayload, error) {
 		var (
-			hostID       string
-			taskID       string
-			afterEntryID *string
-			limit        *int
-			err          error
+			databaseID string
+			instanceID string
+			err        error
 
 			params = mux.Vars(r)
 		)
-		hostID = params["host_id"]
-		if utf8.RuneCountInString(hostID) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 1, true))
-		}
-		if utf8.RuneCountInString(hostID) > 36 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("host_id", hostID, utf8.RuneCountInString(hostID), 36, false))
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
 		}
-		taskID = params["task_id"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("task_id", taskID, goa.FormatUUID))
-		qp := r.URL.Query()
-		afterEntryIDRaw := qp.Get("after_entry_id")
-		if afterEntryIDRaw != "" {
-			afterEntryID = &afterEntryIDRaw
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		if afterEntryID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("after_entry_id", *afterEntryID, goa.FormatUUID))
+		instanceID = params["instance_id"]
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
 		}
-		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
-			}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetHostTaskLogPayload(hostID, taskID, afterEntryID, limit)
+		payload := NewGetInstanceSessionsPayload(databaseID, instanceID)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetHostTaskLogError returns an encoder for errors returned by the
-// get-host-task-log control-plane endpoint.
-func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetInstanceSessionsError returns an encoder for errors returned by the
+// get-instance-sessions control-plane endpoint.
+func EncodeGetInstanceSessionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2646,7 +5202,7 @@ func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskLogClusterNotInitializedResponseBody(res)
+				body = NewGetInstanceSessionsClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2659,7 +5215,7 @@ func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskLogInvalidInputResponseBody(res)
+				body = NewGetInstanceSessionsInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2672,7 +5228,7 @@ func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskLogNotFoundResponseBody(res)
+				body = NewGetInstanceSessionsNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -2685,7 +5241,7 @@ func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetHostTaskLogServerErrorResponseBody(res)
+				body = NewGetInstanceSessionsServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2696,93 +5252,67 @@ func EncodeGetHostTaskLogError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeListTasksResponse returns an encoder for responses returned by the
-// control-plane list-tasks endpoint.
-func EncodeListTasksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCancelInstanceSessionResponse returns an encoder for responses
+// returned by the control-plane cancel-instance-session endpoint.
+func EncodeCancelInstanceSessionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.ListTasksResponse)
+		res, _ := v.(*controlplane.SignalInstanceSessionResponse)
 		enc := encoder(ctx, w)
-		body := NewListTasksResponseBody(res)
+		body := NewCancelInstanceSessionResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListTasksRequest returns a decoder for requests sent to the
-// control-plane list-tasks endpoint.
-func DecodeListTasksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ListTasksPayload, error) {
-	return func(r *http.Request) (*controlplane.ListTasksPayload, error) {
+// DecodeCancelInstanceSessionRequest returns a decoder for requests sent to
+// the control-plane cancel-instance-session endpoint.
+func DecodeCancelInstanceSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.CancelInstanceSessionPayload, error) {
+	return func(r *http.Request) (*controlplane.CancelInstanceSessionPayload, error) {
 		var (
-			scope       *string
-			entityID    *string
-			afterTaskID *string
-			limit       *int
-			sortOrder   *string
-			err         error
+			databaseID string
+			instanceID string
+			pid        int
+			err        error
+
+			params = mux.Vars(r)
 		)
-		qp := r.URL.Query()
-		scopeRaw := qp.Get("scope")
-		if scopeRaw != "" {
-			scope = &scopeRaw
-		}
-		if scope != nil {
-			if !(*scope == "database" || *scope == "host") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("scope", *scope, []any{"database", "host"}))
-			}
-		}
-		entityIDRaw := qp.Get("entity_id")
-		if entityIDRaw != "" {
-			entityID = &entityIDRaw
-		}
-		if entityID != nil {
-			if utf8.RuneCountInString(*entityID) < 1 {
-				err = goa.MergeErrors(err, goa.InvalidLengthError("entity_id", *entityID, utf8.RuneCountInString(*entityID), 1, true))
-			}
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
 		}
-		if entityID != nil {
-			if utf8.RuneCountInString(*entityID) > 36 {
-				err = goa.MergeErrors(err, goa.InvalidLengthError("entity_id", *entityID, utf8.RuneCountInString(*entityID), 36, false))
-			}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
-		afterTaskIDRaw := qp.Get("after_task_id")
-		if afterTaskIDRaw != "" {
-			afterTaskID = &afterTaskIDRaw
+		instanceID = params["instance_id"]
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
 		}
-		if afterTaskID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("after_task_id", *afterTaskID, goa.FormatUUID))
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
 		}
 		{
-			limitRaw := qp.Get("limit")
-			if limitRaw != "" {
-				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
-				}
-				pv := int(v)
-				limit = &pv
+			pidRaw := params["pid"]
+			v, err2 := strconv.ParseInt(pidRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("pid", pidRaw, "integer"))
 			}
+			pid = int(v)
 		}
-		sortOrderRaw := qp.Get("sort_order")
-		if sortOrderRaw != "" {
-			sortOrder = &sortOrderRaw
-		}
-		if sortOrder != nil {
-			if !(*sortOrder == "asc" || *sortOrder == "ascend" || *sortOrder == "ascending" || *sortOrder == "desc" || *sortOrder == "descend" || *sortOrder == "descending") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", *sortOrder, []any{"asc", "ascend", "ascending", "desc", "descend", "descending"}))
-			}
+		if pid < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("pid", pid, 1, true))
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListTasksPayload(scope, entityID, afterTaskID, limit, sortOrder)
+		payload := NewCancelInstanceSessionPayload(databaseID, instanceID, pid)
 
 		return payload, nil
 	}
 }
 
-// EncodeListTasksError returns an encoder for errors returned by the
-// list-tasks control-plane endpoint.
-func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCancelInstanceSessionError returns an encoder for errors returned by
+// the cancel-instance-session control-plane endpoint.
+func EncodeCancelInstanceSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2798,7 +5328,7 @@ func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListTasksClusterNotInitializedResponseBody(res)
+				body = NewCancelInstanceSessionClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2811,11 +5341,24 @@ func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListTasksInvalidInputResponseBody(res)
+				body = NewCancelInstanceSessionInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCancelInstanceSessionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "server_error":
 			var res *controlplane.APIError
 			errors.As(v, &res)
@@ -2824,7 +5367,7 @@ func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewListTasksServerErrorResponseBody(res)
+				body = NewCancelInstanceSessionServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -2835,45 +5378,27 @@ func EncodeListTasksError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
-// EncodeRestoreDatabaseResponse returns an encoder for responses returned by
-// the control-plane restore-database endpoint.
-func EncodeRestoreDatabaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeTerminateInstanceSessionResponse returns an encoder for responses
+// returned by the control-plane terminate-instance-session endpoint.
+func EncodeTerminateInstanceSessionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.RestoreDatabaseResponse)
+		res, _ := v.(*controlplane.SignalInstanceSessionResponse)
 		enc := encoder(ctx, w)
-		body := NewRestoreDatabaseResponseBody(res)
+		body := NewTerminateInstanceSessionResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeRestoreDatabaseRequest returns a decoder for requests sent to the
-// control-plane restore-database endpoint.
-func DecodeRestoreDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.RestoreDatabasePayload, error) {
-	return func(r *http.Request) (*controlplane.RestoreDatabasePayload, error) {
-		var (
-			body RestoreDatabaseRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateRestoreDatabaseRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeTerminateInstanceSessionRequest returns a decoder for requests sent to
+// the control-plane terminate-instance-session endpoint.
+func DecodeTerminateInstanceSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.TerminateInstanceSessionPayload, error) {
+	return func(r *http.Request) (*controlplane.TerminateInstanceSessionPayload, error) {
 		var (
 			databaseID string
-			force      bool
+			instanceID string
+			pid        int
+			err        error
 
 			params = mux.Vars(r)
 		)
@@ -2884,28 +5409,36 @@ func DecodeRestoreDatabaseRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if utf8.RuneCountInString(databaseID) > 36 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
 		}
+		instanceID = params["instance_id"]
+		if utf8.RuneCountInString(instanceID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 1, true))
+		}
+		if utf8.RuneCountInString(instanceID) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("instance_id", instanceID, utf8.RuneCountInString(instanceID), 63, false))
+		}
 		{
-			forceRaw := r.URL.Query().Get("force")
-			if forceRaw != "" {
-				v, err2 := strconv.ParseBool(forceRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
-				}
-				force = v
+			pidRaw := params["pid"]
+			v, err2 := strconv.ParseInt(pidRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("pid", pidRaw, "integer"))
 			}
+			pid = int(v)
+		}
+		if pid < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("pid", pid, 1, true))
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewRestoreDatabasePayload(&body, databaseID, force)
+		payload := NewTerminateInstanceSessionPayload(databaseID, instanceID, pid)
 
 		return payload, nil
 	}
 }
 
-// EncodeRestoreDatabaseError returns an encoder for errors returned by the
-// restore-database control-plane endpoint.
-func EncodeRestoreDatabaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeTerminateInstanceSessionError returns an encoder for errors returned
+// by the terminate-instance-session control-plane endpoint.
+func EncodeTerminateInstanceSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -2921,33 +5454,7 @@ func EncodeRestoreDatabaseError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestoreDatabaseClusterNotInitializedResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
-		case "database_not_modifiable":
-			var res *controlplane.APIError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRestoreDatabaseDatabaseNotModifiableResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
-		case "operation_already_in_progress":
-			var res *controlplane.APIError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRestoreDatabaseOperationAlreadyInProgressResponseBody(res)
+				body = NewTerminateInstanceSessionClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -2960,7 +5467,7 @@ func EncodeRestoreDatabaseError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestoreDatabaseInvalidInputResponseBody(res)
+				body = NewTerminateInstanceSessionInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -2973,52 +5480,11 @@ func EncodeRestoreDatabaseError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestoreDatabaseNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
-		case "server_error":
-			var res *controlplane.APIError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewRestoreDatabaseServerErrorResponseBody(res)
+				body = NewTerminateInstanceSessionNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeGetVersionResponse returns an encoder for responses returned by the
-// control-plane get-version endpoint.
-func EncodeGetVersionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.VersionInfo)
-		enc := encoder(ctx, w)
-		body := NewGetVersionResponseBody(res)
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
-	}
-}
-
-// EncodeGetVersionError returns an encoder for errors returned by the
-// get-version control-plane endpoint.
-func EncodeGetVersionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
 		case "server_error":
 			var res *controlplane.APIError
 			errors.As(v, &res)
@@ -3027,7 +5493,7 @@ func EncodeGetVersionError(encoder func(context.Context, http.ResponseWriter) go
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetVersionServerErrorResponseBody(res)
+				body = NewTerminateInstanceSessionServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3038,50 +5504,26 @@ func EncodeGetVersionError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
-// EncodeRestartInstanceResponse returns an encoder for responses returned by
-// the control-plane restart-instance endpoint.
-func EncodeRestartInstanceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetInstancePostgresqlConfResponse returns an encoder for responses
+// returned by the control-plane get-instance-postgresql-conf endpoint.
+func EncodeGetInstancePostgresqlConfResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*controlplane.RestartInstanceResponse)
+		res, _ := v.(*controlplane.GetInstancePostgreSQLConfResponse)
 		enc := encoder(ctx, w)
-		body := NewRestartInstanceResponseBody(res)
+		body := NewGetInstancePostgresqlConfResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeRestartInstanceRequest returns a decoder for requests sent to the
-// control-plane restart-instance endpoint.
-func DecodeRestartInstanceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.RestartInstancePayload, error) {
-	return func(r *http.Request) (*controlplane.RestartInstancePayload, error) {
-		var (
-			body struct {
-				// The time at which the restart is scheduled.
-				ScheduledAt *string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
-			}
-			err error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		if body.ScheduledAt != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.scheduled_at", *body.ScheduledAt, goa.FormatDateTime))
-		}
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeGetInstancePostgresqlConfRequest returns a decoder for requests sent
+// to the control-plane get-instance-postgresql-conf endpoint.
+func DecodeGetInstancePostgresqlConfRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.GetInstancePostgresqlConfPayload, error) {
+	return func(r *http.Request) (*controlplane.GetInstancePostgresqlConfPayload, error) {
 		var (
 			databaseID string
 			instanceID string
+			err        error
 
 			params = mux.Vars(r)
 		)
@@ -3102,15 +5544,15 @@ func DecodeRestartInstanceRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if err != nil {
 			return nil, err
 		}
-		payload := NewRestartInstancePayload(body, databaseID, instanceID)
+		payload := NewGetInstancePostgresqlConfPayload(databaseID, instanceID)
 
 		return payload, nil
 	}
 }
 
-// EncodeRestartInstanceError returns an encoder for errors returned by the
-// restart-instance control-plane endpoint.
-func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetInstancePostgresqlConfError returns an encoder for errors returned
+// by the get-instance-postgresql-conf control-plane endpoint.
+func EncodeGetInstancePostgresqlConfError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -3126,7 +5568,7 @@ func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestartInstanceClusterNotInitializedResponseBody(res)
+				body = NewGetInstancePostgresqlConfClusterNotInitializedResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
@@ -3139,7 +5581,7 @@ func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestartInstanceInvalidInputResponseBody(res)
+				body = NewGetInstancePostgresqlConfInvalidInputResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -3152,7 +5594,7 @@ func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestartInstanceNotFoundResponseBody(res)
+				body = NewGetInstancePostgresqlConfNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -3165,7 +5607,7 @@ func EncodeRestartInstanceError(encoder func(context.Context, http.ResponseWrite
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewRestartInstanceServerErrorResponseBody(res)
+				body = NewGetInstancePostgresqlConfServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -3527,6 +5969,120 @@ func EncodeCancelDatabaseTaskError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
+// EncodeResumeDatabaseTaskResponse returns an encoder for responses returned
+// by the control-plane resume-database-task endpoint.
+func EncodeResumeDatabaseTaskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*controlplane.Task)
+		enc := encoder(ctx, w)
+		body := NewResumeDatabaseTaskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeResumeDatabaseTaskRequest returns a decoder for requests sent to the
+// control-plane resume-database-task endpoint.
+func DecodeResumeDatabaseTaskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*controlplane.ResumeDatabaseTaskPayload, error) {
+	return func(r *http.Request) (*controlplane.ResumeDatabaseTaskPayload, error) {
+		var (
+			databaseID string
+			taskID     string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		databaseID = params["database_id"]
+		if utf8.RuneCountInString(databaseID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 1, true))
+		}
+		if utf8.RuneCountInString(databaseID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("database_id", databaseID, utf8.RuneCountInString(databaseID), 36, false))
+		}
+		taskID = params["task_id"]
+		if utf8.RuneCountInString(taskID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("task_id", taskID, utf8.RuneCountInString(taskID), 1, true))
+		}
+		if utf8.RuneCountInString(taskID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("task_id", taskID, utf8.RuneCountInString(taskID), 36, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewResumeDatabaseTaskPayload(databaseID, taskID)
+
+		return payload, nil
+	}
+}
+
+// EncodeResumeDatabaseTaskError returns an encoder for errors returned by the
+// resume-database-task control-plane endpoint.
+func EncodeResumeDatabaseTaskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cluster_not_initialized":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResumeDatabaseTaskClusterNotInitializedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResumeDatabaseTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResumeDatabaseTaskInvalidInputResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "server_error":
+			var res *controlplane.APIError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResumeDatabaseTaskServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // marshalControlplaneEtcdClusterMemberToEtcdClusterMemberResponseBody builds a
 // value of type *EtcdClusterMemberResponseBody from a value of type
 // *controlplane.EtcdClusterMember.
@@ -3665,40 +6221,189 @@ func marshalControlplaneHostStatusToHostStatusResponseBody(v *controlplane.HostS
 			res.Components[tk] = marshalControlplaneComponentStatusToComponentStatusResponseBody(val)
 		}
 	}
-
-	return res
-}
-
-// marshalControlplaneComponentStatusToComponentStatusResponseBody builds a
-// value of type *ComponentStatusResponseBody from a value of type
-// *controlplane.ComponentStatus.
-func marshalControlplaneComponentStatusToComponentStatusResponseBody(v *controlplane.ComponentStatus) *ComponentStatusResponseBody {
-	res := &ComponentStatusResponseBody{
-		Healthy: v.Healthy,
-		Error:   v.Error,
+	if v.Version != nil {
+		res.Version = marshalControlplaneVersionInfoToVersionInfoResponseBody(v.Version)
+	}
+
+	return res
+}
+
+// marshalControlplaneComponentStatusToComponentStatusResponseBody builds a
+// value of type *ComponentStatusResponseBody from a value of type
+// *controlplane.ComponentStatus.
+func marshalControlplaneComponentStatusToComponentStatusResponseBody(v *controlplane.ComponentStatus) *ComponentStatusResponseBody {
+	res := &ComponentStatusResponseBody{
+		Healthy: v.Healthy,
+		Error:   v.Error,
+	}
+	if v.Details != nil {
+		res.Details = make(map[string]any, len(v.Details))
+		for key, val := range v.Details {
+			tk := key
+			tv := val
+			res.Details[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalControlplaneVersionInfoToVersionInfoResponseBody builds a value of
+// type *VersionInfoResponseBody from a value of type *controlplane.VersionInfo.
+func marshalControlplaneVersionInfoToVersionInfoResponseBody(v *controlplane.VersionInfo) *VersionInfoResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &VersionInfoResponseBody{
+		Version:      v.Version,
+		Revision:     v.Revision,
+		RevisionTime: v.RevisionTime,
+		Arch:         v.Arch,
+	}
+
+	return res
+}
+
+// marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody builds a value
+// of type *PgEdgeVersionResponseBody from a value of type
+// *controlplane.PgEdgeVersion.
+func marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(v *controlplane.PgEdgeVersion) *PgEdgeVersionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PgEdgeVersionResponseBody{
+		PostgresVersion: v.PostgresVersion,
+		SpockVersion:    v.SpockVersion,
+	}
+
+	return res
+}
+
+// marshalControlplaneClusterUpgradeStatusToClusterUpgradeStatusResponseBody
+// builds a value of type *ClusterUpgradeStatusResponseBody from a value of
+// type *controlplane.ClusterUpgradeStatus.
+func marshalControlplaneClusterUpgradeStatusToClusterUpgradeStatusResponseBody(v *controlplane.ClusterUpgradeStatus) *ClusterUpgradeStatusResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ClusterUpgradeStatusResponseBody{
+		State:        v.State,
+		Revision:     v.Revision,
+		StateVersion: v.StateVersion,
+	}
+	if v.PendingMigrations != nil {
+		res.PendingMigrations = make([]string, len(v.PendingMigrations))
+		for i, val := range v.PendingMigrations {
+			res.PendingMigrations[i] = val
+		}
+	} else {
+		res.PendingMigrations = []string{}
+	}
+	if v.Hosts != nil {
+		res.Hosts = make([]*ClusterUpgradeHostResponseBody, len(v.Hosts))
+		for i, val := range v.Hosts {
+			if val == nil {
+				res.Hosts[i] = nil
+				continue
+			}
+			res.Hosts[i] = marshalControlplaneClusterUpgradeHostToClusterUpgradeHostResponseBody(val)
+		}
+	} else {
+		res.Hosts = []*ClusterUpgradeHostResponseBody{}
+	}
+	if v.Incompatibilities != nil {
+		res.Incompatibilities = make([]string, len(v.Incompatibilities))
+		for i, val := range v.Incompatibilities {
+			res.Incompatibilities[i] = val
+		}
+	} else {
+		res.Incompatibilities = []string{}
+	}
+
+	return res
+}
+
+// marshalControlplaneClusterUpgradeHostToClusterUpgradeHostResponseBody builds
+// a value of type *ClusterUpgradeHostResponseBody from a value of type
+// *controlplane.ClusterUpgradeHost.
+func marshalControlplaneClusterUpgradeHostToClusterUpgradeHostResponseBody(v *controlplane.ClusterUpgradeHost) *ClusterUpgradeHostResponseBody {
+	res := &ClusterUpgradeHostResponseBody{
+		HostID:       string(v.HostID),
+		Version:      v.Version,
+		Revision:     v.Revision,
+		StateVersion: v.StateVersion,
+		Compatible:   v.Compatible,
+		Upgraded:     v.Upgraded,
+	}
+
+	return res
+}
+
+// marshalControlplaneStoragePrefixUsageToStoragePrefixUsageResponseBody builds
+// a value of type *StoragePrefixUsageResponseBody from a value of type
+// *controlplane.StoragePrefixUsage.
+func marshalControlplaneStoragePrefixUsageToStoragePrefixUsageResponseBody(v *controlplane.StoragePrefixUsage) *StoragePrefixUsageResponseBody {
+	res := &StoragePrefixUsageResponseBody{
+		Prefix: v.Prefix,
+		Keys:   v.Keys,
+		Bytes:  v.Bytes,
+	}
+
+	return res
+}
+
+// marshalControlplaneEtcdMemberToEtcdMemberResponseBody builds a value of type
+// *EtcdMemberResponseBody from a value of type *controlplane.EtcdMember.
+func marshalControlplaneEtcdMemberToEtcdMemberResponseBody(v *controlplane.EtcdMember) *EtcdMemberResponseBody {
+	res := &EtcdMemberResponseBody{
+		ID:               v.ID,
+		HostID:           string(v.HostID),
+		Role:             v.Role,
+		IsLeader:         v.IsLeader,
+		Reachable:        v.Reachable,
+		Version:          v.Version,
+		DbSizeBytes:      v.DbSizeBytes,
+		DbSizeInUseBytes: v.DbSizeInUseBytes,
+		RaftTerm:         v.RaftTerm,
+		RaftIndex:        v.RaftIndex,
+		RaftAppliedIndex: v.RaftAppliedIndex,
+		RaftIndexLag:     v.RaftIndexLag,
+	}
+	if v.PeerUrls != nil {
+		res.PeerUrls = make([]string, len(v.PeerUrls))
+		for i, val := range v.PeerUrls {
+			res.PeerUrls[i] = val
+		}
+	} else {
+		res.PeerUrls = []string{}
+	}
+	if v.ClientUrls != nil {
+		res.ClientUrls = make([]string, len(v.ClientUrls))
+		for i, val := range v.ClientUrls {
+			res.ClientUrls[i] = val
+		}
+	} else {
+		res.ClientUrls = []string{}
 	}
-	if v.Details != nil {
-		res.Details = make(map[string]any, len(v.Details))
-		for key, val := range v.Details {
-			tk := key
-			tv := val
-			res.Details[tk] = tv
+	if v.Errors != nil {
+		res.Errors = make([]string, len(v.Errors))
+		for i, val := range v.Errors {
+			res.Errors[i] = val
 		}
 	}
 
 	return res
 }
 
-// marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody builds a value
-// of type *PgEdgeVersionResponseBody from a value of type
-// *controlplane.PgEdgeVersion.
-func marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(v *controlplane.PgEdgeVersion) *PgEdgeVersionResponseBody {
-	if v == nil {
-		return nil
-	}
-	res := &PgEdgeVersionResponseBody{
-		PostgresVersion: v.PostgresVersion,
-		SpockVersion:    v.SpockVersion,
+// marshalControlplaneEtcdDefragmentResultToEtcdDefragmentResultResponseBody
+// builds a value of type *EtcdDefragmentResultResponseBody from a value of
+// type *controlplane.EtcdDefragmentResult.
+func marshalControlplaneEtcdDefragmentResultToEtcdDefragmentResultResponseBody(v *controlplane.EtcdDefragmentResult) *EtcdDefragmentResultResponseBody {
+	res := &EtcdDefragmentResultResponseBody{
+		HostID:            string(v.HostID),
+		DbSizeBeforeBytes: v.DbSizeBeforeBytes,
+		DbSizeAfterBytes:  v.DbSizeAfterBytes,
+		Error:             v.Error,
 	}
 
 	return res
@@ -3708,19 +6413,20 @@ func marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(v *controlplane
 // *TaskResponseBody from a value of type *controlplane.Task.
 func marshalControlplaneTaskToTaskResponseBody(v *controlplane.Task) *TaskResponseBody {
 	res := &TaskResponseBody{
-		ParentID:    v.ParentID,
-		Scope:       v.Scope,
-		EntityID:    v.EntityID,
-		DatabaseID:  v.DatabaseID,
-		NodeName:    v.NodeName,
-		InstanceID:  v.InstanceID,
-		HostID:      v.HostID,
-		TaskID:      v.TaskID,
-		CreatedAt:   v.CreatedAt,
-		CompletedAt: v.CompletedAt,
-		Type:        v.Type,
-		Status:      v.Status,
-		Error:       v.Error,
+		ParentID:     v.ParentID,
+		Scope:        v.Scope,
+		EntityID:     v.EntityID,
+		DatabaseID:   v.DatabaseID,
+		NodeName:     v.NodeName,
+		InstanceID:   v.InstanceID,
+		HostID:       v.HostID,
+		TaskID:       v.TaskID,
+		CreatedAt:    v.CreatedAt,
+		CompletedAt:  v.CompletedAt,
+		Type:         v.Type,
+		Status:       v.Status,
+		StatusReason: v.StatusReason,
+		Error:        v.Error,
 	}
 
 	return res
@@ -3740,6 +6446,12 @@ func marshalControlplaneDatabaseSummaryToDatabaseSummaryResponseBody(v *controlp
 		tenantID := string(*v.TenantID)
 		res.TenantID = &tenantID
 	}
+	if v.DegradedReasons != nil {
+		res.DegradedReasons = make([]string, len(v.DegradedReasons))
+		for i, val := range v.DegradedReasons {
+			res.DegradedReasons[i] = val
+		}
+	}
 	if v.Instances != nil {
 		res.Instances = make([]*InstanceResponseBody, len(v.Instances))
 		for i, val := range v.Instances {
@@ -3789,6 +6501,9 @@ func marshalControlplaneInstanceToInstanceResponseBody(v *controlplane.Instance)
 	if v.Spock != nil {
 		res.Spock = marshalControlplaneInstanceSpockStatusToInstanceSpockStatusResponseBody(v.Spock)
 	}
+	if v.Resources != nil {
+		res.Resources = marshalControlplaneInstanceResourceUsageToInstanceResourceUsageResponseBody(v.Resources)
+	}
 
 	return res
 }
@@ -3872,6 +6587,31 @@ func marshalControlplaneInstanceSubscriptionToInstanceSubscriptionResponseBody(v
 	return res
 }
 
+// marshalControlplaneInstanceResourceUsageToInstanceResourceUsageResponseBody
+// builds a value of type *InstanceResourceUsageResponseBody from a value of
+// type *controlplane.InstanceResourceUsage.
+func marshalControlplaneInstanceResourceUsageToInstanceResourceUsageResponseBody(v *controlplane.InstanceResourceUsage) *InstanceResourceUsageResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &InstanceResourceUsageResponseBody{
+		DataDirectoryBytes: v.DataDirectoryBytes,
+		WalDirectoryBytes:  v.WalDirectoryBytes,
+		VolumeFreeBytes:    v.VolumeFreeBytes,
+		VolumeTotalBytes:   v.VolumeTotalBytes,
+		DatabaseSizeBytes:  v.DatabaseSizeBytes,
+		Connections:        v.Connections,
+		MaxConnections:     v.MaxConnections,
+		CPUPercent:         v.CPUPercent,
+		MemoryBytes:        v.MemoryBytes,
+		MemoryLimitBytes:   v.MemoryLimitBytes,
+		DiskFull:           v.DiskFull,
+		CollectedAt:        v.CollectedAt,
+	}
+
+	return res
+}
+
 // marshalControlplaneAvailableUpgradeToAvailableUpgradeResponseBody builds a
 // value of type *AvailableUpgradeResponseBody from a value of type
 // *controlplane.AvailableUpgrade.
@@ -3900,6 +6640,8 @@ func unmarshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(v *DatabaseSpecR
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	res.Nodes = make([]*controlplane.DatabaseNodeSpec, len(v.Nodes))
 	for i, val := range v.Nodes {
@@ -3961,6 +6703,12 @@ func unmarshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(v *DatabaseSpecR
 	if v.Scripts != nil {
 		res.Scripts = unmarshalDatabaseScriptsRequestBodyToControlplaneDatabaseScripts(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = unmarshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings(v.QueryStats)
+	}
 
 	return res
 }
@@ -4011,6 +6759,9 @@ func unmarshalDatabaseNodeSpecRequestBodyToControlplaneDatabaseNodeSpec(v *Datab
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = unmarshalOrchestratorOptsRequestBodyToControlplaneOrchestratorOpts(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
 
 	return res
 }
@@ -4259,6 +7010,44 @@ func unmarshalExtraNetworkSpecRequestBodyToControlplaneExtraNetworkSpec(v *Extra
 	return res
 }
 
+// unmarshalPatroniSettingsRequestBodyToControlplanePatroniSettings builds a
+// value of type *controlplane.PatroniSettings from a value of type
+// *PatroniSettingsRequestBody.
+func unmarshalPatroniSettingsRequestBodyToControlplanePatroniSettings(v *PatroniSettingsRequestBody) *controlplane.PatroniSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniSettings{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = unmarshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog(v.Watchdog)
+	}
+
+	return res
+}
+
+// unmarshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog builds a
+// value of type *controlplane.PatroniWatchdog from a value of type
+// *PatroniWatchdogRequestBody.
+func unmarshalPatroniWatchdogRequestBodyToControlplanePatroniWatchdog(v *PatroniWatchdogRequestBody) *controlplane.PatroniWatchdog {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniWatchdog{
+		Mode:         *v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // unmarshalDatabaseUserSpecRequestBodyToControlplaneDatabaseUserSpec builds a
 // value of type *controlplane.DatabaseUserSpec from a value of type
 // *DatabaseUserSpecRequestBody.
@@ -4368,6 +7157,35 @@ func unmarshalDatabaseScriptsRequestBodyToControlplaneDatabaseScripts(v *Databas
 	return res
 }
 
+// unmarshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings
+// builds a value of type *controlplane.QueryStatsSettings from a value of type
+// *QueryStatsSettingsRequestBody.
+func unmarshalQueryStatsSettingsRequestBodyToControlplaneQueryStatsSettings(v *QueryStatsSettingsRequestBody) *controlplane.QueryStatsSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.QueryStatsSettings{
+		Enabled: *v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// unmarshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec builds a
+// value of type *controlplane.ImportSourceSpec from a value of type
+// *ImportSourceSpecRequestBody.
+func unmarshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec(v *ImportSourceSpecRequestBody) *controlplane.ImportSourceSpec {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.ImportSourceSpec{
+		Dsn: *v.Dsn,
+	}
+
+	return res
+}
+
 // marshalControlplaneDatabaseToDatabaseResponseBody builds a value of type
 // *DatabaseResponseBody from a value of type *controlplane.Database.
 func marshalControlplaneDatabaseToDatabaseResponseBody(v *controlplane.Database) *DatabaseResponseBody {
@@ -4381,6 +7199,12 @@ func marshalControlplaneDatabaseToDatabaseResponseBody(v *controlplane.Database)
 		tenantID := string(*v.TenantID)
 		res.TenantID = &tenantID
 	}
+	if v.DegradedReasons != nil {
+		res.DegradedReasons = make([]string, len(v.DegradedReasons))
+		for i, val := range v.DegradedReasons {
+			res.DegradedReasons[i] = val
+		}
+	}
 	if v.Instances != nil {
 		res.Instances = make([]*InstanceResponseBody, len(v.Instances))
 		for i, val := range v.Instances {
@@ -4414,6 +7238,9 @@ func marshalControlplaneDatabaseToDatabaseResponseBody(v *controlplane.Database)
 			res.AvailableUpgrades[i] = marshalControlplaneAvailableUpgradeToAvailableUpgradeResponseBody(val)
 		}
 	}
+	if v.Drift != nil {
+		res.Drift = marshalControlplaneDatabaseDriftToDatabaseDriftResponseBody(v.Drift)
+	}
 
 	return res
 }
@@ -4524,6 +7351,8 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecResponseBody(v *controlplane.D
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	if v.Nodes != nil {
 		res.Nodes = make([]*DatabaseNodeSpecResponseBody, len(v.Nodes))
@@ -4589,6 +7418,12 @@ func marshalControlplaneDatabaseSpecToDatabaseSpecResponseBody(v *controlplane.D
 	if v.Scripts != nil {
 		res.Scripts = marshalControlplaneDatabaseScriptsToDatabaseScriptsResponseBody(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsResponseBody(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = marshalControlplaneQueryStatsSettingsToQueryStatsSettingsResponseBody(v.QueryStats)
+	}
 
 	return res
 }
@@ -4643,6 +7478,9 @@ func marshalControlplaneDatabaseNodeSpecToDatabaseNodeSpecResponseBody(v *contro
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = marshalControlplaneOrchestratorOptsToOrchestratorOptsResponseBody(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = marshalControlplanePatroniSettingsToPatroniSettingsResponseBody(v.Patroni)
+	}
 
 	return res
 }
@@ -4897,6 +7735,44 @@ func marshalControlplaneExtraNetworkSpecToExtraNetworkSpecResponseBody(v *contro
 	return res
 }
 
+// marshalControlplanePatroniSettingsToPatroniSettingsResponseBody builds a
+// value of type *PatroniSettingsResponseBody from a value of type
+// *controlplane.PatroniSettings.
+func marshalControlplanePatroniSettingsToPatroniSettingsResponseBody(v *controlplane.PatroniSettings) *PatroniSettingsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniSettingsResponseBody{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = marshalControlplanePatroniWatchdogToPatroniWatchdogResponseBody(v.Watchdog)
+	}
+
+	return res
+}
+
+// marshalControlplanePatroniWatchdogToPatroniWatchdogResponseBody builds a
+// value of type *PatroniWatchdogResponseBody from a value of type
+// *controlplane.PatroniWatchdog.
+func marshalControlplanePatroniWatchdogToPatroniWatchdogResponseBody(v *controlplane.PatroniWatchdog) *PatroniWatchdogResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PatroniWatchdogResponseBody{
+		Mode:         v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // marshalControlplaneDatabaseUserSpecToDatabaseUserSpecResponseBody builds a
 // value of type *DatabaseUserSpecResponseBody from a value of type
 // *controlplane.DatabaseUserSpec.
@@ -5010,6 +7886,73 @@ func marshalControlplaneDatabaseScriptsToDatabaseScriptsResponseBody(v *controlp
 	return res
 }
 
+// marshalControlplaneQueryStatsSettingsToQueryStatsSettingsResponseBody builds
+// a value of type *QueryStatsSettingsResponseBody from a value of type
+// *controlplane.QueryStatsSettings.
+func marshalControlplaneQueryStatsSettingsToQueryStatsSettingsResponseBody(v *controlplane.QueryStatsSettings) *QueryStatsSettingsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &QueryStatsSettingsResponseBody{
+		Enabled: v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// marshalControlplaneDatabaseDriftToDatabaseDriftResponseBody builds a value
+// of type *DatabaseDriftResponseBody from a value of type
+// *controlplane.DatabaseDrift.
+func marshalControlplaneDatabaseDriftToDatabaseDriftResponseBody(v *controlplane.DatabaseDrift) *DatabaseDriftResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &DatabaseDriftResponseBody{
+		CheckedAt:         v.CheckedAt,
+		Error:             v.Error,
+		RemediationTaskID: v.RemediationTaskID,
+	}
+	if v.Resources != nil {
+		res.Resources = make([]*DriftedResourceResponseBody, len(v.Resources))
+		for i, val := range v.Resources {
+			if val == nil {
+				res.Resources[i] = nil
+				continue
+			}
+			res.Resources[i] = marshalControlplaneDriftedResourceToDriftedResourceResponseBody(val)
+		}
+	} else {
+		res.Resources = []*DriftedResourceResponseBody{}
+	}
+
+	return res
+}
+
+// marshalControlplaneDriftedResourceToDriftedResourceResponseBody builds a
+// value of type *DriftedResourceResponseBody from a value of type
+// *controlplane.DriftedResource.
+func marshalControlplaneDriftedResourceToDriftedResourceResponseBody(v *controlplane.DriftedResource) *DriftedResourceResponseBody {
+	res := &DriftedResourceResponseBody{
+		ResourceID: v.ResourceID,
+		Type:       v.Type,
+		Reason:     v.Reason,
+	}
+	if v.Diff != nil {
+		res.Diff = make([]map[string]any, len(v.Diff))
+		for i, val := range v.Diff {
+			res.Diff[i] = make(map[string]any, len(val))
+			for key, val := range val {
+				tk := key
+				tv := val
+				res.Diff[i][tk] = tv
+			}
+		}
+	}
+
+	return res
+}
+
 // unmarshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec builds
 // a value of type *controlplane.DatabaseSpec from a value of type
 // *DatabaseSpecRequestBodyRequestBody.
@@ -5022,6 +7965,8 @@ func unmarshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec(v *Da
 		PatroniPort:     v.PatroniPort,
 		Cpus:            v.Cpus,
 		Memory:          v.Memory,
+		DriftPolicy:     v.DriftPolicy,
+		PatroniDcs:      v.PatroniDcs,
 	}
 	res.Nodes = make([]*controlplane.DatabaseNodeSpec, len(v.Nodes))
 	for i, val := range v.Nodes {
@@ -5083,6 +8028,12 @@ func unmarshalDatabaseSpecRequestBodyRequestBodyToControlplaneDatabaseSpec(v *Da
 	if v.Scripts != nil {
 		res.Scripts = unmarshalDatabaseScriptsRequestBodyRequestBodyToControlplaneDatabaseScripts(v.Scripts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
+	if v.QueryStats != nil {
+		res.QueryStats = unmarshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings(v.QueryStats)
+	}
 
 	return res
 }
@@ -5133,6 +8084,9 @@ func unmarshalDatabaseNodeSpecRequestBodyRequestBodyToControlplaneDatabaseNodeSp
 	if v.OrchestratorOpts != nil {
 		res.OrchestratorOpts = unmarshalOrchestratorOptsRequestBodyRequestBodyToControlplaneOrchestratorOpts(v.OrchestratorOpts)
 	}
+	if v.Patroni != nil {
+		res.Patroni = unmarshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v.Patroni)
+	}
 
 	return res
 }
@@ -5382,6 +8336,44 @@ func unmarshalExtraNetworkSpecRequestBodyRequestBodyToControlplaneExtraNetworkSp
 	return res
 }
 
+// unmarshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings
+// builds a value of type *controlplane.PatroniSettings from a value of type
+// *PatroniSettingsRequestBodyRequestBody.
+func unmarshalPatroniSettingsRequestBodyRequestBodyToControlplanePatroniSettings(v *PatroniSettingsRequestBodyRequestBody) *controlplane.PatroniSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniSettings{
+		MaximumLagOnFailover: v.MaximumLagOnFailover,
+		FailsafeMode:         v.FailsafeMode,
+		LoopWait:             v.LoopWait,
+		TTL:                  v.TTL,
+		RetryTimeout:         v.RetryTimeout,
+		CheckTimeline:        v.CheckTimeline,
+	}
+	if v.Watchdog != nil {
+		res.Watchdog = unmarshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog(v.Watchdog)
+	}
+
+	return res
+}
+
+// unmarshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog
+// builds a value of type *controlplane.PatroniWatchdog from a value of type
+// *PatroniWatchdogRequestBodyRequestBody.
+func unmarshalPatroniWatchdogRequestBodyRequestBodyToControlplanePatroniWatchdog(v *PatroniWatchdogRequestBodyRequestBody) *controlplane.PatroniWatchdog {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.PatroniWatchdog{
+		Mode:         *v.Mode,
+		Device:       v.Device,
+		SafetyMargin: v.SafetyMargin,
+	}
+
+	return res
+}
+
 // unmarshalDatabaseUserSpecRequestBodyRequestBodyToControlplaneDatabaseUserSpec
 // builds a value of type *controlplane.DatabaseUserSpec from a value of type
 // *DatabaseUserSpecRequestBodyRequestBody.
@@ -5492,6 +8484,115 @@ func unmarshalDatabaseScriptsRequestBodyRequestBodyToControlplaneDatabaseScripts
 	return res
 }
 
+// unmarshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings
+// builds a value of type *controlplane.QueryStatsSettings from a value of type
+// *QueryStatsSettingsRequestBodyRequestBody.
+func unmarshalQueryStatsSettingsRequestBodyRequestBodyToControlplaneQueryStatsSettings(v *QueryStatsSettingsRequestBodyRequestBody) *controlplane.QueryStatsSettings {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.QueryStatsSettings{
+		Enabled: *v.Enabled,
+		TopN:    v.TopN,
+	}
+
+	return res
+}
+
+// marshalControlplanePostgreSQLConfChangeToPostgreSQLConfChangeResponseBody
+// builds a value of type *PostgreSQLConfChangeResponseBody from a value of
+// type *controlplane.PostgreSQLConfChange.
+func marshalControlplanePostgreSQLConfChangeToPostgreSQLConfChangeResponseBody(v *controlplane.PostgreSQLConfChange) *PostgreSQLConfChangeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PostgreSQLConfChangeResponseBody{
+		InstanceID:      v.InstanceID,
+		Name:            v.Name,
+		OldValue:        v.OldValue,
+		NewValue:        v.NewValue,
+		RequiresRestart: v.RequiresRestart,
+	}
+
+	return res
+}
+
+// unmarshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec
+// builds a value of type *controlplane.MajorUpgradeNodeSpec from a value of
+// type *MajorUpgradeNodeSpecRequestBodyRequestBody.
+func unmarshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec(v *MajorUpgradeNodeSpecRequestBodyRequestBody) *controlplane.MajorUpgradeNodeSpec {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.MajorUpgradeNodeSpec{
+		Name: *v.Name,
+	}
+	res.HostIds = make([]controlplane.Identifier, len(v.HostIds))
+	for i, val := range v.HostIds {
+		res.HostIds[i] = controlplane.Identifier(val)
+	}
+
+	return res
+}
+
+// unmarshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow
+// builds a value of type *controlplane.MaintenanceWindow from a value of type
+// *MaintenanceWindowRequestBodyRequestBody.
+func unmarshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow(v *MaintenanceWindowRequestBodyRequestBody) *controlplane.MaintenanceWindow {
+	if v == nil {
+		return nil
+	}
+	res := &controlplane.MaintenanceWindow{
+		Start: *v.Start,
+		End:   *v.End,
+	}
+
+	return res
+}
+
+// marshalControlplaneInstanceQueryStatsToInstanceQueryStatsResponseBody builds
+// a value of type *InstanceQueryStatsResponseBody from a value of type
+// *controlplane.InstanceQueryStats.
+func marshalControlplaneInstanceQueryStatsToInstanceQueryStatsResponseBody(v *controlplane.InstanceQueryStats) *InstanceQueryStatsResponseBody {
+	res := &InstanceQueryStatsResponseBody{
+		InstanceID:  v.InstanceID,
+		CollectedAt: v.CollectedAt,
+		WindowStart: v.WindowStart,
+	}
+	if v.Queries != nil {
+		res.Queries = make([]*QueryStatResponseBody, len(v.Queries))
+		for i, val := range v.Queries {
+			if val == nil {
+				res.Queries[i] = nil
+				continue
+			}
+			res.Queries[i] = marshalControlplaneQueryStatToQueryStatResponseBody(val)
+		}
+	} else {
+		res.Queries = []*QueryStatResponseBody{}
+	}
+
+	return res
+}
+
+// marshalControlplaneQueryStatToQueryStatResponseBody builds a value of type
+// *QueryStatResponseBody from a value of type *controlplane.QueryStat.
+func marshalControlplaneQueryStatToQueryStatResponseBody(v *controlplane.QueryStat) *QueryStatResponseBody {
+	res := &QueryStatResponseBody{
+		QueryID:           v.QueryID,
+		Query:             v.Query,
+		Calls:             v.Calls,
+		TotalTimeMs:       v.TotalTimeMs,
+		MeanTimeMs:        v.MeanTimeMs,
+		Rows:              v.Rows,
+		WindowCalls:       v.WindowCalls,
+		WindowTotalTimeMs: v.WindowTotalTimeMs,
+		WindowRows:        v.WindowRows,
+	}
+
+	return res
+}
+
 // marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody builds a value of
 // type *TaskLogEntryResponseBody from a value of type
 // *controlplane.TaskLogEntry.
@@ -5511,3 +8612,118 @@ func marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(v *controlplane.T
 
 	return res
 }
+
+// marshalControlplaneWebhookToWebhookResponseBody builds a value of type
+// *WebhookResponseBody from a value of type *controlplane.Webhook.
+func marshalControlplaneWebhookToWebhookResponseBody(v *controlplane.Webhook) *WebhookResponseBody {
+	res := &WebhookResponseBody{
+		ID:          v.ID,
+		URL:         v.URL,
+		Description: v.Description,
+		Secret:      v.Secret,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.EventTypes != nil {
+		res.EventTypes = make([]string, len(v.EventTypes))
+		for i, val := range v.EventTypes {
+			res.EventTypes[i] = string(val)
+		}
+	}
+	if v.DatabaseIds != nil {
+		res.DatabaseIds = make([]string, len(v.DatabaseIds))
+		for i, val := range v.DatabaseIds {
+			res.DatabaseIds[i] = string(val)
+		}
+	}
+
+	return res
+}
+
+// marshalControlplaneWebhookDeliveryToWebhookDeliveryResponseBody builds a
+// value of type *WebhookDeliveryResponseBody from a value of type
+// *controlplane.WebhookDelivery.
+func marshalControlplaneWebhookDeliveryToWebhookDeliveryResponseBody(v *controlplane.WebhookDelivery) *WebhookDeliveryResponseBody {
+	res := &WebhookDeliveryResponseBody{
+		DeliveryID:     v.DeliveryID,
+		WebhookID:      v.WebhookID,
+		EventType:      string(v.EventType),
+		Status:         v.Status,
+		Attempts:       v.Attempts,
+		LastStatusCode: v.LastStatusCode,
+		LastError:      v.LastError,
+		NextAttemptAt:  v.NextAttemptAt,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalControlplaneInstanceSessionToInstanceSessionResponseBody builds a
+// value of type *InstanceSessionResponseBody from a value of type
+// *controlplane.InstanceSession.
+func marshalControlplaneInstanceSessionToInstanceSessionResponseBody(v *controlplane.InstanceSession) *InstanceSessionResponseBody {
+	res := &InstanceSessionResponseBody{
+		Pid:             v.Pid,
+		Username:        v.Username,
+		DatabaseName:    v.DatabaseName,
+		ApplicationName: v.ApplicationName,
+		ClientAddr:      v.ClientAddr,
+		State:           v.State,
+		WaitEventType:   v.WaitEventType,
+		WaitEvent:       v.WaitEvent,
+		BackendStart:    v.BackendStart,
+		XactStart:       v.XactStart,
+		QueryStart:      v.QueryStart,
+		StateChange:     v.StateChange,
+		Query:           v.Query,
+	}
+	if v.BlockedBy != nil {
+		res.BlockedBy = make([]int, len(v.BlockedBy))
+		for i, val := range v.BlockedBy {
+			res.BlockedBy[i] = val
+		}
+	}
+	if v.Locks != nil {
+		res.Locks = make([]*SessionLockResponseBody, len(v.Locks))
+		for i, val := range v.Locks {
+			if val == nil {
+				res.Locks[i] = nil
+				continue
+			}
+			res.Locks[i] = marshalControlplaneSessionLockToSessionLockResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalControlplaneSessionLockToSessionLockResponseBody builds a value of
+// type *SessionLockResponseBody from a value of type *controlplane.SessionLock.
+func marshalControlplaneSessionLockToSessionLockResponseBody(v *controlplane.SessionLock) *SessionLockResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SessionLockResponseBody{
+		LockType: v.LockType,
+		Relation: v.Relation,
+		Mode:     v.Mode,
+		Granted:  v.Granted,
+	}
+
+	return res
+}
+
+// marshalControlplanePostgreSQLSettingToPostgreSQLSettingResponseBody builds a
+// value of type *PostgreSQLSettingResponseBody from a value of type
+// *controlplane.PostgreSQLSetting.
+func marshalControlplanePostgreSQLSettingToPostgreSQLSettingResponseBody(v *controlplane.PostgreSQLSetting) *PostgreSQLSettingResponseBody {
+	res := &PostgreSQLSettingResponseBody{
+		Name:           v.Name,
+		Value:          v.Value,
+		Source:         v.Source,
+		PendingRestart: v.PendingRestart,
+	}
+
+	return res
+}
@@ -36,6 +36,21 @@ func GetClusterControlPlanePath() string {
 	return "/v1/cluster"
 }
 
+// GetStorageUsageControlPlanePath returns the URL path to the control-plane service get-storage-usage HTTP endpoint.
+func GetStorageUsageControlPlanePath() string {
+	return "/v1/cluster/storage-usage"
+}
+
+// GetEtcdClusterControlPlanePath returns the URL path to the control-plane service get-etcd-cluster HTTP endpoint.
+func GetEtcdClusterControlPlanePath() string {
+	return "/v1/cluster/etcd"
+}
+
+// DefragmentEtcdControlPlanePath returns the URL path to the control-plane service defragment-etcd HTTP endpoint.
+func DefragmentEtcdControlPlanePath() string {
+	return "/v1/cluster/etcd/defragment"
+}
+
 // ListHostsControlPlanePath returns the URL path to the control-plane service list-hosts HTTP endpoint.
 func ListHostsControlPlanePath() string {
 	return "/v1/hosts"
@@ -51,6 +66,11 @@ func RemoveHostControlPlanePath(hostID string) string {
 	return fmt.Sprintf("/v1/hosts/%v", hostID)
 }
 
+// ChangeHostEtcdModeControlPlanePath returns the URL path to the control-plane service change-host-etcd-mode HTTP endpoint.
+func ChangeHostEtcdModeControlPlanePath(hostID string) string {
+	return fmt.Sprintf("/v1/hosts/%v/etcd-mode", hostID)
+}
+
 // ListDatabasesControlPlanePath returns the URL path to the control-plane service list-databases HTTP endpoint.
 func ListDatabasesControlPlanePath() string {
 	return "/v1/databases"
@@ -76,6 +96,16 @@ func ApplyUpgradeControlPlanePath(databaseID string) string {
 	return fmt.Sprintf("/v1/databases/%v/upgrade", databaseID)
 }
 
+// UpgradeDatabaseMajorControlPlanePath returns the URL path to the control-plane service upgrade-database-major HTTP endpoint.
+func UpgradeDatabaseMajorControlPlanePath(databaseID string) string {
+	return fmt.Sprintf("/v1/databases/%v/upgrade-major", databaseID)
+}
+
+// CutoverDatabaseImportControlPlanePath returns the URL path to the control-plane service cutover-database-import HTTP endpoint.
+func CutoverDatabaseImportControlPlanePath(databaseID string) string {
+	return fmt.Sprintf("/v1/databases/%v/import/cutover", databaseID)
+}
+
 // DeleteDatabaseControlPlanePath returns the URL path to the control-plane service delete-database HTTP endpoint.
 func DeleteDatabaseControlPlanePath(databaseID string) string {
 	return fmt.Sprintf("/v1/databases/%v", databaseID)
@@ -91,11 +121,26 @@ func SwitchoverDatabaseNodeControlPlanePath(databaseID string, nodeName string)
 	return fmt.Sprintf("/v1/databases/%v/nodes/%v/switchover", databaseID, nodeName)
 }
 
+// RollingRestartDatabaseControlPlanePath returns the URL path to the control-plane service rolling-restart-database HTTP endpoint.
+func RollingRestartDatabaseControlPlanePath(databaseID string) string {
+	return fmt.Sprintf("/v1/databases/%v/rolling-restart", databaseID)
+}
+
 // FailoverDatabaseNodeControlPlanePath returns the URL path to the control-plane service failover-database-node HTTP endpoint.
 func FailoverDatabaseNodeControlPlanePath(databaseID string, nodeName string) string {
 	return fmt.Sprintf("/v1/databases/%v/nodes/%v/failover", databaseID, nodeName)
 }
 
+// GetNodeQueryStatsControlPlanePath returns the URL path to the control-plane service get-node-query-stats HTTP endpoint.
+func GetNodeQueryStatsControlPlanePath(databaseID string, nodeName string) string {
+	return fmt.Sprintf("/v1/databases/%v/nodes/%v/query-stats", databaseID, nodeName)
+}
+
+// ResetNodeQueryStatsControlPlanePath returns the URL path to the control-plane service reset-node-query-stats HTTP endpoint.
+func ResetNodeQueryStatsControlPlanePath(databaseID string, nodeName string) string {
+	return fmt.Sprintf("/v1/databases/%v/nodes/%v/query-stats/reset", databaseID, nodeName)
+}
+
 // ListDatabaseTasksControlPlanePath returns the URL path to the control-plane service list-database-tasks HTTP endpoint.
 func ListDatabaseTasksControlPlanePath(databaseID string) string {
 	return fmt.Sprintf("/v1/databases/%v/tasks", databaseID)
@@ -131,11 +176,46 @@ func ListTasksControlPlanePath() string {
 	return "/v1/tasks"
 }
 
+// StreamEventsControlPlanePath returns the URL path to the control-plane service stream-events HTTP endpoint.
+func StreamEventsControlPlanePath() string {
+	return "/v1/events"
+}
+
+// CreateWebhookControlPlanePath returns the URL path to the control-plane service create-webhook HTTP endpoint.
+func CreateWebhookControlPlanePath() string {
+	return "/v1/webhooks"
+}
+
+// ListWebhooksControlPlanePath returns the URL path to the control-plane service list-webhooks HTTP endpoint.
+func ListWebhooksControlPlanePath() string {
+	return "/v1/webhooks"
+}
+
+// GetWebhookControlPlanePath returns the URL path to the control-plane service get-webhook HTTP endpoint.
+func GetWebhookControlPlanePath(webhookID string) string {
+	return fmt.Sprintf("/v1/webhooks/%v", webhookID)
+}
+
+// DeleteWebhookControlPlanePath returns the URL path to the control-plane service delete-webhook HTTP endpoint.
+func DeleteWebhookControlPlanePath(webhookID string) string {
+	return fmt.Sprintf("/v1/webhooks/%v", webhookID)
+}
+
+// ListWebhookDeliveriesControlPlanePath returns the URL path to the control-plane service list-webhook-deliveries HTTP endpoint.
+func ListWebhookDeliveriesControlPlanePath(webhookID string) string {
+	return fmt.Sprintf("/v1/webhooks/%v/deliveries", webhookID)
+}
+
 // RestoreDatabaseControlPlanePath returns the URL path to the control-plane service restore-database HTTP endpoint.
 func RestoreDatabaseControlPlanePath(databaseID string) string {
 	return fmt.Sprintf("/v1/databases/%v/restore", databaseID)
 }
 
+// CloneDatabaseControlPlanePath returns the URL path to the control-plane service clone-database HTTP endpoint.
+func CloneDatabaseControlPlanePath(databaseID string) string {
+	return fmt.Sprintf("/v1/databases/%v/clone", databaseID)
+}
+
 // GetVersionControlPlanePath returns the URL path to the control-plane service get-version HTTP endpoint.
 func GetVersionControlPlanePath() string {
 	return "/v1/version"
@@ -146,6 +226,26 @@ func RestartInstanceControlPlanePath(databaseID string, instanceID string) strin
 	return fmt.Sprintf("/v1/databases/%v/instances/%v/restart", databaseID, instanceID)
 }
 
+// GetInstanceSessionsControlPlanePath returns the URL path to the control-plane service get-instance-sessions HTTP endpoint.
+func GetInstanceSessionsControlPlanePath(databaseID string, instanceID string) string {
+	return fmt.Sprintf("/v1/databases/%v/instances/%v/sessions", databaseID, instanceID)
+}
+
+// CancelInstanceSessionControlPlanePath returns the URL path to the control-plane service cancel-instance-session HTTP endpoint.
+func CancelInstanceSessionControlPlanePath(databaseID string, instanceID string, pid int) string {
+	return fmt.Sprintf("/v1/databases/%v/instances/%v/sessions/%v/cancel", databaseID, instanceID, pid)
+}
+
+// TerminateInstanceSessionControlPlanePath returns the URL path to the control-plane service terminate-instance-session HTTP endpoint.
+func TerminateInstanceSessionControlPlanePath(databaseID string, instanceID string, pid int) string {
+	return fmt.Sprintf("/v1/databases/%v/instances/%v/sessions/%v/terminate", databaseID, instanceID, pid)
+}
+
+// GetInstancePostgresqlConfControlPlanePath returns the URL path to the control-plane service get-instance-postgresql-conf HTTP endpoint.
+func GetInstancePostgresqlConfControlPlanePath(databaseID string, instanceID string) string {
+	return fmt.Sprintf("/v1/databases/%v/instances/%v/postgresql-conf", databaseID, instanceID)
+}
+
 // StopInstanceControlPlanePath returns the URL path to the control-plane service stop-instance HTTP endpoint.
 func StopInstanceControlPlanePath(databaseID string, instanceID string) string {
 	return fmt.Sprintf("/v1/databases/%v/instances/%v/stop-instance", databaseID, instanceID)
@@ -160,3 +260,8 @@ func StartInstanceControlPlanePath(databaseID string, instanceID string) string
 func CancelDatabaseTaskControlPlanePath(databaseID string, taskID string) string {
 	return fmt.Sprintf("/v1/databases/%v/tasks/%v/cancel", databaseID, taskID)
 }
+
+// ResumeDatabaseTaskControlPlanePath returns the URL path to the control-plane service resume-database-task HTTP endpoint.
+func ResumeDatabaseTaskControlPlanePath(databaseID string, taskID string) string {
+	return fmt.Sprintf("/v1/databases/%v/tasks/%v/resume", databaseID, taskID)
+}
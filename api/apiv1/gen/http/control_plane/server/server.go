@@ -19,38 +19,59 @@ import (
 
 // Server lists the control-plane service endpoint HTTP handlers.
 type Server struct {
-	Mounts                 []*MountPoint
-	InitCluster            http.Handler
-	JoinCluster            http.Handler
-	GetJoinToken           http.Handler
-	GetJoinOptions         http.Handler
-	GetCluster             http.Handler
-	ListHosts              http.Handler
-	GetHost                http.Handler
-	RemoveHost             http.Handler
-	ListDatabases          http.Handler
-	CreateDatabase         http.Handler
-	GetDatabase            http.Handler
-	UpdateDatabase         http.Handler
-	ApplyUpgrade           http.Handler
-	DeleteDatabase         http.Handler
-	BackupDatabaseNode     http.Handler
-	SwitchoverDatabaseNode http.Handler
-	FailoverDatabaseNode   http.Handler
-	ListDatabaseTasks      http.Handler
-	GetDatabaseTask        http.Handler
-	GetDatabaseTaskLog     http.Handler
-	ListHostTasks          http.Handler
-	GetHostTask            http.Handler
-	GetHostTaskLog         http.Handler
-	ListTasks              http.Handler
-	RestoreDatabase        http.Handler
-	GetVersion             http.Handler
-	RestartInstance        http.Handler
-	StopInstance           http.Handler
-	StartInstance          http.Handler
-	CancelDatabaseTask     http.Handler
-	GenHTTPOpenapi3JSON    http.Handler
+	Mounts                    []*MountPoint
+	InitCluster               http.Handler
+	JoinCluster               http.Handler
+	GetJoinToken              http.Handler
+	GetJoinOptions            http.Handler
+	GetCluster                http.Handler
+	GetStorageUsage           http.Handler
+	GetEtcdCluster            http.Handler
+	DefragmentEtcd            http.Handler
+	ListHosts                 http.Handler
+	GetHost                   http.Handler
+	RemoveHost                http.Handler
+	ChangeHostEtcdMode        http.Handler
+	ListDatabases             http.Handler
+	CreateDatabase            http.Handler
+	GetDatabase               http.Handler
+	UpdateDatabase            http.Handler
+	ApplyUpgrade              http.Handler
+	UpgradeDatabaseMajor      http.Handler
+	CutoverDatabaseImport     http.Handler
+	DeleteDatabase            http.Handler
+	BackupDatabaseNode        http.Handler
+	SwitchoverDatabaseNode    http.Handler
+	RollingRestartDatabase    http.Handler
+	FailoverDatabaseNode      http.Handler
+	GetNodeQueryStats         http.Handler
+	ResetNodeQueryStats       http.Handler
+	ListDatabaseTasks         http.Handler
+	GetDatabaseTask           http.Handler
+	GetDatabaseTaskLog        http.Handler
+	ListHostTasks             http.Handler
+	GetHostTask               http.Handler
+	GetHostTaskLog            http.Handler
+	ListTasks                 http.Handler
+	StreamEvents              http.Handler
+	CreateWebhook             http.Handler
+	ListWebhooks              http.Handler
+	GetWebhook                http.Handler
+	DeleteWebhook             http.Handler
+	ListWebhookDeliveries     http.Handler
+	RestoreDatabase           http.Handler
+	CloneDatabase             http.Handler
+	GetVersion                http.Handler
+	RestartInstance           http.Handler
+	GetInstanceSessions       http.Handler
+	CancelInstanceSession     http.Handler
+	TerminateInstanceSession  http.Handler
+	GetInstancePostgresqlConf http.Handler
+	StopInstance              http.Handler
+	StartInstance             http.Handler
+	CancelDatabaseTask        http.Handler
+	ResumeDatabaseTask        http.Handler
+	GenHTTPOpenapi3JSON       http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -90,18 +111,27 @@ func New(
 			{"GetJoinToken", "GET", "/v1/cluster/join-token"},
 			{"GetJoinOptions", "POST", "/v1/internal/cluster/join-options"},
 			{"GetCluster", "GET", "/v1/cluster"},
+			{"GetStorageUsage", "GET", "/v1/cluster/storage-usage"},
+			{"GetEtcdCluster", "GET", "/v1/cluster/etcd"},
+			{"DefragmentEtcd", "POST", "/v1/cluster/etcd/defragment"},
 			{"ListHosts", "GET", "/v1/hosts"},
 			{"GetHost", "GET", "/v1/hosts/{host_id}"},
 			{"RemoveHost", "DELETE", "/v1/hosts/{host_id}"},
+			{"ChangeHostEtcdMode", "POST", "/v1/hosts/{host_id}/etcd-mode"},
 			{"ListDatabases", "GET", "/v1/databases"},
 			{"CreateDatabase", "POST", "/v1/databases"},
 			{"GetDatabase", "GET", "/v1/databases/{database_id}"},
 			{"UpdateDatabase", "POST", "/v1/databases/{database_id}"},
 			{"ApplyUpgrade", "POST", "/v1/databases/{database_id}/upgrade"},
+			{"UpgradeDatabaseMajor", "POST", "/v1/databases/{database_id}/upgrade-major"},
+			{"CutoverDatabaseImport", "POST", "/v1/databases/{database_id}/import/cutover"},
 			{"DeleteDatabase", "DELETE", "/v1/databases/{database_id}"},
 			{"BackupDatabaseNode", "POST", "/v1/databases/{database_id}/nodes/{node_name}/backups"},
 			{"SwitchoverDatabaseNode", "POST", "/v1/databases/{database_id}/nodes/{node_name}/switchover"},
+			{"RollingRestartDatabase", "POST", "/v1/databases/{database_id}/rolling-restart"},
 			{"FailoverDatabaseNode", "POST", "/v1/databases/{database_id}/nodes/{node_name}/failover"},
+			{"GetNodeQueryStats", "GET", "/v1/databases/{database_id}/nodes/{node_name}/query-stats"},
+			{"ResetNodeQueryStats", "POST", "/v1/databases/{database_id}/nodes/{node_name}/query-stats/reset"},
 			{"ListDatabaseTasks", "GET", "/v1/databases/{database_id}/tasks"},
 			{"GetDatabaseTask", "GET", "/v1/databases/{database_id}/tasks/{task_id}"},
 			{"GetDatabaseTaskLog", "GET", "/v1/databases/{database_id}/tasks/{task_id}/log"},
@@ -109,45 +139,78 @@ func New(
 			{"GetHostTask", "GET", "/v1/hosts/{host_id}/tasks/{task_id}"},
 			{"GetHostTaskLog", "GET", "/v1/hosts/{host_id}/tasks/{task_id}/logs"},
 			{"ListTasks", "GET", "/v1/tasks"},
+			{"StreamEvents", "GET", "/v1/events"},
+			{"CreateWebhook", "POST", "/v1/webhooks"},
+			{"ListWebhooks", "GET", "/v1/webhooks"},
+			{"GetWebhook", "GET", "/v1/webhooks/{webhook_id}"},
+			{"DeleteWebhook", "DELETE", "/v1/webhooks/{webhook_id}"},
+			{"ListWebhookDeliveries", "GET", "/v1/webhooks/{webhook_id}/deliveries"},
 			{"RestoreDatabase", "POST", "/v1/databases/{database_id}/restore"},
+			{"CloneDatabase", "POST", "/v1/databases/{database_id}/clone"},
 			{"GetVersion", "GET", "/v1/version"},
 			{"RestartInstance", "POST", "/v1/databases/{database_id}/instances/{instance_id}/restart"},
+			{"GetInstanceSessions", "GET", "/v1/databases/{database_id}/instances/{instance_id}/sessions"},
+			{"CancelInstanceSession", "POST", "/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/cancel"},
+			{"TerminateInstanceSession", "POST", "/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/terminate"},
+			{"GetInstancePostgresqlConf", "GET", "/v1/databases/{database_id}/instances/{instance_id}/postgresql-conf"},
 			{"StopInstance", "POST", "/v1/databases/{database_id}/instances/{instance_id}/stop-instance"},
 			{"StartInstance", "POST", "/v1/databases/{database_id}/instances/{instance_id}/start-instance"},
 			{"CancelDatabaseTask", "GET", "/v1/databases/{database_id}/tasks/{task_id}/cancel"},
+			{"ResumeDatabaseTask", "POST", "/v1/databases/{database_id}/tasks/{task_id}/resume"},
 			{"Serve ./gen/http/openapi3.json", "GET", "/v1/openapi.json"},
 		},
-		InitCluster:            NewInitClusterHandler(e.InitCluster, mux, decoder, encoder, errhandler, formatter),
-		JoinCluster:            NewJoinClusterHandler(e.JoinCluster, mux, decoder, encoder, errhandler, formatter),
-		GetJoinToken:           NewGetJoinTokenHandler(e.GetJoinToken, mux, decoder, encoder, errhandler, formatter),
-		GetJoinOptions:         NewGetJoinOptionsHandler(e.GetJoinOptions, mux, decoder, encoder, errhandler, formatter),
-		GetCluster:             NewGetClusterHandler(e.GetCluster, mux, decoder, encoder, errhandler, formatter),
-		ListHosts:              NewListHostsHandler(e.ListHosts, mux, decoder, encoder, errhandler, formatter),
-		GetHost:                NewGetHostHandler(e.GetHost, mux, decoder, encoder, errhandler, formatter),
-		RemoveHost:             NewRemoveHostHandler(e.RemoveHost, mux, decoder, encoder, errhandler, formatter),
-		ListDatabases:          NewListDatabasesHandler(e.ListDatabases, mux, decoder, encoder, errhandler, formatter),
-		CreateDatabase:         NewCreateDatabaseHandler(e.CreateDatabase, mux, decoder, encoder, errhandler, formatter),
-		GetDatabase:            NewGetDatabaseHandler(e.GetDatabase, mux, decoder, encoder, errhandler, formatter),
-		UpdateDatabase:         NewUpdateDatabaseHandler(e.UpdateDatabase, mux, decoder, encoder, errhandler, formatter),
-		ApplyUpgrade:           NewApplyUpgradeHandler(e.ApplyUpgrade, mux, decoder, encoder, errhandler, formatter),
-		DeleteDatabase:         NewDeleteDatabaseHandler(e.DeleteDatabase, mux, decoder, encoder, errhandler, formatter),
-		BackupDatabaseNode:     NewBackupDatabaseNodeHandler(e.BackupDatabaseNode, mux, decoder, encoder, errhandler, formatter),
-		SwitchoverDatabaseNode: NewSwitchoverDatabaseNodeHandler(e.SwitchoverDatabaseNode, mux, decoder, encoder, errhandler, formatter),
-		FailoverDatabaseNode:   NewFailoverDatabaseNodeHandler(e.FailoverDatabaseNode, mux, decoder, encoder, errhandler, formatter),
-		ListDatabaseTasks:      NewListDatabaseTasksHandler(e.ListDatabaseTasks, mux, decoder, encoder, errhandler, formatter),
-		GetDatabaseTask:        NewGetDatabaseTaskHandler(e.GetDatabaseTask, mux, decoder, encoder, errhandler, formatter),
-		GetDatabaseTaskLog:     NewGetDatabaseTaskLogHandler(e.GetDatabaseTaskLog, mux, decoder, encoder, errhandler, formatter),
-		ListHostTasks:          NewListHostTasksHandler(e.ListHostTasks, mux, decoder, encoder, errhandler, formatter),
-		GetHostTask:            NewGetHostTaskHandler(e.GetHostTask, mux, decoder, encoder, errhandler, formatter),
-		GetHostTaskLog:         NewGetHostTaskLogHandler(e.GetHostTaskLog, mux, decoder, encoder, errhandler, formatter),
-		ListTasks:              NewListTasksHandler(e.ListTasks, mux, decoder, encoder, errhandler, formatter),
-		RestoreDatabase:        NewRestoreDatabaseHandler(e.RestoreDatabase, mux, decoder, encoder, errhandler, formatter),
-		GetVersion:             NewGetVersionHandler(e.GetVersion, mux, decoder, encoder, errhandler, formatter),
-		RestartInstance:        NewRestartInstanceHandler(e.RestartInstance, mux, decoder, encoder, errhandler, formatter),
-		StopInstance:           NewStopInstanceHandler(e.StopInstance, mux, decoder, encoder, errhandler, formatter),
-		StartInstance:          NewStartInstanceHandler(e.StartInstance, mux, decoder, encoder, errhandler, formatter),
-		CancelDatabaseTask:     NewCancelDatabaseTaskHandler(e.CancelDatabaseTask, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapi3JSON:    http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		InitCluster:               NewInitClusterHandler(e.InitCluster, mux, decoder, encoder, errhandler, formatter),
+		JoinCluster:               NewJoinClusterHandler(e.JoinCluster, mux, decoder, encoder, errhandler, formatter),
+		GetJoinToken:              NewGetJoinTokenHandler(e.GetJoinToken, mux, decoder, encoder, errhandler, formatter),
+		GetJoinOptions:            NewGetJoinOptionsHandler(e.GetJoinOptions, mux, decoder, encoder, errhandler, formatter),
+		GetCluster:                NewGetClusterHandler(e.GetCluster, mux, decoder, encoder, errhandler, formatter),
+		GetStorageUsage:           NewGetStorageUsageHandler(e.GetStorageUsage, mux, decoder, encoder, errhandler, formatter),
+		GetEtcdCluster:            NewGetEtcdClusterHandler(e.GetEtcdCluster, mux, decoder, encoder, errhandler, formatter),
+		DefragmentEtcd:            NewDefragmentEtcdHandler(e.DefragmentEtcd, mux, decoder, encoder, errhandler, formatter),
+		ListHosts:                 NewListHostsHandler(e.ListHosts, mux, decoder, encoder, errhandler, formatter),
+		GetHost:                   NewGetHostHandler(e.GetHost, mux, decoder, encoder, errhandler, formatter),
+		RemoveHost:                NewRemoveHostHandler(e.RemoveHost, mux, decoder, encoder, errhandler, formatter),
+		ChangeHostEtcdMode:        NewChangeHostEtcdModeHandler(e.ChangeHostEtcdMode, mux, decoder, encoder, errhandler, formatter),
+		ListDatabases:             NewListDatabasesHandler(e.ListDatabases, mux, decoder, encoder, errhandler, formatter),
+		CreateDatabase:            NewCreateDatabaseHandler(e.CreateDatabase, mux, decoder, encoder, errhandler, formatter),
+		GetDatabase:               NewGetDatabaseHandler(e.GetDatabase, mux, decoder, encoder, errhandler, formatter),
+		UpdateDatabase:            NewUpdateDatabaseHandler(e.UpdateDatabase, mux, decoder, encoder, errhandler, formatter),
+		ApplyUpgrade:              NewApplyUpgradeHandler(e.ApplyUpgrade, mux, decoder, encoder, errhandler, formatter),
+		UpgradeDatabaseMajor:      NewUpgradeDatabaseMajorHandler(e.UpgradeDatabaseMajor, mux, decoder, encoder, errhandler, formatter),
+		CutoverDatabaseImport:     NewCutoverDatabaseImportHandler(e.CutoverDatabaseImport, mux, decoder, encoder, errhandler, formatter),
+		DeleteDatabase:            NewDeleteDatabaseHandler(e.DeleteDatabase, mux, decoder, encoder, errhandler, formatter),
+		BackupDatabaseNode:        NewBackupDatabaseNodeHandler(e.BackupDatabaseNode, mux, decoder, encoder, errhandler, formatter),
+		SwitchoverDatabaseNode:    NewSwitchoverDatabaseNodeHandler(e.SwitchoverDatabaseNode, mux, decoder, encoder, errhandler, formatter),
+		RollingRestartDatabase:    NewRollingRestartDatabaseHandler(e.RollingRestartDatabase, mux, decoder, encoder, errhandler, formatter),
+		FailoverDatabaseNode:      NewFailoverDatabaseNodeHandler(e.FailoverDatabaseNode, mux, decoder, encoder, errhandler, formatter),
+		GetNodeQueryStats:         NewGetNodeQueryStatsHandler(e.GetNodeQueryStats, mux, decoder, encoder, errhandler, formatter),
+		ResetNodeQueryStats:       NewResetNodeQueryStatsHandler(e.ResetNodeQueryStats, mux, decoder, encoder, errhandler, formatter),
+		ListDatabaseTasks:         NewListDatabaseTasksHandler(e.ListDatabaseTasks, mux, decoder, encoder, errhandler, formatter),
+		GetDatabaseTask:           NewGetDatabaseTaskHandler(e.GetDatabaseTask, mux, decoder, encoder, errhandler, formatter),
+		GetDatabaseTaskLog:        NewGetDatabaseTaskLogHandler(e.GetDatabaseTaskLog, mux, decoder, encoder, errhandler, formatter),
+		ListHostTasks:             NewListHostTasksHandler(e.ListHostTasks, mux, decoder, encoder, errhandler, formatter),
+		GetHostTask:               NewGetHostTaskHandler(e.GetHostTask, mux, decoder, encoder, errhandler, formatter),
+		GetHostTaskLog:            NewGetHostTaskLogHandler(e.GetHostTaskLog, mux, decoder, encoder, errhandler, formatter),
+		ListTasks:                 NewListTasksHandler(e.ListTasks, mux, decoder, encoder, errhandler, formatter),
+		StreamEvents:              NewStreamEventsHandler(e.StreamEvents, mux, decoder, encoder, errhandler, formatter),
+		CreateWebhook:             NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhooks:              NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		GetWebhook:                NewGetWebhookHandler(e.GetWebhook, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:             NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries:     NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		RestoreDatabase:           NewRestoreDatabaseHandler(e.RestoreDatabase, mux, decoder, encoder, errhandler, formatter),
+		CloneDatabase:             NewCloneDatabaseHandler(e.CloneDatabase, mux, decoder, encoder, errhandler, formatter),
+		GetVersion:                NewGetVersionHandler(e.GetVersion, mux, decoder, encoder, errhandler, formatter),
+		RestartInstance:           NewRestartInstanceHandler(e.RestartInstance, mux, decoder, encoder, errhandler, formatter),
+		GetInstanceSessions:       NewGetInstanceSessionsHandler(e.GetInstanceSessions, mux, decoder, encoder, errhandler, formatter),
+		CancelInstanceSession:     NewCancelInstanceSessionHandler(e.CancelInstanceSession, mux, decoder, encoder, errhandler, formatter),
+		TerminateInstanceSession:  NewTerminateInstanceSessionHandler(e.TerminateInstanceSession, mux, decoder, encoder, errhandler, formatter),
+		GetInstancePostgresqlConf: NewGetInstancePostgresqlConfHandler(e.GetInstancePostgresqlConf, mux, decoder, encoder, errhandler, formatter),
+		StopInstance:              NewStopInstanceHandler(e.StopInstance, mux, decoder, encoder, errhandler, formatter),
+		StartInstance:             NewStartInstanceHandler(e.StartInstance, mux, decoder, encoder, errhandler, formatter),
+		CancelDatabaseTask:        NewCancelDatabaseTaskHandler(e.CancelDatabaseTask, mux, decoder, encoder, errhandler, formatter),
+		ResumeDatabaseTask:        NewResumeDatabaseTaskHandler(e.ResumeDatabaseTask, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapi3JSON:       http.FileServer(fileSystemGenHTTPOpenapi3JSON),
 	}
 }
 
@@ -161,18 +224,27 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetJoinToken = m(s.GetJoinToken)
 	s.GetJoinOptions = m(s.GetJoinOptions)
 	s.GetCluster = m(s.GetCluster)
+	s.GetStorageUsage = m(s.GetStorageUsage)
+	s.GetEtcdCluster = m(s.GetEtcdCluster)
+	s.DefragmentEtcd = m(s.DefragmentEtcd)
 	s.ListHosts = m(s.ListHosts)
 	s.GetHost = m(s.GetHost)
 	s.RemoveHost = m(s.RemoveHost)
+	s.ChangeHostEtcdMode = m(s.ChangeHostEtcdMode)
 	s.ListDatabases = m(s.ListDatabases)
 	s.CreateDatabase = m(s.CreateDatabase)
 	s.GetDatabase = m(s.GetDatabase)
 	s.UpdateDatabase = m(s.UpdateDatabase)
 	s.ApplyUpgrade = m(s.ApplyUpgrade)
+	s.UpgradeDatabaseMajor = m(s.UpgradeDatabaseMajor)
+	s.CutoverDatabaseImport = m(s.CutoverDatabaseImport)
 	s.DeleteDatabase = m(s.DeleteDatabase)
 	s.BackupDatabaseNode = m(s.BackupDatabaseNode)
 	s.SwitchoverDatabaseNode = m(s.SwitchoverDatabaseNode)
+	s.RollingRestartDatabase = m(s.RollingRestartDatabase)
 	s.FailoverDatabaseNode = m(s.FailoverDatabaseNode)
+	s.GetNodeQueryStats = m(s.GetNodeQueryStats)
+	s.ResetNodeQueryStats = m(s.ResetNodeQueryStats)
 	s.ListDatabaseTasks = m(s.ListDatabaseTasks)
 	s.GetDatabaseTask = m(s.GetDatabaseTask)
 	s.GetDatabaseTaskLog = m(s.GetDatabaseTaskLog)
@@ -180,12 +252,24 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetHostTask = m(s.GetHostTask)
 	s.GetHostTaskLog = m(s.GetHostTaskLog)
 	s.ListTasks = m(s.ListTasks)
+	s.StreamEvents = m(s.StreamEvents)
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.GetWebhook = m(s.GetWebhook)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
 	s.RestoreDatabase = m(s.RestoreDatabase)
+	s.CloneDatabase = m(s.CloneDatabase)
 	s.GetVersion = m(s.GetVersion)
 	s.RestartInstance = m(s.RestartInstance)
+	s.GetInstanceSessions = m(s.GetInstanceSessions)
+	s.CancelInstanceSession = m(s.CancelInstanceSession)
+	s.TerminateInstanceSession = m(s.TerminateInstanceSession)
+	s.GetInstancePostgresqlConf = m(s.GetInstancePostgresqlConf)
 	s.StopInstance = m(s.StopInstance)
 	s.StartInstance = m(s.StartInstance)
 	s.CancelDatabaseTask = m(s.CancelDatabaseTask)
+	s.ResumeDatabaseTask = m(s.ResumeDatabaseTask)
 }
 
 // MethodNames returns the methods served.
@@ -198,18 +282,27 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetJoinTokenHandler(mux, h.GetJoinToken)
 	MountGetJoinOptionsHandler(mux, h.GetJoinOptions)
 	MountGetClusterHandler(mux, h.GetCluster)
+	MountGetStorageUsageHandler(mux, h.GetStorageUsage)
+	MountGetEtcdClusterHandler(mux, h.GetEtcdCluster)
+	MountDefragmentEtcdHandler(mux, h.DefragmentEtcd)
 	MountListHostsHandler(mux, h.ListHosts)
 	MountGetHostHandler(mux, h.GetHost)
 	MountRemoveHostHandler(mux, h.RemoveHost)
+	MountChangeHostEtcdModeHandler(mux, h.ChangeHostEtcdMode)
 	MountListDatabasesHandler(mux, h.ListDatabases)
 	MountCreateDatabaseHandler(mux, h.CreateDatabase)
 	MountGetDatabaseHandler(mux, h.GetDatabase)
 	MountUpdateDatabaseHandler(mux, h.UpdateDatabase)
 	MountApplyUpgradeHandler(mux, h.ApplyUpgrade)
+	MountUpgradeDatabaseMajorHandler(mux, h.UpgradeDatabaseMajor)
+	MountCutoverDatabaseImportHandler(mux, h.CutoverDatabaseImport)
 	MountDeleteDatabaseHandler(mux, h.DeleteDatabase)
 	MountBackupDatabaseNodeHandler(mux, h.BackupDatabaseNode)
 	MountSwitchoverDatabaseNodeHandler(mux, h.SwitchoverDatabaseNode)
+	MountRollingRestartDatabaseHandler(mux, h.RollingRestartDatabase)
 	MountFailoverDatabaseNodeHandler(mux, h.FailoverDatabaseNode)
+	MountGetNodeQueryStatsHandler(mux, h.GetNodeQueryStats)
+	MountResetNodeQueryStatsHandler(mux, h.ResetNodeQueryStats)
 	MountListDatabaseTasksHandler(mux, h.ListDatabaseTasks)
 	MountGetDatabaseTaskHandler(mux, h.GetDatabaseTask)
 	MountGetDatabaseTaskLogHandler(mux, h.GetDatabaseTaskLog)
@@ -217,12 +310,24 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetHostTaskHandler(mux, h.GetHostTask)
 	MountGetHostTaskLogHandler(mux, h.GetHostTaskLog)
 	MountListTasksHandler(mux, h.ListTasks)
+	MountStreamEventsHandler(mux, h.StreamEvents)
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountGetWebhookHandler(mux, h.GetWebhook)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
 	MountRestoreDatabaseHandler(mux, h.RestoreDatabase)
+	MountCloneDatabaseHandler(mux, h.CloneDatabase)
 	MountGetVersionHandler(mux, h.GetVersion)
 	MountRestartInstanceHandler(mux, h.RestartInstance)
+	MountGetInstanceSessionsHandler(mux, h.GetInstanceSessions)
+	MountCancelInstanceSessionHandler(mux, h.CancelInstanceSession)
+	MountTerminateInstanceSessionHandler(mux, h.TerminateInstanceSession)
+	MountGetInstancePostgresqlConfHandler(mux, h.GetInstancePostgresqlConf)
 	MountStopInstanceHandler(mux, h.StopInstance)
 	MountStartInstanceHandler(mux, h.StartInstance)
 	MountCancelDatabaseTaskHandler(mux, h.CancelDatabaseTask)
+	MountResumeDatabaseTaskHandler(mux, h.ResumeDatabaseTask)
 	MountGenHTTPOpenapi3JSON(mux, http.StripPrefix("/v1", h.GenHTTPOpenapi3JSON))
 }
 
@@ -482,6 +587,158 @@ func NewGetClusterHandler(
 	})
 }
 
+// MountGetStorageUsageHandler configures the mux to serve the "control-plane"
+// service "get-storage-usage" endpoint.
+func MountGetStorageUsageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/cluster/storage-usage", f)
+}
+
+// NewGetStorageUsageHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "get-storage-usage" endpoint.
+func NewGetStorageUsageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetStorageUsageRequest(mux, decoder)
+		encodeResponse = EncodeGetStorageUsageResponse(encoder)
+		encodeError    = EncodeGetStorageUsageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-storage-usage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetEtcdClusterHandler configures the mux to serve the "control-plane"
+// service "get-etcd-cluster" endpoint.
+func MountGetEtcdClusterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/cluster/etcd", f)
+}
+
+// NewGetEtcdClusterHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "get-etcd-cluster" endpoint.
+func NewGetEtcdClusterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeGetEtcdClusterResponse(encoder)
+		encodeError    = EncodeGetEtcdClusterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-etcd-cluster")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDefragmentEtcdHandler configures the mux to serve the "control-plane"
+// service "defragment-etcd" endpoint.
+func MountDefragmentEtcdHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/cluster/etcd/defragment", f)
+}
+
+// NewDefragmentEtcdHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "defragment-etcd" endpoint.
+func NewDefragmentEtcdHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDefragmentEtcdRequest(mux, decoder)
+		encodeResponse = EncodeDefragmentEtcdResponse(encoder)
+		encodeError    = EncodeDefragmentEtcdError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "defragment-etcd")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountListHostsHandler configures the mux to serve the "control-plane"
 // service "list-hosts" endpoint.
 func MountListHostsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -505,6 +762,7 @@ func NewListHostsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
+		decodeRequest  = DecodeListHostsRequest(mux, decoder)
 		encodeResponse = EncodeListHostsResponse(encoder)
 		encodeError    = EncodeListHostsError(encoder, formatter)
 	)
@@ -512,8 +770,14 @@ func NewListHostsHandler(
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
 		ctx = context.WithValue(ctx, goa.MethodKey, "list-hosts")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -634,6 +898,60 @@ func NewRemoveHostHandler(
 	})
 }
 
+// MountChangeHostEtcdModeHandler configures the mux to serve the
+// "control-plane" service "change-host-etcd-mode" endpoint.
+func MountChangeHostEtcdModeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/hosts/{host_id}/etcd-mode", f)
+}
+
+// NewChangeHostEtcdModeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "change-host-etcd-mode"
+// endpoint.
+func NewChangeHostEtcdModeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeChangeHostEtcdModeRequest(mux, decoder)
+		encodeResponse = EncodeChangeHostEtcdModeResponse(encoder)
+		encodeError    = EncodeChangeHostEtcdModeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "change-host-etcd-mode")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountListDatabasesHandler configures the mux to serve the "control-plane"
 // service "list-databases" endpoint.
 func MountListDatabasesHandler(mux goahttp.Muxer, h http.Handler) {
@@ -899,21 +1217,22 @@ func NewApplyUpgradeHandler(
 	})
 }
 
-// MountDeleteDatabaseHandler configures the mux to serve the "control-plane"
-// service "delete-database" endpoint.
-func MountDeleteDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
+// MountUpgradeDatabaseMajorHandler configures the mux to serve the
+// "control-plane" service "upgrade-database-major" endpoint.
+func MountUpgradeDatabaseMajorHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("DELETE", "/v1/databases/{database_id}", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/upgrade-major", f)
 }
 
-// NewDeleteDatabaseHandler creates a HTTP handler which loads the HTTP request
-// and calls the "control-plane" service "delete-database" endpoint.
-func NewDeleteDatabaseHandler(
+// NewUpgradeDatabaseMajorHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "upgrade-database-major"
+// endpoint.
+func NewUpgradeDatabaseMajorHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -922,13 +1241,67 @@ func NewDeleteDatabaseHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteDatabaseRequest(mux, decoder)
-		encodeResponse = EncodeDeleteDatabaseResponse(encoder)
-		encodeError    = EncodeDeleteDatabaseError(encoder, formatter)
+		decodeRequest  = DecodeUpgradeDatabaseMajorRequest(mux, decoder)
+		encodeResponse = EncodeUpgradeDatabaseMajorResponse(encoder)
+		encodeError    = EncodeUpgradeDatabaseMajorError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-database")
+		ctx = context.WithValue(ctx, goa.MethodKey, "upgrade-database-major")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCutoverDatabaseImportHandler configures the mux to serve the
+// "control-plane" service "cutover-database-import" endpoint.
+func MountCutoverDatabaseImportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/import/cutover", f)
+}
+
+// NewCutoverDatabaseImportHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "cutover-database-import"
+// endpoint.
+func NewCutoverDatabaseImportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCutoverDatabaseImportRequest(mux, decoder)
+		encodeResponse = EncodeCutoverDatabaseImportResponse(encoder)
+		encodeError    = EncodeCutoverDatabaseImportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "cutover-database-import")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -952,22 +1325,824 @@ func NewDeleteDatabaseHandler(
 	})
 }
 
-// MountBackupDatabaseNodeHandler configures the mux to serve the
-// "control-plane" service "backup-database-node" endpoint.
-func MountBackupDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+// MountDeleteDatabaseHandler configures the mux to serve the "control-plane"
+// service "delete-database" endpoint.
+func MountDeleteDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/databases/{database_id}", f)
+}
+
+// NewDeleteDatabaseHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "delete-database" endpoint.
+func NewDeleteDatabaseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteDatabaseRequest(mux, decoder)
+		encodeResponse = EncodeDeleteDatabaseResponse(encoder)
+		encodeError    = EncodeDeleteDatabaseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-database")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBackupDatabaseNodeHandler configures the mux to serve the
+// "control-plane" service "backup-database-node" endpoint.
+func MountBackupDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/backups", f)
+}
+
+// NewBackupDatabaseNodeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "backup-database-node"
+// endpoint.
+func NewBackupDatabaseNodeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBackupDatabaseNodeRequest(mux, decoder)
+		encodeResponse = EncodeBackupDatabaseNodeResponse(encoder)
+		encodeError    = EncodeBackupDatabaseNodeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "backup-database-node")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSwitchoverDatabaseNodeHandler configures the mux to serve the
+// "control-plane" service "switchover-database-node" endpoint.
+func MountSwitchoverDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/switchover", f)
+}
+
+// NewSwitchoverDatabaseNodeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "switchover-database-node"
+// endpoint.
+func NewSwitchoverDatabaseNodeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSwitchoverDatabaseNodeRequest(mux, decoder)
+		encodeResponse = EncodeSwitchoverDatabaseNodeResponse(encoder)
+		encodeError    = EncodeSwitchoverDatabaseNodeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "switchover-database-node")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRollingRestartDatabaseHandler configures the mux to serve the
+// "control-plane" service "rolling-restart-database" endpoint.
+func MountRollingRestartDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/rolling-restart", f)
+}
+
+// NewRollingRestartDatabaseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "rolling-restart-database"
+// endpoint.
+func NewRollingRestartDatabaseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRollingRestartDatabaseRequest(mux, decoder)
+		encodeResponse = EncodeRollingRestartDatabaseResponse(encoder)
+		encodeError    = EncodeRollingRestartDatabaseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rolling-restart-database")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountFailoverDatabaseNodeHandler configures the mux to serve the
+// "control-plane" service "failover-database-node" endpoint.
+func MountFailoverDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/failover", f)
+}
+
+// NewFailoverDatabaseNodeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "failover-database-node"
+// endpoint.
+func NewFailoverDatabaseNodeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeFailoverDatabaseNodeRequest(mux, decoder)
+		encodeResponse = EncodeFailoverDatabaseNodeResponse(encoder)
+		encodeError    = EncodeFailoverDatabaseNodeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "failover-database-node")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetNodeQueryStatsHandler configures the mux to serve the
+// "control-plane" service "get-node-query-stats" endpoint.
+func MountGetNodeQueryStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/databases/{database_id}/nodes/{node_name}/query-stats", f)
+}
+
+// NewGetNodeQueryStatsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "get-node-query-stats"
+// endpoint.
+func NewGetNodeQueryStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetNodeQueryStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetNodeQueryStatsResponse(encoder)
+		encodeError    = EncodeGetNodeQueryStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-node-query-stats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountResetNodeQueryStatsHandler configures the mux to serve the
+// "control-plane" service "reset-node-query-stats" endpoint.
+func MountResetNodeQueryStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/query-stats/reset", f)
+}
+
+// NewResetNodeQueryStatsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "reset-node-query-stats"
+// endpoint.
+func NewResetNodeQueryStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResetNodeQueryStatsRequest(mux, decoder)
+		encodeResponse = EncodeResetNodeQueryStatsResponse(encoder)
+		encodeError    = EncodeResetNodeQueryStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reset-node-query-stats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListDatabaseTasksHandler configures the mux to serve the
+// "control-plane" service "list-database-tasks" endpoint.
+func MountListDatabaseTasksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/databases/{database_id}/tasks", f)
+}
+
+// NewListDatabaseTasksHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "list-database-tasks" endpoint.
+func NewListDatabaseTasksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDatabaseTasksRequest(mux, decoder)
+		encodeResponse = EncodeListDatabaseTasksResponse(encoder)
+		encodeError    = EncodeListDatabaseTasksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-database-tasks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetDatabaseTaskHandler configures the mux to serve the "control-plane"
+// service "get-database-task" endpoint.
+func MountGetDatabaseTaskHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/databases/{database_id}/tasks/{task_id}", f)
+}
+
+// NewGetDatabaseTaskHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "get-database-task" endpoint.
+func NewGetDatabaseTaskHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetDatabaseTaskRequest(mux, decoder)
+		encodeResponse = EncodeGetDatabaseTaskResponse(encoder)
+		encodeError    = EncodeGetDatabaseTaskError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-database-task")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetDatabaseTaskLogHandler configures the mux to serve the
+// "control-plane" service "get-database-task-log" endpoint.
+func MountGetDatabaseTaskLogHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/databases/{database_id}/tasks/{task_id}/log", f)
+}
+
+// NewGetDatabaseTaskLogHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "get-database-task-log"
+// endpoint.
+func NewGetDatabaseTaskLogHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetDatabaseTaskLogRequest(mux, decoder)
+		encodeResponse = EncodeGetDatabaseTaskLogResponse(encoder)
+		encodeError    = EncodeGetDatabaseTaskLogError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-database-task-log")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListHostTasksHandler configures the mux to serve the "control-plane"
+// service "list-host-tasks" endpoint.
+func MountListHostTasksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/hosts/{host_id}/tasks", f)
+}
+
+// NewListHostTasksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "list-host-tasks" endpoint.
+func NewListHostTasksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListHostTasksRequest(mux, decoder)
+		encodeResponse = EncodeListHostTasksResponse(encoder)
+		encodeError    = EncodeListHostTasksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-host-tasks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetHostTaskHandler configures the mux to serve the "control-plane"
+// service "get-host-task" endpoint.
+func MountGetHostTaskHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/hosts/{host_id}/tasks/{task_id}", f)
+}
+
+// NewGetHostTaskHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "get-host-task" endpoint.
+func NewGetHostTaskHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetHostTaskRequest(mux, decoder)
+		encodeResponse = EncodeGetHostTaskResponse(encoder)
+		encodeError    = EncodeGetHostTaskError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-host-task")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetHostTaskLogHandler configures the mux to serve the "control-plane"
+// service "get-host-task-log" endpoint.
+func MountGetHostTaskLogHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/hosts/{host_id}/tasks/{task_id}/logs", f)
+}
+
+// NewGetHostTaskLogHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "get-host-task-log" endpoint.
+func NewGetHostTaskLogHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetHostTaskLogRequest(mux, decoder)
+		encodeResponse = EncodeGetHostTaskLogResponse(encoder)
+		encodeError    = EncodeGetHostTaskLogError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-host-task-log")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListTasksHandler configures the mux to serve the "control-plane"
+// service "list-tasks" endpoint.
+func MountListTasksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/tasks", f)
+}
+
+// NewListTasksHandler creates a HTTP handler which loads the HTTP request and
+// calls the "control-plane" service "list-tasks" endpoint.
+func NewListTasksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTasksRequest(mux, decoder)
+		encodeResponse = EncodeListTasksResponse(encoder)
+		encodeError    = EncodeListTasksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-tasks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStreamEventsHandler configures the mux to serve the "control-plane"
+// service "stream-events" endpoint.
+func MountStreamEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/events", f)
+}
+
+// NewStreamEventsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "stream-events" endpoint.
+func NewStreamEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest = DecodeStreamEventsRequest(mux, decoder)
+		encodeError   = EncodeStreamEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "stream-events")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		v := &controlplane.StreamEventsEndpointInput{
+			Stream: &StreamEventsServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountCreateWebhookHandler configures the mux to serve the "control-plane"
+// service "create-webhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/backups", f)
+	mux.Handle("POST", "/v1/webhooks", f)
 }
 
-// NewBackupDatabaseNodeHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "backup-database-node"
-// endpoint.
-func NewBackupDatabaseNodeHandler(
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "create-webhook" endpoint.
+func NewCreateWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -976,13 +2151,13 @@ func NewBackupDatabaseNodeHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeBackupDatabaseNodeRequest(mux, decoder)
-		encodeResponse = EncodeBackupDatabaseNodeResponse(encoder)
-		encodeError    = EncodeBackupDatabaseNodeError(encoder, formatter)
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "backup-database-node")
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1006,22 +2181,21 @@ func NewBackupDatabaseNodeHandler(
 	})
 }
 
-// MountSwitchoverDatabaseNodeHandler configures the mux to serve the
-// "control-plane" service "switchover-database-node" endpoint.
-func MountSwitchoverDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListWebhooksHandler configures the mux to serve the "control-plane"
+// service "list-webhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/switchover", f)
+	mux.Handle("GET", "/v1/webhooks", f)
 }
 
-// NewSwitchoverDatabaseNodeHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "switchover-database-node"
-// endpoint.
-func NewSwitchoverDatabaseNodeHandler(
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "list-webhooks" endpoint.
+func NewListWebhooksHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1030,22 +2204,15 @@ func NewSwitchoverDatabaseNodeHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeSwitchoverDatabaseNodeRequest(mux, decoder)
-		encodeResponse = EncodeSwitchoverDatabaseNodeResponse(encoder)
-		encodeError    = EncodeSwitchoverDatabaseNodeError(encoder, formatter)
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "switchover-database-node")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-webhooks")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
-		payload, err := decodeRequest(r)
-		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
-				errhandler(ctx, w, err)
-			}
-			return
-		}
-		res, err := endpoint(ctx, payload)
+		var err error
+		res, err := endpoint(ctx, nil)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -1060,22 +2227,21 @@ func NewSwitchoverDatabaseNodeHandler(
 	})
 }
 
-// MountFailoverDatabaseNodeHandler configures the mux to serve the
-// "control-plane" service "failover-database-node" endpoint.
-func MountFailoverDatabaseNodeHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetWebhookHandler configures the mux to serve the "control-plane"
+// service "get-webhook" endpoint.
+func MountGetWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/databases/{database_id}/nodes/{node_name}/failover", f)
+	mux.Handle("GET", "/v1/webhooks/{webhook_id}", f)
 }
 
-// NewFailoverDatabaseNodeHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "failover-database-node"
-// endpoint.
-func NewFailoverDatabaseNodeHandler(
+// NewGetWebhookHandler creates a HTTP handler which loads the HTTP request and
+// calls the "control-plane" service "get-webhook" endpoint.
+func NewGetWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1084,13 +2250,13 @@ func NewFailoverDatabaseNodeHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeFailoverDatabaseNodeRequest(mux, decoder)
-		encodeResponse = EncodeFailoverDatabaseNodeResponse(encoder)
-		encodeError    = EncodeFailoverDatabaseNodeError(encoder, formatter)
+		decodeRequest  = DecodeGetWebhookRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookResponse(encoder)
+		encodeError    = EncodeGetWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "failover-database-node")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1114,21 +2280,21 @@ func NewFailoverDatabaseNodeHandler(
 	})
 }
 
-// MountListDatabaseTasksHandler configures the mux to serve the
-// "control-plane" service "list-database-tasks" endpoint.
-func MountListDatabaseTasksHandler(mux goahttp.Muxer, h http.Handler) {
+// MountDeleteWebhookHandler configures the mux to serve the "control-plane"
+// service "delete-webhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/databases/{database_id}/tasks", f)
+	mux.Handle("DELETE", "/v1/webhooks/{webhook_id}", f)
 }
 
-// NewListDatabaseTasksHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "list-database-tasks" endpoint.
-func NewListDatabaseTasksHandler(
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "delete-webhook" endpoint.
+func NewDeleteWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1137,13 +2303,13 @@ func NewListDatabaseTasksHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeListDatabaseTasksRequest(mux, decoder)
-		encodeResponse = EncodeListDatabaseTasksResponse(encoder)
-		encodeError    = EncodeListDatabaseTasksError(encoder, formatter)
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "list-database-tasks")
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1167,21 +2333,22 @@ func NewListDatabaseTasksHandler(
 	})
 }
 
-// MountGetDatabaseTaskHandler configures the mux to serve the "control-plane"
-// service "get-database-task" endpoint.
-func MountGetDatabaseTaskHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListWebhookDeliveriesHandler configures the mux to serve the
+// "control-plane" service "list-webhook-deliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/databases/{database_id}/tasks/{task_id}", f)
+	mux.Handle("GET", "/v1/webhooks/{webhook_id}/deliveries", f)
 }
 
-// NewGetDatabaseTaskHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "get-database-task" endpoint.
-func NewGetDatabaseTaskHandler(
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "list-webhook-deliveries"
+// endpoint.
+func NewListWebhookDeliveriesHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1190,13 +2357,13 @@ func NewGetDatabaseTaskHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetDatabaseTaskRequest(mux, decoder)
-		encodeResponse = EncodeGetDatabaseTaskResponse(encoder)
-		encodeError    = EncodeGetDatabaseTaskError(encoder, formatter)
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-database-task")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-webhook-deliveries")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1220,22 +2387,21 @@ func NewGetDatabaseTaskHandler(
 	})
 }
 
-// MountGetDatabaseTaskLogHandler configures the mux to serve the
-// "control-plane" service "get-database-task-log" endpoint.
-func MountGetDatabaseTaskLogHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRestoreDatabaseHandler configures the mux to serve the "control-plane"
+// service "restore-database" endpoint.
+func MountRestoreDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/databases/{database_id}/tasks/{task_id}/log", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/restore", f)
 }
 
-// NewGetDatabaseTaskLogHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "get-database-task-log"
-// endpoint.
-func NewGetDatabaseTaskLogHandler(
+// NewRestoreDatabaseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "restore-database" endpoint.
+func NewRestoreDatabaseHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1244,13 +2410,13 @@ func NewGetDatabaseTaskLogHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetDatabaseTaskLogRequest(mux, decoder)
-		encodeResponse = EncodeGetDatabaseTaskLogResponse(encoder)
-		encodeError    = EncodeGetDatabaseTaskLogError(encoder, formatter)
+		decodeRequest  = DecodeRestoreDatabaseRequest(mux, decoder)
+		encodeResponse = EncodeRestoreDatabaseResponse(encoder)
+		encodeError    = EncodeRestoreDatabaseError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-database-task-log")
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore-database")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1274,21 +2440,21 @@ func NewGetDatabaseTaskLogHandler(
 	})
 }
 
-// MountListHostTasksHandler configures the mux to serve the "control-plane"
-// service "list-host-tasks" endpoint.
-func MountListHostTasksHandler(mux goahttp.Muxer, h http.Handler) {
+// MountCloneDatabaseHandler configures the mux to serve the "control-plane"
+// service "clone-database" endpoint.
+func MountCloneDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/hosts/{host_id}/tasks", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/clone", f)
 }
 
-// NewListHostTasksHandler creates a HTTP handler which loads the HTTP request
-// and calls the "control-plane" service "list-host-tasks" endpoint.
-func NewListHostTasksHandler(
+// NewCloneDatabaseHandler creates a HTTP handler which loads the HTTP request
+// and calls the "control-plane" service "clone-database" endpoint.
+func NewCloneDatabaseHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1297,13 +2463,13 @@ func NewListHostTasksHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeListHostTasksRequest(mux, decoder)
-		encodeResponse = EncodeListHostTasksResponse(encoder)
-		encodeError    = EncodeListHostTasksError(encoder, formatter)
+		decodeRequest  = DecodeCloneDatabaseRequest(mux, decoder)
+		encodeResponse = EncodeCloneDatabaseResponse(encoder)
+		encodeError    = EncodeCloneDatabaseError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "list-host-tasks")
+		ctx = context.WithValue(ctx, goa.MethodKey, "clone-database")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1327,21 +2493,21 @@ func NewListHostTasksHandler(
 	})
 }
 
-// MountGetHostTaskHandler configures the mux to serve the "control-plane"
-// service "get-host-task" endpoint.
-func MountGetHostTaskHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetVersionHandler configures the mux to serve the "control-plane"
+// service "get-version" endpoint.
+func MountGetVersionHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/hosts/{host_id}/tasks/{task_id}", f)
+	mux.Handle("GET", "/v1/version", f)
 }
 
-// NewGetHostTaskHandler creates a HTTP handler which loads the HTTP request
-// and calls the "control-plane" service "get-host-task" endpoint.
-func NewGetHostTaskHandler(
+// NewGetVersionHandler creates a HTTP handler which loads the HTTP request and
+// calls the "control-plane" service "get-version" endpoint.
+func NewGetVersionHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1350,22 +2516,15 @@ func NewGetHostTaskHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetHostTaskRequest(mux, decoder)
-		encodeResponse = EncodeGetHostTaskResponse(encoder)
-		encodeError    = EncodeGetHostTaskError(encoder, formatter)
+		encodeResponse = EncodeGetVersionResponse(encoder)
+		encodeError    = EncodeGetVersionError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-host-task")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-version")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
-		payload, err := decodeRequest(r)
-		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
-				errhandler(ctx, w, err)
-			}
-			return
-		}
-		res, err := endpoint(ctx, payload)
+		var err error
+		res, err := endpoint(ctx, nil)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -1380,21 +2539,21 @@ func NewGetHostTaskHandler(
 	})
 }
 
-// MountGetHostTaskLogHandler configures the mux to serve the "control-plane"
-// service "get-host-task-log" endpoint.
-func MountGetHostTaskLogHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRestartInstanceHandler configures the mux to serve the "control-plane"
+// service "restart-instance" endpoint.
+func MountRestartInstanceHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/hosts/{host_id}/tasks/{task_id}/logs", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/instances/{instance_id}/restart", f)
 }
 
-// NewGetHostTaskLogHandler creates a HTTP handler which loads the HTTP request
-// and calls the "control-plane" service "get-host-task-log" endpoint.
-func NewGetHostTaskLogHandler(
+// NewRestartInstanceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "restart-instance" endpoint.
+func NewRestartInstanceHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1403,13 +2562,13 @@ func NewGetHostTaskLogHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetHostTaskLogRequest(mux, decoder)
-		encodeResponse = EncodeGetHostTaskLogResponse(encoder)
-		encodeError    = EncodeGetHostTaskLogError(encoder, formatter)
+		decodeRequest  = DecodeRestartInstanceRequest(mux, decoder)
+		encodeResponse = EncodeRestartInstanceResponse(encoder)
+		encodeError    = EncodeRestartInstanceError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-host-task-log")
+		ctx = context.WithValue(ctx, goa.MethodKey, "restart-instance")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1433,21 +2592,22 @@ func NewGetHostTaskLogHandler(
 	})
 }
 
-// MountListTasksHandler configures the mux to serve the "control-plane"
-// service "list-tasks" endpoint.
-func MountListTasksHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetInstanceSessionsHandler configures the mux to serve the
+// "control-plane" service "get-instance-sessions" endpoint.
+func MountGetInstanceSessionsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/tasks", f)
+	mux.Handle("GET", "/v1/databases/{database_id}/instances/{instance_id}/sessions", f)
 }
 
-// NewListTasksHandler creates a HTTP handler which loads the HTTP request and
-// calls the "control-plane" service "list-tasks" endpoint.
-func NewListTasksHandler(
+// NewGetInstanceSessionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "get-instance-sessions"
+// endpoint.
+func NewGetInstanceSessionsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1456,13 +2616,13 @@ func NewListTasksHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeListTasksRequest(mux, decoder)
-		encodeResponse = EncodeListTasksResponse(encoder)
-		encodeError    = EncodeListTasksError(encoder, formatter)
+		decodeRequest  = DecodeGetInstanceSessionsRequest(mux, decoder)
+		encodeResponse = EncodeGetInstanceSessionsResponse(encoder)
+		encodeError    = EncodeGetInstanceSessionsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "list-tasks")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-instance-sessions")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1486,21 +2646,22 @@ func NewListTasksHandler(
 	})
 }
 
-// MountRestoreDatabaseHandler configures the mux to serve the "control-plane"
-// service "restore-database" endpoint.
-func MountRestoreDatabaseHandler(mux goahttp.Muxer, h http.Handler) {
+// MountCancelInstanceSessionHandler configures the mux to serve the
+// "control-plane" service "cancel-instance-session" endpoint.
+func MountCancelInstanceSessionHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/databases/{database_id}/restore", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/cancel", f)
 }
 
-// NewRestoreDatabaseHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "restore-database" endpoint.
-func NewRestoreDatabaseHandler(
+// NewCancelInstanceSessionHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "cancel-instance-session"
+// endpoint.
+func NewCancelInstanceSessionHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1509,13 +2670,13 @@ func NewRestoreDatabaseHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeRestoreDatabaseRequest(mux, decoder)
-		encodeResponse = EncodeRestoreDatabaseResponse(encoder)
-		encodeError    = EncodeRestoreDatabaseError(encoder, formatter)
+		decodeRequest  = DecodeCancelInstanceSessionRequest(mux, decoder)
+		encodeResponse = EncodeCancelInstanceSessionResponse(encoder)
+		encodeError    = EncodeCancelInstanceSessionError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "restore-database")
+		ctx = context.WithValue(ctx, goa.MethodKey, "cancel-instance-session")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1539,21 +2700,22 @@ func NewRestoreDatabaseHandler(
 	})
 }
 
-// MountGetVersionHandler configures the mux to serve the "control-plane"
-// service "get-version" endpoint.
-func MountGetVersionHandler(mux goahttp.Muxer, h http.Handler) {
+// MountTerminateInstanceSessionHandler configures the mux to serve the
+// "control-plane" service "terminate-instance-session" endpoint.
+func MountTerminateInstanceSessionHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/v1/version", f)
+	mux.Handle("POST", "/v1/databases/{database_id}/instances/{instance_id}/sessions/{pid}/terminate", f)
 }
 
-// NewGetVersionHandler creates a HTTP handler which loads the HTTP request and
-// calls the "control-plane" service "get-version" endpoint.
-func NewGetVersionHandler(
+// NewTerminateInstanceSessionHandler creates a HTTP handler which loads the
+// HTTP request and calls the "control-plane" service
+// "terminate-instance-session" endpoint.
+func NewTerminateInstanceSessionHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1562,15 +2724,22 @@ func NewGetVersionHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		encodeResponse = EncodeGetVersionResponse(encoder)
-		encodeError    = EncodeGetVersionError(encoder, formatter)
+		decodeRequest  = DecodeTerminateInstanceSessionRequest(mux, decoder)
+		encodeResponse = EncodeTerminateInstanceSessionResponse(encoder)
+		encodeError    = EncodeTerminateInstanceSessionError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-version")
+		ctx = context.WithValue(ctx, goa.MethodKey, "terminate-instance-session")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -1585,21 +2754,22 @@ func NewGetVersionHandler(
 	})
 }
 
-// MountRestartInstanceHandler configures the mux to serve the "control-plane"
-// service "restart-instance" endpoint.
-func MountRestartInstanceHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetInstancePostgresqlConfHandler configures the mux to serve the
+// "control-plane" service "get-instance-postgresql-conf" endpoint.
+func MountGetInstancePostgresqlConfHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/databases/{database_id}/instances/{instance_id}/restart", f)
+	mux.Handle("GET", "/v1/databases/{database_id}/instances/{instance_id}/postgresql-conf", f)
 }
 
-// NewRestartInstanceHandler creates a HTTP handler which loads the HTTP
-// request and calls the "control-plane" service "restart-instance" endpoint.
-func NewRestartInstanceHandler(
+// NewGetInstancePostgresqlConfHandler creates a HTTP handler which loads the
+// HTTP request and calls the "control-plane" service
+// "get-instance-postgresql-conf" endpoint.
+func NewGetInstancePostgresqlConfHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1608,13 +2778,13 @@ func NewRestartInstanceHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeRestartInstanceRequest(mux, decoder)
-		encodeResponse = EncodeRestartInstanceResponse(encoder)
-		encodeError    = EncodeRestartInstanceError(encoder, formatter)
+		decodeRequest  = DecodeGetInstancePostgresqlConfRequest(mux, decoder)
+		encodeResponse = EncodeGetInstancePostgresqlConfResponse(encoder)
+		encodeError    = EncodeGetInstancePostgresqlConfError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "restart-instance")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-instance-postgresql-conf")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1798,6 +2968,60 @@ func NewCancelDatabaseTaskHandler(
 	})
 }
 
+// MountResumeDatabaseTaskHandler configures the mux to serve the
+// "control-plane" service "resume-database-task" endpoint.
+func MountResumeDatabaseTaskHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/databases/{database_id}/tasks/{task_id}/resume", f)
+}
+
+// NewResumeDatabaseTaskHandler creates a HTTP handler which loads the HTTP
+// request and calls the "control-plane" service "resume-database-task"
+// endpoint.
+func NewResumeDatabaseTaskHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResumeDatabaseTaskRequest(mux, decoder)
+		encodeResponse = EncodeResumeDatabaseTaskResponse(encoder)
+		encodeError    = EncodeResumeDatabaseTaskError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "resume-database-task")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "control-plane")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // appendFS is a custom implementation of fs.FS that appends a specified prefix
 // to the file paths before delegating the Open call to the underlying fs.FS.
 type appendFS struct {
@@ -36,6 +36,20 @@ type GetJoinOptionsRequestBody struct {
 	EmbeddedEtcdEnabled *bool `json:"embedded_etcd_enabled"`
 }
 
+// DefragmentEtcdRequestBody is the type of the "control-plane" service
+// "defragment-etcd" endpoint HTTP request body.
+type DefragmentEtcdRequestBody struct {
+	// The hosts to defragment. Defaults to every Etcd server in the cluster.
+	HostIds []string `json:"host_ids,omitempty"`
+}
+
+// ChangeHostEtcdModeRequestBody is the type of the "control-plane" service
+// "change-host-etcd-mode" endpoint HTTP request body.
+type ChangeHostEtcdModeRequestBody struct {
+	// The new Etcd mode for the host.
+	EtcdMode *string `json:"etcd_mode"`
+}
+
 // CreateDatabaseRequestBody is the type of the "control-plane" service
 // "create-database" endpoint HTTP request body.
 type CreateDatabaseRequestBody struct {
@@ -45,6 +59,9 @@ type CreateDatabaseRequestBody struct {
 	TenantID *string `json:"tenant_id,omitempty"`
 	// The specification for the database.
 	Spec *DatabaseSpecRequestBody `json:"spec"`
+	// An external Postgres database to import into the new database. The database
+	// must have exactly one node during the import.
+	Source *ImportSourceSpecRequestBody `json:"source,omitempty"`
 }
 
 // UpdateDatabaseRequestBody is the type of the "control-plane" service
@@ -59,10 +76,31 @@ type UpdateDatabaseRequestBody struct {
 // ApplyUpgradeRequestBody is the type of the "control-plane" service
 // "apply-upgrade" endpoint HTTP request body.
 type ApplyUpgradeRequestBody struct {
+	// The kind of upgrade to apply. 'minor' redeploys the database with a newer
+	// image in the same major version. 'pg_upgrade' upgrades each node to a new
+	// Postgres major version in place with pg_upgrade --link.
+	Mode *string `json:"mode,omitempty"`
 	// Full container image reference of the upgrade target. Must match the image
 	// field of a stable manifest entry in the same Postgres major / Spock major
-	// bucket as the current version and be strictly newer.
-	Image *string `json:"image"`
+	// bucket as the current version and be strictly newer. Required for the
+	// 'minor' mode.
+	Image *string `json:"image,omitempty"`
+	// The target Postgres version. Required for the 'pg_upgrade' mode.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+}
+
+// UpgradeDatabaseMajorRequestBody is the type of the "control-plane" service
+// "upgrade-database-major" endpoint HTTP request body.
+type UpgradeDatabaseMajorRequestBody struct {
+	// The Postgres version to upgrade to. Nodes that already run this major
+	// version are skipped, and no node may run a newer major version.
+	PostgresVersion *string `json:"postgres_version"`
+	// Places the replacements for these nodes on different hosts. Nodes that are
+	// not listed are replaced on their current hosts.
+	Nodes []*MajorUpgradeNodeSpecRequestBodyRequestBody `json:"nodes,omitempty"`
+	// Pause the upgrade after each node is replaced. Paused upgrades are continued
+	// with the resume-database-task endpoint.
+	PauseBetweenNodes *bool `json:"pause_between_nodes,omitempty"`
 }
 
 // BackupDatabaseNodeRequestBody is the type of the "control-plane" service
@@ -86,6 +124,22 @@ type SwitchoverDatabaseNodeRequestBody struct {
 	ScheduledAt *string `form:"scheduled_at,omitempty" json:"scheduled_at,omitempty" xml:"scheduled_at,omitempty"`
 }
 
+// RollingRestartDatabaseRequestBody is the type of the "control-plane" service
+// "rolling-restart-database" endpoint HTTP request body.
+type RollingRestartDatabaseRequestBody struct {
+	// Restart every instance. By default, only instances with settings that are
+	// waiting for a restart are restarted.
+	AllInstances *bool `json:"all_instances,omitempty"`
+	// The number of nodes to restart at the same time. Instances within a node are
+	// always restarted one at a time.
+	Concurrency *int `json:"concurrency,omitempty"`
+	// Switch each node back to its original primary after the original primary is
+	// restarted.
+	SwitchBack *bool `json:"switch_back,omitempty"`
+	// When set, each restart and switchover waits until the window is open.
+	MaintenanceWindow *MaintenanceWindowRequestBodyRequestBody `json:"maintenance_window,omitempty"`
+}
+
 // FailoverDatabaseNodeRequestBody is the type of the "control-plane" service
 // "failover-database-node" endpoint HTTP request body.
 type FailoverDatabaseNodeRequestBody struct {
@@ -97,6 +151,23 @@ type FailoverDatabaseNodeRequestBody struct {
 	SkipValidation *bool `json:"skip_validation,omitempty"`
 }
 
+// CreateWebhookRequestBody is the type of the "control-plane" service
+// "create-webhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The URL to send events to. Must use the http or https scheme.
+	URL *string `json:"url"`
+	// An optional description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. A random secret is generated if this
+	// is omitted. It's only returned in the create-webhook response.
+	Secret *string `json:"secret,omitempty"`
+	// Only deliver events of these types. All event types are delivered by default.
+	EventTypes []string `json:"event_types,omitempty"`
+	// Only deliver events for these databases. Events that aren't specific to a
+	// database, such as host_unreachable, are not delivered when this is set.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+}
+
 // RestoreDatabaseRequestBody is the type of the "control-plane" service
 // "restore-database" endpoint HTTP request body.
 type RestoreDatabaseRequestBody struct {
@@ -106,6 +177,45 @@ type RestoreDatabaseRequestBody struct {
 	TargetNodes []string `json:"target_nodes,omitempty"`
 }
 
+// CloneDatabaseRequestBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP request body.
+type CloneDatabaseRequestBody struct {
+	// ID of the new database.
+	ID *string `json:"id"`
+	// Unique identifier for the new database's owner. The clone always has the
+	// same owner as the source database, so this must match the source database's
+	// tenant ID when it's given. It's required for requests that are made by a
+	// tenant.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The node of the source database to clone. Defaults to the source database's
+	// first node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// How the clone is populated. The 'backup' method restores the source node's
+	// latest backup and replays its archived WAL. The 'base_backup' method first
+	// takes a fresh full backup from one of the source node's replicas, or from
+	// its primary if it has no replicas, and then restores that backup. Both
+	// methods require a backup repository on the source node.
+	Method *string `json:"method,omitempty"`
+	// Recover the clone to this point in time rather than to the end of the
+	// archived WAL. Only valid with the 'backup' method.
+	TargetTime *string `json:"target_time,omitempty"`
+	// The IDs of the hosts that should run the clone. Defaults to the source
+	// node's hosts.
+	HostIds []string `json:"host_ids,omitempty"`
+	// A new name for the cloned Postgres database. Defaults to the source
+	// database's name.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// Renames database users in the clone. Each key is the name of a user in the
+	// source database's spec, and each value is the user's new name. Roles are
+	// renamed before they're updated from the spec, so renamed users keep the
+	// objects and privileges they had in the source.
+	RenameUsers map[string]string `json:"rename_users,omitempty"`
+	// SQL statements that run once in the cloned database, within a transaction,
+	// after it's restored and before the clone becomes available. This can be used
+	// to remove or mask sensitive data.
+	ScrubScript []string `json:"scrub_script,omitempty"`
+}
+
 // InitClusterResponseBody is the type of the "control-plane" service
 // "init-cluster" endpoint HTTP response body.
 type InitClusterResponseBody struct {
@@ -142,6 +252,51 @@ type GetClusterResponseBody struct {
 	Status *ClusterStatusResponseBody `json:"status"`
 	// All of the hosts in the cluster.
 	Hosts []*HostResponseBody `json:"hosts"`
+	// The progress of the current Control Plane upgrade, if any.
+	Upgrade *ClusterUpgradeStatusResponseBody `json:"upgrade,omitempty"`
+}
+
+// GetStorageUsageResponseBody is the type of the "control-plane" service
+// "get-storage-usage" endpoint HTTP response body.
+type GetStorageUsageResponseBody struct {
+	// The size of the Etcd database on disk, including space that Etcd can reuse.
+	DbSizeBytes int64 `json:"db_size_bytes"`
+	// The portion of the Etcd database that's in use.
+	DbSizeInUseBytes int64 `json:"db_size_in_use_bytes"`
+	// The total number of Control Plane keys.
+	Keys int64 `json:"keys"`
+	// The total size of the Control Plane's keys and values.
+	Bytes int64 `json:"bytes"`
+	// Usage for each key prefix, largest first.
+	Prefixes []*StoragePrefixUsageResponseBody `json:"prefixes"`
+}
+
+// GetEtcdClusterResponseBody is the type of the "control-plane" service
+// "get-etcd-cluster" endpoint HTTP response body.
+type GetEtcdClusterResponseBody struct {
+	// The members of the Etcd cluster, sorted by host ID.
+	Members []*EtcdMemberResponseBody `json:"members"`
+	// The host ID of the raft leader. Empty if the cluster has no leader.
+	Leader *string `json:"leader,omitempty"`
+	// The number of voting members.
+	Voters int `json:"voters"`
+	// The number of learners.
+	Learners int `json:"learners"`
+	// The number of voters that must be available for the cluster to accept writes.
+	Quorum int `json:"quorum"`
+	// The number of voters that can fail before the cluster loses quorum.
+	FailureTolerance int `json:"failure_tolerance"`
+	// Active Etcd alarms, such as NOSPACE.
+	Alarms []string `json:"alarms,omitempty"`
+	// Problems with the cluster's quorum or members.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DefragmentEtcdResponseBody is the type of the "control-plane" service
+// "defragment-etcd" endpoint HTTP response body.
+type DefragmentEtcdResponseBody struct {
+	// The result for each host, in the order that they were defragmented.
+	Results []*EtcdDefragmentResultResponseBody `json:"results"`
 }
 
 // ListHostsResponseBody is the type of the "control-plane" service
@@ -149,6 +304,8 @@ type GetClusterResponseBody struct {
 type ListHostsResponseBody struct {
 	// List of hosts in the cluster
 	Hosts []*HostResponseBody `json:"hosts"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // GetHostResponseBody is the type of the "control-plane" service "get-host"
@@ -189,11 +346,22 @@ type RemoveHostResponseBody struct {
 	UpdateDatabaseTasks []*TaskResponseBody `json:"update_database_tasks"`
 }
 
+// ChangeHostEtcdModeResponseBody is the type of the "control-plane" service
+// "change-host-etcd-mode" endpoint HTTP response body.
+type ChangeHostEtcdModeResponseBody struct {
+	// The task that tracks the change.
+	Task *TaskResponseBody `json:"task"`
+	// Warnings about the Etcd cluster's failure tolerance after the change.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 // ListDatabasesResponseBody is the type of the "control-plane" service
 // "list-databases" endpoint HTTP response body.
 type ListDatabasesResponseBody struct {
 	// The databases managed by this cluster.
 	Databases []*DatabaseSummaryResponseBody `json:"databases"`
+	// Cursor for the next page of results. Only set when there are more results.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // CreateDatabaseResponseBody is the type of the "control-plane" service
@@ -218,6 +386,10 @@ type GetDatabaseResponseBody struct {
 	UpdatedAt string `json:"updated_at"`
 	// Current state of the database.
 	State string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
 	// All of the instances in the database.
 	Instances []*InstanceResponseBody `json:"instances,omitempty"`
 	// Service instances running alongside this database.
@@ -227,6 +399,9 @@ type GetDatabaseResponseBody struct {
 	// Newer stable image versions available in the same Postgres major / Spock
 	// major bucket. Present only when ?include=available_upgrades is set.
 	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+	// The result of the most recent drift check. Absent if the database has not
+	// been checked.
+	Drift *DatabaseDriftResponseBody `json:"drift,omitempty"`
 }
 
 // UpdateDatabaseResponseBody is the type of the "control-plane" service
@@ -236,6 +411,9 @@ type UpdateDatabaseResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 	// The database being updated.
 	Database *DatabaseResponseBody `json:"database"`
+	// The postgresql.conf settings that will change on existing instances, such as
+	// tunable settings that are affected by memory or CPU changes.
+	PostgresqlConfChanges []*PostgreSQLConfChangeResponseBody `json:"postgresql_conf_changes,omitempty"`
 }
 
 // ApplyUpgradeResponseBody is the type of the "control-plane" service
@@ -247,6 +425,24 @@ type ApplyUpgradeResponseBody struct {
 	Database *DatabaseResponseBody `json:"database"`
 }
 
+// UpgradeDatabaseMajorResponseBody is the type of the "control-plane" service
+// "upgrade-database-major" endpoint HTTP response body.
+type UpgradeDatabaseMajorResponseBody struct {
+	// The task tracking the upgrade operation.
+	Task *TaskResponseBody `json:"task"`
+	// The database being upgraded.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
+// CutoverDatabaseImportResponseBody is the type of the "control-plane" service
+// "cutover-database-import" endpoint HTTP response body.
+type CutoverDatabaseImportResponseBody struct {
+	// The task that will cut over the import.
+	Task *TaskResponseBody `json:"task"`
+	// The database being cut over.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
 // DeleteDatabaseResponseBody is the type of the "control-plane" service
 // "delete-database" endpoint HTTP response body.
 type DeleteDatabaseResponseBody struct {
@@ -268,6 +464,13 @@ type SwitchoverDatabaseNodeResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// RollingRestartDatabaseResponseBody is the type of the "control-plane"
+// service "rolling-restart-database" endpoint HTTP response body.
+type RollingRestartDatabaseResponseBody struct {
+	// The task that will perform the rolling restart.
+	Task *TaskResponseBody `json:"task"`
+}
+
 // FailoverDatabaseNodeResponseBody is the type of the "control-plane" service
 // "failover-database-node" endpoint HTTP response body.
 type FailoverDatabaseNodeResponseBody struct {
@@ -275,6 +478,20 @@ type FailoverDatabaseNodeResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// GetNodeQueryStatsResponseBody is the type of the "control-plane" service
+// "get-node-query-stats" endpoint HTTP response body.
+type GetNodeQueryStatsResponseBody struct {
+	// The query statistics for each instance, sorted by instance ID.
+	Instances []*InstanceQueryStatsResponseBody `json:"instances"`
+}
+
+// ResetNodeQueryStatsResponseBody is the type of the "control-plane" service
+// "reset-node-query-stats" endpoint HTTP response body.
+type ResetNodeQueryStatsResponseBody struct {
+	// The IDs of the instances whose statistics were reset.
+	InstanceIds []string `json:"instance_ids"`
+}
+
 // ListDatabaseTasksResponseBody is the type of the "control-plane" service
 // "list-database-tasks" endpoint HTTP response body.
 type ListDatabaseTasksResponseBody struct {
@@ -309,6 +526,9 @@ type GetDatabaseTaskResponseBody struct {
 	Type string `json:"type"`
 	// The status of the task.
 	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -366,6 +586,9 @@ type GetHostTaskResponseBody struct {
 	Type string `json:"type"`
 	// The status of the task.
 	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -396,6 +619,93 @@ type ListTasksResponseBody struct {
 	Tasks []*TaskResponseBody `json:"tasks"`
 }
 
+// StreamEventsResponseBody is the type of the "control-plane" service
+// "stream-events" endpoint HTTP response body.
+type StreamEventsResponseBody struct {
+	// The etcd revision of the change that produced this event. Events from the
+	// same change share an ID.
+	ID string `json:"id"`
+	// The kind of event. Determines which of the database, instance, task, or
+	// task_log_entry fields is set. Heartbeat events are sent when the stream
+	// opens and periodically afterward.
+	Kind string `json:"kind"`
+	// The time that the server observed the change.
+	Timestamp string `json:"timestamp"`
+	// The database that this event relates to, if any.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The host that this event relates to, if known.
+	HostID *string `json:"host_id,omitempty"`
+	// The task that this event relates to. Only set for task and task_log events.
+	TaskID *string `json:"task_id,omitempty"`
+	// The database after a state transition. Only set for database events.
+	// Instances are omitted.
+	Database *DatabaseSummaryResponseBody `json:"database,omitempty"`
+	// The instance after a state or status change. Only set for instance events.
+	Instance *InstanceResponseBody `json:"instance,omitempty"`
+	// The task after a status change. Only set for task events.
+	Task *TaskResponseBody `json:"task,omitempty"`
+	// A new task log entry. Only set for task_log events.
+	TaskLogEntry *TaskLogEntryResponseBody `json:"task_log_entry,omitempty"`
+}
+
+// CreateWebhookResponseBody is the type of the "control-plane" service
+// "create-webhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID string `json:"id"`
+	// The URL that events are sent to.
+	URL string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt string `json:"created_at"`
+}
+
+// ListWebhooksResponseBody is the type of the "control-plane" service
+// "list-webhooks" endpoint HTTP response body.
+type ListWebhooksResponseBody struct {
+	// The webhooks in the cluster, oldest first.
+	Webhooks []*WebhookResponseBody `json:"webhooks"`
+}
+
+// GetWebhookResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body.
+type GetWebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID string `json:"id"`
+	// The URL that events are sent to.
+	URL string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt string `json:"created_at"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "control-plane" service
+// "list-webhook-deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody struct {
+	// The webhook's most recent deliveries, newest first.
+	Deliveries []*WebhookDeliveryResponseBody `json:"deliveries"`
+}
+
 // RestoreDatabaseResponseBody is the type of the "control-plane" service
 // "restore-database" endpoint HTTP response body.
 type RestoreDatabaseResponseBody struct {
@@ -407,6 +717,18 @@ type RestoreDatabaseResponseBody struct {
 	Database *DatabaseResponseBody `json:"database"`
 }
 
+// CloneDatabaseResponseBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP response body.
+type CloneDatabaseResponseBody struct {
+	// The task that will create the clone.
+	Task *TaskResponseBody `json:"task"`
+	// The source database task that will take a fresh backup for the clone. Only
+	// set for the 'base_backup' method.
+	BackupTask *TaskResponseBody `json:"backup_task,omitempty"`
+	// The database being created.
+	Database *DatabaseResponseBody `json:"database"`
+}
+
 // GetVersionResponseBody is the type of the "control-plane" service
 // "get-version" endpoint HTTP response body.
 type GetVersionResponseBody struct {
@@ -427,6 +749,39 @@ type RestartInstanceResponseBody struct {
 	Task *TaskResponseBody `json:"task"`
 }
 
+// GetInstanceSessionsResponseBody is the type of the "control-plane" service
+// "get-instance-sessions" endpoint HTTP response body.
+type GetInstanceSessionsResponseBody struct {
+	// The client sessions, sorted by process ID.
+	Sessions []*InstanceSessionResponseBody `json:"sessions"`
+	// Each chain of blocked sessions, as process IDs ordered from the session at
+	// the root of the chain to a blocked session that isn't blocking any others.
+	BlockingChains [][]int `json:"blocking_chains"`
+}
+
+// CancelInstanceSessionResponseBody is the type of the "control-plane" service
+// "cancel-instance-session" endpoint HTTP response body.
+type CancelInstanceSessionResponseBody struct {
+	// Task representing the operation. The session's details are recorded in the
+	// task's log.
+	Task *TaskResponseBody `json:"task"`
+}
+
+// TerminateInstanceSessionResponseBody is the type of the "control-plane"
+// service "terminate-instance-session" endpoint HTTP response body.
+type TerminateInstanceSessionResponseBody struct {
+	// Task representing the operation. The session's details are recorded in the
+	// task's log.
+	Task *TaskResponseBody `json:"task"`
+}
+
+// GetInstancePostgresqlConfResponseBody is the type of the "control-plane"
+// service "get-instance-postgresql-conf" endpoint HTTP response body.
+type GetInstancePostgresqlConfResponseBody struct {
+	// The instance's effective settings, sorted by name.
+	Settings []*PostgreSQLSettingResponseBody `json:"settings"`
+}
+
 // StopInstanceResponseBody is the type of the "control-plane" service
 // "stop-instance" endpoint HTTP response body.
 type StopInstanceResponseBody struct {
@@ -468,6 +823,43 @@ type CancelDatabaseTaskResponseBody struct {
 	Type string `json:"type"`
 	// The status of the task.
 	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
+	// The error message if the task failed.
+	Error *string `json:"error,omitempty"`
+}
+
+// ResumeDatabaseTaskResponseBody is the type of the "control-plane" service
+// "resume-database-task" endpoint HTTP response body.
+type ResumeDatabaseTaskResponseBody struct {
+	// The parent task ID of the task.
+	ParentID *string `json:"parent_id,omitempty"`
+	// The scope of the task (database or host).
+	Scope string `json:"scope"`
+	// The entity ID (database_id or host_id) that this task belongs to.
+	EntityID string `json:"entity_id"`
+	// The database ID of the task.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The name of the node that the task is operating on.
+	NodeName *string `json:"node_name,omitempty"`
+	// The ID of the instance that the task is operating on.
+	InstanceID *string `json:"instance_id,omitempty"`
+	// The ID of the host that the task is running on.
+	HostID *string `json:"host_id,omitempty"`
+	// The unique ID of the task.
+	TaskID string `json:"task_id"`
+	// The time when the task was created.
+	CreatedAt string `json:"created_at"`
+	// The time when the task was completed.
+	CompletedAt *string `json:"completed_at,omitempty"`
+	// The type of the task.
+	Type string `json:"type"`
+	// The status of the task.
+	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
 	// The error message if the task failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -621,6 +1013,76 @@ type GetClusterServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
+// GetStorageUsageClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-storage-usage" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type GetStorageUsageClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetStorageUsageServerErrorResponseBody is the type of the "control-plane"
+// service "get-storage-usage" endpoint HTTP response body for the
+// "server_error" error.
+type GetStorageUsageServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetEtcdClusterClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-etcd-cluster" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type GetEtcdClusterClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetEtcdClusterServerErrorResponseBody is the type of the "control-plane"
+// service "get-etcd-cluster" endpoint HTTP response body for the
+// "server_error" error.
+type GetEtcdClusterServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DefragmentEtcdClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "defragment-etcd" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type DefragmentEtcdClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DefragmentEtcdInvalidInputResponseBody is the type of the "control-plane"
+// service "defragment-etcd" endpoint HTTP response body for the
+// "invalid_input" error.
+type DefragmentEtcdInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DefragmentEtcdServerErrorResponseBody is the type of the "control-plane"
+// service "defragment-etcd" endpoint HTTP response body for the "server_error"
+// error.
+type DefragmentEtcdServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // ListHostsClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-hosts" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
@@ -631,6 +1093,15 @@ type ListHostsClusterNotInitializedResponseBody struct {
 	Message string `json:"message"`
 }
 
+// ListHostsInvalidInputResponseBody is the type of the "control-plane" service
+// "list-hosts" endpoint HTTP response body for the "invalid_input" error.
+type ListHostsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // ListHostsServerErrorResponseBody is the type of the "control-plane" service
 // "list-hosts" endpoint HTTP response body for the "server_error" error.
 type ListHostsServerErrorResponseBody struct {
@@ -715,6 +1186,46 @@ type RemoveHostServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
+// ChangeHostEtcdModeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "change-host-etcd-mode" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ChangeHostEtcdModeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ChangeHostEtcdModeInvalidInputResponseBody is the type of the
+// "control-plane" service "change-host-etcd-mode" endpoint HTTP response body
+// for the "invalid_input" error.
+type ChangeHostEtcdModeInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ChangeHostEtcdModeNotFoundResponseBody is the type of the "control-plane"
+// service "change-host-etcd-mode" endpoint HTTP response body for the
+// "not_found" error.
+type ChangeHostEtcdModeNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ChangeHostEtcdModeServerErrorResponseBody is the type of the "control-plane"
+// service "change-host-etcd-mode" endpoint HTTP response body for the
+// "server_error" error.
+type ChangeHostEtcdModeServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // ListDatabasesClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-databases" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
@@ -725,6 +1236,16 @@ type ListDatabasesClusterNotInitializedResponseBody struct {
 	Message string `json:"message"`
 }
 
+// ListDatabasesInvalidInputResponseBody is the type of the "control-plane"
+// service "list-databases" endpoint HTTP response body for the "invalid_input"
+// error.
+type ListDatabasesInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // ListDatabasesServerErrorResponseBody is the type of the "control-plane"
 // service "list-databases" endpoint HTTP response body for the "server_error"
 // error.
@@ -943,140 +1464,260 @@ type ApplyUpgradeServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type DeleteDatabaseClusterNotInitializedResponseBody struct {
+// UpgradeDatabaseMajorClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type UpgradeDatabaseMajorClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "database_not_modifiable" error.
-type DeleteDatabaseDatabaseNotModifiableResponseBody struct {
+// UpgradeDatabaseMajorDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "database_not_modifiable" error.
+type UpgradeDatabaseMajorDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "delete-database" endpoint HTTP response body for
-// the "operation_already_in_progress" error.
-type DeleteDatabaseOperationAlreadyInProgressResponseBody struct {
+// UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "upgrade-database-major" endpoint HTTP response
+// body for the "operation_already_in_progress" error.
+type UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseInvalidInputResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the
-// "invalid_input" error.
-type DeleteDatabaseInvalidInputResponseBody struct {
+// UpgradeDatabaseMajorInvalidInputResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "invalid_input" error.
+type UpgradeDatabaseMajorInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseNotFoundResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the "not_found"
-// error.
-type DeleteDatabaseNotFoundResponseBody struct {
+// UpgradeDatabaseMajorNotFoundResponseBody is the type of the "control-plane"
+// service "upgrade-database-major" endpoint HTTP response body for the
+// "not_found" error.
+type UpgradeDatabaseMajorNotFoundResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// DeleteDatabaseServerErrorResponseBody is the type of the "control-plane"
-// service "delete-database" endpoint HTTP response body for the "server_error"
-// error.
-type DeleteDatabaseServerErrorResponseBody struct {
+// UpgradeDatabaseMajorServerErrorResponseBody is the type of the
+// "control-plane" service "upgrade-database-major" endpoint HTTP response body
+// for the "server_error" error.
+type UpgradeDatabaseMajorServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "cluster_not_initialized" error.
-type BackupDatabaseNodeClusterNotInitializedResponseBody struct {
+// CutoverDatabaseImportClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type CutoverDatabaseImportClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "database_not_modifiable" error.
-type BackupDatabaseNodeDatabaseNotModifiableResponseBody struct {
+// CutoverDatabaseImportDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type CutoverDatabaseImportDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "operation_already_in_progress" error.
-type BackupDatabaseNodeOperationAlreadyInProgressResponseBody struct {
+// CutoverDatabaseImportOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "operation_already_in_progress" error.
+type CutoverDatabaseImportOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeInvalidInputResponseBody is the type of the
-// "control-plane" service "backup-database-node" endpoint HTTP response body
-// for the "invalid_input" error.
-type BackupDatabaseNodeInvalidInputResponseBody struct {
+// CutoverDatabaseImportInvalidInputResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "invalid_input" error.
+type CutoverDatabaseImportInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeNotFoundResponseBody is the type of the "control-plane"
-// service "backup-database-node" endpoint HTTP response body for the
+// CutoverDatabaseImportNotFoundResponseBody is the type of the "control-plane"
+// service "cutover-database-import" endpoint HTTP response body for the
 // "not_found" error.
-type BackupDatabaseNodeNotFoundResponseBody struct {
+type CutoverDatabaseImportNotFoundResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// BackupDatabaseNodeServerErrorResponseBody is the type of the "control-plane"
-// service "backup-database-node" endpoint HTTP response body for the
-// "server_error" error.
-type BackupDatabaseNodeServerErrorResponseBody struct {
+// CutoverDatabaseImportServerErrorResponseBody is the type of the
+// "control-plane" service "cutover-database-import" endpoint HTTP response
+// body for the "server_error" error.
+type CutoverDatabaseImportServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// SwitchoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "switchover-database-node" endpoint HTTP response
-// body for the "cluster_not_initialized" error.
-type SwitchoverDatabaseNodeClusterNotInitializedResponseBody struct {
+// DeleteDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type DeleteDatabaseClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "switchover-database-node" endpoint HTTP response
-// body for the "database_not_modifiable" error.
-type SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody struct {
+// DeleteDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "database_not_modifiable" error.
+type DeleteDatabaseDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DeleteDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "delete-database" endpoint HTTP response body for
+// the "operation_already_in_progress" error.
+type DeleteDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DeleteDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the
+// "invalid_input" error.
+type DeleteDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DeleteDatabaseNotFoundResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the "not_found"
+// error.
+type DeleteDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DeleteDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "delete-database" endpoint HTTP response body for the "server_error"
+// error.
+type DeleteDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type BackupDatabaseNodeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "database_not_modifiable" error.
+type BackupDatabaseNodeDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "operation_already_in_progress" error.
+type BackupDatabaseNodeOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeInvalidInputResponseBody is the type of the
+// "control-plane" service "backup-database-node" endpoint HTTP response body
+// for the "invalid_input" error.
+type BackupDatabaseNodeInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeNotFoundResponseBody is the type of the "control-plane"
+// service "backup-database-node" endpoint HTTP response body for the
+// "not_found" error.
+type BackupDatabaseNodeNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// BackupDatabaseNodeServerErrorResponseBody is the type of the "control-plane"
+// service "backup-database-node" endpoint HTTP response body for the
+// "server_error" error.
+type BackupDatabaseNodeServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// SwitchoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "switchover-database-node" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type SwitchoverDatabaseNodeClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "switchover-database-node" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
@@ -1123,6 +1764,66 @@ type SwitchoverDatabaseNodeServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
+// RollingRestartDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type RollingRestartDatabaseClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// RollingRestartDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "database_not_modifiable" error.
+type RollingRestartDatabaseDatabaseNotModifiableResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// RollingRestartDatabaseOperationAlreadyInProgressResponseBody is the type of
+// the "control-plane" service "rolling-restart-database" endpoint HTTP
+// response body for the "operation_already_in_progress" error.
+type RollingRestartDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// RollingRestartDatabaseInvalidInputResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "invalid_input" error.
+type RollingRestartDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// RollingRestartDatabaseNotFoundResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "not_found" error.
+type RollingRestartDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// RollingRestartDatabaseServerErrorResponseBody is the type of the
+// "control-plane" service "rolling-restart-database" endpoint HTTP response
+// body for the "server_error" error.
+type RollingRestartDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // FailoverDatabaseNodeClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "failover-database-node" endpoint HTTP response body
 // for the "cluster_not_initialized" error.
@@ -1183,6 +1884,86 @@ type FailoverDatabaseNodeServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
+// GetNodeQueryStatsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-node-query-stats" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type GetNodeQueryStatsClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetNodeQueryStatsInvalidInputResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "invalid_input" error.
+type GetNodeQueryStatsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetNodeQueryStatsNotFoundResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "not_found" error.
+type GetNodeQueryStatsNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetNodeQueryStatsServerErrorResponseBody is the type of the "control-plane"
+// service "get-node-query-stats" endpoint HTTP response body for the
+// "server_error" error.
+type GetNodeQueryStatsServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ResetNodeQueryStatsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ResetNodeQueryStatsClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ResetNodeQueryStatsInvalidInputResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "invalid_input" error.
+type ResetNodeQueryStatsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ResetNodeQueryStatsNotFoundResponseBody is the type of the "control-plane"
+// service "reset-node-query-stats" endpoint HTTP response body for the
+// "not_found" error.
+type ResetNodeQueryStatsNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// ResetNodeQueryStatsServerErrorResponseBody is the type of the
+// "control-plane" service "reset-node-query-stats" endpoint HTTP response body
+// for the "server_error" error.
+type ResetNodeQueryStatsServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
 // ListDatabaseTasksClusterNotInitializedResponseBody is the type of the
 // "control-plane" service "list-database-tasks" endpoint HTTP response body
 // for the "cluster_not_initialized" error.
@@ -1449,3367 +2230,5717 @@ type ListTasksServerErrorResponseBody struct {
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type RestoreDatabaseClusterNotInitializedResponseBody struct {
+// StreamEventsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "stream-events" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StreamEventsClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseDatabaseNotModifiableResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "database_not_modifiable" error.
-type RestoreDatabaseDatabaseNotModifiableResponseBody struct {
+// StreamEventsInvalidInputResponseBody is the type of the "control-plane"
+// service "stream-events" endpoint HTTP response body for the "invalid_input"
+// error.
+type StreamEventsInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseOperationAlreadyInProgressResponseBody is the type of the
-// "control-plane" service "restore-database" endpoint HTTP response body for
-// the "operation_already_in_progress" error.
-type RestoreDatabaseOperationAlreadyInProgressResponseBody struct {
+// StreamEventsServerErrorResponseBody is the type of the "control-plane"
+// service "stream-events" endpoint HTTP response body for the "server_error"
+// error.
+type StreamEventsServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseInvalidInputResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the
-// "invalid_input" error.
-type RestoreDatabaseInvalidInputResponseBody struct {
+// CreateWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "create-webhook" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type CreateWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseNotFoundResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the "not_found"
+// CreateWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "create-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type RestoreDatabaseNotFoundResponseBody struct {
+type CreateWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestoreDatabaseServerErrorResponseBody is the type of the "control-plane"
-// service "restore-database" endpoint HTTP response body for the
-// "server_error" error.
-type RestoreDatabaseServerErrorResponseBody struct {
+// CreateWebhookServerErrorResponseBody is the type of the "control-plane"
+// service "create-webhook" endpoint HTTP response body for the "server_error"
+// error.
+type CreateWebhookServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// GetVersionServerErrorResponseBody is the type of the "control-plane" service
-// "get-version" endpoint HTTP response body for the "server_error" error.
-type GetVersionServerErrorResponseBody struct {
+// ListWebhooksClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "list-webhooks" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type ListWebhooksClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestartInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "restart-instance" endpoint HTTP response body for
-// the "cluster_not_initialized" error.
-type RestartInstanceClusterNotInitializedResponseBody struct {
+// ListWebhooksServerErrorResponseBody is the type of the "control-plane"
+// service "list-webhooks" endpoint HTTP response body for the "server_error"
+// error.
+type ListWebhooksServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestartInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the
-// "invalid_input" error.
-type RestartInstanceInvalidInputResponseBody struct {
+// GetWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-webhook" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type GetWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestartInstanceNotFoundResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the "not_found"
+// GetWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "get-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type RestartInstanceNotFoundResponseBody struct {
+type GetWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// RestartInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "restart-instance" endpoint HTTP response body for the
-// "server_error" error.
-type RestartInstanceServerErrorResponseBody struct {
+// GetWebhookNotFoundResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body for the "not_found" error.
+type GetWebhookNotFoundResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StopInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "stop-instance" endpoint HTTP response body for the
+// GetWebhookServerErrorResponseBody is the type of the "control-plane" service
+// "get-webhook" endpoint HTTP response body for the "server_error" error.
+type GetWebhookServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// DeleteWebhookClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "delete-webhook" endpoint HTTP response body for the
 // "cluster_not_initialized" error.
-type StopInstanceClusterNotInitializedResponseBody struct {
+type DeleteWebhookClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StopInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "stop-instance" endpoint HTTP response body for the "invalid_input"
+// DeleteWebhookInvalidInputResponseBody is the type of the "control-plane"
+// service "delete-webhook" endpoint HTTP response body for the "invalid_input"
 // error.
-type StopInstanceInvalidInputResponseBody struct {
+type DeleteWebhookInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StopInstanceNotFoundResponseBody is the type of the "control-plane" service
-// "stop-instance" endpoint HTTP response body for the "not_found" error.
-type StopInstanceNotFoundResponseBody struct {
+// DeleteWebhookNotFoundResponseBody is the type of the "control-plane" service
+// "delete-webhook" endpoint HTTP response body for the "not_found" error.
+type DeleteWebhookNotFoundResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StopInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "stop-instance" endpoint HTTP response body for the "server_error"
+// DeleteWebhookServerErrorResponseBody is the type of the "control-plane"
+// service "delete-webhook" endpoint HTTP response body for the "server_error"
 // error.
-type StopInstanceServerErrorResponseBody struct {
+type DeleteWebhookServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StartInstanceClusterNotInitializedResponseBody is the type of the
-// "control-plane" service "start-instance" endpoint HTTP response body for the
-// "cluster_not_initialized" error.
-type StartInstanceClusterNotInitializedResponseBody struct {
+// ListWebhookDeliveriesClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type ListWebhookDeliveriesClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StartInstanceInvalidInputResponseBody is the type of the "control-plane"
-// service "start-instance" endpoint HTTP response body for the "invalid_input"
-// error.
-type StartInstanceInvalidInputResponseBody struct {
+// ListWebhookDeliveriesInvalidInputResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "invalid_input" error.
+type ListWebhookDeliveriesInvalidInputResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StartInstanceNotFoundResponseBody is the type of the "control-plane" service
-// "start-instance" endpoint HTTP response body for the "not_found" error.
-type StartInstanceNotFoundResponseBody struct {
+// ListWebhookDeliveriesNotFoundResponseBody is the type of the "control-plane"
+// service "list-webhook-deliveries" endpoint HTTP response body for the
+// "not_found" error.
+type ListWebhookDeliveriesNotFoundResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// StartInstanceServerErrorResponseBody is the type of the "control-plane"
-// service "start-instance" endpoint HTTP response body for the "server_error"
-// error.
-type StartInstanceServerErrorResponseBody struct {
+// ListWebhookDeliveriesServerErrorResponseBody is the type of the
+// "control-plane" service "list-webhook-deliveries" endpoint HTTP response
+// body for the "server_error" error.
+type ListWebhookDeliveriesServerErrorResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// CancelDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
-// service "cancel-database-task" endpoint HTTP response body for the
-// "not_found" error.
-type CancelDatabaseTaskNotFoundResponseBody struct {
+// RestoreDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type RestoreDatabaseClusterNotInitializedResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// CancelDatabaseTaskInvalidInputResponseBody is the type of the
-// "control-plane" service "cancel-database-task" endpoint HTTP response body
-// for the "invalid_input" error.
-type CancelDatabaseTaskInvalidInputResponseBody struct {
+// RestoreDatabaseDatabaseNotModifiableResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "database_not_modifiable" error.
+type RestoreDatabaseDatabaseNotModifiableResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// CancelDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
-// service "cancel-database-task" endpoint HTTP response body for the
-// "server_error" error.
-type CancelDatabaseTaskServerErrorResponseBody struct {
+// RestoreDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "restore-database" endpoint HTTP response body for
+// the "operation_already_in_progress" error.
+type RestoreDatabaseOperationAlreadyInProgressResponseBody struct {
 	// The name of the error.
 	Name string `json:"name"`
 	// The error message.
 	Message string `json:"message"`
 }
 
-// EtcdClusterMemberResponseBody is used to define fields on response body
-// types.
-type EtcdClusterMemberResponseBody struct {
-	// The name of the Etcd cluster member.
+// RestoreDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the
+// "invalid_input" error.
+type RestoreDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
 	Name string `json:"name"`
-	// The Etcd peer endpoint for this cluster member.
-	PeerUrls []string `json:"peer_urls"`
-	// The Etcd client endpoint for this cluster member.
-	ClientUrls []string `json:"client_urls"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ClusterCredentialsResponseBody is used to define fields on response body
-// types.
-type ClusterCredentialsResponseBody struct {
-	// The Etcd username for the new host.
-	Username string `json:"username"`
-	// The Etcd password for the new host.
-	Password string `json:"password"`
-	// The base64-encoded CA certificate for the cluster.
-	CaCert string `json:"ca_cert"`
-	// The base64-encoded etcd client certificate for the new cluster member.
-	ClientCert string `json:"client_cert"`
-	// The base64-encoded etcd client key for the new cluster member.
-	ClientKey string `json:"client_key"`
-	// The base64-encoded etcd server certificate for the new cluster member.
-	ServerCert string `json:"server_cert"`
-	// The base64-encoded etcd server key for the new cluster member.
-	ServerKey string `json:"server_key"`
+// RestoreDatabaseNotFoundResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the "not_found"
+// error.
+type RestoreDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ClusterStatusResponseBody is used to define fields on response body types.
-type ClusterStatusResponseBody struct {
-	// The current state of the cluster.
-	State string `json:"state"`
+// RestoreDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "restore-database" endpoint HTTP response body for the
+// "server_error" error.
+type RestoreDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// HostResponseBody is used to define fields on response body types.
-type HostResponseBody struct {
-	// Unique identifier for the host.
-	ID string `json:"id"`
-	// The orchestrator used by this host.
-	Orchestrator string `json:"orchestrator"`
-	// The data directory for the host.
-	DataDir string `json:"data_dir"`
-	// The cohort that this host belongs to.
-	Cohort *HostCohortResponseBody `json:"cohort,omitempty"`
-	// The addresses that this host advertises to other hosts.
-	PeerAddresses []string `json:"peer_addresses"`
-	// The addresses that this host advertises to client applications.
-	ClientAddresses []string `json:"client_addresses"`
-	// The number of CPUs on this host.
-	Cpus *int `json:"cpus,omitempty"`
-	// The amount of memory available on this host.
-	Memory *string `json:"memory,omitempty"`
-	// Current status of the host.
-	Status *HostStatusResponseBody `json:"status"`
-	// The default PgEdge version for this host.
-	DefaultPgedgeVersion *PgEdgeVersionResponseBody `json:"default_pgedge_version,omitempty"`
-	// The PgEdge versions supported by this host.
-	SupportedPgedgeVersions []*PgEdgeVersionResponseBody `json:"supported_pgedge_versions,omitempty"`
-	// The etcd mode for this host.
-	EtcdMode *string `json:"etcd_mode,omitempty"`
+// CloneDatabaseDatabaseAlreadyExistsResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "database_already_exists" error.
+type CloneDatabaseDatabaseAlreadyExistsResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// HostCohortResponseBody is used to define fields on response body types.
-type HostCohortResponseBody struct {
-	// The type of cohort that the host belongs to.
-	Type string `json:"type"`
-	// The member ID of the host within the cohort.
-	MemberID string `json:"member_id"`
-	// Indicates if the host is a control node in the cohort.
-	ControlAvailable bool `json:"control_available"`
+// CloneDatabaseClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type CloneDatabaseClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// HostStatusResponseBody is used to define fields on response body types.
-type HostStatusResponseBody struct {
-	State string `json:"state"`
-	// The last time the host status was updated.
-	UpdatedAt string `json:"updated_at"`
-	// The status of each component of the host.
-	Components map[string]*ComponentStatusResponseBody `json:"components"`
+// CloneDatabaseOperationAlreadyInProgressResponseBody is the type of the
+// "control-plane" service "clone-database" endpoint HTTP response body for the
+// "operation_already_in_progress" error.
+type CloneDatabaseOperationAlreadyInProgressResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ComponentStatusResponseBody is used to define fields on response body types.
-type ComponentStatusResponseBody struct {
-	// Indicates if the component is healthy.
-	Healthy bool `json:"healthy"`
-	// Error message from any errors that occurred during the health check.
-	Error *string `json:"error,omitempty"`
-	// Additional details about the component.
-	Details map[string]any `json:"details,omitempty"`
+// CloneDatabaseInvalidInputResponseBody is the type of the "control-plane"
+// service "clone-database" endpoint HTTP response body for the "invalid_input"
+// error.
+type CloneDatabaseInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// PgEdgeVersionResponseBody is used to define fields on response body types.
-type PgEdgeVersionResponseBody struct {
-	// The Postgres major and minor version.
-	PostgresVersion string `json:"postgres_version"`
-	// The Spock major version.
-	SpockVersion string `json:"spock_version"`
+// CloneDatabaseNotFoundResponseBody is the type of the "control-plane" service
+// "clone-database" endpoint HTTP response body for the "not_found" error.
+type CloneDatabaseNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// TaskResponseBody is used to define fields on response body types.
-type TaskResponseBody struct {
-	// The parent task ID of the task.
-	ParentID *string `json:"parent_id,omitempty"`
-	// The scope of the task (database or host).
-	Scope string `json:"scope"`
-	// The entity ID (database_id or host_id) that this task belongs to.
-	EntityID string `json:"entity_id"`
-	// The database ID of the task.
-	DatabaseID *string `json:"database_id,omitempty"`
-	// The name of the node that the task is operating on.
-	NodeName *string `json:"node_name,omitempty"`
-	// The ID of the instance that the task is operating on.
-	InstanceID *string `json:"instance_id,omitempty"`
-	// The ID of the host that the task is running on.
-	HostID *string `json:"host_id,omitempty"`
-	// The unique ID of the task.
-	TaskID string `json:"task_id"`
-	// The time when the task was created.
-	CreatedAt string `json:"created_at"`
-	// The time when the task was completed.
-	CompletedAt *string `json:"completed_at,omitempty"`
-	// The type of the task.
-	Type string `json:"type"`
-	// The status of the task.
-	Status string `json:"status"`
-	// The error message if the task failed.
-	Error *string `json:"error,omitempty"`
+// CloneDatabaseServerErrorResponseBody is the type of the "control-plane"
+// service "clone-database" endpoint HTTP response body for the "server_error"
+// error.
+type CloneDatabaseServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseSummaryResponseBody is used to define fields on response body types.
-type DatabaseSummaryResponseBody struct {
-	// Unique identifier for the database.
-	ID string `json:"id"`
-	// Unique identifier for the database's owner.
-	TenantID *string `json:"tenant_id,omitempty"`
-	// The time that the database was created.
-	CreatedAt string `json:"created_at"`
-	// The time that the database was last updated.
-	UpdatedAt string `json:"updated_at"`
-	// Current state of the database.
-	State string `json:"state"`
-	// All of the instances in the database.
-	Instances []*InstanceResponseBody `json:"instances,omitempty"`
-	// Newer stable image versions available in the same Postgres major / Spock
-	// major bucket. Present only when ?include=available_upgrades is set.
-	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
-}
+// GetVersionServerErrorResponseBody is the type of the "control-plane" service
+// "get-version" endpoint HTTP response body for the "server_error" error.
+type GetVersionServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
+}
 
-// InstanceResponseBody is used to define fields on response body types.
-type InstanceResponseBody struct {
-	// Unique identifier for the instance.
-	ID string `json:"id"`
-	// The ID of the host this instance is running on.
-	HostID string `json:"host_id"`
-	// The Spock node name for this instance.
-	NodeName string `json:"node_name"`
-	// The time that the instance was created.
-	CreatedAt string `json:"created_at"`
-	// The time that the instance was last modified.
-	UpdatedAt string `json:"updated_at"`
-	// The time that the instance status information was last updated.
-	StatusUpdatedAt *string `json:"status_updated_at,omitempty"`
-	State           string  `json:"state"`
-	// Connection information for the instance.
-	ConnectionInfo *InstanceConnectionInfoResponseBody `json:"connection_info,omitempty"`
-	// Postgres status information for the instance.
-	Postgres *InstancePostgresStatusResponseBody `json:"postgres,omitempty"`
-	// Spock status information for the instance.
-	Spock *InstanceSpockStatusResponseBody `json:"spock,omitempty"`
-	// An error message if the instance is in an error state.
-	Error *string `json:"error,omitempty"`
+// RestartInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "restart-instance" endpoint HTTP response body for
+// the "cluster_not_initialized" error.
+type RestartInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// InstanceConnectionInfoResponseBody is used to define fields on response body
-// types.
-type InstanceConnectionInfoResponseBody struct {
-	// The addresses of the host that's running this instance.
-	Addresses []string `json:"addresses,omitempty"`
-	// The host port that Postgres is listening on for this instance.
-	Port *int `json:"port,omitempty"`
+// RestartInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the
+// "invalid_input" error.
+type RestartInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// InstancePostgresStatusResponseBody is used to define fields on response body
-// types.
-type InstancePostgresStatusResponseBody struct {
-	// The version of Postgres for this instance.
-	Version      *string `json:"version,omitempty"`
-	PatroniState *string `json:"patroni_state,omitempty"`
-	Role         *string `json:"role,omitempty"`
-	// True if this instance has a pending restart from a configuration change.
-	PendingRestart *bool `json:"pending_restart,omitempty"`
-	// True if Patroni is paused for this instance.
-	PatroniPaused *bool `json:"patroni_paused,omitempty"`
+// RestartInstanceNotFoundResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the "not_found"
+// error.
+type RestartInstanceNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// InstanceSpockStatusResponseBody is used to define fields on response body
-// types.
-type InstanceSpockStatusResponseBody struct {
-	// The current spock.readonly setting.
-	ReadOnly *string `json:"read_only,omitempty"`
-	// The version of Spock for this instance.
-	Version *string `json:"version,omitempty"`
-	// Status information for this instance's Spock subscriptions.
-	Subscriptions []*InstanceSubscriptionResponseBody `json:"subscriptions,omitempty"`
+// RestartInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "restart-instance" endpoint HTTP response body for the
+// "server_error" error.
+type RestartInstanceServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// InstanceSubscriptionResponseBody is used to define fields on response body
-// types.
-type InstanceSubscriptionResponseBody struct {
-	// The Spock node name of the provider for this subscription.
-	ProviderNode string `json:"provider_node"`
-	// The name of the subscription.
+// GetInstanceSessionsClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type GetInstanceSessionsClusterNotInitializedResponseBody struct {
+	// The name of the error.
 	Name string `json:"name"`
-	// The current status of the subscription.
-	Status string `json:"status"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// AvailableUpgradeResponseBody is used to define fields on response body types.
-type AvailableUpgradeResponseBody struct {
-	// Postgres version of the upgrade candidate.
-	PostgresVersion string `json:"postgres_version"`
-	// Spock major version of the upgrade candidate.
-	SpockVersion string `json:"spock_version"`
-	// Full container image reference for the upgrade candidate.
-	Image string `json:"image"`
+// GetInstanceSessionsInvalidInputResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "invalid_input" error.
+type GetInstanceSessionsInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseResponseBody is used to define fields on response body types.
-type DatabaseResponseBody struct {
-	// Unique identifier for the database.
-	ID string `json:"id"`
-	// Unique identifier for the database's owner.
-	TenantID *string `json:"tenant_id,omitempty"`
-	// The time that the database was created.
-	CreatedAt string `json:"created_at"`
-	// The time that the database was last updated.
-	UpdatedAt string `json:"updated_at"`
-	// Current state of the database.
-	State string `json:"state"`
-	// All of the instances in the database.
-	Instances []*InstanceResponseBody `json:"instances,omitempty"`
-	// Service instances running alongside this database.
-	ServiceInstances []*ServiceInstanceResponseBody `json:"service_instances,omitempty"`
-	// The user-provided specification for the database.
-	Spec *DatabaseSpecResponseBody `json:"spec,omitempty"`
-	// Newer stable image versions available in the same Postgres major / Spock
-	// major bucket. Present only when ?include=available_upgrades is set.
-	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+// GetInstanceSessionsNotFoundResponseBody is the type of the "control-plane"
+// service "get-instance-sessions" endpoint HTTP response body for the
+// "not_found" error.
+type GetInstanceSessionsNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ServiceInstanceResponseBody is used to define fields on response body types.
-type ServiceInstanceResponseBody struct {
-	// Unique identifier for the service instance.
-	ServiceInstanceID string `json:"service_instance_id"`
-	// The service ID from the DatabaseSpec.
-	ServiceID string `json:"service_id"`
-	// The ID of the database this service belongs to.
-	DatabaseID string `json:"database_id"`
-	// The ID of the host this service instance is running on.
-	HostID string `json:"host_id"`
-	// Current state of the service instance.
-	State string `json:"state"`
-	// Runtime status information for the service instance.
-	Status *ServiceInstanceStatusResponseBody `json:"status,omitempty"`
-	// The time that the service instance was created.
-	CreatedAt string `json:"created_at"`
-	// The time that the service instance was last updated.
-	UpdatedAt string `json:"updated_at"`
-	// An error message if the service instance is in an error state.
-	Error *string `json:"error,omitempty"`
+// GetInstanceSessionsServerErrorResponseBody is the type of the
+// "control-plane" service "get-instance-sessions" endpoint HTTP response body
+// for the "server_error" error.
+type GetInstanceSessionsServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ServiceInstanceStatusResponseBody is used to define fields on response body
-// types.
-type ServiceInstanceStatusResponseBody struct {
-	// The Docker container ID.
-	ContainerID *string `json:"container_id,omitempty"`
-	// The container image version currently running.
-	ImageVersion *string `json:"image_version,omitempty"`
-	// The addresses of the host that's running this service instance.
-	Addresses []string `json:"addresses,omitempty"`
-	// Port mappings for this service instance.
-	Ports []*PortMappingResponseBody `json:"ports,omitempty"`
-	// Most recent health check result.
-	HealthCheck *HealthCheckResultResponseBody `json:"health_check,omitempty"`
-	// The time of the last health check attempt.
-	LastHealthAt *string `json:"last_health_at,omitempty"`
-	// Whether the service is ready to accept requests.
-	ServiceReady *bool `json:"service_ready,omitempty"`
+// CancelInstanceSessionClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type CancelInstanceSessionClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// PortMappingResponseBody is used to define fields on response body types.
-type PortMappingResponseBody struct {
-	// The name of the port (e.g., 'http', 'web-client').
+// CancelInstanceSessionInvalidInputResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "invalid_input" error.
+type CancelInstanceSessionInvalidInputResponseBody struct {
+	// The name of the error.
 	Name string `json:"name"`
-	// The port number inside the container.
-	ContainerPort *int `json:"container_port,omitempty"`
-	// The port number on the host (if port-forwarded).
-	HostPort *int `json:"host_port,omitempty"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// HealthCheckResultResponseBody is used to define fields on response body
-// types.
-type HealthCheckResultResponseBody struct {
-	// The health status.
-	Status string `json:"status"`
-	// Optional message about the health status.
-	Message *string `json:"message,omitempty"`
-	// The time this health check was performed.
-	CheckedAt string `json:"checked_at"`
+// CancelInstanceSessionNotFoundResponseBody is the type of the "control-plane"
+// service "cancel-instance-session" endpoint HTTP response body for the
+// "not_found" error.
+type CancelInstanceSessionNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseSpecResponseBody is used to define fields on response body types.
-type DatabaseSpecResponseBody struct {
-	// The name of the Postgres database.
-	DatabaseName string `json:"database_name"`
-	// The Postgres version in 'major.minor' format.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The major version of the Spock extension.
-	SpockVersion *string `json:"spock_version,omitempty"`
-	// The port used by the Postgres database. If the port is 0, each instance will
-	// be assigned a random port. If the port is unspecified, the database will not
-	// be exposed on any port, dependent on orchestrator support for that feature.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this database. If the port is 0, each instance
-	// will be assigned a random port. NOTE: This field is not currently supported
-	// for Docker Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database and to use for tuning
-	// Postgres. Defaults to the number of available CPUs on the host. Can include
-	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
-	// depends on the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database and
-	// to use for tuning Postgres. Defaults to the total available memory on the
-	// host. Whether this limit is enforced depends on the orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecResponseBody `json:"nodes"`
-	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecResponseBody `json:"database_users,omitempty"`
-	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecResponseBody `json:"services,omitempty"`
-	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
-	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
-	// Additional postgresql.conf settings. Will be merged with the settings
-	// provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries, one rule per array element. Inserted between
-	// control-plane's system-user rules and its catch-all, so they cannot affect
-	// control-plane-internal connectivity (Patroni, replication, health checks).
-	// Node-level pg_hba_conf entries are prepended to these.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries, one mapping per array element. Purely
-	// additive; control-plane writes no pg_ident entries of its own. The primary
-	// use case is cert auth with map= translating certificate CNs to PostgreSQL
-	// usernames.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// User-defined SQL scripts that run at different points during the database
-	// creation process. Once a database has been successfully created, changes to
-	// these scripts will have no effect.
-	Scripts *DatabaseScriptsResponseBody `json:"scripts,omitempty"`
+// CancelInstanceSessionServerErrorResponseBody is the type of the
+// "control-plane" service "cancel-instance-session" endpoint HTTP response
+// body for the "server_error" error.
+type CancelInstanceSessionServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseNodeSpecResponseBody is used to define fields on response body types.
-type DatabaseNodeSpecResponseBody struct {
-	// The name of the database node.
+// TerminateInstanceSessionClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "cluster_not_initialized" error.
+type TerminateInstanceSessionClusterNotInitializedResponseBody struct {
+	// The name of the error.
 	Name string `json:"name"`
-	// The IDs of the hosts that should run this node. When multiple hosts are
-	// specified, one host will chosen as a primary, and the others will be read
-	// replicas.
-	HostIds []string `json:"host_ids"`
-	// The Postgres version for this node in 'major.minor' format. Overrides the
-	// Postgres version set in the DatabaseSpec.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The port used by the Postgres database for this node. Overrides the Postgres
-	// port set in the DatabaseSpec.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this node. Overrides the Patroni port set in
-	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
-	// Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database on this node and to use for
-	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
-	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
-	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
-	// than are available on the host. Whether this limit is enforced depends on
-	// the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database on
-	// this node and to use for tuning Postgres. Defaults to the total available
-	// memory on the host. Whether this limit is enforced depends on the
-	// orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// Additional postgresql.conf settings for this particular node. Will be merged
-	// with the settings provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries for this particular node, one rule per array
-	// element. Prepended to the database-level pg_hba_conf entries, so node
-	// entries take first-match priority. Entries are inserted between
-	// control-plane's system-user rules and its catch-all, and cannot affect
-	// control-plane-internal connectivity.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries for this particular node, one mapping per
-	// array element. Prepended to the database-level pg_ident_conf entries.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// The backup configuration for this node. Overrides the backup configuration
-	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
-	// The restore configuration for this node. Overrides the restore configuration
-	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// The name of the source node to use for sync. This is typically the node
-	// (like 'n1') from which the data will be copied to initialize this new node.
-	SourceNode *string `json:"source_node,omitempty"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupConfigSpecResponseBody is used to define fields on response body types.
-type BackupConfigSpecResponseBody struct {
-	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecResponseBody `json:"repositories"`
-	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecResponseBody `json:"schedules,omitempty"`
+// TerminateInstanceSessionInvalidInputResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "invalid_input" error.
+type TerminateInstanceSessionInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupRepositorySpecResponseBody is used to define fields on response body
-// types.
-type BackupRepositorySpecResponseBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain. This field will
-	// be excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile. This field will be
-	// excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// The Azure storage account access key to use for this repository. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The count of full backups to retain or the time to retain full backups.
-	RetentionFull *int `json:"retention_full,omitempty"`
-	// The type of measure used for retention_full.
-	RetentionFullType *string `json:"retention_full_type,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// TerminateInstanceSessionNotFoundResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "not_found" error.
+type TerminateInstanceSessionNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupScheduleSpecResponseBody is used to define fields on response body
-// types.
-type BackupScheduleSpecResponseBody struct {
-	// The unique identifier for this backup schedule.
-	ID string `json:"id"`
-	// The type of backup to take on this schedule.
-	Type string `json:"type"`
-	// The cron expression for this schedule.
-	CronExpression string `json:"cron_expression"`
+// TerminateInstanceSessionServerErrorResponseBody is the type of the
+// "control-plane" service "terminate-instance-session" endpoint HTTP response
+// body for the "server_error" error.
+type TerminateInstanceSessionServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// RestoreConfigSpecResponseBody is used to define fields on response body
-// types.
-type RestoreConfigSpecResponseBody struct {
-	// The ID of the database to restore this database from.
-	SourceDatabaseID string `json:"source_database_id"`
-	// The name of the node to restore this database from.
-	SourceNodeName string `json:"source_node_name"`
-	// The name of the database in this repository. The database will be renamed to
-	// the database_name in the DatabaseSpec after it's restored.
-	SourceDatabaseName string `json:"source_database_name"`
-	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecResponseBody `json:"repository"`
-	// Additional options to use when restoring this database. If omitted, the
-	// database will be restored to the latest point in the given repository.
-	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+// GetInstancePostgresqlConfClusterNotInitializedResponseBody is the type of
+// the "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "cluster_not_initialized" error.
+type GetInstancePostgresqlConfClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// RestoreRepositorySpecResponseBody is used to define fields on response body
-// types.
-type RestoreRepositorySpecResponseBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// An optional Azure storage account access key to use for this repository. If
-	// not provided, pgbackrest will use the VM's managed identity.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// GetInstancePostgresqlConfInvalidInputResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "invalid_input" error.
+type GetInstancePostgresqlConfInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// OrchestratorOptsResponseBody is used to define fields on response body types.
-type OrchestratorOptsResponseBody struct {
-	// Swarm-specific configuration.
-	Swarm *SwarmOptsResponseBody `json:"swarm,omitempty"`
+// GetInstancePostgresqlConfNotFoundResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "not_found" error.
+type GetInstancePostgresqlConfNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// SwarmOptsResponseBody is used to define fields on response body types.
-type SwarmOptsResponseBody struct {
-	// A list of extra volumes to mount. Each entry defines a host and container
-	// path.
-	ExtraVolumes []*ExtraVolumesSpecResponseBody `json:"extra_volumes,omitempty"`
-	// A list of additional Docker Swarm networks to attach containers in this
-	// database to.
-	ExtraNetworks []*ExtraNetworkSpecResponseBody `json:"extra_networks,omitempty"`
-	// Arbitrary labels to apply to the Docker Swarm service
-	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
-	// User-specified container image override. Bypasses manifest version
-	// constraints entirely — the CP will deploy this image without validating it
-	// against the version manifest. The CP verifies the image exists in its
-	// registry before accepting the spec. Clearing this field causes the CP to
-	// fall back to the manifest-resolved image on the next reconcile.
-	Image *string `json:"image,omitempty"`
+// GetInstancePostgresqlConfServerErrorResponseBody is the type of the
+// "control-plane" service "get-instance-postgresql-conf" endpoint HTTP
+// response body for the "server_error" error.
+type GetInstancePostgresqlConfServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ExtraVolumesSpecResponseBody is used to define fields on response body types.
-type ExtraVolumesSpecResponseBody struct {
-	// The host path for the volume.
-	HostPath string `json:"host_path"`
-	// The path inside the container where the volume will be mounted.
-	DestinationPath string `json:"destination_path"`
-}
-
-// ExtraNetworkSpecResponseBody is used to define fields on response body types.
-type ExtraNetworkSpecResponseBody struct {
-	// The name or ID of the network to connect to.
-	ID string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// StopInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "stop-instance" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StopInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseUserSpecResponseBody is used to define fields on response body types.
-type DatabaseUserSpecResponseBody struct {
-	// The username for this database user.
-	Username string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+// StopInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "stop-instance" endpoint HTTP response body for the "invalid_input"
+// error.
+type StopInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ServiceSpecResponseBody is used to define fields on response body types.
-type ServiceSpecResponseBody struct {
-	// The unique identifier for this service.
-	ServiceID string `json:"service_id"`
-	// The type of service to run.
-	ServiceType string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
-	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionResponseBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs string `json:"connect_as"`
+// StopInstanceNotFoundResponseBody is the type of the "control-plane" service
+// "stop-instance" endpoint HTTP response body for the "not_found" error.
+type StopInstanceNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseConnectionResponseBody is used to define fields on response body
-// types.
-type DatabaseConnectionResponseBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+// StopInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "stop-instance" endpoint HTTP response body for the "server_error"
+// error.
+type StopInstanceServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseScriptsResponseBody is used to define fields on response body types.
-type DatabaseScriptsResponseBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+// StartInstanceClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "start-instance" endpoint HTTP response body for the
+// "cluster_not_initialized" error.
+type StartInstanceClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// TaskLogEntryResponseBody is used to define fields on response body types.
-type TaskLogEntryResponseBody struct {
-	// The timestamp of the log entry.
-	Timestamp string `json:"timestamp"`
-	// The log message.
+// StartInstanceInvalidInputResponseBody is the type of the "control-plane"
+// service "start-instance" endpoint HTTP response body for the "invalid_input"
+// error.
+type StartInstanceInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
 	Message string `json:"message"`
-	// Additional fields for the log entry.
-	Fields map[string]any `json:"fields,omitempty"`
 }
 
-// DatabaseSpecRequestBody is used to define fields on request body types.
-type DatabaseSpecRequestBody struct {
-	// The name of the Postgres database.
-	DatabaseName *string `json:"database_name"`
-	// The Postgres version in 'major.minor' format.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The major version of the Spock extension.
-	SpockVersion *string `json:"spock_version,omitempty"`
-	// The port used by the Postgres database. If the port is 0, each instance will
-	// be assigned a random port. If the port is unspecified, the database will not
-	// be exposed on any port, dependent on orchestrator support for that feature.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this database. If the port is 0, each instance
-	// will be assigned a random port. NOTE: This field is not currently supported
-	// for Docker Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database and to use for tuning
-	// Postgres. Defaults to the number of available CPUs on the host. Can include
-	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
-	// depends on the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database and
-	// to use for tuning Postgres. Defaults to the total available memory on the
-	// host. Whether this limit is enforced depends on the orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecRequestBody `json:"nodes"`
-	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecRequestBody `json:"database_users,omitempty"`
-	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecRequestBody `json:"services,omitempty"`
-	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
-	// Additional postgresql.conf settings. Will be merged with the settings
-	// provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries, one rule per array element. Inserted between
-	// control-plane's system-user rules and its catch-all, so they cannot affect
-	// control-plane-internal connectivity (Patroni, replication, health checks).
-	// Node-level pg_hba_conf entries are prepended to these.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries, one mapping per array element. Purely
-	// additive; control-plane writes no pg_ident entries of its own. The primary
-	// use case is cert auth with map= translating certificate CNs to PostgreSQL
-	// usernames.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// User-defined SQL scripts that run at different points during the database
-	// creation process. Once a database has been successfully created, changes to
-	// these scripts will have no effect.
-	Scripts *DatabaseScriptsRequestBody `json:"scripts,omitempty"`
+// StartInstanceNotFoundResponseBody is the type of the "control-plane" service
+// "start-instance" endpoint HTTP response body for the "not_found" error.
+type StartInstanceNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// DatabaseNodeSpecRequestBody is used to define fields on request body types.
-type DatabaseNodeSpecRequestBody struct {
-	// The name of the database node.
-	Name *string `json:"name"`
-	// The IDs of the hosts that should run this node. When multiple hosts are
-	// specified, one host will chosen as a primary, and the others will be read
-	// replicas.
-	HostIds []string `json:"host_ids"`
-	// The Postgres version for this node in 'major.minor' format. Overrides the
-	// Postgres version set in the DatabaseSpec.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The port used by the Postgres database for this node. Overrides the Postgres
-	// port set in the DatabaseSpec.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this node. Overrides the Patroni port set in
-	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
-	// Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database on this node and to use for
-	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
-	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
-	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
-	// than are available on the host. Whether this limit is enforced depends on
-	// the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database on
-	// this node and to use for tuning Postgres. Defaults to the total available
-	// memory on the host. Whether this limit is enforced depends on the
-	// orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// Additional postgresql.conf settings for this particular node. Will be merged
-	// with the settings provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries for this particular node, one rule per array
-	// element. Prepended to the database-level pg_hba_conf entries, so node
-	// entries take first-match priority. Entries are inserted between
-	// control-plane's system-user rules and its catch-all, and cannot affect
-	// control-plane-internal connectivity.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries for this particular node, one mapping per
-	// array element. Prepended to the database-level pg_ident_conf entries.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// The backup configuration for this node. Overrides the backup configuration
-	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this node. Overrides the restore configuration
-	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// The name of the source node to use for sync. This is typically the node
-	// (like 'n1') from which the data will be copied to initialize this new node.
-	SourceNode *string `json:"source_node,omitempty"`
+// StartInstanceServerErrorResponseBody is the type of the "control-plane"
+// service "start-instance" endpoint HTTP response body for the "server_error"
+// error.
+type StartInstanceServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupConfigSpecRequestBody is used to define fields on request body types.
-type BackupConfigSpecRequestBody struct {
-	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecRequestBody `json:"repositories"`
-	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecRequestBody `json:"schedules,omitempty"`
+// CancelDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
+// service "cancel-database-task" endpoint HTTP response body for the
+// "not_found" error.
+type CancelDatabaseTaskNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupRepositorySpecRequestBody is used to define fields on request body
-// types.
-type BackupRepositorySpecRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain. This field will
-	// be excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile. This field will be
-	// excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// The Azure storage account access key to use for this repository. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The count of full backups to retain or the time to retain full backups.
-	RetentionFull *int `json:"retention_full,omitempty"`
-	// The type of measure used for retention_full.
-	RetentionFullType *string `json:"retention_full_type,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// CancelDatabaseTaskInvalidInputResponseBody is the type of the
+// "control-plane" service "cancel-database-task" endpoint HTTP response body
+// for the "invalid_input" error.
+type CancelDatabaseTaskInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// BackupScheduleSpecRequestBody is used to define fields on request body types.
-type BackupScheduleSpecRequestBody struct {
-	// The unique identifier for this backup schedule.
-	ID *string `json:"id"`
-	// The type of backup to take on this schedule.
-	Type *string `json:"type"`
-	// The cron expression for this schedule.
-	CronExpression *string `json:"cron_expression"`
+// CancelDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
+// service "cancel-database-task" endpoint HTTP response body for the
+// "server_error" error.
+type CancelDatabaseTaskServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// RestoreConfigSpecRequestBody is used to define fields on request body types.
-type RestoreConfigSpecRequestBody struct {
-	// The ID of the database to restore this database from.
-	SourceDatabaseID *string `json:"source_database_id"`
-	// The name of the node to restore this database from.
-	SourceNodeName *string `json:"source_node_name"`
-	// The name of the database in this repository. The database will be renamed to
-	// the database_name in the DatabaseSpec after it's restored.
-	SourceDatabaseName *string `json:"source_database_name"`
-	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecRequestBody `json:"repository"`
-	// Additional options to use when restoring this database. If omitted, the
-	// database will be restored to the latest point in the given repository.
-	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+// ResumeDatabaseTaskClusterNotInitializedResponseBody is the type of the
+// "control-plane" service "resume-database-task" endpoint HTTP response body
+// for the "cluster_not_initialized" error.
+type ResumeDatabaseTaskClusterNotInitializedResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// RestoreRepositorySpecRequestBody is used to define fields on request body
-// types.
-type RestoreRepositorySpecRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// An optional Azure storage account access key to use for this repository. If
-	// not provided, pgbackrest will use the VM's managed identity.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// ResumeDatabaseTaskNotFoundResponseBody is the type of the "control-plane"
+// service "resume-database-task" endpoint HTTP response body for the
+// "not_found" error.
+type ResumeDatabaseTaskNotFoundResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// OrchestratorOptsRequestBody is used to define fields on request body types.
-type OrchestratorOptsRequestBody struct {
-	// Swarm-specific configuration.
-	Swarm *SwarmOptsRequestBody `json:"swarm,omitempty"`
+// ResumeDatabaseTaskInvalidInputResponseBody is the type of the
+// "control-plane" service "resume-database-task" endpoint HTTP response body
+// for the "invalid_input" error.
+type ResumeDatabaseTaskInvalidInputResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// SwarmOptsRequestBody is used to define fields on request body types.
-type SwarmOptsRequestBody struct {
-	// A list of extra volumes to mount. Each entry defines a host and container
-	// path.
-	ExtraVolumes []*ExtraVolumesSpecRequestBody `json:"extra_volumes,omitempty"`
-	// A list of additional Docker Swarm networks to attach containers in this
-	// database to.
-	ExtraNetworks []*ExtraNetworkSpecRequestBody `json:"extra_networks,omitempty"`
-	// Arbitrary labels to apply to the Docker Swarm service
-	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
-	// User-specified container image override. Bypasses manifest version
-	// constraints entirely — the CP will deploy this image without validating it
-	// against the version manifest. The CP verifies the image exists in its
-	// registry before accepting the spec. Clearing this field causes the CP to
-	// fall back to the manifest-resolved image on the next reconcile.
-	Image *string `json:"image,omitempty"`
+// ResumeDatabaseTaskServerErrorResponseBody is the type of the "control-plane"
+// service "resume-database-task" endpoint HTTP response body for the
+// "server_error" error.
+type ResumeDatabaseTaskServerErrorResponseBody struct {
+	// The name of the error.
+	Name string `json:"name"`
+	// The error message.
+	Message string `json:"message"`
 }
 
-// ExtraVolumesSpecRequestBody is used to define fields on request body types.
-type ExtraVolumesSpecRequestBody struct {
-	// The host path for the volume.
-	HostPath *string `json:"host_path"`
-	// The path inside the container where the volume will be mounted.
-	DestinationPath *string `json:"destination_path"`
+// EtcdClusterMemberResponseBody is used to define fields on response body
+// types.
+type EtcdClusterMemberResponseBody struct {
+	// The name of the Etcd cluster member.
+	Name string `json:"name"`
+	// The Etcd peer endpoint for this cluster member.
+	PeerUrls []string `json:"peer_urls"`
+	// The Etcd client endpoint for this cluster member.
+	ClientUrls []string `json:"client_urls"`
 }
 
-// ExtraNetworkSpecRequestBody is used to define fields on request body types.
-type ExtraNetworkSpecRequestBody struct {
-	// The name or ID of the network to connect to.
-	ID *string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// ClusterCredentialsResponseBody is used to define fields on response body
+// types.
+type ClusterCredentialsResponseBody struct {
+	// The Etcd username for the new host.
+	Username string `json:"username"`
+	// The Etcd password for the new host.
+	Password string `json:"password"`
+	// The base64-encoded CA certificate for the cluster.
+	CaCert string `json:"ca_cert"`
+	// The base64-encoded etcd client certificate for the new cluster member.
+	ClientCert string `json:"client_cert"`
+	// The base64-encoded etcd client key for the new cluster member.
+	ClientKey string `json:"client_key"`
+	// The base64-encoded etcd server certificate for the new cluster member.
+	ServerCert string `json:"server_cert"`
+	// The base64-encoded etcd server key for the new cluster member.
+	ServerKey string `json:"server_key"`
 }
 
-// DatabaseUserSpecRequestBody is used to define fields on request body types.
-type DatabaseUserSpecRequestBody struct {
-	// The username for this database user.
-	Username *string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+// ClusterStatusResponseBody is used to define fields on response body types.
+type ClusterStatusResponseBody struct {
+	// The current state of the cluster. The state is starting when the host that
+	// served the request hasn't finished starting up, such as while it waits for
+	// the other hosts to be upgraded.
+	State string `json:"state"`
 }
 
-// ServiceSpecRequestBody is used to define fields on request body types.
-type ServiceSpecRequestBody struct {
-	// The unique identifier for this service.
-	ServiceID *string `json:"service_id"`
-	// The type of service to run.
-	ServiceType *string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version *string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
+// HostResponseBody is used to define fields on response body types.
+type HostResponseBody struct {
+	// Unique identifier for the host.
+	ID string `json:"id"`
+	// The orchestrator used by this host.
+	Orchestrator string `json:"orchestrator"`
+	// The data directory for the host.
+	DataDir string `json:"data_dir"`
+	// The cohort that this host belongs to.
+	Cohort *HostCohortResponseBody `json:"cohort,omitempty"`
+	// The addresses that this host advertises to other hosts.
+	PeerAddresses []string `json:"peer_addresses"`
+	// The addresses that this host advertises to client applications.
+	ClientAddresses []string `json:"client_addresses"`
+	// The number of CPUs on this host.
+	Cpus *int `json:"cpus,omitempty"`
+	// The amount of memory available on this host.
 	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionRequestBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs *string `json:"connect_as"`
+	// Current status of the host.
+	Status *HostStatusResponseBody `json:"status"`
+	// The default PgEdge version for this host.
+	DefaultPgedgeVersion *PgEdgeVersionResponseBody `json:"default_pgedge_version,omitempty"`
+	// The PgEdge versions supported by this host.
+	SupportedPgedgeVersions []*PgEdgeVersionResponseBody `json:"supported_pgedge_versions,omitempty"`
+	// The etcd mode for this host.
+	EtcdMode *string `json:"etcd_mode,omitempty"`
 }
 
-// DatabaseConnectionRequestBody is used to define fields on request body types.
-type DatabaseConnectionRequestBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+// HostCohortResponseBody is used to define fields on response body types.
+type HostCohortResponseBody struct {
+	// The type of cohort that the host belongs to.
+	Type string `json:"type"`
+	// The member ID of the host within the cohort.
+	MemberID string `json:"member_id"`
+	// Indicates if the host is a control node in the cohort.
+	ControlAvailable bool `json:"control_available"`
+}
+
+// HostStatusResponseBody is used to define fields on response body types.
+type HostStatusResponseBody struct {
+	State string `json:"state"`
+	// The last time the host status was updated.
+	UpdatedAt string `json:"updated_at"`
+	// The status of each component of the host.
+	Components map[string]*ComponentStatusResponseBody `json:"components"`
+	// The Control Plane version that's running on the host.
+	Version *VersionInfoResponseBody `json:"version,omitempty"`
+}
+
+// ComponentStatusResponseBody is used to define fields on response body types.
+type ComponentStatusResponseBody struct {
+	// Indicates if the component is healthy.
+	Healthy bool `json:"healthy"`
+	// Error message from any errors that occurred during the health check.
+	Error *string `json:"error,omitempty"`
+	// Additional details about the component.
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// VersionInfoResponseBody is used to define fields on response body types.
+type VersionInfoResponseBody struct {
+	// The version of the API server.
+	Version string `json:"version"`
+	// The VCS revision of the API server.
+	Revision string `json:"revision"`
+	// The timestamp associated with the revision.
+	RevisionTime string `json:"revision_time"`
+	// The CPU architecture of the API server.
+	Arch string `json:"arch"`
+}
+
+// PgEdgeVersionResponseBody is used to define fields on response body types.
+type PgEdgeVersionResponseBody struct {
+	// The Postgres major and minor version.
+	PostgresVersion string `json:"postgres_version"`
+	// The Spock major version.
+	SpockVersion string `json:"spock_version"`
+}
+
+// ClusterUpgradeStatusResponseBody is used to define fields on response body
+// types.
+type ClusterUpgradeStatusResponseBody struct {
+	// Whether every host has been upgraded and every pending change has been
+	// applied.
+	State string `json:"state"`
+	// The most recent data migration that's been applied.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version in the data.
+	StateVersion *string `json:"state_version,omitempty"`
+	// Data migrations that are supported by the newest version in the cluster but
+	// haven't been applied. Upgraded hosts apply them once every host supports
+	// them.
+	PendingMigrations []string `json:"pending_migrations"`
+	// The upgrade status of each host.
+	Hosts []*ClusterUpgradeHostResponseBody `json:"hosts"`
+	// Hosts that can't read the current data or that are holding back the upgrade.
+	Incompatibilities []string `json:"incompatibilities"`
+}
+
+// ClusterUpgradeHostResponseBody is used to define fields on response body
+// types.
+type ClusterUpgradeHostResponseBody struct {
+	// The ID of the host.
+	HostID string `json:"host_id"`
+	// The Control Plane version that's running on the host.
+	Version *string `json:"version,omitempty"`
+	// The newest data migration that the host's version supports.
+	Revision *string `json:"revision,omitempty"`
+	// The newest resource state version that the host's version supports.
+	StateVersion *string `json:"state_version,omitempty"`
+	// True if the host's version can read the current data.
+	Compatible bool `json:"compatible"`
+	// True if the host's version supports every change that's supported by the
+	// newest version in the cluster.
+	Upgraded bool `json:"upgraded"`
+}
+
+// StoragePrefixUsageResponseBody is used to define fields on response body
+// types.
+type StoragePrefixUsageResponseBody struct {
+	// The key prefix.
+	Prefix string `json:"prefix"`
+	// The number of keys under this prefix.
+	Keys int64 `json:"keys"`
+	// The total size of the keys and values under this prefix.
+	Bytes int64 `json:"bytes"`
+}
+
+// EtcdMemberResponseBody is used to define fields on response body types.
+type EtcdMemberResponseBody struct {
+	// The hexadecimal Etcd member ID.
+	ID string `json:"id"`
+	// The ID of the host that runs this member.
+	HostID string `json:"host_id"`
+	// Voters count toward quorum. Learners receive updates, but don't vote until
+	// they're promoted.
+	Role string `json:"role"`
+	// True if this member is the raft leader.
+	IsLeader bool `json:"is_leader"`
+	// True if this member responded to a status request.
+	Reachable bool `json:"reachable"`
+	// The member's peer URLs.
+	PeerUrls []string `json:"peer_urls"`
+	// The member's client URLs.
+	ClientUrls []string `json:"client_urls"`
+	// The member's Etcd version.
+	Version *string `json:"version,omitempty"`
+	// The size of the member's database on disk, including space that Etcd can
+	// reuse.
+	DbSizeBytes *int64 `json:"db_size_bytes,omitempty"`
+	// The portion of the member's database that's in use.
+	DbSizeInUseBytes *int64 `json:"db_size_in_use_bytes,omitempty"`
+	// The member's current raft term.
+	RaftTerm *uint64 `json:"raft_term,omitempty"`
+	// The member's committed raft index.
+	RaftIndex *uint64 `json:"raft_index,omitempty"`
+	// The member's applied raft index.
+	RaftAppliedIndex *uint64 `json:"raft_applied_index,omitempty"`
+	// The number of raft entries that this member has yet to apply relative to the
+	// leader.
+	RaftIndexLag uint64 `json:"raft_index_lag"`
+	// Errors reported by or for this member.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// EtcdDefragmentResultResponseBody is used to define fields on response body
+// types.
+type EtcdDefragmentResultResponseBody struct {
+	// The ID of the host that was defragmented.
+	HostID string `json:"host_id"`
+	// The size of the member's database on disk before defragmentation.
+	DbSizeBeforeBytes int64 `json:"db_size_before_bytes"`
+	// The size of the member's database on disk after defragmentation.
+	DbSizeAfterBytes *int64 `json:"db_size_after_bytes,omitempty"`
+	// The reason that the member couldn't be defragmented.
+	Error *string `json:"error,omitempty"`
+}
+
+// TaskResponseBody is used to define fields on response body types.
+type TaskResponseBody struct {
+	// The parent task ID of the task.
+	ParentID *string `json:"parent_id,omitempty"`
+	// The scope of the task (database or host).
+	Scope string `json:"scope"`
+	// The entity ID (database_id or host_id) that this task belongs to.
+	EntityID string `json:"entity_id"`
+	// The database ID of the task.
+	DatabaseID *string `json:"database_id,omitempty"`
+	// The name of the node that the task is operating on.
+	NodeName *string `json:"node_name,omitempty"`
+	// The ID of the instance that the task is operating on.
+	InstanceID *string `json:"instance_id,omitempty"`
+	// The ID of the host that the task is running on.
+	HostID *string `json:"host_id,omitempty"`
+	// The unique ID of the task.
+	TaskID string `json:"task_id"`
+	// The time when the task was created.
+	CreatedAt string `json:"created_at"`
+	// The time when the task was completed.
+	CompletedAt *string `json:"completed_at,omitempty"`
+	// The type of the task.
+	Type string `json:"type"`
+	// The status of the task.
+	Status string `json:"status"`
+	// Additional detail about the task's status. A pending task is 'queued' while
+	// it waits for other tasks to complete.
+	StatusReason *string `json:"status_reason,omitempty"`
+	// The error message if the task failed.
+	Error *string `json:"error,omitempty"`
+}
+
+// DatabaseSummaryResponseBody is used to define fields on response body types.
+type DatabaseSummaryResponseBody struct {
+	// Unique identifier for the database.
+	ID string `json:"id"`
+	// Unique identifier for the database's owner.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The time that the database was created.
+	CreatedAt string `json:"created_at"`
+	// The time that the database was last updated.
+	UpdatedAt string `json:"updated_at"`
+	// Current state of the database.
+	State string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
+	// All of the instances in the database.
+	Instances []*InstanceResponseBody `json:"instances,omitempty"`
+	// Newer stable image versions available in the same Postgres major / Spock
+	// major bucket. Present only when ?include=available_upgrades is set.
+	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+}
+
+// InstanceResponseBody is used to define fields on response body types.
+type InstanceResponseBody struct {
+	// Unique identifier for the instance.
+	ID string `json:"id"`
+	// The ID of the host this instance is running on.
+	HostID string `json:"host_id"`
+	// The Spock node name for this instance.
+	NodeName string `json:"node_name"`
+	// The time that the instance was created.
+	CreatedAt string `json:"created_at"`
+	// The time that the instance was last modified.
+	UpdatedAt string `json:"updated_at"`
+	// The time that the instance status information was last updated.
+	StatusUpdatedAt *string `json:"status_updated_at,omitempty"`
+	State           string  `json:"state"`
+	// Connection information for the instance.
+	ConnectionInfo *InstanceConnectionInfoResponseBody `json:"connection_info,omitempty"`
+	// Postgres status information for the instance.
+	Postgres *InstancePostgresStatusResponseBody `json:"postgres,omitempty"`
+	// Spock status information for the instance.
+	Spock *InstanceSpockStatusResponseBody `json:"spock,omitempty"`
+	// Resource usage information for the instance.
+	Resources *InstanceResourceUsageResponseBody `json:"resources,omitempty"`
+	// An error message if the instance is in an error state.
+	Error *string `json:"error,omitempty"`
+}
+
+// InstanceConnectionInfoResponseBody is used to define fields on response body
+// types.
+type InstanceConnectionInfoResponseBody struct {
+	// The addresses of the host that's running this instance.
+	Addresses []string `json:"addresses,omitempty"`
+	// The host port that Postgres is listening on for this instance.
+	Port *int `json:"port,omitempty"`
+}
+
+// InstancePostgresStatusResponseBody is used to define fields on response body
+// types.
+type InstancePostgresStatusResponseBody struct {
+	// The version of Postgres for this instance.
+	Version      *string `json:"version,omitempty"`
+	PatroniState *string `json:"patroni_state,omitempty"`
+	Role         *string `json:"role,omitempty"`
+	// True if this instance has a pending restart from a configuration change.
+	PendingRestart *bool `json:"pending_restart,omitempty"`
+	// True if Patroni is paused for this instance.
+	PatroniPaused *bool `json:"patroni_paused,omitempty"`
+}
+
+// InstanceSpockStatusResponseBody is used to define fields on response body
+// types.
+type InstanceSpockStatusResponseBody struct {
+	// The current spock.readonly setting.
+	ReadOnly *string `json:"read_only,omitempty"`
+	// The version of Spock for this instance.
+	Version *string `json:"version,omitempty"`
+	// Status information for this instance's Spock subscriptions.
+	Subscriptions []*InstanceSubscriptionResponseBody `json:"subscriptions,omitempty"`
+}
+
+// InstanceSubscriptionResponseBody is used to define fields on response body
+// types.
+type InstanceSubscriptionResponseBody struct {
+	// The Spock node name of the provider for this subscription.
+	ProviderNode string `json:"provider_node"`
+	// The name of the subscription.
+	Name string `json:"name"`
+	// The current status of the subscription.
+	Status string `json:"status"`
+}
+
+// InstanceResourceUsageResponseBody is used to define fields on response body
+// types.
+type InstanceResourceUsageResponseBody struct {
+	// The total size of the instance's data directory in bytes.
+	DataDirectoryBytes *int64 `json:"data_directory_bytes,omitempty"`
+	// The total size of the instance's WAL directory in bytes.
+	WalDirectoryBytes *int64 `json:"wal_directory_bytes,omitempty"`
+	// The free space on the volume that contains the data directory in bytes.
+	VolumeFreeBytes *int64 `json:"volume_free_bytes,omitempty"`
+	// The total size of the volume that contains the data directory in bytes.
+	VolumeTotalBytes *int64 `json:"volume_total_bytes,omitempty"`
+	// The size of the database in bytes, as reported by pg_database_size.
+	DatabaseSizeBytes *int64 `json:"database_size_bytes,omitempty"`
+	// The number of client connections to the instance.
+	Connections *int `json:"connections,omitempty"`
+	// The instance's max_connections setting.
+	MaxConnections *int `json:"max_connections,omitempty"`
+	// The CPU usage of the instance's container or unit as a percentage of a
+	// single CPU.
+	CPUPercent *float64 `json:"cpu_percent,omitempty"`
+	// The memory usage of the instance's container or unit in bytes.
+	MemoryBytes *int64 `json:"memory_bytes,omitempty"`
+	// The memory limit of the instance's container or unit in bytes.
+	MemoryLimitBytes *int64 `json:"memory_limit_bytes,omitempty"`
+	// True if the volume usage exceeds the configured disk-full threshold.
+	DiskFull *bool `json:"disk_full,omitempty"`
+	// The time that the resource usage was collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+}
+
+// AvailableUpgradeResponseBody is used to define fields on response body types.
+type AvailableUpgradeResponseBody struct {
+	// Postgres version of the upgrade candidate.
+	PostgresVersion string `json:"postgres_version"`
+	// Spock major version of the upgrade candidate.
+	SpockVersion string `json:"spock_version"`
+	// Full container image reference for the upgrade candidate.
+	Image string `json:"image"`
+}
+
+// DatabaseResponseBody is used to define fields on response body types.
+type DatabaseResponseBody struct {
+	// Unique identifier for the database.
+	ID string `json:"id"`
+	// Unique identifier for the database's owner.
+	TenantID *string `json:"tenant_id,omitempty"`
+	// The time that the database was created.
+	CreatedAt string `json:"created_at"`
+	// The time that the database was last updated.
+	UpdatedAt string `json:"updated_at"`
+	// Current state of the database.
+	State string `json:"state"`
+	// Conditions that degrade the database, such as instances that are running out
+	// of disk space. An available database is reported as degraded while any are
+	// present, but it can still be modified.
+	DegradedReasons []string `json:"degraded_reasons,omitempty"`
+	// All of the instances in the database.
+	Instances []*InstanceResponseBody `json:"instances,omitempty"`
+	// Service instances running alongside this database.
+	ServiceInstances []*ServiceInstanceResponseBody `json:"service_instances,omitempty"`
+	// The user-provided specification for the database.
+	Spec *DatabaseSpecResponseBody `json:"spec,omitempty"`
+	// Newer stable image versions available in the same Postgres major / Spock
+	// major bucket. Present only when ?include=available_upgrades is set.
+	AvailableUpgrades []*AvailableUpgradeResponseBody `json:"available_upgrades,omitempty"`
+	// The result of the most recent drift check. Absent if the database has not
+	// been checked.
+	Drift *DatabaseDriftResponseBody `json:"drift,omitempty"`
+}
+
+// ServiceInstanceResponseBody is used to define fields on response body types.
+type ServiceInstanceResponseBody struct {
+	// Unique identifier for the service instance.
+	ServiceInstanceID string `json:"service_instance_id"`
+	// The service ID from the DatabaseSpec.
+	ServiceID string `json:"service_id"`
+	// The ID of the database this service belongs to.
+	DatabaseID string `json:"database_id"`
+	// The ID of the host this service instance is running on.
+	HostID string `json:"host_id"`
+	// Current state of the service instance.
+	State string `json:"state"`
+	// Runtime status information for the service instance.
+	Status *ServiceInstanceStatusResponseBody `json:"status,omitempty"`
+	// The time that the service instance was created.
+	CreatedAt string `json:"created_at"`
+	// The time that the service instance was last updated.
+	UpdatedAt string `json:"updated_at"`
+	// An error message if the service instance is in an error state.
+	Error *string `json:"error,omitempty"`
+}
+
+// ServiceInstanceStatusResponseBody is used to define fields on response body
+// types.
+type ServiceInstanceStatusResponseBody struct {
+	// The Docker container ID.
+	ContainerID *string `json:"container_id,omitempty"`
+	// The container image version currently running.
+	ImageVersion *string `json:"image_version,omitempty"`
+	// The addresses of the host that's running this service instance.
+	Addresses []string `json:"addresses,omitempty"`
+	// Port mappings for this service instance.
+	Ports []*PortMappingResponseBody `json:"ports,omitempty"`
+	// Most recent health check result.
+	HealthCheck *HealthCheckResultResponseBody `json:"health_check,omitempty"`
+	// The time of the last health check attempt.
+	LastHealthAt *string `json:"last_health_at,omitempty"`
+	// Whether the service is ready to accept requests.
+	ServiceReady *bool `json:"service_ready,omitempty"`
+}
+
+// PortMappingResponseBody is used to define fields on response body types.
+type PortMappingResponseBody struct {
+	// The name of the port (e.g., 'http', 'web-client').
+	Name string `json:"name"`
+	// The port number inside the container.
+	ContainerPort *int `json:"container_port,omitempty"`
+	// The port number on the host (if port-forwarded).
+	HostPort *int `json:"host_port,omitempty"`
+}
+
+// HealthCheckResultResponseBody is used to define fields on response body
+// types.
+type HealthCheckResultResponseBody struct {
+	// The health status.
+	Status string `json:"status"`
+	// Optional message about the health status.
+	Message *string `json:"message,omitempty"`
+	// The time this health check was performed.
+	CheckedAt string `json:"checked_at"`
+}
+
+// DatabaseSpecResponseBody is used to define fields on response body types.
+type DatabaseSpecResponseBody struct {
+	// The name of the Postgres database.
+	DatabaseName string `json:"database_name"`
+	// The Postgres version in 'major.minor' format.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The major version of the Spock extension.
+	SpockVersion *string `json:"spock_version,omitempty"`
+	// The port used by the Postgres database. If the port is 0, each instance will
+	// be assigned a random port. If the port is unspecified, the database will not
+	// be exposed on any port, dependent on orchestrator support for that feature.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this database. If the port is 0, each instance
+	// will be assigned a random port. NOTE: This field is not currently supported
+	// for Docker Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database and to use for tuning
+	// Postgres. Defaults to the number of available CPUs on the host. Can include
+	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
+	// depends on the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database and
+	// to use for tuning Postgres. Defaults to the total available memory on the
+	// host. Whether this limit is enforced depends on the orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// The Spock nodes for this database.
+	Nodes []*DatabaseNodeSpecResponseBody `json:"nodes"`
+	// The users to create for this database.
+	DatabaseUsers []*DatabaseUserSpecResponseBody `json:"database_users,omitempty"`
+	// Service instances to run alongside the database (e.g., MCP servers).
+	Services []*ServiceSpecResponseBody `json:"services,omitempty"`
+	// The backup configuration for this database.
+	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
+	// The restore configuration for this database.
+	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
+	// Additional postgresql.conf settings. Will be merged with the settings
+	// provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries, one rule per array element. Inserted between
+	// control-plane's system-user rules and its catch-all, so they cannot affect
+	// control-plane-internal connectivity (Patroni, replication, health checks).
+	// Node-level pg_hba_conf entries are prepended to these.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries, one mapping per array element. Purely
+	// additive; control-plane writes no pg_ident entries of its own. The primary
+	// use case is cert auth with map= translating certificate CNs to PostgreSQL
+	// usernames.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// User-defined SQL scripts that run at different points during the database
+	// creation process. Once a database has been successfully created, changes to
+	// these scripts will have no effect.
+	Scripts *DatabaseScriptsResponseBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsResponseBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsResponseBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
+}
+
+// DatabaseNodeSpecResponseBody is used to define fields on response body types.
+type DatabaseNodeSpecResponseBody struct {
+	// The name of the database node.
+	Name string `json:"name"`
+	// The IDs of the hosts that should run this node. When multiple hosts are
+	// specified, one host will chosen as a primary, and the others will be read
+	// replicas.
+	HostIds []string `json:"host_ids"`
+	// The Postgres version for this node in 'major.minor' format. Overrides the
+	// Postgres version set in the DatabaseSpec.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The port used by the Postgres database for this node. Overrides the Postgres
+	// port set in the DatabaseSpec.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this node. Overrides the Patroni port set in
+	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
+	// Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database on this node and to use for
+	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
+	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
+	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
+	// than are available on the host. Whether this limit is enforced depends on
+	// the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database on
+	// this node and to use for tuning Postgres. Defaults to the total available
+	// memory on the host. Whether this limit is enforced depends on the
+	// orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// Additional postgresql.conf settings for this particular node. Will be merged
+	// with the settings provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries for this particular node, one rule per array
+	// element. Prepended to the database-level pg_hba_conf entries, so node
+	// entries take first-match priority. Entries are inserted between
+	// control-plane's system-user rules and its catch-all, and cannot affect
+	// control-plane-internal connectivity.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries for this particular node, one mapping per
+	// array element. Prepended to the database-level pg_ident_conf entries.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// The backup configuration for this node. Overrides the backup configuration
+	// set in the DatabaseSpec.
+	BackupConfig *BackupConfigSpecResponseBody `json:"backup_config,omitempty"`
+	// The restore configuration for this node. Overrides the restore configuration
+	// set in the DatabaseSpec.
+	RestoreConfig *RestoreConfigSpecResponseBody `json:"restore_config,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// The name of the source node to use for sync. This is typically the node
+	// (like 'n1') from which the data will be copied to initialize this new node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsResponseBody `json:"patroni,omitempty"`
+}
+
+// BackupConfigSpecResponseBody is used to define fields on response body types.
+type BackupConfigSpecResponseBody struct {
+	// The repositories for this backup configuration.
+	Repositories []*BackupRepositorySpecResponseBody `json:"repositories"`
+	// The schedules for this backup configuration.
+	Schedules []*BackupScheduleSpecResponseBody `json:"schedules,omitempty"`
+}
+
+// BackupRepositorySpecResponseBody is used to define fields on response body
+// types.
+type BackupRepositorySpecResponseBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain. This field will
+	// be excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile. This field will be
+	// excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// The Azure storage account access key to use for this repository. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The count of full backups to retain or the time to retain full backups.
+	RetentionFull *int `json:"retention_full,omitempty"`
+	// The type of measure used for retention_full.
+	RetentionFullType *string `json:"retention_full_type,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// BackupScheduleSpecResponseBody is used to define fields on response body
+// types.
+type BackupScheduleSpecResponseBody struct {
+	// The unique identifier for this backup schedule.
+	ID string `json:"id"`
+	// The type of backup to take on this schedule.
+	Type string `json:"type"`
+	// The cron expression for this schedule.
+	CronExpression string `json:"cron_expression"`
+}
+
+// RestoreConfigSpecResponseBody is used to define fields on response body
+// types.
+type RestoreConfigSpecResponseBody struct {
+	// The ID of the database to restore this database from.
+	SourceDatabaseID string `json:"source_database_id"`
+	// The name of the node to restore this database from.
+	SourceNodeName string `json:"source_node_name"`
+	// The name of the database in this repository. The database will be renamed to
+	// the database_name in the DatabaseSpec after it's restored.
+	SourceDatabaseName string `json:"source_database_name"`
+	// The repository to restore this database from.
+	Repository *RestoreRepositorySpecResponseBody `json:"repository"`
+	// Additional options to use when restoring this database. If omitted, the
+	// database will be restored to the latest point in the given repository.
+	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+}
+
+// RestoreRepositorySpecResponseBody is used to define fields on response body
+// types.
+type RestoreRepositorySpecResponseBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// An optional Azure storage account access key to use for this repository. If
+	// not provided, pgbackrest will use the VM's managed identity.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// OrchestratorOptsResponseBody is used to define fields on response body types.
+type OrchestratorOptsResponseBody struct {
+	// Swarm-specific configuration.
+	Swarm *SwarmOptsResponseBody `json:"swarm,omitempty"`
+}
+
+// SwarmOptsResponseBody is used to define fields on response body types.
+type SwarmOptsResponseBody struct {
+	// A list of extra volumes to mount. Each entry defines a host and container
+	// path.
+	ExtraVolumes []*ExtraVolumesSpecResponseBody `json:"extra_volumes,omitempty"`
+	// A list of additional Docker Swarm networks to attach containers in this
+	// database to.
+	ExtraNetworks []*ExtraNetworkSpecResponseBody `json:"extra_networks,omitempty"`
+	// Arbitrary labels to apply to the Docker Swarm service
+	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
+	// User-specified container image override. Bypasses manifest version
+	// constraints entirely — the CP will deploy this image without validating it
+	// against the version manifest. The CP verifies the image exists in its
+	// registry before accepting the spec. Clearing this field causes the CP to
+	// fall back to the manifest-resolved image on the next reconcile.
+	Image *string `json:"image,omitempty"`
+}
+
+// ExtraVolumesSpecResponseBody is used to define fields on response body types.
+type ExtraVolumesSpecResponseBody struct {
+	// The host path for the volume.
+	HostPath string `json:"host_path"`
+	// The path inside the container where the volume will be mounted.
+	DestinationPath string `json:"destination_path"`
+}
+
+// ExtraNetworkSpecResponseBody is used to define fields on response body types.
+type ExtraNetworkSpecResponseBody struct {
+	// The name or ID of the network to connect to.
+	ID string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsResponseBody is used to define fields on response body types.
+type PatroniSettingsResponseBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogResponseBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogResponseBody is used to define fields on response body types.
+type PatroniWatchdogResponseBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecResponseBody is used to define fields on response body types.
+type DatabaseUserSpecResponseBody struct {
+	// The username for this database user.
+	Username string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecResponseBody is used to define fields on response body types.
+type ServiceSpecResponseBody struct {
+	// The unique identifier for this service.
+	ServiceID string `json:"service_id"`
+	// The type of service to run.
+	ServiceType string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsResponseBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionResponseBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs string `json:"connect_as"`
+}
+
+// DatabaseConnectionResponseBody is used to define fields on response body
+// types.
+type DatabaseConnectionResponseBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsResponseBody is used to define fields on response body types.
+type DatabaseScriptsResponseBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsResponseBody is used to define fields on response body
+// types.
+type QueryStatsSettingsResponseBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// DatabaseDriftResponseBody is used to define fields on response body types.
+type DatabaseDriftResponseBody struct {
+	// The time that the database was checked.
+	CheckedAt string `json:"checked_at"`
+	// The resources that no longer match the database's spec.
+	Resources []*DriftedResourceResponseBody `json:"resources"`
+	// The error that prevented the check from completing, if any.
+	Error *string `json:"error,omitempty"`
+	// The ID of the update task that was started to remediate the drift, if any.
+	RemediationTaskID *string `json:"remediation_task_id,omitempty"`
+}
+
+// DriftedResourceResponseBody is used to define fields on response body types.
+type DriftedResourceResponseBody struct {
+	// The type and ID of the resource.
+	ResourceID string `json:"resource_id"`
+	// The change that an update would make to the resource.
+	Type string `json:"type"`
+	// Why the resource would be changed.
+	Reason *string `json:"reason,omitempty"`
+	// The JSON Patch operations that would bring the resource in line with the
+	// spec. Only present for updates.
+	Diff []map[string]any `json:"diff,omitempty"`
+}
+
+// PostgreSQLConfChangeResponseBody is used to define fields on response body
+// types.
+type PostgreSQLConfChangeResponseBody struct {
+	// The ID of the instance whose setting will change.
+	InstanceID string `json:"instance_id"`
+	// The name of the setting.
+	Name string `json:"name"`
+	// The current value of the setting, if it's currently set.
+	OldValue *string `json:"old_value,omitempty"`
+	// The new value of the setting, if it will still be set.
+	NewValue *string `json:"new_value,omitempty"`
+	// True if the instance must be restarted for this change to take effect.
+	RequiresRestart bool `json:"requires_restart"`
+}
+
+// InstanceQueryStatsResponseBody is used to define fields on response body
+// types.
+type InstanceQueryStatsResponseBody struct {
+	// The ID of the instance.
+	InstanceID string `json:"instance_id"`
+	// The time that the statistics were collected.
+	CollectedAt *string `json:"collected_at,omitempty"`
+	// The time of the oldest retained collection, which is the start of the window
+	// for the window_* fields.
+	WindowStart *string `json:"window_start,omitempty"`
+	// The collected statements.
+	Queries []*QueryStatResponseBody `json:"queries"`
+}
+
+// QueryStatResponseBody is used to define fields on response body types.
+type QueryStatResponseBody struct {
+	// The statement's query ID.
+	QueryID int64 `json:"query_id"`
+	// The normalized text of the statement, truncated to 1024 characters.
+	Query string `json:"query"`
+	// The number of times the statement has been executed.
+	Calls int64 `json:"calls"`
+	// The total time spent executing the statement, in milliseconds.
+	TotalTimeMs float64 `json:"total_time_ms"`
+	// The mean time spent executing the statement, in milliseconds.
+	MeanTimeMs float64 `json:"mean_time_ms"`
+	// The total number of rows retrieved or affected by the statement.
+	Rows int64 `json:"rows"`
+	// The number of calls since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowCalls *int64 `json:"window_calls,omitempty"`
+	// The execution time since the start of the window, in milliseconds. Absent if
+	// the statement was not collected at the start of the window.
+	WindowTotalTimeMs *float64 `json:"window_total_time_ms,omitempty"`
+	// The number of rows since the start of the window. Absent if the statement
+	// was not collected at the start of the window.
+	WindowRows *int64 `json:"window_rows,omitempty"`
+}
+
+// TaskLogEntryResponseBody is used to define fields on response body types.
+type TaskLogEntryResponseBody struct {
+	// The timestamp of the log entry.
+	Timestamp string `json:"timestamp"`
+	// The log message.
+	Message string `json:"message"`
+	// Additional fields for the log entry.
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// WebhookResponseBody is used to define fields on response body types.
+type WebhookResponseBody struct {
+	// Unique identifier for this webhook.
+	ID string `json:"id"`
+	// The URL that events are sent to.
+	URL string `json:"url"`
+	// The description of this webhook.
+	Description *string `json:"description,omitempty"`
+	// The secret used to sign each payload. Only set in the create-webhook
+	// response.
+	Secret *string `json:"secret,omitempty"`
+	// The event types that are delivered to this webhook. All event types are
+	// delivered when this is empty.
+	EventTypes []string `json:"event_types,omitempty"`
+	// The databases whose events are delivered to this webhook. Events for all
+	// databases are delivered when this is empty.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+	// The time that this webhook was created.
+	CreatedAt string `json:"created_at"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Unique identifier for this delivery. Sent in the X-Control-Plane-Delivery
+	// header and the payload's delivery_id field.
+	DeliveryID string `json:"delivery_id"`
+	// The webhook that this delivery was sent to.
+	WebhookID string `json:"webhook_id"`
+	// The type of event that was delivered.
+	EventType string `json:"event_type"`
+	// The status of this delivery. Pending deliveries are waiting for their first
+	// attempt or for a retry. Failed deliveries have used all of their attempts.
+	Status string `json:"status"`
+	// The number of attempts made so far.
+	Attempts int `json:"attempts"`
+	// The HTTP status code from the most recent attempt. Omitted if no response
+	// was received.
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+	// The error from the most recent attempt, if it failed.
+	LastError *string `json:"last_error,omitempty"`
+	// When the next attempt will be made. Only set for pending deliveries.
+	NextAttemptAt *string `json:"next_attempt_at,omitempty"`
+	// The time that the event occurred.
+	CreatedAt string `json:"created_at"`
+	// The time of the most recent attempt.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// InstanceSessionResponseBody is used to define fields on response body types.
+type InstanceSessionResponseBody struct {
+	// The process ID of the session.
+	Pid int `json:"pid"`
+	// The user that the session is logged in as.
+	Username *string `json:"username,omitempty"`
+	// The database that the session is connected to.
+	DatabaseName *string `json:"database_name,omitempty"`
+	// The application name that the client provided.
+	ApplicationName string `json:"application_name"`
+	// The address of the client.
+	ClientAddr *string `json:"client_addr,omitempty"`
+	// The state of the session, such as active or idle in transaction.
+	State *string `json:"state,omitempty"`
+	// The type of event that the session is waiting for.
+	WaitEventType *string `json:"wait_event_type,omitempty"`
+	// The event that the session is waiting for.
+	WaitEvent *string `json:"wait_event,omitempty"`
+	// The time that the session connected.
+	BackendStart *string `json:"backend_start,omitempty"`
+	// The time that the session's current transaction started.
+	XactStart *string `json:"xact_start,omitempty"`
+	// The time that the session's current or most recent query started.
+	QueryStart *string `json:"query_start,omitempty"`
+	// The time that the session's state last changed.
+	StateChange *string `json:"state_change,omitempty"`
+	// The session's current or most recent query, truncated to 1024 characters.
+	Query string `json:"query"`
+	// The process IDs of the sessions that are blocking this session.
+	BlockedBy []int `json:"blocked_by,omitempty"`
+	// The locks that this session holds or is waiting for.
+	Locks []*SessionLockResponseBody `json:"locks,omitempty"`
+}
+
+// SessionLockResponseBody is used to define fields on response body types.
+type SessionLockResponseBody struct {
+	// The type of the locked object.
+	LockType string `json:"lock_type"`
+	// The name of the locked relation. Only set for relations in the instance's
+	// database.
+	Relation *string `json:"relation,omitempty"`
+	// The lock mode.
+	Mode string `json:"mode"`
+	// True if the lock is held, false if the session is waiting for it.
+	Granted bool `json:"granted"`
+}
+
+// PostgreSQLSettingResponseBody is used to define fields on response body
+// types.
+type PostgreSQLSettingResponseBody struct {
+	// The name of the setting.
+	Name string `json:"name"`
+	// The value of the setting.
+	Value string `json:"value"`
+	// Where the value comes from. Tunable settings are derived from the instance's
+	// memory, CPUs, and cluster size.
+	Source string `json:"source"`
+	// True if the instance must be restarted for this setting to take effect.
+	PendingRestart *bool `json:"pending_restart,omitempty"`
+}
+
+// DatabaseSpecRequestBody is used to define fields on request body types.
+type DatabaseSpecRequestBody struct {
+	// The name of the Postgres database.
+	DatabaseName *string `json:"database_name"`
+	// The Postgres version in 'major.minor' format.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The major version of the Spock extension.
+	SpockVersion *string `json:"spock_version,omitempty"`
+	// The port used by the Postgres database. If the port is 0, each instance will
+	// be assigned a random port. If the port is unspecified, the database will not
+	// be exposed on any port, dependent on orchestrator support for that feature.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this database. If the port is 0, each instance
+	// will be assigned a random port. NOTE: This field is not currently supported
+	// for Docker Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database and to use for tuning
+	// Postgres. Defaults to the number of available CPUs on the host. Can include
+	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
+	// depends on the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database and
+	// to use for tuning Postgres. Defaults to the total available memory on the
+	// host. Whether this limit is enforced depends on the orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// The Spock nodes for this database.
+	Nodes []*DatabaseNodeSpecRequestBody `json:"nodes"`
+	// The users to create for this database.
+	DatabaseUsers []*DatabaseUserSpecRequestBody `json:"database_users,omitempty"`
+	// Service instances to run alongside the database (e.g., MCP servers).
+	Services []*ServiceSpecRequestBody `json:"services,omitempty"`
+	// The backup configuration for this database.
+	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this database.
+	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
+	// Additional postgresql.conf settings. Will be merged with the settings
+	// provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries, one rule per array element. Inserted between
+	// control-plane's system-user rules and its catch-all, so they cannot affect
+	// control-plane-internal connectivity (Patroni, replication, health checks).
+	// Node-level pg_hba_conf entries are prepended to these.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries, one mapping per array element. Purely
+	// additive; control-plane writes no pg_ident entries of its own. The primary
+	// use case is cert auth with map= translating certificate CNs to PostgreSQL
+	// usernames.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
+	// User-defined SQL scripts that run at different points during the database
+	// creation process. Once a database has been successfully created, changes to
+	// these scripts will have no effect.
+	Scripts *DatabaseScriptsRequestBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsRequestBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsRequestBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
+}
+
+// DatabaseNodeSpecRequestBody is used to define fields on request body types.
+type DatabaseNodeSpecRequestBody struct {
+	// The name of the database node.
+	Name *string `json:"name"`
+	// The IDs of the hosts that should run this node. When multiple hosts are
+	// specified, one host will chosen as a primary, and the others will be read
+	// replicas.
+	HostIds []string `json:"host_ids"`
+	// The Postgres version for this node in 'major.minor' format. Overrides the
+	// Postgres version set in the DatabaseSpec.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The port used by the Postgres database for this node. Overrides the Postgres
+	// port set in the DatabaseSpec.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this node. Overrides the Patroni port set in
+	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
+	// Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database on this node and to use for
+	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
+	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
+	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
+	// than are available on the host. Whether this limit is enforced depends on
+	// the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database on
+	// this node and to use for tuning Postgres. Defaults to the total available
+	// memory on the host. Whether this limit is enforced depends on the
+	// orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// Additional postgresql.conf settings for this particular node. Will be merged
+	// with the settings provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries for this particular node, one rule per array
+	// element. Prepended to the database-level pg_hba_conf entries, so node
+	// entries take first-match priority. Entries are inserted between
+	// control-plane's system-user rules and its catch-all, and cannot affect
+	// control-plane-internal connectivity.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries for this particular node, one mapping per
+	// array element. Prepended to the database-level pg_ident_conf entries.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// The backup configuration for this node. Overrides the backup configuration
+	// set in the DatabaseSpec.
+	BackupConfig *BackupConfigSpecRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this node. Overrides the restore configuration
+	// set in the DatabaseSpec.
+	RestoreConfig *RestoreConfigSpecRequestBody `json:"restore_config,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
+	// The name of the source node to use for sync. This is typically the node
+	// (like 'n1') from which the data will be copied to initialize this new node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsRequestBody `json:"patroni,omitempty"`
+}
+
+// BackupConfigSpecRequestBody is used to define fields on request body types.
+type BackupConfigSpecRequestBody struct {
+	// The repositories for this backup configuration.
+	Repositories []*BackupRepositorySpecRequestBody `json:"repositories"`
+	// The schedules for this backup configuration.
+	Schedules []*BackupScheduleSpecRequestBody `json:"schedules,omitempty"`
+}
+
+// BackupRepositorySpecRequestBody is used to define fields on request body
+// types.
+type BackupRepositorySpecRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain. This field will
+	// be excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile. This field will be
+	// excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// The Azure storage account access key to use for this repository. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The count of full backups to retain or the time to retain full backups.
+	RetentionFull *int `json:"retention_full,omitempty"`
+	// The type of measure used for retention_full.
+	RetentionFullType *string `json:"retention_full_type,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// BackupScheduleSpecRequestBody is used to define fields on request body types.
+type BackupScheduleSpecRequestBody struct {
+	// The unique identifier for this backup schedule.
+	ID *string `json:"id"`
+	// The type of backup to take on this schedule.
+	Type *string `json:"type"`
+	// The cron expression for this schedule.
+	CronExpression *string `json:"cron_expression"`
+}
+
+// RestoreConfigSpecRequestBody is used to define fields on request body types.
+type RestoreConfigSpecRequestBody struct {
+	// The ID of the database to restore this database from.
+	SourceDatabaseID *string `json:"source_database_id"`
+	// The name of the node to restore this database from.
+	SourceNodeName *string `json:"source_node_name"`
+	// The name of the database in this repository. The database will be renamed to
+	// the database_name in the DatabaseSpec after it's restored.
+	SourceDatabaseName *string `json:"source_database_name"`
+	// The repository to restore this database from.
+	Repository *RestoreRepositorySpecRequestBody `json:"repository"`
+	// Additional options to use when restoring this database. If omitted, the
+	// database will be restored to the latest point in the given repository.
+	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+}
+
+// RestoreRepositorySpecRequestBody is used to define fields on request body
+// types.
+type RestoreRepositorySpecRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// An optional Azure storage account access key to use for this repository. If
+	// not provided, pgbackrest will use the VM's managed identity.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// OrchestratorOptsRequestBody is used to define fields on request body types.
+type OrchestratorOptsRequestBody struct {
+	// Swarm-specific configuration.
+	Swarm *SwarmOptsRequestBody `json:"swarm,omitempty"`
+}
+
+// SwarmOptsRequestBody is used to define fields on request body types.
+type SwarmOptsRequestBody struct {
+	// A list of extra volumes to mount. Each entry defines a host and container
+	// path.
+	ExtraVolumes []*ExtraVolumesSpecRequestBody `json:"extra_volumes,omitempty"`
+	// A list of additional Docker Swarm networks to attach containers in this
+	// database to.
+	ExtraNetworks []*ExtraNetworkSpecRequestBody `json:"extra_networks,omitempty"`
+	// Arbitrary labels to apply to the Docker Swarm service
+	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
+	// User-specified container image override. Bypasses manifest version
+	// constraints entirely — the CP will deploy this image without validating it
+	// against the version manifest. The CP verifies the image exists in its
+	// registry before accepting the spec. Clearing this field causes the CP to
+	// fall back to the manifest-resolved image on the next reconcile.
+	Image *string `json:"image,omitempty"`
+}
+
+// ExtraVolumesSpecRequestBody is used to define fields on request body types.
+type ExtraVolumesSpecRequestBody struct {
+	// The host path for the volume.
+	HostPath *string `json:"host_path"`
+	// The path inside the container where the volume will be mounted.
+	DestinationPath *string `json:"destination_path"`
+}
+
+// ExtraNetworkSpecRequestBody is used to define fields on request body types.
+type ExtraNetworkSpecRequestBody struct {
+	// The name or ID of the network to connect to.
+	ID *string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsRequestBody is used to define fields on request body types.
+type PatroniSettingsRequestBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogRequestBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogRequestBody is used to define fields on request body types.
+type PatroniWatchdogRequestBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode *string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecRequestBody is used to define fields on request body types.
+type DatabaseUserSpecRequestBody struct {
+	// The username for this database user.
+	Username *string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecRequestBody is used to define fields on request body types.
+type ServiceSpecRequestBody struct {
+	// The unique identifier for this service.
+	ServiceID *string `json:"service_id"`
+	// The type of service to run.
+	ServiceType *string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version *string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsRequestBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionRequestBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs *string `json:"connect_as"`
+}
+
+// DatabaseConnectionRequestBody is used to define fields on request body types.
+type DatabaseConnectionRequestBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsRequestBody is used to define fields on request body types.
+type DatabaseScriptsRequestBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsRequestBody is used to define fields on request body types.
+type QueryStatsSettingsRequestBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled *bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// ImportSourceSpecRequestBody is used to define fields on request body types.
+type ImportSourceSpecRequestBody struct {
+	// The connection string for the source database. It must be reachable from the
+	// Control Plane hosts and from the database's instances.
+	Dsn *string `json:"dsn"`
+}
+
+// DatabaseSpecRequestBodyRequestBody is used to define fields on request body
+// types.
+type DatabaseSpecRequestBodyRequestBody struct {
+	// The name of the Postgres database.
+	DatabaseName *string `json:"database_name"`
+	// The Postgres version in 'major.minor' format.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The major version of the Spock extension.
+	SpockVersion *string `json:"spock_version,omitempty"`
+	// The port used by the Postgres database. If the port is 0, each instance will
+	// be assigned a random port. If the port is unspecified, the database will not
+	// be exposed on any port, dependent on orchestrator support for that feature.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this database. If the port is 0, each instance
+	// will be assigned a random port. NOTE: This field is not currently supported
+	// for Docker Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database and to use for tuning
+	// Postgres. Defaults to the number of available CPUs on the host. Can include
+	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
+	// depends on the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database and
+	// to use for tuning Postgres. Defaults to the total available memory on the
+	// host. Whether this limit is enforced depends on the orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// The Spock nodes for this database.
+	Nodes []*DatabaseNodeSpecRequestBodyRequestBody `json:"nodes"`
+	// The users to create for this database.
+	DatabaseUsers []*DatabaseUserSpecRequestBodyRequestBody `json:"database_users,omitempty"`
+	// Service instances to run alongside the database (e.g., MCP servers).
+	Services []*ServiceSpecRequestBodyRequestBody `json:"services,omitempty"`
+	// The backup configuration for this database.
+	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this database.
+	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	// Additional postgresql.conf settings. Will be merged with the settings
+	// provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries, one rule per array element. Inserted between
+	// control-plane's system-user rules and its catch-all, so they cannot affect
+	// control-plane-internal connectivity (Patroni, replication, health checks).
+	// Node-level pg_hba_conf entries are prepended to these.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries, one mapping per array element. Purely
+	// additive; control-plane writes no pg_ident entries of its own. The primary
+	// use case is cert auth with map= translating certificate CNs to PostgreSQL
+	// usernames.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// User-defined SQL scripts that run at different points during the database
+	// creation process. Once a database has been successfully created, changes to
+	// these scripts will have no effect.
+	Scripts *DatabaseScriptsRequestBodyRequestBody `json:"scripts,omitempty"`
+	// Patroni failover and fencing settings for every node in this database.
+	// Changes are applied without restarting Postgres.
+	Patroni *PatroniSettingsRequestBodyRequestBody `json:"patroni,omitempty"`
+	// Query stats collection settings for every node in this database.
+	QueryStats *QueryStatsSettingsRequestBodyRequestBody `json:"query_stats,omitempty"`
+	// What to do when a drift check finds that this database's resources no longer
+	// match its spec. 'report' records the drift on the database. 'remediate' also
+	// starts an update task to correct the drift. Defaults to 'report'. Drift
+	// checks only run when drift detection is enabled in the server configuration.
+	DriftPolicy *string `json:"drift_policy,omitempty"`
+	// The etcd cluster that Patroni uses as its distributed configuration store.
+	// 'control_plane' uses the Control Plane's etcd. 'external' uses the external
+	// etcd cluster from the patroni_etcd server configuration. Defaults to
+	// 'external' when patroni_etcd.default is set and to 'control_plane'
+	// otherwise. Changing this for an existing database copies each node's Patroni
+	// state to the new cluster, and the change takes effect when the node's
+	// instances are restarted.
+	PatroniDcs *string `json:"patroni_dcs,omitempty"`
+}
+
+// DatabaseNodeSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseNodeSpecRequestBodyRequestBody struct {
+	// The name of the database node.
+	Name *string `json:"name"`
+	// The IDs of the hosts that should run this node. When multiple hosts are
+	// specified, one host will chosen as a primary, and the others will be read
+	// replicas.
+	HostIds []string `json:"host_ids"`
+	// The Postgres version for this node in 'major.minor' format. Overrides the
+	// Postgres version set in the DatabaseSpec.
+	PostgresVersion *string `json:"postgres_version,omitempty"`
+	// The port used by the Postgres database for this node. Overrides the Postgres
+	// port set in the DatabaseSpec.
+	Port *int `json:"port,omitempty"`
+	// The port used by Patroni for this node. Overrides the Patroni port set in
+	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
+	// Swarm.
+	PatroniPort *int `json:"patroni_port,omitempty"`
+	// The number of CPUs to allocate for the database on this node and to use for
+	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
+	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
+	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
+	// than are available on the host. Whether this limit is enforced depends on
+	// the orchestrator.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for the database on
+	// this node and to use for tuning Postgres. Defaults to the total available
+	// memory on the host. Whether this limit is enforced depends on the
+	// orchestrator.
+	Memory *string `json:"memory,omitempty"`
+	// Additional postgresql.conf settings for this particular node. Will be merged
+	// with the settings provided by control-plane.
+	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
+	// Additional pg_hba.conf entries for this particular node, one rule per array
+	// element. Prepended to the database-level pg_hba_conf entries, so node
+	// entries take first-match priority. Entries are inserted between
+	// control-plane's system-user rules and its catch-all, and cannot affect
+	// control-plane-internal connectivity.
+	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
+	// Additional pg_ident.conf entries for this particular node, one mapping per
+	// array element. Prepended to the database-level pg_ident_conf entries.
+	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
+	// The backup configuration for this node. Overrides the backup configuration
+	// set in the DatabaseSpec.
+	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
+	// The restore configuration for this node. Overrides the restore configuration
+	// set in the DatabaseSpec.
+	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
+	// Orchestrator-specific configuration options.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// The name of the source node to use for sync. This is typically the node
+	// (like 'n1') from which the data will be copied to initialize this new node.
+	SourceNode *string `json:"source_node,omitempty"`
+	// Patroni failover and fencing settings for this node. Each setting overrides
+	// the corresponding setting in the DatabaseSpec.
+	Patroni *PatroniSettingsRequestBodyRequestBody `json:"patroni,omitempty"`
+}
+
+// BackupConfigSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type BackupConfigSpecRequestBodyRequestBody struct {
+	// The repositories for this backup configuration.
+	Repositories []*BackupRepositorySpecRequestBodyRequestBody `json:"repositories"`
+	// The schedules for this backup configuration.
+	Schedules []*BackupScheduleSpecRequestBodyRequestBody `json:"schedules,omitempty"`
+}
+
+// BackupRepositorySpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type BackupRepositorySpecRequestBodyRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain. This field will
+	// be excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile. This field will be
+	// excluded from the response of all endpoints. It can also be omitted from
+	// update requests to keep the current value.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// The Azure storage account access key to use for this repository. This field
+	// will be excluded from the response of all endpoints. It can also be omitted
+	// from update requests to keep the current value.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The count of full backups to retain or the time to retain full backups.
+	RetentionFull *int `json:"retention_full,omitempty"`
+	// The type of measure used for retention_full.
+	RetentionFullType *string `json:"retention_full_type,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// BackupScheduleSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type BackupScheduleSpecRequestBodyRequestBody struct {
+	// The unique identifier for this backup schedule.
+	ID *string `json:"id"`
+	// The type of backup to take on this schedule.
+	Type *string `json:"type"`
+	// The cron expression for this schedule.
+	CronExpression *string `json:"cron_expression"`
+}
+
+// RestoreConfigSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type RestoreConfigSpecRequestBodyRequestBody struct {
+	// The ID of the database to restore this database from.
+	SourceDatabaseID *string `json:"source_database_id"`
+	// The name of the node to restore this database from.
+	SourceNodeName *string `json:"source_node_name"`
+	// The name of the database in this repository. The database will be renamed to
+	// the database_name in the DatabaseSpec after it's restored.
+	SourceDatabaseName *string `json:"source_database_name"`
+	// The repository to restore this database from.
+	Repository *RestoreRepositorySpecRequestBodyRequestBody `json:"repository"`
+	// Additional options to use when restoring this database. If omitted, the
+	// database will be restored to the latest point in the given repository.
+	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+}
+
+// RestoreRepositorySpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type RestoreRepositorySpecRequestBodyRequestBody struct {
+	// The unique identifier of this repository.
+	ID *string `json:"id,omitempty"`
+	// The type of this repository.
+	Type *string `json:"type"`
+	// The S3 bucket name for this repository. Only applies when type = 's3'.
+	S3Bucket *string `json:"s3_bucket,omitempty"`
+	// The region of the S3 bucket for this repository. Only applies when type =
+	// 's3'.
+	S3Region *string `json:"s3_region,omitempty"`
+	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
+	S3Endpoint *string `json:"s3_endpoint,omitempty"`
+	// An optional AWS access key ID to use for this repository. If not provided,
+	// pgbackrest will use the default credential provider chain.
+	S3Key *string `json:"s3_key,omitempty"`
+	// The corresponding secret for the AWS access key ID in s3_key.
+	S3KeySecret *string `json:"s3_key_secret,omitempty"`
+	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
+	GcsBucket *string `json:"gcs_bucket,omitempty"`
+	// The optional GCS endpoint for this repository. Only applies when type =
+	// 'gcs'.
+	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
+	// Optional base64-encoded private key data. If omitted, pgbackrest will use
+	// the service account attached to the instance profile.
+	GcsKey *string `json:"gcs_key,omitempty"`
+	// The Azure account name for this repository. Only applies when type = 'azure'.
+	AzureAccount *string `json:"azure_account,omitempty"`
+	// The Azure container name for this repository. Only applies when type =
+	// 'azure'.
+	AzureContainer *string `json:"azure_container,omitempty"`
+	// The optional Azure endpoint for this repository. Only applies when type =
+	// 'azure'.
+	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
+	// An optional Azure storage account access key to use for this repository. If
+	// not provided, pgbackrest will use the VM's managed identity.
+	AzureKey *string `json:"azure_key,omitempty"`
+	// The base path within the repository to store backups. Required for type =
+	// 'posix' and 'cifs'.
+	BasePath *string `json:"base_path,omitempty"`
+	// Additional options to apply to this repository.
+	CustomOptions map[string]string `json:"custom_options,omitempty"`
+}
+
+// OrchestratorOptsRequestBodyRequestBody is used to define fields on request
+// body types.
+type OrchestratorOptsRequestBodyRequestBody struct {
+	// Swarm-specific configuration.
+	Swarm *SwarmOptsRequestBodyRequestBody `json:"swarm,omitempty"`
+}
+
+// SwarmOptsRequestBodyRequestBody is used to define fields on request body
+// types.
+type SwarmOptsRequestBodyRequestBody struct {
+	// A list of extra volumes to mount. Each entry defines a host and container
+	// path.
+	ExtraVolumes []*ExtraVolumesSpecRequestBodyRequestBody `json:"extra_volumes,omitempty"`
+	// A list of additional Docker Swarm networks to attach containers in this
+	// database to.
+	ExtraNetworks []*ExtraNetworkSpecRequestBodyRequestBody `json:"extra_networks,omitempty"`
+	// Arbitrary labels to apply to the Docker Swarm service
+	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
+	// User-specified container image override. Bypasses manifest version
+	// constraints entirely — the CP will deploy this image without validating it
+	// against the version manifest. The CP verifies the image exists in its
+	// registry before accepting the spec. Clearing this field causes the CP to
+	// fall back to the manifest-resolved image on the next reconcile.
+	Image *string `json:"image,omitempty"`
+}
+
+// ExtraVolumesSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type ExtraVolumesSpecRequestBodyRequestBody struct {
+	// The host path for the volume.
+	HostPath *string `json:"host_path"`
+	// The path inside the container where the volume will be mounted.
+	DestinationPath *string `json:"destination_path"`
+}
+
+// ExtraNetworkSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type ExtraNetworkSpecRequestBodyRequestBody struct {
+	// The name or ID of the network to connect to.
+	ID *string `json:"id"`
+	// Optional network-scoped aliases for the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// Optional driver options for the network connection.
+	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+}
+
+// PatroniSettingsRequestBodyRequestBody is used to define fields on request
+// body types.
+type PatroniSettingsRequestBodyRequestBody struct {
+	// The maximum number of bytes that a replica can lag behind the primary and
+	// still be eligible for promotion. Lower values reduce the data that can be
+	// lost during a failover at the cost of availability. Defaults to 1048576.
+	MaximumLagOnFailover *int64 `json:"maximum_lag_on_failover,omitempty"`
+	// Keeps the primary running when Patroni loses access to Etcd, as long as it
+	// can reach every other member of the node. Defaults to true for
+	// single-instance nodes and false otherwise.
+	FailsafeMode *bool `json:"failsafe_mode,omitempty"`
+	// The number of seconds between Patroni's health checks. Defaults to 10.
+	LoopWait *int `json:"loop_wait,omitempty"`
+	// The number of seconds before an unresponsive primary loses its leader lock
+	// and a failover begins. Must be at least loop_wait + 2 * retry_timeout.
+	// Defaults to 30.
+	TTL *int `json:"ttl,omitempty"`
+	// The number of seconds that Patroni retries Etcd and Postgres operations
+	// before demoting the primary. Defaults to 10.
+	RetryTimeout *int `json:"retry_timeout,omitempty"`
+	// Prevents replicas on an older timeline than the last known primary from
+	// being promoted. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
+	// Watchdog settings used to fence a primary that stops responding. The
+	// watchdog is disabled by default.
+	Watchdog *PatroniWatchdogRequestBodyRequestBody `json:"watchdog,omitempty"`
+}
+
+// PatroniWatchdogRequestBodyRequestBody is used to define fields on request
+// body types.
+type PatroniWatchdogRequestBodyRequestBody struct {
+	// Whether to use a watchdog device. With 'required', an instance will not
+	// become primary unless the watchdog can be activated.
+	Mode *string `json:"mode"`
+	// The path to the watchdog device. Defaults to /dev/watchdog. The device must
+	// be available inside the instance.
+	Device *string `json:"device,omitempty"`
+	// The number of seconds between the watchdog triggering and the leader lock
+	// expiring. Set to -1 to trigger the watchdog halfway through the ttl.
+	SafetyMargin *int `json:"safety_margin,omitempty"`
+}
+
+// DatabaseUserSpecRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseUserSpecRequestBodyRequestBody struct {
+	// The username for this database user.
+	Username *string `json:"username"`
+	// The password for this database user. This field will be excluded from the
+	// response of all endpoints. It can also be omitted from update requests to
+	// keep the current value.
+	Password *string `json:"password,omitempty"`
+	// If true, this user will be granted database ownership.
+	DbOwner *bool `json:"db_owner,omitempty"`
+	// The attributes to assign to this database user.
+	Attributes []string `json:"attributes,omitempty"`
+	// The roles to assign to this database user.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ServiceSpecRequestBodyRequestBody is used to define fields on request body
+// types.
+type ServiceSpecRequestBodyRequestBody struct {
+	// The unique identifier for this service.
+	ServiceID *string `json:"service_id"`
+	// The type of service to run.
+	ServiceType *string `json:"service_type"`
+	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
+	Version *string `json:"version"`
+	// The IDs of the hosts that should run this service. One service instance will
+	// be created per host.
+	HostIds []string `json:"host_ids"`
+	// The port to publish the service on the host. If 0, Docker assigns a random
+	// port. If unspecified, no port is published and the service is not accessible
+	// from outside the Docker network.
+	Port *int `json:"port,omitempty"`
+	// Service-specific configuration. For MCP services, this includes
+	// llm_provider, llm_model, and provider-specific API keys.
+	Config map[string]any `json:"config,omitempty"`
+	// The number of CPUs to allocate for this service. It can include the SI
+	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
+	// unspecified.
+	Cpus *string `json:"cpus,omitempty"`
+	// The amount of memory in SI or IEC notation to allocate for this service.
+	// Defaults to container defaults if unspecified.
+	Memory *string `json:"memory,omitempty"`
+	// Orchestrator-specific options for this service.
+	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
+	// Optional database connection routing configuration.
+	DatabaseConnection *DatabaseConnectionRequestBodyRequestBody `json:"database_connection,omitempty"`
+	// Username of the database_users entry this service connects as. The user must
+	// exist in database_users and have appropriate roles for the service's needs.
+	ConnectAs *string `json:"connect_as"`
+}
+
+// DatabaseConnectionRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseConnectionRequestBodyRequestBody struct {
+	// Optional ordered list of database node names. When set, the service's
+	// database connection includes only the listed nodes in the specified order.
+	TargetNodes []string `json:"target_nodes,omitempty"`
+	// Optional libpq target_session_attrs value. When set, overrides the default
+	// derived from the service config. Valid values: primary, prefer-standby,
+	// standby, read-write, any.
+	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+}
+
+// DatabaseScriptsRequestBodyRequestBody is used to define fields on request
+// body types.
+type DatabaseScriptsRequestBodyRequestBody struct {
+	// The `post_init` script runs on each primary instance of each node after the
+	// instance is created for the first time. Each element of the array is single
+	// SQL statement. These statements run within a transaction in the `postgres`
+	// database before the users are created, so this feature can be used to create
+	// nologin roles that can be assigned to the database users via their `roles`
+	// field.
+	PostInit []string `json:"post_init,omitempty"`
+	// The `post_database_create` script runs once on each primary instance of each
+	// node after the application database is created for the first time. Each
+	// element of the array is a single SQL statement. These statements run within
+	// a transaction in the application database after Spock is initialized, but
+	// before subscriptions are created.
+	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+}
+
+// QueryStatsSettingsRequestBodyRequestBody is used to define fields on request
+// body types.
+type QueryStatsSettingsRequestBodyRequestBody struct {
+	// Creates the pg_stat_statements extension and periodically collects the top
+	// statements from each instance. Defaults to false.
+	Enabled *bool `json:"enabled"`
+	// The number of statements to collect by each of total execution time, calls,
+	// and rows. Defaults to 20.
+	TopN *int `json:"top_n,omitempty"`
+}
+
+// MajorUpgradeNodeSpecRequestBodyRequestBody is used to define fields on
+// request body types.
+type MajorUpgradeNodeSpecRequestBodyRequestBody struct {
+	// The name of the node to replace.
+	Name *string `json:"name"`
+	// The IDs of the hosts for the replacement node.
+	HostIds []string `json:"host_ids"`
+}
+
+// MaintenanceWindowRequestBodyRequestBody is used to define fields on request
+// body types.
+type MaintenanceWindowRequestBodyRequestBody struct {
+	// The time of day, in UTC, when the window opens.
+	Start *string `json:"start"`
+	// The time of day, in UTC, when the window closes.
+	End *string `json:"end"`
+}
+
+// NewInitClusterResponseBody builds the HTTP response body from the result of
+// the "init-cluster" endpoint of the "control-plane" service.
+func NewInitClusterResponseBody(res *controlplane.ClusterJoinToken) *InitClusterResponseBody {
+	body := &InitClusterResponseBody{
+		Token: res.Token,
+	}
+	if res.ServerUrls != nil {
+		body.ServerUrls = make([]string, len(res.ServerUrls))
+		for i, val := range res.ServerUrls {
+			body.ServerUrls[i] = val
+		}
+	} else {
+		body.ServerUrls = []string{}
+	}
+	return body
+}
+
+// NewGetJoinTokenResponseBody builds the HTTP response body from the result of
+// the "get-join-token" endpoint of the "control-plane" service.
+func NewGetJoinTokenResponseBody(res *controlplane.ClusterJoinToken) *GetJoinTokenResponseBody {
+	body := &GetJoinTokenResponseBody{
+		Token: res.Token,
+	}
+	if res.ServerUrls != nil {
+		body.ServerUrls = make([]string, len(res.ServerUrls))
+		for i, val := range res.ServerUrls {
+			body.ServerUrls[i] = val
+		}
+	} else {
+		body.ServerUrls = []string{}
+	}
+	return body
+}
+
+// NewGetJoinOptionsResponseBody builds the HTTP response body from the result
+// of the "get-join-options" endpoint of the "control-plane" service.
+func NewGetJoinOptionsResponseBody(res *controlplane.ClusterJoinOptions) *GetJoinOptionsResponseBody {
+	body := &GetJoinOptionsResponseBody{}
+	if res.Leader != nil {
+		body.Leader = marshalControlplaneEtcdClusterMemberToEtcdClusterMemberResponseBody(res.Leader)
+	}
+	if res.Credentials != nil {
+		body.Credentials = marshalControlplaneClusterCredentialsToClusterCredentialsResponseBody(res.Credentials)
+	}
+	return body
+}
+
+// NewGetClusterResponseBody builds the HTTP response body from the result of
+// the "get-cluster" endpoint of the "control-plane" service.
+func NewGetClusterResponseBody(res *controlplane.Cluster) *GetClusterResponseBody {
+	body := &GetClusterResponseBody{
+		ID: string(res.ID),
+	}
+	if res.Status != nil {
+		body.Status = marshalControlplaneClusterStatusToClusterStatusResponseBody(res.Status)
+	}
+	if res.Hosts != nil {
+		body.Hosts = make([]*HostResponseBody, len(res.Hosts))
+		for i, val := range res.Hosts {
+			if val == nil {
+				body.Hosts[i] = nil
+				continue
+			}
+			body.Hosts[i] = marshalControlplaneHostToHostResponseBody(val)
+		}
+	} else {
+		body.Hosts = []*HostResponseBody{}
+	}
+	if res.Upgrade != nil {
+		body.Upgrade = marshalControlplaneClusterUpgradeStatusToClusterUpgradeStatusResponseBody(res.Upgrade)
+	}
+	return body
+}
+
+// NewGetStorageUsageResponseBody builds the HTTP response body from the result
+// of the "get-storage-usage" endpoint of the "control-plane" service.
+func NewGetStorageUsageResponseBody(res *controlplane.StorageUsage) *GetStorageUsageResponseBody {
+	body := &GetStorageUsageResponseBody{
+		DbSizeBytes:      res.DbSizeBytes,
+		DbSizeInUseBytes: res.DbSizeInUseBytes,
+		Keys:             res.Keys,
+		Bytes:            res.Bytes,
+	}
+	if res.Prefixes != nil {
+		body.Prefixes = make([]*StoragePrefixUsageResponseBody, len(res.Prefixes))
+		for i, val := range res.Prefixes {
+			if val == nil {
+				body.Prefixes[i] = nil
+				continue
+			}
+			body.Prefixes[i] = marshalControlplaneStoragePrefixUsageToStoragePrefixUsageResponseBody(val)
+		}
+	} else {
+		body.Prefixes = []*StoragePrefixUsageResponseBody{}
+	}
+	return body
+}
+
+// NewGetEtcdClusterResponseBody builds the HTTP response body from the result
+// of the "get-etcd-cluster" endpoint of the "control-plane" service.
+func NewGetEtcdClusterResponseBody(res *controlplane.EtcdCluster) *GetEtcdClusterResponseBody {
+	body := &GetEtcdClusterResponseBody{
+		Leader:           res.Leader,
+		Voters:           res.Voters,
+		Learners:         res.Learners,
+		Quorum:           res.Quorum,
+		FailureTolerance: res.FailureTolerance,
+	}
+	if res.Members != nil {
+		body.Members = make([]*EtcdMemberResponseBody, len(res.Members))
+		for i, val := range res.Members {
+			if val == nil {
+				body.Members[i] = nil
+				continue
+			}
+			body.Members[i] = marshalControlplaneEtcdMemberToEtcdMemberResponseBody(val)
+		}
+	} else {
+		body.Members = []*EtcdMemberResponseBody{}
+	}
+	if res.Alarms != nil {
+		body.Alarms = make([]string, len(res.Alarms))
+		for i, val := range res.Alarms {
+			body.Alarms[i] = val
+		}
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewDefragmentEtcdResponseBody builds the HTTP response body from the result
+// of the "defragment-etcd" endpoint of the "control-plane" service.
+func NewDefragmentEtcdResponseBody(res *controlplane.DefragmentEtcdResponse) *DefragmentEtcdResponseBody {
+	body := &DefragmentEtcdResponseBody{}
+	if res.Results != nil {
+		body.Results = make([]*EtcdDefragmentResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalControlplaneEtcdDefragmentResultToEtcdDefragmentResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*EtcdDefragmentResultResponseBody{}
+	}
+	return body
+}
+
+// NewListHostsResponseBody builds the HTTP response body from the result of
+// the "list-hosts" endpoint of the "control-plane" service.
+func NewListHostsResponseBody(res *controlplane.ListHostsResponse) *ListHostsResponseBody {
+	body := &ListHostsResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Hosts != nil {
+		body.Hosts = make([]*HostResponseBody, len(res.Hosts))
+		for i, val := range res.Hosts {
+			if val == nil {
+				body.Hosts[i] = nil
+				continue
+			}
+			body.Hosts[i] = marshalControlplaneHostToHostResponseBody(val)
+		}
+	} else {
+		body.Hosts = []*HostResponseBody{}
+	}
+	return body
+}
+
+// NewGetHostResponseBody builds the HTTP response body from the result of the
+// "get-host" endpoint of the "control-plane" service.
+func NewGetHostResponseBody(res *controlplane.Host) *GetHostResponseBody {
+	body := &GetHostResponseBody{
+		ID:           string(res.ID),
+		Orchestrator: res.Orchestrator,
+		DataDir:      res.DataDir,
+		Cpus:         res.Cpus,
+		Memory:       res.Memory,
+		EtcdMode:     res.EtcdMode,
+	}
+	if res.Cohort != nil {
+		body.Cohort = marshalControlplaneHostCohortToHostCohortResponseBody(res.Cohort)
+	}
+	if res.PeerAddresses != nil {
+		body.PeerAddresses = make([]string, len(res.PeerAddresses))
+		for i, val := range res.PeerAddresses {
+			body.PeerAddresses[i] = val
+		}
+	} else {
+		body.PeerAddresses = []string{}
+	}
+	if res.ClientAddresses != nil {
+		body.ClientAddresses = make([]string, len(res.ClientAddresses))
+		for i, val := range res.ClientAddresses {
+			body.ClientAddresses[i] = val
+		}
+	} else {
+		body.ClientAddresses = []string{}
+	}
+	if res.Status != nil {
+		body.Status = marshalControlplaneHostStatusToHostStatusResponseBody(res.Status)
+	}
+	if res.DefaultPgedgeVersion != nil {
+		body.DefaultPgedgeVersion = marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(res.DefaultPgedgeVersion)
+	}
+	if res.SupportedPgedgeVersions != nil {
+		body.SupportedPgedgeVersions = make([]*PgEdgeVersionResponseBody, len(res.SupportedPgedgeVersions))
+		for i, val := range res.SupportedPgedgeVersions {
+			if val == nil {
+				body.SupportedPgedgeVersions[i] = nil
+				continue
+			}
+			body.SupportedPgedgeVersions[i] = marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRemoveHostResponseBody builds the HTTP response body from the result of
+// the "remove-host" endpoint of the "control-plane" service.
+func NewRemoveHostResponseBody(res *controlplane.RemoveHostResponse) *RemoveHostResponseBody {
+	body := &RemoveHostResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.UpdateDatabaseTasks != nil {
+		body.UpdateDatabaseTasks = make([]*TaskResponseBody, len(res.UpdateDatabaseTasks))
+		for i, val := range res.UpdateDatabaseTasks {
+			if val == nil {
+				body.UpdateDatabaseTasks[i] = nil
+				continue
+			}
+			body.UpdateDatabaseTasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
+		}
+	} else {
+		body.UpdateDatabaseTasks = []*TaskResponseBody{}
+	}
+	return body
+}
+
+// NewChangeHostEtcdModeResponseBody builds the HTTP response body from the
+// result of the "change-host-etcd-mode" endpoint of the "control-plane"
+// service.
+func NewChangeHostEtcdModeResponseBody(res *controlplane.ChangeHostEtcdModeResponse) *ChangeHostEtcdModeResponseBody {
+	body := &ChangeHostEtcdModeResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewListDatabasesResponseBody builds the HTTP response body from the result
+// of the "list-databases" endpoint of the "control-plane" service.
+func NewListDatabasesResponseBody(res *controlplane.ListDatabasesResponse) *ListDatabasesResponseBody {
+	body := &ListDatabasesResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Databases != nil {
+		body.Databases = make([]*DatabaseSummaryResponseBody, len(res.Databases))
+		for i, val := range res.Databases {
+			if val == nil {
+				body.Databases[i] = nil
+				continue
+			}
+			body.Databases[i] = marshalControlplaneDatabaseSummaryToDatabaseSummaryResponseBody(val)
+		}
+	} else {
+		body.Databases = []*DatabaseSummaryResponseBody{}
+	}
+	return body
+}
+
+// NewCreateDatabaseResponseBody builds the HTTP response body from the result
+// of the "create-database" endpoint of the "control-plane" service.
+func NewCreateDatabaseResponseBody(res *controlplane.CreateDatabaseResponse) *CreateDatabaseResponseBody {
+	body := &CreateDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewGetDatabaseResponseBody builds the HTTP response body from the result of
+// the "get-database" endpoint of the "control-plane" service.
+func NewGetDatabaseResponseBody(res *controlplane.Database) *GetDatabaseResponseBody {
+	body := &GetDatabaseResponseBody{
+		ID:        string(res.ID),
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		State:     res.State,
+	}
+	if res.TenantID != nil {
+		tenantID := string(*res.TenantID)
+		body.TenantID = &tenantID
+	}
+	if res.DegradedReasons != nil {
+		body.DegradedReasons = make([]string, len(res.DegradedReasons))
+		for i, val := range res.DegradedReasons {
+			body.DegradedReasons[i] = val
+		}
+	}
+	if res.Instances != nil {
+		body.Instances = make([]*InstanceResponseBody, len(res.Instances))
+		for i, val := range res.Instances {
+			if val == nil {
+				body.Instances[i] = nil
+				continue
+			}
+			body.Instances[i] = marshalControlplaneInstanceToInstanceResponseBody(val)
+		}
+	}
+	if res.ServiceInstances != nil {
+		body.ServiceInstances = make([]*ServiceInstanceResponseBody, len(res.ServiceInstances))
+		for i, val := range res.ServiceInstances {
+			if val == nil {
+				body.ServiceInstances[i] = nil
+				continue
+			}
+			body.ServiceInstances[i] = marshalControlplaneServiceInstanceToServiceInstanceResponseBody(val)
+		}
+	}
+	if res.Spec != nil {
+		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecResponseBody(res.Spec)
+	}
+	if res.AvailableUpgrades != nil {
+		body.AvailableUpgrades = make([]*AvailableUpgradeResponseBody, len(res.AvailableUpgrades))
+		for i, val := range res.AvailableUpgrades {
+			if val == nil {
+				body.AvailableUpgrades[i] = nil
+				continue
+			}
+			body.AvailableUpgrades[i] = marshalControlplaneAvailableUpgradeToAvailableUpgradeResponseBody(val)
+		}
+	}
+	if res.Drift != nil {
+		body.Drift = marshalControlplaneDatabaseDriftToDatabaseDriftResponseBody(res.Drift)
+	}
+	return body
+}
+
+// NewUpdateDatabaseResponseBody builds the HTTP response body from the result
+// of the "update-database" endpoint of the "control-plane" service.
+func NewUpdateDatabaseResponseBody(res *controlplane.UpdateDatabaseResponse) *UpdateDatabaseResponseBody {
+	body := &UpdateDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	if res.PostgresqlConfChanges != nil {
+		body.PostgresqlConfChanges = make([]*PostgreSQLConfChangeResponseBody, len(res.PostgresqlConfChanges))
+		for i, val := range res.PostgresqlConfChanges {
+			if val == nil {
+				body.PostgresqlConfChanges[i] = nil
+				continue
+			}
+			body.PostgresqlConfChanges[i] = marshalControlplanePostgreSQLConfChangeToPostgreSQLConfChangeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewApplyUpgradeResponseBody builds the HTTP response body from the result of
+// the "apply-upgrade" endpoint of the "control-plane" service.
+func NewApplyUpgradeResponseBody(res *controlplane.ApplyUpgradeResponse) *ApplyUpgradeResponseBody {
+	body := &ApplyUpgradeResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewUpgradeDatabaseMajorResponseBody builds the HTTP response body from the
+// result of the "upgrade-database-major" endpoint of the "control-plane"
+// service.
+func NewUpgradeDatabaseMajorResponseBody(res *controlplane.UpgradeDatabaseMajorResponse) *UpgradeDatabaseMajorResponseBody {
+	body := &UpgradeDatabaseMajorResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewCutoverDatabaseImportResponseBody builds the HTTP response body from the
+// result of the "cutover-database-import" endpoint of the "control-plane"
+// service.
+func NewCutoverDatabaseImportResponseBody(res *controlplane.CutoverDatabaseImportResponse) *CutoverDatabaseImportResponseBody {
+	body := &CutoverDatabaseImportResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewDeleteDatabaseResponseBody builds the HTTP response body from the result
+// of the "delete-database" endpoint of the "control-plane" service.
+func NewDeleteDatabaseResponseBody(res *controlplane.DeleteDatabaseResponse) *DeleteDatabaseResponseBody {
+	body := &DeleteDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewBackupDatabaseNodeResponseBody builds the HTTP response body from the
+// result of the "backup-database-node" endpoint of the "control-plane" service.
+func NewBackupDatabaseNodeResponseBody(res *controlplane.BackupDatabaseNodeResponse) *BackupDatabaseNodeResponseBody {
+	body := &BackupDatabaseNodeResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewSwitchoverDatabaseNodeResponseBody builds the HTTP response body from the
+// result of the "switchover-database-node" endpoint of the "control-plane"
+// service.
+func NewSwitchoverDatabaseNodeResponseBody(res *controlplane.SwitchoverDatabaseNodeResponse) *SwitchoverDatabaseNodeResponseBody {
+	body := &SwitchoverDatabaseNodeResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewRollingRestartDatabaseResponseBody builds the HTTP response body from the
+// result of the "rolling-restart-database" endpoint of the "control-plane"
+// service.
+func NewRollingRestartDatabaseResponseBody(res *controlplane.RollingRestartDatabaseResponse) *RollingRestartDatabaseResponseBody {
+	body := &RollingRestartDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewFailoverDatabaseNodeResponseBody builds the HTTP response body from the
+// result of the "failover-database-node" endpoint of the "control-plane"
+// service.
+func NewFailoverDatabaseNodeResponseBody(res *controlplane.FailoverDatabaseNodeResponse) *FailoverDatabaseNodeResponseBody {
+	body := &FailoverDatabaseNodeResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewGetNodeQueryStatsResponseBody builds the HTTP response body from the
+// result of the "get-node-query-stats" endpoint of the "control-plane" service.
+func NewGetNodeQueryStatsResponseBody(res *controlplane.GetNodeQueryStatsResponse) *GetNodeQueryStatsResponseBody {
+	body := &GetNodeQueryStatsResponseBody{}
+	if res.Instances != nil {
+		body.Instances = make([]*InstanceQueryStatsResponseBody, len(res.Instances))
+		for i, val := range res.Instances {
+			if val == nil {
+				body.Instances[i] = nil
+				continue
+			}
+			body.Instances[i] = marshalControlplaneInstanceQueryStatsToInstanceQueryStatsResponseBody(val)
+		}
+	} else {
+		body.Instances = []*InstanceQueryStatsResponseBody{}
+	}
+	return body
+}
+
+// NewResetNodeQueryStatsResponseBody builds the HTTP response body from the
+// result of the "reset-node-query-stats" endpoint of the "control-plane"
+// service.
+func NewResetNodeQueryStatsResponseBody(res *controlplane.ResetNodeQueryStatsResponse) *ResetNodeQueryStatsResponseBody {
+	body := &ResetNodeQueryStatsResponseBody{}
+	if res.InstanceIds != nil {
+		body.InstanceIds = make([]string, len(res.InstanceIds))
+		for i, val := range res.InstanceIds {
+			body.InstanceIds[i] = val
+		}
+	} else {
+		body.InstanceIds = []string{}
+	}
+	return body
+}
+
+// NewListDatabaseTasksResponseBody builds the HTTP response body from the
+// result of the "list-database-tasks" endpoint of the "control-plane" service.
+func NewListDatabaseTasksResponseBody(res *controlplane.ListDatabaseTasksResponse) *ListDatabaseTasksResponseBody {
+	body := &ListDatabaseTasksResponseBody{}
+	if res.Tasks != nil {
+		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
+		for i, val := range res.Tasks {
+			if val == nil {
+				body.Tasks[i] = nil
+				continue
+			}
+			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
+		}
+	} else {
+		body.Tasks = []*TaskResponseBody{}
+	}
+	return body
+}
+
+// NewGetDatabaseTaskResponseBody builds the HTTP response body from the result
+// of the "get-database-task" endpoint of the "control-plane" service.
+func NewGetDatabaseTaskResponseBody(res *controlplane.Task) *GetDatabaseTaskResponseBody {
+	body := &GetDatabaseTaskResponseBody{
+		ParentID:     res.ParentID,
+		Scope:        res.Scope,
+		EntityID:     res.EntityID,
+		DatabaseID:   res.DatabaseID,
+		NodeName:     res.NodeName,
+		InstanceID:   res.InstanceID,
+		HostID:       res.HostID,
+		TaskID:       res.TaskID,
+		CreatedAt:    res.CreatedAt,
+		CompletedAt:  res.CompletedAt,
+		Type:         res.Type,
+		Status:       res.Status,
+		StatusReason: res.StatusReason,
+		Error:        res.Error,
+	}
+	return body
+}
+
+// NewGetDatabaseTaskLogResponseBody builds the HTTP response body from the
+// result of the "get-database-task-log" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseTaskLogResponseBody(res *controlplane.TaskLog) *GetDatabaseTaskLogResponseBody {
+	body := &GetDatabaseTaskLogResponseBody{
+		Scope:       res.Scope,
+		EntityID:    res.EntityID,
+		DatabaseID:  res.DatabaseID,
+		TaskID:      res.TaskID,
+		TaskStatus:  res.TaskStatus,
+		LastEntryID: res.LastEntryID,
+	}
+	if res.Entries != nil {
+		body.Entries = make([]*TaskLogEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			if val == nil {
+				body.Entries[i] = nil
+				continue
+			}
+			body.Entries[i] = marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*TaskLogEntryResponseBody{}
+	}
+	return body
+}
+
+// NewListHostTasksResponseBody builds the HTTP response body from the result
+// of the "list-host-tasks" endpoint of the "control-plane" service.
+func NewListHostTasksResponseBody(res *controlplane.ListHostTasksResponse) *ListHostTasksResponseBody {
+	body := &ListHostTasksResponseBody{}
+	if res.Tasks != nil {
+		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
+		for i, val := range res.Tasks {
+			if val == nil {
+				body.Tasks[i] = nil
+				continue
+			}
+			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
+		}
+	} else {
+		body.Tasks = []*TaskResponseBody{}
+	}
+	return body
+}
+
+// NewGetHostTaskResponseBody builds the HTTP response body from the result of
+// the "get-host-task" endpoint of the "control-plane" service.
+func NewGetHostTaskResponseBody(res *controlplane.Task) *GetHostTaskResponseBody {
+	body := &GetHostTaskResponseBody{
+		ParentID:     res.ParentID,
+		Scope:        res.Scope,
+		EntityID:     res.EntityID,
+		DatabaseID:   res.DatabaseID,
+		NodeName:     res.NodeName,
+		InstanceID:   res.InstanceID,
+		HostID:       res.HostID,
+		TaskID:       res.TaskID,
+		CreatedAt:    res.CreatedAt,
+		CompletedAt:  res.CompletedAt,
+		Type:         res.Type,
+		Status:       res.Status,
+		StatusReason: res.StatusReason,
+		Error:        res.Error,
+	}
+	return body
+}
+
+// NewGetHostTaskLogResponseBody builds the HTTP response body from the result
+// of the "get-host-task-log" endpoint of the "control-plane" service.
+func NewGetHostTaskLogResponseBody(res *controlplane.TaskLog) *GetHostTaskLogResponseBody {
+	body := &GetHostTaskLogResponseBody{
+		Scope:       res.Scope,
+		EntityID:    res.EntityID,
+		DatabaseID:  res.DatabaseID,
+		TaskID:      res.TaskID,
+		TaskStatus:  res.TaskStatus,
+		LastEntryID: res.LastEntryID,
+	}
+	if res.Entries != nil {
+		body.Entries = make([]*TaskLogEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			if val == nil {
+				body.Entries[i] = nil
+				continue
+			}
+			body.Entries[i] = marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*TaskLogEntryResponseBody{}
+	}
+	return body
+}
+
+// NewListTasksResponseBody builds the HTTP response body from the result of
+// the "list-tasks" endpoint of the "control-plane" service.
+func NewListTasksResponseBody(res *controlplane.ListTasksResponse) *ListTasksResponseBody {
+	body := &ListTasksResponseBody{}
+	if res.Tasks != nil {
+		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
+		for i, val := range res.Tasks {
+			if val == nil {
+				body.Tasks[i] = nil
+				continue
+			}
+			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
+		}
+	} else {
+		body.Tasks = []*TaskResponseBody{}
+	}
+	return body
+}
+
+// NewStreamEventsResponseBody builds the HTTP response body from the result of
+// the "stream-events" endpoint of the "control-plane" service.
+func NewStreamEventsResponseBody(res *controlplane.Event) *StreamEventsResponseBody {
+	body := &StreamEventsResponseBody{
+		ID:        res.ID,
+		Kind:      res.Kind,
+		Timestamp: res.Timestamp,
+		TaskID:    res.TaskID,
+	}
+	if res.DatabaseID != nil {
+		databaseID := string(*res.DatabaseID)
+		body.DatabaseID = &databaseID
+	}
+	if res.HostID != nil {
+		hostID := string(*res.HostID)
+		body.HostID = &hostID
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseSummaryToDatabaseSummaryResponseBody(res.Database)
+	}
+	if res.Instance != nil {
+		body.Instance = marshalControlplaneInstanceToInstanceResponseBody(res.Instance)
+	}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.TaskLogEntry != nil {
+		body.TaskLogEntry = marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(res.TaskLogEntry)
+	}
+	return body
+}
+
+// NewCreateWebhookResponseBody builds the HTTP response body from the result
+// of the "create-webhook" endpoint of the "control-plane" service.
+func NewCreateWebhookResponseBody(res *controlplane.Webhook) *CreateWebhookResponseBody {
+	body := &CreateWebhookResponseBody{
+		ID:          res.ID,
+		URL:         res.URL,
+		Description: res.Description,
+		Secret:      res.Secret,
+		CreatedAt:   res.CreatedAt,
+	}
+	if res.EventTypes != nil {
+		body.EventTypes = make([]string, len(res.EventTypes))
+		for i, val := range res.EventTypes {
+			body.EventTypes[i] = string(val)
+		}
+	}
+	if res.DatabaseIds != nil {
+		body.DatabaseIds = make([]string, len(res.DatabaseIds))
+		for i, val := range res.DatabaseIds {
+			body.DatabaseIds[i] = string(val)
+		}
+	}
+	return body
+}
+
+// NewListWebhooksResponseBody builds the HTTP response body from the result of
+// the "list-webhooks" endpoint of the "control-plane" service.
+func NewListWebhooksResponseBody(res *controlplane.ListWebhooksResponse) *ListWebhooksResponseBody {
+	body := &ListWebhooksResponseBody{}
+	if res.Webhooks != nil {
+		body.Webhooks = make([]*WebhookResponseBody, len(res.Webhooks))
+		for i, val := range res.Webhooks {
+			if val == nil {
+				body.Webhooks[i] = nil
+				continue
+			}
+			body.Webhooks[i] = marshalControlplaneWebhookToWebhookResponseBody(val)
+		}
+	} else {
+		body.Webhooks = []*WebhookResponseBody{}
+	}
+	return body
+}
+
+// NewGetWebhookResponseBody builds the HTTP response body from the result of
+// the "get-webhook" endpoint of the "control-plane" service.
+func NewGetWebhookResponseBody(res *controlplane.Webhook) *GetWebhookResponseBody {
+	body := &GetWebhookResponseBody{
+		ID:          res.ID,
+		URL:         res.URL,
+		Description: res.Description,
+		Secret:      res.Secret,
+		CreatedAt:   res.CreatedAt,
+	}
+	if res.EventTypes != nil {
+		body.EventTypes = make([]string, len(res.EventTypes))
+		for i, val := range res.EventTypes {
+			body.EventTypes[i] = string(val)
+		}
+	}
+	if res.DatabaseIds != nil {
+		body.DatabaseIds = make([]string, len(res.DatabaseIds))
+		for i, val := range res.DatabaseIds {
+			body.DatabaseIds[i] = string(val)
+		}
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesResponseBody builds the HTTP response body from the
+// result of the "list-webhook-deliveries" endpoint of the "control-plane"
+// service.
+func NewListWebhookDeliveriesResponseBody(res *controlplane.ListWebhookDeliveriesResponse) *ListWebhookDeliveriesResponseBody {
+	body := &ListWebhookDeliveriesResponseBody{}
+	if res.Deliveries != nil {
+		body.Deliveries = make([]*WebhookDeliveryResponseBody, len(res.Deliveries))
+		for i, val := range res.Deliveries {
+			if val == nil {
+				body.Deliveries[i] = nil
+				continue
+			}
+			body.Deliveries[i] = marshalControlplaneWebhookDeliveryToWebhookDeliveryResponseBody(val)
+		}
+	} else {
+		body.Deliveries = []*WebhookDeliveryResponseBody{}
+	}
+	return body
+}
+
+// NewRestoreDatabaseResponseBody builds the HTTP response body from the result
+// of the "restore-database" endpoint of the "control-plane" service.
+func NewRestoreDatabaseResponseBody(res *controlplane.RestoreDatabaseResponse) *RestoreDatabaseResponseBody {
+	body := &RestoreDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.NodeTasks != nil {
+		body.NodeTasks = make([]*TaskResponseBody, len(res.NodeTasks))
+		for i, val := range res.NodeTasks {
+			if val == nil {
+				body.NodeTasks[i] = nil
+				continue
+			}
+			body.NodeTasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
+		}
+	} else {
+		body.NodeTasks = []*TaskResponseBody{}
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewCloneDatabaseResponseBody builds the HTTP response body from the result
+// of the "clone-database" endpoint of the "control-plane" service.
+func NewCloneDatabaseResponseBody(res *controlplane.CloneDatabaseResponse) *CloneDatabaseResponseBody {
+	body := &CloneDatabaseResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	if res.BackupTask != nil {
+		body.BackupTask = marshalControlplaneTaskToTaskResponseBody(res.BackupTask)
+	}
+	if res.Database != nil {
+		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	}
+	return body
+}
+
+// NewGetVersionResponseBody builds the HTTP response body from the result of
+// the "get-version" endpoint of the "control-plane" service.
+func NewGetVersionResponseBody(res *controlplane.VersionInfo) *GetVersionResponseBody {
+	body := &GetVersionResponseBody{
+		Version:      res.Version,
+		Revision:     res.Revision,
+		RevisionTime: res.RevisionTime,
+		Arch:         res.Arch,
+	}
+	return body
+}
+
+// NewRestartInstanceResponseBody builds the HTTP response body from the result
+// of the "restart-instance" endpoint of the "control-plane" service.
+func NewRestartInstanceResponseBody(res *controlplane.RestartInstanceResponse) *RestartInstanceResponseBody {
+	body := &RestartInstanceResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewGetInstanceSessionsResponseBody builds the HTTP response body from the
+// result of the "get-instance-sessions" endpoint of the "control-plane"
+// service.
+func NewGetInstanceSessionsResponseBody(res *controlplane.GetInstanceSessionsResponse) *GetInstanceSessionsResponseBody {
+	body := &GetInstanceSessionsResponseBody{}
+	if res.Sessions != nil {
+		body.Sessions = make([]*InstanceSessionResponseBody, len(res.Sessions))
+		for i, val := range res.Sessions {
+			if val == nil {
+				body.Sessions[i] = nil
+				continue
+			}
+			body.Sessions[i] = marshalControlplaneInstanceSessionToInstanceSessionResponseBody(val)
+		}
+	} else {
+		body.Sessions = []*InstanceSessionResponseBody{}
+	}
+	if res.BlockingChains != nil {
+		body.BlockingChains = make([][]int, len(res.BlockingChains))
+		for i, val := range res.BlockingChains {
+			body.BlockingChains[i] = make([]int, len(val))
+			for j, val := range val {
+				body.BlockingChains[i][j] = val
+			}
+		}
+	} else {
+		body.BlockingChains = [][]int{}
+	}
+	return body
+}
+
+// NewCancelInstanceSessionResponseBody builds the HTTP response body from the
+// result of the "cancel-instance-session" endpoint of the "control-plane"
+// service.
+func NewCancelInstanceSessionResponseBody(res *controlplane.SignalInstanceSessionResponse) *CancelInstanceSessionResponseBody {
+	body := &CancelInstanceSessionResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewTerminateInstanceSessionResponseBody builds the HTTP response body from
+// the result of the "terminate-instance-session" endpoint of the
+// "control-plane" service.
+func NewTerminateInstanceSessionResponseBody(res *controlplane.SignalInstanceSessionResponse) *TerminateInstanceSessionResponseBody {
+	body := &TerminateInstanceSessionResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewGetInstancePostgresqlConfResponseBody builds the HTTP response body from
+// the result of the "get-instance-postgresql-conf" endpoint of the
+// "control-plane" service.
+func NewGetInstancePostgresqlConfResponseBody(res *controlplane.GetInstancePostgreSQLConfResponse) *GetInstancePostgresqlConfResponseBody {
+	body := &GetInstancePostgresqlConfResponseBody{}
+	if res.Settings != nil {
+		body.Settings = make([]*PostgreSQLSettingResponseBody, len(res.Settings))
+		for i, val := range res.Settings {
+			if val == nil {
+				body.Settings[i] = nil
+				continue
+			}
+			body.Settings[i] = marshalControlplanePostgreSQLSettingToPostgreSQLSettingResponseBody(val)
+		}
+	} else {
+		body.Settings = []*PostgreSQLSettingResponseBody{}
+	}
+	return body
+}
+
+// NewStopInstanceResponseBody builds the HTTP response body from the result of
+// the "stop-instance" endpoint of the "control-plane" service.
+func NewStopInstanceResponseBody(res *controlplane.StopInstanceResponse) *StopInstanceResponseBody {
+	body := &StopInstanceResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewStartInstanceResponseBody builds the HTTP response body from the result
+// of the "start-instance" endpoint of the "control-plane" service.
+func NewStartInstanceResponseBody(res *controlplane.StartInstanceResponse) *StartInstanceResponseBody {
+	body := &StartInstanceResponseBody{}
+	if res.Task != nil {
+		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+	}
+	return body
+}
+
+// NewCancelDatabaseTaskResponseBody builds the HTTP response body from the
+// result of the "cancel-database-task" endpoint of the "control-plane" service.
+func NewCancelDatabaseTaskResponseBody(res *controlplane.Task) *CancelDatabaseTaskResponseBody {
+	body := &CancelDatabaseTaskResponseBody{
+		ParentID:     res.ParentID,
+		Scope:        res.Scope,
+		EntityID:     res.EntityID,
+		DatabaseID:   res.DatabaseID,
+		NodeName:     res.NodeName,
+		InstanceID:   res.InstanceID,
+		HostID:       res.HostID,
+		TaskID:       res.TaskID,
+		CreatedAt:    res.CreatedAt,
+		CompletedAt:  res.CompletedAt,
+		Type:         res.Type,
+		Status:       res.Status,
+		StatusReason: res.StatusReason,
+		Error:        res.Error,
+	}
+	return body
+}
+
+// NewResumeDatabaseTaskResponseBody builds the HTTP response body from the
+// result of the "resume-database-task" endpoint of the "control-plane" service.
+func NewResumeDatabaseTaskResponseBody(res *controlplane.Task) *ResumeDatabaseTaskResponseBody {
+	body := &ResumeDatabaseTaskResponseBody{
+		ParentID:     res.ParentID,
+		Scope:        res.Scope,
+		EntityID:     res.EntityID,
+		DatabaseID:   res.DatabaseID,
+		NodeName:     res.NodeName,
+		InstanceID:   res.InstanceID,
+		HostID:       res.HostID,
+		TaskID:       res.TaskID,
+		CreatedAt:    res.CreatedAt,
+		CompletedAt:  res.CompletedAt,
+		Type:         res.Type,
+		Status:       res.Status,
+		StatusReason: res.StatusReason,
+		Error:        res.Error,
+	}
+	return body
+}
+
+// NewInitClusterClusterAlreadyInitializedResponseBody builds the HTTP response
+// body from the result of the "init-cluster" endpoint of the "control-plane"
+// service.
+func NewInitClusterClusterAlreadyInitializedResponseBody(res *controlplane.APIError) *InitClusterClusterAlreadyInitializedResponseBody {
+	body := &InitClusterClusterAlreadyInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInitClusterOperationNotSupportedResponseBody builds the HTTP response
+// body from the result of the "init-cluster" endpoint of the "control-plane"
+// service.
+func NewInitClusterOperationNotSupportedResponseBody(res *controlplane.APIError) *InitClusterOperationNotSupportedResponseBody {
+	body := &InitClusterOperationNotSupportedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInitClusterServerErrorResponseBody builds the HTTP response body from the
+// result of the "init-cluster" endpoint of the "control-plane" service.
+func NewInitClusterServerErrorResponseBody(res *controlplane.APIError) *InitClusterServerErrorResponseBody {
+	body := &InitClusterServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewJoinClusterClusterAlreadyInitializedResponseBody builds the HTTP response
+// body from the result of the "join-cluster" endpoint of the "control-plane"
+// service.
+func NewJoinClusterClusterAlreadyInitializedResponseBody(res *controlplane.APIError) *JoinClusterClusterAlreadyInitializedResponseBody {
+	body := &JoinClusterClusterAlreadyInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewJoinClusterInvalidJoinTokenResponseBody builds the HTTP response body
+// from the result of the "join-cluster" endpoint of the "control-plane"
+// service.
+func NewJoinClusterInvalidJoinTokenResponseBody(res *controlplane.APIError) *JoinClusterInvalidJoinTokenResponseBody {
+	body := &JoinClusterInvalidJoinTokenResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewJoinClusterInvalidInputResponseBody builds the HTTP response body from
+// the result of the "join-cluster" endpoint of the "control-plane" service.
+func NewJoinClusterInvalidInputResponseBody(res *controlplane.APIError) *JoinClusterInvalidInputResponseBody {
+	body := &JoinClusterInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewJoinClusterServerErrorResponseBody builds the HTTP response body from the
+// result of the "join-cluster" endpoint of the "control-plane" service.
+func NewJoinClusterServerErrorResponseBody(res *controlplane.APIError) *JoinClusterServerErrorResponseBody {
+	body := &JoinClusterServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinTokenClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-join-token" endpoint of the "control-plane"
+// service.
+func NewGetJoinTokenClusterNotInitializedResponseBody(res *controlplane.APIError) *GetJoinTokenClusterNotInitializedResponseBody {
+	body := &GetJoinTokenClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinTokenServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-join-token" endpoint of the "control-plane" service.
+func NewGetJoinTokenServerErrorResponseBody(res *controlplane.APIError) *GetJoinTokenServerErrorResponseBody {
+	body := &GetJoinTokenServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinOptionsClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-join-options" endpoint of the
+// "control-plane" service.
+func NewGetJoinOptionsClusterNotInitializedResponseBody(res *controlplane.APIError) *GetJoinOptionsClusterNotInitializedResponseBody {
+	body := &GetJoinOptionsClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinOptionsInvalidJoinTokenResponseBody builds the HTTP response body
+// from the result of the "get-join-options" endpoint of the "control-plane"
+// service.
+func NewGetJoinOptionsInvalidJoinTokenResponseBody(res *controlplane.APIError) *GetJoinOptionsInvalidJoinTokenResponseBody {
+	body := &GetJoinOptionsInvalidJoinTokenResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinOptionsInvalidInputResponseBody builds the HTTP response body from
+// the result of the "get-join-options" endpoint of the "control-plane" service.
+func NewGetJoinOptionsInvalidInputResponseBody(res *controlplane.APIError) *GetJoinOptionsInvalidInputResponseBody {
+	body := &GetJoinOptionsInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetJoinOptionsServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-join-options" endpoint of the "control-plane" service.
+func NewGetJoinOptionsServerErrorResponseBody(res *controlplane.APIError) *GetJoinOptionsServerErrorResponseBody {
+	body := &GetJoinOptionsServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetClusterClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "get-cluster" endpoint of the "control-plane" service.
+func NewGetClusterClusterNotInitializedResponseBody(res *controlplane.APIError) *GetClusterClusterNotInitializedResponseBody {
+	body := &GetClusterClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetClusterServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-cluster" endpoint of the "control-plane" service.
+func NewGetClusterServerErrorResponseBody(res *controlplane.APIError) *GetClusterServerErrorResponseBody {
+	body := &GetClusterServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetStorageUsageClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-storage-usage" endpoint of the
+// "control-plane" service.
+func NewGetStorageUsageClusterNotInitializedResponseBody(res *controlplane.APIError) *GetStorageUsageClusterNotInitializedResponseBody {
+	body := &GetStorageUsageClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetStorageUsageServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-storage-usage" endpoint of the "control-plane"
+// service.
+func NewGetStorageUsageServerErrorResponseBody(res *controlplane.APIError) *GetStorageUsageServerErrorResponseBody {
+	body := &GetStorageUsageServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetEtcdClusterClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-etcd-cluster" endpoint of the
+// "control-plane" service.
+func NewGetEtcdClusterClusterNotInitializedResponseBody(res *controlplane.APIError) *GetEtcdClusterClusterNotInitializedResponseBody {
+	body := &GetEtcdClusterClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetEtcdClusterServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-etcd-cluster" endpoint of the "control-plane" service.
+func NewGetEtcdClusterServerErrorResponseBody(res *controlplane.APIError) *GetEtcdClusterServerErrorResponseBody {
+	body := &GetEtcdClusterServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDefragmentEtcdClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "defragment-etcd" endpoint of the
+// "control-plane" service.
+func NewDefragmentEtcdClusterNotInitializedResponseBody(res *controlplane.APIError) *DefragmentEtcdClusterNotInitializedResponseBody {
+	body := &DefragmentEtcdClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDefragmentEtcdInvalidInputResponseBody builds the HTTP response body from
+// the result of the "defragment-etcd" endpoint of the "control-plane" service.
+func NewDefragmentEtcdInvalidInputResponseBody(res *controlplane.APIError) *DefragmentEtcdInvalidInputResponseBody {
+	body := &DefragmentEtcdInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDefragmentEtcdServerErrorResponseBody builds the HTTP response body from
+// the result of the "defragment-etcd" endpoint of the "control-plane" service.
+func NewDefragmentEtcdServerErrorResponseBody(res *controlplane.APIError) *DefragmentEtcdServerErrorResponseBody {
+	body := &DefragmentEtcdServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListHostsClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "list-hosts" endpoint of the "control-plane" service.
+func NewListHostsClusterNotInitializedResponseBody(res *controlplane.APIError) *ListHostsClusterNotInitializedResponseBody {
+	body := &ListHostsClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListHostsInvalidInputResponseBody builds the HTTP response body from the
+// result of the "list-hosts" endpoint of the "control-plane" service.
+func NewListHostsInvalidInputResponseBody(res *controlplane.APIError) *ListHostsInvalidInputResponseBody {
+	body := &ListHostsInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListHostsServerErrorResponseBody builds the HTTP response body from the
+// result of the "list-hosts" endpoint of the "control-plane" service.
+func NewListHostsServerErrorResponseBody(res *controlplane.APIError) *ListHostsServerErrorResponseBody {
+	body := &ListHostsServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetHostClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "get-host" endpoint of the "control-plane" service.
+func NewGetHostClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostClusterNotInitializedResponseBody {
+	body := &GetHostClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetHostInvalidInputResponseBody builds the HTTP response body from the
+// result of the "get-host" endpoint of the "control-plane" service.
+func NewGetHostInvalidInputResponseBody(res *controlplane.APIError) *GetHostInvalidInputResponseBody {
+	body := &GetHostInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetHostNotFoundResponseBody builds the HTTP response body from the result
+// of the "get-host" endpoint of the "control-plane" service.
+func NewGetHostNotFoundResponseBody(res *controlplane.APIError) *GetHostNotFoundResponseBody {
+	body := &GetHostNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetHostServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-host" endpoint of the "control-plane" service.
+func NewGetHostServerErrorResponseBody(res *controlplane.APIError) *GetHostServerErrorResponseBody {
+	body := &GetHostServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRemoveHostClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "remove-host" endpoint of the "control-plane" service.
+func NewRemoveHostClusterNotInitializedResponseBody(res *controlplane.APIError) *RemoveHostClusterNotInitializedResponseBody {
+	body := &RemoveHostClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseScriptsRequestBody is used to define fields on request body types.
-type DatabaseScriptsRequestBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+// NewRemoveHostInvalidInputResponseBody builds the HTTP response body from the
+// result of the "remove-host" endpoint of the "control-plane" service.
+func NewRemoveHostInvalidInputResponseBody(res *controlplane.APIError) *RemoveHostInvalidInputResponseBody {
+	body := &RemoveHostInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseSpecRequestBodyRequestBody is used to define fields on request body
-// types.
-type DatabaseSpecRequestBodyRequestBody struct {
-	// The name of the Postgres database.
-	DatabaseName *string `json:"database_name"`
-	// The Postgres version in 'major.minor' format.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The major version of the Spock extension.
-	SpockVersion *string `json:"spock_version,omitempty"`
-	// The port used by the Postgres database. If the port is 0, each instance will
-	// be assigned a random port. If the port is unspecified, the database will not
-	// be exposed on any port, dependent on orchestrator support for that feature.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this database. If the port is 0, each instance
-	// will be assigned a random port. NOTE: This field is not currently supported
-	// for Docker Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database and to use for tuning
-	// Postgres. Defaults to the number of available CPUs on the host. Can include
-	// an SI suffix, e.g. '500m' for 500 millicpus. Whether this limit is enforced
-	// depends on the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database and
-	// to use for tuning Postgres. Defaults to the total available memory on the
-	// host. Whether this limit is enforced depends on the orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// The Spock nodes for this database.
-	Nodes []*DatabaseNodeSpecRequestBodyRequestBody `json:"nodes"`
-	// The users to create for this database.
-	DatabaseUsers []*DatabaseUserSpecRequestBodyRequestBody `json:"database_users,omitempty"`
-	// Service instances to run alongside the database (e.g., MCP servers).
-	Services []*ServiceSpecRequestBodyRequestBody `json:"services,omitempty"`
-	// The backup configuration for this database.
-	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this database.
-	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
-	// Additional postgresql.conf settings. Will be merged with the settings
-	// provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries, one rule per array element. Inserted between
-	// control-plane's system-user rules and its catch-all, so they cannot affect
-	// control-plane-internal connectivity (Patroni, replication, health checks).
-	// Node-level pg_hba_conf entries are prepended to these.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries, one mapping per array element. Purely
-	// additive; control-plane writes no pg_ident entries of its own. The primary
-	// use case is cert auth with map= translating certificate CNs to PostgreSQL
-	// usernames.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
-	// User-defined SQL scripts that run at different points during the database
-	// creation process. Once a database has been successfully created, changes to
-	// these scripts will have no effect.
-	Scripts *DatabaseScriptsRequestBodyRequestBody `json:"scripts,omitempty"`
+// NewRemoveHostNotFoundResponseBody builds the HTTP response body from the
+// result of the "remove-host" endpoint of the "control-plane" service.
+func NewRemoveHostNotFoundResponseBody(res *controlplane.APIError) *RemoveHostNotFoundResponseBody {
+	body := &RemoveHostNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseNodeSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseNodeSpecRequestBodyRequestBody struct {
-	// The name of the database node.
-	Name *string `json:"name"`
-	// The IDs of the hosts that should run this node. When multiple hosts are
-	// specified, one host will chosen as a primary, and the others will be read
-	// replicas.
-	HostIds []string `json:"host_ids"`
-	// The Postgres version for this node in 'major.minor' format. Overrides the
-	// Postgres version set in the DatabaseSpec.
-	PostgresVersion *string `json:"postgres_version,omitempty"`
-	// The port used by the Postgres database for this node. Overrides the Postgres
-	// port set in the DatabaseSpec.
-	Port *int `json:"port,omitempty"`
-	// The port used by Patroni for this node. Overrides the Patroni port set in
-	// the DatabaseSpec. NOTE: This field is not currently supported for Docker
-	// Swarm.
-	PatroniPort *int `json:"patroni_port,omitempty"`
-	// The number of CPUs to allocate for the database on this node and to use for
-	// tuning Postgres. It can include the SI suffix 'm', e.g. '500m' for 500
-	// millicpus. Cannot allocate units smaller than 1m. Defaults to the number of
-	// available CPUs on the host if 0 or unspecified. Cannot allocate more CPUs
-	// than are available on the host. Whether this limit is enforced depends on
-	// the orchestrator.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for the database on
-	// this node and to use for tuning Postgres. Defaults to the total available
-	// memory on the host. Whether this limit is enforced depends on the
-	// orchestrator.
-	Memory *string `json:"memory,omitempty"`
-	// Additional postgresql.conf settings for this particular node. Will be merged
-	// with the settings provided by control-plane.
-	PostgresqlConf map[string]any `json:"postgresql_conf,omitempty"`
-	// Additional pg_hba.conf entries for this particular node, one rule per array
-	// element. Prepended to the database-level pg_hba_conf entries, so node
-	// entries take first-match priority. Entries are inserted between
-	// control-plane's system-user rules and its catch-all, and cannot affect
-	// control-plane-internal connectivity.
-	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
-	// Additional pg_ident.conf entries for this particular node, one mapping per
-	// array element. Prepended to the database-level pg_ident_conf entries.
-	PgIdentConf []string `json:"pg_ident_conf,omitempty"`
-	// The backup configuration for this node. Overrides the backup configuration
-	// set in the DatabaseSpec.
-	BackupConfig *BackupConfigSpecRequestBodyRequestBody `json:"backup_config,omitempty"`
-	// The restore configuration for this node. Overrides the restore configuration
-	// set in the DatabaseSpec.
-	RestoreConfig *RestoreConfigSpecRequestBodyRequestBody `json:"restore_config,omitempty"`
-	// Orchestrator-specific configuration options.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
-	// The name of the source node to use for sync. This is typically the node
-	// (like 'n1') from which the data will be copied to initialize this new node.
-	SourceNode *string `json:"source_node,omitempty"`
+// NewRemoveHostServerErrorResponseBody builds the HTTP response body from the
+// result of the "remove-host" endpoint of the "control-plane" service.
+func NewRemoveHostServerErrorResponseBody(res *controlplane.APIError) *RemoveHostServerErrorResponseBody {
+	body := &RemoveHostServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// BackupConfigSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type BackupConfigSpecRequestBodyRequestBody struct {
-	// The repositories for this backup configuration.
-	Repositories []*BackupRepositorySpecRequestBodyRequestBody `json:"repositories"`
-	// The schedules for this backup configuration.
-	Schedules []*BackupScheduleSpecRequestBodyRequestBody `json:"schedules,omitempty"`
+// NewChangeHostEtcdModeClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "change-host-etcd-mode" endpoint of the
+// "control-plane" service.
+func NewChangeHostEtcdModeClusterNotInitializedResponseBody(res *controlplane.APIError) *ChangeHostEtcdModeClusterNotInitializedResponseBody {
+	body := &ChangeHostEtcdModeClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// BackupRepositorySpecRequestBodyRequestBody is used to define fields on
-// request body types.
-type BackupRepositorySpecRequestBodyRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain. This field will
-	// be excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile. This field will be
-	// excluded from the response of all endpoints. It can also be omitted from
-	// update requests to keep the current value.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// The Azure storage account access key to use for this repository. This field
-	// will be excluded from the response of all endpoints. It can also be omitted
-	// from update requests to keep the current value.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The count of full backups to retain or the time to retain full backups.
-	RetentionFull *int `json:"retention_full,omitempty"`
-	// The type of measure used for retention_full.
-	RetentionFullType *string `json:"retention_full_type,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// NewChangeHostEtcdModeInvalidInputResponseBody builds the HTTP response body
+// from the result of the "change-host-etcd-mode" endpoint of the
+// "control-plane" service.
+func NewChangeHostEtcdModeInvalidInputResponseBody(res *controlplane.APIError) *ChangeHostEtcdModeInvalidInputResponseBody {
+	body := &ChangeHostEtcdModeInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// BackupScheduleSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type BackupScheduleSpecRequestBodyRequestBody struct {
-	// The unique identifier for this backup schedule.
-	ID *string `json:"id"`
-	// The type of backup to take on this schedule.
-	Type *string `json:"type"`
-	// The cron expression for this schedule.
-	CronExpression *string `json:"cron_expression"`
+// NewChangeHostEtcdModeNotFoundResponseBody builds the HTTP response body from
+// the result of the "change-host-etcd-mode" endpoint of the "control-plane"
+// service.
+func NewChangeHostEtcdModeNotFoundResponseBody(res *controlplane.APIError) *ChangeHostEtcdModeNotFoundResponseBody {
+	body := &ChangeHostEtcdModeNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// RestoreConfigSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type RestoreConfigSpecRequestBodyRequestBody struct {
-	// The ID of the database to restore this database from.
-	SourceDatabaseID *string `json:"source_database_id"`
-	// The name of the node to restore this database from.
-	SourceNodeName *string `json:"source_node_name"`
-	// The name of the database in this repository. The database will be renamed to
-	// the database_name in the DatabaseSpec after it's restored.
-	SourceDatabaseName *string `json:"source_database_name"`
-	// The repository to restore this database from.
-	Repository *RestoreRepositorySpecRequestBodyRequestBody `json:"repository"`
-	// Additional options to use when restoring this database. If omitted, the
-	// database will be restored to the latest point in the given repository.
-	RestoreOptions map[string]string `json:"restore_options,omitempty"`
+// NewChangeHostEtcdModeServerErrorResponseBody builds the HTTP response body
+// from the result of the "change-host-etcd-mode" endpoint of the
+// "control-plane" service.
+func NewChangeHostEtcdModeServerErrorResponseBody(res *controlplane.APIError) *ChangeHostEtcdModeServerErrorResponseBody {
+	body := &ChangeHostEtcdModeServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// RestoreRepositorySpecRequestBodyRequestBody is used to define fields on
-// request body types.
-type RestoreRepositorySpecRequestBodyRequestBody struct {
-	// The unique identifier of this repository.
-	ID *string `json:"id,omitempty"`
-	// The type of this repository.
-	Type *string `json:"type"`
-	// The S3 bucket name for this repository. Only applies when type = 's3'.
-	S3Bucket *string `json:"s3_bucket,omitempty"`
-	// The region of the S3 bucket for this repository. Only applies when type =
-	// 's3'.
-	S3Region *string `json:"s3_region,omitempty"`
-	// The optional S3 endpoint for this repository. Only applies when type = 's3'.
-	S3Endpoint *string `json:"s3_endpoint,omitempty"`
-	// An optional AWS access key ID to use for this repository. If not provided,
-	// pgbackrest will use the default credential provider chain.
-	S3Key *string `json:"s3_key,omitempty"`
-	// The corresponding secret for the AWS access key ID in s3_key.
-	S3KeySecret *string `json:"s3_key_secret,omitempty"`
-	// The GCS bucket name for this repository. Only applies when type = 'gcs'.
-	GcsBucket *string `json:"gcs_bucket,omitempty"`
-	// The optional GCS endpoint for this repository. Only applies when type =
-	// 'gcs'.
-	GcsEndpoint *string `json:"gcs_endpoint,omitempty"`
-	// Optional base64-encoded private key data. If omitted, pgbackrest will use
-	// the service account attached to the instance profile.
-	GcsKey *string `json:"gcs_key,omitempty"`
-	// The Azure account name for this repository. Only applies when type = 'azure'.
-	AzureAccount *string `json:"azure_account,omitempty"`
-	// The Azure container name for this repository. Only applies when type =
-	// 'azure'.
-	AzureContainer *string `json:"azure_container,omitempty"`
-	// The optional Azure endpoint for this repository. Only applies when type =
-	// 'azure'.
-	AzureEndpoint *string `json:"azure_endpoint,omitempty"`
-	// An optional Azure storage account access key to use for this repository. If
-	// not provided, pgbackrest will use the VM's managed identity.
-	AzureKey *string `json:"azure_key,omitempty"`
-	// The base path within the repository to store backups. Required for type =
-	// 'posix' and 'cifs'.
-	BasePath *string `json:"base_path,omitempty"`
-	// Additional options to apply to this repository.
-	CustomOptions map[string]string `json:"custom_options,omitempty"`
+// NewListDatabasesClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "list-databases" endpoint of the "control-plane"
+// service.
+func NewListDatabasesClusterNotInitializedResponseBody(res *controlplane.APIError) *ListDatabasesClusterNotInitializedResponseBody {
+	body := &ListDatabasesClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// OrchestratorOptsRequestBodyRequestBody is used to define fields on request
-// body types.
-type OrchestratorOptsRequestBodyRequestBody struct {
-	// Swarm-specific configuration.
-	Swarm *SwarmOptsRequestBodyRequestBody `json:"swarm,omitempty"`
+// NewListDatabasesInvalidInputResponseBody builds the HTTP response body from
+// the result of the "list-databases" endpoint of the "control-plane" service.
+func NewListDatabasesInvalidInputResponseBody(res *controlplane.APIError) *ListDatabasesInvalidInputResponseBody {
+	body := &ListDatabasesInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// SwarmOptsRequestBodyRequestBody is used to define fields on request body
-// types.
-type SwarmOptsRequestBodyRequestBody struct {
-	// A list of extra volumes to mount. Each entry defines a host and container
-	// path.
-	ExtraVolumes []*ExtraVolumesSpecRequestBodyRequestBody `json:"extra_volumes,omitempty"`
-	// A list of additional Docker Swarm networks to attach containers in this
-	// database to.
-	ExtraNetworks []*ExtraNetworkSpecRequestBodyRequestBody `json:"extra_networks,omitempty"`
-	// Arbitrary labels to apply to the Docker Swarm service
-	ExtraLabels map[string]string `json:"extra_labels,omitempty"`
-	// User-specified container image override. Bypasses manifest version
-	// constraints entirely — the CP will deploy this image without validating it
-	// against the version manifest. The CP verifies the image exists in its
-	// registry before accepting the spec. Clearing this field causes the CP to
-	// fall back to the manifest-resolved image on the next reconcile.
-	Image *string `json:"image,omitempty"`
+// NewListDatabasesServerErrorResponseBody builds the HTTP response body from
+// the result of the "list-databases" endpoint of the "control-plane" service.
+func NewListDatabasesServerErrorResponseBody(res *controlplane.APIError) *ListDatabasesServerErrorResponseBody {
+	body := &ListDatabasesServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// ExtraVolumesSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type ExtraVolumesSpecRequestBodyRequestBody struct {
-	// The host path for the volume.
-	HostPath *string `json:"host_path"`
-	// The path inside the container where the volume will be mounted.
-	DestinationPath *string `json:"destination_path"`
+// NewCreateDatabaseDatabaseAlreadyExistsResponseBody builds the HTTP response
+// body from the result of the "create-database" endpoint of the
+// "control-plane" service.
+func NewCreateDatabaseDatabaseAlreadyExistsResponseBody(res *controlplane.APIError) *CreateDatabaseDatabaseAlreadyExistsResponseBody {
+	body := &CreateDatabaseDatabaseAlreadyExistsResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// ExtraNetworkSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type ExtraNetworkSpecRequestBodyRequestBody struct {
-	// The name or ID of the network to connect to.
-	ID *string `json:"id"`
-	// Optional network-scoped aliases for the container.
-	Aliases []string `json:"aliases,omitempty"`
-	// Optional driver options for the network connection.
-	DriverOpts map[string]string `json:"driver_opts,omitempty"`
+// NewCreateDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "create-database" endpoint of the
+// "control-plane" service.
+func NewCreateDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *CreateDatabaseClusterNotInitializedResponseBody {
+	body := &CreateDatabaseClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseUserSpecRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseUserSpecRequestBodyRequestBody struct {
-	// The username for this database user.
-	Username *string `json:"username"`
-	// The password for this database user. This field will be excluded from the
-	// response of all endpoints. It can also be omitted from update requests to
-	// keep the current value.
-	Password *string `json:"password,omitempty"`
-	// If true, this user will be granted database ownership.
-	DbOwner *bool `json:"db_owner,omitempty"`
-	// The attributes to assign to this database user.
-	Attributes []string `json:"attributes,omitempty"`
-	// The roles to assign to this database user.
-	Roles []string `json:"roles,omitempty"`
+// NewCreateDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "create-database" endpoint of the
+// "control-plane" service.
+func NewCreateDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *CreateDatabaseOperationAlreadyInProgressResponseBody {
+	body := &CreateDatabaseOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// ServiceSpecRequestBodyRequestBody is used to define fields on request body
-// types.
-type ServiceSpecRequestBodyRequestBody struct {
-	// The unique identifier for this service.
-	ServiceID *string `json:"service_id"`
-	// The type of service to run.
-	ServiceType *string `json:"service_type"`
-	// The version of the service (e.g., '1.0.0', '14.5') or the literal 'latest'.
-	Version *string `json:"version"`
-	// The IDs of the hosts that should run this service. One service instance will
-	// be created per host.
-	HostIds []string `json:"host_ids"`
-	// The port to publish the service on the host. If 0, Docker assigns a random
-	// port. If unspecified, no port is published and the service is not accessible
-	// from outside the Docker network.
-	Port *int `json:"port,omitempty"`
-	// Service-specific configuration. For MCP services, this includes
-	// llm_provider, llm_model, and provider-specific API keys.
-	Config map[string]any `json:"config,omitempty"`
-	// The number of CPUs to allocate for this service. It can include the SI
-	// suffix 'm', e.g. '500m' for 500 millicpus. Defaults to container defaults if
-	// unspecified.
-	Cpus *string `json:"cpus,omitempty"`
-	// The amount of memory in SI or IEC notation to allocate for this service.
-	// Defaults to container defaults if unspecified.
-	Memory *string `json:"memory,omitempty"`
-	// Orchestrator-specific options for this service.
-	OrchestratorOpts *OrchestratorOptsRequestBodyRequestBody `json:"orchestrator_opts,omitempty"`
-	// Optional database connection routing configuration.
-	DatabaseConnection *DatabaseConnectionRequestBodyRequestBody `json:"database_connection,omitempty"`
-	// Username of the database_users entry this service connects as. The user must
-	// exist in database_users and have appropriate roles for the service's needs.
-	ConnectAs *string `json:"connect_as"`
+// NewCreateDatabaseInvalidInputResponseBody builds the HTTP response body from
+// the result of the "create-database" endpoint of the "control-plane" service.
+func NewCreateDatabaseInvalidInputResponseBody(res *controlplane.APIError) *CreateDatabaseInvalidInputResponseBody {
+	body := &CreateDatabaseInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseConnectionRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseConnectionRequestBodyRequestBody struct {
-	// Optional ordered list of database node names. When set, the service's
-	// database connection includes only the listed nodes in the specified order.
-	TargetNodes []string `json:"target_nodes,omitempty"`
-	// Optional libpq target_session_attrs value. When set, overrides the default
-	// derived from the service config. Valid values: primary, prefer-standby,
-	// standby, read-write, any.
-	TargetSessionAttrs *string `json:"target_session_attrs,omitempty"`
+// NewCreateDatabaseServerErrorResponseBody builds the HTTP response body from
+// the result of the "create-database" endpoint of the "control-plane" service.
+func NewCreateDatabaseServerErrorResponseBody(res *controlplane.APIError) *CreateDatabaseServerErrorResponseBody {
+	body := &CreateDatabaseServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// DatabaseScriptsRequestBodyRequestBody is used to define fields on request
-// body types.
-type DatabaseScriptsRequestBodyRequestBody struct {
-	// The `post_init` script runs on each primary instance of each node after the
-	// instance is created for the first time. Each element of the array is single
-	// SQL statement. These statements run within a transaction in the `postgres`
-	// database before the users are created, so this feature can be used to create
-	// nologin roles that can be assigned to the database users via their `roles`
-	// field.
-	PostInit []string `json:"post_init,omitempty"`
-	// The `post_database_create` script runs once on each primary instance of each
-	// node after the application database is created for the first time. Each
-	// element of the array is a single SQL statement. These statements run within
-	// a transaction in the application database after Spock is initialized, but
-	// before subscriptions are created.
-	PostDatabaseCreate []string `json:"post_database_create,omitempty"`
+// NewGetDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-database" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseClusterNotInitializedResponseBody {
+	body := &GetDatabaseClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
 }
 
-// NewInitClusterResponseBody builds the HTTP response body from the result of
-// the "init-cluster" endpoint of the "control-plane" service.
-func NewInitClusterResponseBody(res *controlplane.ClusterJoinToken) *InitClusterResponseBody {
-	body := &InitClusterResponseBody{
-		Token: res.Token,
+// NewGetDatabaseInvalidInputResponseBody builds the HTTP response body from
+// the result of the "get-database" endpoint of the "control-plane" service.
+func NewGetDatabaseInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseInvalidInputResponseBody {
+	body := &GetDatabaseInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.ServerUrls != nil {
-		body.ServerUrls = make([]string, len(res.ServerUrls))
-		for i, val := range res.ServerUrls {
-			body.ServerUrls[i] = val
-		}
-	} else {
-		body.ServerUrls = []string{}
+	return body
+}
+
+// NewGetDatabaseNotFoundResponseBody builds the HTTP response body from the
+// result of the "get-database" endpoint of the "control-plane" service.
+func NewGetDatabaseNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseNotFoundResponseBody {
+	body := &GetDatabaseNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinTokenResponseBody builds the HTTP response body from the result of
-// the "get-join-token" endpoint of the "control-plane" service.
-func NewGetJoinTokenResponseBody(res *controlplane.ClusterJoinToken) *GetJoinTokenResponseBody {
-	body := &GetJoinTokenResponseBody{
-		Token: res.Token,
-	}
-	if res.ServerUrls != nil {
-		body.ServerUrls = make([]string, len(res.ServerUrls))
-		for i, val := range res.ServerUrls {
-			body.ServerUrls[i] = val
-		}
-	} else {
-		body.ServerUrls = []string{}
+// NewGetDatabaseServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-database" endpoint of the "control-plane" service.
+func NewGetDatabaseServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseServerErrorResponseBody {
+	body := &GetDatabaseServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinOptionsResponseBody builds the HTTP response body from the result
-// of the "get-join-options" endpoint of the "control-plane" service.
-func NewGetJoinOptionsResponseBody(res *controlplane.ClusterJoinOptions) *GetJoinOptionsResponseBody {
-	body := &GetJoinOptionsResponseBody{}
-	if res.Leader != nil {
-		body.Leader = marshalControlplaneEtcdClusterMemberToEtcdClusterMemberResponseBody(res.Leader)
-	}
-	if res.Credentials != nil {
-		body.Credentials = marshalControlplaneClusterCredentialsToClusterCredentialsResponseBody(res.Credentials)
+// NewUpdateDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "update-database" endpoint of the
+// "control-plane" service.
+func NewUpdateDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *UpdateDatabaseClusterNotInitializedResponseBody {
+	body := &UpdateDatabaseClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetClusterResponseBody builds the HTTP response body from the result of
-// the "get-cluster" endpoint of the "control-plane" service.
-func NewGetClusterResponseBody(res *controlplane.Cluster) *GetClusterResponseBody {
-	body := &GetClusterResponseBody{
-		ID: string(res.ID),
-	}
-	if res.Status != nil {
-		body.Status = marshalControlplaneClusterStatusToClusterStatusResponseBody(res.Status)
-	}
-	if res.Hosts != nil {
-		body.Hosts = make([]*HostResponseBody, len(res.Hosts))
-		for i, val := range res.Hosts {
-			if val == nil {
-				body.Hosts[i] = nil
-				continue
-			}
-			body.Hosts[i] = marshalControlplaneHostToHostResponseBody(val)
-		}
-	} else {
-		body.Hosts = []*HostResponseBody{}
+// NewUpdateDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
+// body from the result of the "update-database" endpoint of the
+// "control-plane" service.
+func NewUpdateDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *UpdateDatabaseDatabaseNotModifiableResponseBody {
+	body := &UpdateDatabaseDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostsResponseBody builds the HTTP response body from the result of
-// the "list-hosts" endpoint of the "control-plane" service.
-func NewListHostsResponseBody(res *controlplane.ListHostsResponse) *ListHostsResponseBody {
-	body := &ListHostsResponseBody{}
-	if res.Hosts != nil {
-		body.Hosts = make([]*HostResponseBody, len(res.Hosts))
-		for i, val := range res.Hosts {
-			if val == nil {
-				body.Hosts[i] = nil
-				continue
-			}
-			body.Hosts[i] = marshalControlplaneHostToHostResponseBody(val)
-		}
-	} else {
-		body.Hosts = []*HostResponseBody{}
+// NewUpdateDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "update-database" endpoint of the
+// "control-plane" service.
+func NewUpdateDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *UpdateDatabaseOperationAlreadyInProgressResponseBody {
+	body := &UpdateDatabaseOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostResponseBody builds the HTTP response body from the result of the
-// "get-host" endpoint of the "control-plane" service.
-func NewGetHostResponseBody(res *controlplane.Host) *GetHostResponseBody {
-	body := &GetHostResponseBody{
-		ID:           string(res.ID),
-		Orchestrator: res.Orchestrator,
-		DataDir:      res.DataDir,
-		Cpus:         res.Cpus,
-		Memory:       res.Memory,
-		EtcdMode:     res.EtcdMode,
-	}
-	if res.Cohort != nil {
-		body.Cohort = marshalControlplaneHostCohortToHostCohortResponseBody(res.Cohort)
-	}
-	if res.PeerAddresses != nil {
-		body.PeerAddresses = make([]string, len(res.PeerAddresses))
-		for i, val := range res.PeerAddresses {
-			body.PeerAddresses[i] = val
-		}
-	} else {
-		body.PeerAddresses = []string{}
-	}
-	if res.ClientAddresses != nil {
-		body.ClientAddresses = make([]string, len(res.ClientAddresses))
-		for i, val := range res.ClientAddresses {
-			body.ClientAddresses[i] = val
-		}
-	} else {
-		body.ClientAddresses = []string{}
-	}
-	if res.Status != nil {
-		body.Status = marshalControlplaneHostStatusToHostStatusResponseBody(res.Status)
-	}
-	if res.DefaultPgedgeVersion != nil {
-		body.DefaultPgedgeVersion = marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(res.DefaultPgedgeVersion)
-	}
-	if res.SupportedPgedgeVersions != nil {
-		body.SupportedPgedgeVersions = make([]*PgEdgeVersionResponseBody, len(res.SupportedPgedgeVersions))
-		for i, val := range res.SupportedPgedgeVersions {
-			if val == nil {
-				body.SupportedPgedgeVersions[i] = nil
-				continue
-			}
-			body.SupportedPgedgeVersions[i] = marshalControlplanePgEdgeVersionToPgEdgeVersionResponseBody(val)
-		}
+// NewUpdateDatabaseInvalidInputResponseBody builds the HTTP response body from
+// the result of the "update-database" endpoint of the "control-plane" service.
+func NewUpdateDatabaseInvalidInputResponseBody(res *controlplane.APIError) *UpdateDatabaseInvalidInputResponseBody {
+	body := &UpdateDatabaseInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewRemoveHostResponseBody builds the HTTP response body from the result of
-// the "remove-host" endpoint of the "control-plane" service.
-func NewRemoveHostResponseBody(res *controlplane.RemoveHostResponse) *RemoveHostResponseBody {
-	body := &RemoveHostResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
-	}
-	if res.UpdateDatabaseTasks != nil {
-		body.UpdateDatabaseTasks = make([]*TaskResponseBody, len(res.UpdateDatabaseTasks))
-		for i, val := range res.UpdateDatabaseTasks {
-			if val == nil {
-				body.UpdateDatabaseTasks[i] = nil
-				continue
-			}
-			body.UpdateDatabaseTasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
-		}
-	} else {
-		body.UpdateDatabaseTasks = []*TaskResponseBody{}
+// NewUpdateDatabaseNotFoundResponseBody builds the HTTP response body from the
+// result of the "update-database" endpoint of the "control-plane" service.
+func NewUpdateDatabaseNotFoundResponseBody(res *controlplane.APIError) *UpdateDatabaseNotFoundResponseBody {
+	body := &UpdateDatabaseNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabasesResponseBody builds the HTTP response body from the result
-// of the "list-databases" endpoint of the "control-plane" service.
-func NewListDatabasesResponseBody(res *controlplane.ListDatabasesResponse) *ListDatabasesResponseBody {
-	body := &ListDatabasesResponseBody{}
-	if res.Databases != nil {
-		body.Databases = make([]*DatabaseSummaryResponseBody, len(res.Databases))
-		for i, val := range res.Databases {
-			if val == nil {
-				body.Databases[i] = nil
-				continue
-			}
-			body.Databases[i] = marshalControlplaneDatabaseSummaryToDatabaseSummaryResponseBody(val)
-		}
-	} else {
-		body.Databases = []*DatabaseSummaryResponseBody{}
+// NewUpdateDatabaseServerErrorResponseBody builds the HTTP response body from
+// the result of the "update-database" endpoint of the "control-plane" service.
+func NewUpdateDatabaseServerErrorResponseBody(res *controlplane.APIError) *UpdateDatabaseServerErrorResponseBody {
+	body := &UpdateDatabaseServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseResponseBody builds the HTTP response body from the result
-// of the "create-database" endpoint of the "control-plane" service.
-func NewCreateDatabaseResponseBody(res *controlplane.CreateDatabaseResponse) *CreateDatabaseResponseBody {
-	body := &CreateDatabaseResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
-	}
-	if res.Database != nil {
-		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+// NewApplyUpgradeClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "apply-upgrade" endpoint of the "control-plane"
+// service.
+func NewApplyUpgradeClusterNotInitializedResponseBody(res *controlplane.APIError) *ApplyUpgradeClusterNotInitializedResponseBody {
+	body := &ApplyUpgradeClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseResponseBody builds the HTTP response body from the result of
-// the "get-database" endpoint of the "control-plane" service.
-func NewGetDatabaseResponseBody(res *controlplane.Database) *GetDatabaseResponseBody {
-	body := &GetDatabaseResponseBody{
-		ID:        string(res.ID),
-		CreatedAt: res.CreatedAt,
-		UpdatedAt: res.UpdatedAt,
-		State:     res.State,
-	}
-	if res.TenantID != nil {
-		tenantID := string(*res.TenantID)
-		body.TenantID = &tenantID
-	}
-	if res.Instances != nil {
-		body.Instances = make([]*InstanceResponseBody, len(res.Instances))
-		for i, val := range res.Instances {
-			if val == nil {
-				body.Instances[i] = nil
-				continue
-			}
-			body.Instances[i] = marshalControlplaneInstanceToInstanceResponseBody(val)
-		}
-	}
-	if res.ServiceInstances != nil {
-		body.ServiceInstances = make([]*ServiceInstanceResponseBody, len(res.ServiceInstances))
-		for i, val := range res.ServiceInstances {
-			if val == nil {
-				body.ServiceInstances[i] = nil
-				continue
-			}
-			body.ServiceInstances[i] = marshalControlplaneServiceInstanceToServiceInstanceResponseBody(val)
-		}
-	}
-	if res.Spec != nil {
-		body.Spec = marshalControlplaneDatabaseSpecToDatabaseSpecResponseBody(res.Spec)
+// NewApplyUpgradeDatabaseNotModifiableResponseBody builds the HTTP response
+// body from the result of the "apply-upgrade" endpoint of the "control-plane"
+// service.
+func NewApplyUpgradeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *ApplyUpgradeDatabaseNotModifiableResponseBody {
+	body := &ApplyUpgradeDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.AvailableUpgrades != nil {
-		body.AvailableUpgrades = make([]*AvailableUpgradeResponseBody, len(res.AvailableUpgrades))
-		for i, val := range res.AvailableUpgrades {
-			if val == nil {
-				body.AvailableUpgrades[i] = nil
-				continue
-			}
-			body.AvailableUpgrades[i] = marshalControlplaneAvailableUpgradeToAvailableUpgradeResponseBody(val)
-		}
+	return body
+}
+
+// NewApplyUpgradeOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "apply-upgrade" endpoint of the
+// "control-plane" service.
+func NewApplyUpgradeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *ApplyUpgradeOperationAlreadyInProgressResponseBody {
+	body := &ApplyUpgradeOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseResponseBody builds the HTTP response body from the result
-// of the "update-database" endpoint of the "control-plane" service.
-func NewUpdateDatabaseResponseBody(res *controlplane.UpdateDatabaseResponse) *UpdateDatabaseResponseBody {
-	body := &UpdateDatabaseResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
-	}
-	if res.Database != nil {
-		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+// NewApplyUpgradeInvalidInputResponseBody builds the HTTP response body from
+// the result of the "apply-upgrade" endpoint of the "control-plane" service.
+func NewApplyUpgradeInvalidInputResponseBody(res *controlplane.APIError) *ApplyUpgradeInvalidInputResponseBody {
+	body := &ApplyUpgradeInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeResponseBody builds the HTTP response body from the result of
-// the "apply-upgrade" endpoint of the "control-plane" service.
-func NewApplyUpgradeResponseBody(res *controlplane.ApplyUpgradeResponse) *ApplyUpgradeResponseBody {
-	body := &ApplyUpgradeResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
-	}
-	if res.Database != nil {
-		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+// NewApplyUpgradeNotFoundResponseBody builds the HTTP response body from the
+// result of the "apply-upgrade" endpoint of the "control-plane" service.
+func NewApplyUpgradeNotFoundResponseBody(res *controlplane.APIError) *ApplyUpgradeNotFoundResponseBody {
+	body := &ApplyUpgradeNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseResponseBody builds the HTTP response body from the result
-// of the "delete-database" endpoint of the "control-plane" service.
-func NewDeleteDatabaseResponseBody(res *controlplane.DeleteDatabaseResponse) *DeleteDatabaseResponseBody {
-	body := &DeleteDatabaseResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewApplyUpgradeServerErrorResponseBody builds the HTTP response body from
+// the result of the "apply-upgrade" endpoint of the "control-plane" service.
+func NewApplyUpgradeServerErrorResponseBody(res *controlplane.APIError) *ApplyUpgradeServerErrorResponseBody {
+	body := &ApplyUpgradeServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeResponseBody builds the HTTP response body from the
-// result of the "backup-database-node" endpoint of the "control-plane" service.
-func NewBackupDatabaseNodeResponseBody(res *controlplane.BackupDatabaseNodeResponse) *BackupDatabaseNodeResponseBody {
-	body := &BackupDatabaseNodeResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewUpgradeDatabaseMajorClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "upgrade-database-major" endpoint of
+// the "control-plane" service.
+func NewUpgradeDatabaseMajorClusterNotInitializedResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorClusterNotInitializedResponseBody {
+	body := &UpgradeDatabaseMajorClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeResponseBody builds the HTTP response body from the
-// result of the "switchover-database-node" endpoint of the "control-plane"
-// service.
-func NewSwitchoverDatabaseNodeResponseBody(res *controlplane.SwitchoverDatabaseNodeResponse) *SwitchoverDatabaseNodeResponseBody {
-	body := &SwitchoverDatabaseNodeResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewUpgradeDatabaseMajorDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "upgrade-database-major" endpoint of
+// the "control-plane" service.
+func NewUpgradeDatabaseMajorDatabaseNotModifiableResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorDatabaseNotModifiableResponseBody {
+	body := &UpgradeDatabaseMajorDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeResponseBody builds the HTTP response body from the
-// result of the "failover-database-node" endpoint of the "control-plane"
-// service.
-func NewFailoverDatabaseNodeResponseBody(res *controlplane.FailoverDatabaseNodeResponse) *FailoverDatabaseNodeResponseBody {
-	body := &FailoverDatabaseNodeResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody builds the
+// HTTP response body from the result of the "upgrade-database-major" endpoint
+// of the "control-plane" service.
+func NewUpgradeDatabaseMajorOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody {
+	body := &UpgradeDatabaseMajorOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabaseTasksResponseBody builds the HTTP response body from the
-// result of the "list-database-tasks" endpoint of the "control-plane" service.
-func NewListDatabaseTasksResponseBody(res *controlplane.ListDatabaseTasksResponse) *ListDatabaseTasksResponseBody {
-	body := &ListDatabaseTasksResponseBody{}
-	if res.Tasks != nil {
-		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
-		for i, val := range res.Tasks {
-			if val == nil {
-				body.Tasks[i] = nil
-				continue
-			}
-			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
-		}
-	} else {
-		body.Tasks = []*TaskResponseBody{}
+// NewUpgradeDatabaseMajorInvalidInputResponseBody builds the HTTP response
+// body from the result of the "upgrade-database-major" endpoint of the
+// "control-plane" service.
+func NewUpgradeDatabaseMajorInvalidInputResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorInvalidInputResponseBody {
+	body := &UpgradeDatabaseMajorInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskResponseBody builds the HTTP response body from the result
-// of the "get-database-task" endpoint of the "control-plane" service.
-func NewGetDatabaseTaskResponseBody(res *controlplane.Task) *GetDatabaseTaskResponseBody {
-	body := &GetDatabaseTaskResponseBody{
-		ParentID:    res.ParentID,
-		Scope:       res.Scope,
-		EntityID:    res.EntityID,
-		DatabaseID:  res.DatabaseID,
-		NodeName:    res.NodeName,
-		InstanceID:  res.InstanceID,
-		HostID:      res.HostID,
-		TaskID:      res.TaskID,
-		CreatedAt:   res.CreatedAt,
-		CompletedAt: res.CompletedAt,
-		Type:        res.Type,
-		Status:      res.Status,
-		Error:       res.Error,
+// NewUpgradeDatabaseMajorNotFoundResponseBody builds the HTTP response body
+// from the result of the "upgrade-database-major" endpoint of the
+// "control-plane" service.
+func NewUpgradeDatabaseMajorNotFoundResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorNotFoundResponseBody {
+	body := &UpgradeDatabaseMajorNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskLogResponseBody builds the HTTP response body from the
-// result of the "get-database-task-log" endpoint of the "control-plane"
-// service.
-func NewGetDatabaseTaskLogResponseBody(res *controlplane.TaskLog) *GetDatabaseTaskLogResponseBody {
-	body := &GetDatabaseTaskLogResponseBody{
-		Scope:       res.Scope,
-		EntityID:    res.EntityID,
-		DatabaseID:  res.DatabaseID,
-		TaskID:      res.TaskID,
-		TaskStatus:  res.TaskStatus,
-		LastEntryID: res.LastEntryID,
+// NewUpgradeDatabaseMajorServerErrorResponseBody builds the HTTP response body
+// from the result of the "upgrade-database-major" endpoint of the
+// "control-plane" service.
+func NewUpgradeDatabaseMajorServerErrorResponseBody(res *controlplane.APIError) *UpgradeDatabaseMajorServerErrorResponseBody {
+	body := &UpgradeDatabaseMajorServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.Entries != nil {
-		body.Entries = make([]*TaskLogEntryResponseBody, len(res.Entries))
-		for i, val := range res.Entries {
-			if val == nil {
-				body.Entries[i] = nil
-				continue
-			}
-			body.Entries[i] = marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(val)
-		}
-	} else {
-		body.Entries = []*TaskLogEntryResponseBody{}
+	return body
+}
+
+// NewCutoverDatabaseImportClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "cutover-database-import" endpoint of
+// the "control-plane" service.
+func NewCutoverDatabaseImportClusterNotInitializedResponseBody(res *controlplane.APIError) *CutoverDatabaseImportClusterNotInitializedResponseBody {
+	body := &CutoverDatabaseImportClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostTasksResponseBody builds the HTTP response body from the result
-// of the "list-host-tasks" endpoint of the "control-plane" service.
-func NewListHostTasksResponseBody(res *controlplane.ListHostTasksResponse) *ListHostTasksResponseBody {
-	body := &ListHostTasksResponseBody{}
-	if res.Tasks != nil {
-		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
-		for i, val := range res.Tasks {
-			if val == nil {
-				body.Tasks[i] = nil
-				continue
-			}
-			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
-		}
-	} else {
-		body.Tasks = []*TaskResponseBody{}
+// NewCutoverDatabaseImportDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "cutover-database-import" endpoint of
+// the "control-plane" service.
+func NewCutoverDatabaseImportDatabaseNotModifiableResponseBody(res *controlplane.APIError) *CutoverDatabaseImportDatabaseNotModifiableResponseBody {
+	body := &CutoverDatabaseImportDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskResponseBody builds the HTTP response body from the result of
-// the "get-host-task" endpoint of the "control-plane" service.
-func NewGetHostTaskResponseBody(res *controlplane.Task) *GetHostTaskResponseBody {
-	body := &GetHostTaskResponseBody{
-		ParentID:    res.ParentID,
-		Scope:       res.Scope,
-		EntityID:    res.EntityID,
-		DatabaseID:  res.DatabaseID,
-		NodeName:    res.NodeName,
-		InstanceID:  res.InstanceID,
-		HostID:      res.HostID,
-		TaskID:      res.TaskID,
-		CreatedAt:   res.CreatedAt,
-		CompletedAt: res.CompletedAt,
-		Type:        res.Type,
-		Status:      res.Status,
-		Error:       res.Error,
+// NewCutoverDatabaseImportOperationAlreadyInProgressResponseBody builds the
+// HTTP response body from the result of the "cutover-database-import" endpoint
+// of the "control-plane" service.
+func NewCutoverDatabaseImportOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *CutoverDatabaseImportOperationAlreadyInProgressResponseBody {
+	body := &CutoverDatabaseImportOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskLogResponseBody builds the HTTP response body from the result
-// of the "get-host-task-log" endpoint of the "control-plane" service.
-func NewGetHostTaskLogResponseBody(res *controlplane.TaskLog) *GetHostTaskLogResponseBody {
-	body := &GetHostTaskLogResponseBody{
-		Scope:       res.Scope,
-		EntityID:    res.EntityID,
-		DatabaseID:  res.DatabaseID,
-		TaskID:      res.TaskID,
-		TaskStatus:  res.TaskStatus,
-		LastEntryID: res.LastEntryID,
+// NewCutoverDatabaseImportInvalidInputResponseBody builds the HTTP response
+// body from the result of the "cutover-database-import" endpoint of the
+// "control-plane" service.
+func NewCutoverDatabaseImportInvalidInputResponseBody(res *controlplane.APIError) *CutoverDatabaseImportInvalidInputResponseBody {
+	body := &CutoverDatabaseImportInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.Entries != nil {
-		body.Entries = make([]*TaskLogEntryResponseBody, len(res.Entries))
-		for i, val := range res.Entries {
-			if val == nil {
-				body.Entries[i] = nil
-				continue
-			}
-			body.Entries[i] = marshalControlplaneTaskLogEntryToTaskLogEntryResponseBody(val)
-		}
-	} else {
-		body.Entries = []*TaskLogEntryResponseBody{}
+	return body
+}
+
+// NewCutoverDatabaseImportNotFoundResponseBody builds the HTTP response body
+// from the result of the "cutover-database-import" endpoint of the
+// "control-plane" service.
+func NewCutoverDatabaseImportNotFoundResponseBody(res *controlplane.APIError) *CutoverDatabaseImportNotFoundResponseBody {
+	body := &CutoverDatabaseImportNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListTasksResponseBody builds the HTTP response body from the result of
-// the "list-tasks" endpoint of the "control-plane" service.
-func NewListTasksResponseBody(res *controlplane.ListTasksResponse) *ListTasksResponseBody {
-	body := &ListTasksResponseBody{}
-	if res.Tasks != nil {
-		body.Tasks = make([]*TaskResponseBody, len(res.Tasks))
-		for i, val := range res.Tasks {
-			if val == nil {
-				body.Tasks[i] = nil
-				continue
-			}
-			body.Tasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
-		}
-	} else {
-		body.Tasks = []*TaskResponseBody{}
+// NewCutoverDatabaseImportServerErrorResponseBody builds the HTTP response
+// body from the result of the "cutover-database-import" endpoint of the
+// "control-plane" service.
+func NewCutoverDatabaseImportServerErrorResponseBody(res *controlplane.APIError) *CutoverDatabaseImportServerErrorResponseBody {
+	body := &CutoverDatabaseImportServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseResponseBody builds the HTTP response body from the result
-// of the "restore-database" endpoint of the "control-plane" service.
-func NewRestoreDatabaseResponseBody(res *controlplane.RestoreDatabaseResponse) *RestoreDatabaseResponseBody {
-	body := &RestoreDatabaseResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewDeleteDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "delete-database" endpoint of the
+// "control-plane" service.
+func NewDeleteDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *DeleteDatabaseClusterNotInitializedResponseBody {
+	body := &DeleteDatabaseClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.NodeTasks != nil {
-		body.NodeTasks = make([]*TaskResponseBody, len(res.NodeTasks))
-		for i, val := range res.NodeTasks {
-			if val == nil {
-				body.NodeTasks[i] = nil
-				continue
-			}
-			body.NodeTasks[i] = marshalControlplaneTaskToTaskResponseBody(val)
-		}
-	} else {
-		body.NodeTasks = []*TaskResponseBody{}
+	return body
+}
+
+// NewDeleteDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
+// body from the result of the "delete-database" endpoint of the
+// "control-plane" service.
+func NewDeleteDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *DeleteDatabaseDatabaseNotModifiableResponseBody {
+	body := &DeleteDatabaseDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
-	if res.Database != nil {
-		body.Database = marshalControlplaneDatabaseToDatabaseResponseBody(res.Database)
+	return body
+}
+
+// NewDeleteDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "delete-database" endpoint of the
+// "control-plane" service.
+func NewDeleteDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *DeleteDatabaseOperationAlreadyInProgressResponseBody {
+	body := &DeleteDatabaseOperationAlreadyInProgressResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetVersionResponseBody builds the HTTP response body from the result of
-// the "get-version" endpoint of the "control-plane" service.
-func NewGetVersionResponseBody(res *controlplane.VersionInfo) *GetVersionResponseBody {
-	body := &GetVersionResponseBody{
-		Version:      res.Version,
-		Revision:     res.Revision,
-		RevisionTime: res.RevisionTime,
-		Arch:         res.Arch,
+// NewDeleteDatabaseInvalidInputResponseBody builds the HTTP response body from
+// the result of the "delete-database" endpoint of the "control-plane" service.
+func NewDeleteDatabaseInvalidInputResponseBody(res *controlplane.APIError) *DeleteDatabaseInvalidInputResponseBody {
+	body := &DeleteDatabaseInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestartInstanceResponseBody builds the HTTP response body from the result
-// of the "restart-instance" endpoint of the "control-plane" service.
-func NewRestartInstanceResponseBody(res *controlplane.RestartInstanceResponse) *RestartInstanceResponseBody {
-	body := &RestartInstanceResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewDeleteDatabaseNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete-database" endpoint of the "control-plane" service.
+func NewDeleteDatabaseNotFoundResponseBody(res *controlplane.APIError) *DeleteDatabaseNotFoundResponseBody {
+	body := &DeleteDatabaseNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewStopInstanceResponseBody builds the HTTP response body from the result of
-// the "stop-instance" endpoint of the "control-plane" service.
-func NewStopInstanceResponseBody(res *controlplane.StopInstanceResponse) *StopInstanceResponseBody {
-	body := &StopInstanceResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewDeleteDatabaseServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete-database" endpoint of the "control-plane" service.
+func NewDeleteDatabaseServerErrorResponseBody(res *controlplane.APIError) *DeleteDatabaseServerErrorResponseBody {
+	body := &DeleteDatabaseServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewStartInstanceResponseBody builds the HTTP response body from the result
-// of the "start-instance" endpoint of the "control-plane" service.
-func NewStartInstanceResponseBody(res *controlplane.StartInstanceResponse) *StartInstanceResponseBody {
-	body := &StartInstanceResponseBody{}
-	if res.Task != nil {
-		body.Task = marshalControlplaneTaskToTaskResponseBody(res.Task)
+// NewBackupDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "backup-database-node" endpoint of the
+// "control-plane" service.
+func NewBackupDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *BackupDatabaseNodeClusterNotInitializedResponseBody {
+	body := &BackupDatabaseNodeClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewCancelDatabaseTaskResponseBody builds the HTTP response body from the
-// result of the "cancel-database-task" endpoint of the "control-plane" service.
-func NewCancelDatabaseTaskResponseBody(res *controlplane.Task) *CancelDatabaseTaskResponseBody {
-	body := &CancelDatabaseTaskResponseBody{
-		ParentID:    res.ParentID,
-		Scope:       res.Scope,
-		EntityID:    res.EntityID,
-		DatabaseID:  res.DatabaseID,
-		NodeName:    res.NodeName,
-		InstanceID:  res.InstanceID,
-		HostID:      res.HostID,
-		TaskID:      res.TaskID,
-		CreatedAt:   res.CreatedAt,
-		CompletedAt: res.CompletedAt,
-		Type:        res.Type,
-		Status:      res.Status,
-		Error:       res.Error,
+// NewBackupDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "backup-database-node" endpoint of the
+// "control-plane" service.
+func NewBackupDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *BackupDatabaseNodeDatabaseNotModifiableResponseBody {
+	body := &BackupDatabaseNodeDatabaseNotModifiableResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewInitClusterClusterAlreadyInitializedResponseBody builds the HTTP response
-// body from the result of the "init-cluster" endpoint of the "control-plane"
-// service.
-func NewInitClusterClusterAlreadyInitializedResponseBody(res *controlplane.APIError) *InitClusterClusterAlreadyInitializedResponseBody {
-	body := &InitClusterClusterAlreadyInitializedResponseBody{
+// NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "backup-database-node" endpoint of the
+// "control-plane" service.
+func NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *BackupDatabaseNodeOperationAlreadyInProgressResponseBody {
+	body := &BackupDatabaseNodeOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInitClusterOperationNotSupportedResponseBody builds the HTTP response
-// body from the result of the "init-cluster" endpoint of the "control-plane"
-// service.
-func NewInitClusterOperationNotSupportedResponseBody(res *controlplane.APIError) *InitClusterOperationNotSupportedResponseBody {
-	body := &InitClusterOperationNotSupportedResponseBody{
+// NewBackupDatabaseNodeInvalidInputResponseBody builds the HTTP response body
+// from the result of the "backup-database-node" endpoint of the
+// "control-plane" service.
+func NewBackupDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *BackupDatabaseNodeInvalidInputResponseBody {
+	body := &BackupDatabaseNodeInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewInitClusterServerErrorResponseBody builds the HTTP response body from the
-// result of the "init-cluster" endpoint of the "control-plane" service.
-func NewInitClusterServerErrorResponseBody(res *controlplane.APIError) *InitClusterServerErrorResponseBody {
-	body := &InitClusterServerErrorResponseBody{
+// NewBackupDatabaseNodeNotFoundResponseBody builds the HTTP response body from
+// the result of the "backup-database-node" endpoint of the "control-plane"
+// service.
+func NewBackupDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *BackupDatabaseNodeNotFoundResponseBody {
+	body := &BackupDatabaseNodeNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewJoinClusterClusterAlreadyInitializedResponseBody builds the HTTP response
-// body from the result of the "join-cluster" endpoint of the "control-plane"
-// service.
-func NewJoinClusterClusterAlreadyInitializedResponseBody(res *controlplane.APIError) *JoinClusterClusterAlreadyInitializedResponseBody {
-	body := &JoinClusterClusterAlreadyInitializedResponseBody{
+// NewBackupDatabaseNodeServerErrorResponseBody builds the HTTP response body
+// from the result of the "backup-database-node" endpoint of the
+// "control-plane" service.
+func NewBackupDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *BackupDatabaseNodeServerErrorResponseBody {
+	body := &BackupDatabaseNodeServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewJoinClusterInvalidJoinTokenResponseBody builds the HTTP response body
-// from the result of the "join-cluster" endpoint of the "control-plane"
-// service.
-func NewJoinClusterInvalidJoinTokenResponseBody(res *controlplane.APIError) *JoinClusterInvalidJoinTokenResponseBody {
-	body := &JoinClusterInvalidJoinTokenResponseBody{
+// NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "switchover-database-node" endpoint of
+// the "control-plane" service.
+func NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeClusterNotInitializedResponseBody {
+	body := &SwitchoverDatabaseNodeClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewJoinClusterInvalidInputResponseBody builds the HTTP response body from
-// the result of the "join-cluster" endpoint of the "control-plane" service.
-func NewJoinClusterInvalidInputResponseBody(res *controlplane.APIError) *JoinClusterInvalidInputResponseBody {
-	body := &JoinClusterInvalidInputResponseBody{
+// NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "switchover-database-node" endpoint of
+// the "control-plane" service.
+func NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody {
+	body := &SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewJoinClusterServerErrorResponseBody builds the HTTP response body from the
-// result of the "join-cluster" endpoint of the "control-plane" service.
-func NewJoinClusterServerErrorResponseBody(res *controlplane.APIError) *JoinClusterServerErrorResponseBody {
-	body := &JoinClusterServerErrorResponseBody{
+// NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody builds the
+// HTTP response body from the result of the "switchover-database-node"
+// endpoint of the "control-plane" service.
+func NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody {
+	body := &SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinTokenClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-join-token" endpoint of the "control-plane"
-// service.
-func NewGetJoinTokenClusterNotInitializedResponseBody(res *controlplane.APIError) *GetJoinTokenClusterNotInitializedResponseBody {
-	body := &GetJoinTokenClusterNotInitializedResponseBody{
+// NewSwitchoverDatabaseNodeInvalidInputResponseBody builds the HTTP response
+// body from the result of the "switchover-database-node" endpoint of the
+// "control-plane" service.
+func NewSwitchoverDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeInvalidInputResponseBody {
+	body := &SwitchoverDatabaseNodeInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinTokenServerErrorResponseBody builds the HTTP response body from
-// the result of the "get-join-token" endpoint of the "control-plane" service.
-func NewGetJoinTokenServerErrorResponseBody(res *controlplane.APIError) *GetJoinTokenServerErrorResponseBody {
-	body := &GetJoinTokenServerErrorResponseBody{
+// NewSwitchoverDatabaseNodeNotFoundResponseBody builds the HTTP response body
+// from the result of the "switchover-database-node" endpoint of the
+// "control-plane" service.
+func NewSwitchoverDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeNotFoundResponseBody {
+	body := &SwitchoverDatabaseNodeNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinOptionsClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-join-options" endpoint of the
+// NewSwitchoverDatabaseNodeServerErrorResponseBody builds the HTTP response
+// body from the result of the "switchover-database-node" endpoint of the
 // "control-plane" service.
-func NewGetJoinOptionsClusterNotInitializedResponseBody(res *controlplane.APIError) *GetJoinOptionsClusterNotInitializedResponseBody {
-	body := &GetJoinOptionsClusterNotInitializedResponseBody{
+func NewSwitchoverDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeServerErrorResponseBody {
+	body := &SwitchoverDatabaseNodeServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinOptionsInvalidJoinTokenResponseBody builds the HTTP response body
-// from the result of the "get-join-options" endpoint of the "control-plane"
-// service.
-func NewGetJoinOptionsInvalidJoinTokenResponseBody(res *controlplane.APIError) *GetJoinOptionsInvalidJoinTokenResponseBody {
-	body := &GetJoinOptionsInvalidJoinTokenResponseBody{
+// NewRollingRestartDatabaseClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "rolling-restart-database" endpoint of
+// the "control-plane" service.
+func NewRollingRestartDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *RollingRestartDatabaseClusterNotInitializedResponseBody {
+	body := &RollingRestartDatabaseClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinOptionsInvalidInputResponseBody builds the HTTP response body from
-// the result of the "get-join-options" endpoint of the "control-plane" service.
-func NewGetJoinOptionsInvalidInputResponseBody(res *controlplane.APIError) *GetJoinOptionsInvalidInputResponseBody {
-	body := &GetJoinOptionsInvalidInputResponseBody{
+// NewRollingRestartDatabaseDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "rolling-restart-database" endpoint of
+// the "control-plane" service.
+func NewRollingRestartDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *RollingRestartDatabaseDatabaseNotModifiableResponseBody {
+	body := &RollingRestartDatabaseDatabaseNotModifiableResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetJoinOptionsServerErrorResponseBody builds the HTTP response body from
-// the result of the "get-join-options" endpoint of the "control-plane" service.
-func NewGetJoinOptionsServerErrorResponseBody(res *controlplane.APIError) *GetJoinOptionsServerErrorResponseBody {
-	body := &GetJoinOptionsServerErrorResponseBody{
+// NewRollingRestartDatabaseOperationAlreadyInProgressResponseBody builds the
+// HTTP response body from the result of the "rolling-restart-database"
+// endpoint of the "control-plane" service.
+func NewRollingRestartDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *RollingRestartDatabaseOperationAlreadyInProgressResponseBody {
+	body := &RollingRestartDatabaseOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetClusterClusterNotInitializedResponseBody builds the HTTP response body
-// from the result of the "get-cluster" endpoint of the "control-plane" service.
-func NewGetClusterClusterNotInitializedResponseBody(res *controlplane.APIError) *GetClusterClusterNotInitializedResponseBody {
-	body := &GetClusterClusterNotInitializedResponseBody{
+// NewRollingRestartDatabaseInvalidInputResponseBody builds the HTTP response
+// body from the result of the "rolling-restart-database" endpoint of the
+// "control-plane" service.
+func NewRollingRestartDatabaseInvalidInputResponseBody(res *controlplane.APIError) *RollingRestartDatabaseInvalidInputResponseBody {
+	body := &RollingRestartDatabaseInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetClusterServerErrorResponseBody builds the HTTP response body from the
-// result of the "get-cluster" endpoint of the "control-plane" service.
-func NewGetClusterServerErrorResponseBody(res *controlplane.APIError) *GetClusterServerErrorResponseBody {
-	body := &GetClusterServerErrorResponseBody{
+// NewRollingRestartDatabaseNotFoundResponseBody builds the HTTP response body
+// from the result of the "rolling-restart-database" endpoint of the
+// "control-plane" service.
+func NewRollingRestartDatabaseNotFoundResponseBody(res *controlplane.APIError) *RollingRestartDatabaseNotFoundResponseBody {
+	body := &RollingRestartDatabaseNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostsClusterNotInitializedResponseBody builds the HTTP response body
-// from the result of the "list-hosts" endpoint of the "control-plane" service.
-func NewListHostsClusterNotInitializedResponseBody(res *controlplane.APIError) *ListHostsClusterNotInitializedResponseBody {
-	body := &ListHostsClusterNotInitializedResponseBody{
+// NewRollingRestartDatabaseServerErrorResponseBody builds the HTTP response
+// body from the result of the "rolling-restart-database" endpoint of the
+// "control-plane" service.
+func NewRollingRestartDatabaseServerErrorResponseBody(res *controlplane.APIError) *RollingRestartDatabaseServerErrorResponseBody {
+	body := &RollingRestartDatabaseServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostsServerErrorResponseBody builds the HTTP response body from the
-// result of the "list-hosts" endpoint of the "control-plane" service.
-func NewListHostsServerErrorResponseBody(res *controlplane.APIError) *ListHostsServerErrorResponseBody {
-	body := &ListHostsServerErrorResponseBody{
+// NewFailoverDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "failover-database-node" endpoint of
+// the "control-plane" service.
+func NewFailoverDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeClusterNotInitializedResponseBody {
+	body := &FailoverDatabaseNodeClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostClusterNotInitializedResponseBody builds the HTTP response body
-// from the result of the "get-host" endpoint of the "control-plane" service.
-func NewGetHostClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostClusterNotInitializedResponseBody {
-	body := &GetHostClusterNotInitializedResponseBody{
+// NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
+// response body from the result of the "failover-database-node" endpoint of
+// the "control-plane" service.
+func NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeDatabaseNotModifiableResponseBody {
+	body := &FailoverDatabaseNodeDatabaseNotModifiableResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostInvalidInputResponseBody builds the HTTP response body from the
-// result of the "get-host" endpoint of the "control-plane" service.
-func NewGetHostInvalidInputResponseBody(res *controlplane.APIError) *GetHostInvalidInputResponseBody {
-	body := &GetHostInvalidInputResponseBody{
+// NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody builds the
+// HTTP response body from the result of the "failover-database-node" endpoint
+// of the "control-plane" service.
+func NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeOperationAlreadyInProgressResponseBody {
+	body := &FailoverDatabaseNodeOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostNotFoundResponseBody builds the HTTP response body from the result
-// of the "get-host" endpoint of the "control-plane" service.
-func NewGetHostNotFoundResponseBody(res *controlplane.APIError) *GetHostNotFoundResponseBody {
-	body := &GetHostNotFoundResponseBody{
+// NewFailoverDatabaseNodeInvalidInputResponseBody builds the HTTP response
+// body from the result of the "failover-database-node" endpoint of the
+// "control-plane" service.
+func NewFailoverDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeInvalidInputResponseBody {
+	body := &FailoverDatabaseNodeInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostServerErrorResponseBody builds the HTTP response body from the
-// result of the "get-host" endpoint of the "control-plane" service.
-func NewGetHostServerErrorResponseBody(res *controlplane.APIError) *GetHostServerErrorResponseBody {
-	body := &GetHostServerErrorResponseBody{
+// NewFailoverDatabaseNodeNotFoundResponseBody builds the HTTP response body
+// from the result of the "failover-database-node" endpoint of the
+// "control-plane" service.
+func NewFailoverDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeNotFoundResponseBody {
+	body := &FailoverDatabaseNodeNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRemoveHostClusterNotInitializedResponseBody builds the HTTP response body
-// from the result of the "remove-host" endpoint of the "control-plane" service.
-func NewRemoveHostClusterNotInitializedResponseBody(res *controlplane.APIError) *RemoveHostClusterNotInitializedResponseBody {
-	body := &RemoveHostClusterNotInitializedResponseBody{
+// NewFailoverDatabaseNodeServerErrorResponseBody builds the HTTP response body
+// from the result of the "failover-database-node" endpoint of the
+// "control-plane" service.
+func NewFailoverDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeServerErrorResponseBody {
+	body := &FailoverDatabaseNodeServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRemoveHostInvalidInputResponseBody builds the HTTP response body from the
-// result of the "remove-host" endpoint of the "control-plane" service.
-func NewRemoveHostInvalidInputResponseBody(res *controlplane.APIError) *RemoveHostInvalidInputResponseBody {
-	body := &RemoveHostInvalidInputResponseBody{
+// NewGetNodeQueryStatsClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "get-node-query-stats" endpoint of the
+// "control-plane" service.
+func NewGetNodeQueryStatsClusterNotInitializedResponseBody(res *controlplane.APIError) *GetNodeQueryStatsClusterNotInitializedResponseBody {
+	body := &GetNodeQueryStatsClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRemoveHostNotFoundResponseBody builds the HTTP response body from the
-// result of the "remove-host" endpoint of the "control-plane" service.
-func NewRemoveHostNotFoundResponseBody(res *controlplane.APIError) *RemoveHostNotFoundResponseBody {
-	body := &RemoveHostNotFoundResponseBody{
+// NewGetNodeQueryStatsInvalidInputResponseBody builds the HTTP response body
+// from the result of the "get-node-query-stats" endpoint of the
+// "control-plane" service.
+func NewGetNodeQueryStatsInvalidInputResponseBody(res *controlplane.APIError) *GetNodeQueryStatsInvalidInputResponseBody {
+	body := &GetNodeQueryStatsInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRemoveHostServerErrorResponseBody builds the HTTP response body from the
-// result of the "remove-host" endpoint of the "control-plane" service.
-func NewRemoveHostServerErrorResponseBody(res *controlplane.APIError) *RemoveHostServerErrorResponseBody {
-	body := &RemoveHostServerErrorResponseBody{
+// NewGetNodeQueryStatsNotFoundResponseBody builds the HTTP response body from
+// the result of the "get-node-query-stats" endpoint of the "control-plane"
+// service.
+func NewGetNodeQueryStatsNotFoundResponseBody(res *controlplane.APIError) *GetNodeQueryStatsNotFoundResponseBody {
+	body := &GetNodeQueryStatsNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabasesClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "list-databases" endpoint of the "control-plane"
-// service.
-func NewListDatabasesClusterNotInitializedResponseBody(res *controlplane.APIError) *ListDatabasesClusterNotInitializedResponseBody {
-	body := &ListDatabasesClusterNotInitializedResponseBody{
+// NewGetNodeQueryStatsServerErrorResponseBody builds the HTTP response body
+// from the result of the "get-node-query-stats" endpoint of the
+// "control-plane" service.
+func NewGetNodeQueryStatsServerErrorResponseBody(res *controlplane.APIError) *GetNodeQueryStatsServerErrorResponseBody {
+	body := &GetNodeQueryStatsServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabasesServerErrorResponseBody builds the HTTP response body from
-// the result of the "list-databases" endpoint of the "control-plane" service.
-func NewListDatabasesServerErrorResponseBody(res *controlplane.APIError) *ListDatabasesServerErrorResponseBody {
-	body := &ListDatabasesServerErrorResponseBody{
+// NewResetNodeQueryStatsClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "reset-node-query-stats" endpoint of
+// the "control-plane" service.
+func NewResetNodeQueryStatsClusterNotInitializedResponseBody(res *controlplane.APIError) *ResetNodeQueryStatsClusterNotInitializedResponseBody {
+	body := &ResetNodeQueryStatsClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseDatabaseAlreadyExistsResponseBody builds the HTTP response
-// body from the result of the "create-database" endpoint of the
+// NewResetNodeQueryStatsInvalidInputResponseBody builds the HTTP response body
+// from the result of the "reset-node-query-stats" endpoint of the
 // "control-plane" service.
-func NewCreateDatabaseDatabaseAlreadyExistsResponseBody(res *controlplane.APIError) *CreateDatabaseDatabaseAlreadyExistsResponseBody {
-	body := &CreateDatabaseDatabaseAlreadyExistsResponseBody{
+func NewResetNodeQueryStatsInvalidInputResponseBody(res *controlplane.APIError) *ResetNodeQueryStatsInvalidInputResponseBody {
+	body := &ResetNodeQueryStatsInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "create-database" endpoint of the
+// NewResetNodeQueryStatsNotFoundResponseBody builds the HTTP response body
+// from the result of the "reset-node-query-stats" endpoint of the
 // "control-plane" service.
-func NewCreateDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *CreateDatabaseClusterNotInitializedResponseBody {
-	body := &CreateDatabaseClusterNotInitializedResponseBody{
+func NewResetNodeQueryStatsNotFoundResponseBody(res *controlplane.APIError) *ResetNodeQueryStatsNotFoundResponseBody {
+	body := &ResetNodeQueryStatsNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "create-database" endpoint of the
+// NewResetNodeQueryStatsServerErrorResponseBody builds the HTTP response body
+// from the result of the "reset-node-query-stats" endpoint of the
 // "control-plane" service.
-func NewCreateDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *CreateDatabaseOperationAlreadyInProgressResponseBody {
-	body := &CreateDatabaseOperationAlreadyInProgressResponseBody{
+func NewResetNodeQueryStatsServerErrorResponseBody(res *controlplane.APIError) *ResetNodeQueryStatsServerErrorResponseBody {
+	body := &ResetNodeQueryStatsServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseInvalidInputResponseBody builds the HTTP response body from
-// the result of the "create-database" endpoint of the "control-plane" service.
-func NewCreateDatabaseInvalidInputResponseBody(res *controlplane.APIError) *CreateDatabaseInvalidInputResponseBody {
-	body := &CreateDatabaseInvalidInputResponseBody{
+// NewListDatabaseTasksClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "list-database-tasks" endpoint of the
+// "control-plane" service.
+func NewListDatabaseTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListDatabaseTasksClusterNotInitializedResponseBody {
+	body := &ListDatabaseTasksClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateDatabaseServerErrorResponseBody builds the HTTP response body from
-// the result of the "create-database" endpoint of the "control-plane" service.
-func NewCreateDatabaseServerErrorResponseBody(res *controlplane.APIError) *CreateDatabaseServerErrorResponseBody {
-	body := &CreateDatabaseServerErrorResponseBody{
+// NewListDatabaseTasksInvalidInputResponseBody builds the HTTP response body
+// from the result of the "list-database-tasks" endpoint of the "control-plane"
+// service.
+func NewListDatabaseTasksInvalidInputResponseBody(res *controlplane.APIError) *ListDatabaseTasksInvalidInputResponseBody {
+	body := &ListDatabaseTasksInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-database" endpoint of the "control-plane"
+// NewListDatabaseTasksNotFoundResponseBody builds the HTTP response body from
+// the result of the "list-database-tasks" endpoint of the "control-plane"
 // service.
-func NewGetDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseClusterNotInitializedResponseBody {
-	body := &GetDatabaseClusterNotInitializedResponseBody{
+func NewListDatabaseTasksNotFoundResponseBody(res *controlplane.APIError) *ListDatabaseTasksNotFoundResponseBody {
+	body := &ListDatabaseTasksNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseInvalidInputResponseBody builds the HTTP response body from
-// the result of the "get-database" endpoint of the "control-plane" service.
-func NewGetDatabaseInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseInvalidInputResponseBody {
-	body := &GetDatabaseInvalidInputResponseBody{
+// NewListDatabaseTasksServerErrorResponseBody builds the HTTP response body
+// from the result of the "list-database-tasks" endpoint of the "control-plane"
+// service.
+func NewListDatabaseTasksServerErrorResponseBody(res *controlplane.APIError) *ListDatabaseTasksServerErrorResponseBody {
+	body := &ListDatabaseTasksServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseNotFoundResponseBody builds the HTTP response body from the
-// result of the "get-database" endpoint of the "control-plane" service.
-func NewGetDatabaseNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseNotFoundResponseBody {
-	body := &GetDatabaseNotFoundResponseBody{
+// NewGetDatabaseTaskClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-database-task" endpoint of the
+// "control-plane" service.
+func NewGetDatabaseTaskClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseTaskClusterNotInitializedResponseBody {
+	body := &GetDatabaseTaskClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseServerErrorResponseBody builds the HTTP response body from the
-// result of the "get-database" endpoint of the "control-plane" service.
-func NewGetDatabaseServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseServerErrorResponseBody {
-	body := &GetDatabaseServerErrorResponseBody{
+// NewGetDatabaseTaskInvalidInputResponseBody builds the HTTP response body
+// from the result of the "get-database-task" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseTaskInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseTaskInvalidInputResponseBody {
+	body := &GetDatabaseTaskInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "update-database" endpoint of the
-// "control-plane" service.
-func NewUpdateDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *UpdateDatabaseClusterNotInitializedResponseBody {
-	body := &UpdateDatabaseClusterNotInitializedResponseBody{
+// NewGetDatabaseTaskNotFoundResponseBody builds the HTTP response body from
+// the result of the "get-database-task" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseTaskNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseTaskNotFoundResponseBody {
+	body := &GetDatabaseTaskNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
-// body from the result of the "update-database" endpoint of the
-// "control-plane" service.
-func NewUpdateDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *UpdateDatabaseDatabaseNotModifiableResponseBody {
-	body := &UpdateDatabaseDatabaseNotModifiableResponseBody{
+// NewGetDatabaseTaskServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-database-task" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseTaskServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseTaskServerErrorResponseBody {
+	body := &GetDatabaseTaskServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "update-database" endpoint of the
+// NewGetDatabaseTaskLogClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "get-database-task-log" endpoint of the
 // "control-plane" service.
-func NewUpdateDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *UpdateDatabaseOperationAlreadyInProgressResponseBody {
-	body := &UpdateDatabaseOperationAlreadyInProgressResponseBody{
+func NewGetDatabaseTaskLogClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogClusterNotInitializedResponseBody {
+	body := &GetDatabaseTaskLogClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseInvalidInputResponseBody builds the HTTP response body from
-// the result of the "update-database" endpoint of the "control-plane" service.
-func NewUpdateDatabaseInvalidInputResponseBody(res *controlplane.APIError) *UpdateDatabaseInvalidInputResponseBody {
-	body := &UpdateDatabaseInvalidInputResponseBody{
+// NewGetDatabaseTaskLogInvalidInputResponseBody builds the HTTP response body
+// from the result of the "get-database-task-log" endpoint of the
+// "control-plane" service.
+func NewGetDatabaseTaskLogInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogInvalidInputResponseBody {
+	body := &GetDatabaseTaskLogInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseNotFoundResponseBody builds the HTTP response body from the
-// result of the "update-database" endpoint of the "control-plane" service.
-func NewUpdateDatabaseNotFoundResponseBody(res *controlplane.APIError) *UpdateDatabaseNotFoundResponseBody {
-	body := &UpdateDatabaseNotFoundResponseBody{
+// NewGetDatabaseTaskLogNotFoundResponseBody builds the HTTP response body from
+// the result of the "get-database-task-log" endpoint of the "control-plane"
+// service.
+func NewGetDatabaseTaskLogNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogNotFoundResponseBody {
+	body := &GetDatabaseTaskLogNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateDatabaseServerErrorResponseBody builds the HTTP response body from
-// the result of the "update-database" endpoint of the "control-plane" service.
-func NewUpdateDatabaseServerErrorResponseBody(res *controlplane.APIError) *UpdateDatabaseServerErrorResponseBody {
-	body := &UpdateDatabaseServerErrorResponseBody{
+// NewGetDatabaseTaskLogServerErrorResponseBody builds the HTTP response body
+// from the result of the "get-database-task-log" endpoint of the
+// "control-plane" service.
+func NewGetDatabaseTaskLogServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogServerErrorResponseBody {
+	body := &GetDatabaseTaskLogServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "apply-upgrade" endpoint of the "control-plane"
-// service.
-func NewApplyUpgradeClusterNotInitializedResponseBody(res *controlplane.APIError) *ApplyUpgradeClusterNotInitializedResponseBody {
-	body := &ApplyUpgradeClusterNotInitializedResponseBody{
+// NewListHostTasksClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "list-host-tasks" endpoint of the
+// "control-plane" service.
+func NewListHostTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListHostTasksClusterNotInitializedResponseBody {
+	body := &ListHostTasksClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeDatabaseNotModifiableResponseBody builds the HTTP response
-// body from the result of the "apply-upgrade" endpoint of the "control-plane"
-// service.
-func NewApplyUpgradeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *ApplyUpgradeDatabaseNotModifiableResponseBody {
-	body := &ApplyUpgradeDatabaseNotModifiableResponseBody{
+// NewListHostTasksInvalidInputResponseBody builds the HTTP response body from
+// the result of the "list-host-tasks" endpoint of the "control-plane" service.
+func NewListHostTasksInvalidInputResponseBody(res *controlplane.APIError) *ListHostTasksInvalidInputResponseBody {
+	body := &ListHostTasksInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "apply-upgrade" endpoint of the
-// "control-plane" service.
-func NewApplyUpgradeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *ApplyUpgradeOperationAlreadyInProgressResponseBody {
-	body := &ApplyUpgradeOperationAlreadyInProgressResponseBody{
+// NewListHostTasksNotFoundResponseBody builds the HTTP response body from the
+// result of the "list-host-tasks" endpoint of the "control-plane" service.
+func NewListHostTasksNotFoundResponseBody(res *controlplane.APIError) *ListHostTasksNotFoundResponseBody {
+	body := &ListHostTasksNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeInvalidInputResponseBody builds the HTTP response body from
-// the result of the "apply-upgrade" endpoint of the "control-plane" service.
-func NewApplyUpgradeInvalidInputResponseBody(res *controlplane.APIError) *ApplyUpgradeInvalidInputResponseBody {
-	body := &ApplyUpgradeInvalidInputResponseBody{
+// NewListHostTasksServerErrorResponseBody builds the HTTP response body from
+// the result of the "list-host-tasks" endpoint of the "control-plane" service.
+func NewListHostTasksServerErrorResponseBody(res *controlplane.APIError) *ListHostTasksServerErrorResponseBody {
+	body := &ListHostTasksServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeNotFoundResponseBody builds the HTTP response body from the
-// result of the "apply-upgrade" endpoint of the "control-plane" service.
-func NewApplyUpgradeNotFoundResponseBody(res *controlplane.APIError) *ApplyUpgradeNotFoundResponseBody {
-	body := &ApplyUpgradeNotFoundResponseBody{
+// NewGetHostTaskClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-host-task" endpoint of the "control-plane"
+// service.
+func NewGetHostTaskClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostTaskClusterNotInitializedResponseBody {
+	body := &GetHostTaskClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewApplyUpgradeServerErrorResponseBody builds the HTTP response body from
-// the result of the "apply-upgrade" endpoint of the "control-plane" service.
-func NewApplyUpgradeServerErrorResponseBody(res *controlplane.APIError) *ApplyUpgradeServerErrorResponseBody {
-	body := &ApplyUpgradeServerErrorResponseBody{
+// NewGetHostTaskInvalidInputResponseBody builds the HTTP response body from
+// the result of the "get-host-task" endpoint of the "control-plane" service.
+func NewGetHostTaskInvalidInputResponseBody(res *controlplane.APIError) *GetHostTaskInvalidInputResponseBody {
+	body := &GetHostTaskInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "delete-database" endpoint of the
-// "control-plane" service.
-func NewDeleteDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *DeleteDatabaseClusterNotInitializedResponseBody {
-	body := &DeleteDatabaseClusterNotInitializedResponseBody{
+// NewGetHostTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "get-host-task" endpoint of the "control-plane" service.
+func NewGetHostTaskNotFoundResponseBody(res *controlplane.APIError) *GetHostTaskNotFoundResponseBody {
+	body := &GetHostTaskNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
-// body from the result of the "delete-database" endpoint of the
-// "control-plane" service.
-func NewDeleteDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *DeleteDatabaseDatabaseNotModifiableResponseBody {
-	body := &DeleteDatabaseDatabaseNotModifiableResponseBody{
+// NewGetHostTaskServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-host-task" endpoint of the "control-plane" service.
+func NewGetHostTaskServerErrorResponseBody(res *controlplane.APIError) *GetHostTaskServerErrorResponseBody {
+	body := &GetHostTaskServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "delete-database" endpoint of the
+// NewGetHostTaskLogClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "get-host-task-log" endpoint of the
 // "control-plane" service.
-func NewDeleteDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *DeleteDatabaseOperationAlreadyInProgressResponseBody {
-	body := &DeleteDatabaseOperationAlreadyInProgressResponseBody{
+func NewGetHostTaskLogClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostTaskLogClusterNotInitializedResponseBody {
+	body := &GetHostTaskLogClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseInvalidInputResponseBody builds the HTTP response body from
-// the result of the "delete-database" endpoint of the "control-plane" service.
-func NewDeleteDatabaseInvalidInputResponseBody(res *controlplane.APIError) *DeleteDatabaseInvalidInputResponseBody {
-	body := &DeleteDatabaseInvalidInputResponseBody{
+// NewGetHostTaskLogInvalidInputResponseBody builds the HTTP response body from
+// the result of the "get-host-task-log" endpoint of the "control-plane"
+// service.
+func NewGetHostTaskLogInvalidInputResponseBody(res *controlplane.APIError) *GetHostTaskLogInvalidInputResponseBody {
+	body := &GetHostTaskLogInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseNotFoundResponseBody builds the HTTP response body from the
-// result of the "delete-database" endpoint of the "control-plane" service.
-func NewDeleteDatabaseNotFoundResponseBody(res *controlplane.APIError) *DeleteDatabaseNotFoundResponseBody {
-	body := &DeleteDatabaseNotFoundResponseBody{
+// NewGetHostTaskLogNotFoundResponseBody builds the HTTP response body from the
+// result of the "get-host-task-log" endpoint of the "control-plane" service.
+func NewGetHostTaskLogNotFoundResponseBody(res *controlplane.APIError) *GetHostTaskLogNotFoundResponseBody {
+	body := &GetHostTaskLogNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteDatabaseServerErrorResponseBody builds the HTTP response body from
-// the result of the "delete-database" endpoint of the "control-plane" service.
-func NewDeleteDatabaseServerErrorResponseBody(res *controlplane.APIError) *DeleteDatabaseServerErrorResponseBody {
-	body := &DeleteDatabaseServerErrorResponseBody{
+// NewGetHostTaskLogServerErrorResponseBody builds the HTTP response body from
+// the result of the "get-host-task-log" endpoint of the "control-plane"
+// service.
+func NewGetHostTaskLogServerErrorResponseBody(res *controlplane.APIError) *GetHostTaskLogServerErrorResponseBody {
+	body := &GetHostTaskLogServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
-// response body from the result of the "backup-database-node" endpoint of the
-// "control-plane" service.
-func NewBackupDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *BackupDatabaseNodeClusterNotInitializedResponseBody {
-	body := &BackupDatabaseNodeClusterNotInitializedResponseBody{
+// NewListTasksClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "list-tasks" endpoint of the "control-plane" service.
+func NewListTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListTasksClusterNotInitializedResponseBody {
+	body := &ListTasksClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
-// response body from the result of the "backup-database-node" endpoint of the
-// "control-plane" service.
-func NewBackupDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *BackupDatabaseNodeDatabaseNotModifiableResponseBody {
-	body := &BackupDatabaseNodeDatabaseNotModifiableResponseBody{
+// NewListTasksInvalidInputResponseBody builds the HTTP response body from the
+// result of the "list-tasks" endpoint of the "control-plane" service.
+func NewListTasksInvalidInputResponseBody(res *controlplane.APIError) *ListTasksInvalidInputResponseBody {
+	body := &ListTasksInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "backup-database-node" endpoint of the
-// "control-plane" service.
-func NewBackupDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *BackupDatabaseNodeOperationAlreadyInProgressResponseBody {
-	body := &BackupDatabaseNodeOperationAlreadyInProgressResponseBody{
+// NewListTasksServerErrorResponseBody builds the HTTP response body from the
+// result of the "list-tasks" endpoint of the "control-plane" service.
+func NewListTasksServerErrorResponseBody(res *controlplane.APIError) *ListTasksServerErrorResponseBody {
+	body := &ListTasksServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeInvalidInputResponseBody builds the HTTP response body
-// from the result of the "backup-database-node" endpoint of the
-// "control-plane" service.
-func NewBackupDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *BackupDatabaseNodeInvalidInputResponseBody {
-	body := &BackupDatabaseNodeInvalidInputResponseBody{
+// NewStreamEventsClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "stream-events" endpoint of the "control-plane"
+// service.
+func NewStreamEventsClusterNotInitializedResponseBody(res *controlplane.APIError) *StreamEventsClusterNotInitializedResponseBody {
+	body := &StreamEventsClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeNotFoundResponseBody builds the HTTP response body from
-// the result of the "backup-database-node" endpoint of the "control-plane"
-// service.
-func NewBackupDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *BackupDatabaseNodeNotFoundResponseBody {
-	body := &BackupDatabaseNodeNotFoundResponseBody{
+// NewStreamEventsInvalidInputResponseBody builds the HTTP response body from
+// the result of the "stream-events" endpoint of the "control-plane" service.
+func NewStreamEventsInvalidInputResponseBody(res *controlplane.APIError) *StreamEventsInvalidInputResponseBody {
+	body := &StreamEventsInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBackupDatabaseNodeServerErrorResponseBody builds the HTTP response body
-// from the result of the "backup-database-node" endpoint of the
-// "control-plane" service.
-func NewBackupDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *BackupDatabaseNodeServerErrorResponseBody {
-	body := &BackupDatabaseNodeServerErrorResponseBody{
+// NewStreamEventsServerErrorResponseBody builds the HTTP response body from
+// the result of the "stream-events" endpoint of the "control-plane" service.
+func NewStreamEventsServerErrorResponseBody(res *controlplane.APIError) *StreamEventsServerErrorResponseBody {
+	body := &StreamEventsServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
-// response body from the result of the "switchover-database-node" endpoint of
-// the "control-plane" service.
-func NewSwitchoverDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeClusterNotInitializedResponseBody {
-	body := &SwitchoverDatabaseNodeClusterNotInitializedResponseBody{
+// NewCreateWebhookClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "create-webhook" endpoint of the "control-plane"
+// service.
+func NewCreateWebhookClusterNotInitializedResponseBody(res *controlplane.APIError) *CreateWebhookClusterNotInitializedResponseBody {
+	body := &CreateWebhookClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
-// response body from the result of the "switchover-database-node" endpoint of
-// the "control-plane" service.
-func NewSwitchoverDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody {
-	body := &SwitchoverDatabaseNodeDatabaseNotModifiableResponseBody{
+// NewCreateWebhookInvalidInputResponseBody builds the HTTP response body from
+// the result of the "create-webhook" endpoint of the "control-plane" service.
+func NewCreateWebhookInvalidInputResponseBody(res *controlplane.APIError) *CreateWebhookInvalidInputResponseBody {
+	body := &CreateWebhookInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody builds the
-// HTTP response body from the result of the "switchover-database-node"
-// endpoint of the "control-plane" service.
-func NewSwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody {
-	body := &SwitchoverDatabaseNodeOperationAlreadyInProgressResponseBody{
+// NewCreateWebhookServerErrorResponseBody builds the HTTP response body from
+// the result of the "create-webhook" endpoint of the "control-plane" service.
+func NewCreateWebhookServerErrorResponseBody(res *controlplane.APIError) *CreateWebhookServerErrorResponseBody {
+	body := &CreateWebhookServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeInvalidInputResponseBody builds the HTTP response
-// body from the result of the "switchover-database-node" endpoint of the
-// "control-plane" service.
-func NewSwitchoverDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeInvalidInputResponseBody {
-	body := &SwitchoverDatabaseNodeInvalidInputResponseBody{
+// NewListWebhooksClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "list-webhooks" endpoint of the "control-plane"
+// service.
+func NewListWebhooksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListWebhooksClusterNotInitializedResponseBody {
+	body := &ListWebhooksClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeNotFoundResponseBody builds the HTTP response body
-// from the result of the "switchover-database-node" endpoint of the
-// "control-plane" service.
-func NewSwitchoverDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeNotFoundResponseBody {
-	body := &SwitchoverDatabaseNodeNotFoundResponseBody{
+// NewListWebhooksServerErrorResponseBody builds the HTTP response body from
+// the result of the "list-webhooks" endpoint of the "control-plane" service.
+func NewListWebhooksServerErrorResponseBody(res *controlplane.APIError) *ListWebhooksServerErrorResponseBody {
+	body := &ListWebhooksServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSwitchoverDatabaseNodeServerErrorResponseBody builds the HTTP response
-// body from the result of the "switchover-database-node" endpoint of the
-// "control-plane" service.
-func NewSwitchoverDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *SwitchoverDatabaseNodeServerErrorResponseBody {
-	body := &SwitchoverDatabaseNodeServerErrorResponseBody{
+// NewGetWebhookClusterNotInitializedResponseBody builds the HTTP response body
+// from the result of the "get-webhook" endpoint of the "control-plane" service.
+func NewGetWebhookClusterNotInitializedResponseBody(res *controlplane.APIError) *GetWebhookClusterNotInitializedResponseBody {
+	body := &GetWebhookClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeClusterNotInitializedResponseBody builds the HTTP
-// response body from the result of the "failover-database-node" endpoint of
-// the "control-plane" service.
-func NewFailoverDatabaseNodeClusterNotInitializedResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeClusterNotInitializedResponseBody {
-	body := &FailoverDatabaseNodeClusterNotInitializedResponseBody{
+// NewGetWebhookInvalidInputResponseBody builds the HTTP response body from the
+// result of the "get-webhook" endpoint of the "control-plane" service.
+func NewGetWebhookInvalidInputResponseBody(res *controlplane.APIError) *GetWebhookInvalidInputResponseBody {
+	body := &GetWebhookInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody builds the HTTP
-// response body from the result of the "failover-database-node" endpoint of
-// the "control-plane" service.
-func NewFailoverDatabaseNodeDatabaseNotModifiableResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeDatabaseNotModifiableResponseBody {
-	body := &FailoverDatabaseNodeDatabaseNotModifiableResponseBody{
+// NewGetWebhookNotFoundResponseBody builds the HTTP response body from the
+// result of the "get-webhook" endpoint of the "control-plane" service.
+func NewGetWebhookNotFoundResponseBody(res *controlplane.APIError) *GetWebhookNotFoundResponseBody {
+	body := &GetWebhookNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody builds the
-// HTTP response body from the result of the "failover-database-node" endpoint
-// of the "control-plane" service.
-func NewFailoverDatabaseNodeOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeOperationAlreadyInProgressResponseBody {
-	body := &FailoverDatabaseNodeOperationAlreadyInProgressResponseBody{
+// NewGetWebhookServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-webhook" endpoint of the "control-plane" service.
+func NewGetWebhookServerErrorResponseBody(res *controlplane.APIError) *GetWebhookServerErrorResponseBody {
+	body := &GetWebhookServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeInvalidInputResponseBody builds the HTTP response
-// body from the result of the "failover-database-node" endpoint of the
-// "control-plane" service.
-func NewFailoverDatabaseNodeInvalidInputResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeInvalidInputResponseBody {
-	body := &FailoverDatabaseNodeInvalidInputResponseBody{
+// NewDeleteWebhookClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "delete-webhook" endpoint of the "control-plane"
+// service.
+func NewDeleteWebhookClusterNotInitializedResponseBody(res *controlplane.APIError) *DeleteWebhookClusterNotInitializedResponseBody {
+	body := &DeleteWebhookClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeNotFoundResponseBody builds the HTTP response body
-// from the result of the "failover-database-node" endpoint of the
-// "control-plane" service.
-func NewFailoverDatabaseNodeNotFoundResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeNotFoundResponseBody {
-	body := &FailoverDatabaseNodeNotFoundResponseBody{
+// NewDeleteWebhookInvalidInputResponseBody builds the HTTP response body from
+// the result of the "delete-webhook" endpoint of the "control-plane" service.
+func NewDeleteWebhookInvalidInputResponseBody(res *controlplane.APIError) *DeleteWebhookInvalidInputResponseBody {
+	body := &DeleteWebhookInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewFailoverDatabaseNodeServerErrorResponseBody builds the HTTP response body
-// from the result of the "failover-database-node" endpoint of the
-// "control-plane" service.
-func NewFailoverDatabaseNodeServerErrorResponseBody(res *controlplane.APIError) *FailoverDatabaseNodeServerErrorResponseBody {
-	body := &FailoverDatabaseNodeServerErrorResponseBody{
+// NewDeleteWebhookNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete-webhook" endpoint of the "control-plane" service.
+func NewDeleteWebhookNotFoundResponseBody(res *controlplane.APIError) *DeleteWebhookNotFoundResponseBody {
+	body := &DeleteWebhookNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabaseTasksClusterNotInitializedResponseBody builds the HTTP
-// response body from the result of the "list-database-tasks" endpoint of the
-// "control-plane" service.
-func NewListDatabaseTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListDatabaseTasksClusterNotInitializedResponseBody {
-	body := &ListDatabaseTasksClusterNotInitializedResponseBody{
+// NewDeleteWebhookServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete-webhook" endpoint of the "control-plane" service.
+func NewDeleteWebhookServerErrorResponseBody(res *controlplane.APIError) *DeleteWebhookServerErrorResponseBody {
+	body := &DeleteWebhookServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabaseTasksInvalidInputResponseBody builds the HTTP response body
-// from the result of the "list-database-tasks" endpoint of the "control-plane"
-// service.
-func NewListDatabaseTasksInvalidInputResponseBody(res *controlplane.APIError) *ListDatabaseTasksInvalidInputResponseBody {
-	body := &ListDatabaseTasksInvalidInputResponseBody{
+// NewListWebhookDeliveriesClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "list-webhook-deliveries" endpoint of
+// the "control-plane" service.
+func NewListWebhookDeliveriesClusterNotInitializedResponseBody(res *controlplane.APIError) *ListWebhookDeliveriesClusterNotInitializedResponseBody {
+	body := &ListWebhookDeliveriesClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabaseTasksNotFoundResponseBody builds the HTTP response body from
-// the result of the "list-database-tasks" endpoint of the "control-plane"
-// service.
-func NewListDatabaseTasksNotFoundResponseBody(res *controlplane.APIError) *ListDatabaseTasksNotFoundResponseBody {
-	body := &ListDatabaseTasksNotFoundResponseBody{
+// NewListWebhookDeliveriesInvalidInputResponseBody builds the HTTP response
+// body from the result of the "list-webhook-deliveries" endpoint of the
+// "control-plane" service.
+func NewListWebhookDeliveriesInvalidInputResponseBody(res *controlplane.APIError) *ListWebhookDeliveriesInvalidInputResponseBody {
+	body := &ListWebhookDeliveriesInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListDatabaseTasksServerErrorResponseBody builds the HTTP response body
-// from the result of the "list-database-tasks" endpoint of the "control-plane"
-// service.
-func NewListDatabaseTasksServerErrorResponseBody(res *controlplane.APIError) *ListDatabaseTasksServerErrorResponseBody {
-	body := &ListDatabaseTasksServerErrorResponseBody{
+// NewListWebhookDeliveriesNotFoundResponseBody builds the HTTP response body
+// from the result of the "list-webhook-deliveries" endpoint of the
+// "control-plane" service.
+func NewListWebhookDeliveriesNotFoundResponseBody(res *controlplane.APIError) *ListWebhookDeliveriesNotFoundResponseBody {
+	body := &ListWebhookDeliveriesNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-database-task" endpoint of the
+// NewListWebhookDeliveriesServerErrorResponseBody builds the HTTP response
+// body from the result of the "list-webhook-deliveries" endpoint of the
 // "control-plane" service.
-func NewGetDatabaseTaskClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseTaskClusterNotInitializedResponseBody {
-	body := &GetDatabaseTaskClusterNotInitializedResponseBody{
+func NewListWebhookDeliveriesServerErrorResponseBody(res *controlplane.APIError) *ListWebhookDeliveriesServerErrorResponseBody {
+	body := &ListWebhookDeliveriesServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskInvalidInputResponseBody builds the HTTP response body
-// from the result of the "get-database-task" endpoint of the "control-plane"
-// service.
-func NewGetDatabaseTaskInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseTaskInvalidInputResponseBody {
-	body := &GetDatabaseTaskInvalidInputResponseBody{
+// NewRestoreDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "restore-database" endpoint of the
+// "control-plane" service.
+func NewRestoreDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *RestoreDatabaseClusterNotInitializedResponseBody {
+	body := &RestoreDatabaseClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskNotFoundResponseBody builds the HTTP response body from
-// the result of the "get-database-task" endpoint of the "control-plane"
-// service.
-func NewGetDatabaseTaskNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseTaskNotFoundResponseBody {
-	body := &GetDatabaseTaskNotFoundResponseBody{
+// NewRestoreDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
+// body from the result of the "restore-database" endpoint of the
+// "control-plane" service.
+func NewRestoreDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *RestoreDatabaseDatabaseNotModifiableResponseBody {
+	body := &RestoreDatabaseDatabaseNotModifiableResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskServerErrorResponseBody builds the HTTP response body from
-// the result of the "get-database-task" endpoint of the "control-plane"
-// service.
-func NewGetDatabaseTaskServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseTaskServerErrorResponseBody {
-	body := &GetDatabaseTaskServerErrorResponseBody{
+// NewRestoreDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "restore-database" endpoint of the
+// "control-plane" service.
+func NewRestoreDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *RestoreDatabaseOperationAlreadyInProgressResponseBody {
+	body := &RestoreDatabaseOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskLogClusterNotInitializedResponseBody builds the HTTP
-// response body from the result of the "get-database-task-log" endpoint of the
-// "control-plane" service.
-func NewGetDatabaseTaskLogClusterNotInitializedResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogClusterNotInitializedResponseBody {
-	body := &GetDatabaseTaskLogClusterNotInitializedResponseBody{
+// NewRestoreDatabaseInvalidInputResponseBody builds the HTTP response body
+// from the result of the "restore-database" endpoint of the "control-plane"
+// service.
+func NewRestoreDatabaseInvalidInputResponseBody(res *controlplane.APIError) *RestoreDatabaseInvalidInputResponseBody {
+	body := &RestoreDatabaseInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskLogInvalidInputResponseBody builds the HTTP response body
-// from the result of the "get-database-task-log" endpoint of the
-// "control-plane" service.
-func NewGetDatabaseTaskLogInvalidInputResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogInvalidInputResponseBody {
-	body := &GetDatabaseTaskLogInvalidInputResponseBody{
+// NewRestoreDatabaseNotFoundResponseBody builds the HTTP response body from
+// the result of the "restore-database" endpoint of the "control-plane" service.
+func NewRestoreDatabaseNotFoundResponseBody(res *controlplane.APIError) *RestoreDatabaseNotFoundResponseBody {
+	body := &RestoreDatabaseNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskLogNotFoundResponseBody builds the HTTP response body from
-// the result of the "get-database-task-log" endpoint of the "control-plane"
-// service.
-func NewGetDatabaseTaskLogNotFoundResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogNotFoundResponseBody {
-	body := &GetDatabaseTaskLogNotFoundResponseBody{
+// NewRestoreDatabaseServerErrorResponseBody builds the HTTP response body from
+// the result of the "restore-database" endpoint of the "control-plane" service.
+func NewRestoreDatabaseServerErrorResponseBody(res *controlplane.APIError) *RestoreDatabaseServerErrorResponseBody {
+	body := &RestoreDatabaseServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetDatabaseTaskLogServerErrorResponseBody builds the HTTP response body
-// from the result of the "get-database-task-log" endpoint of the
-// "control-plane" service.
-func NewGetDatabaseTaskLogServerErrorResponseBody(res *controlplane.APIError) *GetDatabaseTaskLogServerErrorResponseBody {
-	body := &GetDatabaseTaskLogServerErrorResponseBody{
+// NewCloneDatabaseDatabaseAlreadyExistsResponseBody builds the HTTP response
+// body from the result of the "clone-database" endpoint of the "control-plane"
+// service.
+func NewCloneDatabaseDatabaseAlreadyExistsResponseBody(res *controlplane.APIError) *CloneDatabaseDatabaseAlreadyExistsResponseBody {
+	body := &CloneDatabaseDatabaseAlreadyExistsResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostTasksClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "list-host-tasks" endpoint of the
-// "control-plane" service.
-func NewListHostTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListHostTasksClusterNotInitializedResponseBody {
-	body := &ListHostTasksClusterNotInitializedResponseBody{
+// NewCloneDatabaseClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "clone-database" endpoint of the "control-plane"
+// service.
+func NewCloneDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *CloneDatabaseClusterNotInitializedResponseBody {
+	body := &CloneDatabaseClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostTasksInvalidInputResponseBody builds the HTTP response body from
-// the result of the "list-host-tasks" endpoint of the "control-plane" service.
-func NewListHostTasksInvalidInputResponseBody(res *controlplane.APIError) *ListHostTasksInvalidInputResponseBody {
-	body := &ListHostTasksInvalidInputResponseBody{
+// NewCloneDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
+// response body from the result of the "clone-database" endpoint of the
+// "control-plane" service.
+func NewCloneDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *CloneDatabaseOperationAlreadyInProgressResponseBody {
+	body := &CloneDatabaseOperationAlreadyInProgressResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostTasksNotFoundResponseBody builds the HTTP response body from the
-// result of the "list-host-tasks" endpoint of the "control-plane" service.
-func NewListHostTasksNotFoundResponseBody(res *controlplane.APIError) *ListHostTasksNotFoundResponseBody {
-	body := &ListHostTasksNotFoundResponseBody{
+// NewCloneDatabaseInvalidInputResponseBody builds the HTTP response body from
+// the result of the "clone-database" endpoint of the "control-plane" service.
+func NewCloneDatabaseInvalidInputResponseBody(res *controlplane.APIError) *CloneDatabaseInvalidInputResponseBody {
+	body := &CloneDatabaseInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListHostTasksServerErrorResponseBody builds the HTTP response body from
-// the result of the "list-host-tasks" endpoint of the "control-plane" service.
-func NewListHostTasksServerErrorResponseBody(res *controlplane.APIError) *ListHostTasksServerErrorResponseBody {
-	body := &ListHostTasksServerErrorResponseBody{
+// NewCloneDatabaseNotFoundResponseBody builds the HTTP response body from the
+// result of the "clone-database" endpoint of the "control-plane" service.
+func NewCloneDatabaseNotFoundResponseBody(res *controlplane.APIError) *CloneDatabaseNotFoundResponseBody {
+	body := &CloneDatabaseNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-host-task" endpoint of the "control-plane"
-// service.
-func NewGetHostTaskClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostTaskClusterNotInitializedResponseBody {
-	body := &GetHostTaskClusterNotInitializedResponseBody{
+// NewCloneDatabaseServerErrorResponseBody builds the HTTP response body from
+// the result of the "clone-database" endpoint of the "control-plane" service.
+func NewCloneDatabaseServerErrorResponseBody(res *controlplane.APIError) *CloneDatabaseServerErrorResponseBody {
+	body := &CloneDatabaseServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskInvalidInputResponseBody builds the HTTP response body from
-// the result of the "get-host-task" endpoint of the "control-plane" service.
-func NewGetHostTaskInvalidInputResponseBody(res *controlplane.APIError) *GetHostTaskInvalidInputResponseBody {
-	body := &GetHostTaskInvalidInputResponseBody{
+// NewGetVersionServerErrorResponseBody builds the HTTP response body from the
+// result of the "get-version" endpoint of the "control-plane" service.
+func NewGetVersionServerErrorResponseBody(res *controlplane.APIError) *GetVersionServerErrorResponseBody {
+	body := &GetVersionServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskNotFoundResponseBody builds the HTTP response body from the
-// result of the "get-host-task" endpoint of the "control-plane" service.
-func NewGetHostTaskNotFoundResponseBody(res *controlplane.APIError) *GetHostTaskNotFoundResponseBody {
-	body := &GetHostTaskNotFoundResponseBody{
+// NewRestartInstanceClusterNotInitializedResponseBody builds the HTTP response
+// body from the result of the "restart-instance" endpoint of the
+// "control-plane" service.
+func NewRestartInstanceClusterNotInitializedResponseBody(res *controlplane.APIError) *RestartInstanceClusterNotInitializedResponseBody {
+	body := &RestartInstanceClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskServerErrorResponseBody builds the HTTP response body from the
-// result of the "get-host-task" endpoint of the "control-plane" service.
-func NewGetHostTaskServerErrorResponseBody(res *controlplane.APIError) *GetHostTaskServerErrorResponseBody {
-	body := &GetHostTaskServerErrorResponseBody{
+// NewRestartInstanceInvalidInputResponseBody builds the HTTP response body
+// from the result of the "restart-instance" endpoint of the "control-plane"
+// service.
+func NewRestartInstanceInvalidInputResponseBody(res *controlplane.APIError) *RestartInstanceInvalidInputResponseBody {
+	body := &RestartInstanceInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskLogClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "get-host-task-log" endpoint of the
-// "control-plane" service.
-func NewGetHostTaskLogClusterNotInitializedResponseBody(res *controlplane.APIError) *GetHostTaskLogClusterNotInitializedResponseBody {
-	body := &GetHostTaskLogClusterNotInitializedResponseBody{
+// NewRestartInstanceNotFoundResponseBody builds the HTTP response body from
+// the result of the "restart-instance" endpoint of the "control-plane" service.
+func NewRestartInstanceNotFoundResponseBody(res *controlplane.APIError) *RestartInstanceNotFoundResponseBody {
+	body := &RestartInstanceNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskLogInvalidInputResponseBody builds the HTTP response body from
-// the result of the "get-host-task-log" endpoint of the "control-plane"
-// service.
-func NewGetHostTaskLogInvalidInputResponseBody(res *controlplane.APIError) *GetHostTaskLogInvalidInputResponseBody {
-	body := &GetHostTaskLogInvalidInputResponseBody{
+// NewRestartInstanceServerErrorResponseBody builds the HTTP response body from
+// the result of the "restart-instance" endpoint of the "control-plane" service.
+func NewRestartInstanceServerErrorResponseBody(res *controlplane.APIError) *RestartInstanceServerErrorResponseBody {
+	body := &RestartInstanceServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskLogNotFoundResponseBody builds the HTTP response body from the
-// result of the "get-host-task-log" endpoint of the "control-plane" service.
-func NewGetHostTaskLogNotFoundResponseBody(res *controlplane.APIError) *GetHostTaskLogNotFoundResponseBody {
-	body := &GetHostTaskLogNotFoundResponseBody{
+// NewGetInstanceSessionsClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "get-instance-sessions" endpoint of the
+// "control-plane" service.
+func NewGetInstanceSessionsClusterNotInitializedResponseBody(res *controlplane.APIError) *GetInstanceSessionsClusterNotInitializedResponseBody {
+	body := &GetInstanceSessionsClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetHostTaskLogServerErrorResponseBody builds the HTTP response body from
-// the result of the "get-host-task-log" endpoint of the "control-plane"
-// service.
-func NewGetHostTaskLogServerErrorResponseBody(res *controlplane.APIError) *GetHostTaskLogServerErrorResponseBody {
-	body := &GetHostTaskLogServerErrorResponseBody{
+// NewGetInstanceSessionsInvalidInputResponseBody builds the HTTP response body
+// from the result of the "get-instance-sessions" endpoint of the
+// "control-plane" service.
+func NewGetInstanceSessionsInvalidInputResponseBody(res *controlplane.APIError) *GetInstanceSessionsInvalidInputResponseBody {
+	body := &GetInstanceSessionsInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListTasksClusterNotInitializedResponseBody builds the HTTP response body
-// from the result of the "list-tasks" endpoint of the "control-plane" service.
-func NewListTasksClusterNotInitializedResponseBody(res *controlplane.APIError) *ListTasksClusterNotInitializedResponseBody {
-	body := &ListTasksClusterNotInitializedResponseBody{
+// NewGetInstanceSessionsNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-instance-sessions" endpoint of the
+// "control-plane" service.
+func NewGetInstanceSessionsNotFoundResponseBody(res *controlplane.APIError) *GetInstanceSessionsNotFoundResponseBody {
+	body := &GetInstanceSessionsNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListTasksInvalidInputResponseBody builds the HTTP response body from the
-// result of the "list-tasks" endpoint of the "control-plane" service.
-func NewListTasksInvalidInputResponseBody(res *controlplane.APIError) *ListTasksInvalidInputResponseBody {
-	body := &ListTasksInvalidInputResponseBody{
+// NewGetInstanceSessionsServerErrorResponseBody builds the HTTP response body
+// from the result of the "get-instance-sessions" endpoint of the
+// "control-plane" service.
+func NewGetInstanceSessionsServerErrorResponseBody(res *controlplane.APIError) *GetInstanceSessionsServerErrorResponseBody {
+	body := &GetInstanceSessionsServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListTasksServerErrorResponseBody builds the HTTP response body from the
-// result of the "list-tasks" endpoint of the "control-plane" service.
-func NewListTasksServerErrorResponseBody(res *controlplane.APIError) *ListTasksServerErrorResponseBody {
-	body := &ListTasksServerErrorResponseBody{
+// NewCancelInstanceSessionClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "cancel-instance-session" endpoint of
+// the "control-plane" service.
+func NewCancelInstanceSessionClusterNotInitializedResponseBody(res *controlplane.APIError) *CancelInstanceSessionClusterNotInitializedResponseBody {
+	body := &CancelInstanceSessionClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "restore-database" endpoint of the
+// NewCancelInstanceSessionInvalidInputResponseBody builds the HTTP response
+// body from the result of the "cancel-instance-session" endpoint of the
 // "control-plane" service.
-func NewRestoreDatabaseClusterNotInitializedResponseBody(res *controlplane.APIError) *RestoreDatabaseClusterNotInitializedResponseBody {
-	body := &RestoreDatabaseClusterNotInitializedResponseBody{
+func NewCancelInstanceSessionInvalidInputResponseBody(res *controlplane.APIError) *CancelInstanceSessionInvalidInputResponseBody {
+	body := &CancelInstanceSessionInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseDatabaseNotModifiableResponseBody builds the HTTP response
-// body from the result of the "restore-database" endpoint of the
+// NewCancelInstanceSessionNotFoundResponseBody builds the HTTP response body
+// from the result of the "cancel-instance-session" endpoint of the
 // "control-plane" service.
-func NewRestoreDatabaseDatabaseNotModifiableResponseBody(res *controlplane.APIError) *RestoreDatabaseDatabaseNotModifiableResponseBody {
-	body := &RestoreDatabaseDatabaseNotModifiableResponseBody{
+func NewCancelInstanceSessionNotFoundResponseBody(res *controlplane.APIError) *CancelInstanceSessionNotFoundResponseBody {
+	body := &CancelInstanceSessionNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseOperationAlreadyInProgressResponseBody builds the HTTP
-// response body from the result of the "restore-database" endpoint of the
+// NewCancelInstanceSessionServerErrorResponseBody builds the HTTP response
+// body from the result of the "cancel-instance-session" endpoint of the
 // "control-plane" service.
-func NewRestoreDatabaseOperationAlreadyInProgressResponseBody(res *controlplane.APIError) *RestoreDatabaseOperationAlreadyInProgressResponseBody {
-	body := &RestoreDatabaseOperationAlreadyInProgressResponseBody{
+func NewCancelInstanceSessionServerErrorResponseBody(res *controlplane.APIError) *CancelInstanceSessionServerErrorResponseBody {
+	body := &CancelInstanceSessionServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseInvalidInputResponseBody builds the HTTP response body
-// from the result of the "restore-database" endpoint of the "control-plane"
-// service.
-func NewRestoreDatabaseInvalidInputResponseBody(res *controlplane.APIError) *RestoreDatabaseInvalidInputResponseBody {
-	body := &RestoreDatabaseInvalidInputResponseBody{
+// NewTerminateInstanceSessionClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "terminate-instance-session" endpoint
+// of the "control-plane" service.
+func NewTerminateInstanceSessionClusterNotInitializedResponseBody(res *controlplane.APIError) *TerminateInstanceSessionClusterNotInitializedResponseBody {
+	body := &TerminateInstanceSessionClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseNotFoundResponseBody builds the HTTP response body from
-// the result of the "restore-database" endpoint of the "control-plane" service.
-func NewRestoreDatabaseNotFoundResponseBody(res *controlplane.APIError) *RestoreDatabaseNotFoundResponseBody {
-	body := &RestoreDatabaseNotFoundResponseBody{
+// NewTerminateInstanceSessionInvalidInputResponseBody builds the HTTP response
+// body from the result of the "terminate-instance-session" endpoint of the
+// "control-plane" service.
+func NewTerminateInstanceSessionInvalidInputResponseBody(res *controlplane.APIError) *TerminateInstanceSessionInvalidInputResponseBody {
+	body := &TerminateInstanceSessionInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestoreDatabaseServerErrorResponseBody builds the HTTP response body from
-// the result of the "restore-database" endpoint of the "control-plane" service.
-func NewRestoreDatabaseServerErrorResponseBody(res *controlplane.APIError) *RestoreDatabaseServerErrorResponseBody {
-	body := &RestoreDatabaseServerErrorResponseBody{
+// NewTerminateInstanceSessionNotFoundResponseBody builds the HTTP response
+// body from the result of the "terminate-instance-session" endpoint of the
+// "control-plane" service.
+func NewTerminateInstanceSessionNotFoundResponseBody(res *controlplane.APIError) *TerminateInstanceSessionNotFoundResponseBody {
+	body := &TerminateInstanceSessionNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetVersionServerErrorResponseBody builds the HTTP response body from the
-// result of the "get-version" endpoint of the "control-plane" service.
-func NewGetVersionServerErrorResponseBody(res *controlplane.APIError) *GetVersionServerErrorResponseBody {
-	body := &GetVersionServerErrorResponseBody{
+// NewTerminateInstanceSessionServerErrorResponseBody builds the HTTP response
+// body from the result of the "terminate-instance-session" endpoint of the
+// "control-plane" service.
+func NewTerminateInstanceSessionServerErrorResponseBody(res *controlplane.APIError) *TerminateInstanceSessionServerErrorResponseBody {
+	body := &TerminateInstanceSessionServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestartInstanceClusterNotInitializedResponseBody builds the HTTP response
-// body from the result of the "restart-instance" endpoint of the
-// "control-plane" service.
-func NewRestartInstanceClusterNotInitializedResponseBody(res *controlplane.APIError) *RestartInstanceClusterNotInitializedResponseBody {
-	body := &RestartInstanceClusterNotInitializedResponseBody{
+// NewGetInstancePostgresqlConfClusterNotInitializedResponseBody builds the
+// HTTP response body from the result of the "get-instance-postgresql-conf"
+// endpoint of the "control-plane" service.
+func NewGetInstancePostgresqlConfClusterNotInitializedResponseBody(res *controlplane.APIError) *GetInstancePostgresqlConfClusterNotInitializedResponseBody {
+	body := &GetInstancePostgresqlConfClusterNotInitializedResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestartInstanceInvalidInputResponseBody builds the HTTP response body
-// from the result of the "restart-instance" endpoint of the "control-plane"
-// service.
-func NewRestartInstanceInvalidInputResponseBody(res *controlplane.APIError) *RestartInstanceInvalidInputResponseBody {
-	body := &RestartInstanceInvalidInputResponseBody{
+// NewGetInstancePostgresqlConfInvalidInputResponseBody builds the HTTP
+// response body from the result of the "get-instance-postgresql-conf" endpoint
+// of the "control-plane" service.
+func NewGetInstancePostgresqlConfInvalidInputResponseBody(res *controlplane.APIError) *GetInstancePostgresqlConfInvalidInputResponseBody {
+	body := &GetInstancePostgresqlConfInvalidInputResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestartInstanceNotFoundResponseBody builds the HTTP response body from
-// the result of the "restart-instance" endpoint of the "control-plane" service.
-func NewRestartInstanceNotFoundResponseBody(res *controlplane.APIError) *RestartInstanceNotFoundResponseBody {
-	body := &RestartInstanceNotFoundResponseBody{
+// NewGetInstancePostgresqlConfNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-instance-postgresql-conf" endpoint of the
+// "control-plane" service.
+func NewGetInstancePostgresqlConfNotFoundResponseBody(res *controlplane.APIError) *GetInstancePostgresqlConfNotFoundResponseBody {
+	body := &GetInstancePostgresqlConfNotFoundResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewRestartInstanceServerErrorResponseBody builds the HTTP response body from
-// the result of the "restart-instance" endpoint of the "control-plane" service.
-func NewRestartInstanceServerErrorResponseBody(res *controlplane.APIError) *RestartInstanceServerErrorResponseBody {
-	body := &RestartInstanceServerErrorResponseBody{
+// NewGetInstancePostgresqlConfServerErrorResponseBody builds the HTTP response
+// body from the result of the "get-instance-postgresql-conf" endpoint of the
+// "control-plane" service.
+func NewGetInstancePostgresqlConfServerErrorResponseBody(res *controlplane.APIError) *GetInstancePostgresqlConfServerErrorResponseBody {
+	body := &GetInstancePostgresqlConfServerErrorResponseBody{
 		Name:    res.Name,
 		Message: res.Message,
 	}
@@ -4931,6 +8062,50 @@ func NewCancelDatabaseTaskServerErrorResponseBody(res *controlplane.APIError) *C
 	return body
 }
 
+// NewResumeDatabaseTaskClusterNotInitializedResponseBody builds the HTTP
+// response body from the result of the "resume-database-task" endpoint of the
+// "control-plane" service.
+func NewResumeDatabaseTaskClusterNotInitializedResponseBody(res *controlplane.APIError) *ResumeDatabaseTaskClusterNotInitializedResponseBody {
+	body := &ResumeDatabaseTaskClusterNotInitializedResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResumeDatabaseTaskNotFoundResponseBody builds the HTTP response body from
+// the result of the "resume-database-task" endpoint of the "control-plane"
+// service.
+func NewResumeDatabaseTaskNotFoundResponseBody(res *controlplane.APIError) *ResumeDatabaseTaskNotFoundResponseBody {
+	body := &ResumeDatabaseTaskNotFoundResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResumeDatabaseTaskInvalidInputResponseBody builds the HTTP response body
+// from the result of the "resume-database-task" endpoint of the
+// "control-plane" service.
+func NewResumeDatabaseTaskInvalidInputResponseBody(res *controlplane.APIError) *ResumeDatabaseTaskInvalidInputResponseBody {
+	body := &ResumeDatabaseTaskInvalidInputResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResumeDatabaseTaskServerErrorResponseBody builds the HTTP response body
+// from the result of the "resume-database-task" endpoint of the
+// "control-plane" service.
+func NewResumeDatabaseTaskServerErrorResponseBody(res *controlplane.APIError) *ResumeDatabaseTaskServerErrorResponseBody {
+	body := &ResumeDatabaseTaskServerErrorResponseBody{
+		Name:    res.Name,
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewInitClusterRequest builds a control-plane service init-cluster endpoint
 // payload.
 func NewInitClusterRequest(clusterID *string) *controlplane.InitClusterRequest {
@@ -4973,6 +8148,44 @@ func NewGetJoinOptionsClusterJoinRequest(body *GetJoinOptionsRequestBody) *contr
 	return v
 }
 
+// NewGetStorageUsagePayload builds a control-plane service get-storage-usage
+// endpoint payload.
+func NewGetStorageUsagePayload(depth *int) *controlplane.GetStorageUsagePayload {
+	v := &controlplane.GetStorageUsagePayload{}
+	v.Depth = depth
+
+	return v
+}
+
+// NewDefragmentEtcdRequest builds a control-plane service defragment-etcd
+// endpoint payload.
+func NewDefragmentEtcdRequest(body *DefragmentEtcdRequestBody) *controlplane.DefragmentEtcdRequest {
+	v := &controlplane.DefragmentEtcdRequest{}
+	if body.HostIds != nil {
+		v.HostIds = make([]controlplane.Identifier, len(body.HostIds))
+		for i, val := range body.HostIds {
+			v.HostIds[i] = controlplane.Identifier(val)
+		}
+	}
+
+	return v
+}
+
+// NewListHostsPayload builds a control-plane service list-hosts endpoint
+// payload.
+func NewListHostsPayload(limit *int, cursor *string, state []string, postgresVersion *string) *controlplane.ListHostsPayload {
+	v := &controlplane.ListHostsPayload{}
+	v.Limit = limit
+	if cursor != nil {
+		tmpcursor := controlplane.Identifier(*cursor)
+		v.Cursor = &tmpcursor
+	}
+	v.State = state
+	v.PostgresVersion = postgresVersion
+
+	return v
+}
+
 // NewGetHostPayload builds a control-plane service get-host endpoint payload.
 func NewGetHostPayload(hostID string) *controlplane.GetHostPayload {
 	v := &controlplane.GetHostPayload{}
@@ -4991,11 +8204,41 @@ func NewRemoveHostPayload(hostID string, force bool) *controlplane.RemoveHostPay
 	return v
 }
 
+// NewChangeHostEtcdModePayload builds a control-plane service
+// change-host-etcd-mode endpoint payload.
+func NewChangeHostEtcdModePayload(body *ChangeHostEtcdModeRequestBody, hostID string) *controlplane.ChangeHostEtcdModePayload {
+	v := &controlplane.ChangeHostEtcdModeRequest{
+		EtcdMode: *body.EtcdMode,
+	}
+	res := &controlplane.ChangeHostEtcdModePayload{
+		Request: v,
+	}
+	res.HostID = controlplane.Identifier(hostID)
+
+	return res
+}
+
 // NewListDatabasesPayload builds a control-plane service list-databases
 // endpoint payload.
-func NewListDatabasesPayload(include []string) *controlplane.ListDatabasesPayload {
+func NewListDatabasesPayload(include []string, limit *int, cursor *string, tenantID *string, state []string, hostID *string, postgresVersion *string, view string) *controlplane.ListDatabasesPayload {
 	v := &controlplane.ListDatabasesPayload{}
 	v.Include = include
+	v.Limit = limit
+	if cursor != nil {
+		tmpcursor := controlplane.Identifier(*cursor)
+		v.Cursor = &tmpcursor
+	}
+	if tenantID != nil {
+		tmptenantID := controlplane.Identifier(*tenantID)
+		v.TenantID = &tmptenantID
+	}
+	v.State = state
+	if hostID != nil {
+		tmphostID := controlplane.Identifier(*hostID)
+		v.HostID = &tmphostID
+	}
+	v.PostgresVersion = postgresVersion
+	v.View = view
 
 	return v
 }
@@ -5013,6 +8256,9 @@ func NewCreateDatabaseRequest(body *CreateDatabaseRequestBody) *controlplane.Cre
 		v.TenantID = &tenantID
 	}
 	v.Spec = unmarshalDatabaseSpecRequestBodyToControlplaneDatabaseSpec(body.Spec)
+	if body.Source != nil {
+		v.Source = unmarshalImportSourceSpecRequestBodyToControlplaneImportSourceSpec(body.Source)
+	}
 
 	return v
 }
@@ -5050,7 +8296,14 @@ func NewUpdateDatabasePayload(body *UpdateDatabaseRequestBody, databaseID string
 // payload.
 func NewApplyUpgradePayload(body *ApplyUpgradeRequestBody, databaseID string) *controlplane.ApplyUpgradePayload {
 	v := &controlplane.ApplyUpgradeRequest{
-		Image: *body.Image,
+		Image:           body.Image,
+		PostgresVersion: body.PostgresVersion,
+	}
+	if body.Mode != nil {
+		v.Mode = *body.Mode
+	}
+	if body.Mode == nil {
+		v.Mode = "minor"
 	}
 	res := &controlplane.ApplyUpgradePayload{
 		Request: v,
@@ -5060,6 +8313,40 @@ func NewApplyUpgradePayload(body *ApplyUpgradeRequestBody, databaseID string) *c
 	return res
 }
 
+// NewUpgradeDatabaseMajorPayload builds a control-plane service
+// upgrade-database-major endpoint payload.
+func NewUpgradeDatabaseMajorPayload(body *UpgradeDatabaseMajorRequestBody, databaseID string) *controlplane.UpgradeDatabaseMajorPayload {
+	v := &controlplane.UpgradeDatabaseMajorRequest{
+		PostgresVersion:   *body.PostgresVersion,
+		PauseBetweenNodes: body.PauseBetweenNodes,
+	}
+	if body.Nodes != nil {
+		v.Nodes = make([]*controlplane.MajorUpgradeNodeSpec, len(body.Nodes))
+		for i, val := range body.Nodes {
+			if val == nil {
+				v.Nodes[i] = nil
+				continue
+			}
+			v.Nodes[i] = unmarshalMajorUpgradeNodeSpecRequestBodyRequestBodyToControlplaneMajorUpgradeNodeSpec(val)
+		}
+	}
+	res := &controlplane.UpgradeDatabaseMajorPayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res
+}
+
+// NewCutoverDatabaseImportPayload builds a control-plane service
+// cutover-database-import endpoint payload.
+func NewCutoverDatabaseImportPayload(databaseID string) *controlplane.CutoverDatabaseImportPayload {
+	v := &controlplane.CutoverDatabaseImportPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+
+	return v
+}
+
 // NewDeleteDatabasePayload builds a control-plane service delete-database
 // endpoint payload.
 func NewDeleteDatabasePayload(databaseID string, force bool) *controlplane.DeleteDatabasePayload {
@@ -5115,6 +8402,39 @@ func NewSwitchoverDatabaseNodePayload(body *SwitchoverDatabaseNodeRequestBody, d
 	return v
 }
 
+// NewRollingRestartDatabasePayload builds a control-plane service
+// rolling-restart-database endpoint payload.
+func NewRollingRestartDatabasePayload(body *RollingRestartDatabaseRequestBody, databaseID string) *controlplane.RollingRestartDatabasePayload {
+	v := &controlplane.RollingRestartDatabaseRequest{}
+	if body.AllInstances != nil {
+		v.AllInstances = *body.AllInstances
+	}
+	if body.Concurrency != nil {
+		v.Concurrency = *body.Concurrency
+	}
+	if body.SwitchBack != nil {
+		v.SwitchBack = *body.SwitchBack
+	}
+	if body.AllInstances == nil {
+		v.AllInstances = false
+	}
+	if body.Concurrency == nil {
+		v.Concurrency = 1
+	}
+	if body.SwitchBack == nil {
+		v.SwitchBack = false
+	}
+	if body.MaintenanceWindow != nil {
+		v.MaintenanceWindow = unmarshalMaintenanceWindowRequestBodyRequestBodyToControlplaneMaintenanceWindow(body.MaintenanceWindow)
+	}
+	res := &controlplane.RollingRestartDatabasePayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res
+}
+
 // NewFailoverDatabaseNodeRequest builds a control-plane service
 // failover-database-node endpoint payload.
 func NewFailoverDatabaseNodeRequest(body *FailoverDatabaseNodeRequestBody, databaseID string, nodeName string) *controlplane.FailoverDatabaseNodeRequest {
@@ -5133,6 +8453,28 @@ func NewFailoverDatabaseNodeRequest(body *FailoverDatabaseNodeRequestBody, datab
 	return v
 }
 
+// NewGetNodeQueryStatsPayload builds a control-plane service
+// get-node-query-stats endpoint payload.
+func NewGetNodeQueryStatsPayload(databaseID string, nodeName string, sortBy string, limit *int) *controlplane.GetNodeQueryStatsPayload {
+	v := &controlplane.GetNodeQueryStatsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.NodeName = nodeName
+	v.SortBy = sortBy
+	v.Limit = limit
+
+	return v
+}
+
+// NewResetNodeQueryStatsPayload builds a control-plane service
+// reset-node-query-stats endpoint payload.
+func NewResetNodeQueryStatsPayload(databaseID string, nodeName string) *controlplane.ResetNodeQueryStatsPayload {
+	v := &controlplane.ResetNodeQueryStatsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.NodeName = nodeName
+
+	return v
+}
+
 // NewListDatabaseTasksPayload builds a control-plane service
 // list-database-tasks endpoint payload.
 func NewListDatabaseTasksPayload(databaseID string, afterTaskID *string, limit *int, sortOrder *string) *controlplane.ListDatabaseTasksPayload {
@@ -5217,6 +8559,78 @@ func NewListTasksPayload(scope *string, entityID *string, afterTaskID *string, l
 	return v
 }
 
+// NewStreamEventsPayload builds a control-plane service stream-events endpoint
+// payload.
+func NewStreamEventsPayload(databaseID *string, hostID *string, kind []string) *controlplane.StreamEventsPayload {
+	v := &controlplane.StreamEventsPayload{}
+	if databaseID != nil {
+		tmpdatabaseID := controlplane.Identifier(*databaseID)
+		v.DatabaseID = &tmpdatabaseID
+	}
+	if hostID != nil {
+		tmphostID := controlplane.Identifier(*hostID)
+		v.HostID = &tmphostID
+	}
+	v.Kind = kind
+
+	return v
+}
+
+// NewCreateWebhookPayload builds a control-plane service create-webhook
+// endpoint payload.
+func NewCreateWebhookPayload(body *CreateWebhookRequestBody) *controlplane.CreateWebhookPayload {
+	v := &controlplane.CreateWebhookRequest{
+		URL:         *body.URL,
+		Description: body.Description,
+		Secret:      body.Secret,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]controlplane.WebhookEventType, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = controlplane.WebhookEventType(val)
+		}
+	}
+	if body.DatabaseIds != nil {
+		v.DatabaseIds = make([]controlplane.Identifier, len(body.DatabaseIds))
+		for i, val := range body.DatabaseIds {
+			v.DatabaseIds[i] = controlplane.Identifier(val)
+		}
+	}
+	res := &controlplane.CreateWebhookPayload{
+		Request: v,
+	}
+
+	return res
+}
+
+// NewGetWebhookPayload builds a control-plane service get-webhook endpoint
+// payload.
+func NewGetWebhookPayload(webhookID string) *controlplane.GetWebhookPayload {
+	v := &controlplane.GetWebhookPayload{}
+	v.WebhookID = webhookID
+
+	return v
+}
+
+// NewDeleteWebhookPayload builds a control-plane service delete-webhook
+// endpoint payload.
+func NewDeleteWebhookPayload(webhookID string) *controlplane.DeleteWebhookPayload {
+	v := &controlplane.DeleteWebhookPayload{}
+	v.WebhookID = webhookID
+
+	return v
+}
+
+// NewListWebhookDeliveriesPayload builds a control-plane service
+// list-webhook-deliveries endpoint payload.
+func NewListWebhookDeliveriesPayload(webhookID string, limit int) *controlplane.ListWebhookDeliveriesPayload {
+	v := &controlplane.ListWebhookDeliveriesPayload{}
+	v.WebhookID = webhookID
+	v.Limit = limit
+
+	return v
+}
+
 // NewRestoreDatabasePayload builds a control-plane service restore-database
 // endpoint payload.
 func NewRestoreDatabasePayload(body *RestoreDatabaseRequestBody, databaseID string, force bool) *controlplane.RestoreDatabasePayload {
@@ -5237,6 +8651,53 @@ func NewRestoreDatabasePayload(body *RestoreDatabaseRequestBody, databaseID stri
 	return res
 }
 
+// NewCloneDatabasePayload builds a control-plane service clone-database
+// endpoint payload.
+func NewCloneDatabasePayload(body *CloneDatabaseRequestBody, databaseID string) *controlplane.CloneDatabasePayload {
+	v := &controlplane.CloneDatabaseRequest{
+		ID:           controlplane.Identifier(*body.ID),
+		SourceNode:   body.SourceNode,
+		TargetTime:   body.TargetTime,
+		DatabaseName: body.DatabaseName,
+	}
+	if body.TenantID != nil {
+		tenantID := controlplane.Identifier(*body.TenantID)
+		v.TenantID = &tenantID
+	}
+	if body.Method != nil {
+		v.Method = *body.Method
+	}
+	if body.Method == nil {
+		v.Method = "backup"
+	}
+	if body.HostIds != nil {
+		v.HostIds = make([]controlplane.Identifier, len(body.HostIds))
+		for i, val := range body.HostIds {
+			v.HostIds[i] = controlplane.Identifier(val)
+		}
+	}
+	if body.RenameUsers != nil {
+		v.RenameUsers = make(map[string]string, len(body.RenameUsers))
+		for key, val := range body.RenameUsers {
+			tk := key
+			tv := val
+			v.RenameUsers[tk] = tv
+		}
+	}
+	if body.ScrubScript != nil {
+		v.ScrubScript = make([]string, len(body.ScrubScript))
+		for i, val := range body.ScrubScript {
+			v.ScrubScript[i] = val
+		}
+	}
+	res := &controlplane.CloneDatabasePayload{
+		Request: v,
+	}
+	res.DatabaseID = controlplane.Identifier(databaseID)
+
+	return res
+}
+
 // NewRestartInstancePayload builds a control-plane service restart-instance
 // endpoint payload.
 func NewRestartInstancePayload(body struct {
@@ -5252,6 +8713,48 @@ func NewRestartInstancePayload(body struct {
 	return v
 }
 
+// NewGetInstanceSessionsPayload builds a control-plane service
+// get-instance-sessions endpoint payload.
+func NewGetInstanceSessionsPayload(databaseID string, instanceID string) *controlplane.GetInstanceSessionsPayload {
+	v := &controlplane.GetInstanceSessionsPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+
+	return v
+}
+
+// NewCancelInstanceSessionPayload builds a control-plane service
+// cancel-instance-session endpoint payload.
+func NewCancelInstanceSessionPayload(databaseID string, instanceID string, pid int) *controlplane.CancelInstanceSessionPayload {
+	v := &controlplane.CancelInstanceSessionPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+	v.Pid = pid
+
+	return v
+}
+
+// NewTerminateInstanceSessionPayload builds a control-plane service
+// terminate-instance-session endpoint payload.
+func NewTerminateInstanceSessionPayload(databaseID string, instanceID string, pid int) *controlplane.TerminateInstanceSessionPayload {
+	v := &controlplane.TerminateInstanceSessionPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+	v.Pid = pid
+
+	return v
+}
+
+// NewGetInstancePostgresqlConfPayload builds a control-plane service
+// get-instance-postgresql-conf endpoint payload.
+func NewGetInstancePostgresqlConfPayload(databaseID string, instanceID string) *controlplane.GetInstancePostgresqlConfPayload {
+	v := &controlplane.GetInstancePostgresqlConfPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.InstanceID = instanceID
+
+	return v
+}
+
 // NewStopInstancePayload builds a control-plane service stop-instance endpoint
 // payload.
 func NewStopInstancePayload(databaseID string, instanceID string, force bool) *controlplane.StopInstancePayload {
@@ -5284,6 +8787,16 @@ func NewCancelDatabaseTaskPayload(databaseID string, taskID string) *controlplan
 	return v
 }
 
+// NewResumeDatabaseTaskPayload builds a control-plane service
+// resume-database-task endpoint payload.
+func NewResumeDatabaseTaskPayload(databaseID string, taskID string) *controlplane.ResumeDatabaseTaskPayload {
+	v := &controlplane.ResumeDatabaseTaskPayload{}
+	v.DatabaseID = controlplane.Identifier(databaseID)
+	v.TaskID = controlplane.Identifier(taskID)
+
+	return v
+}
+
 // ValidateJoinClusterRequestBody runs the validations defined on
 // Join-ClusterRequestBody
 func ValidateJoinClusterRequestBody(body *JoinClusterRequestBody) (err error) {
@@ -5335,6 +8848,34 @@ func ValidateGetJoinOptionsRequestBody(body *GetJoinOptionsRequestBody) (err err
 	return
 }
 
+// ValidateDefragmentEtcdRequestBody runs the validations defined on
+// Defragment-EtcdRequestBody
+func ValidateDefragmentEtcdRequestBody(body *DefragmentEtcdRequestBody) (err error) {
+	for _, e := range body.HostIds {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+		}
+	}
+	return
+}
+
+// ValidateChangeHostEtcdModeRequestBody runs the validations defined on
+// Change-Host-Etcd-ModeRequestBody
+func ValidateChangeHostEtcdModeRequestBody(body *ChangeHostEtcdModeRequestBody) (err error) {
+	if body.EtcdMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("etcd_mode", "body"))
+	}
+	if body.EtcdMode != nil {
+		if !(*body.EtcdMode == "server" || *body.EtcdMode == "client") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.etcd_mode", *body.EtcdMode, []any{"server", "client"}))
+		}
+	}
+	return
+}
+
 // ValidateCreateDatabaseRequestBody runs the validations defined on
 // Create-DatabaseRequestBody
 func ValidateCreateDatabaseRequestBody(body *CreateDatabaseRequestBody) (err error) {
@@ -5366,6 +8907,11 @@ func ValidateCreateDatabaseRequestBody(body *CreateDatabaseRequestBody) (err err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Source != nil {
+		if err2 := ValidateImportSourceSpecRequestBody(body.Source); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -5396,14 +8942,38 @@ func ValidateUpdateDatabaseRequestBody(body *UpdateDatabaseRequestBody) (err err
 // ValidateApplyUpgradeRequestBody runs the validations defined on
 // Apply-UpgradeRequestBody
 func ValidateApplyUpgradeRequestBody(body *ApplyUpgradeRequestBody) (err error) {
-	if body.Image == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("image", "body"))
+	if body.Mode != nil {
+		if !(*body.Mode == "minor" || *body.Mode == "pg_upgrade") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"minor", "pg_upgrade"}))
+		}
 	}
 	if body.Image != nil {
 		if utf8.RuneCountInString(*body.Image) < 1 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.image", *body.Image, utf8.RuneCountInString(*body.Image), 1, true))
 		}
 	}
+	if body.PostgresVersion != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.postgres_version", *body.PostgresVersion, "^\\d{2}\\.\\d{1,2}$"))
+	}
+	return
+}
+
+// ValidateUpgradeDatabaseMajorRequestBody runs the validations defined on
+// Upgrade-Database-MajorRequestBody
+func ValidateUpgradeDatabaseMajorRequestBody(body *UpgradeDatabaseMajorRequestBody) (err error) {
+	if body.PostgresVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("postgres_version", "body"))
+	}
+	if body.PostgresVersion != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.postgres_version", *body.PostgresVersion, "^\\d{2}\\.\\d{1,2}$"))
+	}
+	for _, e := range body.Nodes {
+		if e != nil {
+			if err2 := ValidateMajorUpgradeNodeSpecRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -5440,6 +9010,62 @@ func ValidateSwitchoverDatabaseNodeRequestBody(body *SwitchoverDatabaseNodeReque
 	return
 }
 
+// ValidateRollingRestartDatabaseRequestBody runs the validations defined on
+// Rolling-Restart-DatabaseRequestBody
+func ValidateRollingRestartDatabaseRequestBody(body *RollingRestartDatabaseRequestBody) (err error) {
+	if body.Concurrency != nil {
+		if *body.Concurrency < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.concurrency", *body.Concurrency, 1, true))
+		}
+	}
+	if body.MaintenanceWindow != nil {
+		if err2 := ValidateMaintenanceWindowRequestBodyRequestBody(body.MaintenanceWindow); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCreateWebhookRequestBody runs the validations defined on
+// Create-WebhookRequestBody
+func ValidateCreateWebhookRequestBody(body *CreateWebhookRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 256, false))
+		}
+	}
+	if body.Secret != nil {
+		if utf8.RuneCountInString(*body.Secret) < 16 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.secret", *body.Secret, utf8.RuneCountInString(*body.Secret), 16, true))
+		}
+	}
+	if body.Secret != nil {
+		if utf8.RuneCountInString(*body.Secret) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.secret", *body.Secret, utf8.RuneCountInString(*body.Secret), 256, false))
+		}
+	}
+	for _, e := range body.EventTypes {
+		if !(e == "task_completed" || e == "task_failed" || e == "database_state_changed" || e == "instance_role_changed" || e == "host_unreachable") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"task_completed", "task_failed", "database_state_changed", "instance_role_changed", "host_unreachable"}))
+		}
+	}
+	for _, e := range body.DatabaseIds {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+		}
+	}
+	return
+}
+
 // ValidateRestoreDatabaseRequestBody runs the validations defined on
 // Restore-DatabaseRequestBody
 func ValidateRestoreDatabaseRequestBody(body *RestoreDatabaseRequestBody) (err error) {
@@ -5457,6 +9083,81 @@ func ValidateRestoreDatabaseRequestBody(body *RestoreDatabaseRequestBody) (err e
 	return
 }
 
+// ValidateCloneDatabaseRequestBody runs the validations defined on
+// Clone-DatabaseRequestBody
+func ValidateCloneDatabaseRequestBody(body *CloneDatabaseRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ID != nil {
+		if utf8.RuneCountInString(*body.ID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.id", *body.ID, utf8.RuneCountInString(*body.ID), 1, true))
+		}
+	}
+	if body.ID != nil {
+		if utf8.RuneCountInString(*body.ID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.id", *body.ID, utf8.RuneCountInString(*body.ID), 36, false))
+		}
+	}
+	if body.TenantID != nil {
+		if utf8.RuneCountInString(*body.TenantID) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tenant_id", *body.TenantID, utf8.RuneCountInString(*body.TenantID), 1, true))
+		}
+	}
+	if body.TenantID != nil {
+		if utf8.RuneCountInString(*body.TenantID) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tenant_id", *body.TenantID, utf8.RuneCountInString(*body.TenantID), 36, false))
+		}
+	}
+	if body.SourceNode != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.source_node", *body.SourceNode, "n[0-9]+"))
+	}
+	if body.Method != nil {
+		if !(*body.Method == "backup" || *body.Method == "base_backup") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.method", *body.Method, []any{"backup", "base_backup"}))
+		}
+	}
+	if body.TargetTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.target_time", *body.TargetTime, goa.FormatDateTime))
+	}
+	if len(body.HostIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids", body.HostIds, len(body.HostIds), 1, true))
+	}
+	for _, e := range body.HostIds {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+		}
+	}
+	if body.DatabaseName != nil {
+		if utf8.RuneCountInString(*body.DatabaseName) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_name", *body.DatabaseName, utf8.RuneCountInString(*body.DatabaseName), 1, true))
+		}
+	}
+	if body.DatabaseName != nil {
+		if utf8.RuneCountInString(*body.DatabaseName) > 31 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.database_name", *body.DatabaseName, utf8.RuneCountInString(*body.DatabaseName), 31, false))
+		}
+	}
+	if len(body.ScrubScript) < 0 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script", body.ScrubScript, len(body.ScrubScript), 0, true))
+	}
+	if len(body.ScrubScript) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script", body.ScrubScript, len(body.ScrubScript), 256, false))
+	}
+	for _, e := range body.ScrubScript {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 1024 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_script[*]", e, utf8.RuneCountInString(e), 1024, false))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseSpecRequestBody runs the validations defined on
 // DatabaseSpecRequestBody
 func ValidateDatabaseSpecRequestBody(body *DatabaseSpecRequestBody) (err error) {
@@ -5563,6 +9264,26 @@ func ValidateDatabaseSpecRequestBody(body *DatabaseSpecRequestBody) (err error)
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.QueryStats != nil {
+		if err2 := ValidateQueryStatsSettingsRequestBody(body.QueryStats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.DriftPolicy != nil {
+		if !(*body.DriftPolicy == "report" || *body.DriftPolicy == "remediate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.drift_policy", *body.DriftPolicy, []any{"report", "remediate"}))
+		}
+	}
+	if body.PatroniDcs != nil {
+		if !(*body.PatroniDcs == "control_plane" || *body.PatroniDcs == "external") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.patroni_dcs", *body.PatroniDcs, []any{"control_plane", "external"}))
+		}
+	}
 	return
 }
 
@@ -5635,6 +9356,11 @@ func ValidateDatabaseNodeSpecRequestBody(body *DatabaseNodeSpecRequestBody) (err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -6090,6 +9816,56 @@ func ValidateExtraNetworkSpecRequestBody(body *ExtraNetworkSpecRequestBody) (err
 	return
 }
 
+// ValidatePatroniSettingsRequestBody runs the validations defined on
+// PatroniSettingsRequestBody
+func ValidatePatroniSettingsRequestBody(body *PatroniSettingsRequestBody) (err error) {
+	if body.MaximumLagOnFailover != nil {
+		if *body.MaximumLagOnFailover < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maximum_lag_on_failover", *body.MaximumLagOnFailover, 0, true))
+		}
+	}
+	if body.LoopWait != nil {
+		if *body.LoopWait < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loop_wait", *body.LoopWait, 1, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL < 20 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 20, true))
+		}
+	}
+	if body.RetryTimeout != nil {
+		if *body.RetryTimeout < 3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.retry_timeout", *body.RetryTimeout, 3, true))
+		}
+	}
+	if body.Watchdog != nil {
+		if err2 := ValidatePatroniWatchdogRequestBody(body.Watchdog); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatroniWatchdogRequestBody runs the validations defined on
+// PatroniWatchdogRequestBody
+func ValidatePatroniWatchdogRequestBody(body *PatroniWatchdogRequestBody) (err error) {
+	if body.Mode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mode", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "off" || *body.Mode == "automatic" || *body.Mode == "required") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"off", "automatic", "required"}))
+		}
+	}
+	if body.SafetyMargin != nil {
+		if *body.SafetyMargin < -1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.safety_margin", *body.SafetyMargin, -1, true))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseUserSpecRequestBody runs the validations defined on
 // DatabaseUserSpecRequestBody
 func ValidateDatabaseUserSpecRequestBody(body *DatabaseUserSpecRequestBody) (err error) {
@@ -6238,6 +10014,39 @@ func ValidateDatabaseScriptsRequestBody(body *DatabaseScriptsRequestBody) (err e
 	return
 }
 
+// ValidateQueryStatsSettingsRequestBody runs the validations defined on
+// QueryStatsSettingsRequestBody
+func ValidateQueryStatsSettingsRequestBody(body *QueryStatsSettingsRequestBody) (err error) {
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.TopN != nil {
+		if *body.TopN < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 1, true))
+		}
+	}
+	if body.TopN != nil {
+		if *body.TopN > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateImportSourceSpecRequestBody runs the validations defined on
+// ImportSourceSpecRequestBody
+func ValidateImportSourceSpecRequestBody(body *ImportSourceSpecRequestBody) (err error) {
+	if body.Dsn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dsn", "body"))
+	}
+	if body.Dsn != nil {
+		if utf8.RuneCountInString(*body.Dsn) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.dsn", *body.Dsn, utf8.RuneCountInString(*body.Dsn), 1, true))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseSpecRequestBodyRequestBody runs the validations defined on
 // DatabaseSpecRequestBodyRequestBody
 func ValidateDatabaseSpecRequestBodyRequestBody(body *DatabaseSpecRequestBodyRequestBody) (err error) {
@@ -6344,6 +10153,26 @@ func ValidateDatabaseSpecRequestBodyRequestBody(body *DatabaseSpecRequestBodyReq
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBodyRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.QueryStats != nil {
+		if err2 := ValidateQueryStatsSettingsRequestBodyRequestBody(body.QueryStats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.DriftPolicy != nil {
+		if !(*body.DriftPolicy == "report" || *body.DriftPolicy == "remediate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.drift_policy", *body.DriftPolicy, []any{"report", "remediate"}))
+		}
+	}
+	if body.PatroniDcs != nil {
+		if !(*body.PatroniDcs == "control_plane" || *body.PatroniDcs == "external") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.patroni_dcs", *body.PatroniDcs, []any{"control_plane", "external"}))
+		}
+	}
 	return
 }
 
@@ -6416,6 +10245,11 @@ func ValidateDatabaseNodeSpecRequestBodyRequestBody(body *DatabaseNodeSpecReques
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Patroni != nil {
+		if err2 := ValidatePatroniSettingsRequestBodyRequestBody(body.Patroni); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -6871,6 +10705,56 @@ func ValidateExtraNetworkSpecRequestBodyRequestBody(body *ExtraNetworkSpecReques
 	return
 }
 
+// ValidatePatroniSettingsRequestBodyRequestBody runs the validations defined
+// on PatroniSettingsRequestBodyRequestBody
+func ValidatePatroniSettingsRequestBodyRequestBody(body *PatroniSettingsRequestBodyRequestBody) (err error) {
+	if body.MaximumLagOnFailover != nil {
+		if *body.MaximumLagOnFailover < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maximum_lag_on_failover", *body.MaximumLagOnFailover, 0, true))
+		}
+	}
+	if body.LoopWait != nil {
+		if *body.LoopWait < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loop_wait", *body.LoopWait, 1, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL < 20 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 20, true))
+		}
+	}
+	if body.RetryTimeout != nil {
+		if *body.RetryTimeout < 3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.retry_timeout", *body.RetryTimeout, 3, true))
+		}
+	}
+	if body.Watchdog != nil {
+		if err2 := ValidatePatroniWatchdogRequestBodyRequestBody(body.Watchdog); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatroniWatchdogRequestBodyRequestBody runs the validations defined
+// on PatroniWatchdogRequestBodyRequestBody
+func ValidatePatroniWatchdogRequestBodyRequestBody(body *PatroniWatchdogRequestBodyRequestBody) (err error) {
+	if body.Mode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mode", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "off" || *body.Mode == "automatic" || *body.Mode == "required") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"off", "automatic", "required"}))
+		}
+	}
+	if body.SafetyMargin != nil {
+		if *body.SafetyMargin < -1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.safety_margin", *body.SafetyMargin, -1, true))
+		}
+	}
+	return
+}
+
 // ValidateDatabaseUserSpecRequestBodyRequestBody runs the validations defined
 // on DatabaseUserSpecRequestBodyRequestBody
 func ValidateDatabaseUserSpecRequestBodyRequestBody(body *DatabaseUserSpecRequestBodyRequestBody) (err error) {
@@ -7018,3 +10902,66 @@ func ValidateDatabaseScriptsRequestBodyRequestBody(body *DatabaseScriptsRequestB
 	}
 	return
 }
+
+// ValidateQueryStatsSettingsRequestBodyRequestBody runs the validations
+// defined on QueryStatsSettingsRequestBodyRequestBody
+func ValidateQueryStatsSettingsRequestBodyRequestBody(body *QueryStatsSettingsRequestBodyRequestBody) (err error) {
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.TopN != nil {
+		if *body.TopN < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 1, true))
+		}
+	}
+	if body.TopN != nil {
+		if *body.TopN > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top_n", *body.TopN, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateMajorUpgradeNodeSpecRequestBodyRequestBody runs the validations
+// defined on MajorUpgradeNodeSpecRequestBodyRequestBody
+func ValidateMajorUpgradeNodeSpecRequestBodyRequestBody(body *MajorUpgradeNodeSpecRequestBodyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.HostIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("host_ids", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "n[0-9]+"))
+	}
+	if len(body.HostIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids", body.HostIds, len(body.HostIds), 1, true))
+	}
+	for _, e := range body.HostIds {
+		if utf8.RuneCountInString(e) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 1, true))
+		}
+		if utf8.RuneCountInString(e) > 36 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.host_ids[*]", e, utf8.RuneCountInString(e), 36, false))
+		}
+	}
+	return
+}
+
+// ValidateMaintenanceWindowRequestBodyRequestBody runs the validations defined
+// on MaintenanceWindowRequestBodyRequestBody
+func ValidateMaintenanceWindowRequestBodyRequestBody(body *MaintenanceWindowRequestBodyRequestBody) (err error) {
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.start", *body.Start, "^([01][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.end", *body.End, "^([01][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	return
+}
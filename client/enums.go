@@ -9,6 +9,12 @@ const (
 const (
 	ClusterStateAvailable = "available"
 	ClusterStateError     = "error"
+	ClusterStateStarting  = "starting"
+)
+
+const (
+	UpgradeStateComplete   = "complete"
+	UpgradeStateInProgress = "in_progress"
 )
 
 const (
@@ -22,6 +28,14 @@ const (
 	DatabaseStateUnknown   = "unknown"
 )
 
+const (
+	EventKindHeartbeat = "heartbeat"
+	EventKindDatabase  = "database"
+	EventKindInstance  = "instance"
+	EventKindTask      = "task"
+	EventKindTaskLog   = "task_log"
+)
+
 const (
 	HostStateHealthy     = "healthy"
 	HostStateUnreachable = "unreachable"
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// errServerStarting is the health error for servers that belong to a cluster
+// but haven't finished starting up, such as while they wait for the other hosts
+// to be upgraded.
+var errServerStarting = errors.New("server is still starting up")
+
+type serverHealth int
+
+const (
+	// serverHealthUnknown means that the server hasn't been checked yet.
+	serverHealthUnknown serverHealth = iota
+	// serverHealthUnreachable means that the last request to the server failed
+	// with a connection error.
+	serverHealthUnreachable
+	// serverHealthNotReady means that the server is reachable, but it's not
+	// ready to serve requests. This happens when the server hasn't joined a
+	// cluster yet or while it's still starting up.
+	serverHealthNotReady
+	// serverHealthReady means that the server is reachable and initialized.
+	serverHealthReady
+)
+
+// trackedServer tracks the most recently observed health of a single server.
+type trackedServer struct {
+	hostID string
+	client *SingleServerClient
+
+	mu     sync.Mutex
+	health serverHealth
+	err    error
+}
+
+func (s *trackedServer) state() (serverHealth, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.health, s.err
+}
+
+func (s *trackedServer) setHealth(health serverHealth, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health = health
+	s.err = err
+}
+
+// observe updates the server's health from a failed request.
+func (s *trackedServer) observe(err error) {
+	switch {
+	case isConnectionError(err):
+		s.setHealth(serverHealthUnreachable, err)
+	case errors.Is(err, ErrClusterNotInitialized):
+		s.setHealth(serverHealthNotReady, err)
+	}
+}
+
+// check refreshes the server's health. We use the get-cluster endpoint because,
+// unlike get-version, it fails until the server is initialized and it reports
+// a starting state until its handlers are ready.
+func (s *trackedServer) check(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cluster, err := s.client.GetCluster(ctx)
+	switch {
+	case err == nil && cluster.Status != nil && cluster.Status.State == ClusterStateStarting:
+		s.setHealth(serverHealthNotReady, errServerStarting)
+	case err == nil:
+		s.setHealth(serverHealthReady, nil)
+	case errors.Is(err, ErrClusterNotInitialized):
+		s.setHealth(serverHealthNotReady, err)
+	default:
+		// Any other failure, including a server error, means that we
+		// shouldn't send requests to this server.
+		s.setHealth(serverHealthUnreachable, err)
+	}
+}
+
+// isConnectionError returns true if the error indicates that we weren't able
+// to get a response from the server.
+func isConnectionError(err error) bool {
+	var clientErr *goahttp.ClientError
+	if errors.As(err, &clientErr) && clientErr.Name == "request_error" {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// isDialError returns true if the error indicates that we failed to connect to
+// the server. The server could not have received the request, so it's safe to
+// retry any request that fails with a dial error.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -37,6 +37,30 @@ type Client interface {
 	CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (res *api.Task, err error)
 	SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (*api.SwitchoverDatabaseNodeResponse, error)
 	FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (*api.FailoverDatabaseNodeResponse, error)
+	GetJoinOptions(ctx context.Context, req *api.ClusterJoinRequest) (*api.ClusterJoinOptions, error)
+	GetStorageUsage(ctx context.Context, req *api.GetStorageUsagePayload) (*api.StorageUsage, error)
+	GetEtcdCluster(ctx context.Context) (*api.EtcdCluster, error)
+	DefragmentEtcd(ctx context.Context, req *api.DefragmentEtcdRequest) (*api.DefragmentEtcdResponse, error)
+	ChangeHostEtcdMode(ctx context.Context, req *api.ChangeHostEtcdModePayload) (*api.ChangeHostEtcdModeResponse, error)
+	ApplyUpgrade(ctx context.Context, req *api.ApplyUpgradePayload) (*api.ApplyUpgradeResponse, error)
+	UpgradeDatabaseMajor(ctx context.Context, req *api.UpgradeDatabaseMajorPayload) (*api.UpgradeDatabaseMajorResponse, error)
+	CutoverDatabaseImport(ctx context.Context, req *api.CutoverDatabaseImportPayload) (*api.CutoverDatabaseImportResponse, error)
+	RollingRestartDatabase(ctx context.Context, req *api.RollingRestartDatabasePayload) (*api.RollingRestartDatabaseResponse, error)
+	GetNodeQueryStats(ctx context.Context, req *api.GetNodeQueryStatsPayload) (*api.GetNodeQueryStatsResponse, error)
+	ResetNodeQueryStats(ctx context.Context, req *api.ResetNodeQueryStatsPayload) (*api.ResetNodeQueryStatsResponse, error)
+	StreamEvents(ctx context.Context, req *api.StreamEventsPayload) (api.StreamEventsClientStream, error)
+	CreateWebhook(ctx context.Context, req *api.CreateWebhookPayload) (*api.Webhook, error)
+	ListWebhooks(ctx context.Context) (*api.ListWebhooksResponse, error)
+	GetWebhook(ctx context.Context, req *api.GetWebhookPayload) (*api.Webhook, error)
+	DeleteWebhook(ctx context.Context, req *api.DeleteWebhookPayload) error
+	ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesPayload) (*api.ListWebhookDeliveriesResponse, error)
+	CloneDatabase(ctx context.Context, req *api.CloneDatabasePayload) (*api.CloneDatabaseResponse, error)
+	GetInstanceSessions(ctx context.Context, req *api.GetInstanceSessionsPayload) (*api.GetInstanceSessionsResponse, error)
+	CancelInstanceSession(ctx context.Context, req *api.CancelInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error)
+	TerminateInstanceSession(ctx context.Context, req *api.TerminateInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error)
+	GetInstancePostgresqlConf(ctx context.Context, req *api.GetInstancePostgresqlConfPayload) (*api.GetInstancePostgreSQLConfResponse, error)
+	ResumeDatabaseTask(ctx context.Context, req *api.ResumeDatabaseTaskPayload) (*api.Task, error)
+
 	// Helper methods
 
 	WaitForDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskPayload) (*api.Task, error)
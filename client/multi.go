@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
 )
@@ -15,30 +19,121 @@ var ErrNoHealthyServers = errors.New("no healthy servers found")
 
 var _ Client = (*MultiServerClient)(nil)
 
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultHealthCheckTimeout  = 5 * time.Second
+	defaultMaxAttempts         = 4
+	defaultInitialBackoff      = 250 * time.Millisecond
+	defaultMaxBackoff          = 2 * time.Second
+)
+
+// MultiServerOptions configures how a MultiServerClient chooses servers and
+// retries requests. Zero values are replaced with defaults.
+type MultiServerOptions struct {
+	// PreferredHostID is the host that receives requests whenever it's ready,
+	// such as the host that the client is running on. Requests are spread
+	// across all ready servers when this is empty or when the preferred host
+	// is unavailable.
+	PreferredHostID string
+	// HealthCheckInterval is how often each server's health is refreshed in
+	// the background. A negative value disables background health checks, in
+	// which case health is only updated from the results of requests.
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout is the timeout for each health check.
+	HealthCheckTimeout time.Duration
+	// MaxAttempts is the maximum number of times that a request is attempted.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. The wait doubles
+	// after each attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func (o MultiServerOptions) withDefaults() MultiServerOptions {
+	if o.HealthCheckInterval == 0 {
+		o.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if o.HealthCheckTimeout <= 0 {
+		o.HealthCheckTimeout = defaultHealthCheckTimeout
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultMaxAttempts
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = defaultInitialBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = defaultMaxBackoff
+	}
+	return o
+}
+
+// MultiServerClient sends each request to one of several Control Plane servers
+// in the same cluster. It tracks each server's health in the background and
+// only sends requests to servers that are ready. Requests that fail because a
+// server is unreachable or not ready are retried on another server when it's
+// safe to do so. Close must be called to stop the background health checks.
 type MultiServerClient struct {
+	opts    MultiServerOptions
 	servers map[string]*SingleServerClient
+	// ordered contains the same servers as the servers map, sorted by host ID
+	// so that server selection is deterministic.
+	ordered []*trackedServer
+	next    atomic.Uint64
+
+	startOnce sync.Once
+	stop      context.CancelFunc
+	done      chan struct{}
 }
 
+// NewMultiServerClient returns a MultiServerClient with the default options.
+// The client starts a health check goroutine on its first request, and that
+// goroutine runs until Close is called, so callers must call Close once
+// they're done with the client.
 func NewMultiServerClient(servers ...ServerConfig) (*MultiServerClient, error) {
+	return NewMultiServerClientWithOptions(MultiServerOptions{}, servers...)
+}
+
+// NewMultiServerClientWithOptions is like NewMultiServerClient, but with the
+// given options. Callers must call Close once they're done with the client.
+func NewMultiServerClientWithOptions(opts MultiServerOptions, servers ...ServerConfig) (*MultiServerClient, error) {
 	if len(servers) == 0 {
 		return nil, ErrNoServers
 	}
 
 	c := &MultiServerClient{
+		opts:    opts.withDefaults(),
 		servers: make(map[string]*SingleServerClient, len(servers)),
 	}
 
-	for i, server := range servers {
-		s, err := NewSingleServerClient(server)
+	for i, cfg := range servers {
+		s, err := NewSingleServerClient(cfg)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client for server at index %d: %w", i, err)
 		}
-		c.servers[server.hostID] = s
+		c.servers[cfg.hostID] = s
 	}
+	for hostID, s := range c.servers {
+		c.ordered = append(c.ordered, &trackedServer{hostID: hostID, client: s})
+	}
+	slices.SortFunc(c.ordered, func(a, b *trackedServer) int {
+		return strings.Compare(a.hostID, b.hostID)
+	})
 
 	return c, nil
 }
 
+// Close stops the background health checks. The client can still be used
+// after it's closed.
+func (c *MultiServerClient) Close() {
+	// Prevent the health checks from starting if they haven't already.
+	c.startOnce.Do(func() {})
+	if c.stop != nil {
+		c.stop()
+		<-c.done
+	}
+}
+
 func (c *MultiServerClient) Server(hostID string) (*SingleServerClient, error) {
 	server, ok := c.servers[hostID]
 	if !ok {
@@ -89,6 +184,10 @@ func (c *MultiServerClient) InitCluster(ctx context.Context, req *api.InitCluste
 		}
 	}
 
+	// The servers' readiness has changed, so we refresh their health rather
+	// than waiting for the next background check.
+	c.refresh(ctx)
+
 	return joinToken, nil
 }
 
@@ -100,275 +199,525 @@ func (c *MultiServerClient) JoinCluster(ctx context.Context, req *api.ClusterJoi
 		}
 	}
 
+	c.refresh(ctx)
+
 	return nil
 }
 
-func (c *MultiServerClient) GetJoinToken(ctx context.Context) (res *api.ClusterJoinToken, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetJoinToken(ctx)
+func (c *MultiServerClient) GetJoinToken(ctx context.Context) (*api.ClusterJoinToken, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ClusterJoinToken, error) {
+		return s.GetJoinToken(ctx)
+	})
 }
 
-func (c *MultiServerClient) GetCluster(ctx context.Context) (res *api.Cluster, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetCluster(ctx)
+func (c *MultiServerClient) GetCluster(ctx context.Context) (*api.Cluster, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Cluster, error) {
+		return s.GetCluster(ctx)
+	})
 }
 
-func (c *MultiServerClient) ListHosts(ctx context.Context) (res *api.ListHostsResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.ListHosts(ctx)
+func (c *MultiServerClient) ListHosts(ctx context.Context) (*api.ListHostsResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListHostsResponse, error) {
+		return s.ListHosts(ctx)
+	})
 }
 
-func (c *MultiServerClient) GetHost(ctx context.Context, req *api.GetHostPayload) (res *api.Host, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetHost(ctx, req)
+func (c *MultiServerClient) GetHost(ctx context.Context, req *api.GetHostPayload) (*api.Host, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Host, error) {
+		return s.GetHost(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) RemoveHost(ctx context.Context, req *api.RemoveHostPayload) (*api.RemoveHostResponse, error) {
-	for hostID, server := range c.servers {
-		// Try to find a server other than the one we're trying to remove.
-		if hostID == string(req.HostID) {
-			continue
-		}
-		// Check liveness
-		_, err := server.GetVersion(ctx)
-		if err != nil {
-			continue
-		}
-
-		return server.RemoveHost(ctx, req)
-	}
-
-	// Fallback to attempting from any live server so that the user gets the
-	// server-generated error message from trying to remove a host from itself.
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.RemoveHost(ctx, req)
+func (c *MultiServerClient) ListDatabases(ctx context.Context) (*api.ListDatabasesResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListDatabasesResponse, error) {
+		return s.ListDatabases(ctx)
+	})
 }
 
-func (c *MultiServerClient) ListDatabases(ctx context.Context) (res *api.ListDatabasesResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.ListDatabases(ctx)
+func (c *MultiServerClient) CreateDatabase(ctx context.Context, req *api.CreateDatabaseRequest) (*api.CreateDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.CreateDatabaseResponse, error) {
+		return s.CreateDatabase(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) CreateDatabase(ctx context.Context, req *api.CreateDatabaseRequest) (res *api.CreateDatabaseResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.CreateDatabase(ctx, req)
+func (c *MultiServerClient) GetDatabase(ctx context.Context, req *api.GetDatabasePayload) (*api.Database, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Database, error) {
+		return s.GetDatabase(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) GetDatabase(ctx context.Context, req *api.GetDatabasePayload) (res *api.Database, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetDatabase(ctx, req)
-}
-
-func (c *MultiServerClient) UpdateDatabase(ctx context.Context, req *api.UpdateDatabasePayload) (res *api.UpdateDatabaseResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.UpdateDatabase(ctx, req)
+func (c *MultiServerClient) UpdateDatabase(ctx context.Context, req *api.UpdateDatabasePayload) (*api.UpdateDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.UpdateDatabaseResponse, error) {
+		return s.UpdateDatabase(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) DeleteDatabase(ctx context.Context, req *api.DeleteDatabasePayload) (res *api.DeleteDatabaseResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.DeleteDatabase(ctx, req)
+func (c *MultiServerClient) DeleteDatabase(ctx context.Context, req *api.DeleteDatabasePayload) (*api.DeleteDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.DeleteDatabaseResponse, error) {
+		return s.DeleteDatabase(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) BackupDatabaseNode(ctx context.Context, req *api.BackupDatabaseNodePayload) (res *api.BackupDatabaseNodeResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.BackupDatabaseNode(ctx, req)
+func (c *MultiServerClient) BackupDatabaseNode(ctx context.Context, req *api.BackupDatabaseNodePayload) (*api.BackupDatabaseNodeResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.BackupDatabaseNodeResponse, error) {
+		return s.BackupDatabaseNode(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) ListTasks(ctx context.Context, req *api.ListTasksPayload) (res *api.ListTasksResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.ListTasks(ctx, req)
+func (c *MultiServerClient) ListTasks(ctx context.Context, req *api.ListTasksPayload) (*api.ListTasksResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListTasksResponse, error) {
+		return s.ListTasks(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) ListDatabaseTasks(ctx context.Context, req *api.ListDatabaseTasksPayload) (res *api.ListDatabaseTasksResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.ListDatabaseTasks(ctx, req)
+func (c *MultiServerClient) ListDatabaseTasks(ctx context.Context, req *api.ListDatabaseTasksPayload) (*api.ListDatabaseTasksResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListDatabaseTasksResponse, error) {
+		return s.ListDatabaseTasks(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) GetDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskPayload) (res *api.Task, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetDatabaseTask(ctx, req)
+func (c *MultiServerClient) GetDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskPayload) (*api.Task, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Task, error) {
+		return s.GetDatabaseTask(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) GetDatabaseTaskLog(ctx context.Context, req *api.GetDatabaseTaskLogPayload) (res *api.TaskLog, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetDatabaseTaskLog(ctx, req)
+func (c *MultiServerClient) GetDatabaseTaskLog(ctx context.Context, req *api.GetDatabaseTaskLogPayload) (*api.TaskLog, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.TaskLog, error) {
+		return s.GetDatabaseTaskLog(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) ListHostTasks(ctx context.Context, req *api.ListHostTasksPayload) (*api.ListHostTasksResponse, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.ListHostTasks(ctx, req)
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListHostTasksResponse, error) {
+		return s.ListHostTasks(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) GetHostTask(ctx context.Context, req *api.GetHostTaskPayload) (*api.Task, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetHostTask(ctx, req)
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Task, error) {
+		return s.GetHostTask(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) GetHostTaskLog(ctx context.Context, req *api.GetHostTaskLogPayload) (*api.TaskLog, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetHostTaskLog(ctx, req)
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.TaskLog, error) {
+		return s.GetHostTaskLog(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) RestoreDatabase(ctx context.Context, req *api.RestoreDatabasePayload) (res *api.RestoreDatabaseResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.RestoreDatabase(ctx, req)
+func (c *MultiServerClient) RestoreDatabase(ctx context.Context, req *api.RestoreDatabasePayload) (*api.RestoreDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.RestoreDatabaseResponse, error) {
+		return s.RestoreDatabase(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) GetVersion(ctx context.Context) (res *api.VersionInfo, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.GetVersion(ctx)
+func (c *MultiServerClient) GetVersion(ctx context.Context) (*api.VersionInfo, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.VersionInfo, error) {
+		return s.GetVersion(ctx)
+	})
 }
 
 func (c *MultiServerClient) StopInstance(ctx context.Context, req *api.StopInstancePayload) (*api.StopInstanceResponse, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.StopInstance(ctx, req)
+	return do(ctx, c, func(s *SingleServerClient) (*api.StopInstanceResponse, error) {
+		return s.StopInstance(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) StartInstance(ctx context.Context, req *api.StartInstancePayload) (*api.StartInstanceResponse, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.StartInstance(ctx, req)
+	return do(ctx, c, func(s *SingleServerClient) (*api.StartInstanceResponse, error) {
+		return s.StartInstance(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) RestartInstance(ctx context.Context, req *api.RestartInstancePayload) (res *api.RestartInstanceResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.RestartInstance(ctx, req)
+func (c *MultiServerClient) RestartInstance(ctx context.Context, req *api.RestartInstancePayload) (*api.RestartInstanceResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.RestartInstanceResponse, error) {
+		return s.RestartInstance(ctx, req)
+	})
 }
-func (c *MultiServerClient) CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (res *api.Task, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.CancelDatabaseTask(ctx, req)
+
+func (c *MultiServerClient) CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (*api.Task, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.Task, error) {
+		return s.CancelDatabaseTask(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (res *api.SwitchoverDatabaseNodeResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.SwitchoverDatabaseNode(ctx, req)
+func (c *MultiServerClient) SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (*api.SwitchoverDatabaseNodeResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.SwitchoverDatabaseNodeResponse, error) {
+		return s.SwitchoverDatabaseNode(ctx, req)
+	})
 }
 
-func (c *MultiServerClient) FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (res *api.FailoverDatabaseNodeResponse, err error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.FailoverDatabaseNode(ctx, req)
+func (c *MultiServerClient) FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (*api.FailoverDatabaseNodeResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.FailoverDatabaseNodeResponse, error) {
+		return s.FailoverDatabaseNode(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetJoinOptions(ctx context.Context, req *api.ClusterJoinRequest) (*api.ClusterJoinOptions, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ClusterJoinOptions, error) {
+		return s.GetJoinOptions(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetStorageUsage(ctx context.Context, req *api.GetStorageUsagePayload) (*api.StorageUsage, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.StorageUsage, error) {
+		return s.GetStorageUsage(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetEtcdCluster(ctx context.Context) (*api.EtcdCluster, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.EtcdCluster, error) {
+		return s.GetEtcdCluster(ctx)
+	})
+}
+
+func (c *MultiServerClient) DefragmentEtcd(ctx context.Context, req *api.DefragmentEtcdRequest) (*api.DefragmentEtcdResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.DefragmentEtcdResponse, error) {
+		return s.DefragmentEtcd(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) ChangeHostEtcdMode(ctx context.Context, req *api.ChangeHostEtcdModePayload) (*api.ChangeHostEtcdModeResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.ChangeHostEtcdModeResponse, error) {
+		return s.ChangeHostEtcdMode(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) ApplyUpgrade(ctx context.Context, req *api.ApplyUpgradePayload) (*api.ApplyUpgradeResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.ApplyUpgradeResponse, error) {
+		return s.ApplyUpgrade(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) UpgradeDatabaseMajor(ctx context.Context, req *api.UpgradeDatabaseMajorPayload) (*api.UpgradeDatabaseMajorResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.UpgradeDatabaseMajorResponse, error) {
+		return s.UpgradeDatabaseMajor(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) CutoverDatabaseImport(ctx context.Context, req *api.CutoverDatabaseImportPayload) (*api.CutoverDatabaseImportResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.CutoverDatabaseImportResponse, error) {
+		return s.CutoverDatabaseImport(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) RollingRestartDatabase(ctx context.Context, req *api.RollingRestartDatabasePayload) (*api.RollingRestartDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.RollingRestartDatabaseResponse, error) {
+		return s.RollingRestartDatabase(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetNodeQueryStats(ctx context.Context, req *api.GetNodeQueryStatsPayload) (*api.GetNodeQueryStatsResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.GetNodeQueryStatsResponse, error) {
+		return s.GetNodeQueryStats(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) ResetNodeQueryStats(ctx context.Context, req *api.ResetNodeQueryStatsPayload) (*api.ResetNodeQueryStatsResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.ResetNodeQueryStatsResponse, error) {
+		return s.ResetNodeQueryStats(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) StreamEvents(ctx context.Context, req *api.StreamEventsPayload) (api.StreamEventsClientStream, error) {
+	return do(ctx, c, func(s *SingleServerClient) (api.StreamEventsClientStream, error) {
+		return s.StreamEvents(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) CreateWebhook(ctx context.Context, req *api.CreateWebhookPayload) (*api.Webhook, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.Webhook, error) {
+		return s.CreateWebhook(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) ListWebhooks(ctx context.Context) (*api.ListWebhooksResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListWebhooksResponse, error) {
+		return s.ListWebhooks(ctx)
+	})
+}
+
+func (c *MultiServerClient) GetWebhook(ctx context.Context, req *api.GetWebhookPayload) (*api.Webhook, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.Webhook, error) {
+		return s.GetWebhook(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookPayload) error {
+	_, err := do(ctx, c, func(s *SingleServerClient) (struct{}, error) {
+		return struct{}{}, s.DeleteWebhook(ctx, req)
+	})
+	return err
+}
+
+func (c *MultiServerClient) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesPayload) (*api.ListWebhookDeliveriesResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.ListWebhookDeliveriesResponse, error) {
+		return s.ListWebhookDeliveries(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) CloneDatabase(ctx context.Context, req *api.CloneDatabasePayload) (*api.CloneDatabaseResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.CloneDatabaseResponse, error) {
+		return s.CloneDatabase(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetInstanceSessions(ctx context.Context, req *api.GetInstanceSessionsPayload) (*api.GetInstanceSessionsResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.GetInstanceSessionsResponse, error) {
+		return s.GetInstanceSessions(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) CancelInstanceSession(ctx context.Context, req *api.CancelInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.SignalInstanceSessionResponse, error) {
+		return s.CancelInstanceSession(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) TerminateInstanceSession(ctx context.Context, req *api.TerminateInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.SignalInstanceSessionResponse, error) {
+		return s.TerminateInstanceSession(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) GetInstancePostgresqlConf(ctx context.Context, req *api.GetInstancePostgresqlConfPayload) (*api.GetInstancePostgreSQLConfResponse, error) {
+	return doIdempotent(ctx, c, func(s *SingleServerClient) (*api.GetInstancePostgreSQLConfResponse, error) {
+		return s.GetInstancePostgresqlConf(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) ResumeDatabaseTask(ctx context.Context, req *api.ResumeDatabaseTaskPayload) (*api.Task, error) {
+	return do(ctx, c, func(s *SingleServerClient) (*api.Task, error) {
+		return s.ResumeDatabaseTask(ctx, req)
+	})
+}
+
+func (c *MultiServerClient) RemoveHost(ctx context.Context, req *api.RemoveHostPayload) (*api.RemoveHostResponse, error) {
+	// Try to use a server other than the one we're trying to remove. The host
+	// being removed is still used as a last resort so that the user gets the
+	// server-generated error message from trying to remove a host from itself.
+	return doExcluding(ctx, c, false, string(req.HostID), func(s *SingleServerClient) (*api.RemoveHostResponse, error) {
+		return s.RemoveHost(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) WaitForDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskPayload) (*api.Task, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.WaitForDatabaseTask(ctx, req)
+	return waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
+		return c.GetDatabaseTask(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) WaitForHostTask(ctx context.Context, req *api.GetHostTaskPayload) (*api.Task, error) {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return server.WaitForHostTask(ctx, req)
+	return waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
+		return c.GetHostTask(ctx, req)
+	})
 }
 
 func (c *MultiServerClient) FollowDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return err
-	}
-	return server.FollowDatabaseTask(ctx, req, handler)
+	return followTaskLog(ctx, req.AfterEntryID, func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error) {
+		return c.GetDatabaseTaskLog(ctx, &api.GetDatabaseTaskLogPayload{
+			DatabaseID:   req.DatabaseID,
+			TaskID:       req.TaskID,
+			AfterEntryID: afterEntryID,
+			Limit:        req.Limit,
+		})
+	}, handler)
 }
 
 func (c *MultiServerClient) FollowHostTask(ctx context.Context, req *api.GetHostTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
-	server, err := c.liveServer(ctx)
-	if err != nil {
-		return err
+	return followTaskLog(ctx, req.AfterEntryID, func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error) {
+		return c.GetHostTaskLog(ctx, &api.GetHostTaskLogPayload{
+			HostID:       req.HostID,
+			TaskID:       req.TaskID,
+			AfterEntryID: afterEntryID,
+			Limit:        req.Limit,
+		})
+	}, handler)
+}
+
+// doIdempotent executes a request that's safe to repeat. It's retried on
+// another server if the server is unreachable or not ready.
+func doIdempotent[T any](ctx context.Context, c *MultiServerClient, fn func(s *SingleServerClient) (T, error)) (T, error) {
+	return doExcluding(ctx, c, true, "", fn)
+}
+
+// do executes a request that may not be safe to repeat. It's only retried if
+// the server could not have processed it, such as when we're unable to
+// connect to the server.
+func do[T any](ctx context.Context, c *MultiServerClient, fn func(s *SingleServerClient) (T, error)) (T, error) {
+	return doExcluding(ctx, c, false, "", fn)
+}
+
+// doExcluding executes a request with retries. The excluded host is only used
+// if there are no other available servers.
+func doExcluding[T any](
+	ctx context.Context,
+	c *MultiServerClient,
+	idempotent bool,
+	exclude string,
+	fn func(s *SingleServerClient) (T, error),
+) (T, error) {
+	var zero T
+
+	tried := map[string]bool{}
+	if exclude != "" {
+		tried[exclude] = true
+	}
+	backoff := c.opts.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		s, err := c.pick(ctx, tried)
+		if err != nil {
+			return zero, err
+		}
+		health, _ := s.state()
+
+		res, err := fn(s.client)
+		if err == nil {
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			// The caller gave up, so this error says nothing about the
+			// server's health.
+			return res, err
+		}
+		s.observe(err)
+		if attempt >= c.opts.MaxAttempts || !retryable(err, health, idempotent) {
+			return res, err
+		}
+		tried[s.hostID] = true
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, c.opts.MaxBackoff)
+	}
+}
+
+func retryable(err error, health serverHealth, idempotent bool) bool {
+	switch {
+	case isDialError(err):
+		return true
+	case isConnectionError(err):
+		return idempotent
+	case errors.Is(err, ErrClusterNotInitialized):
+		// The pre-initialization handlers reject requests without doing
+		// anything, so these are always safe to retry. We only retry if we
+		// believed the server was ready, which means it's likely restarting.
+		// Otherwise, the cluster is not initialized and the error is
+		// expected.
+		return health == serverHealthReady
+	default:
+		return false
 	}
-	return server.FollowHostTask(ctx, req, handler)
 }
 
-func (c *MultiServerClient) liveServer(ctx context.Context) (*SingleServerClient, error) {
+// pick chooses the server for the next attempt of a request. Servers that have
+// already been tried for this request are only chosen when there are no other
+// available servers.
+func (c *MultiServerClient) pick(ctx context.Context, tried map[string]bool) (*trackedServer, error) {
+	c.start()
+
+	if c.unchecked() {
+		c.refresh(ctx)
+	}
+	if s := c.choose(tried); s != nil {
+		return s, nil
+	}
+
+	// None of the servers are available according to our cached health, so
+	// check them again in case any have recovered since the last check.
+	c.refresh(ctx)
+	if s := c.choose(tried); s != nil {
+		return s, nil
+	}
+
 	var errs []error
-	for _, server := range c.servers {
-		_, err := server.GetVersion(ctx)
-		if err == nil {
-			return server, nil
-		} else {
-			errs = append(errs, err)
+	for _, s := range c.ordered {
+		if _, err := s.state(); err != nil {
+			errs = append(errs, fmt.Errorf("host %s: %w", s.hostID, err))
 		}
 	}
-
 	errs = append(errs, ErrNoHealthyServers)
 	return nil, errors.Join(errs...)
 }
+
+// choose returns an available server, preferring ready servers over servers
+// that are not ready, and untried servers over tried servers. Within each
+// group, the preferred host is chosen if it's present. Otherwise, requests are
+// spread across the group.
+func (c *MultiServerClient) choose(tried map[string]bool) *trackedServer {
+	for _, untried := range []bool{true, false} {
+		for _, health := range []serverHealth{serverHealthReady, serverHealthNotReady} {
+			var candidates []*trackedServer
+			for _, s := range c.ordered {
+				if h, _ := s.state(); h != health || tried[s.hostID] == untried {
+					continue
+				}
+				if s.hostID == c.opts.PreferredHostID {
+					return s
+				}
+				candidates = append(candidates, s)
+			}
+			if len(candidates) > 0 {
+				idx := c.next.Add(1) % uint64(len(candidates))
+				return candidates[idx]
+			}
+		}
+	}
+	return nil
+}
+
+// unchecked returns true if none of the servers have been checked yet.
+func (c *MultiServerClient) unchecked() bool {
+	for _, s := range c.ordered {
+		if h, _ := s.state(); h != serverHealthUnknown {
+			return false
+		}
+	}
+	return true
+}
+
+// refresh checks the health of every server concurrently.
+func (c *MultiServerClient) refresh(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, s := range c.ordered {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.check(ctx, c.opts.HealthCheckTimeout)
+		}()
+	}
+	wg.Wait()
+}
+
+// start starts the background health checks if they haven't already been
+// started.
+func (c *MultiServerClient) start() {
+	c.startOnce.Do(func() {
+		if c.opts.HealthCheckInterval < 0 {
+			return
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		c.stop = cancel
+		c.done = make(chan struct{})
+
+		go func() {
+			defer close(c.done)
+
+			ticker := time.NewTicker(c.opts.HealthCheckInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					c.refresh(ctx)
+				}
+			}
+		}()
+	})
+}
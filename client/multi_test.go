@@ -0,0 +1,253 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+	"github.com/pgEdge/control-plane/client"
+)
+
+// fakeServer implements just enough of the API to exercise server selection
+// and retries.
+type fakeServer struct {
+	hostID string
+	srv    *httptest.Server
+
+	mu       sync.Mutex
+	ready    bool
+	starting bool
+	drop     bool
+	requests map[string]int
+}
+
+func newFakeServer(t *testing.T, hostID string, ready bool) *fakeServer {
+	t.Helper()
+
+	f := &fakeServer{
+		hostID:   hostID,
+		ready:    ready,
+		requests: map[string]int{},
+	}
+	f.srv = httptest.NewServer(f)
+	t.Cleanup(f.srv.Close)
+
+	return f
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests[r.Method+" "+r.URL.Path]++
+	ready, starting, drop := f.ready, f.starting, f.drop
+	f.mu.Unlock()
+
+	if drop {
+		// Close the connection without a response, as if the server crashed
+		// while handling the request.
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/v1/version":
+		writeJSON(w, http.StatusOK, map[string]any{
+			"version":       "1.0.0",
+			"revision":      f.hostID,
+			"revision_time": "2026-01-01T00:00:00Z",
+			"arch":          "amd64",
+		})
+	case !ready:
+		writeJSON(w, http.StatusConflict, map[string]any{
+			"name":    "cluster_not_initialized",
+			"message": "this operation is invalid on an uninitialized cluster",
+		})
+	case r.URL.Path == "/v1/cluster":
+		state := client.ClusterStateAvailable
+		if starting {
+			state = client.ClusterStateStarting
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"id":     "test",
+			"status": map[string]any{"state": state},
+			"hosts":  []any{},
+		})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]any{
+			"name":    "not_found",
+			"message": "not found",
+		})
+	}
+}
+
+func (f *fakeServer) set(ready, drop bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ready = ready
+	f.drop = drop
+}
+
+func (f *fakeServer) count(request string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[request]
+}
+
+func (f *fakeServer) config() client.ServerConfig {
+	u, _ := url.Parse(f.srv.URL)
+	return client.NewHTTPServerConfig(f.hostID, u)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func newTestClient(t *testing.T, opts client.MultiServerOptions, servers ...*fakeServer) *client.MultiServerClient {
+	t.Helper()
+
+	// Health is only refreshed on demand so that tests are deterministic.
+	opts.HealthCheckInterval = -1
+	opts.InitialBackoff = time.Millisecond
+
+	configs := make([]client.ServerConfig, len(servers))
+	for i, s := range servers {
+		configs[i] = s.config()
+	}
+	cli, err := client.NewMultiServerClientWithOptions(opts, configs...)
+	require.NoError(t, err)
+	t.Cleanup(cli.Close)
+
+	return cli
+}
+
+func TestMultiServerClient(t *testing.T) {
+	const getVersion = "GET /v1/version"
+
+	t.Run("prefers the preferred host", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host2 := newFakeServer(t, "host-2", true)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-2"}, host1, host2)
+
+		for range 4 {
+			_, err := cli.GetVersion(t.Context())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 0, host1.count(getVersion))
+		assert.Equal(t, 4, host2.count(getVersion))
+	})
+
+	t.Run("spreads requests across ready servers", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host2 := newFakeServer(t, "host-2", true)
+		host3 := newFakeServer(t, "host-3", false)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-3"}, host1, host2, host3)
+
+		for range 4 {
+			_, err := cli.GetVersion(t.Context())
+			require.NoError(t, err)
+		}
+		// host-3 is preferred, but it's not ready.
+		assert.Equal(t, 2, host1.count(getVersion))
+		assert.Equal(t, 2, host2.count(getVersion))
+		assert.Equal(t, 0, host3.count(getVersion))
+	})
+
+	t.Run("skips servers that are still starting", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host1.starting = true
+		host2 := newFakeServer(t, "host-2", true)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-1"}, host1, host2)
+
+		_, err := cli.GetVersion(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 0, host1.count(getVersion))
+		assert.Equal(t, 1, host2.count(getVersion))
+	})
+
+	t.Run("retries idempotent requests on another server", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host2 := newFakeServer(t, "host-2", true)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-1"}, host1, host2)
+
+		_, err := cli.GetVersion(t.Context())
+		require.NoError(t, err)
+
+		host1.set(true, true)
+		_, err = cli.GetVersion(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 1, host2.count(getVersion))
+
+		// host-1 is now marked unreachable, so it's skipped until its health
+		// is refreshed.
+		dropped := host1.count(getVersion)
+		_, err = cli.GetVersion(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, dropped, host1.count(getVersion))
+		assert.Equal(t, 2, host2.count(getVersion))
+	})
+
+	t.Run("does not retry other requests after a lost response", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host2 := newFakeServer(t, "host-2", true)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-1"}, host1, host2)
+
+		_, err := cli.GetVersion(t.Context())
+		require.NoError(t, err)
+
+		host1.set(true, true)
+		_, err = cli.CreateDatabase(t.Context(), &api.CreateDatabaseRequest{
+			Spec: &api.DatabaseSpec{DatabaseName: "test"},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, host1.count("POST /v1/databases"))
+		assert.Equal(t, 0, host2.count("POST /v1/databases"))
+	})
+
+	t.Run("retries when a ready server is restarting", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host2 := newFakeServer(t, "host-2", true)
+		cli := newTestClient(t, client.MultiServerOptions{PreferredHostID: "host-1"}, host1, host2)
+
+		_, err := cli.GetVersion(t.Context())
+		require.NoError(t, err)
+
+		host1.set(false, false)
+		_, err = cli.GetCluster(t.Context())
+		require.NoError(t, err)
+		// The initial health check also calls get-cluster.
+		assert.Equal(t, 2, host1.count("GET /v1/cluster"))
+		assert.Equal(t, 2, host2.count("GET /v1/cluster"))
+	})
+
+	t.Run("returns the server error when the cluster is not initialized", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", false)
+		cli := newTestClient(t, client.MultiServerOptions{}, host1)
+
+		_, err := cli.GetCluster(t.Context())
+		assert.ErrorIs(t, err, client.ErrClusterNotInitialized)
+	})
+
+	t.Run("no healthy servers", func(t *testing.T) {
+		host1 := newFakeServer(t, "host-1", true)
+		host1.srv.Close()
+		cli := newTestClient(t, client.MultiServerOptions{}, host1)
+
+		_, err := cli.GetVersion(t.Context())
+		assert.ErrorIs(t, err, client.ErrNoHealthyServers)
+		assert.ErrorContains(t, err, "host host-1")
+	})
+}
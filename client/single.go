@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
@@ -23,6 +24,9 @@ var taskEnded = map[string]bool{
 
 type SingleServerClient struct {
 	api *api.Client
+	// streamTaskLogs is true when task logs should be followed using the
+	// event stream rather than by polling.
+	streamTaskLogs bool
 }
 
 type ServerConfig struct {
@@ -46,35 +50,59 @@ func NewSingleServerClient(server ServerConfig) (*SingleServerClient, error) {
 		return nil, ErrInvalidServerConfig
 	}
 	return &SingleServerClient{
+		streamTaskLogs: server.mqtt != nil,
 		api: &api.Client{
-			InitClusterEndpoint:            cli.InitCluster(),
-			JoinClusterEndpoint:            cli.JoinCluster(),
-			GetJoinTokenEndpoint:           cli.GetJoinToken(),
-			GetClusterEndpoint:             cli.GetCluster(),
-			ListHostsEndpoint:              cli.ListHosts(),
-			GetHostEndpoint:                cli.GetHost(),
-			RemoveHostEndpoint:             cli.RemoveHost(),
-			ListDatabasesEndpoint:          cli.ListDatabases(),
-			CreateDatabaseEndpoint:         cli.CreateDatabase(),
-			GetDatabaseEndpoint:            cli.GetDatabase(),
-			UpdateDatabaseEndpoint:         cli.UpdateDatabase(),
-			DeleteDatabaseEndpoint:         cli.DeleteDatabase(),
-			BackupDatabaseNodeEndpoint:     cli.BackupDatabaseNode(),
-			ListDatabaseTasksEndpoint:      cli.ListDatabaseTasks(),
-			GetDatabaseTaskEndpoint:        cli.GetDatabaseTask(),
-			GetDatabaseTaskLogEndpoint:     cli.GetDatabaseTaskLog(),
-			RestoreDatabaseEndpoint:        cli.RestoreDatabase(),
-			GetVersionEndpoint:             cli.GetVersion(),
-			RestartInstanceEndpoint:        cli.RestartInstance(),
-			CancelDatabaseTaskEndpoint:     cli.CancelDatabaseTask(),
-			SwitchoverDatabaseNodeEndpoint: cli.SwitchoverDatabaseNode(),
-			FailoverDatabaseNodeEndpoint:   cli.FailoverDatabaseNode(),
-			ListHostTasksEndpoint:          cli.ListHostTasks(),
-			GetHostTaskEndpoint:            cli.GetHostTask(),
-			GetHostTaskLogEndpoint:         cli.GetHostTaskLog(),
-			ListTasksEndpoint:              cli.ListTasks(),
-			StopInstanceEndpoint:           cli.StopInstance(),
-			StartInstanceEndpoint:          cli.StartInstance(),
+			InitClusterEndpoint:               cli.InitCluster(),
+			JoinClusterEndpoint:               cli.JoinCluster(),
+			GetJoinTokenEndpoint:              cli.GetJoinToken(),
+			GetJoinOptionsEndpoint:            cli.GetJoinOptions(),
+			GetClusterEndpoint:                cli.GetCluster(),
+			GetStorageUsageEndpoint:           cli.GetStorageUsage(),
+			GetEtcdClusterEndpoint:            cli.GetEtcdCluster(),
+			DefragmentEtcdEndpoint:            cli.DefragmentEtcd(),
+			ListHostsEndpoint:                 cli.ListHosts(),
+			GetHostEndpoint:                   cli.GetHost(),
+			RemoveHostEndpoint:                cli.RemoveHost(),
+			ChangeHostEtcdModeEndpoint:        cli.ChangeHostEtcdMode(),
+			ListDatabasesEndpoint:             cli.ListDatabases(),
+			CreateDatabaseEndpoint:            cli.CreateDatabase(),
+			GetDatabaseEndpoint:               cli.GetDatabase(),
+			UpdateDatabaseEndpoint:            cli.UpdateDatabase(),
+			ApplyUpgradeEndpoint:              cli.ApplyUpgrade(),
+			UpgradeDatabaseMajorEndpoint:      cli.UpgradeDatabaseMajor(),
+			CutoverDatabaseImportEndpoint:     cli.CutoverDatabaseImport(),
+			DeleteDatabaseEndpoint:            cli.DeleteDatabase(),
+			BackupDatabaseNodeEndpoint:        cli.BackupDatabaseNode(),
+			SwitchoverDatabaseNodeEndpoint:    cli.SwitchoverDatabaseNode(),
+			RollingRestartDatabaseEndpoint:    cli.RollingRestartDatabase(),
+			FailoverDatabaseNodeEndpoint:      cli.FailoverDatabaseNode(),
+			GetNodeQueryStatsEndpoint:         cli.GetNodeQueryStats(),
+			ResetNodeQueryStatsEndpoint:       cli.ResetNodeQueryStats(),
+			ListDatabaseTasksEndpoint:         cli.ListDatabaseTasks(),
+			GetDatabaseTaskEndpoint:           cli.GetDatabaseTask(),
+			GetDatabaseTaskLogEndpoint:        cli.GetDatabaseTaskLog(),
+			ListHostTasksEndpoint:             cli.ListHostTasks(),
+			GetHostTaskEndpoint:               cli.GetHostTask(),
+			GetHostTaskLogEndpoint:            cli.GetHostTaskLog(),
+			ListTasksEndpoint:                 cli.ListTasks(),
+			StreamEventsEndpoint:              cli.StreamEvents(),
+			CreateWebhookEndpoint:             cli.CreateWebhook(),
+			ListWebhooksEndpoint:              cli.ListWebhooks(),
+			GetWebhookEndpoint:                cli.GetWebhook(),
+			DeleteWebhookEndpoint:             cli.DeleteWebhook(),
+			ListWebhookDeliveriesEndpoint:     cli.ListWebhookDeliveries(),
+			RestoreDatabaseEndpoint:           cli.RestoreDatabase(),
+			CloneDatabaseEndpoint:             cli.CloneDatabase(),
+			GetVersionEndpoint:                cli.GetVersion(),
+			RestartInstanceEndpoint:           cli.RestartInstance(),
+			GetInstanceSessionsEndpoint:       cli.GetInstanceSessions(),
+			CancelInstanceSessionEndpoint:     cli.CancelInstanceSession(),
+			TerminateInstanceSessionEndpoint:  cli.TerminateInstanceSession(),
+			GetInstancePostgresqlConfEndpoint: cli.GetInstancePostgresqlConf(),
+			StopInstanceEndpoint:              cli.StopInstance(),
+			StartInstanceEndpoint:             cli.StartInstance(),
+			CancelDatabaseTaskEndpoint:        cli.CancelDatabaseTask(),
+			ResumeDatabaseTaskEndpoint:        cli.ResumeDatabaseTask(),
 		},
 	}, nil
 }
@@ -100,7 +128,7 @@ func (c *SingleServerClient) GetCluster(ctx context.Context) (*api.Cluster, erro
 }
 
 func (c *SingleServerClient) ListHosts(ctx context.Context) (*api.ListHostsResponse, error) {
-	resp, err := c.api.ListHosts(ctx)
+	resp, err := c.api.ListHosts(ctx, &api.ListHostsPayload{})
 	return resp, translateErr(err)
 }
 
@@ -204,19 +232,192 @@ func (c *SingleServerClient) RestartInstance(ctx context.Context, req *api.Resta
 	return resp, translateErr(err)
 }
 
+func (c *SingleServerClient) GetJoinOptions(ctx context.Context, req *api.ClusterJoinRequest) (*api.ClusterJoinOptions, error) {
+	resp, err := c.api.GetJoinOptions(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetStorageUsage(ctx context.Context, req *api.GetStorageUsagePayload) (*api.StorageUsage, error) {
+	resp, err := c.api.GetStorageUsage(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetEtcdCluster(ctx context.Context) (*api.EtcdCluster, error) {
+	resp, err := c.api.GetEtcdCluster(ctx)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) DefragmentEtcd(ctx context.Context, req *api.DefragmentEtcdRequest) (*api.DefragmentEtcdResponse, error) {
+	resp, err := c.api.DefragmentEtcd(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) ChangeHostEtcdMode(ctx context.Context, req *api.ChangeHostEtcdModePayload) (*api.ChangeHostEtcdModeResponse, error) {
+	resp, err := c.api.ChangeHostEtcdMode(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) ApplyUpgrade(ctx context.Context, req *api.ApplyUpgradePayload) (*api.ApplyUpgradeResponse, error) {
+	resp, err := c.api.ApplyUpgrade(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) UpgradeDatabaseMajor(ctx context.Context, req *api.UpgradeDatabaseMajorPayload) (*api.UpgradeDatabaseMajorResponse, error) {
+	resp, err := c.api.UpgradeDatabaseMajor(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) CutoverDatabaseImport(ctx context.Context, req *api.CutoverDatabaseImportPayload) (*api.CutoverDatabaseImportResponse, error) {
+	resp, err := c.api.CutoverDatabaseImport(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) RollingRestartDatabase(ctx context.Context, req *api.RollingRestartDatabasePayload) (*api.RollingRestartDatabaseResponse, error) {
+	resp, err := c.api.RollingRestartDatabase(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetNodeQueryStats(ctx context.Context, req *api.GetNodeQueryStatsPayload) (*api.GetNodeQueryStatsResponse, error) {
+	resp, err := c.api.GetNodeQueryStats(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) ResetNodeQueryStats(ctx context.Context, req *api.ResetNodeQueryStatsPayload) (*api.ResetNodeQueryStatsResponse, error) {
+	resp, err := c.api.ResetNodeQueryStats(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) StreamEvents(ctx context.Context, req *api.StreamEventsPayload) (api.StreamEventsClientStream, error) {
+	resp, err := c.api.StreamEvents(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) CreateWebhook(ctx context.Context, req *api.CreateWebhookPayload) (*api.Webhook, error) {
+	resp, err := c.api.CreateWebhook(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) ListWebhooks(ctx context.Context) (*api.ListWebhooksResponse, error) {
+	resp, err := c.api.ListWebhooks(ctx)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetWebhook(ctx context.Context, req *api.GetWebhookPayload) (*api.Webhook, error) {
+	resp, err := c.api.GetWebhook(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookPayload) error {
+	err := c.api.DeleteWebhook(ctx, req)
+	return translateErr(err)
+}
+
+func (c *SingleServerClient) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesPayload) (*api.ListWebhookDeliveriesResponse, error) {
+	resp, err := c.api.ListWebhookDeliveries(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) CloneDatabase(ctx context.Context, req *api.CloneDatabasePayload) (*api.CloneDatabaseResponse, error) {
+	resp, err := c.api.CloneDatabase(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetInstanceSessions(ctx context.Context, req *api.GetInstanceSessionsPayload) (*api.GetInstanceSessionsResponse, error) {
+	resp, err := c.api.GetInstanceSessions(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) CancelInstanceSession(ctx context.Context, req *api.CancelInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	resp, err := c.api.CancelInstanceSession(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) TerminateInstanceSession(ctx context.Context, req *api.TerminateInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	resp, err := c.api.TerminateInstanceSession(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) GetInstancePostgresqlConf(ctx context.Context, req *api.GetInstancePostgresqlConfPayload) (*api.GetInstancePostgreSQLConfResponse, error) {
+	resp, err := c.api.GetInstancePostgresqlConf(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) ResumeDatabaseTask(ctx context.Context, req *api.ResumeDatabaseTaskPayload) (*api.Task, error) {
+	resp, err := c.api.ResumeDatabaseTask(ctx, req)
+	return resp, translateErr(err)
+}
+
 func (c *SingleServerClient) WaitForDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskPayload) (*api.Task, error) {
-	return c.waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
+	return waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
 		return c.GetDatabaseTask(ctx, req)
 	})
 }
 
 func (c *SingleServerClient) WaitForHostTask(ctx context.Context, req *api.GetHostTaskPayload) (*api.Task, error) {
-	return c.waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
+	return waitForTask(ctx, func(ctx context.Context) (*api.Task, error) {
 		return c.GetHostTask(ctx, req)
 	})
 }
 
-func (c *SingleServerClient) waitForTask(ctx context.Context, getTask func(context.Context) (*api.Task, error)) (*api.Task, error) {
+func (c *SingleServerClient) CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (*api.Task, error) {
+	resp, err := c.api.CancelDatabaseTask(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (*api.SwitchoverDatabaseNodeResponse, error) {
+	resp, err := c.api.SwitchoverDatabaseNode(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (*api.FailoverDatabaseNodeResponse, error) {
+	resp, err := c.api.FailoverDatabaseNode(ctx, req)
+	return resp, translateErr(err)
+}
+
+func (c *SingleServerClient) FollowDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
+	getLog := func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error) {
+		return c.GetDatabaseTaskLog(ctx, &api.GetDatabaseTaskLogPayload{
+			DatabaseID:   req.DatabaseID,
+			TaskID:       req.TaskID,
+			AfterEntryID: afterEntryID,
+			Limit:        req.Limit,
+		})
+	}
+	if c.streamTaskLogs {
+		databaseID := req.DatabaseID
+		return followTaskLogEvents(ctx, req.AfterEntryID, req.TaskID, func(ctx context.Context) (api.StreamEventsClientStream, error) {
+			return c.StreamEvents(ctx, &api.StreamEventsPayload{
+				DatabaseID: &databaseID,
+				Kind:       taskLogEventKinds,
+			})
+		}, getLog, handler)
+	}
+	return followTaskLog(ctx, req.AfterEntryID, getLog, handler)
+}
+
+func (c *SingleServerClient) FollowHostTask(ctx context.Context, req *api.GetHostTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
+	getLog := func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error) {
+		return c.GetHostTaskLog(ctx, &api.GetHostTaskLogPayload{
+			HostID:       req.HostID,
+			TaskID:       req.TaskID,
+			AfterEntryID: afterEntryID,
+			Limit:        req.Limit,
+		})
+	}
+	if c.streamTaskLogs {
+		hostID := req.HostID
+		return followTaskLogEvents(ctx, req.AfterEntryID, req.TaskID, func(ctx context.Context) (api.StreamEventsClientStream, error) {
+			return c.StreamEvents(ctx, &api.StreamEventsPayload{
+				HostID: &hostID,
+				Kind:   taskLogEventKinds,
+			})
+		}, getLog, handler)
+	}
+	return followTaskLog(ctx, req.AfterEntryID, getLog, handler)
+}
+
+// waitForTask polls the given task until it reaches a terminal status.
+func waitForTask(ctx context.Context, getTask func(context.Context) (*api.Task, error)) (*api.Task, error) {
 	ticker := time.NewTicker(taskPollInterval)
 	defer ticker.Stop()
 
@@ -240,33 +441,18 @@ func (c *SingleServerClient) waitForTask(ctx context.Context, getTask func(conte
 	return task, nil
 }
 
-func (c *SingleServerClient) CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (*api.Task, error) {
-	resp, err := c.api.CancelDatabaseTask(ctx, req)
-	return resp, translateErr(err)
-}
-
-func (c *SingleServerClient) SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (*api.SwitchoverDatabaseNodeResponse, error) {
-	resp, err := c.api.SwitchoverDatabaseNode(ctx, req)
-	return resp, translateErr(err)
-}
-
-func (c *SingleServerClient) FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (*api.FailoverDatabaseNodeResponse, error) {
-	resp, err := c.api.FailoverDatabaseNode(ctx, req)
-	return resp, translateErr(err)
-}
-
-func (c *SingleServerClient) FollowDatabaseTask(ctx context.Context, req *api.GetDatabaseTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
+// followTaskLog polls the given task log and calls the handler for each new
+// entry until the task reaches a terminal status.
+func followTaskLog(
+	ctx context.Context,
+	afterEntryID *string,
+	getLog func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error),
+	handler func(e *api.TaskLogEntry),
+) error {
 	ticker := time.NewTicker(taskPollInterval)
 	defer ticker.Stop()
 
-	curr := &api.GetDatabaseTaskLogPayload{
-		DatabaseID:   req.DatabaseID,
-		TaskID:       req.TaskID,
-		AfterEntryID: req.AfterEntryID,
-		Limit:        req.Limit,
-	}
-
-	taskLog, err := c.GetDatabaseTaskLog(ctx, curr)
+	taskLog, err := getLog(ctx, afterEntryID)
 	if err != nil {
 		return err
 	}
@@ -279,9 +465,11 @@ func (c *SingleServerClient) FollowDatabaseTask(ctx context.Context, req *api.Ge
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
-			curr.AfterEntryID = taskLog.LastEntryID
+			if taskLog.LastEntryID != nil {
+				afterEntryID = taskLog.LastEntryID
+			}
 
-			taskLog, err = c.GetDatabaseTaskLog(ctx, curr)
+			taskLog, err = getLog(ctx, afterEntryID)
 			if err != nil {
 				return err
 			}
@@ -294,41 +482,64 @@ func (c *SingleServerClient) FollowDatabaseTask(ctx context.Context, req *api.Ge
 	return nil
 }
 
-func (c *SingleServerClient) FollowHostTask(ctx context.Context, req *api.GetHostTaskLogPayload, handler func(e *api.TaskLogEntry)) error {
-	ticker := time.NewTicker(taskPollInterval)
-	defer ticker.Stop()
-
-	curr := &api.GetHostTaskLogPayload{
-		HostID:       req.HostID,
-		TaskID:       req.TaskID,
-		AfterEntryID: req.AfterEntryID,
-		Limit:        req.Limit,
-	}
-
-	taskLog, err := c.GetHostTaskLog(ctx, curr)
+var taskLogEventKinds = []string{EventKindTask, EventKindTaskLog}
+
+// followTaskLogEvents is like followTaskLog, but it fetches new entries when
+// the event stream reports a change to the task instead of on an interval.
+// Each poll is a round trip through the broker for MQTT servers, so this
+// greatly reduces the number of requests for long-running tasks. It falls
+// back to polling if the event stream is unavailable.
+func followTaskLogEvents(
+	ctx context.Context,
+	afterEntryID *string,
+	taskID string,
+	openStream func(ctx context.Context) (api.StreamEventsClientStream, error),
+	getLog func(ctx context.Context, afterEntryID *string) (*api.TaskLog, error),
+	handler func(e *api.TaskLogEntry),
+) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := openStream(streamCtx)
 	if err != nil {
-		return err
+		return followTaskLog(ctx, afterEntryID, getLog, handler)
 	}
-	for _, entry := range taskLog.Entries {
-		handler(entry)
+	if closer, ok := stream.(io.Closer); ok {
+		defer closer.Close()
 	}
 
-	for !taskEnded[taskLog.TaskStatus] {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
-			curr.AfterEntryID = taskLog.LastEntryID
+	// The log is fetched after the stream is open so that we don't miss any
+	// changes in between.
+	fetch := func() (bool, error) {
+		taskLog, err := getLog(ctx, afterEntryID)
+		if err != nil {
+			return false, err
+		}
+		for _, entry := range taskLog.Entries {
+			handler(entry)
+		}
+		if taskLog.LastEntryID != nil {
+			afterEntryID = taskLog.LastEntryID
+		}
+		return taskEnded[taskLog.TaskStatus], nil
+	}
 
-			taskLog, err = c.GetHostTaskLog(ctx, curr)
-			if err != nil {
-				return err
-			}
-			for _, entry := range taskLog.Entries {
-				handler(entry)
+	done, err := fetch()
+	for !done && err == nil {
+		var event *api.Event
+		event, err = stream.RecvWithContext(streamCtx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
 			}
+			return followTaskLog(ctx, afterEntryID, getLog, handler)
 		}
+		// Heartbeats also trigger a fetch in case we've missed an event.
+		if event.Kind != EventKindHeartbeat && (event.TaskID == nil || *event.TaskID != taskID) {
+			continue
+		}
+		done, err = fetch()
 	}
 
-	return nil
+	return err
 }
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+)
+
+type fakeEventStream struct {
+	events chan *api.Event
+}
+
+func (s *fakeEventStream) Recv() (*api.Event, error) {
+	return s.RecvWithContext(context.Background())
+}
+
+func (s *fakeEventStream) RecvWithContext(ctx context.Context) (*api.Event, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case e, ok := <-s.events:
+		if !ok {
+			return nil, errors.New("stream closed")
+		}
+		return e, nil
+	}
+}
+
+// fakeTaskLog returns one entry per call and completes the task after the
+// given number of calls.
+type fakeTaskLog struct {
+	calls    int
+	complete int
+	after    []*string
+}
+
+func (f *fakeTaskLog) getLog(_ context.Context, afterEntryID *string) (*api.TaskLog, error) {
+	f.calls++
+	f.after = append(f.after, afterEntryID)
+	id := string(rune('a' + f.calls - 1))
+	status := TaskStatusRunning
+	if f.calls >= f.complete {
+		status = TaskStatusCompleted
+	}
+	return &api.TaskLog{
+		TaskStatus:  status,
+		LastEntryID: &id,
+		Entries:     []*api.TaskLogEntry{{Message: id}},
+	}, nil
+}
+
+func TestFollowTaskLogEvents(t *testing.T) {
+	taskID := "task-1"
+	otherID := "task-2"
+
+	t.Run("fetches on task events", func(t *testing.T) {
+		stream := &fakeEventStream{events: make(chan *api.Event, 3)}
+		stream.events <- &api.Event{Kind: EventKindTask, TaskID: &otherID}
+		stream.events <- &api.Event{Kind: EventKindTaskLog, TaskID: &taskID}
+		stream.events <- &api.Event{Kind: EventKindHeartbeat}
+
+		taskLog := &fakeTaskLog{complete: 3}
+		var messages []string
+		err := followTaskLogEvents(t.Context(), nil, taskID, func(context.Context) (api.StreamEventsClientStream, error) {
+			return stream, nil
+		}, taskLog.getLog, func(e *api.TaskLogEntry) {
+			messages = append(messages, e.Message)
+		})
+		require.NoError(t, err)
+
+		// One initial fetch, then one for the task_log event and one for the
+		// heartbeat. The event for the other task is ignored.
+		assert.Equal(t, []string{"a", "b", "c"}, messages)
+		require.Len(t, taskLog.after, 3)
+		assert.Nil(t, taskLog.after[0])
+		assert.Equal(t, "a", *taskLog.after[1])
+		assert.Equal(t, "b", *taskLog.after[2])
+	})
+
+	t.Run("falls back to polling", func(t *testing.T) {
+		taskLog := &fakeTaskLog{complete: 2}
+		var messages []string
+		err := followTaskLogEvents(t.Context(), nil, taskID, func(context.Context) (api.StreamEventsClientStream, error) {
+			return nil, errors.New("not supported")
+		}, taskLog.getLog, func(e *api.TaskLogEntry) {
+			messages = append(messages, e.Message)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, messages)
+	})
+}
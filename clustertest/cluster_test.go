@@ -140,6 +140,7 @@ func hostsClient(t testing.TB, hosts map[string]*Host) client.Client {
 
 	cli, err := client.NewMultiServerClient(servers...)
 	require.NoError(t, err)
+	t.Cleanup(cli.Close)
 
 	return cli
 }
@@ -181,10 +181,12 @@ func (h *Host) ClientConfig() client.ServerConfig {
 func (h *Host) GetEtcdMode(t testing.TB, cli client.Client) string {
 	t.Helper()
 
-	var err error
 	if cli == nil {
-		cli, err = client.NewMultiServerClient(h.ClientConfig())
+		hostCli, err := client.NewMultiServerClient(h.ClientConfig())
 		require.NoError(t, err)
+		defer hostCli.Close()
+
+		cli = hostCli
 	}
 
 	resp, err := cli.ListHosts(t.Context())
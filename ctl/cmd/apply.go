@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+	"github.com/pgEdge/control-plane/client"
+)
+
+// databaseDiff is the result of comparing a manifest to the current state of
+// its database.
+type databaseDiff struct {
+	DatabaseID string    `json:"database_id"`
+	Exists     bool      `json:"exists"`
+	Changes    []*Change `json:"changes,omitempty"`
+}
+
+func (d *databaseDiff) write(out io.Writer) {
+	switch {
+	case !d.Exists:
+		fmt.Fprintf(out, "database %s will be created\n", d.DatabaseID)
+	case len(d.Changes) == 0:
+		fmt.Fprintf(out, "database %s is up to date\n", d.DatabaseID)
+	default:
+		fmt.Fprintf(out, "database %s will be updated:\n%s\n", d.DatabaseID, formatChanges(d.Changes))
+	}
+}
+
+func diffManifest(ctx context.Context, cli client.Client, manifest *DatabaseManifest) (*databaseDiff, error) {
+	diff := &databaseDiff{DatabaseID: string(*manifest.ID)}
+
+	current, err := cli.GetDatabase(ctx, &api.GetDatabasePayload{
+		DatabaseID: *manifest.ID,
+	})
+	if errors.Is(err, client.ErrNotFound) {
+		return diff, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get database %s: %w", diff.DatabaseID, err)
+	}
+	diff.Exists = true
+	diff.Changes, err = DiffSpecs(current.Spec, manifest.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+func newDiffCommand(opts *globalOptions) *cobra.Command {
+	var files []string
+
+	cmd := &cobra.Command{
+		Use:   "diff -f <file> [-f <file> ...]",
+		Short: "Show the changes that apply would make",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifests, err := readManifests(cmd, files)
+			if err != nil {
+				return err
+			}
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			diffs := make([]*databaseDiff, len(manifests))
+			for i, manifest := range manifests {
+				diffs[i], err = diffManifest(cmd.Context(), cli, manifest)
+				if err != nil {
+					return err
+				}
+			}
+			if opts.output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), diffs)
+			}
+			for _, diff := range diffs {
+				diff.write(cmd.OutOrStdout())
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVarP(&files, "filename", "f", nil, "A YAML or JSON database spec file, or '-' for stdin. Can be repeated.")
+	cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func newApplyCommand(opts *globalOptions) *cobra.Command {
+	var files []string
+	var force bool
+	var noWait bool
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file> [-f <file> ...]",
+		Short: "Create or update databases from spec files",
+		Long: `Create or update databases from spec files.
+
+Each file contains a single database in the same format as the create-database
+request body. Databases that don't exist are created, and existing databases
+are updated if their spec differs from the file. The task log is followed until
+each task completes unless --no-wait is given.`,
+		Example: `  pgedge-ctl apply -f example.yaml`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifests, err := readManifests(cmd, files)
+			if err != nil {
+				return err
+			}
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for _, manifest := range manifests {
+				diff, err := diffManifest(cmd.Context(), cli, manifest)
+				if err != nil {
+					return err
+				}
+				diff.write(out)
+
+				var t *api.Task
+				switch {
+				case !diff.Exists:
+					resp, err := cli.CreateDatabase(cmd.Context(), manifest)
+					if err != nil {
+						return fmt.Errorf("failed to create database %s: %w", diff.DatabaseID, err)
+					}
+					t = resp.Task
+				case len(diff.Changes) > 0 || force:
+					resp, err := cli.UpdateDatabase(cmd.Context(), &api.UpdateDatabasePayload{
+						DatabaseID:  *manifest.ID,
+						ForceUpdate: force,
+						Request: &api.UpdateDatabaseRequest{
+							TenantID: manifest.TenantID,
+							Spec:     manifest.Spec,
+						},
+					})
+					if err != nil {
+						return fmt.Errorf("failed to update database %s: %w", diff.DatabaseID, err)
+					}
+					t = resp.Task
+				default:
+					continue
+				}
+
+				if noWait {
+					fmt.Fprintf(out, "started task %s\n", t.TaskID)
+					continue
+				}
+				if err := followTask(cmd.Context(), out, cli, diff.DatabaseID, t.TaskID); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVarP(&files, "filename", "f", nil, "A YAML or JSON database spec file, or '-' for stdin. Can be repeated.")
+	cmd.Flags().BoolVar(&force, "force", false, "Update databases even if their spec is unchanged.")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return after starting each task instead of following its log.")
+	cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func readManifests(cmd *cobra.Command, files []string) ([]*DatabaseManifest, error) {
+	manifests := make([]*DatabaseManifest, len(files))
+	seen := map[api.Identifier]string{}
+	for i, file := range files {
+		manifest, err := ReadManifest(file, cmd.InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[*manifest.ID]; ok {
+			return nil, fmt.Errorf("database %s is defined in both %s and %s", *manifest.ID, other, file)
+		}
+		seen[*manifest.ID] = file
+		manifests[i] = manifest
+	}
+	return manifests, nil
+}
+
+// followTask prints the task's log entries as they're written and returns an
+// error if the task doesn't complete successfully.
+func followTask(ctx context.Context, out io.Writer, cli client.Client, databaseID, taskID string) error {
+	fmt.Fprintf(out, "following task %s\n", taskID)
+
+	err := cli.FollowDatabaseTask(ctx, &api.GetDatabaseTaskLogPayload{
+		DatabaseID: api.Identifier(databaseID),
+		TaskID:     taskID,
+	}, func(e *api.TaskLogEntry) {
+		fmt.Fprintf(out, "%s  %s%s\n", e.Timestamp, e.Message, formatFields(e.Fields))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to follow task %s: %w", taskID, err)
+	}
+
+	t, err := cli.GetDatabaseTask(ctx, &api.GetDatabaseTaskPayload{
+		DatabaseID: api.Identifier(databaseID),
+		TaskID:     taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get task %s: %w", taskID, err)
+	}
+	if t.Status != client.TaskStatusCompleted {
+		if t.Error != nil {
+			return fmt.Errorf("task %s %s: %s", taskID, t.Status, *t.Error)
+		}
+		return fmt.Errorf("task %s %s", taskID, t.Status)
+	}
+	fmt.Fprintf(out, "task %s completed\n", taskID)
+	return nil
+}
+
+func formatFields(fields map[string]any) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	return " " + formatValue(fields)
+}
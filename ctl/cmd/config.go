@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/pgEdge/control-plane/client"
+)
+
+// configPathEnvVar overrides the default location of the contexts file.
+const configPathEnvVar = "PGEDGE_CTL_CONFIG"
+
+var ErrContextNotFound = errors.New("context not found")
+
+// Config is the contexts file. Similar to a kubeconfig, it holds the servers
+// for one or more clusters and the name of the context to use by default.
+type Config struct {
+	CurrentContext string     `yaml:"current_context,omitempty"`
+	Contexts       []*Context `yaml:"contexts,omitempty"`
+}
+
+// Context is a named set of Control Plane servers that belong to the same
+// cluster.
+type Context struct {
+	Name    string    `yaml:"name"`
+	Servers []*Server `yaml:"servers"`
+}
+
+// Server is a single Control Plane server's API endpoint.
+type Server struct {
+	HostID string `yaml:"host_id"`
+	URL    string `yaml:"url"`
+}
+
+func (s *Server) validate() error {
+	if s.HostID == "" {
+		return errors.New("host_id is required")
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", s.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid url %q: scheme must be http or https", s.URL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid url %q: missing host", s.URL)
+	}
+	return nil
+}
+
+// ParseServer parses a server in the form '<host id>=<url>'.
+func ParseServer(in string) (*Server, error) {
+	hostID, rawURL, ok := strings.Cut(in, "=")
+	if !ok {
+		return nil, fmt.Errorf("invalid server %q: must be in the form <host id>=<url>", in)
+	}
+	server := &Server{
+		HostID: hostID,
+		URL:    rawURL,
+	}
+	if err := server.validate(); err != nil {
+		return nil, fmt.Errorf("invalid server %q: %w", in, err)
+	}
+	return server, nil
+}
+
+// DefaultConfigPath returns the path to the contexts file. It can be
+// overridden by setting the PGEDGE_CTL_CONFIG environment variable.
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(configPathEnvVar); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".pgedge", "ctl.yaml"), nil
+}
+
+// LoadConfig reads the contexts file from the given path. A missing file is
+// treated as an empty config.
+func LoadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Save writes the contexts file to the given path. The file is only readable
+// by the current user.
+func (c *Config) Save(path string) error {
+	raw, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// Context returns the context with the given name, or the current context if
+// the name is empty.
+func (c *Config) Context(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, errors.New("no context specified and no current context is set")
+	}
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrContextNotFound, name)
+}
+
+// SetContext adds the given context or replaces an existing context with the
+// same name.
+func (c *Config) SetContext(ctx *Context) error {
+	if ctx.Name == "" {
+		return errors.New("context name is required")
+	}
+	if len(ctx.Servers) == 0 {
+		return errors.New("context must have at least one server")
+	}
+	for _, server := range ctx.Servers {
+		if err := server.validate(); err != nil {
+			return err
+		}
+	}
+	idx := slices.IndexFunc(c.Contexts, func(existing *Context) bool {
+		return existing.Name == ctx.Name
+	})
+	if idx < 0 {
+		c.Contexts = append(c.Contexts, ctx)
+	} else {
+		c.Contexts[idx] = ctx
+	}
+	if c.CurrentContext == "" {
+		c.CurrentContext = ctx.Name
+	}
+	return nil
+}
+
+// DeleteContext removes the context with the given name. The current context
+// is unset if it's the context being removed.
+func (c *Config) DeleteContext(name string) error {
+	idx := slices.IndexFunc(c.Contexts, func(existing *Context) bool {
+		return existing.Name == name
+	})
+	if idx < 0 {
+		return fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	c.Contexts = slices.Delete(c.Contexts, idx, idx+1)
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return nil
+}
+
+func newClient(servers []*Server) (*client.MultiServerClient, error) {
+	configs := make([]client.ServerConfig, len(servers))
+	for i, server := range servers {
+		if err := server.validate(); err != nil {
+			return nil, err
+		}
+		u, err := url.Parse(server.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url %q: %w", server.URL, err)
+		}
+		configs[i] = client.NewHTTPServerConfig(server.HostID, u)
+	}
+	return client.NewMultiServerClient(configs...)
+}
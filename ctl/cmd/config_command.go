@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage contexts",
+	}
+	cmd.AddCommand(
+		newGetContextsCommand(opts),
+		newCurrentContextCommand(opts),
+		newUseContextCommand(opts),
+		newSetContextCommand(opts),
+		newDeleteContextCommand(opts),
+	)
+	return cmd
+}
+
+func newGetContextsCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, _, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			return printOutput(cmd.OutOrStdout(), opts, cfg, func() *table {
+				t := newTable("CURRENT", "NAME", "SERVERS")
+				for _, ctx := range cfg.Contexts {
+					current := ""
+					if ctx.Name == cfg.CurrentContext {
+						current = "*"
+					}
+					t.addRow(current, ctx.Name, fmt.Sprint(len(ctx.Servers)))
+				}
+				return t
+			})
+		},
+	}
+}
+
+func newCurrentContextCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current-context",
+		Short: "Show the current context",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, _, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			if cfg.CurrentContext == "" {
+				return fmt.Errorf("no current context is set")
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), cfg.CurrentContext)
+			return nil
+		},
+	}
+}
+
+func newUseContextCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context <name>",
+		Short: "Set the current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			if _, err := cfg.Context(args[0]); err != nil {
+				return err
+			}
+			cfg.CurrentContext = args[0]
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "switched to context %q\n", args[0])
+			return nil
+		},
+	}
+}
+
+func newSetContextCommand(opts *globalOptions) *cobra.Command {
+	var servers []string
+
+	cmd := &cobra.Command{
+		Use:   "set-context <name> --server <host id>=<url> [--server ...]",
+		Short: "Create or replace a context",
+		Example: `  pgedge-ctl config set-context prod \
+    --server host-1=https://host-1.example.com:3000 \
+    --server host-2=https://host-2.example.com:3000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			ctx := &Context{Name: args[0]}
+			for _, s := range servers {
+				server, err := ParseServer(s)
+				if err != nil {
+					return err
+				}
+				ctx.Servers = append(ctx.Servers, server)
+			}
+			if err := cfg.SetContext(ctx); err != nil {
+				return err
+			}
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "context %q saved\n", args[0])
+			return nil
+		},
+	}
+	// This shadows the global --server flag, which selects servers for a
+	// single invocation.
+	cmd.Flags().StringArrayVar(&servers, "server", nil, "A server in the form <host id>=<url>. Can be repeated.")
+	cmd.MarkFlagRequired("server")
+
+	return cmd
+}
+
+func newDeleteContextCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-context <name>",
+		Short: "Delete a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := opts.loadConfig()
+			if err != nil {
+				return err
+			}
+			if err := cfg.DeleteContext(args[0]); err != nil {
+				return err
+			}
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "context %q deleted\n", args[0])
+			return nil
+		},
+	}
+}
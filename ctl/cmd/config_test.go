@@ -0,0 +1,67 @@
+package cmd_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/ctl/cmd"
+)
+
+func TestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "ctl.yaml")
+
+	// A missing file is an empty config
+	cfg, err := cmd.LoadConfig(path)
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Contexts)
+
+	_, err = cmd.ParseServer("http://localhost:3000")
+	assert.ErrorContains(t, err, "<host id>=<url>")
+	_, err = cmd.ParseServer("host-1=localhost:3000")
+	assert.ErrorContains(t, err, "scheme must be http or https")
+
+	server, err := cmd.ParseServer("host-1=http://localhost:3000")
+	require.NoError(t, err)
+	assert.Equal(t, &cmd.Server{HostID: "host-1", URL: "http://localhost:3000"}, server)
+
+	// The first context becomes the current context
+	require.NoError(t, cfg.SetContext(&cmd.Context{
+		Name:    "dev",
+		Servers: []*cmd.Server{server},
+	}))
+	require.NoError(t, cfg.SetContext(&cmd.Context{
+		Name: "prod",
+		Servers: []*cmd.Server{
+			{HostID: "host-1", URL: "https://prod-1:3000"},
+			{HostID: "host-2", URL: "https://prod-2:3000"},
+		},
+	}))
+	assert.Equal(t, "dev", cfg.CurrentContext)
+	assert.Error(t, cfg.SetContext(&cmd.Context{Name: "empty"}))
+
+	require.NoError(t, cfg.Save(path))
+	loaded, err := cmd.LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, loaded)
+
+	current, err := loaded.Context("")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", current.Name)
+
+	prod, err := loaded.Context("prod")
+	require.NoError(t, err)
+	assert.Len(t, prod.Servers, 2)
+
+	_, err = loaded.Context("staging")
+	assert.ErrorIs(t, err, cmd.ErrContextNotFound)
+
+	// Deleting the current context unsets it
+	require.NoError(t, loaded.DeleteContext("dev"))
+	assert.Empty(t, loaded.CurrentContext)
+	_, err = loaded.Context("")
+	assert.ErrorContains(t, err, "no current context")
+	assert.ErrorIs(t, loaded.DeleteContext("dev"), cmd.ErrContextNotFound)
+}
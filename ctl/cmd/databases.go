@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+)
+
+func newDatabasesCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "databases",
+		Aliases: []string{"database", "db"},
+		Short:   "Inspect databases",
+	}
+	cmd.AddCommand(
+		newListDatabasesCommand(opts),
+		newGetDatabaseCommand(opts),
+	)
+	return cmd
+}
+
+func newListDatabasesCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List databases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			resp, err := cli.ListDatabases(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list databases: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, resp, func() *table {
+				t := newTable("ID", "STATE", "INSTANCES", "CREATED", "UPDATED")
+				for _, db := range resp.Databases {
+					t.addRow(
+						string(db.ID),
+						db.State,
+						fmt.Sprint(len(db.Instances)),
+						db.CreatedAt,
+						db.UpdatedAt,
+					)
+				}
+				return t
+			})
+		},
+	}
+}
+
+func newGetDatabaseCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <database id>",
+		Short: "Show a database and its instances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			db, err := cli.GetDatabase(cmd.Context(), &api.GetDatabasePayload{
+				DatabaseID: api.Identifier(args[0]),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get database: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, db, func() *table {
+				t := newTable("INSTANCE", "NODE", "HOST", "STATE", "ROLE", "PENDING RESTART")
+				for _, instance := range db.Instances {
+					var role, pendingRestart string
+					if instance.Postgres != nil {
+						role = deref(instance.Postgres.Role)
+						if instance.Postgres.PendingRestart != nil {
+							pendingRestart = fmt.Sprint(*instance.Postgres.PendingRestart)
+						}
+					}
+					t.addRow(
+						instance.ID,
+						instance.NodeName,
+						instance.HostID,
+						instance.State,
+						orDash(role),
+						orDash(pendingRestart),
+					)
+				}
+				return t
+			})
+		},
+	}
+}
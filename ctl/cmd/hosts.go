@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+)
+
+func newHostsCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hosts",
+		Aliases: []string{"host"},
+		Short:   "Inspect hosts",
+	}
+	cmd.AddCommand(
+		newListHostsCommand(opts),
+		newGetHostCommand(opts),
+	)
+	return cmd
+}
+
+func hostsTable(hosts []*api.Host) *table {
+	t := newTable("ID", "STATE", "ORCHESTRATOR", "ETCD MODE", "POSTGRES VERSIONS", "CLIENT ADDRESSES")
+	for _, host := range hosts {
+		var state string
+		if host.Status != nil {
+			state = host.Status.State
+		}
+		versions := make([]string, len(host.SupportedPgedgeVersions))
+		for i, v := range host.SupportedPgedgeVersions {
+			versions[i] = v.PostgresVersion
+		}
+		t.addRow(
+			string(host.ID),
+			orDash(state),
+			host.Orchestrator,
+			orDash(deref(host.EtcdMode)),
+			orDash(strings.Join(versions, ",")),
+			orDash(strings.Join(host.ClientAddresses, ",")),
+		)
+	}
+	return t
+}
+
+func newListHostsCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List hosts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			resp, err := cli.ListHosts(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list hosts: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, resp, func() *table {
+				return hostsTable(resp.Hosts)
+			})
+		},
+	}
+}
+
+func newGetHostCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <host id>",
+		Short: "Show a host",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			host, err := cli.GetHost(cmd.Context(), &api.GetHostPayload{
+				HostID: api.Identifier(args[0]),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get host: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, host, func() *table {
+				return hostsTable([]*api.Host{host})
+			})
+		},
+	}
+}
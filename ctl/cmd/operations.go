@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+	"github.com/pgEdge/control-plane/client"
+)
+
+// taskStarted either prints the started task or follows its log, depending on
+// the --no-wait flag.
+func taskStarted(cmd *cobra.Command, opts *globalOptions, cli client.Client, t *api.Task, noWait bool) error {
+	out := cmd.OutOrStdout()
+	if noWait {
+		return printOutput(out, opts, t, func() *table {
+			return tasksTable([]*api.Task{t})
+		})
+	}
+	return followTask(cmd.Context(), out, cli, deref(t.DatabaseID), t.TaskID)
+}
+
+func newSwitchoverCommand(opts *globalOptions) *cobra.Command {
+	var candidate string
+	var noWait bool
+
+	cmd := &cobra.Command{
+		Use:   "switchover <database id> <node name>",
+		Short: "Switch a node's primary to another instance",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			req := &api.SwitchoverDatabaseNodePayload{
+				DatabaseID: api.Identifier(args[0]),
+				NodeName:   args[1],
+			}
+			if candidate != "" {
+				req.CandidateInstanceID = &candidate
+			}
+			resp, err := cli.SwitchoverDatabaseNode(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to start switchover: %w", err)
+			}
+			return taskStarted(cmd, opts, cli, resp.Task, noWait)
+		},
+	}
+	cmd.Flags().StringVar(&candidate, "candidate", "", "The instance ID to promote. Defaults to the best available replica.")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return after starting the task instead of following its log.")
+
+	return cmd
+}
+
+func newBackupCommand(opts *globalOptions) *cobra.Command {
+	var backupType string
+	var annotations map[string]string
+	var force bool
+	var noWait bool
+
+	cmd := &cobra.Command{
+		Use:   "backup <database id> <node name>",
+		Short: "Back up a database node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			resp, err := cli.BackupDatabaseNode(cmd.Context(), &api.BackupDatabaseNodePayload{
+				DatabaseID: api.Identifier(args[0]),
+				NodeName:   args[1],
+				Force:      force,
+				Options: &api.BackupOptions{
+					Type:        backupType,
+					Annotations: annotations,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to start backup: %w", err)
+			}
+			return taskStarted(cmd, opts, cli, resp.Task, noWait)
+		},
+	}
+	cmd.Flags().StringVar(&backupType, "type", client.BackupTypeFull, "The backup type, one of 'full', 'diff', or 'incr'.")
+	cmd.Flags().StringToStringVar(&annotations, "annotation", nil, "An annotation to add to the backup, in the form key=value. Can be repeated.")
+	cmd.Flags().BoolVar(&force, "force", false, "Start the backup even if the database is not available.")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return after starting the task instead of following its log.")
+
+	return cmd
+}
+
+func newRestoreCommand(opts *globalOptions) *cobra.Command {
+	var file string
+	var targetNodes []string
+	var force bool
+	var noWait bool
+
+	cmd := &cobra.Command{
+		Use:   "restore <database id> -f <restore config file>",
+		Short: "Restore a database from a backup",
+		Long: `Restore a database from a backup.
+
+The file contains a restore config in YAML or JSON, in the same format as the
+restore_config field of the restore-database request body.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			restoreConfig, err := readRestoreConfig(file)
+			if err != nil {
+				return err
+			}
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			resp, err := cli.RestoreDatabase(cmd.Context(), &api.RestoreDatabasePayload{
+				DatabaseID: api.Identifier(args[0]),
+				Force:      force,
+				Request: &api.RestoreDatabaseRequest{
+					RestoreConfig: restoreConfig,
+					TargetNodes:   targetNodes,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to start restore: %w", err)
+			}
+			return taskStarted(cmd, opts, cli, resp.Task, noWait)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "A YAML or JSON restore config file.")
+	cmd.Flags().StringArrayVar(&targetNodes, "node", nil, "A node to restore. Can be repeated. Defaults to all nodes.")
+	cmd.Flags().BoolVar(&force, "force", false, "Start the restore even if the database is not available.")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return after starting the task instead of following its log.")
+	cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func readRestoreConfig(path string) (*api.RestoreConfigSpec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var cfg api.RestoreConfigSpec
+	if err := decodeYAML(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid restore config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pgEdge/control-plane/client"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// globalOptions holds the values of the persistent flags that are shared by
+// every command.
+type globalOptions struct {
+	configPath  string
+	contextName string
+	servers     []string
+	output      string
+}
+
+func (o *globalOptions) validate() error {
+	if o.output != outputTable && o.output != outputJSON {
+		return fmt.Errorf("invalid output format %q: must be one of %s, %s", o.output, outputTable, outputJSON)
+	}
+	return nil
+}
+
+func (o *globalOptions) loadConfig() (*Config, string, error) {
+	path := o.configPath
+	if path == "" {
+		var err error
+		path, err = DefaultConfigPath()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// client returns a client for the servers given by the --server flag, or for
+// the servers in the selected context if the flag is unset.
+func (o *globalOptions) client() (*client.MultiServerClient, error) {
+	if len(o.servers) > 0 {
+		servers := make([]*Server, len(o.servers))
+		for i, s := range o.servers {
+			server, err := ParseServer(s)
+			if err != nil {
+				return nil, err
+			}
+			servers[i] = server
+		}
+		return newClient(servers)
+	}
+
+	cfg, _, err := o.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := cfg.Context(o.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(ctx.Servers)
+}
+
+func newRootCmd() *cobra.Command {
+	opts := &globalOptions{}
+
+	root := &cobra.Command{
+		Use:   "pgedge-ctl",
+		Short: "Manage pgEdge Control Plane clusters",
+		Long: `Manage pgEdge Control Plane clusters.
+
+Databases are managed declaratively by applying spec files with 'diff' and
+'apply'. Clusters are selected with contexts, which are stored in
+~/.pgedge/ctl.yaml by default.`,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return opts.validate()
+		},
+	}
+	root.PersistentFlags().StringVar(&opts.configPath, "config", "", "Path to the contexts file. Defaults to $PGEDGE_CTL_CONFIG or ~/.pgedge/ctl.yaml.")
+	root.PersistentFlags().StringVar(&opts.contextName, "context", "", "The context to use instead of the current context.")
+	root.PersistentFlags().StringArrayVar(&opts.servers, "server", nil, "A server to use instead of a context, in the form <host id>=<url>. Can be repeated.")
+	root.PersistentFlags().StringVarP(&opts.output, "output", "o", outputTable, "The output format, either 'table' or 'json'.")
+
+	root.AddCommand(newConfigCommand(opts))
+	root.AddCommand(newDiffCommand(opts))
+	root.AddCommand(newApplyCommand(opts))
+	root.AddCommand(newDatabasesCommand(opts))
+	root.AddCommand(newSwitchoverCommand(opts))
+	root.AddCommand(newBackupCommand(opts))
+	root.AddCommand(newRestoreCommand(opts))
+	root.AddCommand(newTasksCommand(opts))
+	root.AddCommand(newHostsCommand(opts))
+
+	return root
+}
+
+func Execute() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+)
+
+// DatabaseManifest is the contents of a database spec file. It has the same
+// structure as the create-database request body and can be written in either
+// YAML or JSON.
+type DatabaseManifest = api.CreateDatabaseRequest
+
+// ReadManifest reads a database manifest from the given path. The path "-"
+// reads from stdin.
+func ReadManifest(path string, stdin io.Reader) (*DatabaseManifest, error) {
+	var raw []byte
+	var err error
+	if path == "-" {
+		raw, err = io.ReadAll(stdin)
+	} else {
+		raw, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	manifest, err := ParseManifest(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+// ParseManifest parses a database manifest from YAML or JSON.
+func ParseManifest(raw []byte) (*DatabaseManifest, error) {
+	var manifest DatabaseManifest
+	if err := decodeYAML(raw, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.ID == nil || *manifest.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	if manifest.Spec == nil {
+		return nil, errors.New("spec is required")
+	}
+	return &manifest, nil
+}
+
+// decodeYAML decodes YAML or JSON into one of the API types. Unknown fields are
+// rejected so that typos aren't silently ignored.
+func decodeYAML(raw []byte, v any) error {
+	// JSON is valid YAML, so we always convert to JSON to make use of the
+	// API types' JSON tags.
+	converted, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(converted))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+	return nil
+}
+
+// ChangeKind describes how a field differs between the desired and current
+// database specs.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "+"
+	ChangeRemoved  ChangeKind = "-"
+	ChangeModified ChangeKind = "~"
+)
+
+type Change struct {
+	Kind    ChangeKind `json:"kind"`
+	Path    string     `json:"path"`
+	Current any        `json:"current,omitempty"`
+	Desired any        `json:"desired,omitempty"`
+}
+
+func (c *Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s: %s", c.Path, formatValue(c.Desired))
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s: %s", c.Path, formatValue(c.Current))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, formatValue(c.Current), formatValue(c.Desired))
+	}
+}
+
+// writeOnlyFields are omitted from the database spec in API responses, so we
+// can't tell if they've changed.
+var writeOnlyFields = []string{"password"}
+
+// DiffSpecs returns the differences between the current and desired database
+// specs. Lists of objects that have a "name" field, such as nodes, are
+// compared by name rather than by position. Fields that the server fills in
+// are normalized first so that applying the same manifest twice reports no
+// changes.
+func DiffSpecs(current, desired *api.DatabaseSpec) ([]*Change, error) {
+	c, err := toGeneric(current)
+	if err != nil {
+		return nil, err
+	}
+	d, err := toGeneric(desired)
+	if err != nil {
+		return nil, err
+	}
+	if cm, ok := c.(map[string]any); ok {
+		if dm, ok := d.(map[string]any); ok {
+			normalizeDesired(cm, dm)
+		}
+	}
+	var changes []*Change
+	diffValues("spec", c, d, &changes)
+	return changes, nil
+}
+
+func toGeneric(v any) (any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal spec: %w", err)
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal spec: %w", err)
+	}
+	return out, nil
+}
+
+// serverDefaultedFields are the spec fields that the server keeps from the
+// current spec when they're omitted from an update. The server also replaces
+// the Postgres and Spock versions with the full versions that are running,
+// e.g. "17" becomes "17.6", and it stores the patroni_dcs that it resolves when
+// the database is created.
+var serverDefaultedFields = []string{"postgres_version", "spock_version", "patroni_dcs"}
+
+// normalizeDesired mirrors the server's defaulting in the desired spec so that
+// the server's changes aren't reported as differences.
+func normalizeDesired(current, desired map[string]any) {
+	// Nodes are normalized first because they inherit the desired spec-level
+	// version as it's written in the manifest.
+	normalizeNodeVersions(current, desired)
+	for _, field := range serverDefaultedFields {
+		normalizeVersion(field, current, desired)
+	}
+}
+
+func normalizeNodeVersions(current, desired map[string]any) {
+	currentNodes, _ := current["nodes"].([]any)
+	desiredNodes, _ := desired["nodes"].([]any)
+	currentByName, ok := byName(currentNodes)
+	if !ok {
+		return
+	}
+	for _, d := range desiredNodes {
+		dn, ok := d.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := dn["name"].(string)
+		cn, ok := currentByName[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		// The server moves node versions to and from the spec-level version
+		// as the nodes' versions converge or diverge, so node versions are
+		// compared by their effective value.
+		currentVersion := effectiveVersion(cn, current)
+		desiredVersion := effectiveVersion(dn, desired)
+		if desiredVersion == "" || versionMatches(desiredVersion, currentVersion) {
+			setOrDelete(dn, "postgres_version", cn["postgres_version"])
+		}
+	}
+}
+
+func normalizeVersion(field string, current, desired map[string]any) {
+	c, _ := current[field].(string)
+	d, _ := desired[field].(string)
+	if c != "" && (d == "" || versionMatches(d, c)) {
+		desired[field] = c
+	}
+}
+
+func effectiveVersion(node, spec map[string]any) string {
+	if v, _ := node["postgres_version"].(string); v != "" {
+		return v
+	}
+	v, _ := spec["postgres_version"].(string)
+	return v
+}
+
+// versionMatches returns true if the desired version is equal to or a prefix
+// of the current version, e.g. "17" matches "17.6".
+func versionMatches(desired, current string) bool {
+	return desired == current || strings.HasPrefix(current, desired+".")
+}
+
+func setOrDelete(m map[string]any, key string, value any) {
+	if value == nil {
+		delete(m, key)
+	} else {
+		m[key] = value
+	}
+}
+
+func diffValues(path string, current, desired any, changes *[]*Change) {
+	switch {
+	case current == nil && desired == nil:
+		return
+	case current == nil:
+		*changes = append(*changes, &Change{Kind: ChangeAdded, Path: path, Desired: desired})
+		return
+	case desired == nil:
+		*changes = append(*changes, &Change{Kind: ChangeRemoved, Path: path, Current: current})
+		return
+	}
+
+	switch d := desired.(type) {
+	case map[string]any:
+		if c, ok := current.(map[string]any); ok {
+			diffMaps(path, c, d, changes)
+			return
+		}
+	case []any:
+		if c, ok := current.([]any); ok {
+			diffLists(path, c, d, changes)
+			return
+		}
+	default:
+		if current == desired {
+			return
+		}
+	}
+	*changes = append(*changes, &Change{
+		Kind:    ChangeModified,
+		Path:    path,
+		Current: current,
+		Desired: desired,
+	})
+}
+
+func diffMaps(path string, current, desired map[string]any, changes *[]*Change) {
+	keys := make([]string, 0, len(current)+len(desired))
+	for k := range current {
+		keys = append(keys, k)
+	}
+	for k := range desired {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		if slices.Contains(writeOnlyFields, k) {
+			continue
+		}
+		diffValues(path+"."+k, current[k], desired[k], changes)
+	}
+}
+
+func diffLists(path string, current, desired []any, changes *[]*Change) {
+	currentByName, cok := byName(current)
+	desiredByName, dok := byName(desired)
+	if cok && dok {
+		// Report in the desired order followed by any removed elements.
+		for _, d := range desired {
+			name := d.(map[string]any)["name"].(string)
+			diffValues(fmt.Sprintf("%s[%s]", path, name), currentByName[name], d, changes)
+		}
+		for _, c := range current {
+			name := c.(map[string]any)["name"].(string)
+			if _, ok := desiredByName[name]; !ok {
+				diffValues(fmt.Sprintf("%s[%s]", path, name), c, nil, changes)
+			}
+		}
+		return
+	}
+
+	for i := range max(len(current), len(desired)) {
+		var c, d any
+		if i < len(current) {
+			c = current[i]
+		}
+		if i < len(desired) {
+			d = desired[i]
+		}
+		diffValues(fmt.Sprintf("%s[%d]", path, i), c, d, changes)
+	}
+}
+
+// byName indexes a list of objects by their "name" field. It returns false if
+// any element is not an object with a unique, non-empty name.
+func byName(list []any) (map[string]any, bool) {
+	out := make(map[string]any, len(list))
+	for _, elem := range list {
+		m, ok := elem.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, dup := out[name]; dup {
+			return nil, false
+		}
+		out[name] = elem
+	}
+	return out, true
+}
+
+func formatValue(v any) string {
+	switch v.(type) {
+	case map[string]any, []any:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(raw)
+	case string:
+		return fmt.Sprintf("%q", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatChanges(changes []*Change) string {
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,164 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/ctl/cmd"
+)
+
+func TestParseManifest(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		manifest, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_name: example
+  nodes:
+    - name: n1
+      host_ids: [host-1]
+`))
+		require.NoError(t, err)
+		assert.Equal(t, "example", string(*manifest.ID))
+		assert.Equal(t, "example", manifest.Spec.DatabaseName)
+		require.Len(t, manifest.Spec.Nodes, 1)
+		assert.Equal(t, "n1", manifest.Spec.Nodes[0].Name)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		manifest, err := cmd.ParseManifest([]byte(`{"id": "example", "spec": {"database_name": "example", "nodes": []}}`))
+		require.NoError(t, err)
+		assert.Equal(t, "example", string(*manifest.ID))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_nme: example
+`))
+		assert.ErrorContains(t, err, "database_nme")
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		_, err := cmd.ParseManifest([]byte(`spec: {database_name: example}`))
+		assert.ErrorContains(t, err, "id is required")
+	})
+}
+
+func TestDiffSpecs(t *testing.T) {
+	current, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_name: example
+  postgres_version: "17"
+  database_users:
+    - username: admin
+  nodes:
+    - name: n1
+      host_ids: [host-1]
+    - name: n2
+      host_ids: [host-2]
+      postgresql_conf:
+        max_connections: 100
+`))
+	require.NoError(t, err)
+
+	t.Run("unchanged", func(t *testing.T) {
+		changes, err := cmd.DiffSpecs(current.Spec, current.Spec)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		desired, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_name: example
+  postgres_version: "18"
+  database_users:
+    - username: admin
+      password: secret
+  nodes:
+    - name: n2
+      host_ids: [host-2]
+    - name: n3
+      host_ids: [host-3]
+`))
+		require.NoError(t, err)
+
+		changes, err := cmd.DiffSpecs(current.Spec, desired.Spec)
+		require.NoError(t, err)
+
+		// Nodes are matched by name, so reordering them isn't a change, and
+		// passwords are ignored because they're never returned by the API.
+		actual := make([]string, len(changes))
+		for i, c := range changes {
+			actual[i] = c.String()
+		}
+		assert.Equal(t, []string{
+			`- spec.nodes[n2].postgresql_conf: {"max_connections":100}`,
+			`+ spec.nodes[n3]: {"host_ids":["host-3"],"name":"n3"}`,
+			`- spec.nodes[n1]: {"host_ids":["host-1"],"name":"n1"}`,
+			`~ spec.postgres_version: "17" -> "18"`,
+		}, actual)
+	})
+
+	t.Run("server-defaulted fields", func(t *testing.T) {
+		manifest, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_name: example
+  postgres_version: "17"
+  database_users:
+    - username: admin
+      password: secret
+  nodes:
+    - name: n1
+      host_ids: [host-1]
+    - name: n2
+      host_ids: [host-2]
+`))
+		require.NoError(t, err)
+
+		// This is the spec as it's returned by the API after the server has
+		// filled in the Spock version, the full Postgres versions, and the
+		// patroni_dcs, with one node running a newer minor version.
+		get, err := cmd.ParseManifest([]byte(`
+id: example
+spec:
+  database_name: example
+  postgres_version: "17.6"
+  spock_version: "5"
+  patroni_dcs: control_plane
+  database_users:
+    - username: admin
+  nodes:
+    - name: n1
+      host_ids: [host-1]
+    - name: n2
+      host_ids: [host-2]
+      postgres_version: "17.7"
+`))
+		require.NoError(t, err)
+
+		changes, err := cmd.DiffSpecs(get.Spec, manifest.Spec)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+
+		// A major version upgrade is still reported.
+		upgrade := "18"
+		manifest.Spec.PostgresVersion = &upgrade
+		changes, err = cmd.DiffSpecs(get.Spec, manifest.Spec)
+		require.NoError(t, err)
+		actual := make([]string, len(changes))
+		for i, c := range changes {
+			actual[i] = c.String()
+		}
+		assert.Equal(t, []string{
+			`- spec.nodes[n2].postgres_version: "17.7"`,
+			`~ spec.postgres_version: "17.6" -> "18"`,
+		}, actual)
+	})
+}
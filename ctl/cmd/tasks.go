@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
+	"github.com/pgEdge/control-plane/client"
+)
+
+func newTasksCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tasks",
+		Aliases: []string{"task"},
+		Short:   "Inspect and cancel database tasks",
+	}
+	cmd.AddCommand(
+		newListTasksCommand(opts),
+		newGetTaskCommand(opts),
+		newTaskLogsCommand(opts),
+		newCancelTaskCommand(opts),
+	)
+	return cmd
+}
+
+func tasksTable(tasks []*api.Task) *table {
+	t := newTable("TASK ID", "TYPE", "STATUS", "NODE", "INSTANCE", "CREATED", "COMPLETED")
+	for _, task := range tasks {
+		status := task.Status
+		if task.StatusReason != nil {
+			status = fmt.Sprintf("%s (%s)", status, *task.StatusReason)
+		}
+		t.addRow(
+			task.TaskID,
+			task.Type,
+			status,
+			orDash(deref(task.NodeName)),
+			orDash(deref(task.InstanceID)),
+			task.CreatedAt,
+			orDash(deref(task.CompletedAt)),
+		)
+	}
+	return t
+}
+
+func newListTasksCommand(opts *globalOptions) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list <database id>",
+		Short: "List a database's tasks, newest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			sortOrder := client.TaskSortOrderDesc
+			resp, err := cli.ListDatabaseTasks(cmd.Context(), &api.ListDatabaseTasksPayload{
+				DatabaseID: api.Identifier(args[0]),
+				Limit:      &limit,
+				SortOrder:  &sortOrder,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list tasks: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, resp, func() *table {
+				return tasksTable(resp.Tasks)
+			})
+		},
+	}
+	cmd.Flags().IntVar(&limit, "limit", 20, "The maximum number of tasks to show.")
+
+	return cmd
+}
+
+func newGetTaskCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <database id> <task id>",
+		Short: "Show a database task",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			task, err := cli.GetDatabaseTask(cmd.Context(), &api.GetDatabaseTaskPayload{
+				DatabaseID: api.Identifier(args[0]),
+				TaskID:     args[1],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get task: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, task, func() *table {
+				return tasksTable([]*api.Task{task})
+			})
+		},
+	}
+}
+
+func newTaskLogsCommand(opts *globalOptions) *cobra.Command {
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:   "logs <database id> <task id>",
+		Short: "Show a database task's log",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			if follow {
+				return followTask(cmd.Context(), cmd.OutOrStdout(), cli, args[0], args[1])
+			}
+			taskLog, err := cli.GetDatabaseTaskLog(cmd.Context(), &api.GetDatabaseTaskLogPayload{
+				DatabaseID: api.Identifier(args[0]),
+				TaskID:     args[1],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get task log: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, taskLog, func() *table {
+				t := newTable("TIMESTAMP", "MESSAGE")
+				for _, e := range taskLog.Entries {
+					t.addRow(e.Timestamp, e.Message+formatFields(e.Fields))
+				}
+				return t
+			})
+		},
+	}
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Follow the log until the task completes.")
+
+	return cmd
+}
+
+func newCancelTaskCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <database id> <task id>",
+		Short: "Cancel a running database task",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := opts.client()
+			if err != nil {
+				return err
+			}
+			task, err := cli.CancelDatabaseTask(cmd.Context(), &api.CancelDatabaseTaskPayload{
+				DatabaseID: api.Identifier(args[0]),
+				TaskID:     api.Identifier(args[1]),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to cancel task: %w", err)
+			}
+			return printOutput(cmd.OutOrStdout(), opts, task, func() *table {
+				return tasksTable([]*api.Task{task})
+			})
+		},
+	}
+}
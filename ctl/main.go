@@ -0,0 +1,7 @@
+package main
+
+import "github.com/pgEdge/control-plane/ctl/cmd"
+
+func main() {
+	cmd.Execute()
+}
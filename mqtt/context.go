@@ -4,7 +4,11 @@ import "context"
 
 type contextKey string
 
-const publishKey = contextKey("mqtt:publish")
+const (
+	publishKey = contextKey("mqtt:publish")
+	streamKey  = contextKey("mqtt:stream")
+	topicKey   = contextKey("mqtt:topic")
+)
 
 // WithPublishFunc adds a PublishFunc to the context.
 func WithPublishFunc(ctx context.Context, fn PublishFunc) context.Context {
@@ -16,3 +20,28 @@ func GetPublishFunc(ctx context.Context) (PublishFunc, bool) {
 	fn, ok := ctx.Value(publishKey).(PublishFunc)
 	return fn, ok
 }
+
+// WithStreamFunc adds a StreamFunc to the context.
+func WithStreamFunc(ctx context.Context, fn StreamFunc) context.Context {
+	return context.WithValue(ctx, streamKey, fn)
+}
+
+// GetStreamFunc returns the StreamFunc associated with the context, if any.
+// Request handlers receive a StreamFunc when the caller accepts a streamed
+// response. Each call sends a chunk to the caller ahead of the handler's final
+// response.
+func GetStreamFunc(ctx context.Context) (StreamFunc, bool) {
+	fn, ok := ctx.Value(streamKey).(StreamFunc)
+	return fn, ok
+}
+
+// WithTopic adds the topic that a request was received on to the context.
+func WithTopic(ctx context.Context, topic string) context.Context {
+	return context.WithValue(ctx, topicKey, topic)
+}
+
+// GetTopic returns the topic that a request was received on, if any.
+func GetTopic(ctx context.Context) (string, bool) {
+	topic, ok := ctx.Value(topicKey).(string)
+	return topic, ok
+}
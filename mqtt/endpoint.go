@@ -2,10 +2,12 @@ package mqtt
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -29,12 +31,30 @@ type Endpoint interface {
 
 var _ Endpoint = (*MQTTEndpoint)(nil)
 
+// User properties that implement streamed responses.
+const (
+	// propStream is set on requests from callers that accept streamed
+	// responses.
+	propStream = "stream"
+	// propCancel is set on messages that cancel an in-progress streamed
+	// request.
+	propCancel = "cancel"
+	// propChunk is set on each streamed chunk to the chunk's sequence number,
+	// starting from 1.
+	propChunk = "chunk"
+)
+
+// streamBufferSize is the number of streamed chunks that can be queued for a
+// slow caller before chunks are dropped.
+const streamBufferSize = 64
+
 type MQTTEndpoint struct {
 	logger         zerolog.Logger
 	router         paho.Router
 	url            string
 	username       string
 	password       string
+	tlsConfig      *tls.Config
 	cm             *autopaho.ConnectionManager
 	subs           map[string]bool
 	responseTopic  string
@@ -44,6 +64,8 @@ type MQTTEndpoint struct {
 	clientID       string
 	subsErr        error
 	handlerTimeout time.Duration
+	requests       map[string]context.CancelFunc
+	requestsMutex  sync.Mutex
 }
 
 type BrokerConfig struct {
@@ -51,6 +73,9 @@ type BrokerConfig struct {
 	ClientID string
 	Username string
 	Password string
+	// TLSConfig is used when connecting with the tls, ssl, or mqtts schemes.
+	// Set its Certificates to authenticate with a client certificate.
+	TLSConfig *tls.Config
 }
 
 type Config struct {
@@ -61,6 +86,11 @@ type Config struct {
 	HandlerTimeout  time.Duration
 	Subscriptions   []string
 	AutoSubscribe   bool
+	// ResponseTopic is the topic that this endpoint receives call responses
+	// on. Defaults to rsp/callers/<client id>. Clients whose broker
+	// permissions are limited to a tenant's topics should use
+	// TenantResponseTopic.
+	ResponseTopic string
 }
 
 type Message struct {
@@ -77,6 +107,9 @@ type MessageHandler func(ctx context.Context, msg *Message)
 
 type RequestHandler func(ctx context.Context, msg *Message) (any, error)
 
+// StreamFunc sends a chunk of a streamed response. See GetStreamFunc.
+type StreamFunc func(ctx context.Context, chunk []byte) error
+
 type Call struct {
 	Topic     string
 	Request   any
@@ -85,6 +118,13 @@ type Call struct {
 	MaxWait   time.Duration
 	Retain    bool
 	Unmarshal func(payload []byte, resp any) error
+	// Stream, if set, requests a streamed response. It's called with each
+	// chunk that the handler sends before its final response. The request is
+	// canceled if Stream returns an error.
+	Stream func(chunk []byte) error
+	// StreamWait limits the time between the chunks of a streamed response.
+	// Defaults to MaxWait.
+	StreamWait time.Duration
 }
 
 func (c *Call) Payload() ([]byte, error) {
@@ -102,7 +142,10 @@ func New(config Config) *MQTTEndpoint {
 	if clientID == "" {
 		clientID = fmt.Sprintf("mqtt-%s", uuid.New())
 	}
-	respTopic := fmt.Sprintf("rsp/callers/%s", clientID)
+	respTopic := config.ResponseTopic
+	if respTopic == "" {
+		respTopic = fmt.Sprintf("rsp/callers/%s", clientID)
+	}
 	handlerTimeout := config.HandlerTimeout
 	if handlerTimeout == 0 {
 		handlerTimeout = time.Minute
@@ -121,8 +164,10 @@ func New(config Config) *MQTTEndpoint {
 		responseChans:  make(map[string]chan *paho.Publish),
 		username:       config.Broker.Username,
 		password:       config.Broker.Password,
+		tlsConfig:      config.Broker.TLSConfig,
 		handlerTimeout: handlerTimeout,
 		subs:           map[string]bool{},
+		requests:       map[string]context.CancelFunc{},
 	}
 	e.router = paho.NewStandardRouterWithDefault(e.defaultHandler)
 	for _, topic := range config.Subscriptions {
@@ -215,17 +260,34 @@ func (e *MQTTEndpoint) publish(ctx context.Context, msg *paho.Publish) error {
 }
 
 func (e *MQTTEndpoint) handleRequest(msg *paho.Publish, h RequestHandler) {
+	correlationID := string(msg.Properties.CorrelationData)
+	if msg.Properties.User.Get(propCancel) != "" {
+		e.cancelRequest(correlationID)
+		return
+	}
 	logger := e.logger.With().
 		Str("topic", msg.Topic).
-		Str("correlation_id", string(msg.Properties.CorrelationData)).
+		Str("correlation_id", correlationID).
 		Logger()
 	handlerCtx := context.Background()
 	handlerCtx = logger.WithContext(handlerCtx)
 	handlerCtx = WithPublishFunc(handlerCtx, func(ctx context.Context, msg *Message) error {
 		return e.Publish(ctx, msg)
 	})
+	cancel := context.CancelFunc(func() {})
+	if msg.Properties.User.Get(propStream) != "" {
+		// Streamed requests can run indefinitely, so the caller can cancel
+		// them.
+		handlerCtx, cancel = context.WithCancel(handlerCtx)
+		e.trackRequest(correlationID, cancel)
+		handlerCtx = WithStreamFunc(handlerCtx, e.streamFunc(msg, cancel))
+	}
 	// response should be asynchronous - don't block the handler
 	go func() {
+		defer func() {
+			e.untrackRequest(correlationID)
+			cancel()
+		}()
 		result, handlerErr := h(handlerCtx, &Message{
 			Topic:   msg.Topic,
 			QoS:     int(msg.QoS),
@@ -241,6 +303,56 @@ func (e *MQTTEndpoint) handleRequest(msg *paho.Publish, h RequestHandler) {
 	}()
 }
 
+// streamFunc returns a function that sends chunks of the response to the given
+// request. The request's handler is canceled if a chunk can't be sent.
+func (e *MQTTEndpoint) streamFunc(req *paho.Publish, cancel context.CancelFunc) StreamFunc {
+	var mu sync.Mutex
+	var seq int
+	return func(ctx context.Context, chunk []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		seq++
+		pub := &paho.Publish{
+			Topic:   req.Properties.ResponseTopic,
+			Payload: chunk,
+			QoS:     1,
+			Properties: &paho.PublishProperties{
+				CorrelationData: req.Properties.CorrelationData,
+			},
+		}
+		pub.Properties.User.Add(propChunk, strconv.Itoa(seq))
+		if err := e.publish(ctx, pub); err != nil {
+			cancel()
+			return err
+		}
+		return nil
+	}
+}
+
+func (e *MQTTEndpoint) trackRequest(correlationID string, cancel context.CancelFunc) {
+	e.requestsMutex.Lock()
+	defer e.requestsMutex.Unlock()
+	e.requests[correlationID] = cancel
+}
+
+func (e *MQTTEndpoint) untrackRequest(correlationID string) {
+	e.requestsMutex.Lock()
+	defer e.requestsMutex.Unlock()
+	delete(e.requests, correlationID)
+}
+
+func (e *MQTTEndpoint) cancelRequest(correlationID string) {
+	e.requestsMutex.Lock()
+	defer e.requestsMutex.Unlock()
+	if cancel, ok := e.requests[correlationID]; ok {
+		e.logger.Debug().
+			Str("correlation_id", correlationID).
+			Msg("canceling request")
+		cancel()
+	}
+}
+
 func (e *MQTTEndpoint) defaultHandler(msg *paho.Publish) {
 	ctx, cancel := context.WithTimeout(context.Background(), e.handlerTimeout)
 	defer cancel()
@@ -282,7 +394,7 @@ func (e *MQTTEndpoint) Call(ctx context.Context, c *Call) error {
 	if qos == 0 {
 		qos = 1
 	}
-	resp, err := e.executeCall(ctx, &paho.Publish{
+	pub := &paho.Publish{
 		Payload: payload,
 		Topic:   c.Topic,
 		QoS:     qos,
@@ -290,7 +402,15 @@ func (e *MQTTEndpoint) Call(ctx context.Context, c *Call) error {
 		Properties: &paho.PublishProperties{
 			MessageExpiry: messageExpiry(maxWait),
 		},
-	}, maxWait)
+	}
+	if c.Stream != nil {
+		pub.Properties.User.Add(propStream, "true")
+	}
+	streamWait := maxWait
+	if c.StreamWait > 0 {
+		streamWait = c.StreamWait
+	}
+	resp, err := e.executeCall(ctx, pub, maxWait, streamWait, c.Stream)
 	if err != nil {
 		return err
 	}
@@ -322,10 +442,20 @@ func (e *MQTTEndpoint) Call(ctx context.Context, c *Call) error {
 	return nil
 }
 
-func (e *MQTTEndpoint) executeCall(ctx context.Context, pub *paho.Publish, maxWait time.Duration) (*paho.Publish, error) {
-	// Create a dedicated response channel for the call
+func (e *MQTTEndpoint) executeCall(
+	ctx context.Context,
+	pub *paho.Publish,
+	maxWait time.Duration,
+	streamWait time.Duration,
+	stream func(chunk []byte) error,
+) (*paho.Publish, error) {
+	// Create a dedicated response channel for the call. Streamed chunks can
+	// arrive faster than they're handled, so they're buffered.
 	correlationID := uuid.NewString()
 	responseChan := make(chan *paho.Publish)
+	if stream != nil {
+		responseChan = make(chan *paho.Publish, streamBufferSize)
+	}
 	e.mutex.Lock()
 	e.responseChans[correlationID] = responseChan
 	e.mutex.Unlock()
@@ -335,10 +465,8 @@ func (e *MQTTEndpoint) executeCall(ctx context.Context, pub *paho.Publish, maxWa
 		e.mutex.Unlock()
 	}()
 	// Send the request message with properties that allow a response
-	pub.Properties = &paho.PublishProperties{
-		ResponseTopic:   e.responseTopic,
-		CorrelationData: []byte(correlationID),
-	}
+	pub.Properties.ResponseTopic = e.responseTopic
+	pub.Properties.CorrelationData = []byte(correlationID)
 	if err := e.publish(ctx, pub); err != nil {
 		return nil, err
 	}
@@ -347,27 +475,71 @@ func (e *MQTTEndpoint) executeCall(ctx context.Context, pub *paho.Publish, maxWa
 		Str("correlation_id", correlationID).
 		Str("response_topic", e.responseTopic).
 		Msg("sent request")
+	// Let the handler know that we've stopped waiting for a streamed response.
+	cancel := func() {
+		if stream != nil {
+			e.cancelCall(pub.Topic, correlationID)
+		}
+	}
 	// Wait for a response. While waiting, check for context cancellation
 	// and discard any messages that don't match the correlation ID.
+	var seq int
+	wait := maxWait
 	for {
 		select {
-		case <-time.After(maxWait):
+		case <-time.After(wait):
+			cancel()
 			return nil, errors.New("timeout waiting for response")
 		case <-ctx.Done():
+			cancel()
 			return nil, ctx.Err()
 		case resp := <-responseChan:
 			rxCorr := string(resp.Properties.CorrelationData)
-			if rxCorr == correlationID {
+			if rxCorr != correlationID {
+				e.logger.Warn().
+					Str("correlation_id", correlationID).
+					Str("received_correlation_id", rxCorr).
+					Msg("dropping response with unexpected correlation id")
+				continue
+			}
+			chunk := resp.Properties.User.Get(propChunk)
+			if chunk == "" || stream == nil {
 				return resp, nil
 			}
-			e.logger.Warn().
-				Str("correlation_id", correlationID).
-				Str("received_correlation_id", rxCorr).
-				Msg("dropping response with unexpected correlation id")
+			seq++
+			if chunk != strconv.Itoa(seq) {
+				cancel()
+				return nil, fmt.Errorf("streamed response interrupted: expected chunk %d, got %s", seq, chunk)
+			}
+			if err := stream(resp.Payload); err != nil {
+				cancel()
+				return nil, err
+			}
+			wait = streamWait
 		}
 	}
 }
 
+// cancelCall asks the handler of a streamed request to stop.
+func (e *MQTTEndpoint) cancelCall(topic, correlationID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pub := &paho.Publish{
+		Topic: topic,
+		QoS:   1,
+		Properties: &paho.PublishProperties{
+			CorrelationData: []byte(correlationID),
+		},
+	}
+	pub.Properties.User.Add(propCancel, "true")
+	if err := e.publish(ctx, pub); err != nil {
+		e.logger.Warn().Err(err).
+			Str("correlation_id", correlationID).
+			Msg("failed to cancel request")
+	}
+}
+
 func (e *MQTTEndpoint) handleResponse(msg *paho.Publish) {
 	// There should be an executeCall call in progress that's waiting for
 	// this response message. Pass the message via the matching responseChan.
@@ -453,6 +625,7 @@ func (e *MQTTEndpoint) getPahoConfig(brokerURL *url.URL, readyChan chan struct{}
 		ServerUrls:        []*url.URL{brokerURL},
 		ConnectUsername:   e.username,
 		ConnectPassword:   []byte(e.password),
+		TlsCfg:            e.tlsConfig,
 		KeepAlive:         30,
 		ConnectRetryDelay: 10 * time.Second,
 		OnConnectionUp: func(cm *autopaho.ConnectionManager, connAck *paho.Connack) {
@@ -130,6 +130,84 @@ func TestCall(t *testing.T) {
 	require.Equal(t, "that's really unfortunate", err.Error())
 }
 
+func TestStreamedCall(t *testing.T) {
+	ctx, broker := setupTestBroker(t)
+
+	canceled := make(chan struct{})
+	service := mqtt.New(mqtt.Config{
+		Broker: mqtt.BrokerConfig{
+			Username: "service",
+			URL:      broker.URL(),
+		},
+		AutoSubscribe: true,
+		RequestHandlers: map[string]mqtt.RequestHandler{
+			"cmd/count": func(ctx context.Context, msg *mqtt.Message) (interface{}, error) {
+				stream, ok := mqtt.GetStreamFunc(ctx)
+				if !ok {
+					return nil, errors.New("expected a stream")
+				}
+				for _, chunk := range []string{"one", "two", "three"} {
+					if err := stream(ctx, []byte(chunk)); err != nil {
+						return nil, err
+					}
+				}
+				return "done", nil
+			},
+			"cmd/forever": func(ctx context.Context, msg *mqtt.Message) (interface{}, error) {
+				stream, _ := mqtt.GetStreamFunc(ctx)
+				if err := stream(ctx, []byte("started")); err != nil {
+					return nil, err
+				}
+				<-ctx.Done()
+				close(canceled)
+				return nil, ctx.Err()
+			},
+		},
+	})
+	require.NoError(t, service.Connect(ctx))
+	defer service.Disconnect(ctx)
+
+	client := mqtt.New(mqtt.Config{
+		Broker: mqtt.BrokerConfig{
+			Username: "client",
+			URL:      broker.URL(),
+		},
+	})
+	require.NoError(t, client.Connect(ctx))
+	defer client.Disconnect(ctx)
+
+	// Chunks are delivered in order before the final response.
+	var chunks []string
+	var responseStr string
+	err := client.Call(ctx, &mqtt.Call{
+		Topic:    "cmd/count",
+		Response: &responseStr,
+		Stream: func(chunk []byte) error {
+			chunks = append(chunks, string(chunk))
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "two", "three"}, chunks)
+	require.Equal(t, "done", responseStr)
+
+	// Returning an error from Stream cancels the handler.
+	stop := errors.New("stop")
+	err = client.Call(ctx, &mqtt.Call{
+		Topic: "cmd/forever",
+		Stream: func(chunk []byte) error {
+			return stop
+		},
+	})
+	require.ErrorIs(t, err, stop)
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second * 10):
+		t.Fatal("timed out waiting for the handler to be canceled")
+	}
+}
+
 func TestUnsupportedOperation(t *testing.T) {
 	ctx, broker := setupTestBroker(t)
 
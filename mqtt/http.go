@@ -12,15 +12,26 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// minStreamWait is the minimum time that an HTTPDoer waits between the chunks
+// of a streamed response. It's longer than the interval between heartbeats
+// on the API's event stream.
+const minStreamWait = 2 * time.Minute
+
 // HTTPServer is an HTTP server that communicates over MQTT. It works by reading
 // and writing raw HTTP requests/responses in the MQTT message payloads.
+// Handlers can stream their responses by flushing them with an http.Flusher.
+// Each flush is sent to callers that accept streamed responses as a separate
+// chunk. The topic that a request arrived on is available from the request's
+// context via GetTopic.
 type HTTPServer struct {
 	endpoint Endpoint
 	handler  http.Handler
 }
 
 type HTTPServerConfig struct {
-	Topic          string
+	Topic string
+	// Topics are additional topics or topic filters to serve requests on.
+	Topics         []string
 	Broker         BrokerConfig
 	HandlerTimeout time.Duration
 	Handler        http.Handler
@@ -28,10 +39,16 @@ type HTTPServerConfig struct {
 }
 
 func NewHTTPServer(config HTTPServerConfig) *HTTPServer {
+	var topics []string
+	if config.Topic != "" {
+		topics = append(topics, config.Topic)
+	}
+	topics = append(topics, config.Topics...)
+
 	endpoint := New(Config{
 		Broker:         config.Broker,
 		HandlerTimeout: config.HandlerTimeout,
-		Subscriptions:  []string{config.Topic},
+		Subscriptions:  topics,
 		Logger:         config.Logger,
 	})
 
@@ -39,7 +56,9 @@ func NewHTTPServer(config HTTPServerConfig) *HTTPServer {
 		endpoint: endpoint,
 		handler:  config.Handler,
 	}
-	endpoint.RegisterRequestHandler(config.Topic, svr.handleRequest)
+	for _, topic := range topics {
+		endpoint.RegisterRequestHandler(topic, svr.handleRequest)
+	}
 
 	return svr
 }
@@ -58,14 +77,24 @@ func (s *HTTPServer) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Publish sends a message using the server's broker connection.
+func (s *HTTPServer) Publish(ctx context.Context, msg *Message) error {
+	return s.endpoint.Publish(ctx, msg)
+}
+
 func (p *HTTPServer) handleRequest(ctx context.Context, msg *Message) (any, error) {
 	rdr := bufio.NewReader(bytes.NewBuffer(msg.Payload))
 	req, err := http.ReadRequest(rdr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read request from payload: %w", err)
 	}
+	req = req.WithContext(WithTopic(ctx, msg.Topic))
 
 	writer := newHttpResponseWriter()
+	if stream, ok := GetStreamFunc(ctx); ok {
+		writer.ctx = ctx
+		writer.stream = stream
+	}
 	p.handler.ServeHTTP(writer, req)
 
 	return writer.marshal()
@@ -99,44 +128,150 @@ func NewHTTPDoer(config HTTPDoerConfig) *HTTPDoer {
 	}
 }
 
+// Do sends the request and waits for the response. Streamed responses are
+// returned as soon as their headers arrive, and their bodies are read as the
+// remaining chunks arrive. Closing the body of a streamed response cancels
+// the request.
 func (d *HTTPDoer) Do(req *http.Request) (*http.Response, error) {
 	out := &bytes.Buffer{}
 	if err := req.Write(out); err != nil {
 		return nil, fmt.Errorf("failed to write request to wire format: %w", err)
 	}
 
-	var resp *http.Response
-	if err := d.endpoint.Call(req.Context(), &Call{
-		Topic:    d.topic,
-		Request:  out.Bytes(),
-		Response: &resp,
-		QoS:      d.qos,
-		MaxWait:  d.maxWait,
-		Retain:   d.retain,
-		Unmarshal: func(payload []byte, resp any) error {
-			ptr, ok := resp.(**http.Response)
-			if !ok {
-				return fmt.Errorf("expected a **http.Response, got %T", resp)
-			}
+	ctx, cancel := context.WithCancel(req.Context())
+	stream := newResponseStream(req, cancel)
 
-			// Read response from payload
-			rdr := bufio.NewReader(bytes.NewBuffer(payload))
-			unmarshalled, err := http.ReadResponse(rdr, req)
-			if err != nil {
-				return fmt.Errorf("failed to read response from wire format: %w", err)
-			}
+	go func() {
+		defer cancel()
 
-			// reassign the input response to the unmarshalled response
-			*ptr = unmarshalled
+		var payload []byte
+		err := d.endpoint.Call(ctx, &Call{
+			Topic:      d.topic,
+			Request:    out.Bytes(),
+			Response:   &payload,
+			QoS:        d.qos,
+			MaxWait:    d.maxWait,
+			StreamWait: max(d.maxWait, minStreamWait),
+			Retain:     d.retain,
+			Stream:     stream.write,
+			Unmarshal: func(p []byte, _ any) error {
+				payload = p
+				return nil
+			},
+		})
+		stream.finish(payload, err)
+	}()
 
-			return nil
+	return stream.response()
+}
+
+// responseStream assembles an HTTP response from a call's response. The first
+// chunk of a streamed response contains the response headers, and the
+// remaining chunks and the final payload contain the body. Responses that
+// aren't streamed are contained in the final payload.
+type responseStream struct {
+	req     *http.Request
+	cancel  context.CancelFunc
+	pr      *io.PipeReader
+	pw      *io.PipeWriter
+	started chan struct{}
+	result  chan responseResult
+}
 
-		},
-	}); err != nil {
-		return nil, fmt.Errorf("failed to make http call: %w", err)
+type responseResult struct {
+	resp *http.Response
+	err  error
+}
+
+func newResponseStream(req *http.Request, cancel context.CancelFunc) *responseStream {
+	pr, pw := io.Pipe()
+	return &responseStream{
+		req:     req,
+		cancel:  cancel,
+		pr:      pr,
+		pw:      pw,
+		started: make(chan struct{}),
+		result:  make(chan responseResult, 1),
 	}
+}
 
-	return resp, nil
+func (s *responseStream) isStarted() bool {
+	select {
+	case <-s.started:
+		return true
+	default:
+		return false
+	}
+}
+
+// write handles a streamed chunk. It blocks until the chunk is read.
+func (s *responseStream) write(chunk []byte) error {
+	if !s.isStarted() {
+		close(s.started)
+	}
+	_, err := s.pw.Write(chunk)
+	return err
+}
+
+// finish handles the final payload or the error from the call.
+func (s *responseStream) finish(payload []byte, err error) {
+	if s.isStarted() {
+		if err == nil {
+			_, err = s.pw.Write(payload)
+		}
+		s.pw.CloseWithError(err)
+		return
+	}
+	if err != nil {
+		s.result <- responseResult{err: fmt.Errorf("failed to make http call: %w", err)}
+		return
+	}
+
+	// Read response from payload
+	rdr := bufio.NewReader(bytes.NewBuffer(payload))
+	resp, err := http.ReadResponse(rdr, s.req)
+	if err != nil {
+		err = fmt.Errorf("failed to read response from wire format: %w", err)
+	}
+	s.result <- responseResult{resp: resp, err: err}
+}
+
+// response waits for either the start of a streamed response or the complete
+// response.
+func (s *responseStream) response() (*http.Response, error) {
+	select {
+	case res := <-s.result:
+		return res.resp, res.err
+	case <-s.started:
+		resp, err := http.ReadResponse(bufio.NewReader(s.pr), s.req)
+		if err != nil {
+			s.cancel()
+			s.pr.CloseWithError(err)
+			return nil, fmt.Errorf("failed to read response from wire format: %w", err)
+		}
+		resp.Body = &streamBody{
+			ReadCloser: resp.Body,
+			cancel:     s.cancel,
+			pr:         s.pr,
+		}
+		return resp, nil
+	}
+}
+
+// streamBody cancels the request when a streamed response's body is closed.
+type streamBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+	pr     *io.PipeReader
+}
+
+func (b *streamBody) Close() error {
+	b.cancel()
+	// Closing the pipe first keeps the body from being drained, which could
+	// block until the handler returns.
+	b.pr.Close()
+	b.ReadCloser.Close()
+	return nil
 }
 
 // httpResponseWriter is a custom implementation of http.httpResponseWriter.
@@ -145,6 +280,11 @@ type httpResponseWriter struct {
 	body       *bytes.Buffer
 	statusCode int
 	written    bool
+	// ctx and stream are set when the caller accepts a streamed response.
+	ctx       context.Context
+	stream    StreamFunc
+	headSent  bool
+	streamErr error
 }
 
 // newHttpResponseWriter creates a new ResponseWriter instance.
@@ -163,6 +303,9 @@ func (w *httpResponseWriter) Header() http.Header {
 
 // Write writes the response body.
 func (w *httpResponseWriter) Write(data []byte) (int, error) {
+	if w.streamErr != nil {
+		return 0, w.streamErr
+	}
 	if !w.written {
 		// Ensure headers are written before the body.
 		w.WriteHeader(http.StatusOK)
@@ -180,7 +323,54 @@ func (w *httpResponseWriter) WriteHeader(statusCode int) {
 	w.written = true
 }
 
+// Flush sends the response so far as a chunk when the caller accepts streamed
+// responses. Otherwise, the response is sent once the handler returns.
+func (w *httpResponseWriter) Flush() {
+	if w.stream == nil || w.streamErr != nil {
+		return
+	}
+	if !w.written {
+		w.WriteHeader(http.StatusOK)
+	}
+	var chunk []byte
+	if !w.headSent {
+		chunk = w.marshalHead()
+		w.headSent = true
+	}
+	chunk = append(chunk, w.body.Bytes()...)
+	w.body.Reset()
+	if len(chunk) == 0 {
+		return
+	}
+	if err := w.stream(w.ctx, chunk); err != nil {
+		w.streamErr = fmt.Errorf("failed to send response chunk: %w", err)
+	}
+}
+
+// marshalHead returns the status line and headers of a streamed response. The
+// body's length isn't known in advance, so the caller reads it until the final
+// message.
+func (w *httpResponseWriter) marshalHead() []byte {
+	header := w.header.Clone()
+	header.Del("Content-Length")
+	header.Del("Transfer-Encoding")
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "HTTP/1.1 %03d %s\r\n", w.statusCode, http.StatusText(w.statusCode))
+	header.Write(out)
+	out.WriteString("\r\n")
+
+	return out.Bytes()
+}
+
 func (w *httpResponseWriter) marshal() ([]byte, error) {
+	if w.headSent {
+		// The remainder of a streamed response.
+		if w.streamErr != nil {
+			return nil, w.streamErr
+		}
+		return w.body.Bytes(), nil
+	}
 	res := &http.Response{
 		Proto:         "HTTP/1.1",
 		ProtoMajor:    1,
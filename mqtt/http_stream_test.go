@@ -0,0 +1,130 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loopbackEndpoint passes calls directly to an HTTPServer's request handler so
+// that the HTTP streaming logic can be tested without a broker.
+type loopbackEndpoint struct {
+	Endpoint
+	server *HTTPServer
+}
+
+func (e *loopbackEndpoint) Call(ctx context.Context, c *Call) error {
+	payload, err := c.Payload()
+	if err != nil {
+		return err
+	}
+	handlerCtx := WithTopic(ctx, c.Topic)
+	if c.Stream != nil {
+		handlerCtx = WithStreamFunc(handlerCtx, func(_ context.Context, chunk []byte) error {
+			return c.Stream(chunk)
+		})
+	}
+	result, err := e.server.handleRequest(handlerCtx, &Message{
+		Topic:   c.Topic,
+		Payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+	return c.Unmarshal(result.([]byte), c.Response)
+}
+
+func newLoopbackDoer(handler http.Handler) *HTTPDoer {
+	return NewHTTPDoer(HTTPDoerConfig{
+		Topic: "test",
+		Endpoint: &loopbackEndpoint{
+			server: &HTTPServer{handler: handler},
+		},
+	})
+}
+
+func TestHTTPStreaming(t *testing.T) {
+	t.Run("buffered response", func(t *testing.T) {
+		doer := newLoopbackDoer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			topic, _ := GetTopic(r.Context())
+			w.Header().Set("X-Topic", topic)
+			fmt.Fprint(w, "hello")
+		}))
+
+		req, err := http.NewRequestWithContext(t.Context(), "GET", "/hello", nil)
+		require.NoError(t, err)
+		resp, err := doer.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "test", resp.Header.Get("X-Topic"))
+		assert.Equal(t, int64(5), resp.ContentLength)
+		assert.Equal(t, "hello", string(body))
+	})
+
+	t.Run("streamed response", func(t *testing.T) {
+		next := make(chan struct{})
+		doer := newLoopbackDoer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusAccepted)
+			for i := range 3 {
+				fmt.Fprintf(w, "line %d\n", i)
+				http.NewResponseController(w).Flush()
+				<-next
+			}
+			fmt.Fprint(w, "done\n")
+		}))
+
+		req, err := http.NewRequestWithContext(t.Context(), "GET", "/stream", nil)
+		require.NoError(t, err)
+		resp, err := doer.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// The response is returned before the handler finishes.
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		rdr := bufio.NewReader(resp.Body)
+		for i := range 3 {
+			line, err := rdr.ReadString('\n')
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("line %d\n", i), line)
+			next <- struct{}{}
+		}
+		rest, err := io.ReadAll(rdr)
+		require.NoError(t, err)
+		assert.Equal(t, "done\n", string(rest))
+	})
+
+	t.Run("closing the body cancels the request", func(t *testing.T) {
+		canceled := make(chan struct{})
+		doer := newLoopbackDoer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "started\n")
+			http.NewResponseController(w).Flush()
+			<-r.Context().Done()
+			close(canceled)
+		}))
+
+		req, err := http.NewRequestWithContext(t.Context(), "GET", "/stream", nil)
+		require.NoError(t, err)
+		resp, err := doer.Do(req)
+		require.NoError(t, err)
+
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "started\n", line)
+
+		require.NoError(t, resp.Body.Close())
+		<-canceled
+	})
+}
@@ -0,0 +1,67 @@
+package mqtt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The functions below implement the topic layout for serving the Control Plane
+// API over MQTT. Every topic starts with a configurable prefix:
+//
+//	<prefix>/hosts/<host id>/api
+//	<prefix>/tenants/<tenant id>/hosts/<host id>/api
+//	<prefix>/tenants/<tenant id>/responses/<client id>
+//	<prefix>/events/databases/<database id>/<kind>
+//	<prefix>/tenants/<tenant id>/events/databases/<database id>/<kind>
+//
+// Brokers can use this layout to authorize clients by topic. For example, a
+// tenant's clients can be limited to publishing and subscribing under
+// <prefix>/tenants/<tenant id>/, and the servers handle requests that arrive
+// on a tenant's topics as that tenant.
+
+// HostAPITopic returns the topic for requests to a host's API.
+func HostAPITopic(prefix, hostID string) string {
+	return fmt.Sprintf("%s/hosts/%s/api", prefix, hostID)
+}
+
+// TenantAPITopic returns the topic for a tenant's requests to a host's API.
+func TenantAPITopic(prefix, tenantID, hostID string) string {
+	return fmt.Sprintf("%s/tenants/%s/hosts/%s/api", prefix, tenantID, hostID)
+}
+
+// TenantAPITopicFilter returns a topic filter that matches every tenant's API
+// topic for the given host.
+func TenantAPITopicFilter(prefix, hostID string) string {
+	return TenantAPITopic(prefix, "+", hostID)
+}
+
+// TenantResponseTopic returns a response topic for a tenant's client. See
+// Config.ResponseTopic.
+func TenantResponseTopic(prefix, tenantID, clientID string) string {
+	return fmt.Sprintf("%s/tenants/%s/responses/%s", prefix, tenantID, clientID)
+}
+
+// EventTopic returns the topic for events of the given kind for a database.
+func EventTopic(prefix, databaseID, kind string) string {
+	return fmt.Sprintf("%s/events/databases/%s/%s", prefix, databaseID, kind)
+}
+
+// TenantEventTopic returns the topic for events of the given kind for a
+// tenant's database.
+func TenantEventTopic(prefix, tenantID, databaseID, kind string) string {
+	return fmt.Sprintf("%s/tenants/%s/events/databases/%s/%s", prefix, tenantID, databaseID, kind)
+}
+
+// TenantFromTopic returns the tenant ID from a topic under
+// <prefix>/tenants/<tenant id>/.
+func TenantFromTopic(prefix, topic string) (string, bool) {
+	rest, ok := strings.CutPrefix(topic, prefix+"/tenants/")
+	if !ok {
+		return "", false
+	}
+	tenantID, _, ok := strings.Cut(rest, "/")
+	if !ok || tenantID == "" {
+		return "", false
+	}
+	return tenantID, true
+}
@@ -0,0 +1,46 @@
+package mqtt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/mqtt"
+)
+
+func TestTenantFromTopic(t *testing.T) {
+	for _, tc := range []struct {
+		topic    string
+		expected string
+		ok       bool
+	}{
+		{
+			topic:    mqtt.TenantAPITopic("pgedge", "acme", "host-1"),
+			expected: "acme",
+			ok:       true,
+		},
+		{
+			topic:    mqtt.TenantEventTopic("pgedge", "acme", "storefront", "task"),
+			expected: "acme",
+			ok:       true,
+		},
+		{
+			topic: mqtt.HostAPITopic("pgedge", "host-1"),
+		},
+		{
+			topic: "other/tenants/acme/hosts/host-1/api",
+		},
+		{
+			topic: "pgedge/tenants/acme",
+		},
+		{
+			topic: "pgedge/tenants//hosts/host-1/api",
+		},
+	} {
+		t.Run(tc.topic, func(t *testing.T) {
+			tenantID, ok := mqtt.TenantFromTopic("pgedge", tc.topic)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, tenantID)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/grpclog"
+
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+)
+
+const downgradeTimeout = 5 * time.Minute
+
+func newDowngradeCommand(i *do.Injector) *cobra.Command {
+	var revision string
+
+	cmd := &cobra.Command{
+		Use:   "downgrade",
+		Short: "Revert the cluster's data so that it can run an earlier version",
+		Long: `Revert the data in etcd to the formats used by an earlier control plane
+version. --revision is the identifier of the last data migration that the
+earlier version knows about, or 'none' if it predates every migration.
+Resource state migrations can't be reverted, so the earlier version must use
+the same resource state version as this one.
+
+Run this command with the current version on a host where the control plane
+is running, then replace the control plane on every host with the earlier
+version. Avoid making changes to databases until every host has been replaced,
+and don't restart any host with the current version, because it will apply
+its migrations again.
+
+This command fails without modifying any data if any of the changes can't be
+reverted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			ctx, cancel := context.WithTimeout(ctx, downgradeTimeout)
+			defer cancel()
+
+			target := migrate.DowngradeTarget{Revision: revision}
+
+			grpcLogger, err := do.Invoke[grpclog.LoggerV2](i)
+			if err != nil {
+				return fmt.Errorf("failed to initialize grpc logger: %w", err)
+			}
+			grpclog.SetLoggerV2(grpcLogger)
+
+			e, err := do.Invoke[etcd.Etcd](i)
+			if err != nil {
+				return fmt.Errorf("failed to initialize etcd: %w", err)
+			}
+			initialized, err := e.IsInitialized()
+			if err != nil {
+				return fmt.Errorf("failed to check if etcd is initialized: %w", err)
+			}
+			if !initialized {
+				return errors.New("this host has not been initialized")
+			}
+
+			downgrader, err := do.Invoke[*migrate.Downgrader](i)
+			if err != nil {
+				return fmt.Errorf("failed to initialize downgrader: %w", err)
+			}
+			result, err := downgrader.Downgrade(ctx, target)
+			if result != nil {
+				event := logger.Info().
+					Strs("reverted_migrations", result.RevertedMigrations)
+				if result.Compatibility != nil {
+					event = event.
+						Str("revision", result.Compatibility.Revision).
+						Stringer("state_version", result.Compatibility.StateVersion)
+				}
+				event.Msg("downgrade results")
+			}
+			if err != nil {
+				return fmt.Errorf("failed to downgrade: %w", err)
+			}
+
+			logger.Info().Msg("downgrade complete. replace the control plane on every host with the earlier version.")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&revision, "revision", "", "Identifier of the last data migration to keep, or 'none' to revert every migration.")
+
+	return cmd
+}
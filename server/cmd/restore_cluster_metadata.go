@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/metadatabackup"
+)
+
+func newRestoreClusterMetadataCommand(i *do.Injector) *cobra.Command {
+	var snapshotFile string
+	var snapshotName string
+	var encryptionKeyFile string
+
+	cmd := &cobra.Command{
+		Use:   "restore-cluster-metadata",
+		Short: "Rebuild a single-host cluster from a metadata snapshot",
+		Long: `Rebuild this host's embedded etcd as a new single-member cluster from a
+metadata snapshot. The snapshot is read from --file if it's set. Otherwise,
+it's read from the configured metadata_backup repository, using the snapshot
+named by --snapshot or the newest snapshot if --snapshot is not set.
+Encrypted snapshots are decrypted with the key from --encryption-key-file, or
+from metadata_backup.encryption_key_file if the flag is not set.
+
+The control plane must be stopped on this host before running this command.
+The existing etcd data directory, certificates, and generated config are
+moved aside rather than deleted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			cfg, err := do.Invoke[config.Config](i)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			manager, err := do.Invoke[*config.Manager](i)
+			if err != nil {
+				return fmt.Errorf("failed to get config manager: %w", err)
+			}
+			loggerFactory, err := do.Invoke[*logging.Factory](i)
+			if err != nil {
+				return fmt.Errorf("failed to get logger factory: %w", err)
+			}
+
+			data, source, err := readSnapshot(ctx, cfg, snapshotFile, snapshotName)
+			if err != nil {
+				return err
+			}
+			if metadatabackup.IsEncrypted(data) {
+				data, err = decryptSnapshot(cfg, encryptionKeyFile, data)
+				if err != nil {
+					return err
+				}
+			}
+			snapshot, err := metadatabackup.DecodeSnapshot(bytes.NewReader(data))
+			if err != nil {
+				return err
+			}
+
+			logger.Info().
+				Str("snapshot", source).
+				Time("created_at", snapshot.CreatedAt).
+				Int("keys", len(snapshot.KVs)).
+				Msg("restoring cluster metadata")
+
+			result, err := metadatabackup.Restore(ctx, manager, loggerFactory, snapshot)
+			if result != nil {
+				logger.Info().
+					Strs("moved_paths", result.MovedPaths).
+					Int("keys_restored", result.KeysRestored).
+					Int("instance_users_restored", result.InstanceUsersRestored).
+					Strs("other_hosts", result.OtherHosts).
+					Msg("restore results")
+			}
+			if err != nil {
+				return fmt.Errorf("failed to restore cluster metadata: %w", err)
+			}
+
+			logger.Info().Msg("restored cluster metadata. start the control plane on this host, then remove or rejoin the other hosts.")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&snapshotFile, "file", "", "Path to a local snapshot file.")
+	cmd.Flags().StringVar(&snapshotName, "snapshot", "", "Name of a snapshot in the configured repository. Defaults to the newest snapshot.")
+	cmd.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "Path to the key file for encrypted snapshots. Defaults to metadata_backup.encryption_key_file.")
+	cmd.MarkFlagsMutuallyExclusive("file", "snapshot")
+
+	return cmd
+}
+
+func readSnapshot(ctx context.Context, cfg config.Config, file, name string) ([]byte, string, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read snapshot file: %w", err)
+		}
+		return data, file, nil
+	}
+
+	repo, err := metadatabackup.NewRepository(ctx, cfg.MetadataBackup.Repository)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to initialize metadata backup repository: %w", err)
+	}
+	if name == "" {
+		name, err = metadatabackup.LatestSnapshot(ctx, repo)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to find latest snapshot: %w", err)
+		}
+	}
+	data, err := repo.Get(ctx, name)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get snapshot %q: %w", name, err)
+	}
+
+	return data, name, nil
+}
+
+func decryptSnapshot(cfg config.Config, keyFile string, data []byte) ([]byte, error) {
+	if keyFile == "" {
+		keyFile = cfg.MetadataBackup.EncryptionKeyFile
+	}
+	if keyFile == "" {
+		return nil, metadatabackup.ErrEncryptionKeyRequired
+	}
+	key, err := metadatabackup.LoadEncryptionKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadatabackup.Decrypt(key, data)
+}
@@ -13,12 +13,15 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/database"
 	"github.com/pgEdge/control-plane/server/internal/docker"
+	"github.com/pgEdge/control-plane/server/internal/drift"
 	"github.com/pgEdge/control-plane/server/internal/election"
 	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/events"
 	"github.com/pgEdge/control-plane/server/internal/filesystem"
 	"github.com/pgEdge/control-plane/server/internal/host"
 	"github.com/pgEdge/control-plane/server/internal/ipam"
 	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/metadatabackup"
 	"github.com/pgEdge/control-plane/server/internal/migrate"
 	"github.com/pgEdge/control-plane/server/internal/monitor"
 	"github.com/pgEdge/control-plane/server/internal/orchestrator"
@@ -26,10 +29,13 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/orchestrator/swarm"
 	"github.com/pgEdge/control-plane/server/internal/orchestrator/systemd"
 	"github.com/pgEdge/control-plane/server/internal/ports"
+	"github.com/pgEdge/control-plane/server/internal/replacement"
 	"github.com/pgEdge/control-plane/server/internal/resource"
 	"github.com/pgEdge/control-plane/server/internal/resource/migrations"
+	"github.com/pgEdge/control-plane/server/internal/retention"
 	"github.com/pgEdge/control-plane/server/internal/scheduler"
 	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 	"github.com/pgEdge/control-plane/server/internal/workflows"
 	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
 )
@@ -72,18 +78,24 @@ func newRootCmd(i *do.Injector) *cobra.Command {
 			certificates.Provide(i)
 			database.Provide(i)
 			docker.Provide(i)
+			drift.Provide(i)
 			election.Provide(i)
 			etcd.Provide(i)
+			events.Provide(i)
 			filesystem.Provide(i)
 			host.Provide(i)
 			ipam.Provide(i)
 			logging.Provide(i)
+			metadatabackup.Provide(i)
 			migrate.Provide(i)
 			monitor.Provide(i)
 			ports.Provide(i)
 			resource.Provide(i)
 			migrations.Provide(i)
+			replacement.Provide(i)
+			retention.Provide(i)
 			scheduler.Provide(i)
+			webhook.Provide(i)
 			workflows.Provide(i)
 			activities.Provide(i)
 			task.Provide(i)
@@ -125,6 +137,8 @@ func Execute() {
 	rootCmd.PersistentFlags().BoolP("logging.pretty", "p", false, "Use pretty logging instead of JSON logging.")
 
 	rootCmd.AddCommand(newRunCommand(i))
+	rootCmd.AddCommand(newRestoreClusterMetadataCommand(i))
+	rootCmd.AddCommand(newDowngradeCommand(i))
 	rootCmd.AddCommand(newVersionCommand(i))
 
 	if err := rootCmd.Execute(); err != nil {
@@ -7,21 +7,35 @@ import (
 	"github.com/samber/do"
 	"github.com/spf13/cobra"
 
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+	"github.com/pgEdge/control-plane/server/internal/resource"
 	"github.com/pgEdge/control-plane/server/internal/version"
 )
 
 func newVersionCommand(i *do.Injector) *cobra.Command {
-	return &cobra.Command{
+	var compatibility bool
+
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Show version information and exit",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
-			info, err := version.GetInfo()
-			if err != nil {
-				return fmt.Errorf("failed to initialize application: %w", err)
+			var out any
+			if compatibility {
+				compat, err := compatibilityInfo()
+				if err != nil {
+					return err
+				}
+				out = compat
+			} else {
+				info, err := version.GetInfo()
+				if err != nil {
+					return fmt.Errorf("failed to initialize application: %w", err)
+				}
+				out = info
 			}
-			raw, err := json.MarshalIndent(info, "", "  ")
+			raw, err := json.MarshalIndent(out, "", "  ")
 			if err != nil {
 				return fmt.Errorf("failed to marshal version info: %w", err)
 			}
@@ -31,4 +45,29 @@ func newVersionCommand(i *do.Injector) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&compatibility, "compatibility", false, "Show the data formats that this version uses, which are the targets for the downgrade command.")
+
+	return cmd
+}
+
+type compatibility struct {
+	Revision     string `json:"revision"`
+	StateVersion string `json:"state_version"`
+}
+
+func compatibilityInfo() (*compatibility, error) {
+	migrations, err := migrate.AllMigrations()
+	if err != nil {
+		return nil, err
+	}
+	revision := migrate.RevisionNone
+	if len(migrations) > 0 {
+		revision = migrations[len(migrations)-1].Identifier()
+	}
+
+	return &compatibility{
+		Revision:     revision,
+		StateVersion: resource.CurrentVersion.String(),
+	}, nil
 }
@@ -1,10 +1,12 @@
 package apiv1
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,10 +16,18 @@ import (
 	api "github.com/pgEdge/control-plane/api/apiv1/gen/control_plane"
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/events"
 	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
 	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
 	"github.com/pgEdge/control-plane/server/internal/task"
 	"github.com/pgEdge/control-plane/server/internal/utils"
+	"github.com/pgEdge/control-plane/server/internal/version"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 )
 
 // isSensitiveConfigKey returns true if the given config key name likely
@@ -125,10 +135,56 @@ func hostToAPI(h *host.Host) *api.Host {
 			State:      string(h.Status.State),
 			Components: components,
 			UpdatedAt:  h.Status.UpdatedAt.Format(time.RFC3339),
+			Version:    versionInfoToAPI(h.Status.VersionInfo),
 		},
 	}
 }
 
+func versionInfoToAPI(info *version.Info) *api.VersionInfo {
+	if info == nil {
+		return nil
+	}
+	return &api.VersionInfo{
+		Version:      info.Version,
+		Revision:     info.Revision,
+		RevisionTime: info.RevisionTime,
+		Arch:         info.Arch,
+	}
+}
+
+func upgradeStatusToAPI(status *migrate.UpgradeStatus) *api.ClusterUpgradeStatus {
+	hosts := make([]*api.ClusterUpgradeHost, len(status.Hosts))
+	for i, h := range status.Hosts {
+		var hostVersion *string
+		if h.VersionInfo != nil {
+			hostVersion = utils.NillablePointerTo(h.VersionInfo.Version)
+		}
+		hosts[i] = &api.ClusterUpgradeHost{
+			HostID:       api.Identifier(h.HostID),
+			Version:      hostVersion,
+			Revision:     utils.NillablePointerTo(h.Revision),
+			StateVersion: stateVersionToAPI(h.StateVersion),
+			Compatible:   h.Compatible,
+			Upgraded:     h.Upgraded,
+		}
+	}
+	return &api.ClusterUpgradeStatus{
+		State:             string(status.State),
+		Revision:          utils.NillablePointerTo(status.Revision),
+		StateVersion:      stateVersionToAPI(status.StateVersion),
+		PendingMigrations: append([]string{}, status.PendingMigrations...),
+		Hosts:             hosts,
+		Incompatibilities: append([]string{}, status.Incompatibilities...),
+	}
+}
+
+func stateVersionToAPI(v *ds.Version) *string {
+	if v == nil {
+		return nil
+	}
+	return utils.PointerTo(v.String())
+}
+
 func databaseNodesToAPI(nodes []*database.Node) []*api.DatabaseNodeSpec {
 	apiNodes := make([]*api.DatabaseNodeSpec, len(nodes))
 	for i, node := range nodes {
@@ -151,6 +207,7 @@ func databaseNodesToAPI(nodes []*database.Node) []*api.DatabaseNodeSpec {
 			RestoreConfig:    restoreConfigToAPI(node.RestoreConfig),
 			OrchestratorOpts: orchestratorOptsToAPI(node.OrchestratorOpts),
 			SourceNode:       utils.NillablePointerTo(node.SourceNode),
+			Patroni:          patroniSettingsToAPI(node.Patroni),
 		}
 	}
 	return apiNodes
@@ -301,6 +358,39 @@ func scriptsToAPI(scripts *database.ScriptStatements) *api.DatabaseScripts {
 	}
 }
 
+func patroniSettingsToAPI(settings *database.PatroniSettings) *api.PatroniSettings {
+	if settings == nil {
+		return nil
+	}
+	var watchdog *api.PatroniWatchdog
+	if settings.Watchdog != nil {
+		watchdog = &api.PatroniWatchdog{
+			Mode:         string(settings.Watchdog.Mode),
+			Device:       settings.Watchdog.Device,
+			SafetyMargin: settings.Watchdog.SafetyMargin,
+		}
+	}
+	return &api.PatroniSettings{
+		MaximumLagOnFailover: settings.MaximumLagOnFailover,
+		FailsafeMode:         settings.FailsafeMode,
+		LoopWait:             settings.LoopWait,
+		TTL:                  settings.TTL,
+		RetryTimeout:         settings.RetryTimeout,
+		CheckTimeline:        settings.CheckTimeline,
+		Watchdog:             watchdog,
+	}
+}
+
+func queryStatsSettingsToAPI(settings *database.QueryStatsSettings) *api.QueryStatsSettings {
+	if settings == nil {
+		return nil
+	}
+	return &api.QueryStatsSettings{
+		Enabled: settings.Enabled,
+		TopN:    utils.NillablePointerTo(settings.TopN),
+	}
+}
+
 func databaseSpecToAPI(d *database.Spec) *api.DatabaseSpec {
 	return &api.DatabaseSpec{
 		DatabaseName:     d.DatabaseName,
@@ -320,6 +410,10 @@ func databaseSpecToAPI(d *database.Spec) *api.DatabaseSpec {
 		PgIdentConf:      d.PgIdentConf,
 		OrchestratorOpts: orchestratorOptsToAPI(d.OrchestratorOpts),
 		Scripts:          scriptsToAPI(d.Scripts),
+		Patroni:          patroniSettingsToAPI(d.Patroni),
+		QueryStats:       queryStatsSettingsToAPI(d.QueryStats),
+		DriftPolicy:      utils.NillablePointerTo(string(d.DriftPolicy)),
+		PatroniDcs:       utils.NillablePointerTo(string(d.PatroniDCS)),
 	}
 }
 
@@ -434,10 +528,45 @@ func databaseToAPI(d *database.Database) *api.Database {
 		TenantID:         tenantID,
 		CreatedAt:        d.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:        d.UpdatedAt.Format(time.RFC3339),
-		State:            string(d.State),
+		State:            string(d.ReportedState()),
+		DegradedReasons:  d.DegradedReasons,
 		Spec:             spec,
 		ServiceInstances: serviceInstances,
 		Instances:        instancesToAPI(d.Instances),
+		Drift:            driftToAPI(d.Drift),
+	}
+}
+
+func driftToAPI(d *database.Drift) *api.DatabaseDrift {
+	if d == nil {
+		return nil
+	}
+
+	resources := make([]*api.DriftedResource, len(d.Resources))
+	for i, r := range d.Resources {
+		var diff []map[string]any
+		// The diff was produced by our own marshaller, so an error here
+		// would only mean that it's empty.
+		_ = json.Unmarshal(r.Diff, &diff)
+
+		resources[i] = &api.DriftedResource{
+			ResourceID: r.ResourceID,
+			Type:       string(r.Type),
+			Reason:     utils.NillablePointerTo(string(r.Reason)),
+			Diff:       diff,
+		}
+	}
+
+	var remediationTaskID *string
+	if d.RemediationTaskID != nil {
+		remediationTaskID = utils.PointerTo(d.RemediationTaskID.String())
+	}
+
+	return &api.DatabaseDrift{
+		CheckedAt:         d.CheckedAt.Format(time.RFC3339),
+		Resources:         resources,
+		Error:             utils.NillablePointerTo(d.Error),
+		RemediationTaskID: remediationTaskID,
 	}
 }
 
@@ -452,12 +581,13 @@ func databaseToSummaryAPI(d *database.Database) *api.DatabaseSummary {
 	}
 
 	return &api.DatabaseSummary{
-		ID:        api.Identifier(d.DatabaseID),
-		TenantID:  tenantID,
-		CreatedAt: d.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: d.UpdatedAt.Format(time.RFC3339),
-		State:     string(d.State),
-		Instances: instancesToAPI(d.Instances),
+		ID:              api.Identifier(d.DatabaseID),
+		TenantID:        tenantID,
+		CreatedAt:       d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       d.UpdatedAt.Format(time.RFC3339),
+		State:           string(d.ReportedState()),
+		DegradedReasons: d.DegradedReasons,
+		Instances:       instancesToAPI(d.Instances),
 	}
 }
 
@@ -503,6 +633,134 @@ func instanceSpockStatusToAPI(status *database.InstanceStatus) *api.InstanceSpoc
 	}
 }
 
+func instanceResourceUsageToAPI(usage *database.InstanceResourceUsage) *api.InstanceResourceUsage {
+	if usage == nil {
+		return nil
+	}
+	apiUsage := &api.InstanceResourceUsage{
+		DataDirectoryBytes: usage.DataDirectoryBytes,
+		WalDirectoryBytes:  usage.WALDirectoryBytes,
+		VolumeFreeBytes:    usage.VolumeFreeBytes,
+		VolumeTotalBytes:   usage.VolumeTotalBytes,
+		DatabaseSizeBytes:  usage.DatabaseSizeBytes,
+		Connections:        usage.Connections,
+		MaxConnections:     usage.MaxConnections,
+		CPUPercent:         usage.CPUPercent,
+		MemoryBytes:        usage.MemoryBytes,
+		MemoryLimitBytes:   usage.MemoryLimitBytes,
+		DiskFull:           usage.DiskFull,
+	}
+	if usage.CollectedAt != nil {
+		apiUsage.CollectedAt = utils.PointerTo(usage.CollectedAt.Format(time.RFC3339))
+	}
+	return apiUsage
+}
+
+func postgreSQLSettingsToAPI(settings []*database.PostgreSQLSetting) []*api.PostgreSQLSetting {
+	apiSettings := make([]*api.PostgreSQLSetting, len(settings))
+	for i, setting := range settings {
+		apiSettings[i] = &api.PostgreSQLSetting{
+			Name:           setting.Name,
+			Value:          fmt.Sprint(setting.Value),
+			Source:         string(setting.Source),
+			PendingRestart: utils.NillablePointerTo(setting.PendingRestart),
+		}
+	}
+	return apiSettings
+}
+
+func timeToAPI(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return utils.PointerTo(t.Format(time.RFC3339))
+}
+
+func sessionsToAPI(sessions []postgres.Session) []*api.InstanceSession {
+	apiSessions := make([]*api.InstanceSession, len(sessions))
+	for i, session := range sessions {
+		var locks []*api.SessionLock
+		for _, lock := range session.Locks {
+			locks = append(locks, &api.SessionLock{
+				LockType: lock.LockType,
+				Relation: lock.Relation,
+				Mode:     lock.Mode,
+				Granted:  lock.Granted,
+			})
+		}
+		apiSessions[i] = &api.InstanceSession{
+			Pid:             session.PID,
+			Username:        session.Username,
+			DatabaseName:    session.DatabaseName,
+			ApplicationName: session.ApplicationName,
+			ClientAddr:      session.ClientAddr,
+			State:           session.State,
+			WaitEventType:   session.WaitEventType,
+			WaitEvent:       session.WaitEvent,
+			BackendStart:    timeToAPI(session.BackendStart),
+			XactStart:       timeToAPI(session.XactStart),
+			QueryStart:      timeToAPI(session.QueryStart),
+			StateChange:     timeToAPI(session.StateChange),
+			Query:           session.Query,
+			BlockedBy:       session.BlockedBy,
+			Locks:           locks,
+		}
+	}
+	return apiSessions
+}
+
+func queryStatsSummariesToAPI(summaries []*database.QueryStatsSummary) []*api.InstanceQueryStats {
+	apiStats := make([]*api.InstanceQueryStats, len(summaries))
+	for i, summary := range summaries {
+		queries := make([]*api.QueryStat, len(summary.Queries))
+		for j, q := range summary.Queries {
+			queries[j] = &api.QueryStat{
+				QueryID:           q.QueryID,
+				Query:             q.Query,
+				Calls:             q.Calls,
+				TotalTimeMs:       q.TotalTimeMs,
+				MeanTimeMs:        q.MeanTimeMs,
+				Rows:              q.Rows,
+				WindowCalls:       q.WindowCalls,
+				WindowTotalTimeMs: q.WindowTotalTimeMs,
+				WindowRows:        q.WindowRows,
+			}
+		}
+		stats := &api.InstanceQueryStats{
+			InstanceID: summary.InstanceID,
+			Queries:    queries,
+		}
+		if !summary.CollectedAt.IsZero() {
+			stats.CollectedAt = utils.PointerTo(summary.CollectedAt.Format(time.RFC3339))
+			stats.WindowStart = utils.PointerTo(summary.WindowStart.Format(time.RFC3339))
+		}
+		apiStats[i] = stats
+	}
+	return apiStats
+}
+
+func postgreSQLConfChangesToAPI(changes []*database.PostgreSQLConfChange) []*api.PostgreSQLConfChange {
+	if len(changes) == 0 {
+		return nil
+	}
+	apiChanges := make([]*api.PostgreSQLConfChange, len(changes))
+	for i, change := range changes {
+		apiChange := &api.PostgreSQLConfChange{
+			InstanceID:      change.InstanceID,
+			Name:            change.Name,
+			RequiresRestart: change.RequiresRestart,
+		}
+		if change.OldValue != nil {
+			apiChange.OldValue = utils.PointerTo(fmt.Sprint(change.OldValue))
+		}
+		if change.NewValue != nil {
+			apiChange.NewValue = utils.PointerTo(fmt.Sprint(change.NewValue))
+		}
+		apiChanges[i] = apiChange
+	}
+	return apiChanges
+}
+
 func instanceToAPI(instance *database.Instance) *api.Instance {
 	if instance == nil {
 		return nil
@@ -522,6 +780,7 @@ func instanceToAPI(instance *database.Instance) *api.Instance {
 		apiInst.ConnectionInfo = instanceConnectionInfoToAPI(status)
 		apiInst.Postgres = instancePostgresStatusToAPI(status)
 		apiInst.Spock = instanceSpockStatusToAPI(status)
+		apiInst.Resources = instanceResourceUsageToAPI(status.Resources)
 		if status.StatusUpdatedAt != nil {
 			apiInst.StatusUpdatedAt = utils.PointerTo(status.StatusUpdatedAt.Format(time.RFC3339))
 		}
@@ -575,6 +834,7 @@ func apiToDatabaseNodes(apiNodes []*api.DatabaseNodeSpec) ([]*database.Node, err
 			RestoreConfig:    restoreConfig,
 			OrchestratorOpts: orchestratorOptsToDatabase(apiNode.OrchestratorOpts),
 			SourceNode:       utils.FromPointer(apiNode.SourceNode),
+			Patroni:          apiToPatroniSettings(apiNode.Patroni),
 		}
 	}
 	return nodes, nil
@@ -748,6 +1008,39 @@ func apiToScripts(scripts *api.DatabaseScripts) *database.ScriptStatements {
 	}
 }
 
+func apiToPatroniSettings(settings *api.PatroniSettings) *database.PatroniSettings {
+	if settings == nil {
+		return nil
+	}
+	var watchdog *database.PatroniWatchdog
+	if settings.Watchdog != nil {
+		watchdog = &database.PatroniWatchdog{
+			Mode:         patroni.WatchdogMode(settings.Watchdog.Mode),
+			Device:       settings.Watchdog.Device,
+			SafetyMargin: settings.Watchdog.SafetyMargin,
+		}
+	}
+	return &database.PatroniSettings{
+		MaximumLagOnFailover: settings.MaximumLagOnFailover,
+		FailsafeMode:         settings.FailsafeMode,
+		LoopWait:             settings.LoopWait,
+		TTL:                  settings.TTL,
+		RetryTimeout:         settings.RetryTimeout,
+		CheckTimeline:        settings.CheckTimeline,
+		Watchdog:             watchdog,
+	}
+}
+
+func apiToQueryStatsSettings(settings *api.QueryStatsSettings) *database.QueryStatsSettings {
+	if settings == nil {
+		return nil
+	}
+	return &database.QueryStatsSettings{
+		Enabled: settings.Enabled,
+		TopN:    utils.FromPointer(settings.TopN),
+	}
+}
+
 func apiToDatabaseSpec(
 	orchestrator config.Orchestrator,
 	id, tID *api.Identifier,
@@ -830,6 +1123,10 @@ func apiToDatabaseSpec(
 		RestoreConfig:    restoreConfig,
 		OrchestratorOpts: orchestratorOptsToDatabase(apiSpec.OrchestratorOpts),
 		Scripts:          apiToScripts(apiSpec.Scripts),
+		Patroni:          apiToPatroniSettings(apiSpec.Patroni),
+		QueryStats:       apiToQueryStatsSettings(apiSpec.QueryStats),
+		DriftPolicy:      database.DriftPolicy(utils.FromPointer(apiSpec.DriftPolicy)),
+		PatroniDCS:       database.PatroniDCS(utils.FromPointer(apiSpec.PatroniDcs)),
 	}, nil
 }
 
@@ -845,19 +1142,20 @@ func taskToAPI(t *task.Task) *api.Task {
 		parentID = utils.PointerTo(t.ParentID.String())
 	}
 	return &api.Task{
-		ParentID:    parentID,
-		Scope:       t.Scope.String(),
-		EntityID:    t.EntityID,
-		DatabaseID:  utils.NillablePointerTo(t.DatabaseID),
-		TaskID:      t.TaskID.String(),
-		NodeName:    utils.NillablePointerTo(t.NodeName),
-		HostID:      utils.NillablePointerTo(t.HostID),
-		InstanceID:  utils.NillablePointerTo(t.InstanceID),
-		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
-		CompletedAt: completedAt,
-		Type:        string(t.Type),
-		Status:      string(t.Status),
-		Error:       utils.NillablePointerTo(t.Error),
+		ParentID:     parentID,
+		Scope:        t.Scope.String(),
+		EntityID:     t.EntityID,
+		DatabaseID:   utils.NillablePointerTo(t.DatabaseID),
+		TaskID:       t.TaskID.String(),
+		NodeName:     utils.NillablePointerTo(t.NodeName),
+		HostID:       utils.NillablePointerTo(t.HostID),
+		InstanceID:   utils.NillablePointerTo(t.InstanceID),
+		CreatedAt:    t.CreatedAt.Format(time.RFC3339),
+		CompletedAt:  completedAt,
+		Type:         string(t.Type),
+		Status:       string(t.Status),
+		StatusReason: utils.NillablePointerTo(t.StatusReason),
+		Error:        utils.NillablePointerTo(t.Error),
 	}
 }
 
@@ -1163,6 +1461,114 @@ func taskLogOptionsFromHost(req *api.GetHostTaskLogPayload) (task.TaskLogOptions
 	return options, nil
 }
 
+func databaseListOptions(req *api.ListDatabasesPayload) (database.DatabaseListOptions, error) {
+	options := database.DatabaseListOptions{
+		ExcludeInstances: req.View == "summary",
+	}
+	if req.Limit != nil {
+		options.Limit = *req.Limit
+	}
+	if req.Cursor != nil {
+		options.AfterDatabaseID = string(*req.Cursor)
+	}
+	if req.TenantID != nil {
+		options.TenantID = string(*req.TenantID)
+	}
+	for _, state := range req.State {
+		options.States = append(options.States, database.DatabaseState(state))
+	}
+	if req.HostID != nil {
+		options.HostID = string(*req.HostID)
+	}
+	if req.PostgresVersion != nil {
+		version, err := ds.ParseVersion(*req.PostgresVersion)
+		if err != nil {
+			return database.DatabaseListOptions{}, fmt.Errorf("invalid postgres version %q: %w", *req.PostgresVersion, err)
+		}
+		options.PostgresVersion = version
+	}
+
+	return options, nil
+}
+
+func hostListOptions(req *api.ListHostsPayload) (host.HostListOptions, error) {
+	options := host.HostListOptions{}
+	if req.Limit != nil {
+		options.Limit = *req.Limit
+	}
+	if req.Cursor != nil {
+		options.AfterHostID = string(*req.Cursor)
+	}
+	for _, state := range req.State {
+		options.States = append(options.States, host.HostState(state))
+	}
+	if req.PostgresVersion != nil {
+		version, err := ds.ParseVersion(*req.PostgresVersion)
+		if err != nil {
+			return host.HostListOptions{}, fmt.Errorf("invalid postgres version %q: %w", *req.PostgresVersion, err)
+		}
+		options.PostgresVersion = version
+	}
+
+	return options, nil
+}
+
+func eventFilter(req *api.StreamEventsPayload) (events.Filter, error) {
+	filter := events.Filter{}
+	if req.DatabaseID != nil {
+		databaseID, err := identToString(*req.DatabaseID, []string{"database_id"})
+		if err != nil {
+			return events.Filter{}, err
+		}
+		filter.DatabaseID = databaseID
+	}
+	if req.HostID != nil {
+		hostID, err := identToString(*req.HostID, []string{"host_id"})
+		if err != nil {
+			return events.Filter{}, err
+		}
+		filter.HostID = hostID
+	}
+	for _, kind := range req.Kind {
+		filter.Kinds = append(filter.Kinds, events.Kind(kind))
+	}
+
+	return filter, nil
+}
+
+func eventToAPI(e *events.Event) *api.Event {
+	out := &api.Event{
+		Kind:      e.Kind.String(),
+		Timestamp: e.Timestamp.Format(time.RFC3339),
+	}
+	if e.Revision > 0 {
+		out.ID = strconv.FormatInt(e.Revision, 10)
+	}
+	if e.DatabaseID != "" {
+		out.DatabaseID = utils.PointerTo(api.Identifier(e.DatabaseID))
+	}
+	if e.HostID != "" {
+		out.HostID = utils.PointerTo(api.Identifier(e.HostID))
+	}
+	if e.TaskID != uuid.Nil {
+		out.TaskID = utils.PointerTo(e.TaskID.String())
+	}
+	if e.Database != nil {
+		out.Database = databaseToSummaryAPI(e.Database)
+	}
+	if e.Instance != nil {
+		out.Instance = instanceToAPI(e.Instance)
+	}
+	if e.Task != nil {
+		out.Task = taskToAPI(e.Task)
+	}
+	if e.TaskLogEntry != nil {
+		out.TaskLogEntry = taskLogEntriesToAPI([]task.LogEntry{*e.TaskLogEntry})[0]
+	}
+
+	return out
+}
+
 func taskListOptionsFromGeneric(req *api.ListTasksPayload) (task.Scope, string, error) {
 	if req.Scope == nil {
 		// No scope specified - return empty scope and entity ID
@@ -1219,3 +1625,134 @@ func availableUpgradesToAPI(upgrades []*database.AvailableUpgrade) []*api.Availa
 	}
 	return out
 }
+
+func apiToWebhook(req *api.CreateWebhookRequest) (*webhook.Webhook, error) {
+	databaseIDs := make([]string, len(req.DatabaseIds))
+	for idx, id := range req.DatabaseIds {
+		databaseID, err := identToString(id, []string{"database_ids", strconv.Itoa(idx)})
+		if err != nil {
+			return nil, err
+		}
+		databaseIDs[idx] = databaseID
+	}
+	eventTypes := make([]webhook.EventType, len(req.EventTypes))
+	for idx, t := range req.EventTypes {
+		eventTypes[idx] = webhook.EventType(t)
+	}
+
+	return &webhook.Webhook{
+		URL:         req.URL,
+		Description: utils.FromPointer(req.Description),
+		Secret:      utils.FromPointer(req.Secret),
+		EventTypes:  eventTypes,
+		DatabaseIDs: databaseIDs,
+	}, nil
+}
+
+func webhookToAPI(w *webhook.Webhook) *api.Webhook {
+	out := &api.Webhook{
+		ID:          w.ID,
+		URL:         w.URL,
+		Description: utils.NillablePointerTo(w.Description),
+		Secret:      utils.NillablePointerTo(w.Secret),
+		CreatedAt:   w.CreatedAt.Format(time.RFC3339),
+	}
+	for _, t := range w.EventTypes {
+		out.EventTypes = append(out.EventTypes, api.WebhookEventType(t))
+	}
+	for _, id := range w.DatabaseIDs {
+		out.DatabaseIds = append(out.DatabaseIds, api.Identifier(id))
+	}
+	return out
+}
+
+func webhookDeliveryToAPI(d *webhook.Delivery) *api.WebhookDelivery {
+	out := &api.WebhookDelivery{
+		DeliveryID:     d.DeliveryID,
+		WebhookID:      d.WebhookID,
+		EventType:      api.WebhookEventType(d.EventType),
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		LastStatusCode: utils.NillablePointerTo(d.LastStatusCode),
+		LastError:      utils.NillablePointerTo(d.LastError),
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.NextAttemptAt != nil {
+		out.NextAttemptAt = utils.PointerTo(d.NextAttemptAt.Format(time.RFC3339))
+	}
+	return out
+}
+
+func storageUsageToAPI(usage *etcd.Usage) *api.StorageUsage {
+	prefixes := make([]*api.StoragePrefixUsage, len(usage.Prefixes))
+	for i, p := range usage.Prefixes {
+		prefixes[i] = &api.StoragePrefixUsage{
+			Prefix: p.Prefix,
+			Keys:   p.Keys,
+			Bytes:  p.Bytes,
+		}
+	}
+	return &api.StorageUsage{
+		DbSizeBytes:      usage.DBSize,
+		DbSizeInUseBytes: usage.DBSizeInUse,
+		Keys:             usage.Keys,
+		Bytes:            usage.Bytes,
+		Prefixes:         prefixes,
+	}
+}
+
+func etcdClusterToAPI(status *etcd.ClusterStatus) *api.EtcdCluster {
+	members := make([]*api.EtcdMember, len(status.Members))
+	for i, m := range status.Members {
+		member := &api.EtcdMember{
+			ID:           strconv.FormatUint(m.ID, 16),
+			HostID:       api.Identifier(m.Name),
+			Role:         string(m.Role),
+			IsLeader:     m.IsLeader,
+			Reachable:    m.Reachable,
+			PeerUrls:     m.PeerURLs,
+			ClientUrls:   m.ClientURLs,
+			RaftIndexLag: m.RaftIndexLag,
+			Errors:       m.Errors,
+		}
+		if m.Reachable {
+			member.Version = utils.PointerTo(m.Version)
+			member.DbSizeBytes = utils.PointerTo(m.DBSize)
+			member.DbSizeInUseBytes = utils.PointerTo(m.DBSizeInUse)
+			member.RaftTerm = utils.PointerTo(m.RaftTerm)
+			member.RaftIndex = utils.PointerTo(m.RaftIndex)
+			member.RaftAppliedIndex = utils.PointerTo(m.RaftAppliedIndex)
+		}
+		members[i] = member
+	}
+	return &api.EtcdCluster{
+		Members:          members,
+		Leader:           utils.NillablePointerTo(status.Leader),
+		Voters:           status.Voters,
+		Learners:         status.Learners,
+		Quorum:           status.Quorum,
+		FailureTolerance: status.FailureTolerance,
+		Alarms:           status.Alarms,
+		Warnings:         status.Warnings,
+	}
+}
+
+func defragmentResultsToAPI(results []*etcd.DefragmentResult) *api.DefragmentEtcdResponse {
+	out := make([]*api.EtcdDefragmentResult, len(results))
+	for i, r := range results {
+		result := &api.EtcdDefragmentResult{
+			HostID:            api.Identifier(r.Name),
+			DbSizeBeforeBytes: r.DBSizeBefore,
+		}
+		if r.Error != nil {
+			result.Error = utils.PointerTo(r.Error.Error())
+		} else {
+			result.DbSizeAfterBytes = utils.PointerTo(r.DBSizeAfter)
+		}
+		out[i] = result
+	}
+	return &api.DefragmentEtcdResponse{
+		Results: out,
+	}
+}
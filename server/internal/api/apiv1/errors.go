@@ -11,6 +11,7 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/etcd"
 	"github.com/pgEdge/control-plane/server/internal/task"
 	"github.com/pgEdge/control-plane/server/internal/validation"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 	"github.com/pgEdge/control-plane/server/internal/workflows"
 )
 
@@ -42,6 +43,7 @@ var (
 	ErrHostNotFound               = newAPIError(errNotFound, "no host found with the given ID")
 	ErrNoPrimaryInstance          = newAPIError(errNotFound, "no primary instance found for the given node")
 	ErrOperationNotSupported      = newAPIError(errOperationNotSupported, "operation not supported by this control plane server")
+	ErrWebhookNotFound            = newAPIError(errNotFound, "no webhook found with the given ID")
 )
 
 // ErrHostAlreadyExistsWithID returns an error indicating that a host with the given ID already exists.
@@ -80,12 +82,24 @@ func apiErr(err error) error {
 		return makeInvalidInputErr(err)
 	case errors.Is(err, database.ErrUpgradeNotAvailable):
 		return makeInvalidInputErr(err)
+	case errors.Is(err, database.ErrInvalidMajorUpgrade):
+		return makeInvalidInputErr(err)
+	case errors.Is(err, database.ErrInvalidImportSource):
+		return makeInvalidInputErr(err)
+	case errors.Is(err, database.ErrInvalidClone):
+		return makeInvalidInputErr(err)
+	case errors.Is(err, workflows.ErrTaskNotRunning):
+		return makeInvalidInputErr(err)
 	case errors.Is(err, etcd.ErrCannotRemoveSelf):
 		return makeInvalidInputErr(err)
 	case errors.Is(err, etcd.ErrMinimumClusterSize):
 		return makeInvalidInputErr(err)
 	case errors.Is(err, etcd.ErrOperationNotSupported):
 		return ErrOperationNotSupported
+	case errors.Is(err, webhook.ErrWebhookNotFound):
+		return ErrWebhookNotFound
+	case errors.Is(err, webhook.ErrInvalidWebhook):
+		return makeInvalidInputErr(err)
 	default:
 		return newAPIError(errServerError, err.Error())
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -20,11 +21,15 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/database"
 	"github.com/pgEdge/control-plane/server/internal/ds"
 	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/events"
 	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
 	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
 	"github.com/pgEdge/control-plane/server/internal/storage"
 	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
 	"github.com/pgEdge/control-plane/server/internal/version"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 	"github.com/pgEdge/control-plane/server/internal/workflows"
 	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
 )
@@ -40,6 +45,9 @@ type PostInitHandlers struct {
 	taskSvc     *task.Service
 	workflowSvc *workflows.Service
 	clusterSvc  *cluster.Service
+	eventsSvc   *events.Service
+	webhookSvc  *webhook.Service
+	compat      *migrate.Compatibility
 }
 
 func NewPostInitHandlers(
@@ -51,6 +59,9 @@ func NewPostInitHandlers(
 	taskSvc *task.Service,
 	workflowSvc *workflows.Service,
 	clusterSvc *cluster.Service,
+	eventsSvc *events.Service,
+	webhookSvc *webhook.Service,
+	compat *migrate.Compatibility,
 ) *PostInitHandlers {
 	return &PostInitHandlers{
 		cfg:         cfg,
@@ -61,6 +72,9 @@ func NewPostInitHandlers(
 		taskSvc:     taskSvc,
 		workflowSvc: workflowSvc,
 		clusterSvc:  clusterSvc,
+		eventsSvc:   eventsSvc,
+		webhookSvc:  webhookSvc,
+		compat:      compat,
 	}
 }
 
@@ -157,26 +171,91 @@ func (s *PostInitHandlers) GetCluster(ctx context.Context) (*api.Cluster, error)
 	if err != nil {
 		return nil, apiErr(err)
 	}
+	upgrade, err := s.compat.UpgradeStatus(ctx)
+	if err != nil {
+		return nil, apiErr(err)
+	}
 	cluster := &api.Cluster{
-		ID:     api.Identifier(storedCluster.ID),
-		Hosts:  apiHosts,
-		Status: &api.ClusterStatus{State: "available"},
+		ID:      api.Identifier(storedCluster.ID),
+		Hosts:   apiHosts,
+		Status:  &api.ClusterStatus{State: "available"},
+		Upgrade: upgradeStatusToAPI(upgrade),
 	}
 
 	return cluster, nil
 }
 
-func (s *PostInitHandlers) ListHosts(ctx context.Context) (*api.ListHostsResponse, error) {
-	hosts, err := s.hostSvc.GetAllHosts(ctx)
+func (s *PostInitHandlers) GetStorageUsage(ctx context.Context, req *api.GetStorageUsagePayload) (*api.StorageUsage, error) {
+	depth := 1
+	if req.Depth != nil {
+		depth = *req.Depth
+	}
+	client, err := s.etcd.GetClient()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	usage, err := etcd.GetUsage(ctx, client, s.cfg.EtcdKeyRoot, depth)
 	if err != nil {
 		return nil, apiErr(err)
 	}
-	apiHosts := make([]*api.Host, len(hosts))
 
-	for idx, h := range hosts {
+	return storageUsageToAPI(usage), nil
+}
+
+func (s *PostInitHandlers) GetEtcdCluster(ctx context.Context) (*api.EtcdCluster, error) {
+	client, err := s.etcd.GetClient()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	status, err := etcd.GetClusterStatus(ctx, client)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return etcdClusterToAPI(status), nil
+}
+
+func (s *PostInitHandlers) DefragmentEtcd(ctx context.Context, req *api.DefragmentEtcdRequest) (*api.DefragmentEtcdResponse, error) {
+	hostIDs := make([]string, len(req.HostIds))
+	for i, id := range req.HostIds {
+		hostID, err := hostIdentToString(id)
+		if err != nil {
+			return nil, apiErr(err)
+		}
+		hostIDs[i] = hostID
+	}
+	client, err := s.etcd.GetClient()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	results, err := etcd.Defragment(ctx, client, hostIDs...)
+	if errors.Is(err, etcd.ErrMemberNotFound) {
+		return nil, makeInvalidInputErr(err)
+	} else if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return defragmentResultsToAPI(results), nil
+}
+
+func (s *PostInitHandlers) ListHosts(ctx context.Context, req *api.ListHostsPayload) (*api.ListHostsResponse, error) {
+	options, err := hostListOptions(req)
+	if err != nil {
+		return nil, makeInvalidInputErr(err)
+	}
+	list, err := s.hostSvc.ListHosts(ctx, options)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	apiHosts := make([]*api.Host, len(list.Hosts))
+
+	for idx, h := range list.Hosts {
 		apiHosts[idx] = hostToAPI(h)
 	}
-	return &api.ListHostsResponse{Hosts: apiHosts}, nil
+	return &api.ListHostsResponse{
+		Hosts:      apiHosts,
+		NextCursor: utils.NillablePointerTo(list.NextHostID),
+	}, nil
 }
 
 func (s *PostInitHandlers) GetHost(ctx context.Context, req *api.GetHostPayload) (*api.Host, error) {
@@ -222,6 +301,93 @@ func (s *PostInitHandlers) RemoveHost(ctx context.Context, req *api.RemoveHostPa
 	}, nil
 }
 
+func (s *PostInitHandlers) ChangeHostEtcdMode(ctx context.Context, req *api.ChangeHostEtcdModePayload) (*api.ChangeHostEtcdModeResponse, error) {
+	hostID, err := hostIdentToString(req.HostID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	mode := config.EtcdMode(req.Request.EtcdMode)
+
+	warnings, err := s.validateEtcdModeChange(ctx, hostID, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.workflowSvc.ChangeEtcdMode(ctx, hostID, mode)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return &api.ChangeHostEtcdModeResponse{
+		Task:     taskToAPI(t),
+		Warnings: warnings,
+	}, nil
+}
+
+// validateEtcdModeChange checks that the host can change to the given etcd
+// mode without losing quorum. It returns warnings about the cluster's failure
+// tolerance after the change.
+func (s *PostInitHandlers) validateEtcdModeChange(ctx context.Context, hostID string, mode config.EtcdMode) ([]string, error) {
+	h, err := s.hostSvc.GetHost(ctx, hostID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, ErrHostNotFound
+	} else if err != nil {
+		return nil, apiErr(err)
+	}
+	if h.EtcdMode == mode {
+		return nil, makeInvalidInputErr(fmt.Errorf("host '%s' is already running in etcd '%s' mode", hostID, mode))
+	}
+	if h.Status == nil || h.Status.State == host.HostStateUnreachable {
+		return nil, makeInvalidInputErr(fmt.Errorf("host '%s' is unreachable", hostID))
+	}
+
+	client, err := s.etcd.GetClient()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	status, err := etcd.GetClusterStatus(ctx, client)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	var reachable int
+	var member *etcd.MemberStatus
+	for _, m := range status.Members {
+		if m.Name == hostID {
+			member = m
+		}
+		if m.Role == etcd.MemberRoleVoter && m.Reachable {
+			reachable++
+		}
+	}
+
+	voters := status.Voters
+	switch mode {
+	case config.EtcdModeServer:
+		voters++
+	case config.EtcdModeClient:
+		if len(status.Members) < 3 {
+			return nil, makeInvalidInputErr(etcd.ErrMinimumClusterSize)
+		}
+		if member != nil && member.Role == etcd.MemberRoleVoter {
+			voters--
+			if member.Reachable {
+				reachable--
+			}
+		}
+		// The remaining voters must be able to form a quorum without this
+		// host.
+		if reachable < etcd.QuorumSize(voters) {
+			return nil, makeInvalidInputErr(fmt.Errorf(
+				"only %d of the %d remaining voters are reachable, so removing host '%s' would leave the cluster without quorum",
+				reachable, voters, hostID,
+			))
+		}
+	}
+
+	return etcd.QuorumWarnings(voters), nil
+}
+
 // validateHostRemoval checks if the host can be safely removed from the cluster.
 func (s *PostInitHandlers) validateHostRemoval(ctx context.Context, hostID string, force bool) error {
 	if hostID == s.cfg.HostID {
@@ -270,11 +436,17 @@ func (s *PostInitHandlers) prepareDatabaseUpdates(ctx context.Context, hostID st
 
 // ListDatabases fetches all databases from the database service and converts them to API format.
 func (s *PostInitHandlers) ListDatabases(ctx context.Context, req *api.ListDatabasesPayload) (*api.ListDatabasesResponse, error) {
+	options, err := databaseListOptions(req)
+	if err != nil {
+		return nil, makeInvalidInputErr(err)
+	}
+
 	// Fetch databases from the database service
-	databases, err := s.dbSvc.GetDatabases(ctx)
+	list, err := s.dbSvc.ListDatabases(ctx, options)
 	if err != nil {
 		return nil, apiErr(err)
 	}
+	databases := list.Databases
 
 	// Ensure we return an empty (non-nil) slice if no databases found
 	if len(databases) == 0 {
@@ -305,7 +477,8 @@ func (s *PostInitHandlers) ListDatabases(ctx context.Context, req *api.ListDatab
 	}
 
 	return &api.ListDatabasesResponse{
-		Databases: apiDatabases,
+		Databases:  apiDatabases,
+		NextCursor: utils.NillablePointerTo(list.NextDatabaseID),
 	}, nil
 }
 
@@ -320,6 +493,14 @@ func (s *PostInitHandlers) CreateDatabase(ctx context.Context, req *api.CreateDa
 		return nil, makeInvalidInputErr(fmt.Errorf("failed to validate database spec: %w", err))
 	}
 
+	var source *database.ImportSource
+	if req.Source != nil {
+		source = &database.ImportSource{DSN: req.Source.Dsn}
+		if err := source.Validate(spec); err != nil {
+			return nil, apiErr(err)
+		}
+	}
+
 	// Full validation on create (no PreviousSpec, not delta mode).
 	input := &workflows.ValidateSpecInput{
 		DatabaseID:   spec.DatabaseID,
@@ -339,7 +520,12 @@ func (s *PostInitHandlers) CreateDatabase(ctx context.Context, req *api.CreateDa
 		return nil, apiErr(err)
 	}
 
-	t, err := s.workflowSvc.CreateDatabase(ctx, db)
+	var t *task.Task
+	if source != nil {
+		t, err = s.workflowSvc.ImportDatabase(ctx, db, source)
+	} else {
+		t, err = s.workflowSvc.CreateDatabase(ctx, db)
+	}
 	if err != nil {
 		return nil, apiErr(err)
 	}
@@ -393,6 +579,11 @@ func (s *PostInitHandlers) UpdateDatabase(ctx context.Context, req *api.UpdateDa
 	if err := validateDatabaseUpdate(existing.Spec, req.Request.Spec); err != nil {
 		return nil, makeInvalidInputErr(err)
 	}
+	if existing.Importing {
+		if err := database.ValidateImportingSpec(spec); err != nil {
+			return nil, makeInvalidInputErr(err)
+		}
+	}
 	// Copy optional fields from the previous spec to the current spec if they
 	// are unset.
 	spec.DefaultOptionalFieldsFrom(existing.Spec)
@@ -402,6 +593,16 @@ func (s *PostInitHandlers) UpdateDatabase(ctx context.Context, req *api.UpdateDa
 		return nil, makeInvalidInputErr(fmt.Errorf("failed to validate database spec: %w", err))
 	}
 
+	// The settings changes are informational, so we don't want to fail the
+	// update if we're unable to compute them.
+	confChanges, err := s.dbSvc.PostgreSQLConfChanges(ctx, existing.Spec, spec)
+	if err != nil {
+		s.logger.Warn().
+			Err(err).
+			Str("database_id", spec.DatabaseID).
+			Msg("failed to compute postgresql.conf changes")
+	}
+
 	input := &workflows.ValidateSpecInput{
 		DatabaseID:   spec.DatabaseID,
 		Spec:         spec,
@@ -431,8 +632,9 @@ func (s *PostInitHandlers) UpdateDatabase(ctx context.Context, req *api.UpdateDa
 	}
 
 	return &api.UpdateDatabaseResponse{
-		Database: databaseToAPI(db),
-		Task:     taskToAPI(t),
+		Database:              databaseToAPI(db),
+		Task:                  taskToAPI(t),
+		PostgresqlConfChanges: postgreSQLConfChangesToAPI(confChanges),
 	}, nil
 }
 
@@ -442,7 +644,17 @@ func (s *PostInitHandlers) ApplyUpgrade(ctx context.Context, req *api.ApplyUpgra
 		return nil, err
 	}
 
-	result, err := s.dbSvc.ApplyUpgrade(ctx, databaseID, req.Request.Image)
+	if req.Request.Mode == "pg_upgrade" {
+		return s.applyPgUpgrade(ctx, databaseID, req.Request)
+	}
+	if req.Request.PostgresVersion != nil {
+		return nil, makeInvalidInputErr(errors.New("postgres_version is only supported by the 'pg_upgrade' mode"))
+	}
+	if req.Request.Image == nil {
+		return nil, makeInvalidInputErr(errors.New("image is required for the 'minor' mode"))
+	}
+
+	result, err := s.dbSvc.ApplyUpgrade(ctx, databaseID, *req.Request.Image)
 	if err != nil {
 		return nil, apiErr(err)
 	}
@@ -461,6 +673,144 @@ func (s *PostInitHandlers) ApplyUpgrade(ctx context.Context, req *api.ApplyUpgra
 	}, nil
 }
 
+func (s *PostInitHandlers) applyPgUpgrade(ctx context.Context, databaseID string, req *api.ApplyUpgradeRequest) (*api.ApplyUpgradeResponse, error) {
+	if req.Image != nil {
+		return nil, makeInvalidInputErr(errors.New("image is not supported by the 'pg_upgrade' mode"))
+	}
+	if req.PostgresVersion == nil {
+		return nil, makeInvalidInputErr(errors.New("postgres_version is required for the 'pg_upgrade' mode"))
+	}
+
+	result, plan, err := s.dbSvc.ApplyPgUpgrade(ctx, databaseID, *req.PostgresVersion)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	t, err := s.workflowSvc.PgUpgrade(ctx, result.Database, plan)
+	if err != nil {
+		if rollbackErr := s.dbSvc.RollbackApplyUpgrade(ctx, result); rollbackErr != nil {
+			s.logger.Err(rollbackErr).Msg("failed to roll back upgrade after workflow trigger failure")
+		}
+		return nil, apiErr(err)
+	}
+
+	return &api.ApplyUpgradeResponse{
+		Database: databaseToAPI(result.Database),
+		Task:     taskToAPI(t),
+	}, nil
+}
+
+func (s *PostInitHandlers) UpgradeDatabaseMajor(ctx context.Context, req *api.UpgradeDatabaseMajorPayload) (*api.UpgradeDatabaseMajorResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !database.DatabaseStateModifiable(db.State) {
+		return nil, ErrDatabaseNotModifiable
+	}
+
+	hostIDs := make(map[string][]string, len(req.Request.Nodes))
+	for i, node := range req.Request.Nodes {
+		for j, h := range node.HostIds {
+			hostID, err := identToString(h, []string{"nodes", strconv.Itoa(i), "host_ids", strconv.Itoa(j)})
+			if err != nil {
+				return nil, makeInvalidInputErr(err)
+			}
+			hostIDs[node.Name] = append(hostIDs[node.Name], hostID)
+		}
+	}
+	plan, err := database.NewMajorUpgradePlan(db.Spec, req.Request.PostgresVersion, hostIDs)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	// Validate the spec with every replacement node added so that problems
+	// with later nodes are caught before the upgrade starts.
+	spec := db.Spec
+	for _, node := range plan.Nodes {
+		spec, err = plan.AddReplacement(spec, node)
+		if err != nil {
+			return nil, apiErr(err)
+		}
+	}
+	output, err := s.workflowSvc.ValidateSpec(ctx, &workflows.ValidateSpecInput{
+		DatabaseID:   databaseID,
+		Spec:         spec,
+		PreviousSpec: db.Spec,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !output.Valid {
+		return nil, makeInvalidInputErr(errors.New(strings.Join(output.Errors, "\n")))
+	}
+
+	prevState := db.State
+	err = s.dbSvc.UpdateDatabaseState(ctx, databaseID, prevState, database.DatabaseStateModifying)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	db.State = database.DatabaseStateModifying
+
+	t, err := s.workflowSvc.UpgradeMajor(ctx, db, plan, utils.FromPointer(req.Request.PauseBetweenNodes))
+	if err != nil {
+		restorationErr := s.dbSvc.UpdateDatabaseState(ctx, databaseID, database.DatabaseStateModifying, prevState)
+		if restorationErr != nil {
+			s.logger.Err(restorationErr).Msg("failed to roll back database state change")
+		}
+		return nil, apiErr(err)
+	}
+
+	return &api.UpgradeDatabaseMajorResponse{
+		Database: databaseToAPI(db),
+		Task:     taskToAPI(t),
+	}, nil
+}
+
+func (s *PostInitHandlers) CutoverDatabaseImport(ctx context.Context, req *api.CutoverDatabaseImportPayload) (*api.CutoverDatabaseImportResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !database.DatabaseStateModifiable(db.State) {
+		return nil, ErrDatabaseNotModifiable
+	}
+	if len(db.Spec.Nodes) != 1 {
+		return nil, makeInvalidInputErr(errors.New("imports require a database with exactly one node"))
+	}
+
+	prevState := db.State
+	err = s.dbSvc.UpdateDatabaseState(ctx, databaseID, prevState, database.DatabaseStateModifying)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	db.State = database.DatabaseStateModifying
+
+	t, err := s.workflowSvc.CutoverImport(ctx, db)
+	if err != nil {
+		restorationErr := s.dbSvc.UpdateDatabaseState(ctx, databaseID, database.DatabaseStateModifying, prevState)
+		if restorationErr != nil {
+			s.logger.Err(restorationErr).Msg("failed to roll back database state change")
+		}
+		return nil, apiErr(err)
+	}
+
+	return &api.CutoverDatabaseImportResponse{
+		Database: databaseToAPI(db),
+		Task:     taskToAPI(t),
+	}, nil
+}
+
 func (s *PostInitHandlers) DeleteDatabase(ctx context.Context, req *api.DeleteDatabasePayload) (*api.DeleteDatabaseResponse, error) {
 	databaseID, err := dbIdentToString(req.DatabaseID)
 	if err != nil {
@@ -663,6 +1013,79 @@ func (s *PostInitHandlers) SwitchoverDatabaseNode(ctx context.Context, req *api.
 	}, nil
 }
 
+func (s *PostInitHandlers) RollingRestartDatabase(ctx context.Context, req *api.RollingRestartDatabasePayload) (*api.RollingRestartDatabaseResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	request := req.Request
+	if request == nil {
+		request = &api.RollingRestartDatabaseRequest{Concurrency: 1}
+	}
+
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !database.DatabaseStateModifiable(db.State) {
+		return nil, ErrDatabaseNotModifiable
+	}
+
+	input := &workflows.RollingRestartInput{
+		DatabaseID:  databaseID,
+		Concurrency: request.Concurrency,
+		SwitchBack:  request.SwitchBack,
+	}
+	if request.MaintenanceWindow != nil {
+		window := &database.MaintenanceWindow{
+			Start: request.MaintenanceWindow.Start,
+			End:   request.MaintenanceWindow.End,
+		}
+		if err := window.Validate(); err != nil {
+			return nil, makeInvalidInputErr(err)
+		}
+		input.MaintenanceWindow = window
+	}
+
+	input.Nodes, err = workflows.PlanRollingRestart(db, request.AllInstances)
+	if err != nil {
+		return nil, makeInvalidInputErr(err)
+	}
+	if len(input.Nodes) == 0 {
+		return nil, makeInvalidInputErr(errors.New("no instances are waiting for a restart"))
+	}
+
+	activeTasks, err := s.taskSvc.GetTasks(ctx, task.ScopeDatabase, databaseID, task.TaskListOptions{
+		Type: task.TypeRollingRestart,
+		Statuses: []task.Status{
+			task.StatusPending,
+			task.StatusRunning,
+			task.StatusCanceling,
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if len(activeTasks) > 0 {
+		return nil, ErrOperationAlreadyInProgress
+	}
+
+	t, err := s.workflowSvc.RollingRestart(ctx, input)
+	if err != nil {
+		return nil, apiErr(fmt.Errorf("failed to start rolling restart workflow: %w", err))
+	}
+
+	s.logger.Info().
+		Str("database_id", databaseID).
+		Str("task_id", t.TaskID.String()).
+		Msg("rolling restart workflow initiated")
+
+	return &api.RollingRestartDatabaseResponse{
+		Task: taskToAPI(t),
+	}, nil
+}
+
 func (s *PostInitHandlers) FailoverDatabaseNode(ctx context.Context, req *api.FailoverDatabaseNodeRequest) (*api.FailoverDatabaseNodeResponse, error) {
 
 	databaseID, err := dbIdentToString(req.DatabaseID)
@@ -819,6 +1242,84 @@ func (s *PostInitHandlers) GetDatabaseTaskLog(ctx context.Context, req *api.GetD
 	return taskLogToAPI(log, t.Status), nil
 }
 
+func (s *PostInitHandlers) StreamEvents(ctx context.Context, req *api.StreamEventsPayload, stream api.StreamEventsServerStream) error {
+	filter, err := eventFilter(req)
+	if err != nil {
+		return makeInvalidInputErr(err)
+	}
+
+	err = s.eventsSvc.Subscribe(ctx, filter, func(e *events.Event) error {
+		return stream.SendWithContext(ctx, eventToAPI(e))
+	})
+	if err != nil {
+		return apiErr(err)
+	}
+
+	return nil
+}
+
+func (s *PostInitHandlers) CreateWebhook(ctx context.Context, req *api.CreateWebhookPayload) (*api.Webhook, error) {
+	w, err := apiToWebhook(req.Request)
+	if err != nil {
+		return nil, makeInvalidInputErr(err)
+	}
+	created, err := s.webhookSvc.CreateWebhook(ctx, w)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return webhookToAPI(created), nil
+}
+
+func (s *PostInitHandlers) ListWebhooks(ctx context.Context) (*api.ListWebhooksResponse, error) {
+	webhooks, err := s.webhookSvc.ListWebhooks(ctx)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	res := &api.ListWebhooksResponse{
+		Webhooks: make([]*api.Webhook, len(webhooks)),
+	}
+	for idx, w := range webhooks {
+		res.Webhooks[idx] = webhookToAPI(w)
+	}
+
+	return res, nil
+}
+
+func (s *PostInitHandlers) GetWebhook(ctx context.Context, req *api.GetWebhookPayload) (*api.Webhook, error) {
+	w, err := s.webhookSvc.GetWebhook(ctx, req.WebhookID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return webhookToAPI(w), nil
+}
+
+func (s *PostInitHandlers) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookPayload) error {
+	if err := s.webhookSvc.DeleteWebhook(ctx, req.WebhookID); err != nil {
+		return apiErr(err)
+	}
+
+	return nil
+}
+
+func (s *PostInitHandlers) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesPayload) (*api.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.webhookSvc.ListDeliveries(ctx, req.WebhookID, req.Limit)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	res := &api.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*api.WebhookDelivery, len(deliveries)),
+	}
+	for idx, d := range deliveries {
+		res.Deliveries[idx] = webhookDeliveryToAPI(d)
+	}
+
+	return res, nil
+}
+
 func (s *PostInitHandlers) RestoreDatabase(ctx context.Context, req *api.RestoreDatabasePayload) (res *api.RestoreDatabaseResponse, err error) {
 	databaseID, err := dbIdentToString(req.DatabaseID)
 	if err != nil {
@@ -882,6 +1383,88 @@ func (s *PostInitHandlers) RestoreDatabase(ctx context.Context, req *api.Restore
 	}, nil
 }
 
+func (s *PostInitHandlers) CloneDatabase(ctx context.Context, req *api.CloneDatabasePayload) (*api.CloneDatabaseResponse, error) {
+	sourceID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	databaseID, err := identToString(req.Request.ID, []string{"id"})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.dbSvc.GetDatabase(ctx, sourceID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	opts := &database.CloneOptions{
+		DatabaseID:      databaseID,
+		TenantID:        (*string)(req.Request.TenantID),
+		SourceNode:      utils.FromPointer(req.Request.SourceNode),
+		Method:          database.CloneMethod(req.Request.Method),
+		DatabaseName:    utils.FromPointer(req.Request.DatabaseName),
+		RenameUsers:     req.Request.RenameUsers,
+		ScrubStatements: req.Request.ScrubScript,
+	}
+	for _, hostID := range req.Request.HostIds {
+		opts.HostIDs = append(opts.HostIDs, string(hostID))
+	}
+	if req.Request.TargetTime != nil {
+		targetTime, err := time.Parse(time.RFC3339, *req.Request.TargetTime)
+		if err != nil {
+			return nil, makeInvalidInputErr(fmt.Errorf("invalid target_time: %w", err))
+		}
+		opts.TargetTime = &targetTime
+	}
+
+	spec, err := database.CloneSpec(source.Spec, opts)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if opts.Method == database.CloneMethodBaseBackup {
+		if _, err := IsNodeAvailable(source, spec.RestoreConfig.SourceNodeName); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.dbSvc.PopulateSpecDefaults(ctx, spec)
+	if err != nil {
+		return nil, makeInvalidInputErr(fmt.Errorf("failed to validate database spec: %w", err))
+	}
+
+	output, err := s.workflowSvc.ValidateSpec(ctx, &workflows.ValidateSpecInput{
+		DatabaseID: spec.DatabaseID,
+		Spec:       spec,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !output.Valid {
+		return nil, makeInvalidInputErr(errors.New(strings.Join(output.Errors, "\n")))
+	}
+
+	db, err := s.dbSvc.CreateDatabase(ctx, spec)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	t, backupTask, err := s.workflowSvc.CloneDatabase(ctx, db, source, opts.Method == database.CloneMethodBaseBackup)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	res := &api.CloneDatabaseResponse{
+		Database: databaseToAPI(db),
+		Task:     taskToAPI(t),
+	}
+	if backupTask != nil {
+		res.BackupTask = taskToAPI(backupTask)
+	}
+
+	return res, nil
+}
+
 func (s *PostInitHandlers) GetVersion(context.Context) (res *api.VersionInfo, err error) {
 	info, err := version.GetInfo()
 	if err != nil {
@@ -917,6 +1500,14 @@ func (s *PostInitHandlers) RestartInstance(ctx context.Context, req *api.Restart
 		return nil, makeInvalidInputErr(fmt.Errorf("instance %s is not restartable, it is in %s state",
 			req.InstanceID, storedInstance.State))
 	}
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if slices.Contains(db.PatroniDCSSwitchNodes, storedInstance.NodeName) {
+		return nil, makeInvalidInputErr(fmt.Errorf("node %s is switching to a different patroni_dcs. update the database to complete the switch before restarting its instances",
+			storedInstance.NodeName))
+	}
 	input := &workflows.RestartInstanceInput{
 		HostID:     storedInstance.HostID,
 		DatabaseID: databaseID,
@@ -945,6 +1536,197 @@ func (s *PostInitHandlers) RestartInstance(ctx context.Context, req *api.Restart
 	return &api.RestartInstanceResponse{Task: taskToAPI(t)}, nil
 }
 
+func (s *PostInitHandlers) GetInstancePostgresqlConf(ctx context.Context, req *api.GetInstancePostgresqlConfPayload) (*api.GetInstancePostgreSQLConfResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := s.dbSvc.GetInstancePostgreSQLConf(ctx, databaseID, req.InstanceID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return &api.GetInstancePostgreSQLConfResponse{
+		Settings: postgreSQLSettingsToAPI(conf.Settings()),
+	}, nil
+}
+
+func (s *PostInitHandlers) GetInstanceSessions(ctx context.Context, req *api.GetInstanceSessionsPayload) (*api.GetInstanceSessionsResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, instance, err := s.getAvailableInstance(ctx, databaseID, req.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := s.workflowSvc.GetInstanceSessions(ctx, &workflows.GetInstanceSessionsInput{
+		DatabaseID:   databaseID,
+		DatabaseName: db.Spec.DatabaseName,
+		InstanceID:   instance.InstanceID,
+		HostID:       instance.HostID,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	chains := database.BlockingChains(output.Sessions)
+	if chains == nil {
+		chains = [][]int{}
+	}
+
+	return &api.GetInstanceSessionsResponse{
+		Sessions:       sessionsToAPI(output.Sessions),
+		BlockingChains: chains,
+	}, nil
+}
+
+func (s *PostInitHandlers) CancelInstanceSession(ctx context.Context, req *api.CancelInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	return s.signalInstanceSession(ctx, req.DatabaseID, req.InstanceID, req.Pid, database.SessionActionCancel)
+}
+
+func (s *PostInitHandlers) TerminateInstanceSession(ctx context.Context, req *api.TerminateInstanceSessionPayload) (*api.SignalInstanceSessionResponse, error) {
+	return s.signalInstanceSession(ctx, req.DatabaseID, req.InstanceID, req.Pid, database.SessionActionTerminate)
+}
+
+func (s *PostInitHandlers) signalInstanceSession(
+	ctx context.Context,
+	dbIdent api.Identifier,
+	instanceID string,
+	pid int,
+	action database.SessionAction,
+) (*api.SignalInstanceSessionResponse, error) {
+	databaseID, err := dbIdentToString(dbIdent)
+	if err != nil {
+		return nil, err
+	}
+
+	db, instance, err := s.getAvailableInstance(ctx, databaseID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.workflowSvc.SignalSession(ctx, &workflows.SignalSessionInput{
+		DatabaseID:   databaseID,
+		DatabaseName: db.Spec.DatabaseName,
+		InstanceID:   instance.InstanceID,
+		HostID:       instance.HostID,
+		PID:          pid,
+		Action:       action,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	s.logger.Info().
+		Str("database_id", databaseID).
+		Str("instance_id", instanceID).
+		Int("pid", pid).
+		Str("action", string(action)).
+		Str("task_id", t.TaskID.String()).
+		Msg("signal session workflow initiated")
+
+	return &api.SignalInstanceSessionResponse{Task: taskToAPI(t)}, nil
+}
+
+// getAvailableInstance returns the given instance and its database. It returns
+// an invalid input error if the instance is not available.
+func (s *PostInitHandlers) getAvailableInstance(ctx context.Context, databaseID, instanceID string) (*database.Database, *database.Instance, error) {
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, nil, apiErr(err)
+	}
+	idx := slices.IndexFunc(db.Instances, func(i *database.Instance) bool {
+		return i.InstanceID == instanceID
+	})
+	if idx < 0 {
+		return nil, nil, apiErr(database.ErrInstanceNotFound)
+	}
+	instance := db.Instances[idx]
+	if instance.State != database.InstanceStateAvailable {
+		return nil, nil, makeInvalidInputErr(fmt.Errorf("instance %s is not available, it is in %s state",
+			instanceID, instance.State))
+	}
+
+	return db, instance, nil
+}
+
+func (s *PostInitHandlers) GetNodeQueryStats(ctx context.Context, req *api.GetNodeQueryStatsPayload) (*api.GetNodeQueryStatsResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if _, err := db.Spec.Node(req.NodeName); err != nil {
+		return nil, apiErr(err)
+	}
+	sortBy := database.QueryStatsSortBy(req.SortBy)
+	if err := sortBy.Validate(); err != nil {
+		return nil, makeInvalidInputErr(err)
+	}
+
+	summaries, err := s.dbSvc.GetNodeQueryStats(ctx, databaseID, req.NodeName, sortBy, utils.FromPointer(req.Limit))
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return &api.GetNodeQueryStatsResponse{
+		Instances: queryStatsSummariesToAPI(summaries),
+	}, nil
+}
+
+func (s *PostInitHandlers) ResetNodeQueryStats(ctx context.Context, req *api.ResetNodeQueryStatsPayload) (*api.ResetNodeQueryStatsResponse, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := s.dbSvc.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if _, err := db.Spec.Node(req.NodeName); err != nil {
+		return nil, apiErr(err)
+	}
+	if !db.Spec.QueryStats.IsEnabled() {
+		return nil, makeInvalidInputErr(errors.New("query stats are not enabled for this database"))
+	}
+
+	var instances []*activities.InstanceHost
+	for _, instance := range db.Instances {
+		if instance.NodeName != req.NodeName || instance.State != database.InstanceStateAvailable {
+			continue
+		}
+		instances = append(instances, &activities.InstanceHost{
+			InstanceID: instance.InstanceID,
+			HostID:     instance.HostID,
+		})
+	}
+	if len(instances) == 0 {
+		return nil, makeInvalidInputErr(fmt.Errorf("node %s has no available instances", req.NodeName))
+	}
+
+	output, err := s.workflowSvc.ResetQueryStats(ctx, &workflows.ResetQueryStatsInput{
+		DatabaseID:   databaseID,
+		DatabaseName: db.Spec.DatabaseName,
+		Instances:    instances,
+	})
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return &api.ResetNodeQueryStatsResponse{
+		InstanceIds: output.InstanceIDs,
+	}, nil
+}
+
 func (s *PostInitHandlers) StopInstance(ctx context.Context, req *api.StopInstancePayload) (*api.StopInstanceResponse, error) {
 	if req == nil {
 		return nil, makeInvalidInputErr(errors.New("request cannot be nil"))
@@ -1085,6 +1867,29 @@ func (s *PostInitHandlers) CancelDatabaseTask(ctx context.Context, req *api.Canc
 	return taskToAPI(t), nil
 }
 
+func (s *PostInitHandlers) ResumeDatabaseTask(ctx context.Context, req *api.ResumeDatabaseTaskPayload) (*api.Task, error) {
+	databaseID, err := dbIdentToString(req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := uuid.Parse(string(req.TaskID))
+	if err != nil {
+		return nil, ErrInvalidTaskID
+	}
+
+	t, err := s.workflowSvc.ResumeDatabaseTask(ctx, databaseID, taskID)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	s.logger.Info().
+		Str("database_id", databaseID).
+		Str("task_id", taskID.String()).
+		Msg("task resume requested")
+
+	return taskToAPI(t), nil
+}
+
 func IsNodeAvailable(db *database.Database, nodeName string) (bool, error) {
 	for _, instance := range db.Instances {
 		if instance.NodeName != nodeName {
@@ -20,6 +20,8 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/cluster"
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
 	"github.com/pgEdge/control-plane/server/internal/version"
 )
 
@@ -177,11 +179,58 @@ func (s *PreInitHandlers) ServiceDescription(ctx context.Context) (string, error
 	return "", ErrUninitialized
 }
 
+// GetCluster reports the upgrade status when this host belongs to a cluster
+// but hasn't finished starting up, such as while it waits for the other hosts
+// to be upgraded. The cluster's hosts aren't listed until startup completes.
 func (s *PreInitHandlers) GetCluster(ctx context.Context) (*api.Cluster, error) {
+	initialized, err := s.etcd.IsInitialized()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	if !initialized {
+		return nil, ErrUninitialized
+	}
+	etcdClient, err := s.etcd.GetClient()
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	storedCluster, err := cluster.NewStore(etcdClient, s.cfg.EtcdKeyRoot).Cluster.
+		Get().
+		Exec(ctx)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+	upgrade, err := migrate.GetUpgradeStatus(
+		ctx,
+		migrate.NewStore(etcdClient, s.cfg.EtcdKeyRoot),
+		host.NewStore(etcdClient, s.cfg.EtcdKeyRoot),
+	)
+	if err != nil {
+		return nil, apiErr(err)
+	}
+
+	return &api.Cluster{
+		ID:      api.Identifier(storedCluster.ID),
+		Hosts:   []*api.Host{},
+		Status:  &api.ClusterStatus{State: "starting"},
+		Upgrade: upgradeStatusToAPI(upgrade),
+	}, nil
+}
+
+func (s *PreInitHandlers) GetStorageUsage(ctx context.Context, req *api.GetStorageUsagePayload) (*api.StorageUsage, error) {
 	return nil, ErrUninitialized
 }
 
-func (s *PreInitHandlers) ListHosts(ctx context.Context) (*api.ListHostsResponse, error) {
+func (s *PreInitHandlers) GetEtcdCluster(ctx context.Context) (*api.EtcdCluster, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) DefragmentEtcd(ctx context.Context, req *api.DefragmentEtcdRequest) (*api.DefragmentEtcdResponse, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) ListHosts(ctx context.Context, req *api.ListHostsPayload) (*api.ListHostsResponse, error) {
 	return nil, ErrUninitialized
 }
 
@@ -189,6 +238,10 @@ func (s *PreInitHandlers) GetHost(ctx context.Context, req *api.GetHostPayload)
 	return nil, ErrUninitialized
 }
 
+func (s *PreInitHandlers) ChangeHostEtcdMode(ctx context.Context, req *api.ChangeHostEtcdModePayload) (*api.ChangeHostEtcdModeResponse, error) {
+	return nil, ErrUninitialized
+}
+
 func (s *PreInitHandlers) RemoveHost(ctx context.Context, req *api.RemoveHostPayload) (*api.RemoveHostResponse, error) {
 	return nil, ErrUninitialized
 }
@@ -217,6 +270,10 @@ func (s *PreInitHandlers) BackupDatabaseNode(ctx context.Context, req *api.Backu
 	return nil, ErrUninitialized
 }
 
+func (s *PreInitHandlers) RollingRestartDatabase(ctx context.Context, req *api.RollingRestartDatabasePayload) (*api.RollingRestartDatabaseResponse, error) {
+	return nil, ErrUninitialized
+}
+
 func (s *PreInitHandlers) SwitchoverDatabaseNode(ctx context.Context, req *api.SwitchoverDatabaseNodePayload) (*api.SwitchoverDatabaseNodeResponse, error) {
 	return nil, ErrUninitialized
 }
@@ -237,6 +294,30 @@ func (s *PreInitHandlers) GetDatabaseTaskLog(ctx context.Context, req *api.GetDa
 	return nil, ErrUninitialized
 }
 
+func (s *PreInitHandlers) StreamEvents(ctx context.Context, req *api.StreamEventsPayload, stream api.StreamEventsServerStream) error {
+	return ErrUninitialized
+}
+
+func (s *PreInitHandlers) CreateWebhook(ctx context.Context, req *api.CreateWebhookPayload) (*api.Webhook, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) ListWebhooks(ctx context.Context) (*api.ListWebhooksResponse, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) GetWebhook(ctx context.Context, req *api.GetWebhookPayload) (*api.Webhook, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookPayload) error {
+	return ErrUninitialized
+}
+
+func (s *PreInitHandlers) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesPayload) (*api.ListWebhookDeliveriesResponse, error) {
+	return nil, ErrUninitialized
+}
+
 func (s *PreInitHandlers) RestoreDatabase(ctx context.Context, req *api.RestoreDatabasePayload) (res *api.RestoreDatabaseResponse, err error) {
 	return nil, ErrUninitialized
 }
@@ -245,6 +326,30 @@ func (s *PreInitHandlers) RestartInstance(ctx context.Context, req *api.RestartI
 	return nil, ErrUninitialized
 }
 
+func (s *PreInitHandlers) GetNodeQueryStats(ctx context.Context, req *api.GetNodeQueryStatsPayload) (res *api.GetNodeQueryStatsResponse, err error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) ResetNodeQueryStats(ctx context.Context, req *api.ResetNodeQueryStatsPayload) (res *api.ResetNodeQueryStatsResponse, err error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) GetInstanceSessions(ctx context.Context, req *api.GetInstanceSessionsPayload) (res *api.GetInstanceSessionsResponse, err error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) CancelInstanceSession(ctx context.Context, req *api.CancelInstanceSessionPayload) (res *api.SignalInstanceSessionResponse, err error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) TerminateInstanceSession(ctx context.Context, req *api.TerminateInstanceSessionPayload) (res *api.SignalInstanceSessionResponse, err error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) GetInstancePostgresqlConf(ctx context.Context, req *api.GetInstancePostgresqlConfPayload) (res *api.GetInstancePostgreSQLConfResponse, err error) {
+	return nil, ErrUninitialized
+}
+
 func (s *PreInitHandlers) StopInstance(ctx context.Context, req *api.StopInstancePayload) (res *api.StopInstanceResponse, err error) {
 	return nil, ErrUninitialized
 }
@@ -257,6 +362,22 @@ func (s *PreInitHandlers) ApplyUpgrade(ctx context.Context, req *api.ApplyUpgrad
 	return nil, ErrUninitialized
 }
 
+func (s *PreInitHandlers) CloneDatabase(ctx context.Context, req *api.CloneDatabasePayload) (*api.CloneDatabaseResponse, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) CutoverDatabaseImport(ctx context.Context, req *api.CutoverDatabaseImportPayload) (*api.CutoverDatabaseImportResponse, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) UpgradeDatabaseMajor(ctx context.Context, req *api.UpgradeDatabaseMajorPayload) (*api.UpgradeDatabaseMajorResponse, error) {
+	return nil, ErrUninitialized
+}
+
+func (s *PreInitHandlers) ResumeDatabaseTask(ctx context.Context, req *api.ResumeDatabaseTaskPayload) (*api.Task, error) {
+	return nil, ErrUninitialized
+}
+
 func (s *PreInitHandlers) CancelDatabaseTask(ctx context.Context, req *api.CancelDatabaseTaskPayload) (*api.Task, error) {
 	return nil, ErrUninitialized
 }
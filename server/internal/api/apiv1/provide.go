@@ -10,8 +10,11 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/database"
 	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/events"
 	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
 	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 	"github.com/pgEdge/control-plane/server/internal/workflows"
 )
 
@@ -70,7 +73,20 @@ func providePostInitHandlers(i *do.Injector) {
 			return nil, fmt.Errorf("failed to get cluster service: %w", err)
 		}
 
-		return NewPostInitHandlers(cfg, logger, e, hostSvc, dbSvc, taskSvc, workflowSvc, clusterSvc), nil
+		eventsSvc, err := do.Invoke[*events.Service](i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get events service: %w", err)
+		}
+		webhookSvc, err := do.Invoke[*webhook.Service](i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get webhook service: %w", err)
+		}
+		compat, err := do.Invoke[*migrate.Compatibility](i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get compatibility: %w", err)
+		}
+
+		return NewPostInitHandlers(cfg, logger, e, hostSvc, dbSvc, taskSvc, workflowSvc, clusterSvc, eventsSvc, webhookSvc, compat), nil
 	})
 }
 
@@ -171,6 +171,7 @@ func validateDatabaseSpec(orchestrator config.Orchestrator, databaseID string, s
 
 	// Validate orchestrator_opts (spec-level)
 	errs = append(errs, validateOrchestratorOpts(spec.OrchestratorOpts, validation.NewPath("orchestrator_opts"))...)
+	errs = append(errs, validatePatroniSettings(apiToPatroniSettings(spec.Patroni), validation.NewPath("patroni"))...)
 
 	servicesPath := validation.NewPath("services")
 
@@ -340,6 +341,14 @@ func validateNode(
 	// Validate orchestrator_opts (per-node)
 	errs = append(errs, validateOrchestratorOpts(node.OrchestratorOpts, path.Append("orchestrator_opts"))...)
 
+	// Node-level Patroni settings are validated after they're merged with the
+	// database-level settings, because the timing settings depend on each
+	// other.
+	if node.Patroni != nil {
+		settings := apiToPatroniSettings(db.Patroni).Merge(apiToPatroniSettings(node.Patroni))
+		errs = append(errs, validatePatroniSettings(settings, path.Append("patroni"))...)
+	}
+
 	return errs
 }
 
@@ -518,6 +527,13 @@ func validateRAGServiceConfig(config map[string]any, path validation.Path, isUpd
 	return result
 }
 
+func validatePatroniSettings(settings *database.PatroniSettings, path validation.Path) []error {
+	if err := settings.Validate(); err != nil {
+		return []error{validation.NewError(err, path)}
+	}
+	return nil
+}
+
 func validateCPUs(value *string, path validation.Path) []error {
 	var errs []error
 
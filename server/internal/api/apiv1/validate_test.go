@@ -674,6 +674,27 @@ func TestValidateNode(t *testing.T) {
 				`"spock" must be included in shared_preload_libraries`,
 			},
 		},
+		{
+			name:         "invalid merged patroni settings",
+			orchestrator: config.OrchestratorSwarm,
+			db: &api.DatabaseSpec{
+				Patroni: &api.PatroniSettings{
+					LoopWait:     utils.PointerTo(10),
+					RetryTimeout: utils.PointerTo(10),
+				},
+			},
+			node: &api.DatabaseNodeSpec{
+				HostIds: []api.Identifier{
+					api.Identifier("host-1"),
+				},
+				Patroni: &api.PatroniSettings{
+					TTL: utils.PointerTo(25),
+				},
+			},
+			expected: []string{
+				"patroni: loop_wait + 2*retry_timeout must not exceed ttl",
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := errors.Join(validateNode(tc.orchestrator, tc.db, tc.node, nil)...)
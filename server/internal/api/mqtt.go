@@ -2,58 +2,165 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
 
 	"github.com/pgEdge/control-plane/mqtt"
+	"github.com/pgEdge/control-plane/server/internal/certificates"
 	"github.com/pgEdge/control-plane/server/internal/config"
-	"github.com/rs/zerolog"
 )
 
 type mqttServer struct {
-	cfg    config.MQTT
-	server *mqtt.HTTPServer
-	logger zerolog.Logger
-	errCh  chan error
+	cfg     config.MQTT
+	hostID  string
+	i       *do.Injector
+	handler http.Handler
+	server  *mqtt.HTTPServer
+	logger  zerolog.Logger
+	errCh   chan error
 }
 
 func newMQTTServer(
 	cfg config.MQTT,
+	hostID string,
+	i *do.Injector,
 	handler http.Handler,
 	logger zerolog.Logger,
 ) *mqttServer {
-	server := mqtt.NewHTTPServer(mqtt.HTTPServerConfig{
-		Topic:   cfg.Topic,
-		Logger:  &logger,
-		Handler: handler,
-		Broker: mqtt.BrokerConfig{
-			URL:      cfg.BrokerURL,
-			ClientID: cfg.ClientID,
-			Username: cfg.Username,
-			Password: cfg.Password,
-		},
-	})
+	if cfg.TopicPrefix != "" {
+		handler = tenantHandler(cfg.TopicPrefix, i, handler)
+	}
 	return &mqttServer{
-		cfg:    cfg,
-		server: server,
-		logger: logger,
-		errCh:  make(chan error, 1),
+		cfg:     cfg,
+		hostID:  hostID,
+		i:       i,
+		handler: handler,
+		logger:  logger,
+		errCh:   make(chan error, 1),
 	}
 }
 
+// requiresCluster returns true if the server can't connect to the broker until
+// the cluster is initialized.
+func (s *mqttServer) requiresCluster() bool {
+	return s.cfg.UseClusterCertificates
+}
+
+func (s *mqttServer) topics() []string {
+	var topics []string
+	if s.cfg.TopicPrefix != "" {
+		topics = append(topics,
+			mqtt.HostAPITopic(s.cfg.TopicPrefix, s.hostID),
+			mqtt.TenantAPITopicFilter(s.cfg.TopicPrefix, s.hostID),
+		)
+	}
+	return topics
+}
+
 func (s *mqttServer) start(ctx context.Context) {
 	s.logger.Info().
 		Str("broker_url", s.cfg.BrokerURL).
+		Strs("topics", s.topics()).
 		Msg("starting mqtt server")
 
+	tlsConfig, err := s.tlsConfig(ctx)
+	if err != nil {
+		s.errCh <- fmt.Errorf("error while starting mqtt server: %w", err)
+		return
+	}
+	s.server = mqtt.NewHTTPServer(mqtt.HTTPServerConfig{
+		Topic:   s.cfg.Topic,
+		Topics:  s.topics(),
+		Logger:  &s.logger,
+		Handler: s.handler,
+		Broker: mqtt.BrokerConfig{
+			URL:       s.cfg.BrokerURL,
+			ClientID:  s.cfg.ClientID,
+			Username:  s.cfg.Username,
+			Password:  s.cfg.Password,
+			TLSConfig: tlsConfig,
+		},
+	})
 	if err := s.server.Start(ctx); err != nil {
 		s.errCh <- fmt.Errorf("error while starting mqtt server: %w", err)
 	}
 }
 
 func (s *mqttServer) stop(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
 	if err := s.server.Stop(ctx); err != nil {
 		return fmt.Errorf("error while stopping mqtt server: %w", err)
 	}
 	return nil
 }
+
+func (s *mqttServer) publish(ctx context.Context, msg *mqtt.Message) error {
+	if s.server == nil {
+		return errors.New("mqtt server is not started")
+	}
+	return s.server.Publish(ctx, msg)
+}
+
+// tlsConfig returns the TLS config for the broker connection, or nil to use
+// the defaults.
+func (s *mqttServer) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	if s.cfg.CAFile == "" && !s.cfg.UseClusterCertificates {
+		return nil, nil
+	}
+
+	var rootCAs *x509.CertPool
+	if s.cfg.CAFile != "" {
+		caCert, err := os.ReadFile(s.cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read broker CA file: %w", err)
+		}
+		rootCAs = x509.NewCertPool()
+		if ok := rootCAs.AppendCertsFromPEM(caCert); !ok {
+			return nil, errors.New("failed to use broker CA file")
+		}
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	if !s.cfg.UseClusterCertificates {
+		return tlsConfig, nil
+	}
+
+	certSvc, err := do.Invoke[*certificates.Service](s.i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate service: %w", err)
+	}
+	principal, err := certSvc.HostMQTTClient(ctx, s.hostID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mqtt client certificate: %w", err)
+	}
+	clientCert, err := tls.X509KeyPair(principal.CertPEM, principal.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mqtt client certificate: %w", err)
+	}
+	tlsConfig.Certificates = []tls.Certificate{clientCert}
+	if rootCAs == nil {
+		// Trust brokers with certificates from the cluster's CA in addition
+		// to the system's certificates.
+		rootCAs, err = x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if ok := rootCAs.AppendCertsFromPEM(certSvc.CACert()); !ok {
+			return nil, errors.New("failed to use cluster CA cert")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	return tlsConfig, nil
+}
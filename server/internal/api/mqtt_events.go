@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pgEdge/control-plane/mqtt"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/events"
+	"github.com/pgEdge/control-plane/server/internal/monitor"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
+)
+
+const mqttEventsElectionName election.Name = "mqtt_events"
+const mqttEventsElectionTTL time.Duration = 30 * time.Second
+
+// mqttEventsCheckInterval is how often the publisher checks whether it holds
+// the election.
+const mqttEventsCheckInterval = 5 * time.Second
+
+// mqttEvent is the payload of each event that's published to MQTT. Exactly one
+// of Database or Task is set, depending on the event's Kind.
+type mqttEvent struct {
+	Kind       events.Kind `json:"kind"`
+	Timestamp  time.Time   `json:"timestamp"`
+	DatabaseID string      `json:"database_id"`
+	TenantID   string      `json:"tenant_id,omitempty"`
+	HostID     string      `json:"host_id,omitempty"`
+
+	Database *webhook.DatabaseEvent `json:"database,omitempty"`
+	Task     *webhook.TaskEvent     `json:"task,omitempty"`
+}
+
+// mqttEventPublisher publishes database state changes and task status changes
+// to each database's event topics. Like webhooks, events are only published
+// while this server holds the election so that each event is published once.
+// Changes that happen while there's no leader are not published.
+type mqttEventPublisher struct {
+	logger    zerolog.Logger
+	prefix    string
+	publish   func(ctx context.Context, msg *mqtt.Message) error
+	eventsSvc *events.Service
+	dbSvc     *database.Service
+	candidate *election.Candidate
+	monitor   *monitor.Monitor
+
+	mu    sync.Mutex
+	watch *mqttEventWatch
+}
+
+type mqttEventWatch struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newMQTTEventPublisher(
+	logger zerolog.Logger,
+	prefix string,
+	publish func(ctx context.Context, msg *mqtt.Message) error,
+	eventsSvc *events.Service,
+	dbSvc *database.Service,
+	candidate *election.Candidate,
+) *mqttEventPublisher {
+	return &mqttEventPublisher{
+		logger:    logger,
+		prefix:    prefix,
+		publish:   publish,
+		eventsSvc: eventsSvc,
+		dbSvc:     dbSvc,
+		candidate: candidate,
+	}
+}
+
+func (p *mqttEventPublisher) start(ctx context.Context) error {
+	if err := p.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	p.monitor = monitor.NewMonitor(p.logger, mqttEventsCheckInterval, p.check)
+	p.monitor.Start(ctx)
+
+	return nil
+}
+
+func (p *mqttEventPublisher) stop(ctx context.Context) error {
+	if p.monitor == nil {
+		return nil
+	}
+
+	p.monitor.Stop()
+	p.stopWatch()
+	if err := p.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (p *mqttEventPublisher) check(ctx context.Context) error {
+	if !p.candidate.IsLeader() {
+		p.stopWatch()
+		return nil
+	}
+	p.startWatch(ctx)
+
+	return nil
+}
+
+// startWatch subscribes to database and task events if there isn't already a
+// running subscription. A subscription that has failed is restarted on the
+// next check.
+func (p *mqttEventPublisher) startWatch(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.watch != nil {
+		select {
+		case <-p.watch.done:
+			p.watch = nil
+		default:
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	w := &mqttEventWatch{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	p.watch = w
+
+	go func() {
+		defer close(w.done)
+
+		// Task events don't include the database's tenant, so we remember
+		// the tenants of the databases that we've seen.
+		tenants := map[string]string{}
+		filter := events.Filter{
+			Kinds: []events.Kind{events.KindDatabase, events.KindTask},
+		}
+		err := p.eventsSvc.Subscribe(ctx, filter, func(e *events.Event) error {
+			p.handleEvent(ctx, tenants, e)
+			return nil
+		})
+		if err != nil {
+			p.logger.Err(err).Msg("mqtt event subscription failed")
+		}
+	}()
+}
+
+func (p *mqttEventPublisher) stopWatch() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.watch == nil {
+		return
+	}
+	p.watch.cancel()
+	<-p.watch.done
+	p.watch = nil
+}
+
+func (p *mqttEventPublisher) handleEvent(ctx context.Context, tenants map[string]string, e *events.Event) {
+	evt := mqttEventFromChange(e)
+	if evt == nil {
+		return
+	}
+	if e.Database != nil {
+		tenants[e.DatabaseID] = utils.FromPointer(e.Database.TenantID)
+	}
+	tenantID, ok := tenants[e.DatabaseID]
+	if !ok {
+		db, err := p.dbSvc.GetDatabase(ctx, e.DatabaseID)
+		switch {
+		case err == nil:
+			tenantID = utils.FromPointer(db.TenantID)
+			tenants[e.DatabaseID] = tenantID
+		case !errors.Is(err, database.ErrDatabaseNotFound):
+			p.logger.Err(err).
+				Str("database_id", e.DatabaseID).
+				Msg("failed to get database tenant")
+		}
+	}
+	evt.TenantID = tenantID
+
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		p.logger.Err(err).Msg("failed to marshal mqtt event")
+		return
+	}
+	topics := []string{mqtt.EventTopic(p.prefix, evt.DatabaseID, evt.Kind.String())}
+	if tenantID != "" {
+		topics = append(topics, mqtt.TenantEventTopic(p.prefix, tenantID, evt.DatabaseID, evt.Kind.String()))
+	}
+	for _, topic := range topics {
+		err := p.publish(ctx, &mqtt.Message{
+			Topic:   topic,
+			QoS:     1,
+			Payload: payload,
+		})
+		if err != nil {
+			p.logger.Err(err).
+				Str("topic", topic).
+				Msg("failed to publish mqtt event")
+		}
+	}
+}
+
+// mqttEventFromChange returns the MQTT event for the given change, or nil if
+// the change isn't one that's published.
+func mqttEventFromChange(e *events.Event) *mqttEvent {
+	if e.DatabaseID == "" {
+		return nil
+	}
+	switch e.Kind {
+	case events.KindDatabase:
+		return &mqttEvent{
+			Kind:       e.Kind,
+			Timestamp:  e.Timestamp,
+			DatabaseID: e.DatabaseID,
+			Database: &webhook.DatabaseEvent{
+				State:         string(e.Database.State),
+				PreviousState: string(e.PreviousDatabaseState),
+			},
+		}
+	case events.KindTask:
+		return &mqttEvent{
+			Kind:       e.Kind,
+			Timestamp:  e.Timestamp,
+			DatabaseID: e.DatabaseID,
+			HostID:     e.HostID,
+			Task: &webhook.TaskEvent{
+				TaskID:     e.Task.TaskID.String(),
+				Scope:      e.Task.Scope.String(),
+				EntityID:   e.Task.EntityID,
+				Type:       e.Task.Type.String(),
+				Status:     e.Task.Status.String(),
+				NodeName:   e.Task.NodeName,
+				InstanceID: e.Task.InstanceID,
+				Error:      e.Task.Error,
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/mqtt"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+// errTenantNotAllowed is returned for requests that tenants can't make. Tenant
+// requests for other tenants' databases get the same response as requests for
+// databases that don't exist.
+var errTenantNotAllowed = errors.New("not found")
+
+// databaseTenantFunc returns the tenant ID of the given database.
+type databaseTenantFunc func(r *http.Request, databaseID string) (string, error)
+
+// tenantHandler limits requests that arrive on a tenant's topics to that
+// tenant's databases. Brokers authorize clients by topic, so this keeps a
+// tenant's clients from reaching other tenants' databases through a host that
+// they share.
+func tenantHandler(prefix string, i *do.Injector, next http.Handler) http.Handler {
+	databaseTenant := func(r *http.Request, databaseID string) (string, error) {
+		// The database service isn't available until the cluster is
+		// initialized.
+		dbSvc, err := do.Invoke[*database.Service](i)
+		if err != nil {
+			return "", errTenantNotAllowed
+		}
+		db, err := dbSvc.GetDatabase(r.Context(), databaseID)
+		if errors.Is(err, database.ErrDatabaseNotFound) {
+			return "", errTenantNotAllowed
+		} else if err != nil {
+			return "", err
+		}
+		return utils.FromPointer(db.TenantID), nil
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		topic, _ := mqtt.GetTopic(r.Context())
+		tenantID, ok := mqtt.TenantFromTopic(prefix, topic)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		err := authorizeTenantRequest(r, tenantID, databaseTenant)
+		switch {
+		case errors.Is(err, errTenantNotAllowed):
+			writeAPIError(w, http.StatusNotFound, "not_found", "no resource found at the given path for this tenant")
+		case errors.Is(err, errInvalidTenant):
+			writeAPIError(w, http.StatusBadRequest, "invalid_input", err.Error())
+		case err != nil:
+			writeAPIError(w, http.StatusInternalServerError, "server_error", err.Error())
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+var errInvalidTenant = errors.New("tenant_id must match the tenant that the request was sent by")
+
+// authorizeTenantRequest returns nil if the tenant is allowed to make the
+// given request. Tenants can use the database endpoints for their own
+// databases and stream those databases' events. The tenant ID is added to
+// list requests so that they only return the tenant's databases. Requests that
+// create or update a database must set the tenant_id, and databases can only
+// be restored from the tenant's own databases.
+func authorizeTenantRequest(r *http.Request, tenantID string, databaseTenant databaseTenantFunc) error {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(path) < 2 || path[0] != "v1" {
+		return errTenantNotAllowed
+	}
+
+	switch {
+	case len(path) == 2 && path[1] == "version":
+		return nil
+	case len(path) == 2 && path[1] == "events":
+		databaseID := r.URL.Query().Get("database_id")
+		if databaseID == "" {
+			return errTenantNotAllowed
+		}
+		return checkDatabaseTenant(r, tenantID, databaseID, databaseTenant)
+	case len(path) == 2 && path[1] == "databases":
+		switch r.Method {
+		case http.MethodGet:
+			query := r.URL.Query()
+			query.Set("tenant_id", tenantID)
+			r.URL.RawQuery = query.Encode()
+			return nil
+		case http.MethodPost:
+			return checkBody(r, tenantID, true, databaseTenant)
+		default:
+			return errTenantNotAllowed
+		}
+	case len(path) >= 3 && path[1] == "databases":
+		if err := checkDatabaseTenant(r, tenantID, path[2], databaseTenant); err != nil {
+			return err
+		}
+		// Updates and clones create or modify a database with the tenant_id
+		// from the request body.
+		required := r.Method == http.MethodPost &&
+			(len(path) == 3 || (len(path) == 4 && path[3] == "clone"))
+		return checkBody(r, tenantID, required, databaseTenant)
+	default:
+		return errTenantNotAllowed
+	}
+}
+
+func checkDatabaseTenant(r *http.Request, tenantID, databaseID string, databaseTenant databaseTenantFunc) error {
+	owner, err := databaseTenant(r, databaseID)
+	if err != nil {
+		return err
+	}
+	if owner != tenantID {
+		return errTenantNotAllowed
+	}
+	return nil
+}
+
+type tenantRestoreConfig struct {
+	SourceDatabaseID string `json:"source_database_id"`
+}
+
+// tenantRequestBody contains the properties of the request bodies that are
+// checked for tenant requests.
+type tenantRequestBody struct {
+	TenantID *string `json:"tenant_id"`
+	Spec     *struct {
+		RestoreConfig *tenantRestoreConfig `json:"restore_config"`
+		Nodes         []struct {
+			RestoreConfig *tenantRestoreConfig `json:"restore_config"`
+		} `json:"nodes"`
+	} `json:"spec"`
+	RestoreConfig *tenantRestoreConfig `json:"restore_config"`
+}
+
+// restoreSources returns the IDs of the databases that the request restores
+// from.
+func (b *tenantRequestBody) restoreSources() []string {
+	configs := []*tenantRestoreConfig{b.RestoreConfig}
+	if b.Spec != nil {
+		configs = append(configs, b.Spec.RestoreConfig)
+		for _, node := range b.Spec.Nodes {
+			configs = append(configs, node.RestoreConfig)
+		}
+	}
+	var sources []string
+	for _, c := range configs {
+		if c != nil && c.SourceDatabaseID != "" {
+			sources = append(sources, c.SourceDatabaseID)
+		}
+	}
+	return sources
+}
+
+// checkBody checks the tenant_id property of a JSON request body, if any, and
+// that any restore_config's source database belongs to the tenant. The body is
+// restored so that it can be read by the handler.
+func checkBody(r *http.Request, tenantID string, required bool, databaseTenant databaseTenantFunc) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		if required {
+			return errInvalidTenant
+		}
+		return nil
+	}
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var body tenantRequestBody
+	// Invalid bodies are rejected by the handler.
+	_ = json.Unmarshal(raw, &body)
+	switch {
+	case body.TenantID == nil && required:
+		return errInvalidTenant
+	case body.TenantID != nil && *body.TenantID != tenantID:
+		return errInvalidTenant
+	}
+	for _, sourceID := range body.restoreSources() {
+		if err := checkDatabaseTenant(r, tenantID, sourceID, databaseTenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAPIError(w http.ResponseWriter, status int, name, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"name":    name,
+		"message": message,
+	})
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizeTenantRequest(t *testing.T) {
+	owners := map[string]string{
+		"db-a": "tenant-a",
+		"db-b": "tenant-b",
+	}
+	databaseTenant := func(_ *http.Request, databaseID string) (string, error) {
+		owner, ok := owners[databaseID]
+		if !ok {
+			return "", errTenantNotAllowed
+		}
+		return owner, nil
+	}
+
+	for _, tc := range []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		expected error
+	}{
+		{name: "version", method: "GET", target: "/v1/version"},
+		{name: "cluster", method: "GET", target: "/v1/cluster", expected: errTenantNotAllowed},
+		{name: "hosts", method: "GET", target: "/v1/hosts", expected: errTenantNotAllowed},
+		{name: "own database", method: "GET", target: "/v1/databases/db-a"},
+		{name: "own database tasks", method: "GET", target: "/v1/databases/db-a/tasks"},
+		{name: "other database", method: "GET", target: "/v1/databases/db-b", expected: errTenantNotAllowed},
+		{name: "missing database", method: "GET", target: "/v1/databases/db-c", expected: errTenantNotAllowed},
+		{name: "own events", method: "GET", target: "/v1/events?database_id=db-a"},
+		{name: "other events", method: "GET", target: "/v1/events?database_id=db-b", expected: errTenantNotAllowed},
+		{name: "all events", method: "GET", target: "/v1/events", expected: errTenantNotAllowed},
+		{name: "create", method: "POST", target: "/v1/databases", body: `{"id":"db-d","tenant_id":"tenant-a"}`},
+		{name: "create without tenant", method: "POST", target: "/v1/databases", body: `{"id":"db-d"}`, expected: errInvalidTenant},
+		{name: "create for other tenant", method: "POST", target: "/v1/databases", body: `{"id":"db-d","tenant_id":"tenant-b"}`, expected: errInvalidTenant},
+		{name: "create from own database", method: "POST", target: "/v1/databases", body: `{"id":"db-d","tenant_id":"tenant-a","spec":{"restore_config":{"source_database_id":"db-a"}}}`},
+		{name: "create from other database", method: "POST", target: "/v1/databases", body: `{"id":"db-d","tenant_id":"tenant-a","spec":{"restore_config":{"source_database_id":"db-b"}}}`, expected: errTenantNotAllowed},
+		{name: "create node from other database", method: "POST", target: "/v1/databases", body: `{"id":"db-d","tenant_id":"tenant-a","spec":{"nodes":[{"restore_config":{"source_database_id":"db-b"}}]}}`, expected: errTenantNotAllowed},
+		{name: "update", method: "POST", target: "/v1/databases/db-a", body: `{"tenant_id":"tenant-a","spec":{}}`},
+		{name: "update without tenant", method: "POST", target: "/v1/databases/db-a", body: `{"spec":{}}`, expected: errInvalidTenant},
+		{name: "update to other tenant", method: "POST", target: "/v1/databases/db-a", body: `{"tenant_id":"tenant-b"}`, expected: errInvalidTenant},
+		{name: "restore from own database", method: "POST", target: "/v1/databases/db-a/restore", body: `{"restore_config":{"source_database_id":"db-a"}}`},
+		{name: "restore from other database", method: "POST", target: "/v1/databases/db-a/restore", body: `{"restore_config":{"source_database_id":"db-b"}}`, expected: errTenantNotAllowed},
+		{name: "clone", method: "POST", target: "/v1/databases/db-a/clone", body: `{"id":"db-d","tenant_id":"tenant-a"}`},
+		{name: "clone without tenant", method: "POST", target: "/v1/databases/db-a/clone", body: `{"id":"db-d"}`, expected: errInvalidTenant},
+		{name: "switchover", method: "POST", target: "/v1/databases/db-a/nodes/n1/switchover"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			r := httptest.NewRequest(tc.method, tc.target, body)
+
+			err := authorizeTenantRequest(r, "tenant-a", databaseTenant)
+			if tc.expected != nil {
+				assert.ErrorIs(t, err, tc.expected)
+				return
+			}
+			require.NoError(t, err)
+			if tc.body != "" {
+				// The body is still readable by the handler.
+				raw, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tc.body, string(raw))
+			}
+		})
+	}
+
+	t.Run("list is limited to the tenant", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1/databases?tenant_id=tenant-b", nil)
+
+		require.NoError(t, authorizeTenantRequest(r, "tenant-a", databaseTenant))
+		assert.Equal(t, "tenant-a", r.URL.Query().Get("tenant_id"))
+	})
+}
@@ -29,6 +29,6 @@ func provideServer(i *do.Injector) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to get v1 api service: %w", err)
 		}
-		return NewServer(cfg, loggerFactory, v1Svc), nil
+		return NewServer(cfg, i, loggerFactory, v1Svc), nil
 	})
 }
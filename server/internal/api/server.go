@@ -12,23 +12,30 @@ import (
 
 	"github.com/pgEdge/control-plane/server/internal/api/apiv1"
 	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/events"
 	"github.com/pgEdge/control-plane/server/internal/logging"
 )
 
 var _ do.Shutdownable = (*Server)(nil)
 
 type Server struct {
-	logger  zerolog.Logger
-	started bool
-	cfg     config.Config
-	v1Svc   *apiv1.Service
-	http    *httpServer
-	mqtt    *mqttServer
-	errCh   chan error
+	logger      zerolog.Logger
+	started     bool
+	mqttStarted bool
+	cfg         config.Config
+	i           *do.Injector
+	v1Svc       *apiv1.Service
+	http        *httpServer
+	mqtt        *mqttServer
+	mqttEvents  *mqttEventPublisher
+	errCh       chan error
 }
 
 func NewServer(
 	cfg config.Config,
+	i *do.Injector,
 	loggerFactory *logging.Factory,
 	v1Svc *apiv1.Service,
 ) *Server {
@@ -58,16 +65,17 @@ func NewServer(
 		httpSvr = newHTTPServer(cfg.HTTP, handler, logger)
 	}
 	if cfg.MQTT.Enabled {
-		mqttSvr = newMQTTServer(cfg.MQTT, handler, logger)
+		mqttSvr = newMQTTServer(cfg.MQTT, cfg.HostID, i, handler, logger)
 	}
 
 	return &Server{
 		logger: logger,
 		cfg:    cfg,
+		i:      i,
 		v1Svc:  v1Svc,
 		http:   httpSvr,
 		mqtt:   mqttSvr,
-		errCh:  make(chan error, 2),
+		errCh:  make(chan error, 3),
 	}
 }
 
@@ -78,7 +86,7 @@ func (s *Server) ServePreInit(ctx context.Context) error {
 		return fmt.Errorf("failed to set v1 api to use pre-init handlers: %w", err)
 	}
 
-	s.serve(ctx)
+	s.serve(ctx, false)
 
 	return nil
 }
@@ -90,7 +98,13 @@ func (s *Server) ServePostInit(ctx context.Context) error {
 		return fmt.Errorf("failed to set v1 api to use post-init handlers: %w", err)
 	}
 
-	s.serve(ctx)
+	s.serve(ctx, true)
+
+	if s.mqtt != nil && s.cfg.MQTT.PublishEvents && s.mqttEvents == nil {
+		if err := s.startMQTTEvents(ctx); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -102,34 +116,62 @@ func (s *Server) HandleInitializationError(err error) {
 	s.v1Svc.HandleInitializationError(err)
 }
 
-func (s *Server) serve(ctx context.Context) {
-	if s.started {
-		return
+func (s *Server) serve(ctx context.Context, initialized bool) {
+	if !s.started {
+		if s.http != nil {
+			s.http.start()
+			s.forwardErrors(ctx, s.http.errCh)
+		}
+		s.started = true
+	}
+	if s.mqtt != nil && !s.mqttStarted && (initialized || !s.mqtt.requiresCluster()) {
+		s.mqtt.start(ctx)
+		s.forwardErrors(ctx, s.mqtt.errCh)
+		s.mqttStarted = true
 	}
+}
 
-	var errChs []chan error
+func (s *Server) forwardErrors(ctx context.Context, c <-chan error) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-c:
+			s.errCh <- err
+		}
+	}()
+}
 
-	if s.http != nil {
-		s.http.start()
-		errChs = append(errChs, s.http.errCh)
+func (s *Server) startMQTTEvents(ctx context.Context) error {
+	eventsSvc, err := do.Invoke[*events.Service](s.i)
+	if err != nil {
+		return fmt.Errorf("failed to get events service: %w", err)
 	}
-	if s.mqtt != nil {
-		s.mqtt.start(ctx)
-		errChs = append(errChs, s.mqtt.errCh)
+	dbSvc, err := do.Invoke[*database.Service](s.i)
+	if err != nil {
+		return fmt.Errorf("failed to get database service: %w", err)
+	}
+	electionSvc, err := do.Invoke[*election.Service](s.i)
+	if err != nil {
+		return fmt.Errorf("failed to get election service: %w", err)
 	}
 
-	s.started = true
-
-	for _, c := range errChs {
-		go func(c chan error) {
-			select {
-			case <-ctx.Done():
-				return
-			case err := <-c:
-				s.errCh <- err
-			}
-		}(c)
+	candidate := electionSvc.NewCandidate(mqttEventsElectionName, s.cfg.HostID, mqttEventsElectionTTL)
+	publisher := newMQTTEventPublisher(
+		s.logger,
+		s.cfg.MQTT.TopicPrefix,
+		s.mqtt.publish,
+		eventsSvc,
+		dbSvc,
+		candidate,
+	)
+	if err := publisher.start(ctx); err != nil {
+		return fmt.Errorf("failed to start mqtt event publisher: %w", err)
 	}
+	s.forwardErrors(ctx, candidate.Error())
+	s.mqttEvents = publisher
+
+	return nil
 }
 
 func (s *Server) Shutdown() error {
@@ -139,6 +181,11 @@ func (s *Server) Shutdown() error {
 
 	var errs []error
 
+	if s.mqttEvents != nil {
+		stopCtx, cancel := context.WithTimeout(ctx, mqttEventsElectionTTL/3)
+		errs = append(errs, s.mqttEvents.stop(stopCtx))
+		cancel()
+	}
 	if s.http != nil {
 		errs = append(errs, s.http.stop(ctx))
 	}
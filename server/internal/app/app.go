@@ -13,13 +13,18 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/api"
 	"github.com/pgEdge/control-plane/server/internal/certificates"
 	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/drift"
 	"github.com/pgEdge/control-plane/server/internal/etcd"
 	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/metadatabackup"
 	"github.com/pgEdge/control-plane/server/internal/migrate"
 	"github.com/pgEdge/control-plane/server/internal/monitor"
 	"github.com/pgEdge/control-plane/server/internal/orchestrator"
+	"github.com/pgEdge/control-plane/server/internal/replacement"
 	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/retention"
 	"github.com/pgEdge/control-plane/server/internal/scheduler"
+	"github.com/pgEdge/control-plane/server/internal/webhook"
 	"github.com/pgEdge/control-plane/server/internal/workflows"
 )
 
@@ -144,14 +149,36 @@ func (a *App) runInitialized(parentCtx context.Context) error {
 		return err
 	}
 
+	// Refuse to run against data that was written by a newer version.
+	compatibility, err := do.Invoke[*migrate.Compatibility](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize compatibility check: %w", err))
+	}
+	if err := compatibility.Check(a.serviceCtx); err != nil {
+		return handleError(err)
+	}
+	if err := compatibility.Register(a.serviceCtx); err != nil {
+		return handleError(err)
+	}
+
 	// Run migrations before starting other services
 	migrationRunner, err := do.Invoke[*migrate.Runner](a.i)
 	if err != nil {
 		return handleError(fmt.Errorf("failed to initialize migration runner: %w", err))
 	}
-	if err := migrationRunner.Run(a.serviceCtx); err != nil {
+	// The runner waits for the other hosts to be upgraded during rolling
+	// upgrades, so it needs to stop if we're shut down in the meantime.
+	migrationCtx, cancelMigrations := context.WithCancel(a.serviceCtx)
+	stopMigrations := context.AfterFunc(parentCtx, cancelMigrations)
+	err = migrationRunner.Run(migrationCtx)
+	stopMigrations()
+	cancelMigrations()
+	if err != nil {
 		return handleError(fmt.Errorf("failed to run migrations: %w", err))
 	}
+	if err := compatibility.Update(a.serviceCtx); err != nil {
+		return handleError(err)
+	}
 
 	certSvc, err := do.Invoke[*certificates.Service](a.i)
 	if err != nil {
@@ -202,6 +229,13 @@ func (a *App) runInitialized(parentCtx context.Context) error {
 		return handleError(fmt.Errorf("failed to start scheduler service: %w", err))
 	}
 
+	// Etcd mode changes through the API restart the server.
+	modeChanger, err := do.Invoke[*etcd.ModeChanger](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize etcd mode changer: %w", err))
+	}
+	a.addErrorProducer(parentCtx, modeChanger)
+
 	worker, err := do.Invoke[*workflows.Worker](a.i)
 	if err != nil {
 		return handleError(fmt.Errorf("failed to initialize worker: %w", err))
@@ -211,6 +245,51 @@ func (a *App) runInitialized(parentCtx context.Context) error {
 	}
 	a.addErrorProducer(parentCtx, worker)
 
+	replacementSvc, err := do.Invoke[*replacement.Service](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize replacement service: %w", err))
+	}
+	if err := replacementSvc.Start(a.serviceCtx); err != nil {
+		return handleError(fmt.Errorf("failed to start replacement service: %w", err))
+	}
+	a.addErrorProducer(parentCtx, replacementSvc)
+
+	driftSvc, err := do.Invoke[*drift.Service](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize drift detection service: %w", err))
+	}
+	if err := driftSvc.Start(a.serviceCtx); err != nil {
+		return handleError(fmt.Errorf("failed to start drift detection service: %w", err))
+	}
+	a.addErrorProducer(parentCtx, driftSvc)
+
+	retentionSvc, err := do.Invoke[*retention.Service](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize retention service: %w", err))
+	}
+	if err := retentionSvc.Start(a.serviceCtx); err != nil {
+		return handleError(fmt.Errorf("failed to start retention service: %w", err))
+	}
+	a.addErrorProducer(parentCtx, retentionSvc)
+
+	webhookSvc, err := do.Invoke[*webhook.Service](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize webhook service: %w", err))
+	}
+	if err := webhookSvc.Start(a.serviceCtx); err != nil {
+		return handleError(fmt.Errorf("failed to start webhook service: %w", err))
+	}
+	a.addErrorProducer(parentCtx, webhookSvc)
+
+	metadataBackupSvc, err := do.Invoke[*metadatabackup.Service](a.i)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to initialize metadata backup service: %w", err))
+	}
+	if err := metadataBackupSvc.Start(a.serviceCtx); err != nil {
+		return handleError(fmt.Errorf("failed to start metadata backup service: %w", err))
+	}
+	a.addErrorProducer(parentCtx, metadataBackupSvc)
+
 	if err := a.api.ServePostInit(a.serviceCtx); err != nil {
 		return handleError(fmt.Errorf("failed to serve post-init API: %w", err))
 	}
@@ -139,6 +139,24 @@ func (s *Service) RemoveHostEtcdUser(ctx context.Context, hostID string) error {
 	return s.removePrincipal(ctx, id)
 }
 
+func hostMQTTClientID(hostID string) string {
+	return fmt.Sprintf("host:%s:mqtt-client", hostID)
+}
+
+// HostMQTTClient returns the principal that a host uses to authenticate to an
+// MQTT broker. The certificate's common name is the host ID.
+func (s *Service) HostMQTTClient(ctx context.Context, hostID string) (*Principal, error) {
+	id := hostMQTTClientID(hostID)
+
+	return s.getPrincipal(ctx, id, userCertTemplate(hostID))
+}
+
+func (s *Service) RemoveHostMQTTClient(ctx context.Context, hostID string) error {
+	id := hostMQTTClientID(hostID)
+
+	return s.removePrincipal(ctx, id)
+}
+
 func postgresServerID(instanceID string) string {
 	return fmt.Sprintf("instance:%s:postgres-server", instanceID)
 }
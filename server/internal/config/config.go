@@ -57,9 +57,25 @@ type MQTT struct {
 	Enabled   bool   `koanf:"enabled" json:"enabled,omitempty"`
 	BrokerURL string `koanf:"broker_url" json:"broker_url,omitempty"`
 	Topic     string `koanf:"topic" json:"topic,omitempty"`
-	ClientID  string `koanf:"client_id" json:"client_id,omitempty"`
-	Username  string `koanf:"username" json:"username,omitempty"`
-	Password  string `koanf:"password" json:"password,omitempty"`
+	// TopicPrefix enables the per-host and per-tenant topics described in the
+	// mqtt package, e.g. <prefix>/hosts/<host id>/api. Requests that arrive
+	// on a tenant's topics are limited to that tenant's databases.
+	TopicPrefix string `koanf:"topic_prefix" json:"topic_prefix,omitempty"`
+	ClientID    string `koanf:"client_id" json:"client_id,omitempty"`
+	Username    string `koanf:"username" json:"username,omitempty"`
+	Password    string `koanf:"password" json:"password,omitempty"`
+	// CAFile is a PEM file with the certificates used to verify the broker.
+	// Defaults to the system's certificates, plus the cluster's CA when
+	// UseClusterCertificates is set.
+	CAFile string `koanf:"ca_file" json:"ca_file,omitempty"`
+	// UseClusterCertificates authenticates to the broker with a client
+	// certificate that's issued by the cluster's CA. The certificate's common
+	// name is the host ID. Because the CA is created when the cluster is
+	// initialized, the server doesn't connect to the broker until then.
+	UseClusterCertificates bool `koanf:"use_cluster_certificates" json:"use_cluster_certificates,omitempty"`
+	// PublishEvents publishes database and task events to the event topics
+	// under TopicPrefix.
+	PublishEvents bool `koanf:"publish_events" json:"publish_events,omitempty"`
 }
 
 func (m MQTT) validate() []error {
@@ -70,8 +86,60 @@ func (m MQTT) validate() []error {
 	if m.BrokerURL == "" {
 		errs = append(errs, errors.New("broker_url: cannot be empty"))
 	}
-	if m.Topic == "" {
-		errs = append(errs, errors.New("topic: cannot be empty"))
+	if m.Topic == "" && m.TopicPrefix == "" {
+		errs = append(errs, errors.New("topic: cannot be empty unless topic_prefix is set"))
+	}
+	if strings.ContainsAny(m.TopicPrefix, "+#") {
+		errs = append(errs, errors.New("topic_prefix: cannot contain wildcards"))
+	}
+	if m.PublishEvents && m.TopicPrefix == "" {
+		errs = append(errs, errors.New("publish_events: requires topic_prefix"))
+	}
+	return errs
+}
+
+// PatroniEtcd configures an external etcd cluster that Patroni can use as its
+// DCS instead of the control plane's etcd. Databases use it when their
+// patroni_dcs is 'external'. This configuration must be the same on every
+// host.
+type PatroniEtcd struct {
+	// Endpoints are the host:port client addresses of the external cluster.
+	Endpoints []string `koanf:"endpoints" json:"endpoints,omitempty"`
+	// CACertFile is a PEM file with the CA certificate that's used to verify
+	// the cluster. The connection uses TLS when it's set.
+	CACertFile string `koanf:"ca_cert_file" json:"ca_cert_file,omitempty"`
+	// ClientCertFile and ClientKeyFile are an optional client certificate and
+	// key that are used to authenticate to the cluster.
+	ClientCertFile string `koanf:"client_cert_file" json:"client_cert_file,omitempty"`
+	ClientKeyFile  string `koanf:"client_key_file" json:"client_key_file,omitempty"`
+	Username       string `koanf:"username" json:"username,omitempty"`
+	Password       string `koanf:"password" json:"password,omitempty"`
+	// Default makes the external cluster the DCS for new databases that don't
+	// specify a patroni_dcs. The resolved patroni_dcs is stored in the
+	// database's spec, so changing this doesn't affect existing databases.
+	Default bool `koanf:"default" json:"default,omitempty"`
+}
+
+// Configured returns true if an external cluster has been configured.
+func (p PatroniEtcd) Configured() bool {
+	return len(p.Endpoints) > 0
+}
+
+func (p PatroniEtcd) validate() []error {
+	var errs []error
+	if p.Default && !p.Configured() {
+		errs = append(errs, errors.New("default: requires endpoints"))
+	}
+	for i, endpoint := range p.Endpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			errs = append(errs, fmt.Errorf("endpoints[%d]: %w", i, err))
+		}
+	}
+	if (p.ClientCertFile == "") != (p.ClientKeyFile == "") {
+		errs = append(errs, errors.New("client_cert_file: client_cert_file and client_key_file must be set together"))
+	}
+	if p.ClientCertFile != "" && p.CACertFile == "" {
+		errs = append(errs, errors.New("ca_cert_file: required when client_cert_file is set"))
 	}
 	return errs
 }
@@ -243,6 +311,235 @@ func (r RandomPorts) validate() []error {
 	return errs
 }
 
+type HostReplacement struct {
+	Enabled                     bool   `koanf:"enabled" json:"enabled,omitempty"`
+	UnreachableThresholdSeconds uint64 `koanf:"unreachable_threshold_seconds" json:"unreachable_threshold_seconds,omitempty"`
+	IntervalSeconds             uint64 `koanf:"interval_seconds" json:"interval_seconds,omitempty"`
+}
+
+// minUnreachableThresholdSeconds is a lower bound on the replacement threshold
+// that prevents brief network interruptions or host restarts from triggering a
+// replacement.
+const minUnreachableThresholdSeconds = 60
+
+func (h HostReplacement) validate() []error {
+	if !h.Enabled {
+		return nil
+	}
+	var errs []error
+	if h.UnreachableThresholdSeconds < minUnreachableThresholdSeconds {
+		errs = append(errs, fmt.Errorf("unreachable_threshold_seconds: must be at least %d", minUnreachableThresholdSeconds))
+	}
+	if h.IntervalSeconds == 0 {
+		errs = append(errs, errors.New("interval_seconds: must be greater than 0"))
+	}
+	return errs
+}
+
+var defaultHostReplacement = HostReplacement{
+	UnreachableThresholdSeconds: 900,
+	IntervalSeconds:             30,
+}
+
+type DriftDetection struct {
+	Enabled         bool   `koanf:"enabled" json:"enabled,omitempty"`
+	IntervalSeconds uint64 `koanf:"interval_seconds" json:"interval_seconds,omitempty"`
+}
+
+// minDriftDetectionIntervalSeconds is a lower bound on the drift check
+// interval. Each check refreshes every resource in every database, so short
+// intervals put unnecessary load on the hosts.
+const minDriftDetectionIntervalSeconds = 60
+
+func (d DriftDetection) validate() []error {
+	if !d.Enabled {
+		return nil
+	}
+	var errs []error
+	if d.IntervalSeconds < minDriftDetectionIntervalSeconds {
+		errs = append(errs, fmt.Errorf("interval_seconds: must be at least %d", minDriftDetectionIntervalSeconds))
+	}
+	return errs
+}
+
+var defaultDriftDetection = DriftDetection{
+	IntervalSeconds: 900,
+}
+
+type Retention struct {
+	Enabled           bool   `koanf:"enabled" json:"enabled,omitempty"`
+	IntervalSeconds   uint64 `koanf:"interval_seconds" json:"interval_seconds,omitempty"`
+	MaxAgeSeconds     uint64 `koanf:"max_age_seconds" json:"max_age_seconds,omitempty"`
+	MaxTasksPerEntity uint64 `koanf:"max_tasks_per_entity" json:"max_tasks_per_entity,omitempty"`
+}
+
+// minRetentionIntervalSeconds is a lower bound on the retention interval. Each
+// run reads every task and workflow instance, so short intervals put
+// unnecessary load on Etcd.
+const minRetentionIntervalSeconds = 60
+
+func (r Retention) validate() []error {
+	if !r.Enabled {
+		return nil
+	}
+	var errs []error
+	if r.IntervalSeconds < minRetentionIntervalSeconds {
+		errs = append(errs, fmt.Errorf("interval_seconds: must be at least %d", minRetentionIntervalSeconds))
+	}
+	if r.MaxAgeSeconds == 0 && r.MaxTasksPerEntity == 0 {
+		errs = append(errs, errors.New("max_age_seconds: must be set when max_tasks_per_entity is not set"))
+	}
+	return errs
+}
+
+var defaultRetention = Retention{
+	IntervalSeconds:   3600,
+	MaxAgeSeconds:     30 * 24 * 60 * 60,
+	MaxTasksPerEntity: 100,
+}
+
+// TaskTypeConcurrency limits the number of tasks of a single type that can run
+// at once. Tasks with a higher priority are started before queued tasks with
+// a lower priority.
+type TaskTypeConcurrency struct {
+	MaxPerHost uint64 `koanf:"max_per_host" json:"max_per_host,omitempty"`
+	MaxGlobal  uint64 `koanf:"max_global" json:"max_global,omitempty"`
+	Priority   int    `koanf:"priority" json:"priority,omitempty"`
+}
+
+func (t TaskTypeConcurrency) validate() []error {
+	if t.MaxGlobal > 0 && t.MaxPerHost > t.MaxGlobal {
+		return []error{errors.New("max_per_host: must not be greater than max_global")}
+	}
+	return nil
+}
+
+// TaskConcurrency limits the number of tasks that can run at once. Zero values
+// mean that there is no limit. TaskTypes is keyed by task type, e.g.
+// "node_backup".
+type TaskConcurrency struct {
+	MaxPerHost uint64                         `koanf:"max_per_host" json:"max_per_host,omitempty"`
+	MaxGlobal  uint64                         `koanf:"max_global" json:"max_global,omitempty"`
+	TaskTypes  map[string]TaskTypeConcurrency `koanf:"task_types" json:"task_types,omitempty"`
+}
+
+func (t TaskConcurrency) validate() []error {
+	var errs []error
+	if t.MaxGlobal > 0 && t.MaxPerHost > t.MaxGlobal {
+		errs = append(errs, errors.New("max_per_host: must not be greater than max_global"))
+	}
+	for taskType, limits := range t.TaskTypes {
+		for _, err := range limits.validate() {
+			errs = append(errs, fmt.Errorf("task_types.%s.%w", taskType, err))
+		}
+	}
+	return errs
+}
+
+// defaultTaskConcurrency allows one backup per host at a time and starts
+// failovers and switchovers ahead of other queued tasks.
+var defaultTaskConcurrency = TaskConcurrency{
+	TaskTypes: map[string]TaskTypeConcurrency{
+		"node_backup": {MaxPerHost: 1},
+		"failover":    {Priority: 100},
+		"switchover":  {Priority: 100},
+	},
+}
+
+type MetadataBackupRepositoryType string
+
+const (
+	MetadataBackupRepositoryTypePosix MetadataBackupRepositoryType = "posix"
+	MetadataBackupRepositoryTypeS3    MetadataBackupRepositoryType = "s3"
+)
+
+// MetadataBackupRepository uses the same field names as the pgBackRest
+// repositories in database specs.
+type MetadataBackupRepository struct {
+	Type       MetadataBackupRepositoryType `koanf:"type" json:"type,omitempty"`
+	BasePath   string                       `koanf:"base_path" json:"base_path,omitempty"`
+	S3Bucket   string                       `koanf:"s3_bucket" json:"s3_bucket,omitempty"`
+	S3Region   string                       `koanf:"s3_region" json:"s3_region,omitempty"`
+	S3Endpoint string                       `koanf:"s3_endpoint" json:"s3_endpoint,omitempty"`
+	// S3Key, S3KeySecret, and S3SessionToken are optional. When they're
+	// unset, credentials are loaded from the AWS SDK's default chain, which
+	// includes environment variables, shared config files, web identity tokens
+	// (IRSA), and instance roles.
+	S3Key          string `koanf:"s3_key" json:"s3_key,omitempty"`
+	S3KeySecret    string `koanf:"s3_key_secret" json:"s3_key_secret,omitempty"`
+	S3SessionToken string `koanf:"s3_session_token" json:"s3_session_token,omitempty"`
+	// S3URIStyle is either "host" for virtual-hosted-style requests or "path"
+	// for path-style requests, which are needed by some S3-compatible stores.
+	S3URIStyle string `koanf:"s3_uri_style" json:"s3_uri_style,omitempty"`
+}
+
+func (r MetadataBackupRepository) validate() []error {
+	var errs []error
+	switch r.Type {
+	case MetadataBackupRepositoryTypePosix:
+		if r.BasePath == "" {
+			errs = append(errs, errors.New("base_path: cannot be empty for posix repositories"))
+		}
+	case MetadataBackupRepositoryTypeS3:
+		if r.S3Bucket == "" {
+			errs = append(errs, errors.New("s3_bucket: cannot be empty for s3 repositories"))
+		}
+		if r.S3Region == "" {
+			errs = append(errs, errors.New("s3_region: cannot be empty for s3 repositories"))
+		}
+		if (r.S3Key == "") != (r.S3KeySecret == "") {
+			errs = append(errs, errors.New("s3_key: s3_key and s3_key_secret must be set together"))
+		}
+		if r.S3SessionToken != "" && r.S3Key == "" {
+			errs = append(errs, errors.New("s3_session_token: requires s3_key and s3_key_secret"))
+		}
+		switch r.S3URIStyle {
+		case "", "host", "path":
+		default:
+			errs = append(errs, fmt.Errorf("s3_uri_style: unsupported uri style %q", r.S3URIStyle))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("type: unsupported repository type %q", r.Type))
+	}
+	return errs
+}
+
+type MetadataBackup struct {
+	Enabled        bool                     `koanf:"enabled" json:"enabled,omitempty"`
+	CronExpression string                   `koanf:"cron_expression" json:"cron_expression,omitempty"`
+	Retention      int                      `koanf:"retention" json:"retention,omitempty"`
+	Repository     MetadataBackupRepository `koanf:"repository" json:"repository,omitzero"`
+	// EncryptionKeyFile is the path to a file that contains a base64-encoded,
+	// 32-byte key. Snapshots contain secrets, so they're encrypted with
+	// AES-256-GCM when this is set.
+	EncryptionKeyFile string `koanf:"encryption_key_file" json:"encryption_key_file,omitempty"`
+}
+
+func (m MetadataBackup) validate() []error {
+	if !m.Enabled {
+		return nil
+	}
+	var errs []error
+	if m.CronExpression == "" {
+		errs = append(errs, errors.New("cron_expression: cannot be empty"))
+	}
+	if m.Retention < 1 {
+		errs = append(errs, errors.New("retention: must be at least 1"))
+	}
+	for _, err := range m.Repository.validate() {
+		errs = append(errs, fmt.Errorf("repository.%w", err))
+	}
+	return errs
+}
+
+var defaultMetadataBackup = MetadataBackup{
+	CronExpression: "0 * * * *",
+	Retention:      24,
+	Repository: MetadataBackupRepository{
+		Type: MetadataBackupRepositoryTypePosix,
+	},
+}
+
 // We're intentionally using a range that's well below the ephemeral port range
 // to reduce the risk of interference from the OS.
 var defaultRandomPorts = RandomPorts{
@@ -251,31 +548,43 @@ var defaultRandomPorts = RandomPorts{
 }
 
 type Config struct {
-	TenantID                        string       `koanf:"tenant_id" json:"tenant_id,omitempty"`
-	HostID                          string       `koanf:"host_id" json:"host_id,omitempty"`
-	Orchestrator                    Orchestrator `koanf:"orchestrator" json:"orchestrator,omitempty"`
-	DataDir                         string       `koanf:"data_dir" json:"data_dir,omitempty"`
-	PeerAddresses                   []string     `koanf:"peer_addresses" json:"peer_addresses,omitempty"`
-	ClientAddresses                 []string     `koanf:"client_addresses" json:"client_addresses,omitempty"`
-	StopGracePeriodSeconds          int64        `koanf:"stop_grace_period_seconds" json:"stop_grace_period_seconds,omitempty"`
-	MQTT                            MQTT         `koanf:"mqtt" json:"mqtt,omitzero"`
-	HTTP                            HTTP         `koanf:"http" json:"http,omitzero"`
-	Logging                         Logging      `koanf:"logging" json:"logging,omitzero"`
-	EtcdMode                        EtcdMode     `koanf:"etcd_mode" json:"etcd_mode,omitempty"`
-	EtcdUsername                    string       `koanf:"etcd_username" json:"etcd_username,omitempty"`
-	EtcdPassword                    string       `koanf:"etcd_password" json:"etcd_password,omitempty"`
-	EtcdKeyRoot                     string       `koanf:"etcd_key_root" json:"etcd_key_root,omitempty"`
-	EtcdServer                      EtcdServer   `koanf:"etcd_server" json:"etcd_server,omitzero"`
-	EtcdClient                      EtcdClient   `koanf:"etcd_client" json:"etcd_client,omitzero"`
-	TraefikEnabled                  bool         `koanf:"traefik_enabled" json:"traefik_enabled,omitempty"`
-	VectorEnabled                   bool         `koanf:"vector_enabled" json:"vector_enabled,omitempty"`
-	DockerSwarm                     DockerSwarm  `koanf:"docker_swarm" json:"docker_swarm,omitzero"`
-	SystemD                         SystemD      `koanf:"systemd" json:"systemd,omitzero"`
-	DatabaseOwnerUID                int          `koanf:"database_owner_uid" json:"database_owner_uid,omitempty"`
-	DatabaseOwnerGID                int          `koanf:"database_owner_gid" json:"database_owner_gid,omitempty"`
-	ProfilingEnabled                bool         `koanf:"profiling_enabled" json:"profiling_enabled,omitempty"`
-	RandomPorts                     RandomPorts  `koanf:"random_ports" json:"random_ports,omitzero"`
-	DatabasesMonitorIntervalSeconds uint64       `koanf:"databases_monitor_interval_seconds" json:"databases_monitor_interval_seconds,omitempty"`
+	TenantID                        string          `koanf:"tenant_id" json:"tenant_id,omitempty"`
+	HostID                          string          `koanf:"host_id" json:"host_id,omitempty"`
+	Orchestrator                    Orchestrator    `koanf:"orchestrator" json:"orchestrator,omitempty"`
+	DataDir                         string          `koanf:"data_dir" json:"data_dir,omitempty"`
+	PeerAddresses                   []string        `koanf:"peer_addresses" json:"peer_addresses,omitempty"`
+	ClientAddresses                 []string        `koanf:"client_addresses" json:"client_addresses,omitempty"`
+	StopGracePeriodSeconds          int64           `koanf:"stop_grace_period_seconds" json:"stop_grace_period_seconds,omitempty"`
+	MQTT                            MQTT            `koanf:"mqtt" json:"mqtt,omitzero"`
+	HTTP                            HTTP            `koanf:"http" json:"http,omitzero"`
+	Logging                         Logging         `koanf:"logging" json:"logging,omitzero"`
+	EtcdMode                        EtcdMode        `koanf:"etcd_mode" json:"etcd_mode,omitempty"`
+	EtcdUsername                    string          `koanf:"etcd_username" json:"etcd_username,omitempty"`
+	EtcdPassword                    string          `koanf:"etcd_password" json:"etcd_password,omitempty"`
+	EtcdKeyRoot                     string          `koanf:"etcd_key_root" json:"etcd_key_root,omitempty"`
+	EtcdServer                      EtcdServer      `koanf:"etcd_server" json:"etcd_server,omitzero"`
+	EtcdClient                      EtcdClient      `koanf:"etcd_client" json:"etcd_client,omitzero"`
+	TraefikEnabled                  bool            `koanf:"traefik_enabled" json:"traefik_enabled,omitempty"`
+	VectorEnabled                   bool            `koanf:"vector_enabled" json:"vector_enabled,omitempty"`
+	DockerSwarm                     DockerSwarm     `koanf:"docker_swarm" json:"docker_swarm,omitzero"`
+	SystemD                         SystemD         `koanf:"systemd" json:"systemd,omitzero"`
+	DatabaseOwnerUID                int             `koanf:"database_owner_uid" json:"database_owner_uid,omitempty"`
+	DatabaseOwnerGID                int             `koanf:"database_owner_gid" json:"database_owner_gid,omitempty"`
+	ProfilingEnabled                bool            `koanf:"profiling_enabled" json:"profiling_enabled,omitempty"`
+	RandomPorts                     RandomPorts     `koanf:"random_ports" json:"random_ports,omitzero"`
+	DatabasesMonitorIntervalSeconds uint64          `koanf:"databases_monitor_interval_seconds" json:"databases_monitor_interval_seconds,omitempty"`
+	DiskFullThresholdPercent        float64         `koanf:"disk_full_threshold_percent" json:"disk_full_threshold_percent,omitempty"`
+	HostReplacement                 HostReplacement `koanf:"host_replacement" json:"host_replacement,omitzero"`
+	MetadataBackup                  MetadataBackup  `koanf:"metadata_backup" json:"metadata_backup,omitzero"`
+	DriftDetection                  DriftDetection  `koanf:"drift_detection" json:"drift_detection,omitzero"`
+	TaskConcurrency                 TaskConcurrency `koanf:"task_concurrency" json:"task_concurrency,omitzero"`
+	Retention                       Retention       `koanf:"retention" json:"retention,omitzero"`
+	PatroniEtcd                     PatroniEtcd     `koanf:"patroni_etcd" json:"patroni_etcd,omitzero"`
+	// EtcdModeOverride is only read from the generated config. It's set when
+	// the host's etcd mode is changed through the API, and it takes precedence
+	// over the user-specified etcd mode. It's removed when the mode is changed
+	// back to the user-specified etcd mode.
+	EtcdModeOverride EtcdMode `koanf:"etcd_mode_override" json:"etcd_mode_override,omitempty"`
 }
 
 // ClientAddress is a convenience function to return the first client address.
@@ -370,9 +679,30 @@ func (c Config) Validate() error {
 	for _, err := range c.Logging.validate() {
 		errs = append(errs, fmt.Errorf("logging.%w", err))
 	}
+	if c.DiskFullThresholdPercent < 0 || c.DiskFullThresholdPercent > 100 {
+		errs = append(errs, errors.New("disk_full_threshold_percent: must be between 0 and 100"))
+	}
 	for _, err := range c.RandomPorts.validate() {
 		errs = append(errs, fmt.Errorf("random_ports.%w", err))
 	}
+	for _, err := range c.HostReplacement.validate() {
+		errs = append(errs, fmt.Errorf("host_replacement.%w", err))
+	}
+	for _, err := range c.MetadataBackup.validate() {
+		errs = append(errs, fmt.Errorf("metadata_backup.%w", err))
+	}
+	for _, err := range c.DriftDetection.validate() {
+		errs = append(errs, fmt.Errorf("drift_detection.%w", err))
+	}
+	for _, err := range c.TaskConcurrency.validate() {
+		errs = append(errs, fmt.Errorf("task_concurrency.%w", err))
+	}
+	for _, err := range c.Retention.validate() {
+		errs = append(errs, fmt.Errorf("retention.%w", err))
+	}
+	for _, err := range c.PatroniEtcd.validate() {
+		errs = append(errs, fmt.Errorf("patroni_etcd.%w", err))
+	}
 	switch c.Orchestrator {
 	case OrchestratorSwarm:
 		for _, err := range c.DockerSwarm.validate() {
@@ -427,6 +757,12 @@ func DefaultConfig() (Config, error) {
 		SystemD:                         defaultSystemD,
 		RandomPorts:                     defaultRandomPorts,
 		DatabasesMonitorIntervalSeconds: 30,
+		DiskFullThresholdPercent:        90,
+		HostReplacement:                 defaultHostReplacement,
+		MetadataBackup:                  defaultMetadataBackup,
+		DriftDetection:                  defaultDriftDetection,
+		TaskConcurrency:                 defaultTaskConcurrency,
+		Retention:                       defaultRetention,
 	}, nil
 }
 
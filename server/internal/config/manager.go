@@ -13,10 +13,11 @@ import (
 )
 
 type Manager struct {
-	sources     []*Source
-	config      Config
-	generated   Config
-	generatedMu sync.Mutex
+	sources            []*Source
+	config             Config
+	configuredEtcdMode EtcdMode
+	generated          Config
+	generatedMu        sync.Mutex
 }
 
 func NewManager(sources ...*Source) *Manager {
@@ -70,12 +71,19 @@ func (m *Manager) Load() error {
 		return fmt.Errorf("failed to unmarshal combined config: %w", err)
 	}
 
+	configuredEtcdMode := combined.EtcdMode
+	if generated.EtcdModeOverride != "" {
+		combined.EtcdMode = generated.EtcdModeOverride
+	}
+	combined.EtcdModeOverride = generated.EtcdModeOverride
+
 	if err := combined.Validate(); err != nil {
 		return err
 	}
 
 	m.generated = generated
 	m.config = combined
+	m.configuredEtcdMode = configuredEtcdMode
 
 	return nil
 }
@@ -109,6 +117,28 @@ func (m *Manager) UpdateGeneratedConfig(config Config) error {
 	return m.Load()
 }
 
+// SetEtcdModeOverride records an etcd mode in the generated config that takes
+// precedence over the user-specified etcd mode. Setting the mode that's
+// already in the user-specified config removes the override instead, so that
+// later changes to the user-specified etcd mode take effect again. The new mode
+// takes effect the next time the server starts.
+func (m *Manager) SetEtcdModeOverride(mode EtcdMode) error {
+	switch mode {
+	case EtcdModeServer, EtcdModeClient:
+	default:
+		return fmt.Errorf("unsupported etcd mode %q", mode)
+	}
+
+	generated := m.GeneratedConfig()
+	if mode == m.configuredEtcdMode {
+		generated.EtcdModeOverride = ""
+	} else {
+		generated.EtcdModeOverride = mode
+	}
+
+	return m.UpdateGeneratedConfig(generated)
+}
+
 func (m *Manager) loadUserConfig() (*koanf.Koanf, error) {
 	k := koanf.New(".")
 	for _, source := range m.sources {
@@ -51,6 +51,43 @@ func TestManager(t *testing.T) {
 		}), manager.Config())
 	})
 
+	t.Run("with etcd mode override", func(t *testing.T) {
+		data := t.TempDir()
+		user := config.Config{
+			HostID:   "test-host",
+			DataDir:  data,
+			EtcdMode: config.EtcdModeServer,
+		}
+
+		manager := config.NewManager(structSource(t, user))
+		require.NoError(t, manager.Load())
+		assert.Equal(t, config.EtcdModeServer, manager.Config().EtcdMode)
+
+		require.NoError(t, manager.SetEtcdModeOverride(config.EtcdModeClient))
+
+		// The override takes precedence over the user-specified config.
+		assert.Equal(t, config.EtcdModeClient, manager.Config().EtcdMode)
+		assert.Equal(t, config.EtcdModeClient, manager.GeneratedConfig().EtcdModeOverride)
+
+		// The override persists across loads.
+		reloaded := config.NewManager(structSource(t, user))
+		require.NoError(t, reloaded.Load())
+		assert.Equal(t, config.EtcdModeClient, reloaded.Config().EtcdMode)
+
+		assert.ErrorContains(t, manager.SetEtcdModeOverride("invalid"), "unsupported etcd mode")
+
+		// Changing back to the user-specified mode removes the override.
+		require.NoError(t, manager.SetEtcdModeOverride(config.EtcdModeServer))
+		assert.Equal(t, config.EtcdModeServer, manager.Config().EtcdMode)
+		assert.Empty(t, manager.GeneratedConfig().EtcdModeOverride)
+
+		// Later changes to the user-specified mode take effect again.
+		user.EtcdMode = config.EtcdModeClient
+		reloaded = config.NewManager(structSource(t, user))
+		require.NoError(t, reloaded.Load())
+		assert.Equal(t, config.EtcdModeClient, reloaded.Config().EtcdMode)
+	})
+
 	t.Run("invalid user-specified config", func(t *testing.T) {
 		user := config.Config{
 			HostID: "test-host",
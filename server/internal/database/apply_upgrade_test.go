@@ -53,6 +53,10 @@ func (s *stubOrchestrator) GetServiceInstanceStatus(context.Context, string) (*d
 func (s *stubOrchestrator) CreatePgBackRestBackup(context.Context, io.Writer, *database.InstanceSpec, *pgbackrest.BackupOptions) error {
 	return nil
 }
+func (s *stubOrchestrator) ExecuteInstanceCommandWithEnv(context.Context, io.Writer, string, string, []string, ...string) error {
+	return nil
+}
+
 func (s *stubOrchestrator) ExecuteInstanceCommand(context.Context, io.Writer, string, string, ...string) error {
 	return nil
 }
@@ -61,12 +65,21 @@ func (s *stubOrchestrator) ValidateInstanceSpecs(context.Context, []*database.In
 }
 func (s *stubOrchestrator) StopInstance(context.Context, string) error  { return nil }
 func (s *stubOrchestrator) StartInstance(context.Context, string) error { return nil }
+func (s *stubOrchestrator) PgUpgrade(context.Context, io.Writer, *database.PgUpgradeOptions) error {
+	return nil
+}
 func (s *stubOrchestrator) NodeDSN(context.Context, *resource.Context, string, string, string) (*postgres.DSN, error) {
 	return nil, nil
 }
 func (s *stubOrchestrator) InstancePaths(*ds.Version, string) (database.InstancePaths, error) {
 	return database.InstancePaths{}, nil
 }
+func (s *stubOrchestrator) PostgreSQLSystemParameters(*database.InstanceSpec) (map[string]any, error) {
+	return nil, nil
+}
+func (s *stubOrchestrator) GetInstanceResourceUsage(context.Context, string, *ds.Version) (*database.InstanceResourceUsage, error) {
+	return nil, nil
+}
 func (s *stubOrchestrator) ReconcileInstanceSpec(_, _ *database.InstanceSpec) error { return nil }
 func (s *stubOrchestrator) ReconcileServiceInstanceSpec(_, _ *database.ServiceInstanceSpec) error {
 	return nil
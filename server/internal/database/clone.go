@@ -0,0 +1,167 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+var ErrInvalidClone = errors.New("invalid clone")
+
+type CloneMethod string
+
+const (
+	// CloneMethodBackup restores the source node's latest backup and replays
+	// its archived WAL, optionally up to a target time.
+	CloneMethodBackup CloneMethod = "backup"
+	// CloneMethodBaseBackup takes a fresh full backup of the source node from
+	// one of its replicas and then restores it.
+	CloneMethodBaseBackup CloneMethod = "base_backup"
+)
+
+// CloneOptions configures a new database that's cloned from a node of an
+// existing database.
+type CloneOptions struct {
+	DatabaseID      string
+	SourceNode      string
+	Method          CloneMethod
+	TargetTime      *time.Time
+	HostIDs         []string
+	DatabaseName    string
+	RenameUsers     map[string]string
+	ScrubStatements []string
+	// TenantID is optional, but it must match the source database's tenant
+	// when it's set.
+	TenantID *string
+}
+
+// CloneSpec returns the spec for a single-node database that's restored from a
+// node of the given source database. The clone inherits the source node's
+// version, resources, configuration, and users, but not its services, backup
+// configuration, or scripts. Ports that are set in the source are randomly
+// assigned in the clone so that it can run on the same hosts as the source.
+func CloneSpec(source *Spec, opts *CloneOptions) (*Spec, error) {
+	var errs []error
+
+	nodeName := opts.SourceNode
+	if nodeName == "" && len(source.Nodes) > 0 {
+		nodeName = source.Nodes[0].Name
+	}
+	node, err := source.Node(nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClone, err)
+	}
+
+	var repository *pgbackrest.Repository
+	backupConfig := overridableValue(source.BackupConfig, node.BackupConfig)
+	if backupConfig == nil || len(backupConfig.Repositories) == 0 {
+		errs = append(errs, fmt.Errorf("node '%s' does not have a backup repository", nodeName))
+	} else {
+		repository = backupConfig.Repositories[0].Clone()
+	}
+
+	switch opts.Method {
+	case CloneMethodBackup, "":
+	case CloneMethodBaseBackup:
+		if opts.TargetTime != nil {
+			errs = append(errs, fmt.Errorf("target_time cannot be used with the '%s' method", CloneMethodBaseBackup))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported clone method '%s'", opts.Method))
+	}
+
+	users := make([]*User, len(source.DatabaseUsers))
+	names := ds.NewSet[string]()
+	for i, user := range source.DatabaseUsers {
+		users[i] = user.Clone()
+		if newName, ok := opts.RenameUsers[user.Username]; ok {
+			users[i].Username = newName
+		}
+		if names.Has(users[i].Username) {
+			errs = append(errs, fmt.Errorf("more than one user would be named '%s'", users[i].Username))
+		}
+		names.Add(users[i].Username)
+	}
+	for _, oldName := range slices.Sorted(maps.Keys(opts.RenameUsers)) {
+		if !slices.ContainsFunc(source.DatabaseUsers, func(u *User) bool { return u.Username == oldName }) {
+			errs = append(errs, fmt.Errorf("cannot rename '%s': user does not exist in the source database", oldName))
+		}
+		if opts.RenameUsers[oldName] == "" {
+			errs = append(errs, fmt.Errorf("cannot rename '%s': new name cannot be empty", oldName))
+		}
+	}
+
+	if opts.TenantID != nil && !tenantIDsMatch(opts.TenantID, source.TenantID) {
+		errs = append(errs, errors.New("tenant_id must match the source database's tenant"))
+	}
+
+	hostIDs := opts.HostIDs
+	if len(hostIDs) == 0 {
+		hostIDs = node.HostIDs
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClone, err)
+	}
+
+	databaseName := opts.DatabaseName
+	if databaseName == "" {
+		databaseName = source.DatabaseName
+	}
+
+	restoreOptions := map[string]string{}
+	if opts.TargetTime != nil {
+		restoreOptions["type"] = "time"
+		restoreOptions["target"] = opts.TargetTime.UTC().Format("2006-01-02 15:04:05-07")
+	}
+
+	return &Spec{
+		DatabaseID:      opts.DatabaseID,
+		TenantID:        utils.ClonePointer(source.TenantID),
+		DatabaseName:    databaseName,
+		PostgresVersion: overridableValue(source.PostgresVersion, node.PostgresVersion),
+		SpockVersion:    source.SpockVersion,
+		Port:            clonePort(overridableValue(source.Port, node.Port)),
+		PatroniPort:     clonePort(overridableValue(source.PatroniPort, node.PatroniPort)),
+		CPUs:            overridableValue(source.CPUs, node.CPUs),
+		MemoryBytes:     overridableValue(source.MemoryBytes, node.MemoryBytes),
+		Nodes: []*Node{
+			{
+				Name:    "n1",
+				HostIDs: slices.Clone(hostIDs),
+			},
+		},
+		DatabaseUsers:  users,
+		PostgreSQLConf: mergableMapValue(source.PostgreSQLConf, node.PostgreSQLConf),
+		PgHbaConf:      slices.Concat(node.PgHbaConf, source.PgHbaConf),
+		PgIdentConf:    slices.Concat(node.PgIdentConf, source.PgIdentConf),
+		RestoreConfig: &RestoreConfig{
+			SourceDatabaseID:   source.DatabaseID,
+			SourceNodeName:     nodeName,
+			SourceDatabaseName: source.DatabaseName,
+			Repository:         repository,
+			RestoreOptions:     restoreOptions,
+			RenameUsers:        maps.Clone(opts.RenameUsers),
+			ScrubStatements:    slices.Clone(opts.ScrubStatements),
+		},
+		OrchestratorOpts: overridableValue(source.OrchestratorOpts, node.OrchestratorOpts).Clone(),
+		Patroni:          source.Patroni.Merge(node.Patroni),
+		QueryStats:       source.QueryStats.Clone(),
+		DriftPolicy:      source.DriftPolicy,
+		PatroniDCS:       source.PatroniDCS,
+	}, nil
+}
+
+// clonePort returns a port that's randomly assigned if the given port is set.
+func clonePort(port *int) *int {
+	if port == nil {
+		return nil
+	}
+	return utils.PointerTo(0)
+}
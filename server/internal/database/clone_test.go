@@ -0,0 +1,140 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestCloneSpec(t *testing.T) {
+	source := &database.Spec{
+		DatabaseID:      "storefront",
+		DatabaseName:    "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Port:            utils.PointerTo(5432),
+		PostgreSQLConf:  map[string]any{"max_connections": 200},
+		BackupConfig: &database.BackupConfig{
+			Repositories: []*pgbackrest.Repository{
+				{ID: "default", Type: pgbackrest.RepositoryTypePosix, BasePath: "/backups"},
+			},
+			Schedules: []*database.BackupSchedule{
+				{ID: "daily", Type: "full", CronExpression: "0 0 * * *"},
+			},
+		},
+		Nodes: []*database.Node{
+			{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+			{
+				Name:            "n2",
+				HostIDs:         []string{"host-3"},
+				PostgresVersion: "18.1",
+				PostgreSQLConf:  map[string]any{"work_mem": "64MB"},
+			},
+		},
+		DatabaseUsers: []*database.User{
+			{Username: "admin", Password: "secret", DBOwner: true},
+			{Username: "app", Password: "secret", Roles: []string{"pgedge_application"}},
+		},
+		Scripts: &database.ScriptStatements{
+			PostInit: []string{"CREATE ROLE reporting NOLOGIN"},
+		},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		spec, err := database.CloneSpec(source, &database.CloneOptions{
+			DatabaseID: "storefront-staging",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "storefront-staging", spec.DatabaseID)
+		assert.Equal(t, "storefront", spec.DatabaseName)
+		assert.Equal(t, "17.6", spec.PostgresVersion)
+		assert.Equal(t, utils.PointerTo(0), spec.Port)
+		assert.Nil(t, spec.PatroniPort)
+		assert.Equal(t, []*database.Node{
+			{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+		}, spec.Nodes)
+		assert.Equal(t, source.DatabaseUsers, spec.DatabaseUsers)
+		assert.Nil(t, spec.BackupConfig)
+		assert.Nil(t, spec.Scripts)
+		assert.Equal(t, &database.RestoreConfig{
+			SourceDatabaseID:   "storefront",
+			SourceNodeName:     "n1",
+			SourceDatabaseName: "storefront",
+			Repository:         source.BackupConfig.Repositories[0],
+			RestoreOptions:     map[string]string{},
+		}, spec.RestoreConfig)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		targetTime := time.Date(2026, 10, 18, 9, 30, 0, 0, time.FixedZone("EDT", -4*60*60))
+		spec, err := database.CloneSpec(source, &database.CloneOptions{
+			DatabaseID:      "storefront-staging",
+			SourceNode:      "n2",
+			Method:          database.CloneMethodBackup,
+			TargetTime:      &targetTime,
+			HostIDs:         []string{"host-4"},
+			DatabaseName:    "storefront_staging",
+			RenameUsers:     map[string]string{"app": "app_staging"},
+			ScrubStatements: []string{"TRUNCATE payment_methods"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "storefront_staging", spec.DatabaseName)
+		assert.Equal(t, "18.1", spec.PostgresVersion)
+		assert.Equal(t, map[string]any{"max_connections": 200, "work_mem": "64MB"}, spec.PostgreSQLConf)
+		assert.Equal(t, []string{"host-4"}, spec.Nodes[0].HostIDs)
+		assert.Equal(t, "app_staging", spec.DatabaseUsers[1].Username)
+		assert.Equal(t, "app", source.DatabaseUsers[1].Username)
+		assert.Equal(t, &database.RestoreConfig{
+			SourceDatabaseID:   "storefront",
+			SourceNodeName:     "n2",
+			SourceDatabaseName: "storefront",
+			Repository:         source.BackupConfig.Repositories[0],
+			RestoreOptions: map[string]string{
+				"type":   "time",
+				"target": "2026-10-18 13:30:00+00",
+			},
+			RenameUsers:     map[string]string{"app": "app_staging"},
+			ScrubStatements: []string{"TRUNCATE payment_methods"},
+		}, spec.RestoreConfig)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		noBackups := source.Clone()
+		noBackups.BackupConfig = nil
+
+		targetTime := time.Now()
+		_, err := database.CloneSpec(noBackups, &database.CloneOptions{
+			DatabaseID: "storefront-staging",
+			Method:     database.CloneMethodBaseBackup,
+			TargetTime: &targetTime,
+			RenameUsers: map[string]string{
+				"app":     "admin",
+				"missing": "other",
+			},
+			TenantID: utils.PointerTo("other-tenant"),
+		})
+		assert.ErrorIs(t, err, database.ErrInvalidClone)
+		assert.ErrorContains(t, err, "tenant_id must match the source database's tenant")
+		assert.ErrorContains(t, err, "node 'n1' does not have a backup repository")
+		assert.ErrorContains(t, err, "target_time cannot be used with the 'base_backup' method")
+		assert.ErrorContains(t, err, "more than one user would be named 'admin'")
+		assert.ErrorContains(t, err, "cannot rename 'missing': user does not exist in the source database")
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, err := database.CloneSpec(source, &database.CloneOptions{
+			DatabaseID: "storefront-staging",
+			SourceNode: "n3",
+		})
+		assert.ErrorIs(t, err, database.ErrInvalidClone)
+		assert.ErrorIs(t, err, database.ErrNodeNotInDBSpec)
+	})
+}
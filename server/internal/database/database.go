@@ -1,6 +1,8 @@
 package database
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pgEdge/control-plane/server/internal/ds"
@@ -52,6 +54,16 @@ type Database struct {
 	Instances        []*Instance
 	ServiceInstances []*ServiceInstance
 	NotCreated       bool
+	Drift            *Drift
+	// DegradedReasons describes the conditions that degrade the database
+	// without changing its state, such as instances that are running out of
+	// disk space.
+	DegradedReasons []string
+	// PatroniDCSSwitchNodes are the nodes that are still switching to a
+	// different patroni_dcs.
+	PatroniDCSSwitchNodes []string
+	// Importing is true until the database's import is cut over.
+	Importing bool
 }
 
 func (d *Database) Variables() resource.Variables {
@@ -60,29 +72,62 @@ func (d *Database) Variables() resource.Variables {
 	}
 }
 
+// ReportedState returns the state that's reported to clients. An available
+// database is reported as degraded while it has degraded reasons, but its
+// stored state doesn't change so that it doesn't block operations.
+func (d *Database) ReportedState() DatabaseState {
+	if d.State == DatabaseStateAvailable && len(d.DegradedReasons) > 0 {
+		return DatabaseStateDegraded
+	}
+	return d.State
+}
+
 func databaseToStored(d *Database) *StoredDatabase {
 	return &StoredDatabase{
-		DatabaseID: d.DatabaseID,
-		TenantID:   d.TenantID,
-		CreatedAt:  d.CreatedAt,
-		UpdatedAt:  d.UpdatedAt,
-		State:      d.State,
-		NotCreated: d.NotCreated,
+		DatabaseID:            d.DatabaseID,
+		TenantID:              d.TenantID,
+		CreatedAt:             d.CreatedAt,
+		UpdatedAt:             d.UpdatedAt,
+		State:                 d.State,
+		NotCreated:            d.NotCreated,
+		PatroniDCSSwitchNodes: slices.Clone(d.PatroniDCSSwitchNodes),
+		Importing:             d.Importing,
 	}
 }
 
 func storedToDatabase(d *StoredDatabase, storedSpec *StoredSpec, instances []*Instance, serviceInstances []*ServiceInstance) *Database {
 	return &Database{
-		DatabaseID:       d.DatabaseID,
-		TenantID:         d.TenantID,
-		CreatedAt:        d.CreatedAt,
-		UpdatedAt:        d.UpdatedAt,
-		State:            d.State,
-		Spec:             storedSpec.Spec,
-		Instances:        instances,
-		ServiceInstances: serviceInstances,
-		NotCreated:       d.NotCreated,
+		DatabaseID:            d.DatabaseID,
+		TenantID:              d.TenantID,
+		CreatedAt:             d.CreatedAt,
+		UpdatedAt:             d.UpdatedAt,
+		State:                 d.State,
+		Spec:                  storedSpec.Spec,
+		Instances:             instances,
+		ServiceInstances:      serviceInstances,
+		NotCreated:            d.NotCreated,
+		DegradedReasons:       degradedReasons(instances),
+		PatroniDCSSwitchNodes: slices.Clone(d.PatroniDCSSwitchNodes),
+		Importing:             d.Importing,
+	}
+}
+
+// degradedReasons is computed when the database is read rather than stored
+// in the database's state, because the state guards the database's
+// operations.
+func degradedReasons(instances []*Instance) []string {
+	var reasons []string
+	for _, instance := range instances {
+		if instance.Status == nil || !instance.Status.IsDiskFull() {
+			continue
+		}
+		if used, ok := instance.Status.Resources.VolumeUsedPercent(); ok {
+			reasons = append(reasons, fmt.Sprintf("instance '%s' is running out of disk space (%.0f%% used)", instance.InstanceID, used))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("instance '%s' is running out of disk space", instance.InstanceID))
+		}
 	}
+	return reasons
 }
 
 func storedToDatabases(storedDbs []*StoredDatabase, storedSpecs []*StoredSpec, allInstances []*Instance, allServiceInstances []*ServiceInstance) []*Database {
@@ -16,6 +16,12 @@ type StoredDatabase struct {
 	UpdatedAt  time.Time     `json:"updated_at"`
 	State      DatabaseState `json:"state"`
 	NotCreated bool          `json:"not_created"`
+	// PatroniDCSSwitchNodes are the nodes that have been reconfigured for a
+	// different patroni_dcs, but that haven't been restarted with it yet.
+	PatroniDCSSwitchNodes []string `json:"patroni_dcs_switch_nodes,omitempty"`
+	// Importing is true from the start of an import until it's cut over,
+	// while the import subscription is still applying the source's changes.
+	Importing bool `json:"importing,omitempty"`
 }
 
 type DatabaseStore struct {
@@ -61,6 +67,25 @@ func (s *DatabaseStore) GetAll() storage.GetMultipleOp[*StoredDatabase] {
 	return storage.NewGetPrefixOp[*StoredDatabase](s.client, prefix)
 }
 
+// GetRange returns up to limit databases, ordered by ID, with IDs that sort
+// after afterDatabaseID. An empty afterDatabaseID starts from the beginning.
+func (s *DatabaseStore) GetRange(afterDatabaseID string, limit int) storage.GetMultipleOp[*StoredDatabase] {
+	rangeStart := s.Prefix()
+	rangeEnd := clientv3.GetPrefixRangeEnd(rangeStart)
+	if afterDatabaseID != "" {
+		rangeStart = s.Key(afterDatabaseID) + "\x00"
+	}
+
+	opOptions := []clientv3.OpOption{
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	}
+	if limit > 0 {
+		opOptions = append(opOptions, clientv3.WithLimit(int64(limit)))
+	}
+
+	return storage.NewGetRangeOp[*StoredDatabase](s.client, rangeStart, rangeEnd, opOptions...)
+}
+
 func (s *DatabaseStore) Create(item *StoredDatabase) storage.PutOp[*StoredDatabase] {
 	key := s.Key(item.DatabaseID)
 	return storage.NewCreateOp(s.client, key, item)
@@ -75,3 +100,10 @@ func (s *DatabaseStore) Delete(item *StoredDatabase) storage.DeleteValueOp[*Stor
 	key := s.Key(item.DatabaseID)
 	return storage.NewDeleteValueOp(s.client, key, item)
 }
+
+// WatchChanges watches for modifications to any database, including the
+// previous value for each modification.
+func (s *DatabaseStore) WatchChanges() storage.WatchOp[*StoredDatabase] {
+	prefix := s.Prefix()
+	return storage.NewWatchPrefixChangesOp[*StoredDatabase](s.client, prefix, clientv3.WithPrevKV())
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// DriftPolicy controls what happens when a drift check finds that a
+// database's resources no longer match its spec.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport records the drift on the database without changing
+	// it. This is the default.
+	DriftPolicyReport DriftPolicy = "report"
+	// DriftPolicyRemediate records the drift and then starts an update task to
+	// bring the database's resources back in line with its spec.
+	DriftPolicyRemediate DriftPolicy = "remediate"
+)
+
+// Drift is the result of a drift check. Resources contains an entry for each
+// resource that would be created, updated, or deleted by an update with the
+// database's current spec. Resources that would only be updated because one
+// of their dependencies changed are omitted.
+type Drift struct {
+	CheckedAt         time.Time                `json:"checked_at"`
+	Resources         []*resource.EventSummary `json:"resources"`
+	Error             string                   `json:"error,omitempty"`
+	RemediationTaskID *uuid.UUID               `json:"remediation_task_id,omitempty"`
+}
+
+func (d *Drift) HasDrift() bool {
+	return d != nil && len(d.Resources) > 0
+}
+
+// DriftedResources returns the resources that differ between the given plans'
+// current and desired states, sorted by resource ID.
+func DriftedResources(plans []resource.Plan) []*resource.EventSummary {
+	seen := ds.NewSet[string]()
+	drifted := []*resource.EventSummary{}
+	for _, plan := range plans {
+		for _, phase := range plan {
+			for _, event := range phase {
+				if event.Reason == resource.EventReasonDependencyUpdated {
+					continue
+				}
+				summary := event.Summary()
+				if seen.Has(summary.ResourceID) {
+					continue
+				}
+				seen.Add(summary.ResourceID)
+				drifted = append(drifted, summary)
+			}
+		}
+	}
+	slices.SortFunc(drifted, func(a, b *resource.EventSummary) int {
+		return strings.Compare(a.ResourceID, b.ResourceID)
+	})
+
+	return drifted
+}
+
+// GetDrift returns the result of the most recent drift check for the given
+// database. It returns nil if the database has not been checked.
+func (s *Service) GetDrift(ctx context.Context, databaseID string) (*Drift, error) {
+	stored, err := s.store.Drift.
+		GetByKey(databaseID).
+		Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get stored drift: %w", err)
+	}
+
+	return stored.Drift, nil
+}
+
+func (s *Service) SetDrift(ctx context.Context, databaseID string, drift *Drift) error {
+	err := s.store.Drift.
+		Put(&StoredDrift{
+			DatabaseID: databaseID,
+			Drift:      drift,
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update stored drift: %w", err)
+	}
+
+	return nil
+}
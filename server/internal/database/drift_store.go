@@ -0,0 +1,49 @@
+package database
+
+import (
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// StoredDrift holds the result of the most recent drift check for a database.
+type StoredDrift struct {
+	storage.StoredValue
+	DatabaseID string `json:"database_id"`
+	Drift      *Drift `json:"drift"`
+}
+
+type DriftStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewDriftStore(client *clientv3.Client, root string) *DriftStore {
+	return &DriftStore{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *DriftStore) Prefix() string {
+	return storage.Prefix("/", s.root, "drift")
+}
+
+func (s *DriftStore) Key(databaseID string) string {
+	return storage.Key(s.Prefix(), databaseID)
+}
+
+func (s *DriftStore) GetByKey(databaseID string) storage.GetOp[*StoredDrift] {
+	key := s.Key(databaseID)
+	return storage.NewGetOp[*StoredDrift](s.client, key)
+}
+
+func (s *DriftStore) Put(item *StoredDrift) storage.PutOp[*StoredDrift] {
+	key := s.Key(item.DatabaseID)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *DriftStore) DeleteByKey(databaseID string) storage.DeleteOp {
+	key := s.Key(databaseID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
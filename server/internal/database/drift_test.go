@@ -0,0 +1,87 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+)
+
+func TestDriftedResources(t *testing.T) {
+	data := func(id string, attributes string) *resource.ResourceData {
+		return &resource.ResourceData{
+			Identifier: resource.Identifier{ID: id, Type: "test.resource"},
+			Attributes: []byte(attributes),
+		}
+	}
+	current := data("b", `{"memory":"512MiB"}`)
+	desired := data("b", `{"memory":"1GiB"}`)
+	diff, err := current.Diff(desired)
+	require.NoError(t, err)
+
+	plans := []resource.Plan{
+		{
+			{
+				{Type: resource.EventTypeUpdate, Resource: desired, Reason: resource.EventReasonHasDiff, Diff: diff},
+				{Type: resource.EventTypeCreate, Resource: data("a", `{}`), Reason: resource.EventReasonDoesNotExist},
+			},
+			{
+				{Type: resource.EventTypeUpdate, Resource: data("c", `{}`), Reason: resource.EventReasonDependencyUpdated},
+			},
+		},
+		{
+			{
+				// Resources that appear in multiple plans are only reported
+				// once.
+				{Type: resource.EventTypeUpdate, Resource: desired, Reason: resource.EventReasonHasDiff, Diff: diff},
+				{Type: resource.EventTypeDelete, Resource: data("d", `{}`)},
+			},
+		},
+	}
+
+	drifted := database.DriftedResources(plans)
+	require.Len(t, drifted, 3)
+	assert.Equal(t, "test.resource::a", drifted[0].ResourceID)
+	assert.Equal(t, resource.EventTypeCreate, drifted[0].Type)
+	assert.Equal(t, "test.resource::b", drifted[1].ResourceID)
+	assert.Equal(t, resource.EventReasonHasDiff, drifted[1].Reason)
+	assert.JSONEq(t, `[{"op":"replace","path":"/memory","value":"1GiB"}]`, string(drifted[1].Diff))
+	assert.Equal(t, "test.resource::d", drifted[2].ResourceID)
+	assert.Equal(t, resource.EventTypeDelete, drifted[2].Type)
+
+	assert.Empty(t, database.DriftedResources(nil))
+}
+
+func TestService_Drift(t *testing.T) {
+	svc := newTestService(t, &stubOrchestrator{})
+	ctx := t.Context()
+
+	drift, err := svc.GetDrift(ctx, "storefront")
+	require.NoError(t, err)
+	assert.Nil(t, drift)
+
+	taskID := uuid.New()
+	expected := &database.Drift{
+		CheckedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Resources: []*resource.EventSummary{
+			{
+				Type:       resource.EventTypeCreate,
+				ResourceID: "test.resource::a",
+				Reason:     resource.EventReasonDoesNotExist,
+				Diff:       resource.RawJSON("null"),
+			},
+		},
+		RemediationTaskID: &taskID,
+	}
+	require.NoError(t, svc.SetDrift(ctx, "storefront", expected))
+
+	drift, err = svc.GetDrift(ctx, "storefront")
+	require.NoError(t, err)
+	assert.Equal(t, expected, drift)
+	assert.True(t, drift.HasDrift())
+}
@@ -0,0 +1,246 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+)
+
+var ErrInvalidImportSource = errors.New("invalid import source")
+
+// ImportSource is an external Postgres server that a new database is populated
+// from. Its roles and schema are copied into the new database, and then its
+// data is streamed with a native logical replication subscription until the
+// import is cut over.
+type ImportSource struct {
+	DSN string `json:"dsn"`
+}
+
+// Validate checks the source's connection string and that the given spec can
+// receive the import. Changes that are applied by the import subscription
+// aren't replicated to other Spock nodes, so the database must start with a
+// single node. More nodes can be added after the cutover.
+func (s *ImportSource) Validate(spec *Spec) error {
+	var errs []error
+	if _, err := pgconn.ParseConfig(s.DSN); err != nil {
+		// The parse error can include the password from the connection
+		// string, so it's deliberately left out.
+		errs = append(errs, errors.New("dsn is not a valid connection string"))
+	}
+	if len(spec.Nodes) != 1 {
+		errs = append(errs, errors.New("the database must have exactly one node during an import"))
+	}
+	if spec.RestoreConfig != nil {
+		errs = append(errs, errors.New("restore_config cannot be combined with an import"))
+	}
+	for _, node := range spec.Nodes {
+		if node.RestoreConfig != nil {
+			errs = append(errs, fmt.Errorf("node '%s' has a restore_config, which cannot be combined with an import", node.Name))
+		}
+		if node.SourceNode != "" {
+			errs = append(errs, fmt.Errorf("node '%s' has a source_node, which cannot be combined with an import", node.Name))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidImportSource, err)
+	}
+
+	return nil
+}
+
+// ValidateImportingSpec checks that an update to a database with an import
+// that hasn't been cut over keeps it to a single node. Rows that are applied
+// by the import subscription wouldn't be replicated to the new nodes.
+func ValidateImportingSpec(spec *Spec) error {
+	if len(spec.Nodes) != 1 {
+		return errors.New("the database must have exactly one node until its import is cut over")
+	}
+	return nil
+}
+
+var nonSlotNameChars = regexp.MustCompile(`[^a-z0-9_]`)
+
+// ImportSubscriptionName returns the name that's used for the import's
+// subscription, as well as its publication and replication slot on the source
+// server. Slot names are restricted to lowercase letters, numbers, and
+// underscores.
+func ImportSubscriptionName(databaseID string) string {
+	name := "pgedge_import_" + nonSlotNameChars.ReplaceAllString(strings.ToLower(databaseID), "_")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return name
+}
+
+var grantedByRegex = regexp.MustCompile(`(?i) granted by [^;]+;$`)
+var alterRoleRegex = regexp.MustCompile(`(?i)^(alter|comment on) role ("(?:[^"]|"")+"|[^ ;]+)`)
+
+// FilterImportedRoles removes the roles that already exist in the new
+// database from a roles dump of the source server, along with any statements
+// that would alter them. The existing roles are managed by the Control Plane
+// and must not be modified by the import. GRANTED BY clauses are removed
+// because the grantor might not exist in the new database.
+func FilterImportedRoles(roles, statements, existing []string) ([]string, []string) {
+	var filteredRoles []string
+	for _, role := range roles {
+		if !slices.Contains(existing, role) {
+			filteredRoles = append(filteredRoles, role)
+		}
+	}
+
+	filteredStatements := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		if m := alterRoleRegex.FindStringSubmatch(statement); len(m) > 2 {
+			if slices.Contains(existing, postgres.UnquoteIdentifier(m[2])) {
+				continue
+			}
+		}
+		filteredStatements = append(filteredStatements, grantedByRegex.ReplaceAllString(statement, ";"))
+	}
+
+	return filteredRoles, filteredStatements
+}
+
+// ImportSchemaScriptOptions configures the shell script produced by
+// ImportSchemaScript.
+type ImportSchemaScriptOptions struct {
+	SourceDSN    string
+	DatabaseName string
+	Port         int
+}
+
+// ImportSchemaScript returns a shell script that copies the source database's
+// schema into the new database. The Spock schema is excluded in case the
+// source is itself a pgEdge database, and publications and subscriptions are
+// excluded because the import manages its own.
+func ImportSchemaScript(opts ImportSchemaScriptOptions) string {
+	var script strings.Builder
+	script.WriteString(`set -e
+schema="$(mktemp)"
+trap 'rm -f "$schema"' EXIT
+`)
+	fmt.Fprintf(&script,
+		`pg_dump --schema-only --no-publications --no-subscriptions --exclude-schema=spock --file="$schema" --dbname=%s`+"\n",
+		shellQuote(opts.SourceDSN),
+	)
+	// The source password, if any, is only meant for pg_dump.
+	script.WriteString("unset PGPASSWORD\n")
+	fmt.Fprintf(&script,
+		`psql --no-psqlrc --quiet --set=ON_ERROR_STOP=1 --single-transaction --username=%s --host=localhost --port=%d --dbname=%s --file="$schema"`+"\n",
+		pgEdgeUser, opts.Port, shellQuote(opts.DatabaseName),
+	)
+
+	return script.String()
+}
+
+// SplitDSNPassword removes the password from a connection string, in either
+// the URI or the keyword/value format, and returns it separately. The import
+// passes the password to the dump tools through the environment so that it
+// isn't visible in their command lines.
+func SplitDSNPassword(dsn string) (string, string, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return splitURIPassword(dsn)
+	}
+	return splitKeywordValuePassword(dsn)
+}
+
+func splitURIPassword(dsn string) (string, string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		// The parse error includes the connection string.
+		return "", "", errors.New("dsn is not a valid connection string")
+	}
+	var password string
+	if u.User != nil {
+		if p, ok := u.User.Password(); ok {
+			password = p
+			u.User = url.User(u.User.Username())
+		}
+	}
+	query := u.Query()
+	if query.Has("password") {
+		password = query.Get("password")
+		query.Del("password")
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String(), password, nil
+}
+
+func splitKeywordValuePassword(dsn string) (string, string, error) {
+	var password string
+	var fields []string
+	s := strings.TrimSpace(dsn)
+	for s != "" {
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			return "", "", errors.New("dsn is not a valid connection string")
+		}
+		key := strings.TrimSpace(s[:eq])
+		s = strings.TrimLeft(s[eq+1:], " \t\n\r\v\f")
+
+		var value strings.Builder
+		var rest string
+		if strings.HasPrefix(s, "'") {
+			closed := false
+		quoted:
+			for i := 1; i < len(s); i++ {
+				switch s[i] {
+				case '\\':
+					i++
+					if i < len(s) {
+						value.WriteByte(s[i])
+					}
+				case '\'':
+					closed = true
+					rest = s[i+1:]
+					break quoted
+				default:
+					value.WriteByte(s[i])
+				}
+			}
+			if !closed {
+				return "", "", errors.New("dsn is not a valid connection string")
+			}
+		} else {
+			end := len(s)
+			for i := 0; i < len(s); i++ {
+				if s[i] == '\\' {
+					i++
+					if i < len(s) {
+						value.WriteByte(s[i])
+					}
+					continue
+				}
+				if strings.IndexByte(" \t\n\r\v\f", s[i]) >= 0 {
+					end = i
+					break
+				}
+				value.WriteByte(s[i])
+			}
+			rest = s[end:]
+		}
+		s = strings.TrimSpace(rest)
+
+		if key == "password" {
+			password = value.String()
+			continue
+		}
+		fields = append(fields, key+"="+quoteDSNValue(value.String()))
+	}
+
+	return strings.Join(fields, " "), password, nil
+}
+
+func quoteDSNValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
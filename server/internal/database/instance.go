@@ -100,17 +100,25 @@ type InstanceStatus struct {
 	Role            *patroni.InstanceRole `json:"role,omitempty"`
 	ReadOnly        *string               `json:"read_only,omitempty"`
 	PendingRestart  *bool                 `json:"pending_restart,omitempty"`
-	PatroniPaused   *bool                 `json:"patroni_paused,omitempty"`
-	StatusUpdatedAt *time.Time            `json:"status_updated_at,omitempty"`
-	Stopped         *bool                 `json:"stopped,omitempty"`
-	Subscriptions   []SubscriptionStatus  `json:"subscriptions,omitempty"`
-	Error           *string               `json:"error,omitempty"`
+	// PendingRestartSettings are the names of the settings that are waiting
+	// for a restart to take effect.
+	PendingRestartSettings []string               `json:"pending_restart_settings,omitempty"`
+	PatroniPaused          *bool                  `json:"patroni_paused,omitempty"`
+	StatusUpdatedAt        *time.Time             `json:"status_updated_at,omitempty"`
+	Stopped                *bool                  `json:"stopped,omitempty"`
+	Subscriptions          []SubscriptionStatus   `json:"subscriptions,omitempty"`
+	Resources              *InstanceResourceUsage `json:"resources,omitempty"`
+	Error                  *string                `json:"error,omitempty"`
 }
 
 func (s *InstanceStatus) IsPrimary() bool {
 	return s.Role != nil && *s.Role == patroni.InstanceRolePrimary
 }
 
+func (s *InstanceStatus) IsDiskFull() bool {
+	return s.Resources != nil && s.Resources.DiskFull != nil && *s.Resources.DiskFull
+}
+
 func (s *InstanceStatus) IsStale() bool {
 	return s.StatusUpdatedAt == nil || s.StatusUpdatedAt.Before(time.Now().Add(-2*InstanceMonitorRefreshInterval))
 }
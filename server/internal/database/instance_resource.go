@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"time"
 
@@ -236,6 +237,9 @@ func (r *InstanceResource) initializeInstance(ctx context.Context, rc *resource.
 	}
 	defer tx.Rollback(ctx)
 
+	if err := r.renameRestoredRoles(ctx, tx); err != nil {
+		return err
+	}
 	if err := r.createRoles(ctx, rc, tx); err != nil {
 		return err
 	}
@@ -365,6 +369,23 @@ func (r *InstanceResource) patroniClient() *patroni.Client {
 	return patroni.NewClient(r.ConnectionInfo.PatroniURL(), nil)
 }
 
+// renameRestoredRoles renames roles that were restored from a backup so that
+// they're matched with the renamed users in the spec.
+func (r *InstanceResource) renameRestoredRoles(ctx context.Context, tx pgx.Tx) error {
+	if r.Spec.RestoreConfig == nil {
+		return nil
+	}
+	renames := r.Spec.RestoreConfig.RenameUsers
+	for _, oldName := range slices.Sorted(maps.Keys(renames)) {
+		err := postgres.RenameRole(oldName, renames[oldName]).Exec(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to rename role %q to %q: %w", oldName, renames[oldName], err)
+		}
+	}
+
+	return nil
+}
+
 func (r *InstanceResource) createRoles(ctx context.Context, rc *resource.Context, tx pgx.Tx) error {
 	source, err := resource.FromContext[*RolesSourceResource](rc, RolesSourceResourceIdentifier(r.Spec.NodeName))
 	if errors.Is(err, resource.ErrNotFound) {
@@ -66,3 +66,14 @@ func (s *InstanceStatusStore) DeleteByDatabaseID(databaseID string) storage.Dele
 	prefix := s.DatabasePrefix(databaseID)
 	return storage.NewDeletePrefixOp(s.client, prefix)
 }
+
+// WatchChanges watches for modifications to the instance statuses for the
+// given database, including the previous value for each modification. An
+// empty databaseID watches all databases.
+func (s *InstanceStatusStore) WatchChanges(databaseID string) storage.WatchOp[*StoredInstanceStatus] {
+	prefix := s.Prefix()
+	if databaseID != "" {
+		prefix = s.DatabasePrefix(databaseID)
+	}
+	return storage.NewWatchPrefixChangesOp[*StoredInstanceStatus](s.client, prefix, clientv3.WithPrevKV())
+}
@@ -151,3 +151,14 @@ func (s *InstanceStore) DeleteByDatabaseID(databaseID string) storage.DeleteOp {
 	prefix := s.DatabasePrefix(databaseID)
 	return storage.NewDeletePrefixOp(s.client, prefix)
 }
+
+// WatchChanges watches for modifications to the instances for the
+// given database, including the previous value for each modification. An
+// empty databaseID watches all databases.
+func (s *InstanceStore) WatchChanges(databaseID string) storage.WatchOp[*StoredInstance] {
+	prefix := s.Prefix()
+	if databaseID != "" {
+		prefix = s.DatabasePrefix(databaseID)
+	}
+	return storage.NewWatchPrefixChangesOp[*StoredInstance](s.client, prefix, clientv3.WithPrevKV())
+}
@@ -0,0 +1,200 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+)
+
+// listBatchSize is the number of databases that ListDatabases reads from
+// storage at a time while applying filters.
+const listBatchSize = 100
+
+type DatabaseListOptions struct {
+	Limit           int
+	AfterDatabaseID string
+
+	// Optional filters
+	TenantID        string
+	States          []DatabaseState
+	HostID          string
+	PostgresVersion *ds.Version
+
+	// ExcludeInstances skips fetching the instances and service instances for
+	// each database.
+	ExcludeInstances bool
+}
+
+func (o DatabaseListOptions) matchesDatabase(db *StoredDatabase) bool {
+	if o.TenantID != "" && (db.TenantID == nil || *db.TenantID != o.TenantID) {
+		return false
+	}
+	if len(o.States) > 0 && !slices.Contains(o.States, db.State) {
+		return false
+	}
+	return true
+}
+
+func (o DatabaseListOptions) matchesSpec(spec *Spec) bool {
+	if o.HostID != "" && !spec.HasHost(o.HostID) {
+		return false
+	}
+	if o.PostgresVersion != nil && !specHasPostgresVersion(spec, o.PostgresVersion) {
+		return false
+	}
+	return true
+}
+
+// specHasPostgresVersion returns true if the database-level Postgres version
+// or any node-level override matches the given version. Versions are matched
+// by prefix, so "17" matches "17.6".
+func specHasPostgresVersion(spec *Spec, version *ds.Version) bool {
+	versions := []string{spec.PostgresVersion}
+	for _, node := range spec.Nodes {
+		if node.PostgresVersion != "" {
+			versions = append(versions, node.PostgresVersion)
+		}
+	}
+	for _, v := range versions {
+		parsed, err := ds.ParseVersion(v)
+		if err != nil {
+			continue
+		}
+		if parsed.HasPrefix(version) {
+			return true
+		}
+	}
+	return false
+}
+
+type DatabaseList struct {
+	Databases []*Database
+	// NextDatabaseID is set to the last database ID in Databases when there are
+	// more results. It can be passed as AfterDatabaseID to fetch the next page.
+	NextDatabaseID string
+}
+
+// ListDatabases returns databases that match the given options, ordered by
+// database ID. Unlike GetDatabases, it only reads the specs and instances for
+// the databases that it returns.
+func (s *Service) ListDatabases(ctx context.Context, options DatabaseListOptions) (*DatabaseList, error) {
+	var matched []*Database
+	hasMore := func() bool {
+		return options.Limit > 0 && len(matched) > options.Limit
+	}
+
+	after := options.AfterDatabaseID
+	for !hasMore() {
+		storedDbs, err := s.store.Database.
+			GetRange(after, listBatchSize).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get databases: %w", err)
+		}
+		if len(storedDbs) == 0 {
+			break
+		}
+		after = storedDbs[len(storedDbs)-1].DatabaseID
+
+		var candidates []*StoredDatabase
+		var candidateIDs []string
+		for _, db := range storedDbs {
+			if options.matchesDatabase(db) {
+				candidates = append(candidates, db)
+				candidateIDs = append(candidateIDs, db.DatabaseID)
+			}
+		}
+		if len(candidates) > 0 {
+			storedSpecs, err := s.store.Spec.
+				GetByKeys(candidateIDs...).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get database specs: %w", err)
+			}
+			specsByID := make(map[string]*StoredSpec, len(storedSpecs))
+			for _, spec := range storedSpecs {
+				specsByID[spec.DatabaseID] = spec
+			}
+			for _, db := range candidates {
+				spec, ok := specsByID[db.DatabaseID]
+				if !ok || !options.matchesSpec(spec.Spec) {
+					continue
+				}
+				matched = append(matched, storedToDatabase(db, spec, nil, nil))
+				if hasMore() {
+					break
+				}
+			}
+		}
+
+		if len(storedDbs) < listBatchSize {
+			break
+		}
+	}
+
+	list := &DatabaseList{Databases: matched}
+	if hasMore() {
+		list.Databases = matched[:options.Limit]
+		list.NextDatabaseID = list.Databases[options.Limit-1].DatabaseID
+	}
+
+	if !options.ExcludeInstances {
+		if err := s.populateInstances(ctx, list.Databases); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// populateInstances fetches the instances and service instances for the given
+// databases. Small pages are fetched per-database, otherwise all instances are
+// fetched at once.
+func (s *Service) populateInstances(ctx context.Context, databases []*Database) error {
+	if len(databases) > listBatchSize {
+		instances, err := s.GetAllInstances(ctx)
+		if err != nil {
+			return err
+		}
+		serviceInstances, err := s.GetAllServiceInstances(ctx)
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]*Database, len(databases))
+		for _, db := range databases {
+			byID[db.DatabaseID] = db
+		}
+		for _, instance := range instances {
+			if db, ok := byID[instance.DatabaseID]; ok {
+				db.Instances = append(db.Instances, instance)
+			}
+		}
+		for _, serviceInstance := range serviceInstances {
+			if db, ok := byID[serviceInstance.DatabaseID]; ok {
+				db.ServiceInstances = append(db.ServiceInstances, serviceInstance)
+			}
+		}
+		for _, db := range databases {
+			db.DegradedReasons = degradedReasons(db.Instances)
+		}
+		return nil
+	}
+
+	for _, db := range databases {
+		instances, err := s.GetInstances(ctx, db.DatabaseID)
+		if err != nil {
+			return fmt.Errorf("failed to get database instances: %w", err)
+		}
+		serviceInstances, err := s.GetServiceInstances(ctx, db.DatabaseID)
+		if err != nil {
+			return fmt.Errorf("failed to get service instances: %w", err)
+		}
+		db.Instances = instances
+		db.ServiceInstances = serviceInstances
+		db.DegradedReasons = degradedReasons(instances)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestService_ListDatabases(t *testing.T) {
+	ctx := t.Context()
+	svc := newTestService(t, &stubOrchestrator{})
+
+	for _, db := range []struct {
+		id       string
+		tenantID *string
+		version  string
+		hostID   string
+	}{
+		{id: "db-1", tenantID: utils.PointerTo("tenant-a"), version: "17.6", hostID: "host-1"},
+		{id: "db-2", tenantID: utils.PointerTo("tenant-b"), version: "16.10", hostID: "host-2"},
+		{id: "db-3", tenantID: utils.PointerTo("tenant-a"), version: "16.10", hostID: "host-1"},
+		{id: "db-4", version: "17.6", hostID: "host-3"},
+	} {
+		_, err := svc.CreateDatabase(ctx, &database.Spec{
+			DatabaseID:      db.id,
+			TenantID:        db.tenantID,
+			DatabaseName:    "test",
+			PostgresVersion: db.version,
+			SpockVersion:    "5",
+			Nodes: []*database.Node{
+				{Name: "n1", HostIDs: []string{db.hostID}},
+			},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, svc.UpdateDatabaseState(ctx, "db-2", database.DatabaseStateCreating, database.DatabaseStateAvailable))
+
+	ids := func(list *database.DatabaseList) []string {
+		var out []string
+		for _, db := range list.Databases {
+			out = append(out, db.DatabaseID)
+		}
+		return out
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		first, err := svc.ListDatabases(ctx, database.DatabaseListOptions{Limit: 3})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db-1", "db-2", "db-3"}, ids(first))
+		assert.Equal(t, "db-3", first.NextDatabaseID)
+
+		second, err := svc.ListDatabases(ctx, database.DatabaseListOptions{
+			Limit:           3,
+			AfterDatabaseID: first.NextDatabaseID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db-4"}, ids(second))
+		assert.Empty(t, second.NextDatabaseID)
+	})
+
+	t.Run("exact page size has no next page", func(t *testing.T) {
+		list, err := svc.ListDatabases(ctx, database.DatabaseListOptions{Limit: 4})
+		require.NoError(t, err)
+		assert.Len(t, list.Databases, 4)
+		assert.Empty(t, list.NextDatabaseID)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			options  database.DatabaseListOptions
+			expected []string
+		}{
+			{
+				name:     "tenant",
+				options:  database.DatabaseListOptions{TenantID: "tenant-a"},
+				expected: []string{"db-1", "db-3"},
+			},
+			{
+				name:     "state",
+				options:  database.DatabaseListOptions{States: []database.DatabaseState{database.DatabaseStateAvailable}},
+				expected: []string{"db-2"},
+			},
+			{
+				name:     "host",
+				options:  database.DatabaseListOptions{HostID: "host-1"},
+				expected: []string{"db-1", "db-3"},
+			},
+			{
+				name:     "postgres version",
+				options:  database.DatabaseListOptions{PostgresVersion: ds.MustParseVersion("17")},
+				expected: []string{"db-1", "db-4"},
+			},
+			{
+				name: "combined with limit",
+				options: database.DatabaseListOptions{
+					Limit:           1,
+					PostgresVersion: ds.MustParseVersion("16"),
+				},
+				expected: []string{"db-2"},
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				list, err := svc.ListDatabases(ctx, tc.options)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, ids(list))
+			})
+		}
+	})
+}
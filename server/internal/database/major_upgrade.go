@@ -0,0 +1,213 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+var ErrInvalidMajorUpgrade = errors.New("invalid major upgrade")
+
+// MajorUpgradeNode describes the replacement for a single node in a rolling
+// major version upgrade.
+type MajorUpgradeNode struct {
+	NodeName        string   `json:"node_name"`
+	ReplacementName string   `json:"replacement_name"`
+	HostIDs         []string `json:"host_ids"`
+}
+
+// MajorUpgradePlan upgrades a database to a new Postgres major version by
+// replacing each of its nodes, one at a time, with a new node that's populated
+// from the original node.
+type MajorUpgradePlan struct {
+	PostgresVersion string              `json:"postgres_version"`
+	Nodes           []*MajorUpgradeNode `json:"nodes"`
+}
+
+// NewMajorUpgradePlan validates the target version against the given spec and
+// computes a replacement for each node that's running an older major version.
+// Replacement nodes use the next unused node names, and they're placed on the
+// same hosts as the nodes they replace unless hostIDs contains an entry for
+// that node.
+func NewMajorUpgradePlan(spec *Spec, postgresVersion string, hostIDs map[string][]string) (*MajorUpgradePlan, error) {
+	var errs []error
+
+	target, err := ds.ParsePgEdgeVersion(postgresVersion, spec.SpockVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid postgres version: %w", ErrInvalidMajorUpgrade, err)
+	}
+	targetMajor, ok := target.PostgresVersion.Major()
+	if !ok {
+		return nil, fmt.Errorf("%w: postgres version is missing its major component", ErrInvalidMajorUpgrade)
+	}
+
+	for name := range hostIDs {
+		if _, err := spec.Node(name); err != nil {
+			errs = append(errs, fmt.Errorf("host IDs specified for unknown node '%s'", name))
+		}
+	}
+
+	nextOrdinal := 1
+	for _, node := range spec.Nodes {
+		ordinal, err := extractOrdinal(node.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidMajorUpgrade, err)
+		}
+		nextOrdinal = max(nextOrdinal, ordinal+1)
+	}
+
+	plan := &MajorUpgradePlan{PostgresVersion: postgresVersion}
+	for _, node := range spec.Nodes {
+		current := utils.NillablePointerTo(node.PostgresVersion)
+		if current == nil {
+			current = &spec.PostgresVersion
+		}
+		version, err := ds.ParsePgEdgeVersion(*current, spec.SpockVersion)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid version for node '%s': %w", ErrInvalidMajorUpgrade, node.Name, err)
+		}
+		if node.SourceNode != "" {
+			errs = append(errs, fmt.Errorf(
+				"node '%s' has a source_node, which must be removed before upgrading",
+				node.Name,
+			))
+		}
+		currentMajor, _ := version.PostgresVersion.Major()
+		if currentMajor > targetMajor {
+			errs = append(errs, fmt.Errorf(
+				"node '%s' is running postgres %d, which is newer than %d",
+				node.Name, currentMajor, targetMajor,
+			))
+			continue
+		}
+		if currentMajor == targetMajor {
+			// Skipping nodes that have already been upgraded allows an
+			// interrupted upgrade to be resubmitted.
+			if _, ok := hostIDs[node.Name]; ok {
+				errs = append(errs, fmt.Errorf(
+					"host IDs specified for node '%s', which is already running postgres %d",
+					node.Name, targetMajor,
+				))
+			}
+			continue
+		}
+
+		hosts, ok := hostIDs[node.Name]
+		if !ok {
+			hosts = node.HostIDs
+		}
+		if len(hosts) == 0 {
+			errs = append(errs, fmt.Errorf("node '%s' must have at least one host", node.Name))
+		}
+
+		plan.Nodes = append(plan.Nodes, &MajorUpgradeNode{
+			NodeName:        node.Name,
+			ReplacementName: fmt.Sprintf("n%d", nextOrdinal),
+			HostIDs:         slices.Clone(hosts),
+		})
+		nextOrdinal++
+	}
+
+	if len(errs) == 0 && len(plan.Nodes) == 0 {
+		errs = append(errs, fmt.Errorf("every node is already running postgres %d", targetMajor))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMajorUpgrade, err)
+	}
+
+	return plan, nil
+}
+
+// AddReplacement returns a copy of the spec with a replacement for the given
+// node. The replacement inherits the node's settings, except for image
+// overrides, and it's populated from the original node.
+func (p *MajorUpgradePlan) AddReplacement(spec *Spec, n *MajorUpgradeNode) (*Spec, error) {
+	node, err := spec.Node(n.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := node.Clone()
+	replacement.Name = n.ReplacementName
+	replacement.HostIDs = slices.Clone(n.HostIDs)
+	replacement.PostgresVersion = p.PostgresVersion
+	replacement.SourceNode = node.Name
+	replacement.RestoreConfig = nil
+	// Image overrides are specific to the current version.
+	if opts := replacement.OrchestratorOpts; opts != nil && opts.Swarm != nil {
+		opts.Swarm.Image = ""
+		opts.Swarm.ResolvedImage = ""
+	}
+	// Both nodes run side-by-side until the original node is removed, so they
+	// can't share fixed ports on the same host.
+	if hasCommonHost(node.HostIDs, n.HostIDs) {
+		if port := overridableValue(spec.Port, node.Port); port != nil && *port != 0 {
+			replacement.Port = utils.PointerTo(0)
+		}
+		if port := overridableValue(spec.PatroniPort, node.PatroniPort); port != nil && *port != 0 {
+			replacement.PatroniPort = utils.PointerTo(0)
+		}
+	}
+
+	updated := spec.Clone()
+	updated.Nodes = append(updated.Nodes, replacement)
+
+	return updated, nil
+}
+
+// SwitchEndpoints returns a copy of the spec where every service that targets
+// the given node targets its replacement instead. It also returns false if no
+// services targeted the node.
+func (p *MajorUpgradePlan) SwitchEndpoints(spec *Spec, n *MajorUpgradeNode) (*Spec, bool) {
+	updated := spec.Clone()
+	var changed bool
+	for _, svc := range updated.Services {
+		if svc.DatabaseConnection == nil {
+			continue
+		}
+		for i, target := range svc.DatabaseConnection.TargetNodes {
+			if target == n.NodeName {
+				svc.DatabaseConnection.TargetNodes[i] = n.ReplacementName
+				changed = true
+			}
+		}
+	}
+
+	return updated, changed
+}
+
+// Decommission returns a copy of the spec without the given node. The
+// replacement no longer needs its source node after this point, so it's
+// cleared as well.
+func (p *MajorUpgradePlan) Decommission(spec *Spec, n *MajorUpgradeNode) *Spec {
+	updated := spec.Clone()
+	updated.Nodes = slices.DeleteFunc(updated.Nodes, func(node *Node) bool {
+		return node.Name == n.NodeName
+	})
+	for _, node := range updated.Nodes {
+		if node.Name == n.ReplacementName {
+			node.SourceNode = ""
+		}
+	}
+
+	return updated
+}
+
+// Finalize returns a copy of the spec with the database-level Postgres version
+// set to the target version. It should be called once every node has been
+// replaced.
+func (p *MajorUpgradePlan) Finalize(spec *Spec) *Spec {
+	return finalizePostgresVersion(spec, p.PostgresVersion)
+}
+
+func hasCommonHost(a, b []string) bool {
+	for _, hostID := range a {
+		if slices.Contains(b, hostID) {
+			return true
+		}
+	}
+	return false
+}
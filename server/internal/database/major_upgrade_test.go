@@ -0,0 +1,152 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestNewMajorUpgradePlan(t *testing.T) {
+	spec := &database.Spec{
+		DatabaseID:      "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Nodes: []*database.Node{
+			{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+			{Name: "n3", HostIDs: []string{"host-3"}},
+		},
+	}
+
+	t.Run("defaults to the same hosts", func(t *testing.T) {
+		plan, err := database.NewMajorUpgradePlan(spec, "18.1", map[string][]string{
+			"n3": {"host-4"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &database.MajorUpgradePlan{
+			PostgresVersion: "18.1",
+			Nodes: []*database.MajorUpgradeNode{
+				{NodeName: "n1", ReplacementName: "n4", HostIDs: []string{"host-1", "host-2"}},
+				{NodeName: "n3", ReplacementName: "n5", HostIDs: []string{"host-4"}},
+			},
+		}, plan)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := spec.Clone()
+		invalid.Nodes[1].PostgresVersion = "18.1"
+		invalid.Nodes = append(invalid.Nodes, &database.Node{
+			Name:       "n4",
+			HostIDs:    []string{"host-4"},
+			SourceNode: "n1",
+		})
+
+		_, err := database.NewMajorUpgradePlan(invalid, "17.7", map[string][]string{
+			"n9": {"host-9"},
+		})
+		assert.ErrorIs(t, err, database.ErrInvalidMajorUpgrade)
+		assert.ErrorContains(t, err, "host IDs specified for unknown node 'n9'")
+		assert.ErrorContains(t, err, "node 'n3' is running postgres 18, which is newer than 17")
+		assert.ErrorContains(t, err, "node 'n4' has a source_node")
+	})
+
+	t.Run("skips upgraded nodes", func(t *testing.T) {
+		partial := spec.Clone()
+		partial.Nodes[0].PostgresVersion = "18.1"
+
+		plan, err := database.NewMajorUpgradePlan(partial, "18.2", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []*database.MajorUpgradeNode{
+			{NodeName: "n3", ReplacementName: "n4", HostIDs: []string{"host-3"}},
+		}, plan.Nodes)
+
+		_, err = database.NewMajorUpgradePlan(partial, "18.1", map[string][]string{
+			"n1": {"host-4"},
+		})
+		assert.ErrorContains(t, err, "host IDs specified for node 'n1'")
+
+		partial.Nodes[1].PostgresVersion = "18.1"
+		_, err = database.NewMajorUpgradePlan(partial, "18.1", nil)
+		assert.ErrorContains(t, err, "every node is already running postgres 18")
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := database.NewMajorUpgradePlan(spec, "latest", nil)
+		assert.ErrorIs(t, err, database.ErrInvalidMajorUpgrade)
+	})
+}
+
+func TestMajorUpgradePlan_Stages(t *testing.T) {
+	spec := &database.Spec{
+		DatabaseID:      "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Port:            utils.PointerTo(5432),
+		Nodes: []*database.Node{
+			{
+				Name:           "n1",
+				HostIDs:        []string{"host-1"},
+				PostgreSQLConf: map[string]any{"work_mem": "64MB"},
+				OrchestratorOpts: &database.OrchestratorOpts{
+					Swarm: &database.SwarmOpts{
+						ResolvedImage: "ghcr.io/pgedge/pgedge-postgres:17.6-spock5.0.4-standard-1",
+					},
+				},
+			},
+			{Name: "n2", HostIDs: []string{"host-2"}},
+		},
+		Services: []*database.ServiceSpec{
+			{
+				ServiceID: "api",
+				DatabaseConnection: &database.DatabaseConnection{
+					TargetNodes: []string{"n1", "n2"},
+				},
+			},
+		},
+	}
+	plan, err := database.NewMajorUpgradePlan(spec, "18.1", map[string][]string{
+		"n2": {"host-3"},
+	})
+	require.NoError(t, err)
+
+	// n1's replacement shares its host, so it can't use the same fixed port.
+	added, err := plan.AddReplacement(spec, plan.Nodes[0])
+	require.NoError(t, err)
+	require.Len(t, added.Nodes, 3)
+	assert.Len(t, spec.Nodes, 2)
+	replacement := added.Nodes[2]
+	assert.Equal(t, "n3", replacement.Name)
+	assert.Equal(t, "18.1", replacement.PostgresVersion)
+	assert.Equal(t, "n1", replacement.SourceNode)
+	assert.Equal(t, utils.PointerTo(0), replacement.Port)
+	assert.Equal(t, map[string]any{"work_mem": "64MB"}, replacement.PostgreSQLConf)
+	assert.Empty(t, replacement.OrchestratorOpts.Swarm.ResolvedImage)
+	assert.NoError(t, database.ValidateChangedSpec(spec, added))
+
+	switched, changed := plan.SwitchEndpoints(added, plan.Nodes[0])
+	assert.True(t, changed)
+	assert.Equal(t, []string{"n3", "n2"}, switched.Services[0].DatabaseConnection.TargetNodes)
+	assert.Equal(t, []string{"n1", "n2"}, added.Services[0].DatabaseConnection.TargetNodes)
+
+	removed := plan.Decommission(switched, plan.Nodes[0])
+	assert.Equal(t, []string{"n2", "n3"}, removed.NodeNames())
+	assert.Empty(t, removed.Nodes[1].SourceNode)
+
+	// n2's replacement is on a different host, so it keeps the fixed port.
+	added, err = plan.AddReplacement(removed, plan.Nodes[1])
+	require.NoError(t, err)
+	assert.Nil(t, added.Nodes[2].Port)
+	switched, _ = plan.SwitchEndpoints(added, plan.Nodes[1])
+	removed = plan.Decommission(switched, plan.Nodes[1])
+
+	final := plan.Finalize(removed)
+	assert.Equal(t, "18.1", final.PostgresVersion)
+	assert.Equal(t, []string{"n3", "n4"}, final.NodeNames())
+	for _, node := range final.Nodes {
+		assert.Empty(t, node.PostgresVersion)
+	}
+	assert.NoError(t, database.ValidateChangedSpec(removed, final))
+}
@@ -18,6 +18,7 @@ type NodeResources struct {
 	InstanceResources []*database.InstanceResources
 	RestoreConfig     *database.RestoreConfig
 	Scripts           database.Scripts
+	QueryStats        bool
 }
 
 
@@ -56,6 +57,8 @@ func (n *NodeResources) databaseResourceState() (*resource.State, error) {
 		RenameFrom:         renameFrom,
 		HasRestoreConfig:   hasRestoreConfig,
 		PostDatabaseCreate: n.Scripts[database.ScriptNamePostDatabaseCreate],
+		Scrub:              n.Scripts[database.ScriptNameScrub],
+		QueryStats:         n.QueryStats,
 	}
 
 	state := resource.NewState()
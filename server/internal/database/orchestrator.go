@@ -179,11 +179,24 @@ type Orchestrator interface {
 	GetServiceInstanceStatus(ctx context.Context, serviceInstanceID string) (*ServiceInstanceStatus, error)
 	CreatePgBackRestBackup(ctx context.Context, w io.Writer, spec *InstanceSpec, options *pgbackrest.BackupOptions) error
 	ExecuteInstanceCommand(ctx context.Context, w io.Writer, databaseID, instanceID string, args ...string) error
+	// ExecuteInstanceCommandWithEnv is like ExecuteInstanceCommand, but it
+	// adds the given KEY=value pairs to the command's environment. It's used
+	// to pass secrets that mustn't appear in the command line.
+	ExecuteInstanceCommandWithEnv(ctx context.Context, w io.Writer, databaseID, instanceID string, env []string, args ...string) error
 	ValidateInstanceSpecs(ctx context.Context, changes []*InstanceSpecChange) ([]*ValidationResult, error)
 	StopInstance(ctx context.Context, instanceID string) error
 	StartInstance(ctx context.Context, instanceID string) error
+	// PgUpgrade performs one phase of an in-place major version upgrade for a
+	// single instance. See PgUpgradeMode for the individual phases.
+	PgUpgrade(ctx context.Context, w io.Writer, opts *PgUpgradeOptions) error
 	NodeDSN(ctx context.Context, rc *resource.Context, nodeName string, fromInstanceID string, dbName string) (*postgres.DSN, error)
 	InstancePaths(pgVersion *ds.Version, instanceID string) (InstancePaths, error)
+	// PostgreSQLSystemParameters returns the orchestrator-specific Postgres
+	// settings for the given instance, such as its SSL and archive settings.
+	PostgreSQLSystemParameters(spec *InstanceSpec) (map[string]any, error)
+	// GetInstanceResourceUsage returns the data directory size, volume usage,
+	// and the CPU and memory usage of the instance's container or unit.
+	GetInstanceResourceUsage(ctx context.Context, instanceID string, pgVersion *ds.Version) (*InstanceResourceUsage, error)
 	// ReconcileInstanceSpec is called during spec reconciliation to allow the
 	// orchestrator to update computed fields (e.g. resolved image) on the new
 	// spec before it is persisted. old is nil when the instance is being created
@@ -9,6 +9,11 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
 )
 
+const (
+	EtcdCertificatesDir       = "etcd"
+	PatroniDCSCertificatesDir = "patroni-dcs"
+)
+
 const (
 	EtcdCaCertName     = "ca.crt"
 	EtcdClientCertName = "client.crt"
@@ -125,7 +130,11 @@ func (p *Paths) PgBackRestConfig(confType pgbackrest.ConfigType) string {
 }
 
 func (p *Paths) EtcdCertificates() string {
-	return filepath.Join(p.Certificates(), "etcd")
+	return filepath.Join(p.Certificates(), EtcdCertificatesDir)
+}
+
+func (p *Paths) PatroniDCSCertificates() string {
+	return filepath.Join(p.Certificates(), PatroniDCSCertificatesDir)
 }
 
 func (p *Paths) EtcdCaCert() string {
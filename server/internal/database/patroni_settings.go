@@ -0,0 +1,193 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+// PatroniDCS selects the etcd cluster that Patroni uses as its distributed
+// configuration store.
+type PatroniDCS string
+
+const (
+	// PatroniDCSControlPlane uses the control plane's etcd.
+	PatroniDCSControlPlane PatroniDCS = "control_plane"
+	// PatroniDCSExternal uses the external etcd cluster that's configured with
+	// patroni_etcd.
+	PatroniDCSExternal PatroniDCS = "external"
+)
+
+// IsExternal returns true if this setting selects the external etcd cluster.
+// An empty setting uses the control plane's etcd. New specs are resolved with
+// ResolvePatroniDCS when they're created, and specs that were stored before
+// this setting existed are migrated to control_plane, so patroni_etcd.default
+// never moves an existing database.
+func (d PatroniDCS) IsExternal() bool {
+	return d == PatroniDCSExternal
+}
+
+// ResolvePatroniDCS returns the given setting, or the setting that's selected
+// by the given default if it's empty.
+func ResolvePatroniDCS(d PatroniDCS, defaultExternal bool) PatroniDCS {
+	if d != "" {
+		return d
+	}
+	if defaultExternal {
+		return PatroniDCSExternal
+	}
+	return PatroniDCSControlPlane
+}
+
+// PatroniDCSSwitchNodes returns the nodes that must be restarted to switch to
+// the new spec's patroni_dcs, including the pending nodes from an earlier
+// switch that hasn't completed. Nodes that are added by the new spec are
+// created with the new patroni_dcs, so they don't need to switch.
+func PatroniDCSSwitchNodes(pending []string, previous, spec *Spec) []string {
+	nodes := ds.NewSet(pending...)
+	if previous.PatroniDCS.IsExternal() != spec.PatroniDCS.IsExternal() {
+		nodes.Add(previous.NodeNames()...)
+	}
+
+	var switching []string
+	for _, name := range spec.NodeNames() {
+		if nodes.Has(name) {
+			switching = append(switching, name)
+		}
+	}
+
+	return switching
+}
+
+// PatroniSettings are user-specified overrides for Patroni's failover and
+// fencing behavior. Unset fields use the defaults from the Patroni config
+// generator.
+type PatroniSettings struct {
+	MaximumLagOnFailover *int64           `json:"maximum_lag_on_failover,omitempty"`
+	FailsafeMode         *bool            `json:"failsafe_mode,omitempty"`
+	LoopWait             *int             `json:"loop_wait,omitempty"`
+	TTL                  *int             `json:"ttl,omitempty"`
+	RetryTimeout         *int             `json:"retry_timeout,omitempty"`
+	CheckTimeline        *bool            `json:"check_timeline,omitempty"`
+	Watchdog             *PatroniWatchdog `json:"watchdog,omitempty"`
+}
+
+type PatroniWatchdog struct {
+	Mode         patroni.WatchdogMode `json:"mode"`
+	Device       *string              `json:"device,omitempty"`
+	SafetyMargin *int                 `json:"safety_margin,omitempty"`
+}
+
+func (w *PatroniWatchdog) Clone() *PatroniWatchdog {
+	if w == nil {
+		return nil
+	}
+	return &PatroniWatchdog{
+		Mode:         w.Mode,
+		Device:       utils.ClonePointer(w.Device),
+		SafetyMargin: utils.ClonePointer(w.SafetyMargin),
+	}
+}
+
+func (s *PatroniSettings) Clone() *PatroniSettings {
+	if s == nil {
+		return nil
+	}
+	return &PatroniSettings{
+		MaximumLagOnFailover: utils.ClonePointer(s.MaximumLagOnFailover),
+		FailsafeMode:         utils.ClonePointer(s.FailsafeMode),
+		LoopWait:             utils.ClonePointer(s.LoopWait),
+		TTL:                  utils.ClonePointer(s.TTL),
+		RetryTimeout:         utils.ClonePointer(s.RetryTimeout),
+		CheckTimeline:        utils.ClonePointer(s.CheckTimeline),
+		Watchdog:             s.Watchdog.Clone(),
+	}
+}
+
+// Merge returns a copy of these settings with each field that's set in the
+// given override replacing the corresponding field. The watchdog settings are
+// replaced as a whole.
+func (s *PatroniSettings) Merge(override *PatroniSettings) *PatroniSettings {
+	if s == nil {
+		return override.Clone()
+	}
+	merged := s.Clone()
+	if override == nil {
+		return merged
+	}
+	if override.MaximumLagOnFailover != nil {
+		merged.MaximumLagOnFailover = utils.ClonePointer(override.MaximumLagOnFailover)
+	}
+	if override.FailsafeMode != nil {
+		merged.FailsafeMode = utils.ClonePointer(override.FailsafeMode)
+	}
+	if override.LoopWait != nil {
+		merged.LoopWait = utils.ClonePointer(override.LoopWait)
+	}
+	if override.TTL != nil {
+		merged.TTL = utils.ClonePointer(override.TTL)
+	}
+	if override.RetryTimeout != nil {
+		merged.RetryTimeout = utils.ClonePointer(override.RetryTimeout)
+	}
+	if override.CheckTimeline != nil {
+		merged.CheckTimeline = utils.ClonePointer(override.CheckTimeline)
+	}
+	if override.Watchdog != nil {
+		merged.Watchdog = override.Watchdog.Clone()
+	}
+	return merged
+}
+
+// Validate checks each setting against Patroni's limits. The timing settings
+// are validated together using Patroni's defaults for any that are unset,
+// because Patroni requires loop_wait + 2*retry_timeout <= ttl.
+func (s *PatroniSettings) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	var errs []error
+	if s.MaximumLagOnFailover != nil && *s.MaximumLagOnFailover < 0 {
+		errs = append(errs, errors.New("maximum_lag_on_failover cannot be negative"))
+	}
+	loopWait := utils.FromPointer(s.LoopWait)
+	if s.LoopWait == nil {
+		loopWait = patroni.DefaultLoopWaitSeconds
+	} else if loopWait < 1 {
+		errs = append(errs, errors.New("loop_wait must be at least 1"))
+	}
+	ttl := utils.FromPointer(s.TTL)
+	if s.TTL == nil {
+		ttl = patroni.DefaultTTLSeconds
+	} else if ttl < 20 {
+		errs = append(errs, errors.New("ttl must be at least 20"))
+	}
+	retryTimeout := utils.FromPointer(s.RetryTimeout)
+	if s.RetryTimeout == nil {
+		retryTimeout = patroni.DefaultRetryTimeoutSeconds
+	} else if retryTimeout < 3 {
+		errs = append(errs, errors.New("retry_timeout must be at least 3"))
+	}
+	if loopWait+2*retryTimeout > ttl {
+		errs = append(errs, fmt.Errorf(
+			"loop_wait + 2*retry_timeout must not exceed ttl, got %d + 2*%d > %d",
+			loopWait, retryTimeout, ttl,
+		))
+	}
+	if w := s.Watchdog; w != nil {
+		switch w.Mode {
+		case patroni.WatchdogModeOff, patroni.WatchdogModeAutomatic, patroni.WatchdogModeRequired:
+		default:
+			errs = append(errs, fmt.Errorf("invalid watchdog mode '%s'", w.Mode))
+		}
+		if w.SafetyMargin != nil && *w.SafetyMargin < -1 {
+			errs = append(errs, errors.New("watchdog safety_margin must be at least -1"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
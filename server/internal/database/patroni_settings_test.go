@@ -0,0 +1,168 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestPatroniSettings_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		settings *database.PatroniSettings
+		expected []string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:     "empty",
+			settings: &database.PatroniSettings{},
+		},
+		{
+			name: "valid",
+			settings: &database.PatroniSettings{
+				MaximumLagOnFailover: utils.PointerTo(int64(0)),
+				LoopWait:             utils.PointerTo(5),
+				TTL:                  utils.PointerTo(20),
+				RetryTimeout:         utils.PointerTo(5),
+				Watchdog: &database.PatroniWatchdog{
+					Mode:         patroni.WatchdogModeAutomatic,
+					SafetyMargin: utils.PointerTo(-1),
+				},
+			},
+		},
+		{
+			name: "out of range",
+			settings: &database.PatroniSettings{
+				MaximumLagOnFailover: utils.PointerTo(int64(-1)),
+				LoopWait:             utils.PointerTo(0),
+				TTL:                  utils.PointerTo(10),
+				RetryTimeout:         utils.PointerTo(1),
+				Watchdog: &database.PatroniWatchdog{
+					Mode:         "sometimes",
+					SafetyMargin: utils.PointerTo(-2),
+				},
+			},
+			expected: []string{
+				"maximum_lag_on_failover cannot be negative",
+				"loop_wait must be at least 1",
+				"ttl must be at least 20",
+				"retry_timeout must be at least 3",
+				"invalid watchdog mode 'sometimes'",
+				"watchdog safety_margin must be at least -1",
+			},
+		},
+		{
+			name: "timing uses defaults for unset values",
+			settings: &database.PatroniSettings{
+				TTL: utils.PointerTo(25),
+			},
+			expected: []string{
+				"loop_wait + 2*retry_timeout must not exceed ttl, got 10 + 2*10 > 25",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settings.Validate()
+			if len(tc.expected) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, msg := range tc.expected {
+				assert.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
+func TestPatroniSettings_Merge(t *testing.T) {
+	base := &database.PatroniSettings{
+		LoopWait: utils.PointerTo(5),
+		Watchdog: &database.PatroniWatchdog{
+			Mode:   patroni.WatchdogModeRequired,
+			Device: utils.PointerTo("/dev/watchdog"),
+		},
+	}
+	override := &database.PatroniSettings{
+		CheckTimeline: utils.PointerTo(true),
+		Watchdog: &database.PatroniWatchdog{
+			Mode: patroni.WatchdogModeOff,
+		},
+	}
+
+	assert.Nil(t, (*database.PatroniSettings)(nil).Merge(nil))
+	assert.Equal(t, override, (*database.PatroniSettings)(nil).Merge(override))
+	assert.Equal(t, base, base.Merge(nil))
+	assert.Equal(t, &database.PatroniSettings{
+		LoopWait:      utils.PointerTo(5),
+		CheckTimeline: utils.PointerTo(true),
+		Watchdog: &database.PatroniWatchdog{
+			Mode: patroni.WatchdogModeOff,
+		},
+	}, base.Merge(override))
+}
+
+func TestPatroniDCSSwitchNodes(t *testing.T) {
+	spec := func(dcs database.PatroniDCS, nodeNames ...string) *database.Spec {
+		s := &database.Spec{PatroniDCS: dcs}
+		for _, name := range nodeNames {
+			s.Nodes = append(s.Nodes, &database.Node{Name: name})
+		}
+		return s
+	}
+
+	for _, tc := range []struct {
+		name     string
+		pending  []string
+		previous *database.Spec
+		spec     *database.Spec
+		expected []string
+	}{
+		{
+			name:     "no change",
+			previous: spec(database.PatroniDCSControlPlane, "n1", "n2"),
+			spec:     spec(database.PatroniDCSControlPlane, "n1", "n2"),
+		},
+		{
+			name:     "switch excludes new nodes",
+			previous: spec(database.PatroniDCSControlPlane, "n1", "n2"),
+			spec:     spec(database.PatroniDCSExternal, "n1", "n2", "n3"),
+			expected: []string{"n1", "n2"},
+		},
+		{
+			name:     "unset values use the control plane",
+			previous: spec("", "n1"),
+			spec:     spec(database.PatroniDCSControlPlane, "n1"),
+		},
+		{
+			name:     "switch from unset value",
+			previous: spec("", "n1"),
+			spec:     spec(database.PatroniDCSExternal, "n1"),
+			expected: []string{"n1"},
+		},
+		{
+			name:     "keeps pending nodes that still exist",
+			pending:  []string{"n1", "n2"},
+			previous: spec(database.PatroniDCSExternal, "n1", "n2"),
+			spec:     spec(database.PatroniDCSExternal, "n2"),
+			expected: []string{"n2"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := database.PatroniDCSSwitchNodes(tc.pending, tc.previous, tc.spec)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestResolvePatroniDCS(t *testing.T) {
+	assert.Equal(t, database.PatroniDCSExternal, database.ResolvePatroniDCS("", true))
+	assert.Equal(t, database.PatroniDCSControlPlane, database.ResolvePatroniDCS("", false))
+	assert.Equal(t, database.PatroniDCSControlPlane, database.ResolvePatroniDCS(database.PatroniDCSControlPlane, true))
+	assert.Equal(t, database.PatroniDCSExternal, database.ResolvePatroniDCS(database.PatroniDCSExternal, false))
+}
@@ -0,0 +1,245 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+// PgUpgradeMode determines which part of an in-place upgrade an orchestrator
+// performs for a single instance.
+type PgUpgradeMode string
+
+const (
+	// PgUpgradeModeCheck runs pg_upgrade --check against the running instance
+	// without modifying it.
+	PgUpgradeModeCheck PgUpgradeMode = "check"
+	// PgUpgradeModeLink upgrades the stopped primary's data directory with
+	// pg_upgrade --link.
+	PgUpgradeModeLink PgUpgradeMode = "link"
+	// PgUpgradeModeReplica moves the stopped replica's data directory aside so
+	// that it's rebuilt from the upgraded primary.
+	PgUpgradeModeReplica PgUpgradeMode = "replica"
+)
+
+// PgUpgradeOptions are passed to the orchestrator to upgrade a single
+// instance. Previous is the instance's current spec and Spec is the same
+// instance at the target version.
+type PgUpgradeOptions struct {
+	Mode     PgUpgradeMode
+	Previous *InstanceSpec
+	Spec     *InstanceSpec
+}
+
+// PgUpgradePlan upgrades a database to a new Postgres major version in place
+// by running pg_upgrade on each node's primary and rebuilding its replicas.
+// Nodes are upgraded one at a time.
+type PgUpgradePlan struct {
+	PostgresVersion string   `json:"postgres_version"`
+	NodeNames       []string `json:"node_names"`
+}
+
+// NewPgUpgradePlan validates the target version against the given spec and
+// returns a plan that upgrades every node that's running an older major
+// version.
+func NewPgUpgradePlan(spec *Spec, postgresVersion string) (*PgUpgradePlan, error) {
+	var errs []error
+
+	target, err := ds.ParsePgEdgeVersion(postgresVersion, spec.SpockVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid postgres version: %w", ErrInvalidMajorUpgrade, err)
+	}
+	targetMajor, ok := target.PostgresVersion.Major()
+	if !ok {
+		return nil, fmt.Errorf("%w: postgres version is missing its major component", ErrInvalidMajorUpgrade)
+	}
+
+	if hasImageOverride(spec.OrchestratorOpts) {
+		errs = append(errs, errors.New("the database has an image override, which must be removed before upgrading"))
+	}
+
+	plan := &PgUpgradePlan{PostgresVersion: postgresVersion}
+	for _, node := range spec.Nodes {
+		current := utils.NillablePointerTo(node.PostgresVersion)
+		if current == nil {
+			current = &spec.PostgresVersion
+		}
+		version, err := ds.ParsePgEdgeVersion(*current, spec.SpockVersion)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid version for node '%s': %w", ErrInvalidMajorUpgrade, node.Name, err)
+		}
+		if node.SourceNode != "" {
+			errs = append(errs, fmt.Errorf(
+				"node '%s' has a source_node, which must be removed before upgrading",
+				node.Name,
+			))
+		}
+		currentMajor, _ := version.PostgresVersion.Major()
+		if currentMajor > targetMajor {
+			errs = append(errs, fmt.Errorf(
+				"node '%s' is running postgres %d, which is newer than %d",
+				node.Name, currentMajor, targetMajor,
+			))
+			continue
+		}
+		if currentMajor == targetMajor {
+			// Skipping nodes that have already been upgraded allows an
+			// interrupted upgrade to be resubmitted.
+			continue
+		}
+		if hasImageOverride(node.OrchestratorOpts) {
+			errs = append(errs, fmt.Errorf(
+				"node '%s' has an image override, which must be removed before upgrading",
+				node.Name,
+			))
+		}
+
+		plan.NodeNames = append(plan.NodeNames, node.Name)
+	}
+
+	if len(errs) == 0 && len(plan.NodeNames) == 0 {
+		errs = append(errs, fmt.Errorf("every node is already running postgres %d", targetMajor))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMajorUpgrade, err)
+	}
+
+	return plan, nil
+}
+
+// UpgradeNode returns a copy of the spec where the given node runs the target
+// version.
+func (p *PgUpgradePlan) UpgradeNode(spec *Spec, nodeName string) (*Spec, error) {
+	updated := spec.Clone()
+	node, err := updated.Node(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	node.PostgresVersion = p.PostgresVersion
+	// The pinned image is specific to the previous version.
+	if opts := node.OrchestratorOpts; opts != nil && opts.Swarm != nil {
+		opts.Swarm.ResolvedImage = ""
+	}
+
+	return updated, nil
+}
+
+// Finalize returns a copy of the spec with the database-level Postgres version
+// set to the target version. It should be called once every node has been
+// upgraded.
+func (p *PgUpgradePlan) Finalize(spec *Spec) *Spec {
+	return finalizePostgresVersion(spec, p.PostgresVersion)
+}
+
+// PgUpgradeScriptOptions configures the shell script produced by
+// PgUpgradeScript.
+type PgUpgradeScriptOptions struct {
+	// OldBinDir defaults to the bin directory that's reported by pg_config.
+	OldBinDir  string
+	NewBinDir  string
+	OldDataDir string
+	NewDataDir string
+	// WorkDir holds pg_upgrade's output files and sockets. It's recreated by
+	// the script.
+	WorkDir string
+	// Check runs pg_upgrade --check against the running old server instead
+	// of upgrading it.
+	Check bool
+	// OldPort is the port of the running old server. It's only used when
+	// Check is true.
+	OldPort int
+	// LibraryPath is prepended to LD_LIBRARY_PATH for the new binaries when
+	// they're not installed in their usual location.
+	LibraryPath string
+}
+
+// pgUpgradeGUCs are copied from the old server's configuration to the new
+// server that pg_upgrade starts. Spock can only be loaded through
+// shared_preload_libraries, and pg_upgrade requires the logical replication
+// settings to migrate replication slots.
+var pgUpgradeGUCs = []string{
+	"shared_preload_libraries",
+	"wal_level",
+	"max_replication_slots",
+	"max_wal_senders",
+	"track_commit_timestamp",
+}
+
+// PgUpgradeScript returns a shell script that initializes a new data directory
+// and upgrades the old data directory into it with pg_upgrade --link. In check
+// mode, the new data directory is a scratch directory that's removed once the
+// check completes.
+func PgUpgradeScript(opts PgUpgradeScriptOptions) string {
+	var script strings.Builder
+	script.WriteString("set -e\n")
+	if opts.OldBinDir != "" {
+		fmt.Fprintf(&script, "old_bindir=%s\n", shellQuote(opts.OldBinDir))
+	} else {
+		script.WriteString("old_bindir=\"$(pg_config --bindir)\"\n")
+	}
+	fmt.Fprintf(&script, "new_bindir=%s\n", shellQuote(opts.NewBinDir))
+	fmt.Fprintf(&script, "old_datadir=%s\n", shellQuote(opts.OldDataDir))
+	fmt.Fprintf(&script, "new_datadir=%s\n", shellQuote(opts.NewDataDir))
+	fmt.Fprintf(&script, "work_dir=%s\n", shellQuote(opts.WorkDir))
+	if opts.LibraryPath != "" {
+		fmt.Fprintf(&script, "export LD_LIBRARY_PATH=%s${LD_LIBRARY_PATH:+:$LD_LIBRARY_PATH}\n", shellQuote(opts.LibraryPath))
+	}
+	script.WriteString(`rm -rf "$work_dir" "$new_datadir"
+mkdir -p "$work_dir"
+cd "$work_dir"
+`)
+	if opts.Check {
+		script.WriteString(`trap 'rm -rf "$new_datadir"' EXIT
+`)
+	}
+	script.WriteString(`new_options=""
+for guc in ` + strings.Join(pgUpgradeGUCs, " ") + `; do
+	value="$("$old_bindir/postgres" -D "$old_datadir" -C "$guc")"
+	new_options="$new_options -c $guc='$value'"
+done
+"$new_bindir/initdb" --pgdata="$new_datadir" --username=` + pgEdgeUser + ` --data-checksums
+`)
+
+	args := []string{
+		`"$new_bindir/pg_upgrade"`,
+		`--old-bindir="$old_bindir"`,
+		`--new-bindir="$new_bindir"`,
+		`--old-datadir="$old_datadir"`,
+		`--new-datadir="$new_datadir"`,
+		"--username=" + pgEdgeUser,
+		`--socketdir="$work_dir"`,
+		`--new-options="$new_options"`,
+		"--link",
+	}
+	if opts.Check {
+		args = append(args, "--check", fmt.Sprintf("--old-port=%d", opts.OldPort))
+	}
+	script.WriteString(strings.Join(args, " "))
+	script.WriteString("\n")
+
+	return script.String()
+}
+
+func hasImageOverride(opts *OrchestratorOpts) bool {
+	return opts != nil && opts.Swarm != nil && opts.Swarm.Image != ""
+}
+
+func finalizePostgresVersion(spec *Spec, postgresVersion string) *Spec {
+	updated := spec.Clone()
+	updated.PostgresVersion = postgresVersion
+	for _, node := range updated.Nodes {
+		if node.PostgresVersion == postgresVersion {
+			node.PostgresVersion = ""
+		}
+	}
+	updated.NormalizePostgresVersions()
+
+	return updated
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,143 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+)
+
+func TestNewPgUpgradePlan(t *testing.T) {
+	spec := &database.Spec{
+		DatabaseID:      "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Nodes: []*database.Node{
+			{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+			{Name: "n2", HostIDs: []string{"host-3"}},
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		plan, err := database.NewPgUpgradePlan(spec, "18.1")
+		require.NoError(t, err)
+		assert.Equal(t, &database.PgUpgradePlan{
+			PostgresVersion: "18.1",
+			NodeNames:       []string{"n1", "n2"},
+		}, plan)
+	})
+
+	t.Run("skips upgraded nodes", func(t *testing.T) {
+		partial := spec.Clone()
+		partial.Nodes[0].PostgresVersion = "18.1"
+
+		plan, err := database.NewPgUpgradePlan(partial, "18.1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"n2"}, plan.NodeNames)
+
+		partial.Nodes[1].PostgresVersion = "18.1"
+		_, err = database.NewPgUpgradePlan(partial, "18.1")
+		assert.ErrorContains(t, err, "every node is already running postgres 18")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := spec.Clone()
+		invalid.Nodes[0].OrchestratorOpts = &database.OrchestratorOpts{
+			Swarm: &database.SwarmOpts{Image: "example.com/postgres:17"},
+		}
+		invalid.Nodes[1].SourceNode = "n1"
+
+		_, err := database.NewPgUpgradePlan(invalid, "18.1")
+		assert.ErrorIs(t, err, database.ErrInvalidMajorUpgrade)
+		assert.ErrorContains(t, err, "node 'n1' has an image override")
+		assert.ErrorContains(t, err, "node 'n2' has a source_node")
+
+		_, err = database.NewPgUpgradePlan(spec, "16.4")
+		assert.ErrorContains(t, err, "node 'n1' is running postgres 17, which is newer than 16")
+	})
+}
+
+func TestPgUpgradePlan_Stages(t *testing.T) {
+	spec := &database.Spec{
+		DatabaseID:      "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Nodes: []*database.Node{
+			{
+				Name:    "n1",
+				HostIDs: []string{"host-1"},
+				OrchestratorOpts: &database.OrchestratorOpts{
+					Swarm: &database.SwarmOpts{
+						ResolvedImage: "ghcr.io/pgedge/pgedge-postgres:17.6-spock5.0.4-standard-1",
+					},
+				},
+			},
+			{Name: "n2", HostIDs: []string{"host-2"}},
+		},
+	}
+	plan, err := database.NewPgUpgradePlan(spec, "18.1")
+	require.NoError(t, err)
+
+	upgraded, err := plan.UpgradeNode(spec, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, "18.1", upgraded.Nodes[0].PostgresVersion)
+	assert.Empty(t, upgraded.Nodes[0].OrchestratorOpts.Swarm.ResolvedImage)
+	assert.Empty(t, spec.Nodes[0].PostgresVersion)
+	assert.Error(t, database.ValidateChangedSpec(spec, upgraded))
+
+	upgraded, err = plan.UpgradeNode(upgraded, "n2")
+	require.NoError(t, err)
+
+	final := plan.Finalize(upgraded)
+	assert.Equal(t, "18.1", final.PostgresVersion)
+	for _, node := range final.Nodes {
+		assert.Empty(t, node.PostgresVersion)
+	}
+	assert.NoError(t, database.ValidateChangedSpec(upgraded, final))
+
+	_, err = plan.UpgradeNode(spec, "n9")
+	assert.Error(t, err)
+}
+
+func TestPgUpgradeScript(t *testing.T) {
+	t.Run("link", func(t *testing.T) {
+		script := database.PgUpgradeScript(database.PgUpgradeScriptOptions{
+			OldBinDir:  "/usr/pgsql-17/bin",
+			NewBinDir:  "/usr/pgsql-18/bin",
+			OldDataDir: "/var/lib/pgsql/17/instance-1/data/pgdata",
+			NewDataDir: "/var/lib/pgsql/18/instance-1/data/pgdata",
+			WorkDir:    "/var/lib/pgsql/18/instance-1/data/pg-upgrade",
+		})
+		assert.Contains(t, script, "old_bindir='/usr/pgsql-17/bin'\n")
+		assert.Contains(t, script, `"$new_bindir/initdb" --pgdata="$new_datadir" --username=pgedge --data-checksums`)
+		assert.Contains(t, script, `--new-options="$new_options" --link`+"\n")
+		assert.NotContains(t, script, "--check")
+		assert.NotContains(t, script, "trap")
+		assert.NotContains(t, script, "LD_LIBRARY_PATH")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		script := database.PgUpgradeScript(database.PgUpgradeScriptOptions{
+			NewBinDir:   "/opt/pgedge/data/pg-upgrade-install/bin",
+			OldDataDir:  "/opt/pgedge/data/pgdata",
+			NewDataDir:  "/opt/pgedge/data/pgdata-check",
+			WorkDir:     "/opt/pgedge/data/pg-upgrade",
+			LibraryPath: "/opt/pgedge/data/pg-upgrade-install/lib",
+			Check:       true,
+			OldPort:     5432,
+		})
+		assert.Contains(t, script, `old_bindir="$(pg_config --bindir)"`)
+		assert.Contains(t, script, "export LD_LIBRARY_PATH='/opt/pgedge/data/pg-upgrade-install/lib'")
+		assert.Contains(t, script, `trap 'rm -rf "$new_datadir"' EXIT`)
+		assert.Contains(t, script, "--link --check --old-port=5432\n")
+	})
+
+	t.Run("quotes paths", func(t *testing.T) {
+		script := database.PgUpgradeScript(database.PgUpgradeScriptOptions{
+			OldDataDir: "/data/it's here",
+		})
+		assert.Contains(t, script, `old_datadir='/data/it'\''s here'`)
+	})
+}
@@ -34,6 +34,8 @@ type PostgresDatabaseResource struct {
 	HasRestoreConfig   bool                  `json:"has_restore_config"`
 	ExtraDependencies  []resource.Identifier `json:"extra_dependencies"`
 	PostDatabaseCreate *Script               `json:"post_database_create,omitempty"`
+	Scrub              *Script               `json:"scrub,omitempty"`
+	QueryStats         bool                  `json:"query_stats,omitempty"`
 }
 
 func (p *PostgresDatabaseResource) ResourceVersion() string {
@@ -101,6 +103,9 @@ func (p *PostgresDatabaseResource) Refresh(ctx context.Context, rc *resource.Con
 	if err := SetScriptNeedsToRun(ctx, rc, p.PostDatabaseCreate); err != nil {
 		return err
 	}
+	if err := SetScriptNeedsToRun(ctx, rc, p.Scrub); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -137,6 +142,9 @@ func (p *PostgresDatabaseResource) Create(ctx context.Context, rc *resource.Cont
 	if err := p.updateUserPrivileges(ctx, rc, primary); err != nil {
 		return err
 	}
+	if err := p.enableQueryStats(ctx, rc, primary); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -198,6 +206,9 @@ func (p *PostgresDatabaseResource) postRestore(ctx context.Context, rc *resource
 			return fmt.Errorf("failed to initialize spock: %w", err)
 		}
 	}
+	if err := ExecuteScript(ctx, rc, conn, p.Scrub); err != nil {
+		return fmt.Errorf("failed to execute scrub script: %w", err)
+	}
 
 	return nil
 }
@@ -305,6 +316,28 @@ func (p *PostgresDatabaseResource) updateUserPrivileges(ctx context.Context, rc
 	return nil
 }
 
+// enableQueryStats creates the pg_stat_statements extension when query stats
+// are enabled. We leave the extension in place when they're disabled because
+// users may have their own uses for it.
+func (p *PostgresDatabaseResource) enableQueryStats(ctx context.Context, rc *resource.Context, primary *InstanceResource) error {
+	if !p.QueryStats {
+		return nil
+	}
+
+	conn, err := primary.Connection(ctx, rc, p.DatabaseName)
+	if err != nil {
+		return fmt.Errorf("failed to connect to instance: %w", err)
+	}
+	defer conn.Close(ctx)
+
+	err = postgres.CreatePgStatStatementsExtension().Exec(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed to create pg_stat_statements extension: %w", err)
+	}
+
+	return nil
+}
+
 func (p *PostgresDatabaseResource) isFirstTimeSetup(rc *resource.Context) bool {
 	// This database will already exist in the state if it's been successfully
 	// created before. It's important that we use State.Get here rather than
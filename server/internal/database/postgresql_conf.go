@@ -0,0 +1,322 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+)
+
+var ErrInstanceNotInSpec = errors.New("instance does not exist in database spec")
+
+type PostgreSQLConfSource string
+
+const (
+	// PostgreSQLConfSourceDefault is used for settings that the Control Plane
+	// always sets, including orchestrator-specific settings.
+	PostgreSQLConfSourceDefault PostgreSQLConfSource = "default"
+	// PostgreSQLConfSourceTunable is used for settings that are derived from
+	// the instance's memory, CPUs, and cluster size.
+	PostgreSQLConfSourceTunable PostgreSQLConfSource = "tunable"
+	// PostgreSQLConfSourceSpec is used for settings from the database-level
+	// postgresql_conf.
+	PostgreSQLConfSourceSpec PostgreSQLConfSource = "spec"
+	// PostgreSQLConfSourceNode is used for settings from the node-level
+	// postgresql_conf.
+	PostgreSQLConfSourceNode PostgreSQLConfSource = "node"
+)
+
+type PostgreSQLSetting struct {
+	Name           string
+	Value          any
+	Source         PostgreSQLConfSource
+	PendingRestart bool
+}
+
+// PostgreSQLConf is an instance's effective postgresql.conf, keyed by setting
+// name.
+type PostgreSQLConf map[string]*PostgreSQLSetting
+
+type PostgreSQLConfOptions struct {
+	// MemoryBytes is the instance's memory, which is used for the tunable
+	// settings.
+	MemoryBytes uint64
+	// CPUs is the instance's CPUs, which is used for the tunable settings.
+	CPUs float64
+	// ClusterSize is the number of nodes in the database, which is used for
+	// the tunable settings.
+	ClusterSize int
+	// NodeOrdinal is the ordinal part of the node name, e.g. '1' for 'n1'.
+	NodeOrdinal int
+	// QueryStats enables the pg_stat_statements settings that are used for
+	// query stats collection.
+	QueryStats bool
+	// SystemParameters are the orchestrator-specific settings, such as the
+	// SSL and archive settings.
+	SystemParameters map[string]any
+	// SpecConf is the database-level postgresql_conf.
+	SpecConf map[string]any
+	// NodeConf is the node-level postgresql_conf.
+	NodeConf map[string]any
+}
+
+// EffectivePostgreSQLConf merges each source of Postgres settings in order of
+// precedence, from lowest to highest: defaults, tunables, orchestrator
+// settings, spec settings, then node settings.
+func EffectivePostgreSQLConf(opts *PostgreSQLConfOptions) PostgreSQLConf {
+	conf := PostgreSQLConf{}
+	conf.set(postgres.DefaultGUCs(), PostgreSQLConfSourceDefault)
+	conf.set(postgres.SpockDefaultGUCs(), PostgreSQLConfSourceDefault)
+	conf.set(postgres.DefaultTunableGUCs(opts.MemoryBytes, opts.CPUs, opts.ClusterSize), PostgreSQLConfSourceTunable)
+	conf.set(opts.SystemParameters, PostgreSQLConfSourceDefault)
+	conf.set(postgres.SnowflakeLolorGUCs(opts.NodeOrdinal), PostgreSQLConfSourceDefault)
+	if opts.QueryStats {
+		conf.set(postgres.QueryStatsGUCs(), PostgreSQLConfSourceDefault)
+	}
+	conf.set(opts.SpecConf, PostgreSQLConfSourceSpec)
+	conf.set(opts.NodeConf, PostgreSQLConfSourceNode)
+	if opts.QueryStats {
+		conf.ensurePreloaded("pg_stat_statements")
+	}
+
+	return conf
+}
+
+// ensurePreloaded adds the given library to shared_preload_libraries if a user
+// override has left it out.
+func (c PostgreSQLConf) ensurePreloaded(library string) {
+	setting, ok := c["shared_preload_libraries"]
+	if !ok {
+		c.set(map[string]any{"shared_preload_libraries": library}, PostgreSQLConfSourceDefault)
+		return
+	}
+	current := fmt.Sprint(setting.Value)
+	var libraries []string
+	for _, l := range strings.Split(current, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			libraries = append(libraries, l)
+		}
+	}
+	if slices.Contains(libraries, library) {
+		return
+	}
+	setting.Value = strings.Join(append(libraries, library), ",")
+}
+
+func (c PostgreSQLConf) set(parameters map[string]any, source PostgreSQLConfSource) {
+	for name, value := range parameters {
+		c[name] = &PostgreSQLSetting{
+			Name:   name,
+			Value:  value,
+			Source: source,
+		}
+	}
+}
+
+// Parameters returns the setting values keyed by name.
+func (c PostgreSQLConf) Parameters() map[string]any {
+	parameters := make(map[string]any, len(c))
+	for name, setting := range c {
+		parameters[name] = setting.Value
+	}
+	return parameters
+}
+
+// Settings returns the settings sorted by name.
+func (c PostgreSQLConf) Settings() []*PostgreSQLSetting {
+	settings := slices.Collect(maps.Values(c))
+	slices.SortFunc(settings, func(a, b *PostgreSQLSetting) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return settings
+}
+
+// MarkPendingRestart flags the given settings as waiting for a restart to take
+// effect.
+func (c PostgreSQLConf) MarkPendingRestart(names []string) {
+	for _, name := range names {
+		if setting, ok := c[name]; ok {
+			setting.PendingRestart = true
+		}
+	}
+}
+
+type PostgreSQLConfChange struct {
+	InstanceID      string
+	Name            string
+	OldValue        any
+	NewValue        any
+	RequiresRestart bool
+}
+
+// Diff returns the settings that differ between this configuration and the
+// updated configuration, sorted by name. Settings that are added or removed
+// have a nil new or old value, respectively.
+func (c PostgreSQLConf) Diff(instanceID string, updated PostgreSQLConf) []*PostgreSQLConfChange {
+	names := slices.Collect(maps.Keys(c))
+	for name := range updated {
+		if _, ok := c[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []*PostgreSQLConfChange
+	for _, name := range names {
+		var oldValue, newValue any
+		if setting, ok := c[name]; ok {
+			oldValue = setting.Value
+		}
+		if setting, ok := updated[name]; ok {
+			newValue = setting.Value
+		}
+		if settingValuesEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, &PostgreSQLConfChange{
+			InstanceID:      instanceID,
+			Name:            name,
+			OldValue:        oldValue,
+			NewValue:        newValue,
+			RequiresRestart: postgres.GUCRequiresRestart(name),
+		})
+	}
+
+	return changes
+}
+
+// settingValuesEqual compares values by their string representation so that
+// values that have been round-tripped through JSON, e.g. an int that's been
+// decoded as a float64, compare equal to the original value.
+func settingValuesEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return reflect.DeepEqual(a, b) || fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// InstancePostgreSQLConf computes the effective postgresql.conf for an
+// instance in the given spec. The instance's memory and CPUs default to the
+// host's when they're not set in the spec.
+func (s *Service) InstancePostgreSQLConf(ctx context.Context, spec *Spec, instanceID string) (PostgreSQLConf, error) {
+	nodes, err := spec.NodeInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		for _, instance := range node.Instances {
+			if instance.InstanceID != instanceID {
+				continue
+			}
+			nodeSpec, err := spec.Node(node.NodeName)
+			if err != nil {
+				return nil, err
+			}
+			return s.instancePostgreSQLConf(ctx, spec, nodeSpec, instance)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInstanceNotInSpec, instanceID)
+}
+
+func (s *Service) instancePostgreSQLConf(ctx context.Context, spec *Spec, node *Node, instance *InstanceSpec) (PostgreSQLConf, error) {
+	cpus := instance.CPUs
+	memoryBytes := instance.MemoryBytes
+	if cpus == 0 || memoryBytes == 0 {
+		host, err := s.hostSvc.GetHost(ctx, instance.HostID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host '%s': %w", instance.HostID, err)
+		}
+		if cpus == 0 {
+			cpus = float64(host.CPUs)
+		}
+		if memoryBytes == 0 {
+			memoryBytes = host.MemBytes
+		}
+	}
+	systemParameters, err := s.orchestrator.PostgreSQLSystemParameters(instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orchestrator parameters: %w", err)
+	}
+
+	return EffectivePostgreSQLConf(&PostgreSQLConfOptions{
+		MemoryBytes:      memoryBytes,
+		CPUs:             cpus,
+		ClusterSize:      instance.ClusterSize,
+		NodeOrdinal:      instance.NodeOrdinal,
+		QueryStats:       instance.QueryStats.IsEnabled(),
+		SystemParameters: systemParameters,
+		SpecConf:         spec.PostgreSQLConf,
+		NodeConf:         node.PostgreSQLConf,
+	}), nil
+}
+
+// GetInstancePostgreSQLConf returns the effective postgresql.conf for an
+// existing instance, including which settings are waiting for a restart.
+func (s *Service) GetInstancePostgreSQLConf(ctx context.Context, databaseID, instanceID string) (PostgreSQLConf, error) {
+	db, err := s.GetDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(db.Instances, func(i *Instance) bool {
+		return i.InstanceID == instanceID
+	})
+	if idx < 0 {
+		return nil, ErrInstanceNotFound
+	}
+
+	conf, err := s.InstancePostgreSQLConf(ctx, db.Spec, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if status := db.Instances[idx].Status; status != nil {
+		conf.MarkPendingRestart(status.PendingRestartSettings)
+	}
+
+	return conf, nil
+}
+
+// PostgreSQLConfChanges returns the settings that will change on each existing
+// instance when the database is updated from the current spec to the updated
+// spec. Instances that are being added or removed are not included.
+func (s *Service) PostgreSQLConfChanges(ctx context.Context, current, updated *Spec) ([]*PostgreSQLConfChange, error) {
+	currentNodes, err := current.NodeInstances()
+	if err != nil {
+		return nil, err
+	}
+	currentInstances := map[string]bool{}
+	for _, node := range currentNodes {
+		for _, instance := range node.Instances {
+			currentInstances[instance.InstanceID] = true
+		}
+	}
+	updatedNodes, err := updated.NodeInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*PostgreSQLConfChange
+	for _, node := range updatedNodes {
+		for _, instance := range node.Instances {
+			if !currentInstances[instance.InstanceID] {
+				continue
+			}
+			before, err := s.InstancePostgreSQLConf(ctx, current, instance.InstanceID)
+			if err != nil {
+				return nil, err
+			}
+			after, err := s.InstancePostgreSQLConf(ctx, updated, instance.InstanceID)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, before.Diff(instance.InstanceID, after)...)
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,167 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+)
+
+func TestEffectivePostgreSQLConf(t *testing.T) {
+	conf := database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{
+		MemoryBytes: 8 * 1024 * 1024 * 1024,
+		CPUs:        4,
+		ClusterSize: 3,
+		NodeOrdinal: 2,
+		SystemParameters: map[string]any{
+			"ssl": "on",
+		},
+		SpecConf: map[string]any{
+			"max_connections": 500,
+			"work_mem":        "16MB",
+		},
+		NodeConf: map[string]any{
+			"work_mem": "64MB",
+		},
+	})
+
+	for _, tc := range []struct {
+		name   string
+		value  any
+		source database.PostgreSQLConfSource
+	}{
+		{name: "wal_level", value: "logical", source: database.PostgreSQLConfSourceDefault},
+		{name: "spock.conflict_resolution", value: "last_update_wins", source: database.PostgreSQLConfSourceDefault},
+		{name: "ssl", value: "on", source: database.PostgreSQLConfSourceDefault},
+		{name: "snowflake.node", value: 2, source: database.PostgreSQLConfSourceDefault},
+		{name: "shared_buffers", value: int64(262144), source: database.PostgreSQLConfSourceTunable},
+		{name: "max_connections", value: 500, source: database.PostgreSQLConfSourceSpec},
+		{name: "work_mem", value: "64MB", source: database.PostgreSQLConfSourceNode},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, conf, tc.name)
+			assert.Equal(t, tc.value, conf[tc.name].Value)
+			assert.Equal(t, tc.source, conf[tc.name].Source)
+		})
+	}
+
+	settings := conf.Settings()
+	require.Len(t, settings, len(conf))
+	for i := 1; i < len(settings); i++ {
+		assert.Less(t, settings[i-1].Name, settings[i].Name)
+	}
+
+	conf.MarkPendingRestart([]string{"max_connections", "not_a_setting"})
+	assert.True(t, conf["max_connections"].PendingRestart)
+	assert.False(t, conf["work_mem"].PendingRestart)
+}
+
+func TestPostgreSQLConfDiff(t *testing.T) {
+	before := database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{
+		MemoryBytes: 4 * 1024 * 1024 * 1024,
+		CPUs:        2,
+		ClusterSize: 3,
+		SpecConf: map[string]any{
+			"work_mem":          "16MB",
+			"jit":               "off",
+			"statement_timeout": float64(1000),
+		},
+	})
+	after := database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{
+		MemoryBytes: 8 * 1024 * 1024 * 1024,
+		CPUs:        2,
+		ClusterSize: 3,
+		SpecConf: map[string]any{
+			"work_mem":          "32MB",
+			"statement_timeout": 1000,
+		},
+	})
+
+	var names []string
+	changes := before.Diff("storefront-n1-689qacsi", after)
+	for _, change := range changes {
+		names = append(names, change.Name)
+		assert.Equal(t, "storefront-n1-689qacsi", change.InstanceID)
+	}
+	assert.Equal(t, []string{
+		"autovacuum_work_mem",
+		"effective_cache_size",
+		"jit",
+		"maintenance_work_mem",
+		"max_connections",
+		"shared_buffers",
+		"work_mem",
+	}, names)
+
+	byName := map[string]*database.PostgreSQLConfChange{}
+	for _, change := range changes {
+		byName[change.Name] = change
+	}
+	assert.Equal(t, &database.PostgreSQLConfChange{
+		InstanceID:      "storefront-n1-689qacsi",
+		Name:            "shared_buffers",
+		OldValue:        int64(131072),
+		NewValue:        int64(262144),
+		RequiresRestart: true,
+	}, byName["shared_buffers"])
+	assert.False(t, byName["work_mem"].RequiresRestart)
+	assert.Equal(t, "off", byName["jit"].OldValue)
+	assert.Nil(t, byName["jit"].NewValue)
+}
+
+func TestService_PostgreSQLConfChanges(t *testing.T) {
+	svc := newTestService(t, &stubOrchestrator{})
+	current := &database.Spec{
+		DatabaseID:      "storefront",
+		DatabaseName:    "storefront",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		CPUs:            2,
+		MemoryBytes:     4 * 1024 * 1024 * 1024,
+		Nodes: []*database.Node{
+			{Name: "n1", HostIDs: []string{"host-1"}},
+		},
+	}
+	updated := current.Clone()
+	updated.MemoryBytes = 8 * 1024 * 1024 * 1024
+	updated.Nodes = append(updated.Nodes, &database.Node{Name: "n2", HostIDs: []string{"host-2"}})
+
+	changes, err := svc.PostgreSQLConfChanges(t.Context(), current, updated)
+	require.NoError(t, err)
+
+	instanceID := database.InstanceIDFor("host-1", "storefront", "n1")
+	var names []string
+	for _, change := range changes {
+		assert.Equal(t, instanceID, change.InstanceID)
+		names = append(names, change.Name)
+	}
+	// max_wal_senders and max_replication_slots don't change because they
+	// have a minimum of 16.
+	assert.Equal(t, []string{
+		"autovacuum_work_mem",
+		"effective_cache_size",
+		"maintenance_work_mem",
+		"max_connections",
+		"shared_buffers",
+	}, names)
+
+	_, err = svc.InstancePostgreSQLConf(t.Context(), current, "unknown")
+	assert.ErrorIs(t, err, database.ErrInstanceNotInSpec)
+}
+
+func TestEffectivePostgreSQLConfQueryStats(t *testing.T) {
+	conf := database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{
+		QueryStats: true,
+		SpecConf: map[string]any{
+			"shared_preload_libraries": "auto_explain, spock",
+		},
+	})
+	require.Contains(t, conf, "pg_stat_statements.track")
+	assert.Equal(t, "top", conf["pg_stat_statements.track"].Value)
+	assert.Equal(t, "auto_explain,spock,pg_stat_statements", conf["shared_preload_libraries"].Value)
+
+	conf = database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{})
+	assert.NotContains(t, conf, "pg_stat_statements.track")
+}
@@ -0,0 +1,304 @@
+package database
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+const (
+	// DefaultQueryStatsTopN is the number of statements that we collect for
+	// each ranking when top_n is unset.
+	DefaultQueryStatsTopN = 20
+	// QueryStatsRefreshInterval is how often the instance monitor collects a
+	// new query stats sample.
+	QueryStatsRefreshInterval = 5 * time.Minute
+	// QueryStatsWindow is how long we retain query stats samples.
+	QueryStatsWindow = time.Hour
+)
+
+// QueryStatsSettings controls whether pg_stat_statements is enabled and
+// collected for a database.
+type QueryStatsSettings struct {
+	Enabled bool `json:"enabled"`
+	TopN    int  `json:"top_n,omitempty"`
+}
+
+func (s *QueryStatsSettings) Clone() *QueryStatsSettings {
+	if s == nil {
+		return nil
+	}
+	return &QueryStatsSettings{
+		Enabled: s.Enabled,
+		TopN:    s.TopN,
+	}
+}
+
+// IsEnabled returns true if query stats collection is enabled. It's safe to
+// call on a nil receiver.
+func (s *QueryStatsSettings) IsEnabled() bool {
+	return s != nil && s.Enabled
+}
+
+// Limit returns the number of statements to collect for each ranking.
+func (s *QueryStatsSettings) Limit() int {
+	if s == nil || s.TopN == 0 {
+		return DefaultQueryStatsTopN
+	}
+	return s.TopN
+}
+
+// QueryStatsEntry is a compact representation of a single statement's
+// cumulative statistics in a sample. The statement text is stored once per
+// instance in StoredQueryStats.Texts.
+type QueryStatsEntry struct {
+	QueryID     int64   `json:"id"`
+	Calls       int64   `json:"c"`
+	TotalTimeMs float64 `json:"ms"`
+	Rows        int64   `json:"r"`
+}
+
+type QueryStatsSample struct {
+	CollectedAt time.Time          `json:"t"`
+	Queries     []*QueryStatsEntry `json:"q"`
+}
+
+func (s *QueryStatsSample) entry(queryID int64) *QueryStatsEntry {
+	for _, e := range s.Queries {
+		if e.QueryID == queryID {
+			return e
+		}
+	}
+	return nil
+}
+
+// AddSample appends a sample to the rolling window. It discards samples that
+// are older than the window as well as any statement text that's no longer
+// referenced by a retained sample.
+func (s *StoredQueryStats) AddSample(collectedAt time.Time, stats []postgres.QueryStat, window time.Duration) {
+	if s.Texts == nil {
+		s.Texts = map[int64]string{}
+	}
+	sample := &QueryStatsSample{
+		CollectedAt: collectedAt,
+		Queries:     make([]*QueryStatsEntry, len(stats)),
+	}
+	for i, stat := range stats {
+		s.Texts[stat.QueryID] = stat.Query
+		sample.Queries[i] = &QueryStatsEntry{
+			QueryID:     stat.QueryID,
+			Calls:       stat.Calls,
+			TotalTimeMs: stat.TotalTimeMs,
+			Rows:        stat.Rows,
+		}
+	}
+
+	cutoff := collectedAt.Add(-window)
+	samples := []*QueryStatsSample{}
+	for _, existing := range s.Samples {
+		if !existing.CollectedAt.Before(cutoff) {
+			samples = append(samples, existing)
+		}
+	}
+	s.Samples = append(samples, sample)
+
+	referenced := map[int64]bool{}
+	for _, retained := range s.Samples {
+		for _, e := range retained.Queries {
+			referenced[e.QueryID] = true
+		}
+	}
+	for queryID := range s.Texts {
+		if !referenced[queryID] {
+			delete(s.Texts, queryID)
+		}
+	}
+}
+
+type QueryStatsSortBy string
+
+const (
+	QueryStatsSortByTotalTime QueryStatsSortBy = "total_time"
+	QueryStatsSortByCalls     QueryStatsSortBy = "calls"
+	QueryStatsSortByRows      QueryStatsSortBy = "rows"
+)
+
+var ErrInvalidQueryStatsSortBy = errors.New("invalid query stats sort")
+
+func (s QueryStatsSortBy) Validate() error {
+	switch s {
+	case QueryStatsSortByTotalTime, QueryStatsSortByCalls, QueryStatsSortByRows:
+		return nil
+	default:
+		return fmt.Errorf("%w: '%s'", ErrInvalidQueryStatsSortBy, s)
+	}
+}
+
+// QueryStatsItem is a statement's cumulative statistics from the most recent
+// sample. The window fields hold the change since the oldest retained sample,
+// and they're nil when the statement was not in that sample.
+type QueryStatsItem struct {
+	QueryID           int64
+	Query             string
+	Calls             int64
+	TotalTimeMs       float64
+	MeanTimeMs        float64
+	Rows              int64
+	WindowCalls       *int64
+	WindowTotalTimeMs *float64
+	WindowRows        *int64
+}
+
+type QueryStatsSummary struct {
+	InstanceID  string
+	NodeName    string
+	CollectedAt time.Time
+	WindowStart time.Time
+	Queries     []*QueryStatsItem
+}
+
+// Summary returns the statements from the most recent sample, sorted in
+// descending order by the given field and truncated to the given limit. A
+// limit of zero returns every statement.
+func (s *StoredQueryStats) Summary(sortBy QueryStatsSortBy, limit int) *QueryStatsSummary {
+	summary := &QueryStatsSummary{
+		InstanceID: s.InstanceID,
+		NodeName:   s.NodeName,
+	}
+	if len(s.Samples) == 0 {
+		return summary
+	}
+
+	oldest := s.Samples[0]
+	latest := s.Samples[len(s.Samples)-1]
+	summary.CollectedAt = latest.CollectedAt
+	summary.WindowStart = oldest.CollectedAt
+
+	queries := make([]*QueryStatsItem, len(latest.Queries))
+	for i, e := range latest.Queries {
+		item := &QueryStatsItem{
+			QueryID:     e.QueryID,
+			Query:       s.Texts[e.QueryID],
+			Calls:       e.Calls,
+			TotalTimeMs: e.TotalTimeMs,
+			Rows:        e.Rows,
+		}
+		if e.Calls > 0 {
+			item.MeanTimeMs = e.TotalTimeMs / float64(e.Calls)
+		}
+		if oldest != latest {
+			if prev := oldest.entry(e.QueryID); prev != nil {
+				if e.Calls < prev.Calls {
+					// The statistics were reset during the window, so the
+					// current values are the best estimate of the change.
+					prev = &QueryStatsEntry{}
+				}
+				calls := e.Calls - prev.Calls
+				totalTime := e.TotalTimeMs - prev.TotalTimeMs
+				rows := e.Rows - prev.Rows
+				item.WindowCalls = &calls
+				item.WindowTotalTimeMs = &totalTime
+				item.WindowRows = &rows
+			}
+		}
+		queries[i] = item
+	}
+
+	slices.SortStableFunc(queries, func(a, b *QueryStatsItem) int {
+		switch sortBy {
+		case QueryStatsSortByCalls:
+			return cmp.Compare(b.Calls, a.Calls)
+		case QueryStatsSortByRows:
+			return cmp.Compare(b.Rows, a.Rows)
+		default:
+			return cmp.Compare(b.TotalTimeMs, a.TotalTimeMs)
+		}
+	})
+	if limit > 0 && len(queries) > limit {
+		queries = queries[:limit]
+	}
+	summary.Queries = queries
+
+	return summary
+}
+
+// AddQueryStatsSample records a new query stats sample for the given instance.
+func (s *Service) AddQueryStatsSample(
+	ctx context.Context,
+	databaseID string,
+	instanceID string,
+	nodeName string,
+	collectedAt time.Time,
+	stats []postgres.QueryStat,
+) error {
+	stored, err := s.store.QueryStats.
+		GetByKey(databaseID, instanceID).
+		Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		stored = &StoredQueryStats{
+			DatabaseID: databaseID,
+			InstanceID: instanceID,
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to get stored query stats: %w", err)
+	}
+	stored.NodeName = nodeName
+	stored.AddSample(collectedAt, stats, QueryStatsWindow)
+
+	err = s.store.QueryStats.
+		Put(stored).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update stored query stats: %w", err)
+	}
+
+	return nil
+}
+
+// GetNodeQueryStats returns a summary of the collected query stats for each
+// instance in the given node, sorted by instance ID.
+func (s *Service) GetNodeQueryStats(
+	ctx context.Context,
+	databaseID string,
+	nodeName string,
+	sortBy QueryStatsSortBy,
+	limit int,
+) ([]*QueryStatsSummary, error) {
+	stored, err := s.store.QueryStats.
+		GetByDatabaseID(databaseID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored query stats: %w", err)
+	}
+
+	var summaries []*QueryStatsSummary
+	for _, stats := range stored {
+		if stats.NodeName != nodeName {
+			continue
+		}
+		summaries = append(summaries, stats.Summary(sortBy, limit))
+	}
+	slices.SortFunc(summaries, func(a, b *QueryStatsSummary) int {
+		return strings.Compare(a.InstanceID, b.InstanceID)
+	})
+
+	return summaries, nil
+}
+
+func (s *Service) DeleteQueryStats(ctx context.Context, databaseID, instanceID string) error {
+	_, err := s.store.QueryStats.
+		DeleteByKey(databaseID, instanceID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete stored query stats: %w", err)
+	}
+
+	return nil
+}
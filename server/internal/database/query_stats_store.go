@@ -0,0 +1,67 @@
+package database
+
+import (
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// StoredQueryStats holds a rolling window of pg_stat_statements samples for a
+// single instance.
+type StoredQueryStats struct {
+	storage.StoredValue
+	DatabaseID string              `json:"database_id"`
+	InstanceID string              `json:"instance_id"`
+	NodeName   string              `json:"node_name"`
+	Texts      map[int64]string    `json:"texts"`
+	Samples    []*QueryStatsSample `json:"samples"`
+}
+
+type QueryStatsStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewQueryStatsStore(client *clientv3.Client, root string) *QueryStatsStore {
+	return &QueryStatsStore{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *QueryStatsStore) Prefix() string {
+	return storage.Prefix("/", s.root, "query_stats")
+}
+
+func (s *QueryStatsStore) DatabasePrefix(databaseID string) string {
+	return storage.Prefix(s.Prefix(), databaseID)
+}
+
+func (s *QueryStatsStore) Key(databaseID, instanceID string) string {
+	return storage.Key(s.DatabasePrefix(databaseID), instanceID)
+}
+
+func (s *QueryStatsStore) GetByKey(databaseID, instanceID string) storage.GetOp[*StoredQueryStats] {
+	key := s.Key(databaseID, instanceID)
+	return storage.NewGetOp[*StoredQueryStats](s.client, key)
+}
+
+func (s *QueryStatsStore) GetByDatabaseID(databaseID string) storage.GetMultipleOp[*StoredQueryStats] {
+	prefix := s.DatabasePrefix(databaseID)
+	return storage.NewGetPrefixOp[*StoredQueryStats](s.client, prefix)
+}
+
+func (s *QueryStatsStore) Put(item *StoredQueryStats) storage.PutOp[*StoredQueryStats] {
+	key := s.Key(item.DatabaseID, item.InstanceID)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *QueryStatsStore) DeleteByKey(databaseID, instanceID string) storage.DeleteOp {
+	key := s.Key(databaseID, instanceID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
+
+func (s *QueryStatsStore) DeleteByDatabaseID(databaseID string) storage.DeleteOp {
+	prefix := s.DatabasePrefix(databaseID)
+	return storage.NewDeletePrefixOp(s.client, prefix)
+}
@@ -0,0 +1,125 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestStoredQueryStats(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	stats := &database.StoredQueryStats{
+		DatabaseID: "storefront",
+		InstanceID: "storefront-n1-689qacsi",
+		NodeName:   "n1",
+	}
+
+	stats.AddSample(start, []postgres.QueryStat{
+		{QueryID: 1, Query: "SELECT 1", Calls: 10, TotalTimeMs: 100, Rows: 10},
+		{QueryID: 2, Query: "SELECT * FROM orders", Calls: 2, TotalTimeMs: 400, Rows: 2000},
+	}, time.Hour)
+	stats.AddSample(start.Add(30*time.Minute), []postgres.QueryStat{
+		{QueryID: 1, Query: "SELECT 1", Calls: 50, TotalTimeMs: 300, Rows: 50},
+		{QueryID: 2, Query: "SELECT * FROM orders", Calls: 4, TotalTimeMs: 900, Rows: 4000},
+		{QueryID: 3, Query: "UPDATE orders SET status = $1", Calls: 5, TotalTimeMs: 20, Rows: 5},
+	}, time.Hour)
+
+	t.Run("summary by total time", func(t *testing.T) {
+		summary := stats.Summary(database.QueryStatsSortByTotalTime, 0)
+		assert.Equal(t, start, summary.WindowStart)
+		assert.Equal(t, start.Add(30*time.Minute), summary.CollectedAt)
+		assert.Equal(t, []*database.QueryStatsItem{
+			{
+				QueryID:           2,
+				Query:             "SELECT * FROM orders",
+				Calls:             4,
+				TotalTimeMs:       900,
+				MeanTimeMs:        225,
+				Rows:              4000,
+				WindowCalls:       utils.PointerTo(int64(2)),
+				WindowTotalTimeMs: utils.PointerTo(float64(500)),
+				WindowRows:        utils.PointerTo(int64(2000)),
+			},
+			{
+				QueryID:           1,
+				Query:             "SELECT 1",
+				Calls:             50,
+				TotalTimeMs:       300,
+				MeanTimeMs:        6,
+				Rows:              50,
+				WindowCalls:       utils.PointerTo(int64(40)),
+				WindowTotalTimeMs: utils.PointerTo(float64(200)),
+				WindowRows:        utils.PointerTo(int64(40)),
+			},
+			{
+				QueryID:     3,
+				Query:       "UPDATE orders SET status = $1",
+				Calls:       5,
+				TotalTimeMs: 20,
+				MeanTimeMs:  4,
+				Rows:        5,
+			},
+		}, summary.Queries)
+	})
+
+	t.Run("summary by calls with limit", func(t *testing.T) {
+		summary := stats.Summary(database.QueryStatsSortByCalls, 2)
+		require.Len(t, summary.Queries, 2)
+		assert.Equal(t, int64(1), summary.Queries[0].QueryID)
+		assert.Equal(t, int64(3), summary.Queries[1].QueryID)
+	})
+
+	t.Run("prunes samples outside of the window", func(t *testing.T) {
+		stats.AddSample(start.Add(90*time.Minute), []postgres.QueryStat{
+			{QueryID: 1, Query: "SELECT 1", Calls: 5, TotalTimeMs: 30, Rows: 5},
+		}, time.Hour)
+		require.Len(t, stats.Samples, 2)
+		assert.Equal(t, start.Add(30*time.Minute), stats.Samples[0].CollectedAt)
+		assert.Len(t, stats.Texts, 3)
+
+		// The counters went backwards, so they must have been reset.
+		summary := stats.Summary(database.QueryStatsSortByTotalTime, 0)
+		require.Len(t, summary.Queries, 1)
+		assert.Equal(t, utils.PointerTo(int64(5)), summary.Queries[0].WindowCalls)
+
+		stats.AddSample(start.Add(150*time.Minute), nil, time.Hour)
+		require.Len(t, stats.Samples, 2)
+		assert.Equal(t, map[int64]string{1: "SELECT 1"}, stats.Texts)
+	})
+}
+
+func TestService_QueryStats(t *testing.T) {
+	svc := newTestService(t, &stubOrchestrator{})
+	ctx := t.Context()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	for _, instance := range []struct{ id, node string }{
+		{"storefront-n2-9ptayhma", "n2"},
+		{"storefront-n1-689qacsi", "n1"},
+		{"storefront-n1-ant97dj4", "n1"},
+	} {
+		err := svc.AddQueryStatsSample(ctx, "storefront", instance.id, instance.node, now, []postgres.QueryStat{
+			{QueryID: 1, Query: "SELECT 1", Calls: 1, TotalTimeMs: 1, Rows: 1},
+		})
+		require.NoError(t, err)
+	}
+
+	summaries, err := svc.GetNodeQueryStats(ctx, "storefront", "n1", database.QueryStatsSortByCalls, 10)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "storefront-n1-689qacsi", summaries[0].InstanceID)
+	assert.Equal(t, "storefront-n1-ant97dj4", summaries[1].InstanceID)
+	assert.Len(t, summaries[0].Queries, 1)
+
+	require.NoError(t, svc.DeleteQueryStats(ctx, "storefront", "storefront-n1-689qacsi"))
+	summaries, err = svc.GetNodeQueryStats(ctx, "storefront", "n1", database.QueryStatsSortByCalls, 10)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "storefront-n1-ant97dj4", summaries[0].InstanceID)
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// InstanceResourceUsageRefreshInterval is how often the instance monitor
+// collects resource usage. It's longer than the status refresh interval
+// because measuring the data directory requires walking it.
+const InstanceResourceUsageRefreshInterval = time.Minute
+
+// InstanceResourceUsage contains point-in-time resource usage for an instance.
+// The orchestrator populates the data directory, volume, CPU, and memory
+// fields, and the instance monitor populates the fields that come from
+// Postgres.
+type InstanceResourceUsage struct {
+	DataDirectoryBytes *int64     `json:"data_directory_bytes,omitempty"`
+	WALDirectoryBytes  *int64     `json:"wal_directory_bytes,omitempty"`
+	VolumeFreeBytes    *int64     `json:"volume_free_bytes,omitempty"`
+	VolumeTotalBytes   *int64     `json:"volume_total_bytes,omitempty"`
+	DatabaseSizeBytes  *int64     `json:"database_size_bytes,omitempty"`
+	Connections        *int       `json:"connections,omitempty"`
+	MaxConnections     *int       `json:"max_connections,omitempty"`
+	CPUPercent         *float64   `json:"cpu_percent,omitempty"`
+	MemoryBytes        *int64     `json:"memory_bytes,omitempty"`
+	MemoryLimitBytes   *int64     `json:"memory_limit_bytes,omitempty"`
+	DiskFull           *bool      `json:"disk_full,omitempty"`
+	CollectedAt        *time.Time `json:"collected_at,omitempty"`
+}
+
+// VolumeUsedPercent returns the percentage of the data directory's volume
+// that's in use, or false if the volume size is unknown.
+func (u *InstanceResourceUsage) VolumeUsedPercent() (float64, bool) {
+	if u == nil || u.VolumeTotalBytes == nil || u.VolumeFreeBytes == nil || *u.VolumeTotalBytes <= 0 {
+		return 0, false
+	}
+	used := *u.VolumeTotalBytes - *u.VolumeFreeBytes
+	return float64(used) / float64(*u.VolumeTotalBytes) * 100, true
+}
+
+// IsDiskFull returns true if the volume usage meets or exceeds the given
+// threshold percentage. A threshold of zero disables the check.
+func (u *InstanceResourceUsage) IsDiskFull(thresholdPercent float64) bool {
+	if thresholdPercent <= 0 {
+		return false
+	}
+	used, ok := u.VolumeUsedPercent()
+	return ok && used >= thresholdPercent
+}
+
+// DirectorySize returns the total size of the regular files under the given
+// directory. Files that are removed while the directory is being walked, such
+// as recycled WAL segments, are skipped.
+func DirectorySize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && path != dir {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to measure directory '%s': %w", dir, err)
+	}
+
+	return size, nil
+}
+
+// VolumeUsage returns the free and total bytes of the volume that contains the
+// given path. Free bytes are the bytes available to unprivileged users.
+func VolumeUsage(path string) (free int64, total int64, err error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, fmt.Errorf("failed to stat volume for '%s': %w", path, err)
+	}
+	blockSize := int64(stat.Bsize)
+
+	return int64(stat.Bavail) * blockSize, int64(stat.Blocks) * blockSize, nil
+}
+
+// HostResourceUsage returns the filesystem usage for an instance's data
+// directory on the host. It's shared by the orchestrator implementations.
+func HostResourceUsage(paths InstancePaths) (*InstanceResourceUsage, error) {
+	dataDir := paths.Host.PgData()
+	dataSize, err := DirectorySize(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	free, total, err := VolumeUsage(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstanceResourceUsage{
+		DataDirectoryBytes: &dataSize,
+		VolumeFreeBytes:    &free,
+		VolumeTotalBytes:   &total,
+	}, nil
+}
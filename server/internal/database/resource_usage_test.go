@@ -0,0 +1,137 @@
+package database_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestInstanceResourceUsage_IsDiskFull(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		usage     *database.InstanceResourceUsage
+		threshold float64
+		expected  bool
+	}{
+		{
+			name: "below threshold",
+			usage: &database.InstanceResourceUsage{
+				VolumeFreeBytes:  utils.PointerTo(int64(20)),
+				VolumeTotalBytes: utils.PointerTo(int64(100)),
+			},
+			threshold: 90,
+			expected:  false,
+		},
+		{
+			name: "at threshold",
+			usage: &database.InstanceResourceUsage{
+				VolumeFreeBytes:  utils.PointerTo(int64(10)),
+				VolumeTotalBytes: utils.PointerTo(int64(100)),
+			},
+			threshold: 90,
+			expected:  true,
+		},
+		{
+			name: "disabled",
+			usage: &database.InstanceResourceUsage{
+				VolumeFreeBytes:  utils.PointerTo(int64(0)),
+				VolumeTotalBytes: utils.PointerTo(int64(100)),
+			},
+			threshold: 0,
+			expected:  false,
+		},
+		{
+			name:      "unknown volume size",
+			usage:     &database.InstanceResourceUsage{},
+			threshold: 90,
+			expected:  false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.usage.IsDiskFull(tc.threshold))
+		})
+	}
+}
+
+func TestHostResourceUsage(t *testing.T) {
+	paths := database.InstancePaths{
+		Host: database.Paths{BaseDir: t.TempDir()},
+	}
+	pgData := paths.Host.PgData()
+	require.NoError(t, os.MkdirAll(filepath.Join(pgData, "pg_wal"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(pgData, "PG_VERSION"), []byte("18\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(pgData, "pg_wal", "000000010000000000000001"), make([]byte, 1024), 0o600))
+
+	usage, err := database.HostResourceUsage(paths)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1027), *usage.DataDirectoryBytes)
+	assert.Positive(t, *usage.VolumeTotalBytes)
+	assert.LessOrEqual(t, *usage.VolumeFreeBytes, *usage.VolumeTotalBytes)
+
+	_, err = database.HostResourceUsage(database.InstancePaths{
+		Host: database.Paths{BaseDir: filepath.Join(t.TempDir(), "missing")},
+	})
+	assert.ErrorContains(t, err, "failed to measure directory")
+}
+
+func TestService_GetDatabaseDiskFull(t *testing.T) {
+	ctx := t.Context()
+	svc := newTestService(t, &stubOrchestrator{})
+	dbID := seedDatabase(t, svc, "17.9")
+
+	require.NoError(t, svc.UpdateInstance(ctx, &database.InstanceUpdateOptions{
+		InstanceID: dbID + "-n1-instance",
+		DatabaseID: dbID,
+		HostID:     "host-1",
+		NodeName:   "n1",
+		State:      database.InstanceStateAvailable,
+	}))
+	updateStatus := func(diskFull bool) {
+		require.NoError(t, svc.UpdateInstanceStatus(ctx, dbID, dbID+"-n1-instance", &database.InstanceStatus{
+			PatroniState:    utils.PointerTo(patroni.StateRunning),
+			StatusUpdatedAt: utils.PointerTo(time.Now()),
+			Resources: &database.InstanceResourceUsage{
+				VolumeFreeBytes:  utils.PointerTo(int64(5)),
+				VolumeTotalBytes: utils.PointerTo(int64(100)),
+				DiskFull:         utils.PointerTo(diskFull),
+			},
+		}))
+	}
+
+	updateStatus(false)
+	db, err := svc.GetDatabase(ctx, dbID)
+	require.NoError(t, err)
+	assert.Equal(t, database.DatabaseStateAvailable, db.State)
+	assert.Equal(t, database.DatabaseStateAvailable, db.ReportedState())
+	require.Len(t, db.Instances, 1)
+	assert.Equal(t, database.InstanceStateAvailable, db.Instances[0].State)
+	assert.Empty(t, db.DegradedReasons)
+
+	// A full disk is reported as degraded without changing the stored state,
+	// which guards the database's operations.
+	updateStatus(true)
+	db, err = svc.GetDatabase(ctx, dbID)
+	require.NoError(t, err)
+	assert.Equal(t, database.DatabaseStateAvailable, db.State)
+	assert.Equal(t, database.DatabaseStateDegraded, db.ReportedState())
+	require.Len(t, db.Instances, 1)
+	assert.Equal(t, database.InstanceStateAvailable, db.Instances[0].State)
+	assert.Equal(t, []string{
+		"instance '" + dbID + "-n1-instance' is running out of disk space (95% used)",
+	}, db.DegradedReasons)
+
+	list, err := svc.ListDatabases(ctx, database.DatabaseListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Databases, 1)
+	assert.Equal(t, db.DegradedReasons, list.Databases[0].DegradedReasons)
+
+	require.NoError(t, svc.UpdateDatabaseState(ctx, dbID, db.State, database.DatabaseStateModifying))
+}
@@ -0,0 +1,127 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+)
+
+var ErrNoUpToDateReplica = errors.New("no up-to-date replica available")
+
+// MaintenanceWindow is a daily window, in UTC, during which disruptive
+// operations are allowed to run. Start and End are formatted as "HH:MM". A
+// window whose end is before its start spans midnight.
+type MaintenanceWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+func (m *MaintenanceWindow) Validate() error {
+	start, err := parseTimeOfDay(m.Start)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance window start: %w", err)
+	}
+	end, err := parseTimeOfDay(m.End)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance window end: %w", err)
+	}
+	if start == end {
+		return errors.New("maintenance window start and end must be different")
+	}
+
+	return nil
+}
+
+// NextOpen returns the earliest time at or after now that falls within the
+// window. It returns now if the window is currently open, and it returns now
+// for a nil window.
+func (m *MaintenanceWindow) NextOpen(now time.Time) time.Time {
+	if m == nil {
+		return now
+	}
+	start, err := parseTimeOfDay(m.Start)
+	if err != nil {
+		return now
+	}
+	end, err := parseTimeOfDay(m.End)
+	if err != nil {
+		return now
+	}
+
+	utc := now.UTC()
+	midnight := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	current := utc.Sub(midnight)
+
+	var open bool
+	if start < end {
+		open = current >= start && current < end
+	} else {
+		open = current >= start || current < end
+	}
+	if open {
+		return now
+	}
+	if current < start {
+		return midnight.Add(start)
+	}
+
+	return midnight.Add(24 * time.Hour).Add(start)
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a valid HH:MM time", value)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// UpToDateReplica returns the candidate that is the most caught-up streaming
+// replica in the given cluster state. Replicas that are pending a restart or
+// whose lag is unknown are not eligible. Ties are broken by the order of the
+// candidates.
+func UpToDateReplica(state *patroni.ClusterState, candidates []string) (string, error) {
+	var (
+		best    string
+		bestLag patroni.Lag
+	)
+	for _, candidate := range candidates {
+		member := clusterMember(state, candidate)
+		if member == nil || member.IsLeader() || !member.IsRunning() {
+			continue
+		}
+		if member.PendingRestart != nil && *member.PendingRestart {
+			continue
+		}
+		if member.Lag == nil || *member.Lag < 0 {
+			continue
+		}
+		if best == "" || *member.Lag < bestLag {
+			best = candidate
+			bestLag = *member.Lag
+		}
+	}
+	if best == "" {
+		return "", ErrNoUpToDateReplica
+	}
+
+	return best, nil
+}
+
+func clusterMember(state *patroni.ClusterState, name string) *patroni.ClusterMember {
+	if state == nil {
+		return nil
+	}
+	idx := slices.IndexFunc(state.Members, func(m patroni.ClusterMember) bool {
+		return m.Name != nil && *m.Name == name
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	return &state.Members[idx]
+}
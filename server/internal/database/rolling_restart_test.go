@@ -0,0 +1,129 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2025, 6, 18, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		window   *database.MaintenanceWindow
+		now      time.Time
+		expected time.Time
+	}{
+		{
+			name:     "nil window",
+			now:      day(12, 0),
+			expected: day(12, 0),
+		},
+		{
+			name:     "inside window",
+			window:   &database.MaintenanceWindow{Start: "02:00", End: "04:00"},
+			now:      day(3, 15),
+			expected: day(3, 15),
+		},
+		{
+			name:     "before window",
+			window:   &database.MaintenanceWindow{Start: "02:00", End: "04:00"},
+			now:      day(1, 0),
+			expected: day(2, 0),
+		},
+		{
+			name:     "after window",
+			window:   &database.MaintenanceWindow{Start: "02:00", End: "04:00"},
+			now:      day(4, 0),
+			expected: day(26, 0),
+		},
+		{
+			name:     "spans midnight before midnight",
+			window:   &database.MaintenanceWindow{Start: "22:00", End: "02:00"},
+			now:      day(23, 30),
+			expected: day(23, 30),
+		},
+		{
+			name:     "spans midnight after midnight",
+			window:   &database.MaintenanceWindow{Start: "22:00", End: "02:00"},
+			now:      day(1, 30),
+			expected: day(1, 30),
+		},
+		{
+			name:     "spans midnight closed",
+			window:   &database.MaintenanceWindow{Start: "22:00", End: "02:00"},
+			now:      day(12, 0),
+			expected: day(22, 0),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.window.NextOpen(tc.now))
+		})
+	}
+}
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	assert.NoError(t, (&database.MaintenanceWindow{Start: "22:00", End: "02:00"}).Validate())
+	assert.ErrorContains(t, (&database.MaintenanceWindow{Start: "2am", End: "04:00"}).Validate(), "start")
+	assert.ErrorContains(t, (&database.MaintenanceWindow{Start: "02:00", End: "24:30"}).Validate(), "end")
+	assert.ErrorContains(t, (&database.MaintenanceWindow{Start: "02:00", End: "02:00"}).Validate(), "different")
+}
+
+func TestUpToDateReplica(t *testing.T) {
+	member := func(name string, role patroni.ClusterRole, state patroni.State, lag int64, pendingRestart bool) patroni.ClusterMember {
+		return patroni.ClusterMember{
+			Name:           utils.PointerTo(name),
+			Role:           utils.PointerTo(role),
+			State:          utils.PointerTo(state),
+			Lag:            utils.PointerTo(patroni.Lag(lag)),
+			PendingRestart: utils.PointerTo(pendingRestart),
+		}
+	}
+
+	t.Run("picks the least lagged replica", func(t *testing.T) {
+		state := &patroni.ClusterState{
+			Members: []patroni.ClusterMember{
+				member("a", patroni.ClusterRoleLeader, patroni.StateRunning, 0, true),
+				member("b", patroni.ClusterRoleReplica, patroni.StateStreaming, 1024, false),
+				member("c", patroni.ClusterRoleReplica, patroni.StateStreaming, 0, false),
+			},
+		}
+		candidate, err := database.UpToDateReplica(state, []string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Equal(t, "c", candidate)
+	})
+
+	t.Run("breaks ties by candidate order", func(t *testing.T) {
+		state := &patroni.ClusterState{
+			Members: []patroni.ClusterMember{
+				member("b", patroni.ClusterRoleReplica, patroni.StateStreaming, 0, false),
+				member("c", patroni.ClusterRoleReplica, patroni.StateStreaming, 0, false),
+			},
+		}
+		candidate, err := database.UpToDateReplica(state, []string{"c", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, "c", candidate)
+	})
+
+	t.Run("skips ineligible replicas", func(t *testing.T) {
+		state := &patroni.ClusterState{
+			Members: []patroni.ClusterMember{
+				member("a", patroni.ClusterRoleLeader, patroni.StateRunning, 0, false),
+				member("b", patroni.ClusterRoleReplica, patroni.StateStreaming, 0, true),
+				member("c", patroni.ClusterRoleReplica, patroni.StateStreaming, -1, false),
+				member("d", patroni.ClusterRoleReplica, patroni.StateStopped, 0, false),
+			},
+		}
+		_, err := database.UpToDateReplica(state, []string{"a", "b", "c", "d", "e"})
+		assert.ErrorIs(t, err, database.ErrNoUpToDateReplica)
+	})
+}
@@ -21,6 +21,7 @@ func (s ScriptName) String() string {
 const (
 	ScriptNamePostInit           ScriptName = "post_init"
 	ScriptNamePostDatabaseCreate ScriptName = "post_database_create"
+	ScriptNameScrub              ScriptName = "scrub"
 )
 
 type Script struct {
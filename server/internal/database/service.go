@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -118,6 +119,50 @@ func (s *Service) UpdateDatabase(ctx context.Context, state DatabaseState, spec
 	return s.applySpecUpdate(ctx, currentSpec, currentDB, spec, state)
 }
 
+// UpdateDatabaseSpec persists the spec for a database that's already being
+// modified by a workflow. Unlike UpdateDatabase, it neither checks nor changes
+// the database's state.
+func (s *Service) UpdateDatabaseSpec(ctx context.Context, spec *Spec) error {
+	currentSpec, err := s.store.Spec.GetByKey(spec.DatabaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return ErrDatabaseNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get database spec: %w", err)
+	}
+	if err := ValidateChangedSpec(currentSpec.Spec, spec); err != nil {
+		return err
+	}
+
+	currentSpec.Spec = spec
+	if err := s.store.Spec.Update(currentSpec).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to persist database spec: %w", err)
+	}
+
+	return nil
+}
+
+// UpgradeDatabaseSpec is like UpdateDatabaseSpec, except that it allows
+// instances to move to a newer Postgres major version. It's used by in-place
+// upgrades, which keep each instance's ID and data across major versions.
+func (s *Service) UpgradeDatabaseSpec(ctx context.Context, spec *Spec) error {
+	currentSpec, err := s.store.Spec.GetByKey(spec.DatabaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return ErrDatabaseNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get database spec: %w", err)
+	}
+	if err := validateChangedSpec(currentSpec.Spec, spec, true); err != nil {
+		return err
+	}
+
+	currentSpec.Spec = spec
+	if err := s.store.Spec.Update(currentSpec).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to persist database spec: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Service) DeleteDatabase(ctx context.Context, databaseID string) error {
 	specs, err := s.store.InstanceSpec.
 		GetByDatabaseID(databaseID).
@@ -164,9 +209,11 @@ func (s *Service) DeleteDatabase(ctx context.Context, databaseID string) error {
 	}
 
 	ops = append(ops,
+		s.store.Drift.DeleteByKey(databaseID),
 		s.store.Instance.DeleteByDatabaseID(databaseID),
 		s.store.InstanceSpec.DeleteByDatabaseID(databaseID),
 		s.store.InstanceStatus.DeleteByDatabaseID(databaseID),
+		s.store.QueryStats.DeleteByDatabaseID(databaseID),
 		s.store.ScriptResult.DeleteByDatabaseID(databaseID),
 		s.store.ServiceInstanceSpec.DeleteByDatabaseID(databaseID),
 	)
@@ -202,7 +249,15 @@ func (s *Service) GetDatabase(ctx context.Context, databaseID string) (*Database
 		return nil, fmt.Errorf("failed to get service instances: %w", err)
 	}
 
-	return storedToDatabase(storedDb, storedSpec, instances, serviceInstances), nil
+	drift, err := s.GetDrift(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := storedToDatabase(storedDb, storedSpec, instances, serviceInstances)
+	db.Drift = drift
+
+	return db, nil
 }
 
 func (s *Service) GetDatabases(ctx context.Context) ([]*Database, error) {
@@ -249,6 +304,50 @@ func (s *Service) GetDatabasesByHostId(ctx context.Context, hostID string) ([]*D
 	return result, nil
 }
 
+// CompletePatroniDCSSwitch records that the given node has been restarted with
+// its database's patroni_dcs.
+func (s *Service) CompletePatroniDCSSwitch(ctx context.Context, databaseID, nodeName string) error {
+	storedDb, err := s.store.Database.GetByKey(databaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return ErrDatabaseNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get database: %w", err)
+	}
+	if !slices.Contains(storedDb.PatroniDCSSwitchNodes, nodeName) {
+		return nil
+	}
+
+	storedDb.PatroniDCSSwitchNodes = slices.DeleteFunc(storedDb.PatroniDCSSwitchNodes, func(n string) bool {
+		return n == nodeName
+	})
+	if err := s.store.Database.Update(storedDb).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update database: %w", err)
+	}
+
+	return nil
+}
+
+// SetDatabaseImporting records whether the database has an import that hasn't
+// been cut over.
+func (s *Service) SetDatabaseImporting(ctx context.Context, databaseID string, importing bool) error {
+	storedDb, err := s.store.Database.GetByKey(databaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return ErrDatabaseNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get database: %w", err)
+	}
+	if storedDb.Importing == importing {
+		return nil
+	}
+
+	storedDb.Importing = importing
+	if err := s.store.Database.Update(storedDb).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update database: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Service) UpdateDatabaseState(ctx context.Context, databaseID string, from, to DatabaseState) error {
 	storedDb, err := s.store.Database.GetByKey(databaseID).Exec(ctx)
 	if errors.Is(err, storage.ErrNotFound) {
@@ -379,6 +478,9 @@ func (s *Service) DeleteInstance(ctx context.Context, databaseID, instanceID str
 	if err != nil {
 		return fmt.Errorf("failed to delete stored instance status: %w", err)
 	}
+	if err := s.DeleteQueryStats(ctx, databaseID, instanceID); err != nil {
+		return err
+	}
 
 	return s.DeleteInstanceSpec(ctx, databaseID, instanceID)
 }
@@ -520,6 +622,18 @@ func (s *Service) CreatePgBackRestBackup(ctx context.Context, w io.Writer, datab
 	return s.orchestrator.CreatePgBackRestBackup(ctx, w, instance.Spec, options)
 }
 
+func (s *Service) GetInstanceSpec(ctx context.Context, databaseID, instanceID string) (*InstanceSpec, error) {
+	stored, err := s.store.InstanceSpec.
+		GetByKey(databaseID, instanceID).
+		Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, ErrInstanceNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get instance spec: %w", err)
+	}
+	return stored.Spec, nil
+}
+
 func (s *Service) GetInstanceConnectionInfo(ctx context.Context, databaseID, instanceID string) (*ConnectionInfo, error) {
 	storedInstance, err := s.store.Instance.
 		GetByKey(databaseID, instanceID).
@@ -590,6 +704,9 @@ func (s *Service) PopulateSpecDefaults(ctx context.Context, spec *Spec) error {
 	if spec.SpockVersion == "" {
 		spec.SpockVersion = defaultVersion.SpockVersion.String()
 	}
+	// The default DCS is resolved once and stored so that changing
+	// patroni_etcd.default doesn't move existing databases.
+	spec.PatroniDCS = ResolvePatroniDCS(spec.PatroniDCS, s.cfg.PatroniEtcd.Default)
 	specVersion, err := ds.ParsePgEdgeVersion(spec.PostgresVersion, spec.SpockVersion)
 	if err != nil {
 		return fmt.Errorf("failed to parse versions from spec: %w", err)
@@ -803,6 +920,47 @@ func (s *Service) ApplyUpgrade(ctx context.Context, databaseID, targetImage stri
 	}, nil
 }
 
+// ApplyPgUpgrade validates an in-place major version upgrade to
+// postgresVersion and sets the database state to modifying. The spec is left
+// unchanged because the workflow upgrades one node at a time.
+func (s *Service) ApplyPgUpgrade(ctx context.Context, databaseID, postgresVersion string) (*ApplyUpgradeResult, *PgUpgradePlan, error) {
+	currentSpec, err := s.store.Spec.GetByKey(databaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, ErrDatabaseNotFound
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get database spec: %w", err)
+	}
+
+	currentDB, err := s.store.Database.GetByKey(databaseID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, ErrDatabaseNotFound
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	if !DatabaseStateModifiable(currentDB.State) {
+		return nil, nil, ErrDatabaseNotModifiable
+	}
+
+	plan, err := NewPgUpgradePlan(currentSpec.Spec, postgresVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prevState := currentDB.State
+	prevSpec := currentSpec.Spec
+
+	db, err := s.applySpecUpdate(ctx, currentSpec, currentDB, currentSpec.Clone(), DatabaseStateModifying)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ApplyUpgradeResult{
+		Database:  db,
+		PrevState: prevState,
+		prevSpec:  prevSpec,
+	}, plan, nil
+}
+
 // applySpecUpdate persists newSpec and newState atomically and returns the
 // resulting Database. currentSpec and currentDB must already be fetched and
 // validated by the caller.
@@ -822,6 +980,11 @@ func (s *Service) applySpecUpdate(
 		return nil, fmt.Errorf("failed to get service instances: %w", err)
 	}
 
+	currentDB.PatroniDCSSwitchNodes = PatroniDCSSwitchNodes(
+		currentDB.PatroniDCSSwitchNodes,
+		currentSpec.Spec,
+		newSpec,
+	)
 	currentSpec.Spec = newSpec
 	currentDB.UpdatedAt = time.Now()
 	currentDB.State = newState
@@ -1015,6 +1178,10 @@ func portShouldBeReleased(current *int, new *int) bool {
 }
 
 func ValidateChangedSpec(current, updated *Spec) error {
+	return validateChangedSpec(current, updated, false)
+}
+
+func validateChangedSpec(current, updated *Spec, allowMajorUpgrade bool) error {
 	var errs []error
 
 	// Immutable: tenant_id must not change
@@ -1045,7 +1212,12 @@ func ValidateChangedSpec(current, updated *Spec) error {
 			// removed instances don't need to be checked.
 			continue
 		}
-		err := majorVersionChanged(oldInstance.PgEdgeVersion, newInstance.PgEdgeVersion)
+		var err error
+		if allowMajorUpgrade {
+			err = majorVersionDowngraded(oldInstance.PgEdgeVersion, newInstance.PgEdgeVersion)
+		} else {
+			err = majorVersionChanged(oldInstance.PgEdgeVersion, newInstance.PgEdgeVersion)
+		}
 		if err != nil {
 			errs = append(errs, fmt.Errorf("invalid change for instance %s: %w", id, err))
 		}
@@ -1090,6 +1262,24 @@ func majorVersionChanged(old, new *ds.PgEdgeVersion) error {
 	return nil
 }
 
+func majorVersionDowngraded(old, new *ds.PgEdgeVersion) error {
+	if old == nil || new == nil {
+		return errors.New("expected both current and updated versions to be defined")
+	}
+	oldPgMajor, ok := old.PostgresVersion.Major()
+	if !ok {
+		return errors.New("current postgres version is missing its major component")
+	}
+	newPgMajor, ok := new.PostgresVersion.Major()
+	if !ok {
+		return errors.New("updated postgres version is missing its major component")
+	}
+	if newPgMajor < oldPgMajor {
+		return fmt.Errorf("major version downgraded from %d to %d", oldPgMajor, newPgMajor)
+	}
+	return nil
+}
+
 func tenantIDsMatch(a, b *string) bool {
 	switch {
 	case a == nil && b == nil:
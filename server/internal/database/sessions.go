@@ -0,0 +1,76 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+type SessionAction string
+
+const (
+	// SessionActionCancel cancels the session's current query.
+	SessionActionCancel SessionAction = "cancel"
+	// SessionActionTerminate closes the session's connection.
+	SessionActionTerminate SessionAction = "terminate"
+)
+
+func (a SessionAction) Validate() error {
+	switch a {
+	case SessionActionCancel, SessionActionTerminate:
+		return nil
+	default:
+		return fmt.Errorf("invalid session action: '%s'", a)
+	}
+}
+
+// BlockingChains returns each chain of blocked sessions, ordered from the
+// session at the root of the chain to a blocked session that is not blocking
+// any others. A session that's blocked by more than one session appears in a
+// chain for each of them. Chains are sorted by their PIDs.
+func BlockingChains(sessions []postgres.Session) [][]int {
+	blockedBy := map[int][]int{}
+	blocking := map[int][]int{}
+	for _, s := range sessions {
+		for _, blocker := range s.BlockedBy {
+			blockedBy[s.PID] = append(blockedBy[s.PID], blocker)
+			blocking[blocker] = append(blocking[blocker], s.PID)
+		}
+	}
+
+	var chains [][]int
+	var walk func(pid int, chain []int)
+	walk = func(pid int, chain []int) {
+		chain = append(slices.Clone(chain), pid)
+		// Skip sessions that are already in the chain. Cycles can exist
+		// briefly before Postgres detects a deadlock.
+		var children []int
+		for _, child := range blocking[pid] {
+			if !slices.Contains(chain, child) {
+				children = append(children, child)
+			}
+		}
+		if len(children) == 0 {
+			if len(chain) > 1 {
+				chains = append(chains, chain)
+			}
+			return
+		}
+		for _, child := range children {
+			walk(child, chain)
+		}
+	}
+
+	for blocker := range blocking {
+		if len(blockedBy[blocker]) == 0 {
+			walk(blocker, nil)
+		}
+	}
+	slices.SortFunc(chains, slices.Compare)
+
+	return chains
+}
@@ -0,0 +1,68 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+)
+
+func TestBlockingChains(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sessions []postgres.Session
+		expected [][]int
+	}{
+		{
+			name: "no blocking",
+			sessions: []postgres.Session{
+				{PID: 100},
+				{PID: 101},
+			},
+			expected: nil,
+		},
+		{
+			name: "chain and fan out",
+			sessions: []postgres.Session{
+				{PID: 100},
+				{PID: 101, BlockedBy: []int{100}},
+				{PID: 102, BlockedBy: []int{101}},
+				{PID: 103, BlockedBy: []int{100}},
+				{PID: 104},
+			},
+			expected: [][]int{
+				{100, 101, 102},
+				{100, 103},
+			},
+		},
+		{
+			name: "multiple blockers",
+			sessions: []postgres.Session{
+				{PID: 100},
+				{PID: 101},
+				{PID: 102, BlockedBy: []int{100, 101}},
+			},
+			expected: [][]int{
+				{100, 102},
+				{101, 102},
+			},
+		},
+		{
+			name: "cycle below a root",
+			sessions: []postgres.Session{
+				{PID: 100},
+				{PID: 101, BlockedBy: []int{100, 102}},
+				{PID: 102, BlockedBy: []int{101}},
+			},
+			expected: [][]int{
+				{100, 101, 102},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, database.BlockingChains(tc.sessions))
+		})
+	}
+}
@@ -56,6 +56,7 @@ type Node struct {
 	RestoreConfig    *RestoreConfig    `json:"restore_config"`
 	OrchestratorOpts *OrchestratorOpts `json:"orchestrator_opts,omitempty"`
 	SourceNode       string            `json:"source_node,omitempty"`
+	Patroni          *PatroniSettings  `json:"patroni,omitempty"`
 }
 
 func (n *Node) Clone() *Node {
@@ -77,6 +78,7 @@ func (n *Node) Clone() *Node {
 		RestoreConfig:    n.RestoreConfig.Clone(),
 		OrchestratorOpts: n.OrchestratorOpts.Clone(),
 		SourceNode:       n.SourceNode,
+		Patroni:          n.Patroni.Clone(),
 	}
 }
 
@@ -254,6 +256,12 @@ type RestoreConfig struct {
 	SourceDatabaseName string                 `json:"source_database_name"`
 	Repository         *pgbackrest.Repository `json:"repository"`
 	RestoreOptions     map[string]string      `json:"restore_options"`
+	// RenameUsers maps the names of restored roles to their new names. The
+	// roles are renamed before the database users are created from the spec.
+	RenameUsers map[string]string `json:"rename_users,omitempty"`
+	// ScrubStatements run once in the restored database before it becomes
+	// available.
+	ScrubStatements []string `json:"scrub_statements,omitempty"`
 }
 
 // DefaultOptionalFieldsFrom will default this config's optional fields to the
@@ -274,6 +282,8 @@ func (r *RestoreConfig) Clone() *RestoreConfig {
 		SourceDatabaseName: r.SourceDatabaseName,
 		Repository:         r.Repository.Clone(),
 		RestoreOptions:     maps.Clone(r.RestoreOptions),
+		RenameUsers:        maps.Clone(r.RenameUsers),
+		ScrubStatements:    slices.Clone(r.ScrubStatements),
 	}
 }
 
@@ -327,25 +337,29 @@ func (s *ScriptStatements) Clone() *ScriptStatements {
 }
 
 type Spec struct {
-	DatabaseID       string            `json:"database_id"`
-	TenantID         *string           `json:"tenant_id,omitempty"`
-	DatabaseName     string            `json:"database_name"`
-	PostgresVersion  string            `json:"postgres_version"`
-	SpockVersion     string            `json:"spock_version"`
-	Port             *int              `json:"port"`
-	PatroniPort      *int              `json:"patroni_port"`
-	CPUs             float64           `json:"cpus"`
-	MemoryBytes      uint64            `json:"memory"`
-	Nodes            []*Node           `json:"nodes"`
-	DatabaseUsers    []*User           `json:"database_users"`
-	Services         []*ServiceSpec    `json:"services,omitempty"`
-	BackupConfig     *BackupConfig     `json:"backup_config"`
-	RestoreConfig    *RestoreConfig    `json:"restore_config"`
-	PostgreSQLConf   map[string]any    `json:"postgresql_conf"`
-	PgHbaConf        []string          `json:"pg_hba_conf,omitempty"`
-	PgIdentConf      []string          `json:"pg_ident_conf,omitempty"`
-	OrchestratorOpts *OrchestratorOpts `json:"orchestrator_opts,omitempty"`
-	Scripts          *ScriptStatements `json:"scripts,omitempty"`
+	DatabaseID       string              `json:"database_id"`
+	TenantID         *string             `json:"tenant_id,omitempty"`
+	DatabaseName     string              `json:"database_name"`
+	PostgresVersion  string              `json:"postgres_version"`
+	SpockVersion     string              `json:"spock_version"`
+	Port             *int                `json:"port"`
+	PatroniPort      *int                `json:"patroni_port"`
+	CPUs             float64             `json:"cpus"`
+	MemoryBytes      uint64              `json:"memory"`
+	Nodes            []*Node             `json:"nodes"`
+	DatabaseUsers    []*User             `json:"database_users"`
+	Services         []*ServiceSpec      `json:"services,omitempty"`
+	BackupConfig     *BackupConfig       `json:"backup_config"`
+	RestoreConfig    *RestoreConfig      `json:"restore_config"`
+	PostgreSQLConf   map[string]any      `json:"postgresql_conf"`
+	PgHbaConf        []string            `json:"pg_hba_conf,omitempty"`
+	PgIdentConf      []string            `json:"pg_ident_conf,omitempty"`
+	OrchestratorOpts *OrchestratorOpts   `json:"orchestrator_opts,omitempty"`
+	Scripts          *ScriptStatements   `json:"scripts,omitempty"`
+	Patroni          *PatroniSettings    `json:"patroni,omitempty"`
+	QueryStats       *QueryStatsSettings `json:"query_stats,omitempty"`
+	DriftPolicy      DriftPolicy         `json:"drift_policy,omitempty"`
+	PatroniDCS       PatroniDCS          `json:"patroni_dcs,omitempty"`
 }
 
 func (s *Spec) Node(name string) (*Node, error) {
@@ -357,6 +371,17 @@ func (s *Spec) Node(name string) (*Node, error) {
 	return nil, fmt.Errorf("%w: %s", ErrNodeNotInDBSpec, name)
 }
 
+// HasHost returns true if any node in the spec has an instance on the given
+// host.
+func (s *Spec) HasHost(hostID string) bool {
+	for _, node := range s.Nodes {
+		if slices.Contains(node.HostIDs, hostID) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Spec) ValidateNodeNames(names ...string) error {
 	existing := ds.NewSet(s.NodeNames()...)
 	invalid := ds.NewSet(names...).Difference(existing)
@@ -436,6 +461,10 @@ func (s *Spec) Clone() *Spec {
 		RestoreConfig:    s.RestoreConfig.Clone(),
 		OrchestratorOpts: s.OrchestratorOpts.Clone(),
 		Scripts:          s.Scripts.Clone(),
+		Patroni:          s.Patroni.Clone(),
+		QueryStats:       s.QueryStats.Clone(),
+		DriftPolicy:      s.DriftPolicy,
+		PatroniDCS:       s.PatroniDCS,
 	}
 }
 
@@ -448,6 +477,9 @@ func (s *Spec) DefaultOptionalFieldsFrom(other *Spec) {
 	if s.SpockVersion == "" && other.SpockVersion != "" {
 		s.SpockVersion = other.SpockVersion
 	}
+	if s.PatroniDCS == "" {
+		s.PatroniDCS = other.PatroniDCS
+	}
 
 	s.defaultOptionalFieldFromNodes(other.Nodes)
 	s.defaultOptionalFieldFromUsers(other.DatabaseUsers)
@@ -563,29 +595,32 @@ func InstanceIDFor(hostID, databaseID, nodeName string) string {
 }
 
 type InstanceSpec struct {
-	InstanceID       string            `json:"instance_id"`
-	TenantID         *string           `json:"tenant_id,omitempty"`
-	DatabaseID       string            `json:"database_id"`
-	HostID           string            `json:"host_id"`
-	DatabaseName     string            `json:"database_name"`
-	NodeName         string            `json:"node_name"`
-	NodeOrdinal      int               `json:"node_ordinal"`
-	PgEdgeVersion    *ds.PgEdgeVersion `json:"pg_edge_version"`
-	Port             *int              `json:"port"`
-	PatroniPort      *int              `json:"patroni_port"`
-	CPUs             float64           `json:"cpus"`
-	MemoryBytes      uint64            `json:"memory"`
-	DatabaseUsers    []*User           `json:"database_users"`
-	BackupConfig     *BackupConfig     `json:"backup_config"`
-	RestoreConfig    *RestoreConfig    `json:"restore_config"`
-	PostgreSQLConf   map[string]any    `json:"postgresql_conf"`
-	PgHbaConf        []string          `json:"pg_hba_conf,omitempty"`
-	PgIdentConf      []string          `json:"pg_ident_conf,omitempty"`
-	ClusterSize      int               `json:"cluster_size"`
-	NodeSize         int               `json:"node_size"`
-	OrchestratorOpts *OrchestratorOpts `json:"orchestrator_opts,omitempty"`
-	InPlaceRestore   bool              `json:"in_place_restore,omitempty"`
-	AllHostIDs       []string          `json:"all_host_ids"` // All host IDs in the database
+	InstanceID       string              `json:"instance_id"`
+	TenantID         *string             `json:"tenant_id,omitempty"`
+	DatabaseID       string              `json:"database_id"`
+	HostID           string              `json:"host_id"`
+	DatabaseName     string              `json:"database_name"`
+	NodeName         string              `json:"node_name"`
+	NodeOrdinal      int                 `json:"node_ordinal"`
+	PgEdgeVersion    *ds.PgEdgeVersion   `json:"pg_edge_version"`
+	Port             *int                `json:"port"`
+	PatroniPort      *int                `json:"patroni_port"`
+	CPUs             float64             `json:"cpus"`
+	MemoryBytes      uint64              `json:"memory"`
+	DatabaseUsers    []*User             `json:"database_users"`
+	BackupConfig     *BackupConfig       `json:"backup_config"`
+	RestoreConfig    *RestoreConfig      `json:"restore_config"`
+	PostgreSQLConf   map[string]any      `json:"postgresql_conf"`
+	PgHbaConf        []string            `json:"pg_hba_conf,omitempty"`
+	PgIdentConf      []string            `json:"pg_ident_conf,omitempty"`
+	ClusterSize      int                 `json:"cluster_size"`
+	NodeSize         int                 `json:"node_size"`
+	OrchestratorOpts *OrchestratorOpts   `json:"orchestrator_opts,omitempty"`
+	InPlaceRestore   bool                `json:"in_place_restore,omitempty"`
+	AllHostIDs       []string            `json:"all_host_ids"` // All host IDs in the database
+	Patroni          *PatroniSettings    `json:"patroni,omitempty"`
+	QueryStats       *QueryStatsSettings `json:"query_stats,omitempty"`
+	PatroniDCS       PatroniDCS          `json:"patroni_dcs,omitempty"`
 }
 
 func (s *InstanceSpec) CopySettingsFrom(current *InstanceSpec) {
@@ -641,18 +676,22 @@ func (s *InstanceSpec) Clone() *InstanceSpec {
 		NodeSize:         s.NodeSize,
 		OrchestratorOpts: s.OrchestratorOpts.Clone(),
 		AllHostIDs:       slices.Clone(s.AllHostIDs),
+		Patroni:          s.Patroni.Clone(),
+		QueryStats:       s.QueryStats.Clone(),
+		PatroniDCS:       s.PatroniDCS,
 	}
 }
 
 type NodeInstances struct {
-	DatabaseID    string            `json:"database_id"`
-	DatabaseOwner string            `json:"database_owner"`
-	DatabaseName  string            `json:"database_name"`
-	NodeName      string            `json:"node_name"`
-	SourceNode    string            `json:"source_node"`
-	Instances     []*InstanceSpec   `json:"instances"`
-	RestoreConfig *RestoreConfig    `json:"restore_config"`
-	Scripts       *ScriptStatements `json:"scripts"`
+	DatabaseID    string              `json:"database_id"`
+	DatabaseOwner string              `json:"database_owner"`
+	DatabaseName  string              `json:"database_name"`
+	NodeName      string              `json:"node_name"`
+	SourceNode    string              `json:"source_node"`
+	Instances     []*InstanceSpec     `json:"instances"`
+	RestoreConfig *RestoreConfig      `json:"restore_config"`
+	Scripts       *ScriptStatements   `json:"scripts"`
+	QueryStats    *QueryStatsSettings `json:"query_stats,omitempty"`
 }
 
 func (n *NodeInstances) InstanceIDs() []string {
@@ -743,6 +782,9 @@ func (s *Spec) NodeInstances() ([]*NodeInstances, error) {
 				NodeSize:         nodeSize,
 				OrchestratorOpts: overridableValue(s.OrchestratorOpts, node.OrchestratorOpts),
 				AllHostIDs:       allHostIDs,
+				Patroni:          s.Patroni.Merge(node.Patroni),
+				QueryStats:       s.QueryStats,
+				PatroniDCS:       s.PatroniDCS,
 			}
 		}
 
@@ -755,6 +797,7 @@ func (s *Spec) NodeInstances() ([]*NodeInstances, error) {
 			Instances:     instances,
 			RestoreConfig: effectiveRestore,
 			Scripts:       s.Scripts,
+			QueryStats:    s.QueryStats,
 		}
 	}
 	return nodes, nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pgEdge/control-plane/server/internal/database"
 	"github.com/pgEdge/control-plane/server/internal/pgbackrest"
+	"github.com/pgEdge/control-plane/server/internal/utils"
 )
 
 func TestSpec(t *testing.T) {
@@ -496,6 +497,17 @@ func TestSpec(t *testing.T) {
 			assert.Equal(t, expected, new)
 		})
 
+		t.Run("keeps patroni_dcs", func(t *testing.T) {
+			current := base.Clone()
+			current.PatroniDCS = database.PatroniDCSExternal
+			new := base.Clone()
+			expected := current.Clone()
+
+			new.DefaultOptionalFieldsFrom(current)
+
+			assert.Equal(t, expected, new)
+		})
+
 		t.Run("updating postgres and spock versions", func(t *testing.T) {
 			current := base.Clone()
 			new := base.Clone()
@@ -654,3 +666,38 @@ func TestSpec_NodeInstances_PgHbaIdentMerge(t *testing.T) {
 		assert.Nil(t, nodes[0].Instances[0].PgIdentConf)
 	})
 }
+
+func TestSpec_NodeInstances_PatroniMerge(t *testing.T) {
+	s := &database.Spec{
+		DatabaseID:      "test-db",
+		DatabaseName:    "testdb",
+		PostgresVersion: "17.6",
+		SpockVersion:    "5",
+		Patroni: &database.PatroniSettings{
+			MaximumLagOnFailover: utils.PointerTo(int64(0)),
+			TTL:                  utils.PointerTo(60),
+		},
+		Nodes: []*database.Node{
+			{
+				Name:    "n1",
+				HostIDs: []string{"host-1"},
+				Patroni: &database.PatroniSettings{
+					TTL:          utils.PointerTo(40),
+					FailsafeMode: utils.PointerTo(true),
+				},
+			},
+			{Name: "n2", HostIDs: []string{"host-2"}},
+		},
+	}
+
+	nodes, err := s.NodeInstances()
+	assert.NoError(t, err)
+	assert.Equal(t, &database.PatroniSettings{
+		MaximumLagOnFailover: utils.PointerTo(int64(0)),
+		TTL:                  utils.PointerTo(40),
+		FailsafeMode:         utils.PointerTo(true),
+	}, nodes[0].Instances[0].Patroni)
+	assert.Equal(t, s.Patroni, nodes[1].Instances[0].Patroni)
+	// Merging must not modify the database-level settings.
+	assert.Equal(t, 60, *s.Patroni.TTL)
+}
@@ -10,9 +10,11 @@ type Store struct {
 	client                  *clientv3.Client
 	Spec                    *SpecStore
 	Database                *DatabaseStore
+	Drift                   *DriftStore
 	Instance                *InstanceStore
 	InstanceStatus          *InstanceStatusStore
 	InstanceSpec            *InstanceSpecStore
+	QueryStats              *QueryStatsStore
 	ScriptResult            *ScriptResultStore
 	ServiceInstance         *ServiceInstanceStore
 	ServiceInstanceStatus   *ServiceInstanceStatusStore
@@ -24,9 +26,11 @@ func NewStore(client *clientv3.Client, root string) *Store {
 		client:                  client,
 		Spec:                    NewSpecStore(client, root),
 		Database:                NewDatabaseStore(client, root),
+		Drift:                   NewDriftStore(client, root),
 		Instance:                NewInstanceStore(client, root),
 		InstanceStatus:          NewInstanceStatusStore(client, root),
 		InstanceSpec:            NewInstanceSpecStore(client, root),
+		QueryStats:              NewQueryStatsStore(client, root),
 		ScriptResult:            NewScriptResultStore(client, root),
 		ServiceInstance:         NewServiceInstanceStore(client, root),
 		ServiceInstanceStatus:   NewServiceInstanceStatusStore(client, root),
@@ -56,11 +56,18 @@ func NewDocker(logger zerolog.Logger) (*Docker, error) {
 }
 
 func (d *Docker) Exec(ctx context.Context, w io.Writer, containerID string, command []string) error {
+	return d.ExecWithEnv(ctx, w, containerID, nil, command)
+}
+
+// ExecWithEnv is like Exec, but it adds the given KEY=value pairs to the
+// command's environment.
+func (d *Docker) ExecWithEnv(ctx context.Context, w io.Writer, containerID string, env []string, command []string) error {
 	execIDResp, err := d.client.ContainerExecCreate(ctx, containerID, container.ExecOptions{
 		AttachStdin:  true,
 		AttachStdout: true,
 		AttachStderr: true,
 		Tty:          true,
+		Env:          env,
 		Cmd:          command,
 	})
 	if err != nil {
@@ -565,6 +572,23 @@ func (d *Docker) ContainerInspect(ctx context.Context, containerID string) (type
 	return resp, nil
 }
 
+// ContainerStats returns a single stats sample for the given container. The
+// Docker daemon waits for a second sample before responding so that the
+// previous CPU stats are populated.
+func (d *Docker) ContainerStats(ctx context.Context, containerID string) (container.StatsResponse, error) {
+	resp, err := d.client.ContainerStats(ctx, containerID, false)
+	if err != nil {
+		return container.StatsResponse{}, fmt.Errorf("failed to get container stats: %w", errTranslate(err))
+	}
+	defer resp.Body.Close()
+
+	var stats container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return container.StatsResponse{}, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+	return stats, nil
+}
+
 func (d *Docker) GetContainerByLabels(ctx context.Context, labels map[string]string) (types.Container, error) {
 	var f []filters.KeyValuePair
 	for key, value := range labels {
@@ -0,0 +1,224 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/monitor"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows"
+)
+
+var _ do.Shutdownable = (*Service)(nil)
+
+// Service periodically compares each database's resources against its spec
+// and records any differences on the database. When a database's drift
+// policy is set to remediate, it also starts an update task to correct the
+// drift. Checks only run while the service holds the drift detection election
+// so that each database is checked once per interval.
+type Service struct {
+	cfg         config.DriftDetection
+	logger      zerolog.Logger
+	dbSvc       *database.Service
+	taskSvc     *task.Service
+	workflowSvc *workflows.Service
+	candidate   *election.Candidate
+	monitor     *monitor.Monitor
+}
+
+func NewService(
+	cfg config.DriftDetection,
+	logger zerolog.Logger,
+	dbSvc *database.Service,
+	taskSvc *task.Service,
+	workflowSvc *workflows.Service,
+	candidate *election.Candidate,
+) *Service {
+	return &Service{
+		cfg:         cfg,
+		logger:      logger,
+		dbSvc:       dbSvc,
+		taskSvc:     taskSvc,
+		workflowSvc: workflowSvc,
+		candidate:   candidate,
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		s.logger.Debug().Msg("drift detection is disabled")
+		return nil
+	}
+	if err := s.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	interval := time.Duration(s.cfg.IntervalSeconds) * time.Second
+	s.monitor = monitor.NewMonitor(s.logger, interval, s.check)
+	// The monitor runs its first check synchronously, and a full check can
+	// take several minutes, so we start it in the background to avoid
+	// delaying startup.
+	go s.monitor.Start(ctx)
+
+	return nil
+}
+
+func (s *Service) Shutdown() error {
+	if s.monitor == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), electionTTL/3)
+	defer cancel()
+
+	s.monitor.Stop()
+	if err := s.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Error() <-chan error {
+	return s.candidate.Error()
+}
+
+func (s *Service) check(ctx context.Context) error {
+	if !s.candidate.IsLeader() {
+		return nil
+	}
+
+	dbs, err := s.dbSvc.GetDatabases(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get databases: %w", err)
+	}
+
+	interval := time.Duration(s.cfg.IntervalSeconds) * time.Second
+
+	var errs []error
+	for _, db := range dbs {
+		if db.State != database.DatabaseStateAvailable {
+			// Resources are expected to differ from the spec while the
+			// database is being modified, and we don't want to interfere
+			// with a user fixing a failed database.
+			continue
+		}
+		last, err := s.dbSvc.GetDrift(ctx, db.DatabaseID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !checkDue(last, time.Now(), interval) {
+			continue
+		}
+		if err := s.checkDatabase(ctx, db); err != nil {
+			errs = append(errs, fmt.Errorf("failed to check database %s for drift: %w", db.DatabaseID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) checkDatabase(ctx context.Context, db *database.Database) error {
+	drift := &database.Drift{
+		CheckedAt: time.Now(),
+	}
+
+	output, err := s.workflowSvc.CheckDrift(ctx, db)
+	if err != nil {
+		drift.Error = err.Error()
+	} else {
+		drift.Resources = output.Resources
+	}
+
+	if drift.HasDrift() {
+		resourceIDs := make([]string, len(drift.Resources))
+		for i, r := range drift.Resources {
+			resourceIDs[i] = r.ResourceID
+		}
+		s.logger.Warn().
+			Str("database_id", db.DatabaseID).
+			Strs("resources", resourceIDs).
+			Msg("database resources have drifted from spec")
+
+		if db.Spec.DriftPolicy == database.DriftPolicyRemediate {
+			t, remediateErr := s.remediate(ctx, db, resourceIDs)
+			if remediateErr != nil {
+				err = errors.Join(err, remediateErr)
+			} else if t != nil {
+				drift.RemediationTaskID = &t.TaskID
+			}
+		}
+	}
+
+	if setErr := s.dbSvc.SetDrift(ctx, db.DatabaseID, drift); setErr != nil {
+		err = errors.Join(err, setErr)
+	}
+
+	return err
+}
+
+// remediate starts an update task for the given database. It returns a nil
+// task if the database was modified while it was being checked, since the
+// drift check result may no longer be accurate.
+func (s *Service) remediate(ctx context.Context, db *database.Database, resourceIDs []string) (*task.Task, error) {
+	current, err := s.dbSvc.GetDatabase(ctx, db.DatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	if current.State != database.DatabaseStateAvailable || !current.UpdatedAt.Equal(db.UpdatedAt) {
+		s.logger.Debug().
+			Str("database_id", db.DatabaseID).
+			Msg("skipping drift remediation for database that changed during the check")
+		return nil, nil
+	}
+
+	updated, err := s.dbSvc.UpdateDatabase(ctx, database.DatabaseStateModifying, current.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update database: %w", err)
+	}
+	t, err := s.workflowSvc.UpdateDatabase(ctx, updated, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start update: %w", err)
+	}
+
+	s.logger.Info().
+		Str("database_id", db.DatabaseID).
+		Stringer("task_id", t.TaskID).
+		Msg("remediating database drift")
+
+	err = s.taskSvc.AddLogEntry(ctx, t.Scope, t.EntityID, t.TaskID, task.LogEntry{
+		Message: fmt.Sprintf("remediating drift in %d resources", len(resourceIDs)),
+		Fields: map[string]any{
+			"resources": resourceIDs,
+		},
+	})
+	if err != nil {
+		// This log entry is informational, so it's safe to treat this error
+		// as non-fatal.
+		s.logger.Err(err).
+			Stringer("task_id", t.TaskID).
+			Msg("failed to add task log entry")
+	}
+
+	return t, nil
+}
+
+// checkDue returns true if the given drift check result is old enough that
+// the database should be checked again. We compare against half of the
+// interval so that small variations in when each database is reached during a
+// check don't cause it to skip an entire interval.
+func checkDue(last *database.Drift, now time.Time, interval time.Duration) bool {
+	if last == nil {
+		return true
+	}
+	return now.Sub(last.CheckedAt) >= interval/2
+}
@@ -0,0 +1,26 @@
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+)
+
+func TestCheckDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	interval := 15 * time.Minute
+	checkedAt := func(ago time.Duration) *database.Drift {
+		return &database.Drift{CheckedAt: now.Add(-ago)}
+	}
+
+	assert.True(t, checkDue(nil, now, interval))
+	assert.False(t, checkDue(checkedAt(time.Minute), now, interval))
+	// A check that happened slightly less than one interval ago is due, so
+	// that small delays during the previous check don't skip an interval.
+	assert.True(t, checkDue(checkedAt(14*time.Minute), now, interval))
+	assert.True(t, checkDue(checkedAt(interval/2), now, interval))
+	assert.True(t, checkDue(checkedAt(time.Hour), now, interval))
+}
@@ -142,6 +142,15 @@ func (v *Version) Compare(other *Version) int {
 	return slices.Compare(v.Components, other.Components)
 }
 
+// HasPrefix returns true if the leading components of v are equal to the
+// components of prefix, e.g. 17.6 has the prefix 17.
+func (v *Version) HasPrefix(prefix *Version) bool {
+	if len(prefix.Components) > len(v.Components) {
+		return false
+	}
+	return slices.Equal(v.Components[:len(prefix.Components)], prefix.Components)
+}
+
 var semverRegexp = regexp.MustCompile(`^\d+(\.\d+){0,2}$`)
 
 func MustParseVersion(s string) *Version {
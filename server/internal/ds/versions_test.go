@@ -181,6 +181,29 @@ func TestVersion(t *testing.T) {
 		}
 	})
 
+	t.Run("HasPrefix", func(t *testing.T) {
+		for _, tc := range []struct {
+			v        string
+			prefix   string
+			expected bool
+		}{
+			{v: "17.6", prefix: "17", expected: true},
+			{v: "17.6", prefix: "17.6", expected: true},
+			{v: "17", prefix: "17", expected: true},
+			{v: "17.6", prefix: "17.5", expected: false},
+			{v: "17", prefix: "17.6", expected: false},
+			{v: "18.0", prefix: "17", expected: false},
+			{v: "171.0", prefix: "17", expected: false},
+		} {
+			t.Run(fmt.Sprintf("%s and %s", tc.v, tc.prefix), func(t *testing.T) {
+				v := ds.MustParseVersion(tc.v)
+				prefix := ds.MustParseVersion(tc.prefix)
+
+				assert.Equal(t, tc.expected, v.HasPrefix(prefix))
+			})
+		}
+	})
+
 	t.Run("json marshal and unmarshal", func(t *testing.T) {
 		version := &ds.Version{Components: []uint64{17, 6}}
 
@@ -0,0 +1,283 @@
+package etcd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// memberStatusTimeout limits how long we wait for each member's status so that
+// one unreachable member doesn't hold up the rest of the report.
+const memberStatusTimeout = 5 * time.Second
+
+var ErrMemberNotFound = errors.New("etcd member not found")
+
+type MemberRole string
+
+const (
+	MemberRoleVoter   MemberRole = "voter"
+	MemberRoleLearner MemberRole = "learner"
+)
+
+// MemberStatus describes a single Etcd cluster member. The member's name is
+// the ID of the host that runs it. The status fields are only populated when
+// the member is reachable.
+type MemberStatus struct {
+	ID               uint64
+	Name             string
+	Role             MemberRole
+	IsLeader         bool
+	PeerURLs         []string
+	ClientURLs       []string
+	Reachable        bool
+	Version          string
+	DBSize           int64
+	DBSizeInUse      int64
+	RaftTerm         uint64
+	RaftIndex        uint64
+	RaftAppliedIndex uint64
+	// RaftIndexLag is the number of entries that the member has yet to apply
+	// relative to the leader's raft index.
+	RaftIndexLag uint64
+	Errors       []string
+}
+
+// ClusterStatus describes the Etcd cluster's members and quorum.
+type ClusterStatus struct {
+	Members  []*MemberStatus
+	Leader   string
+	Voters   int
+	Learners int
+	// Quorum is the number of voters that must be available for the cluster
+	// to accept writes.
+	Quorum int
+	// FailureTolerance is the number of voters that can fail before the
+	// cluster loses quorum.
+	FailureTolerance int
+	Alarms           []string
+	Warnings         []string
+}
+
+// GetClusterStatus lists the cluster's members and queries the status of each
+// member. Unreachable members are included in the result with Reachable set to
+// false.
+func GetClusterStatus(ctx context.Context, client *clientv3.Client) (*ClusterStatus, error) {
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	status := &ClusterStatus{}
+	var leaderID uint64
+	var leaderIndex uint64
+	for _, m := range resp.Members {
+		member, memberLeaderID := memberStatus(ctx, client, m)
+		status.Members = append(status.Members, member)
+		if member.Role == MemberRoleLearner {
+			status.Learners++
+		} else {
+			status.Voters++
+		}
+		if leaderID == 0 && member.Reachable {
+			leaderID = memberLeaderID
+		}
+	}
+	for _, member := range status.Members {
+		if member.ID == leaderID {
+			member.IsLeader = true
+			leaderIndex = member.RaftIndex
+			status.Leader = member.Name
+		}
+	}
+	for _, member := range status.Members {
+		if member.Reachable && leaderIndex > member.RaftAppliedIndex {
+			member.RaftIndexLag = leaderIndex - member.RaftAppliedIndex
+		}
+	}
+	slices.SortFunc(status.Members, func(a, b *MemberStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	alarms, err := client.AlarmList(ctx)
+	if err != nil {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("failed to list alarms: %s", err))
+	} else {
+		for _, a := range alarms.Alarms {
+			status.Alarms = append(status.Alarms, fmt.Sprintf("%s on member %x", a.Alarm, a.MemberID))
+		}
+	}
+
+	status.Quorum = QuorumSize(status.Voters)
+	status.FailureTolerance = FailureTolerance(status.Voters)
+	status.Warnings = append(status.Warnings, QuorumWarnings(status.Voters)...)
+	if status.Leader == "" {
+		status.Warnings = append(status.Warnings, "the cluster has no leader")
+	}
+	var reachable int
+	for _, member := range status.Members {
+		if member.Role == MemberRoleVoter && member.Reachable {
+			reachable++
+		}
+	}
+	if unreachable := status.Voters - reachable; unreachable > 0 {
+		status.Warnings = append(status.Warnings, fmt.Sprintf(
+			"%d of %d voters are unreachable, so the cluster can tolerate %d more voter failures",
+			unreachable, status.Voters, max(reachable-status.Quorum, 0),
+		))
+	}
+
+	return status, nil
+}
+
+// memberStatus queries a member's status. It also returns the ID of the leader
+// as reported by the member.
+func memberStatus(ctx context.Context, client *clientv3.Client, m *etcdserverpb.Member) (*MemberStatus, uint64) {
+	member := &MemberStatus{
+		ID:         m.ID,
+		Name:       m.Name,
+		Role:       MemberRoleVoter,
+		PeerURLs:   m.PeerURLs,
+		ClientURLs: m.ClientURLs,
+	}
+	if m.IsLearner {
+		member.Role = MemberRoleLearner
+	}
+	if len(m.ClientURLs) == 0 {
+		// Members that haven't started yet don't have client URLs.
+		member.Errors = append(member.Errors, "member has not started")
+		return member, 0
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, memberStatusTimeout)
+	defer cancel()
+
+	resp, err := client.Status(ctx, m.ClientURLs[0])
+	if err != nil {
+		member.Errors = append(member.Errors, err.Error())
+		return member, 0
+	}
+	member.Reachable = true
+	member.Version = resp.Version
+	member.DBSize = resp.DbSize
+	member.DBSizeInUse = resp.DbSizeInUse
+	member.RaftTerm = resp.RaftTerm
+	member.RaftIndex = resp.RaftIndex
+	member.RaftAppliedIndex = resp.RaftAppliedIndex
+	member.Errors = append(member.Errors, resp.Errors...)
+
+	return member, resp.Leader
+}
+
+// QuorumSize returns the number of voters that must be available for a
+// cluster with the given number of voters to accept writes.
+func QuorumSize(voters int) int {
+	return voters/2 + 1
+}
+
+// FailureTolerance returns the number of voters that can fail before a cluster
+// with the given number of voters loses quorum.
+func FailureTolerance(voters int) int {
+	return max(voters-QuorumSize(voters), 0)
+}
+
+// QuorumWarnings returns warnings about a cluster with the given number of
+// voters.
+func QuorumWarnings(voters int) []string {
+	var warnings []string
+	if voters > 0 && FailureTolerance(voters) == 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"the cluster has %d voter(s) and can't tolerate the failure of any of them. Run Etcd on at least 3 hosts to tolerate a failure",
+			voters,
+		))
+	}
+	if voters > 0 && voters%2 == 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"the cluster has an even number of voters, which tolerates no more failures than %d voters",
+			voters-1,
+		))
+	}
+	return warnings
+}
+
+// DefragmentResult describes the outcome of defragmenting one member.
+type DefragmentResult struct {
+	Name         string
+	DBSizeBefore int64
+	DBSizeAfter  int64
+	Error        error
+}
+
+// Defragment defragments the given members one at a time to reclaim the space
+// that Etcd can reuse after compaction. Defragmentation blocks reads and writes
+// to the member while it runs, so the leader is defragmented last. All members
+// are defragmented if no names are given. It returns an error if any of the
+// names don't match a member.
+func Defragment(ctx context.Context, client *clientv3.Client, names ...string) ([]*DefragmentResult, error) {
+	status, err := GetClusterStatus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*MemberStatus
+	if len(names) == 0 {
+		members = status.Members
+	} else {
+		for _, name := range names {
+			idx := slices.IndexFunc(status.Members, func(m *MemberStatus) bool {
+				return m.Name == name
+			})
+			if idx < 0 {
+				return nil, fmt.Errorf("%w: '%s'", ErrMemberNotFound, name)
+			}
+			members = append(members, status.Members[idx])
+		}
+	}
+	slices.SortStableFunc(members, func(a, b *MemberStatus) int {
+		return cmp.Compare(boolToInt(a.IsLeader), boolToInt(b.IsLeader))
+	})
+
+	results := make([]*DefragmentResult, len(members))
+	for i, member := range members {
+		results[i] = defragmentMember(ctx, client, member)
+	}
+
+	return results, nil
+}
+
+func defragmentMember(ctx context.Context, client *clientv3.Client, member *MemberStatus) *DefragmentResult {
+	result := &DefragmentResult{
+		Name:         member.Name,
+		DBSizeBefore: member.DBSize,
+	}
+	if !member.Reachable {
+		result.Error = errors.New("member is unreachable")
+		return result
+	}
+	endpoint := member.ClientURLs[0]
+	if _, err := client.Defragment(ctx, endpoint); err != nil {
+		result.Error = fmt.Errorf("failed to defragment member: %w", err)
+		return result
+	}
+	resp, err := client.Status(ctx, endpoint)
+	if err != nil {
+		result.Error = fmt.Errorf("failed to get member status after defragmenting: %w", err)
+		return result
+	}
+	result.DBSizeAfter = resp.DbSize
+
+	return result
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,101 @@
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+)
+
+func TestGetClusterStatus(t *testing.T) {
+	client := storagetest.NewEtcdTestServer(t).Client(t)
+
+	status, err := etcd.GetClusterStatus(t.Context(), client)
+	require.NoError(t, err)
+
+	require.Len(t, status.Members, 1)
+	member := status.Members[0]
+	assert.Equal(t, "test", member.Name)
+	assert.Equal(t, etcd.MemberRoleVoter, member.Role)
+	assert.True(t, member.IsLeader)
+	assert.True(t, member.Reachable)
+	assert.NotEmpty(t, member.Version)
+	assert.Positive(t, member.DBSize)
+	assert.Positive(t, member.RaftIndex)
+	assert.Empty(t, member.Errors)
+
+	assert.Equal(t, "test", status.Leader)
+	assert.Equal(t, 1, status.Voters)
+	assert.Equal(t, 0, status.Learners)
+	assert.Equal(t, 1, status.Quorum)
+	assert.Equal(t, 0, status.FailureTolerance)
+	assert.Empty(t, status.Alarms)
+	assert.Equal(t, etcd.QuorumWarnings(1), status.Warnings)
+}
+
+func TestQuorumWarnings(t *testing.T) {
+	for _, tc := range []struct {
+		voters    int
+		quorum    int
+		tolerance int
+		warnings  []string
+	}{
+		{
+			voters:    1,
+			quorum:    1,
+			tolerance: 0,
+			warnings: []string{
+				"the cluster has 1 voter(s) and can't tolerate the failure of any of them. Run Etcd on at least 3 hosts to tolerate a failure",
+			},
+		},
+		{
+			voters:    2,
+			quorum:    2,
+			tolerance: 0,
+			warnings: []string{
+				"the cluster has 2 voter(s) and can't tolerate the failure of any of them. Run Etcd on at least 3 hosts to tolerate a failure",
+				"the cluster has an even number of voters, which tolerates no more failures than 1 voters",
+			},
+		},
+		{
+			voters:    3,
+			quorum:    2,
+			tolerance: 1,
+		},
+		{
+			voters:    4,
+			quorum:    3,
+			tolerance: 1,
+			warnings: []string{
+				"the cluster has an even number of voters, which tolerates no more failures than 3 voters",
+			},
+		},
+		{
+			voters:    5,
+			quorum:    3,
+			tolerance: 2,
+		},
+	} {
+		assert.Equal(t, tc.quorum, etcd.QuorumSize(tc.voters), "quorum for %d voters", tc.voters)
+		assert.Equal(t, tc.tolerance, etcd.FailureTolerance(tc.voters), "tolerance for %d voters", tc.voters)
+		assert.Equal(t, tc.warnings, etcd.QuorumWarnings(tc.voters), "warnings for %d voters", tc.voters)
+	}
+}
+
+func TestDefragment(t *testing.T) {
+	client := storagetest.NewEtcdTestServer(t).Client(t)
+
+	results, err := etcd.Defragment(t.Context(), client)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "test", results[0].Name)
+	assert.NoError(t, results[0].Error)
+	assert.Positive(t, results[0].DBSizeBefore)
+	assert.Positive(t, results[0].DBSizeAfter)
+
+	_, err = etcd.Defragment(t.Context(), client, "missing")
+	assert.ErrorIs(t, err, etcd.ErrMemberNotFound)
+}
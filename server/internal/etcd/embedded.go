@@ -67,13 +67,37 @@ func (e *EmbeddedEtcd) Start(ctx context.Context) error {
 		return err
 	}
 	if !initialized {
-		return e.initialize(ctx)
+		return e.initialize(ctx, nil)
 	}
 
 	return e.start(ctx)
 }
 
-func (e *EmbeddedEtcd) initialize(ctx context.Context) error {
+// Restore initializes a new single-member cluster in the same way as Start,
+// except that it calls seed to populate the new cluster before it loads the
+// certificate authority or creates this host's credentials. This allows seed
+// to restore a previous certificate authority so that existing certificates
+// remain valid.
+func (e *EmbeddedEtcd) Restore(ctx context.Context, seed func(ctx context.Context, client *clientv3.Client) error) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.etcd != nil {
+		return errors.New("etcd is already started")
+	}
+
+	initialized, err := e.IsInitialized()
+	if err != nil {
+		return err
+	}
+	if initialized {
+		return fmt.Errorf("etcd data directory %q must be moved or removed before restoring", e.etcdDir())
+	}
+
+	return e.initialize(ctx, seed)
+}
+
+func (e *EmbeddedEtcd) initialize(ctx context.Context, seed func(ctx context.Context, client *clientv3.Client) error) error {
 	appCfg := e.cfg.Config()
 
 	etcdCfg, err := initializationConfig(appCfg, e.logger)
@@ -88,6 +112,11 @@ func (e *EmbeddedEtcd) initialize(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get etcd client for initialization: %w", err)
 	}
+	if seed != nil {
+		if err := seed(ctx, client); err != nil {
+			return err
+		}
+	}
 	// Initialize the certificate authority. We don't persist this instance of
 	// the cert service because this client is temporary.
 	certSvc, err := certificateService(ctx, appCfg, client)
@@ -741,6 +770,10 @@ func initializationConfig(cfg config.Config, logger zerolog.Logger) (*embed.Conf
 		cfg.HostID,
 		net.JoinHostPort(loopback, strconv.Itoa(cfg.EtcdServer.PeerPort)),
 	)
+	// These match the normal server limits so that any value that was
+	// accepted by a previous cluster can be restored during initialization.
+	c.MaxTxnOps = 2048
+	c.MaxRequestBytes = 10 * 1024 * 1024 // 10MB
 	c.QuotaBackendBytes = quotaBackendBytes
 
 	return c, nil
@@ -0,0 +1,67 @@
+package etcd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+)
+
+// modeChangeRestartDelay gives the caller time to report its result before the
+// server restarts.
+const modeChangeRestartDelay = 5 * time.Second
+
+var ErrRestartForModeChange = errors.New("restarting to change etcd mode")
+
+// ModeChanger changes this host's etcd mode at runtime. Etcd mode transitions
+// happen at startup, so the ModeChanger records the new mode in the generated
+// config and then stops the server by producing an error. The server's
+// supervisor, e.g. systemd or Docker Swarm, restarts it in the new mode.
+type ModeChanger struct {
+	cfg    *config.Manager
+	logger zerolog.Logger
+	errCh  chan error
+}
+
+func NewModeChanger(cfg *config.Manager, logger zerolog.Logger) *ModeChanger {
+	return &ModeChanger{
+		cfg:    cfg,
+		logger: logger.With().Str("component", "etcd_mode_changer").Logger(),
+		errCh:  make(chan error, 1),
+	}
+}
+
+// ChangeMode records the new mode and schedules a restart. The restart is
+// skipped if the host is already running in the given mode.
+func (m *ModeChanger) ChangeMode(mode config.EtcdMode) error {
+	running := m.cfg.Config().EtcdMode == mode && m.cfg.GeneratedConfig().EtcdMode == mode
+	// Persisting the mode even when the host is already running in it removes
+	// an override that matches the user-specified etcd mode.
+	if err := m.cfg.SetEtcdModeOverride(mode); err != nil {
+		return fmt.Errorf("failed to persist etcd mode: %w", err)
+	}
+	if running {
+		return nil
+	}
+
+	m.logger.Info().
+		Str("etcd_mode", string(mode)).
+		Dur("delay", modeChangeRestartDelay).
+		Msg("restarting to change etcd mode")
+
+	time.AfterFunc(modeChangeRestartDelay, func() {
+		select {
+		case m.errCh <- fmt.Errorf("%w to '%s'", ErrRestartForModeChange, mode):
+		default:
+		}
+	})
+
+	return nil
+}
+
+func (m *ModeChanger) Error() <-chan error {
+	return m.errCh
+}
@@ -0,0 +1,122 @@
+package etcd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+)
+
+var ErrPatroniDCSNotConfigured = errors.New("an external etcd cluster for patroni is not configured on this host")
+
+// PatroniDCS connects to the external etcd cluster that's configured with
+// patroni_etcd. Patroni uses this cluster as its DCS instead of the control
+// plane's etcd for databases with the 'external' patroni_dcs.
+type PatroniDCS struct {
+	mu     sync.Mutex
+	cfg    config.Config
+	logger zerolog.Logger
+	client *clientv3.Client
+}
+
+func NewPatroniDCS(cfg config.Config, logger zerolog.Logger) *PatroniDCS {
+	return &PatroniDCS{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Configured returns true if an external cluster is configured on this host.
+func (d *PatroniDCS) Configured() bool {
+	return d.cfg.PatroniEtcd.Configured()
+}
+
+// Config returns the external cluster's configuration.
+func (d *PatroniDCS) Config() config.PatroniEtcd {
+	return d.cfg.PatroniEtcd
+}
+
+// Client returns a client for the external cluster. The client is created on
+// first use.
+func (d *PatroniDCS) Client() (*clientv3.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.client != nil {
+		return d.client, nil
+	}
+	if !d.Configured() {
+		return nil, ErrPatroniDCSNotConfigured
+	}
+
+	zap, err := newZapLogger(d.logger, d.cfg.EtcdClient.LogLevel, "patroni_dcs_client")
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize patroni dcs client logger: %w", err)
+	}
+	tlsCfg, err := patroniDCSTLSConfig(d.cfg.PatroniEtcd)
+	if err != nil {
+		return nil, err
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Logger:      zap,
+		Endpoints:   d.cfg.PatroniEtcd.Endpoints,
+		TLS:         tlsCfg,
+		Username:    d.cfg.PatroniEtcd.Username,
+		Password:    d.cfg.PatroniEtcd.Password,
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patroni dcs client: %w", err)
+	}
+	d.client = client
+
+	return client, nil
+}
+
+func (d *PatroniDCS) Shutdown() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.client == nil {
+		return nil
+	}
+	err := d.client.Close()
+	d.client = nil
+
+	return err
+}
+
+func patroniDCSTLSConfig(cfg config.PatroniEtcd) (*tls.Config, error) {
+	if cfg.CACertFile == "" {
+		return nil, nil
+	}
+	rootCA, err := os.ReadFile(cfg.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patroni dcs CA cert: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if ok := certPool.AppendCertsFromPEM(rootCA); !ok {
+		return nil, errors.New("failed to use patroni dcs CA cert")
+	}
+	tlsCfg := &tls.Config{
+		RootCAs:    certPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.ClientCertFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read patroni dcs client cert: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsCfg, nil
+}
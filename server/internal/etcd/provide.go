@@ -18,6 +18,8 @@ func Provide(i *do.Injector) {
 	provideEtcd(i)
 	provideClient(i)
 	provideGrpcLogger(i)
+	providePatroniDCS(i)
+	provideModeChanger(i)
 }
 
 func provideClient(i *do.Injector) {
@@ -115,3 +117,31 @@ func provideGrpcLogger(i *do.Injector) {
 		return newGrpcLogger(logger), nil
 	})
 }
+
+func providePatroniDCS(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*PatroniDCS, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		logger, err := do.Invoke[zerolog.Logger](i)
+		if err != nil {
+			return nil, err
+		}
+		return NewPatroniDCS(cfg, logger), nil
+	})
+}
+
+func provideModeChanger(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*ModeChanger, error) {
+		cfg, err := do.Invoke[*config.Manager](i)
+		if err != nil {
+			return nil, err
+		}
+		logger, err := do.Invoke[zerolog.Logger](i)
+		if err != nil {
+			return nil, err
+		}
+		return NewModeChanger(cfg, logger), nil
+	})
+}
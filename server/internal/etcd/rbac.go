@@ -78,6 +78,10 @@ func RemoveHostCredentials(
 	if err != nil {
 		return fmt.Errorf("failed to remove host etcd server principal: %w", err)
 	}
+	err = certSvc.RemoveHostMQTTClient(ctx, hostID)
+	if err != nil {
+		return fmt.Errorf("failed to remove host mqtt client principal: %w", err)
+	}
 
 	return nil
 }
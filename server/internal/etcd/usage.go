@@ -0,0 +1,124 @@
+package etcd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// usagePageSize is the number of keys that we read in each request when
+// computing usage. We read values as well as keys, so this is kept small to
+// avoid large responses.
+const usagePageSize = 500
+
+type PrefixUsage struct {
+	Prefix string
+	Keys   int64
+	Bytes  int64
+}
+
+// Usage describes how much space the Control Plane's data takes up in Etcd.
+// DBSize is the size of the Etcd database on disk, which includes space that
+// Etcd can reuse after compaction. DBSizeInUse excludes that space.
+type Usage struct {
+	DBSize      int64
+	DBSizeInUse int64
+	Keys        int64
+	Bytes       int64
+	Prefixes    []*PrefixUsage
+}
+
+// GetUsage computes the number of keys and the total size of the keys and
+// values under each prefix within the given root. Depth controls how many
+// levels of the key hierarchy are used to group keys, e.g. a depth of 1 groups
+// all keys under "/<root>/tasks_v2/" together. Prefixes are sorted by size,
+// largest first.
+func GetUsage(ctx context.Context, client *clientv3.Client, root string, depth int) (*Usage, error) {
+	if depth < 1 {
+		return nil, errors.New("depth must be at least 1")
+	}
+
+	endpoints := client.Endpoints()
+	if len(endpoints) == 0 {
+		return nil, errors.New("etcd client has no endpoints")
+	}
+	status, err := client.Status(ctx, endpoints[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd status: %w", err)
+	}
+
+	usage := &Usage{
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
+	}
+
+	rootPrefix := storage.Prefix("/", root)
+	byPrefix := map[string]*PrefixUsage{}
+	start := rootPrefix
+	end := clientv3.GetPrefixRangeEnd(rootPrefix)
+	for {
+		resp, err := client.Get(ctx, start,
+			clientv3.WithRange(end),
+			clientv3.WithLimit(usagePageSize),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+			clientv3.WithSerializable(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get keys: %w", err)
+		}
+		for _, kv := range resp.Kvs {
+			key := string(kv.Key)
+			prefix := usagePrefix(rootPrefix, key, depth)
+			p, ok := byPrefix[prefix]
+			if !ok {
+				p = &PrefixUsage{Prefix: prefix}
+				byPrefix[prefix] = p
+			}
+			size := int64(len(kv.Key) + len(kv.Value))
+			p.Keys++
+			p.Bytes += size
+			usage.Keys++
+			usage.Bytes += size
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			break
+		}
+		// Continue from the key immediately after the last key we read.
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+
+	usage.Prefixes = make([]*PrefixUsage, 0, len(byPrefix))
+	for _, p := range byPrefix {
+		usage.Prefixes = append(usage.Prefixes, p)
+	}
+	slices.SortFunc(usage.Prefixes, func(a, b *PrefixUsage) int {
+		if c := cmp.Compare(b.Bytes, a.Bytes); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Prefix, b.Prefix)
+	})
+
+	return usage, nil
+}
+
+// usagePrefix returns the prefix that the given key is grouped under. Keys
+// that have fewer levels than the given depth are grouped under their parent.
+func usagePrefix(rootPrefix, key string, depth int) string {
+	parts := strings.Split(strings.TrimPrefix(key, rootPrefix), "/")
+	// The last part is the key's own name rather than a prefix.
+	parts = parts[:len(parts)-1]
+	if len(parts) > depth {
+		parts = parts[:depth]
+	}
+	if len(parts) == 0 {
+		return rootPrefix
+	}
+	return rootPrefix + strings.Join(parts, "/") + "/"
+}
@@ -0,0 +1,82 @@
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+)
+
+func TestGetUsage(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	ctx := t.Context()
+
+	kvs := map[string]string{
+		"/usage-test/tasks_v2/database/db-1/a":           "12345",
+		"/usage-test/tasks_v2/host/host-1/b":             "1",
+		"/usage-test/workflows/instances/wf-1/exec-1":    "123",
+		"/usage-test/workflows/history_events/wf-1/1":    "1234567890",
+		"/usage-test/version":                            "1",
+		"/other-root/tasks_v2/database/db-1/not-counted": "1",
+	}
+	for k, v := range kvs {
+		_, err := client.Put(ctx, k, v)
+		require.NoError(t, err)
+	}
+	size := func(keys ...string) int64 {
+		var n int64
+		for _, k := range keys {
+			n += int64(len(k) + len(kvs[k]))
+		}
+		return n
+	}
+
+	usage, err := etcd.GetUsage(ctx, client, "usage-test", 1)
+	require.NoError(t, err)
+	assert.Positive(t, usage.DBSize)
+	assert.Equal(t, int64(5), usage.Keys)
+	assert.Equal(t, []*etcd.PrefixUsage{
+		{
+			Prefix: "/usage-test/workflows/",
+			Keys:   2,
+			Bytes: size(
+				"/usage-test/workflows/instances/wf-1/exec-1",
+				"/usage-test/workflows/history_events/wf-1/1",
+			),
+		},
+		{
+			Prefix: "/usage-test/tasks_v2/",
+			Keys:   2,
+			Bytes: size(
+				"/usage-test/tasks_v2/database/db-1/a",
+				"/usage-test/tasks_v2/host/host-1/b",
+			),
+		},
+		{
+			Prefix: "/usage-test/",
+			Keys:   1,
+			Bytes:  size("/usage-test/version"),
+		},
+	}, usage.Prefixes)
+
+	usage, err = etcd.GetUsage(ctx, client, "usage-test", 2)
+	require.NoError(t, err)
+	prefixes := make([]string, len(usage.Prefixes))
+	for i, p := range usage.Prefixes {
+		prefixes[i] = p.Prefix
+	}
+	assert.Equal(t, []string{
+		"/usage-test/workflows/history_events/",
+		"/usage-test/workflows/instances/",
+		"/usage-test/tasks_v2/database/",
+		"/usage-test/tasks_v2/host/",
+		"/usage-test/",
+	}, prefixes)
+
+	_, err = etcd.GetUsage(ctx, client, "usage-test", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/task"
+)
+
+type Kind string
+
+const (
+	// KindHeartbeat events are produced when a subscription starts and
+	// periodically afterward. They are not subject to the Kinds filter.
+	KindHeartbeat Kind = "heartbeat"
+	// KindDatabase events are produced when a database changes state.
+	KindDatabase Kind = "database"
+	// KindInstance events are produced when an instance changes state or when
+	// its Postgres status, such as its role, changes.
+	KindInstance Kind = "instance"
+	// KindTask events are produced when a task is created or changes status.
+	KindTask Kind = "task"
+	// KindTaskLog events are produced for each new task log entry.
+	KindTaskLog Kind = "task_log"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// Event is a single change notification. Exactly one of Database, Instance,
+// Task, or TaskLogEntry is set, depending on the event's Kind.
+type Event struct {
+	Kind Kind
+	// Revision is the etcd revision of the change that produced this event.
+	Revision   int64
+	Timestamp  time.Time
+	DatabaseID string
+	HostID     string
+	TaskID     uuid.UUID
+
+	Database     *database.Database
+	Instance     *database.Instance
+	Task         *task.Task
+	TaskLogEntry *task.LogEntry
+
+	// PreviousDatabaseState is the database's state before this change. It's
+	// only set for database events when the previous state is known.
+	PreviousDatabaseState database.DatabaseState
+	// PreviousRole is the instance's role before this change. It's only set
+	// for instance events that were produced by a role change.
+	PreviousRole patroni.InstanceRole
+}
+
+type Filter struct {
+	// DatabaseID limits events to a single database.
+	DatabaseID string
+	// HostID limits events to databases, instances, and tasks on a single
+	// host.
+	HostID string
+	// Kinds limits events to the given kinds. All kinds are included when
+	// this is empty.
+	Kinds []Kind
+}
+
+func (f Filter) includes(kind Kind) bool {
+	return len(f.Kinds) == 0 || slices.Contains(f.Kinds, kind)
+}
+
+func (f Filter) matchesTask(t *task.Task) bool {
+	if f.DatabaseID != "" && t.DatabaseID != f.DatabaseID {
+		return false
+	}
+	if f.HostID != "" && taskHostID(t) != f.HostID {
+		return false
+	}
+	return true
+}
+
+func taskHostID(t *task.Task) string {
+	if t.Scope == task.ScopeHost {
+		return t.EntityID
+	}
+	return t.HostID
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/task"
+)
+
+func Provide(i *do.Injector) {
+	provideService(i)
+}
+
+func provideService(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		dbSvc, err := do.Invoke[*database.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		dbStore, err := do.Invoke[*database.Store](i)
+		if err != nil {
+			return nil, err
+		}
+		taskStore, err := do.Invoke[*task.Store](i)
+		if err != nil {
+			return nil, err
+		}
+		return NewService(dbSvc, dbStore, taskStore), nil
+	})
+}
@@ -0,0 +1,389 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+// eventBufferSize is the number of events that can be queued for a slow
+// subscriber before the underlying watches are paused.
+const eventBufferSize = 64
+
+// heartbeatInterval is how often heartbeat events are produced. Heartbeats keep
+// idle connections open through proxies.
+const heartbeatInterval = 30 * time.Second
+
+type Service struct {
+	dbSvc     *database.Service
+	dbStore   *database.Store
+	taskStore *task.Store
+}
+
+func NewService(
+	dbSvc *database.Service,
+	dbStore *database.Store,
+	taskStore *task.Store,
+) *Service {
+	return &Service{
+		dbSvc:     dbSvc,
+		dbStore:   dbStore,
+		taskStore: taskStore,
+	}
+}
+
+// Subscribe calls handle for each change that matches the given filter until
+// the context is canceled, handle returns an error, or one of the underlying
+// watches fails. The first event is always a heartbeat, and only changes that
+// happen after the first heartbeat are reported. Events of the same kind are
+// delivered in the order that they occurred, but there is no ordering
+// guarantee between kinds. handle is never called concurrently. Subscribe
+// returns nil when the context is canceled.
+func (s *Service) Subscribe(ctx context.Context, filter Filter, handle func(e *Event) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sub := &subscription{
+		svc:    s,
+		filter: filter,
+	}
+	events := make(chan *Event, eventBufferSize)
+	errCh := make(chan error, 5)
+
+	var watches []interface{ Close() }
+	defer func() {
+		for _, w := range watches {
+			w.Close()
+		}
+	}()
+
+	// Task watches can only be narrowed by database. Host filters are applied
+	// to each event.
+	var taskScope task.Scope
+	if filter.DatabaseID != "" {
+		taskScope = task.ScopeDatabase
+	}
+
+	if filter.includes(KindDatabase) {
+		op := s.dbStore.Database.WatchChanges()
+		watches = append(watches, op)
+		if err := startWatch(ctx, op, sub.databaseEvent, events, errCh); err != nil {
+			return err
+		}
+	}
+	if filter.includes(KindInstance) {
+		instanceOp := s.dbStore.Instance.WatchChanges(filter.DatabaseID)
+		watches = append(watches, instanceOp)
+		if err := startWatch(ctx, instanceOp, sub.instanceEvent, events, errCh); err != nil {
+			return err
+		}
+		statusOp := s.dbStore.InstanceStatus.WatchChanges(filter.DatabaseID)
+		watches = append(watches, statusOp)
+		if err := startWatch(ctx, statusOp, sub.instanceStatusEvent, events, errCh); err != nil {
+			return err
+		}
+	}
+	if filter.includes(KindTask) {
+		op := s.taskStore.Task.WatchChanges(taskScope, filter.DatabaseID)
+		watches = append(watches, op)
+		if err := startWatch(ctx, op, sub.taskEvent, events, errCh); err != nil {
+			return err
+		}
+	}
+	if filter.includes(KindTaskLog) {
+		op := s.taskStore.TaskLogMessage.WatchChanges(taskScope, filter.DatabaseID)
+		watches = append(watches, op)
+		taskLogs := &taskLogConverter{
+			subscription: sub,
+			taskHosts:    map[uuid.UUID]string{},
+		}
+		if err := startWatch(ctx, op, taskLogs.taskLogEvent, events, errCh); err != nil {
+			return err
+		}
+	}
+
+	if err := handle(heartbeat()); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			return fmt.Errorf("event watch failed: %w", err)
+		case <-ticker.C:
+			if err := handle(heartbeat()); err != nil {
+				return err
+			}
+		case e := <-events:
+			if err := handle(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func heartbeat() *Event {
+	return &Event{
+		Kind:      KindHeartbeat,
+		Timestamp: time.Now(),
+	}
+}
+
+// startWatch starts the given watch, converting each modification to an event
+// with convert. Modifications that convert maps to a nil event are skipped.
+func startWatch[V storage.Value](
+	ctx context.Context,
+	op storage.WatchOp[V],
+	convert func(ctx context.Context, e *storage.Event[V]) (*Event, error),
+	events chan<- *Event,
+	errCh chan error,
+) error {
+	err := op.Watch(ctx, func(e *storage.Event[V]) error {
+		if e.Type == storage.EventTypeError {
+			return e.Err
+		}
+		evt, err := convert(ctx, e)
+		if err != nil || evt == nil {
+			return err
+		}
+		select {
+		case events <- evt:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start event watch: %w", err)
+	}
+	op.PropagateErrors(ctx, errCh)
+
+	return nil
+}
+
+type subscription struct {
+	svc    *Service
+	filter Filter
+}
+
+func (s *subscription) databaseEvent(ctx context.Context, e *storage.Event[*database.StoredDatabase]) (*Event, error) {
+	if e.Type != storage.EventTypePut {
+		return nil, nil
+	}
+	db := e.Value
+	if s.filter.DatabaseID != "" && db.DatabaseID != s.filter.DatabaseID {
+		return nil, nil
+	}
+	if e.PrevValue != nil && e.PrevValue.State == db.State {
+		return nil, nil
+	}
+	if s.filter.HostID != "" {
+		spec, err := s.svc.dbStore.Spec.
+			GetByKey(db.DatabaseID).
+			Exec(ctx)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get database spec: %w", err)
+		}
+		if !spec.Spec.HasHost(s.filter.HostID) {
+			return nil, nil
+		}
+	}
+
+	evt := &Event{
+		Kind:       KindDatabase,
+		Revision:   e.Revision,
+		Timestamp:  time.Now(),
+		DatabaseID: db.DatabaseID,
+		Database: &database.Database{
+			DatabaseID: db.DatabaseID,
+			TenantID:   db.TenantID,
+			CreatedAt:  db.CreatedAt,
+			UpdatedAt:  db.UpdatedAt,
+			State:      db.State,
+		},
+	}
+	if e.PrevValue != nil {
+		evt.PreviousDatabaseState = e.PrevValue.State
+	}
+
+	return evt, nil
+}
+
+func (s *subscription) instanceEvent(ctx context.Context, e *storage.Event[*database.StoredInstance]) (*Event, error) {
+	if e.Type != storage.EventTypePut {
+		return nil, nil
+	}
+	instance, prev := e.Value, e.PrevValue
+	if prev != nil && prev.State == instance.State && prev.Error == instance.Error {
+		return nil, nil
+	}
+	if s.filter.HostID != "" && instance.HostID != s.filter.HostID {
+		return nil, nil
+	}
+
+	return s.getInstanceEvent(ctx, e.Revision, instance.DatabaseID, instance.InstanceID)
+}
+
+func (s *subscription) instanceStatusEvent(ctx context.Context, e *storage.Event[*database.StoredInstanceStatus]) (*Event, error) {
+	if e.Type != storage.EventTypePut || e.Value.Status == nil {
+		return nil, nil
+	}
+	if e.PrevValue != nil && !instanceStatusChanged(e.PrevValue.Status, e.Value.Status) {
+		return nil, nil
+	}
+
+	evt, err := s.getInstanceEvent(ctx, e.Revision, e.Value.DatabaseID, e.Value.InstanceID)
+	if err != nil || evt == nil {
+		return nil, err
+	}
+	if e.PrevValue != nil && e.PrevValue.Status != nil {
+		prevRole := utils.FromPointer(e.PrevValue.Status.Role)
+		if prevRole != utils.FromPointer(e.Value.Status.Role) {
+			evt.PreviousRole = prevRole
+		}
+	}
+
+	return evt, nil
+}
+
+func (s *subscription) getInstanceEvent(ctx context.Context, revision int64, databaseID, instanceID string) (*Event, error) {
+	instance, err := s.svc.dbSvc.GetInstance(ctx, databaseID, instanceID)
+	if errors.Is(err, database.ErrInstanceNotFound) {
+		// The instance was deleted after this modification.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if s.filter.HostID != "" && instance.HostID != s.filter.HostID {
+		return nil, nil
+	}
+
+	return &Event{
+		Kind:       KindInstance,
+		Revision:   revision,
+		Timestamp:  time.Now(),
+		DatabaseID: instance.DatabaseID,
+		HostID:     instance.HostID,
+		Instance:   instance,
+	}, nil
+}
+
+// instanceStatusChanged returns true if any of the status fields that we
+// report events for have changed. Other fields, such as the status timestamp,
+// change on every status update.
+func instanceStatusChanged(prev, curr *database.InstanceStatus) bool {
+	if prev == nil {
+		return true
+	}
+	return utils.FromPointer(prev.Role) != utils.FromPointer(curr.Role) ||
+		utils.FromPointer(prev.PatroniState) != utils.FromPointer(curr.PatroniState) ||
+		utils.FromPointer(prev.PatroniPaused) != utils.FromPointer(curr.PatroniPaused) ||
+		utils.FromPointer(prev.PendingRestart) != utils.FromPointer(curr.PendingRestart) ||
+		utils.FromPointer(prev.Stopped) != utils.FromPointer(curr.Stopped) ||
+		utils.FromPointer(prev.Error) != utils.FromPointer(curr.Error)
+}
+
+func (s *subscription) taskEvent(_ context.Context, e *storage.Event[*task.StoredTask]) (*Event, error) {
+	if e.Type != storage.EventTypePut || e.Value.Task == nil {
+		return nil, nil
+	}
+	t := e.Value.Task
+	if e.PrevValue != nil && e.PrevValue.Task != nil && e.PrevValue.Task.Status == t.Status {
+		return nil, nil
+	}
+	if !s.filter.matchesTask(t) {
+		return nil, nil
+	}
+
+	return &Event{
+		Kind:       KindTask,
+		Revision:   e.Revision,
+		Timestamp:  time.Now(),
+		DatabaseID: t.DatabaseID,
+		HostID:     taskHostID(t),
+		TaskID:     t.TaskID,
+		Task:       t,
+	}, nil
+}
+
+// taskLogConverter converts task log entry modifications to events. Log
+// entries don't record a host ID, so it caches the host ID for each task when
+// there's a host filter.
+type taskLogConverter struct {
+	*subscription
+	taskHosts map[uuid.UUID]string
+}
+
+func (c *taskLogConverter) taskLogEvent(ctx context.Context, e *storage.Event[*task.StoredTaskLogEntry]) (*Event, error) {
+	if e.Type != storage.EventTypePut || !e.IsCreate {
+		return nil, nil
+	}
+	entry := e.Value
+	if c.filter.DatabaseID != "" && entry.DatabaseID != c.filter.DatabaseID {
+		return nil, nil
+	}
+
+	var hostID string
+	if entry.Scope == task.ScopeHost {
+		hostID = entry.EntityID
+	}
+	if c.filter.HostID != "" {
+		if hostID == "" {
+			h, err := c.taskHostID(ctx, entry)
+			if err != nil {
+				return nil, err
+			}
+			hostID = h
+		}
+		if hostID != c.filter.HostID {
+			return nil, nil
+		}
+	}
+
+	return &Event{
+		Kind:       KindTaskLog,
+		Revision:   e.Revision,
+		Timestamp:  time.Now(),
+		DatabaseID: entry.DatabaseID,
+		HostID:     hostID,
+		TaskID:     entry.TaskID,
+		TaskLogEntry: &task.LogEntry{
+			Timestamp: entry.Timestamp,
+			Message:   entry.Message,
+			Fields:    entry.Fields,
+		},
+	}, nil
+}
+
+func (c *taskLogConverter) taskHostID(ctx context.Context, entry *task.StoredTaskLogEntry) (string, error) {
+	if hostID, ok := c.taskHosts[entry.TaskID]; ok {
+		return hostID, nil
+	}
+	stored, err := c.svc.taskStore.Task.
+		GetByKey(entry.Scope, entry.EntityID, entry.TaskID).
+		Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get task: %w", err)
+	}
+	hostID := taskHostID(stored.Task)
+	c.taskHosts[entry.TaskID] = hostID
+
+	return hostID, nil
+}
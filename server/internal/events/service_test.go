@@ -0,0 +1,148 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/events"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/task"
+)
+
+func TestService_Subscribe(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	root := uuid.NewString()
+
+	logFactory, err := logging.NewFactory(config.Config{}, zerolog.Nop())
+	require.NoError(t, err)
+
+	dbStore := database.NewStore(client, root)
+	taskStore := task.NewStore(client, root)
+	dbSvc := database.NewService(config.Config{}, nil, dbStore, nil, nil, logFactory)
+	taskSvc := task.NewService(taskStore, task.ConcurrencyLimits{})
+	svc := events.NewService(dbSvc, dbStore, taskStore)
+
+	t.Run("database and task events", func(t *testing.T) {
+		ctx := t.Context()
+		received := subscribe(t, svc, events.Filter{})
+
+		db, err := dbSvc.CreateDatabase(ctx, testSpec("database-1", "host-1"))
+		require.NoError(t, err)
+
+		e := next(t, received)
+		assert.Equal(t, events.KindDatabase, e.Kind)
+		assert.Equal(t, db.DatabaseID, e.DatabaseID)
+		assert.Equal(t, database.DatabaseStateCreating, e.Database.State)
+		assert.NotZero(t, e.Revision)
+
+		require.NoError(t, dbSvc.UpdateDatabaseState(ctx, db.DatabaseID, database.DatabaseStateCreating, database.DatabaseStateAvailable))
+
+		e = next(t, received)
+		assert.Equal(t, events.KindDatabase, e.Kind)
+		assert.Equal(t, database.DatabaseStateAvailable, e.Database.State)
+		assert.Equal(t, database.DatabaseStateCreating, e.PreviousDatabaseState)
+
+		tsk, err := taskSvc.CreateTask(ctx, task.Options{
+			Scope:      task.ScopeDatabase,
+			DatabaseID: db.DatabaseID,
+			Type:       task.TypeUpdate,
+		})
+		require.NoError(t, err)
+
+		e = next(t, received)
+		assert.Equal(t, events.KindTask, e.Kind)
+		assert.Equal(t, tsk.TaskID, e.TaskID)
+		assert.Equal(t, task.StatusPending, e.Task.Status)
+
+		require.NoError(t, taskSvc.AddLogEntry(ctx, task.ScopeDatabase, db.DatabaseID, tsk.TaskID, task.LogEntry{
+			Message: "hello",
+		}))
+
+		e = next(t, received)
+		assert.Equal(t, events.KindTaskLog, e.Kind)
+		assert.Equal(t, tsk.TaskID, e.TaskID)
+		assert.Equal(t, "hello", e.TaskLogEntry.Message)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		ctx := t.Context()
+		received := subscribe(t, svc, events.Filter{
+			HostID: "host-2",
+			Kinds:  []events.Kind{events.KindDatabase},
+		})
+
+		_, err := dbSvc.CreateDatabase(ctx, testSpec("database-2", "host-1"))
+		require.NoError(t, err)
+		_, err = taskSvc.CreateTask(ctx, task.Options{
+			Scope:      task.ScopeDatabase,
+			DatabaseID: "database-2",
+			Type:       task.TypeCreate,
+		})
+		require.NoError(t, err)
+		_, err = dbSvc.CreateDatabase(ctx, testSpec("database-3", "host-2"))
+		require.NoError(t, err)
+
+		// The first event is from the only database on host-2.
+		e := next(t, received)
+		assert.Equal(t, events.KindDatabase, e.Kind)
+		assert.Equal(t, "database-3", e.DatabaseID)
+	})
+}
+
+func subscribe(t *testing.T, svc *events.Service, filter events.Filter) <-chan *events.Event {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	received := make(chan *events.Event, 16)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- svc.Subscribe(ctx, filter, func(e *events.Event) error {
+			received <- e
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-errCh)
+	})
+
+	// Changes are only reported after the first heartbeat.
+	e := next(t, received)
+	require.Equal(t, events.KindHeartbeat, e.Kind)
+
+	return received
+}
+
+func next(t *testing.T, received <-chan *events.Event) *events.Event {
+	t.Helper()
+
+	select {
+	case e := <-received:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func testSpec(databaseID, hostID string) *database.Spec {
+	return &database.Spec{
+		DatabaseID:      databaseID,
+		DatabaseName:    "test",
+		PostgresVersion: "17",
+		SpockVersion:    "5",
+		Nodes: []*database.Node{
+			{Name: "n1", HostIDs: []string{hostID}},
+		},
+	}
+}
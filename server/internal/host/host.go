@@ -9,6 +9,7 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/ds"
 	"github.com/pgEdge/control-plane/server/internal/healthcheck"
+	"github.com/pgEdge/control-plane/server/internal/version"
 )
 
 const HostMonitorRefreshInterval = 15 * time.Second
@@ -27,6 +28,9 @@ type HostStatus struct {
 	UpdatedAt  time.Time
 	State      HostState
 	Components map[string]healthcheck.ComponentStatus
+	// VersionInfo is the version of the control plane that's running on the
+	// host. It's nil for hosts that run versions that don't publish it.
+	VersionInfo *version.Info
 }
 
 type CohortType string
@@ -106,9 +110,10 @@ func fromStorage(host *StoredHost, status *StoredHostStatus) (*Host, error) {
 		SupportedPgEdgeVersions: host.SupportedPgEdgeVersions,
 		DefaultPgEdgeVersion:    host.DefaultPgEdgeVersion,
 		Status: &HostStatus{
-			UpdatedAt:  status.UpdatedAt,
-			State:      status.State,
-			Components: status.Components,
+			UpdatedAt:   status.UpdatedAt,
+			State:       status.State,
+			Components:  status.Components,
+			VersionInfo: status.VersionInfo,
 		},
 	}
 
@@ -159,10 +164,11 @@ func toStorage(host *Host) *StoredHost {
 
 func statusToStorage(status *HostStatus) *StoredHostStatus {
 	return &StoredHostStatus{
-		HostID:     status.HostID,
-		UpdatedAt:  status.UpdatedAt,
-		State:      status.State,
-		Components: status.Components,
+		HostID:      status.HostID,
+		UpdatedAt:   status.UpdatedAt,
+		State:       status.State,
+		Components:  status.Components,
+		VersionInfo: status.VersionInfo,
 	}
 }
 
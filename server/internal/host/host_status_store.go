@@ -7,14 +7,16 @@ import (
 
 	"github.com/pgEdge/control-plane/server/internal/healthcheck"
 	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
 )
 
 type StoredHostStatus struct {
 	storage.StoredValue
-	HostID     string                                 `json:"host_id"`
-	UpdatedAt  time.Time                              `json:"updated_at"`
-	State      HostState                              `json:"state"`
-	Components map[string]healthcheck.ComponentStatus `json:"components"`
+	HostID      string                                 `json:"host_id"`
+	UpdatedAt   time.Time                              `json:"updated_at"`
+	State       HostState                              `json:"state"`
+	Components  map[string]healthcheck.ComponentStatus `json:"components"`
+	VersionInfo *version.Info                          `json:"version_info,omitempty"`
 }
 
 type HostStatusStore struct {
@@ -67,6 +67,20 @@ func (s *HostStore) GetAll() storage.GetMultipleOp[*StoredHost] {
 	return storage.NewGetPrefixOp[*StoredHost](s.client, prefix)
 }
 
+// GetAfter returns the hosts, ordered by ID, with IDs that sort after
+// afterHostID. An empty afterHostID starts from the beginning.
+func (s *HostStore) GetAfter(afterHostID string) storage.GetMultipleOp[*StoredHost] {
+	rangeStart := s.Prefix()
+	rangeEnd := clientv3.GetPrefixRangeEnd(rangeStart)
+	if afterHostID != "" {
+		rangeStart = s.Key(afterHostID) + "\x00"
+	}
+
+	return storage.NewGetRangeOp[*StoredHost](s.client, rangeStart, rangeEnd,
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	)
+}
+
 func (s *HostStore) Create(item *StoredHost) storage.PutOp[*StoredHost] {
 	key := s.Key(item.ID)
 	return storage.NewCreateOp(s.client, key, item)
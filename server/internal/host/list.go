@@ -0,0 +1,95 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+)
+
+type HostListOptions struct {
+	Limit       int
+	AfterHostID string
+
+	// Optional filters
+	States []HostState
+	// PostgresVersion matches hosts that support any Postgres version with
+	// this prefix, e.g. "17" matches "17.6".
+	PostgresVersion *ds.Version
+}
+
+func (o HostListOptions) matches(h *Host) bool {
+	if len(o.States) > 0 && !slices.Contains(o.States, h.Status.State) {
+		return false
+	}
+	if o.PostgresVersion != nil {
+		supported := slices.ContainsFunc(h.SupportedPgEdgeVersions, func(v *ds.PgEdgeVersion) bool {
+			return v.PostgresVersion.HasPrefix(o.PostgresVersion)
+		})
+		if !supported {
+			return false
+		}
+	}
+	return true
+}
+
+type HostList struct {
+	Hosts []*Host
+	// NextHostID is set to the last host ID in Hosts when there are more
+	// results. It can be passed as AfterHostID to fetch the next page.
+	NextHostID string
+}
+
+// ListHosts returns hosts that match the given options, ordered by host ID.
+func (s *Service) ListHosts(ctx context.Context, options HostListOptions) (*HostList, error) {
+	storedHosts, err := s.store.Host.
+		GetAfter(options.AfterHostID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch hosts from storage: %w", err)
+	}
+	if len(storedHosts) == 0 {
+		return &HostList{}, nil
+	}
+
+	hostIDs := make([]string, len(storedHosts))
+	for idx, h := range storedHosts {
+		hostIDs[idx] = h.ID
+	}
+	storedStatuses, err := s.store.HostStatus.
+		GetByKeys(hostIDs...).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch host statuses from storage: %w", err)
+	}
+	statusMap := make(map[string]*StoredHostStatus, len(storedStatuses))
+	for _, status := range storedStatuses {
+		statusMap[status.HostID] = status
+	}
+
+	list := &HostList{}
+	for _, stored := range storedHosts {
+		status, ok := statusMap[stored.ID]
+		if !ok {
+			status = &StoredHostStatus{
+				HostID: stored.ID,
+				State:  HostStateUnknown,
+			}
+		}
+		h, err := fromStorage(stored, status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal host: %w", err)
+		}
+		if !options.matches(h) {
+			continue
+		}
+		if options.Limit > 0 && len(list.Hosts) == options.Limit {
+			list.NextHostID = list.Hosts[len(list.Hosts)-1].ID
+			break
+		}
+		list.Hosts = append(list.Hosts, h)
+	}
+
+	return list, nil
+}
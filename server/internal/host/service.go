@@ -10,6 +10,7 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/etcd"
 	"github.com/pgEdge/control-plane/server/internal/healthcheck"
 	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
 )
 
 type Orchestrator interface {
@@ -84,6 +85,11 @@ func (s *Service) UpdateHostStatus(ctx context.Context) error {
 			"etcd": s.etcd.HealthCheck(),
 		},
 	}
+	// The version is published so that other hosts can tell when a rolling
+	// upgrade has finished. It's omitted if the build info is unavailable.
+	if info, err := version.GetInfo(); err == nil {
+		status.VersionInfo = info
+	}
 	if err := s.orchestrator.PopulateHostStatus(ctx, status); err != nil {
 		return fmt.Errorf("failed to populate orchestrator info: %w", err)
 	}
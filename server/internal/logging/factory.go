@@ -17,15 +17,20 @@ func (c Component) String() string {
 const (
 	ComponentAPIServer         Component = "api_server"
 	ComponentDatabaseService   Component = "database_service"
+	ComponentDriftDetection    Component = "drift_detection"
 	ComponentElectionCandidate Component = "election_candidate"
 	ComponentEmbeddedEtcd      Component = "embedded_etcd"
 	ComponentManifestLoader    Component = "manifest_loader"
+	ComponentMetadataBackup    Component = "metadata_backup"
 	ComponentMigration         Component = "migration"
 	ComponentMigrationRunner   Component = "migration_runner"
 	ComponentPortsService      Component = "ports_service"
 	ComponentRemoteEtcd        Component = "remote_etcd"
+	ComponentReplacement       Component = "replacement"
+	ComponentRetention         Component = "retention"
 	ComponentSchedulerService  Component = "scheduler_service"
 	ComponentWorkflowsBackend  Component = "workflows_backend"
+	ComponentWebhooks          Component = "webhooks"
 	ComponentWorkflowsWorker   Component = "workflows_worker"
 )
 
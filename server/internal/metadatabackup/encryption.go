@@ -0,0 +1,101 @@
+package metadatabackup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// encryptedMagic prefixes encrypted snapshots so that they can be told apart
+// from unencrypted snapshots when they're restored. It's also authenticated as
+// additional data.
+var encryptedMagic = []byte("PGEDGEMB1")
+
+const encryptionKeySize = 32
+
+var ErrEncryptionKeyRequired = errors.New("snapshot is encrypted and requires an encryption key")
+
+// LoadEncryptionKey reads a base64-encoded, 32-byte key from the given file.
+// Such a key can be generated with `openssl rand -base64 32`.
+func LoadEncryptionKey(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key: %w", err)
+	}
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got %d", encryptionKeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// IsEncrypted returns true if the given data was produced by Encrypt.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedMagic)
+}
+
+// Encrypt encrypts an encoded snapshot with AES-256-GCM.
+func Encrypt(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(encryptedMagic)+len(nonce)+len(data)+gcm.Overhead())
+	out = append(out, encryptedMagic...)
+	out = append(out, nonce...)
+
+	return gcm.Seal(out, nonce, data, encryptedMagic), nil
+}
+
+// Decrypt reverses Encrypt.
+func Decrypt(key, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, errors.New("snapshot is not encrypted")
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	data = data[len(encryptedMagic):]
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted snapshot is truncated")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, encryptedMagic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt snapshot: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got %d", encryptionKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
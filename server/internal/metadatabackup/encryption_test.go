@@ -0,0 +1,58 @@
+package metadatabackup
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, encryptionKeySize)
+	data := []byte("snapshot")
+
+	encrypted, err := Encrypt(key, data)
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.False(t, IsEncrypted(data))
+	assert.NotContains(t, string(encrypted), "snapshot")
+
+	decrypted, err := Decrypt(key, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, data, decrypted)
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := Decrypt(bytes.Repeat([]byte{2}, encryptionKeySize), encrypted)
+		assert.ErrorContains(t, err, "failed to decrypt snapshot")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := Decrypt(key, encrypted[:len(encryptedMagic)+4])
+		assert.ErrorContains(t, err, "truncated")
+	})
+
+	t.Run("invalid key size", func(t *testing.T) {
+		_, err := Encrypt(key[:16], data)
+		assert.ErrorContains(t, err, "must be 32 bytes")
+	})
+}
+
+func TestLoadEncryptionKey(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{1}, encryptionKeySize)
+
+	valid := filepath.Join(dir, "valid")
+	require.NoError(t, os.WriteFile(valid, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	loaded, err := LoadEncryptionKey(valid)
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	short := filepath.Join(dir, "short")
+	require.NoError(t, os.WriteFile(short, []byte(base64.StdEncoding.EncodeToString(key[:16])), 0o600))
+	_, err = LoadEncryptionKey(short)
+	assert.ErrorContains(t, err, "must be 32 bytes")
+}
@@ -0,0 +1,77 @@
+package metadatabackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var _ Repository = (*PosixRepository)(nil)
+
+// PosixRepository stores snapshots as files in a local directory. The
+// directory should be on storage that's shared between server-mode hosts so
+// that snapshots remain available if the host that took them is lost.
+type PosixRepository struct {
+	basePath string
+}
+
+func NewPosixRepository(basePath string) *PosixRepository {
+	return &PosixRepository{basePath: basePath}
+}
+
+func (r *PosixRepository) Put(_ context.Context, name string, data []byte) error {
+	if err := os.MkdirAll(r.basePath, 0o700); err != nil {
+		return fmt.Errorf("failed to create repository directory: %w", err)
+	}
+	// Write to a temporary file first so that a partial snapshot is never
+	// visible under its final name.
+	path := filepath.Join(r.basePath, name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to rename snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PosixRepository) Get(_ context.Context, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(r.basePath, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	return data, nil
+}
+
+func (r *PosixRepository) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(r.basePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read repository directory: %w", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (r *PosixRepository) Delete(_ context.Context, name string) error {
+	err := os.Remove(filepath.Join(r.basePath, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	return nil
+}
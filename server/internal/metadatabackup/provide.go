@@ -0,0 +1,48 @@
+package metadatabackup
+
+import (
+	"time"
+
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+)
+
+const electionName election.Name = "metadata-backup"
+const electionTTL time.Duration = 30 * time.Second
+
+func Provide(i *do.Injector) {
+	provideService(i)
+}
+
+func provideService(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		client, err := do.Invoke[*clientv3.Client](i)
+		if err != nil {
+			return nil, err
+		}
+		electionSvc, err := do.Invoke[*election.Service](i)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := electionSvc.NewCandidate(electionName, cfg.HostID, electionTTL)
+		return NewService(
+			cfg,
+			loggerFactory.Logger(logging.ComponentMetadataBackup),
+			client,
+			candidate,
+		), nil
+	})
+}
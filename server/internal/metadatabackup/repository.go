@@ -0,0 +1,103 @@
+package metadatabackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+)
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+const (
+	snapshotNamePrefix = "metadata-"
+	snapshotNameSuffix = ".json.gz"
+	// The timestamp format sorts lexically in chronological order.
+	snapshotTimeFormat = "20060102T150405Z"
+)
+
+// Repository stores encoded snapshots by name.
+type Repository interface {
+	Put(ctx context.Context, name string, data []byte) error
+	Get(ctx context.Context, name string) ([]byte, error)
+	// List returns the names of every object in the repository. The names
+	// are not necessarily snapshot names.
+	List(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, name string) error
+}
+
+func NewRepository(ctx context.Context, cfg config.MetadataBackupRepository) (Repository, error) {
+	switch cfg.Type {
+	case config.MetadataBackupRepositoryTypePosix:
+		return NewPosixRepository(cfg.BasePath), nil
+	case config.MetadataBackupRepositoryTypeS3:
+		repo, err := NewS3Repository(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return repo, nil
+	default:
+		return nil, fmt.Errorf("unsupported repository type %q", cfg.Type)
+	}
+}
+
+// SnapshotName returns the name for a snapshot that was created at the given
+// time.
+func SnapshotName(createdAt time.Time) string {
+	return snapshotNamePrefix + createdAt.UTC().Format(snapshotTimeFormat) + snapshotNameSuffix
+}
+
+func isSnapshotName(name string) bool {
+	if !strings.HasPrefix(name, snapshotNamePrefix) || !strings.HasSuffix(name, snapshotNameSuffix) {
+		return false
+	}
+	timestamp := strings.TrimSuffix(strings.TrimPrefix(name, snapshotNamePrefix), snapshotNameSuffix)
+	_, err := time.Parse(snapshotTimeFormat, timestamp)
+
+	return err == nil
+}
+
+// ListSnapshots returns the names of the snapshots in the repository from
+// oldest to newest.
+func ListSnapshots(ctx context.Context, repo Repository) ([]string, error) {
+	names, err := repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	var snapshots []string
+	for _, name := range names {
+		if isSnapshotName(name) {
+			snapshots = append(snapshots, name)
+		}
+	}
+	slices.Sort(snapshots)
+
+	return snapshots, nil
+}
+
+// LatestSnapshot returns the name of the newest snapshot in the repository.
+func LatestSnapshot(ctx context.Context, repo Repository) (string, error) {
+	snapshots, err := ListSnapshots(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+	if len(snapshots) == 0 {
+		return "", ErrSnapshotNotFound
+	}
+
+	return snapshots[len(snapshots)-1], nil
+}
+
+// expiredSnapshots returns the snapshots that exceed the retention count,
+// given a list of snapshot names from oldest to newest.
+func expiredSnapshots(snapshots []string, retention int) []string {
+	if retention < 1 || len(snapshots) <= retention {
+		return nil
+	}
+
+	return snapshots[:len(snapshots)-retention]
+}
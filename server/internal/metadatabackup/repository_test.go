@@ -0,0 +1,68 @@
+package metadatabackup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotName(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 1, 2, 3, 0, time.FixedZone("EST", -5*60*60))
+
+	name := SnapshotName(createdAt)
+	assert.Equal(t, "metadata-20261018T060203Z.json.gz", name)
+	assert.True(t, isSnapshotName(name))
+	assert.False(t, isSnapshotName("metadata-latest.json.gz"))
+	assert.False(t, isSnapshotName("metadata-20261018T060203Z.json.gz.tmp"))
+}
+
+func TestPosixRepository(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "metadata")
+	repo := NewPosixRepository(dir)
+
+	latest, err := LatestSnapshot(ctx, repo)
+	assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	assert.Empty(t, latest)
+
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	var names []string
+	for i := range 3 {
+		name := SnapshotName(start.Add(time.Duration(i) * time.Hour))
+		names = append(names, name)
+		require.NoError(t, repo.Put(ctx, name, []byte(name)))
+	}
+	// Unrelated files are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600))
+
+	snapshots, err := ListSnapshots(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, names, snapshots)
+
+	latest, err = LatestSnapshot(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, names[2], latest)
+
+	data, err := repo.Get(ctx, names[1])
+	require.NoError(t, err)
+	assert.Equal(t, []byte(names[1]), data)
+
+	require.NoError(t, repo.Delete(ctx, names[0]))
+	require.NoError(t, repo.Delete(ctx, names[0]))
+	_, err = repo.Get(ctx, names[0])
+	assert.ErrorIs(t, err, ErrSnapshotNotFound)
+}
+
+func TestExpiredSnapshots(t *testing.T) {
+	snapshots := []string{"a", "b", "c", "d"}
+
+	assert.Equal(t, []string{"a", "b"}, expiredSnapshots(snapshots, 2))
+	assert.Empty(t, expiredSnapshots(snapshots, 4))
+	assert.Empty(t, expiredSnapshots(snapshots, 10))
+	assert.Empty(t, expiredSnapshots(snapshots, 0))
+}
@@ -0,0 +1,203 @@
+package metadatabackup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/certificates"
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/orchestrator/common"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+)
+
+type RestoreResult struct {
+	// MovedPaths are the previous etcd data directory, certificates, and
+	// generated config, which are kept under new names.
+	MovedPaths []string
+	// KeysRestored is the number of keys that were restored from the
+	// snapshot.
+	KeysRestored int
+	// InstanceUsersRestored is the number of etcd users that were recreated
+	// for this host's database instances.
+	InstanceUsersRestored int
+	// OtherHosts are the other hosts in the restored cluster. They are no
+	// longer members of the etcd cluster and need to be removed or rejoined.
+	OtherHosts []string
+}
+
+// Restore rebuilds this host's embedded etcd as a new single-member cluster
+// from a snapshot. The existing etcd data directory, certificates, and
+// generated config are moved aside rather than deleted. The restored cluster
+// keeps the snapshot's certificate authority so that the certificates used by
+// existing database instances remain valid, but this host gets new etcd
+// credentials. Since etcd users are not part of the snapshot, the etcd users
+// for the database instances on this host are recreated with the passwords
+// that are recorded in their resource state. Restore doesn't reconcile the
+// restored metadata with the running containers and services, so workloads
+// that were created after the snapshot must be cleaned up manually.
+//
+// The control plane must be stopped on this host before calling Restore.
+func Restore(
+	ctx context.Context,
+	cfg *config.Manager,
+	loggerFactory *logging.Factory,
+	snapshot *Snapshot,
+) (*RestoreResult, error) {
+	appCfg := cfg.Config()
+	if snapshot.KeyRoot != appCfg.EtcdKeyRoot {
+		return nil, fmt.Errorf("snapshot key root %q does not match the configured etcd_key_root %q", snapshot.KeyRoot, appCfg.EtcdKeyRoot)
+	}
+
+	moved, err := moveAside(appCfg.DataDir, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	result := &RestoreResult{
+		MovedPaths:   moved,
+		KeysRestored: len(snapshot.KVs),
+	}
+
+	// Reload the config without the previous generated config so that the
+	// previous etcd credentials and mode are discarded.
+	if err := cfg.Load(); err != nil {
+		return result, fmt.Errorf("failed to reload config: %w", err)
+	}
+	appCfg = cfg.Config()
+	if appCfg.EtcdMode != config.EtcdModeServer {
+		return result, fmt.Errorf("metadata can only be restored on a host with etcd_mode %q", config.EtcdModeServer)
+	}
+
+	embedded := etcd.NewEmbeddedEtcd(cfg, loggerFactory)
+	defer embedded.Shutdown()
+
+	if err := embedded.Restore(ctx, snapshot.Restore); err != nil {
+		return result, fmt.Errorf("failed to restore etcd: %w", err)
+	}
+	client, err := embedded.GetClient()
+	if err != nil {
+		return result, fmt.Errorf("failed to get etcd client: %w", err)
+	}
+
+	others, err := updateHosts(ctx, client, appCfg)
+	if err != nil {
+		return result, err
+	}
+	result.OtherHosts = others
+
+	restored, err := restoreInstanceUsers(ctx, client, appCfg)
+	result.InstanceUsersRestored = restored
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// moveAside renames the etcd data directory, certificates directory, and
+// generated config with a timestamp suffix.
+func moveAside(dataDir string, now time.Time) ([]string, error) {
+	suffix := fmt.Sprintf(".backup.%d", now.Unix())
+
+	var moved []string
+	for _, name := range []string{"etcd", "certificates", "generated.config.json"} {
+		path := filepath.Join(dataDir, name)
+		_, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return moved, fmt.Errorf("failed to check %q: %w", path, err)
+		}
+		if err := os.Rename(path, path+suffix); err != nil {
+			return moved, fmt.Errorf("failed to move %q: %w", path, err)
+		}
+		moved = append(moved, path+suffix)
+	}
+
+	return moved, nil
+}
+
+// updateHosts updates this host's record to reflect that it's the only etcd
+// server, and returns the IDs of every other host.
+func updateHosts(ctx context.Context, client *clientv3.Client, cfg config.Config) ([]string, error) {
+	store := host.NewHostStore(client, cfg.EtcdKeyRoot)
+	hosts, err := store.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hosts: %w", err)
+	}
+
+	var others []string
+	for _, h := range hosts {
+		if h.ID != cfg.HostID {
+			others = append(others, h.ID)
+			continue
+		}
+		h.EtcdMode = config.EtcdModeServer
+		h.PeerAddresses = cfg.PeerAddresses
+		h.ClientAddresses = cfg.ClientAddresses
+		if err := store.Put(h).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update host %q: %w", h.ID, err)
+		}
+	}
+	slices.Sort(others)
+
+	return others, nil
+}
+
+// restoreInstanceUsers recreates the etcd users for the database instances on
+// this host so that their Patroni processes can reconnect with their existing
+// credentials.
+func restoreInstanceUsers(ctx context.Context, client *clientv3.Client, cfg config.Config) (int, error) {
+	certSvc := certificates.NewService(certificates.NewStore(client, cfg.EtcdKeyRoot))
+	if err := certSvc.Start(ctx); err != nil {
+		return 0, fmt.Errorf("failed to start certificate service: %w", err)
+	}
+
+	states, err := resource.NewStateStore(client, cfg.EtcdKeyRoot).GetAll().Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get resource states: %w", err)
+	}
+
+	var restored int
+	var errs []error
+	for _, stored := range states {
+		if stored.State == nil {
+			continue
+		}
+		for _, data := range stored.State.GetAll(common.ResourceTypeEtcdCreds) {
+			var creds common.EtcdCreds
+			if err := json.Unmarshal(data.Attributes, &creds); err != nil {
+				errs = append(errs, fmt.Errorf("failed to unmarshal etcd credentials for instance %q: %w", data.Identifier.ID, err))
+				continue
+			}
+			// Instances on the external Patroni DCS don't have users in
+			// the control plane's etcd.
+			if creds.HostID != cfg.HostID || creds.ExternalDCS {
+				continue
+			}
+			_, err := etcd.CreateInstanceEtcdUser(ctx, client, certSvc, etcd.InstanceUserOptions{
+				InstanceID: creds.InstanceID,
+				KeyPrefix:  patroni.ClusterPrefix(creds.DatabaseID, creds.NodeName),
+				Password:   creds.Password,
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore etcd user for instance %q: %w", creds.InstanceID, err))
+				continue
+			}
+			restored++
+		}
+	}
+
+	return restored, errors.Join(errs...)
+}
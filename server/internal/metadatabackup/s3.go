@@ -0,0 +1,171 @@
+package metadatabackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+)
+
+var _ Repository = (*S3Repository)(nil)
+
+// S3Repository stores snapshots in an S3 or S3-compatible bucket. When the
+// repository doesn't have a static key, credentials are loaded from the AWS
+// SDK's default chain, which supports environment variables, shared config
+// files, web identity tokens (IRSA), and instance roles.
+type S3Repository struct {
+	cfg    config.MetadataBackupRepository
+	client *s3.Client
+}
+
+func NewS3Repository(ctx context.Context, cfg config.MetadataBackupRepository) (*S3Repository, error) {
+	opts := []func(*awsconfig.LoadOptions) error{
+		awsconfig.WithRegion(cfg.S3Region),
+		// Some S3-compatible stores reject the checksums that the SDK adds
+		// to every request by default.
+		awsconfig.WithRequestChecksumCalculation(aws.RequestChecksumCalculationWhenRequired),
+		awsconfig.WithResponseChecksumValidation(aws.ResponseChecksumValidationWhenRequired),
+	}
+	if cfg.S3Key != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.S3Key, cfg.S3KeySecret, cfg.S3SessionToken),
+		))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		o.UsePathStyle = cfg.S3URIStyle == "path"
+		if cfg.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(s3EndpointURL(cfg.S3Endpoint))
+		}
+	})
+
+	return &S3Repository{
+		cfg:    cfg,
+		client: client,
+	}, nil
+}
+
+func (r *S3Repository) Put(ctx context.Context, name string, data []byte) error {
+	_, err := r.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(r.cfg.S3Bucket),
+		Key:           aws.String(r.objectKey(name)),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
+func (r *S3Repository) Get(ctx context.Context, name string) ([]byte, error) {
+	out, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.cfg.S3Bucket),
+		Key:    aws.String(r.objectKey(name)),
+	})
+	if isS3NotFound(err) {
+		return nil, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	return data, nil
+}
+
+func (r *S3Repository) List(ctx context.Context) ([]string, error) {
+	prefix := r.keyPrefix()
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(r.cfg.S3Bucket)}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	var names []string
+	paginator := s3.NewListObjectsV2Paginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			name := strings.TrimPrefix(aws.ToString(obj.Key), prefix)
+			if name != "" && !strings.Contains(name, "/") {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func (r *S3Repository) Delete(ctx context.Context, name string) error {
+	_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.cfg.S3Bucket),
+		Key:    aws.String(r.objectKey(name)),
+	})
+	if err != nil && !isS3NotFound(err) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+func (r *S3Repository) keyPrefix() string {
+	base := strings.Trim(r.cfg.BasePath, "/")
+	if base == "" {
+		return ""
+	}
+	return base + "/"
+}
+
+func (r *S3Repository) objectKey(name string) string {
+	return r.keyPrefix() + name
+}
+
+// s3EndpointURL returns the endpoint as a URL. Endpoints without a scheme use
+// HTTPS.
+func s3EndpointURL(endpoint string) string {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+func isS3NotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "NoSuchKey", "NotFound":
+			return true
+		}
+	}
+	return false
+}
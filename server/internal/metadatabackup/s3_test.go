@@ -0,0 +1,162 @@
+package metadatabackup
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+)
+
+func TestS3EndpointURL(t *testing.T) {
+	assert.Equal(t, "https://s3.example.com", s3EndpointURL("s3.example.com"))
+	assert.Equal(t, "http://minio:9000", s3EndpointURL("http://minio:9000/"))
+}
+
+func TestS3Repository(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeS3(t, "backups", "key")
+	defer server.Close()
+
+	repo, err := NewS3Repository(ctx, config.MetadataBackupRepository{
+		Type:        config.MetadataBackupRepositoryTypeS3,
+		BasePath:    "/cluster-1/",
+		S3Bucket:    "backups",
+		S3Region:    "us-east-1",
+		S3Endpoint:  server.URL,
+		S3Key:       "key",
+		S3KeySecret: "secret",
+		S3URIStyle:  "path",
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	var names []string
+	for i := range 3 {
+		name := SnapshotName(start.Add(time.Duration(i) * time.Hour))
+		names = append(names, name)
+		require.NoError(t, repo.Put(ctx, name, []byte(name)))
+	}
+
+	snapshots, err := ListSnapshots(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, names, snapshots)
+
+	data, err := repo.Get(ctx, names[2])
+	require.NoError(t, err)
+	assert.Equal(t, []byte(names[2]), data)
+
+	require.NoError(t, repo.Delete(ctx, names[0]))
+	_, err = repo.Get(ctx, names[0])
+	assert.ErrorIs(t, err, ErrSnapshotNotFound)
+}
+
+func TestS3RepositoryDefaultCredentials(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeS3(t, "backups", "env-key")
+	defer server.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "env-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+	t.Setenv("AWS_SESSION_TOKEN", "env-token")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+
+	repo, err := NewS3Repository(ctx, config.MetadataBackupRepository{
+		Type:       config.MetadataBackupRepositoryTypeS3,
+		S3Bucket:   "backups",
+		S3Region:   "us-east-1",
+		S3Endpoint: server.URL,
+		S3URIStyle: "path",
+	})
+	require.NoError(t, err)
+
+	name := SnapshotName(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, repo.Put(ctx, name, []byte(name)))
+
+	snapshots, err := ListSnapshots(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, []string{name}, snapshots)
+}
+
+type fakeS3ListBucketResult struct {
+	XMLName  xml.Name `xml:"ListBucketResult"`
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated bool `xml:"IsTruncated"`
+}
+
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+// newFakeS3 starts a server that implements the subset of the S3 API that's
+// used by S3Repository for a single path-style bucket. Requests must be signed
+// with the given access key.
+func newFakeS3(t *testing.T, bucket, accessKey string) *httptest.Server {
+	t.Helper()
+
+	f := &fakeS3{objects: map[string][]byte{}}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+accessKey+"/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket)
+		key = strings.TrimPrefix(key, "/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && key == "" && r.URL.Query().Get("list-type") == "2":
+			var result fakeS3ListBucketResult
+			var keys []string
+			for k := range f.objects {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				result.Contents = append(result.Contents, struct {
+					Key string `xml:"Key"`
+				}{Key: k})
+			}
+			_ = xml.NewEncoder(w).Encode(result)
+		case r.Method == http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			f.objects[key] = data
+		case r.Method == http.MethodGet:
+			data, ok := f.objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code></Error>`)
+				return
+			}
+			_, _ = w.Write(data)
+		case r.Method == http.MethodDelete:
+			delete(f.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
@@ -0,0 +1,163 @@
+package metadatabackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/election"
+)
+
+var _ do.Shutdownable = (*Service)(nil)
+
+// Service takes scheduled snapshots of the control plane's metadata and
+// removes snapshots that exceed the retention count. Snapshots are only taken
+// while the service holds the metadata backup election so that each scheduled
+// snapshot is taken once.
+type Service struct {
+	cfg       config.MetadataBackup
+	hostID    string
+	keyRoot   string
+	logger    zerolog.Logger
+	client    *clientv3.Client
+	candidate *election.Candidate
+	scheduler *gocron.Scheduler
+	repo      Repository
+	// encryptionKey is nil when snapshots are stored unencrypted.
+	encryptionKey []byte
+}
+
+func NewService(
+	cfg config.Config,
+	logger zerolog.Logger,
+	client *clientv3.Client,
+	candidate *election.Candidate,
+) *Service {
+	return &Service{
+		cfg:       cfg.MetadataBackup,
+		hostID:    cfg.HostID,
+		keyRoot:   cfg.EtcdKeyRoot,
+		logger:    logger,
+		client:    client,
+		candidate: candidate,
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		s.logger.Debug().Msg("metadata backups are disabled")
+		return nil
+	}
+	repo, err := NewRepository(ctx, s.cfg.Repository)
+	if err != nil {
+		return err
+	}
+	s.repo = repo
+	if s.cfg.EncryptionKeyFile != "" {
+		key, err := LoadEncryptionKey(s.cfg.EncryptionKeyFile)
+		if err != nil {
+			return err
+		}
+		s.encryptionKey = key
+	} else {
+		s.logger.Warn().Msg("metadata snapshots contain secrets and will be stored unencrypted. set metadata_backup.encryption_key_file to encrypt them.")
+	}
+
+	if err := s.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	s.scheduler = gocron.NewScheduler(time.UTC)
+	_, err = s.scheduler.Cron(s.cfg.CronExpression).SingletonMode().Do(func() {
+		if !s.candidate.IsLeader() {
+			return
+		}
+		if _, err := s.Backup(ctx); err != nil {
+			s.logger.Error().Err(err).Msg("failed to back up metadata")
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule metadata backups: %w", err)
+	}
+	s.scheduler.StartAsync()
+
+	return nil
+}
+
+func (s *Service) Shutdown() error {
+	if s.scheduler == nil {
+		return nil
+	}
+	s.scheduler.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), electionTTL/3)
+	defer cancel()
+
+	if err := s.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Error() <-chan error {
+	return s.candidate.Error()
+}
+
+// Backup takes a snapshot, stores it in the repository, and then removes the
+// snapshots that exceed the retention count. It returns the new snapshot's
+// name.
+func (s *Service) Backup(ctx context.Context) (string, error) {
+	if s.repo == nil {
+		return "", errors.New("metadata backups are disabled")
+	}
+
+	snapshot, err := TakeSnapshot(ctx, s.client, s.hostID, s.keyRoot)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := snapshot.Encode(&buf); err != nil {
+		return "", err
+	}
+	data := buf.Bytes()
+	if s.encryptionKey != nil {
+		data, err = Encrypt(s.encryptionKey, data)
+		if err != nil {
+			return "", fmt.Errorf("failed to encrypt snapshot: %w", err)
+		}
+	}
+	name := SnapshotName(snapshot.CreatedAt)
+	if err := s.repo.Put(ctx, name, data); err != nil {
+		return "", fmt.Errorf("failed to store snapshot %q: %w", name, err)
+	}
+
+	s.logger.Info().
+		Str("snapshot", name).
+		Int("keys", len(snapshot.KVs)).
+		Int64("revision", snapshot.Revision).
+		Msg("stored metadata snapshot")
+
+	snapshots, err := ListSnapshots(ctx, s.repo)
+	if err != nil {
+		return name, err
+	}
+	var errs []error
+	for _, expired := range expiredSnapshots(snapshots, s.cfg.Retention) {
+		if err := s.repo.Delete(ctx, expired); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete expired snapshot %q: %w", expired, err))
+			continue
+		}
+		s.logger.Debug().Str("snapshot", expired).Msg("deleted expired metadata snapshot")
+	}
+
+	return name, errors.Join(errs...)
+}
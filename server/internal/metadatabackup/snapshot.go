@@ -0,0 +1,144 @@
+package metadatabackup
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+const snapshotVersion = 1
+
+// These limits keep each restore transaction below the embedded etcd server's
+// transaction and request size limits.
+const (
+	maxRestoreTxnOps   = 500
+	maxRestoreTxnBytes = 8 * 1024 * 1024 // 8MB
+)
+
+// Snapshot is a point-in-time copy of every key under the control plane's
+// etcd key root. Keys that are attached to leases, such as election claims,
+// are ephemeral and are left out of snapshots.
+type Snapshot struct {
+	Version   int        `json:"version"`
+	CreatedAt time.Time  `json:"created_at"`
+	HostID    string     `json:"host_id"`
+	KeyRoot   string     `json:"key_root"`
+	Revision  int64      `json:"revision"`
+	KVs       []KeyValue `json:"kvs"`
+}
+
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// TakeSnapshot reads every key under the given root at a single revision.
+func TakeSnapshot(ctx context.Context, client *clientv3.Client, hostID, root string) (*Snapshot, error) {
+	prefix := storage.Prefix("/", root)
+	resp, err := client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys under %q: %w", prefix, err)
+	}
+
+	snapshot := &Snapshot{
+		Version:   snapshotVersion,
+		CreatedAt: time.Now().UTC(),
+		HostID:    hostID,
+		KeyRoot:   root,
+		Revision:  resp.Header.Revision,
+	}
+	for _, kv := range resp.Kvs {
+		if kv.Lease != 0 {
+			continue
+		}
+		snapshot.KVs = append(snapshot.KVs, KeyValue{
+			Key:   string(kv.Key),
+			Value: kv.Value,
+		})
+	}
+
+	return snapshot, nil
+}
+
+// Encode writes the snapshot as gzip-compressed JSON.
+func (s *Snapshot) Encode(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(s); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeSnapshot reads a snapshot that was written by Encode.
+func DecodeSnapshot(r io.Reader) (*Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	var snapshot Snapshot
+	if err := json.NewDecoder(gz).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if snapshot.Version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	prefix := storage.Prefix("/", snapshot.KeyRoot)
+	for _, kv := range snapshot.KVs {
+		if !strings.HasPrefix(kv.Key, prefix) {
+			return nil, fmt.Errorf("snapshot key %q is outside of key root %q", kv.Key, snapshot.KeyRoot)
+		}
+	}
+
+	return &snapshot, nil
+}
+
+// Restore writes the snapshot's keys to the given client. It does not remove
+// any existing keys, so it should only be used on an empty cluster.
+func (s *Snapshot) Restore(ctx context.Context, client *clientv3.Client) error {
+	for _, batch := range s.batches() {
+		ops := make([]clientv3.Op, len(batch))
+		for i, kv := range batch {
+			ops[i] = clientv3.OpPut(kv.Key, string(kv.Value))
+		}
+		if _, err := client.Txn(ctx).Then(ops...).Commit(); err != nil {
+			return fmt.Errorf("failed to restore keys starting at %q: %w", batch[0].Key, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Snapshot) batches() [][]KeyValue {
+	var batches [][]KeyValue
+	var current []KeyValue
+	var size int
+	for _, kv := range s.KVs {
+		kvSize := len(kv.Key) + len(kv.Value)
+		if len(current) > 0 && (len(current) == maxRestoreTxnOps || size+kvSize > maxRestoreTxnBytes) {
+			batches = append(batches, current)
+			current = nil
+			size = 0
+		}
+		current = append(current, kv)
+		size += kvSize
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
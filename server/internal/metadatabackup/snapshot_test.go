@@ -0,0 +1,148 @@
+package metadatabackup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+)
+
+func TestTakeAndRestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+
+	root := uuid.NewString()
+	_, err := client.Put(ctx, "/"+root+"/hosts/host-1", "host-1")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/"+root+"/databases/db-1", "db-1")
+	require.NoError(t, err)
+	// Keys outside of the root and keys with leases are excluded.
+	_, err = client.Put(ctx, "/"+root+"-other/hosts/host-2", "host-2")
+	require.NoError(t, err)
+	lease, err := client.Grant(ctx, 60)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/"+root+"/elections/scheduler", "host-1", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+
+	snapshot, err := TakeSnapshot(ctx, client, "host-1", root)
+	require.NoError(t, err)
+	assert.Equal(t, []KeyValue{
+		{Key: "/" + root + "/databases/db-1", Value: []byte("db-1")},
+		{Key: "/" + root + "/hosts/host-1", Value: []byte("host-1")},
+	}, snapshot.KVs)
+
+	// Restore the snapshot under a new root to simulate an empty cluster.
+	restoreRoot := uuid.NewString()
+	for i, kv := range snapshot.KVs {
+		snapshot.KVs[i].Key = strings.Replace(kv.Key, root, restoreRoot, 1)
+	}
+	require.NoError(t, snapshot.Restore(ctx, client))
+
+	resp, err := client.Get(ctx, "/"+restoreRoot+"/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 2)
+	assert.Equal(t, "/"+restoreRoot+"/databases/db-1", string(resp.Kvs[0].Key))
+	assert.Equal(t, "db-1", string(resp.Kvs[0].Value))
+	assert.Equal(t, "/"+restoreRoot+"/hosts/host-1", string(resp.Kvs[1].Key))
+	assert.Equal(t, "host-1", string(resp.Kvs[1].Value))
+}
+
+func TestSnapshotEncodeDecode(t *testing.T) {
+	snapshot := &Snapshot{
+		Version:   snapshotVersion,
+		CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		HostID:    "host-1",
+		KeyRoot:   "control-plane",
+		Revision:  42,
+		KVs: []KeyValue{
+			{Key: "/control-plane/hosts/host-1", Value: []byte(`{"id":"host-1"}`)},
+			{Key: "/control-plane/certificates/ca", Value: []byte{0x00, 0xff}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Encode(&buf))
+
+	decoded, err := DecodeSnapshot(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, decoded)
+}
+
+func TestDecodeSnapshotErrors(t *testing.T) {
+	encode := func(t *testing.T, v any) *bytes.Buffer {
+		t.Helper()
+
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		require.NoError(t, json.NewEncoder(gz).Encode(v))
+		require.NoError(t, gz.Close())
+		return &buf
+	}
+
+	t.Run("not compressed", func(t *testing.T) {
+		_, err := DecodeSnapshot(strings.NewReader(`{"version":1}`))
+		assert.ErrorContains(t, err, "failed to decompress snapshot")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := DecodeSnapshot(encode(t, Snapshot{Version: 2}))
+		assert.ErrorContains(t, err, "unsupported snapshot version 2")
+	})
+
+	t.Run("key outside of root", func(t *testing.T) {
+		_, err := DecodeSnapshot(encode(t, Snapshot{
+			Version: snapshotVersion,
+			KeyRoot: "control-plane",
+			KVs: []KeyValue{
+				{Key: "/other/hosts/host-1"},
+			},
+		}))
+		assert.ErrorContains(t, err, `snapshot key "/other/hosts/host-1" is outside of key root "control-plane"`)
+	})
+}
+
+func TestSnapshotBatches(t *testing.T) {
+	t.Run("splits by count", func(t *testing.T) {
+		snapshot := &Snapshot{}
+		for range maxRestoreTxnOps*2 + 1 {
+			snapshot.KVs = append(snapshot.KVs, KeyValue{Key: "/k", Value: []byte("v")})
+		}
+
+		batches := snapshot.batches()
+		require.Len(t, batches, 3)
+		assert.Len(t, batches[0], maxRestoreTxnOps)
+		assert.Len(t, batches[1], maxRestoreTxnOps)
+		assert.Len(t, batches[2], 1)
+	})
+
+	t.Run("splits by size", func(t *testing.T) {
+		large := make([]byte, maxRestoreTxnBytes/2)
+		snapshot := &Snapshot{
+			KVs: []KeyValue{
+				{Key: "/a", Value: large},
+				{Key: "/b", Value: large},
+				{Key: "/c", Value: []byte("small")},
+			},
+		}
+
+		batches := snapshot.batches()
+		require.Len(t, batches, 2)
+		assert.Len(t, batches[0], 1)
+		assert.Len(t, batches[1], 2)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, (&Snapshot{}).batches())
+	})
+}
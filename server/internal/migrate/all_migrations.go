@@ -13,6 +13,7 @@ import (
 func AllMigrations() ([]Migration, error) {
 	all := []Migration{
 		&migrations.AddTaskScope{},
+		&migrations.StorePatroniDCS{},
 	}
 
 	// Validate that migration identifiers are unique.
@@ -0,0 +1,172 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
+)
+
+var ErrControlPlaneNeedsUpgrade = errors.New("control plane upgrade required: the data in etcd was written by a newer control plane version")
+
+// Compatibility maintains the stored compatibility record. Check prevents this
+// version from running against data that it can't read, and Update records
+// this version's data formats after its migrations have run so that earlier
+// versions will refuse to run against them. During rolling upgrades, Register
+// and the Wait methods keep upgraded hosts from changing the data formats until
+// every host can read them.
+type Compatibility struct {
+	hostID       string
+	store        *Store
+	hostStore    *host.Store
+	logger       zerolog.Logger
+	migrations   []Migration
+	stateVersion *ds.Version
+}
+
+func NewCompatibility(
+	hostID string,
+	store *Store,
+	hostStore *host.Store,
+	loggerFactory *logging.Factory,
+	migrations []Migration,
+	stateVersion *ds.Version,
+) *Compatibility {
+	return &Compatibility{
+		hostID:       hostID,
+		store:        store,
+		hostStore:    hostStore,
+		logger:       loggerFactory.Logger(logging.ComponentMigrationRunner),
+		migrations:   migrations,
+		stateVersion: stateVersion,
+	}
+}
+
+// Check returns ErrControlPlaneNeedsUpgrade if the data in Etcd requires a
+// newer version of the control plane.
+func (c *Compatibility) Check(ctx context.Context) error {
+	stored, err := c.get(ctx)
+	if err != nil {
+		return err
+	}
+	if stored != nil {
+		if !c.isKnownRevision(stored.Revision) {
+			return fmt.Errorf("%w: the data requires migration '%s'", ErrControlPlaneNeedsUpgrade, stored.Revision)
+		}
+		if stored.StateVersion != nil && stored.StateVersion.Compare(c.stateVersion) > 0 {
+			return fmt.Errorf("%w: the data requires resource state version '%s'", ErrControlPlaneNeedsUpgrade, stored.StateVersion)
+		}
+	}
+
+	// Versions that predate the compatibility record only store the revision.
+	rev, err := c.store.Revision.Get().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get current revision: %w", err)
+	}
+	if !c.isKnownRevision(rev.Identifier) {
+		return fmt.Errorf("%w: the data requires migration '%s'", ErrControlPlaneNeedsUpgrade, rev.Identifier)
+	}
+
+	return nil
+}
+
+// Update raises the stored compatibility record to this version's latest
+// migration and resource state version. It never lowers the record, so it's
+// safe to call from hosts that are running different versions. Only the
+// downgrade command lowers the record.
+func (c *Compatibility) Update(ctx context.Context) error {
+	var revision string
+	if len(c.migrations) > 0 {
+		revision = c.migrations[len(c.migrations)-1].Identifier()
+	}
+
+	for {
+		stored, err := c.get(ctx)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			err := c.store.Compatibility.Create(c.record(revision, c.stateVersion)).Exec(ctx)
+			if errors.Is(err, storage.ErrAlreadyExists) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to create compatibility record: %w", err)
+			}
+			return nil
+		}
+
+		raised := false
+		if c.revisionIndex(revision) > c.revisionIndex(stored.Revision) {
+			stored.Revision = revision
+			raised = true
+		}
+		if stored.StateVersion == nil || c.stateVersion.Compare(stored.StateVersion) > 0 {
+			stored.StateVersion = c.stateVersion.Clone()
+			raised = true
+		}
+		if !raised {
+			return nil
+		}
+
+		updated := c.record(stored.Revision, stored.StateVersion)
+		updated.StoredValue = stored.StoredValue
+		err = c.store.Compatibility.Update(updated).Exec(ctx)
+		if errors.Is(err, storage.ErrValueVersionMismatch) {
+			// Another host updated the record at the same time.
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to update compatibility record: %w", err)
+		}
+
+		c.logger.Info().
+			Str("revision", updated.Revision).
+			Stringer("state_version", updated.StateVersion).
+			Msg("updated compatibility record")
+
+		return nil
+	}
+}
+
+func (c *Compatibility) get(ctx context.Context) (*StoredCompatibility, error) {
+	stored, err := c.store.Compatibility.Get().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get compatibility record: %w", err)
+	}
+	return stored, nil
+}
+
+func (c *Compatibility) record(revision string, stateVersion *ds.Version) *StoredCompatibility {
+	// failure to get version info is non-fatal
+	versionInfo, _ := version.GetInfo()
+
+	return &StoredCompatibility{
+		Revision:             revision,
+		StateVersion:         stateVersion.Clone(),
+		UpdatedByHostID:      c.hostID,
+		UpdatedByVersionInfo: versionInfo,
+	}
+}
+
+func (c *Compatibility) isKnownRevision(revision string) bool {
+	return revision == "" || c.revisionIndex(revision) >= 0
+}
+
+// revisionIndex returns the position of the given revision in the migrations
+// list, or -1 if the revision is empty or unknown.
+func (c *Compatibility) revisionIndex(revision string) int {
+	return slices.IndexFunc(c.migrations, func(m Migration) bool {
+		return m.Identifier() == revision
+	})
+}
@@ -0,0 +1,54 @@
+package migrate
+
+import (
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
+)
+
+// StoredCompatibility records the newest data formats in Etcd. Control plane
+// versions that don't recognize these formats refuse to start.
+type StoredCompatibility struct {
+	storage.StoredValue
+	// Revision is the identifier of the most recent data migration that the
+	// data requires, or empty if it doesn't require any migrations.
+	Revision string `json:"revision"`
+	// StateVersion is the newest resource state version in the data.
+	StateVersion         *ds.Version   `json:"state_version"`
+	UpdatedByHostID      string        `json:"updated_by_host_id"`
+	UpdatedByVersionInfo *version.Info `json:"updated_by_version_info"`
+}
+
+type CompatibilityStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewCompatibilityStore(client *clientv3.Client, root string) *CompatibilityStore {
+	return &CompatibilityStore{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *CompatibilityStore) Key() string {
+	return storage.Key(s.root, "migrations", "compatibility")
+}
+
+func (s *CompatibilityStore) Get() storage.GetOp[*StoredCompatibility] {
+	return storage.NewGetOp[*StoredCompatibility](s.client, s.Key())
+}
+
+func (s *CompatibilityStore) Create(item *StoredCompatibility) storage.PutOp[*StoredCompatibility] {
+	return storage.NewCreateOp(s.client, s.Key(), item)
+}
+
+func (s *CompatibilityStore) Update(item *StoredCompatibility) storage.PutOp[*StoredCompatibility] {
+	return storage.NewUpdateOp(s.client, s.Key(), item)
+}
+
+func (s *CompatibilityStore) Put(item *StoredCompatibility) storage.PutOp[*StoredCompatibility] {
+	return storage.NewPutOp(s.client, s.Key(), item)
+}
@@ -0,0 +1,81 @@
+package migrate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/testutils"
+)
+
+func TestCompatibility(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	loggerFactory := testutils.LoggerFactory(t)
+	hostStore := host.NewStore(client, uuid.NewString())
+
+	m1 := &runnerMockMigration{id: "migration-1"}
+	m2 := &runnerMockMigration{id: "migration-2"}
+	v1 := ds.MustParseVersion("1.0.0")
+	v2 := ds.MustParseVersion("2.0.0")
+
+	t.Run("records the current version", func(t *testing.T) {
+		store := migrate.NewStore(client, uuid.NewString())
+		compat := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+
+		require.NoError(t, compat.Check(t.Context()))
+		require.NoError(t, compat.Update(t.Context()))
+
+		stored, err := store.Compatibility.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "migration-2", stored.Revision)
+		assert.Equal(t, v2, stored.StateVersion)
+		assert.Equal(t, "host-1", stored.UpdatedByHostID)
+	})
+
+	t.Run("older versions refuse to run", func(t *testing.T) {
+		store := migrate.NewStore(client, uuid.NewString())
+		newer := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+		require.NoError(t, newer.Update(t.Context()))
+
+		olderRevision := migrate.NewCompatibility("host-2", store, hostStore, loggerFactory, []migrate.Migration{m1}, v2)
+		err := olderRevision.Check(t.Context())
+		assert.ErrorIs(t, err, migrate.ErrControlPlaneNeedsUpgrade)
+		assert.ErrorContains(t, err, "migration-2")
+
+		olderState := migrate.NewCompatibility("host-2", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v1)
+		err = olderState.Check(t.Context())
+		assert.ErrorIs(t, err, migrate.ErrControlPlaneNeedsUpgrade)
+		assert.ErrorContains(t, err, "2.0.0")
+	})
+
+	t.Run("checks revision from versions without the record", func(t *testing.T) {
+		store := migrate.NewStore(client, uuid.NewString())
+		err := store.Revision.Create(&migrate.StoredRevision{Identifier: "migration-3"}).Exec(t.Context())
+		require.NoError(t, err)
+
+		compat := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+		assert.ErrorIs(t, compat.Check(t.Context()), migrate.ErrControlPlaneNeedsUpgrade)
+	})
+
+	t.Run("update never lowers the record", func(t *testing.T) {
+		store := migrate.NewStore(client, uuid.NewString())
+		newer := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+		require.NoError(t, newer.Update(t.Context()))
+
+		older := migrate.NewCompatibility("host-2", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v1)
+		require.NoError(t, older.Update(t.Context()))
+
+		stored, err := store.Compatibility.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "migration-2", stored.Revision)
+		assert.Equal(t, v2, stored.StateVersion)
+		assert.Equal(t, "host-1", stored.UpdatedByHostID)
+	})
+}
@@ -1,5 +1,9 @@
 // Package migrate provides a mechanism for arbitrary migration operations that
 // should block startup, such as moving Etcd objects from one key to another.
 // IMPORTANT: migrations _must_ be written to be idempotent, and we should
-// prefer non-destructive updates in order to allow rollbacks.
+// prefer non-destructive updates in order to allow rollbacks. Migrations that
+// implement ReversibleMigration can be reverted by the downgrade command.
+// During rolling upgrades, the runner waits for every host to support a
+// migration before applying it unless it implements
+// BackwardCompatibleMigration.
 package migrate
@@ -0,0 +1,225 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
+)
+
+// RevisionNone is the downgrade target revision that reverts every migration.
+const RevisionNone = "none"
+
+const downgradeLockInterval = time.Second
+
+// DowngradeTarget describes the data formats that a downgrade reverts to.
+// Resource states are never reverted because the resource state migrations
+// are one-way, so the earlier version must use the same resource state version.
+type DowngradeTarget struct {
+	// Revision is the identifier of the last data migration to keep, or
+	// RevisionNone to revert every migration.
+	Revision string
+}
+
+type DowngradeResult struct {
+	RevertedMigrations []string
+	Compatibility      *StoredCompatibility
+}
+
+// Downgrader reverts the data in Etcd to earlier formats so that the cluster
+// can be run with an earlier control plane version.
+type Downgrader struct {
+	hostID     string
+	store      *Store
+	injector   *do.Injector
+	logger     zerolog.Logger
+	migrations []Migration
+	candidate  *election.Candidate
+}
+
+func NewDowngrader(
+	hostID string,
+	store *Store,
+	injector *do.Injector,
+	loggerFactory *logging.Factory,
+	migrations []Migration,
+	candidate *election.Candidate,
+) *Downgrader {
+	return &Downgrader{
+		hostID:     hostID,
+		store:      store,
+		injector:   injector,
+		logger:     loggerFactory.Logger(logging.ComponentMigrationRunner),
+		migrations: migrations,
+		candidate:  candidate,
+	}
+}
+
+// Downgrade reverts the data to the given target. Every change is validated
+// before any data is modified. It holds the migration runner's lock while it
+// runs so that it can't race with migrations on other hosts.
+func (d *Downgrader) Downgrade(ctx context.Context, target DowngradeTarget) (*DowngradeResult, error) {
+	if target.Revision == "" {
+		return nil, errors.New("a target revision is required")
+	}
+
+	if err := d.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer d.candidate.Stop(ctx)
+
+	currentRevision, err := d.currentRevision(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reverts, err := d.plan(currentRevision, target.Revision)
+	if err != nil {
+		return nil, err
+	}
+	targetRevision := target.Revision
+	if targetRevision == RevisionNone {
+		targetRevision = ""
+	}
+
+	result := &DowngradeResult{}
+	for _, migration := range reverts {
+		identifier := migration.Identifier()
+		d.logger.Info().Str("migration", identifier).Msg("reverting migration")
+
+		if err := migration.Down(ctx, d.injector); err != nil {
+			return result, fmt.Errorf("failed to revert migration '%s': %w", identifier, err)
+		}
+		result.RevertedMigrations = append(result.RevertedMigrations, identifier)
+
+		if err := d.updateRevision(ctx, d.previousRevision(identifier)); err != nil {
+			return result, fmt.Errorf("failed to update revision: %w", err)
+		}
+	}
+
+	compat, err := d.updateCompatibility(ctx, targetRevision)
+	if err != nil {
+		return result, err
+	}
+	result.Compatibility = compat
+
+	return result, nil
+}
+
+func (d *Downgrader) lock(ctx context.Context) error {
+	if err := d.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to initialize locker: %w", err)
+	}
+	ticker := time.NewTicker(downgradeLockInterval)
+	defer ticker.Stop()
+
+	for !d.candidate.IsLeader() {
+		select {
+		case <-ctx.Done():
+			d.candidate.Stop(context.Background())
+			return fmt.Errorf("timed out waiting for the migration lock: %w", ctx.Err())
+		case err := <-d.candidate.Error():
+			d.candidate.Stop(context.Background())
+			return fmt.Errorf("failed to acquire the migration lock: %w", err)
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// plan returns the migrations that must be reverted to move from the current
+// revision to the target revision, in the order that they should be reverted.
+func (d *Downgrader) plan(currentRevision, targetRevision string) ([]ReversibleMigration, error) {
+	currentIndex := d.revisionIndex(currentRevision)
+	if currentRevision != "" && currentIndex < 0 {
+		return nil, fmt.Errorf("%w: the data requires migration '%s'", ErrControlPlaneNeedsUpgrade, currentRevision)
+	}
+	targetIndex := -1
+	if targetRevision != RevisionNone {
+		targetIndex = d.revisionIndex(targetRevision)
+		if targetIndex < 0 {
+			return nil, fmt.Errorf("unrecognized target revision '%s'", targetRevision)
+		}
+	}
+	if targetIndex > currentIndex {
+		return nil, fmt.Errorf("target revision '%s' is newer than the current revision '%s'", targetRevision, currentRevision)
+	}
+
+	var reverts []ReversibleMigration
+	for i := currentIndex; i > targetIndex; i-- {
+		reversible, ok := d.migrations[i].(ReversibleMigration)
+		if !ok {
+			return nil, fmt.Errorf("migration '%s' can't be reverted", d.migrations[i].Identifier())
+		}
+		reverts = append(reverts, reversible)
+	}
+
+	return reverts, nil
+}
+
+func (d *Downgrader) currentRevision(ctx context.Context) (string, error) {
+	rev, err := d.store.Revision.Get().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get current revision: %w", err)
+	}
+	return rev.Identifier, nil
+}
+
+func (d *Downgrader) previousRevision(identifier string) string {
+	i := d.revisionIndex(identifier)
+	if i <= 0 {
+		return ""
+	}
+	return d.migrations[i-1].Identifier()
+}
+
+func (d *Downgrader) revisionIndex(revision string) int {
+	return slices.IndexFunc(d.migrations, func(m Migration) bool {
+		return m.Identifier() == revision
+	})
+}
+
+func (d *Downgrader) updateRevision(ctx context.Context, identifier string) error {
+	rev, err := d.store.Revision.Get().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return d.store.Revision.Create(&StoredRevision{Identifier: identifier}).Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	rev.Identifier = identifier
+	return d.store.Revision.Update(rev).Exec(ctx)
+}
+
+// updateCompatibility lowers the compatibility record to the target so that
+// the earlier version will start.
+func (d *Downgrader) updateCompatibility(ctx context.Context, revision string) (*StoredCompatibility, error) {
+	stored, err := d.store.Compatibility.Get().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		stored = &StoredCompatibility{}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get compatibility record: %w", err)
+	}
+	stored.Revision = revision
+	stored.UpdatedByHostID = d.hostID
+	// failure to get version info is non-fatal
+	stored.UpdatedByVersionInfo, _ = version.GetInfo()
+
+	if err := d.store.Compatibility.Put(stored).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update compatibility record: %w", err)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,138 @@
+package migrate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/testutils"
+)
+
+func TestDowngrader(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	loggerFactory := testutils.LoggerFactory(t)
+
+	setup := func(t *testing.T, revision string) (*migrate.Store, *election.Service) {
+		t.Helper()
+
+		root := uuid.NewString()
+		store := migrate.NewStore(client, root)
+		err := store.Revision.Create(&migrate.StoredRevision{Identifier: revision}).Exec(t.Context())
+		require.NoError(t, err)
+
+		return store, election.NewService(election.NewElectionStore(client, root), loggerFactory)
+	}
+
+	t.Run("reverts migrations in reverse order", func(t *testing.T) {
+		store, electionSvc := setup(t, "migration-3")
+
+		var order []string
+		m1 := newReversibleMockMigration("migration-1", &order)
+		m2 := newReversibleMockMigration("migration-2", &order)
+		m3 := newReversibleMockMigration("migration-3", &order)
+
+		downgrader := migrate.NewDowngrader("host-1", store, do.New(), loggerFactory,
+			[]migrate.Migration{m1, m2, m3}, testCandidate(t, electionSvc, "host-1"))
+
+		result, err := downgrader.Downgrade(t.Context(), migrate.DowngradeTarget{
+			Revision: "migration-1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"down:migration-3", "down:migration-2"}, order)
+		assert.Equal(t, []string{"migration-3", "migration-2"}, result.RevertedMigrations)
+
+		rev, err := store.Revision.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "migration-1", rev.Identifier)
+
+		compat, err := store.Compatibility.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "migration-1", compat.Revision)
+	})
+
+	t.Run("reverts every migration", func(t *testing.T) {
+		store, electionSvc := setup(t, "migration-2")
+
+		var order []string
+		m1 := newReversibleMockMigration("migration-1", &order)
+		m2 := newReversibleMockMigration("migration-2", &order)
+
+		downgrader := migrate.NewDowngrader("host-1", store, do.New(), loggerFactory,
+			[]migrate.Migration{m1, m2}, testCandidate(t, electionSvc, "host-1"))
+
+		_, err := downgrader.Downgrade(t.Context(), migrate.DowngradeTarget{
+			Revision: migrate.RevisionNone,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"down:migration-2", "down:migration-1"}, order)
+
+		rev, err := store.Revision.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, rev.Identifier)
+	})
+
+	t.Run("fails before changes if a migration is irreversible", func(t *testing.T) {
+		store, electionSvc := setup(t, "migration-3")
+
+		var order []string
+		m1 := newReversibleMockMigration("migration-1", &order)
+		m2 := &runnerMockMigration{id: "migration-2"}
+		m3 := newReversibleMockMigration("migration-3", &order)
+
+		downgrader := migrate.NewDowngrader("host-1", store, do.New(), loggerFactory,
+			[]migrate.Migration{m1, m2, m3}, testCandidate(t, electionSvc, "host-1"))
+
+		_, err := downgrader.Downgrade(t.Context(), migrate.DowngradeTarget{
+			Revision: "migration-1",
+		})
+		assert.ErrorContains(t, err, "migration 'migration-2' can't be reverted")
+		assert.Empty(t, order)
+
+		rev, err := store.Revision.Get().Exec(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "migration-3", rev.Identifier)
+	})
+
+	t.Run("rejects unknown and newer targets", func(t *testing.T) {
+		store, electionSvc := setup(t, "migration-1")
+
+		var order []string
+		m1 := newReversibleMockMigration("migration-1", &order)
+		m2 := newReversibleMockMigration("migration-2", &order)
+
+		downgrader := migrate.NewDowngrader("host-1", store, do.New(), loggerFactory,
+			[]migrate.Migration{m1, m2}, testCandidate(t, electionSvc, "host-1"))
+
+		_, err := downgrader.Downgrade(t.Context(), migrate.DowngradeTarget{Revision: "migration-9"})
+		assert.ErrorContains(t, err, "unrecognized target revision 'migration-9'")
+
+		_, err = downgrader.Downgrade(t.Context(), migrate.DowngradeTarget{Revision: "migration-2"})
+		assert.ErrorContains(t, err, "newer than the current revision")
+		assert.Empty(t, order)
+	})
+}
+
+type reversibleMockMigration struct {
+	runnerMockMigration
+	order *[]string
+}
+
+func newReversibleMockMigration(id string, order *[]string) *reversibleMockMigration {
+	return &reversibleMockMigration{
+		runnerMockMigration: runnerMockMigration{id: id},
+		order:               order,
+	}
+}
+
+func (m *reversibleMockMigration) Down(_ context.Context, _ *do.Injector) error {
+	*m.order = append(*m.order, "down:"+m.id)
+	return nil
+}
@@ -0,0 +1,70 @@
+package migrate
+
+import (
+	"slices"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
+)
+
+// StoredHostCompatibility records the data formats that a host's control plane
+// version can read. Each host writes its record on startup, before it runs or
+// waits for migrations.
+type StoredHostCompatibility struct {
+	storage.StoredValue
+	HostID string `json:"host_id"`
+	// Migrations are the identifiers of every migration that the host's
+	// version knows about, in order.
+	Migrations []string `json:"migrations"`
+	// StateVersion is the newest resource state version that the host's
+	// version can read.
+	StateVersion *ds.Version   `json:"state_version"`
+	VersionInfo  *version.Info `json:"version_info"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// Supports returns true if the host's version knows about the given migration.
+func (h *StoredHostCompatibility) Supports(migration string) bool {
+	return slices.Contains(h.Migrations, migration)
+}
+
+// Revision returns the identifier of the newest migration that the host's
+// version knows about, or empty if it doesn't know about any migrations.
+func (h *StoredHostCompatibility) Revision() string {
+	if len(h.Migrations) == 0 {
+		return ""
+	}
+	return h.Migrations[len(h.Migrations)-1]
+}
+
+type HostCompatibilityStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewHostCompatibilityStore(client *clientv3.Client, root string) *HostCompatibilityStore {
+	return &HostCompatibilityStore{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *HostCompatibilityStore) Prefix() string {
+	return storage.Prefix(s.root, "migrations", "hosts")
+}
+
+func (s *HostCompatibilityStore) Key(hostID string) string {
+	return storage.Key(s.Prefix(), hostID)
+}
+
+func (s *HostCompatibilityStore) GetAll() storage.GetMultipleOp[*StoredHostCompatibility] {
+	return storage.NewGetPrefixOp[*StoredHostCompatibility](s.client, s.Prefix())
+}
+
+func (s *HostCompatibilityStore) Put(item *StoredHostCompatibility) storage.PutOp[*StoredHostCompatibility] {
+	return storage.NewPutOp(s.client, s.Key(item.HostID), item)
+}
@@ -14,3 +14,29 @@ type Migration interface {
 	// The context should be used for cancellation and timeouts.
 	Run(ctx context.Context, i *do.Injector) error
 }
+
+// ReversibleMigration is implemented by migrations that can be reverted by the
+// downgrade command. Like Run, Down must be idempotent.
+type ReversibleMigration interface {
+	Migration
+	// Down reverts the changes made by Run so that the data can be used by
+	// control plane versions that predate this migration.
+	Down(ctx context.Context, i *do.Injector) error
+}
+
+// BackwardCompatibleMigration is implemented by migrations that don't change
+// stored formats in ways that affect earlier control plane versions, such as
+// migrations that only add new keys. During a rolling upgrade, the runner waits
+// for every host to support a migration before it's applied unless the
+// migration is backward compatible.
+type BackwardCompatibleMigration interface {
+	Migration
+	// BackwardCompatible returns true if control plane versions that predate
+	// this migration can run against the migrated data.
+	BackwardCompatible() bool
+}
+
+func isBackwardCompatible(m Migration) bool {
+	bc, ok := m.(BackwardCompatibleMigration)
+	return ok && bc.BackwardCompatible()
+}
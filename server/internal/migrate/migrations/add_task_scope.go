@@ -80,6 +80,59 @@ func (a *AddTaskScope) Run(ctx context.Context, i *do.Injector) error {
 	return nil
 }
 
+// Down copies database tasks and their log entries back to the keys that were
+// used before this migration so that earlier versions can see tasks that were
+// created after the upgrade. The migrated keys are left in place.
+func (a *AddTaskScope) Down(ctx context.Context, i *do.Injector) error {
+	cfg, err := do.Invoke[config.Config](i)
+	if err != nil {
+		return fmt.Errorf("failed to initialize config: %w", err)
+	}
+	client, err := do.Invoke[*clientv3.Client](i)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	taskStore, err := do.Invoke[*task.Store](i)
+	if err != nil {
+		return fmt.Errorf("failed to initialize task store: %w", err)
+	}
+
+	tasks, err := taskStore.Task.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for tasks: %w", err)
+	}
+
+	oldTasksPrefix := storage.Prefix("/", cfg.EtcdKeyRoot, "tasks")
+	for _, stored := range tasks {
+		if stored.Task.Scope != task.ScopeDatabase {
+			// Earlier versions only had database tasks.
+			continue
+		}
+		old := newOldStoredTask(stored.Task)
+		key := storage.Key(oldTasksPrefix, old.Task.DatabaseID, old.Task.TaskID.String())
+		if err := storage.NewPutOp(client, key, old).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to revert task %s: %w", old.Task.TaskID, err)
+		}
+	}
+
+	entriesPrefix := taskStore.TaskLogMessage.EntityPrefix(task.ScopeDatabase, "")
+	entries, err := storage.NewGetPrefixOp[*task.StoredTaskLogEntry](client, entriesPrefix).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for task logs: %w", err)
+	}
+
+	oldTaskLogsPrefix := storage.Prefix("/", cfg.EtcdKeyRoot, "task_log_messages")
+	for _, entry := range entries {
+		old := newOldStoredTaskLogEntry(entry)
+		key := storage.Key(oldTaskLogsPrefix, old.DatabaseID, old.TaskID.String(), old.EntryID.String())
+		if err := storage.NewPutOp(client, key, old).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to revert task log entry %s for task %s: %w", old.EntryID, old.TaskID, err)
+		}
+	}
+
+	return nil
+}
+
 type oldStoredTask struct {
 	storage.StoredValue
 	Task struct {
@@ -121,6 +174,24 @@ func (o *oldStoredTask) convert() *task.StoredTask {
 	}
 }
 
+func newOldStoredTask(t *task.Task) *oldStoredTask {
+	old := &oldStoredTask{}
+	old.Task.ParentID = t.ParentID
+	old.Task.DatabaseID = t.EntityID
+	old.Task.NodeName = t.NodeName
+	old.Task.InstanceID = t.InstanceID
+	old.Task.HostID = t.HostID
+	old.Task.TaskID = t.TaskID
+	old.Task.CreatedAt = t.CreatedAt
+	old.Task.CompletedAt = t.CompletedAt
+	old.Task.Type = t.Type
+	old.Task.WorkflowInstanceID = t.WorkflowInstanceID
+	old.Task.WorkflowExecutionID = t.WorkflowExecutionID
+	old.Task.Status = t.Status
+	old.Task.Error = t.Error
+	return old
+}
+
 type oldStoredTaskLogEntry struct {
 	storage.StoredValue
 	DatabaseID string         `json:"database_id"`
@@ -143,3 +214,14 @@ func (o *oldStoredTaskLogEntry) convert() *task.StoredTaskLogEntry {
 		Fields:     o.Fields,
 	}
 }
+
+func newOldStoredTaskLogEntry(e *task.StoredTaskLogEntry) *oldStoredTaskLogEntry {
+	return &oldStoredTaskLogEntry{
+		DatabaseID: e.EntityID,
+		TaskID:     e.TaskID,
+		EntryID:    e.EntryID,
+		Timestamp:  e.Timestamp,
+		Message:    e.Message,
+		Fields:     e.Fields,
+	}
+}
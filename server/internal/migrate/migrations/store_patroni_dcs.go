@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+)
+
+// StorePatroniDCS stores patroni_dcs 'control_plane' on the database and
+// instance specs that were created before the patroni_dcs setting existed.
+// Those databases use the control plane's etcd, and storing the setting makes
+// sure that enabling patroni_etcd.default doesn't move them.
+type StorePatroniDCS struct{}
+
+func (s *StorePatroniDCS) Identifier() string {
+	return "store_patroni_dcs"
+}
+
+// BackwardCompatible returns true because earlier versions either ignore
+// patroni_dcs or treat 'control_plane' the same as an empty value.
+func (s *StorePatroniDCS) BackwardCompatible() bool {
+	return true
+}
+
+func (s *StorePatroniDCS) Run(ctx context.Context, i *do.Injector) error {
+	loggerFactory, err := do.Invoke[*logging.Factory](i)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+	store, err := do.Invoke[*database.Store](i)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database store: %w", err)
+	}
+
+	logger := loggerFactory.Logger(logging.ComponentMigration).With().
+		Str("identifier", s.Identifier()).
+		Logger()
+
+	specs, err := store.Spec.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for database specs: %w", err)
+	}
+	for _, spec := range specs {
+		if spec.Spec.PatroniDCS != "" {
+			continue
+		}
+		spec.Spec.PatroniDCS = database.PatroniDCSControlPlane
+		if err := store.Spec.Update(spec).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to migrate spec for database %s: %w", spec.Spec.DatabaseID, err)
+		}
+		logger.Info().
+			Str("database_id", spec.Spec.DatabaseID).
+			Msg("stored patroni_dcs for database")
+	}
+
+	instanceSpecs, err := store.InstanceSpec.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for instance specs: %w", err)
+	}
+	for _, spec := range instanceSpecs {
+		if spec.Spec.PatroniDCS != "" {
+			continue
+		}
+		spec.Spec.PatroniDCS = database.PatroniDCSControlPlane
+		if err := store.InstanceSpec.Update(spec).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to migrate spec for instance %s: %w", spec.Spec.InstanceID, err)
+		}
+	}
+
+	return nil
+}
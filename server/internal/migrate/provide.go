@@ -8,7 +8,9 @@ import (
 
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/host"
 	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/resource"
 )
 
 const ElectionName = election.Name("migration_runner")
@@ -18,6 +20,8 @@ const LockTTL time.Duration = 30 * time.Second
 func Provide(i *do.Injector) {
 	provideStore(i)
 	provideRunner(i)
+	provideCompatibility(i)
+	provideDowngrader(i)
 }
 
 func provideStore(i *do.Injector) {
@@ -52,6 +56,10 @@ func provideRunner(i *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
+		compatibility, err := do.Invoke[*Compatibility](i)
+		if err != nil {
+			return nil, err
+		}
 		migrations, err := AllMigrations()
 		if err != nil {
 			return nil, err
@@ -65,6 +73,76 @@ func provideRunner(i *do.Injector) {
 			loggerFactory,
 			migrations,
 			locker,
+			compatibility,
+		), nil
+	})
+}
+
+func provideCompatibility(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Compatibility, error) {
+		store, err := do.Invoke[*Store](i)
+		if err != nil {
+			return nil, err
+		}
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		hostStore, err := do.Invoke[*host.Store](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		migrations, err := AllMigrations()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCompatibility(
+			cfg.HostID,
+			store,
+			hostStore,
+			loggerFactory,
+			migrations,
+			resource.CurrentVersion,
+		), nil
+	})
+}
+
+func provideDowngrader(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Downgrader, error) {
+		store, err := do.Invoke[*Store](i)
+		if err != nil {
+			return nil, err
+		}
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		electionSvc, err := do.Invoke[*election.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		migrations, err := AllMigrations()
+		if err != nil {
+			return nil, err
+		}
+
+		locker := electionSvc.NewCandidate(ElectionName, cfg.HostID, LockTTL)
+		return NewDowngrader(
+			cfg.HostID,
+			store,
+			i,
+			loggerFactory,
+			migrations,
+			locker,
 		), nil
 	})
 }
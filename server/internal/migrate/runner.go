@@ -17,7 +17,9 @@ import (
 )
 
 // migrations should take on the order of seconds at a maximum, but we're going
-// to be overly cautious just in case since this can prevent startup.
+// to be overly cautious just in case since this can prevent startup. The
+// timeout applies to each migration rather than the whole run because the
+// runner can wait indefinitely for a rolling upgrade to finish.
 const migrationTimeout = 5 * time.Minute
 
 // Runner orchestrates migration execution with distributed locking.
@@ -33,9 +35,13 @@ type Runner struct {
 	doneCh      chan struct{}
 	doneOnce    sync.Once
 	versionInfo *version.Info
+
+	compatibility *Compatibility
 }
 
-// NewRunner creates a new migration runner.
+// NewRunner creates a new migration runner. When compatibility is non-nil, the
+// runner coordinates with the other hosts in the cluster so that stored
+// formats aren't changed until every host is able to read them.
 func NewRunner(
 	hostID string,
 	store *Store,
@@ -43,22 +49,34 @@ func NewRunner(
 	loggerFactory *logging.Factory,
 	migrations []Migration,
 	candidate *election.Candidate,
+	compatibility *Compatibility,
 ) *Runner {
 	return &Runner{
-		hostID:     hostID,
-		store:      store,
-		injector:   injector,
-		logger:     loggerFactory.Logger(logging.ComponentMigrationRunner),
-		migrations: migrations,
-		candidate:  candidate,
-		errCh:      make(chan error, 1),
-		doneCh:     make(chan struct{}),
+		hostID:        hostID,
+		store:         store,
+		injector:      injector,
+		logger:        loggerFactory.Logger(logging.ComponentMigrationRunner),
+		migrations:    migrations,
+		candidate:     candidate,
+		errCh:         make(chan error, 1),
+		doneCh:        make(chan struct{}),
+		compatibility: compatibility,
 	}
 }
 
 // Run executes any pending migrations if this runner wins the election,
-// otherwise waits until the current revision reaches its target.
+// otherwise waits until the current revision reaches its target. During a
+// rolling upgrade, Run blocks until every host runs a version that supports
+// this version's resource state version and pending migrations.
 func (r *Runner) Run(ctx context.Context) error {
+	if r.compatibility != nil {
+		// Resource states are migrated as they're read, so we need to wait
+		// before any of this host's components start.
+		if err := r.compatibility.WaitForStateVersion(ctx); err != nil {
+			return err
+		}
+	}
+
 	hasPendingMigrations, err := r.hasPendingMigrations(ctx)
 	if err != nil {
 		return err
@@ -71,7 +89,7 @@ func (r *Runner) Run(ctx context.Context) error {
 	versionInfo, _ := version.GetInfo()
 	r.versionInfo = versionInfo
 
-	ctx, cancel := context.WithTimeout(ctx, migrationTimeout)
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	r.watchOp = r.store.Revision.Watch()
@@ -90,8 +108,8 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 	defer r.candidate.Stop(ctx)
 
-	// Block until either the migrations complete, we timeout, or we encounter
-	// an error.
+	// Block until either the migrations complete, the context is cancelled,
+	// or we encounter an error.
 	select {
 	case <-r.doneCh:
 		return nil
@@ -149,6 +167,11 @@ func (r *Runner) runMigrations(ctx context.Context) error {
 		migration := r.migrations[i]
 		identifier := migration.Identifier()
 
+		if r.compatibility != nil {
+			if err := r.compatibility.WaitForMigration(ctx, migration); err != nil {
+				return err
+			}
+		}
 		if err := r.runMigration(ctx, migration); err != nil {
 			r.logger.Err(err).
 				Str("migration", identifier).
@@ -213,6 +236,9 @@ func (r *Runner) hasPendingMigrations(ctx context.Context) (bool, error) {
 }
 
 func (r *Runner) runMigration(ctx context.Context, migration Migration) error {
+	ctx, cancel := context.WithTimeout(ctx, migrationTimeout)
+	defer cancel()
+
 	identifier := migration.Identifier()
 	r.logger.Info().Str("migration", identifier).Msg("running migration")
 
@@ -40,7 +40,7 @@ func TestRunner(t *testing.T) {
 		}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m}, candidate, nil)
 		err := runner.Run(t.Context())
 		require.NoError(t, err)
 		assert.True(t, ran, "migration should have run")
@@ -76,7 +76,7 @@ func TestRunner(t *testing.T) {
 			defer wg.Done()
 
 			candidate := testCandidate(t, electionSvc, "host-1")
-			runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m}, candidate)
+			runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m}, candidate, nil)
 			require.NoError(t, runner.Run(t.Context()))
 		}()
 
@@ -85,7 +85,7 @@ func TestRunner(t *testing.T) {
 			defer wg.Done()
 
 			candidate := testCandidate(t, electionSvc, "host-2")
-			runner := migrate.NewRunner("host-2", store, i, loggerFactory, []migrate.Migration{m}, candidate)
+			runner := migrate.NewRunner("host-2", store, i, loggerFactory, []migrate.Migration{m}, candidate, nil)
 			require.NoError(t, runner.Run(t.Context()))
 		}()
 
@@ -131,7 +131,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate, nil)
 		err := runner.Run(t.Context())
 		require.NoError(t, err)
 
@@ -172,7 +172,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate, nil)
 		err = runner.Run(t.Context())
 		require.NoError(t, err)
 
@@ -210,7 +210,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2, m3}, candidate, nil)
 		err := runner.Run(t.Context())
 		assert.ErrorContains(t, err, "migration failed")
 
@@ -228,7 +228,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		m2 := &runnerMockMigration{id: "migration-2", err: errors.New("failed")}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate, nil)
 		err := runner.Run(t.Context())
 		assert.ErrorContains(t, err, "failed")
 
@@ -260,7 +260,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		m2 := &runnerMockMigration{id: "migration-2"}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate, nil)
 		err := runner.Run(t.Context())
 		require.NoError(t, err)
 
@@ -279,7 +279,7 @@ func TestRunnerMigrationOrdering(t *testing.T) {
 		m2 := &runnerMockMigration{id: "migration-2", err: errors.New("failed")}
 
 		candidate := testCandidate(t, electionSvc, "host-1")
-		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate)
+		runner := migrate.NewRunner("host-1", store, i, loggerFactory, []migrate.Migration{m1, m2}, candidate, nil)
 		err := runner.Run(t.Context())
 		assert.ErrorContains(t, err, "failed")
 
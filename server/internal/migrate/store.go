@@ -7,14 +7,18 @@ import (
 
 // Store wraps all migration-related stores.
 type Store struct {
-	Revision *RevisionStore
-	Result   *ResultStore
+	Revision      *RevisionStore
+	Result        *ResultStore
+	Compatibility *CompatibilityStore
+	Host          *HostCompatibilityStore
 }
 
 // NewStore creates a new composite migration store.
 func NewStore(client *clientv3.Client, root string) *Store {
 	return &Store{
-		Revision: NewRevisionStore(client, root),
-		Result:   NewResultStore(client, root),
+		Revision:      NewRevisionStore(client, root),
+		Result:        NewResultStore(client, root),
+		Compatibility: NewCompatibilityStore(client, root),
+		Host:          NewHostCompatibilityStore(client, root),
 	}
 }
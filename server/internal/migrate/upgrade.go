@@ -0,0 +1,352 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/version"
+)
+
+// hostWaitInterval is how often we check the hosts' compatibility records
+// while we wait for a rolling upgrade to finish.
+const hostWaitInterval = 5 * time.Second
+
+// hostWaitLogInterval limits how often we log the hosts that we're waiting for.
+const hostWaitLogInterval = time.Minute
+
+// Register records the data formats that this host's version can read. Other
+// hosts use this record to decide when it's safe to apply migrations.
+func (c *Compatibility) Register(ctx context.Context) error {
+	migrations := make([]string, len(c.migrations))
+	for i, m := range c.migrations {
+		migrations[i] = m.Identifier()
+	}
+	// failure to get version info is non-fatal
+	versionInfo, _ := version.GetInfo()
+
+	err := c.store.Host.Put(&StoredHostCompatibility{
+		HostID:       c.hostID,
+		Migrations:   migrations,
+		StateVersion: c.stateVersion.Clone(),
+		VersionInfo:  versionInfo,
+		UpdatedAt:    time.Now(),
+	}).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to register host compatibility: %w", err)
+	}
+
+	return nil
+}
+
+// WaitForMigration blocks until every host in the cluster runs a version that
+// supports the given migration. Backward-compatible migrations don't wait.
+func (c *Compatibility) WaitForMigration(ctx context.Context, m Migration) error {
+	if isBackwardCompatible(m) {
+		return nil
+	}
+	identifier := m.Identifier()
+	change := fmt.Sprintf("migration '%s'", identifier)
+
+	return c.waitForHosts(ctx, change, func(h *StoredHostCompatibility) bool {
+		return h.Supports(identifier)
+	})
+}
+
+// WaitForStateVersion blocks until every host in the cluster can read this
+// version's resource state version. It only waits when this version raises the
+// state version in the compatibility record. Versions that predate the record
+// didn't publish their compatibility, so we don't wait when it's missing.
+func (c *Compatibility) WaitForStateVersion(ctx context.Context) error {
+	stored, err := c.get(ctx)
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.StateVersion == nil || c.stateVersion.Compare(stored.StateVersion) <= 0 {
+		return nil
+	}
+	change := fmt.Sprintf("resource state version '%s'", c.stateVersion)
+
+	return c.waitForHosts(ctx, change, func(h *StoredHostCompatibility) bool {
+		return h.StateVersion != nil && h.StateVersion.Compare(c.stateVersion) >= 0
+	})
+}
+
+// UpgradeStatus returns the progress of the current rolling upgrade, if any.
+func (c *Compatibility) UpgradeStatus(ctx context.Context) (*UpgradeStatus, error) {
+	return GetUpgradeStatus(ctx, c.store, c.hostStore)
+}
+
+func (c *Compatibility) waitForHosts(
+	ctx context.Context,
+	change string,
+	supports func(h *StoredHostCompatibility) bool,
+) error {
+	var lastLogged time.Time
+	for {
+		waiting, err := c.unsupportedHosts(ctx, supports)
+		if err != nil {
+			return err
+		}
+		if len(waiting) == 0 {
+			return nil
+		}
+		if time.Since(lastLogged) >= hostWaitLogInterval {
+			c.logger.Info().
+				Strs("host_ids", waiting).
+				Msgf("waiting for hosts to be upgraded to a version that supports %s", change)
+			lastLogged = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while waiting for hosts to support %s: %w", change, ctx.Err())
+		case <-time.After(hostWaitInterval):
+		}
+	}
+}
+
+// unsupportedHosts returns the IDs of the hosts in the cluster that don't
+// support a change. Hosts that run versions that predate the host
+// compatibility records are never considered to support a change.
+func (c *Compatibility) unsupportedHosts(
+	ctx context.Context,
+	supports func(h *StoredHostCompatibility) bool,
+) ([]string, error) {
+	hosts, err := c.hostStore.Host.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hosts: %w", err)
+	}
+	records, err := getHostRecords(ctx, c.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsupported []string
+	for _, h := range hosts {
+		record, ok := records[h.ID]
+		if !ok || !supports(record) {
+			unsupported = append(unsupported, h.ID)
+		}
+	}
+	slices.Sort(unsupported)
+
+	return unsupported, nil
+}
+
+type UpgradeState string
+
+const (
+	UpgradeStateComplete   UpgradeState = "complete"
+	UpgradeStateInProgress UpgradeState = "in_progress"
+)
+
+// UpgradeStatus describes the progress of a rolling control plane upgrade. The
+// target of the upgrade is the newest version that's running in the cluster.
+type UpgradeStatus struct {
+	State UpgradeState
+	// Revision is the identifier of the most recent migration that's been
+	// applied to the data.
+	Revision string
+	// StateVersion is the newest resource state version in the data, or nil
+	// if it hasn't been recorded.
+	StateVersion *ds.Version
+	// PendingMigrations are the migrations that are known to the newest
+	// version in the cluster but haven't been applied.
+	PendingMigrations []string
+	Hosts             []*HostUpgradeStatus
+	// Incompatibilities describe the hosts that can't read the current data
+	// or that are holding back the upgrade.
+	Incompatibilities []string
+}
+
+type HostUpgradeStatus struct {
+	HostID      string
+	VersionInfo *version.Info
+	// Revision and StateVersion are the newest migration and resource state
+	// version that the host's version supports. They're empty for hosts that
+	// run versions that don't publish their compatibility.
+	Revision     string
+	StateVersion *ds.Version
+	// Compatible is true if the host's version can read the current data.
+	Compatible bool
+	// Upgraded is true if the host's version supports every migration and
+	// resource state version that's supported by the newest version in the
+	// cluster.
+	Upgraded bool
+}
+
+// GetUpgradeStatus computes the upgrade status from the stored compatibility
+// records. It doesn't depend on this host's version, so it can be used before
+// this host has finished starting up.
+func GetUpgradeStatus(ctx context.Context, store *Store, hostStore *host.Store) (*UpgradeStatus, error) {
+	hosts, err := hostStore.Host.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hosts: %w", err)
+	}
+	statuses, err := hostStore.HostStatus.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host statuses: %w", err)
+	}
+	records, err := getHostRecords(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision string
+	rev, err := store.Revision.Get().Exec(ctx)
+	switch {
+	case err == nil:
+		revision = rev.Identifier
+	case !errors.Is(err, storage.ErrNotFound):
+		return nil, fmt.Errorf("failed to get current revision: %w", err)
+	}
+	var stateVersion *ds.Version
+	compat, err := store.Compatibility.Get().Exec(ctx)
+	switch {
+	case err == nil:
+		stateVersion = compat.StateVersion
+	case !errors.Is(err, storage.ErrNotFound):
+		return nil, fmt.Errorf("failed to get compatibility record: %w", err)
+	}
+
+	// Migrations are only ever appended, so the newest version is the one
+	// that knows about the most migrations.
+	var targetMigrations []string
+	targetStateVersion := stateVersion
+	for _, h := range hosts {
+		record, ok := records[h.ID]
+		if !ok {
+			continue
+		}
+		if len(record.Migrations) > len(targetMigrations) {
+			targetMigrations = record.Migrations
+		}
+		if record.StateVersion != nil && (targetStateVersion == nil || record.StateVersion.Compare(targetStateVersion) > 0) {
+			targetStateVersion = record.StateVersion
+		}
+	}
+
+	status := &UpgradeStatus{
+		State:             UpgradeStateComplete,
+		Revision:          revision,
+		StateVersion:      stateVersion,
+		PendingMigrations: pendingMigrations(targetMigrations, revision),
+	}
+	if len(status.PendingMigrations) > 0 {
+		status.State = UpgradeStateInProgress
+	}
+	if targetStateVersion != nil && (stateVersion == nil || targetStateVersion.Compare(stateVersion) > 0) {
+		status.State = UpgradeStateInProgress
+	}
+
+	versionInfos := make(map[string]*version.Info, len(statuses))
+	for _, s := range statuses {
+		versionInfos[s.HostID] = s.VersionInfo
+	}
+	slices.SortFunc(hosts, func(a, b *host.StoredHost) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	for _, h := range hosts {
+		hostStatus := &HostUpgradeStatus{
+			HostID:      h.ID,
+			VersionInfo: versionInfos[h.ID],
+		}
+		status.Hosts = append(status.Hosts, hostStatus)
+
+		record, ok := records[h.ID]
+		if !ok {
+			status.State = UpgradeStateInProgress
+			status.Incompatibilities = append(status.Incompatibilities, fmt.Sprintf(
+				"host '%s' is running a version that doesn't publish its compatibility and must be upgraded",
+				h.ID,
+			))
+			continue
+		}
+		if record.VersionInfo != nil {
+			hostStatus.VersionInfo = record.VersionInfo
+		}
+		hostStatus.Revision = record.Revision()
+		hostStatus.StateVersion = record.StateVersion
+
+		hostStatus.Compatible = true
+		if revision != "" && !record.Supports(revision) {
+			hostStatus.Compatible = false
+			status.Incompatibilities = append(status.Incompatibilities, fmt.Sprintf(
+				"host '%s' can't read the current data, which requires migration '%s'",
+				h.ID, revision,
+			))
+		}
+		if !supportsStateVersion(record, stateVersion) {
+			hostStatus.Compatible = false
+			status.Incompatibilities = append(status.Incompatibilities, fmt.Sprintf(
+				"host '%s' can't read the current data, which requires resource state version '%s'",
+				h.ID, stateVersion,
+			))
+		}
+
+		hostStatus.Upgraded = true
+		if idx := slices.IndexFunc(status.PendingMigrations, func(m string) bool {
+			return !record.Supports(m)
+		}); idx >= 0 {
+			hostStatus.Upgraded = false
+			status.Incompatibilities = append(status.Incompatibilities, fmt.Sprintf(
+				"host '%s' doesn't support pending migration '%s'",
+				h.ID, status.PendingMigrations[idx],
+			))
+		} else if len(targetMigrations) > 0 && !record.Supports(targetMigrations[len(targetMigrations)-1]) {
+			hostStatus.Upgraded = false
+		}
+		if hostStatus.Compatible && !supportsStateVersion(record, targetStateVersion) {
+			hostStatus.Upgraded = false
+			status.Incompatibilities = append(status.Incompatibilities, fmt.Sprintf(
+				"host '%s' doesn't support resource state version '%s'",
+				h.ID, targetStateVersion,
+			))
+		}
+		if !hostStatus.Upgraded {
+			status.State = UpgradeStateInProgress
+		}
+	}
+
+	return status, nil
+}
+
+func getHostRecords(ctx context.Context, store *Store) (map[string]*StoredHostCompatibility, error) {
+	records, err := store.Host.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host compatibility records: %w", err)
+	}
+	byHostID := make(map[string]*StoredHostCompatibility, len(records))
+	for _, r := range records {
+		byHostID[r.HostID] = r
+	}
+	return byHostID, nil
+}
+
+// pendingMigrations returns the migrations that come after the given revision.
+// It returns nil if the revision isn't in the list, which means that the data
+// is at least as new as the list.
+func pendingMigrations(migrations []string, revision string) []string {
+	if revision == "" {
+		return slices.Clone(migrations)
+	}
+	idx := slices.Index(migrations, revision)
+	if idx < 0 {
+		return nil
+	}
+	return slices.Clone(migrations[idx+1:])
+}
+
+func supportsStateVersion(record *StoredHostCompatibility, stateVersion *ds.Version) bool {
+	if stateVersion == nil {
+		return true
+	}
+	return record.StateVersion != nil && record.StateVersion.Compare(stateVersion) >= 0
+}
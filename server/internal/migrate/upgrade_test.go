@@ -0,0 +1,202 @@
+package migrate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/migrate"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/testutils"
+)
+
+type backwardCompatibleMigration struct {
+	runnerMockMigration
+}
+
+func (m *backwardCompatibleMigration) BackwardCompatible() bool {
+	return true
+}
+
+func TestUpgradeCoordination(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	loggerFactory := testutils.LoggerFactory(t)
+
+	m1 := &runnerMockMigration{id: "migration-1"}
+	m2 := &runnerMockMigration{id: "migration-2"}
+	v1 := ds.MustParseVersion("1.0.0")
+	v2 := ds.MustParseVersion("2.0.0")
+
+	// setup creates a two-host cluster where host-1 has been upgraded and
+	// host-2 is still running the previous version.
+	setup := func(t *testing.T) (*migrate.Store, *host.Store, *migrate.Compatibility, *migrate.Compatibility) {
+		t.Helper()
+
+		root := uuid.NewString()
+		store := migrate.NewStore(client, root)
+		hostStore := host.NewStore(client, root)
+		for _, hostID := range []string{"host-1", "host-2"} {
+			require.NoError(t, hostStore.Host.Create(&host.StoredHost{ID: hostID}).Exec(t.Context()))
+		}
+
+		older := migrate.NewCompatibility("host-2", store, hostStore, loggerFactory, []migrate.Migration{m1}, v1)
+		require.NoError(t, older.Register(t.Context()))
+		require.NoError(t, store.Revision.Create(&migrate.StoredRevision{Identifier: "migration-1"}).Exec(t.Context()))
+		require.NoError(t, older.Update(t.Context()))
+
+		newer := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+		require.NoError(t, newer.Register(t.Context()))
+
+		return store, hostStore, newer, older
+	}
+
+	upgrade := func(t *testing.T, store *migrate.Store, hostStore *host.Store) {
+		t.Helper()
+
+		upgraded := migrate.NewCompatibility("host-2", store, hostStore, loggerFactory, []migrate.Migration{m1, m2}, v2)
+		require.NoError(t, upgraded.Register(t.Context()))
+	}
+
+	t.Run("waits for every host to support a migration", func(t *testing.T) {
+		store, hostStore, newer, _ := setup(t)
+
+		require.NoError(t, newer.WaitForMigration(t.Context(), m1))
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		err := newer.WaitForMigration(ctx, m2)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		bc := &backwardCompatibleMigration{runnerMockMigration{id: "migration-3"}}
+		require.NoError(t, newer.WaitForMigration(t.Context(), bc))
+
+		upgrade(t, store, hostStore)
+		require.NoError(t, newer.WaitForMigration(t.Context(), m2))
+	})
+
+	t.Run("waits for every host to support the state version", func(t *testing.T) {
+		store, hostStore, newer, _ := setup(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		err := newer.WaitForStateVersion(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		upgrade(t, store, hostStore)
+		require.NoError(t, newer.WaitForStateVersion(t.Context()))
+	})
+
+	t.Run("reports upgrade progress", func(t *testing.T) {
+		store, hostStore, newer, _ := setup(t)
+
+		status, err := newer.UpgradeStatus(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, migrate.UpgradeStateInProgress, status.State)
+		assert.Equal(t, "migration-1", status.Revision)
+		assert.Equal(t, v1, status.StateVersion)
+		assert.Equal(t, []string{"migration-2"}, status.PendingMigrations)
+		require.Len(t, status.Hosts, 2)
+		assert.Equal(t, "host-1", status.Hosts[0].HostID)
+		assert.Equal(t, "migration-2", status.Hosts[0].Revision)
+		assert.True(t, status.Hosts[0].Compatible)
+		assert.True(t, status.Hosts[0].Upgraded)
+		assert.Equal(t, "host-2", status.Hosts[1].HostID)
+		assert.Equal(t, "migration-1", status.Hosts[1].Revision)
+		assert.True(t, status.Hosts[1].Compatible)
+		assert.False(t, status.Hosts[1].Upgraded)
+		assert.Equal(t, []string{
+			"host 'host-2' doesn't support pending migration 'migration-2'",
+			"host 'host-2' doesn't support resource state version '2.0.0'",
+		}, status.Incompatibilities)
+
+		// The upgrade is still in progress until the migrations have run.
+		upgrade(t, store, hostStore)
+		status, err = newer.UpgradeStatus(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, migrate.UpgradeStateInProgress, status.State)
+		assert.Empty(t, status.Incompatibilities)
+
+		rev, err := store.Revision.Get().Exec(t.Context())
+		require.NoError(t, err)
+		rev.Identifier = "migration-2"
+		require.NoError(t, store.Revision.Update(rev).Exec(t.Context()))
+		require.NoError(t, newer.Update(t.Context()))
+
+		status, err = newer.UpgradeStatus(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, migrate.UpgradeStateComplete, status.State)
+		assert.Empty(t, status.PendingMigrations)
+		assert.Empty(t, status.Incompatibilities)
+	})
+
+	t.Run("reports hosts that can't read the data", func(t *testing.T) {
+		store, hostStore, newer, _ := setup(t)
+		upgrade(t, store, hostStore)
+
+		rev, err := store.Revision.Get().Exec(t.Context())
+		require.NoError(t, err)
+		rev.Identifier = "migration-2"
+		require.NoError(t, store.Revision.Update(rev).Exec(t.Context()))
+		require.NoError(t, newer.Update(t.Context()))
+
+		// host-2 is rolled back to the previous version.
+		require.NoError(t, store.Host.Put(&migrate.StoredHostCompatibility{
+			HostID:       "host-2",
+			Migrations:   []string{"migration-1"},
+			StateVersion: v1,
+		}).Exec(t.Context()))
+
+		status, err := newer.UpgradeStatus(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, migrate.UpgradeStateInProgress, status.State)
+		assert.False(t, status.Hosts[1].Compatible)
+		assert.Equal(t, []string{
+			"host 'host-2' can't read the current data, which requires migration 'migration-2'",
+			"host 'host-2' can't read the current data, which requires resource state version '2.0.0'",
+		}, status.Incompatibilities)
+	})
+
+	t.Run("runner waits before applying migrations", func(t *testing.T) {
+		store, hostStore, _, _ := setup(t)
+		newElectionSvc := func() *election.Service {
+			return election.NewService(election.NewElectionStore(client, uuid.NewString()), loggerFactory)
+		}
+
+		var ran bool
+		m2 := &runnerMockMigration{
+			id: "migration-2",
+			runFunc: func(_ context.Context, _ *do.Injector) error {
+				ran = true
+				return nil
+			},
+		}
+		migrations := []migrate.Migration{m1, m2}
+		// This version doesn't change the state version, so the runner only
+		// waits for the migration.
+		compat := migrate.NewCompatibility("host-1", store, hostStore, loggerFactory, migrations, v1)
+		require.NoError(t, compat.Register(t.Context()))
+
+		ctx, cancel := context.WithTimeout(t.Context(), 500*time.Millisecond)
+		defer cancel()
+		candidate := testCandidate(t, newElectionSvc(), "host-1")
+		runner := migrate.NewRunner("host-1", store, do.New(), loggerFactory, migrations, candidate, compat)
+		err := runner.Run(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, ran, "migration should not run until every host supports it")
+
+		upgrade(t, store, hostStore)
+		candidate = testCandidate(t, newElectionSvc(), "host-1")
+		runner = migrate.NewRunner("host-1", store, do.New(), loggerFactory, migrations, candidate, compat)
+		require.NoError(t, runner.Run(t.Context()))
+		assert.True(t, ran, "migration should have run")
+	})
+}
@@ -5,40 +5,60 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog"
 
 	"github.com/pgEdge/control-plane/server/internal/certificates"
 	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
 	"github.com/pgEdge/control-plane/server/internal/patroni"
 	"github.com/pgEdge/control-plane/server/internal/postgres"
 	"github.com/pgEdge/control-plane/server/internal/utils"
 )
 
 type InstanceMonitor struct {
-	statusMonitor *Monitor
-	databaseID    string
-	instanceID    string
-	dbName        string
-	dbSvc         *database.Service
-	certSvc       *certificates.Service
+	statusMonitor     *Monitor
+	logger            zerolog.Logger
+	databaseID        string
+	instanceID        string
+	dbName            string
+	diskFullThreshold float64
+	dbSvc             *database.Service
+	certSvc           *certificates.Service
+	dbOrch            database.Orchestrator
+	// resources holds the most recently collected resource usage, which is
+	// reported with every status update until the next collection.
+	resources *database.InstanceResourceUsage
+	// queryStatsCheckedAt is the last time that we checked whether to collect
+	// query stats.
+	queryStatsCheckedAt time.Time
 }
 
 func NewInstanceMonitor(
 	dbSvc *database.Service,
 	certSvc *certificates.Service,
+	dbOrch database.Orchestrator,
 	logger zerolog.Logger,
 	databaseID string,
 	instanceID string,
 	dbName string,
+	diskFullThreshold float64,
 ) *InstanceMonitor {
 	m := &InstanceMonitor{
-		databaseID: databaseID,
-		instanceID: instanceID,
-		dbName:     dbName,
-		dbSvc:      dbSvc,
-		certSvc:    certSvc,
+		logger: logger.With().
+			Str("database_id", databaseID).
+			Str("instance_id", instanceID).
+			Logger(),
+		databaseID:        databaseID,
+		instanceID:        instanceID,
+		dbName:            dbName,
+		diskFullThreshold: diskFullThreshold,
+		dbSvc:             dbSvc,
+		certSvc:           certSvc,
+		dbOrch:            dbOrch,
 	}
 	m.statusMonitor = NewMonitor(
 		logger,
@@ -59,6 +79,7 @@ func (m *InstanceMonitor) Stop() {
 func (m *InstanceMonitor) checkStatus(ctx context.Context) error {
 	status := &database.InstanceStatus{
 		StatusUpdatedAt: utils.PointerTo(time.Now()),
+		Resources:       m.resources,
 	}
 	dbState, err := m.dbSvc.GetStoredDatabaseState(ctx, m.databaseID)
 	if err != nil {
@@ -89,7 +110,11 @@ func (m *InstanceMonitor) checkStatus(ctx context.Context) error {
 	if err != nil {
 		return m.updateInstanceErrStatus(ctx, status, err)
 	}
-	err = m.populateFromDbConn(ctx, dbState, info, tlsCfg, status)
+	var resources *database.InstanceResourceUsage
+	if m.resourcesDue() {
+		resources = &database.InstanceResourceUsage{}
+	}
+	err = m.populateFromDbConn(ctx, dbState, info, tlsCfg, status, resources)
 	if err != nil {
 		return m.updateInstanceErrStatus(ctx, status, err)
 	}
@@ -97,6 +122,10 @@ func (m *InstanceMonitor) checkStatus(ctx context.Context) error {
 	if err != nil {
 		return m.updateInstanceErrStatus(ctx, status, err)
 	}
+	if resources != nil {
+		m.populateResources(ctx, currentInstance, resources)
+		status.Resources = resources
+	}
 	if currentInstance != nil && currentInstance.State != database.InstanceStateAvailable {
 		_ = m.dbSvc.UpdateInstanceState(ctx, &database.InstanceStateUpdateOptions{
 			InstanceID: m.instanceID,
@@ -121,6 +150,7 @@ func (m *InstanceMonitor) populateFromPatroni(
 	status.Role = patroniStatus.Role
 	status.PatroniPaused = patroniStatus.Pause
 	status.PendingRestart = patroniStatus.PendingRestart
+	status.PendingRestartSettings = slices.Sorted(maps.Keys(patroniStatus.PendingRestartReason))
 
 	return nil
 }
@@ -131,6 +161,7 @@ func (m *InstanceMonitor) populateFromDbConn(
 	info *database.ConnectionInfo,
 	tlsCfg *tls.Config,
 	status *database.InstanceStatus,
+	resources *database.InstanceResourceUsage,
 ) error {
 	conn, err := database.ConnectToInstance(ctx, &database.ConnectionOptions{
 		DSN: info.AdminDSN(m.dbName),
@@ -157,6 +188,20 @@ func (m *InstanceMonitor) populateFromDbConn(
 	}
 	status.SpockVersion = utils.PointerTo(spockVersion)
 
+	if resources != nil {
+		usage, err := postgres.GetResourceUsage().Scalar(ctx, conn)
+		if err != nil {
+			return fmt.Errorf("failed to query resource usage: %w", err)
+		}
+		resources.DatabaseSizeBytes = utils.PointerTo(usage.DatabaseSizeBytes)
+		resources.WALDirectoryBytes = utils.PointerTo(usage.WALDirectoryBytes)
+		resources.Connections = utils.PointerTo(usage.Connections)
+		resources.MaxConnections = utils.PointerTo(usage.MaxConnections)
+	}
+	if m.queryStatsDue() {
+		m.collectQueryStats(ctx, conn)
+	}
+
 	if status.IsPrimary() {
 		spockReadOnly, err := postgres.GetSpockReadOnly().Scalar(ctx, conn)
 		if err != nil {
@@ -180,12 +225,89 @@ func (m *InstanceMonitor) populateFromDbConn(
 	return nil
 }
 
+func (m *InstanceMonitor) resourcesDue() bool {
+	if m.resources == nil || m.resources.CollectedAt == nil {
+		return true
+	}
+	return time.Since(*m.resources.CollectedAt) >= database.InstanceResourceUsageRefreshInterval
+}
+
+func (m *InstanceMonitor) queryStatsDue() bool {
+	return time.Since(m.queryStatsCheckedAt) >= database.QueryStatsRefreshInterval
+}
+
+// collectQueryStats records a query stats sample if query stats are enabled
+// for this instance. Failing to collect query stats is not fatal because it
+// doesn't affect the instance's availability.
+func (m *InstanceMonitor) collectQueryStats(ctx context.Context, conn postgres.Executor) {
+	m.queryStatsCheckedAt = time.Now()
+
+	spec, err := m.dbSvc.GetInstanceSpec(ctx, m.databaseID, m.instanceID)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to get instance spec for query stats")
+		return
+	}
+	if !spec.QueryStats.IsEnabled() {
+		return
+	}
+
+	stats, err := postgres.GetTopQueryStats(spec.QueryStats.Limit()).Scalars(ctx, conn)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to query pg_stat_statements")
+		return
+	}
+	err = m.dbSvc.AddQueryStatsSample(ctx, m.databaseID, m.instanceID, spec.NodeName, m.queryStatsCheckedAt, stats)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to store query stats")
+	}
+}
+
+// populateResources adds the orchestrator's resource usage to the usage that
+// was collected from Postgres. Failing to collect the orchestrator's usage is
+// not fatal because it doesn't affect the instance's availability.
+func (m *InstanceMonitor) populateResources(
+	ctx context.Context,
+	instance *database.Instance,
+	resources *database.InstanceResourceUsage,
+) {
+	var pgVersion *ds.Version
+	if instance != nil && instance.PgEdgeVersion != nil {
+		pgVersion = instance.PgEdgeVersion.PostgresVersion
+	}
+	usage, err := m.dbOrch.GetInstanceResourceUsage(ctx, m.instanceID, pgVersion)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to get instance resource usage")
+	} else {
+		resources.DataDirectoryBytes = usage.DataDirectoryBytes
+		resources.VolumeFreeBytes = usage.VolumeFreeBytes
+		resources.VolumeTotalBytes = usage.VolumeTotalBytes
+		resources.CPUPercent = usage.CPUPercent
+		resources.MemoryBytes = usage.MemoryBytes
+		resources.MemoryLimitBytes = usage.MemoryLimitBytes
+	}
+	resources.DiskFull = utils.PointerTo(resources.IsDiskFull(m.diskFullThreshold))
+	resources.CollectedAt = utils.PointerTo(time.Now())
+
+	m.resources = resources
+}
+
 func (m *InstanceMonitor) updateInstanceErrStatus(
 	ctx context.Context,
 	status *database.InstanceStatus,
 	cause error,
 ) error {
 	status.Error = utils.PointerTo(cause.Error())
+	// Postgres can fail because its disk is full, so we still collect the
+	// orchestrator's resource usage when we can't reach it.
+	if m.resourcesDue() {
+		instance, err := m.dbSvc.GetInstance(ctx, m.databaseID, m.instanceID)
+		if err != nil {
+			m.logger.Warn().Err(err).Msg("failed to get instance")
+		}
+		resources := &database.InstanceResourceUsage{}
+		m.populateResources(ctx, instance, resources)
+		status.Resources = resources
+	}
 	return m.updateInstanceStatus(ctx, status)
 }
 
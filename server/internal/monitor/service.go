@@ -177,10 +177,12 @@ func (s *Service) addInstanceMonitor(databaseID, instanceID, dbName string) {
 	mon := NewInstanceMonitor(
 		s.dbSvc,
 		s.certSvc,
+		s.dbOrch,
 		s.logger,
 		databaseID,
 		instanceID,
 		dbName,
+		s.cfg.DiskFullThresholdPercent,
 	)
 	mon.Start(s.appCtx)
 	s.instances[instanceID] = mon
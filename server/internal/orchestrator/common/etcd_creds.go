@@ -42,6 +42,13 @@ type EtcdCreds struct {
 	CaCert     []byte `json:"ca_cert"`
 	ClientCert []byte `json:"client_cert"`
 	ClientKey  []byte `json:"client_key"`
+	// ExternalDCS is true if Patroni uses the external etcd cluster from the
+	// patroni_etcd configuration rather than the control plane's etcd. The
+	// credentials for the external cluster are copied from the configuration
+	// rather than created for each instance.
+	ExternalDCS bool `json:"external_dcs,omitempty"`
+	// Endpoints are the external cluster's endpoints.
+	Endpoints []string `json:"endpoints,omitempty"`
 }
 
 func (c *EtcdCreds) ResourceVersion() string {
@@ -82,29 +89,44 @@ func (c *EtcdCreds) Refresh(ctx context.Context, rc *resource.Context) error {
 		return err
 	}
 
-	parentFullPath, err := filesystem.DirResourceFullPath(rc, c.ParentID)
+	certsDir, err := c.certsDir(rc)
 	if err != nil {
-		return fmt.Errorf("failed to get parent full path: %w", err)
+		return err
 	}
-	certsDir := filepath.Join(parentFullPath, "etcd")
 
-	caCert, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdCaCertName))
-	if err != nil {
-		return fmt.Errorf("failed to read CA cert: %w", err)
+	var readCaCert, readClientCert bool
+	if c.ExternalDCS {
+		dcs, err := do.Invoke[*etcd.PatroniDCS](rc.Injector)
+		if err != nil {
+			return err
+		}
+		readCaCert = dcs.Config().CACertFile != ""
+		readClientCert = dcs.Config().ClientCertFile != ""
+	} else {
+		readCaCert = true
+		readClientCert = true
 	}
-	clientCert, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdClientCertName))
-	if err != nil {
-		return fmt.Errorf("failed to read client cert: %w", err)
+
+	if readCaCert {
+		caCert, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdCaCertName))
+		if err != nil {
+			return fmt.Errorf("failed to read CA cert: %w", err)
+		}
+		c.CaCert = caCert
 	}
-	clientKey, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdClientKeyName))
-	if err != nil {
-		return fmt.Errorf("failed to read client key: %w", err)
+	if readClientCert {
+		clientCert, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdClientCertName))
+		if err != nil {
+			return fmt.Errorf("failed to read client cert: %w", err)
+		}
+		clientKey, err := ReadResourceFile(fs, filepath.Join(certsDir, database.EtcdClientKeyName))
+		if err != nil {
+			return fmt.Errorf("failed to read client key: %w", err)
+		}
+		c.ClientCert = clientCert
+		c.ClientKey = clientKey
 	}
 
-	c.CaCert = caCert
-	c.ClientCert = clientCert
-	c.ClientKey = clientKey
-
 	return nil
 }
 
@@ -113,20 +135,58 @@ func (c *EtcdCreds) Create(ctx context.Context, rc *resource.Context) error {
 	if err != nil {
 		return err
 	}
-	certService, err := do.Invoke[*certificates.Service](rc.Injector)
+
+	certsDir, err := c.certsDir(rc)
 	if err != nil {
 		return err
 	}
-	etcdClient, err := do.Invoke[*clientv3.Client](rc.Injector)
+
+	if c.ExternalDCS {
+		err = c.copyExternalCreds(rc)
+	} else {
+		err = c.createInstanceUser(ctx, rc)
+	}
 	if err != nil {
 		return err
 	}
 
-	parentFullPath, err := filesystem.DirResourceFullPath(rc, c.ParentID)
+	if err := fs.MkdirAll(certsDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create etcd certificates directory: %w", err)
+	}
+	if err := fs.Chown(certsDir, c.OwnerUID, c.OwnerGID); err != nil {
+		return fmt.Errorf("failed to change ownership for certificates directory: %w", err)
+	}
+
+	files := map[string][]byte{
+		database.EtcdCaCertName:     c.CaCert,
+		database.EtcdClientCertName: c.ClientCert,
+		database.EtcdClientKeyName:  c.ClientKey,
+	}
+
+	for name, content := range files {
+		if content == nil {
+			continue
+		}
+		if err := afero.WriteFile(fs, filepath.Join(certsDir, name), content, 0o600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if err := fs.Chown(filepath.Join(certsDir, name), c.OwnerUID, c.OwnerGID); err != nil {
+			return fmt.Errorf("failed to change ownership for %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *EtcdCreds) createInstanceUser(ctx context.Context, rc *resource.Context) error {
+	certService, err := do.Invoke[*certificates.Service](rc.Injector)
 	if err != nil {
-		return fmt.Errorf("failed to get parent full path: %w", err)
+		return err
+	}
+	etcdClient, err := do.Invoke[*clientv3.Client](rc.Injector)
+	if err != nil {
+		return err
 	}
-	certsDir := filepath.Join(parentFullPath, "etcd")
 
 	etcdCreds, err := etcd.CreateInstanceEtcdUser(ctx,
 		etcdClient,
@@ -147,31 +207,63 @@ func (c *EtcdCreds) Create(ctx context.Context, rc *resource.Context) error {
 	c.ClientCert = etcdCreds.ClientCert
 	c.ClientKey = etcdCreds.ClientKey
 
-	if err := fs.MkdirAll(certsDir, 0o700); err != nil {
-		return fmt.Errorf("failed to create etcd certificates directory: %w", err)
+	return nil
+}
+
+func (c *EtcdCreds) copyExternalCreds(rc *resource.Context) error {
+	fs, err := do.Invoke[afero.Fs](rc.Injector)
+	if err != nil {
+		return err
 	}
-	if err := fs.Chown(certsDir, c.OwnerUID, c.OwnerGID); err != nil {
-		return fmt.Errorf("failed to change ownership for certificates directory: %w", err)
+	dcs, err := do.Invoke[*etcd.PatroniDCS](rc.Injector)
+	if err != nil {
+		return err
 	}
-
-	files := map[string][]byte{
-		database.EtcdCaCertName:     c.CaCert,
-		database.EtcdClientCertName: c.ClientCert,
-		database.EtcdClientKeyName:  c.ClientKey,
+	if !dcs.Configured() {
+		return fmt.Errorf("patroni_dcs is 'external', but %w", etcd.ErrPatroniDCSNotConfigured)
 	}
-
-	for name, content := range files {
-		if err := afero.WriteFile(fs, filepath.Join(certsDir, name), content, 0o600); err != nil {
-			return fmt.Errorf("failed to write %s: %w", name, err)
+	cfg := dcs.Config()
+
+	c.Username = cfg.Username
+	c.Password = cfg.Password
+	c.CaCert = nil
+	c.ClientCert = nil
+	c.ClientKey = nil
+
+	if cfg.CACertFile != "" {
+		c.CaCert, err = afero.ReadFile(fs, cfg.CACertFile)
+		if err != nil {
+			return fmt.Errorf("failed to read patroni_etcd CA cert: %w", err)
 		}
-		if err := fs.Chown(filepath.Join(certsDir, name), c.OwnerUID, c.OwnerGID); err != nil {
-			return fmt.Errorf("failed to change ownership for %s: %w", name, err)
+	}
+	if cfg.ClientCertFile != "" {
+		c.ClientCert, err = afero.ReadFile(fs, cfg.ClientCertFile)
+		if err != nil {
+			return fmt.Errorf("failed to read patroni_etcd client cert: %w", err)
+		}
+		c.ClientKey, err = afero.ReadFile(fs, cfg.ClientKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read patroni_etcd client key: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// certsDir returns the directory for the credentials files. The files for the
+// external cluster are kept separate so that switching DCSs doesn't disrupt a
+// running Patroni before it's restarted with its new configuration.
+func (c *EtcdCreds) certsDir(rc *resource.Context) (string, error) {
+	parentFullPath, err := filesystem.DirResourceFullPath(rc, c.ParentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get parent full path: %w", err)
+	}
+	if c.ExternalDCS {
+		return filepath.Join(parentFullPath, database.PatroniDCSCertificatesDir), nil
+	}
+	return filepath.Join(parentFullPath, database.EtcdCertificatesDir), nil
+}
+
 func (c *EtcdCreds) Update(ctx context.Context, rc *resource.Context) error {
 	return c.Create(ctx, rc)
 }
@@ -194,10 +286,13 @@ func (c *EtcdCreds) Delete(ctx context.Context, rc *resource.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get parent full path: %w", err)
 	}
-	etcdDir := filepath.Join(parentFullPath, "etcd")
 
-	if err := fs.RemoveAll(etcdDir); err != nil {
-		return fmt.Errorf("failed to remove certificates directory: %w", err)
+	// We remove the credentials for both DCSs in case this instance switched
+	// DCSs.
+	for _, dir := range []string{database.EtcdCertificatesDir, database.PatroniDCSCertificatesDir} {
+		if err := fs.RemoveAll(filepath.Join(parentFullPath, dir)); err != nil {
+			return fmt.Errorf("failed to remove certificates directory: %w", err)
+		}
 	}
 	if err := etcd.RemoveInstanceEtcdUser(ctx, etcdClient, certService, c.InstanceID); err != nil {
 		return fmt.Errorf("failed to delete etcd user: %w", err)
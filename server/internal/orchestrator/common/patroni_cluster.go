@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rs/zerolog"
 	"github.com/samber/do"
-	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/pgEdge/control-plane/server/internal/patroni"
 	"github.com/pgEdge/control-plane/server/internal/resource"
@@ -26,6 +26,9 @@ func PatroniClusterResourceIdentifier(nodeName string) resource.Identifier {
 type PatroniCluster struct {
 	DatabaseID string `json:"database_id"`
 	NodeName   string `json:"node_name"`
+	// ExternalDCS is true if the cluster uses the external etcd cluster from
+	// the patroni_etcd configuration rather than the control plane's etcd.
+	ExternalDCS bool `json:"external_dcs,omitempty"`
 }
 
 func (p *PatroniCluster) ResourceVersion() string {
@@ -60,21 +63,52 @@ func (p *PatroniCluster) Create(ctx context.Context, rc *resource.Context) error
 	return nil
 }
 
+// Update is only called when the cluster switches to a different DCS. It
+// copies the cluster's state from the previous DCS so that the instances can
+// resume from it when they're restarted with the new DCS.
 func (p *PatroniCluster) Update(ctx context.Context, rc *resource.Context) error {
+	logger, err := do.Invoke[zerolog.Logger](rc.Injector)
+	if err != nil {
+		return err
+	}
+	source, err := patroniDCSClient(rc, !p.ExternalDCS)
+	if err != nil {
+		return fmt.Errorf("failed to get client for previous patroni DCS: %w", err)
+	}
+	target, err := patroniDCSClient(rc, p.ExternalDCS)
+	if err != nil {
+		return fmt.Errorf("failed to get client for patroni DCS: %w", err)
+	}
+
+	copied, err := patroni.CopyCluster(ctx, source, target, p.DatabaseID, p.NodeName)
+	if err != nil {
+		return err
+	}
+	if !copied {
+		logger.Info().
+			Str("database_id", p.DatabaseID).
+			Str("node_name", p.NodeName).
+			Msg("patroni cluster already has a leader in the new DCS, skipping copy")
+	}
+
 	return nil
 }
 
 func (p *PatroniCluster) Delete(ctx context.Context, rc *resource.Context) error {
-	client, err := do.Invoke[*clientv3.Client](rc.Injector)
+	// The namespace can be left over in the other DCS if the cluster has
+	// switched DCSs, so we delete it from all of them.
+	clients, err := patroniDCSClients(rc)
 	if err != nil {
 		return err
 	}
 
-	_, err = storage.
-		NewDeletePrefixOp(client, patroni.ClusterPrefix(p.DatabaseID, p.NodeName)).
-		Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete patroni namespace from DCS: %w", err)
+	for _, client := range clients {
+		_, err = storage.
+			NewDeletePrefixOp(client, patroni.ClusterPrefix(p.DatabaseID, p.NodeName)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete patroni namespace from DCS: %w", err)
+		}
 	}
 
 	return nil
@@ -164,11 +164,6 @@ func (c *PatroniConfig) create(
 	if err != nil {
 		return nil, err
 	}
-	etcdClient, err := do.Invoke[*clientv3.Client](rc.Injector)
-	if err != nil {
-		return nil, err
-	}
-
 	parentFullPath, err := filesystem.DirResourceFullPath(rc, c.ParentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get parent full path: %w", err)
@@ -179,9 +174,16 @@ func (c *PatroniConfig) create(
 		return nil, fmt.Errorf("failed to get etcd creds from state: %w", err)
 	}
 
-	etcdHosts, err := patroni.EtcdHosts(ctx, etcdClient)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get etcd hosts: %w", err)
+	etcdHosts := etcdCreds.Endpoints
+	if !etcdCreds.ExternalDCS {
+		etcdClient, err := do.Invoke[*clientv3.Client](rc.Injector)
+		if err != nil {
+			return nil, err
+		}
+		etcdHosts, err = patroni.EtcdHosts(ctx, etcdClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get etcd hosts: %w", err)
+		}
 	}
 
 	enableFastBasebackup, err := c.isNewNode(rc)
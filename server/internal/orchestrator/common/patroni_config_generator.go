@@ -13,7 +13,6 @@ import (
 	"github.com/alessio/shellescape"
 	"github.com/pgEdge/control-plane/server/internal/database"
 	"github.com/pgEdge/control-plane/server/internal/patroni"
-	"github.com/pgEdge/control-plane/server/internal/postgres"
 	"github.com/pgEdge/control-plane/server/internal/postgres/hba"
 	"github.com/pgEdge/control-plane/server/internal/utils"
 )
@@ -33,6 +32,9 @@ type PatroniConfigGenerator struct {
 	DataDir string `json:"data_dir"`
 	// EtcdCertsDir is the Etcd certificates directory.
 	EtcdCertsDir string `json:"etcd_certs_dir"`
+	// ExternalDCS is true if Patroni uses the external etcd cluster from the
+	// patroni_etcd configuration rather than the control plane's etcd.
+	ExternalDCS bool `json:"external_dcs,omitempty"`
 	// FQDN is the fully-qualified domain name for this instance. This name must
 	// be reachable by sibling instances within the Spock node.
 	FQDN string `json:"fqdn"`
@@ -59,6 +61,9 @@ type PatroniConfigGenerator struct {
 	PatroniAllowlist []string `json:"patroni_allowlist"`
 	// PatroniPort is the port that Patroni will listen on.
 	PatroniPort int `json:"patroni_port"`
+	// PatroniSettings are user-specified overrides for Patroni's failover and
+	// fencing settings.
+	PatroniSettings *database.PatroniSettings `json:"patroni_settings,omitempty"`
 	// PgHbaConf are user-supplied pg_hba.conf entries (one rule per element),
 	// inserted in the user zone after the CP rules and before the catch-all.
 	PgHbaConf []string `json:"pg_hba_conf,omitempty"`
@@ -69,6 +74,9 @@ type PatroniConfigGenerator struct {
 	PostgresCertsDir string `json:"postgres_certs_dir"`
 	// PostgresPort is the port that Postgres will listen on.
 	PostgresPort int `json:"postgres_port"`
+	// QueryStats enables the pg_stat_statements settings that are used for
+	// query stats collection.
+	QueryStats bool `json:"query_stats,omitempty"`
 	// RestoreCommand is an alternate command to use to bootstrap this instance.
 	RestoreCommand string `json:"restore_command"`
 	// SpecParameters are user-specified Postgres parameters that are included
@@ -101,6 +109,9 @@ type PatroniConfigGeneratorOptions struct {
 	PostgresPort int
 	// Paths is used to compute the paths of directories and executables.
 	Paths database.InstancePaths
+	// ExternalDCS is true if Patroni uses the external etcd cluster from the
+	// patroni_etcd configuration rather than the control plane's etcd.
+	ExternalDCS bool
 }
 
 func NewPatroniConfigGenerator(opts PatroniConfigGeneratorOptions) *PatroniConfigGenerator {
@@ -128,13 +139,18 @@ func NewPatroniConfigGenerator(opts PatroniConfigGeneratorOptions) *PatroniConfi
 			restoreCommand = opts.Paths.PgBackRestRestoreCmd("restore", restoreOptions...).String()
 		}
 	}
+	etcdCertsDir := opts.Paths.Instance.EtcdCertificates()
+	if opts.ExternalDCS {
+		etcdCertsDir = opts.Paths.Instance.PatroniDCSCertificates()
+	}
 	return &PatroniConfigGenerator{
 		ArchiveCommand:         archiveCommand,
 		ClusterSize:            opts.Instance.ClusterSize,
 		CPUs:                   cpus,
 		DatabaseID:             opts.Instance.DatabaseID,
 		DataDir:                opts.Paths.Instance.PgData(),
-		EtcdCertsDir:           opts.Paths.Instance.EtcdCertificates(),
+		EtcdCertsDir:           etcdCertsDir,
+		ExternalDCS:            opts.ExternalDCS,
 		FQDN:                   opts.FQDN,
 		InstanceID:             opts.Instance.InstanceID,
 		LogType:                opts.LogType,
@@ -144,8 +160,10 @@ func NewPatroniConfigGenerator(opts PatroniConfigGeneratorOptions) *PatroniConfi
 		NodeSize:               opts.Instance.NodeSize,
 		OrchestratorParameters: opts.OrchestratorParameters,
 		PatroniPort:            opts.PatroniPort,
+		PatroniSettings:        opts.Instance.Patroni,
 		PostgresCertsDir:       opts.Paths.Instance.PostgresCertificates(),
 		PostgresPort:           opts.PostgresPort,
+		QueryStats:             opts.Instance.QueryStats.IsEnabled(),
 		RestoreCommand:         restoreCommand,
 		SpecParameters:         opts.Instance.PostgreSQLConf,
 		PgHbaConf:              opts.Instance.PgHbaConf,
@@ -184,9 +202,7 @@ func (p *PatroniConfigGenerator) Generate(
 		Bootstrap: p.bootstrap(dcsParameters),
 		Etcd3:     p.etcd(etcdHosts, etcdCreds),
 		RestAPI:   p.restAPI(opts.SystemAddresses),
-		Watchdog: &patroni.Watchdog{
-			Mode: utils.PointerTo(patroni.WatchdogModeOff),
-		},
+		Watchdog:  p.watchdog(),
 		Postgresql: p.postgreSQL(
 			opts.EnableFastBasebackup,
 			parameters,
@@ -207,9 +223,24 @@ func (p *PatroniConfigGenerator) AuthMethod() hba.AuthMethod {
 }
 
 func (p *PatroniConfigGenerator) parameters() map[string]any {
-	parameters := postgres.DefaultGUCs()
-	maps.Copy(parameters, postgres.SpockDefaultGUCs())
-	maps.Copy(parameters, postgres.DefaultTunableGUCs(p.MemoryBytes, p.CPUs, p.ClusterSize))
+	// The spec parameters have already been merged with the node's parameters,
+	// so we don't need to distinguish between them here.
+	return database.EffectivePostgreSQLConf(&database.PostgreSQLConfOptions{
+		MemoryBytes:      p.MemoryBytes,
+		CPUs:             p.CPUs,
+		ClusterSize:      p.ClusterSize,
+		NodeOrdinal:      p.NodeOrdinal,
+		QueryStats:       p.QueryStats,
+		SystemParameters: p.SystemParameters(),
+		SpecConf:         p.SpecParameters,
+	}).Parameters()
+}
+
+// SystemParameters returns the Postgres parameters that are computed from the
+// orchestrator's paths and options rather than from the instance's resources
+// or spec.
+func (p *PatroniConfigGenerator) SystemParameters() map[string]any {
+	parameters := map[string]any{}
 	maps.Copy(parameters, map[string]any{
 		"ssl":           "on",
 		"ssl_ca_file":   filepath.Join(p.PostgresCertsDir, database.PostgresCaCertName),
@@ -226,8 +257,6 @@ func (p *PatroniConfigGenerator) parameters() map[string]any {
 			"archive_command": p.ArchiveCommand,
 		})
 	}
-	maps.Copy(parameters, postgres.SnowflakeLolorGUCs(p.NodeOrdinal))
-	maps.Copy(parameters, p.SpecParameters)
 
 	return parameters
 }
@@ -241,14 +270,41 @@ func (p *PatroniConfigGenerator) bootstrap(dcsParameters map[string]any) *patron
 			IgnoreSlots: &[]patroni.IgnoreSlot{
 				{Plugin: utils.PointerTo("spock_output")},
 			},
-			TTL:          utils.PointerTo(30),
-			LoopWait:     utils.PointerTo(int(patroni.DefaultLoopWaitSeconds)),
-			RetryTimeout: utils.PointerTo(10),
+			TTL:          utils.PointerTo(patroni.DefaultTTLSeconds),
+			LoopWait:     utils.PointerTo(patroni.DefaultLoopWaitSeconds),
+			RetryTimeout: utils.PointerTo(patroni.DefaultRetryTimeoutSeconds),
 			FailsafeMode: utils.PointerTo(p.NodeSize == 1),
+			// These are set explicitly, even though they match Patroni's
+			// defaults, so that removing an override reverts it when we patch
+			// the dynamic configuration.
+			MaximumLagOnFailover: utils.PointerTo(patroni.DefaultMaximumLagOnFailover),
+			CheckTimeline:        utils.PointerTo(false),
 		},
 		InitDB: utils.PointerTo([]string{"data-checksums"}),
 	}
 
+	if s := p.PatroniSettings; s != nil {
+		dcs := bootstrap.DCS
+		if s.TTL != nil {
+			dcs.TTL = utils.ClonePointer(s.TTL)
+		}
+		if s.LoopWait != nil {
+			dcs.LoopWait = utils.ClonePointer(s.LoopWait)
+		}
+		if s.RetryTimeout != nil {
+			dcs.RetryTimeout = utils.ClonePointer(s.RetryTimeout)
+		}
+		if s.FailsafeMode != nil {
+			dcs.FailsafeMode = utils.ClonePointer(s.FailsafeMode)
+		}
+		if s.MaximumLagOnFailover != nil {
+			dcs.MaximumLagOnFailover = utils.ClonePointer(s.MaximumLagOnFailover)
+		}
+		if s.CheckTimeline != nil {
+			dcs.CheckTimeline = utils.ClonePointer(s.CheckTimeline)
+		}
+	}
+
 	if p.RestoreCommand != "" {
 		bootstrap.Method = utils.PointerTo(patroni.BootstrapMethodNameRestore)
 		bootstrap.Restore = &patroni.BootstrapMethodConf{
@@ -261,6 +317,20 @@ func (p *PatroniConfigGenerator) bootstrap(dcsParameters map[string]any) *patron
 	return bootstrap
 }
 
+func (p *PatroniConfigGenerator) watchdog() *patroni.Watchdog {
+	if p.PatroniSettings == nil || p.PatroniSettings.Watchdog == nil {
+		return &patroni.Watchdog{
+			Mode: utils.PointerTo(patroni.WatchdogModeOff),
+		}
+	}
+	w := p.PatroniSettings.Watchdog
+	return &patroni.Watchdog{
+		Mode:         utils.PointerTo(w.Mode),
+		Device:       utils.ClonePointer(w.Device),
+		SafetyMargin: utils.ClonePointer(w.SafetyMargin),
+	}
+}
+
 func (p *PatroniConfigGenerator) log() *patroni.Log {
 	fields := map[string]string{
 		"database_id": p.DatabaseID,
@@ -283,6 +353,9 @@ func (p *PatroniConfigGenerator) log() *patroni.Log {
 }
 
 func (p *PatroniConfigGenerator) etcd(hosts []string, creds *EtcdCreds) *patroni.Etcd {
+	if p.ExternalDCS {
+		return p.externalEtcd(hosts, creds)
+	}
 	return &patroni.Etcd{
 		Hosts:    &hosts,
 		CACert:   utils.PointerTo(filepath.Join(p.EtcdCertsDir, database.EtcdCaCertName)),
@@ -294,6 +367,28 @@ func (p *PatroniConfigGenerator) etcd(hosts []string, creds *EtcdCreds) *patroni
 	}
 }
 
+// externalEtcd uses the credentials from the patroni_etcd configuration, which
+// may not include certificates or a username.
+func (p *PatroniConfigGenerator) externalEtcd(hosts []string, creds *EtcdCreds) *patroni.Etcd {
+	etcd := &patroni.Etcd{
+		Hosts:    &hosts,
+		Protocol: utils.PointerTo("http"),
+	}
+	if creds.CaCert != nil {
+		etcd.CACert = utils.PointerTo(filepath.Join(p.EtcdCertsDir, database.EtcdCaCertName))
+		etcd.Protocol = utils.PointerTo("https")
+	}
+	if creds.ClientCert != nil {
+		etcd.Cert = utils.PointerTo(filepath.Join(p.EtcdCertsDir, database.EtcdClientCertName))
+		etcd.Key = utils.PointerTo(filepath.Join(p.EtcdCertsDir, database.EtcdClientKeyName))
+	}
+	if creds.Username != "" {
+		etcd.Username = &creds.Username
+		etcd.Password = &creds.Password
+	}
+	return etcd
+}
+
 func (p *PatroniConfigGenerator) restAPI(systemAddresses []string) *patroni.RestAPI {
 	combined := utils.PointerTo(slices.Concat(
 		p.PatroniAllowlist,
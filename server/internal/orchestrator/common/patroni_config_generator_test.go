@@ -12,6 +12,7 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/patroni"
 	"github.com/pgEdge/control-plane/server/internal/postgres/hba"
 	"github.com/pgEdge/control-plane/server/internal/testutils"
+	"github.com/pgEdge/control-plane/server/internal/utils"
 )
 
 func TestPatroniConfigGenerator(t *testing.T) {
@@ -414,6 +415,95 @@ func TestPatroniConfigGenerator(t *testing.T) {
 				SystemAddresses: []string{"172.17.0.1", "10.128.165.128/26"},
 			},
 		},
+		{
+			name: "with patroni settings",
+			options: common.PatroniConfigGeneratorOptions{
+				Instance: &database.InstanceSpec{
+					InstanceID:    "storefront-n1-689qacsi",
+					DatabaseID:    "storefront",
+					HostID:        "host-1",
+					DatabaseName:  "app",
+					NodeName:      "n1",
+					NodeOrdinal:   1,
+					NodeSize:      2,
+					PgEdgeVersion: ds.MustParsePgEdgeVersion("18.1", "5.0.4"),
+					ClusterSize:   3,
+					Patroni: &database.PatroniSettings{
+						MaximumLagOnFailover: utils.PointerTo(int64(0)),
+						FailsafeMode:         utils.PointerTo(true),
+						LoopWait:             utils.PointerTo(5),
+						TTL:                  utils.PointerTo(20),
+						RetryTimeout:         utils.PointerTo(5),
+						CheckTimeline:        utils.PointerTo(true),
+						Watchdog: &database.PatroniWatchdog{
+							Mode:         patroni.WatchdogModeRequired,
+							Device:       utils.PointerTo("/dev/watchdog"),
+							SafetyMargin: utils.PointerTo(-1),
+						},
+					},
+				},
+				HostCPUs:        4,
+				HostMemoryBytes: 1024 * 1024 * 1024 * 8,
+				FQDN:            "storefront-n1-689qacsi.storefront-database",
+				LogType:         patroni.LogTypeJson,
+				PatroniPort:     8888,
+				PostgresPort:    5432,
+				Paths: database.InstancePaths{
+					Instance:       database.Paths{BaseDir: "/opt/pgedge"},
+					Host:           database.Paths{BaseDir: "/data/control-plane/instances/storefront-n1-689qacsi"},
+					PgBackRestPath: "/usr/bin/pgbackrest",
+					PatroniPath:    "/usr/local/bin/patroni",
+				},
+			},
+			etcdHosts: []string{"i-0123456789abcdef.ec2.internal:2379"},
+			etcdCreds: &common.EtcdCreds{
+				Username: "instance.storefront-n1-689qacsi",
+				Password: "password",
+			},
+			generateOptions: common.GenerateOptions{
+				SystemAddresses: []string{"172.17.0.1", "10.128.165.128/26"},
+			},
+		},
+		{
+			name: "external dcs",
+			options: common.PatroniConfigGeneratorOptions{
+				Instance: &database.InstanceSpec{
+					InstanceID:    "storefront-n1-689qacsi",
+					DatabaseID:    "storefront",
+					HostID:        "host-1",
+					DatabaseName:  "app",
+					NodeName:      "n1",
+					NodeOrdinal:   1,
+					NodeSize:      1,
+					PgEdgeVersion: ds.MustParsePgEdgeVersion("18.1", "5.0.4"),
+					ClusterSize:   3,
+					PatroniDCS:    database.PatroniDCSExternal,
+				},
+				HostCPUs:        4,
+				HostMemoryBytes: 1024 * 1024 * 1024 * 8,
+				FQDN:            "storefront-n1-689qacsi.storefront-database",
+				LogType:         patroni.LogTypeJson,
+				PatroniPort:     8888,
+				PostgresPort:    5432,
+				Paths: database.InstancePaths{
+					Instance:       database.Paths{BaseDir: "/opt/pgedge"},
+					Host:           database.Paths{BaseDir: "/data/control-plane/instances/storefront-n1-689qacsi"},
+					PgBackRestPath: "/usr/bin/pgbackrest",
+					PatroniPath:    "/usr/local/bin/patroni",
+				},
+				ExternalDCS: true,
+			},
+			etcdHosts: []string{"dcs-1.internal:2379", "dcs-2.internal:2379", "dcs-3.internal:2379"},
+			etcdCreds: &common.EtcdCreds{
+				Username:    "patroni",
+				Password:    "password",
+				CaCert:      []byte("ca"),
+				ExternalDCS: true,
+			},
+			generateOptions: common.GenerateOptions{
+				SystemAddresses: []string{"172.17.0.1", "10.128.165.128/26"},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			gen := common.NewPatroniConfigGenerator(tc.options)
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+)
+
+// patroniDCSClient returns a client for the etcd cluster that Patroni uses as
+// its DCS. This is the control plane's etcd unless external is true.
+func patroniDCSClient(rc *resource.Context, external bool) (*clientv3.Client, error) {
+	if !external {
+		return do.Invoke[*clientv3.Client](rc.Injector)
+	}
+	dcs, err := do.Invoke[*etcd.PatroniDCS](rc.Injector)
+	if err != nil {
+		return nil, err
+	}
+	return dcs.Client()
+}
+
+// patroniDCSClients returns clients for every etcd cluster that Patroni can use
+// as its DCS on this host.
+func patroniDCSClients(rc *resource.Context) ([]*clientv3.Client, error) {
+	client, err := do.Invoke[*clientv3.Client](rc.Injector)
+	if err != nil {
+		return nil, err
+	}
+	dcs, err := do.Invoke[*etcd.PatroniDCS](rc.Injector)
+	if err != nil {
+		return nil, err
+	}
+	if !dcs.Configured() {
+		return []*clientv3.Client{client}, nil
+	}
+	external, err := dcs.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	return []*clientv3.Client{client, external}, nil
+}
+
+// ExternalPatroniDCS returns true if the instance's Patroni uses the external
+// etcd cluster from the patroni_etcd configuration.
+func ExternalPatroniDCS(cfg config.Config, spec *database.InstanceSpec) (bool, error) {
+	if !spec.PatroniDCS.IsExternal() {
+		return false, nil
+	}
+	if !cfg.PatroniEtcd.Configured() {
+		return false, fmt.Errorf("instance '%s' has patroni_dcs 'external', but %w", spec.InstanceID, etcd.ErrPatroniDCSNotConfigured)
+	}
+	return true, nil
+}
@@ -0,0 +1,46 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/orchestrator/common"
+)
+
+func TestExternalPatroniDCS(t *testing.T) {
+	cfg := config.Config{
+		PatroniEtcd: config.PatroniEtcd{
+			Endpoints: []string{"patroni-etcd:2379"},
+			Default:   true,
+		},
+	}
+
+	t.Run("unset patroni_dcs ignores the default", func(t *testing.T) {
+		// Instances that were created before patroni_dcs existed don't have
+		// it in their spec. Enabling the default must not move them.
+		external, err := common.ExternalPatroniDCS(cfg, &database.InstanceSpec{InstanceID: "n1"})
+		require.NoError(t, err)
+		assert.False(t, external)
+	})
+
+	t.Run("external", func(t *testing.T) {
+		external, err := common.ExternalPatroniDCS(cfg, &database.InstanceSpec{
+			InstanceID: "n1",
+			PatroniDCS: database.PatroniDCSExternal,
+		})
+		require.NoError(t, err)
+		assert.True(t, external)
+	})
+
+	t.Run("external without patroni_etcd", func(t *testing.T) {
+		_, err := common.ExternalPatroniDCS(config.Config{}, &database.InstanceSpec{
+			InstanceID: "n1",
+			PatroniDCS: database.PatroniDCSExternal,
+		})
+		assert.Error(t, err)
+	})
+}
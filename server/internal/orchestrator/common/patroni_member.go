@@ -4,9 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/samber/do"
-	clientv3 "go.etcd.io/etcd/client/v3"
-
 	"github.com/pgEdge/control-plane/server/internal/patroni"
 	"github.com/pgEdge/control-plane/server/internal/resource"
 	"github.com/pgEdge/control-plane/server/internal/storage"
@@ -27,6 +24,9 @@ type PatroniMember struct {
 	DatabaseID string `json:"database_id"`
 	NodeName   string `json:"node_name"`
 	InstanceID string `json:"instance_id"`
+	// ExternalDCS is true if the member uses the external etcd cluster from
+	// the patroni_etcd configuration rather than the control plane's etcd.
+	ExternalDCS bool `json:"external_dcs,omitempty"`
 }
 
 func (p *PatroniMember) ResourceVersion() string {
@@ -68,7 +68,7 @@ func (p *PatroniMember) Update(ctx context.Context, rc *resource.Context) error
 }
 
 func (p *PatroniMember) Delete(ctx context.Context, rc *resource.Context) error {
-	client, err := do.Invoke[*clientv3.Client](rc.Injector)
+	client, err := patroniDCSClient(rc, p.ExternalDCS)
 	if err != nil {
 		return err
 	}
@@ -416,26 +416,39 @@ func (o *Orchestrator) instanceResources(spec *database.InstanceSpec, scripts da
 		OwnerGID: databaseOwnerGID,
 	}
 
-	// patroni resources - used to clean up etcd on deletion
+	externalDCS, err := common.ExternalPatroniDCS(o.cfg, spec)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var dcsEndpoints []string
+	if externalDCS {
+		dcsEndpoints = o.cfg.PatroniEtcd.Endpoints
+	}
+
+	// patroni resources - used to switch DCSs and to clean up etcd on deletion
 	patroniCluster := &common.PatroniCluster{
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		ExternalDCS: externalDCS,
 	}
 	patroniMember := &common.PatroniMember{
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
-		InstanceID: spec.InstanceID,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		InstanceID:  spec.InstanceID,
+		ExternalDCS: externalDCS,
 	}
 
 	// file resources
 	etcdCreds := &common.EtcdCreds{
-		InstanceID: spec.InstanceID,
-		HostID:     spec.HostID,
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
-		ParentID:   certificatesDir.ID,
-		OwnerUID:   databaseOwnerUID,
-		OwnerGID:   databaseOwnerGID,
+		InstanceID:  spec.InstanceID,
+		HostID:      spec.HostID,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		ParentID:    certificatesDir.ID,
+		OwnerUID:    databaseOwnerUID,
+		OwnerGID:    databaseOwnerGID,
+		ExternalDCS: externalDCS,
+		Endpoints:   dcsEndpoints,
 	}
 	postgresCerts := &common.PostgresCerts{
 		InstanceID: spec.InstanceID,
@@ -472,6 +485,7 @@ func (o *Orchestrator) instanceResources(spec *database.InstanceSpec, scripts da
 				FQDN:            instanceHostname,
 				LogType:         patroni.LogTypeJson,
 				Paths:           paths,
+				ExternalDCS:     externalDCS,
 			}),
 		},
 	}
@@ -1103,6 +1117,10 @@ func (o *Orchestrator) ExecuteInstanceCommand(ctx context.Context, w io.Writer,
 	return PostgresContainerExec(ctx, w, o.docker, instanceID, args)
 }
 
+func (o *Orchestrator) ExecuteInstanceCommandWithEnv(ctx context.Context, w io.Writer, databaseID, instanceID string, env []string, args ...string) error {
+	return PostgresContainerExecWithEnv(ctx, w, o.docker, instanceID, env, args)
+}
+
 func (o *Orchestrator) ValidateInstanceSpecs(ctx context.Context, changes []*database.InstanceSpecChange) ([]*database.ValidationResult, error) {
 	results := make([]*database.ValidationResult, 0, len(changes)*3)
 
@@ -1253,6 +1271,15 @@ func (o *Orchestrator) InstancePaths(_ *ds.Version, instanceID string) (database
 	return o.instancePaths(instanceID), nil
 }
 
+func (o *Orchestrator) PostgreSQLSystemParameters(spec *database.InstanceSpec) (map[string]any, error) {
+	generator := common.NewPatroniConfigGenerator(common.PatroniConfigGeneratorOptions{
+		Instance: spec,
+		Paths:    o.instancePaths(spec.InstanceID),
+	})
+
+	return generator.SystemParameters(), nil
+}
+
 func (o *Orchestrator) instancePaths(instanceID string) database.InstancePaths {
 	return database.InstancePaths{
 		Instance:       database.Paths{BaseDir: "/opt/pgedge"},
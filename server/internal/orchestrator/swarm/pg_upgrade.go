@@ -0,0 +1,156 @@
+package swarm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/docker"
+)
+
+// PgUpgrade runs one phase of an in-place major version upgrade. The new
+// version's binaries aren't available in the old version's image, so they're
+// staged into the instance's data volume and run from there.
+func (o *Orchestrator) PgUpgrade(ctx context.Context, w io.Writer, opts *database.PgUpgradeOptions) error {
+	instanceID := opts.Spec.InstanceID
+	paths := o.instancePaths(instanceID)
+
+	svc, err := o.docker.ServiceInspectByLabels(ctx, map[string]string{
+		"pgedge.component":   "postgres",
+		"pgedge.instance.id": instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to inspect postgres service: %w", err)
+	}
+	containerSpec := svc.Spec.TaskTemplate.ContainerSpec
+	if containerSpec == nil {
+		return fmt.Errorf("postgres service for instance '%s' has no container spec", instanceID)
+	}
+
+	if opts.Mode == database.PgUpgradeModeReplica {
+		// Patroni rebuilds the replica from the upgraded primary when it
+		// starts with an empty data directory.
+		script := fmt.Sprintf(
+			`set -e; if [ -d %[1]s ]; then rm -rf %[2]s; mv %[1]s %[2]s; fi`,
+			paths.Instance.PgData(), pgDataPreUpgrade(paths),
+		)
+		return o.runPgUpgradeContainer(ctx, w, containerSpec, containerSpec.Image, script)
+	}
+	if opts.Mode != database.PgUpgradeModeCheck && opts.Mode != database.PgUpgradeModeLink {
+		return fmt.Errorf("unrecognized pg_upgrade mode '%s'", opts.Mode)
+	}
+
+	images, err := o.resolveInstanceImages(opts.Spec)
+	if err != nil {
+		return err
+	}
+
+	// Copy the new version's installation, including its extensions, into the
+	// data volume so that it's accessible from the old version's container.
+	staged := filepath.Join(paths.Instance.Data(), "pg-upgrade-install")
+	stageScript := fmt.Sprintf(
+		`set -e; prefix="$(dirname "$(pg_config --bindir)")"; rm -rf %[1]s; mkdir -p %[1]s; cp -a "$prefix"/. %[1]s/`,
+		staged,
+	)
+	if err := o.runPgUpgradeContainer(ctx, w, containerSpec, images.PgEdgeImage, stageScript); err != nil {
+		return fmt.Errorf("failed to stage binaries for the new version: %w", err)
+	}
+
+	// The script runs in a container from the current version's image, so it
+	// finds the old binaries through pg_config.
+	scriptOpts := database.PgUpgradeScriptOptions{
+		NewBinDir:   filepath.Join(staged, "bin"),
+		OldDataDir:  paths.Instance.PgData(),
+		NewDataDir:  filepath.Join(paths.Instance.Data(), "pgdata-upgrade"),
+		WorkDir:     filepath.Join(paths.Instance.Data(), "pg-upgrade"),
+		LibraryPath: filepath.Join(staged, "lib"),
+	}
+
+	if opts.Mode == database.PgUpgradeModeCheck {
+		// The check needs to connect to the running server over its socket,
+		// so it runs inside the running container.
+		scriptOpts.Check = true
+		scriptOpts.OldPort = PostgresContainerPort
+		scriptOpts.NewDataDir = filepath.Join(paths.Instance.Data(), "pgdata-check")
+		script := database.PgUpgradeScript(scriptOpts)
+
+		return PostgresContainerExec(ctx, w, o.docker, instanceID, []string{"sh", "-c", script})
+	}
+
+	// Swap the upgraded data directory into place once the upgrade succeeds.
+	// The old data directory is kept because pg_upgrade --link shares its
+	// files with the upgraded one.
+	script := database.PgUpgradeScript(scriptOpts) + fmt.Sprintf(
+		"rm -rf %[1]s\nmv %[2]s %[1]s\nmv %[3]s %[2]s\n",
+		pgDataPreUpgrade(paths), scriptOpts.OldDataDir, scriptOpts.NewDataDir,
+	)
+
+	return o.runPgUpgradeContainer(ctx, w, containerSpec, containerSpec.Image, script)
+}
+
+// runPgUpgradeContainer runs the given script to completion in a container
+// that has the same mounts as the instance's postgres service and streams its
+// output to w.
+func (o *Orchestrator) runPgUpgradeContainer(
+	ctx context.Context,
+	w io.Writer,
+	containerSpec *swarm.ContainerSpec,
+	image string,
+	script string,
+) error {
+	containerID, err := o.docker.ContainerRun(ctx, docker.ContainerRunOptions{
+		Config: &container.Config{
+			Image: image,
+			Labels: map[string]string{
+				"pgedge.instance.id": containerSpec.Labels["pgedge.instance.id"],
+				"pgedge.component":   "pg-upgrade",
+			},
+			Hostname:   containerSpec.Hostname,
+			User:       containerSpec.User,
+			Entrypoint: []string{"sh", "-c", script},
+		},
+		Host: &container.HostConfig{
+			Mounts: containerSpec.Mounts,
+		},
+		Name: fmt.Sprintf("pg-upgrade-%s", uuid.NewString()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start pg_upgrade container: %w", err)
+	}
+	defer func() {
+		err := o.docker.ContainerRemove(ctx, containerID, container.RemoveOptions{Force: true})
+		if err != nil {
+			o.logger.Error().
+				Err(err).
+				Str("container_id", containerID).
+				Msg("failed to remove pg_upgrade container")
+		}
+	}()
+
+	// The follow: true means that this will block until the container exits.
+	err = o.docker.ContainerLogs(ctx, w, containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get pg_upgrade container logs: %w", err)
+	}
+	err = o.docker.ContainerWait(ctx, containerID, container.WaitConditionNotRunning, 30*time.Second)
+	if err != nil {
+		return fmt.Errorf("error while waiting for pg_upgrade container: %w", err)
+	}
+
+	return nil
+}
+
+func pgDataPreUpgrade(paths database.InstancePaths) string {
+	return filepath.Join(paths.Instance.Data(), "pgdata-pre-upgrade")
+}
@@ -0,0 +1,63 @@
+package swarm
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+)
+
+func (o *Orchestrator) GetInstanceResourceUsage(ctx context.Context, instanceID string, _ *ds.Version) (*database.InstanceResourceUsage, error) {
+	usage, err := database.HostResourceUsage(o.instancePaths(instanceID))
+	if err != nil {
+		return nil, err
+	}
+
+	ctr, err := GetPostgresContainer(ctx, o.docker, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := o.docker.ContainerStats(ctx, ctr.ID)
+	if err != nil {
+		return nil, err
+	}
+	applyContainerStats(usage, stats)
+
+	return usage, nil
+}
+
+// applyContainerStats computes CPU and memory usage the same way as the
+// 'docker stats' command. CPU usage is a percentage of a single CPU, so it can
+// exceed 100 when the container uses more than one CPU.
+func applyContainerStats(usage *database.InstanceResourceUsage, stats container.StatsResponse) {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta >= 0 && systemDelta > 0 {
+		cpuPercent := cpuDelta / systemDelta * onlineCPUs * 100
+		usage.CPUPercent = &cpuPercent
+	}
+
+	if stats.MemoryStats.Usage > 0 {
+		// Page cache that can be reclaimed isn't counted as usage. The stat
+		// name depends on the cgroup version.
+		memory := stats.MemoryStats.Usage
+		for _, key := range []string{"inactive_file", "total_inactive_file"} {
+			if inactive, ok := stats.MemoryStats.Stats[key]; ok && inactive < memory {
+				memory -= inactive
+				break
+			}
+		}
+		memoryBytes := int64(memory)
+		usage.MemoryBytes = &memoryBytes
+	}
+	if stats.MemoryStats.Limit > 0 {
+		limit := int64(stats.MemoryStats.Limit)
+		usage.MemoryLimitBytes = &limit
+	}
+}
@@ -0,0 +1,44 @@
+package swarm
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestApplyContainerStats(t *testing.T) {
+	var stats container.StatsResponse
+	stats.CPUStats = container.CPUStats{
+		CPUUsage:    container.CPUUsage{TotalUsage: 3_000_000_000},
+		SystemUsage: 20_000_000_000,
+		OnlineCPUs:  4,
+	}
+	stats.PreCPUStats = container.CPUStats{
+		CPUUsage:    container.CPUUsage{TotalUsage: 2_000_000_000},
+		SystemUsage: 12_000_000_000,
+	}
+	stats.MemoryStats = container.MemoryStats{
+		Usage: 512 * 1024 * 1024,
+		Limit: 2 * 1024 * 1024 * 1024,
+		Stats: map[string]uint64{"inactive_file": 128 * 1024 * 1024},
+	}
+
+	usage := &database.InstanceResourceUsage{}
+	applyContainerStats(usage, stats)
+
+	assert.Equal(t, &database.InstanceResourceUsage{
+		CPUPercent:       utils.PointerTo(50.0),
+		MemoryBytes:      utils.PointerTo(int64(384 * 1024 * 1024)),
+		MemoryLimitBytes: utils.PointerTo(int64(2 * 1024 * 1024 * 1024)),
+	}, usage)
+
+	t.Run("no previous sample", func(t *testing.T) {
+		usage := &database.InstanceResourceUsage{}
+		applyContainerStats(usage, container.StatsResponse{})
+		assert.Equal(t, &database.InstanceResourceUsage{}, usage)
+	})
+}
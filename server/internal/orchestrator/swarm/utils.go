@@ -62,11 +62,15 @@ func GetServiceContainer(ctx context.Context, dockerClient *docker.Docker, servi
 // avoid building cmd from unvalidated external input as a matter of defense
 // in depth, since the exec'd program is free to interpret its own arguments.
 func PostgresContainerExec(ctx context.Context, w io.Writer, dockerClient *docker.Docker, instanceID string, cmd []string) error {
+	return PostgresContainerExecWithEnv(ctx, w, dockerClient, instanceID, nil, cmd)
+}
+
+func PostgresContainerExecWithEnv(ctx context.Context, w io.Writer, dockerClient *docker.Docker, instanceID string, env []string, cmd []string) error {
 	container, err := GetPostgresContainer(ctx, dockerClient, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get postgres container: %w", err)
 	}
-	err = dockerClient.Exec(ctx, w, container.ID, cmd)
+	err = dockerClient.ExecWithEnv(ctx, w, container.ID, env, cmd)
 	if err != nil {
 		return fmt.Errorf("failed to exec command in postgres container: %w", err)
 	}
@@ -0,0 +1,135 @@
+package systemd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+	// cpuSampleInterval is the time between the two CPU usage samples that
+	// are used to compute the CPU percentage. This matches 'docker stats'.
+	cpuSampleInterval = time.Second
+)
+
+func (o *Orchestrator) GetInstanceResourceUsage(ctx context.Context, instanceID string, pgVersion *ds.Version) (*database.InstanceResourceUsage, error) {
+	paths, err := o.InstancePaths(pgVersion, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := database.HostResourceUsage(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	cgroup, err := o.client.GetControlGroup(ctx, patroniServiceName(instanceID))
+	if err != nil {
+		return nil, err
+	}
+	if err := readCgroupUsage(ctx, filepath.Join(cgroupRoot, cgroup), cpuSampleInterval, usage); err != nil {
+		return nil, fmt.Errorf("failed to read cgroup usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+// readCgroupUsage reads CPU and memory usage from a cgroup v2 directory. CPU
+// usage is a percentage of a single CPU over the sample interval, so it can
+// exceed 100 when the unit uses more than one CPU.
+func readCgroupUsage(ctx context.Context, dir string, sampleInterval time.Duration, usage *database.InstanceResourceUsage) error {
+	start := time.Now()
+	startCPU, err := readCgroupCPUUsage(dir)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(sampleInterval):
+	}
+	elapsed := time.Since(start)
+	endCPU, err := readCgroupCPUUsage(dir)
+	if err != nil {
+		return err
+	}
+	if endCPU >= startCPU && elapsed > 0 {
+		cpuPercent := float64(endCPU-startCPU) / float64(elapsed) * 100
+		usage.CPUPercent = &cpuPercent
+	}
+
+	memory, _, err := readCgroupValue(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return err
+	}
+	usage.MemoryBytes = &memory
+
+	limit, ok, err := readCgroupValue(filepath.Join(dir, "memory.max"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		usage.MemoryLimitBytes = &limit
+	}
+
+	return nil
+}
+
+func readCgroupCPUUsage(dir string) (time.Duration, error) {
+	f, err := os.Open(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return extractCPUUsage(f)
+}
+
+func extractCPUUsage(r io.Reader) (time.Duration, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "usage_usec" {
+			continue
+		}
+		usec, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse usage_usec: %w", err)
+		}
+		return time.Duration(usec) * time.Microsecond, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, errors.New("usage_usec not found")
+}
+
+// readCgroupValue reads a single-value cgroup file. It returns false if the
+// value is 'max', which means that there's no limit.
+func readCgroupValue(path string) (int64, bool, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false, err
+	}
+	value := strings.TrimSpace(string(raw))
+	if value == "max" {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("parse %s: %w", filepath.Base(path), err)
+	}
+
+	return n, true, nil
+}
@@ -0,0 +1,65 @@
+package systemd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+)
+
+func TestExtractCPUUsage(t *testing.T) {
+	out, err := extractCPUUsage(strings.NewReader(cpuStat))
+	require.NoError(t, err)
+	assert.Equal(t, 2_745_931_126*time.Microsecond, out)
+
+	_, err = extractCPUUsage(strings.NewReader("user_usec 1\n"))
+	assert.ErrorContains(t, err, "usage_usec not found")
+}
+
+func TestReadCgroupUsage(t *testing.T) {
+	write := func(t *testing.T, dir, name, content string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	t.Run("with memory limit", func(t *testing.T) {
+		dir := t.TempDir()
+		write(t, dir, "cpu.stat", cpuStat)
+		write(t, dir, "memory.current", "402653184\n")
+		write(t, dir, "memory.max", "2147483648\n")
+
+		usage := &database.InstanceResourceUsage{}
+		require.NoError(t, readCgroupUsage(t.Context(), dir, time.Millisecond, usage))
+		require.NotNil(t, usage.CPUPercent)
+		assert.Equal(t, 0.0, *usage.CPUPercent)
+		assert.Equal(t, int64(402653184), *usage.MemoryBytes)
+		assert.Equal(t, int64(2147483648), *usage.MemoryLimitBytes)
+	})
+
+	t.Run("without memory limit", func(t *testing.T) {
+		dir := t.TempDir()
+		write(t, dir, "cpu.stat", cpuStat)
+		write(t, dir, "memory.current", "402653184\n")
+		write(t, dir, "memory.max", "max\n")
+
+		usage := &database.InstanceResourceUsage{}
+		require.NoError(t, readCgroupUsage(t.Context(), dir, time.Millisecond, usage))
+		assert.Nil(t, usage.MemoryLimitBytes)
+	})
+}
+
+const cpuStat = `usage_usec 2745931126
+user_usec 1974215730
+system_usec 771715396
+nr_periods 0
+nr_throttled 0
+throttled_usec 0
+nr_bursts 0
+burst_usec 0
+`
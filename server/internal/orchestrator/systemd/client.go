@@ -245,6 +245,30 @@ func (c *Client) GetMainPID(ctx context.Context, name string) (int, error) {
 	return int(pid), nil
 }
 
+func (c *Client) GetControlGroup(ctx context.Context, name string) (string, error) {
+	logger := c.logger.With().Str("unit", name).Logger()
+	logger.Debug().Msg("getting control group for unit")
+
+	if err := c.UnitExists(ctx, name); err != nil {
+		return "", err
+	}
+
+	prop, err := c.conn.GetServicePropertyContext(ctx, name, "ControlGroup")
+	if err != nil {
+		return "", fmt.Errorf("failed to get ControlGroup for unit '%s': %w", name, err)
+	}
+
+	cgroup, ok := prop.Value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type for ControlGroup property of unit '%s'", name)
+	}
+	if cgroup == "" {
+		return "", fmt.Errorf("unit '%s' does not have a control group", name)
+	}
+
+	return cgroup, nil
+}
+
 func (c *Client) Shutdown() error {
 	c.logger.Debug().Msg("stopping systemd client")
 
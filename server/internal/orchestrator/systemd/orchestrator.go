@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"os/exec"
 	"os/user"
 	"path/filepath"
@@ -206,26 +207,39 @@ func (o *Orchestrator) GenerateInstanceResources(spec *database.InstanceSpec, sc
 		OwnerGID: databaseOwnerGID,
 	}
 
-	// patroni resources - used to clean up etcd on deletion
+	externalDCS, err := common.ExternalPatroniDCS(o.cfg, spec)
+	if err != nil {
+		return nil, err
+	}
+	var dcsEndpoints []string
+	if externalDCS {
+		dcsEndpoints = o.cfg.PatroniEtcd.Endpoints
+	}
+
+	// patroni resources - used to switch DCSs and to clean up etcd on deletion
 	patroniCluster := &common.PatroniCluster{
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		ExternalDCS: externalDCS,
 	}
 	patroniMember := &common.PatroniMember{
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
-		InstanceID: spec.InstanceID,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		InstanceID:  spec.InstanceID,
+		ExternalDCS: externalDCS,
 	}
 
 	// file resources
 	etcdCreds := &common.EtcdCreds{
-		InstanceID: spec.InstanceID,
-		HostID:     spec.HostID,
-		DatabaseID: spec.DatabaseID,
-		NodeName:   spec.NodeName,
-		ParentID:   certificatesDir.ID,
-		OwnerUID:   databaseOwnerUID,
-		OwnerGID:   databaseOwnerGID,
+		InstanceID:  spec.InstanceID,
+		HostID:      spec.HostID,
+		DatabaseID:  spec.DatabaseID,
+		NodeName:    spec.NodeName,
+		ParentID:    certificatesDir.ID,
+		OwnerUID:    databaseOwnerUID,
+		OwnerGID:    databaseOwnerGID,
+		ExternalDCS: externalDCS,
+		Endpoints:   dcsEndpoints,
 	}
 	postgresCerts := &common.PostgresCerts{
 		InstanceID:        spec.InstanceID,
@@ -254,16 +268,15 @@ func (o *Orchestrator) GenerateInstanceResources(spec *database.InstanceSpec, sc
 			HostID:     spec.HostID,
 			NodeName:   spec.NodeName,
 			Generator: common.NewPatroniConfigGenerator(common.PatroniConfigGeneratorOptions{
-				Instance:        spec,
-				HostCPUs:        float64(o.cpus),
-				HostMemoryBytes: o.memBytes,
-				PatroniPort:     patroniPort,
-				PostgresPort:    postgresPort,
-				OrchestratorParameters: map[string]any{
-					"shared_preload_libraries": "pg_stat_statements,spock",
-				},
-				FQDN:  o.cfg.PeerAddress(),
-				Paths: paths,
+				Instance:               spec,
+				HostCPUs:               float64(o.cpus),
+				HostMemoryBytes:        o.memBytes,
+				PatroniPort:            patroniPort,
+				PostgresPort:           postgresPort,
+				OrchestratorParameters: orchestratorParameters(),
+				FQDN:                   o.cfg.PeerAddress(),
+				Paths:                  paths,
+				ExternalDCS:            externalDCS,
 			}),
 			ParentID: configsDir.ID,
 			OwnerUID: databaseOwnerUID,
@@ -461,6 +474,10 @@ func (o *Orchestrator) GetServiceInstanceStatus(ctx context.Context, serviceInst
 }
 
 func (o *Orchestrator) ExecuteInstanceCommand(ctx context.Context, w io.Writer, databaseID, instanceID string, args ...string) error {
+	return o.ExecuteInstanceCommandWithEnv(ctx, w, databaseID, instanceID, nil, args...)
+}
+
+func (o *Orchestrator) ExecuteInstanceCommandWithEnv(ctx context.Context, w io.Writer, databaseID, instanceID string, env []string, args ...string) error {
 	if len(args) == 0 {
 		return errors.New("got empty args")
 	}
@@ -475,6 +492,9 @@ func (o *Orchestrator) ExecuteInstanceCommand(ctx context.Context, w io.Writer,
 			Gid: uint32(databaseOwnerGID),
 		},
 	}
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	cmd.Stdout = w
 	cmd.Stderr = w
 	if err := cmd.Run(); err != nil {
@@ -609,6 +629,28 @@ func (o *Orchestrator) InstancePaths(pgVersion *ds.Version, instanceID string) (
 	}, nil
 }
 
+func (o *Orchestrator) PostgreSQLSystemParameters(spec *database.InstanceSpec) (map[string]any, error) {
+	paths, err := o.InstancePaths(spec.PgEdgeVersion.PostgresVersion, spec.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	generator := common.NewPatroniConfigGenerator(common.PatroniConfigGeneratorOptions{
+		Instance:               spec,
+		OrchestratorParameters: orchestratorParameters(),
+		Paths:                  paths,
+	})
+
+	return generator.SystemParameters(), nil
+}
+
+// orchestratorParameters are the Postgres parameters that differ from the
+// defaults for systemd instances.
+func orchestratorParameters() map[string]any {
+	return map[string]any{
+		"shared_preload_libraries": "pg_stat_statements,spock",
+	}
+}
+
 func (o *Orchestrator) databaseOwnerIDs() (int, int, error) {
 	u, err := user.Lookup("postgres")
 	if err != nil {
@@ -0,0 +1,65 @@
+package systemd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+)
+
+// PgUpgrade runs one phase of an in-place major version upgrade. Each major
+// version has its own instance directory, so the upgraded data directory is
+// created in the new version's directory and the old one is left in place.
+func (o *Orchestrator) PgUpgrade(ctx context.Context, w io.Writer, opts *database.PgUpgradeOptions) error {
+	switch opts.Mode {
+	case database.PgUpgradeModeReplica:
+		// The replica's data directory for the new version is empty, so
+		// Patroni rebuilds it from the upgraded primary when it starts.
+		return nil
+	case database.PgUpgradeModeCheck, database.PgUpgradeModeLink:
+	default:
+		return fmt.Errorf("unrecognized pg_upgrade mode '%s'", opts.Mode)
+	}
+
+	oldVersion := opts.Previous.PgEdgeVersion.PostgresVersion
+	newVersion := opts.Spec.PgEdgeVersion.PostgresVersion
+	oldMajor, ok := oldVersion.MajorString()
+	if !ok {
+		return errors.New("got empty postgres version for the current spec")
+	}
+	newMajor, ok := newVersion.MajorString()
+	if !ok {
+		return errors.New("got empty postgres version for the new spec")
+	}
+	oldPaths, err := o.InstancePaths(oldVersion, opts.Previous.InstanceID)
+	if err != nil {
+		return err
+	}
+	newPaths, err := o.InstancePaths(newVersion, opts.Spec.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	scriptOpts := database.PgUpgradeScriptOptions{
+		OldBinDir:  o.packageManager.BinDir(oldMajor),
+		NewBinDir:  o.packageManager.BinDir(newMajor),
+		OldDataDir: oldPaths.Instance.PgData(),
+		NewDataDir: newPaths.Instance.PgData(),
+		WorkDir:    filepath.Join(newPaths.Instance.Data(), "pg-upgrade"),
+	}
+	if opts.Mode == database.PgUpgradeModeCheck {
+		if opts.Previous.Port == nil {
+			return errors.New("instance port must be defined")
+		}
+		scriptOpts.Check = true
+		scriptOpts.OldPort = *opts.Previous.Port
+		scriptOpts.NewDataDir = filepath.Join(newPaths.Instance.Data(), "pgdata-check")
+	}
+
+	script := database.PgUpgradeScript(scriptOpts)
+
+	return o.ExecuteInstanceCommand(ctx, w, opts.Spec.DatabaseID, opts.Spec.InstanceID, "sh", "-c", script)
+}
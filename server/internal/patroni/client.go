@@ -127,6 +127,14 @@ type InstanceStatus struct {
 	DCSLastSeen *int64 `json:"dcs_last_seen,omitempty"`
 	// True if PostgreSQL needs to be restarted to get new configuration
 	PendingRestart *bool `json:"pending_restart,omitempty"`
+	// The settings that are waiting for a restart to take effect, keyed by
+	// setting name
+	PendingRestartReason map[string]PendingRestartReason `json:"pending_restart_reason,omitempty"`
+}
+
+type PendingRestartReason struct {
+	OldValue *string `json:"old_value,omitempty"`
+	NewValue *string `json:"new_value,omitempty"`
 }
 
 func (s *InstanceStatus) InErrorState() bool {
@@ -334,6 +342,10 @@ type DynamicConfig struct {
 	// will not take action to swap synchronous unhealthy follower when the
 	// value is set to 0 or below.
 	MaximumLagOnSyncNode *int64 `json:"maximum_lag_on_syncnode,omitempty"`
+	// Checks the timeline of a replica before allowing it to participate in
+	// leader election. Replicas on an older timeline than the last known
+	// primary are not eligible. Defaults to false.
+	CheckTimeline *bool `json:"check_timeline,omitempty"`
 	// maximum number of timeline history items kept in DCS. Default value: 0.
 	// When set to 0, it keeps the full history in DCS.
 	MaxTimelinesHistory *int `json:"max_timelines_history,omitempty"`
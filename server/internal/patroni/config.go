@@ -4,7 +4,14 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/storage"
 )
 
-const DefaultLoopWaitSeconds = 10
+const (
+	DefaultLoopWaitSeconds     = 10
+	DefaultTTLSeconds          = 30
+	DefaultRetryTimeoutSeconds = 10
+	// DefaultMaximumLagOnFailover is Patroni's default maximum_lag_on_failover
+	// of 1MiB.
+	DefaultMaximumLagOnFailover int64 = 1048576
+)
 
 func Namespace() string {
 	return storage.Prefix("/", "patroni")
@@ -22,6 +29,10 @@ func MemberKey(databaseID, nodeName, instanceID string) string {
 	return storage.Key(Namespace(), ClusterName(databaseID, nodeName), "members", instanceID)
 }
 
+func LeaderKey(databaseID, nodeName string) string {
+	return storage.Key(Namespace(), ClusterName(databaseID, nodeName), "leader")
+}
+
 type LogType string
 
 const (
@@ -134,6 +145,7 @@ type DCS struct {
 	RetryTimeout          *int               `json:"retry_timeout,omitempty"`
 	MaximumLagOnFailover  *int64             `json:"maximum_lag_on_failover,omitempty"`
 	MaximumLagOnSyncNode  *int64             `json:"maximum_lag_on_syncnode,omitempty"`
+	CheckTimeline         *bool              `json:"check_timeline,omitempty"`
 	MaxTimelinesHistory   *int               `json:"max_timelines_history,omitempty"`
 	PrimaryStartTimeout   *int               `json:"primary_start_timeout,omitempty"`
 	PrimaryStopTimeout    *int               `json:"primary_stop_timeout,omitempty"`
@@ -158,6 +170,7 @@ func (d *DCS) ToDynamicConfig() *DynamicConfig {
 		RetryTimeout:          d.RetryTimeout,
 		MaximumLagOnFailover:  d.MaximumLagOnFailover,
 		MaximumLagOnSyncNode:  d.MaximumLagOnSyncNode,
+		CheckTimeline:         d.CheckTimeline,
 		MaxTimelinesHistory:   d.MaxTimelinesHistory,
 		PrimaryStartTimeout:   d.PrimaryStartTimeout,
 		PrimaryStopTimeout:    d.PrimaryStopTimeout,
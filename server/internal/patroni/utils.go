@@ -28,3 +28,56 @@ func EtcdHosts(ctx context.Context, client *clientv3.Client) ([]string, error) {
 
 	return hosts.ToSortedSlice(strings.Compare), nil
 }
+
+// CopyCluster copies a Patroni cluster's state from one DCS to another. It
+// copies the keys that outlive the cluster's members, such as the dynamic
+// configuration, the initialize key, and the timeline history, and it skips the
+// keys that are attached to leases, such as the leader and member keys.
+// Instances pick these keys up when they're restarted with the target DCS. The
+// copy replaces any keys that are left over in the target from an earlier
+// switch, but it's skipped if the cluster already has a leader in the target.
+// It returns true if the keys were copied.
+func CopyCluster(
+	ctx context.Context,
+	source, target *clientv3.Client,
+	databaseID, nodeName string,
+) (bool, error) {
+	prefix := ClusterPrefix(databaseID, nodeName)
+	leaderKey := LeaderKey(databaseID, nodeName)
+
+	resp, err := source.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return false, fmt.Errorf("failed to get patroni cluster keys from source: %w", err)
+	}
+	existing, err := target.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return false, fmt.Errorf("failed to get patroni cluster keys from target: %w", err)
+	}
+
+	// etcd doesn't allow a transaction to delete and put the same key, so we
+	// only delete the leftover keys that we're not replacing.
+	copied := ds.NewSet[string]()
+	var ops []clientv3.Op
+	for _, kv := range resp.Kvs {
+		if kv.Lease != 0 {
+			continue
+		}
+		copied.Add(string(kv.Key))
+		ops = append(ops, clientv3.OpPut(string(kv.Key), string(kv.Value)))
+	}
+	for _, kv := range existing.Kvs {
+		if !copied.Has(string(kv.Key)) {
+			ops = append(ops, clientv3.OpDelete(string(kv.Key)))
+		}
+	}
+
+	txn, err := target.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(leaderKey), "=", 0)).
+		Then(ops...).
+		Commit()
+	if err != nil {
+		return false, fmt.Errorf("failed to copy patroni cluster keys to target: %w", err)
+	}
+
+	return txn.Succeeded, nil
+}
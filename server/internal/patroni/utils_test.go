@@ -0,0 +1,71 @@
+package patroni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+)
+
+func TestCopyCluster(t *testing.T) {
+	source := storagetest.NewEtcdTestServer(t).Client(t)
+	target := storagetest.NewEtcdTestServer(t).Client(t)
+
+	prefix := patroni.ClusterPrefix("storefront", "n1")
+	leaderKey := patroni.LeaderKey("storefront", "n1")
+	memberKey := patroni.MemberKey("storefront", "n1", "storefront-n1-689qacsi")
+
+	lease, err := source.Grant(t.Context(), 60)
+	require.NoError(t, err)
+	for key, value := range map[string]string{
+		prefix + "config":     `{"ttl":30}`,
+		prefix + "initialize": "7412345678901234567",
+		prefix + "history":    "[]",
+	} {
+		_, err := source.Put(t.Context(), key, value)
+		require.NoError(t, err)
+	}
+	_, err = source.Put(t.Context(), leaderKey, "storefront-n1-689qacsi", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+	_, err = source.Put(t.Context(), memberKey, "{}", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+
+	// Left over from an earlier switch
+	_, err = target.Put(t.Context(), prefix+"status", `{"optime":1}`)
+	require.NoError(t, err)
+
+	copied, err := patroni.CopyCluster(t.Context(), source, target, "storefront", "n1")
+	require.NoError(t, err)
+	assert.True(t, copied)
+
+	resp, err := target.Get(t.Context(), prefix, clientv3.WithPrefix())
+	require.NoError(t, err)
+	actual := map[string]string{}
+	for _, kv := range resp.Kvs {
+		actual[string(kv.Key)] = string(kv.Value)
+	}
+	assert.Equal(t, map[string]string{
+		prefix + "config":     `{"ttl":30}`,
+		prefix + "initialize": "7412345678901234567",
+		prefix + "history":    "[]",
+	}, actual)
+
+	// The copy is skipped once the cluster has a leader in the target.
+	_, err = target.Put(t.Context(), leaderKey, "storefront-n1-689qacsi")
+	require.NoError(t, err)
+	_, err = source.Put(t.Context(), prefix+"config", `{"ttl":20}`)
+	require.NoError(t, err)
+
+	copied, err = patroni.CopyCluster(t.Context(), source, target, "storefront", "n1")
+	require.NoError(t, err)
+	assert.False(t, copied)
+
+	config, err := target.Get(t.Context(), prefix+"config")
+	require.NoError(t, err)
+	require.Len(t, config.Kvs, 1)
+	assert.Equal(t, `{"ttl":30}`, string(config.Kvs[0].Value))
+}
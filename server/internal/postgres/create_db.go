@@ -39,6 +39,14 @@ func IsSpockEnabled() Query[bool] {
 	}
 }
 
+// UpdateSpockExtension updates the Spock extension to the version that's
+// installed with the current binaries.
+func UpdateSpockExtension() Statement {
+	return Statement{
+		SQL: "ALTER EXTENSION spock UPDATE;",
+	}
+}
+
 func EnableRepairMode() Statement {
 	return Statement{
 		SQL: "SELECT spock.repair_mode('True');",
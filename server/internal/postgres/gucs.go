@@ -41,6 +41,17 @@ func SpockDefaultGUCs() map[string]any {
 	}
 }
 
+// QueryStatsGUCs returns the pg_stat_statements settings that we apply when
+// query stats collection is enabled. pg_stat_statements is always included in
+// shared_preload_libraries, so these settings do not require a restart.
+func QueryStatsGUCs() map[string]any {
+	return map[string]any{
+		"pg_stat_statements.track":         "top",
+		"pg_stat_statements.track_utility": "off",
+		"pg_stat_statements.save":          "on",
+	}
+}
+
 func SnowflakeLolorGUCs(nodeOrdinal int) map[string]any {
 	return map[string]any{
 		"snowflake.node": nodeOrdinal,
@@ -69,3 +80,36 @@ func DefaultTunableGUCs(memBytes uint64, cpus float64, clusterSize int) map[stri
 		"max_replication_slots":        int(max(clusterSize+2, 16)),
 	}
 }
+
+// restartRequiredGUCs are the settings that we manage or commonly see in
+// specs that only take effect after a restart, i.e. settings with the
+// 'postmaster' context in pg_settings.
+var restartRequiredGUCs = map[string]bool{
+	"archive_mode":                   true,
+	"autovacuum_max_workers":         true,
+	"dynamic_shared_memory_type":     true,
+	"huge_pages":                     true,
+	"listen_addresses":               true,
+	"logging_collector":              true,
+	"max_connections":                true,
+	"max_files_per_process":          true,
+	"max_locks_per_transaction":      true,
+	"max_pred_locks_per_transaction": true,
+	"max_prepared_transactions":      true,
+	"max_replication_slots":          true,
+	"max_wal_senders":                true,
+	"max_worker_processes":           true,
+	"port":                           true,
+	"shared_buffers":                 true,
+	"shared_preload_libraries":       true,
+	"track_commit_timestamp":         true,
+	"wal_buffers":                    true,
+	"wal_level":                      true,
+	"wal_log_hints":                  true,
+}
+
+// GUCRequiresRestart returns true if changing the given setting requires a
+// Postgres restart.
+func GUCRequiresRestart(name string) bool {
+	return restartRequiredGUCs[name]
+}
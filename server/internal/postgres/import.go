@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type Sequence struct {
+	Name      string
+	LastValue int64
+}
+
+// ImportPublicationNeedsCreate checks whether the publication that an import
+// subscribes to exists on the source server.
+func ImportPublicationNeedsCreate(name string) Query[bool] {
+	return Query[bool]{
+		SQL: "SELECT NOT EXISTS (SELECT 1 FROM pg_catalog.pg_publication WHERE pubname = @name);",
+		Args: pgx.NamedArgs{
+			"name": name,
+		},
+	}
+}
+
+// CreateImportPublication creates a publication for every table on the source
+// server. Publishing all tables requires superuser privileges on the source.
+func CreateImportPublication(name string) ConditionalStatement {
+	return ConditionalStatement{
+		If: ImportPublicationNeedsCreate(name),
+		Then: Statement{
+			SQL: fmt.Sprintf("CREATE PUBLICATION %s FOR ALL TABLES;", QuoteIdentifier(name)),
+		},
+	}
+}
+
+func DropImportPublication(name string) Statement {
+	return Statement{
+		SQL: fmt.Sprintf("DROP PUBLICATION IF EXISTS %s;", QuoteIdentifier(name)),
+	}
+}
+
+func ImportSubscriptionNeedsCreate(name string) Query[bool] {
+	return Query[bool]{
+		SQL: "SELECT NOT EXISTS (SELECT 1 FROM pg_catalog.pg_subscription WHERE subname = @name);",
+		Args: pgx.NamedArgs{
+			"name": name,
+		},
+	}
+}
+
+// CreateImportSubscription creates a native logical replication subscription
+// that copies the existing data from the source server and then streams its
+// changes. The subscription, its replication slot, and the publication on the
+// source share the same name.
+func CreateImportSubscription(name, sourceDSN string) ConditionalStatement {
+	return ConditionalStatement{
+		If: ImportSubscriptionNeedsCreate(name),
+		Then: Statement{
+			// CREATE SUBSCRIPTION doesn't accept parameters, so the connection
+			// string has to be included as a literal.
+			SQL: fmt.Sprintf(
+				"CREATE SUBSCRIPTION %[1]s CONNECTION %[2]s PUBLICATION %[1]s WITH (copy_data = true, slot_name = %[3]s);",
+				QuoteIdentifier(name),
+				QuoteLiteral(sourceDSN),
+				QuoteLiteral(name),
+			),
+		},
+	}
+}
+
+// DropImportSubscription drops the subscription along with its replication
+// slot on the source server.
+func DropImportSubscription(name string) Statement {
+	return Statement{
+		SQL: fmt.Sprintf("DROP SUBSCRIPTION IF EXISTS %s;", QuoteIdentifier(name)),
+	}
+}
+
+func ImportSubscriptionExists(name string) Query[bool] {
+	return Query[bool]{
+		SQL: "SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_subscription WHERE subname = @name);",
+		Args: pgx.NamedArgs{
+			"name": name,
+		},
+	}
+}
+
+// ImportSubscriptionConnInfo returns the connection string for the source
+// server. Reading it requires superuser privileges.
+func ImportSubscriptionConnInfo(name string) Query[string] {
+	return Query[string]{
+		SQL: "SELECT subconninfo FROM pg_catalog.pg_subscription WHERE subname = @name;",
+		Args: pgx.NamedArgs{
+			"name": name,
+		},
+	}
+}
+
+// ImportTablesSyncing returns the number of tables that haven't finished
+// their initial copy.
+func ImportTablesSyncing(name string) Query[int64] {
+	return Query[int64]{
+		SQL: `
+		SELECT count(*)
+		FROM pg_catalog.pg_subscription_rel r
+		JOIN pg_catalog.pg_subscription s ON s.oid = r.srsubid
+		WHERE s.subname = @name AND r.srsubstate <> 'r';
+	`,
+		Args: pgx.NamedArgs{
+			"name": name,
+		},
+	}
+}
+
+// ReplicationSlotLagBytes returns the amount of WAL on the source server that
+// the slot's consumer has yet to confirm. It must be executed on the source.
+func ReplicationSlotLagBytes(slotName string) Query[int64] {
+	return Query[int64]{
+		SQL: `
+		SELECT COALESCE(pg_wal_lsn_diff(pg_current_wal_lsn(), confirmed_flush_lsn), 0)::bigint
+		FROM pg_catalog.pg_replication_slots
+		WHERE slot_name = @slot_name;
+	`,
+		Args: pgx.NamedArgs{
+			"slot_name": slotName,
+		},
+	}
+}
+
+// ListSequences returns the current value of every sequence that has been
+// used. Logical replication doesn't replicate sequences, so these values are
+// copied separately.
+func ListSequences() Query[Sequence] {
+	return Query[Sequence]{
+		SQL: `
+		SELECT
+			format('%I.%I', schemaname, sequencename) AS name,
+			last_value                                AS lastvalue
+		FROM pg_catalog.pg_sequences
+		WHERE last_value IS NOT NULL
+		ORDER BY 1;
+	`,
+	}
+}
+
+func SetSequenceValue(seq Sequence) Statement {
+	return Statement{
+		SQL: "SELECT setval(@name::regclass, @value, true);",
+		Args: pgx.NamedArgs{
+			"name":  seq.Name,
+			"value": seq.LastValue,
+		},
+	}
+}
+
+func ListRoleNames() Query[string] {
+	return Query[string]{
+		SQL: "SELECT rolname FROM pg_catalog.pg_roles ORDER BY rolname;",
+	}
+}
@@ -50,3 +50,24 @@ func GetSubscriptionStatuses() Query[SubscriptionStatus] {
 		SQL: "SELECT to_json(spock.sub_show_status(sub_name)) from spock.subscription;",
 	}
 }
+
+type ResourceUsage struct {
+	DatabaseSizeBytes int64 `json:"database_size_bytes"`
+	WALDirectoryBytes int64 `json:"wal_directory_bytes"`
+	Connections       int   `json:"connections"`
+	MaxConnections    int   `json:"max_connections"`
+}
+
+// GetResourceUsage returns the size of the current database and the WAL
+// directory, as well as the number of client connections. Reading the WAL
+// directory requires superuser or pg_monitor privileges.
+func GetResourceUsage() Query[ResourceUsage] {
+	return Query[ResourceUsage]{
+		SQL: `SELECT json_build_object(
+				'database_size_bytes', pg_database_size(current_database()),
+				'wal_directory_bytes', (SELECT coalesce(sum(size), 0) FROM pg_ls_waldir()),
+				'connections', (SELECT count(*) FROM pg_stat_activity WHERE backend_type = 'client backend'),
+				'max_connections', current_setting('max_connections')::integer
+			);`,
+	}
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import "github.com/jackc/pgx/v5"
+
+// maxQueryTextLength limits the size of the query text that we collect for
+// each statement.
+const maxQueryTextLength = 1024
+
+type QueryStat struct {
+	QueryID     int64   `json:"query_id"`
+	Query       string  `json:"query"`
+	Calls       int64   `json:"calls"`
+	TotalTimeMs float64 `json:"total_time_ms"`
+	Rows        int64   `json:"rows"`
+}
+
+func CreatePgStatStatementsExtension() Statement {
+	return Statement{
+		SQL: "CREATE EXTENSION IF NOT EXISTS pg_stat_statements;",
+	}
+}
+
+// GetTopQueryStats returns the union of the top N statements in the current
+// database by total execution time, by calls, and by rows. Statistics for the
+// same statement that were recorded for different users are combined.
+func GetTopQueryStats(topN int) Query[QueryStat] {
+	return Query[QueryStat]{
+		SQL: `WITH stats AS (
+				SELECT
+					queryid,
+					min(left(query, @max_query_length)) AS query,
+					sum(calls)::bigint AS calls,
+					sum(total_exec_time)::float8 AS total_time_ms,
+					sum(rows)::bigint AS rows
+				FROM pg_stat_statements
+				WHERE dbid = (SELECT oid FROM pg_database WHERE datname = current_database())
+					AND queryid IS NOT NULL
+				GROUP BY queryid
+			), top AS (
+				(SELECT * FROM stats ORDER BY total_time_ms DESC LIMIT @top_n)
+				UNION
+				(SELECT * FROM stats ORDER BY calls DESC LIMIT @top_n)
+				UNION
+				(SELECT * FROM stats ORDER BY rows DESC LIMIT @top_n)
+			)
+			SELECT json_build_object(
+				'query_id', queryid,
+				'query', query,
+				'calls', calls,
+				'total_time_ms', total_time_ms,
+				'rows', rows
+			)
+			FROM top
+			ORDER BY total_time_ms DESC;`,
+		Args: pgx.NamedArgs{
+			"max_query_length": maxQueryTextLength,
+			"top_n":            topN,
+		},
+	}
+}
+
+// ResetQueryStats discards the statistics that pg_stat_statements has
+// gathered on the instance.
+func ResetQueryStats() Statement {
+	return Statement{
+		SQL: "SELECT pg_stat_statements_reset();",
+	}
+}
@@ -54,6 +54,22 @@ func CreateRoleIfNotExists(name string) ConditionalStatement {
 	}
 }
 
+// RenameRole renames a role if it exists and the new name is not already taken.
+func RenameRole(oldName, newName string) ConditionalStatement {
+	return ConditionalStatement{
+		If: Query[bool]{
+			SQL: "SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = @oldName) AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = @newName);",
+			Args: pgx.NamedArgs{
+				"oldName": oldName,
+				"newName": newName,
+			},
+		},
+		Then: Statement{
+			SQL: fmt.Sprintf("ALTER ROLE %s RENAME TO %s;", QuoteIdentifier(oldName), QuoteIdentifier(newName)),
+		},
+	}
+}
+
 type UserRoleOptions struct {
 	Name       string
 	Password   string
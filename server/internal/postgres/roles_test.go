@@ -150,3 +150,20 @@ func TestCreateUserRole(t *testing.T) {
 		})
 	}
 }
+
+func TestRenameRole(t *testing.T) {
+	expected := postgres.ConditionalStatement{
+		If: postgres.Query[bool]{
+			SQL: `SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = @oldName) AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = @newName);`,
+			Args: pgx.NamedArgs{
+				"oldName": "app",
+				"newName": `app "staging"`,
+			},
+		},
+		Then: postgres.Statement{
+			SQL: `ALTER ROLE "app" RENAME TO "app ""staging""";`,
+		},
+	}
+
+	assert.Equal(t, expected, postgres.RenameRole("app", `app "staging"`))
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type Lock struct {
+	LockType string  `json:"lock_type"`
+	Relation *string `json:"relation,omitempty"`
+	Mode     string  `json:"mode"`
+	Granted  bool    `json:"granted"`
+}
+
+type Session struct {
+	PID             int        `json:"pid"`
+	Username        *string    `json:"username,omitempty"`
+	DatabaseName    *string    `json:"database_name,omitempty"`
+	ApplicationName string     `json:"application_name"`
+	ClientAddr      *string    `json:"client_addr,omitempty"`
+	State           *string    `json:"state,omitempty"`
+	WaitEventType   *string    `json:"wait_event_type,omitempty"`
+	WaitEvent       *string    `json:"wait_event,omitempty"`
+	BackendStart    *time.Time `json:"backend_start,omitempty"`
+	XactStart       *time.Time `json:"xact_start,omitempty"`
+	QueryStart      *time.Time `json:"query_start,omitempty"`
+	StateChange     *time.Time `json:"state_change,omitempty"`
+	Query           string     `json:"query"`
+	BlockedBy       []int      `json:"blocked_by"`
+	Locks           []*Lock    `json:"locks"`
+}
+
+// GetSessions returns every client session on the instance, other than the
+// current session, along with the PIDs of the sessions that block each one
+// and the locks that each one holds or is waiting for. Relation names are only
+// resolved for locks in the current database.
+func GetSessions() Query[Session] {
+	return Query[Session]{
+		SQL: `SELECT json_build_object(
+				'pid', a.pid,
+				'username', a.usename,
+				'database_name', a.datname,
+				'application_name', a.application_name,
+				'client_addr', host(a.client_addr),
+				'state', a.state,
+				'wait_event_type', a.wait_event_type,
+				'wait_event', a.wait_event,
+				'backend_start', a.backend_start,
+				'xact_start', a.xact_start,
+				'query_start', a.query_start,
+				'state_change', a.state_change,
+				'query', left(a.query, @max_query_length),
+				'blocked_by', pg_blocking_pids(a.pid),
+				'locks', coalesce((
+					SELECT json_agg(json_build_object(
+						'lock_type', l.locktype,
+						'relation', CASE
+							WHEN l.database = (SELECT oid FROM pg_database WHERE datname = current_database())
+							THEN l.relation::regclass::text
+						END,
+						'mode', l.mode,
+						'granted', l.granted
+					) ORDER BY l.granted, l.locktype, l.mode)
+					FROM pg_locks l
+					WHERE l.pid = a.pid
+				), '[]'::json)
+			)
+			FROM pg_stat_activity a
+			WHERE a.backend_type = 'client backend'
+				AND a.pid <> pg_backend_pid()
+			ORDER BY a.pid;`,
+		Args: pgx.NamedArgs{
+			"max_query_length": maxQueryTextLength,
+		},
+	}
+}
+
+// CancelSession cancels the current query of the given client session. It
+// returns no rows if the PID does not belong to a client session.
+func CancelSession(pid int) Query[bool] {
+	return Query[bool]{
+		SQL: `SELECT pg_cancel_backend(pid)
+			FROM pg_stat_activity
+			WHERE pid = @pid AND backend_type = 'client backend';`,
+		Args: pgx.NamedArgs{"pid": pid},
+	}
+}
+
+// TerminateSession terminates the given client session. It returns no rows if
+// the PID does not belong to a client session.
+func TerminateSession(pid int) Query[bool] {
+	return Query[bool]{
+		SQL: `SELECT pg_terminate_backend(pid)
+			FROM pg_stat_activity
+			WHERE pid = @pid AND backend_type = 'client backend';`,
+		Args: pgx.NamedArgs{"pid": pid},
+	}
+}
@@ -27,3 +27,11 @@ func UnquoteIdentifier(in string) string {
 	out := strings.TrimPrefix(strings.TrimSuffix(in, `"`), `"`)
 	return strings.ReplaceAll(out, `""`, `"`)
 }
+
+// QuoteLiteral quotes a string literal for statements that don't accept
+// parameters. Callers must execute the SetSafeIdentifiers statements to
+// consider the output of this method safe.
+func QuoteLiteral(in string) string {
+	out := strings.ReplaceAll(in, string([]byte{0}), "")
+	return `'` + strings.ReplaceAll(out, `'`, `''`) + `'`
+}
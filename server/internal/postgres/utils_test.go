@@ -48,3 +48,27 @@ func TestQuoteUnquoteIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestQuoteLiteral(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		expected string
+	}{
+		{
+			in:       `host=db.example.com dbname=app`,
+			expected: `'host=db.example.com dbname=app'`,
+		},
+		{
+			in:       `password='it''s'`,
+			expected: `'password=''it''''s'''`,
+		},
+		{
+			in:       `a` + string([]byte{0}) + `b`,
+			expected: `'ab'`,
+		},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			assert.Equal(t, tc.expected, postgres.QuoteLiteral(tc.in))
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package replacement
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/host"
+)
+
+var (
+	ErrNoSpareHost   = errors.New("no spare host available")
+	ErrNoSourceNode  = errors.New("no source node available")
+	ErrHostNotInSpec = errors.New("host is not in database spec")
+)
+
+// Replacement describes how a single node is moved off of a failed host.
+type Replacement struct {
+	NodeName          string
+	FailedHostID      string
+	ReplacementHostID string
+	// SourceNode is set when the failed host was the node's only host. In
+	// that case, the node is removed from the spec and then re-added on the
+	// replacement host, populated from this source node.
+	SourceNode string
+}
+
+// Plan is the result of planning the replacement of a failed host within a
+// single database.
+type Plan struct {
+	// Spec is the updated database spec, with the failed host removed.
+	Spec         *database.Spec
+	Replacements []*Replacement
+}
+
+// Pending returns the replacements that must be re-added after the spec
+// update completes.
+func (p *Plan) Pending() []*Replacement {
+	var pending []*Replacement
+	for _, r := range p.Replacements {
+		if r.SourceNode != "" {
+			pending = append(pending, r)
+		}
+	}
+	return pending
+}
+
+// failedHosts returns the hosts that have not reported a status within the
+// given threshold. Hosts that have never reported a status are excluded.
+func failedHosts(hosts []*host.Host, threshold time.Duration, now time.Time) []*host.Host {
+	var failed []*host.Host
+	for _, h := range hosts {
+		if h.Status == nil || h.Status.UpdatedAt.IsZero() {
+			continue
+		}
+		if now.Sub(h.Status.UpdatedAt) > threshold {
+			failed = append(failed, h)
+		}
+	}
+	return failed
+}
+
+// planReplacement computes an updated spec that replaces each instance on the
+// failed host with an instance on a spare host. Replicas are replaced in-place
+// within their node so that Patroni can rebuild them from the node's primary.
+// Nodes that only had an instance on the failed host are removed from the spec
+// and recorded as replacements with a source node so that they can be re-added
+// and populated via Spock. instanceCounts is used to prefer the least-loaded
+// spare hosts, and it's updated with each assignment.
+func planReplacement(
+	spec *database.Spec,
+	failed *host.Host,
+	hosts []*host.Host,
+	instanceCounts map[string]int,
+) (*Plan, error) {
+	nodeVersions, err := nodeVersions(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	spec = spec.Clone()
+	used := ds.NewSet[string]()
+	for _, node := range spec.Nodes {
+		used.Add(node.HostIDs...)
+	}
+	if !used.Has(failed.ID) {
+		return nil, fmt.Errorf("%w: %s", ErrHostNotInSpec, failed.ID)
+	}
+
+	var replacements []*Replacement
+	var remainingNodes []*database.Node
+	for _, node := range spec.Nodes {
+		idx := slices.Index(node.HostIDs, failed.ID)
+		if idx < 0 {
+			remainingNodes = append(remainingNodes, node)
+			continue
+		}
+		spare, err := selectSpareHost(failed, hosts, nodeVersions[node.Name], used, instanceCounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replace host %s in node %s: %w", failed.ID, node.Name, err)
+		}
+		used.Add(spare.ID)
+		instanceCounts[spare.ID]++
+
+		replacement := &Replacement{
+			NodeName:          node.Name,
+			FailedHostID:      failed.ID,
+			ReplacementHostID: spare.ID,
+		}
+		replacements = append(replacements, replacement)
+
+		if len(node.HostIDs) > 1 {
+			node.HostIDs[idx] = spare.ID
+			remainingNodes = append(remainingNodes, node)
+		}
+	}
+
+	// Assign source nodes after we know which nodes will remain in the spec.
+	for _, r := range replacements {
+		node, err := spec.Node(r.NodeName)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(node.HostIDs, r.ReplacementHostID) {
+			continue
+		}
+		source := sourceNodeFor(node, remainingNodes)
+		if source == "" {
+			return nil, fmt.Errorf("failed to replace host %s in node %s: %w", failed.ID, node.Name, ErrNoSourceNode)
+		}
+		r.SourceNode = source
+	}
+
+	spec.Nodes = remainingNodes
+
+	return &Plan{
+		Spec:         spec,
+		Replacements: replacements,
+	}, nil
+}
+
+// sourceNodeFor returns the node's existing source node if it's still in the
+// spec, otherwise it returns the first remaining node.
+func sourceNodeFor(node *database.Node, remaining []*database.Node) string {
+	var first string
+	for _, n := range remaining {
+		if n.Name == node.SourceNode {
+			return n.Name
+		}
+		if first == "" {
+			first = n.Name
+		}
+	}
+	return first
+}
+
+// selectSpareHost picks a healthy host that matches the failed host's
+// placement, supports the node's version, and isn't already used by the
+// database. Ties are broken by the number of instances on each host and then by
+// host ID so that the selection is deterministic.
+func selectSpareHost(
+	failed *host.Host,
+	hosts []*host.Host,
+	version *ds.PgEdgeVersion,
+	used ds.Set[string],
+	instanceCounts map[string]int,
+) (*host.Host, error) {
+	var candidates []*host.Host
+	for _, h := range hosts {
+		if used.Has(h.ID) || !matchesPlacement(failed, h) {
+			continue
+		}
+		if h.Status == nil || h.Status.State != host.HostStateHealthy {
+			continue
+		}
+		if version != nil && !h.Supports(version) {
+			continue
+		}
+		candidates = append(candidates, h)
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoSpareHost
+	}
+
+	slices.SortFunc(candidates, func(a, b *host.Host) int {
+		if c := instanceCounts[a.ID] - instanceCounts[b.ID]; c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return candidates[0], nil
+}
+
+func matchesPlacement(failed, candidate *host.Host) bool {
+	if failed.Orchestrator != candidate.Orchestrator {
+		return false
+	}
+	if failed.Cohort == nil {
+		return true
+	}
+	return candidate.Cohort != nil && candidate.Cohort.Type == failed.Cohort.Type
+}
+
+func nodeVersions(spec *database.Spec) (map[string]*ds.PgEdgeVersion, error) {
+	nodes, err := spec.NodeInstances()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute node instances: %w", err)
+	}
+	versions := make(map[string]*ds.PgEdgeVersion, len(nodes))
+	for _, node := range nodes {
+		if len(node.Instances) > 0 {
+			versions[node.NodeName] = node.Instances[0].PgEdgeVersion
+		}
+	}
+	return versions, nil
+}
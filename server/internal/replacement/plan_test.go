@@ -0,0 +1,157 @@
+package replacement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/host"
+)
+
+func TestFailedHosts(t *testing.T) {
+	now := time.Now()
+	hosts := []*host.Host{
+		testHost("host-1", host.HostStateHealthy, now),
+		testHost("host-2", host.HostStateUnreachable, now.Add(-2*time.Minute)),
+		testHost("host-3", host.HostStateUnreachable, now.Add(-20*time.Minute)),
+		testHost("host-4", host.HostStateUnknown, time.Time{}),
+	}
+
+	failed := failedHosts(hosts, 15*time.Minute, now)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "host-3", failed[0].ID)
+}
+
+func TestPlanReplacement(t *testing.T) {
+	now := time.Now()
+
+	t.Run("replaces replica in place", func(t *testing.T) {
+		failed := testHost("host-2", host.HostStateUnreachable, now.Add(-time.Hour))
+		hosts := []*host.Host{
+			testHost("host-1", host.HostStateHealthy, now),
+			failed,
+			testHost("host-3", host.HostStateHealthy, now),
+			testHost("host-4", host.HostStateHealthy, now),
+			testHost("host-5", host.HostStateHealthy, now),
+		}
+		spec := testSpec(
+			&database.Node{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+			&database.Node{Name: "n2", HostIDs: []string{"host-3"}},
+		)
+		// host-4 has more instances than host-5, so host-5 is preferred.
+		counts := map[string]int{"host-4": 2, "host-5": 1}
+
+		plan, err := planReplacement(spec, failed, hosts, counts)
+		require.NoError(t, err)
+
+		require.Len(t, plan.Spec.Nodes, 2)
+		assert.Equal(t, []string{"host-1", "host-5"}, plan.Spec.Nodes[0].HostIDs)
+		assert.Equal(t, []string{"host-3"}, plan.Spec.Nodes[1].HostIDs)
+		assert.Equal(t, []*Replacement{
+			{
+				NodeName:          "n1",
+				FailedHostID:      "host-2",
+				ReplacementHostID: "host-5",
+			},
+		}, plan.Replacements)
+		assert.Empty(t, plan.Pending())
+		assert.Equal(t, 2, counts["host-5"])
+
+		// The original spec is not modified
+		assert.Equal(t, []string{"host-1", "host-2"}, spec.Nodes[0].HostIDs)
+	})
+
+	t.Run("removes single-host node and uses a source node", func(t *testing.T) {
+		failed := testHost("host-2", host.HostStateUnreachable, now.Add(-time.Hour))
+		hosts := []*host.Host{
+			testHost("host-1", host.HostStateHealthy, now),
+			failed,
+			testHost("host-3", host.HostStateHealthy, now),
+		}
+		spec := testSpec(
+			&database.Node{Name: "n1", HostIDs: []string{"host-1"}},
+			&database.Node{Name: "n2", HostIDs: []string{"host-2"}},
+		)
+
+		plan, err := planReplacement(spec, failed, hosts, map[string]int{})
+		require.NoError(t, err)
+
+		require.Len(t, plan.Spec.Nodes, 1)
+		assert.Equal(t, "n1", plan.Spec.Nodes[0].Name)
+		assert.Equal(t, []*Replacement{
+			{
+				NodeName:          "n2",
+				FailedHostID:      "host-2",
+				ReplacementHostID: "host-3",
+				SourceNode:        "n1",
+			},
+		}, plan.Pending())
+	})
+
+	t.Run("skips unhealthy, mismatched, and unsupported hosts", func(t *testing.T) {
+		failed := testHost("host-2", host.HostStateUnreachable, now.Add(-time.Hour))
+		otherOrchestrator := testHost("host-3", host.HostStateHealthy, now)
+		otherOrchestrator.Orchestrator = config.OrchestratorSystemD
+		unsupported := testHost("host-4", host.HostStateHealthy, now)
+		unsupported.SupportedPgEdgeVersions = []*ds.PgEdgeVersion{
+			ds.MustParsePgEdgeVersion("16", "5"),
+		}
+		hosts := []*host.Host{
+			testHost("host-1", host.HostStateHealthy, now),
+			failed,
+			otherOrchestrator,
+			unsupported,
+			testHost("host-5", host.HostStateDegraded, now),
+		}
+		spec := testSpec(
+			&database.Node{Name: "n1", HostIDs: []string{"host-1", "host-2"}},
+		)
+
+		_, err := planReplacement(spec, failed, hosts, map[string]int{})
+		assert.ErrorIs(t, err, ErrNoSpareHost)
+	})
+
+	t.Run("fails without a source node", func(t *testing.T) {
+		failed := testHost("host-1", host.HostStateUnreachable, now.Add(-time.Hour))
+		hosts := []*host.Host{
+			failed,
+			testHost("host-2", host.HostStateHealthy, now),
+		}
+		spec := testSpec(
+			&database.Node{Name: "n1", HostIDs: []string{"host-1"}},
+		)
+
+		_, err := planReplacement(spec, failed, hosts, map[string]int{})
+		assert.ErrorIs(t, err, ErrNoSourceNode)
+	})
+}
+
+func testSpec(nodes ...*database.Node) *database.Spec {
+	return &database.Spec{
+		DatabaseID:      "database-1",
+		DatabaseName:    "app",
+		PostgresVersion: "17",
+		SpockVersion:    "5",
+		Nodes:           nodes,
+	}
+}
+
+func testHost(id string, state host.HostState, updatedAt time.Time) *host.Host {
+	return &host.Host{
+		ID:           id,
+		Orchestrator: config.OrchestratorSwarm,
+		SupportedPgEdgeVersions: []*ds.PgEdgeVersion{
+			ds.MustParsePgEdgeVersion("17", "5"),
+		},
+		Status: &host.HostStatus{
+			HostID:    id,
+			UpdatedAt: updatedAt,
+			State:     state,
+		},
+	}
+}
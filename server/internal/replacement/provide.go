@@ -0,0 +1,87 @@
+package replacement
+
+import (
+	"time"
+
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows"
+)
+
+const electionName election.Name = "host-replacement"
+const electionTTL time.Duration = 30 * time.Second
+
+func Provide(i *do.Injector) {
+	provideStore(i)
+	provideService(i)
+}
+
+func provideService(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		dbSvc, err := do.Invoke[*database.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		hostSvc, err := do.Invoke[*host.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		taskSvc, err := do.Invoke[*task.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		workflowSvc, err := do.Invoke[*workflows.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		store, err := do.Invoke[*Store](i)
+		if err != nil {
+			return nil, err
+		}
+		electionSvc, err := do.Invoke[*election.Service](i)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := electionSvc.NewCandidate(electionName, cfg.HostID, electionTTL)
+		return NewService(
+			cfg.HostReplacement,
+			loggerFactory.Logger(logging.ComponentReplacement),
+			dbSvc,
+			hostSvc,
+			taskSvc,
+			workflowSvc,
+			store,
+			candidate,
+		), nil
+	})
+}
+
+func provideStore(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Store, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		client, err := do.Invoke[*clientv3.Client](i)
+		if err != nil {
+			return nil, err
+		}
+		return NewStore(client, cfg.EtcdKeyRoot), nil
+	})
+}
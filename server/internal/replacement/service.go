@@ -0,0 +1,342 @@
+package replacement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/monitor"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows"
+)
+
+var _ do.Shutdownable = (*Service)(nil)
+
+// Service automatically replaces instances on hosts that have been unreachable
+// for longer than the configured threshold. It only performs replacements
+// while it holds the replacement election so that each failure is handled
+// once.
+type Service struct {
+	cfg         config.HostReplacement
+	logger      zerolog.Logger
+	dbSvc       *database.Service
+	hostSvc     *host.Service
+	taskSvc     *task.Service
+	workflowSvc *workflows.Service
+	store       *Store
+	candidate   *election.Candidate
+	monitor     *monitor.Monitor
+}
+
+func NewService(
+	cfg config.HostReplacement,
+	logger zerolog.Logger,
+	dbSvc *database.Service,
+	hostSvc *host.Service,
+	taskSvc *task.Service,
+	workflowSvc *workflows.Service,
+	store *Store,
+	candidate *election.Candidate,
+) *Service {
+	return &Service{
+		cfg:         cfg,
+		logger:      logger,
+		dbSvc:       dbSvc,
+		hostSvc:     hostSvc,
+		taskSvc:     taskSvc,
+		workflowSvc: workflowSvc,
+		store:       store,
+		candidate:   candidate,
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		s.logger.Debug().Msg("automatic host replacement is disabled")
+		return nil
+	}
+	if err := s.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	interval := time.Duration(s.cfg.IntervalSeconds) * time.Second
+	s.monitor = monitor.NewMonitor(s.logger, interval, s.check)
+	s.monitor.Start(ctx)
+
+	return nil
+}
+
+func (s *Service) Shutdown() error {
+	if s.monitor == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), electionTTL/3)
+	defer cancel()
+
+	s.monitor.Stop()
+	if err := s.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Error() <-chan error {
+	return s.candidate.Error()
+}
+
+func (s *Service) check(ctx context.Context) error {
+	if !s.candidate.IsLeader() {
+		return nil
+	}
+
+	var errs []error
+	if err := s.addPendingNodes(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if err := s.replaceFailedHosts(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) replaceFailedHosts(ctx context.Context) error {
+	hosts, err := s.hostSvc.GetAllHosts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get hosts: %w", err)
+	}
+	threshold := time.Duration(s.cfg.UnreachableThresholdSeconds) * time.Second
+	failed := failedHosts(hosts, threshold, time.Now())
+	if len(failed) == 0 {
+		return nil
+	}
+
+	dbs, err := s.dbSvc.GetDatabases(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get databases: %w", err)
+	}
+	instanceCounts := map[string]int{}
+	for _, db := range dbs {
+		for _, node := range db.Spec.Nodes {
+			for _, hostID := range node.HostIDs {
+				instanceCounts[hostID]++
+			}
+		}
+	}
+
+	// Each database is updated at most once per check because the update
+	// moves it out of a modifiable state.
+	updated := map[string]bool{}
+
+	var errs []error
+	for _, h := range failed {
+		for _, db := range dbs {
+			if updated[db.DatabaseID] || !db.Spec.HasHost(h.ID) {
+				continue
+			}
+			if !database.DatabaseStateModifiable(db.State) {
+				s.logger.Debug().
+					Str("database_id", db.DatabaseID).
+					Str("host_id", h.ID).
+					Str("database_state", string(db.State)).
+					Msg("skipping replacement for database that is not in a modifiable state")
+				continue
+			}
+			if err := s.replaceHost(ctx, db, h, hosts, instanceCounts); err != nil {
+				errs = append(errs, fmt.Errorf("failed to replace host %s in database %s: %w", h.ID, db.DatabaseID, err))
+				continue
+			}
+			updated[db.DatabaseID] = true
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) replaceHost(
+	ctx context.Context,
+	db *database.Database,
+	failed *host.Host,
+	hosts []*host.Host,
+	instanceCounts map[string]int,
+) error {
+	plan, err := planReplacement(db.Spec, failed, hosts, instanceCounts)
+	if err != nil {
+		return err
+	}
+
+	// Pending nodes are recorded first. If the update fails to start, the
+	// pending node is discarded on the next check because the node will still
+	// be in the spec.
+	for _, r := range plan.Pending() {
+		node, err := db.Spec.Node(r.NodeName)
+		if err != nil {
+			return err
+		}
+		err = s.store.Put(&StoredPendingNode{
+			DatabaseID:        db.DatabaseID,
+			NodeName:          r.NodeName,
+			FailedHostID:      r.FailedHostID,
+			ReplacementHostID: r.ReplacementHostID,
+			SourceNode:        r.SourceNode,
+			Node:              node.Clone(),
+			CreatedAt:         time.Now(),
+		}).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to store pending node: %w", err)
+		}
+	}
+
+	updated, err := s.dbSvc.UpdateDatabase(ctx, database.DatabaseStateModifying, plan.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to update database: %w", err)
+	}
+
+	t, err := s.workflowSvc.UpdateDatabase(ctx, updated, false, failed.ID)
+	if err != nil {
+		return fmt.Errorf("failed to start update: %w", err)
+	}
+
+	for _, r := range plan.Replacements {
+		s.logger.Info().
+			Str("database_id", db.DatabaseID).
+			Str("node_name", r.NodeName).
+			Str("failed_host_id", r.FailedHostID).
+			Str("replacement_host_id", r.ReplacementHostID).
+			Str("source_node", r.SourceNode).
+			Stringer("task_id", t.TaskID).
+			Msg("replacing instance on failed host")
+
+		message := fmt.Sprintf("replacing instance on failed host %s with host %s", r.FailedHostID, r.ReplacementHostID)
+		if r.SourceNode != "" {
+			message = fmt.Sprintf("removing node %s from failed host %s, it will be re-added on host %s from source node %s",
+				r.NodeName, r.FailedHostID, r.ReplacementHostID, r.SourceNode)
+		}
+		s.logTaskEvent(ctx, t, task.LogEntry{
+			Message: message,
+			Fields: map[string]any{
+				"node_name":           r.NodeName,
+				"failed_host_id":      r.FailedHostID,
+				"replacement_host_id": r.ReplacementHostID,
+			},
+		})
+	}
+
+	return nil
+}
+
+// addPendingNodes re-adds nodes that were removed from their database because
+// their only host failed. Each node is added on its replacement host once the
+// database has become available again.
+func (s *Service) addPendingNodes(ctx context.Context) error {
+	pending, err := s.store.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nodes: %w", err)
+	}
+
+	var errs []error
+	for _, p := range pending {
+		if err := s.addPendingNode(ctx, p); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add node %s to database %s: %w", p.NodeName, p.DatabaseID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) addPendingNode(ctx context.Context, p *StoredPendingNode) error {
+	db, err := s.dbSvc.GetDatabase(ctx, p.DatabaseID)
+	if errors.Is(err, database.ErrDatabaseNotFound) {
+		return s.deletePending(ctx, p)
+	} else if err != nil {
+		return fmt.Errorf("failed to get database: %w", err)
+	}
+	if db.State != database.DatabaseStateAvailable {
+		// Wait for the removal, or any other in-progress update, to finish.
+		return nil
+	}
+	if _, err := db.Spec.Node(p.NodeName); err == nil {
+		// The node was already re-added, e.g. by a user.
+		return s.deletePending(ctx, p)
+	}
+
+	replacementHost, err := s.hostSvc.GetHost(ctx, p.ReplacementHostID)
+	if err != nil {
+		return fmt.Errorf("failed to get replacement host: %w", err)
+	}
+	if replacementHost.Status.State != host.HostStateHealthy {
+		s.logger.Warn().
+			Str("database_id", p.DatabaseID).
+			Str("node_name", p.NodeName).
+			Str("replacement_host_id", p.ReplacementHostID).
+			Msg("replacement host is not healthy, waiting to add node")
+		return nil
+	}
+
+	node := p.Node.Clone()
+	node.HostIDs = []string{p.ReplacementHostID}
+	node.SourceNode = sourceNodeFor(&database.Node{SourceNode: p.SourceNode}, db.Spec.Nodes)
+	if node.SourceNode == "" {
+		return ErrNoSourceNode
+	}
+	node.RestoreConfig = nil
+
+	spec := db.Spec.Clone()
+	spec.Nodes = append(spec.Nodes, node)
+
+	updated, err := s.dbSvc.UpdateDatabase(ctx, database.DatabaseStateModifying, spec)
+	if err != nil {
+		return fmt.Errorf("failed to update database: %w", err)
+	}
+	t, err := s.workflowSvc.UpdateDatabase(ctx, updated, false)
+	if err != nil {
+		return fmt.Errorf("failed to start update: %w", err)
+	}
+
+	s.logger.Info().
+		Str("database_id", p.DatabaseID).
+		Str("node_name", p.NodeName).
+		Str("replacement_host_id", p.ReplacementHostID).
+		Str("source_node", node.SourceNode).
+		Stringer("task_id", t.TaskID).
+		Msg("adding replacement node")
+	s.logTaskEvent(ctx, t, task.LogEntry{
+		Message: fmt.Sprintf("adding node %s on host %s to replace failed host %s", p.NodeName, p.ReplacementHostID, p.FailedHostID),
+		Fields: map[string]any{
+			"node_name":           p.NodeName,
+			"failed_host_id":      p.FailedHostID,
+			"replacement_host_id": p.ReplacementHostID,
+			"source_node":         node.SourceNode,
+		},
+	})
+
+	return s.deletePending(ctx, p)
+}
+
+func (s *Service) deletePending(ctx context.Context, p *StoredPendingNode) error {
+	if _, err := s.store.Delete(p).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete pending node: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) logTaskEvent(ctx context.Context, t *task.Task, entry task.LogEntry) {
+	err := s.taskSvc.AddLogEntry(ctx, t.Scope, t.EntityID, t.TaskID, entry)
+	if err != nil {
+		// These log entries are informational, so it's safe to treat this
+		// error as non-fatal.
+		s.logger.Err(err).
+			Stringer("task_id", t.TaskID).
+			Msg("failed to add task log entry")
+	}
+}
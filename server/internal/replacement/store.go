@@ -0,0 +1,58 @@
+package replacement
+
+import (
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// StoredPendingNode records a node that was removed from its database because
+// its only instance was on a failed host. The node is added back on its
+// replacement host, populated from SourceNode, once the removal has completed.
+type StoredPendingNode struct {
+	storage.StoredValue
+	DatabaseID        string         `json:"database_id"`
+	NodeName          string         `json:"node_name"`
+	FailedHostID      string         `json:"failed_host_id"`
+	ReplacementHostID string         `json:"replacement_host_id"`
+	SourceNode        string         `json:"source_node"`
+	Node              *database.Node `json:"node"`
+	CreatedAt         time.Time      `json:"created_at"`
+}
+
+type Store struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewStore(client *clientv3.Client, root string) *Store {
+	return &Store{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *Store) Prefix() string {
+	return storage.Prefix("/", s.root, "pending_replacements")
+}
+
+func (s *Store) Key(databaseID, nodeName string) string {
+	return storage.Key(s.Prefix(), databaseID, nodeName)
+}
+
+func (s *Store) GetAll() storage.GetMultipleOp[*StoredPendingNode] {
+	return storage.NewGetPrefixOp[*StoredPendingNode](s.client, s.Prefix())
+}
+
+func (s *Store) Put(item *StoredPendingNode) storage.PutOp[*StoredPendingNode] {
+	key := s.Key(item.DatabaseID, item.NodeName)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *Store) Delete(item *StoredPendingNode) storage.DeleteOp {
+	key := s.Key(item.DatabaseID, item.NodeName)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
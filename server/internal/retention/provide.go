@@ -0,0 +1,54 @@
+package retention
+
+import (
+	"time"
+
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows"
+)
+
+const electionName election.Name = "retention"
+const electionTTL time.Duration = 30 * time.Second
+
+func Provide(i *do.Injector) {
+	provideService(i)
+}
+
+func provideService(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		taskSvc, err := do.Invoke[*task.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		workflowSvc, err := do.Invoke[*workflows.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		electionSvc, err := do.Invoke[*election.Service](i)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := electionSvc.NewCandidate(electionName, cfg.HostID, electionTTL)
+		return NewService(
+			cfg.Retention,
+			loggerFactory.Logger(logging.ComponentRetention),
+			taskSvc,
+			workflowSvc,
+			candidate,
+		), nil
+	})
+}
@@ -0,0 +1,144 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cschleiden/go-workflows/backend"
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/monitor"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows"
+)
+
+var _ do.Shutdownable = (*Service)(nil)
+
+// Service periodically removes completed tasks, their logs, and finished
+// workflow instances that are no longer retained by the configured policy.
+// Removals only run while the service holds the retention election so that
+// only one host prunes data at a time.
+type Service struct {
+	cfg         config.Retention
+	logger      zerolog.Logger
+	taskSvc     *task.Service
+	workflowSvc *workflows.Service
+	candidate   *election.Candidate
+	monitor     *monitor.Monitor
+}
+
+func NewService(
+	cfg config.Retention,
+	logger zerolog.Logger,
+	taskSvc *task.Service,
+	workflowSvc *workflows.Service,
+	candidate *election.Candidate,
+) *Service {
+	return &Service{
+		cfg:         cfg,
+		logger:      logger,
+		taskSvc:     taskSvc,
+		workflowSvc: workflowSvc,
+		candidate:   candidate,
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		s.logger.Debug().Msg("retention is disabled")
+		return nil
+	}
+	if err := s.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	interval := time.Duration(s.cfg.IntervalSeconds) * time.Second
+	s.monitor = monitor.NewMonitor(s.logger, interval, s.prune)
+	s.monitor.Start(ctx)
+
+	return nil
+}
+
+func (s *Service) Shutdown() error {
+	if s.monitor == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), electionTTL/3)
+	defer cancel()
+
+	s.monitor.Stop()
+	if err := s.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Error() <-chan error {
+	return s.candidate.Error()
+}
+
+func (s *Service) policy() task.RetentionPolicy {
+	return task.RetentionPolicy{
+		MaxAge:       time.Duration(s.cfg.MaxAgeSeconds) * time.Second,
+		MaxPerEntity: int(s.cfg.MaxTasksPerEntity),
+	}
+}
+
+func (s *Service) prune(ctx context.Context) error {
+	if !s.candidate.IsLeader() {
+		return nil
+	}
+
+	now := time.Now()
+	policy := s.policy()
+
+	expired, err := s.taskSvc.ExpiredTasks(ctx, policy, now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var removed int
+	for _, t := range expired {
+		// We remove the workflow first so that we don't lose track of it if
+		// the task removal fails.
+		err := s.workflowSvc.RemoveTaskWorkflow(ctx, t)
+		if errors.Is(err, backend.ErrInstanceNotFinished) {
+			// A task's workflow can briefly outlive the task. We'll remove
+			// both on the next run.
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove workflow for task %s: %w", t.TaskID, err))
+			continue
+		}
+		err = s.taskSvc.DeleteTask(ctx, t.Scope, t.EntityID, t.TaskID)
+		if err != nil && !errors.Is(err, task.ErrTaskNotFound) {
+			errs = append(errs, fmt.Errorf("failed to remove task %s: %w", t.TaskID, err))
+			continue
+		}
+		removed++
+	}
+
+	if policy.MaxAge > 0 {
+		// This also removes the workflows that aren't associated with a task,
+		// such as the workflows for internal operations.
+		if err := s.workflowSvc.RemoveFinishedWorkflows(ctx, now.Add(-policy.MaxAge)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if removed > 0 {
+		s.logger.Info().
+			Int("removed_tasks", removed).
+			Msg("removed expired tasks")
+	}
+
+	return errors.Join(errs...)
+}
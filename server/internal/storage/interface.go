@@ -113,13 +113,15 @@ const (
 // Event is generated by a modification to the watched key.
 type Event[V Value] struct {
 	// Err will be non-nil when Type is "error".
-	Err      error
-	Type     EventType
-	Key      string
-	Value    V
-	IsCreate bool
-	IsModify bool
-	Revision int64
+	Err   error
+	Type  EventType
+	Key   string
+	Value V
+	// PrevValue is only populated for watches that use clientv3.WithPrevKV().
+	PrevValue V
+	IsCreate  bool
+	IsModify  bool
+	Revision  int64
 }
 
 // WatchOp watches one or more keys for modifications.
@@ -22,6 +22,7 @@ type watchOp[V Value] struct {
 	cancel   context.CancelFunc
 	errCh    chan error
 	running  atomic.Bool
+	skipLoad bool
 }
 
 func NewWatchOp[V Value](client *clientv3.Client, key string, options ...clientv3.OpOption) WatchOp[V] {
@@ -47,6 +48,39 @@ func NewWatchPrefixOp[V Value](client *clientv3.Client, key string, options ...c
 	}
 }
 
+// NewWatchPrefixChangesOp is like NewWatchPrefixOp, except that it skips the
+// initial Get of current items and only calls handle for modifications that
+// happen after Watch is called. Pass clientv3.WithPrevKV() to populate each
+// event's PrevValue.
+func NewWatchPrefixChangesOp[V Value](client *clientv3.Client, key string, options ...clientv3.OpOption) WatchOp[V] {
+	allOptions := []clientv3.OpOption{clientv3.WithPrefix()}
+	allOptions = append(allOptions, options...)
+
+	return &watchOp[V]{
+		client:   client,
+		key:      ensureTrailingSlash(key),
+		options:  allOptions,
+		errCh:    make(chan error, 1),
+		skipLoad: true,
+	}
+}
+
+// loadRevision stores the current revision without fetching any items so that
+// the watch starts from the next modification.
+func (o *watchOp[V]) loadRevision(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	resp, err := o.client.Get(ctx, o.key, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("failed to get current revision for watch: %w", err)
+	}
+
+	o.revision = resp.Header.Revision
+
+	return nil
+}
+
 // load performs an initial Get of the current items at the watched key or
 // prefix, calls handle for each, stores the revision from the response header.
 func (o *watchOp[V]) load(ctx context.Context, handle func(e *Event[V]) error) error {
@@ -105,7 +139,11 @@ func (o *watchOp[V]) Watch(ctx context.Context, handle func(e *Event[V]) error)
 	}
 	o.running.Store(true)
 
-	if err := o.load(ctx, handle); err != nil {
+	if o.skipLoad {
+		if err := o.loadRevision(ctx); err != nil {
+			return err
+		}
+	} else if err := o.load(ctx, handle); err != nil {
 		return err
 	}
 	go func() {
@@ -229,6 +267,19 @@ func convertEvent[V Value](in *clientv3.Event, revision int64) *Event[V] {
 		val = v
 	}
 
+	var prevVal V
+	if in.PrevKv != nil && len(in.PrevKv.Value) > 0 {
+		v, err := decodeKV[V](in.PrevKv)
+		if err != nil {
+			return &Event[V]{
+				Type:     EventTypeError,
+				Err:      err,
+				Revision: revision,
+			}
+		}
+		prevVal = v
+	}
+
 	var eventType EventType
 	switch in.Type {
 	case clientv3.EventTypeDelete:
@@ -240,11 +291,12 @@ func convertEvent[V Value](in *clientv3.Event, revision int64) *Event[V] {
 	}
 
 	return &Event[V]{
-		Type:     eventType,
-		Key:      key,
-		Value:    val,
-		IsCreate: in.IsCreate(),
-		IsModify: in.IsModify(),
-		Revision: revision,
+		Type:      eventType,
+		Key:       key,
+		Value:     val,
+		PrevValue: prevVal,
+		IsCreate:  in.IsCreate(),
+		IsModify:  in.IsModify(),
+		Revision:  revision,
 	}
 }
@@ -3,6 +3,8 @@ package storage_test
 import (
 	"testing"
 
+	clientv3 "go.etcd.io/etcd/client/v3"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,3 +103,49 @@ func TestWatchOp(t *testing.T) {
 		require.ErrorIs(t, <-watch.Error(), sentinel)
 	})
 }
+
+func TestWatchPrefixChangesOp(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+
+	ctx := t.Context()
+	prefix := storage.Prefix(uuid.NewString())
+	existingKey := storage.Key(prefix, "existing")
+	newKey := storage.Key(prefix, "new")
+
+	// Pre-create a key before starting the watch.
+	err := storage.NewCreateOp(client, existingKey, &TestValue{SomeField: "before"}).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	watch := storage.NewWatchPrefixChangesOp[*TestValue](client, prefix, clientv3.WithPrevKV())
+
+	received := make(chan *storage.Event[*TestValue], 2)
+	handler := func(e *storage.Event[*TestValue]) error {
+		received <- e
+		return nil
+	}
+	require.NoError(t, watch.Watch(ctx, handler))
+	t.Cleanup(watch.Close)
+
+	// The existing item is not delivered, so the first event is the update.
+	err = storage.NewPutOp(client, existingKey, &TestValue{SomeField: "after"}).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	e := <-received
+	assert.Equal(t, storage.EventTypePut, e.Type)
+	assert.Equal(t, existingKey, e.Key)
+	assert.Equal(t, "after", e.Value.SomeField)
+	require.NotNil(t, e.PrevValue)
+	assert.Equal(t, "before", e.PrevValue.SomeField)
+
+	err = storage.NewCreateOp(client, newKey, &TestValue{SomeField: "created"}).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	e = <-received
+	assert.Equal(t, newKey, e.Key)
+	assert.True(t, e.IsCreate)
+	assert.Nil(t, e.PrevValue)
+}
@@ -13,14 +13,38 @@ func Provide(i *do.Injector) {
 
 func provideService(i *do.Injector) {
 	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
 		store, err := do.Invoke[*Store](i)
 		if err != nil {
 			return nil, err
 		}
-		return NewService(store), nil
+		return NewService(store, concurrencyLimits(cfg.TaskConcurrency)), nil
 	})
 }
 
+func concurrencyLimits(cfg config.TaskConcurrency) ConcurrencyLimits {
+	limits := ConcurrencyLimits{
+		Limit: Limit{
+			MaxPerHost: int(cfg.MaxPerHost),
+			MaxGlobal:  int(cfg.MaxGlobal),
+		},
+		Types: make(map[Type]TypeLimit, len(cfg.TaskTypes)),
+	}
+	for taskType, t := range cfg.TaskTypes {
+		limits.Types[Type(taskType)] = TypeLimit{
+			Limit: Limit{
+				MaxPerHost: int(t.MaxPerHost),
+				MaxGlobal:  int(t.MaxGlobal),
+			},
+			Priority: t.Priority,
+		}
+	}
+	return limits
+}
+
 func provideStore(i *do.Injector) {
 	do.Provide(i, func(i *do.Injector) (*Store, error) {
 		cfg, err := do.Invoke[config.Config](i)
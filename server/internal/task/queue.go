@@ -0,0 +1,259 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// Limit caps the number of tasks that can run at once. A zero value means
+// that there is no limit.
+type Limit struct {
+	MaxPerHost int
+	MaxGlobal  int
+}
+
+func (l Limit) enabled() bool {
+	return l.MaxPerHost > 0 || l.MaxGlobal > 0
+}
+
+// TypeLimit is the limit for a single task type. Queued tasks with a higher
+// priority acquire slots before tasks with a lower priority, regardless of
+// when they were queued.
+type TypeLimit struct {
+	Limit
+	Priority int
+}
+
+// ConcurrencyLimits contains the limits that apply to all tasks as well as
+// the limits for individual task types.
+type ConcurrencyLimits struct {
+	Limit
+	Types map[Type]TypeLimit
+}
+
+func (c ConcurrencyLimits) enabled() bool {
+	if c.Limit.enabled() {
+		return true
+	}
+	for _, t := range c.Types {
+		if t.enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// maxQueueAttempts is the number of times we'll retry a queue modification
+// that conflicted with a concurrent modification.
+const maxQueueAttempts = 5
+
+// AcquireSlot attempts to acquire a concurrency slot for the given task on the
+// given hosts. The task is added to the queue if it's not already queued. When
+// the slot can't be acquired, AcquireSlot returns a human-readable reason for
+// each limit that's blocking the task. Slots are released when the task is
+// completed via UpdateTask.
+//
+// Child tasks always acquire a slot immediately because they run within their
+// parent's slot, and making them wait for it would deadlock.
+func (s *Service) AcquireSlot(ctx context.Context, task *Task, hostIDs []string) (bool, []string, error) {
+	if task.ParentID != uuid.Nil || !s.limits.enabled() {
+		return true, nil, nil
+	}
+
+	for attempt := 1; ; attempt++ {
+		acquired, reasons, err := s.tryAcquireSlot(ctx, task, hostIDs)
+		if errors.Is(err, storage.ErrOperationConstraintViolated) && attempt < maxQueueAttempts {
+			continue
+		} else if err != nil {
+			return false, nil, fmt.Errorf("failed to acquire task slot: %w", err)
+		}
+
+		return acquired, reasons, nil
+	}
+}
+
+func (s *Service) tryAcquireSlot(ctx context.Context, task *Task, hostIDs []string) (bool, []string, error) {
+	version, err := s.Store.TaskQueue.GetVersion().Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		version = nil
+	} else if err != nil {
+		return false, nil, fmt.Errorf("failed to get task queue version: %w", err)
+	}
+	entries, err := s.Store.TaskQueue.GetAll().Exec(ctx)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get task queue: %w", err)
+	}
+
+	var entry *StoredQueueEntry
+	for _, e := range entries {
+		if e.TaskID == task.TaskID {
+			entry = e
+			break
+		}
+	}
+	if entry != nil && entry.AcquiredAt != nil {
+		return true, nil, nil
+	}
+
+	now := time.Now()
+
+	var ops []storage.TxnOperation
+	var active []*StoredQueueEntry
+	for _, e := range entries {
+		if e.TaskID == task.TaskID {
+			continue
+		}
+		stale, err := s.isStaleQueueEntry(ctx, e)
+		if err != nil {
+			return false, nil, err
+		}
+		if stale {
+			// The task completed or was deleted without releasing its slot.
+			ops = append(ops, s.Store.TaskQueue.DeleteByKey(e.TaskID))
+			continue
+		}
+		active = append(active, e)
+	}
+
+	changed := false
+	if entry == nil {
+		entry = &StoredQueueEntry{
+			Scope:      task.Scope,
+			EntityID:   task.EntityID,
+			TaskID:     task.TaskID,
+			Type:       task.Type,
+			HostIDs:    hostIDs,
+			Priority:   s.limits.Types[task.Type].Priority,
+			EnqueuedAt: now,
+		}
+		changed = true
+	}
+
+	reasons := blockers(entry, active, s.limits)
+	acquired := len(reasons) == 0
+	if acquired {
+		entry.AcquiredAt = &now
+		changed = true
+	}
+	if changed {
+		ops = append(ops, s.Store.TaskQueue.Put(entry))
+	}
+	if len(ops) == 0 {
+		return acquired, reasons, nil
+	}
+
+	if version == nil {
+		ops = append(ops, s.Store.TaskQueue.CreateVersion(&StoredQueueVersion{
+			UpdatedAt: now,
+		}))
+	} else {
+		version.UpdatedAt = now
+		ops = append(ops, s.Store.TaskQueue.UpdateVersion(version))
+	}
+	if err := s.Store.Txn(ops...).Commit(ctx); err != nil {
+		return false, nil, err
+	}
+
+	return acquired, reasons, nil
+}
+
+func (s *Service) isStaleQueueEntry(ctx context.Context, entry *StoredQueueEntry) (bool, error) {
+	t, err := s.GetTask(ctx, entry.Scope, entry.EntityID, entry.TaskID)
+	if errors.Is(err, ErrTaskNotFound) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return t.IsComplete(), nil
+}
+
+// blockers returns a reason for each limit that prevents the given entry from
+// acquiring a slot. Entries that hold a slot or that are ahead of the given
+// entry in the queue count towards each limit that applies to them. Counting
+// queued entries prevents a newer or lower-priority task from taking a slot
+// that an older or higher-priority task is waiting for.
+func blockers(entry *StoredQueueEntry, others []*StoredQueueEntry, limits ConcurrencyLimits) []string {
+	var counted []*StoredQueueEntry
+	for _, o := range others {
+		if o.TaskID == entry.TaskID {
+			continue
+		}
+		if o.AcquiredAt != nil || ahead(o, entry) {
+			counted = append(counted, o)
+		}
+	}
+
+	count := func(match func(o *StoredQueueEntry) bool) int {
+		var n int
+		for _, o := range counted {
+			if match(o) {
+				n++
+			}
+		}
+		return n
+	}
+	sameType := func(o *StoredQueueEntry) bool {
+		return o.Type == entry.Type
+	}
+	onHost := func(hostID string) func(o *StoredQueueEntry) bool {
+		return func(o *StoredQueueEntry) bool {
+			return slices.Contains(o.HostIDs, hostID)
+		}
+	}
+	all := func(o *StoredQueueEntry) bool {
+		return true
+	}
+
+	var reasons []string
+	typeLimit := limits.Types[entry.Type]
+	if limit := typeLimit.MaxGlobal; limit > 0 {
+		if n := count(sameType); n >= limit {
+			reasons = append(reasons, fmt.Sprintf("%d %s tasks are running or queued ahead of this task (limit %d)", n, entry.Type, limit))
+		}
+	}
+	if limit := typeLimit.MaxPerHost; limit > 0 {
+		for _, hostID := range entry.HostIDs {
+			host := onHost(hostID)
+			n := count(func(o *StoredQueueEntry) bool {
+				return sameType(o) && host(o)
+			})
+			if n >= limit {
+				reasons = append(reasons, fmt.Sprintf("%d %s tasks are running or queued ahead of this task on host %s (limit %d)", n, entry.Type, hostID, limit))
+			}
+		}
+	}
+	if limit := limits.MaxGlobal; limit > 0 {
+		if n := count(all); n >= limit {
+			reasons = append(reasons, fmt.Sprintf("%d tasks are running or queued ahead of this task (limit %d)", n, limit))
+		}
+	}
+	if limit := limits.MaxPerHost; limit > 0 {
+		for _, hostID := range entry.HostIDs {
+			if n := count(onHost(hostID)); n >= limit {
+				reasons = append(reasons, fmt.Sprintf("%d tasks are running or queued ahead of this task on host %s (limit %d)", n, hostID, limit))
+			}
+		}
+	}
+
+	return reasons
+}
+
+// ahead returns true if a should acquire a slot before b.
+func ahead(a, b *StoredQueueEntry) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if !a.EnqueuedAt.Equal(b.EnqueuedAt) {
+		return a.EnqueuedAt.Before(b.EnqueuedAt)
+	}
+	return bytes.Compare(a.TaskID[:], b.TaskID[:]) < 0
+}
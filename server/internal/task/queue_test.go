@@ -0,0 +1,223 @@
+package task_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/task"
+)
+
+func TestServiceAcquireSlot(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+
+	createTask := func(t *testing.T, svc *task.Service, taskType task.Type) *task.Task {
+		t.Helper()
+
+		tsk, err := svc.CreateTask(t.Context(), task.Options{
+			Scope:      task.ScopeDatabase,
+			DatabaseID: "database-1",
+			Type:       taskType,
+		})
+		require.NoError(t, err)
+		return tsk
+	}
+	complete := func(t *testing.T, svc *task.Service, tsk *task.Task) {
+		t.Helper()
+
+		tsk.SetCompleted()
+		require.NoError(t, svc.UpdateTask(t.Context(), tsk))
+	}
+
+	t.Run("per-host type limit", func(t *testing.T) {
+		svc := task.NewService(task.NewStore(client, uuid.NewString()), task.ConcurrencyLimits{
+			Types: map[task.Type]task.TypeLimit{
+				task.TypeNodeBackup: {Limit: task.Limit{MaxPerHost: 1}},
+			},
+		})
+		ctx := t.Context()
+
+		first := createTask(t, svc, task.TypeNodeBackup)
+		acquired, reasons, err := svc.AcquireSlot(ctx, first, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Empty(t, reasons)
+
+		// Acquiring again is a no-op
+		acquired, _, err = svc.AcquireSlot(ctx, first, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		second := createTask(t, svc, task.TypeNodeBackup)
+		acquired, reasons, err = svc.AcquireSlot(ctx, second, []string{"host-1"})
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Equal(t, []string{
+			"1 node_backup tasks are running or queued ahead of this task on host host-1 (limit 1)",
+		}, reasons)
+
+		// Other hosts and other task types are unaffected
+		third := createTask(t, svc, task.TypeNodeBackup)
+		acquired, _, err = svc.AcquireSlot(ctx, third, []string{"host-2"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		update := createTask(t, svc, task.TypeUpdate)
+		acquired, _, err = svc.AcquireSlot(ctx, update, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		// Completing the first task releases its slot
+		complete(t, svc, first)
+		acquired, reasons, err = svc.AcquireSlot(ctx, second, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Empty(t, reasons)
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		svc := task.NewService(task.NewStore(client, uuid.NewString()), task.ConcurrencyLimits{
+			Limit: task.Limit{MaxGlobal: 1},
+			Types: map[task.Type]task.TypeLimit{
+				task.TypeFailover: {Priority: 100},
+			},
+		})
+		ctx := t.Context()
+
+		update := createTask(t, svc, task.TypeUpdate)
+		acquired, _, err := svc.AcquireSlot(ctx, update, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		backup := createTask(t, svc, task.TypeNodeBackup)
+		acquired, _, err = svc.AcquireSlot(ctx, backup, nil)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		failover := createTask(t, svc, task.TypeFailover)
+		acquired, reasons, err := svc.AcquireSlot(ctx, failover, nil)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		// The failover is only blocked by the running update
+		assert.Equal(t, []string{
+			"1 tasks are running or queued ahead of this task (limit 1)",
+		}, reasons)
+
+		complete(t, svc, update)
+
+		// The failover was queued after the backup, but it has a higher
+		// priority.
+		acquired, _, err = svc.AcquireSlot(ctx, backup, nil)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		acquired, _, err = svc.AcquireSlot(ctx, failover, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("deleted tasks are removed from the queue", func(t *testing.T) {
+		svc := task.NewService(task.NewStore(client, uuid.NewString()), task.ConcurrencyLimits{
+			Limit: task.Limit{MaxGlobal: 1},
+		})
+		ctx := t.Context()
+
+		first := createTask(t, svc, task.TypeUpdate)
+		acquired, _, err := svc.AcquireSlot(ctx, first, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		second := createTask(t, svc, task.TypeUpdate)
+		acquired, _, err = svc.AcquireSlot(ctx, second, nil)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		require.NoError(t, svc.DeleteTask(ctx, first.Scope, first.EntityID, first.TaskID))
+
+		acquired, _, err = svc.AcquireSlot(ctx, second, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("child tasks are exempt", func(t *testing.T) {
+		svc := task.NewService(task.NewStore(client, uuid.NewString()), task.ConcurrencyLimits{
+			Limit: task.Limit{MaxGlobal: 1},
+		})
+		ctx := t.Context()
+
+		parent := createTask(t, svc, task.TypeRestore)
+		acquired, _, err := svc.AcquireSlot(ctx, parent, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		child, err := svc.CreateTask(ctx, task.Options{
+			Scope:      task.ScopeDatabase,
+			ParentID:   parent.TaskID,
+			DatabaseID: "database-1",
+			Type:       task.TypeNodeRestore,
+		})
+		require.NoError(t, err)
+		acquired, _, err = svc.AcquireSlot(ctx, child, nil)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("nested tasks on another entity don't deadlock", func(t *testing.T) {
+		svc := task.NewService(task.NewStore(client, uuid.NewString()), task.ConcurrencyLimits{
+			Limit: task.Limit{MaxPerHost: 1},
+		})
+		ctx := t.Context()
+
+		// A clone holds a slot on the host and takes a backup of its source
+		// database on the same host.
+		clone := createTask(t, svc, task.TypeClone)
+		acquired, _, err := svc.AcquireSlot(ctx, clone, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		backup, err := svc.CreateTask(ctx, task.Options{
+			Scope:      task.ScopeDatabase,
+			ParentID:   clone.TaskID,
+			DatabaseID: "database-2",
+			NodeName:   "n1",
+			Type:       task.TypeNodeBackup,
+		})
+		require.NoError(t, err)
+		acquired, reasons, err := svc.AcquireSlot(ctx, backup, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Empty(t, reasons)
+
+		// Other tasks still wait for the clone's slot.
+		other := createTask(t, svc, task.TypeNodeBackup)
+		acquired, _, err = svc.AcquireSlot(ctx, other, []string{"host-1"})
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		complete(t, svc, backup)
+		acquired, _, err = svc.AcquireSlot(ctx, other, []string{"host-1"})
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		complete(t, svc, clone)
+		acquired, _, err = svc.AcquireSlot(ctx, other, []string{"host-1"})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
+
+func TestTaskUpdateStatusReason(t *testing.T) {
+	tsk := &task.Task{Status: task.StatusPending}
+
+	tsk.Update(task.UpdateQueued())
+	assert.Equal(t, task.StatusPending, tsk.Status)
+	assert.Equal(t, task.StatusReasonQueued, tsk.StatusReason)
+
+	tsk.Update(task.UpdateStart())
+	assert.Equal(t, task.StatusRunning, tsk.Status)
+	assert.Empty(t, tsk.StatusReason)
+}
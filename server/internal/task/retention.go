@@ -0,0 +1,81 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RetentionPolicy controls how long completed tasks are kept. A completed task
+// is expired when it's older than MaxAge or when it's not one of the
+// MaxPerEntity most recent completed tasks for its database or host. Zero
+// values disable the corresponding limit.
+type RetentionPolicy struct {
+	MaxAge       time.Duration
+	MaxPerEntity int
+}
+
+// ExpiredTasks returns the completed tasks that are no longer retained by the
+// given policy as of now. Tasks that are still pending or running are never
+// expired.
+func (s *Service) ExpiredTasks(ctx context.Context, policy RetentionPolicy, now time.Time) ([]*Task, error) {
+	stored, err := s.Store.Task.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	tasks := make([]*Task, len(stored))
+	for i, st := range stored {
+		tasks[i] = st.Task
+	}
+
+	return expiredTasks(tasks, policy, now), nil
+}
+
+func expiredTasks(tasks []*Task, policy RetentionPolicy, now time.Time) []*Task {
+	type entityKey struct {
+		scope    Scope
+		entityID string
+	}
+	completed := map[entityKey][]*Task{}
+	var keys []entityKey
+	for _, t := range tasks {
+		if !t.IsComplete() {
+			continue
+		}
+		key := entityKey{scope: t.Scope, entityID: t.EntityID}
+		if _, ok := completed[key]; !ok {
+			keys = append(keys, key)
+		}
+		completed[key] = append(completed[key], t)
+	}
+
+	var expired []*Task
+	for _, key := range keys {
+		entityTasks := completed[key]
+		// Newest first
+		slices.SortFunc(entityTasks, func(a, b *Task) int {
+			return b.CreatedAt.Compare(a.CreatedAt)
+		})
+		for i, t := range entityTasks {
+			if policy.MaxPerEntity > 0 && i >= policy.MaxPerEntity {
+				expired = append(expired, t)
+				continue
+			}
+			if policy.MaxAge > 0 && now.Sub(completedAt(t)) > policy.MaxAge {
+				expired = append(expired, t)
+			}
+		}
+	}
+
+	return expired
+}
+
+// completedAt returns the time that the task completed, falling back to its
+// creation time for tasks that didn't record a completion time.
+func completedAt(t *Task) time.Time {
+	if t.CompletedAt.IsZero() {
+		return t.CreatedAt
+	}
+	return t.CompletedAt
+}
@@ -0,0 +1,89 @@
+package task_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+	"github.com/pgEdge/control-plane/server/internal/task"
+)
+
+func TestServiceExpiredTasks(t *testing.T) {
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	store := task.NewStore(client, uuid.NewString())
+	svc := task.NewService(store, task.ConcurrencyLimits{})
+
+	createTask := func(t *testing.T, scope task.Scope, entityID string, age time.Duration, status task.Status) *task.Task {
+		t.Helper()
+
+		tsk := &task.Task{
+			Scope:     scope,
+			EntityID:  entityID,
+			TaskID:    uuid.New(),
+			CreatedAt: now.Add(-age),
+			Type:      task.TypeUpdate,
+			Status:    status,
+		}
+		if tsk.IsComplete() {
+			tsk.CompletedAt = tsk.CreatedAt.Add(time.Minute)
+		}
+		require.NoError(t, store.Task.Create(&task.StoredTask{Task: tsk}).Exec(t.Context()))
+		return tsk
+	}
+
+	oldDB1 := createTask(t, task.ScopeDatabase, "database-1", 40*day, task.StatusCompleted)
+	// Running tasks are never expired
+	createTask(t, task.ScopeDatabase, "database-1", 50*day, task.StatusRunning)
+	overflowDB1 := createTask(t, task.ScopeDatabase, "database-1", 3*day, task.StatusFailed)
+	createTask(t, task.ScopeDatabase, "database-1", 2*day, task.StatusCompleted)
+	createTask(t, task.ScopeDatabase, "database-1", 1*day, task.StatusCanceled)
+	createTask(t, task.ScopeDatabase, "database-2", 3*day, task.StatusCompleted)
+	oldHost1 := createTask(t, task.ScopeHost, "host-1", 31*day, task.StatusCompleted)
+
+	taskIDs := func(tasks []*task.Task) []uuid.UUID {
+		ids := make([]uuid.UUID, len(tasks))
+		for i, tsk := range tasks {
+			ids[i] = tsk.TaskID
+		}
+		return ids
+	}
+
+	t.Run("age and count", func(t *testing.T) {
+		expired, err := svc.ExpiredTasks(t.Context(), task.RetentionPolicy{
+			MaxAge:       30 * day,
+			MaxPerEntity: 2,
+		}, now)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{
+			oldDB1.TaskID,
+			overflowDB1.TaskID,
+			oldHost1.TaskID,
+		}, taskIDs(expired))
+	})
+
+	t.Run("age only", func(t *testing.T) {
+		expired, err := svc.ExpiredTasks(t.Context(), task.RetentionPolicy{
+			MaxAge: 30 * day,
+		}, now)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{
+			oldDB1.TaskID,
+			oldHost1.TaskID,
+		}, taskIDs(expired))
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		expired, err := svc.ExpiredTasks(t.Context(), task.RetentionPolicy{}, now)
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+	})
+}
@@ -25,12 +25,14 @@ var ErrTaskNotFound = errors.New("task not found")
 type Service struct {
 	Store    *Store
 	registry *watcherRegistry
+	limits   ConcurrencyLimits
 }
 
-func NewService(store *Store) *Service {
+func NewService(store *Store, limits ConcurrencyLimits) *Service {
 	return &Service{
 		Store:    store,
 		registry: newWatcherRegistry(),
+		limits:   limits,
 	}
 }
 
@@ -58,7 +60,12 @@ func (s *Service) UpdateTask(ctx context.Context, task *Task) error {
 	}
 	stored.Task = task
 
-	err = s.Store.Task.Update(stored).Exec(ctx)
+	ops := []storage.TxnOperation{s.Store.Task.Update(stored)}
+	if task.IsComplete() && s.limits.enabled() {
+		// Release the task's concurrency slot, if it has one.
+		ops = append(ops, s.Store.TaskQueue.DeleteByKey(task.TaskID))
+	}
+	err = s.Store.Txn(ops...).Commit(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create task: %w", err)
 	}
@@ -17,7 +17,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Create and get database task", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create database task
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -42,7 +42,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Create and get host task", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create host task
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -66,7 +66,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Get tasks by entity", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create multiple database tasks
 		for i := 0; i < 3; i++ {
@@ -119,7 +119,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Add and get task log", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create database task
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -159,7 +159,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Update task", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create task
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -183,7 +183,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Delete task", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create task
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -204,7 +204,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Delete all tasks", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create multiple tasks
 		for i := 0; i < 3; i++ {
@@ -228,7 +228,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Delete task logs", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create task with logs
 		tsk, err := svc.CreateTask(t.Context(), task.Options{
@@ -258,7 +258,7 @@ func TestServiceScoped(t *testing.T) {
 
 	t.Run("Delete all task logs", func(t *testing.T) {
 		store := task.NewStore(client, uuid.NewString())
-		svc := task.NewService(store)
+		svc := task.NewService(store, task.ConcurrencyLimits{})
 
 		// Create multiple tasks with logs
 		for i := 0; i < 2; i++ {
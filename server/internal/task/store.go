@@ -10,6 +10,7 @@ type Store struct {
 	client         *clientv3.Client
 	Task           *TaskStore
 	TaskLogMessage *TaskLogEntryStore
+	TaskQueue      *TaskQueueStore
 }
 
 func NewStore(client *clientv3.Client, root string) *Store {
@@ -17,6 +18,7 @@ func NewStore(client *clientv3.Client, root string) *Store {
 		client:         client,
 		Task:           NewTaskStore(client, root),
 		TaskLogMessage: NewTaskLogEntryStore(client, root),
+		TaskQueue:      NewTaskQueueStore(client, root),
 	}
 }
 
@@ -28,19 +28,27 @@ func (t Type) String() string {
 }
 
 const (
-	TypeCreate          Type = "create"
-	TypeUpdate          Type = "update"
-	TypeDelete          Type = "delete"
-	TypeNodeBackup      Type = "node_backup"
-	TypeRestore         Type = "restore"
-	TypeNodeRestore     Type = "node_restore"
-	TypeRestartInstance Type = "restart_instance"
-	TypeStopInstance    Type = "stop_instance"
-	TypeStartInstance   Type = "start_instance"
-	TypeSwitchover      Type = "switchover"
-	TypeFailover        Type = "failover"
-	TypeRemoveHost      Type = "remove_host"
-	TypeUpgrade         Type = "upgrade"
+	TypeCreate           Type = "create"
+	TypeUpdate           Type = "update"
+	TypeDelete           Type = "delete"
+	TypeNodeBackup       Type = "node_backup"
+	TypeRestore          Type = "restore"
+	TypeNodeRestore      Type = "node_restore"
+	TypeRestartInstance  Type = "restart_instance"
+	TypeStopInstance     Type = "stop_instance"
+	TypeStartInstance    Type = "start_instance"
+	TypeSwitchover       Type = "switchover"
+	TypeFailover         Type = "failover"
+	TypeRemoveHost       Type = "remove_host"
+	TypeUpgrade          Type = "upgrade"
+	TypeUpgradeMajor     Type = "upgrade_major"
+	TypePgUpgrade        Type = "pg_upgrade"
+	TypeImportCutover    Type = "import_cutover"
+	TypeClone            Type = "clone"
+	TypeRollingRestart   Type = "rolling_restart"
+	TypeCancelSession    Type = "cancel_session"
+	TypeTerminateSession Type = "terminate_session"
+	TypeChangeEtcdMode   Type = "change_etcd_mode"
 )
 
 type Status string
@@ -80,9 +88,14 @@ type Task struct {
 	WorkflowInstanceID  string    `json:"workflow_id"`
 	WorkflowExecutionID string    `json:"workflow_execution_id"`
 	Status              Status    `json:"status"`
+	StatusReason        string    `json:"status_reason,omitempty"`
 	Error               string    `json:"error"`
 }
 
+// StatusReasonQueued indicates that a task is waiting for a concurrency slot
+// before it can start running.
+const StatusReasonQueued = "queued"
+
 func (t *Task) IsComplete() bool {
 	return completedStatuses.Has(t.Status)
 }
@@ -171,9 +184,17 @@ type UpdateOptions struct {
 	WorkflowExecutionID *string    `json:"workflow_execution_id,omitempty"`
 	CompletedAt         *time.Time `json:"completed_at,omitempty"`
 	Status              *Status    `json:"status,omitempty"`
+	StatusReason        *string    `json:"status_reason,omitempty"`
 	Error               *string    `json:"error,omitempty"`
 }
 
+// UpdateQueued marks a pending task as waiting for a concurrency slot.
+func UpdateQueued() UpdateOptions {
+	return UpdateOptions{
+		StatusReason: utils.PointerTo(StatusReasonQueued),
+	}
+}
+
 func UpdateStart() UpdateOptions {
 	return UpdateOptions{
 		Status: utils.PointerTo(StatusRunning),
@@ -225,6 +246,11 @@ func (t *Task) Update(options UpdateOptions) {
 		}
 		if options.Status != nil {
 			t.Status = *options.Status
+			// The reason only describes the previous status.
+			t.StatusReason = ""
+		}
+		if options.StatusReason != nil {
+			t.StatusReason = *options.StatusReason
 		}
 		if options.Error != nil {
 			t.Error = *options.Error
@@ -130,3 +130,13 @@ func (s *TaskLogEntryStore) DeleteByEntity(scope Scope, entityID string) storage
 func (s *TaskLogEntryStore) DeleteByDatabaseID(databaseID string) storage.DeleteOp {
 	return s.DeleteByEntity(ScopeDatabase, databaseID)
 }
+
+// WatchChanges watches for new log entries for the given scope and entity. An
+// empty scope watches all task log entries.
+func (s *TaskLogEntryStore) WatchChanges(scope Scope, entityID string) storage.WatchOp[*StoredTaskLogEntry] {
+	prefix := s.Prefix()
+	if scope != "" {
+		prefix = s.EntityPrefix(scope, entityID)
+	}
+	return storage.NewWatchPrefixChangesOp[*StoredTaskLogEntry](s.client, prefix)
+}
@@ -0,0 +1,90 @@
+package task
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+// StoredQueueEntry records a task that is waiting for, or holding, a
+// concurrency slot. AcquiredAt is nil while the task is queued.
+type StoredQueueEntry struct {
+	storage.StoredValue
+	Scope      Scope      `json:"scope"`
+	EntityID   string     `json:"entity_id"`
+	TaskID     uuid.UUID  `json:"task_id"`
+	Type       Type       `json:"type"`
+	HostIDs    []string   `json:"host_ids"`
+	Priority   int        `json:"priority"`
+	EnqueuedAt time.Time  `json:"enqueued_at"`
+	AcquiredAt *time.Time `json:"acquired_at,omitempty"`
+}
+
+// StoredQueueVersion is rewritten by every modification to the queue. Queue
+// modifications are conditioned on its version so that concurrent writers
+// can't both claim the last slot for a limit.
+type StoredQueueVersion struct {
+	storage.StoredValue
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TaskQueueStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func NewTaskQueueStore(client *clientv3.Client, root string) *TaskQueueStore {
+	return &TaskQueueStore{
+		client: client,
+		root:   root,
+	}
+}
+
+func (s *TaskQueueStore) Prefix() string {
+	return storage.Prefix("/", s.root, "task_queue")
+}
+
+func (s *TaskQueueStore) EntriesPrefix() string {
+	return storage.Prefix(s.Prefix(), "entries")
+}
+
+func (s *TaskQueueStore) Key(taskID uuid.UUID) string {
+	return storage.Key(s.EntriesPrefix(), taskID.String())
+}
+
+func (s *TaskQueueStore) VersionKey() string {
+	return storage.Key(s.Prefix(), "version")
+}
+
+func (s *TaskQueueStore) GetAll() storage.GetMultipleOp[*StoredQueueEntry] {
+	prefix := s.EntriesPrefix()
+	return storage.NewGetPrefixOp[*StoredQueueEntry](s.client, prefix)
+}
+
+func (s *TaskQueueStore) Put(item *StoredQueueEntry) storage.PutOp[*StoredQueueEntry] {
+	key := s.Key(item.TaskID)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *TaskQueueStore) DeleteByKey(taskID uuid.UUID) storage.DeleteOp {
+	key := s.Key(taskID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
+
+func (s *TaskQueueStore) GetVersion() storage.GetOp[*StoredQueueVersion] {
+	key := s.VersionKey()
+	return storage.NewGetOp[*StoredQueueVersion](s.client, key)
+}
+
+func (s *TaskQueueStore) CreateVersion(item *StoredQueueVersion) storage.PutOp[*StoredQueueVersion] {
+	key := s.VersionKey()
+	return storage.NewCreateOp(s.client, key, item)
+}
+
+func (s *TaskQueueStore) UpdateVersion(item *StoredQueueVersion) storage.PutOp[*StoredQueueVersion] {
+	key := s.VersionKey()
+	return storage.NewUpdateOp(s.client, key, item)
+}
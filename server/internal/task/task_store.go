@@ -89,6 +89,11 @@ func (s *TaskStore) GetAllByEntity(scope Scope, entityID string, options TaskLis
 	return storage.NewGetRangeOp[*StoredTask](s.client, rangeStart, rangeEnd, opOptions...)
 }
 
+func (s *TaskStore) GetAll() storage.GetMultipleOp[*StoredTask] {
+	prefix := s.Prefix()
+	return storage.NewGetPrefixOp[*StoredTask](s.client, prefix)
+}
+
 func (s *TaskStore) Create(item *StoredTask) storage.PutOp[*StoredTask] {
 	key := s.Key(item.Task.Scope, item.Task.EntityID, item.Task.TaskID)
 	return storage.NewCreateOp(s.client, key, item)
@@ -113,3 +118,14 @@ func (s *TaskStore) Watch(scope Scope, entityID string, taskID uuid.UUID) storag
 	key := s.Key(scope, entityID, taskID)
 	return storage.NewWatchOp[*StoredTask](s.client, key)
 }
+
+// WatchChanges watches for modifications to the tasks for the given scope and
+// entity, including the previous value for each modification. An empty scope
+// watches all tasks.
+func (s *TaskStore) WatchChanges(scope Scope, entityID string) storage.WatchOp[*StoredTask] {
+	prefix := s.Prefix()
+	if scope != "" {
+		prefix = s.EntityPrefix(scope, entityID)
+	}
+	return storage.NewWatchPrefixChangesOp[*StoredTask](s.client, prefix, clientv3.WithPrevKV())
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/events"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/logging"
+)
+
+const electionName election.Name = "webhooks"
+const electionTTL time.Duration = 30 * time.Second
+
+func Provide(i *do.Injector) {
+	provideStore(i)
+	provideService(i)
+}
+
+func provideService(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Service, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		loggerFactory, err := do.Invoke[*logging.Factory](i)
+		if err != nil {
+			return nil, err
+		}
+		store, err := do.Invoke[*Store](i)
+		if err != nil {
+			return nil, err
+		}
+		eventsSvc, err := do.Invoke[*events.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		hostSvc, err := do.Invoke[*host.Service](i)
+		if err != nil {
+			return nil, err
+		}
+		electionSvc, err := do.Invoke[*election.Service](i)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := electionSvc.NewCandidate(electionName, cfg.HostID, electionTTL)
+		return NewService(
+			loggerFactory.Logger(logging.ComponentWebhooks),
+			store,
+			eventsSvc,
+			hostSvc,
+			candidate,
+		), nil
+	})
+}
+
+func provideStore(i *do.Injector) {
+	do.Provide(i, func(i *do.Injector) (*Store, error) {
+		cfg, err := do.Invoke[config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		client, err := do.Invoke[*clientv3.Client](i)
+		if err != nil {
+			return nil, err
+		}
+		return NewStore(client, cfg.EtcdKeyRoot), nil
+	})
+}
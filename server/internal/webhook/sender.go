@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// maxAttempts is the number of times a delivery is attempted before it's
+	// marked as failed. With the backoff below, the last attempt is made about
+	// 20 minutes after the first.
+	maxAttempts = 8
+	// initialRetryDelay is the delay before the first retry. The delay doubles
+	// after each failed attempt, up to maxRetryDelay.
+	initialRetryDelay = 10 * time.Second
+	maxRetryDelay     = 30 * time.Minute
+	// requestTimeout bounds each delivery attempt.
+	requestTimeout = 10 * time.Second
+	// deliveryRetention is how long delivery history is kept.
+	deliveryRetention = 7 * 24 * time.Hour
+	// maxResponseBytes is how much of a failed response body is recorded in
+	// the delivery history.
+	maxResponseBytes = 512
+)
+
+const (
+	EventTypeHeader  = "X-Control-Plane-Event"
+	DeliveryIDHeader = "X-Control-Plane-Delivery"
+)
+
+// retryDelay returns how long to wait after the given number of failed
+// attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := initialRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
+
+// send makes a single delivery attempt. It returns the response status code,
+// if any, and an error if the receiver did not respond with a 2xx status.
+func send(ctx context.Context, client *http.Client, w *StoredWebhook, event *Event) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "pgedge-control-plane")
+	req.Header.Set(EventTypeHeader, event.Type.String())
+	req.Header.Set(DeliveryIDHeader, event.DeliveryID)
+	req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if len(msg) > 0 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, msg)
+	}
+	return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+}
+
+// recordAttempt updates the delivery with the outcome of an attempt.
+func (d *StoredDelivery) recordAttempt(statusCode int, err error, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.UpdatedAt = now
+	d.NextAttemptAt = nil
+
+	switch {
+	case err == nil:
+		d.Status = DeliveryStatusSucceeded
+		d.LastError = ""
+	case d.Attempts >= maxAttempts:
+		d.Status = DeliveryStatusFailed
+		d.LastError = err.Error()
+	default:
+		d.LastError = err.Error()
+		next := now.Add(retryDelay(d.Attempts))
+		d.NextAttemptAt = &next
+	}
+}
@@ -0,0 +1,693 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/samber/do"
+
+	"github.com/pgEdge/control-plane/server/internal/election"
+	"github.com/pgEdge/control-plane/server/internal/events"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/monitor"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+var _ do.Shutdownable = (*Service)(nil)
+
+// checkInterval is how often the leader checks host states and sends pending
+// deliveries.
+const checkInterval = 5 * time.Second
+
+// maxConcurrentSenders limits the number of webhooks that deliveries are sent
+// to at the same time.
+const maxConcurrentSenders = 8
+
+// Service manages webhook registrations and sends webhook deliveries. Events
+// are only captured and sent while this server holds the webhook election so
+// that each event is sent once. Deliveries are persisted before they're sent,
+// so a newly elected leader resumes any outstanding retries.
+type Service struct {
+	logger    zerolog.Logger
+	store     *Store
+	eventsSvc *events.Service
+	hostSvc   *host.Service
+	candidate *election.Candidate
+	client    *http.Client
+	monitor   *monitor.Monitor
+
+	mu    sync.Mutex
+	watch *eventWatch
+
+	sendMu      sync.Mutex
+	sendCtx     context.Context
+	sendCancel  context.CancelFunc
+	sending     map[string]struct{}
+	senderSlots chan struct{}
+}
+
+// eventWatch is a running event subscription.
+type eventWatch struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewService(
+	logger zerolog.Logger,
+	store *Store,
+	eventsSvc *events.Service,
+	hostSvc *host.Service,
+	candidate *election.Candidate,
+) *Service {
+	return &Service{
+		logger:      logger,
+		store:       store,
+		eventsSvc:   eventsSvc,
+		hostSvc:     hostSvc,
+		candidate:   candidate,
+		client:      &http.Client{},
+		sending:     map[string]struct{}{},
+		senderSlots: make(chan struct{}, maxConcurrentSenders),
+	}
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if err := s.candidate.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start candidate: %w", err)
+	}
+
+	s.monitor = monitor.NewMonitor(s.logger, checkInterval, s.check)
+	s.monitor.Start(ctx)
+
+	return nil
+}
+
+func (s *Service) Shutdown() error {
+	if s.monitor == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), electionTTL/3)
+	defer cancel()
+
+	s.monitor.Stop()
+	s.stopWatch()
+	s.stopSending()
+	if err := s.candidate.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Error() <-chan error {
+	return s.candidate.Error()
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, w *Webhook) (*Webhook, error) {
+	if err := w.validate(); err != nil {
+		return nil, err
+	}
+
+	secret := w.Secret
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	stored := &StoredWebhook{
+		ID:          uuid.NewString(),
+		URL:         w.URL,
+		Description: w.Description,
+		Secret:      secret,
+		EventTypes:  w.EventTypes,
+		DatabaseIDs: w.DatabaseIDs,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.store.Webhook.Create(stored).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to store webhook: %w", err)
+	}
+
+	created := webhookFromStored(stored)
+	created.Secret = secret
+
+	return created, nil
+}
+
+func (s *Service) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	stored, err := s.getWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	return webhookFromStored(stored), nil
+}
+
+// ListWebhooks returns all webhooks, oldest first.
+func (s *Service) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	stored, err := s.store.Webhook.GetAll().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	slices.SortFunc(stored, func(a, b *StoredWebhook) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	webhooks := make([]*Webhook, len(stored))
+	for idx, w := range stored {
+		webhooks[idx] = webhookFromStored(w)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery history. Pending deliveries
+// for the webhook are discarded by the sender.
+func (s *Service) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if _, err := s.getWebhook(ctx, webhookID); err != nil {
+		return err
+	}
+	err := s.store.Txn(
+		s.store.Webhook.DeleteByKey(webhookID),
+		s.store.Delivery.DeleteByWebhook(webhookID),
+	).Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeliveries returns up to limit of the webhook's most recent deliveries,
+// newest first.
+func (s *Service) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]*Delivery, error) {
+	if _, err := s.getWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	stored, err := s.store.Delivery.
+		GetByWebhook(webhookID, limit).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries: %w", err)
+	}
+
+	deliveries := make([]*Delivery, len(stored))
+	for idx, d := range stored {
+		deliveries[idx] = deliveryFromStored(d)
+	}
+
+	return deliveries, nil
+}
+
+func (s *Service) getWebhook(ctx context.Context, webhookID string) (*StoredWebhook, error) {
+	stored, err := s.store.Webhook.GetByKey(webhookID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, ErrWebhookNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *Service) check(ctx context.Context) error {
+	if !s.candidate.IsLeader() {
+		s.stopWatch()
+		s.stopSending()
+		return nil
+	}
+	s.startWatch(ctx)
+
+	var errs []error
+	if err := s.checkHosts(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	// Deliveries are sent in the background so that slow webhooks don't hold
+	// up the next check. Webhooks that are still being sent to from an
+	// earlier check are skipped.
+	sendCtx := s.leaderSendContext(ctx)
+	go func() {
+		if err := s.sendPending(sendCtx); err != nil {
+			s.logger.Err(err).Msg("failed to send webhook deliveries")
+		}
+	}()
+
+	return errors.Join(errs...)
+}
+
+// startWatch subscribes to database, instance, and task events if there isn't
+// already a running subscription. A subscription that has failed is restarted
+// on the next check. Changes that happen while there's no subscription, such
+// as during a leadership change, are not sent.
+func (s *Service) startWatch(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.watch != nil {
+		select {
+		case <-s.watch.done:
+			s.watch = nil
+		default:
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	w := &eventWatch{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	s.watch = w
+
+	go func() {
+		defer close(w.done)
+
+		filter := events.Filter{
+			Kinds: []events.Kind{events.KindDatabase, events.KindInstance, events.KindTask},
+		}
+		err := s.eventsSvc.Subscribe(ctx, filter, func(e *events.Event) error {
+			s.handleEvent(ctx, e)
+			return nil
+		})
+		if err != nil {
+			s.logger.Err(err).Msg("webhook event subscription failed")
+		}
+	}()
+}
+
+func (s *Service) stopWatch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.watch == nil {
+		return
+	}
+	s.watch.cancel()
+	<-s.watch.done
+	s.watch = nil
+}
+
+func (s *Service) handleEvent(ctx context.Context, e *events.Event) {
+	evt := eventFromChange(e)
+	if evt == nil {
+		return
+	}
+	if err := s.enqueue(ctx, evt); err != nil {
+		s.logger.Err(err).
+			Str("event_type", evt.Type.String()).
+			Msg("failed to enqueue webhook deliveries")
+	}
+}
+
+// eventFromChange returns the webhook event for the given change, or nil if
+// the change isn't one that webhooks are sent for.
+func eventFromChange(e *events.Event) *Event {
+	switch e.Kind {
+	case events.KindTask:
+		var eventType EventType
+		switch e.Task.Status {
+		case task.StatusCompleted:
+			eventType = EventTypeTaskCompleted
+		case task.StatusFailed:
+			eventType = EventTypeTaskFailed
+		default:
+			return nil
+		}
+		return &Event{
+			Type:       eventType,
+			Timestamp:  e.Timestamp,
+			DatabaseID: e.DatabaseID,
+			HostID:     e.HostID,
+			Task: &TaskEvent{
+				TaskID:     e.Task.TaskID.String(),
+				Scope:      e.Task.Scope.String(),
+				EntityID:   e.Task.EntityID,
+				Type:       e.Task.Type.String(),
+				Status:     e.Task.Status.String(),
+				NodeName:   e.Task.NodeName,
+				InstanceID: e.Task.InstanceID,
+				Error:      e.Task.Error,
+			},
+		}
+	case events.KindDatabase:
+		// New databases don't have a previous state. Their creation is
+		// reported by the task events instead.
+		if e.PreviousDatabaseState == "" {
+			return nil
+		}
+		return &Event{
+			Type:       EventTypeDatabaseStateChanged,
+			Timestamp:  e.Timestamp,
+			DatabaseID: e.DatabaseID,
+			Database: &DatabaseEvent{
+				State:         string(e.Database.State),
+				PreviousState: string(e.PreviousDatabaseState),
+			},
+		}
+	case events.KindInstance:
+		if e.PreviousRole == "" {
+			return nil
+		}
+		var role string
+		if e.Instance.Status != nil {
+			role = utils.FromPointer(e.Instance.Status.Role).String()
+		}
+		return &Event{
+			Type:       EventTypeInstanceRoleChanged,
+			Timestamp:  e.Timestamp,
+			DatabaseID: e.DatabaseID,
+			HostID:     e.HostID,
+			Instance: &InstanceEvent{
+				InstanceID:   e.Instance.InstanceID,
+				NodeName:     e.Instance.NodeName,
+				Role:         role,
+				PreviousRole: e.PreviousRole.String(),
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// checkHosts enqueues host_unreachable events for hosts that have become
+// unreachable since the last check. The last known state of each host is
+// persisted so that host failures are reported once across leadership
+// changes, including when the failed host was the previous leader.
+func (s *Service) checkHosts(ctx context.Context) error {
+	hosts, err := s.hostSvc.GetAllHosts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get hosts: %w", err)
+	}
+	storedStates, err := s.store.HostState.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get host states: %w", err)
+	}
+	known := make(map[string]*StoredHostState, len(storedStates))
+	for _, st := range storedStates {
+		known[st.HostID] = st
+	}
+
+	var errs []error
+	for _, h := range hosts {
+		curr := h.Status.State
+		prev, ok := known[h.ID]
+		delete(known, h.ID)
+		if ok && prev.State == curr {
+			continue
+		}
+		if curr == host.HostStateUnreachable {
+			err := s.enqueue(ctx, &Event{
+				Type:      EventTypeHostUnreachable,
+				Timestamp: time.Now(),
+				HostID:    h.ID,
+				Host: &HostEvent{
+					State:      string(curr),
+					LastSeenAt: h.Status.UpdatedAt,
+				},
+			})
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		err := s.store.HostState.Put(&StoredHostState{
+			HostID: h.ID,
+			State:  curr,
+		}).Exec(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to store host state: %w", err))
+		}
+	}
+
+	// Clean up the states of removed hosts.
+	for hostID := range known {
+		if _, err := s.store.HostState.DeleteByKey(hostID).Exec(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete host state: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// enqueue records a pending delivery of the event for each webhook that
+// matches it.
+func (s *Service) enqueue(ctx context.Context, evt *Event) error {
+	webhooks, err := s.store.Webhook.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	var errs []error
+	for _, w := range webhooks {
+		if !webhookFromStored(w).matches(evt) {
+			continue
+		}
+		deliveryID, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("failed to generate delivery ID: %w", err)
+		}
+
+		e := *evt
+		e.DeliveryID = deliveryID.String()
+		e.WebhookID = w.ID
+
+		now := time.Now()
+		err = s.store.Txn(
+			s.store.Delivery.Put(&StoredDelivery{
+				DeliveryID:    e.DeliveryID,
+				WebhookID:     w.ID,
+				Event:         &e,
+				Status:        DeliveryStatusPending,
+				NextAttemptAt: &now,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}),
+			s.store.PendingDelivery.Put(&StoredPendingDelivery{
+				DeliveryID: e.DeliveryID,
+				WebhookID:  w.ID,
+			}),
+		).Commit(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to store delivery for webhook %s: %w", w.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sendPending attempts each pending delivery that is due. Each webhook's
+// deliveries are sent in order, and up to maxConcurrentSenders webhooks are sent
+// to at the same time so that a slow or unreachable webhook doesn't delay the
+// others. It returns once every webhook that it started sending to is done.
+func (s *Service) sendPending(ctx context.Context) error {
+	pending, err := s.store.PendingDelivery.GetAll().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pending deliveries: %w", err)
+	}
+
+	// Pending deliveries are sorted by their time-ordered IDs.
+	var webhookIDs []string
+	byWebhook := map[string][]*StoredPendingDelivery{}
+	for _, p := range pending {
+		if _, ok := byWebhook[p.WebhookID]; !ok {
+			webhookIDs = append(webhookIDs, p.WebhookID)
+		}
+		byWebhook[p.WebhookID] = append(byWebhook[p.WebhookID], p)
+	}
+
+	var errsMu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	for _, webhookID := range webhookIDs {
+		if !s.startSending(webhookID) {
+			continue
+		}
+		wg.Go(func() {
+			defer s.finishSending(webhookID)
+
+			err := s.sendWebhookDeliveries(ctx, byWebhook[webhookID])
+			if err != nil {
+				errsMu.Lock()
+				errs = append(errs, err)
+				errsMu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) sendWebhookDeliveries(ctx context.Context, pending []*StoredPendingDelivery) error {
+	select {
+	case s.senderSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-s.senderSlots }()
+
+	var errs []error
+	for _, p := range pending {
+		// The context is canceled when we lose the election, so stop here to
+		// avoid sending the same deliveries as the new leader.
+		if ctx.Err() != nil {
+			break
+		}
+		if err := s.sendDelivery(ctx, p); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send delivery %s: %w", p.DeliveryID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// leaderSendContext returns a context for sending deliveries that's canceled
+// when this server loses the election or shuts down.
+func (s *Service) leaderSendContext(ctx context.Context) context.Context {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if s.sendCtx == nil {
+		s.sendCtx, s.sendCancel = context.WithCancel(ctx)
+	}
+
+	return s.sendCtx
+}
+
+func (s *Service) stopSending() {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if s.sendCancel != nil {
+		s.sendCancel()
+	}
+	s.sendCtx = nil
+	s.sendCancel = nil
+}
+
+// startSending marks the webhook as being sent to. It returns false if the
+// webhook is already being sent to.
+func (s *Service) startSending(webhookID string) bool {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if _, ok := s.sending[webhookID]; ok {
+		return false
+	}
+	s.sending[webhookID] = struct{}{}
+
+	return true
+}
+
+func (s *Service) finishSending(webhookID string) {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	delete(s.sending, webhookID)
+}
+
+func (s *Service) sendDelivery(ctx context.Context, p *StoredPendingDelivery) error {
+	w, err := s.store.Webhook.GetByKey(p.WebhookID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		// The webhook was deleted.
+		return s.deletePending(ctx, p)
+	} else if err != nil {
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+	d, err := s.store.Delivery.GetByKey(p.WebhookID, p.DeliveryID).Exec(ctx)
+	if errors.Is(err, storage.ErrNotFound) {
+		// The delivery expired.
+		return s.deletePending(ctx, p)
+	} else if err != nil {
+		return fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	now := time.Now()
+	if d.NextAttemptAt != nil && d.NextAttemptAt.After(now) {
+		return nil
+	}
+
+	statusCode, sendErr := send(ctx, s.client, w, d.Event)
+	d.recordAttempt(statusCode, sendErr, time.Now())
+
+	logger := s.logger.With().
+		Str("webhook_id", d.WebhookID).
+		Str("delivery_id", d.DeliveryID).
+		Str("event_type", d.Event.Type.String()).
+		Int("attempts", d.Attempts).
+		Logger()
+	switch d.Status {
+	case DeliveryStatusSucceeded:
+		logger.Debug().Msg("webhook delivered")
+	case DeliveryStatusFailed:
+		logger.Warn().Err(sendErr).Msg("webhook delivery failed, giving up")
+	default:
+		logger.Debug().Err(sendErr).Msg("webhook delivery failed, will retry")
+	}
+
+	ops := []storage.TxnOperation{s.store.Delivery.Put(d)}
+	if d.Status != DeliveryStatusPending {
+		ops = append(ops, s.store.PendingDelivery.DeleteByKey(p.DeliveryID))
+	}
+	if err := s.store.Txn(ops...).Commit(ctx); err != nil {
+		return fmt.Errorf("failed to update delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) deletePending(ctx context.Context, p *StoredPendingDelivery) error {
+	if _, err := s.store.PendingDelivery.DeleteByKey(p.DeliveryID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete pending delivery: %w", err)
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func webhookFromStored(w *StoredWebhook) *Webhook {
+	return &Webhook{
+		ID:          w.ID,
+		URL:         w.URL,
+		Description: w.Description,
+		EventTypes:  w.EventTypes,
+		DatabaseIDs: w.DatabaseIDs,
+		CreatedAt:   w.CreatedAt,
+	}
+}
+
+func deliveryFromStored(d *StoredDelivery) *Delivery {
+	delivery := &Delivery{
+		DeliveryID:     d.DeliveryID,
+		WebhookID:      d.WebhookID,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+	if d.Event != nil {
+		delivery.EventType = d.Event.Type
+	}
+	return delivery
+}
@@ -0,0 +1,276 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/storage/storagetest"
+)
+
+func TestService_Webhooks(t *testing.T) {
+	ctx := t.Context()
+	svc := newTestService(t)
+
+	t.Run("invalid url", func(t *testing.T) {
+		_, err := svc.CreateWebhook(ctx, &Webhook{URL: "ftp://example.com"})
+		assert.ErrorIs(t, err, ErrInvalidWebhook)
+	})
+
+	t.Run("invalid event type", func(t *testing.T) {
+		_, err := svc.CreateWebhook(ctx, &Webhook{
+			URL:        "https://example.com",
+			EventTypes: []EventType{"backup_started"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidWebhook)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		created, err := svc.CreateWebhook(ctx, &Webhook{
+			URL:        "https://example.com/hook",
+			EventTypes: []EventType{EventTypeTaskFailed},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Len(t, created.Secret, 64)
+
+		got, err := svc.GetWebhook(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/hook", got.URL)
+		assert.Equal(t, []EventType{EventTypeTaskFailed}, got.EventTypes)
+		assert.Empty(t, got.Secret)
+
+		list, err := svc.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, created.ID, list[0].ID)
+		assert.Empty(t, list[0].Secret)
+
+		require.NoError(t, svc.DeleteWebhook(ctx, created.ID))
+		_, err = svc.GetWebhook(ctx, created.ID)
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+		assert.ErrorIs(t, svc.DeleteWebhook(ctx, created.ID), ErrWebhookNotFound)
+	})
+}
+
+func TestService_Deliveries(t *testing.T) {
+	ctx := t.Context()
+	svc := newTestService(t)
+
+	const secret = "0123456789abcdef"
+	received := make(chan *Event, 4)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, VerifySignature(secret, body, r.Header.Get(SignatureHeader)))
+
+		var e Event
+		if !assert.NoError(t, json.Unmarshal(body, &e)) {
+			return
+		}
+		assert.Equal(t, e.Type.String(), r.Header.Get(EventTypeHeader))
+		assert.Equal(t, e.DeliveryID, r.Header.Get(DeliveryIDHeader))
+		received <- &e
+	}))
+	t.Cleanup(receiver.Close)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+
+	ok, err := svc.CreateWebhook(ctx, &Webhook{
+		URL:         receiver.URL,
+		Secret:      secret,
+		EventTypes:  []EventType{EventTypeTaskFailed},
+		DatabaseIDs: []string{"database-1"},
+	})
+	require.NoError(t, err)
+	broken, err := svc.CreateWebhook(ctx, &Webhook{
+		URL: failing.URL,
+	})
+	require.NoError(t, err)
+
+	taskID := uuid.NewString()
+	for _, evt := range []*Event{
+		{Type: EventTypeTaskFailed, DatabaseID: "database-1", Task: &TaskEvent{TaskID: taskID, Status: "failed"}},
+		{Type: EventTypeTaskCompleted, DatabaseID: "database-1", Task: &TaskEvent{Status: "completed"}},
+		{Type: EventTypeTaskFailed, DatabaseID: "database-2", Task: &TaskEvent{Status: "failed"}},
+	} {
+		require.NoError(t, svc.enqueue(ctx, evt))
+	}
+	require.NoError(t, svc.sendPending(ctx))
+
+	// Only the first event matches the first webhook's filters.
+	select {
+	case e := <-received:
+		assert.Equal(t, EventTypeTaskFailed, e.Type)
+		assert.Equal(t, ok.ID, e.WebhookID)
+		assert.Equal(t, taskID, e.Task.TaskID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for delivery")
+	}
+	assert.Empty(t, received)
+
+	deliveries, err := svc.ListDeliveries(ctx, ok.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, DeliveryStatusSucceeded, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusOK, deliveries[0].LastStatusCode)
+	assert.Nil(t, deliveries[0].NextAttemptAt)
+
+	// The second webhook has no filters, so it receives every event and
+	// they're all scheduled for a retry.
+	deliveries, err = svc.ListDeliveries(ctx, broken.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	for _, d := range deliveries {
+		assert.Equal(t, DeliveryStatusPending, d.Status)
+		assert.Equal(t, 1, d.Attempts)
+		assert.Equal(t, http.StatusServiceUnavailable, d.LastStatusCode)
+		assert.Contains(t, d.LastError, "unavailable")
+		require.NotNil(t, d.NextAttemptAt)
+		assert.True(t, d.NextAttemptAt.After(time.Now()))
+	}
+
+	pending, err := svc.store.PendingDelivery.GetAll().Exec(ctx)
+	require.NoError(t, err)
+	assert.Len(t, pending, 3)
+
+	// Retries aren't attempted until they're due.
+	require.NoError(t, svc.sendPending(ctx))
+	deliveries, err = svc.ListDeliveries(ctx, broken.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+
+	// Pending deliveries are discarded once their webhook is deleted.
+	require.NoError(t, svc.DeleteWebhook(ctx, broken.ID))
+	require.NoError(t, svc.sendPending(ctx))
+	pending, err = svc.store.PendingDelivery.GetAll().Exec(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestService_SendPendingConcurrently(t *testing.T) {
+	ctx := t.Context()
+	svc := newTestService(t)
+
+	release := make(chan struct{})
+	var slowRequests atomic.Int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slowRequests.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(release) })
+
+	received := make(chan string, 4)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Event
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&e)) {
+			received <- e.Task.TaskID
+		}
+	}))
+	t.Cleanup(fast.Close)
+
+	_, err := svc.CreateWebhook(ctx, &Webhook{URL: slow.URL})
+	require.NoError(t, err)
+	_, err = svc.CreateWebhook(ctx, &Webhook{URL: fast.URL})
+	require.NoError(t, err)
+
+	taskIDs := []string{uuid.NewString(), uuid.NewString()}
+	for _, taskID := range taskIDs {
+		evt := &Event{Type: EventTypeTaskFailed, Task: &TaskEvent{TaskID: taskID, Status: "failed"}}
+		require.NoError(t, svc.enqueue(ctx, evt))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.sendPending(ctx)
+	}()
+
+	// The fast webhook receives its deliveries, in order, while the slow
+	// webhook is still handling its first delivery.
+	for _, taskID := range taskIDs {
+		select {
+		case got := <-received:
+			assert.Equal(t, taskID, got)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for delivery")
+		}
+	}
+
+	// Webhooks that are still being sent to are skipped.
+	require.NoError(t, svc.sendPending(ctx))
+	assert.Equal(t, int32(1), slowRequests.Load())
+
+	release <- struct{}{}
+	release <- struct{}{}
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sendPending")
+	}
+	assert.Equal(t, int32(2), slowRequests.Load())
+}
+
+func TestStoredDelivery_RecordAttempt(t *testing.T) {
+	now := time.Now()
+	d := &StoredDelivery{Status: DeliveryStatusPending}
+
+	for attempt := 1; attempt < maxAttempts; attempt++ {
+		d.recordAttempt(http.StatusBadGateway, assert.AnError, now)
+		assert.Equal(t, DeliveryStatusPending, d.Status)
+		require.NotNil(t, d.NextAttemptAt)
+		assert.Equal(t, now.Add(retryDelay(attempt)), *d.NextAttemptAt)
+	}
+
+	d.recordAttempt(http.StatusBadGateway, assert.AnError, now)
+	assert.Equal(t, DeliveryStatusFailed, d.Status)
+	assert.Equal(t, maxAttempts, d.Attempts)
+	assert.Nil(t, d.NextAttemptAt)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryDelay(1))
+	assert.Equal(t, 20*time.Second, retryDelay(2))
+	assert.Equal(t, 80*time.Second, retryDelay(4))
+	assert.Equal(t, maxRetryDelay, retryDelay(20))
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"task_failed"}`)
+	sig := Sign("secret", body)
+
+	assert.Equal(t, "sha256=", sig[:7])
+	assert.True(t, VerifySignature("secret", body, sig))
+	assert.False(t, VerifySignature("other", body, sig))
+	assert.False(t, VerifySignature("secret", []byte(`{}`), sig))
+}
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+
+	server := storagetest.NewEtcdTestServer(t)
+	client := server.Client(t)
+	store := NewStore(client, uuid.NewString())
+
+	return NewService(zerolog.Nop(), store, nil, nil, nil)
+}
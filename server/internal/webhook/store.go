@@ -0,0 +1,228 @@
+package webhook
+
+import (
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+)
+
+type StoredWebhook struct {
+	storage.StoredValue
+	ID          string      `json:"id"`
+	URL         string      `json:"url"`
+	Description string      `json:"description,omitempty"`
+	Secret      string      `json:"secret"`
+	EventTypes  []EventType `json:"event_types,omitempty"`
+	DatabaseIDs []string    `json:"database_ids,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type DeliveryStatus string
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+const (
+	// DeliveryStatusPending deliveries are waiting for their first attempt
+	// or for a retry.
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusSucceeded deliveries received a 2xx response.
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	// DeliveryStatusFailed deliveries have used all of their attempts.
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+type StoredDelivery struct {
+	storage.StoredValue
+	DeliveryID string         `json:"delivery_id"`
+	WebhookID  string         `json:"webhook_id"`
+	Event      *Event         `json:"event"`
+	Status     DeliveryStatus `json:"status"`
+	Attempts   int            `json:"attempts"`
+	// LastStatusCode is the HTTP status code from the most recent attempt. It's
+	// zero if the request could not be completed.
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// StoredPendingDelivery is a queue entry for a delivery that has not yet
+// succeeded or failed. Keeping these separate from the delivery history lets
+// the sender find outstanding work without scanning every delivery.
+type StoredPendingDelivery struct {
+	storage.StoredValue
+	DeliveryID string `json:"delivery_id"`
+	WebhookID  string `json:"webhook_id"`
+}
+
+// StoredHostState records the last host state that webhooks were sent for,
+// so that a newly elected leader doesn't miss or repeat host events.
+type StoredHostState struct {
+	storage.StoredValue
+	HostID string         `json:"host_id"`
+	State  host.HostState `json:"state"`
+}
+
+type Store struct {
+	client          *clientv3.Client
+	Webhook         *WebhookStore
+	Delivery        *DeliveryStore
+	PendingDelivery *PendingDeliveryStore
+	HostState       *HostStateStore
+}
+
+func NewStore(client *clientv3.Client, root string) *Store {
+	return &Store{
+		client:          client,
+		Webhook:         &WebhookStore{client: client, root: root},
+		Delivery:        &DeliveryStore{client: client, root: root},
+		PendingDelivery: &PendingDeliveryStore{client: client, root: root},
+		HostState:       &HostStateStore{client: client, root: root},
+	}
+}
+
+func (s *Store) Txn(ops ...storage.TxnOperation) storage.Txn {
+	return storage.NewTxn(s.client, ops...)
+}
+
+type WebhookStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func (s *WebhookStore) Prefix() string {
+	return storage.Prefix("/", s.root, "webhooks")
+}
+
+func (s *WebhookStore) Key(webhookID string) string {
+	return storage.Key(s.Prefix(), webhookID)
+}
+
+func (s *WebhookStore) GetByKey(webhookID string) storage.GetOp[*StoredWebhook] {
+	key := s.Key(webhookID)
+	return storage.NewGetOp[*StoredWebhook](s.client, key)
+}
+
+func (s *WebhookStore) GetAll() storage.GetMultipleOp[*StoredWebhook] {
+	return storage.NewGetPrefixOp[*StoredWebhook](s.client, s.Prefix())
+}
+
+func (s *WebhookStore) Create(item *StoredWebhook) storage.PutOp[*StoredWebhook] {
+	key := s.Key(item.ID)
+	return storage.NewCreateOp(s.client, key, item)
+}
+
+func (s *WebhookStore) DeleteByKey(webhookID string) storage.DeleteOp {
+	key := s.Key(webhookID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
+
+type DeliveryStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func (s *DeliveryStore) Prefix() string {
+	return storage.Prefix("/", s.root, "webhook_deliveries")
+}
+
+func (s *DeliveryStore) WebhookPrefix(webhookID string) string {
+	return storage.Prefix(s.Prefix(), webhookID)
+}
+
+func (s *DeliveryStore) Key(webhookID, deliveryID string) string {
+	return storage.Key(s.WebhookPrefix(webhookID), deliveryID)
+}
+
+func (s *DeliveryStore) GetByKey(webhookID, deliveryID string) storage.GetOp[*StoredDelivery] {
+	key := s.Key(webhookID, deliveryID)
+	return storage.NewGetOp[*StoredDelivery](s.client, key)
+}
+
+// GetByWebhook returns the most recent deliveries for the given webhook,
+// newest first. Delivery IDs are time-ordered, so key order is creation order.
+func (s *DeliveryStore) GetByWebhook(webhookID string, limit int) storage.GetMultipleOp[*StoredDelivery] {
+	prefix := s.WebhookPrefix(webhookID)
+	options := []clientv3.OpOption{
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+	}
+	if limit > 0 {
+		options = append(options, clientv3.WithLimit(int64(limit)))
+	}
+	return storage.NewGetRangeOp[*StoredDelivery](s.client, prefix, clientv3.GetPrefixRangeEnd(prefix), options...)
+}
+
+func (s *DeliveryStore) Put(item *StoredDelivery) storage.PutOp[*StoredDelivery] {
+	key := s.Key(item.WebhookID, item.DeliveryID)
+	return storage.NewPutOp(s.client, key, item).WithTTL(deliveryRetention)
+}
+
+func (s *DeliveryStore) DeleteByWebhook(webhookID string) storage.DeleteOp {
+	prefix := s.WebhookPrefix(webhookID)
+	return storage.NewDeletePrefixOp(s.client, prefix)
+}
+
+type PendingDeliveryStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func (s *PendingDeliveryStore) Prefix() string {
+	return storage.Prefix("/", s.root, "webhook_pending_deliveries")
+}
+
+func (s *PendingDeliveryStore) Key(deliveryID string) string {
+	return storage.Key(s.Prefix(), deliveryID)
+}
+
+// GetAll returns all pending deliveries, oldest first.
+func (s *PendingDeliveryStore) GetAll() storage.GetMultipleOp[*StoredPendingDelivery] {
+	return storage.NewGetPrefixOp[*StoredPendingDelivery](
+		s.client,
+		s.Prefix(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	)
+}
+
+func (s *PendingDeliveryStore) Put(item *StoredPendingDelivery) storage.PutOp[*StoredPendingDelivery] {
+	key := s.Key(item.DeliveryID)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *PendingDeliveryStore) DeleteByKey(deliveryID string) storage.DeleteOp {
+	key := s.Key(deliveryID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
+
+type HostStateStore struct {
+	client *clientv3.Client
+	root   string
+}
+
+func (s *HostStateStore) Prefix() string {
+	return storage.Prefix("/", s.root, "webhook_host_states")
+}
+
+func (s *HostStateStore) Key(hostID string) string {
+	return storage.Key(s.Prefix(), hostID)
+}
+
+func (s *HostStateStore) GetAll() storage.GetMultipleOp[*StoredHostState] {
+	return storage.NewGetPrefixOp[*StoredHostState](s.client, s.Prefix())
+}
+
+func (s *HostStateStore) Put(item *StoredHostState) storage.PutOp[*StoredHostState] {
+	key := s.Key(item.HostID)
+	return storage.NewPutOp(s.client, key, item)
+}
+
+func (s *HostStateStore) DeleteByKey(hostID string) storage.DeleteOp {
+	key := s.Key(hostID)
+	return storage.NewDeleteKeyOp(s.client, key)
+}
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrInvalidWebhook  = errors.New("invalid webhook")
+)
+
+type EventType string
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+const (
+	// EventTypeTaskCompleted is sent when a task finishes successfully.
+	EventTypeTaskCompleted EventType = "task_completed"
+	// EventTypeTaskFailed is sent when a task fails.
+	EventTypeTaskFailed EventType = "task_failed"
+	// EventTypeDatabaseStateChanged is sent when a database changes state,
+	// e.g. from available to degraded.
+	EventTypeDatabaseStateChanged EventType = "database_state_changed"
+	// EventTypeInstanceRoleChanged is sent when the instance monitor observes
+	// a role change, such as a replica being promoted during a failover.
+	EventTypeInstanceRoleChanged EventType = "instance_role_changed"
+	// EventTypeHostUnreachable is sent when a host stops reporting its status.
+	EventTypeHostUnreachable EventType = "host_unreachable"
+)
+
+var EventTypes = []EventType{
+	EventTypeTaskCompleted,
+	EventTypeTaskFailed,
+	EventTypeDatabaseStateChanged,
+	EventTypeInstanceRoleChanged,
+	EventTypeHostUnreachable,
+}
+
+type Webhook struct {
+	ID          string
+	URL         string
+	Description string
+	// Secret is used to sign each payload. It's only returned when the webhook
+	// is created.
+	Secret string
+	// EventTypes limits deliveries to the given event types. All event types
+	// are delivered when this is empty.
+	EventTypes []EventType
+	// DatabaseIDs limits deliveries to events for the given databases. Events
+	// that aren't specific to a database, such as host events, are not
+	// delivered when this is set.
+	DatabaseIDs []string
+	CreatedAt   time.Time
+}
+
+func (w *Webhook) validate() error {
+	var errs []error
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid url: %w", err))
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		errs = append(errs, errors.New("url must use the http or https scheme"))
+	} else if u.Host == "" {
+		errs = append(errs, errors.New("url must include a host"))
+	}
+	for _, t := range w.EventTypes {
+		if !slices.Contains(EventTypes, t) {
+			errs = append(errs, fmt.Errorf("unrecognized event type '%s'", t))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWebhook, err)
+	}
+	return nil
+}
+
+func (w *Webhook) matches(e *Event) bool {
+	if len(w.EventTypes) > 0 && !slices.Contains(w.EventTypes, e.Type) {
+		return false
+	}
+	if len(w.DatabaseIDs) > 0 && !slices.Contains(w.DatabaseIDs, e.DatabaseID) {
+		return false
+	}
+	return true
+}
+
+// Event is the body of each webhook request. Exactly one of Task, Database,
+// Instance, or Host is set, depending on the event's Type.
+type Event struct {
+	// DeliveryID uniquely identifies this event. It's the same for every
+	// attempt, so receivers can use it to discard duplicates.
+	DeliveryID string    `json:"delivery_id"`
+	WebhookID  string    `json:"webhook_id"`
+	Type       EventType `json:"type"`
+	Timestamp  time.Time `json:"timestamp"`
+	DatabaseID string    `json:"database_id,omitempty"`
+	HostID     string    `json:"host_id,omitempty"`
+
+	Task     *TaskEvent     `json:"task,omitempty"`
+	Database *DatabaseEvent `json:"database,omitempty"`
+	Instance *InstanceEvent `json:"instance,omitempty"`
+	Host     *HostEvent     `json:"host,omitempty"`
+}
+
+type TaskEvent struct {
+	TaskID     string `json:"task_id"`
+	Scope      string `json:"scope"`
+	EntityID   string `json:"entity_id"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	NodeName   string `json:"node_name,omitempty"`
+	InstanceID string `json:"instance_id,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type DatabaseEvent struct {
+	State         string `json:"state"`
+	PreviousState string `json:"previous_state,omitempty"`
+}
+
+type InstanceEvent struct {
+	InstanceID   string `json:"instance_id"`
+	NodeName     string `json:"node_name"`
+	Role         string `json:"role"`
+	PreviousRole string `json:"previous_role"`
+}
+
+type HostEvent struct {
+	State string `json:"state"`
+	// LastSeenAt is when the host last reported its status.
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// SignatureHeader contains the hex-encoded HMAC-SHA256 of the request body,
+// keyed with the webhook's secret and prefixed with "sha256=".
+const SignatureHeader = "X-Control-Plane-Signature"
+
+// Sign returns the value of the signature header for the given body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether the given signature header value is valid
+// for the body.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Delivery is a record of a single event sent to a webhook, including the
+// outcome of the most recent attempt.
+type Delivery struct {
+	DeliveryID     string
+	WebhookID      string
+	EventType      EventType
+	Status         DeliveryStatus
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/events"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+func TestEventFromChange(t *testing.T) {
+	now := time.Now()
+	taskID := uuid.New()
+
+	for _, tc := range []struct {
+		name     string
+		change   *events.Event
+		expected *Event
+	}{
+		{
+			name: "task failed",
+			change: &events.Event{
+				Kind:       events.KindTask,
+				Timestamp:  now,
+				DatabaseID: "database-1",
+				TaskID:     taskID,
+				Task: &task.Task{
+					TaskID:     taskID,
+					Scope:      task.ScopeDatabase,
+					EntityID:   "database-1",
+					DatabaseID: "database-1",
+					Type:       task.TypeUpdate,
+					Status:     task.StatusFailed,
+					Error:      "boom",
+				},
+			},
+			expected: &Event{
+				Type:       EventTypeTaskFailed,
+				Timestamp:  now,
+				DatabaseID: "database-1",
+				Task: &TaskEvent{
+					TaskID:   taskID.String(),
+					Scope:    "database",
+					EntityID: "database-1",
+					Type:     "update",
+					Status:   "failed",
+					Error:    "boom",
+				},
+			},
+		},
+		{
+			name: "task running",
+			change: &events.Event{
+				Kind: events.KindTask,
+				Task: &task.Task{Status: task.StatusRunning},
+			},
+		},
+		{
+			name: "database degraded",
+			change: &events.Event{
+				Kind:                  events.KindDatabase,
+				Timestamp:             now,
+				DatabaseID:            "database-1",
+				Database:              &database.Database{State: database.DatabaseStateDegraded},
+				PreviousDatabaseState: database.DatabaseStateAvailable,
+			},
+			expected: &Event{
+				Type:       EventTypeDatabaseStateChanged,
+				Timestamp:  now,
+				DatabaseID: "database-1",
+				Database: &DatabaseEvent{
+					State:         "degraded",
+					PreviousState: "available",
+				},
+			},
+		},
+		{
+			name: "database created",
+			change: &events.Event{
+				Kind:     events.KindDatabase,
+				Database: &database.Database{State: database.DatabaseStateCreating},
+			},
+		},
+		{
+			name: "instance promoted",
+			change: &events.Event{
+				Kind:       events.KindInstance,
+				Timestamp:  now,
+				DatabaseID: "database-1",
+				HostID:     "host-2",
+				Instance: &database.Instance{
+					InstanceID: "database-1-n1-host-2",
+					NodeName:   "n1",
+					Status: &database.InstanceStatus{
+						Role: utils.PointerTo(patroni.InstanceRolePrimary),
+					},
+				},
+				PreviousRole: patroni.InstanceRoleReplica,
+			},
+			expected: &Event{
+				Type:       EventTypeInstanceRoleChanged,
+				Timestamp:  now,
+				DatabaseID: "database-1",
+				HostID:     "host-2",
+				Instance: &InstanceEvent{
+					InstanceID:   "database-1-n1-host-2",
+					NodeName:     "n1",
+					Role:         "primary",
+					PreviousRole: "replica",
+				},
+			},
+		},
+		{
+			name: "instance status without role change",
+			change: &events.Event{
+				Kind:     events.KindInstance,
+				Instance: &database.Instance{},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, eventFromChange(tc.change))
+		})
+	}
+}
+
+func TestWebhookMatches(t *testing.T) {
+	taskFailed := &Event{Type: EventTypeTaskFailed, DatabaseID: "database-1"}
+	hostUnreachable := &Event{Type: EventTypeHostUnreachable, HostID: "host-1"}
+
+	all := &Webhook{}
+	assert.True(t, all.matches(taskFailed))
+	assert.True(t, all.matches(hostUnreachable))
+
+	byType := &Webhook{EventTypes: []EventType{EventTypeHostUnreachable}}
+	assert.False(t, byType.matches(taskFailed))
+	assert.True(t, byType.matches(hostUnreachable))
+
+	byDatabase := &Webhook{DatabaseIDs: []string{"database-1"}}
+	assert.True(t, byDatabase.matches(taskFailed))
+	assert.False(t, byDatabase.matches(hostUnreachable))
+}
@@ -0,0 +1,112 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type AcquireTaskSlotInput struct {
+	Scope    task.Scope `json:"scope"`
+	EntityID string     `json:"entity_id"`
+	TaskID   uuid.UUID  `json:"task_id"`
+	// UpdateOptions are the options that will be used to start the task. We
+	// use them to determine which hosts the task will run on, since they can
+	// set the task's instance ID.
+	UpdateOptions task.UpdateOptions `json:"update_options,omitempty"`
+}
+
+type AcquireTaskSlotOutput struct {
+	Acquired bool     `json:"acquired"`
+	Reasons  []string `json:"reasons,omitempty"`
+}
+
+func (a *Activities) ExecuteAcquireTaskSlot(
+	ctx workflow.Context,
+	input *AcquireTaskSlotInput,
+) workflow.Future[*AcquireTaskSlotOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*AcquireTaskSlotOutput](ctx, options, a.AcquireTaskSlot, input)
+}
+
+func (a *Activities) AcquireTaskSlot(ctx context.Context, input *AcquireTaskSlotInput) (*AcquireTaskSlotOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"scope", input.Scope,
+		"entity_id", input.EntityID,
+		"task_id", input.TaskID.String(),
+	)
+	logger.Debug("acquiring task slot")
+
+	t, err := a.TaskSvc.GetTask(ctx, input.Scope, input.EntityID, input.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	// This update is only used to determine the task's hosts and is not
+	// persisted.
+	t.Update(input.UpdateOptions)
+
+	hostIDs, err := a.taskHostIDs(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	acquired, reasons, err := a.TaskSvc.AcquireSlot(ctx, t, hostIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcquireTaskSlotOutput{
+		Acquired: acquired,
+		Reasons:  reasons,
+	}, nil
+}
+
+// taskHostIDs returns the hosts that the given task will run on. Database
+// tasks that aren't specific to an instance or node will run on every host in
+// the database.
+func (a *Activities) taskHostIDs(ctx context.Context, t *task.Task) ([]string, error) {
+	if t.HostID != "" {
+		return []string{t.HostID}, nil
+	}
+	if t.Scope != task.ScopeDatabase {
+		return nil, nil
+	}
+
+	db, err := a.DatabaseService.GetDatabase(ctx, t.DatabaseID)
+	if errors.Is(err, database.ErrDatabaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+
+	if t.InstanceID != "" {
+		for _, instance := range db.Instances {
+			if instance.InstanceID == t.InstanceID {
+				return []string{instance.HostID}, nil
+			}
+		}
+	}
+
+	hostIDs := ds.NewSet[string]()
+	for _, node := range db.Spec.Nodes {
+		if t.NodeName == "" || node.Name == t.NodeName {
+			hostIDs.Add(node.HostIDs...)
+		}
+	}
+	return hostIDs.ToSortedSlice(strings.Compare), nil
+}
@@ -1,13 +1,18 @@
 package activities
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/cschleiden/go-workflows/worker"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/do"
 
+	"github.com/pgEdge/control-plane/server/internal/certificates"
 	"github.com/pgEdge/control-plane/server/internal/config"
 	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
 	"github.com/pgEdge/control-plane/server/internal/task"
 )
 
@@ -21,32 +26,93 @@ type Activities struct {
 
 func (a *Activities) Register(work *worker.Worker) error {
 	errs := []error{
+		work.RegisterActivity(a.AcquireTaskSlot),
 		work.RegisterActivity(a.ApplyEvent),
 		work.RegisterActivity(a.CancelSwitchover),
+		work.RegisterActivity(a.ChangeEtcdMode),
 		work.RegisterActivity(a.CheckClusterHealth),
+		work.RegisterActivity(a.CheckEtcdMode),
+		work.RegisterActivity(a.CompletePatroniDCSSwitch),
+		work.RegisterActivity(a.CopyPatroniCluster),
 		work.RegisterActivity(a.CreatePgBackRestBackup),
+		work.RegisterActivity(a.CreateTask),
 		work.RegisterActivity(a.DeleteDbEntities),
+		work.RegisterActivity(a.FinishImport),
 		work.RegisterActivity(a.GenerateServiceInstanceResources),
 		work.RegisterActivity(a.GetCurrentState),
+		work.RegisterActivity(a.GetImportStatus),
 		work.RegisterActivity(a.GetInstanceResources),
+		work.RegisterActivity(a.GetInstanceSessions),
 		work.RegisterActivity(a.GetPrimaryInstance),
 		work.RegisterActivity(a.GetRestoreResources),
 		work.RegisterActivity(a.GetScriptResults),
+		work.RegisterActivity(a.ImportSchema),
 		work.RegisterActivity(a.LogTaskEvent),
 		work.RegisterActivity(a.PerformFailover),
 		work.RegisterActivity(a.PerformSwitchover),
 		work.RegisterActivity(a.PersistPlanSummaries),
 		work.RegisterActivity(a.PersistState),
+		work.RegisterActivity(a.PgUpgrade),
 		work.RegisterActivity(a.PlanRefresh),
+		work.RegisterActivity(a.ReinitializeReplica),
 		work.RegisterActivity(a.RemoveHost),
+		work.RegisterActivity(a.ResetQueryStats),
 		work.RegisterActivity(a.RestartInstance),
 		work.RegisterActivity(a.SelectCandidate),
+		work.RegisterActivity(a.SelectUpToDateReplica),
+		work.RegisterActivity(a.SetDatabaseImporting),
+		work.RegisterActivity(a.SignalSession),
+		work.RegisterActivity(a.StartImportReplication),
 		work.RegisterActivity(a.StartInstance),
 		work.RegisterActivity(a.StopInstance),
+		work.RegisterActivity(a.UpdateDbSpec),
 		work.RegisterActivity(a.UpdateDbState),
 		work.RegisterActivity(a.UpdatePlannedInstanceStates),
+		work.RegisterActivity(a.UpdateSpockExtension),
 		work.RegisterActivity(a.UpdateTask),
 		work.RegisterActivity(a.ValidateInstanceSpecs),
 	}
 	return errors.Join(errs...)
 }
+
+// connectToInstance opens an admin connection to the given database on an
+// instance.
+func (a *Activities) connectToInstance(ctx context.Context, databaseID, instanceID, databaseName string) (*pgx.Conn, error) {
+	certSvc, err := do.Invoke[*certificates.Service](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+
+	connInfo, err := a.DatabaseService.GetInstanceConnectionInfo(ctx, databaseID, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance connection info: %w", err)
+	}
+	tlsCfg, err := certSvc.PostgresUserTLS(ctx, instanceID, connInfo.InstanceHostname, "pgedge")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TLS config: %w", err)
+	}
+	conn, err := database.ConnectToInstance(ctx, &database.ConnectionOptions{
+		DSN: connInfo.AdminDSN(databaseName),
+		TLS: tlsCfg,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to instance: %w", err)
+	}
+
+	return conn, nil
+}
+
+// connectToImportSource opens a connection to the external server that a
+// database is being imported from.
+func connectToImportSource(ctx context.Context, dsn string) (*pgx.Conn, error) {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to import source: %w", err)
+	}
+	if err := postgres.SetSafeIdentifiers().Exec(ctx, conn); err != nil {
+		conn.Close(ctx)
+		return nil, fmt.Errorf("failed to configure import source connection: %w", err)
+	}
+
+	return conn, nil
+}
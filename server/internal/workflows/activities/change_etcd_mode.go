@@ -0,0 +1,139 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/host"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type ChangeEtcdModeInput struct {
+	HostID string          `json:"host_id"`
+	Mode   config.EtcdMode `json:"mode"`
+}
+
+type ChangeEtcdModeOutput struct{}
+
+// ExecuteChangeEtcdMode runs on the host whose mode is changing, because the
+// host restarts itself to apply the change.
+func (a *Activities) ExecuteChangeEtcdMode(
+	ctx workflow.Context,
+	input *ChangeEtcdModeInput,
+) workflow.Future[*ChangeEtcdModeOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*ChangeEtcdModeOutput](ctx, options, a.ChangeEtcdMode, input)
+}
+
+func (a *Activities) ChangeEtcdMode(ctx context.Context, input *ChangeEtcdModeInput) (*ChangeEtcdModeOutput, error) {
+	logger := activity.Logger(ctx)
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+	logger = logger.With(
+		"host_id", input.HostID,
+		"etcd_mode", input.Mode,
+	)
+	logger.Info("starting change etcd mode activity")
+
+	modeChanger, err := do.Invoke[*etcd.ModeChanger](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+	if err := modeChanger.ChangeMode(input.Mode); err != nil {
+		return nil, err
+	}
+
+	logger.Info("change etcd mode activity completed")
+	return &ChangeEtcdModeOutput{}, nil
+}
+
+type CheckEtcdModeInput struct {
+	HostID string          `json:"host_id"`
+	Mode   config.EtcdMode `json:"mode"`
+}
+
+type CheckEtcdModeOutput struct {
+	Complete bool   `json:"complete"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (a *Activities) ExecuteCheckEtcdMode(
+	ctx workflow.Context,
+	input *CheckEtcdModeInput,
+) workflow.Future[*CheckEtcdModeOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*CheckEtcdModeOutput](ctx, options, a.CheckEtcdMode, input)
+}
+
+// CheckEtcdMode reports whether a host has restarted in the given etcd mode.
+// Servers must also be voting members of the etcd cluster, and clients must no
+// longer be members.
+func (a *Activities) CheckEtcdMode(ctx context.Context, input *CheckEtcdModeInput) (*CheckEtcdModeOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	hostSvc, err := do.Invoke[*host.Service](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+	client, err := do.Invoke[*clientv3.Client](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hostSvc.GetHost(ctx, input.HostID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host: %w", err)
+	}
+	if h.EtcdMode != input.Mode {
+		return &CheckEtcdModeOutput{
+			Reason: fmt.Sprintf("host is running in '%s' mode", h.EtcdMode),
+		}, nil
+	}
+	if h.Status == nil || h.Status.State != host.HostStateHealthy {
+		return &CheckEtcdModeOutput{Reason: "host is not healthy"}, nil
+	}
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etcd members: %w", err)
+	}
+	var member bool
+	for _, m := range resp.Members {
+		if m.Name != input.HostID {
+			continue
+		}
+		if m.IsLearner {
+			return &CheckEtcdModeOutput{Reason: "host is an etcd learner"}, nil
+		}
+		member = true
+	}
+	switch {
+	case input.Mode == config.EtcdModeServer && !member:
+		return &CheckEtcdModeOutput{Reason: "host is not an etcd member"}, nil
+	case input.Mode == config.EtcdModeClient && member:
+		return &CheckEtcdModeOutput{Reason: "host is still an etcd member"}, nil
+	}
+
+	return &CheckEtcdModeOutput{Complete: true}, nil
+}
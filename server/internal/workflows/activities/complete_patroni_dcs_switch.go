@@ -0,0 +1,46 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type CompletePatroniDCSSwitchInput struct {
+	DatabaseID string `json:"database_id"`
+	NodeName   string `json:"node_name"`
+}
+
+type CompletePatroniDCSSwitchOutput struct{}
+
+func (a *Activities) ExecuteCompletePatroniDCSSwitch(
+	ctx workflow.Context,
+	input *CompletePatroniDCSSwitchInput,
+) workflow.Future[*CompletePatroniDCSSwitchOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*CompletePatroniDCSSwitchOutput](ctx, options, a.CompletePatroniDCSSwitch, input)
+}
+
+func (a *Activities) CompletePatroniDCSSwitch(ctx context.Context, input *CompletePatroniDCSSwitchInput) (*CompletePatroniDCSSwitchOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"node_name", input.NodeName,
+	)
+	logger.Debug("completing patroni DCS switch")
+
+	err := a.DatabaseService.CompletePatroniDCSSwitch(ctx, input.DatabaseID, input.NodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete patroni DCS switch: %w", err)
+	}
+
+	return &CompletePatroniDCSSwitchOutput{}, nil
+}
@@ -0,0 +1,67 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type CopyPatroniClusterInput struct {
+	DatabaseID string `json:"database_id"`
+	NodeName   string `json:"node_name"`
+	HostID     string `json:"host_id"`
+}
+
+type CopyPatroniClusterOutput struct {
+	Copied bool `json:"copied"`
+}
+
+func (a *Activities) ExecuteCopyPatroniCluster(
+	ctx workflow.Context,
+	input *CopyPatroniClusterInput,
+) workflow.Future[*CopyPatroniClusterOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*CopyPatroniClusterOutput](ctx, options, a.CopyPatroniCluster, input)
+}
+
+// CopyPatroniCluster copies a node's Patroni state from the DCS that it's
+// switching from to the DCS in its database's spec. The node's instances must
+// be stopped so that the state doesn't change during the copy.
+func (a *Activities) CopyPatroniCluster(ctx context.Context, input *CopyPatroniClusterInput) (*CopyPatroniClusterOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"node_name", input.NodeName,
+	)
+	logger.Info("copying patroni cluster to new DCS")
+
+	db, err := a.DatabaseService.GetDatabase(ctx, input.DatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	external := db.Spec.PatroniDCS.IsExternal()
+	source, err := a.patroniDCSClient(!external)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for previous patroni DCS: %w", err)
+	}
+	target, err := a.patroniDCSClient(external)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client for patroni DCS: %w", err)
+	}
+
+	copied, err := patroni.CopyCluster(ctx, source, target, input.DatabaseID, input.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CopyPatroniClusterOutput{Copied: copied}, nil
+}
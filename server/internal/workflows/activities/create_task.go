@@ -0,0 +1,50 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type CreateTaskInput struct {
+	Options task.Options `json:"options"`
+}
+
+type CreateTaskOutput struct {
+	TaskID uuid.UUID `json:"task_id"`
+}
+
+func (a *Activities) ExecuteCreateTask(
+	ctx workflow.Context,
+	input *CreateTaskInput,
+) workflow.Future[*CreateTaskOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*CreateTaskOutput](ctx, options, a.CreateTask, input)
+}
+
+func (a *Activities) CreateTask(ctx context.Context, input *CreateTaskInput) (*CreateTaskOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"scope", input.Options.Scope,
+		"entity_id", input.Options.EntityID(),
+		"parent_id", input.Options.ParentID.String(),
+	)
+	logger.Info("creating task")
+
+	t, err := a.TaskSvc.CreateTask(ctx, input.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return &CreateTaskOutput{TaskID: t.TaskID}, nil
+}
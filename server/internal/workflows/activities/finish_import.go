@@ -0,0 +1,90 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type FinishImportInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type FinishImportOutput struct {
+	SequencesCopied int `json:"sequences_copied"`
+}
+
+func (a *Activities) ExecuteFinishImport(
+	ctx workflow.Context,
+	input *FinishImportInput,
+) workflow.Future[*FinishImportOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*FinishImportOutput](ctx, options, a.FinishImport, input)
+}
+
+// FinishImport copies the current sequence values from the import source,
+// which logical replication doesn't replicate, and then removes the import
+// subscription along with its replication slot and publication on the source.
+func (a *Activities) FinishImport(ctx context.Context, input *FinishImportInput) (*FinishImportOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+	logger.Info("finishing import")
+
+	name := database.ImportSubscriptionName(input.DatabaseID)
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	sourceDSN, err := importSourceDSN(ctx, conn, input.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	source, err := connectToImportSource(ctx, sourceDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close(ctx)
+
+	sequences, err := postgres.ListSequences().Structs(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sequences on import source: %w", err)
+	}
+	for _, seq := range sequences {
+		if err := postgres.SetSequenceValue(seq).Exec(ctx, conn); err != nil {
+			return nil, fmt.Errorf("failed to set value for sequence %s: %w", seq.Name, err)
+		}
+	}
+
+	if err := postgres.SetSafeIdentifiers().Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to set safe identifiers: %w", err)
+	}
+	if err := postgres.DropImportSubscription(name).Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to drop import subscription: %w", err)
+	}
+	if err := postgres.DropImportPublication(name).Exec(ctx, source); err != nil {
+		return nil, fmt.Errorf("failed to drop publication on import source: %w", err)
+	}
+
+	logger.Info("finished import", "sequences_copied", len(sequences))
+
+	return &FinishImportOutput{SequencesCopied: len(sequences)}, nil
+}
@@ -0,0 +1,104 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type GetImportStatusInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type GetImportStatusOutput struct {
+	TablesSyncing int64 `json:"tables_syncing"`
+	LagBytes      int64 `json:"lag_bytes"`
+}
+
+// CaughtUp is true once every table has been copied and the subscription has
+// confirmed all of the source's WAL.
+func (o *GetImportStatusOutput) CaughtUp() bool {
+	return o.TablesSyncing == 0 && o.LagBytes == 0
+}
+
+func (a *Activities) ExecuteGetImportStatus(
+	ctx workflow.Context,
+	input *GetImportStatusInput,
+) workflow.Future[*GetImportStatusOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*GetImportStatusOutput](ctx, options, a.GetImportStatus, input)
+}
+
+// GetImportStatus reports the progress of the import subscription on the
+// given primary instance. The lag is measured on the source server.
+func (a *Activities) GetImportStatus(ctx context.Context, input *GetImportStatusInput) (*GetImportStatusOutput, error) {
+	name := database.ImportSubscriptionName(input.DatabaseID)
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	sourceDSN, err := importSourceDSN(ctx, conn, input.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	syncing, err := postgres.ImportTablesSyncing(name).Scalar(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query import subscription tables: %w", err)
+	}
+
+	source, err := connectToImportSource(ctx, sourceDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close(ctx)
+
+	lag, err := postgres.ReplicationSlotLagBytes(name).Scalar(ctx, source)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("replication slot '%s' does not exist on the import source", name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to query replication lag on import source: %w", err)
+	}
+
+	return &GetImportStatusOutput{
+		TablesSyncing: syncing,
+		LagBytes:      lag,
+	}, nil
+}
+
+// importSourceDSN reads the source's connection string from the import
+// subscription so that it doesn't need to be stored anywhere else.
+func importSourceDSN(ctx context.Context, conn *pgx.Conn, databaseID string) (string, error) {
+	name := database.ImportSubscriptionName(databaseID)
+
+	exists, err := postgres.ImportSubscriptionExists(name).Scalar(ctx, conn)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for import subscription: %w", err)
+	}
+	if !exists {
+		return "", fmt.Errorf("database '%s' does not have an import in progress", databaseID)
+	}
+	dsn, err := postgres.ImportSubscriptionConnInfo(name).Scalar(ctx, conn)
+	if err != nil {
+		return "", fmt.Errorf("failed to get import source connection info: %w", err)
+	}
+
+	return dsn, nil
+}
@@ -0,0 +1,50 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type GetInstanceSessionsInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type GetInstanceSessionsOutput struct {
+	Sessions []postgres.Session `json:"sessions"`
+}
+
+func (a *Activities) ExecuteGetInstanceSessions(
+	ctx workflow.Context,
+	input *GetInstanceSessionsInput,
+) workflow.Future[*GetInstanceSessionsOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*GetInstanceSessionsOutput](ctx, options, a.GetInstanceSessions, input)
+}
+
+func (a *Activities) GetInstanceSessions(ctx context.Context, input *GetInstanceSessionsInput) (*GetInstanceSessionsOutput, error) {
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	sessions, err := postgres.GetSessions().Scalars(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+
+	return &GetInstanceSessionsOutput{Sessions: sessions}, nil
+}
@@ -0,0 +1,126 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type ImportSchemaInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+	SourceDSN    string `json:"source_dsn"`
+}
+
+type ImportSchemaOutput struct {
+	RolesCreated int `json:"roles_created"`
+}
+
+func (a *Activities) ExecuteImportSchema(
+	ctx workflow.Context,
+	input *ImportSchemaInput,
+) workflow.Future[*ImportSchemaOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*ImportSchemaOutput](ctx, options, a.ImportSchema, input)
+}
+
+// ImportSchema copies the roles and schema from an import source into the
+// primary instance of a new database. The dump tools are run on the instance
+// so that their versions match the instance's Postgres version.
+func (a *Activities) ImportSchema(ctx context.Context, input *ImportSchemaInput) (*ImportSchemaOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+	logger.Info("importing roles and schema")
+
+	// The password is passed through the environment so that it's not visible
+	// in the dump tools' command lines.
+	sourceDSN, password, err := database.SplitDSNPassword(input.SourceDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse import source dsn: %w", err)
+	}
+	var env []string
+	if password != "" {
+		env = []string{"PGPASSWORD=" + password}
+	}
+
+	var dump strings.Builder
+	err = a.Orchestrator.ExecuteInstanceCommandWithEnv(ctx, &dump, input.DatabaseID, input.InstanceID, env,
+		"pg_dumpall",
+		"--roles-only",
+		"--dbname="+sourceDSN,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump roles from import source: %w", err)
+	}
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	existing, err := postgres.ListRoleNames().Scalars(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing roles: %w", err)
+	}
+	roles, statements := database.SanitizeRolesDump(dump.String())
+	roles, statements = database.FilterImportedRoles(roles, statements, existing)
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := postgres.SetSafeIdentifiers().Exec(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to set safe identifiers: %w", err)
+	}
+	for i, role := range roles {
+		if err := postgres.CreateRoleIfNotExists(role).Exec(ctx, tx); err != nil {
+			return nil, fmt.Errorf("failed to create role[%d] from import source: %w", i, err)
+		}
+	}
+	for i, statement := range statements {
+		if _, err := tx.Exec(ctx, statement); err != nil {
+			return nil, fmt.Errorf("failed to execute statement[%d] from import source roles: %w", i, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit roles: %w", err)
+	}
+
+	connInfo, err := a.DatabaseService.GetInstanceConnectionInfo(ctx, input.DatabaseID, input.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance connection info: %w", err)
+	}
+	script := database.ImportSchemaScript(database.ImportSchemaScriptOptions{
+		SourceDSN:    sourceDSN,
+		DatabaseName: input.DatabaseName,
+		Port:         connInfo.AdminPort,
+	})
+	var out strings.Builder
+	err = a.Orchestrator.ExecuteInstanceCommandWithEnv(ctx, &out, input.DatabaseID, input.InstanceID, env, "sh", "-c", script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import schema: %w: %s", err, strings.TrimSpace(out.String()))
+	}
+
+	logger.Info("imported roles and schema", "roles_created", len(roles))
+
+	return &ImportSchemaOutput{RolesCreated: len(roles)}, nil
+}
@@ -0,0 +1,121 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/ds"
+	"github.com/pgEdge/control-plane/server/internal/etcd"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/storage"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type PgUpgradeInput struct {
+	DatabaseID      string                 `json:"database_id"`
+	InstanceID      string                 `json:"instance_id"`
+	HostID          string                 `json:"host_id"`
+	NodeName        string                 `json:"node_name"`
+	TaskID          uuid.UUID              `json:"task_id"`
+	Mode            database.PgUpgradeMode `json:"mode"`
+	PostgresVersion string                 `json:"postgres_version"`
+}
+
+type PgUpgradeOutput struct{}
+
+func (a *Activities) ExecutePgUpgrade(
+	ctx workflow.Context,
+	input *PgUpgradeInput,
+) workflow.Future[*PgUpgradeOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*PgUpgradeOutput](ctx, options, a.PgUpgrade, input)
+}
+
+func (a *Activities) PgUpgrade(ctx context.Context, input *PgUpgradeInput) (*PgUpgradeOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+		"mode", input.Mode,
+	)
+	logger.Info("running pg_upgrade")
+
+	taskSvc, err := do.Invoke[*task.Service](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := a.DatabaseService.GetInstanceSpec(ctx, input.DatabaseID, input.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance spec: %w", err)
+	}
+	if previous.PgEdgeVersion == nil {
+		return nil, fmt.Errorf("instance spec for '%s' is missing a pgedge version", input.InstanceID)
+	}
+	version, err := ds.ParsePgEdgeVersion(input.PostgresVersion, previous.PgEdgeVersion.SpockVersion.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse target version: %w", err)
+	}
+	spec := previous.Clone()
+	spec.PgEdgeVersion = version
+	// The pinned image is specific to the previous version.
+	if spec.OrchestratorOpts != nil && spec.OrchestratorOpts.Swarm != nil {
+		spec.OrchestratorOpts.Swarm.ResolvedImage = ""
+	}
+
+	taskLogWriter := task.NewTaskLogWriter(ctx, taskSvc, task.ScopeDatabase, input.DatabaseID, input.TaskID)
+	defer taskLogWriter.Close()
+
+	err = a.Orchestrator.PgUpgrade(ctx, taskLogWriter, &database.PgUpgradeOptions{
+		Mode:     input.Mode,
+		Previous: previous,
+		Spec:     spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run pg_upgrade in %s mode: %w", input.Mode, err)
+	}
+
+	if input.Mode == database.PgUpgradeModeLink {
+		// The upgraded cluster has a new system identifier, so Patroni needs
+		// to re-create its namespace when it starts up again.
+		client, err := a.patroniDCSClient(spec.PatroniDCS.IsExternal())
+		if err != nil {
+			return nil, err
+		}
+		_, err = storage.
+			NewDeletePrefixOp(client, patroni.ClusterPrefix(input.DatabaseID, input.NodeName)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete patroni namespace from DCS: %w", err)
+		}
+	}
+
+	logger.Info("pg_upgrade completed")
+
+	return &PgUpgradeOutput{}, nil
+}
+
+// patroniDCSClient returns a client for the etcd cluster that Patroni uses as
+// its DCS. This is the control plane's etcd unless external is true.
+func (a *Activities) patroniDCSClient(external bool) (*clientv3.Client, error) {
+	if !external {
+		return do.Invoke[*clientv3.Client](a.Injector)
+	}
+	dcs, err := do.Invoke[*etcd.PatroniDCS](a.Injector)
+	if err != nil {
+		return nil, err
+	}
+	return dcs.Client()
+}
@@ -0,0 +1,66 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type ReinitializeReplicaInput struct {
+	DatabaseID string `json:"database_id"`
+	InstanceID string `json:"instance_id"`
+	HostID     string `json:"host_id"`
+}
+
+type ReinitializeReplicaOutput struct {
+	Reinitialized bool `json:"reinitialized"`
+}
+
+func (a *Activities) ExecuteReinitializeReplica(
+	ctx workflow.Context,
+	input *ReinitializeReplicaInput,
+) workflow.Future[*ReinitializeReplicaOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*ReinitializeReplicaOutput](ctx, options, a.ReinitializeReplica, input)
+}
+
+// ReinitializeReplica rebuilds a replica from its primary with Patroni's
+// reinitialize operation unless the replica is already running.
+func (a *Activities) ReinitializeReplica(ctx context.Context, input *ReinitializeReplicaInput) (*ReinitializeReplicaOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+
+	connInfo, err := a.DatabaseService.GetInstanceConnectionInfo(ctx, input.DatabaseID, input.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance connection info: %w", err)
+	}
+
+	patroniClient := patroni.NewClient(connInfo.PatroniURL(), nil)
+
+	status, err := patroniClient.GetInstanceStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get patroni status: %w", err)
+	}
+	if status.InRunningState() {
+		return &ReinitializeReplicaOutput{}, nil
+	}
+
+	logger.Info("reinitializing replica")
+	if err := patroniClient.Reinitialize(ctx); err != nil {
+		return nil, fmt.Errorf("failed to reinitialize replica: %w", err)
+	}
+
+	return &ReinitializeReplicaOutput{Reinitialized: true}, nil
+}
@@ -0,0 +1,59 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type ResetQueryStatsInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type ResetQueryStatsOutput struct{}
+
+func (a *Activities) ExecuteResetQueryStats(
+	ctx workflow.Context,
+	input *ResetQueryStatsInput,
+) workflow.Future[*ResetQueryStatsOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*ResetQueryStatsOutput](ctx, options, a.ResetQueryStats, input)
+}
+
+// ResetQueryStats discards the pg_stat_statements statistics on the given
+// instance along with the samples that we've collected from it.
+func (a *Activities) ResetQueryStats(ctx context.Context, input *ResetQueryStatsInput) (*ResetQueryStatsOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+	logger.Info("resetting query stats")
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	if err := postgres.ResetQueryStats().Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to reset pg_stat_statements: %w", err)
+	}
+	if err := a.DatabaseService.DeleteQueryStats(ctx, input.DatabaseID, input.InstanceID); err != nil {
+		return nil, err
+	}
+
+	return &ResetQueryStatsOutput{}, nil
+}
@@ -0,0 +1,62 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/patroni"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type SelectUpToDateReplicaInput struct {
+	DatabaseID       string   `json:"database_id"`
+	LeaderInstanceID string   `json:"leader_instance_id"`
+	Candidates       []string `json:"candidates"`
+}
+
+type SelectUpToDateReplicaOutput struct {
+	CandidateInstanceID string `json:"candidate_instance_id"`
+}
+
+func (a *Activities) ExecuteSelectUpToDateReplica(
+	ctx workflow.Context,
+	hostID string,
+	input *SelectUpToDateReplicaInput,
+) workflow.Future[*SelectUpToDateReplicaOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(hostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*SelectUpToDateReplicaOutput](ctx, options, a.SelectUpToDateReplica, input)
+}
+
+func (a *Activities) SelectUpToDateReplica(ctx context.Context, input *SelectUpToDateReplicaInput) (*SelectUpToDateReplicaOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"leader_instance_id", input.LeaderInstanceID,
+	)
+	logger.Info("selecting up-to-date replica")
+
+	connInfo, err := a.DatabaseService.GetInstanceConnectionInfo(ctx, input.DatabaseID, input.LeaderInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance connection info: %w", err)
+	}
+
+	state, err := patroni.NewClient(connInfo.PatroniURL(), nil).GetClusterStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster status: %w", err)
+	}
+
+	candidate, err := database.UpToDateReplica(state, input.Candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SelectUpToDateReplicaOutput{CandidateInstanceID: candidate}, nil
+}
@@ -0,0 +1,43 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type SetDatabaseImportingInput struct {
+	DatabaseID string `json:"database_id"`
+	Importing  bool   `json:"importing"`
+}
+
+type SetDatabaseImportingOutput struct{}
+
+func (a *Activities) ExecuteSetDatabaseImporting(
+	ctx workflow.Context,
+	input *SetDatabaseImportingInput,
+) workflow.Future[*SetDatabaseImportingOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*SetDatabaseImportingOutput](ctx, options, a.SetDatabaseImporting, input)
+}
+
+func (a *Activities) SetDatabaseImporting(ctx context.Context, input *SetDatabaseImportingInput) (*SetDatabaseImportingOutput, error) {
+	logger := activity.Logger(ctx).With("database_id", input.DatabaseID)
+	logger.Debug("setting database import marker", "importing", input.Importing)
+
+	err := a.DatabaseService.SetDatabaseImporting(ctx, input.DatabaseID, input.Importing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set database import marker: %w", err)
+	}
+
+	return &SetDatabaseImportingOutput{}, nil
+}
@@ -0,0 +1,95 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type SignalSessionInput struct {
+	DatabaseID   string                 `json:"database_id"`
+	DatabaseName string                 `json:"database_name"`
+	InstanceID   string                 `json:"instance_id"`
+	HostID       string                 `json:"host_id"`
+	PID          int                    `json:"pid"`
+	Action       database.SessionAction `json:"action"`
+}
+
+type SignalSessionOutput struct {
+	// Session is the state of the session before it was signaled.
+	Session *postgres.Session `json:"session"`
+}
+
+func (a *Activities) ExecuteSignalSession(
+	ctx workflow.Context,
+	input *SignalSessionInput,
+) workflow.Future[*SignalSessionOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*SignalSessionOutput](ctx, options, a.SignalSession, input)
+}
+
+// SignalSession cancels the current query of or terminates a client session.
+func (a *Activities) SignalSession(ctx context.Context, input *SignalSessionInput) (*SignalSessionOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+		"pid", input.PID,
+		"action", input.Action,
+	)
+	logger.Info("signaling session")
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	sessions, err := postgres.GetSessions().Scalars(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	var session *postgres.Session
+	for _, s := range sessions {
+		if s.PID == input.PID {
+			session = &s
+			break
+		}
+	}
+	if session == nil {
+		return nil, fmt.Errorf("%w: %d", database.ErrSessionNotFound, input.PID)
+	}
+
+	var query postgres.Query[bool]
+	switch input.Action {
+	case database.SessionActionCancel:
+		query = postgres.CancelSession(input.PID)
+	case database.SessionActionTerminate:
+		query = postgres.TerminateSession(input.PID)
+	default:
+		return nil, input.Action.Validate()
+	}
+	ok, err := query.Scalar(ctx, conn)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %d", database.ErrSessionNotFound, input.PID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to %s session: %w", input.Action, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("failed to %s session %d", input.Action, input.PID)
+	}
+
+	return &SignalSessionOutput{Session: session}, nil
+}
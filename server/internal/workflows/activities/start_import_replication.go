@@ -0,0 +1,75 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type StartImportReplicationInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+	SourceDSN    string `json:"source_dsn"`
+}
+
+type StartImportReplicationOutput struct{}
+
+func (a *Activities) ExecuteStartImportReplication(
+	ctx workflow.Context,
+	input *StartImportReplicationInput,
+) workflow.Future[*StartImportReplicationOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*StartImportReplicationOutput](ctx, options, a.StartImportReplication, input)
+}
+
+// StartImportReplication publishes every table on the import source and
+// subscribes the primary instance of the new database to that publication.
+func (a *Activities) StartImportReplication(ctx context.Context, input *StartImportReplicationInput) (*StartImportReplicationOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+	logger.Info("starting import replication")
+
+	name := database.ImportSubscriptionName(input.DatabaseID)
+
+	source, err := connectToImportSource(ctx, input.SourceDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close(ctx)
+
+	if err := postgres.CreateImportPublication(name).Exec(ctx, source); err != nil {
+		return nil, fmt.Errorf("failed to create publication on import source: %w", err)
+	}
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	if err := postgres.SetSafeIdentifiers().Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to set safe identifiers: %w", err)
+	}
+	if err := postgres.CreateImportSubscription(name, input.SourceDSN).Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to create import subscription: %w", err)
+	}
+
+	logger.Info("started import replication")
+
+	return &StartImportReplicationOutput{}, nil
+}
@@ -0,0 +1,50 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type UpdateDbSpecInput struct {
+	Spec *database.Spec `json:"spec"`
+	// InPlaceUpgrade allows instances to move to a newer major version.
+	InPlaceUpgrade bool `json:"in_place_upgrade,omitempty"`
+}
+
+type UpdateDbSpecOutput struct{}
+
+func (a *Activities) ExecuteUpdateDbSpec(
+	ctx workflow.Context,
+	input *UpdateDbSpecInput,
+) workflow.Future[*UpdateDbSpecOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(a.Config.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*UpdateDbSpecOutput](ctx, options, a.UpdateDbSpec, input)
+}
+
+func (a *Activities) UpdateDbSpec(ctx context.Context, input *UpdateDbSpecInput) (*UpdateDbSpecOutput, error) {
+	logger := activity.Logger(ctx).With("database_id", input.Spec.DatabaseID)
+	logger.Debug("updating database spec")
+
+	var err error
+	if input.InPlaceUpgrade {
+		err = a.DatabaseService.UpgradeDatabaseSpec(ctx, input.Spec)
+	} else {
+		err = a.DatabaseService.UpdateDatabaseSpec(ctx, input.Spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update database spec: %w", err)
+	}
+
+	return &UpdateDbSpecOutput{}, nil
+}
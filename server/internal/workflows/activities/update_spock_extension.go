@@ -0,0 +1,58 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/activity"
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+)
+
+type UpdateSpockExtensionInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type UpdateSpockExtensionOutput struct{}
+
+func (a *Activities) ExecuteUpdateSpockExtension(
+	ctx workflow.Context,
+	input *UpdateSpockExtensionInput,
+) workflow.Future[*UpdateSpockExtensionOutput] {
+	options := workflow.ActivityOptions{
+		Queue: utils.HostQueue(input.HostID),
+		RetryOptions: workflow.RetryOptions{
+			MaxAttempts: 1,
+		},
+	}
+	return workflow.ExecuteActivity[*UpdateSpockExtensionOutput](ctx, options, a.UpdateSpockExtension, input)
+}
+
+// UpdateSpockExtension updates the Spock extension on a primary instance after
+// a major version upgrade. pg_upgrade carries over the extension's catalog
+// objects from the previous version, which can be older than the version
+// that's installed with the new binaries.
+func (a *Activities) UpdateSpockExtension(ctx context.Context, input *UpdateSpockExtensionInput) (*UpdateSpockExtensionOutput, error) {
+	logger := activity.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+	)
+	logger.Info("updating spock extension")
+
+	conn, err := a.connectToInstance(ctx, input.DatabaseID, input.InstanceID, input.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	if err := postgres.UpdateSpockExtension().Exec(ctx, conn); err != nil {
+		return nil, fmt.Errorf("failed to update spock extension: %w", err)
+	}
+
+	return &UpdateSpockExtensionOutput{}, nil
+}
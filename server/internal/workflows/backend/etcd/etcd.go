@@ -167,24 +167,49 @@ func (b *Backend) RemoveWorkflowInstances(ctx context.Context, options ...backen
 	}
 
 	var ops []storage.TxnOperation
+	var batch int
+	commit := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		if err := b.store.Txn(ops...).Commit(ctx); err != nil {
+			return fmt.Errorf("failed to remove workflow instances: %w", err)
+		}
+		ops = nil
+		batch = 0
+		return nil
+	}
+
 	for _, instance := range instances {
 		if instance.State == core.WorkflowInstanceStateActive {
 			continue
 		}
+		if !ro.FinishedBefore.IsZero() {
+			// Instances that finished before we started recording the
+			// finish time fall back to their creation time.
+			finishedAt := instance.CreatedAt
+			if instance.FinishedAt != nil {
+				finishedAt = *instance.FinishedAt
+			}
+			if !finishedAt.Before(ro.FinishedBefore) {
+				continue
+			}
+		}
 		instanceID := instance.WorkflowInstance.InstanceID
 		executionID := instance.WorkflowInstance.ExecutionID
 		ops = append(ops,
 			b.store.WorkflowInstance.DeleteByKey(instanceID, executionID),
 			b.store.HistoryEvent.DeleteByInstanceExecution(instanceID, executionID),
 		)
+		batch++
+		if ro.BatchSize > 0 && batch >= ro.BatchSize {
+			if err := commit(); err != nil {
+				return err
+			}
+		}
 	}
 
-	err = b.store.Txn(ops...).Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to remove workflow instances: %w", err)
-	}
-
-	return nil
+	return commit()
 }
 
 func (b *Backend) GetWorkflowInstanceState(ctx context.Context, instance *workflow.Instance) (core.WorkflowInstanceState, error) {
@@ -0,0 +1,135 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/config"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+const (
+	etcdModePollInterval = 5 * time.Second
+	// etcdModeTimeout allows for the restart as well as the time it takes a
+	// new member to catch up with the leader and get promoted.
+	etcdModeTimeout = 10 * time.Minute
+)
+
+type ChangeEtcdModeInput struct {
+	TaskID uuid.UUID       `json:"task_id"`
+	HostID string          `json:"host_id"`
+	Mode   config.EtcdMode `json:"mode"`
+}
+
+type ChangeEtcdModeOutput struct{}
+
+func (w *Workflows) ChangeEtcdMode(ctx workflow.Context, input *ChangeEtcdModeInput) (*ChangeEtcdModeOutput, error) {
+	logger := workflow.Logger(ctx).With(
+		"host_id", input.HostID,
+		"etcd_mode", input.Mode,
+	)
+	logger.Info("changing etcd mode")
+
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			w.cancelTask(cleanupCtx, task.ScopeHost, input.HostID, input.TaskID, logger)
+		}
+	}()
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to change etcd mode")
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeHost,
+			EntityID:      input.HostID,
+			TaskID:        input.TaskID,
+			UpdateOptions: task.UpdateFail(cause),
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+
+		return cause
+	}
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx,
+			task.ScopeHost,
+			input.HostID,
+			input.TaskID,
+			entry,
+		)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeHost,
+		EntityID:      input.HostID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("restarting host '%s' to change its etcd mode to '%s'", input.HostID, input.Mode),
+	})
+
+	_, err := w.Activities.ExecuteChangeEtcdMode(ctx, &activities.ChangeEtcdModeInput{
+		HostID: input.HostID,
+		Mode:   input.Mode,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	deadline := workflow.Now(ctx).Add(etcdModeTimeout)
+	for {
+		out, err := w.Activities.ExecuteCheckEtcdMode(ctx, &activities.CheckEtcdModeInput{
+			HostID: input.HostID,
+			Mode:   input.Mode,
+		}).Get(ctx)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		if out.Complete {
+			break
+		}
+		if workflow.Now(ctx).After(deadline) {
+			return nil, handleError(fmt.Errorf("host did not change its etcd mode within %s: %s", etcdModeTimeout, out.Reason))
+		}
+		if err := workflow.Sleep(ctx, etcdModePollInterval); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	// Needs to come before the UpdateTaskInput or else clients will see the
+	// task complete before the log entry is added.
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("host '%s' is running in etcd '%s' mode", input.HostID, input.Mode),
+	})
+
+	updateTaskInput = &activities.UpdateTaskInput{
+		Scope:         task.ScopeHost,
+		EntityID:      input.HostID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateComplete(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("successfully changed etcd mode")
+
+	return &ChangeEtcdModeOutput{}, nil
+}
@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/database/operations"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+type CheckDriftInput struct {
+	Spec      *database.Spec     `json:"spec"`
+	Variables resource.Variables `json:"variables"`
+}
+
+type CheckDriftOutput struct {
+	Resources []*resource.EventSummary `json:"resources"`
+}
+
+// CheckDrift refreshes the database's resources and then plans an update with
+// its current spec. Any resources in the resulting plan have drifted from the
+// spec. Unlike RefreshCurrentState, this workflow does not record task events
+// and it never persists the refreshed state.
+func (w *Workflows) CheckDrift(ctx workflow.Context, input *CheckDriftInput) (*CheckDriftOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	logger.Info("checking database for drift")
+
+	getCurrentOutput, err := w.Activities.
+		ExecuteGetCurrentState(ctx, &activities.GetCurrentStateInput{
+			DatabaseID: databaseID,
+		}).
+		Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current state: %w", err)
+	}
+	current := getCurrentOutput.State
+
+	planRefreshOutput, err := w.Activities.
+		ExecutePlanRefresh(ctx, &activities.PlanRefreshInput{
+			DatabaseID: databaseID,
+			State:      current,
+		}).
+		Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan refresh: %w", err)
+	}
+
+	err = w.applyEvents(ctx, databaseID, uuid.Nil, current, input.Variables, planRefreshOutput.Plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh current state: %w", err)
+	}
+
+	planOutput, err := w.ExecutePlanUpdate(ctx, &PlanUpdateInput{
+		Spec:    input.Spec,
+		Current: current,
+		Options: operations.UpdateDatabaseOptions{},
+	}).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan update: %w", err)
+	}
+
+	resources := database.DriftedResources(planOutput.Plans)
+	logger.Info("finished checking database for drift", "drifted_resources", len(resources))
+
+	return &CheckDriftOutput{
+		Resources: resources,
+	}, nil
+}
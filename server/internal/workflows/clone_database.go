@@ -0,0 +1,131 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+type CloneDatabaseInput struct {
+	TaskID    uuid.UUID                    `json:"task_id"`
+	Spec      *database.Spec               `json:"spec"`
+	Backup    *CreatePgBackRestBackupInput `json:"backup,omitempty"`
+	Variables resource.Variables           `json:"variables"`
+}
+
+type CloneDatabaseOutput struct{}
+
+// CloneDatabase creates a database that's restored from a node of another
+// database. When Backup is set, a fresh backup of the source node is taken
+// before the clone is restored so that the clone starts from that backup.
+func (w *Workflows) CloneDatabase(ctx workflow.Context, input *CloneDatabaseInput) (*CloneDatabaseOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			updateStateInput := &activities.UpdateDbStateInput{
+				DatabaseID: databaseID,
+				State:      database.DatabaseStateFailed,
+			}
+			_, err := w.Activities.ExecuteUpdateDbState(cleanupCtx, updateStateInput).Get(cleanupCtx)
+			if err != nil {
+				logger.With("error", err).Error("failed to update database state")
+			}
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, databaseID, input.TaskID, logger)
+		}
+	}()
+
+	logger.Info("cloning database")
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	finish := func(state database.DatabaseState, opts task.UpdateOptions) {
+		updateStateInput := &activities.UpdateDbStateInput{
+			DatabaseID: databaseID,
+			State:      state,
+		}
+		_, err := w.Activities.ExecuteUpdateDbState(ctx, updateStateInput).Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to update database state")
+		}
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      databaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: opts,
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to clone database")
+		finish(database.DatabaseStateFailed, task.UpdateFail(cause))
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      databaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	if input.Backup != nil {
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf(
+				"taking a fresh backup of node '%s' of database '%s'",
+				input.Backup.NodeName, input.Backup.DatabaseID,
+			),
+			Fields: map[string]any{
+				"backup_task_id": input.Backup.TaskID.String(),
+			},
+		})
+		_, err := workflow.CreateSubWorkflowInstance[*CreatePgBackRestBackupOutput](
+			ctx,
+			workflow.SubWorkflowOptions{},
+			w.CreatePgBackRestBackup,
+			input.Backup,
+		).Get(ctx)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to take a fresh backup of the source node: %w", err))
+		}
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf(
+			"restoring clone from node '%s' of database '%s'",
+			input.Spec.RestoreConfig.SourceNodeName, input.Spec.RestoreConfig.SourceDatabaseID,
+		),
+	})
+	if _, err := w.reconcileDatabase(ctx, input.TaskID, input.Spec, input.Variables, false); err != nil {
+		return nil, handleError(err)
+	}
+
+	finish(database.DatabaseStateAvailable, task.UpdateComplete())
+
+	logger.Info("successfully cloned database")
+
+	return &CloneDatabaseOutput{}, nil
+}
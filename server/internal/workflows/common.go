@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/cschleiden/go-workflows/workflow"
 	"github.com/google/uuid"
@@ -188,6 +190,13 @@ func (w *Workflows) updateTask(
 	logger *slog.Logger,
 	input *activities.UpdateTaskInput,
 ) error {
+	status := input.UpdateOptions.Status
+	if status != nil && *status == task.StatusRunning {
+		if err := w.acquireTaskSlot(ctx, logger, input); err != nil {
+			return err
+		}
+	}
+
 	_, err := w.Activities.
 		ExecuteUpdateTask(ctx, input).
 		Get(ctx)
@@ -198,6 +207,67 @@ func (w *Workflows) updateTask(
 	return nil
 }
 
+// taskSlotPollInterval is how often a queued task checks whether it can
+// acquire a concurrency slot.
+const taskSlotPollInterval = 15 * time.Second
+
+// acquireTaskSlot blocks until the task can acquire a concurrency slot. While
+// it waits, the task is marked as queued and the reasons it's waiting are
+// recorded in the task log each time they change.
+func (w *Workflows) acquireTaskSlot(
+	ctx workflow.Context,
+	logger *slog.Logger,
+	input *activities.UpdateTaskInput,
+) error {
+	var queued bool
+	var lastReasons []string
+	for {
+		out, err := w.Activities.
+			ExecuteAcquireTaskSlot(ctx, &activities.AcquireTaskSlotInput{
+				Scope:         input.Scope,
+				EntityID:      input.EntityID,
+				TaskID:        input.TaskID,
+				UpdateOptions: input.UpdateOptions,
+			}).
+			Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to acquire task slot")
+			return fmt.Errorf("failed to acquire task slot: %w", err)
+		}
+		if out.Acquired {
+			return nil
+		}
+		if !queued {
+			logger.Info("task is queued")
+			err := w.updateTask(ctx, logger, &activities.UpdateTaskInput{
+				Scope:         input.Scope,
+				EntityID:      input.EntityID,
+				TaskID:        input.TaskID,
+				UpdateOptions: task.UpdateQueued(),
+			})
+			if err != nil {
+				return err
+			}
+			queued = true
+		}
+		if !slices.Equal(out.Reasons, lastReasons) {
+			err := w.logTaskEvent(ctx, input.Scope, input.EntityID, input.TaskID, task.LogEntry{
+				Message: "waiting for other tasks to complete",
+				Fields: map[string]any{
+					"reasons": out.Reasons,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			lastReasons = out.Reasons
+		}
+		if err := workflow.Sleep(ctx, taskSlotPollInterval); err != nil {
+			return err
+		}
+	}
+}
+
 func (w *Workflows) logTaskEvent(
 	ctx workflow.Context,
 	scope task.Scope,
@@ -281,6 +351,7 @@ func (w *Workflows) getNodeResources(
 		InstanceResources: resources,
 		RestoreConfig:     node.RestoreConfig,
 		Scripts:           scripts,
+		QueryStats:        node.QueryStats.IsEnabled(),
 	}, nil
 }
 
@@ -288,28 +359,35 @@ func (w *Workflows) getScripts(
 	ctx workflow.Context,
 	node *database.NodeInstances,
 ) (database.Scripts, error) {
-	if node.Scripts == nil {
-		return nil, nil
+	statements := map[database.ScriptName][]string{}
+	if node.Scripts != nil {
+		statements[database.ScriptNamePostInit] = node.Scripts.PostInit
+		statements[database.ScriptNamePostDatabaseCreate] = node.Scripts.PostDatabaseCreate
+	}
+	if node.RestoreConfig != nil {
+		statements[database.ScriptNameScrub] = node.RestoreConfig.ScrubStatements
 	}
+
 	var scriptNames []database.ScriptName
 	scripts := database.Scripts{}
-	if len(node.Scripts.PostInit) != 0 {
-		scripts[database.ScriptNamePostInit] = &database.Script{
-			DatabaseID: node.DatabaseID,
-			NodeName:   node.NodeName,
-			Name:       database.ScriptNamePostInit,
-			Statements: node.Scripts.PostInit,
+	for _, name := range []database.ScriptName{
+		database.ScriptNamePostInit,
+		database.ScriptNamePostDatabaseCreate,
+		database.ScriptNameScrub,
+	} {
+		if len(statements[name]) == 0 {
+			continue
 		}
-		scriptNames = append(scriptNames, database.ScriptNamePostInit)
-	}
-	if len(node.Scripts.PostDatabaseCreate) != 0 {
-		scripts[database.ScriptNamePostDatabaseCreate] = &database.Script{
+		scripts[name] = &database.Script{
 			DatabaseID: node.DatabaseID,
 			NodeName:   node.NodeName,
-			Name:       database.ScriptNamePostDatabaseCreate,
-			Statements: node.Scripts.PostDatabaseCreate,
+			Name:       name,
+			Statements: statements[name],
 		}
-		scriptNames = append(scriptNames, database.ScriptNamePostDatabaseCreate)
+		scriptNames = append(scriptNames, name)
+	}
+	if node.Scripts == nil && len(scriptNames) == 0 {
+		return nil, nil
 	}
 	in := &activities.GetScriptResultsInput{
 		DatabaseID:  node.DatabaseID,
@@ -0,0 +1,354 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+const (
+	importPollInterval  = 30 * time.Second
+	cutoverPollInterval = 5 * time.Second
+	cutoverTimeout      = 5 * time.Minute
+)
+
+type ImportDatabaseInput struct {
+	TaskID    uuid.UUID              `json:"task_id"`
+	Spec      *database.Spec         `json:"spec"`
+	Source    *database.ImportSource `json:"source"`
+	Variables resource.Variables     `json:"variables"`
+}
+
+type ImportDatabaseOutput struct{}
+
+// ImportDatabase creates a database and populates it from an external Postgres
+// server. The source's roles and schema are copied into the new database, and
+// then a logical replication subscription copies its data and streams its
+// changes. The task completes once the subscription has caught up with the
+// source, and the subscription keeps running until the import is cut over.
+func (w *Workflows) ImportDatabase(ctx workflow.Context, input *ImportDatabaseInput) (*ImportDatabaseOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			updateStateInput := &activities.UpdateDbStateInput{
+				DatabaseID: databaseID,
+				State:      database.DatabaseStateFailed,
+			}
+			_, err := w.Activities.ExecuteUpdateDbState(cleanupCtx, updateStateInput).Get(cleanupCtx)
+			if err != nil {
+				logger.With("error", err).Error("failed to update database state")
+			}
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, databaseID, input.TaskID, logger)
+		}
+	}()
+
+	logger.Info("importing database")
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	finish := func(state database.DatabaseState, opts task.UpdateOptions) {
+		updateStateInput := &activities.UpdateDbStateInput{
+			DatabaseID: databaseID,
+			State:      state,
+		}
+		_, err := w.Activities.ExecuteUpdateDbState(ctx, updateStateInput).Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to update database state")
+		}
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      databaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: opts,
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to import database")
+		finish(database.DatabaseStateFailed, task.UpdateFail(cause))
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      databaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	// The marker prevents updates from adding nodes until the cutover.
+	_, err := w.Activities.ExecuteSetDatabaseImporting(ctx, &activities.SetDatabaseImportingInput{
+		DatabaseID: databaseID,
+		Importing:  true,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	if _, err := w.reconcileDatabase(ctx, input.TaskID, input.Spec, input.Variables, false); err != nil {
+		return nil, handleError(err)
+	}
+
+	primary, err := w.importPrimary(ctx, input.Spec)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: "copying roles and schema from the import source",
+	})
+	schemaOutput, err := w.Activities.ExecuteImportSchema(ctx, &activities.ImportSchemaInput{
+		DatabaseID:   databaseID,
+		DatabaseName: input.Spec.DatabaseName,
+		InstanceID:   primary.InstanceID,
+		HostID:       primary.HostID,
+		SourceDSN:    input.Source.DSN,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("created %d roles from the import source", schemaOutput.RolesCreated),
+	})
+
+	_, err = w.Activities.ExecuteStartImportReplication(ctx, &activities.StartImportReplicationInput{
+		DatabaseID:   databaseID,
+		DatabaseName: input.Spec.DatabaseName,
+		InstanceID:   primary.InstanceID,
+		HostID:       primary.HostID,
+		SourceDSN:    input.Source.DSN,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	logTaskEvent(task.LogEntry{
+		Message: "started replication from the import source",
+	})
+
+	statusInput := &activities.GetImportStatusInput{
+		DatabaseID:   databaseID,
+		DatabaseName: input.Spec.DatabaseName,
+		InstanceID:   primary.InstanceID,
+		HostID:       primary.HostID,
+	}
+	for {
+		status, err := w.Activities.ExecuteGetImportStatus(ctx, statusInput).Get(ctx)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		if status.CaughtUp() {
+			break
+		}
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf(
+				"waiting for import to catch up: %d tables copying, %d bytes of lag",
+				status.TablesSyncing, status.LagBytes,
+			),
+			Fields: map[string]any{
+				"tables_syncing": status.TablesSyncing,
+				"lag_bytes":      status.LagBytes,
+			},
+		})
+		if err := workflow.Sleep(ctx, importPollInterval); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	// Needs to come before the task update or else clients will see the task
+	// complete before the log entry is added.
+	logTaskEvent(task.LogEntry{
+		Message: "import has caught up with the source and is ready for cutover",
+	})
+	finish(database.DatabaseStateAvailable, task.UpdateComplete())
+
+	logger.Info("successfully imported database")
+
+	return &ImportDatabaseOutput{}, nil
+}
+
+type CutoverImportInput struct {
+	TaskID uuid.UUID      `json:"task_id"`
+	Spec   *database.Spec `json:"spec"`
+}
+
+type CutoverImportOutput struct{}
+
+// CutoverImport waits for the import subscription to confirm all of the
+// source's changes, and then copies the source's sequence values and removes
+// the subscription. Writes to the source should be stopped before the cutover.
+// The database is left unchanged if the cutover fails.
+func (w *Workflows) CutoverImport(ctx workflow.Context, input *CutoverImportInput) (*CutoverImportOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			updateStateInput := &activities.UpdateDbStateInput{
+				DatabaseID: databaseID,
+				State:      database.DatabaseStateAvailable,
+			}
+			_, err := w.Activities.ExecuteUpdateDbState(cleanupCtx, updateStateInput).Get(cleanupCtx)
+			if err != nil {
+				logger.With("error", err).Error("failed to update database state")
+			}
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, databaseID, input.TaskID, logger)
+		}
+	}()
+
+	logger.Info("cutting over import")
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	finish := func(opts task.UpdateOptions) {
+		updateStateInput := &activities.UpdateDbStateInput{
+			DatabaseID: databaseID,
+			State:      database.DatabaseStateAvailable,
+		}
+		_, err := w.Activities.ExecuteUpdateDbState(ctx, updateStateInput).Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to update database state")
+		}
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      databaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: opts,
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to cut over import")
+		finish(task.UpdateFail(cause))
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      databaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	primary, err := w.importPrimary(ctx, input.Spec)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	statusInput := &activities.GetImportStatusInput{
+		DatabaseID:   databaseID,
+		DatabaseName: input.Spec.DatabaseName,
+		InstanceID:   primary.InstanceID,
+		HostID:       primary.HostID,
+	}
+	deadline := workflow.Now(ctx).Add(cutoverTimeout)
+	for {
+		status, err := w.Activities.ExecuteGetImportStatus(ctx, statusInput).Get(ctx)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		if status.CaughtUp() {
+			break
+		}
+		if workflow.Now(ctx).After(deadline) {
+			return nil, handleError(fmt.Errorf(
+				"import still has %d tables copying and %d bytes of lag after %s; stop writes to the source and retry the cutover",
+				status.TablesSyncing, status.LagBytes, cutoverTimeout,
+			))
+		}
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("waiting for lag to reach zero: %d bytes of lag", status.LagBytes),
+			Fields: map[string]any{
+				"tables_syncing": status.TablesSyncing,
+				"lag_bytes":      status.LagBytes,
+			},
+		})
+		if err := workflow.Sleep(ctx, cutoverPollInterval); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	out, err := w.Activities.ExecuteFinishImport(ctx, &activities.FinishImportInput{
+		DatabaseID:   databaseID,
+		DatabaseName: input.Spec.DatabaseName,
+		InstanceID:   primary.InstanceID,
+		HostID:       primary.HostID,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("copied %d sequence values from the import source", out.SequencesCopied),
+	})
+
+	_, err = w.Activities.ExecuteSetDatabaseImporting(ctx, &activities.SetDatabaseImportingInput{
+		DatabaseID: databaseID,
+		Importing:  false,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	// Needs to come before the task update or else clients will see the task
+	// complete before the log entry is added.
+	logTaskEvent(task.LogEntry{
+		Message: "stopped replication from the import source",
+	})
+	finish(task.UpdateComplete())
+
+	logger.Info("successfully cut over import")
+
+	return &CutoverImportOutput{}, nil
+}
+
+// importPrimary returns the primary instance of the database's only node.
+func (w *Workflows) importPrimary(ctx workflow.Context, spec *database.Spec) (*database.InstanceSpec, error) {
+	nodes, err := spec.NodeInstances()
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 1 || len(nodes[0].Instances) == 0 {
+		return nil, errors.New("imports require a database with exactly one node")
+	}
+
+	return w.nodePrimary(ctx, nodes[0])
+}
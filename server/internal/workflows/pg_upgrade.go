@@ -0,0 +1,309 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+type PgUpgradeInput struct {
+	TaskID    uuid.UUID               `json:"task_id"`
+	Spec      *database.Spec          `json:"spec"`
+	Plan      *database.PgUpgradePlan `json:"plan"`
+	Variables resource.Variables      `json:"variables"`
+}
+
+type PgUpgradeOutput struct{}
+
+// PgUpgrade upgrades each node in the database to a new major version in
+// place. Every node's primary is checked with pg_upgrade --check before any
+// node is modified. Then, one node at a time, the node's instances are
+// stopped, the primary is upgraded with pg_upgrade --link, and the replicas are
+// rebuilt from the upgraded primary.
+func (w *Workflows) PgUpgrade(ctx workflow.Context, input *PgUpgradeInput) (*PgUpgradeOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			updateStateInput := &activities.UpdateDbStateInput{
+				DatabaseID: databaseID,
+				State:      database.DatabaseStateFailed,
+			}
+			_, err := w.Activities.ExecuteUpdateDbState(cleanupCtx, updateStateInput).Get(cleanupCtx)
+			if err != nil {
+				logger.With("error", err).Error("failed to update database state")
+			}
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, databaseID, input.TaskID, logger)
+		}
+	}()
+
+	logger.Info("upgrading database major version with pg_upgrade", "postgres_version", input.Plan.PostgresVersion)
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	finish := func(state database.DatabaseState, opts task.UpdateOptions) {
+		updateStateInput := &activities.UpdateDbStateInput{
+			DatabaseID: databaseID,
+			State:      state,
+		}
+		_, err := w.Activities.ExecuteUpdateDbState(ctx, updateStateInput).Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to update database state")
+		}
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      databaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: opts,
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to upgrade database")
+		finish(database.DatabaseStateFailed, task.UpdateFail(cause))
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      databaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	nodes, err := input.Spec.NodeInstances()
+	if err != nil {
+		return nil, handleError(err)
+	}
+	nodesByName := make(map[string]*database.NodeInstances, len(nodes))
+	for _, node := range nodes {
+		nodesByName[node.NodeName] = node
+	}
+
+	plan := input.Plan
+	primaries := make(map[string]*database.InstanceSpec, len(plan.NodeNames))
+	for _, name := range plan.NodeNames {
+		node, ok := nodesByName[name]
+		if !ok || len(node.Instances) == 0 {
+			return nil, handleError(fmt.Errorf("node '%s' has no instances", name))
+		}
+		primary, err := w.nodePrimary(ctx, node)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		primaries[name] = primary
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("checking node '%s' with pg_upgrade --check", name),
+			Fields: map[string]any{
+				"node_name":   name,
+				"instance_id": primary.InstanceID,
+				"stage":       "check",
+			},
+		})
+		_, err = w.Activities.ExecutePgUpgrade(ctx, &activities.PgUpgradeInput{
+			DatabaseID:      databaseID,
+			InstanceID:      primary.InstanceID,
+			HostID:          primary.HostID,
+			NodeName:        name,
+			TaskID:          input.TaskID,
+			Mode:            database.PgUpgradeModeCheck,
+			PostgresVersion: plan.PostgresVersion,
+		}).Get(ctx)
+		if err != nil {
+			// Nothing has been modified yet, so the database is still usable.
+			cause := fmt.Errorf("pre-upgrade check failed for node '%s': %w", name, err)
+			logger.With("error", cause).Error("failed to upgrade database")
+			finish(database.DatabaseStateAvailable, task.UpdateFail(cause))
+
+			return nil, cause
+		}
+	}
+
+	spec := input.Spec
+	for i, name := range plan.NodeNames {
+		node := nodesByName[name]
+		primary := primaries[name]
+		var replicas []*database.InstanceSpec
+		for _, instance := range node.Instances {
+			if instance.InstanceID != primary.InstanceID {
+				replicas = append(replicas, instance)
+			}
+		}
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("upgrading node '%s' (%d of %d): stopping instances", name, i+1, len(plan.NodeNames)),
+			Fields: map[string]any{
+				"node_name": name,
+				"stage":     "stop",
+			},
+		})
+		// Stopping the replicas first prevents them from being promoted.
+		for _, instance := range append(slices.Clone(replicas), primary) {
+			_, err := w.Activities.ExecuteStopInstance(ctx, &activities.StopInstanceInput{
+				DatabaseID: databaseID,
+				InstanceID: instance.InstanceID,
+				HostID:     instance.HostID,
+				TaskID:     input.TaskID,
+			}).Get(ctx)
+			if err != nil {
+				return nil, handleError(fmt.Errorf("failed to stop instance '%s': %w", instance.InstanceID, err))
+			}
+		}
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("running pg_upgrade --link on node '%s'", name),
+			Fields: map[string]any{
+				"node_name":   name,
+				"instance_id": primary.InstanceID,
+				"stage":       "link",
+			},
+		})
+		_, err := w.Activities.ExecutePgUpgrade(ctx, &activities.PgUpgradeInput{
+			DatabaseID:      databaseID,
+			InstanceID:      primary.InstanceID,
+			HostID:          primary.HostID,
+			NodeName:        name,
+			TaskID:          input.TaskID,
+			Mode:            database.PgUpgradeModeLink,
+			PostgresVersion: plan.PostgresVersion,
+		}).Get(ctx)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to upgrade node '%s': %w", name, err))
+		}
+		for _, replica := range replicas {
+			_, err := w.Activities.ExecutePgUpgrade(ctx, &activities.PgUpgradeInput{
+				DatabaseID:      databaseID,
+				InstanceID:      replica.InstanceID,
+				HostID:          replica.HostID,
+				NodeName:        name,
+				TaskID:          input.TaskID,
+				Mode:            database.PgUpgradeModeReplica,
+				PostgresVersion: plan.PostgresVersion,
+			}).Get(ctx)
+			if err != nil {
+				return nil, handleError(fmt.Errorf("failed to prepare replica '%s': %w", replica.InstanceID, err))
+			}
+		}
+
+		// The reconcile restarts the node's instances on the new version and
+		// re-creates any replication slots that pg_upgrade didn't carry over.
+		upgraded, err := plan.UpgradeNode(spec, name)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		if err := w.applyUpgradedSpec(ctx, input, upgraded); err != nil {
+			return nil, handleError(fmt.Errorf("failed to start node '%s' on the new version: %w", name, err))
+		}
+		spec = upgraded
+
+		_, err = w.Activities.ExecuteUpdateSpockExtension(ctx, &activities.UpdateSpockExtensionInput{
+			DatabaseID:   databaseID,
+			DatabaseName: spec.DatabaseName,
+			InstanceID:   primary.InstanceID,
+			HostID:       primary.HostID,
+		}).Get(ctx)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to update spock on node '%s': %w", name, err))
+		}
+
+		for _, replica := range replicas {
+			out, err := w.Activities.ExecuteReinitializeReplica(ctx, &activities.ReinitializeReplicaInput{
+				DatabaseID: databaseID,
+				InstanceID: replica.InstanceID,
+				HostID:     replica.HostID,
+			}).Get(ctx)
+			if err != nil {
+				return nil, handleError(fmt.Errorf("failed to rebuild replica '%s': %w", replica.InstanceID, err))
+			}
+			if out.Reinitialized {
+				logTaskEvent(task.LogEntry{
+					Message: fmt.Sprintf("reinitialized replica '%s' from the upgraded primary", replica.InstanceID),
+					Fields: map[string]any{
+						"node_name":   name,
+						"instance_id": replica.InstanceID,
+					},
+				})
+			}
+		}
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("node '%s' has been upgraded to postgres %s", name, plan.PostgresVersion),
+		})
+	}
+
+	if err := w.applyUpgradedSpec(ctx, input, plan.Finalize(spec)); err != nil {
+		return nil, handleError(fmt.Errorf("failed to finalize upgrade: %w", err))
+	}
+
+	// Needs to come before the task update or else clients will see the task
+	// complete before the log entry is added.
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("successfully upgraded database to postgres %s", plan.PostgresVersion),
+	})
+	finish(database.DatabaseStateAvailable, task.UpdateComplete())
+
+	logger.Info("successfully upgraded database major version with pg_upgrade")
+
+	return &PgUpgradeOutput{}, nil
+}
+
+// nodePrimary returns the spec for the node's current primary instance.
+func (w *Workflows) nodePrimary(ctx workflow.Context, node *database.NodeInstances) (*database.InstanceSpec, error) {
+	instance := node.Instances[0]
+	out, err := w.Activities.ExecuteGetPrimaryInstance(ctx, instance.HostID, &activities.GetPrimaryInstanceInput{
+		DatabaseID: instance.DatabaseID,
+		InstanceID: instance.InstanceID,
+	}).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary instance for node '%s': %w", node.NodeName, err)
+	}
+	for _, instance := range node.Instances {
+		if instance.InstanceID == out.PrimaryInstanceID {
+			return instance, nil
+		}
+	}
+
+	return nil, fmt.Errorf("primary instance '%s' does not belong to node '%s'", out.PrimaryInstanceID, node.NodeName)
+}
+
+// applyUpgradedSpec persists a spec where some instances have moved to a newer
+// major version and reconciles the database's resources to match it.
+func (w *Workflows) applyUpgradedSpec(ctx workflow.Context, input *PgUpgradeInput, spec *database.Spec) error {
+	_, err := w.Activities.
+		ExecuteUpdateDbSpec(ctx, &activities.UpdateDbSpecInput{
+			Spec:           spec,
+			InPlaceUpgrade: true,
+		}).
+		Get(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = w.reconcileDatabase(ctx, input.TaskID, spec, input.Variables, false)
+
+	return err
+}
@@ -0,0 +1,56 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+type ResetQueryStatsInput struct {
+	DatabaseID   string                     `json:"database_id"`
+	DatabaseName string                     `json:"database_name"`
+	Instances    []*activities.InstanceHost `json:"instances"`
+}
+
+type ResetQueryStatsOutput struct {
+	InstanceIDs []string `json:"instance_ids"`
+}
+
+// ResetQueryStats resets the query stats on each of the given instances in
+// parallel.
+func (w *Workflows) ResetQueryStats(ctx workflow.Context, input *ResetQueryStatsInput) (*ResetQueryStatsOutput, error) {
+	logger := workflow.Logger(ctx).With("database_id", input.DatabaseID)
+	logger.Info("resetting query stats")
+
+	futures := make([]workflow.Future[*activities.ResetQueryStatsOutput], len(input.Instances))
+	for i, instance := range input.Instances {
+		futures[i] = w.Activities.ExecuteResetQueryStats(ctx, &activities.ResetQueryStatsInput{
+			DatabaseID:   input.DatabaseID,
+			DatabaseName: input.DatabaseName,
+			InstanceID:   instance.InstanceID,
+			HostID:       instance.HostID,
+		})
+	}
+
+	output := &ResetQueryStatsOutput{}
+	var errs []error
+	for i, future := range futures {
+		instanceID := input.Instances[i].InstanceID
+		if _, err := future.Get(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reset query stats on instance '%s': %w", instanceID, err))
+			continue
+		}
+		output.InstanceIDs = append(output.InstanceIDs, instanceID)
+	}
+	if err := errors.Join(errs...); err != nil {
+		logger.Error("failed to reset query stats", "error", err)
+		return nil, err
+	}
+
+	logger.Info("successfully reset query stats")
+
+	return output, nil
+}
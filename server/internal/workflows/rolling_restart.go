@@ -0,0 +1,425 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+const (
+	replicaCatchUpPollInterval = 5 * time.Second
+	replicaCatchUpTimeout      = 5 * time.Minute
+)
+
+type RollingRestartInput struct {
+	TaskID            uuid.UUID                   `json:"task_id"`
+	DatabaseID        string                      `json:"database_id"`
+	Nodes             []*RollingRestartNode       `json:"nodes"`
+	Concurrency       int                         `json:"concurrency"`
+	SwitchBack        bool                        `json:"switch_back"`
+	MaintenanceWindow *database.MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+// RollingRestartNode contains all of a node's instances and the IDs of the
+// instances that should be restarted.
+type RollingRestartNode struct {
+	NodeName  string                     `json:"node_name"`
+	Instances []*activities.InstanceHost `json:"instances"`
+	Restart   []string                   `json:"restart"`
+}
+
+type RollingRestartOutput struct{}
+
+// PlanRollingRestart returns the nodes of the given database that have
+// instances to restart. Unless allInstances is true, only the instances that
+// are pending a restart are restarted. Every instance that will be restarted
+// must be available, and none of the database's nodes can be switching to a
+// different patroni_dcs, because restarting their instances one at a time
+// would split them between DCSs.
+func PlanRollingRestart(db *database.Database, allInstances bool) ([]*RollingRestartNode, error) {
+	if len(db.PatroniDCSSwitchNodes) > 0 {
+		return nil, fmt.Errorf(
+			"nodes %s are switching to a different patroni_dcs. update the database to complete the switch before restarting its instances",
+			strings.Join(db.PatroniDCSSwitchNodes, ", "),
+		)
+	}
+
+	var nodes []*RollingRestartNode
+	for _, node := range db.Spec.Nodes {
+		planned := &RollingRestartNode{NodeName: node.Name}
+		for _, instance := range db.Instances {
+			if instance.NodeName != node.Name {
+				continue
+			}
+			planned.Instances = append(planned.Instances, &activities.InstanceHost{
+				InstanceID: instance.InstanceID,
+				HostID:     instance.HostID,
+			})
+			pending := instance.Status != nil && utils.FromPointer(instance.Status.PendingRestart)
+			if !allInstances && !pending {
+				continue
+			}
+			if instance.State != database.InstanceStateAvailable {
+				return nil, fmt.Errorf("instance '%s' is not restartable, it is in %s state", instance.InstanceID, instance.State)
+			}
+			planned.Restart = append(planned.Restart, instance.InstanceID)
+		}
+		if len(planned.Restart) > 0 {
+			nodes = append(nodes, planned)
+		}
+	}
+
+	return nodes, nil
+}
+
+// RollingRestart restarts instances across a database without taking any of
+// its nodes offline. Nodes are processed in batches of input.Concurrency, and
+// each node is restarted by the RollingRestartNode workflow.
+func (w *Workflows) RollingRestart(ctx workflow.Context, input *RollingRestartInput) (*RollingRestartOutput, error) {
+	logger := workflow.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"task_id", input.TaskID.String(),
+	)
+	logger.Info("starting rolling restart")
+
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, input.DatabaseID, input.TaskID, logger)
+		}
+	}()
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, input.DatabaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("rolling restart failed")
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      input.DatabaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: task.UpdateFail(cause),
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      input.DatabaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	concurrency := max(input.Concurrency, 1)
+	for start := 0; start < len(input.Nodes); start += concurrency {
+		batch := input.Nodes[start:min(start+concurrency, len(input.Nodes))]
+
+		futures := make([]workflow.Future[*RollingRestartNodeOutput], len(batch))
+		for i, node := range batch {
+			logTaskEvent(task.LogEntry{
+				Message: fmt.Sprintf("restarting node '%s'", node.NodeName),
+				Fields: map[string]any{
+					"instance_ids": node.Restart,
+				},
+			})
+			futures[i] = workflow.CreateSubWorkflowInstance[*RollingRestartNodeOutput](
+				ctx,
+				workflow.SubWorkflowOptions{},
+				w.RollingRestartNode,
+				&RollingRestartNodeInput{
+					TaskID:            input.TaskID,
+					DatabaseID:        input.DatabaseID,
+					Node:              node,
+					SwitchBack:        input.SwitchBack,
+					MaintenanceWindow: input.MaintenanceWindow,
+				},
+			)
+		}
+
+		var errs []error
+		for i, future := range futures {
+			if _, err := future.Get(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restart node '%s': %w", batch[i].NodeName, err))
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	updateTaskInput = &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      input.DatabaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateComplete(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("rolling restart completed successfully")
+	return &RollingRestartOutput{}, nil
+}
+
+type RollingRestartNodeInput struct {
+	TaskID            uuid.UUID                   `json:"task_id"`
+	DatabaseID        string                      `json:"database_id"`
+	Node              *RollingRestartNode         `json:"node"`
+	SwitchBack        bool                        `json:"switch_back"`
+	MaintenanceWindow *database.MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+type RollingRestartNodeOutput struct{}
+
+// RollingRestartNode restarts the given instances of a single node, one at a
+// time. Replicas are restarted first, and each replica must be streaming and
+// caught up before the next instance is restarted. If the primary needs a
+// restart, it's switched over to the most up-to-date replica before it's
+// restarted, and it's optionally switched back afterwards. Each restart and
+// switchover runs as a RestartInstance or Switchover sub-workflow with its own
+// task, and it waits for the maintenance window to open, if there is one.
+func (w *Workflows) RollingRestartNode(ctx workflow.Context, input *RollingRestartNodeInput) (*RollingRestartNodeOutput, error) {
+	node := input.Node
+	logger := workflow.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"task_id", input.TaskID.String(),
+		"node_name", node.NodeName,
+	)
+	logger.Info("starting rolling restart of node")
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, input.DatabaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	if len(node.Instances) == 0 {
+		return nil, fmt.Errorf("node '%s' has no instances", node.NodeName)
+	}
+	hostIDs := make(map[string]string, len(node.Instances))
+	for _, instance := range node.Instances {
+		hostIDs[instance.InstanceID] = instance.HostID
+	}
+
+	waitForWindow := func() error {
+		now := workflow.Now(ctx)
+		open := input.MaintenanceWindow.NextOpen(now)
+		if !open.After(now) {
+			return nil
+		}
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("waiting for the maintenance window to open at %s", open.Format(time.RFC3339)),
+		})
+		return workflow.Sleep(ctx, open.Sub(now))
+	}
+
+	createTask := func(taskType task.Type, instanceID string) (uuid.UUID, error) {
+		out, err := w.Activities.ExecuteCreateTask(ctx, &activities.CreateTaskInput{
+			Options: task.Options{
+				Scope:      task.ScopeDatabase,
+				ParentID:   input.TaskID,
+				DatabaseID: input.DatabaseID,
+				NodeName:   node.NodeName,
+				InstanceID: instanceID,
+				Type:       taskType,
+			},
+		}).Get(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return out.TaskID, nil
+	}
+
+	restart := func(instanceID string) error {
+		if err := waitForWindow(); err != nil {
+			return err
+		}
+		taskID, err := createTask(task.TypeRestartInstance, instanceID)
+		if err != nil {
+			return fmt.Errorf("failed to create restart task: %w", err)
+		}
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("restarting instance '%s'", instanceID),
+			Fields: map[string]any{
+				"restart_task_id": taskID.String(),
+			},
+		})
+		_, err = workflow.CreateSubWorkflowInstance[*RestartInstanceOutput](
+			ctx,
+			workflow.SubWorkflowOptions{},
+			w.RestartInstance,
+			&RestartInstanceInput{
+				HostID:     hostIDs[instanceID],
+				DatabaseID: input.DatabaseID,
+				InstanceID: instanceID,
+				TaskID:     taskID,
+			},
+		).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restart instance '%s': %w", instanceID, err)
+		}
+		return nil
+	}
+
+	switchover := func(leaderID, candidateID string) error {
+		if err := waitForWindow(); err != nil {
+			return err
+		}
+		taskID, err := createTask(task.TypeSwitchover, candidateID)
+		if err != nil {
+			return fmt.Errorf("failed to create switchover task: %w", err)
+		}
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("switching over from instance '%s' to instance '%s'", leaderID, candidateID),
+			Fields: map[string]any{
+				"switchover_task_id": taskID.String(),
+			},
+		})
+		_, err = workflow.CreateSubWorkflowInstance[*SwitchoverOutput](
+			ctx,
+			workflow.SubWorkflowOptions{},
+			w.Switchover,
+			&SwitchoverInput{
+				DatabaseID:          input.DatabaseID,
+				NodeName:            node.NodeName,
+				Instances:           node.Instances,
+				CandidateInstanceID: candidateID,
+				TaskID:              taskID,
+			},
+		).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to switch over to instance '%s': %w", candidateID, err)
+		}
+
+		primary, err := w.Activities.ExecuteGetPrimaryInstance(ctx, hostIDs[candidateID], &activities.GetPrimaryInstanceInput{
+			DatabaseID: input.DatabaseID,
+			InstanceID: candidateID,
+		}).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get primary instance: %w", err)
+		}
+		if primary.PrimaryInstanceID != candidateID {
+			return fmt.Errorf("instance '%s' is not the primary after switching over to it", candidateID)
+		}
+		return nil
+	}
+
+	// waitForReplica waits for a restarted replica to resume streaming and
+	// catch up with the primary.
+	waitForReplica := func(leaderID, instanceID string) error {
+		deadline := workflow.Now(ctx).Add(replicaCatchUpTimeout)
+		for {
+			_, err := w.Activities.ExecuteSelectUpToDateReplica(ctx, hostIDs[leaderID], &activities.SelectUpToDateReplicaInput{
+				DatabaseID:       input.DatabaseID,
+				LeaderInstanceID: leaderID,
+				Candidates:       []string{instanceID},
+			}).Get(ctx)
+			if err == nil {
+				return nil
+			}
+			if workflow.Now(ctx).After(deadline) {
+				return fmt.Errorf("instance '%s' did not catch up with the primary within %s: %w", instanceID, replicaCatchUpTimeout, err)
+			}
+			if err := workflow.Sleep(ctx, replicaCatchUpPollInterval); err != nil {
+				return err
+			}
+		}
+	}
+
+	first := node.Instances[0]
+	primary, err := w.Activities.ExecuteGetPrimaryInstance(ctx, first.HostID, &activities.GetPrimaryInstanceInput{
+		DatabaseID: input.DatabaseID,
+		InstanceID: first.InstanceID,
+	}).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary instance: %w", err)
+	}
+	primaryID := primary.PrimaryInstanceID
+
+	var restartPrimary bool
+	for _, instanceID := range node.Restart {
+		if instanceID == primaryID {
+			restartPrimary = true
+			continue
+		}
+		if err := restart(instanceID); err != nil {
+			return nil, err
+		}
+		if err := waitForReplica(primaryID, instanceID); err != nil {
+			return nil, err
+		}
+	}
+	if !restartPrimary {
+		logger.Info("rolling restart of node completed successfully")
+		return &RollingRestartNodeOutput{}, nil
+	}
+
+	var replicas []string
+	for _, instance := range node.Instances {
+		if instance.InstanceID != primaryID {
+			replicas = append(replicas, instance.InstanceID)
+		}
+	}
+	if len(replicas) == 0 {
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("node '%s' has no replicas, so its primary will be restarted in place", node.NodeName),
+		})
+		if err := restart(primaryID); err != nil {
+			return nil, err
+		}
+		logger.Info("rolling restart of node completed successfully")
+		return &RollingRestartNodeOutput{}, nil
+	}
+
+	candidate, err := w.Activities.ExecuteSelectUpToDateReplica(ctx, hostIDs[primaryID], &activities.SelectUpToDateReplicaInput{
+		DatabaseID:       input.DatabaseID,
+		LeaderInstanceID: primaryID,
+		Candidates:       replicas,
+	}).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select a switchover candidate: %w", err)
+	}
+	candidateID := candidate.CandidateInstanceID
+
+	if err := switchover(primaryID, candidateID); err != nil {
+		return nil, err
+	}
+	if err := restart(primaryID); err != nil {
+		return nil, err
+	}
+	if input.SwitchBack {
+		if err := waitForReplica(candidateID, primaryID); err != nil {
+			return nil, err
+		}
+		if err := switchover(candidateID, primaryID); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("rolling restart of node completed successfully")
+	return &RollingRestartNodeOutput{}, nil
+}
@@ -0,0 +1,104 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/utils"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+func TestPlanRollingRestart(t *testing.T) {
+	instance := func(id, nodeName string, state database.InstanceState, pendingRestart bool) *database.Instance {
+		return &database.Instance{
+			InstanceID: id,
+			HostID:     "host-" + id,
+			NodeName:   nodeName,
+			State:      state,
+			Status: &database.InstanceStatus{
+				PendingRestart: utils.PointerTo(pendingRestart),
+			},
+		}
+	}
+	db := &database.Database{
+		DatabaseID: "storefront",
+		Spec: &database.Spec{
+			Nodes: []*database.Node{
+				{Name: "n1"},
+				{Name: "n2"},
+				{Name: "n3"},
+			},
+		},
+		Instances: []*database.Instance{
+			instance("a", "n1", database.InstanceStateAvailable, true),
+			instance("b", "n1", database.InstanceStateAvailable, false),
+			instance("c", "n2", database.InstanceStateAvailable, false),
+			instance("d", "n3", database.InstanceStateAvailable, true),
+		},
+	}
+
+	t.Run("pending restart only", func(t *testing.T) {
+		nodes, err := PlanRollingRestart(db, false)
+		require.NoError(t, err)
+		assert.Equal(t, []*RollingRestartNode{
+			{
+				NodeName: "n1",
+				Instances: []*activities.InstanceHost{
+					{InstanceID: "a", HostID: "host-a"},
+					{InstanceID: "b", HostID: "host-b"},
+				},
+				Restart: []string{"a"},
+			},
+			{
+				NodeName: "n3",
+				Instances: []*activities.InstanceHost{
+					{InstanceID: "d", HostID: "host-d"},
+				},
+				Restart: []string{"d"},
+			},
+		}, nodes)
+	})
+
+	t.Run("all instances", func(t *testing.T) {
+		nodes, err := PlanRollingRestart(db, true)
+		require.NoError(t, err)
+		require.Len(t, nodes, 3)
+		assert.Equal(t, []string{"a", "b"}, nodes[0].Restart)
+		assert.Equal(t, []string{"c"}, nodes[1].Restart)
+		assert.Equal(t, []string{"d"}, nodes[2].Restart)
+	})
+
+	t.Run("unavailable instance", func(t *testing.T) {
+		unavailable := &database.Database{
+			DatabaseID: "storefront",
+			Spec:       db.Spec,
+			Instances: []*database.Instance{
+				instance("a", "n1", database.InstanceStateDegraded, true),
+				instance("b", "n1", database.InstanceStateAvailable, false),
+			},
+		}
+		_, err := PlanRollingRestart(unavailable, false)
+		assert.ErrorContains(t, err, "instance 'a' is not restartable")
+
+		// Unavailable instances that don't need a restart are allowed.
+		unavailable.Instances[0].Status.PendingRestart = utils.PointerTo(false)
+		unavailable.Instances[1].Status.PendingRestart = utils.PointerTo(true)
+		nodes, err := PlanRollingRestart(unavailable, false)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, []string{"b"}, nodes[0].Restart)
+	})
+	t.Run("patroni_dcs switch pending", func(t *testing.T) {
+		switching := &database.Database{
+			DatabaseID:            "storefront",
+			Spec:                  db.Spec,
+			Instances:             db.Instances,
+			PatroniDCSSwitchNodes: []string{"n2"},
+		}
+		_, err := PlanRollingRestart(switching, true)
+		assert.ErrorContains(t, err, "nodes n2 are switching to a different patroni_dcs")
+	})
+}
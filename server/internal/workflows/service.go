@@ -20,7 +20,10 @@ import (
 	"github.com/pgEdge/control-plane/server/internal/utils"
 )
 
-var ErrDuplicateWorkflow = errors.New("duplicate workflow already in progress")
+var (
+	ErrDuplicateWorkflow = errors.New("duplicate workflow already in progress")
+	ErrTaskNotRunning    = errors.New("task is not running")
+)
 
 type Service struct {
 	cfg       config.Config
@@ -72,6 +75,137 @@ func (s *Service) CreateDatabase(ctx context.Context, db *database.Database) (*t
 	return t, nil
 }
 
+// ImportDatabase is like CreateDatabase, except that the new database is
+// populated from the given import source.
+func (s *Service) ImportDatabase(ctx context.Context, db *database.Database, source *database.ImportSource) (*task.Task, error) {
+	databaseID := db.DatabaseID
+	// Clear out any old tasks. This can happen if you were to recreate a
+	// database with the same ID.
+	if err := s.taskSvc.DeleteAllTasks(ctx, task.ScopeDatabase, databaseID); err != nil {
+		return nil, fmt.Errorf("failed to delete old task logs: %w", err)
+	}
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: databaseID,
+		Type:       task.TypeCreate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new task: %w", err)
+	}
+	input := &ImportDatabaseInput{
+		TaskID:    t.TaskID,
+		Spec:      db.Spec,
+		Source:    source,
+		Variables: db.Variables(),
+	}
+	err = s.createWorkflow(ctx, t, s.workflows.ImportDatabase, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// CloneDatabase is like CreateDatabase, except that the new database is
+// restored from a node of the source database. When baseBackup is true, a
+// fresh full backup of the source node is taken first, preferably from one of
+// its replicas. The second task tracks that backup on the source database.
+func (s *Service) CloneDatabase(
+	ctx context.Context,
+	db *database.Database,
+	source *database.Database,
+	baseBackup bool,
+) (*task.Task, *task.Task, error) {
+	databaseID := db.DatabaseID
+	// Clear out any old tasks. This can happen if you were to recreate a
+	// database with the same ID.
+	if err := s.taskSvc.DeleteAllTasks(ctx, task.ScopeDatabase, databaseID); err != nil {
+		return nil, nil, fmt.Errorf("failed to delete old task logs: %w", err)
+	}
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: databaseID,
+		Type:       task.TypeClone,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new task: %w", err)
+	}
+	input := &CloneDatabaseInput{
+		TaskID:    t.TaskID,
+		Spec:      db.Spec,
+		Variables: db.Variables(),
+	}
+
+	var backupTask *task.Task
+	if baseBackup {
+		nodeName := db.Spec.RestoreConfig.SourceNodeName
+		node, err := source.Spec.Node(nodeName)
+		if err != nil {
+			s.abortTasks(ctx, t)
+			return nil, nil, err
+		}
+		// The backup runs within the clone's task slot, so it's created as a
+		// child of the clone task.
+		backupTask, err = s.taskSvc.CreateTask(ctx, task.Options{
+			Scope:      task.ScopeDatabase,
+			ParentID:   t.TaskID,
+			DatabaseID: source.DatabaseID,
+			NodeName:   nodeName,
+			Type:       task.TypeNodeBackup,
+		})
+		if err != nil {
+			s.abortTasks(ctx, t)
+			return nil, nil, fmt.Errorf("failed to create new task: %w", err)
+		}
+		instances := make([]*InstanceHost, len(node.HostIDs))
+		for i, hostID := range node.HostIDs {
+			instances[i] = &InstanceHost{
+				InstanceID: database.InstanceIDFor(hostID, source.DatabaseID, nodeName),
+				HostID:     hostID,
+			}
+		}
+		input.Backup = &CreatePgBackRestBackupInput{
+			DatabaseID:        source.DatabaseID,
+			TaskID:            backupTask.TaskID,
+			NodeName:          nodeName,
+			BackupFromStandby: len(instances) > 1,
+			Instances:         instances,
+			BackupOptions: &pgbackrest.BackupOptions{
+				Type: pgbackrest.BackupTypeFull,
+			},
+		}
+	}
+
+	err = s.createWorkflow(ctx, t, s.workflows.CloneDatabase, input)
+	if err != nil {
+		if backupTask != nil {
+			s.abortTasks(ctx, backupTask)
+		}
+		return nil, nil, err
+	}
+
+	return t, backupTask, nil
+}
+
+func (s *Service) CutoverImport(ctx context.Context, db *database.Database) (*task.Task, error) {
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: db.DatabaseID,
+		Type:       task.TypeImportCutover,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import cutover task: %w", err)
+	}
+	input := &CutoverImportInput{
+		TaskID: t.TaskID,
+		Spec:   db.Spec,
+	}
+	if err := s.createWorkflow(ctx, t, s.workflows.CutoverImport, input); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
 func (s *Service) UpdateDatabase(ctx context.Context, db *database.Database, forceUpdate bool, removeHosts ...string) (*task.Task, error) {
 	databaseID := db.DatabaseID
 	t, err := s.taskSvc.CreateTask(ctx, task.Options{
@@ -83,11 +217,12 @@ func (s *Service) UpdateDatabase(ctx context.Context, db *database.Database, for
 		return nil, fmt.Errorf("failed to create new task: %w", err)
 	}
 	input := &UpdateDatabaseInput{
-		TaskID:      t.TaskID,
-		Spec:        db.Spec,
-		ForceUpdate: forceUpdate,
-		RemoveHosts: removeHosts,
-		Variables:   db.Variables(),
+		TaskID:                t.TaskID,
+		Spec:                  db.Spec,
+		ForceUpdate:           forceUpdate,
+		RemoveHosts:           removeHosts,
+		Variables:             db.Variables(),
+		PatroniDCSSwitchNodes: db.PatroniDCSSwitchNodes,
 	}
 	err = s.createWorkflow(ctx, t, s.workflows.UpdateDatabase, input)
 	if err != nil {
@@ -108,11 +243,66 @@ func (s *Service) UpgradeDatabase(ctx context.Context, db *database.Database) (*
 		return nil, fmt.Errorf("failed to create upgrade task: %w", err)
 	}
 	input := &UpdateDatabaseInput{
+		TaskID:                t.TaskID,
+		Spec:                  db.Spec,
+		Variables:             db.Variables(),
+		PatroniDCSSwitchNodes: db.PatroniDCSSwitchNodes,
+	}
+	if err := s.createWorkflow(ctx, t, s.workflows.UpdateDatabase, input); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *Service) UpgradeMajor(
+	ctx context.Context,
+	db *database.Database,
+	plan *database.MajorUpgradePlan,
+	pauseBetweenNodes bool,
+) (*task.Task, error) {
+	databaseID := db.DatabaseID
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: databaseID,
+		Type:       task.TypeUpgradeMajor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create major upgrade task: %w", err)
+	}
+	input := &UpgradeMajorInput{
+		TaskID:            t.TaskID,
+		Spec:              db.Spec,
+		Plan:              plan,
+		PauseBetweenNodes: pauseBetweenNodes,
+		Variables:         db.Variables(),
+	}
+	if err := s.createWorkflow(ctx, t, s.workflows.UpgradeMajor, input); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *Service) PgUpgrade(
+	ctx context.Context,
+	db *database.Database,
+	plan *database.PgUpgradePlan,
+) (*task.Task, error) {
+	databaseID := db.DatabaseID
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: databaseID,
+		Type:       task.TypePgUpgrade,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pg_upgrade task: %w", err)
+	}
+	input := &PgUpgradeInput{
 		TaskID:    t.TaskID,
 		Spec:      db.Spec,
+		Plan:      plan,
 		Variables: db.Variables(),
 	}
-	if err := s.createWorkflow(ctx, t, s.workflows.UpdateDatabase, input); err != nil {
+	if err := s.createWorkflow(ctx, t, s.workflows.PgUpgrade, input); err != nil {
 		return nil, err
 	}
 	return t, nil
@@ -292,6 +482,98 @@ func (s *Service) ValidateSpec(ctx context.Context, input *ValidateSpecInput) (*
 	return output, nil
 }
 
+// CheckDrift runs a drift check for the given database and waits for its
+// result.
+func (s *Service) CheckDrift(ctx context.Context, db *database.Database) (*CheckDriftOutput, error) {
+	opts := client.WorkflowInstanceOptions{
+		Queue:      utils.HostQueue(s.cfg.HostID),
+		InstanceID: uuid.NewString(),
+	}
+	input := &CheckDriftInput{
+		Spec:      db.Spec,
+		Variables: db.Variables(),
+	}
+
+	instance, err := s.client.CreateWorkflowInstance(ctx, opts, s.workflows.CheckDrift, input)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", db.DatabaseID).Msg("failed to create drift check workflow")
+		return nil, fmt.Errorf("failed to create workflow instance: %w", err)
+	}
+
+	output, err := client.GetWorkflowResult[*CheckDriftOutput](ctx, s.client, instance, 10*time.Minute)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", db.DatabaseID).Msg("drift check workflow failed")
+		return nil, fmt.Errorf("drift check workflow failed: %w", err)
+	}
+
+	return output, nil
+}
+
+func (s *Service) ResetQueryStats(ctx context.Context, input *ResetQueryStatsInput) (*ResetQueryStatsOutput, error) {
+	opts := client.WorkflowInstanceOptions{
+		Queue:      utils.HostQueue(s.cfg.HostID),
+		InstanceID: uuid.NewString(),
+	}
+
+	instance, err := s.client.CreateWorkflowInstance(ctx, opts, s.workflows.ResetQueryStats, input)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", input.DatabaseID).Msg("failed to create reset query stats workflow")
+		return nil, fmt.Errorf("failed to create workflow instance: %w", err)
+	}
+
+	output, err := client.GetWorkflowResult[*ResetQueryStatsOutput](ctx, s.client, instance, 5*time.Minute)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", input.DatabaseID).Msg("reset query stats workflow failed")
+		return nil, fmt.Errorf("reset query stats workflow failed: %w", err)
+	}
+
+	return output, nil
+}
+
+func (s *Service) GetInstanceSessions(ctx context.Context, input *GetInstanceSessionsInput) (*GetInstanceSessionsOutput, error) {
+	opts := client.WorkflowInstanceOptions{
+		Queue:      utils.HostQueue(s.cfg.HostID),
+		InstanceID: uuid.NewString(),
+	}
+
+	instance, err := s.client.CreateWorkflowInstance(ctx, opts, s.workflows.GetInstanceSessions, input)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", input.DatabaseID).Msg("failed to create get instance sessions workflow")
+		return nil, fmt.Errorf("failed to create workflow instance: %w", err)
+	}
+
+	output, err := client.GetWorkflowResult[*GetInstanceSessionsOutput](ctx, s.client, instance, time.Minute)
+	if err != nil {
+		s.logger.Error().Err(err).Str("database_id", input.DatabaseID).Msg("get instance sessions workflow failed")
+		return nil, fmt.Errorf("get instance sessions workflow failed: %w", err)
+	}
+
+	return output, nil
+}
+
+func (s *Service) SignalSession(ctx context.Context, input *SignalSessionInput) (*task.Task, error) {
+	taskType := task.TypeCancelSession
+	if input.Action == database.SessionActionTerminate {
+		taskType = task.TypeTerminateSession
+	}
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: input.DatabaseID,
+		InstanceID: input.InstanceID,
+		Type:       taskType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new task: %w", err)
+	}
+	input.TaskID = t.TaskID
+	err = s.createWorkflow(ctx, t, s.workflows.SignalSession, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (s *Service) RestartInstance(ctx context.Context, input *RestartInstanceInput) (*task.Task, error) {
 	t, err := s.taskSvc.CreateTask(ctx, task.Options{
 		Scope:      task.ScopeDatabase,
@@ -311,6 +593,24 @@ func (s *Service) RestartInstance(ctx context.Context, input *RestartInstanceInp
 	return t, nil
 }
 
+func (s *Service) RollingRestart(ctx context.Context, input *RollingRestartInput) (*task.Task, error) {
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:      task.ScopeDatabase,
+		DatabaseID: input.DatabaseID,
+		Type:       task.TypeRollingRestart,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new task: %w", err)
+	}
+	input.TaskID = t.TaskID
+	err = s.createWorkflow(ctx, t, s.workflows.RollingRestart, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (s *Service) StopInstance(ctx context.Context, input *StopInstanceInput) (*task.Task, error) {
 	t, err := s.taskSvc.CreateTask(ctx, task.Options{
 		Scope:      task.ScopeDatabase,
@@ -383,6 +683,26 @@ func (s *Service) CancelDatabaseTask(ctx context.Context, databaseID string, tas
 
 }
 
+func (s *Service) ResumeDatabaseTask(ctx context.Context, databaseID string, taskID uuid.UUID) (*task.Task, error) {
+	t, err := s.taskSvc.GetTask(ctx, task.ScopeDatabase, databaseID, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve task from database: %w", err)
+	}
+	if t.Status != task.StatusRunning {
+		return nil, fmt.Errorf("%w: task is %s", ErrTaskNotRunning, t.Status)
+	}
+	if t.WorkflowInstanceID == "" {
+		return nil, fmt.Errorf("no workflow instances associated with task")
+	}
+
+	err = s.client.SignalWorkflow(ctx, t.WorkflowInstanceID, ResumeSignal, struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to signal workflow instance: %w", err)
+	}
+
+	return t, nil
+}
+
 func (s *Service) SwitchoverDatabaseNode(ctx context.Context, input *SwitchoverInput) (*task.Task, error) {
 	t, err := s.taskSvc.CreateTask(ctx, task.Options{
 		Scope:      task.ScopeDatabase,
@@ -491,3 +811,68 @@ func (s *Service) RemoveHost(ctx context.Context, hostID string, specs ...*datab
 		DatabaseTasks: databaseTasks,
 	}, nil
 }
+
+func (s *Service) ChangeEtcdMode(ctx context.Context, hostID string, mode config.EtcdMode) (*task.Task, error) {
+	t, err := s.taskSvc.CreateTask(ctx, task.Options{
+		Scope:  task.ScopeHost,
+		HostID: hostID,
+		Type:   task.TypeChangeEtcdMode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create change etcd mode task: %w", err)
+	}
+
+	opts := client.WorkflowInstanceOptions{
+		Queue:      utils.HostQueue(s.cfg.HostID),
+		InstanceID: uuid.NewString(),
+	}
+	instance, err := s.client.CreateWorkflowInstance(ctx, opts, s.workflows.ChangeEtcdMode, &ChangeEtcdModeInput{
+		TaskID: t.TaskID,
+		HostID: hostID,
+		Mode:   mode,
+	})
+	if err != nil {
+		s.abortTasks(ctx, t)
+		return nil, fmt.Errorf("failed to create change etcd mode workflow: %w", err)
+	}
+	t.WorkflowExecutionID = instance.ExecutionID
+	t.WorkflowInstanceID = instance.InstanceID
+	if err := s.taskSvc.UpdateTask(ctx, t); err != nil {
+		s.abortTasks(ctx, t)
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	return t, nil
+}
+
+// RemoveTaskWorkflow removes the given task's workflow instance and its
+// history. It returns backend.ErrInstanceNotFinished if the workflow instance
+// is still active.
+func (s *Service) RemoveTaskWorkflow(ctx context.Context, t *task.Task) error {
+	if t.WorkflowInstanceID == "" {
+		return nil
+	}
+
+	err := s.client.RemoveWorkflowInstance(ctx, &core.WorkflowInstance{
+		InstanceID:  t.WorkflowInstanceID,
+		ExecutionID: t.WorkflowExecutionID,
+	})
+	if errors.Is(err, backend.ErrInstanceNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to remove workflow instance: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFinishedWorkflows removes every workflow instance that finished before
+// the given time, including instances that aren't associated with a task.
+func (s *Service) RemoveFinishedWorkflows(ctx context.Context, finishedBefore time.Time) error {
+	err := s.client.RemoveWorkflowInstances(ctx, backend.RemoveFinishedBefore(finishedBefore))
+	if err != nil {
+		return fmt.Errorf("failed to remove workflow instances: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/postgres"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+type GetInstanceSessionsInput struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseName string `json:"database_name"`
+	InstanceID   string `json:"instance_id"`
+	HostID       string `json:"host_id"`
+}
+
+type GetInstanceSessionsOutput struct {
+	Sessions []postgres.Session `json:"sessions"`
+}
+
+// GetInstanceSessions reads the client sessions from an instance. Instances
+// are only reachable from their own host, so this runs the query on the
+// instance's host queue.
+func (w *Workflows) GetInstanceSessions(ctx workflow.Context, input *GetInstanceSessionsInput) (*GetInstanceSessionsOutput, error) {
+	out, err := w.Activities.ExecuteGetInstanceSessions(ctx, &activities.GetInstanceSessionsInput{
+		DatabaseID:   input.DatabaseID,
+		DatabaseName: input.DatabaseName,
+		InstanceID:   input.InstanceID,
+		HostID:       input.HostID,
+	}).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance sessions: %w", err)
+	}
+
+	return &GetInstanceSessionsOutput{Sessions: out.Sessions}, nil
+}
+
+type SignalSessionInput struct {
+	TaskID       uuid.UUID              `json:"task_id"`
+	DatabaseID   string                 `json:"database_id"`
+	DatabaseName string                 `json:"database_name"`
+	InstanceID   string                 `json:"instance_id"`
+	HostID       string                 `json:"host_id"`
+	PID          int                    `json:"pid"`
+	Action       database.SessionAction `json:"action"`
+}
+
+type SignalSessionOutput struct{}
+
+// SignalSession cancels the current query of or terminates a client session.
+// The session's details are recorded in the task log.
+func (w *Workflows) SignalSession(ctx workflow.Context, input *SignalSessionInput) (*SignalSessionOutput, error) {
+	logger := workflow.Logger(ctx).With(
+		"database_id", input.DatabaseID,
+		"instance_id", input.InstanceID,
+		"task_id", input.TaskID.String(),
+		"pid", input.PID,
+		"action", input.Action,
+	)
+	logger.Info("signaling session")
+
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, input.DatabaseID, input.TaskID, logger)
+		}
+	}()
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to signal session")
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      input.DatabaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: task.UpdateFail(cause),
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      input.DatabaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	out, err := w.Activities.ExecuteSignalSession(ctx, &activities.SignalSessionInput{
+		DatabaseID:   input.DatabaseID,
+		DatabaseName: input.DatabaseName,
+		InstanceID:   input.InstanceID,
+		HostID:       input.HostID,
+		PID:          input.PID,
+		Action:       input.Action,
+	}).Get(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = w.logTaskEvent(ctx, task.ScopeDatabase, input.DatabaseID, input.TaskID, sessionLogEntry(input.Action, out.Session))
+	if err != nil {
+		logger.With("error", err).Warn("failed to log task event")
+	}
+
+	updateTaskInput = &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      input.DatabaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateComplete(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	logger.Info("successfully signaled session")
+
+	return &SignalSessionOutput{}, nil
+}
+
+func sessionLogEntry(action database.SessionAction, session *postgres.Session) task.LogEntry {
+	fields := map[string]any{
+		"pid":              session.PID,
+		"application_name": session.ApplicationName,
+		"query":            session.Query,
+	}
+	if session.Username != nil {
+		fields["username"] = *session.Username
+	}
+	if session.DatabaseName != nil {
+		fields["database_name"] = *session.DatabaseName
+	}
+	if session.ClientAddr != nil {
+		fields["client_addr"] = *session.ClientAddr
+	}
+	if session.State != nil {
+		fields["state"] = *session.State
+	}
+
+	var message string
+	switch action {
+	case database.SessionActionCancel:
+		message = fmt.Sprintf("canceled query for session %d", session.PID)
+	default:
+		message = fmt.Sprintf("terminated session %d", session.PID)
+	}
+
+	return task.LogEntry{
+		Message: message,
+		Fields:  fields,
+	}
+}
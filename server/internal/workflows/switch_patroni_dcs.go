@@ -0,0 +1,174 @@
+package workflows
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+const (
+	patroniDCSSwitchPollInterval = 5 * time.Second
+	patroniDCSSwitchTimeout      = 5 * time.Minute
+)
+
+// switchPatroniDCS moves a node's Patroni cluster to the DCS in the given spec
+// after its instances have been reconfigured for it. Patroni only connects to
+// a new DCS when it starts, and instances that are running on different DCSs
+// can't see each other, so every instance in the node is stopped before the
+// cluster's state is copied to the new DCS. Then the previous primary is
+// started, followed by the replicas, so that the primary claims the leader key
+// before any replica can.
+func (w *Workflows) switchPatroniDCS(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	spec *database.Spec,
+	nodeName string,
+) error {
+	databaseID := spec.DatabaseID
+	logger := workflow.Logger(ctx).With(
+		"database_id", databaseID,
+		"node_name", nodeName,
+	)
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, taskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	complete := func() error {
+		_, err := w.Activities.ExecuteCompletePatroniDCSSwitch(ctx, &activities.CompletePatroniDCSSwitchInput{
+			DatabaseID: databaseID,
+			NodeName:   nodeName,
+		}).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to complete patroni_dcs switch for node '%s': %w", nodeName, err)
+		}
+		return nil
+	}
+
+	nodes, err := spec.NodeInstances()
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(nodes, func(n *database.NodeInstances) bool {
+		return n.NodeName == nodeName
+	})
+	if idx < 0 || len(nodes[idx].Instances) == 0 {
+		// The node has been removed, so there's nothing to restart.
+		return complete()
+	}
+	node := nodes[idx]
+
+	// The primary can't be found if the node is already stopped, for example
+	// because an earlier switch failed after stopping it. In that case, we
+	// start the node's first instance before the others.
+	primary, err := w.nodePrimary(ctx, node)
+	if err != nil {
+		logger.With("error", err).Warn("failed to get primary instance, starting the first instance as the primary")
+		primary = node.Instances[0]
+	}
+	var replicas []*database.InstanceSpec
+	for _, instance := range node.Instances {
+		if instance.InstanceID != primary.InstanceID {
+			replicas = append(replicas, instance)
+		}
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("switching node '%s' to patroni_dcs '%s': stopping instances", nodeName, spec.PatroniDCS),
+		Fields: map[string]any{
+			"node_name":   nodeName,
+			"instance_id": primary.InstanceID,
+		},
+	})
+	// Stopping the replicas first prevents them from being promoted.
+	for _, instance := range append(slices.Clone(replicas), primary) {
+		_, err := w.Activities.ExecuteStopInstance(ctx, &activities.StopInstanceInput{
+			DatabaseID: databaseID,
+			InstanceID: instance.InstanceID,
+			HostID:     instance.HostID,
+			TaskID:     taskID,
+		}).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to stop instance '%s': %w", instance.InstanceID, err)
+		}
+	}
+
+	out, err := w.Activities.ExecuteCopyPatroniCluster(ctx, &activities.CopyPatroniClusterInput{
+		DatabaseID: databaseID,
+		NodeName:   nodeName,
+		HostID:     primary.HostID,
+	}).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to copy patroni state for node '%s': %w", nodeName, err)
+	}
+	if !out.Copied {
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("node '%s' already has a leader in the new DCS, skipping the copy of its patroni state", nodeName),
+		})
+	}
+
+	start := func(instance *database.InstanceSpec) error {
+		_, err := w.Activities.ExecuteStartInstance(ctx, &activities.StartInstanceInput{
+			DatabaseID: databaseID,
+			InstanceID: instance.InstanceID,
+			HostID:     instance.HostID,
+			TaskID:     taskID,
+		}).Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to start instance '%s': %w", instance.InstanceID, err)
+		}
+		return nil
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("starting primary instance '%s'", primary.InstanceID),
+	})
+	if err := start(primary); err != nil {
+		return err
+	}
+	deadline := workflow.Now(ctx).Add(patroniDCSSwitchTimeout)
+	for {
+		out, err := w.Activities.ExecuteGetPrimaryInstance(ctx, primary.HostID, &activities.GetPrimaryInstanceInput{
+			DatabaseID: databaseID,
+			InstanceID: primary.InstanceID,
+		}).Get(ctx)
+		if err == nil && out.PrimaryInstanceID == primary.InstanceID {
+			break
+		}
+		if workflow.Now(ctx).After(deadline) {
+			return fmt.Errorf("instance '%s' did not become the primary within %s", primary.InstanceID, patroniDCSSwitchTimeout)
+		}
+		if err := workflow.Sleep(ctx, patroniDCSSwitchPollInterval); err != nil {
+			return err
+		}
+	}
+
+	for _, replica := range replicas {
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("starting replica instance '%s'", replica.InstanceID),
+		})
+		if err := start(replica); err != nil {
+			return err
+		}
+	}
+
+	if err := complete(); err != nil {
+		return err
+	}
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("node '%s' has switched to patroni_dcs '%s'", nodeName, spec.PatroniDCS),
+	})
+
+	return nil
+}
@@ -20,6 +20,9 @@ type UpdateDatabaseInput struct {
 	ForceUpdate bool               `json:"force_update"`
 	RemoveHosts []string           `json:"remove_hosts"`
 	Variables   resource.Variables `json:"variables"`
+	// PatroniDCSSwitchNodes are the nodes that must be restarted to switch
+	// to the spec's patroni_dcs after they're reconfigured.
+	PatroniDCSSwitchNodes []string `json:"patroni_dcs_switch_nodes,omitempty"`
 }
 
 type UpdateDatabaseOutput struct {
@@ -85,40 +88,17 @@ func (w *Workflows) UpdateDatabase(ctx workflow.Context, input *UpdateDatabaseIn
 		return nil, handleError(err)
 	}
 
-	refreshCurrentInput := &RefreshCurrentStateInput{
-		DatabaseID:  input.Spec.DatabaseID,
-		TaskID:      input.TaskID,
-		RemoveHosts: input.RemoveHosts,
-		Variables:   input.Variables,
-	}
-	refreshCurrentOutput, err := w.ExecuteRefreshCurrentState(ctx, refreshCurrentInput).Get(ctx)
-	if err != nil {
-		return nil, handleError(fmt.Errorf("failed to get current state: %w", err))
-	}
-	current := refreshCurrentOutput.State
-
-	planInput := &PlanUpdateInput{
-		Spec:    input.Spec,
-		Current: current,
-		Options: operations.UpdateDatabaseOptions{
-			PlanOptions: resource.PlanOptions{
-				ForceUpdate: input.ForceUpdate,
-			},
-		},
-	}
-	planOutput, err := w.ExecutePlanUpdate(ctx, planInput).Get(ctx)
-	if err != nil {
-		return nil, handleError(fmt.Errorf("failed to execute plan update: %w", err))
-	}
-
-	err = w.persistPlans(ctx, input.Spec.DatabaseID, input.TaskID, planOutput.Plans)
+	current, err := w.reconcileDatabase(ctx, input.TaskID, input.Spec, input.Variables, input.ForceUpdate, input.RemoveHosts...)
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	err = w.applyPlans(ctx, input.Spec.DatabaseID, input.TaskID, current, input.Variables, planOutput.Plans, input.RemoveHosts...)
-	if err != nil {
-		return nil, handleError(err)
+	// Nodes are switched one at a time so that the other nodes can continue
+	// serving traffic.
+	for _, nodeName := range input.PatroniDCSSwitchNodes {
+		if err := w.switchPatroniDCS(ctx, input.TaskID, input.Spec, nodeName); err != nil {
+			return nil, handleError(err)
+		}
 	}
 
 	updateStateInput := &activities.UpdateDbStateInput{
@@ -147,3 +127,52 @@ func (w *Workflows) UpdateDatabase(ctx workflow.Context, input *UpdateDatabaseIn
 		Updated: current,
 	}, nil
 }
+
+// reconcileDatabase plans and applies the changes needed to bring the
+// database's resources in line with the given spec.
+func (w *Workflows) reconcileDatabase(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	spec *database.Spec,
+	variables resource.Variables,
+	forceUpdate bool,
+	removeHosts ...string,
+) (*resource.State, error) {
+	refreshCurrentInput := &RefreshCurrentStateInput{
+		DatabaseID:  spec.DatabaseID,
+		TaskID:      taskID,
+		RemoveHosts: removeHosts,
+		Variables:   variables,
+	}
+	refreshCurrentOutput, err := w.ExecuteRefreshCurrentState(ctx, refreshCurrentInput).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current state: %w", err)
+	}
+	current := refreshCurrentOutput.State
+
+	planInput := &PlanUpdateInput{
+		Spec:    spec,
+		Current: current,
+		Options: operations.UpdateDatabaseOptions{
+			PlanOptions: resource.PlanOptions{
+				ForceUpdate: forceUpdate,
+			},
+		},
+	}
+	planOutput, err := w.ExecutePlanUpdate(ctx, planInput).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute plan update: %w", err)
+	}
+
+	err = w.persistPlans(ctx, spec.DatabaseID, taskID, planOutput.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.applyPlans(ctx, spec.DatabaseID, taskID, current, variables, planOutput.Plans, removeHosts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return current, nil
+}
@@ -0,0 +1,257 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/cschleiden/go-workflows/workflow"
+	"github.com/google/uuid"
+
+	"github.com/pgEdge/control-plane/server/internal/database"
+	"github.com/pgEdge/control-plane/server/internal/resource"
+	"github.com/pgEdge/control-plane/server/internal/task"
+	"github.com/pgEdge/control-plane/server/internal/workflows/activities"
+)
+
+// ResumeSignal is the name of the signal that resumes a paused workflow.
+const ResumeSignal = "resume"
+
+type UpgradeMajorInput struct {
+	TaskID            uuid.UUID                  `json:"task_id"`
+	Spec              *database.Spec             `json:"spec"`
+	Plan              *database.MajorUpgradePlan `json:"plan"`
+	PauseBetweenNodes bool                       `json:"pause_between_nodes,omitempty"`
+	Variables         resource.Variables         `json:"variables"`
+}
+
+type UpgradeMajorOutput struct{}
+
+// UpgradeMajor upgrades each node in the database to a new major version by
+// adding a replacement node, switching services over to it, and removing the
+// original node. Nodes are upgraded one at a time. If adding the replacement or
+// switching services over fails, the database is rolled back to its state
+// before that node's upgrade started.
+func (w *Workflows) UpgradeMajor(ctx workflow.Context, input *UpgradeMajorInput) (*UpgradeMajorOutput, error) {
+	databaseID := input.Spec.DatabaseID
+	logger := workflow.Logger(ctx).With("database_id", databaseID)
+	defer func() {
+		if errors.Is(ctx.Err(), workflow.Canceled) {
+			logger.Warn("workflow was canceled")
+			cleanupCtx := workflow.NewDisconnectedContext(ctx)
+
+			updateStateInput := &activities.UpdateDbStateInput{
+				DatabaseID: databaseID,
+				State:      database.DatabaseStateFailed,
+			}
+			_, err := w.Activities.ExecuteUpdateDbState(cleanupCtx, updateStateInput).Get(cleanupCtx)
+			if err != nil {
+				logger.With("error", err).Error("failed to update database state")
+			}
+
+			w.cancelTask(cleanupCtx, task.ScopeDatabase, databaseID, input.TaskID, logger)
+		}
+	}()
+
+	logger.Info("upgrading database major version", "postgres_version", input.Plan.PostgresVersion)
+
+	// Curry w.logTaskEvent and treat logging errors as non-fatal
+	logTaskEvent := func(entry task.LogEntry) {
+		err := w.logTaskEvent(ctx, task.ScopeDatabase, databaseID, input.TaskID, entry)
+		if err != nil {
+			logger.With("error", err).Error("failed to log task event")
+		}
+	}
+
+	finish := func(state database.DatabaseState, opts task.UpdateOptions) {
+		updateStateInput := &activities.UpdateDbStateInput{
+			DatabaseID: databaseID,
+			State:      state,
+		}
+		_, err := w.Activities.ExecuteUpdateDbState(ctx, updateStateInput).Get(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to update database state")
+		}
+
+		updateTaskInput := &activities.UpdateTaskInput{
+			Scope:         task.ScopeDatabase,
+			EntityID:      databaseID,
+			TaskID:        input.TaskID,
+			UpdateOptions: opts,
+		}
+		_ = w.updateTask(ctx, logger, updateTaskInput)
+	}
+
+	handleError := func(cause error) error {
+		logger.With("error", cause).Error("failed to upgrade database")
+		finish(database.DatabaseStateFailed, task.UpdateFail(cause))
+
+		return cause
+	}
+
+	// rollback restores the spec from before the current node's upgrade. The
+	// database is usable again if the rollback succeeds, so the task fails but
+	// the database is left available.
+	rollback := func(node *database.MajorUpgradeNode, previous *database.Spec, cause error) error {
+		logger.With("error", cause).Error("failed to upgrade node", "node_name", node.NodeName)
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("failed to upgrade node '%s', rolling back", node.NodeName),
+			Fields: map[string]any{
+				"error": cause.Error(),
+			},
+		})
+
+		if err := w.applyDatabaseSpec(ctx, input, previous); err != nil {
+			logTaskEvent(task.LogEntry{
+				Message: fmt.Sprintf("failed to roll back node '%s'", node.NodeName),
+				Fields: map[string]any{
+					"error": err.Error(),
+				},
+			})
+			return handleError(errors.Join(cause, fmt.Errorf("failed to roll back: %w", err)))
+		}
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("rolled back node '%s'", node.NodeName),
+		})
+		finish(database.DatabaseStateAvailable, task.UpdateFail(cause))
+
+		return cause
+	}
+
+	updateTaskInput := &activities.UpdateTaskInput{
+		Scope:         task.ScopeDatabase,
+		EntityID:      databaseID,
+		TaskID:        input.TaskID,
+		UpdateOptions: task.UpdateStart(),
+	}
+	if err := w.updateTask(ctx, logger, updateTaskInput); err != nil {
+		return nil, handleError(err)
+	}
+
+	plan := input.Plan
+	spec := input.Spec
+	for i, node := range plan.Nodes {
+		previous := spec
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf(
+				"upgrading node '%s' (%d of %d): adding replacement node '%s' on hosts %s",
+				node.NodeName, i+1, len(plan.Nodes), node.ReplacementName, strings.Join(node.HostIDs, ", "),
+			),
+			Fields: map[string]any{
+				"node_name":        node.NodeName,
+				"replacement_name": node.ReplacementName,
+				"stage":            "add_replacement",
+			},
+		})
+		added, err := plan.AddReplacement(spec, node)
+		if err != nil {
+			return nil, rollback(node, previous, err)
+		}
+		if err := w.applyDatabaseSpec(ctx, input, added); err != nil {
+			return nil, rollback(node, previous, err)
+		}
+
+		switched, changed := plan.SwitchEndpoints(added, node)
+		if changed {
+			logTaskEvent(task.LogEntry{
+				Message: fmt.Sprintf("switching services from node '%s' to '%s'", node.NodeName, node.ReplacementName),
+				Fields: map[string]any{
+					"node_name":        node.NodeName,
+					"replacement_name": node.ReplacementName,
+					"stage":            "switch_endpoints",
+				},
+			})
+			if err := w.applyDatabaseSpec(ctx, input, switched); err != nil {
+				return nil, rollback(node, previous, err)
+			}
+		}
+
+		// We can't roll back past this point because the original node's
+		// resources may already be gone.
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("decommissioning node '%s'", node.NodeName),
+			Fields: map[string]any{
+				"node_name": node.NodeName,
+				"stage":     "decommission",
+			},
+		})
+		removed := plan.Decommission(switched, node)
+		if err := w.applyDatabaseSpec(ctx, input, removed); err != nil {
+			return nil, handleError(fmt.Errorf("failed to decommission node '%s': %w", node.NodeName, err))
+		}
+		spec = removed
+
+		logTaskEvent(task.LogEntry{
+			Message: fmt.Sprintf("node '%s' has been replaced by node '%s'", node.NodeName, node.ReplacementName),
+		})
+
+		if input.PauseBetweenNodes && i < len(plan.Nodes)-1 {
+			if err := w.waitForResume(ctx, logger, logTaskEvent); err != nil {
+				return nil, handleError(err)
+			}
+		}
+	}
+
+	if err := w.applyDatabaseSpec(ctx, input, plan.Finalize(spec)); err != nil {
+		return nil, handleError(fmt.Errorf("failed to finalize upgrade: %w", err))
+	}
+
+	// Needs to come before the task update or else clients will see the task
+	// complete before the log entry is added.
+	logTaskEvent(task.LogEntry{
+		Message: fmt.Sprintf("successfully upgraded database to postgres %s", plan.PostgresVersion),
+	})
+	finish(database.DatabaseStateAvailable, task.UpdateComplete())
+
+	logger.Info("successfully upgraded database major version")
+
+	return &UpgradeMajorOutput{}, nil
+}
+
+// applyDatabaseSpec persists the given spec and reconciles the database's
+// resources to match it.
+func (w *Workflows) applyDatabaseSpec(ctx workflow.Context, input *UpgradeMajorInput, spec *database.Spec) error {
+	_, err := w.Activities.
+		ExecuteUpdateDbSpec(ctx, &activities.UpdateDbSpecInput{Spec: spec}).
+		Get(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = w.reconcileDatabase(ctx, input.TaskID, spec, input.Variables, false)
+
+	return err
+}
+
+func (w *Workflows) waitForResume(ctx workflow.Context, logger *slog.Logger, logTaskEvent func(task.LogEntry)) error {
+	resume := workflow.NewSignalChannel[struct{}](ctx, ResumeSignal)
+	// Discard any resume signals that were sent before we paused.
+	for {
+		if _, ok := resume.ReceiveNonBlocking(); !ok {
+			break
+		}
+	}
+
+	logger.Info("pausing until resumed")
+	logTaskEvent(task.LogEntry{
+		Message: "paused, waiting for the task to be resumed",
+		Fields:  map[string]any{"status": "paused"},
+	})
+
+	workflow.Select(ctx,
+		workflow.Receive(resume, func(workflow.Context, struct{}, bool) {}),
+		workflow.Receive(ctx.Done(), func(workflow.Context, struct{}, bool) {}),
+	)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logTaskEvent(task.LogEntry{
+		Message: "resumed",
+		Fields:  map[string]any{"status": "running"},
+	})
+
+	return nil
+}
@@ -21,19 +21,31 @@ func (w *Workflows) Register(work *worker.Worker) error {
 		return err
 	}
 	errs := []error{
+		work.RegisterWorkflow(w.ChangeEtcdMode),
+		work.RegisterWorkflow(w.CheckDrift),
+		work.RegisterWorkflow(w.CloneDatabase),
 		work.RegisterWorkflow(w.CreatePgBackRestBackup),
+		work.RegisterWorkflow(w.CutoverImport),
 		work.RegisterWorkflow(w.Failover),
 		work.RegisterWorkflow(w.DeleteDatabase),
+		work.RegisterWorkflow(w.GetInstanceSessions),
+		work.RegisterWorkflow(w.ImportDatabase),
 		work.RegisterWorkflow(w.PgBackRestRestore),
 		work.RegisterWorkflow(w.PlanRestore),
 		work.RegisterWorkflow(w.PlanUpdate),
 		work.RegisterWorkflow(w.RefreshCurrentState),
 		work.RegisterWorkflow(w.RemoveHost),
+		work.RegisterWorkflow(w.ResetQueryStats),
 		work.RegisterWorkflow(w.RestartInstance),
+		work.RegisterWorkflow(w.RollingRestart),
+		work.RegisterWorkflow(w.RollingRestartNode),
+		work.RegisterWorkflow(w.SignalSession),
 		work.RegisterWorkflow(w.StartInstance),
 		work.RegisterWorkflow(w.StopInstance),
 		work.RegisterWorkflow(w.Switchover),
 		work.RegisterWorkflow(w.UpdateDatabase),
+		work.RegisterWorkflow(w.UpgradeMajor),
+		work.RegisterWorkflow(w.PgUpgrade),
 		work.RegisterWorkflow(w.ValidateSpec),
 	}
 	return errors.Join(errs...)